@@ -0,0 +1,74 @@
+/*
+ * @Description: 文章正文存量压缩工具 - 压缩已有文章的 content_md 与 content_html 并输出节省的空间
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 08:00:00
+ *
+ * 编译方式: go build -o anheyu-compress-articles ./cmd/compress-articles
+ * 使用方式: 在主程序的工作目录下执行（读取 data/conf.ini），建议先停止主程序并备份数据库
+ *
+ * 参数:
+ *   -dry-run    只统计压缩后的大小，不写入数据库
+ *   -decompress 将已压缩的正文还原为原文，用于关闭压缩或回退到不支持压缩的版本之前
+ *   -batch      每批处理的文章数，默认 100
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/database"
+	ent_impl "github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/config"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "只统计，不写入数据库")
+	decompress := flag.Bool("decompress", false, "将已压缩的正文还原为原文")
+	batch := flag.Int("batch", 100, "每批处理的文章数")
+	flag.Parse()
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Fatalf("加载配置失败: %v", err)
+	}
+	sqlDB, err := database.NewSQLDB(cfg)
+	if err != nil {
+		log.Fatalf("连接数据库失败: %v", err)
+	}
+	defer sqlDB.Close()
+	client, err := database.NewEntClient(sqlDB, cfg)
+	if err != nil {
+		log.Fatalf("初始化 Ent 客户端失败: %v", err)
+	}
+
+	report, err := ent_impl.CompressArticleContent(context.Background(), client, *batch, *decompress, *dryRun)
+	if err != nil {
+		log.Fatalf("处理中断（已处理 %d 篇）: %v", report.Scanned, err)
+	}
+
+	action := "压缩"
+	if *decompress {
+		action = "还原"
+	}
+	if *dryRun {
+		action += "（试运行，未写入）"
+	}
+	fmt.Printf("%s完成：扫描 %d 篇，变更 %d 篇\n", action, report.Scanned, report.Changed)
+	fmt.Printf("正文大小：%s -> %s，节省 %.1f%%\n", formatBytes(report.BytesBefore), formatBytes(report.BytesAfter), report.SavedPercent())
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.2f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
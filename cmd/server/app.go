@@ -14,10 +14,11 @@ import (
 	"embed"
 	"fmt"
 	"log"
-	"net/http"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -30,90 +31,156 @@ import (
 	ent_impl "github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/infra/router"
 	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/compression"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/logger"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/slowquery"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
 	"github.com/anzhiyu-c/anheyu-app/internal/service/cache"
 	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	access_token_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/access_token"
 	album_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album"
 	album_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album_category"
 	article_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article"
+	article_collection_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_collection"
 	article_history_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_history"
+	article_template_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_template"
+	audit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/audit"
 	auth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/auth"
 	captcha_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/captcha"
+	changelog_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/changelog"
 	comment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/comment"
 	config_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/config"
+	contribution_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/contribution"
+	delivery_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/delivery"
+	diagnostic_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/diagnostic"
 	direct_link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/direct_link"
+	disposable_email_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/disposable_email"
 	doc_series_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/doc_series"
 	file_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/file"
 	image_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/image"
+	image_palette_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/image_palette"
+	invitation_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/invitation"
 	link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/link"
+	mail_template_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/mail_template"
+	media_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/media"
+	member_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/member"
+	micropub_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/micropub"
+	migration_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/migration"
+	moment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/moment"
 	music_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/music"
-	plugin_admin_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/plugin_admin"
 	notification_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/notification"
 	page_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/page"
+	plugin_admin_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/plugin_admin"
 	post_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/post_category"
 	post_tag_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/post_tag"
+	privacy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/privacy"
+	profile_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/profile"
 	proxy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/proxy"
 	public_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/public"
+	reading_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/reading"
 	rss_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/rss"
 	search_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/search"
 	setting_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setting"
+	setup_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setup"
 	sitemap_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sitemap"
+	social_card_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/social_card"
 	ssrtheme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/ssrtheme"
 	statistics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/statistics"
 	storage_policy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/storage_policy"
 	subscriber_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/subscriber"
+	task_queue_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/task_queue"
 	theme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/theme"
 	thumbnail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/thumbnail"
+	tts_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/tts"
+	url_migration_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/url_migration"
 	user_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/user"
 	version_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/version"
+	weather_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/weather"
+	webdav_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webdav"
 	wechat_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/wechat"
+	widget_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/widget"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/plugin"
+	access_token_service "github.com/anzhiyu-c/anheyu-app/pkg/service/access_token"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/album"
 	album_category_service "github.com/anzhiyu-c/anheyu-app/pkg/service/album_category"
 	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	article_collection_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_collection"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
+	article_template_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_template"
+	audit_service "github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 	captcha_service "github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
+	changelog_service "github.com/anzhiyu-c/anheyu-app/pkg/service/changelog"
 	cleanup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
 	comment_service "github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
 	config_service "github.com/anzhiyu-c/anheyu-app/pkg/service/config"
+	contribution_service "github.com/anzhiyu-c/anheyu-app/pkg/service/contribution"
+	db_maintenance_service "github.com/anzhiyu-c/anheyu-app/pkg/service/db_maintenance"
+	delivery_service "github.com/anzhiyu-c/anheyu-app/pkg/service/delivery"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
+	disposable_email_service "github.com/anzhiyu-c/anheyu-app/pkg/service/disposable_email"
 	doc_series_service "github.com/anzhiyu-c/anheyu-app/pkg/service/doc_series"
 	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file_info"
 	geetest_service "github.com/anzhiyu-c/anheyu-app/pkg/service/geetest"
-	imagecaptcha_service "github.com/anzhiyu-c/anheyu-app/pkg/service/imagecaptcha"
+	image_palette_service "github.com/anzhiyu-c/anheyu-app/pkg/service/image_palette"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/image_style"
 	image_style_engine "github.com/anzhiyu-c/anheyu-app/pkg/service/image_style/engine"
+	imagecaptcha_service "github.com/anzhiyu-c/anheyu-app/pkg/service/imagecaptcha"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/impersonation"
+	invitation_service "github.com/anzhiyu-c/anheyu-app/pkg/service/invitation"
 	link_service "github.com/anzhiyu-c/anheyu-app/pkg/service/link"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/loginguard"
+	mail_template_service "github.com/anzhiyu-c/anheyu-app/pkg/service/mail_template"
+	media_service "github.com/anzhiyu-c/anheyu-app/pkg/service/media"
+	member_service "github.com/anzhiyu-c/anheyu-app/pkg/service/member"
+	micropub_service "github.com/anzhiyu-c/anheyu-app/pkg/service/micropub"
+	migration_service "github.com/anzhiyu-c/anheyu-app/pkg/service/migration"
+	moment_service "github.com/anzhiyu-c/anheyu-app/pkg/service/moment"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/music"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
 	page_service "github.com/anzhiyu-c/anheyu-app/pkg/service/page"
 	parser_service "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	password_service "github.com/anzhiyu-c/anheyu-app/pkg/service/password"
 	post_category_service "github.com/anzhiyu-c/anheyu-app/pkg/service/post_category"
 	post_tag_service "github.com/anzhiyu-c/anheyu-app/pkg/service/post_tag"
+	privacy_service "github.com/anzhiyu-c/anheyu-app/pkg/service/privacy"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/process"
+	profile_service "github.com/anzhiyu-c/anheyu-app/pkg/service/profile"
+	reading_service "github.com/anzhiyu-c/anheyu-app/pkg/service/reading"
+	rss_service "github.com/anzhiyu-c/anheyu-app/pkg/service/rss"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/search"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	setup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/setup"
+	site_stats_service "github.com/anzhiyu-c/anheyu-app/pkg/service/site_stats"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/sitemap"
-	rss_service "github.com/anzhiyu-c/anheyu-app/pkg/service/rss"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/siteverify"
+	social_card_service "github.com/anzhiyu-c/anheyu-app/pkg/service/social_card"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
 	subscriber_service "github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/thumbnail"
+	tts_service "github.com/anzhiyu-c/anheyu-app/pkg/service/tts"
 	turnstile_service "github.com/anzhiyu-c/anheyu-app/pkg/service/turnstile"
+	url_migration_service "github.com/anzhiyu-c/anheyu-app/pkg/service/url_migration"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/user"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume/strategy"
+	weather_service "github.com/anzhiyu-c/anheyu-app/pkg/service/weather"
+	webdav_service "github.com/anzhiyu-c/anheyu-app/pkg/service/webdav"
 	wechat_service "github.com/anzhiyu-c/anheyu-app/pkg/service/wechat"
+	widget_service "github.com/anzhiyu-c/anheyu-app/pkg/service/widget"
 	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
-	"github.com/anzhiyu-c/anheyu-app/pkg/plugin"
 	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	_ "github.com/anzhiyu-c/anheyu-app/ent/runtime"
@@ -121,35 +188,35 @@ import (
 
 // App 结构体，用于封装应用的所有核心组件
 type App struct {
-	cfg                    *config.Config
-	engine                 *gin.Engine
-	taskBroker             *task.Broker
-	sqlDB                  *sql.DB
-	appVersion             string
-	articleService         article_service.Service
-	directLinkService      direct_link.Service
-	storagePolicyRepo      repository.StoragePolicyRepository
-	storagePolicyService   volume.IStoragePolicyService
-	fileService            file_service.FileService
-	mw                     *middleware.Middleware
-	settingRepo            repository.SettingRepository
-	settingSvc             setting.SettingService
-	tokenSvc               auth.TokenService
-	userSvc                user.UserService
-	fileRepo               repository.FileRepository
-	entityRepo             repository.EntityRepository
-	cacheSvc               utility.CacheService
-	eventBus               *event.EventBus
-	postCategorySvc        *post_category_service.Service
-	postTagSvc             *post_tag_service.Service
-	commentSvc             *comment_service.Service
-	themeSvc               theme.ThemeService
-	themeHandler           *theme_handler.Handler
-	ssrManager             *ssr.Manager
-	ssrThemeHandler        *ssrtheme_handler.Handler
-	imageStyleService      image_style.ImageStyleService
-	imageStyleCache        *image_style.DiskCache
-	configExtensionHolder  *configExtensionHolder // Pro 可通过 SetConfigExtension 注入支付配置导出/导入
+	cfg                   *config.Config
+	engine                *gin.Engine
+	taskBroker            *task.Broker
+	sqlDB                 *sql.DB
+	appVersion            string
+	articleService        article_service.Service
+	directLinkService     direct_link.Service
+	storagePolicyRepo     repository.StoragePolicyRepository
+	storagePolicyService  volume.IStoragePolicyService
+	fileService           file_service.FileService
+	mw                    *middleware.Middleware
+	settingRepo           repository.SettingRepository
+	settingSvc            setting.SettingService
+	tokenSvc              auth.TokenService
+	userSvc               user.UserService
+	fileRepo              repository.FileRepository
+	entityRepo            repository.EntityRepository
+	cacheSvc              utility.CacheService
+	eventBus              *event.EventBus
+	postCategorySvc       *post_category_service.Service
+	postTagSvc            *post_tag_service.Service
+	commentSvc            *comment_service.Service
+	themeSvc              theme.ThemeService
+	themeHandler          *theme_handler.Handler
+	ssrManager            *ssr.Manager
+	ssrThemeHandler       *ssrtheme_handler.Handler
+	imageStyleService     image_style.ImageStyleService
+	imageStyleCache       *image_style.DiskCache
+	configExtensionHolder *configExtensionHolder // Pro 可通过 SetConfigExtension 注入支付配置导出/导入
 }
 
 func (a *App) PrintBanner() {
@@ -216,6 +283,8 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	if err != nil {
 		return nil, nil, fmt.Errorf("加载配置失败: %w", err)
 	}
+	// 结构化日志：之后的 log 与 slog 输出统一经过全局记录器，级别在读取配置后由后台设置控制
+	logger.Init(cfg.GetString(config.KeyLogFormat))
 
 	// --- Phase 2: 初始化基础设施 ---
 	sqlDB, err := database.NewSQLDB(cfg)
@@ -265,23 +334,43 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	userGroupRepo := ent_impl.NewEntUserGroupRepository(entClient)
 	fileRepo := ent_impl.NewEntFileRepository(entClient, sqlDB, dbType)
 	entityRepo := ent_impl.NewEntEntityRepository(entClient)
+	uploadSessionRepo := ent_impl.NewUploadSessionRepo(entClient)
+	recycleItemRepo := ent_impl.NewRecycleItemRepo(entClient)
 	fileEntityRepo := ent_impl.NewEntFileEntityRepository(entClient)
 	tagRepo := ent_impl.NewEntTagRepository(entClient)
 	directLinkRepo := ent_impl.NewEntDirectLinkRepository(entClient)
 	albumRepo := ent_impl.NewEntAlbumRepository(entClient)
 	albumCategoryRepo := ent_impl.NewAlbumCategoryRepo(entClient)
 	storagePolicyRepo := ent_impl.NewEntStoragePolicyRepository(entClient)
+	storagePolicyMountRepo := ent_impl.NewEntStoragePolicyMountRepository(entClient)
 	metadataRepo := ent_impl.NewEntMetadataRepository(entClient)
 	articleRepo := ent_impl.NewArticleRepo(entClient, dbType)
 	articleHistoryRepo := ent_impl.NewArticleHistoryRepo(entClient)
+	articleAudioRepo := ent_impl.NewEntArticleAudioRepository(entClient)
+	notificationDeliveryRepo := ent_impl.NewEntNotificationDeliveryRepository(entClient)
+	auditLogRepo := ent_impl.NewEntAuditLogRepository(entClient)
+	invitationCodeRepo := ent_impl.NewInvitationCodeRepo(entClient)
+	mailTemplateVersionRepo := ent_impl.NewMailTemplateVersionRepo(entClient)
+	userIdentityRepo := ent_impl.NewUserIdentityRepo(entClient)
 	postTagRepo := ent_impl.NewPostTagRepo(entClient, dbType)
 	postCategoryRepo := ent_impl.NewPostCategoryRepo(entClient)
 	docSeriesRepo := ent_impl.NewDocSeriesRepo(entClient)
+	articleTemplateRepo := ent_impl.NewArticleTemplateRepo(entClient)
+	contentSnippetRepo := ent_impl.NewContentSnippetRepo(entClient)
+	articleCollectionRepo := ent_impl.NewArticleCollectionRepo(entClient)
+	articleReviewRepo := ent_impl.NewArticleReviewRepo(entClient)
+	articleReactionRepo := ent_impl.NewArticleReactionRepo(entClient)
+	userBookmarkRepo := ent_impl.NewUserBookmarkRepo(entClient)
+	readingProgressRepo := ent_impl.NewReadingProgressRepo(entClient)
+	changelogRepo := ent_impl.NewChangelogRepo(entClient)
+	accessTokenRepo := ent_impl.NewAccessTokenRepo(entClient)
+	momentRepo := ent_impl.NewMomentRepo(entClient)
 	cleanupRepo := ent_impl.NewCleanupRepo(entClient)
 	commentRepo := ent_impl.NewCommentRepo(entClient, dbType)
 	linkRepo := ent_impl.NewLinkRepo(entClient, dbType)
 	linkCategoryRepo := ent_impl.NewLinkCategoryRepo(entClient)
 	linkTagRepo := ent_impl.NewLinkTagRepo(entClient)
+	linkCheckRecordRepo := ent_impl.NewLinkCheckRecordRepo(entClient)
 	pageRepo := ent_impl.NewEntPageRepository(entClient)
 	notificationTypeRepo := ent_impl.NewEntNotificationTypeRepository(entClient)
 	userNotificationConfigRepo := ent_impl.NewEntUserNotificationConfigRepository(entClient)
@@ -309,6 +398,39 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	if err := settingSvc.LoadAllSettings(context.Background()); err != nil {
 		return nil, tempCleanup, fmt.Errorf("从数据库加载站点配置失败: %w", err)
 	}
+	// 生产模式下拒绝使用空 JWT 密钥启动，避免签发可被伪造的令牌
+	if strings.TrimSpace(settingSvc.Get(constant.KeyJWTSecret.String())) == "" {
+		if !cfg.GetBool("System.Debug") {
+			return nil, tempCleanup, fmt.Errorf("JWT_SECRET 为空，拒绝在生产模式下启动，请检查数据库 setting 表或设置环境变量 AN_SETTING_DEFAULT_JWT_SECRET")
+		}
+		log.Println("⚠️ 警告: JWT_SECRET 为空，仅允许在 Debug 模式下运行")
+	}
+	// 后台任务协程池需在任何服务提交任务之前完成配置
+	if poolConfigs, err := workerpool.ParseConfigs(settingSvc.Get(constant.KeyWorkerPools.String())); err != nil {
+		log.Printf("⚠️ %v，后台任务协程池使用默认配置", err)
+	} else {
+		workerpool.Configure(poolConfigs)
+	}
+	// 日志级别在后台修改后立即生效
+	applyLogLevel(settingSvc.Get(constant.KeyLogLevel.String()))
+	eventBus.Subscribe(event.Topic(setting.TopicSettingUpdated), func(payload interface{}) {
+		if evt, ok := payload.(setting.SettingUpdatedEvent); ok && evt.Key == constant.KeyLogLevel.String() {
+			applyLogLevel(evt.Value)
+		}
+	})
+	// 慢查询阈值在后台修改后立即生效
+	slowquery.SetThreshold(slowquery.ParseThresholdMs(settingSvc.Get(constant.KeySlowQueryThresholdMs.String())))
+	eventBus.Subscribe(event.Topic(setting.TopicSettingUpdated), func(payload interface{}) {
+		if evt, ok := payload.(setting.SettingUpdatedEvent); ok && evt.Key == constant.KeySlowQueryThresholdMs.String() {
+			slowquery.SetThreshold(slowquery.ParseThresholdMs(evt.Value))
+		}
+	})
+	compression.SetEnabled(settingSvc.Get(constant.KeyArticleContentCompression.String()) != "false")
+	eventBus.Subscribe(event.Topic(setting.TopicSettingUpdated), func(payload interface{}) {
+		if evt, ok := payload.(setting.SettingUpdatedEvent); ok && evt.Key == constant.KeyArticleContentCompression.String() {
+			compression.SetEnabled(evt.Value != "false")
+		}
+	})
 	strategyManager := strategy.NewManager()
 	strategyManager.Register(constant.PolicyTypeLocal, strategy.NewLocalStrategy())
 	strategyManager.Register(constant.PolicyTypeOneDrive, strategy.NewOneDriveStrategy())
@@ -341,15 +463,18 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	postCategorySvc := post_category_service.NewService(postCategoryRepo, articleRepo)
 	docSeriesSvc := doc_series_service.NewService(docSeriesRepo)
 	cleanupSvc := cleanup_service.NewCleanupService(cleanupRepo)
-	userSvc := user.NewUserService(userRepo, userGroupRepo)
+	cleanupSvc.SetCommentCleanup(settingSvc, commentRepo)
+	// 密码策略：注册、重置与修改密码时统一校验
+	passwordPolicySvc := password_service.NewService(settingSvc)
+	userSvc := user.NewUserService(userRepo, userGroupRepo, passwordPolicySvc)
 	storagePolicySvc := volume.NewStoragePolicyService(storagePolicyRepo, fileRepo, txManager, strategyManager, settingSvc, cacheSvc, storageProviders)
 	thumbnailSvc := thumbnail.NewThumbnailService(metadataSvc, fileRepo, entityRepo, storagePolicySvc, settingSvc, storageProviders)
 	pathLocker := utility.NewPathLocker()
 	syncSvc := process.NewSyncService(txManager, fileRepo, entityRepo, fileEntityRepo, storagePolicySvc, eventBus, storageProviders, settingSvc)
-	vfsSvc := volume.NewVFSService(storagePolicySvc, cacheSvc, fileRepo, entityRepo, settingSvc, storageProviders)
+	vfsSvc := volume.NewVFSService(storagePolicySvc, storagePolicyMountRepo, cacheSvc, fileRepo, entityRepo, settingSvc, storageProviders)
 	extractionSvc := file_info.NewExtractionService(fileRepo, settingSvc, metadataSvc, vfsSvc)
-	fileSvc := file_service.NewService(fileRepo, storagePolicyRepo, txManager, entityRepo, fileEntityRepo, userGroupRepo, metadataSvc, extractionSvc, cacheSvc, storagePolicySvc, settingSvc, syncSvc, vfsSvc, storageProviders, eventBus, pathLocker)
-	uploadSvc := file_service.NewUploadService(txManager, eventBus, entityRepo, metadataSvc, cacheSvc, storagePolicySvc, settingSvc, storageProviders)
+	fileSvc := file_service.NewService(fileRepo, storagePolicyRepo, txManager, entityRepo, fileEntityRepo, uploadSessionRepo, recycleItemRepo, userGroupRepo, metadataSvc, extractionSvc, cacheSvc, storagePolicySvc, settingSvc, syncSvc, vfsSvc, storageProviders, eventBus, pathLocker)
+	uploadSvc := file_service.NewUploadService(txManager, eventBus, entityRepo, uploadSessionRepo, metadataSvc, cacheSvc, storagePolicySvc, vfsSvc, settingSvc, userRepo, storageProviders)
 	directLinkSvc := direct_link.NewDirectLinkService(directLinkRepo, fileRepo, userGroupRepo, settingSvc, storagePolicyRepo)
 
 	// 初始化图片样式处理服务（Phase 1：纯 Go 引擎 + 磁盘缓存；Phase 2 会接入 vips）
@@ -385,7 +510,9 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	// 初始化文章历史版本服务（需要在taskBroker之前创建，用于定时清理任务）
 	articleHistorySvc := article_history_service.NewService(articleHistoryRepo, articleRepo, userRepo)
 
-	taskBroker := task.NewBroker(uploadSvc, thumbnailSvc, cleanupSvc, articleRepo, commentRepo, emailSvc, cacheSvc, linkCategoryRepo, linkTagRepo, linkRepo, settingSvc, statService, articleHistorySvc, nil)
+	// 后台任务队列：Redis 可用时持久化，进程崩溃或重启后继续执行未完成的任务
+	taskQueue := task.NewQueue(cfg.GetString(config.KeyQueueBackend), redisClient)
+	taskBroker := task.NewBroker(uploadSvc, thumbnailSvc, cleanupSvc, articleRepo, commentRepo, emailSvc, cacheSvc, linkCategoryRepo, linkTagRepo, linkRepo, settingSvc, statService, articleHistorySvc, nil, taskQueue)
 	pageSvc := page_service.NewService(pageRepo)
 
 	// 初始化搜索服务（稍后在插件初始化后会再次检查插件提供的搜索引擎）
@@ -396,55 +523,33 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	searchSvc := search.NewSearchService()
 	sitemapSvc := sitemap.NewService(articleRepo, pageRepo, linkRepo, settingSvc)
 
-	// 重建所有文章的搜索索引（分页获取全部文章）
+	// 同步搜索索引：持久化索引只重新索引变化的文章，否则全量重建
 	go func() {
-		log.Println("🔄 开始重建搜索索引...")
-		ctx := context.Background()
-		if err := searchSvc.RebuildAllIndexes(ctx); err != nil {
-			log.Printf("重建搜索索引失败: %v", err)
+		log.Println("🔄 开始同步搜索索引...")
+		result, err := searchSvc.SyncAllIndexes(context.Background(), articleRepo)
+		if err != nil {
+			log.Printf("同步搜索索引失败: %v", err)
+		}
+		if result == nil {
 			return
 		}
-
-		const batchSize = 200
-		successCount := 0
-		totalCount := 0
-
-		for page := 1; ; page++ {
-			articles, _, err := articleRepo.List(ctx, &model.ListArticlesOptions{
-				WithContent: true,
-				Page:        page,
-				PageSize:    batchSize,
-			})
-			if err != nil {
-				log.Printf("获取文章列表失败(page=%d): %v", page, err)
-				break
-			}
-			if len(articles) == 0 {
-				break
-			}
-			totalCount += len(articles)
-
-			for _, article := range articles {
-				if err := searchSvc.IndexArticle(ctx, article); err != nil {
-					log.Printf("为文章 %s 建立索引失败: %v", article.Title, err)
-				} else {
-					successCount++
-				}
-			}
-
-			if len(articles) < batchSize {
-				break
-			}
+		if result.Incremental {
+			log.Printf("✅ 搜索索引增量同步完成！共 %d 篇文章，更新 %d，跳过 %d，清理 %d，失败 %d",
+				result.Total, result.Indexed, result.Skipped, result.Deleted, result.Failed)
+		} else {
+			log.Printf("✅ 搜索索引重建完成！成功为 %d/%d 篇文章建立索引", result.Indexed, result.Total)
 		}
-
-		log.Printf("✅ 搜索索引重建完成！成功为 %d/%d 篇文章建立索引", successCount, totalCount)
 	}()
 
 	// 初始化主色调服务
 	log.Printf("[DEBUG] 正在初始化 PrimaryColorService...")
 	colorSvc := utility.NewColorService()
-	httpClient := &http.Client{Timeout: 10 * time.Second}
+	// 主色调提取会抓取用户提供的图片地址，出站连接需经过 SSRF 防护
+	outboundGuard := ssrf.NewGuard(func() string { return settingSvc.Get(constant.KeyOutboundAllowlist.String()) })
+	httpClient := httpclient.New("primary_color", httpclient.DefaultPolicy(), httpclient.WithBaseTransport(outboundGuard.Transport()))
 	primaryColorSvc := utility.NewPrimaryColorService(colorSvc, settingSvc, fileRepo, directLinkRepo, storagePolicyRepo, httpClient, storageProviders)
+	// 文章保存时主色调提取交由后台任务执行
+	taskBroker.SetPrimaryColorService(primaryColorSvc)
 	log.Printf("[DEBUG] PrimaryColorService 初始化完成")
 
 	// 初始化CDN服务
@@ -456,26 +561,78 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	subscriberSvc := subscriber_service.NewService(entClient, redisClient, emailSvc)
 
 	articleSvc := article_service.NewService(articleRepo, postTagRepo, postCategoryRepo, commentRepo, docSeriesRepo, pageRepo, txManager, cacheSvc, geoSvc, taskBroker, settingSvc, parserSvc, fileSvc, directLinkSvc, searchSvc, primaryColorSvc, cdnSvc, subscriberSvc, userRepo)
+	articleTemplateSvc := article_template_service.NewService(articleTemplateRepo, contentSnippetRepo, articleSvc, parserSvc)
+	articleCollectionSvc := article_collection_service.NewService(articleCollectionRepo, articleRepo, articleSvc)
+	accessTokenSvc := access_token_service.NewService(accessTokenRepo, userRepo)
+	micropubSvc := micropub_service.NewService(articleSvc, articleRepo, postTagRepo, parserSvc, settingSvc)
 	// 注入文章历史版本仓储
 	articleSvc.SetHistoryRepo(articleHistoryRepo)
 	// 注入事件总线，用于文章 CRUD 时通知前端清缓存
 	articleSvc.SetEventBus(eventBus)
+	// 注入站点地图服务，文章发布后通知站点地图提交地址
+	articleSvc.SetSitemapService(sitemapSvc)
+	// 定时发布的文章由文章服务完成索引、订阅通知等后续处理
+	taskBroker.SetScheduledPublishHook(articleSvc.HandleScheduledPublished)
 	// 注入图片样式服务，使上传响应 URL 自动拼默认样式后缀
 	articleSvc.SetImageStyleService(imageStyleSvc)
+	// 注入文章语音仓储，用于在文章详情中返回朗读音频地址
+	articleSvc.SetArticleAudioRepo(articleAudioRepo)
+	// 注入文章回应计数仓储，用于文章表情回应与回应统计
+	articleSvc.SetArticleReactionRepo(articleReactionRepo)
 	// articleHistorySvc 已在 taskBroker 之前创建
 	log.Printf("[DEBUG] 正在初始化 PushooService...")
 	pushooSvc := utility.NewPushooService(settingSvc)
 	log.Printf("[DEBUG] PushooService 初始化完成")
 
+	// 初始化通知投递队列：通知邮件与即时推送先持久化，再由后台任务发送并按退避策略重试
+	deliverySvc := delivery_service.NewService(notificationDeliveryRepo, settingSvc)
+	deliverySvc.RegisterSender(model.DeliveryChannelEmail, emailSvc.DeliverQueued)
+	deliverySvc.RegisterSender(model.DeliveryChannelBark, pushooSvc.DeliverQueued)
+	deliverySvc.RegisterSender(model.DeliveryChannelWebhook, pushooSvc.DeliverQueued)
+	deliverySvc.SetDispatcher(taskBroker.DispatchNotificationDelivery)
+	deliverySvc.Recover(context.Background())
+	emailSvc.SetQueue(deliverySvc)
+	pushooSvc.SetQueue(deliverySvc)
+	taskBroker.SetNotificationDeliveryService(deliverySvc)
+	deliveryHandler := delivery_handler.NewHandler(deliverySvc)
+
 	log.Printf("[DEBUG] 正在初始化 LinkService，将注入 PushooService、EmailService 和 EventBus...")
-	linkSvc := link_service.NewService(linkRepo, linkCategoryRepo, linkTagRepo, txManager, taskBroker, settingSvc, pushooSvc, emailSvc, eventBus)
+	linkSvc := link_service.NewService(linkRepo, linkCategoryRepo, linkTagRepo, linkCheckRecordRepo, txManager, taskBroker, settingSvc, pushooSvc, emailSvc, eventBus)
+	taskBroker.SetLinkHealthChecker(linkSvc.RunScheduledHealthCheck)
 	log.Printf("[DEBUG] LinkService 初始化完成，PushooService、EmailService 和 EventBus 已注入")
 
-	authSvc := auth.NewAuthService(userRepo, settingSvc, tokenSvc, emailSvc, txManager, articleSvc)
+	// 一次性邮箱拦截：评论与注册按配置拒绝或转为人工审核，域名列表每天自动更新
+	disposableEmailSvc := disposable_email_service.NewService(settingSvc, cacheSvc, ent_impl.NewDisposableEmailStatRepo(entClient))
+	taskBroker.SetDisposableEmailUpdater(disposableEmailSvc.AutoRefresh)
+	taskBroker.SetDisposableEmailStatsFlusher(disposableEmailSvc.FlushCounters)
+	changelogSvc := changelog_service.NewService(changelogRepo, settingSvc, parserSvc, httpclient.New("changelog", httpclient.DefaultPolicy(), httpclient.WithBaseTransport(outboundGuard.Transport())))
+	taskBroker.SetChangelogSyncer(changelogSvc.AutoSync)
+	authSvc := auth.NewAuthService(userRepo, settingSvc, tokenSvc, emailSvc, txManager, articleSvc, passwordPolicySvc, disposableEmailSvc)
 	log.Printf("[DEBUG] 正在初始化 CommentService，将注入 PushooService 和 NotificationService...")
 	commentSvc := comment_service.NewService(commentRepo, userRepo, txManager, geoSvc, settingSvc, cacheSvc, taskBroker, fileSvc, parserSvc, pushooSvc, notificationSvc)
 	// 注入图片样式服务，使评论内嵌图片 URL 自动拼默认样式后缀（Plan B Phase 1 Task 1.13.2）
 	commentSvc.SetImageStyleService(imageStyleSvc)
+	commentSvc.SetCommenterTrustRepo(ent_impl.NewCommenterTrustRepo(entClient))
+	commentSvc.SetDisposableEmailService(disposableEmailSvc)
+	// 实时评论流：通过 /api/ws/comments 向订阅了对应路径的前端推送新评论、状态变更与点赞
+	commentSvc.SetStreamHub(comment_service.NewStreamHub(0))
+	// 评论区订阅：新评论按间隔合并为摘要邮件发送，退订链接使用 JWT 密钥签名
+	commentSvc.SetSubscriptionRepo(ent_impl.NewCommentSubscriptionRepo(entClient), tokenSvc)
+	commentSvc.SetEmailService(emailSvc)
+	// 侧边栏站点统计快照：文章与评论变化时立即刷新，定时任务每 10 分钟兜底刷新一次
+	commentSvc.SetEventBus(eventBus)
+	siteStatsSvc := site_stats_service.NewService(articleRepo, commentRepo, cacheSvc, settingSvc)
+	siteStatsSvc.Subscribe(eventBus)
+	taskBroker.SetSiteStatsRefresher(siteStatsSvc.Refresh)
+	// 数据库维护：在配置的维护时段内清理过期的软删除数据并整理数据库，结果记录在后台任务面板中
+	taskBroker.SetDBMaintenanceService(db_maintenance_service.NewService(sqlDB, dbType, settingSvc))
+	taskBroker.SetCommentDigestRunner(commentSvc.SendSubscriptionDigests)
+	momentSvc := moment_service.NewService(momentRepo, commentRepo, parserSvc, cacheSvc)
+	// 说说的评论路径为 /moments/{id}，创建评论前校验说说是否允许评论
+	commentSvc.AddTargetGuard(momentSvc.CheckCommentTarget)
+	// 反垃圾评论：Akismet（配置 API Key 后生效）与本地贝叶斯过滤器，管理员标记垃圾/正常评论时反馈训练
+	commentSvc.AddSpamDetector(comment_service.NewAkismetDetector(settingSvc))
+	commentSvc.AddSpamDetector(comment_service.NewBayesDetector(ent_impl.NewSpamTokenRepo(entClient), settingSvc))
 	log.Printf("[DEBUG] CommentService 初始化完成，PushooService 和 NotificationService 已注入")
 	themeSvc := theme.NewThemeService(entClient, userRepo)
 	_ = listener.NewFilePostProcessingListener(eventBus, taskBroker, extractionSvc)
@@ -487,7 +644,7 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 
 	// 初始化音乐服务
 	log.Printf("[DEBUG] 正在初始化 MusicService...")
-	musicSvc := music.NewMusicService(settingSvc)
+	musicSvc := music.NewMusicService(settingSvc, fileRepo, directLinkSvc)
 	log.Printf("[DEBUG] MusicService 初始化完成")
 
 	// 初始化配置导入导出服务（备份服务依赖此服务导出/导入系统设置）
@@ -502,6 +659,14 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	taskBroker.SetBackupService(configBackupSvc)
 	log.Printf("[DEBUG] ConfigBackupService 初始化完成")
 
+	// 初始化隐私数据服务，并注入到 TaskBroker 用于定时匿名化访客IP
+	privacySvc := privacy_service.NewService(entClient, settingSvc)
+	taskBroker.SetPrivacyService(privacySvc)
+
+	// 初始化媒体库服务，并为清理服务注入孤立图片的查找与删除能力
+	mediaSvc := media_service.NewService(entClient, settingSvc)
+	cleanupSvc.SetMediaCleanup(mediaSvc, fileSvc)
+
 	// 初始化 Turnstile 人机验证服务
 	log.Printf("[DEBUG] 正在初始化 TurnstileService...")
 	turnstileSvc := turnstile_service.NewTurnstileService(settingSvc)
@@ -519,7 +684,14 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 
 	// 初始化统一验证服务
 	log.Printf("[DEBUG] 正在初始化 CaptchaService...")
-	captchaSvc := captcha_service.NewCaptchaService(settingSvc, turnstileSvc, geetestSvc, imageCaptchaSvc)
+	captchaSvc := captcha_service.NewCaptchaService(
+		settingSvc,
+		turnstileSvc,
+		siteverify.NewHCaptchaVerifier(settingSvc),
+		siteverify.NewRecaptchaVerifier(settingSvc),
+		geetestSvc,
+		imageCaptchaSvc,
+	)
 	log.Printf("[DEBUG] CaptchaService 初始化完成")
 
 	// --- Phase 5.5: 初始化 SSR 主题管理器 ---
@@ -575,37 +747,77 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 
 	// --- Phase 6: 初始化表现层 (Handlers) ---
 	mw := middleware.NewMiddleware(tokenSvc)
+	// 审计日志：记录管理员模拟登录的签发与模拟期间发起的请求，以及后台对设置、文章、评论、存储策略和用户的修改
+	auditSvc := audit_service.NewService(auditLogRepo)
+	mw.SetAuditService(auditSvc)
+	settingSvc.SetAuditService(auditSvc)
+	articleSvc.SetAuditService(auditSvc)
+	commentSvc.SetAuditService(auditSvc)
+	storagePolicySvc.SetAuditService(auditSvc)
+	userSvc.SetAuditService(auditSvc)
+	// 个人访问令牌（ahy_ 开头）可代替 JWT 调用站点接口，按令牌的权限范围限制请求
+	mw.SetAccessTokenService(accessTokenSvc)
+	mw.SetUserGroupRepository(userGroupRepo)
+	auditHandler := audit_handler.NewHandler(auditSvc)
+	memberHandler := member_handler.NewHandler(member_service.NewService(userRepo, commentSvc, settingSvc))
+	invitationHandler := invitation_handler.NewHandler(invitation_service.NewService(invitationCodeRepo))
+	migrationSvc := migration_service.NewService(articleSvc, articleRepo, settingSvc)
+	migrationSvc.SetWordPressImport(commentSvc, parserSvc, taskBroker.DispatchWordPressImport)
+	taskBroker.SetWordPressImportRunner(migrationSvc.RunWordPressImport)
+	migrationHandler := migration_handler.NewHandler(migrationSvc)
 	authHandler := auth_handler.NewAuthHandler(authSvc, tokenSvc, settingSvc, captchaSvc)
+	authHandler.SetPasswordPolicyService(passwordPolicySvc)
+	loginGuardSvc := loginguard.NewService(settingSvc, cacheSvc, userRepo, auditSvc, emailSvc)
+	authHandler.SetLoginGuard(loginGuardSvc)
+	authHandler.SetOAuthService(auth.NewOAuthService(userRepo, userIdentityRepo, authSvc, settingSvc, cacheSvc))
 	albumHandler := album_handler.NewAlbumHandler(albumSvc)
 	albumCategoryHandler := album_category_handler.NewHandler(albumCategorySvc)
 	userHandler := user_handler.NewUserHandler(userSvc, settingSvc, fileSvc, directLinkSvc)
 	// 注入图片样式服务，使头像上传响应 URL 自动拼默认样式后缀
 	userHandler.SetImageStyleService(imageStyleSvc)
+	userHandler.SetImpersonationService(impersonation.NewService(userRepo, tokenSvc, auditSvc))
 	publicHandler := public_handler.NewPublicHandler(albumSvc, albumCategorySvc)
 	settingHandler := setting_handler.NewSettingHandler(settingSvc, emailSvc, cdnSvc, configBackupSvc)
 	storagePolicyHandler := storage_policy_handler.NewStoragePolicyHandler(storagePolicySvc)
+	storagePolicyHandler.SetPurgeService(volume.NewPolicyPurgeService(storagePolicySvc, entityRepo, fileRepo, storageProviders))
+	staleUploadSvc := volume.NewStaleUploadService(storagePolicySvc, settingSvc, storageProviders)
+	storagePolicyHandler.SetStaleUploadService(staleUploadSvc)
+	taskBroker.SetStaleUploadCleaner(staleUploadSvc.AutoCleanup)
+	taskBroker.SetRecycleBinCleaner(fileSvc.CleanupExpiredRecycleItems)
+	integritySvc := volume.NewIntegrityService(ent_impl.NewIntegrityRepo(entClient), entityRepo, storagePolicySvc, settingSvc, storageProviders)
+	storagePolicyHandler.SetIntegrityService(integritySvc)
+	taskBroker.SetIntegrityChecker(integritySvc.AutoCheck)
+	storagePolicyHandler.SetMountService(volume.NewMountService(storagePolicySvc, storagePolicyMountRepo, txManager, cacheSvc))
 	fileHandler := file_handler.NewHandler(fileSvc, uploadSvc, settingSvc)
 	directLinkHandler := direct_link_handler.NewDirectLinkHandler(directLinkSvc, storageProviders)
 	// 注入图片样式服务，使 `/api/f/:pubID/filename!style` 的本地策略直链下载能走
 	// ImageStyleService 的缓存 + 处理流程（Plan B Phase 1 Task 1.13 的客户端落地配套）。
 	directLinkHandler.SetImageStyleService(imageStyleSvc)
 	linkHandler := link_handler.NewHandler(linkSvc)
+	linkHandler.SetCaptchaService(captchaSvc)
 	thumbnailHandler := thumbnail_handler.NewThumbnailHandler(taskBroker, metadataSvc, fileSvc, thumbnailSvc, settingSvc)
 	articleHandler := article_handler.NewHandler(articleSvc)
+	articleHandler.SetCachePolicySettings(settingSvc)
 	articleHistoryHandler := article_history_handler.NewHandler(articleHistorySvc)
+	articleHistoryHandler.SetArticleService(articleSvc)
 	postTagHandler := post_tag_handler.NewHandler(postTagSvc)
 	postCategoryHandler := post_category_handler.NewHandler(postCategorySvc)
 	docSeriesHandler := doc_series_handler.NewHandler(docSeriesSvc)
 	commentHandler := comment_handler.NewHandler(commentSvc, settingSvc)
+	commentHandler.SetCleanupService(cleanupSvc)
+	commentHandler.SetCaptchaService(captchaSvc)
 	pageHandler := page_handler.NewHandler(pageSvc)
+	pageHandler.SetCachePolicySettings(settingSvc)
 	searchHandler := search_handler.NewHandler(searchSvc)
-	statisticsHandler := statistics_handler.NewStatisticsHandler(statService)
+	statisticsHandler := statistics_handler.NewStatisticsHandler(statService, siteStatsSvc)
 	themeHandler := theme_handler.NewHandler(themeSvc, ssrManager)
 	sitemapHandler := sitemap_handler.NewHandler(sitemapSvc)
-	rssSvc := rss_service.NewService(articleSvc, settingSvc, cacheSvc)
+	rssSvc := rss_service.NewService(articleSvc, articleRepo, commentRepo, momentRepo, settingSvc, cacheSvc)
+	rssSvc.SetChangelogRepository(changelogRepo)
 	rssHandler := rss_handler.NewHandler(rssSvc, settingSvc)
-	proxyHandler := proxy_handler.NewHandler()
-	musicHandler := music_handler.NewMusicHandler(musicSvc)
+	proxyHandler := proxy_handler.NewHandler(outboundGuard)
+	musicPlayStatSvc := music.NewPlayStatService(ent_impl.NewMusicPlayStatRepo(entClient), cacheSvc)
+	musicHandler := music_handler.NewMusicHandler(musicSvc, musicPlayStatSvc)
 	versionHandler := version_handler.NewHandler()
 	notificationHandler := notification_handler.NewHandler(notificationSvc)
 	configBackupHandler := config_handler.NewConfigBackupHandler(configBackupSvc)
@@ -613,6 +825,48 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	subscriberHandler := subscriber_handler.NewHandler(subscriberSvc, captchaSvc)
 	captchaHandler := captcha_handler.NewHandler(captchaSvc)
 	imageHandler := image_handler.NewHandler(imageStyleSvc, fileRepo, storagePolicyRepo, directLinkSvc)
+	diagnosticHandler := diagnostic_handler.NewHandler(settingSvc)
+	widgetSvc := widget_service.NewService(articleRepo, cacheSvc, settingSvc, siteStatsSvc)
+	widgetHandler := widget_handler.NewHandler(widgetSvc)
+	privacyHandler := privacy_handler.NewHandler(privacySvc)
+	mediaHandler := media_handler.NewHandler(mediaSvc, cleanupSvc)
+	articleTemplateHandler := article_template_handler.NewHandler(articleTemplateSvc)
+	articleCollectionHandler := article_collection_handler.NewHandler(articleCollectionSvc)
+	articleCollectionHandler.SetCachePolicySettings(settingSvc)
+	contributionHandler := contribution_handler.NewHandler(contribution_service.NewService(articleSvc, articleReviewRepo, userRepo, emailSvc))
+	readingHandler := reading_handler.NewHandler(reading_service.NewService(userBookmarkRepo, readingProgressRepo, articleRepo, articleSvc))
+	changelogHandler := changelog_handler.NewHandler(changelogSvc)
+	changelogHandler.SetCachePolicySettings(settingSvc)
+	micropubHandler := micropub_handler.NewHandler(micropubSvc, accessTokenSvc)
+	momentHandler := moment_handler.NewHandler(momentSvc)
+	profileSvc := profile_service.NewService(settingSvc, cacheSvc, httpclient.New("profile", httpclient.DefaultPolicy(), httpclient.WithBaseTransport(outboundGuard.Transport())))
+	profileHandler := profile_handler.NewHandler(profileSvc)
+	weatherSvc := weather_service.NewService(settingSvc, cacheSvc, httpclient.New("weather", httpclient.DefaultPolicy(), httpclient.WithBaseTransport(outboundGuard.Transport())))
+	weatherHandler := weather_handler.NewHandler(weatherSvc)
+	// 语音合成单次请求耗时较长，且 POST 请求不会重试
+	ttsPolicy := httpclient.DefaultPolicy()
+	ttsPolicy.Timeout = 2 * time.Minute
+	ttsPolicy.MaxRetries = 0
+	ttsSvc := tts_service.NewService(settingSvc, articleRepo, articleAudioRepo, fileSvc, directLinkSvc, eventBus, httpclient.New("tts", ttsPolicy, httpclient.WithBaseTransport(outboundGuard.Transport())))
+	ttsSvc.Subscribe()
+	ttsHandler := tts_handler.NewHandler(ttsSvc)
+	setupSvc := setup_service.NewService(settingSvc, userRepo, authSvc, storagePolicySvc, emailSvc)
+	if err := setupSvc.Init(context.Background()); err != nil {
+		log.Printf("⚠️ 初始化向导状态检查失败: %v", err)
+	}
+	setupHandler := setup_handler.NewHandler(setupSvc)
+	mailTemplateSvc := mail_template_service.NewService(mailTemplateVersionRepo, settingSvc)
+	mailTemplateHandler := mail_template_handler.NewHandler(mailTemplateSvc)
+	disposableEmailHandler := disposable_email_handler.NewHandler(disposableEmailSvc)
+	accessTokenHandler := access_token_handler.NewHandler(accessTokenSvc)
+	webdavHandler := webdav_handler.NewHandler(webdav_service.NewService(fileSvc, uploadSvc, fileRepo), settingSvc, authSvc, accessTokenSvc)
+	webdavHandler.SetLoginGuard(loginGuardSvc)
+	taskQueueHandler := task_queue_handler.NewHandler(taskBroker)
+	// 站点地址迁移：替换文章、评论与配置中的旧地址，完成后由文章服务清理缓存并重建索引
+	urlMigrationHandler := url_migration_handler.NewHandler(url_migration_service.NewService(entClient, settingSvc, articleSvc))
+	socialCardHandler := social_card_handler.NewHandler(social_card_service.NewService(articleRepo, settingSvc))
+	// 图片配色：由 broker 在后台调用主色调服务提取，结果写入缓存
+	imagePaletteHandler := image_palette_handler.NewHandler(image_palette_service.NewService(cacheSvc, taskBroker))
 
 	// --- Phase 7: 初始化路由 ---
 	appRouter := router.NewRouter(
@@ -650,6 +904,34 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 		subscriberHandler,
 		captchaHandler,
 		imageHandler,
+		diagnosticHandler,
+		widgetHandler,
+		privacyHandler,
+		mediaHandler,
+		articleTemplateHandler,
+		micropubHandler,
+		momentHandler,
+		profileHandler,
+		weatherHandler,
+		ttsHandler,
+		deliveryHandler,
+		auditHandler,
+		memberHandler,
+		invitationHandler,
+		migrationHandler,
+		setupHandler,
+		mailTemplateHandler,
+		disposableEmailHandler,
+		accessTokenHandler,
+		webdavHandler,
+		taskQueueHandler,
+		urlMigrationHandler,
+		socialCardHandler,
+		imagePaletteHandler,
+		articleCollectionHandler,
+		contributionHandler,
+		readingHandler,
+		changelogHandler,
 	)
 
 	// --- Phase 8: 配置 Gin 引擎 ---
@@ -663,7 +945,21 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	}
 
 	engine := gin.Default()
-	err = engine.SetTrustedProxies(util.TrustedProxyCIDRs)
+	// 请求 ID：写入响应头与请求上下文，同一请求的日志通过 request_id 关联
+	engine.Use(middleware.RequestID())
+	trustedProxies, err := util.ParseTrustedProxies(cfg.GetString(config.KeyTrustedProxies))
+	if err != nil {
+		return nil, nil, fmt.Errorf("解析可信代理配置失败: %w", err)
+	}
+	if err := util.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, nil, fmt.Errorf("设置信任代理失败: %w", err)
+	}
+	if cfg.GetString(config.KeyTrustedProxies) == "" {
+		log.Println("提示: 未配置可信代理(System.TrustedProxies)，默认仅信任本机与私有网段的 X-Forwarded-For。若部署在 CDN 或公网反向代理之后，请配置其地址以获取真实客户端IP。")
+	} else {
+		log.Printf("可信代理: %v", trustedProxies)
+	}
+	err = engine.SetTrustedProxies(trustedProxies)
 	if err != nil {
 		return nil, nil, fmt.Errorf("设置信任代理失败: %w", err)
 	}
@@ -675,8 +971,31 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 	} else {
 		log.Println("⚠️  警告: 站点URL(SiteURL)未配置，跨域请求将被拒绝。请在后台设置中配置站点URL。")
 	}
+	middleware.SetWidgetCORSOriginsProvider(func() string {
+		return settingSvc.Get(constant.KeyWidgetCORSAllowedOrigins.String())
+	})
+	middleware.SetPublicCORSPolicyProvider(func() middleware.PublicCORSPolicy {
+		return middleware.PublicCORSPolicy{
+			AllowedOrigins:   settingSvc.Get(constant.KeyPublicAPICORSAllowedOrigins.String()),
+			AllowedMethods:   settingSvc.Get(constant.KeyPublicAPICORSAllowedMethods.String()),
+			AllowedHeaders:   settingSvc.Get(constant.KeyPublicAPICORSAllowedHeaders.String()),
+			AllowCredentials: settingSvc.GetBool(constant.KeyPublicAPICORSAllowCredentials.String()),
+		}
+	})
 	engine.Use(middleware.Cors())
 
+	// 管理接口 IP 白名单：在 JWT 与管理员权限之外限制管理接口的来源 IP
+	middleware.SetAdminIPAllowlistProvider(func() string {
+		return settingSvc.Get(constant.KeyAdminIPAllowlist.String())
+	})
+	engine.Use(middleware.AdminIPAllowlist())
+
+	// 站点只读模式：迁移或恢复数据库期间拒绝写操作
+	middleware.SetReadOnlyProvider(func() (bool, string) {
+		return settingSvc.GetBool(constant.KeyReadOnlyModeEnable.String()), settingSvc.Get(constant.KeyReadOnlyModeMessage.String())
+	})
+	engine.Use(middleware.ReadOnly())
+
 	// 设置 SSR 主题检查器（基于数据库状态判断是否应该代理）
 	// 这样即使 SSR 进程还在运行，切换到普通主题后也不会代理
 	middleware.SetSSRThemeChecker(func() (string, bool) {
@@ -744,30 +1063,30 @@ func NewAppWithOptions(content embed.FS, opts AppOptions) (*App, func(), error)
 
 	// 将所有初始化好的组件装配到 App 实例中
 	app := &App{
-		cfg:                  cfg,
-		engine:               engine,
-		taskBroker:           taskBroker,
-		sqlDB:                sqlDB,
-		appVersion:           appVersion,
-		articleService:       articleSvc,
-		directLinkService:    directLinkSvc,
-		storagePolicyRepo:    storagePolicyRepo,
-		storagePolicyService: storagePolicySvc,
-		fileService:          fileSvc,
-		mw:                   mw,
-		settingRepo:          settingRepo,
-		settingSvc:           settingSvc,
-		tokenSvc:             tokenSvc,
-		userSvc:              userSvc,
-		fileRepo:             fileRepo,
-		entityRepo:           entityRepo,
-		cacheSvc:             cacheSvc,
-		eventBus:             eventBus,
-		postCategorySvc:      postCategorySvc,
-		postTagSvc:           postTagSvc,
-		commentSvc:           commentSvc,
-		themeSvc:             themeSvc,
-		themeHandler:         themeHandler,
+		cfg:                   cfg,
+		engine:                engine,
+		taskBroker:            taskBroker,
+		sqlDB:                 sqlDB,
+		appVersion:            appVersion,
+		articleService:        articleSvc,
+		directLinkService:     directLinkSvc,
+		storagePolicyRepo:     storagePolicyRepo,
+		storagePolicyService:  storagePolicySvc,
+		fileService:           fileSvc,
+		mw:                    mw,
+		settingRepo:           settingRepo,
+		settingSvc:            settingSvc,
+		tokenSvc:              tokenSvc,
+		userSvc:               userSvc,
+		fileRepo:              fileRepo,
+		entityRepo:            entityRepo,
+		cacheSvc:              cacheSvc,
+		eventBus:              eventBus,
+		postCategorySvc:       postCategorySvc,
+		postTagSvc:            postTagSvc,
+		commentSvc:            commentSvc,
+		themeSvc:              themeSvc,
+		themeHandler:          themeHandler,
 		ssrManager:            ssrManager,
 		ssrThemeHandler:       ssrThemeHandler,
 		imageStyleService:     imageStyleSvc,
@@ -988,6 +1307,8 @@ func (a *App) Run() error {
 	a.taskBroker.RegisterCronJobs()
 	a.taskBroker.CheckAndRunMissedAggregation()
 	a.taskBroker.Start()
+	// 部署启动后预热热点页面，避免首位访客承担冷渲染开销
+	a.taskBroker.DispatchCacheWarmup()
 	port := a.cfg.GetString(config.KeyServerPort)
 	if port == "" {
 		port = "8091"
@@ -1061,6 +1382,16 @@ func getOrCreateIDSeed(ctx context.Context, settingRepo repository.SettingReposi
 	return newSeed, nil
 }
 
+// applyLogLevel 应用后台设置的日志级别，无法识别时保持当前级别
+func applyLogLevel(value string) {
+	if value == "" {
+		return
+	}
+	if !logger.SetLevel(value) {
+		slog.Warn("无法识别的日志级别，保持当前级别", slog.String("value", value), slog.String("current", logger.Level().String()))
+	}
+}
+
 // setupWechatShareRoutes 设置微信分享相关路由
 func setupWechatShareRoutes(engine *gin.Engine, settingSvc setting.SettingService) {
 	// 获取微信分享配置
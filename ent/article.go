@@ -73,9 +73,11 @@ type Article struct {
 	CopyrightURL string `json:"copyright_url,omitempty"`
 	// 文章关键词，用于SEO优化
 	Keywords string `json:"keywords,omitempty"`
+	// 链接文章指向的外部URL，非空时该文章为链接文章（正文为点评）
+	LinkURL string `json:"link_url,omitempty"`
 	// 定时发布时间，当status为SCHEDULED时有效
 	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
-	// 审核状态：NONE-无需审核, PENDING-待审核, APPROVED-已通过, REJECTED-已拒绝
+	// 审核状态：NONE-无需审核, PENDING-待审核, CHANGES_REQUESTED-需修改, APPROVED-已通过, REJECTED-已拒绝
 	ReviewStatus article.ReviewStatus `json:"review_status,omitempty"`
 	// 审核意见
 	ReviewComment string `json:"review_comment,omitempty"`
@@ -188,7 +190,7 @@ func (*Article) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case article.FieldID, article.FieldOwnerID, article.FieldViewCount, article.FieldWordCount, article.FieldReadingTime, article.FieldHomeSort, article.FieldPinSort, article.FieldReviewedBy, article.FieldTakedownBy, article.FieldDocSeriesID, article.FieldDocSort:
 			values[i] = new(sql.NullInt64)
-		case article.FieldTitle, article.FieldContentMd, article.FieldContentHTML, article.FieldCoverURL, article.FieldStatus, article.FieldIPLocation, article.FieldPrimaryColor, article.FieldTopImgURL, article.FieldAbbrlink, article.FieldCopyrightAuthor, article.FieldCopyrightAuthorHref, article.FieldCopyrightURL, article.FieldKeywords, article.FieldReviewStatus, article.FieldReviewComment, article.FieldTakedownReason:
+		case article.FieldTitle, article.FieldContentMd, article.FieldContentHTML, article.FieldCoverURL, article.FieldStatus, article.FieldIPLocation, article.FieldPrimaryColor, article.FieldTopImgURL, article.FieldAbbrlink, article.FieldCopyrightAuthor, article.FieldCopyrightAuthorHref, article.FieldCopyrightURL, article.FieldKeywords, article.FieldLinkURL, article.FieldReviewStatus, article.FieldReviewComment, article.FieldTakedownReason:
 			values[i] = new(sql.NullString)
 		case article.FieldDeletedAt, article.FieldCreatedAt, article.FieldUpdatedAt, article.FieldScheduledAt, article.FieldReviewedAt, article.FieldTakedownAt:
 			values[i] = new(sql.NullTime)
@@ -379,6 +381,12 @@ func (_m *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Keywords = value.String
 			}
+		case article.FieldLinkURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link_url", values[i])
+			} else if value.Valid {
+				_m.LinkURL = value.String
+			}
 		case article.FieldScheduledAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
@@ -635,6 +643,9 @@ func (_m *Article) String() string {
 	builder.WriteString("keywords=")
 	builder.WriteString(_m.Keywords)
 	builder.WriteString(", ")
+	builder.WriteString("link_url=")
+	builder.WriteString(_m.LinkURL)
+	builder.WriteString(", ")
 	if v := _m.ScheduledAt; v != nil {
 		builder.WriteString("scheduled_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -70,6 +70,8 @@ const (
 	FieldCopyrightURL = "copyright_url"
 	// FieldKeywords holds the string denoting the keywords field in the database.
 	FieldKeywords = "keywords"
+	// FieldLinkURL holds the string denoting the link_url field in the database.
+	FieldLinkURL = "link_url"
 	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
 	FieldScheduledAt = "scheduled_at"
 	// FieldReviewStatus holds the string denoting the review_status field in the database.
@@ -179,6 +181,7 @@ var Columns = []string{
 	FieldCopyrightAuthorHref,
 	FieldCopyrightURL,
 	FieldKeywords,
+	FieldLinkURL,
 	FieldScheduledAt,
 	FieldReviewStatus,
 	FieldReviewComment,
@@ -316,10 +319,11 @@ const DefaultReviewStatus = ReviewStatusNONE
 
 // ReviewStatus values.
 const (
-	ReviewStatusNONE     ReviewStatus = "NONE"
-	ReviewStatusPENDING  ReviewStatus = "PENDING"
-	ReviewStatusAPPROVED ReviewStatus = "APPROVED"
-	ReviewStatusREJECTED ReviewStatus = "REJECTED"
+	ReviewStatusNONE              ReviewStatus = "NONE"
+	ReviewStatusPENDING           ReviewStatus = "PENDING"
+	ReviewStatusCHANGES_REQUESTED ReviewStatus = "CHANGES_REQUESTED"
+	ReviewStatusAPPROVED          ReviewStatus = "APPROVED"
+	ReviewStatusREJECTED          ReviewStatus = "REJECTED"
 )
 
 func (rs ReviewStatus) String() string {
@@ -329,7 +333,7 @@ func (rs ReviewStatus) String() string {
 // ReviewStatusValidator is a validator for the "review_status" field enum values. It is called by the builders before save.
 func ReviewStatusValidator(rs ReviewStatus) error {
 	switch rs {
-	case ReviewStatusNONE, ReviewStatusPENDING, ReviewStatusAPPROVED, ReviewStatusREJECTED:
+	case ReviewStatusNONE, ReviewStatusPENDING, ReviewStatusCHANGES_REQUESTED, ReviewStatusAPPROVED, ReviewStatusREJECTED:
 		return nil
 	default:
 		return fmt.Errorf("article: invalid enum value for review_status field: %q", rs)
@@ -474,6 +478,11 @@ func ByKeywords(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKeywords, opts...).ToFunc()
 }
 
+// ByLinkURL orders the results by the link_url field.
+func ByLinkURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkURL, opts...).ToFunc()
+}
+
 // ByScheduledAt orders the results by the scheduled_at field.
 func ByScheduledAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScheduledAt, opts...).ToFunc()
@@ -180,6 +180,11 @@ func Keywords(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldKeywords, v))
 }
 
+// LinkURL applies equality check predicate on the "link_url" field. It's identical to LinkURLEQ.
+func LinkURL(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldLinkURL, v))
+}
+
 // ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
 func ScheduledAt(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldScheduledAt, v))
@@ -1585,6 +1590,81 @@ func KeywordsContainsFold(v string) predicate.Article {
 	return predicate.Article(sql.FieldContainsFold(FieldKeywords, v))
 }
 
+// LinkURLEQ applies the EQ predicate on the "link_url" field.
+func LinkURLEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldLinkURL, v))
+}
+
+// LinkURLNEQ applies the NEQ predicate on the "link_url" field.
+func LinkURLNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldLinkURL, v))
+}
+
+// LinkURLIn applies the In predicate on the "link_url" field.
+func LinkURLIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldLinkURL, vs...))
+}
+
+// LinkURLNotIn applies the NotIn predicate on the "link_url" field.
+func LinkURLNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldLinkURL, vs...))
+}
+
+// LinkURLGT applies the GT predicate on the "link_url" field.
+func LinkURLGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldLinkURL, v))
+}
+
+// LinkURLGTE applies the GTE predicate on the "link_url" field.
+func LinkURLGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldLinkURL, v))
+}
+
+// LinkURLLT applies the LT predicate on the "link_url" field.
+func LinkURLLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldLinkURL, v))
+}
+
+// LinkURLLTE applies the LTE predicate on the "link_url" field.
+func LinkURLLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldLinkURL, v))
+}
+
+// LinkURLContains applies the Contains predicate on the "link_url" field.
+func LinkURLContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldLinkURL, v))
+}
+
+// LinkURLHasPrefix applies the HasPrefix predicate on the "link_url" field.
+func LinkURLHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldLinkURL, v))
+}
+
+// LinkURLHasSuffix applies the HasSuffix predicate on the "link_url" field.
+func LinkURLHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldLinkURL, v))
+}
+
+// LinkURLIsNil applies the IsNil predicate on the "link_url" field.
+func LinkURLIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldLinkURL))
+}
+
+// LinkURLNotNil applies the NotNil predicate on the "link_url" field.
+func LinkURLNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldLinkURL))
+}
+
+// LinkURLEqualFold applies the EqualFold predicate on the "link_url" field.
+func LinkURLEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldLinkURL, v))
+}
+
+// LinkURLContainsFold applies the ContainsFold predicate on the "link_url" field.
+func LinkURLContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldLinkURL, v))
+}
+
 // ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
 func ScheduledAtEQ(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldScheduledAt, v))
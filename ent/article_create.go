@@ -389,6 +389,20 @@ func (_c *ArticleCreate) SetNillableKeywords(v *string) *ArticleCreate {
 	return _c
 }
 
+// SetLinkURL sets the "link_url" field.
+func (_c *ArticleCreate) SetLinkURL(v string) *ArticleCreate {
+	_c.mutation.SetLinkURL(v)
+	return _c
+}
+
+// SetNillableLinkURL sets the "link_url" field if the given value is not nil.
+func (_c *ArticleCreate) SetNillableLinkURL(v *string) *ArticleCreate {
+	if v != nil {
+		_c.SetLinkURL(*v)
+	}
+	return _c
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (_c *ArticleCreate) SetScheduledAt(v time.Time) *ArticleCreate {
 	_c.mutation.SetScheduledAt(v)
@@ -1078,6 +1092,10 @@ func (_c *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldKeywords, field.TypeString, value)
 		_node.Keywords = value
 	}
+	if value, ok := _c.mutation.LinkURL(); ok {
+		_spec.SetField(article.FieldLinkURL, field.TypeString, value)
+		_node.LinkURL = value
+	}
 	if value, ok := _c.mutation.ScheduledAt(); ok {
 		_spec.SetField(article.FieldScheduledAt, field.TypeTime, value)
 		_node.ScheduledAt = &value
@@ -1713,6 +1731,24 @@ func (u *ArticleUpsert) ClearKeywords() *ArticleUpsert {
 	return u
 }
 
+// SetLinkURL sets the "link_url" field.
+func (u *ArticleUpsert) SetLinkURL(v string) *ArticleUpsert {
+	u.Set(article.FieldLinkURL, v)
+	return u
+}
+
+// UpdateLinkURL sets the "link_url" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateLinkURL() *ArticleUpsert {
+	u.SetExcluded(article.FieldLinkURL)
+	return u
+}
+
+// ClearLinkURL clears the value of the "link_url" field.
+func (u *ArticleUpsert) ClearLinkURL() *ArticleUpsert {
+	u.SetNull(article.FieldLinkURL)
+	return u
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (u *ArticleUpsert) SetScheduledAt(v time.Time) *ArticleUpsert {
 	u.Set(article.FieldScheduledAt, v)
@@ -2548,6 +2584,27 @@ func (u *ArticleUpsertOne) ClearKeywords() *ArticleUpsertOne {
 	})
 }
 
+// SetLinkURL sets the "link_url" field.
+func (u *ArticleUpsertOne) SetLinkURL(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetLinkURL(v)
+	})
+}
+
+// UpdateLinkURL sets the "link_url" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateLinkURL() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateLinkURL()
+	})
+}
+
+// ClearLinkURL clears the value of the "link_url" field.
+func (u *ArticleUpsertOne) ClearLinkURL() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearLinkURL()
+	})
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (u *ArticleUpsertOne) SetScheduledAt(v time.Time) *ArticleUpsertOne {
 	return u.Update(func(s *ArticleUpsert) {
@@ -3595,6 +3652,27 @@ func (u *ArticleUpsertBulk) ClearKeywords() *ArticleUpsertBulk {
 	})
 }
 
+// SetLinkURL sets the "link_url" field.
+func (u *ArticleUpsertBulk) SetLinkURL(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetLinkURL(v)
+	})
+}
+
+// UpdateLinkURL sets the "link_url" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateLinkURL() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateLinkURL()
+	})
+}
+
+// ClearLinkURL clears the value of the "link_url" field.
+func (u *ArticleUpsertBulk) ClearLinkURL() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearLinkURL()
+	})
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (u *ArticleUpsertBulk) SetScheduledAt(v time.Time) *ArticleUpsertBulk {
 	return u.Update(func(s *ArticleUpsert) {
@@ -531,6 +531,26 @@ func (_u *ArticleUpdate) ClearKeywords() *ArticleUpdate {
 	return _u
 }
 
+// SetLinkURL sets the "link_url" field.
+func (_u *ArticleUpdate) SetLinkURL(v string) *ArticleUpdate {
+	_u.mutation.SetLinkURL(v)
+	return _u
+}
+
+// SetNillableLinkURL sets the "link_url" field if the given value is not nil.
+func (_u *ArticleUpdate) SetNillableLinkURL(v *string) *ArticleUpdate {
+	if v != nil {
+		_u.SetLinkURL(*v)
+	}
+	return _u
+}
+
+// ClearLinkURL clears the value of the "link_url" field.
+func (_u *ArticleUpdate) ClearLinkURL() *ArticleUpdate {
+	_u.mutation.ClearLinkURL()
+	return _u
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (_u *ArticleUpdate) SetScheduledAt(v time.Time) *ArticleUpdate {
 	_u.mutation.SetScheduledAt(v)
@@ -1234,6 +1254,12 @@ func (_u *ArticleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.KeywordsCleared() {
 		_spec.ClearField(article.FieldKeywords, field.TypeString)
 	}
+	if value, ok := _u.mutation.LinkURL(); ok {
+		_spec.SetField(article.FieldLinkURL, field.TypeString, value)
+	}
+	if _u.mutation.LinkURLCleared() {
+		_spec.ClearField(article.FieldLinkURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.ScheduledAt(); ok {
 		_spec.SetField(article.FieldScheduledAt, field.TypeTime, value)
 	}
@@ -2042,6 +2068,26 @@ func (_u *ArticleUpdateOne) ClearKeywords() *ArticleUpdateOne {
 	return _u
 }
 
+// SetLinkURL sets the "link_url" field.
+func (_u *ArticleUpdateOne) SetLinkURL(v string) *ArticleUpdateOne {
+	_u.mutation.SetLinkURL(v)
+	return _u
+}
+
+// SetNillableLinkURL sets the "link_url" field if the given value is not nil.
+func (_u *ArticleUpdateOne) SetNillableLinkURL(v *string) *ArticleUpdateOne {
+	if v != nil {
+		_u.SetLinkURL(*v)
+	}
+	return _u
+}
+
+// ClearLinkURL clears the value of the "link_url" field.
+func (_u *ArticleUpdateOne) ClearLinkURL() *ArticleUpdateOne {
+	_u.mutation.ClearLinkURL()
+	return _u
+}
+
 // SetScheduledAt sets the "scheduled_at" field.
 func (_u *ArticleUpdateOne) SetScheduledAt(v time.Time) *ArticleUpdateOne {
 	_u.mutation.SetScheduledAt(v)
@@ -2775,6 +2821,12 @@ func (_u *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err er
 	if _u.mutation.KeywordsCleared() {
 		_spec.ClearField(article.FieldKeywords, field.TypeString)
 	}
+	if value, ok := _u.mutation.LinkURL(); ok {
+		_spec.SetField(article.FieldLinkURL, field.TypeString, value)
+	}
+	if _u.mutation.LinkURLCleared() {
+		_spec.ClearField(article.FieldLinkURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.ScheduledAt(); ok {
 		_spec.SetField(article.FieldScheduledAt, field.TypeTime, value)
 	}
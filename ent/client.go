@@ -15,31 +15,57 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
 	"github.com/anzhiyu-c/anheyu-app/ent/album"
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/articleaudio"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlecollection"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereaction"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereviewnote"
+	"github.com/anzhiyu-c/anheyu-app/ent/articletemplate"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/changelogentry"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentertrust"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentreaction"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentsubscription"
+	"github.com/anzhiyu-c/anheyu-app/ent/contentsnippet"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
+	"github.com/anzhiyu-c/anheyu-app/ent/disposableemailstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkcheckrecord"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/mailtemplateversion"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
+	"github.com/anzhiyu-c/anheyu-app/ent/moment"
+	"github.com/anzhiyu-c/anheyu-app/ent/musicplaystat"
+	"github.com/anzhiyu-c/anheyu-app/ent/notificationdelivery"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/readingprogress"
+	"github.com/anzhiyu-c/anheyu-app/ent/recycleitem"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/spamtoken"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
+	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicymount"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/ent/tag"
+	"github.com/anzhiyu-c/anheyu-app/ent/uploadsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/urlstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/ent/userbookmark"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
@@ -51,18 +77,44 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// AlbumCategory is the client for interacting with the AlbumCategory builders.
 	AlbumCategory *AlbumCategoryClient
 	// Article is the client for interacting with the Article builders.
 	Article *ArticleClient
+	// ArticleAudio is the client for interacting with the ArticleAudio builders.
+	ArticleAudio *ArticleAudioClient
+	// ArticleCollection is the client for interacting with the ArticleCollection builders.
+	ArticleCollection *ArticleCollectionClient
 	// ArticleHistory is the client for interacting with the ArticleHistory builders.
 	ArticleHistory *ArticleHistoryClient
+	// ArticleReaction is the client for interacting with the ArticleReaction builders.
+	ArticleReaction *ArticleReactionClient
+	// ArticleReviewNote is the client for interacting with the ArticleReviewNote builders.
+	ArticleReviewNote *ArticleReviewNoteClient
+	// ArticleTemplate is the client for interacting with the ArticleTemplate builders.
+	ArticleTemplate *ArticleTemplateClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// ChangelogEntry is the client for interacting with the ChangelogEntry builders.
+	ChangelogEntry *ChangelogEntryClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentReaction is the client for interacting with the CommentReaction builders.
+	CommentReaction *CommentReactionClient
+	// CommentSubscription is the client for interacting with the CommentSubscription builders.
+	CommentSubscription *CommentSubscriptionClient
+	// CommenterTrust is the client for interacting with the CommenterTrust builders.
+	CommenterTrust *CommenterTrustClient
+	// ContentSnippet is the client for interacting with the ContentSnippet builders.
+	ContentSnippet *ContentSnippetClient
 	// DirectLink is the client for interacting with the DirectLink builders.
 	DirectLink *DirectLinkClient
+	// DisposableEmailStat is the client for interacting with the DisposableEmailStat builders.
+	DisposableEmailStat *DisposableEmailStatClient
 	// DocSeries is the client for interacting with the DocSeries builders.
 	DocSeries *DocSeriesClient
 	// Entity is the client for interacting with the Entity builders.
@@ -71,14 +123,26 @@ type Client struct {
 	File *FileClient
 	// FileEntity is the client for interacting with the FileEntity builders.
 	FileEntity *FileEntityClient
+	// InvitationCode is the client for interacting with the InvitationCode builders.
+	InvitationCode *InvitationCodeClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
 	LinkCategory *LinkCategoryClient
+	// LinkCheckRecord is the client for interacting with the LinkCheckRecord builders.
+	LinkCheckRecord *LinkCheckRecordClient
 	// LinkTag is the client for interacting with the LinkTag builders.
 	LinkTag *LinkTagClient
+	// MailTemplateVersion is the client for interacting with the MailTemplateVersion builders.
+	MailTemplateVersion *MailTemplateVersionClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
+	// Moment is the client for interacting with the Moment builders.
+	Moment *MomentClient
+	// MusicPlayStat is the client for interacting with the MusicPlayStat builders.
+	MusicPlayStat *MusicPlayStatClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
 	// NotificationType is the client for interacting with the NotificationType builders.
 	NotificationType *NotificationTypeClient
 	// Page is the client for interacting with the Page builders.
@@ -87,20 +151,34 @@ type Client struct {
 	PostCategory *PostCategoryClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
+	// ReadingProgress is the client for interacting with the ReadingProgress builders.
+	ReadingProgress *ReadingProgressClient
+	// RecycleItem is the client for interacting with the RecycleItem builders.
+	RecycleItem *RecycleItemClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// SpamToken is the client for interacting with the SpamToken builders.
+	SpamToken *SpamTokenClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
 	StoragePolicy *StoragePolicyClient
+	// StoragePolicyMount is the client for interacting with the StoragePolicyMount builders.
+	StoragePolicyMount *StoragePolicyMountClient
 	// Subscriber is the client for interacting with the Subscriber builders.
 	Subscriber *SubscriberClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// URLStat is the client for interacting with the URLStat builders.
 	URLStat *URLStatClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBookmark is the client for interacting with the UserBookmark builders.
+	UserBookmark *UserBookmarkClient
 	// UserGroup is the client for interacting with the UserGroup builders.
 	UserGroup *UserGroupClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserInstalledTheme is the client for interacting with the UserInstalledTheme builders.
 	UserInstalledTheme *UserInstalledThemeClient
 	// UserNotificationConfig is the client for interacting with the UserNotificationConfig builders.
@@ -120,31 +198,57 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessToken = NewAccessTokenClient(c.config)
 	c.Album = NewAlbumClient(c.config)
 	c.AlbumCategory = NewAlbumCategoryClient(c.config)
 	c.Article = NewArticleClient(c.config)
+	c.ArticleAudio = NewArticleAudioClient(c.config)
+	c.ArticleCollection = NewArticleCollectionClient(c.config)
 	c.ArticleHistory = NewArticleHistoryClient(c.config)
+	c.ArticleReaction = NewArticleReactionClient(c.config)
+	c.ArticleReviewNote = NewArticleReviewNoteClient(c.config)
+	c.ArticleTemplate = NewArticleTemplateClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.ChangelogEntry = NewChangelogEntryClient(c.config)
 	c.Comment = NewCommentClient(c.config)
+	c.CommentReaction = NewCommentReactionClient(c.config)
+	c.CommentSubscription = NewCommentSubscriptionClient(c.config)
+	c.CommenterTrust = NewCommenterTrustClient(c.config)
+	c.ContentSnippet = NewContentSnippetClient(c.config)
 	c.DirectLink = NewDirectLinkClient(c.config)
+	c.DisposableEmailStat = NewDisposableEmailStatClient(c.config)
 	c.DocSeries = NewDocSeriesClient(c.config)
 	c.Entity = NewEntityClient(c.config)
 	c.File = NewFileClient(c.config)
 	c.FileEntity = NewFileEntityClient(c.config)
+	c.InvitationCode = NewInvitationCodeClient(c.config)
 	c.Link = NewLinkClient(c.config)
 	c.LinkCategory = NewLinkCategoryClient(c.config)
+	c.LinkCheckRecord = NewLinkCheckRecordClient(c.config)
 	c.LinkTag = NewLinkTagClient(c.config)
+	c.MailTemplateVersion = NewMailTemplateVersionClient(c.config)
 	c.Metadata = NewMetadataClient(c.config)
+	c.Moment = NewMomentClient(c.config)
+	c.MusicPlayStat = NewMusicPlayStatClient(c.config)
+	c.NotificationDelivery = NewNotificationDeliveryClient(c.config)
 	c.NotificationType = NewNotificationTypeClient(c.config)
 	c.Page = NewPageClient(c.config)
 	c.PostCategory = NewPostCategoryClient(c.config)
 	c.PostTag = NewPostTagClient(c.config)
+	c.ReadingProgress = NewReadingProgressClient(c.config)
+	c.RecycleItem = NewRecycleItemClient(c.config)
 	c.Setting = NewSettingClient(c.config)
+	c.SpamToken = NewSpamTokenClient(c.config)
 	c.StoragePolicy = NewStoragePolicyClient(c.config)
+	c.StoragePolicyMount = NewStoragePolicyMountClient(c.config)
 	c.Subscriber = NewSubscriberClient(c.config)
 	c.Tag = NewTagClient(c.config)
 	c.URLStat = NewURLStatClient(c.config)
+	c.UploadSession = NewUploadSessionClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserBookmark = NewUserBookmarkClient(c.config)
 	c.UserGroup = NewUserGroupClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 	c.UserInstalledTheme = NewUserInstalledThemeClient(c.config)
 	c.UserNotificationConfig = NewUserNotificationConfigClient(c.config)
 	c.VisitorLog = NewVisitorLogClient(c.config)
@@ -241,31 +345,57 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		AccessToken:            NewAccessTokenClient(cfg),
 		Album:                  NewAlbumClient(cfg),
 		AlbumCategory:          NewAlbumCategoryClient(cfg),
 		Article:                NewArticleClient(cfg),
+		ArticleAudio:           NewArticleAudioClient(cfg),
+		ArticleCollection:      NewArticleCollectionClient(cfg),
 		ArticleHistory:         NewArticleHistoryClient(cfg),
+		ArticleReaction:        NewArticleReactionClient(cfg),
+		ArticleReviewNote:      NewArticleReviewNoteClient(cfg),
+		ArticleTemplate:        NewArticleTemplateClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		ChangelogEntry:         NewChangelogEntryClient(cfg),
 		Comment:                NewCommentClient(cfg),
+		CommentReaction:        NewCommentReactionClient(cfg),
+		CommentSubscription:    NewCommentSubscriptionClient(cfg),
+		CommenterTrust:         NewCommenterTrustClient(cfg),
+		ContentSnippet:         NewContentSnippetClient(cfg),
 		DirectLink:             NewDirectLinkClient(cfg),
+		DisposableEmailStat:    NewDisposableEmailStatClient(cfg),
 		DocSeries:              NewDocSeriesClient(cfg),
 		Entity:                 NewEntityClient(cfg),
 		File:                   NewFileClient(cfg),
 		FileEntity:             NewFileEntityClient(cfg),
+		InvitationCode:         NewInvitationCodeClient(cfg),
 		Link:                   NewLinkClient(cfg),
 		LinkCategory:           NewLinkCategoryClient(cfg),
+		LinkCheckRecord:        NewLinkCheckRecordClient(cfg),
 		LinkTag:                NewLinkTagClient(cfg),
+		MailTemplateVersion:    NewMailTemplateVersionClient(cfg),
 		Metadata:               NewMetadataClient(cfg),
+		Moment:                 NewMomentClient(cfg),
+		MusicPlayStat:          NewMusicPlayStatClient(cfg),
+		NotificationDelivery:   NewNotificationDeliveryClient(cfg),
 		NotificationType:       NewNotificationTypeClient(cfg),
 		Page:                   NewPageClient(cfg),
 		PostCategory:           NewPostCategoryClient(cfg),
 		PostTag:                NewPostTagClient(cfg),
+		ReadingProgress:        NewReadingProgressClient(cfg),
+		RecycleItem:            NewRecycleItemClient(cfg),
 		Setting:                NewSettingClient(cfg),
+		SpamToken:              NewSpamTokenClient(cfg),
 		StoragePolicy:          NewStoragePolicyClient(cfg),
+		StoragePolicyMount:     NewStoragePolicyMountClient(cfg),
 		Subscriber:             NewSubscriberClient(cfg),
 		Tag:                    NewTagClient(cfg),
 		URLStat:                NewURLStatClient(cfg),
+		UploadSession:          NewUploadSessionClient(cfg),
 		User:                   NewUserClient(cfg),
+		UserBookmark:           NewUserBookmarkClient(cfg),
 		UserGroup:              NewUserGroupClient(cfg),
+		UserIdentity:           NewUserIdentityClient(cfg),
 		UserInstalledTheme:     NewUserInstalledThemeClient(cfg),
 		UserNotificationConfig: NewUserNotificationConfigClient(cfg),
 		VisitorLog:             NewVisitorLogClient(cfg),
@@ -289,31 +419,57 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		AccessToken:            NewAccessTokenClient(cfg),
 		Album:                  NewAlbumClient(cfg),
 		AlbumCategory:          NewAlbumCategoryClient(cfg),
 		Article:                NewArticleClient(cfg),
+		ArticleAudio:           NewArticleAudioClient(cfg),
+		ArticleCollection:      NewArticleCollectionClient(cfg),
 		ArticleHistory:         NewArticleHistoryClient(cfg),
+		ArticleReaction:        NewArticleReactionClient(cfg),
+		ArticleReviewNote:      NewArticleReviewNoteClient(cfg),
+		ArticleTemplate:        NewArticleTemplateClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		ChangelogEntry:         NewChangelogEntryClient(cfg),
 		Comment:                NewCommentClient(cfg),
+		CommentReaction:        NewCommentReactionClient(cfg),
+		CommentSubscription:    NewCommentSubscriptionClient(cfg),
+		CommenterTrust:         NewCommenterTrustClient(cfg),
+		ContentSnippet:         NewContentSnippetClient(cfg),
 		DirectLink:             NewDirectLinkClient(cfg),
+		DisposableEmailStat:    NewDisposableEmailStatClient(cfg),
 		DocSeries:              NewDocSeriesClient(cfg),
 		Entity:                 NewEntityClient(cfg),
 		File:                   NewFileClient(cfg),
 		FileEntity:             NewFileEntityClient(cfg),
+		InvitationCode:         NewInvitationCodeClient(cfg),
 		Link:                   NewLinkClient(cfg),
 		LinkCategory:           NewLinkCategoryClient(cfg),
+		LinkCheckRecord:        NewLinkCheckRecordClient(cfg),
 		LinkTag:                NewLinkTagClient(cfg),
+		MailTemplateVersion:    NewMailTemplateVersionClient(cfg),
 		Metadata:               NewMetadataClient(cfg),
+		Moment:                 NewMomentClient(cfg),
+		MusicPlayStat:          NewMusicPlayStatClient(cfg),
+		NotificationDelivery:   NewNotificationDeliveryClient(cfg),
 		NotificationType:       NewNotificationTypeClient(cfg),
 		Page:                   NewPageClient(cfg),
 		PostCategory:           NewPostCategoryClient(cfg),
 		PostTag:                NewPostTagClient(cfg),
+		ReadingProgress:        NewReadingProgressClient(cfg),
+		RecycleItem:            NewRecycleItemClient(cfg),
 		Setting:                NewSettingClient(cfg),
+		SpamToken:              NewSpamTokenClient(cfg),
 		StoragePolicy:          NewStoragePolicyClient(cfg),
+		StoragePolicyMount:     NewStoragePolicyMountClient(cfg),
 		Subscriber:             NewSubscriberClient(cfg),
 		Tag:                    NewTagClient(cfg),
 		URLStat:                NewURLStatClient(cfg),
+		UploadSession:          NewUploadSessionClient(cfg),
 		User:                   NewUserClient(cfg),
+		UserBookmark:           NewUserBookmarkClient(cfg),
 		UserGroup:              NewUserGroupClient(cfg),
+		UserIdentity:           NewUserIdentityClient(cfg),
 		UserInstalledTheme:     NewUserInstalledThemeClient(cfg),
 		UserNotificationConfig: NewUserNotificationConfigClient(cfg),
 		VisitorLog:             NewVisitorLogClient(cfg),
@@ -324,7 +480,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Album.
+//		AccessToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -347,11 +503,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.Comment, c.DirectLink,
-		c.DocSeries, c.Entity, c.File, c.FileEntity, c.Link, c.LinkCategory, c.LinkTag,
-		c.Metadata, c.NotificationType, c.Page, c.PostCategory, c.PostTag, c.Setting,
-		c.StoragePolicy, c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup,
-		c.UserInstalledTheme, c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
+		c.AccessToken, c.Album, c.AlbumCategory, c.Article, c.ArticleAudio,
+		c.ArticleCollection, c.ArticleHistory, c.ArticleReaction, c.ArticleReviewNote,
+		c.ArticleTemplate, c.AuditLog, c.ChangelogEntry, c.Comment, c.CommentReaction,
+		c.CommentSubscription, c.CommenterTrust, c.ContentSnippet, c.DirectLink,
+		c.DisposableEmailStat, c.DocSeries, c.Entity, c.File, c.FileEntity,
+		c.InvitationCode, c.Link, c.LinkCategory, c.LinkCheckRecord, c.LinkTag,
+		c.MailTemplateVersion, c.Metadata, c.Moment, c.MusicPlayStat,
+		c.NotificationDelivery, c.NotificationType, c.Page, c.PostCategory, c.PostTag,
+		c.ReadingProgress, c.RecycleItem, c.Setting, c.SpamToken, c.StoragePolicy,
+		c.StoragePolicyMount, c.Subscriber, c.Tag, c.URLStat, c.UploadSession, c.User,
+		c.UserBookmark, c.UserGroup, c.UserIdentity, c.UserInstalledTheme,
+		c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
 	} {
 		n.Use(hooks...)
 	}
@@ -361,11 +524,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.Comment, c.DirectLink,
-		c.DocSeries, c.Entity, c.File, c.FileEntity, c.Link, c.LinkCategory, c.LinkTag,
-		c.Metadata, c.NotificationType, c.Page, c.PostCategory, c.PostTag, c.Setting,
-		c.StoragePolicy, c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup,
-		c.UserInstalledTheme, c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
+		c.AccessToken, c.Album, c.AlbumCategory, c.Article, c.ArticleAudio,
+		c.ArticleCollection, c.ArticleHistory, c.ArticleReaction, c.ArticleReviewNote,
+		c.ArticleTemplate, c.AuditLog, c.ChangelogEntry, c.Comment, c.CommentReaction,
+		c.CommentSubscription, c.CommenterTrust, c.ContentSnippet, c.DirectLink,
+		c.DisposableEmailStat, c.DocSeries, c.Entity, c.File, c.FileEntity,
+		c.InvitationCode, c.Link, c.LinkCategory, c.LinkCheckRecord, c.LinkTag,
+		c.MailTemplateVersion, c.Metadata, c.Moment, c.MusicPlayStat,
+		c.NotificationDelivery, c.NotificationType, c.Page, c.PostCategory, c.PostTag,
+		c.ReadingProgress, c.RecycleItem, c.Setting, c.SpamToken, c.StoragePolicy,
+		c.StoragePolicyMount, c.Subscriber, c.Tag, c.URLStat, c.UploadSession, c.User,
+		c.UserBookmark, c.UserGroup, c.UserIdentity, c.UserInstalledTheme,
+		c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -374,18 +544,44 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessTokenMutation:
+		return c.AccessToken.mutate(ctx, m)
 	case *AlbumMutation:
 		return c.Album.mutate(ctx, m)
 	case *AlbumCategoryMutation:
 		return c.AlbumCategory.mutate(ctx, m)
 	case *ArticleMutation:
 		return c.Article.mutate(ctx, m)
+	case *ArticleAudioMutation:
+		return c.ArticleAudio.mutate(ctx, m)
+	case *ArticleCollectionMutation:
+		return c.ArticleCollection.mutate(ctx, m)
 	case *ArticleHistoryMutation:
 		return c.ArticleHistory.mutate(ctx, m)
+	case *ArticleReactionMutation:
+		return c.ArticleReaction.mutate(ctx, m)
+	case *ArticleReviewNoteMutation:
+		return c.ArticleReviewNote.mutate(ctx, m)
+	case *ArticleTemplateMutation:
+		return c.ArticleTemplate.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *ChangelogEntryMutation:
+		return c.ChangelogEntry.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
+	case *CommentReactionMutation:
+		return c.CommentReaction.mutate(ctx, m)
+	case *CommentSubscriptionMutation:
+		return c.CommentSubscription.mutate(ctx, m)
+	case *CommenterTrustMutation:
+		return c.CommenterTrust.mutate(ctx, m)
+	case *ContentSnippetMutation:
+		return c.ContentSnippet.mutate(ctx, m)
 	case *DirectLinkMutation:
 		return c.DirectLink.mutate(ctx, m)
+	case *DisposableEmailStatMutation:
+		return c.DisposableEmailStat.mutate(ctx, m)
 	case *DocSeriesMutation:
 		return c.DocSeries.mutate(ctx, m)
 	case *EntityMutation:
@@ -394,14 +590,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.File.mutate(ctx, m)
 	case *FileEntityMutation:
 		return c.FileEntity.mutate(ctx, m)
+	case *InvitationCodeMutation:
+		return c.InvitationCode.mutate(ctx, m)
 	case *LinkMutation:
 		return c.Link.mutate(ctx, m)
 	case *LinkCategoryMutation:
 		return c.LinkCategory.mutate(ctx, m)
+	case *LinkCheckRecordMutation:
+		return c.LinkCheckRecord.mutate(ctx, m)
 	case *LinkTagMutation:
 		return c.LinkTag.mutate(ctx, m)
+	case *MailTemplateVersionMutation:
+		return c.MailTemplateVersion.mutate(ctx, m)
 	case *MetadataMutation:
 		return c.Metadata.mutate(ctx, m)
+	case *MomentMutation:
+		return c.Moment.mutate(ctx, m)
+	case *MusicPlayStatMutation:
+		return c.MusicPlayStat.mutate(ctx, m)
+	case *NotificationDeliveryMutation:
+		return c.NotificationDelivery.mutate(ctx, m)
 	case *NotificationTypeMutation:
 		return c.NotificationType.mutate(ctx, m)
 	case *PageMutation:
@@ -410,20 +618,34 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PostCategory.mutate(ctx, m)
 	case *PostTagMutation:
 		return c.PostTag.mutate(ctx, m)
+	case *ReadingProgressMutation:
+		return c.ReadingProgress.mutate(ctx, m)
+	case *RecycleItemMutation:
+		return c.RecycleItem.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
+	case *SpamTokenMutation:
+		return c.SpamToken.mutate(ctx, m)
 	case *StoragePolicyMutation:
 		return c.StoragePolicy.mutate(ctx, m)
+	case *StoragePolicyMountMutation:
+		return c.StoragePolicyMount.mutate(ctx, m)
 	case *SubscriberMutation:
 		return c.Subscriber.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
 	case *URLStatMutation:
 		return c.URLStat.mutate(ctx, m)
+	case *UploadSessionMutation:
+		return c.UploadSession.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserBookmarkMutation:
+		return c.UserBookmark.mutate(ctx, m)
 	case *UserGroupMutation:
 		return c.UserGroup.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	case *UserInstalledThemeMutation:
 		return c.UserInstalledTheme.mutate(ctx, m)
 	case *UserNotificationConfigMutation:
@@ -437,6 +659,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AccessTokenClient is a client for the AccessToken schema.
+type AccessTokenClient struct {
+	config
+}
+
+// NewAccessTokenClient returns a client for the AccessToken from the given config.
+func NewAccessTokenClient(c config) *AccessTokenClient {
+	return &AccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accesstoken.Hooks(f(g(h())))`.
+func (c *AccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.AccessToken = append(c.hooks.AccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accesstoken.Intercept(f(g(h())))`.
+func (c *AccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessToken = append(c.inters.AccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a AccessToken entity.
+func (c *AccessTokenClient) Create() *AccessTokenCreate {
+	mutation := newAccessTokenMutation(c.config, OpCreate)
+	return &AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessToken entities.
+func (c *AccessTokenClient) CreateBulk(builders ...*AccessTokenCreate) *AccessTokenCreateBulk {
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessTokenClient) MapCreateBulk(slice any, setFunc func(*AccessTokenCreate, int)) *AccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessTokenCreateBulk{err: fmt.Errorf("calling to AccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessToken.
+func (c *AccessTokenClient) Update() *AccessTokenUpdate {
+	mutation := newAccessTokenMutation(c.config, OpUpdate)
+	return &AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessTokenClient) UpdateOne(_m *AccessToken) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessToken(_m))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessTokenClient) UpdateOneID(id uint) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessTokenID(id))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessToken.
+func (c *AccessTokenClient) Delete() *AccessTokenDelete {
+	mutation := newAccessTokenMutation(c.config, OpDelete)
+	return &AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessTokenClient) DeleteOne(_m *AccessToken) *AccessTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessTokenClient) DeleteOneID(id uint) *AccessTokenDeleteOne {
+	builder := c.Delete().Where(accesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessToken.
+func (c *AccessTokenClient) Query() *AccessTokenQuery {
+	return &AccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessToken entity by its id.
+func (c *AccessTokenClient) Get(ctx context.Context, id uint) (*AccessToken, error) {
+	return c.Query().Where(accesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessTokenClient) GetX(ctx context.Context, id uint) *AccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessTokenClient) Hooks() []Hook {
+	return c.hooks.AccessToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.AccessToken
+}
+
+func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessToken mutation op: %q", m.Op())
+	}
+}
+
 // AlbumClient is a client for the Album schema.
 type AlbumClient struct {
 	config
@@ -950,6 +1305,272 @@ func (c *ArticleClient) mutate(ctx context.Context, m *ArticleMutation) (Value,
 	}
 }
 
+// ArticleAudioClient is a client for the ArticleAudio schema.
+type ArticleAudioClient struct {
+	config
+}
+
+// NewArticleAudioClient returns a client for the ArticleAudio from the given config.
+func NewArticleAudioClient(c config) *ArticleAudioClient {
+	return &ArticleAudioClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `articleaudio.Hooks(f(g(h())))`.
+func (c *ArticleAudioClient) Use(hooks ...Hook) {
+	c.hooks.ArticleAudio = append(c.hooks.ArticleAudio, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `articleaudio.Intercept(f(g(h())))`.
+func (c *ArticleAudioClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleAudio = append(c.inters.ArticleAudio, interceptors...)
+}
+
+// Create returns a builder for creating a ArticleAudio entity.
+func (c *ArticleAudioClient) Create() *ArticleAudioCreate {
+	mutation := newArticleAudioMutation(c.config, OpCreate)
+	return &ArticleAudioCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArticleAudio entities.
+func (c *ArticleAudioClient) CreateBulk(builders ...*ArticleAudioCreate) *ArticleAudioCreateBulk {
+	return &ArticleAudioCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArticleAudioClient) MapCreateBulk(slice any, setFunc func(*ArticleAudioCreate, int)) *ArticleAudioCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArticleAudioCreateBulk{err: fmt.Errorf("calling to ArticleAudioClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArticleAudioCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArticleAudioCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArticleAudio.
+func (c *ArticleAudioClient) Update() *ArticleAudioUpdate {
+	mutation := newArticleAudioMutation(c.config, OpUpdate)
+	return &ArticleAudioUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArticleAudioClient) UpdateOne(_m *ArticleAudio) *ArticleAudioUpdateOne {
+	mutation := newArticleAudioMutation(c.config, OpUpdateOne, withArticleAudio(_m))
+	return &ArticleAudioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArticleAudioClient) UpdateOneID(id uint) *ArticleAudioUpdateOne {
+	mutation := newArticleAudioMutation(c.config, OpUpdateOne, withArticleAudioID(id))
+	return &ArticleAudioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArticleAudio.
+func (c *ArticleAudioClient) Delete() *ArticleAudioDelete {
+	mutation := newArticleAudioMutation(c.config, OpDelete)
+	return &ArticleAudioDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArticleAudioClient) DeleteOne(_m *ArticleAudio) *ArticleAudioDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArticleAudioClient) DeleteOneID(id uint) *ArticleAudioDeleteOne {
+	builder := c.Delete().Where(articleaudio.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArticleAudioDeleteOne{builder}
+}
+
+// Query returns a query builder for ArticleAudio.
+func (c *ArticleAudioClient) Query() *ArticleAudioQuery {
+	return &ArticleAudioQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArticleAudio},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArticleAudio entity by its id.
+func (c *ArticleAudioClient) Get(ctx context.Context, id uint) (*ArticleAudio, error) {
+	return c.Query().Where(articleaudio.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArticleAudioClient) GetX(ctx context.Context, id uint) *ArticleAudio {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ArticleAudioClient) Hooks() []Hook {
+	return c.hooks.ArticleAudio
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArticleAudioClient) Interceptors() []Interceptor {
+	return c.inters.ArticleAudio
+}
+
+func (c *ArticleAudioClient) mutate(ctx context.Context, m *ArticleAudioMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArticleAudioCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArticleAudioUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArticleAudioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArticleAudioDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArticleAudio mutation op: %q", m.Op())
+	}
+}
+
+// ArticleCollectionClient is a client for the ArticleCollection schema.
+type ArticleCollectionClient struct {
+	config
+}
+
+// NewArticleCollectionClient returns a client for the ArticleCollection from the given config.
+func NewArticleCollectionClient(c config) *ArticleCollectionClient {
+	return &ArticleCollectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `articlecollection.Hooks(f(g(h())))`.
+func (c *ArticleCollectionClient) Use(hooks ...Hook) {
+	c.hooks.ArticleCollection = append(c.hooks.ArticleCollection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `articlecollection.Intercept(f(g(h())))`.
+func (c *ArticleCollectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleCollection = append(c.inters.ArticleCollection, interceptors...)
+}
+
+// Create returns a builder for creating a ArticleCollection entity.
+func (c *ArticleCollectionClient) Create() *ArticleCollectionCreate {
+	mutation := newArticleCollectionMutation(c.config, OpCreate)
+	return &ArticleCollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArticleCollection entities.
+func (c *ArticleCollectionClient) CreateBulk(builders ...*ArticleCollectionCreate) *ArticleCollectionCreateBulk {
+	return &ArticleCollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArticleCollectionClient) MapCreateBulk(slice any, setFunc func(*ArticleCollectionCreate, int)) *ArticleCollectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArticleCollectionCreateBulk{err: fmt.Errorf("calling to ArticleCollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArticleCollectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArticleCollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArticleCollection.
+func (c *ArticleCollectionClient) Update() *ArticleCollectionUpdate {
+	mutation := newArticleCollectionMutation(c.config, OpUpdate)
+	return &ArticleCollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArticleCollectionClient) UpdateOne(_m *ArticleCollection) *ArticleCollectionUpdateOne {
+	mutation := newArticleCollectionMutation(c.config, OpUpdateOne, withArticleCollection(_m))
+	return &ArticleCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArticleCollectionClient) UpdateOneID(id uint) *ArticleCollectionUpdateOne {
+	mutation := newArticleCollectionMutation(c.config, OpUpdateOne, withArticleCollectionID(id))
+	return &ArticleCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArticleCollection.
+func (c *ArticleCollectionClient) Delete() *ArticleCollectionDelete {
+	mutation := newArticleCollectionMutation(c.config, OpDelete)
+	return &ArticleCollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArticleCollectionClient) DeleteOne(_m *ArticleCollection) *ArticleCollectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArticleCollectionClient) DeleteOneID(id uint) *ArticleCollectionDeleteOne {
+	builder := c.Delete().Where(articlecollection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArticleCollectionDeleteOne{builder}
+}
+
+// Query returns a query builder for ArticleCollection.
+func (c *ArticleCollectionClient) Query() *ArticleCollectionQuery {
+	return &ArticleCollectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArticleCollection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArticleCollection entity by its id.
+func (c *ArticleCollectionClient) Get(ctx context.Context, id uint) (*ArticleCollection, error) {
+	return c.Query().Where(articlecollection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArticleCollectionClient) GetX(ctx context.Context, id uint) *ArticleCollection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ArticleCollectionClient) Hooks() []Hook {
+	return c.hooks.ArticleCollection
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArticleCollectionClient) Interceptors() []Interceptor {
+	return c.inters.ArticleCollection
+}
+
+func (c *ArticleCollectionClient) mutate(ctx context.Context, m *ArticleCollectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArticleCollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArticleCollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArticleCollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArticleCollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArticleCollection mutation op: %q", m.Op())
+	}
+}
+
 // ArticleHistoryClient is a client for the ArticleHistory schema.
 type ArticleHistoryClient struct {
 	config
@@ -1099,107 +1720,107 @@ func (c *ArticleHistoryClient) mutate(ctx context.Context, m *ArticleHistoryMuta
 	}
 }
 
-// CommentClient is a client for the Comment schema.
-type CommentClient struct {
+// ArticleReactionClient is a client for the ArticleReaction schema.
+type ArticleReactionClient struct {
 	config
 }
 
-// NewCommentClient returns a client for the Comment from the given config.
-func NewCommentClient(c config) *CommentClient {
-	return &CommentClient{config: c}
+// NewArticleReactionClient returns a client for the ArticleReaction from the given config.
+func NewArticleReactionClient(c config) *ArticleReactionClient {
+	return &ArticleReactionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
-func (c *CommentClient) Use(hooks ...Hook) {
-	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+// A call to `Use(f, g, h)` equals to `articlereaction.Hooks(f(g(h())))`.
+func (c *ArticleReactionClient) Use(hooks ...Hook) {
+	c.hooks.ArticleReaction = append(c.hooks.ArticleReaction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
-func (c *CommentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Comment = append(c.inters.Comment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `articlereaction.Intercept(f(g(h())))`.
+func (c *ArticleReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleReaction = append(c.inters.ArticleReaction, interceptors...)
 }
 
-// Create returns a builder for creating a Comment entity.
-func (c *CommentClient) Create() *CommentCreate {
-	mutation := newCommentMutation(c.config, OpCreate)
-	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ArticleReaction entity.
+func (c *ArticleReactionClient) Create() *ArticleReactionCreate {
+	mutation := newArticleReactionMutation(c.config, OpCreate)
+	return &ArticleReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Comment entities.
-func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
-	return &CommentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ArticleReaction entities.
+func (c *ArticleReactionClient) CreateBulk(builders ...*ArticleReactionCreate) *ArticleReactionCreateBulk {
+	return &ArticleReactionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+func (c *ArticleReactionClient) MapCreateBulk(slice any, setFunc func(*ArticleReactionCreate, int)) *ArticleReactionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArticleReactionCreateBulk{err: fmt.Errorf("calling to ArticleReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CommentCreate, rv.Len())
+	builders := make([]*ArticleReactionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CommentCreateBulk{config: c.config, builders: builders}
+	return &ArticleReactionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Comment.
-func (c *CommentClient) Update() *CommentUpdate {
-	mutation := newCommentMutation(c.config, OpUpdate)
-	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ArticleReaction.
+func (c *ArticleReactionClient) Update() *ArticleReactionUpdate {
+	mutation := newArticleReactionMutation(c.config, OpUpdate)
+	return &ArticleReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CommentClient) UpdateOne(_m *Comment) *CommentUpdateOne {
-	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(_m))
-	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleReactionClient) UpdateOne(_m *ArticleReaction) *ArticleReactionUpdateOne {
+	mutation := newArticleReactionMutation(c.config, OpUpdateOne, withArticleReaction(_m))
+	return &ArticleReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CommentClient) UpdateOneID(id uint) *CommentUpdateOne {
-	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
-	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleReactionClient) UpdateOneID(id uint) *ArticleReactionUpdateOne {
+	mutation := newArticleReactionMutation(c.config, OpUpdateOne, withArticleReactionID(id))
+	return &ArticleReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Comment.
-func (c *CommentClient) Delete() *CommentDelete {
-	mutation := newCommentMutation(c.config, OpDelete)
-	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ArticleReaction.
+func (c *ArticleReactionClient) Delete() *ArticleReactionDelete {
+	mutation := newArticleReactionMutation(c.config, OpDelete)
+	return &ArticleReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CommentClient) DeleteOne(_m *Comment) *CommentDeleteOne {
+func (c *ArticleReactionClient) DeleteOne(_m *ArticleReaction) *ArticleReactionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CommentClient) DeleteOneID(id uint) *CommentDeleteOne {
-	builder := c.Delete().Where(comment.ID(id))
+func (c *ArticleReactionClient) DeleteOneID(id uint) *ArticleReactionDeleteOne {
+	builder := c.Delete().Where(articlereaction.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CommentDeleteOne{builder}
+	return &ArticleReactionDeleteOne{builder}
 }
 
-// Query returns a query builder for Comment.
-func (c *CommentClient) Query() *CommentQuery {
-	return &CommentQuery{
+// Query returns a query builder for ArticleReaction.
+func (c *ArticleReactionClient) Query() *ArticleReactionQuery {
+	return &ArticleReactionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeComment},
+		ctx:    &QueryContext{Type: TypeArticleReaction},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Comment entity by its id.
-func (c *CommentClient) Get(ctx context.Context, id uint) (*Comment, error) {
-	return c.Query().Where(comment.ID(id)).Only(ctx)
+// Get returns a ArticleReaction entity by its id.
+func (c *ArticleReactionClient) Get(ctx context.Context, id uint) (*ArticleReaction, error) {
+	return c.Query().Where(articlereaction.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
+func (c *ArticleReactionClient) GetX(ctx context.Context, id uint) *ArticleReaction {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1207,181 +1828,132 @@ func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
 	return obj
 }
 
-// QueryUser queries the user edge of a Comment.
-func (c *CommentClient) QueryUser(_m *Comment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Comment.
-func (c *CommentClient) QueryChildren(_m *Comment) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, comment.ChildrenTable, comment.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Comment.
-func (c *CommentClient) QueryParent(_m *Comment) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.ParentTable, comment.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CommentClient) Hooks() []Hook {
-	hooks := c.hooks.Comment
-	return append(hooks[:len(hooks):len(hooks)], comment.Hooks[:]...)
+func (c *ArticleReactionClient) Hooks() []Hook {
+	return c.hooks.ArticleReaction
 }
 
 // Interceptors returns the client interceptors.
-func (c *CommentClient) Interceptors() []Interceptor {
-	return c.inters.Comment
+func (c *ArticleReactionClient) Interceptors() []Interceptor {
+	return c.inters.ArticleReaction
 }
 
-func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+func (c *ArticleReactionClient) mutate(ctx context.Context, m *ArticleReactionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ArticleReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ArticleReaction mutation op: %q", m.Op())
 	}
 }
 
-// DirectLinkClient is a client for the DirectLink schema.
-type DirectLinkClient struct {
+// ArticleReviewNoteClient is a client for the ArticleReviewNote schema.
+type ArticleReviewNoteClient struct {
 	config
 }
 
-// NewDirectLinkClient returns a client for the DirectLink from the given config.
-func NewDirectLinkClient(c config) *DirectLinkClient {
-	return &DirectLinkClient{config: c}
+// NewArticleReviewNoteClient returns a client for the ArticleReviewNote from the given config.
+func NewArticleReviewNoteClient(c config) *ArticleReviewNoteClient {
+	return &ArticleReviewNoteClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `directlink.Hooks(f(g(h())))`.
-func (c *DirectLinkClient) Use(hooks ...Hook) {
-	c.hooks.DirectLink = append(c.hooks.DirectLink, hooks...)
+// A call to `Use(f, g, h)` equals to `articlereviewnote.Hooks(f(g(h())))`.
+func (c *ArticleReviewNoteClient) Use(hooks ...Hook) {
+	c.hooks.ArticleReviewNote = append(c.hooks.ArticleReviewNote, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `directlink.Intercept(f(g(h())))`.
-func (c *DirectLinkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DirectLink = append(c.inters.DirectLink, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `articlereviewnote.Intercept(f(g(h())))`.
+func (c *ArticleReviewNoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleReviewNote = append(c.inters.ArticleReviewNote, interceptors...)
 }
 
-// Create returns a builder for creating a DirectLink entity.
-func (c *DirectLinkClient) Create() *DirectLinkCreate {
-	mutation := newDirectLinkMutation(c.config, OpCreate)
-	return &DirectLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ArticleReviewNote entity.
+func (c *ArticleReviewNoteClient) Create() *ArticleReviewNoteCreate {
+	mutation := newArticleReviewNoteMutation(c.config, OpCreate)
+	return &ArticleReviewNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DirectLink entities.
-func (c *DirectLinkClient) CreateBulk(builders ...*DirectLinkCreate) *DirectLinkCreateBulk {
-	return &DirectLinkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ArticleReviewNote entities.
+func (c *ArticleReviewNoteClient) CreateBulk(builders ...*ArticleReviewNoteCreate) *ArticleReviewNoteCreateBulk {
+	return &ArticleReviewNoteCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DirectLinkClient) MapCreateBulk(slice any, setFunc func(*DirectLinkCreate, int)) *DirectLinkCreateBulk {
+func (c *ArticleReviewNoteClient) MapCreateBulk(slice any, setFunc func(*ArticleReviewNoteCreate, int)) *ArticleReviewNoteCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DirectLinkCreateBulk{err: fmt.Errorf("calling to DirectLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArticleReviewNoteCreateBulk{err: fmt.Errorf("calling to ArticleReviewNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DirectLinkCreate, rv.Len())
+	builders := make([]*ArticleReviewNoteCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DirectLinkCreateBulk{config: c.config, builders: builders}
+	return &ArticleReviewNoteCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DirectLink.
-func (c *DirectLinkClient) Update() *DirectLinkUpdate {
-	mutation := newDirectLinkMutation(c.config, OpUpdate)
-	return &DirectLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ArticleReviewNote.
+func (c *ArticleReviewNoteClient) Update() *ArticleReviewNoteUpdate {
+	mutation := newArticleReviewNoteMutation(c.config, OpUpdate)
+	return &ArticleReviewNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DirectLinkClient) UpdateOne(_m *DirectLink) *DirectLinkUpdateOne {
-	mutation := newDirectLinkMutation(c.config, OpUpdateOne, withDirectLink(_m))
-	return &DirectLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleReviewNoteClient) UpdateOne(_m *ArticleReviewNote) *ArticleReviewNoteUpdateOne {
+	mutation := newArticleReviewNoteMutation(c.config, OpUpdateOne, withArticleReviewNote(_m))
+	return &ArticleReviewNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DirectLinkClient) UpdateOneID(id uint) *DirectLinkUpdateOne {
-	mutation := newDirectLinkMutation(c.config, OpUpdateOne, withDirectLinkID(id))
-	return &DirectLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleReviewNoteClient) UpdateOneID(id uint) *ArticleReviewNoteUpdateOne {
+	mutation := newArticleReviewNoteMutation(c.config, OpUpdateOne, withArticleReviewNoteID(id))
+	return &ArticleReviewNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DirectLink.
-func (c *DirectLinkClient) Delete() *DirectLinkDelete {
-	mutation := newDirectLinkMutation(c.config, OpDelete)
-	return &DirectLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ArticleReviewNote.
+func (c *ArticleReviewNoteClient) Delete() *ArticleReviewNoteDelete {
+	mutation := newArticleReviewNoteMutation(c.config, OpDelete)
+	return &ArticleReviewNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DirectLinkClient) DeleteOne(_m *DirectLink) *DirectLinkDeleteOne {
+func (c *ArticleReviewNoteClient) DeleteOne(_m *ArticleReviewNote) *ArticleReviewNoteDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DirectLinkClient) DeleteOneID(id uint) *DirectLinkDeleteOne {
-	builder := c.Delete().Where(directlink.ID(id))
+func (c *ArticleReviewNoteClient) DeleteOneID(id uint) *ArticleReviewNoteDeleteOne {
+	builder := c.Delete().Where(articlereviewnote.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DirectLinkDeleteOne{builder}
+	return &ArticleReviewNoteDeleteOne{builder}
 }
 
-// Query returns a query builder for DirectLink.
-func (c *DirectLinkClient) Query() *DirectLinkQuery {
-	return &DirectLinkQuery{
+// Query returns a query builder for ArticleReviewNote.
+func (c *ArticleReviewNoteClient) Query() *ArticleReviewNoteQuery {
+	return &ArticleReviewNoteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDirectLink},
+		ctx:    &QueryContext{Type: TypeArticleReviewNote},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DirectLink entity by its id.
-func (c *DirectLinkClient) Get(ctx context.Context, id uint) (*DirectLink, error) {
-	return c.Query().Where(directlink.ID(id)).Only(ctx)
+// Get returns a ArticleReviewNote entity by its id.
+func (c *ArticleReviewNoteClient) Get(ctx context.Context, id uint) (*ArticleReviewNote, error) {
+	return c.Query().Where(articlereviewnote.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DirectLinkClient) GetX(ctx context.Context, id uint) *DirectLink {
+func (c *ArticleReviewNoteClient) GetX(ctx context.Context, id uint) *ArticleReviewNote {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1389,149 +1961,132 @@ func (c *DirectLinkClient) GetX(ctx context.Context, id uint) *DirectLink {
 	return obj
 }
 
-// QueryFile queries the file edge of a DirectLink.
-func (c *DirectLinkClient) QueryFile(_m *DirectLink) *FileQuery {
-	query := (&FileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(directlink.Table, directlink.FieldID, id),
-			sqlgraph.To(file.Table, file.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, directlink.FileTable, directlink.FileColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DirectLinkClient) Hooks() []Hook {
-	hooks := c.hooks.DirectLink
-	return append(hooks[:len(hooks):len(hooks)], directlink.Hooks[:]...)
+func (c *ArticleReviewNoteClient) Hooks() []Hook {
+	return c.hooks.ArticleReviewNote
 }
 
 // Interceptors returns the client interceptors.
-func (c *DirectLinkClient) Interceptors() []Interceptor {
-	return c.inters.DirectLink
+func (c *ArticleReviewNoteClient) Interceptors() []Interceptor {
+	return c.inters.ArticleReviewNote
 }
 
-func (c *DirectLinkClient) mutate(ctx context.Context, m *DirectLinkMutation) (Value, error) {
+func (c *ArticleReviewNoteClient) mutate(ctx context.Context, m *ArticleReviewNoteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DirectLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleReviewNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DirectLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleReviewNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DirectLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleReviewNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DirectLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ArticleReviewNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DirectLink mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ArticleReviewNote mutation op: %q", m.Op())
 	}
 }
 
-// DocSeriesClient is a client for the DocSeries schema.
-type DocSeriesClient struct {
+// ArticleTemplateClient is a client for the ArticleTemplate schema.
+type ArticleTemplateClient struct {
 	config
 }
 
-// NewDocSeriesClient returns a client for the DocSeries from the given config.
-func NewDocSeriesClient(c config) *DocSeriesClient {
-	return &DocSeriesClient{config: c}
+// NewArticleTemplateClient returns a client for the ArticleTemplate from the given config.
+func NewArticleTemplateClient(c config) *ArticleTemplateClient {
+	return &ArticleTemplateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `docseries.Hooks(f(g(h())))`.
-func (c *DocSeriesClient) Use(hooks ...Hook) {
-	c.hooks.DocSeries = append(c.hooks.DocSeries, hooks...)
+// A call to `Use(f, g, h)` equals to `articletemplate.Hooks(f(g(h())))`.
+func (c *ArticleTemplateClient) Use(hooks ...Hook) {
+	c.hooks.ArticleTemplate = append(c.hooks.ArticleTemplate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `docseries.Intercept(f(g(h())))`.
-func (c *DocSeriesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DocSeries = append(c.inters.DocSeries, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `articletemplate.Intercept(f(g(h())))`.
+func (c *ArticleTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleTemplate = append(c.inters.ArticleTemplate, interceptors...)
 }
 
-// Create returns a builder for creating a DocSeries entity.
-func (c *DocSeriesClient) Create() *DocSeriesCreate {
-	mutation := newDocSeriesMutation(c.config, OpCreate)
-	return &DocSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ArticleTemplate entity.
+func (c *ArticleTemplateClient) Create() *ArticleTemplateCreate {
+	mutation := newArticleTemplateMutation(c.config, OpCreate)
+	return &ArticleTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DocSeries entities.
-func (c *DocSeriesClient) CreateBulk(builders ...*DocSeriesCreate) *DocSeriesCreateBulk {
-	return &DocSeriesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ArticleTemplate entities.
+func (c *ArticleTemplateClient) CreateBulk(builders ...*ArticleTemplateCreate) *ArticleTemplateCreateBulk {
+	return &ArticleTemplateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DocSeriesClient) MapCreateBulk(slice any, setFunc func(*DocSeriesCreate, int)) *DocSeriesCreateBulk {
+func (c *ArticleTemplateClient) MapCreateBulk(slice any, setFunc func(*ArticleTemplateCreate, int)) *ArticleTemplateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DocSeriesCreateBulk{err: fmt.Errorf("calling to DocSeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArticleTemplateCreateBulk{err: fmt.Errorf("calling to ArticleTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DocSeriesCreate, rv.Len())
+	builders := make([]*ArticleTemplateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DocSeriesCreateBulk{config: c.config, builders: builders}
+	return &ArticleTemplateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DocSeries.
-func (c *DocSeriesClient) Update() *DocSeriesUpdate {
-	mutation := newDocSeriesMutation(c.config, OpUpdate)
-	return &DocSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ArticleTemplate.
+func (c *ArticleTemplateClient) Update() *ArticleTemplateUpdate {
+	mutation := newArticleTemplateMutation(c.config, OpUpdate)
+	return &ArticleTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DocSeriesClient) UpdateOne(_m *DocSeries) *DocSeriesUpdateOne {
-	mutation := newDocSeriesMutation(c.config, OpUpdateOne, withDocSeries(_m))
-	return &DocSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleTemplateClient) UpdateOne(_m *ArticleTemplate) *ArticleTemplateUpdateOne {
+	mutation := newArticleTemplateMutation(c.config, OpUpdateOne, withArticleTemplate(_m))
+	return &ArticleTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DocSeriesClient) UpdateOneID(id uint) *DocSeriesUpdateOne {
-	mutation := newDocSeriesMutation(c.config, OpUpdateOne, withDocSeriesID(id))
-	return &DocSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleTemplateClient) UpdateOneID(id uint) *ArticleTemplateUpdateOne {
+	mutation := newArticleTemplateMutation(c.config, OpUpdateOne, withArticleTemplateID(id))
+	return &ArticleTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DocSeries.
-func (c *DocSeriesClient) Delete() *DocSeriesDelete {
-	mutation := newDocSeriesMutation(c.config, OpDelete)
-	return &DocSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ArticleTemplate.
+func (c *ArticleTemplateClient) Delete() *ArticleTemplateDelete {
+	mutation := newArticleTemplateMutation(c.config, OpDelete)
+	return &ArticleTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DocSeriesClient) DeleteOne(_m *DocSeries) *DocSeriesDeleteOne {
+func (c *ArticleTemplateClient) DeleteOne(_m *ArticleTemplate) *ArticleTemplateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DocSeriesClient) DeleteOneID(id uint) *DocSeriesDeleteOne {
-	builder := c.Delete().Where(docseries.ID(id))
+func (c *ArticleTemplateClient) DeleteOneID(id uint) *ArticleTemplateDeleteOne {
+	builder := c.Delete().Where(articletemplate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DocSeriesDeleteOne{builder}
+	return &ArticleTemplateDeleteOne{builder}
 }
 
-// Query returns a query builder for DocSeries.
-func (c *DocSeriesClient) Query() *DocSeriesQuery {
-	return &DocSeriesQuery{
+// Query returns a query builder for ArticleTemplate.
+func (c *ArticleTemplateClient) Query() *ArticleTemplateQuery {
+	return &ArticleTemplateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDocSeries},
+		ctx:    &QueryContext{Type: TypeArticleTemplate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DocSeries entity by its id.
-func (c *DocSeriesClient) Get(ctx context.Context, id uint) (*DocSeries, error) {
-	return c.Query().Where(docseries.ID(id)).Only(ctx)
+// Get returns a ArticleTemplate entity by its id.
+func (c *ArticleTemplateClient) Get(ctx context.Context, id uint) (*ArticleTemplate, error) {
+	return c.Query().Where(articletemplate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DocSeriesClient) GetX(ctx context.Context, id uint) *DocSeries {
+func (c *ArticleTemplateClient) GetX(ctx context.Context, id uint) *ArticleTemplate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1539,148 +2094,132 @@ func (c *DocSeriesClient) GetX(ctx context.Context, id uint) *DocSeries {
 	return obj
 }
 
-// QueryArticles queries the articles edge of a DocSeries.
-func (c *DocSeriesClient) QueryArticles(_m *DocSeries) *ArticleQuery {
-	query := (&ArticleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(docseries.Table, docseries.FieldID, id),
-			sqlgraph.To(article.Table, article.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, docseries.ArticlesTable, docseries.ArticlesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DocSeriesClient) Hooks() []Hook {
-	return c.hooks.DocSeries
+func (c *ArticleTemplateClient) Hooks() []Hook {
+	return c.hooks.ArticleTemplate
 }
 
 // Interceptors returns the client interceptors.
-func (c *DocSeriesClient) Interceptors() []Interceptor {
-	return c.inters.DocSeries
+func (c *ArticleTemplateClient) Interceptors() []Interceptor {
+	return c.inters.ArticleTemplate
 }
 
-func (c *DocSeriesClient) mutate(ctx context.Context, m *DocSeriesMutation) (Value, error) {
+func (c *ArticleTemplateClient) mutate(ctx context.Context, m *ArticleTemplateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DocSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DocSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DocSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DocSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ArticleTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DocSeries mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ArticleTemplate mutation op: %q", m.Op())
 	}
 }
 
-// EntityClient is a client for the Entity schema.
-type EntityClient struct {
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
 	config
 }
 
-// NewEntityClient returns a client for the Entity from the given config.
-func NewEntityClient(c config) *EntityClient {
-	return &EntityClient{config: c}
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `entity.Hooks(f(g(h())))`.
-func (c *EntityClient) Use(hooks ...Hook) {
-	c.hooks.Entity = append(c.hooks.Entity, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `entity.Intercept(f(g(h())))`.
-func (c *EntityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Entity = append(c.inters.Entity, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a Entity entity.
-func (c *EntityClient) Create() *EntityCreate {
-	mutation := newEntityMutation(c.config, OpCreate)
-	return &EntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Entity entities.
-func (c *EntityClient) CreateBulk(builders ...*EntityCreate) *EntityCreateBulk {
-	return &EntityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EntityClient) MapCreateBulk(slice any, setFunc func(*EntityCreate, int)) *EntityCreateBulk {
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EntityCreateBulk{err: fmt.Errorf("calling to EntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EntityCreate, rv.Len())
+	builders := make([]*AuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EntityCreateBulk{config: c.config, builders: builders}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Entity.
-func (c *EntityClient) Update() *EntityUpdate {
-	mutation := newEntityMutation(c.config, OpUpdate)
-	return &EntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EntityClient) UpdateOne(_m *Entity) *EntityUpdateOne {
-	mutation := newEntityMutation(c.config, OpUpdateOne, withEntity(_m))
-	return &EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EntityClient) UpdateOneID(id uint) *EntityUpdateOne {
-	mutation := newEntityMutation(c.config, OpUpdateOne, withEntityID(id))
-	return &EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOneID(id uint) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Entity.
-func (c *EntityClient) Delete() *EntityDelete {
-	mutation := newEntityMutation(c.config, OpDelete)
-	return &EntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EntityClient) DeleteOne(_m *Entity) *EntityDeleteOne {
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EntityClient) DeleteOneID(id uint) *EntityDeleteOne {
-	builder := c.Delete().Where(entity.ID(id))
+func (c *AuditLogClient) DeleteOneID(id uint) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EntityDeleteOne{builder}
+	return &AuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Entity.
-func (c *EntityClient) Query() *EntityQuery {
-	return &EntityQuery{
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEntity},
+		ctx:    &QueryContext{Type: TypeAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Entity entity by its id.
-func (c *EntityClient) Get(ctx context.Context, id uint) (*Entity, error) {
-	return c.Query().Where(entity.ID(id)).Only(ctx)
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uint) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EntityClient) GetX(ctx context.Context, id uint) *Entity {
+func (c *AuditLogClient) GetX(ctx context.Context, id uint) *AuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1688,148 +2227,265 @@ func (c *EntityClient) GetX(ctx context.Context, id uint) *Entity {
 	return obj
 }
 
-// QueryFileVersions queries the file_versions edge of a Entity.
-func (c *EntityClient) QueryFileVersions(_m *Entity) *FileEntityQuery {
-	query := (&FileEntityClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(entity.Table, entity.FieldID, id),
-			sqlgraph.To(fileentity.Table, fileentity.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, entity.FileVersionsTable, entity.FileVersionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
 	}
-	return query
+}
+
+// ChangelogEntryClient is a client for the ChangelogEntry schema.
+type ChangelogEntryClient struct {
+	config
+}
+
+// NewChangelogEntryClient returns a client for the ChangelogEntry from the given config.
+func NewChangelogEntryClient(c config) *ChangelogEntryClient {
+	return &ChangelogEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `changelogentry.Hooks(f(g(h())))`.
+func (c *ChangelogEntryClient) Use(hooks ...Hook) {
+	c.hooks.ChangelogEntry = append(c.hooks.ChangelogEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `changelogentry.Intercept(f(g(h())))`.
+func (c *ChangelogEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChangelogEntry = append(c.inters.ChangelogEntry, interceptors...)
+}
+
+// Create returns a builder for creating a ChangelogEntry entity.
+func (c *ChangelogEntryClient) Create() *ChangelogEntryCreate {
+	mutation := newChangelogEntryMutation(c.config, OpCreate)
+	return &ChangelogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChangelogEntry entities.
+func (c *ChangelogEntryClient) CreateBulk(builders ...*ChangelogEntryCreate) *ChangelogEntryCreateBulk {
+	return &ChangelogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChangelogEntryClient) MapCreateBulk(slice any, setFunc func(*ChangelogEntryCreate, int)) *ChangelogEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChangelogEntryCreateBulk{err: fmt.Errorf("calling to ChangelogEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChangelogEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChangelogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChangelogEntry.
+func (c *ChangelogEntryClient) Update() *ChangelogEntryUpdate {
+	mutation := newChangelogEntryMutation(c.config, OpUpdate)
+	return &ChangelogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChangelogEntryClient) UpdateOne(_m *ChangelogEntry) *ChangelogEntryUpdateOne {
+	mutation := newChangelogEntryMutation(c.config, OpUpdateOne, withChangelogEntry(_m))
+	return &ChangelogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChangelogEntryClient) UpdateOneID(id uint) *ChangelogEntryUpdateOne {
+	mutation := newChangelogEntryMutation(c.config, OpUpdateOne, withChangelogEntryID(id))
+	return &ChangelogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChangelogEntry.
+func (c *ChangelogEntryClient) Delete() *ChangelogEntryDelete {
+	mutation := newChangelogEntryMutation(c.config, OpDelete)
+	return &ChangelogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChangelogEntryClient) DeleteOne(_m *ChangelogEntry) *ChangelogEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChangelogEntryClient) DeleteOneID(id uint) *ChangelogEntryDeleteOne {
+	builder := c.Delete().Where(changelogentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChangelogEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for ChangelogEntry.
+func (c *ChangelogEntryClient) Query() *ChangelogEntryQuery {
+	return &ChangelogEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChangelogEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChangelogEntry entity by its id.
+func (c *ChangelogEntryClient) Get(ctx context.Context, id uint) (*ChangelogEntry, error) {
+	return c.Query().Where(changelogentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChangelogEntryClient) GetX(ctx context.Context, id uint) *ChangelogEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *EntityClient) Hooks() []Hook {
-	return c.hooks.Entity
+func (c *ChangelogEntryClient) Hooks() []Hook {
+	return c.hooks.ChangelogEntry
 }
 
 // Interceptors returns the client interceptors.
-func (c *EntityClient) Interceptors() []Interceptor {
-	return c.inters.Entity
+func (c *ChangelogEntryClient) Interceptors() []Interceptor {
+	return c.inters.ChangelogEntry
 }
 
-func (c *EntityClient) mutate(ctx context.Context, m *EntityMutation) (Value, error) {
+func (c *ChangelogEntryClient) mutate(ctx context.Context, m *ChangelogEntryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChangelogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChangelogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChangelogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ChangelogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Entity mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ChangelogEntry mutation op: %q", m.Op())
 	}
 }
 
-// FileClient is a client for the File schema.
-type FileClient struct {
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
 	config
 }
 
-// NewFileClient returns a client for the File from the given config.
-func NewFileClient(c config) *FileClient {
-	return &FileClient{config: c}
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `file.Hooks(f(g(h())))`.
-func (c *FileClient) Use(hooks ...Hook) {
-	c.hooks.File = append(c.hooks.File, hooks...)
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `file.Intercept(f(g(h())))`.
-func (c *FileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.File = append(c.inters.File, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
 }
 
-// Create returns a builder for creating a File entity.
-func (c *FileClient) Create() *FileCreate {
-	mutation := newFileMutation(c.config, OpCreate)
-	return &FileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Comment entity.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of File entities.
-func (c *FileClient) CreateBulk(builders ...*FileCreate) *FileCreateBulk {
-	return &FileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FileClient) MapCreateBulk(slice any, setFunc func(*FileCreate, int)) *FileCreateBulk {
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FileCreateBulk{err: fmt.Errorf("calling to FileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FileCreate, rv.Len())
+	builders := make([]*CommentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FileCreateBulk{config: c.config, builders: builders}
+	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for File.
-func (c *FileClient) Update() *FileUpdate {
-	mutation := newFileMutation(c.config, OpUpdate)
-	return &FileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FileClient) UpdateOne(_m *File) *FileUpdateOne {
-	mutation := newFileMutation(c.config, OpUpdateOne, withFile(_m))
-	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentClient) UpdateOne(_m *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(_m))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FileClient) UpdateOneID(id uint) *FileUpdateOne {
-	mutation := newFileMutation(c.config, OpUpdateOne, withFileID(id))
-	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentClient) UpdateOneID(id uint) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for File.
-func (c *FileClient) Delete() *FileDelete {
-	mutation := newFileMutation(c.config, OpDelete)
-	return &FileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FileClient) DeleteOne(_m *File) *FileDeleteOne {
+func (c *CommentClient) DeleteOne(_m *Comment) *CommentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FileClient) DeleteOneID(id uint) *FileDeleteOne {
-	builder := c.Delete().Where(file.ID(id))
+func (c *CommentClient) DeleteOneID(id uint) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FileDeleteOne{builder}
+	return &CommentDeleteOne{builder}
 }
 
-// Query returns a query builder for File.
-func (c *FileClient) Query() *FileQuery {
-	return &FileQuery{
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFile},
+		ctx:    &QueryContext{Type: TypeComment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a File entity by its id.
-func (c *FileClient) Get(ctx context.Context, id uint) (*File, error) {
-	return c.Query().Where(file.ID(id)).Only(ctx)
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id uint) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FileClient) GetX(ctx context.Context, id uint) *File {
+func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1837,15 +2493,15 @@ func (c *FileClient) GetX(ctx context.Context, id uint) *File {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a File.
-func (c *FileClient) QueryOwner(_m *File) *UserQuery {
+// QueryUser queries the user edge of a Comment.
+func (c *CommentClient) QueryUser(_m *Comment) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.From(comment.Table, comment.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, file.OwnerTable, file.OwnerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1853,15 +2509,15 @@ func (c *FileClient) QueryOwner(_m *File) *UserQuery {
 	return query
 }
 
-// QueryParent queries the parent edge of a File.
-func (c *FileClient) QueryParent(_m *File) *FileQuery {
-	query := (&FileClient{config: c.config}).Query()
+// QueryChildren queries the children edge of a Comment.
+func (c *CommentClient) QueryChildren(_m *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(file.Table, file.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, file.ParentTable, file.ParentColumn),
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ChildrenTable, comment.ChildrenColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1869,15 +2525,15 @@ func (c *FileClient) QueryParent(_m *File) *FileQuery {
 	return query
 }
 
-// QueryChildren queries the children edge of a File.
-func (c *FileClient) QueryChildren(_m *File) *FileQuery {
-	query := (&FileClient{config: c.config}).Query()
+// QueryParent queries the parent edge of a Comment.
+func (c *CommentClient) QueryParent(_m *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(file.Table, file.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, file.ChildrenTable, file.ChildrenColumn),
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.ParentTable, comment.ParentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1885,63 +2541,2254 @@ func (c *FileClient) QueryChildren(_m *File) *FileQuery {
 	return query
 }
 
-// QueryPrimaryEntity queries the primary_entity edge of a File.
-func (c *FileClient) QueryPrimaryEntity(_m *File) *EntityQuery {
-	query := (&EntityClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(entity.Table, entity.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, file.PrimaryEntityTable, file.PrimaryEntityColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *CommentClient) Hooks() []Hook {
+	hooks := c.hooks.Comment
+	return append(hooks[:len(hooks):len(hooks)], comment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
+func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+	}
+}
+
+// CommentReactionClient is a client for the CommentReaction schema.
+type CommentReactionClient struct {
+	config
+}
+
+// NewCommentReactionClient returns a client for the CommentReaction from the given config.
+func NewCommentReactionClient(c config) *CommentReactionClient {
+	return &CommentReactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commentreaction.Hooks(f(g(h())))`.
+func (c *CommentReactionClient) Use(hooks ...Hook) {
+	c.hooks.CommentReaction = append(c.hooks.CommentReaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commentreaction.Intercept(f(g(h())))`.
+func (c *CommentReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentReaction = append(c.inters.CommentReaction, interceptors...)
+}
+
+// Create returns a builder for creating a CommentReaction entity.
+func (c *CommentReactionClient) Create() *CommentReactionCreate {
+	mutation := newCommentReactionMutation(c.config, OpCreate)
+	return &CommentReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommentReaction entities.
+func (c *CommentReactionClient) CreateBulk(builders ...*CommentReactionCreate) *CommentReactionCreateBulk {
+	return &CommentReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentReactionClient) MapCreateBulk(slice any, setFunc func(*CommentReactionCreate, int)) *CommentReactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentReactionCreateBulk{err: fmt.Errorf("calling to CommentReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentReactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommentReaction.
+func (c *CommentReactionClient) Update() *CommentReactionUpdate {
+	mutation := newCommentReactionMutation(c.config, OpUpdate)
+	return &CommentReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentReactionClient) UpdateOne(_m *CommentReaction) *CommentReactionUpdateOne {
+	mutation := newCommentReactionMutation(c.config, OpUpdateOne, withCommentReaction(_m))
+	return &CommentReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentReactionClient) UpdateOneID(id uint) *CommentReactionUpdateOne {
+	mutation := newCommentReactionMutation(c.config, OpUpdateOne, withCommentReactionID(id))
+	return &CommentReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommentReaction.
+func (c *CommentReactionClient) Delete() *CommentReactionDelete {
+	mutation := newCommentReactionMutation(c.config, OpDelete)
+	return &CommentReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentReactionClient) DeleteOne(_m *CommentReaction) *CommentReactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentReactionClient) DeleteOneID(id uint) *CommentReactionDeleteOne {
+	builder := c.Delete().Where(commentreaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentReactionDeleteOne{builder}
+}
+
+// Query returns a query builder for CommentReaction.
+func (c *CommentReactionClient) Query() *CommentReactionQuery {
+	return &CommentReactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommentReaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommentReaction entity by its id.
+func (c *CommentReactionClient) Get(ctx context.Context, id uint) (*CommentReaction, error) {
+	return c.Query().Where(commentreaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentReactionClient) GetX(ctx context.Context, id uint) *CommentReaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommentReactionClient) Hooks() []Hook {
+	return c.hooks.CommentReaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentReactionClient) Interceptors() []Interceptor {
+	return c.inters.CommentReaction
+}
+
+func (c *CommentReactionClient) mutate(ctx context.Context, m *CommentReactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommentReaction mutation op: %q", m.Op())
+	}
+}
+
+// CommentSubscriptionClient is a client for the CommentSubscription schema.
+type CommentSubscriptionClient struct {
+	config
+}
+
+// NewCommentSubscriptionClient returns a client for the CommentSubscription from the given config.
+func NewCommentSubscriptionClient(c config) *CommentSubscriptionClient {
+	return &CommentSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commentsubscription.Hooks(f(g(h())))`.
+func (c *CommentSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.CommentSubscription = append(c.hooks.CommentSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commentsubscription.Intercept(f(g(h())))`.
+func (c *CommentSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentSubscription = append(c.inters.CommentSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a CommentSubscription entity.
+func (c *CommentSubscriptionClient) Create() *CommentSubscriptionCreate {
+	mutation := newCommentSubscriptionMutation(c.config, OpCreate)
+	return &CommentSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommentSubscription entities.
+func (c *CommentSubscriptionClient) CreateBulk(builders ...*CommentSubscriptionCreate) *CommentSubscriptionCreateBulk {
+	return &CommentSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentSubscriptionClient) MapCreateBulk(slice any, setFunc func(*CommentSubscriptionCreate, int)) *CommentSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentSubscriptionCreateBulk{err: fmt.Errorf("calling to CommentSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommentSubscription.
+func (c *CommentSubscriptionClient) Update() *CommentSubscriptionUpdate {
+	mutation := newCommentSubscriptionMutation(c.config, OpUpdate)
+	return &CommentSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentSubscriptionClient) UpdateOne(_m *CommentSubscription) *CommentSubscriptionUpdateOne {
+	mutation := newCommentSubscriptionMutation(c.config, OpUpdateOne, withCommentSubscription(_m))
+	return &CommentSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentSubscriptionClient) UpdateOneID(id uint) *CommentSubscriptionUpdateOne {
+	mutation := newCommentSubscriptionMutation(c.config, OpUpdateOne, withCommentSubscriptionID(id))
+	return &CommentSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommentSubscription.
+func (c *CommentSubscriptionClient) Delete() *CommentSubscriptionDelete {
+	mutation := newCommentSubscriptionMutation(c.config, OpDelete)
+	return &CommentSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentSubscriptionClient) DeleteOne(_m *CommentSubscription) *CommentSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentSubscriptionClient) DeleteOneID(id uint) *CommentSubscriptionDeleteOne {
+	builder := c.Delete().Where(commentsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for CommentSubscription.
+func (c *CommentSubscriptionClient) Query() *CommentSubscriptionQuery {
+	return &CommentSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommentSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommentSubscription entity by its id.
+func (c *CommentSubscriptionClient) Get(ctx context.Context, id uint) (*CommentSubscription, error) {
+	return c.Query().Where(commentsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentSubscriptionClient) GetX(ctx context.Context, id uint) *CommentSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommentSubscriptionClient) Hooks() []Hook {
+	return c.hooks.CommentSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.CommentSubscription
+}
+
+func (c *CommentSubscriptionClient) mutate(ctx context.Context, m *CommentSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommentSubscription mutation op: %q", m.Op())
+	}
+}
+
+// CommenterTrustClient is a client for the CommenterTrust schema.
+type CommenterTrustClient struct {
+	config
+}
+
+// NewCommenterTrustClient returns a client for the CommenterTrust from the given config.
+func NewCommenterTrustClient(c config) *CommenterTrustClient {
+	return &CommenterTrustClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commentertrust.Hooks(f(g(h())))`.
+func (c *CommenterTrustClient) Use(hooks ...Hook) {
+	c.hooks.CommenterTrust = append(c.hooks.CommenterTrust, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commentertrust.Intercept(f(g(h())))`.
+func (c *CommenterTrustClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommenterTrust = append(c.inters.CommenterTrust, interceptors...)
+}
+
+// Create returns a builder for creating a CommenterTrust entity.
+func (c *CommenterTrustClient) Create() *CommenterTrustCreate {
+	mutation := newCommenterTrustMutation(c.config, OpCreate)
+	return &CommenterTrustCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommenterTrust entities.
+func (c *CommenterTrustClient) CreateBulk(builders ...*CommenterTrustCreate) *CommenterTrustCreateBulk {
+	return &CommenterTrustCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommenterTrustClient) MapCreateBulk(slice any, setFunc func(*CommenterTrustCreate, int)) *CommenterTrustCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommenterTrustCreateBulk{err: fmt.Errorf("calling to CommenterTrustClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommenterTrustCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommenterTrustCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommenterTrust.
+func (c *CommenterTrustClient) Update() *CommenterTrustUpdate {
+	mutation := newCommenterTrustMutation(c.config, OpUpdate)
+	return &CommenterTrustUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommenterTrustClient) UpdateOne(_m *CommenterTrust) *CommenterTrustUpdateOne {
+	mutation := newCommenterTrustMutation(c.config, OpUpdateOne, withCommenterTrust(_m))
+	return &CommenterTrustUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommenterTrustClient) UpdateOneID(id uint) *CommenterTrustUpdateOne {
+	mutation := newCommenterTrustMutation(c.config, OpUpdateOne, withCommenterTrustID(id))
+	return &CommenterTrustUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommenterTrust.
+func (c *CommenterTrustClient) Delete() *CommenterTrustDelete {
+	mutation := newCommenterTrustMutation(c.config, OpDelete)
+	return &CommenterTrustDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommenterTrustClient) DeleteOne(_m *CommenterTrust) *CommenterTrustDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommenterTrustClient) DeleteOneID(id uint) *CommenterTrustDeleteOne {
+	builder := c.Delete().Where(commentertrust.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommenterTrustDeleteOne{builder}
+}
+
+// Query returns a query builder for CommenterTrust.
+func (c *CommenterTrustClient) Query() *CommenterTrustQuery {
+	return &CommenterTrustQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommenterTrust},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommenterTrust entity by its id.
+func (c *CommenterTrustClient) Get(ctx context.Context, id uint) (*CommenterTrust, error) {
+	return c.Query().Where(commentertrust.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommenterTrustClient) GetX(ctx context.Context, id uint) *CommenterTrust {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommenterTrustClient) Hooks() []Hook {
+	return c.hooks.CommenterTrust
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommenterTrustClient) Interceptors() []Interceptor {
+	return c.inters.CommenterTrust
+}
+
+func (c *CommenterTrustClient) mutate(ctx context.Context, m *CommenterTrustMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommenterTrustCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommenterTrustUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommenterTrustUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommenterTrustDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommenterTrust mutation op: %q", m.Op())
+	}
+}
+
+// ContentSnippetClient is a client for the ContentSnippet schema.
+type ContentSnippetClient struct {
+	config
+}
+
+// NewContentSnippetClient returns a client for the ContentSnippet from the given config.
+func NewContentSnippetClient(c config) *ContentSnippetClient {
+	return &ContentSnippetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contentsnippet.Hooks(f(g(h())))`.
+func (c *ContentSnippetClient) Use(hooks ...Hook) {
+	c.hooks.ContentSnippet = append(c.hooks.ContentSnippet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contentsnippet.Intercept(f(g(h())))`.
+func (c *ContentSnippetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentSnippet = append(c.inters.ContentSnippet, interceptors...)
+}
+
+// Create returns a builder for creating a ContentSnippet entity.
+func (c *ContentSnippetClient) Create() *ContentSnippetCreate {
+	mutation := newContentSnippetMutation(c.config, OpCreate)
+	return &ContentSnippetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContentSnippet entities.
+func (c *ContentSnippetClient) CreateBulk(builders ...*ContentSnippetCreate) *ContentSnippetCreateBulk {
+	return &ContentSnippetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContentSnippetClient) MapCreateBulk(slice any, setFunc func(*ContentSnippetCreate, int)) *ContentSnippetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContentSnippetCreateBulk{err: fmt.Errorf("calling to ContentSnippetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContentSnippetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContentSnippetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContentSnippet.
+func (c *ContentSnippetClient) Update() *ContentSnippetUpdate {
+	mutation := newContentSnippetMutation(c.config, OpUpdate)
+	return &ContentSnippetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContentSnippetClient) UpdateOne(_m *ContentSnippet) *ContentSnippetUpdateOne {
+	mutation := newContentSnippetMutation(c.config, OpUpdateOne, withContentSnippet(_m))
+	return &ContentSnippetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContentSnippetClient) UpdateOneID(id uint) *ContentSnippetUpdateOne {
+	mutation := newContentSnippetMutation(c.config, OpUpdateOne, withContentSnippetID(id))
+	return &ContentSnippetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContentSnippet.
+func (c *ContentSnippetClient) Delete() *ContentSnippetDelete {
+	mutation := newContentSnippetMutation(c.config, OpDelete)
+	return &ContentSnippetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentSnippetClient) DeleteOne(_m *ContentSnippet) *ContentSnippetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentSnippetClient) DeleteOneID(id uint) *ContentSnippetDeleteOne {
+	builder := c.Delete().Where(contentsnippet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentSnippetDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentSnippet.
+func (c *ContentSnippetClient) Query() *ContentSnippetQuery {
+	return &ContentSnippetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentSnippet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentSnippet entity by its id.
+func (c *ContentSnippetClient) Get(ctx context.Context, id uint) (*ContentSnippet, error) {
+	return c.Query().Where(contentsnippet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentSnippetClient) GetX(ctx context.Context, id uint) *ContentSnippet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ContentSnippetClient) Hooks() []Hook {
+	return c.hooks.ContentSnippet
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentSnippetClient) Interceptors() []Interceptor {
+	return c.inters.ContentSnippet
+}
+
+func (c *ContentSnippetClient) mutate(ctx context.Context, m *ContentSnippetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentSnippetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentSnippetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentSnippetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentSnippetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContentSnippet mutation op: %q", m.Op())
+	}
+}
+
+// DirectLinkClient is a client for the DirectLink schema.
+type DirectLinkClient struct {
+	config
+}
+
+// NewDirectLinkClient returns a client for the DirectLink from the given config.
+func NewDirectLinkClient(c config) *DirectLinkClient {
+	return &DirectLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `directlink.Hooks(f(g(h())))`.
+func (c *DirectLinkClient) Use(hooks ...Hook) {
+	c.hooks.DirectLink = append(c.hooks.DirectLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `directlink.Intercept(f(g(h())))`.
+func (c *DirectLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DirectLink = append(c.inters.DirectLink, interceptors...)
+}
+
+// Create returns a builder for creating a DirectLink entity.
+func (c *DirectLinkClient) Create() *DirectLinkCreate {
+	mutation := newDirectLinkMutation(c.config, OpCreate)
+	return &DirectLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DirectLink entities.
+func (c *DirectLinkClient) CreateBulk(builders ...*DirectLinkCreate) *DirectLinkCreateBulk {
+	return &DirectLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DirectLinkClient) MapCreateBulk(slice any, setFunc func(*DirectLinkCreate, int)) *DirectLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DirectLinkCreateBulk{err: fmt.Errorf("calling to DirectLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DirectLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DirectLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DirectLink.
+func (c *DirectLinkClient) Update() *DirectLinkUpdate {
+	mutation := newDirectLinkMutation(c.config, OpUpdate)
+	return &DirectLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DirectLinkClient) UpdateOne(_m *DirectLink) *DirectLinkUpdateOne {
+	mutation := newDirectLinkMutation(c.config, OpUpdateOne, withDirectLink(_m))
+	return &DirectLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DirectLinkClient) UpdateOneID(id uint) *DirectLinkUpdateOne {
+	mutation := newDirectLinkMutation(c.config, OpUpdateOne, withDirectLinkID(id))
+	return &DirectLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DirectLink.
+func (c *DirectLinkClient) Delete() *DirectLinkDelete {
+	mutation := newDirectLinkMutation(c.config, OpDelete)
+	return &DirectLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DirectLinkClient) DeleteOne(_m *DirectLink) *DirectLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DirectLinkClient) DeleteOneID(id uint) *DirectLinkDeleteOne {
+	builder := c.Delete().Where(directlink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DirectLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for DirectLink.
+func (c *DirectLinkClient) Query() *DirectLinkQuery {
+	return &DirectLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDirectLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DirectLink entity by its id.
+func (c *DirectLinkClient) Get(ctx context.Context, id uint) (*DirectLink, error) {
+	return c.Query().Where(directlink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DirectLinkClient) GetX(ctx context.Context, id uint) *DirectLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a DirectLink.
+func (c *DirectLinkClient) QueryFile(_m *DirectLink) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(directlink.Table, directlink.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, directlink.FileTable, directlink.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DirectLinkClient) Hooks() []Hook {
+	hooks := c.hooks.DirectLink
+	return append(hooks[:len(hooks):len(hooks)], directlink.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DirectLinkClient) Interceptors() []Interceptor {
+	return c.inters.DirectLink
+}
+
+func (c *DirectLinkClient) mutate(ctx context.Context, m *DirectLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DirectLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DirectLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DirectLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DirectLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DirectLink mutation op: %q", m.Op())
+	}
+}
+
+// DisposableEmailStatClient is a client for the DisposableEmailStat schema.
+type DisposableEmailStatClient struct {
+	config
+}
+
+// NewDisposableEmailStatClient returns a client for the DisposableEmailStat from the given config.
+func NewDisposableEmailStatClient(c config) *DisposableEmailStatClient {
+	return &DisposableEmailStatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `disposableemailstat.Hooks(f(g(h())))`.
+func (c *DisposableEmailStatClient) Use(hooks ...Hook) {
+	c.hooks.DisposableEmailStat = append(c.hooks.DisposableEmailStat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `disposableemailstat.Intercept(f(g(h())))`.
+func (c *DisposableEmailStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DisposableEmailStat = append(c.inters.DisposableEmailStat, interceptors...)
+}
+
+// Create returns a builder for creating a DisposableEmailStat entity.
+func (c *DisposableEmailStatClient) Create() *DisposableEmailStatCreate {
+	mutation := newDisposableEmailStatMutation(c.config, OpCreate)
+	return &DisposableEmailStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DisposableEmailStat entities.
+func (c *DisposableEmailStatClient) CreateBulk(builders ...*DisposableEmailStatCreate) *DisposableEmailStatCreateBulk {
+	return &DisposableEmailStatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DisposableEmailStatClient) MapCreateBulk(slice any, setFunc func(*DisposableEmailStatCreate, int)) *DisposableEmailStatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DisposableEmailStatCreateBulk{err: fmt.Errorf("calling to DisposableEmailStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DisposableEmailStatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DisposableEmailStatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DisposableEmailStat.
+func (c *DisposableEmailStatClient) Update() *DisposableEmailStatUpdate {
+	mutation := newDisposableEmailStatMutation(c.config, OpUpdate)
+	return &DisposableEmailStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DisposableEmailStatClient) UpdateOne(_m *DisposableEmailStat) *DisposableEmailStatUpdateOne {
+	mutation := newDisposableEmailStatMutation(c.config, OpUpdateOne, withDisposableEmailStat(_m))
+	return &DisposableEmailStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DisposableEmailStatClient) UpdateOneID(id uint) *DisposableEmailStatUpdateOne {
+	mutation := newDisposableEmailStatMutation(c.config, OpUpdateOne, withDisposableEmailStatID(id))
+	return &DisposableEmailStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DisposableEmailStat.
+func (c *DisposableEmailStatClient) Delete() *DisposableEmailStatDelete {
+	mutation := newDisposableEmailStatMutation(c.config, OpDelete)
+	return &DisposableEmailStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DisposableEmailStatClient) DeleteOne(_m *DisposableEmailStat) *DisposableEmailStatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DisposableEmailStatClient) DeleteOneID(id uint) *DisposableEmailStatDeleteOne {
+	builder := c.Delete().Where(disposableemailstat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DisposableEmailStatDeleteOne{builder}
+}
+
+// Query returns a query builder for DisposableEmailStat.
+func (c *DisposableEmailStatClient) Query() *DisposableEmailStatQuery {
+	return &DisposableEmailStatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDisposableEmailStat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DisposableEmailStat entity by its id.
+func (c *DisposableEmailStatClient) Get(ctx context.Context, id uint) (*DisposableEmailStat, error) {
+	return c.Query().Where(disposableemailstat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DisposableEmailStatClient) GetX(ctx context.Context, id uint) *DisposableEmailStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DisposableEmailStatClient) Hooks() []Hook {
+	return c.hooks.DisposableEmailStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *DisposableEmailStatClient) Interceptors() []Interceptor {
+	return c.inters.DisposableEmailStat
+}
+
+func (c *DisposableEmailStatClient) mutate(ctx context.Context, m *DisposableEmailStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DisposableEmailStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DisposableEmailStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DisposableEmailStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DisposableEmailStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DisposableEmailStat mutation op: %q", m.Op())
+	}
+}
+
+// DocSeriesClient is a client for the DocSeries schema.
+type DocSeriesClient struct {
+	config
+}
+
+// NewDocSeriesClient returns a client for the DocSeries from the given config.
+func NewDocSeriesClient(c config) *DocSeriesClient {
+	return &DocSeriesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `docseries.Hooks(f(g(h())))`.
+func (c *DocSeriesClient) Use(hooks ...Hook) {
+	c.hooks.DocSeries = append(c.hooks.DocSeries, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `docseries.Intercept(f(g(h())))`.
+func (c *DocSeriesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocSeries = append(c.inters.DocSeries, interceptors...)
+}
+
+// Create returns a builder for creating a DocSeries entity.
+func (c *DocSeriesClient) Create() *DocSeriesCreate {
+	mutation := newDocSeriesMutation(c.config, OpCreate)
+	return &DocSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocSeries entities.
+func (c *DocSeriesClient) CreateBulk(builders ...*DocSeriesCreate) *DocSeriesCreateBulk {
+	return &DocSeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocSeriesClient) MapCreateBulk(slice any, setFunc func(*DocSeriesCreate, int)) *DocSeriesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocSeriesCreateBulk{err: fmt.Errorf("calling to DocSeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocSeriesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocSeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocSeries.
+func (c *DocSeriesClient) Update() *DocSeriesUpdate {
+	mutation := newDocSeriesMutation(c.config, OpUpdate)
+	return &DocSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocSeriesClient) UpdateOne(_m *DocSeries) *DocSeriesUpdateOne {
+	mutation := newDocSeriesMutation(c.config, OpUpdateOne, withDocSeries(_m))
+	return &DocSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocSeriesClient) UpdateOneID(id uint) *DocSeriesUpdateOne {
+	mutation := newDocSeriesMutation(c.config, OpUpdateOne, withDocSeriesID(id))
+	return &DocSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocSeries.
+func (c *DocSeriesClient) Delete() *DocSeriesDelete {
+	mutation := newDocSeriesMutation(c.config, OpDelete)
+	return &DocSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocSeriesClient) DeleteOne(_m *DocSeries) *DocSeriesDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocSeriesClient) DeleteOneID(id uint) *DocSeriesDeleteOne {
+	builder := c.Delete().Where(docseries.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocSeriesDeleteOne{builder}
+}
+
+// Query returns a query builder for DocSeries.
+func (c *DocSeriesClient) Query() *DocSeriesQuery {
+	return &DocSeriesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocSeries},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocSeries entity by its id.
+func (c *DocSeriesClient) Get(ctx context.Context, id uint) (*DocSeries, error) {
+	return c.Query().Where(docseries.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocSeriesClient) GetX(ctx context.Context, id uint) *DocSeries {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArticles queries the articles edge of a DocSeries.
+func (c *DocSeriesClient) QueryArticles(_m *DocSeries) *ArticleQuery {
+	query := (&ArticleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(docseries.Table, docseries.FieldID, id),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, docseries.ArticlesTable, docseries.ArticlesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocSeriesClient) Hooks() []Hook {
+	return c.hooks.DocSeries
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocSeriesClient) Interceptors() []Interceptor {
+	return c.inters.DocSeries
+}
+
+func (c *DocSeriesClient) mutate(ctx context.Context, m *DocSeriesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocSeries mutation op: %q", m.Op())
+	}
+}
+
+// EntityClient is a client for the Entity schema.
+type EntityClient struct {
+	config
+}
+
+// NewEntityClient returns a client for the Entity from the given config.
+func NewEntityClient(c config) *EntityClient {
+	return &EntityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `entity.Hooks(f(g(h())))`.
+func (c *EntityClient) Use(hooks ...Hook) {
+	c.hooks.Entity = append(c.hooks.Entity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `entity.Intercept(f(g(h())))`.
+func (c *EntityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Entity = append(c.inters.Entity, interceptors...)
+}
+
+// Create returns a builder for creating a Entity entity.
+func (c *EntityClient) Create() *EntityCreate {
+	mutation := newEntityMutation(c.config, OpCreate)
+	return &EntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Entity entities.
+func (c *EntityClient) CreateBulk(builders ...*EntityCreate) *EntityCreateBulk {
+	return &EntityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EntityClient) MapCreateBulk(slice any, setFunc func(*EntityCreate, int)) *EntityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EntityCreateBulk{err: fmt.Errorf("calling to EntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EntityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EntityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Entity.
+func (c *EntityClient) Update() *EntityUpdate {
+	mutation := newEntityMutation(c.config, OpUpdate)
+	return &EntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EntityClient) UpdateOne(_m *Entity) *EntityUpdateOne {
+	mutation := newEntityMutation(c.config, OpUpdateOne, withEntity(_m))
+	return &EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EntityClient) UpdateOneID(id uint) *EntityUpdateOne {
+	mutation := newEntityMutation(c.config, OpUpdateOne, withEntityID(id))
+	return &EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Entity.
+func (c *EntityClient) Delete() *EntityDelete {
+	mutation := newEntityMutation(c.config, OpDelete)
+	return &EntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EntityClient) DeleteOne(_m *Entity) *EntityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EntityClient) DeleteOneID(id uint) *EntityDeleteOne {
+	builder := c.Delete().Where(entity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EntityDeleteOne{builder}
+}
+
+// Query returns a query builder for Entity.
+func (c *EntityClient) Query() *EntityQuery {
+	return &EntityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEntity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Entity entity by its id.
+func (c *EntityClient) Get(ctx context.Context, id uint) (*Entity, error) {
+	return c.Query().Where(entity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EntityClient) GetX(ctx context.Context, id uint) *Entity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFileVersions queries the file_versions edge of a Entity.
+func (c *EntityClient) QueryFileVersions(_m *Entity) *FileEntityQuery {
+	query := (&FileEntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(entity.Table, entity.FieldID, id),
+			sqlgraph.To(fileentity.Table, fileentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, entity.FileVersionsTable, entity.FileVersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EntityClient) Hooks() []Hook {
+	return c.hooks.Entity
+}
+
+// Interceptors returns the client interceptors.
+func (c *EntityClient) Interceptors() []Interceptor {
+	return c.inters.Entity
+}
+
+func (c *EntityClient) mutate(ctx context.Context, m *EntityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Entity mutation op: %q", m.Op())
+	}
+}
+
+// FileClient is a client for the File schema.
+type FileClient struct {
+	config
+}
+
+// NewFileClient returns a client for the File from the given config.
+func NewFileClient(c config) *FileClient {
+	return &FileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `file.Hooks(f(g(h())))`.
+func (c *FileClient) Use(hooks ...Hook) {
+	c.hooks.File = append(c.hooks.File, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `file.Intercept(f(g(h())))`.
+func (c *FileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.File = append(c.inters.File, interceptors...)
+}
+
+// Create returns a builder for creating a File entity.
+func (c *FileClient) Create() *FileCreate {
+	mutation := newFileMutation(c.config, OpCreate)
+	return &FileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of File entities.
+func (c *FileClient) CreateBulk(builders ...*FileCreate) *FileCreateBulk {
+	return &FileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileClient) MapCreateBulk(slice any, setFunc func(*FileCreate, int)) *FileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileCreateBulk{err: fmt.Errorf("calling to FileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for File.
+func (c *FileClient) Update() *FileUpdate {
+	mutation := newFileMutation(c.config, OpUpdate)
+	return &FileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileClient) UpdateOne(_m *File) *FileUpdateOne {
+	mutation := newFileMutation(c.config, OpUpdateOne, withFile(_m))
+	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileClient) UpdateOneID(id uint) *FileUpdateOne {
+	mutation := newFileMutation(c.config, OpUpdateOne, withFileID(id))
+	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for File.
+func (c *FileClient) Delete() *FileDelete {
+	mutation := newFileMutation(c.config, OpDelete)
+	return &FileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileClient) DeleteOne(_m *File) *FileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileClient) DeleteOneID(id uint) *FileDeleteOne {
+	builder := c.Delete().Where(file.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileDeleteOne{builder}
+}
+
+// Query returns a query builder for File.
+func (c *FileClient) Query() *FileQuery {
+	return &FileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a File entity by its id.
+func (c *FileClient) Get(ctx context.Context, id uint) (*File, error) {
+	return c.Query().Where(file.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileClient) GetX(ctx context.Context, id uint) *File {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a File.
+func (c *FileClient) QueryOwner(_m *File) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.OwnerTable, file.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a File.
+func (c *FileClient) QueryParent(_m *File) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, file.ParentTable, file.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a File.
+func (c *FileClient) QueryChildren(_m *File) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, file.ChildrenTable, file.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrimaryEntity queries the primary_entity edge of a File.
+func (c *FileClient) QueryPrimaryEntity(_m *File) *EntityQuery {
+	query := (&EntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(entity.Table, entity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, file.PrimaryEntityTable, file.PrimaryEntityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVersions queries the versions edge of a File.
+func (c *FileClient) QueryVersions(_m *File) *FileEntityQuery {
+	query := (&FileEntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(fileentity.Table, fileentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.VersionsTable, file.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDirectLink queries the direct_link edge of a File.
+func (c *FileClient) QueryDirectLink(_m *File) *DirectLinkQuery {
+	query := (&DirectLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(directlink.Table, directlink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, file.DirectLinkTable, file.DirectLinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMetadata queries the metadata edge of a File.
+func (c *FileClient) QueryMetadata(_m *File) *MetadataQuery {
+	query := (&MetadataClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(metadata.Table, metadata.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.MetadataTable, file.MetadataColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileClient) Hooks() []Hook {
+	hooks := c.hooks.File
+	return append(hooks[:len(hooks):len(hooks)], file.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileClient) Interceptors() []Interceptor {
+	return c.inters.File
+}
+
+func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown File mutation op: %q", m.Op())
+	}
+}
+
+// FileEntityClient is a client for the FileEntity schema.
+type FileEntityClient struct {
+	config
+}
+
+// NewFileEntityClient returns a client for the FileEntity from the given config.
+func NewFileEntityClient(c config) *FileEntityClient {
+	return &FileEntityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileentity.Hooks(f(g(h())))`.
+func (c *FileEntityClient) Use(hooks ...Hook) {
+	c.hooks.FileEntity = append(c.hooks.FileEntity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fileentity.Intercept(f(g(h())))`.
+func (c *FileEntityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileEntity = append(c.inters.FileEntity, interceptors...)
+}
+
+// Create returns a builder for creating a FileEntity entity.
+func (c *FileEntityClient) Create() *FileEntityCreate {
+	mutation := newFileEntityMutation(c.config, OpCreate)
+	return &FileEntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileEntity entities.
+func (c *FileEntityClient) CreateBulk(builders ...*FileEntityCreate) *FileEntityCreateBulk {
+	return &FileEntityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileEntityClient) MapCreateBulk(slice any, setFunc func(*FileEntityCreate, int)) *FileEntityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileEntityCreateBulk{err: fmt.Errorf("calling to FileEntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileEntityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileEntityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileEntity.
+func (c *FileEntityClient) Update() *FileEntityUpdate {
+	mutation := newFileEntityMutation(c.config, OpUpdate)
+	return &FileEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileEntityClient) UpdateOne(_m *FileEntity) *FileEntityUpdateOne {
+	mutation := newFileEntityMutation(c.config, OpUpdateOne, withFileEntity(_m))
+	return &FileEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileEntityClient) UpdateOneID(id uint) *FileEntityUpdateOne {
+	mutation := newFileEntityMutation(c.config, OpUpdateOne, withFileEntityID(id))
+	return &FileEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileEntity.
+func (c *FileEntityClient) Delete() *FileEntityDelete {
+	mutation := newFileEntityMutation(c.config, OpDelete)
+	return &FileEntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileEntityClient) DeleteOne(_m *FileEntity) *FileEntityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileEntityClient) DeleteOneID(id uint) *FileEntityDeleteOne {
+	builder := c.Delete().Where(fileentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileEntityDeleteOne{builder}
+}
+
+// Query returns a query builder for FileEntity.
+func (c *FileEntityClient) Query() *FileEntityQuery {
+	return &FileEntityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileEntity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileEntity entity by its id.
+func (c *FileEntityClient) Get(ctx context.Context, id uint) (*FileEntity, error) {
+	return c.Query().Where(fileentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileEntityClient) GetX(ctx context.Context, id uint) *FileEntity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a FileEntity.
+func (c *FileEntityClient) QueryFile(_m *FileEntity) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileentity.Table, fileentity.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileentity.FileTable, fileentity.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntity queries the entity edge of a FileEntity.
+func (c *FileEntityClient) QueryEntity(_m *FileEntity) *EntityQuery {
+	query := (&EntityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileentity.Table, fileentity.FieldID, id),
+			sqlgraph.To(entity.Table, entity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileentity.EntityTable, fileentity.EntityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileEntityClient) Hooks() []Hook {
+	hooks := c.hooks.FileEntity
+	return append(hooks[:len(hooks):len(hooks)], fileentity.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileEntityClient) Interceptors() []Interceptor {
+	return c.inters.FileEntity
+}
+
+func (c *FileEntityClient) mutate(ctx context.Context, m *FileEntityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileEntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileEntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileEntity mutation op: %q", m.Op())
+	}
+}
+
+// InvitationCodeClient is a client for the InvitationCode schema.
+type InvitationCodeClient struct {
+	config
+}
+
+// NewInvitationCodeClient returns a client for the InvitationCode from the given config.
+func NewInvitationCodeClient(c config) *InvitationCodeClient {
+	return &InvitationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitationcode.Hooks(f(g(h())))`.
+func (c *InvitationCodeClient) Use(hooks ...Hook) {
+	c.hooks.InvitationCode = append(c.hooks.InvitationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitationcode.Intercept(f(g(h())))`.
+func (c *InvitationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvitationCode = append(c.inters.InvitationCode, interceptors...)
+}
+
+// Create returns a builder for creating a InvitationCode entity.
+func (c *InvitationCodeClient) Create() *InvitationCodeCreate {
+	mutation := newInvitationCodeMutation(c.config, OpCreate)
+	return &InvitationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvitationCode entities.
+func (c *InvitationCodeClient) CreateBulk(builders ...*InvitationCodeCreate) *InvitationCodeCreateBulk {
+	return &InvitationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationCodeClient) MapCreateBulk(slice any, setFunc func(*InvitationCodeCreate, int)) *InvitationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCodeCreateBulk{err: fmt.Errorf("calling to InvitationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvitationCode.
+func (c *InvitationCodeClient) Update() *InvitationCodeUpdate {
+	mutation := newInvitationCodeMutation(c.config, OpUpdate)
+	return &InvitationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationCodeClient) UpdateOne(_m *InvitationCode) *InvitationCodeUpdateOne {
+	mutation := newInvitationCodeMutation(c.config, OpUpdateOne, withInvitationCode(_m))
+	return &InvitationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationCodeClient) UpdateOneID(id uint) *InvitationCodeUpdateOne {
+	mutation := newInvitationCodeMutation(c.config, OpUpdateOne, withInvitationCodeID(id))
+	return &InvitationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvitationCode.
+func (c *InvitationCodeClient) Delete() *InvitationCodeDelete {
+	mutation := newInvitationCodeMutation(c.config, OpDelete)
+	return &InvitationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationCodeClient) DeleteOne(_m *InvitationCode) *InvitationCodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationCodeClient) DeleteOneID(id uint) *InvitationCodeDeleteOne {
+	builder := c.Delete().Where(invitationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for InvitationCode.
+func (c *InvitationCodeClient) Query() *InvitationCodeQuery {
+	return &InvitationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvitationCode entity by its id.
+func (c *InvitationCodeClient) Get(ctx context.Context, id uint) (*InvitationCode, error) {
+	return c.Query().Where(invitationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationCodeClient) GetX(ctx context.Context, id uint) *InvitationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationCodeClient) Hooks() []Hook {
+	return c.hooks.InvitationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationCodeClient) Interceptors() []Interceptor {
+	return c.inters.InvitationCode
+}
+
+func (c *InvitationCodeClient) mutate(ctx context.Context, m *InvitationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvitationCode mutation op: %q", m.Op())
+	}
+}
+
+// LinkClient is a client for the Link schema.
+type LinkClient struct {
+	config
+}
+
+// NewLinkClient returns a client for the Link from the given config.
+func NewLinkClient(c config) *LinkClient {
+	return &LinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `link.Hooks(f(g(h())))`.
+func (c *LinkClient) Use(hooks ...Hook) {
+	c.hooks.Link = append(c.hooks.Link, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `link.Intercept(f(g(h())))`.
+func (c *LinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Link = append(c.inters.Link, interceptors...)
+}
+
+// Create returns a builder for creating a Link entity.
+func (c *LinkClient) Create() *LinkCreate {
+	mutation := newLinkMutation(c.config, OpCreate)
+	return &LinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Link entities.
+func (c *LinkClient) CreateBulk(builders ...*LinkCreate) *LinkCreateBulk {
+	return &LinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkClient) MapCreateBulk(slice any, setFunc func(*LinkCreate, int)) *LinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCreateBulk{err: fmt.Errorf("calling to LinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Link.
+func (c *LinkClient) Update() *LinkUpdate {
+	mutation := newLinkMutation(c.config, OpUpdate)
+	return &LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkClient) UpdateOne(_m *Link) *LinkUpdateOne {
+	mutation := newLinkMutation(c.config, OpUpdateOne, withLink(_m))
+	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkClient) UpdateOneID(id int) *LinkUpdateOne {
+	mutation := newLinkMutation(c.config, OpUpdateOne, withLinkID(id))
+	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Link.
+func (c *LinkClient) Delete() *LinkDelete {
+	mutation := newLinkMutation(c.config, OpDelete)
+	return &LinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkClient) DeleteOne(_m *Link) *LinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkClient) DeleteOneID(id int) *LinkDeleteOne {
+	builder := c.Delete().Where(link.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkDeleteOne{builder}
+}
+
+// Query returns a query builder for Link.
+func (c *LinkClient) Query() *LinkQuery {
+	return &LinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Link entity by its id.
+func (c *LinkClient) Get(ctx context.Context, id int) (*Link, error) {
+	return c.Query().Where(link.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkClient) GetX(ctx context.Context, id int) *Link {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCategory queries the category edge of a Link.
+func (c *LinkClient) QueryCategory(_m *Link) *LinkCategoryQuery {
+	query := (&LinkCategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(linkcategory.Table, linkcategory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, link.CategoryTable, link.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Link.
+func (c *LinkClient) QueryTags(_m *Link) *LinkTagQuery {
+	query := (&LinkTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(link.Table, link.FieldID, id),
+			sqlgraph.To(linktag.Table, linktag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, link.TagsTable, link.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkClient) Hooks() []Hook {
+	return c.hooks.Link
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkClient) Interceptors() []Interceptor {
+	return c.inters.Link
+}
+
+func (c *LinkClient) mutate(ctx context.Context, m *LinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Link mutation op: %q", m.Op())
+	}
+}
+
+// LinkCategoryClient is a client for the LinkCategory schema.
+type LinkCategoryClient struct {
+	config
+}
+
+// NewLinkCategoryClient returns a client for the LinkCategory from the given config.
+func NewLinkCategoryClient(c config) *LinkCategoryClient {
+	return &LinkCategoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkcategory.Hooks(f(g(h())))`.
+func (c *LinkCategoryClient) Use(hooks ...Hook) {
+	c.hooks.LinkCategory = append(c.hooks.LinkCategory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkcategory.Intercept(f(g(h())))`.
+func (c *LinkCategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkCategory = append(c.inters.LinkCategory, interceptors...)
+}
+
+// Create returns a builder for creating a LinkCategory entity.
+func (c *LinkCategoryClient) Create() *LinkCategoryCreate {
+	mutation := newLinkCategoryMutation(c.config, OpCreate)
+	return &LinkCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkCategory entities.
+func (c *LinkCategoryClient) CreateBulk(builders ...*LinkCategoryCreate) *LinkCategoryCreateBulk {
+	return &LinkCategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkCategoryClient) MapCreateBulk(slice any, setFunc func(*LinkCategoryCreate, int)) *LinkCategoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCategoryCreateBulk{err: fmt.Errorf("calling to LinkCategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCategoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkCategory.
+func (c *LinkCategoryClient) Update() *LinkCategoryUpdate {
+	mutation := newLinkCategoryMutation(c.config, OpUpdate)
+	return &LinkCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkCategoryClient) UpdateOne(_m *LinkCategory) *LinkCategoryUpdateOne {
+	mutation := newLinkCategoryMutation(c.config, OpUpdateOne, withLinkCategory(_m))
+	return &LinkCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkCategoryClient) UpdateOneID(id int) *LinkCategoryUpdateOne {
+	mutation := newLinkCategoryMutation(c.config, OpUpdateOne, withLinkCategoryID(id))
+	return &LinkCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkCategory.
+func (c *LinkCategoryClient) Delete() *LinkCategoryDelete {
+	mutation := newLinkCategoryMutation(c.config, OpDelete)
+	return &LinkCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkCategoryClient) DeleteOne(_m *LinkCategory) *LinkCategoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkCategoryClient) DeleteOneID(id int) *LinkCategoryDeleteOne {
+	builder := c.Delete().Where(linkcategory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkCategoryDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkCategory.
+func (c *LinkCategoryClient) Query() *LinkCategoryQuery {
+	return &LinkCategoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkCategory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkCategory entity by its id.
+func (c *LinkCategoryClient) Get(ctx context.Context, id int) (*LinkCategory, error) {
+	return c.Query().Where(linkcategory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkCategoryClient) GetX(ctx context.Context, id int) *LinkCategory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLinks queries the links edge of a LinkCategory.
+func (c *LinkCategoryClient) QueryLinks(_m *LinkCategory) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkcategory.Table, linkcategory.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkcategory.LinksTable, linkcategory.LinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkCategoryClient) Hooks() []Hook {
+	return c.hooks.LinkCategory
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkCategoryClient) Interceptors() []Interceptor {
+	return c.inters.LinkCategory
+}
+
+func (c *LinkCategoryClient) mutate(ctx context.Context, m *LinkCategoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkCategory mutation op: %q", m.Op())
+	}
+}
+
+// LinkCheckRecordClient is a client for the LinkCheckRecord schema.
+type LinkCheckRecordClient struct {
+	config
+}
+
+// NewLinkCheckRecordClient returns a client for the LinkCheckRecord from the given config.
+func NewLinkCheckRecordClient(c config) *LinkCheckRecordClient {
+	return &LinkCheckRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkcheckrecord.Hooks(f(g(h())))`.
+func (c *LinkCheckRecordClient) Use(hooks ...Hook) {
+	c.hooks.LinkCheckRecord = append(c.hooks.LinkCheckRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkcheckrecord.Intercept(f(g(h())))`.
+func (c *LinkCheckRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkCheckRecord = append(c.inters.LinkCheckRecord, interceptors...)
+}
+
+// Create returns a builder for creating a LinkCheckRecord entity.
+func (c *LinkCheckRecordClient) Create() *LinkCheckRecordCreate {
+	mutation := newLinkCheckRecordMutation(c.config, OpCreate)
+	return &LinkCheckRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkCheckRecord entities.
+func (c *LinkCheckRecordClient) CreateBulk(builders ...*LinkCheckRecordCreate) *LinkCheckRecordCreateBulk {
+	return &LinkCheckRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkCheckRecordClient) MapCreateBulk(slice any, setFunc func(*LinkCheckRecordCreate, int)) *LinkCheckRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCheckRecordCreateBulk{err: fmt.Errorf("calling to LinkCheckRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCheckRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCheckRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkCheckRecord.
+func (c *LinkCheckRecordClient) Update() *LinkCheckRecordUpdate {
+	mutation := newLinkCheckRecordMutation(c.config, OpUpdate)
+	return &LinkCheckRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkCheckRecordClient) UpdateOne(_m *LinkCheckRecord) *LinkCheckRecordUpdateOne {
+	mutation := newLinkCheckRecordMutation(c.config, OpUpdateOne, withLinkCheckRecord(_m))
+	return &LinkCheckRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkCheckRecordClient) UpdateOneID(id int) *LinkCheckRecordUpdateOne {
+	mutation := newLinkCheckRecordMutation(c.config, OpUpdateOne, withLinkCheckRecordID(id))
+	return &LinkCheckRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkCheckRecord.
+func (c *LinkCheckRecordClient) Delete() *LinkCheckRecordDelete {
+	mutation := newLinkCheckRecordMutation(c.config, OpDelete)
+	return &LinkCheckRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkCheckRecordClient) DeleteOne(_m *LinkCheckRecord) *LinkCheckRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkCheckRecordClient) DeleteOneID(id int) *LinkCheckRecordDeleteOne {
+	builder := c.Delete().Where(linkcheckrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkCheckRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkCheckRecord.
+func (c *LinkCheckRecordClient) Query() *LinkCheckRecordQuery {
+	return &LinkCheckRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkCheckRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkCheckRecord entity by its id.
+func (c *LinkCheckRecordClient) Get(ctx context.Context, id int) (*LinkCheckRecord, error) {
+	return c.Query().Where(linkcheckrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkCheckRecordClient) GetX(ctx context.Context, id int) *LinkCheckRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LinkCheckRecordClient) Hooks() []Hook {
+	return c.hooks.LinkCheckRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkCheckRecordClient) Interceptors() []Interceptor {
+	return c.inters.LinkCheckRecord
+}
+
+func (c *LinkCheckRecordClient) mutate(ctx context.Context, m *LinkCheckRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkCheckRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkCheckRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkCheckRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkCheckRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkCheckRecord mutation op: %q", m.Op())
+	}
+}
+
+// LinkTagClient is a client for the LinkTag schema.
+type LinkTagClient struct {
+	config
+}
+
+// NewLinkTagClient returns a client for the LinkTag from the given config.
+func NewLinkTagClient(c config) *LinkTagClient {
+	return &LinkTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linktag.Hooks(f(g(h())))`.
+func (c *LinkTagClient) Use(hooks ...Hook) {
+	c.hooks.LinkTag = append(c.hooks.LinkTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linktag.Intercept(f(g(h())))`.
+func (c *LinkTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkTag = append(c.inters.LinkTag, interceptors...)
+}
+
+// Create returns a builder for creating a LinkTag entity.
+func (c *LinkTagClient) Create() *LinkTagCreate {
+	mutation := newLinkTagMutation(c.config, OpCreate)
+	return &LinkTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkTag entities.
+func (c *LinkTagClient) CreateBulk(builders ...*LinkTagCreate) *LinkTagCreateBulk {
+	return &LinkTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkTagClient) MapCreateBulk(slice any, setFunc func(*LinkTagCreate, int)) *LinkTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkTagCreateBulk{err: fmt.Errorf("calling to LinkTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkTag.
+func (c *LinkTagClient) Update() *LinkTagUpdate {
+	mutation := newLinkTagMutation(c.config, OpUpdate)
+	return &LinkTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkTagClient) UpdateOne(_m *LinkTag) *LinkTagUpdateOne {
+	mutation := newLinkTagMutation(c.config, OpUpdateOne, withLinkTag(_m))
+	return &LinkTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkTagClient) UpdateOneID(id int) *LinkTagUpdateOne {
+	mutation := newLinkTagMutation(c.config, OpUpdateOne, withLinkTagID(id))
+	return &LinkTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkTag.
+func (c *LinkTagClient) Delete() *LinkTagDelete {
+	mutation := newLinkTagMutation(c.config, OpDelete)
+	return &LinkTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkTagClient) DeleteOne(_m *LinkTag) *LinkTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkTagClient) DeleteOneID(id int) *LinkTagDeleteOne {
+	builder := c.Delete().Where(linktag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkTagDeleteOne{builder}
 }
 
-// QueryVersions queries the versions edge of a File.
-func (c *FileClient) QueryVersions(_m *File) *FileEntityQuery {
-	query := (&FileEntityClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(fileentity.Table, fileentity.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, file.VersionsTable, file.VersionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Query returns a query builder for LinkTag.
+func (c *LinkTagClient) Query() *LinkTagQuery {
+	return &LinkTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkTag},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryDirectLink queries the direct_link edge of a File.
-func (c *FileClient) QueryDirectLink(_m *File) *DirectLinkQuery {
-	query := (&DirectLinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(directlink.Table, directlink.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, file.DirectLinkTable, file.DirectLinkColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a LinkTag entity by its id.
+func (c *LinkTagClient) Get(ctx context.Context, id int) (*LinkTag, error) {
+	return c.Query().Where(linktag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkTagClient) GetX(ctx context.Context, id int) *LinkTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryMetadata queries the metadata edge of a File.
-func (c *FileClient) QueryMetadata(_m *File) *MetadataQuery {
-	query := (&MetadataClient{config: c.config}).Query()
+// QueryLinks queries the links edge of a LinkTag.
+func (c *LinkTagClient) QueryLinks(_m *LinkTag) *LinkQuery {
+	query := (&LinkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(metadata.Table, metadata.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, file.MetadataTable, file.MetadataColumn),
+			sqlgraph.From(linktag.Table, linktag.FieldID, id),
+			sqlgraph.To(link.Table, link.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, linktag.LinksTable, linktag.LinksPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1950,132 +4797,131 @@ func (c *FileClient) QueryMetadata(_m *File) *MetadataQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *FileClient) Hooks() []Hook {
-	hooks := c.hooks.File
-	return append(hooks[:len(hooks):len(hooks)], file.Hooks[:]...)
+func (c *LinkTagClient) Hooks() []Hook {
+	return c.hooks.LinkTag
 }
 
 // Interceptors returns the client interceptors.
-func (c *FileClient) Interceptors() []Interceptor {
-	return c.inters.File
+func (c *LinkTagClient) Interceptors() []Interceptor {
+	return c.inters.LinkTag
 }
 
-func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error) {
+func (c *LinkTagClient) mutate(ctx context.Context, m *LinkTagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LinkTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LinkTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LinkTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LinkTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown File mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LinkTag mutation op: %q", m.Op())
 	}
 }
 
-// FileEntityClient is a client for the FileEntity schema.
-type FileEntityClient struct {
+// MailTemplateVersionClient is a client for the MailTemplateVersion schema.
+type MailTemplateVersionClient struct {
 	config
 }
 
-// NewFileEntityClient returns a client for the FileEntity from the given config.
-func NewFileEntityClient(c config) *FileEntityClient {
-	return &FileEntityClient{config: c}
+// NewMailTemplateVersionClient returns a client for the MailTemplateVersion from the given config.
+func NewMailTemplateVersionClient(c config) *MailTemplateVersionClient {
+	return &MailTemplateVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `fileentity.Hooks(f(g(h())))`.
-func (c *FileEntityClient) Use(hooks ...Hook) {
-	c.hooks.FileEntity = append(c.hooks.FileEntity, hooks...)
+// A call to `Use(f, g, h)` equals to `mailtemplateversion.Hooks(f(g(h())))`.
+func (c *MailTemplateVersionClient) Use(hooks ...Hook) {
+	c.hooks.MailTemplateVersion = append(c.hooks.MailTemplateVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `fileentity.Intercept(f(g(h())))`.
-func (c *FileEntityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FileEntity = append(c.inters.FileEntity, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `mailtemplateversion.Intercept(f(g(h())))`.
+func (c *MailTemplateVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MailTemplateVersion = append(c.inters.MailTemplateVersion, interceptors...)
 }
 
-// Create returns a builder for creating a FileEntity entity.
-func (c *FileEntityClient) Create() *FileEntityCreate {
-	mutation := newFileEntityMutation(c.config, OpCreate)
-	return &FileEntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MailTemplateVersion entity.
+func (c *MailTemplateVersionClient) Create() *MailTemplateVersionCreate {
+	mutation := newMailTemplateVersionMutation(c.config, OpCreate)
+	return &MailTemplateVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FileEntity entities.
-func (c *FileEntityClient) CreateBulk(builders ...*FileEntityCreate) *FileEntityCreateBulk {
-	return &FileEntityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MailTemplateVersion entities.
+func (c *MailTemplateVersionClient) CreateBulk(builders ...*MailTemplateVersionCreate) *MailTemplateVersionCreateBulk {
+	return &MailTemplateVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FileEntityClient) MapCreateBulk(slice any, setFunc func(*FileEntityCreate, int)) *FileEntityCreateBulk {
+func (c *MailTemplateVersionClient) MapCreateBulk(slice any, setFunc func(*MailTemplateVersionCreate, int)) *MailTemplateVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FileEntityCreateBulk{err: fmt.Errorf("calling to FileEntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MailTemplateVersionCreateBulk{err: fmt.Errorf("calling to MailTemplateVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FileEntityCreate, rv.Len())
+	builders := make([]*MailTemplateVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FileEntityCreateBulk{config: c.config, builders: builders}
+	return &MailTemplateVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FileEntity.
-func (c *FileEntityClient) Update() *FileEntityUpdate {
-	mutation := newFileEntityMutation(c.config, OpUpdate)
-	return &FileEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MailTemplateVersion.
+func (c *MailTemplateVersionClient) Update() *MailTemplateVersionUpdate {
+	mutation := newMailTemplateVersionMutation(c.config, OpUpdate)
+	return &MailTemplateVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FileEntityClient) UpdateOne(_m *FileEntity) *FileEntityUpdateOne {
-	mutation := newFileEntityMutation(c.config, OpUpdateOne, withFileEntity(_m))
-	return &FileEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MailTemplateVersionClient) UpdateOne(_m *MailTemplateVersion) *MailTemplateVersionUpdateOne {
+	mutation := newMailTemplateVersionMutation(c.config, OpUpdateOne, withMailTemplateVersion(_m))
+	return &MailTemplateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FileEntityClient) UpdateOneID(id uint) *FileEntityUpdateOne {
-	mutation := newFileEntityMutation(c.config, OpUpdateOne, withFileEntityID(id))
-	return &FileEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MailTemplateVersionClient) UpdateOneID(id uint) *MailTemplateVersionUpdateOne {
+	mutation := newMailTemplateVersionMutation(c.config, OpUpdateOne, withMailTemplateVersionID(id))
+	return &MailTemplateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FileEntity.
-func (c *FileEntityClient) Delete() *FileEntityDelete {
-	mutation := newFileEntityMutation(c.config, OpDelete)
-	return &FileEntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MailTemplateVersion.
+func (c *MailTemplateVersionClient) Delete() *MailTemplateVersionDelete {
+	mutation := newMailTemplateVersionMutation(c.config, OpDelete)
+	return &MailTemplateVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FileEntityClient) DeleteOne(_m *FileEntity) *FileEntityDeleteOne {
+func (c *MailTemplateVersionClient) DeleteOne(_m *MailTemplateVersion) *MailTemplateVersionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FileEntityClient) DeleteOneID(id uint) *FileEntityDeleteOne {
-	builder := c.Delete().Where(fileentity.ID(id))
+func (c *MailTemplateVersionClient) DeleteOneID(id uint) *MailTemplateVersionDeleteOne {
+	builder := c.Delete().Where(mailtemplateversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FileEntityDeleteOne{builder}
+	return &MailTemplateVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for FileEntity.
-func (c *FileEntityClient) Query() *FileEntityQuery {
-	return &FileEntityQuery{
+// Query returns a query builder for MailTemplateVersion.
+func (c *MailTemplateVersionClient) Query() *MailTemplateVersionQuery {
+	return &MailTemplateVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFileEntity},
+		ctx:    &QueryContext{Type: TypeMailTemplateVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FileEntity entity by its id.
-func (c *FileEntityClient) Get(ctx context.Context, id uint) (*FileEntity, error) {
-	return c.Query().Where(fileentity.ID(id)).Only(ctx)
+// Get returns a MailTemplateVersion entity by its id.
+func (c *MailTemplateVersionClient) Get(ctx context.Context, id uint) (*MailTemplateVersion, error) {
+	return c.Query().Where(mailtemplateversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FileEntityClient) GetX(ctx context.Context, id uint) *FileEntity {
+func (c *MailTemplateVersionClient) GetX(ctx context.Context, id uint) *MailTemplateVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2083,165 +4929,132 @@ func (c *FileEntityClient) GetX(ctx context.Context, id uint) *FileEntity {
 	return obj
 }
 
-// QueryFile queries the file edge of a FileEntity.
-func (c *FileEntityClient) QueryFile(_m *FileEntity) *FileQuery {
-	query := (&FileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fileentity.Table, fileentity.FieldID, id),
-			sqlgraph.To(file.Table, file.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, fileentity.FileTable, fileentity.FileColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEntity queries the entity edge of a FileEntity.
-func (c *FileEntityClient) QueryEntity(_m *FileEntity) *EntityQuery {
-	query := (&EntityClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fileentity.Table, fileentity.FieldID, id),
-			sqlgraph.To(entity.Table, entity.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, fileentity.EntityTable, fileentity.EntityColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *FileEntityClient) Hooks() []Hook {
-	hooks := c.hooks.FileEntity
-	return append(hooks[:len(hooks):len(hooks)], fileentity.Hooks[:]...)
+func (c *MailTemplateVersionClient) Hooks() []Hook {
+	return c.hooks.MailTemplateVersion
 }
 
 // Interceptors returns the client interceptors.
-func (c *FileEntityClient) Interceptors() []Interceptor {
-	return c.inters.FileEntity
+func (c *MailTemplateVersionClient) Interceptors() []Interceptor {
+	return c.inters.MailTemplateVersion
 }
 
-func (c *FileEntityClient) mutate(ctx context.Context, m *FileEntityMutation) (Value, error) {
+func (c *MailTemplateVersionClient) mutate(ctx context.Context, m *MailTemplateVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FileEntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MailTemplateVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FileEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MailTemplateVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FileEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MailTemplateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FileEntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MailTemplateVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FileEntity mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MailTemplateVersion mutation op: %q", m.Op())
 	}
 }
 
-// LinkClient is a client for the Link schema.
-type LinkClient struct {
+// MetadataClient is a client for the Metadata schema.
+type MetadataClient struct {
 	config
 }
 
-// NewLinkClient returns a client for the Link from the given config.
-func NewLinkClient(c config) *LinkClient {
-	return &LinkClient{config: c}
+// NewMetadataClient returns a client for the Metadata from the given config.
+func NewMetadataClient(c config) *MetadataClient {
+	return &MetadataClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `link.Hooks(f(g(h())))`.
-func (c *LinkClient) Use(hooks ...Hook) {
-	c.hooks.Link = append(c.hooks.Link, hooks...)
+// A call to `Use(f, g, h)` equals to `metadata.Hooks(f(g(h())))`.
+func (c *MetadataClient) Use(hooks ...Hook) {
+	c.hooks.Metadata = append(c.hooks.Metadata, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `link.Intercept(f(g(h())))`.
-func (c *LinkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Link = append(c.inters.Link, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `metadata.Intercept(f(g(h())))`.
+func (c *MetadataClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Metadata = append(c.inters.Metadata, interceptors...)
 }
 
-// Create returns a builder for creating a Link entity.
-func (c *LinkClient) Create() *LinkCreate {
-	mutation := newLinkMutation(c.config, OpCreate)
-	return &LinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Metadata entity.
+func (c *MetadataClient) Create() *MetadataCreate {
+	mutation := newMetadataMutation(c.config, OpCreate)
+	return &MetadataCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Link entities.
-func (c *LinkClient) CreateBulk(builders ...*LinkCreate) *LinkCreateBulk {
-	return &LinkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Metadata entities.
+func (c *MetadataClient) CreateBulk(builders ...*MetadataCreate) *MetadataCreateBulk {
+	return &MetadataCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LinkClient) MapCreateBulk(slice any, setFunc func(*LinkCreate, int)) *LinkCreateBulk {
+func (c *MetadataClient) MapCreateBulk(slice any, setFunc func(*MetadataCreate, int)) *MetadataCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LinkCreateBulk{err: fmt.Errorf("calling to LinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MetadataCreateBulk{err: fmt.Errorf("calling to MetadataClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LinkCreate, rv.Len())
+	builders := make([]*MetadataCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LinkCreateBulk{config: c.config, builders: builders}
+	return &MetadataCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Link.
-func (c *LinkClient) Update() *LinkUpdate {
-	mutation := newLinkMutation(c.config, OpUpdate)
-	return &LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Metadata.
+func (c *MetadataClient) Update() *MetadataUpdate {
+	mutation := newMetadataMutation(c.config, OpUpdate)
+	return &MetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LinkClient) UpdateOne(_m *Link) *LinkUpdateOne {
-	mutation := newLinkMutation(c.config, OpUpdateOne, withLink(_m))
-	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MetadataClient) UpdateOne(_m *Metadata) *MetadataUpdateOne {
+	mutation := newMetadataMutation(c.config, OpUpdateOne, withMetadata(_m))
+	return &MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LinkClient) UpdateOneID(id int) *LinkUpdateOne {
-	mutation := newLinkMutation(c.config, OpUpdateOne, withLinkID(id))
-	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MetadataClient) UpdateOneID(id uint) *MetadataUpdateOne {
+	mutation := newMetadataMutation(c.config, OpUpdateOne, withMetadataID(id))
+	return &MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Link.
-func (c *LinkClient) Delete() *LinkDelete {
-	mutation := newLinkMutation(c.config, OpDelete)
-	return &LinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Metadata.
+func (c *MetadataClient) Delete() *MetadataDelete {
+	mutation := newMetadataMutation(c.config, OpDelete)
+	return &MetadataDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LinkClient) DeleteOne(_m *Link) *LinkDeleteOne {
+func (c *MetadataClient) DeleteOne(_m *Metadata) *MetadataDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LinkClient) DeleteOneID(id int) *LinkDeleteOne {
-	builder := c.Delete().Where(link.ID(id))
+func (c *MetadataClient) DeleteOneID(id uint) *MetadataDeleteOne {
+	builder := c.Delete().Where(metadata.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LinkDeleteOne{builder}
+	return &MetadataDeleteOne{builder}
 }
 
-// Query returns a query builder for Link.
-func (c *LinkClient) Query() *LinkQuery {
-	return &LinkQuery{
+// Query returns a query builder for Metadata.
+func (c *MetadataClient) Query() *MetadataQuery {
+	return &MetadataQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLink},
+		ctx:    &QueryContext{Type: TypeMetadata},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Link entity by its id.
-func (c *LinkClient) Get(ctx context.Context, id int) (*Link, error) {
-	return c.Query().Where(link.ID(id)).Only(ctx)
+// Get returns a Metadata entity by its id.
+func (c *MetadataClient) Get(ctx context.Context, id uint) (*Metadata, error) {
+	return c.Query().Where(metadata.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LinkClient) GetX(ctx context.Context, id int) *Link {
+func (c *MetadataClient) GetX(ctx context.Context, id uint) *Metadata {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2249,31 +5062,15 @@ func (c *LinkClient) GetX(ctx context.Context, id int) *Link {
 	return obj
 }
 
-// QueryCategory queries the category edge of a Link.
-func (c *LinkClient) QueryCategory(_m *Link) *LinkCategoryQuery {
-	query := (&LinkCategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(linkcategory.Table, linkcategory.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, link.CategoryTable, link.CategoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTags queries the tags edge of a Link.
-func (c *LinkClient) QueryTags(_m *Link) *LinkTagQuery {
-	query := (&LinkTagClient{config: c.config}).Query()
+// QueryFile queries the file edge of a Metadata.
+func (c *MetadataClient) QueryFile(_m *Metadata) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(link.Table, link.FieldID, id),
-			sqlgraph.To(linktag.Table, linktag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, link.TagsTable, link.TagsPrimaryKey...),
+			sqlgraph.From(metadata.Table, metadata.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, metadata.FileTable, metadata.FileColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2282,131 +5079,132 @@ func (c *LinkClient) QueryTags(_m *Link) *LinkTagQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LinkClient) Hooks() []Hook {
-	return c.hooks.Link
+func (c *MetadataClient) Hooks() []Hook {
+	hooks := c.hooks.Metadata
+	return append(hooks[:len(hooks):len(hooks)], metadata.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *LinkClient) Interceptors() []Interceptor {
-	return c.inters.Link
+func (c *MetadataClient) Interceptors() []Interceptor {
+	return c.inters.Metadata
 }
 
-func (c *LinkClient) mutate(ctx context.Context, m *LinkMutation) (Value, error) {
+func (c *MetadataClient) mutate(ctx context.Context, m *MetadataMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MetadataCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MetadataDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Link mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Metadata mutation op: %q", m.Op())
 	}
 }
 
-// LinkCategoryClient is a client for the LinkCategory schema.
-type LinkCategoryClient struct {
+// MomentClient is a client for the Moment schema.
+type MomentClient struct {
 	config
 }
 
-// NewLinkCategoryClient returns a client for the LinkCategory from the given config.
-func NewLinkCategoryClient(c config) *LinkCategoryClient {
-	return &LinkCategoryClient{config: c}
+// NewMomentClient returns a client for the Moment from the given config.
+func NewMomentClient(c config) *MomentClient {
+	return &MomentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `linkcategory.Hooks(f(g(h())))`.
-func (c *LinkCategoryClient) Use(hooks ...Hook) {
-	c.hooks.LinkCategory = append(c.hooks.LinkCategory, hooks...)
+// A call to `Use(f, g, h)` equals to `moment.Hooks(f(g(h())))`.
+func (c *MomentClient) Use(hooks ...Hook) {
+	c.hooks.Moment = append(c.hooks.Moment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `linkcategory.Intercept(f(g(h())))`.
-func (c *LinkCategoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LinkCategory = append(c.inters.LinkCategory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `moment.Intercept(f(g(h())))`.
+func (c *MomentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Moment = append(c.inters.Moment, interceptors...)
 }
 
-// Create returns a builder for creating a LinkCategory entity.
-func (c *LinkCategoryClient) Create() *LinkCategoryCreate {
-	mutation := newLinkCategoryMutation(c.config, OpCreate)
-	return &LinkCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Moment entity.
+func (c *MomentClient) Create() *MomentCreate {
+	mutation := newMomentMutation(c.config, OpCreate)
+	return &MomentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LinkCategory entities.
-func (c *LinkCategoryClient) CreateBulk(builders ...*LinkCategoryCreate) *LinkCategoryCreateBulk {
-	return &LinkCategoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Moment entities.
+func (c *MomentClient) CreateBulk(builders ...*MomentCreate) *MomentCreateBulk {
+	return &MomentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LinkCategoryClient) MapCreateBulk(slice any, setFunc func(*LinkCategoryCreate, int)) *LinkCategoryCreateBulk {
+func (c *MomentClient) MapCreateBulk(slice any, setFunc func(*MomentCreate, int)) *MomentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LinkCategoryCreateBulk{err: fmt.Errorf("calling to LinkCategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MomentCreateBulk{err: fmt.Errorf("calling to MomentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LinkCategoryCreate, rv.Len())
+	builders := make([]*MomentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LinkCategoryCreateBulk{config: c.config, builders: builders}
+	return &MomentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LinkCategory.
-func (c *LinkCategoryClient) Update() *LinkCategoryUpdate {
-	mutation := newLinkCategoryMutation(c.config, OpUpdate)
-	return &LinkCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Moment.
+func (c *MomentClient) Update() *MomentUpdate {
+	mutation := newMomentMutation(c.config, OpUpdate)
+	return &MomentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LinkCategoryClient) UpdateOne(_m *LinkCategory) *LinkCategoryUpdateOne {
-	mutation := newLinkCategoryMutation(c.config, OpUpdateOne, withLinkCategory(_m))
-	return &LinkCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MomentClient) UpdateOne(_m *Moment) *MomentUpdateOne {
+	mutation := newMomentMutation(c.config, OpUpdateOne, withMoment(_m))
+	return &MomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LinkCategoryClient) UpdateOneID(id int) *LinkCategoryUpdateOne {
-	mutation := newLinkCategoryMutation(c.config, OpUpdateOne, withLinkCategoryID(id))
-	return &LinkCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MomentClient) UpdateOneID(id uint) *MomentUpdateOne {
+	mutation := newMomentMutation(c.config, OpUpdateOne, withMomentID(id))
+	return &MomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LinkCategory.
-func (c *LinkCategoryClient) Delete() *LinkCategoryDelete {
-	mutation := newLinkCategoryMutation(c.config, OpDelete)
-	return &LinkCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Moment.
+func (c *MomentClient) Delete() *MomentDelete {
+	mutation := newMomentMutation(c.config, OpDelete)
+	return &MomentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LinkCategoryClient) DeleteOne(_m *LinkCategory) *LinkCategoryDeleteOne {
+func (c *MomentClient) DeleteOne(_m *Moment) *MomentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LinkCategoryClient) DeleteOneID(id int) *LinkCategoryDeleteOne {
-	builder := c.Delete().Where(linkcategory.ID(id))
+func (c *MomentClient) DeleteOneID(id uint) *MomentDeleteOne {
+	builder := c.Delete().Where(moment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LinkCategoryDeleteOne{builder}
+	return &MomentDeleteOne{builder}
 }
 
-// Query returns a query builder for LinkCategory.
-func (c *LinkCategoryClient) Query() *LinkCategoryQuery {
-	return &LinkCategoryQuery{
+// Query returns a query builder for Moment.
+func (c *MomentClient) Query() *MomentQuery {
+	return &MomentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLinkCategory},
+		ctx:    &QueryContext{Type: TypeMoment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LinkCategory entity by its id.
-func (c *LinkCategoryClient) Get(ctx context.Context, id int) (*LinkCategory, error) {
-	return c.Query().Where(linkcategory.ID(id)).Only(ctx)
+// Get returns a Moment entity by its id.
+func (c *MomentClient) Get(ctx context.Context, id uint) (*Moment, error) {
+	return c.Query().Where(moment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LinkCategoryClient) GetX(ctx context.Context, id int) *LinkCategory {
+func (c *MomentClient) GetX(ctx context.Context, id uint) *Moment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2414,148 +5212,132 @@ func (c *LinkCategoryClient) GetX(ctx context.Context, id int) *LinkCategory {
 	return obj
 }
 
-// QueryLinks queries the links edge of a LinkCategory.
-func (c *LinkCategoryClient) QueryLinks(_m *LinkCategory) *LinkQuery {
-	query := (&LinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(linkcategory.Table, linkcategory.FieldID, id),
-			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, linkcategory.LinksTable, linkcategory.LinksColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LinkCategoryClient) Hooks() []Hook {
-	return c.hooks.LinkCategory
+func (c *MomentClient) Hooks() []Hook {
+	return c.hooks.Moment
 }
 
 // Interceptors returns the client interceptors.
-func (c *LinkCategoryClient) Interceptors() []Interceptor {
-	return c.inters.LinkCategory
+func (c *MomentClient) Interceptors() []Interceptor {
+	return c.inters.Moment
 }
 
-func (c *LinkCategoryClient) mutate(ctx context.Context, m *LinkCategoryMutation) (Value, error) {
+func (c *MomentClient) mutate(ctx context.Context, m *MomentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LinkCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MomentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LinkCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MomentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LinkCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MomentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LinkCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MomentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LinkCategory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Moment mutation op: %q", m.Op())
 	}
 }
 
-// LinkTagClient is a client for the LinkTag schema.
-type LinkTagClient struct {
+// MusicPlayStatClient is a client for the MusicPlayStat schema.
+type MusicPlayStatClient struct {
 	config
 }
 
-// NewLinkTagClient returns a client for the LinkTag from the given config.
-func NewLinkTagClient(c config) *LinkTagClient {
-	return &LinkTagClient{config: c}
+// NewMusicPlayStatClient returns a client for the MusicPlayStat from the given config.
+func NewMusicPlayStatClient(c config) *MusicPlayStatClient {
+	return &MusicPlayStatClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `linktag.Hooks(f(g(h())))`.
-func (c *LinkTagClient) Use(hooks ...Hook) {
-	c.hooks.LinkTag = append(c.hooks.LinkTag, hooks...)
+// A call to `Use(f, g, h)` equals to `musicplaystat.Hooks(f(g(h())))`.
+func (c *MusicPlayStatClient) Use(hooks ...Hook) {
+	c.hooks.MusicPlayStat = append(c.hooks.MusicPlayStat, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `linktag.Intercept(f(g(h())))`.
-func (c *LinkTagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LinkTag = append(c.inters.LinkTag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `musicplaystat.Intercept(f(g(h())))`.
+func (c *MusicPlayStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MusicPlayStat = append(c.inters.MusicPlayStat, interceptors...)
 }
 
-// Create returns a builder for creating a LinkTag entity.
-func (c *LinkTagClient) Create() *LinkTagCreate {
-	mutation := newLinkTagMutation(c.config, OpCreate)
-	return &LinkTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MusicPlayStat entity.
+func (c *MusicPlayStatClient) Create() *MusicPlayStatCreate {
+	mutation := newMusicPlayStatMutation(c.config, OpCreate)
+	return &MusicPlayStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LinkTag entities.
-func (c *LinkTagClient) CreateBulk(builders ...*LinkTagCreate) *LinkTagCreateBulk {
-	return &LinkTagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MusicPlayStat entities.
+func (c *MusicPlayStatClient) CreateBulk(builders ...*MusicPlayStatCreate) *MusicPlayStatCreateBulk {
+	return &MusicPlayStatCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LinkTagClient) MapCreateBulk(slice any, setFunc func(*LinkTagCreate, int)) *LinkTagCreateBulk {
+func (c *MusicPlayStatClient) MapCreateBulk(slice any, setFunc func(*MusicPlayStatCreate, int)) *MusicPlayStatCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LinkTagCreateBulk{err: fmt.Errorf("calling to LinkTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MusicPlayStatCreateBulk{err: fmt.Errorf("calling to MusicPlayStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LinkTagCreate, rv.Len())
+	builders := make([]*MusicPlayStatCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LinkTagCreateBulk{config: c.config, builders: builders}
+	return &MusicPlayStatCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LinkTag.
-func (c *LinkTagClient) Update() *LinkTagUpdate {
-	mutation := newLinkTagMutation(c.config, OpUpdate)
-	return &LinkTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MusicPlayStat.
+func (c *MusicPlayStatClient) Update() *MusicPlayStatUpdate {
+	mutation := newMusicPlayStatMutation(c.config, OpUpdate)
+	return &MusicPlayStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LinkTagClient) UpdateOne(_m *LinkTag) *LinkTagUpdateOne {
-	mutation := newLinkTagMutation(c.config, OpUpdateOne, withLinkTag(_m))
-	return &LinkTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MusicPlayStatClient) UpdateOne(_m *MusicPlayStat) *MusicPlayStatUpdateOne {
+	mutation := newMusicPlayStatMutation(c.config, OpUpdateOne, withMusicPlayStat(_m))
+	return &MusicPlayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LinkTagClient) UpdateOneID(id int) *LinkTagUpdateOne {
-	mutation := newLinkTagMutation(c.config, OpUpdateOne, withLinkTagID(id))
-	return &LinkTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MusicPlayStatClient) UpdateOneID(id uint) *MusicPlayStatUpdateOne {
+	mutation := newMusicPlayStatMutation(c.config, OpUpdateOne, withMusicPlayStatID(id))
+	return &MusicPlayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LinkTag.
-func (c *LinkTagClient) Delete() *LinkTagDelete {
-	mutation := newLinkTagMutation(c.config, OpDelete)
-	return &LinkTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MusicPlayStat.
+func (c *MusicPlayStatClient) Delete() *MusicPlayStatDelete {
+	mutation := newMusicPlayStatMutation(c.config, OpDelete)
+	return &MusicPlayStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LinkTagClient) DeleteOne(_m *LinkTag) *LinkTagDeleteOne {
+func (c *MusicPlayStatClient) DeleteOne(_m *MusicPlayStat) *MusicPlayStatDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LinkTagClient) DeleteOneID(id int) *LinkTagDeleteOne {
-	builder := c.Delete().Where(linktag.ID(id))
+func (c *MusicPlayStatClient) DeleteOneID(id uint) *MusicPlayStatDeleteOne {
+	builder := c.Delete().Where(musicplaystat.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LinkTagDeleteOne{builder}
+	return &MusicPlayStatDeleteOne{builder}
 }
 
-// Query returns a query builder for LinkTag.
-func (c *LinkTagClient) Query() *LinkTagQuery {
-	return &LinkTagQuery{
+// Query returns a query builder for MusicPlayStat.
+func (c *MusicPlayStatClient) Query() *MusicPlayStatQuery {
+	return &MusicPlayStatQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLinkTag},
+		ctx:    &QueryContext{Type: TypeMusicPlayStat},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LinkTag entity by its id.
-func (c *LinkTagClient) Get(ctx context.Context, id int) (*LinkTag, error) {
-	return c.Query().Where(linktag.ID(id)).Only(ctx)
+// Get returns a MusicPlayStat entity by its id.
+func (c *MusicPlayStatClient) Get(ctx context.Context, id uint) (*MusicPlayStat, error) {
+	return c.Query().Where(musicplaystat.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LinkTagClient) GetX(ctx context.Context, id int) *LinkTag {
+func (c *MusicPlayStatClient) GetX(ctx context.Context, id uint) *MusicPlayStat {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2563,148 +5345,132 @@ func (c *LinkTagClient) GetX(ctx context.Context, id int) *LinkTag {
 	return obj
 }
 
-// QueryLinks queries the links edge of a LinkTag.
-func (c *LinkTagClient) QueryLinks(_m *LinkTag) *LinkQuery {
-	query := (&LinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(linktag.Table, linktag.FieldID, id),
-			sqlgraph.To(link.Table, link.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, linktag.LinksTable, linktag.LinksPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LinkTagClient) Hooks() []Hook {
-	return c.hooks.LinkTag
+func (c *MusicPlayStatClient) Hooks() []Hook {
+	return c.hooks.MusicPlayStat
 }
 
 // Interceptors returns the client interceptors.
-func (c *LinkTagClient) Interceptors() []Interceptor {
-	return c.inters.LinkTag
+func (c *MusicPlayStatClient) Interceptors() []Interceptor {
+	return c.inters.MusicPlayStat
 }
 
-func (c *LinkTagClient) mutate(ctx context.Context, m *LinkTagMutation) (Value, error) {
+func (c *MusicPlayStatClient) mutate(ctx context.Context, m *MusicPlayStatMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LinkTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MusicPlayStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LinkTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MusicPlayStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LinkTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MusicPlayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LinkTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MusicPlayStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LinkTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MusicPlayStat mutation op: %q", m.Op())
 	}
 }
 
-// MetadataClient is a client for the Metadata schema.
-type MetadataClient struct {
+// NotificationDeliveryClient is a client for the NotificationDelivery schema.
+type NotificationDeliveryClient struct {
 	config
 }
 
-// NewMetadataClient returns a client for the Metadata from the given config.
-func NewMetadataClient(c config) *MetadataClient {
-	return &MetadataClient{config: c}
+// NewNotificationDeliveryClient returns a client for the NotificationDelivery from the given config.
+func NewNotificationDeliveryClient(c config) *NotificationDeliveryClient {
+	return &NotificationDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `metadata.Hooks(f(g(h())))`.
-func (c *MetadataClient) Use(hooks ...Hook) {
-	c.hooks.Metadata = append(c.hooks.Metadata, hooks...)
+// A call to `Use(f, g, h)` equals to `notificationdelivery.Hooks(f(g(h())))`.
+func (c *NotificationDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.NotificationDelivery = append(c.hooks.NotificationDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `metadata.Intercept(f(g(h())))`.
-func (c *MetadataClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Metadata = append(c.inters.Metadata, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notificationdelivery.Intercept(f(g(h())))`.
+func (c *NotificationDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationDelivery = append(c.inters.NotificationDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Metadata entity.
-func (c *MetadataClient) Create() *MetadataCreate {
-	mutation := newMetadataMutation(c.config, OpCreate)
-	return &MetadataCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NotificationDelivery entity.
+func (c *NotificationDeliveryClient) Create() *NotificationDeliveryCreate {
+	mutation := newNotificationDeliveryMutation(c.config, OpCreate)
+	return &NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Metadata entities.
-func (c *MetadataClient) CreateBulk(builders ...*MetadataCreate) *MetadataCreateBulk {
-	return &MetadataCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NotificationDelivery entities.
+func (c *NotificationDeliveryClient) CreateBulk(builders ...*NotificationDeliveryCreate) *NotificationDeliveryCreateBulk {
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MetadataClient) MapCreateBulk(slice any, setFunc func(*MetadataCreate, int)) *MetadataCreateBulk {
+func (c *NotificationDeliveryClient) MapCreateBulk(slice any, setFunc func(*NotificationDeliveryCreate, int)) *NotificationDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MetadataCreateBulk{err: fmt.Errorf("calling to MetadataClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationDeliveryCreateBulk{err: fmt.Errorf("calling to NotificationDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MetadataCreate, rv.Len())
+	builders := make([]*NotificationDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MetadataCreateBulk{config: c.config, builders: builders}
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Metadata.
-func (c *MetadataClient) Update() *MetadataUpdate {
-	mutation := newMetadataMutation(c.config, OpUpdate)
-	return &MetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Update() *NotificationDeliveryUpdate {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdate)
+	return &NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MetadataClient) UpdateOne(_m *Metadata) *MetadataUpdateOne {
-	mutation := newMetadataMutation(c.config, OpUpdateOne, withMetadata(_m))
-	return &MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationDeliveryClient) UpdateOne(_m *NotificationDelivery) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDelivery(_m))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MetadataClient) UpdateOneID(id uint) *MetadataUpdateOne {
-	mutation := newMetadataMutation(c.config, OpUpdateOne, withMetadataID(id))
-	return &MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationDeliveryClient) UpdateOneID(id uint) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDeliveryID(id))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Metadata.
-func (c *MetadataClient) Delete() *MetadataDelete {
-	mutation := newMetadataMutation(c.config, OpDelete)
-	return &MetadataDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Delete() *NotificationDeliveryDelete {
+	mutation := newNotificationDeliveryMutation(c.config, OpDelete)
+	return &NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MetadataClient) DeleteOne(_m *Metadata) *MetadataDeleteOne {
+func (c *NotificationDeliveryClient) DeleteOne(_m *NotificationDelivery) *NotificationDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MetadataClient) DeleteOneID(id uint) *MetadataDeleteOne {
-	builder := c.Delete().Where(metadata.ID(id))
+func (c *NotificationDeliveryClient) DeleteOneID(id uint) *NotificationDeliveryDeleteOne {
+	builder := c.Delete().Where(notificationdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MetadataDeleteOne{builder}
+	return &NotificationDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Metadata.
-func (c *MetadataClient) Query() *MetadataQuery {
-	return &MetadataQuery{
+// Query returns a query builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Query() *NotificationDeliveryQuery {
+	return &NotificationDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMetadata},
+		ctx:    &QueryContext{Type: TypeNotificationDelivery},
 		inters: c.Interceptors(),
 	}
 }
-
-// Get returns a Metadata entity by its id.
-func (c *MetadataClient) Get(ctx context.Context, id uint) (*Metadata, error) {
-	return c.Query().Where(metadata.ID(id)).Only(ctx)
+
+// Get returns a NotificationDelivery entity by its id.
+func (c *NotificationDeliveryClient) Get(ctx context.Context, id uint) (*NotificationDelivery, error) {
+	return c.Query().Where(notificationdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MetadataClient) GetX(ctx context.Context, id uint) *Metadata {
+func (c *NotificationDeliveryClient) GetX(ctx context.Context, id uint) *NotificationDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2712,45 +5478,28 @@ func (c *MetadataClient) GetX(ctx context.Context, id uint) *Metadata {
 	return obj
 }
 
-// QueryFile queries the file edge of a Metadata.
-func (c *MetadataClient) QueryFile(_m *Metadata) *FileQuery {
-	query := (&FileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(metadata.Table, metadata.FieldID, id),
-			sqlgraph.To(file.Table, file.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, metadata.FileTable, metadata.FileColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MetadataClient) Hooks() []Hook {
-	hooks := c.hooks.Metadata
-	return append(hooks[:len(hooks):len(hooks)], metadata.Hooks[:]...)
+func (c *NotificationDeliveryClient) Hooks() []Hook {
+	return c.hooks.NotificationDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *MetadataClient) Interceptors() []Interceptor {
-	return c.inters.Metadata
+func (c *NotificationDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.NotificationDelivery
 }
 
-func (c *MetadataClient) mutate(ctx context.Context, m *MetadataMutation) (Value, error) {
+func (c *NotificationDeliveryClient) mutate(ctx context.Context, m *NotificationDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MetadataCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MetadataUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MetadataDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Metadata mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NotificationDelivery mutation op: %q", m.Op())
 	}
 }
 
@@ -3181,113 +5930,396 @@ func (c *PostCategoryClient) mutate(ctx context.Context, m *PostCategoryMutation
 	case OpUpdateOne:
 		return (&PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PostCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostCategory mutation op: %q", m.Op())
+	}
+}
+
+// PostTagClient is a client for the PostTag schema.
+type PostTagClient struct {
+	config
+}
+
+// NewPostTagClient returns a client for the PostTag from the given config.
+func NewPostTagClient(c config) *PostTagClient {
+	return &PostTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posttag.Hooks(f(g(h())))`.
+func (c *PostTagClient) Use(hooks ...Hook) {
+	c.hooks.PostTag = append(c.hooks.PostTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posttag.Intercept(f(g(h())))`.
+func (c *PostTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostTag = append(c.inters.PostTag, interceptors...)
+}
+
+// Create returns a builder for creating a PostTag entity.
+func (c *PostTagClient) Create() *PostTagCreate {
+	mutation := newPostTagMutation(c.config, OpCreate)
+	return &PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostTag entities.
+func (c *PostTagClient) CreateBulk(builders ...*PostTagCreate) *PostTagCreateBulk {
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostTagClient) MapCreateBulk(slice any, setFunc func(*PostTagCreate, int)) *PostTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostTagCreateBulk{err: fmt.Errorf("calling to PostTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostTag.
+func (c *PostTagClient) Update() *PostTagUpdate {
+	mutation := newPostTagMutation(c.config, OpUpdate)
+	return &PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostTagClient) UpdateOne(_m *PostTag) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTag(_m))
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostTagClient) UpdateOneID(id uint) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTagID(id))
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostTag.
+func (c *PostTagClient) Delete() *PostTagDelete {
+	mutation := newPostTagMutation(c.config, OpDelete)
+	return &PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostTagClient) DeleteOne(_m *PostTag) *PostTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostTagClient) DeleteOneID(id uint) *PostTagDeleteOne {
+	builder := c.Delete().Where(posttag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostTagDeleteOne{builder}
+}
+
+// Query returns a query builder for PostTag.
+func (c *PostTagClient) Query() *PostTagQuery {
+	return &PostTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostTag entity by its id.
+func (c *PostTagClient) Get(ctx context.Context, id uint) (*PostTag, error) {
+	return c.Query().Where(posttag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostTagClient) GetX(ctx context.Context, id uint) *PostTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArticles queries the articles edge of a PostTag.
+func (c *PostTagClient) QueryArticles(_m *PostTag) *ArticleQuery {
+	query := (&ArticleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posttag.Table, posttag.FieldID, id),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, posttag.ArticlesTable, posttag.ArticlesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostTagClient) Hooks() []Hook {
+	hooks := c.hooks.PostTag
+	return append(hooks[:len(hooks):len(hooks)], posttag.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostTagClient) Interceptors() []Interceptor {
+	return c.inters.PostTag
+}
+
+func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostTag mutation op: %q", m.Op())
+	}
+}
+
+// ReadingProgressClient is a client for the ReadingProgress schema.
+type ReadingProgressClient struct {
+	config
+}
+
+// NewReadingProgressClient returns a client for the ReadingProgress from the given config.
+func NewReadingProgressClient(c config) *ReadingProgressClient {
+	return &ReadingProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `readingprogress.Hooks(f(g(h())))`.
+func (c *ReadingProgressClient) Use(hooks ...Hook) {
+	c.hooks.ReadingProgress = append(c.hooks.ReadingProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `readingprogress.Intercept(f(g(h())))`.
+func (c *ReadingProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReadingProgress = append(c.inters.ReadingProgress, interceptors...)
+}
+
+// Create returns a builder for creating a ReadingProgress entity.
+func (c *ReadingProgressClient) Create() *ReadingProgressCreate {
+	mutation := newReadingProgressMutation(c.config, OpCreate)
+	return &ReadingProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReadingProgress entities.
+func (c *ReadingProgressClient) CreateBulk(builders ...*ReadingProgressCreate) *ReadingProgressCreateBulk {
+	return &ReadingProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReadingProgressClient) MapCreateBulk(slice any, setFunc func(*ReadingProgressCreate, int)) *ReadingProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReadingProgressCreateBulk{err: fmt.Errorf("calling to ReadingProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReadingProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReadingProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReadingProgress.
+func (c *ReadingProgressClient) Update() *ReadingProgressUpdate {
+	mutation := newReadingProgressMutation(c.config, OpUpdate)
+	return &ReadingProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReadingProgressClient) UpdateOne(_m *ReadingProgress) *ReadingProgressUpdateOne {
+	mutation := newReadingProgressMutation(c.config, OpUpdateOne, withReadingProgress(_m))
+	return &ReadingProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReadingProgressClient) UpdateOneID(id uint) *ReadingProgressUpdateOne {
+	mutation := newReadingProgressMutation(c.config, OpUpdateOne, withReadingProgressID(id))
+	return &ReadingProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReadingProgress.
+func (c *ReadingProgressClient) Delete() *ReadingProgressDelete {
+	mutation := newReadingProgressMutation(c.config, OpDelete)
+	return &ReadingProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReadingProgressClient) DeleteOne(_m *ReadingProgress) *ReadingProgressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReadingProgressClient) DeleteOneID(id uint) *ReadingProgressDeleteOne {
+	builder := c.Delete().Where(readingprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReadingProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for ReadingProgress.
+func (c *ReadingProgressClient) Query() *ReadingProgressQuery {
+	return &ReadingProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReadingProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReadingProgress entity by its id.
+func (c *ReadingProgressClient) Get(ctx context.Context, id uint) (*ReadingProgress, error) {
+	return c.Query().Where(readingprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReadingProgressClient) GetX(ctx context.Context, id uint) *ReadingProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReadingProgressClient) Hooks() []Hook {
+	return c.hooks.ReadingProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReadingProgressClient) Interceptors() []Interceptor {
+	return c.inters.ReadingProgress
+}
+
+func (c *ReadingProgressClient) mutate(ctx context.Context, m *ReadingProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReadingProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReadingProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReadingProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReadingProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostCategory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReadingProgress mutation op: %q", m.Op())
 	}
 }
 
-// PostTagClient is a client for the PostTag schema.
-type PostTagClient struct {
+// RecycleItemClient is a client for the RecycleItem schema.
+type RecycleItemClient struct {
 	config
 }
 
-// NewPostTagClient returns a client for the PostTag from the given config.
-func NewPostTagClient(c config) *PostTagClient {
-	return &PostTagClient{config: c}
+// NewRecycleItemClient returns a client for the RecycleItem from the given config.
+func NewRecycleItemClient(c config) *RecycleItemClient {
+	return &RecycleItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `posttag.Hooks(f(g(h())))`.
-func (c *PostTagClient) Use(hooks ...Hook) {
-	c.hooks.PostTag = append(c.hooks.PostTag, hooks...)
+// A call to `Use(f, g, h)` equals to `recycleitem.Hooks(f(g(h())))`.
+func (c *RecycleItemClient) Use(hooks ...Hook) {
+	c.hooks.RecycleItem = append(c.hooks.RecycleItem, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `posttag.Intercept(f(g(h())))`.
-func (c *PostTagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PostTag = append(c.inters.PostTag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `recycleitem.Intercept(f(g(h())))`.
+func (c *RecycleItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecycleItem = append(c.inters.RecycleItem, interceptors...)
 }
 
-// Create returns a builder for creating a PostTag entity.
-func (c *PostTagClient) Create() *PostTagCreate {
-	mutation := newPostTagMutation(c.config, OpCreate)
-	return &PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RecycleItem entity.
+func (c *RecycleItemClient) Create() *RecycleItemCreate {
+	mutation := newRecycleItemMutation(c.config, OpCreate)
+	return &RecycleItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PostTag entities.
-func (c *PostTagClient) CreateBulk(builders ...*PostTagCreate) *PostTagCreateBulk {
-	return &PostTagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RecycleItem entities.
+func (c *RecycleItemClient) CreateBulk(builders ...*RecycleItemCreate) *RecycleItemCreateBulk {
+	return &RecycleItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostTagClient) MapCreateBulk(slice any, setFunc func(*PostTagCreate, int)) *PostTagCreateBulk {
+func (c *RecycleItemClient) MapCreateBulk(slice any, setFunc func(*RecycleItemCreate, int)) *RecycleItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostTagCreateBulk{err: fmt.Errorf("calling to PostTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RecycleItemCreateBulk{err: fmt.Errorf("calling to RecycleItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostTagCreate, rv.Len())
+	builders := make([]*RecycleItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostTagCreateBulk{config: c.config, builders: builders}
+	return &RecycleItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PostTag.
-func (c *PostTagClient) Update() *PostTagUpdate {
-	mutation := newPostTagMutation(c.config, OpUpdate)
-	return &PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RecycleItem.
+func (c *RecycleItemClient) Update() *RecycleItemUpdate {
+	mutation := newRecycleItemMutation(c.config, OpUpdate)
+	return &RecycleItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostTagClient) UpdateOne(_m *PostTag) *PostTagUpdateOne {
-	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTag(_m))
-	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecycleItemClient) UpdateOne(_m *RecycleItem) *RecycleItemUpdateOne {
+	mutation := newRecycleItemMutation(c.config, OpUpdateOne, withRecycleItem(_m))
+	return &RecycleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostTagClient) UpdateOneID(id uint) *PostTagUpdateOne {
-	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTagID(id))
-	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecycleItemClient) UpdateOneID(id uint) *RecycleItemUpdateOne {
+	mutation := newRecycleItemMutation(c.config, OpUpdateOne, withRecycleItemID(id))
+	return &RecycleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PostTag.
-func (c *PostTagClient) Delete() *PostTagDelete {
-	mutation := newPostTagMutation(c.config, OpDelete)
-	return &PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RecycleItem.
+func (c *RecycleItemClient) Delete() *RecycleItemDelete {
+	mutation := newRecycleItemMutation(c.config, OpDelete)
+	return &RecycleItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostTagClient) DeleteOne(_m *PostTag) *PostTagDeleteOne {
+func (c *RecycleItemClient) DeleteOne(_m *RecycleItem) *RecycleItemDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostTagClient) DeleteOneID(id uint) *PostTagDeleteOne {
-	builder := c.Delete().Where(posttag.ID(id))
+func (c *RecycleItemClient) DeleteOneID(id uint) *RecycleItemDeleteOne {
+	builder := c.Delete().Where(recycleitem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostTagDeleteOne{builder}
+	return &RecycleItemDeleteOne{builder}
 }
 
-// Query returns a query builder for PostTag.
-func (c *PostTagClient) Query() *PostTagQuery {
-	return &PostTagQuery{
+// Query returns a query builder for RecycleItem.
+func (c *RecycleItemClient) Query() *RecycleItemQuery {
+	return &RecycleItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePostTag},
+		ctx:    &QueryContext{Type: TypeRecycleItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PostTag entity by its id.
-func (c *PostTagClient) Get(ctx context.Context, id uint) (*PostTag, error) {
-	return c.Query().Where(posttag.ID(id)).Only(ctx)
+// Get returns a RecycleItem entity by its id.
+func (c *RecycleItemClient) Get(ctx context.Context, id uint) (*RecycleItem, error) {
+	return c.Query().Where(recycleitem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostTagClient) GetX(ctx context.Context, id uint) *PostTag {
+func (c *RecycleItemClient) GetX(ctx context.Context, id uint) *RecycleItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3295,45 +6327,28 @@ func (c *PostTagClient) GetX(ctx context.Context, id uint) *PostTag {
 	return obj
 }
 
-// QueryArticles queries the articles edge of a PostTag.
-func (c *PostTagClient) QueryArticles(_m *PostTag) *ArticleQuery {
-	query := (&ArticleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(posttag.Table, posttag.FieldID, id),
-			sqlgraph.To(article.Table, article.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, posttag.ArticlesTable, posttag.ArticlesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PostTagClient) Hooks() []Hook {
-	hooks := c.hooks.PostTag
-	return append(hooks[:len(hooks):len(hooks)], posttag.Hooks[:]...)
+func (c *RecycleItemClient) Hooks() []Hook {
+	return c.hooks.RecycleItem
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostTagClient) Interceptors() []Interceptor {
-	return c.inters.PostTag
+func (c *RecycleItemClient) Interceptors() []Interceptor {
+	return c.inters.RecycleItem
 }
 
-func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value, error) {
+func (c *RecycleItemClient) mutate(ctx context.Context, m *RecycleItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecycleItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecycleItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecycleItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RecycleItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RecycleItem mutation op: %q", m.Op())
 	}
 }
 
@@ -3471,6 +6486,139 @@ func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value,
 	}
 }
 
+// SpamTokenClient is a client for the SpamToken schema.
+type SpamTokenClient struct {
+	config
+}
+
+// NewSpamTokenClient returns a client for the SpamToken from the given config.
+func NewSpamTokenClient(c config) *SpamTokenClient {
+	return &SpamTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spamtoken.Hooks(f(g(h())))`.
+func (c *SpamTokenClient) Use(hooks ...Hook) {
+	c.hooks.SpamToken = append(c.hooks.SpamToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spamtoken.Intercept(f(g(h())))`.
+func (c *SpamTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpamToken = append(c.inters.SpamToken, interceptors...)
+}
+
+// Create returns a builder for creating a SpamToken entity.
+func (c *SpamTokenClient) Create() *SpamTokenCreate {
+	mutation := newSpamTokenMutation(c.config, OpCreate)
+	return &SpamTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpamToken entities.
+func (c *SpamTokenClient) CreateBulk(builders ...*SpamTokenCreate) *SpamTokenCreateBulk {
+	return &SpamTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpamTokenClient) MapCreateBulk(slice any, setFunc func(*SpamTokenCreate, int)) *SpamTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpamTokenCreateBulk{err: fmt.Errorf("calling to SpamTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpamTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpamTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SpamToken.
+func (c *SpamTokenClient) Update() *SpamTokenUpdate {
+	mutation := newSpamTokenMutation(c.config, OpUpdate)
+	return &SpamTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpamTokenClient) UpdateOne(_m *SpamToken) *SpamTokenUpdateOne {
+	mutation := newSpamTokenMutation(c.config, OpUpdateOne, withSpamToken(_m))
+	return &SpamTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpamTokenClient) UpdateOneID(id uint) *SpamTokenUpdateOne {
+	mutation := newSpamTokenMutation(c.config, OpUpdateOne, withSpamTokenID(id))
+	return &SpamTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpamToken.
+func (c *SpamTokenClient) Delete() *SpamTokenDelete {
+	mutation := newSpamTokenMutation(c.config, OpDelete)
+	return &SpamTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpamTokenClient) DeleteOne(_m *SpamToken) *SpamTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpamTokenClient) DeleteOneID(id uint) *SpamTokenDeleteOne {
+	builder := c.Delete().Where(spamtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpamTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for SpamToken.
+func (c *SpamTokenClient) Query() *SpamTokenQuery {
+	return &SpamTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpamToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SpamToken entity by its id.
+func (c *SpamTokenClient) Get(ctx context.Context, id uint) (*SpamToken, error) {
+	return c.Query().Where(spamtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpamTokenClient) GetX(ctx context.Context, id uint) *SpamToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SpamTokenClient) Hooks() []Hook {
+	return c.hooks.SpamToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpamTokenClient) Interceptors() []Interceptor {
+	return c.inters.SpamToken
+}
+
+func (c *SpamTokenClient) mutate(ctx context.Context, m *SpamTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpamTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpamTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpamTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpamTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SpamToken mutation op: %q", m.Op())
+	}
+}
+
 // StoragePolicyClient is a client for the StoragePolicy schema.
 type StoragePolicyClient struct {
 	config
@@ -3532,46 +6680,180 @@ func (c *StoragePolicyClient) UpdateOne(_m *StoragePolicy) *StoragePolicyUpdateO
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *StoragePolicyClient) UpdateOneID(id uint) *StoragePolicyUpdateOne {
-	mutation := newStoragePolicyMutation(c.config, OpUpdateOne, withStoragePolicyID(id))
-	return &StoragePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StoragePolicyClient) UpdateOneID(id uint) *StoragePolicyUpdateOne {
+	mutation := newStoragePolicyMutation(c.config, OpUpdateOne, withStoragePolicyID(id))
+	return &StoragePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StoragePolicy.
+func (c *StoragePolicyClient) Delete() *StoragePolicyDelete {
+	mutation := newStoragePolicyMutation(c.config, OpDelete)
+	return &StoragePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StoragePolicyClient) DeleteOne(_m *StoragePolicy) *StoragePolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StoragePolicyClient) DeleteOneID(id uint) *StoragePolicyDeleteOne {
+	builder := c.Delete().Where(storagepolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StoragePolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for StoragePolicy.
+func (c *StoragePolicyClient) Query() *StoragePolicyQuery {
+	return &StoragePolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStoragePolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StoragePolicy entity by its id.
+func (c *StoragePolicyClient) Get(ctx context.Context, id uint) (*StoragePolicy, error) {
+	return c.Query().Where(storagepolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StoragePolicyClient) GetX(ctx context.Context, id uint) *StoragePolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StoragePolicyClient) Hooks() []Hook {
+	hooks := c.hooks.StoragePolicy
+	return append(hooks[:len(hooks):len(hooks)], storagepolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *StoragePolicyClient) Interceptors() []Interceptor {
+	return c.inters.StoragePolicy
+}
+
+func (c *StoragePolicyClient) mutate(ctx context.Context, m *StoragePolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StoragePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StoragePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StoragePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StoragePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StoragePolicy mutation op: %q", m.Op())
+	}
+}
+
+// StoragePolicyMountClient is a client for the StoragePolicyMount schema.
+type StoragePolicyMountClient struct {
+	config
+}
+
+// NewStoragePolicyMountClient returns a client for the StoragePolicyMount from the given config.
+func NewStoragePolicyMountClient(c config) *StoragePolicyMountClient {
+	return &StoragePolicyMountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storagepolicymount.Hooks(f(g(h())))`.
+func (c *StoragePolicyMountClient) Use(hooks ...Hook) {
+	c.hooks.StoragePolicyMount = append(c.hooks.StoragePolicyMount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storagepolicymount.Intercept(f(g(h())))`.
+func (c *StoragePolicyMountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StoragePolicyMount = append(c.inters.StoragePolicyMount, interceptors...)
+}
+
+// Create returns a builder for creating a StoragePolicyMount entity.
+func (c *StoragePolicyMountClient) Create() *StoragePolicyMountCreate {
+	mutation := newStoragePolicyMountMutation(c.config, OpCreate)
+	return &StoragePolicyMountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StoragePolicyMount entities.
+func (c *StoragePolicyMountClient) CreateBulk(builders ...*StoragePolicyMountCreate) *StoragePolicyMountCreateBulk {
+	return &StoragePolicyMountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StoragePolicyMountClient) MapCreateBulk(slice any, setFunc func(*StoragePolicyMountCreate, int)) *StoragePolicyMountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StoragePolicyMountCreateBulk{err: fmt.Errorf("calling to StoragePolicyMountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StoragePolicyMountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StoragePolicyMountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StoragePolicyMount.
+func (c *StoragePolicyMountClient) Update() *StoragePolicyMountUpdate {
+	mutation := newStoragePolicyMountMutation(c.config, OpUpdate)
+	return &StoragePolicyMountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StoragePolicyMountClient) UpdateOne(_m *StoragePolicyMount) *StoragePolicyMountUpdateOne {
+	mutation := newStoragePolicyMountMutation(c.config, OpUpdateOne, withStoragePolicyMount(_m))
+	return &StoragePolicyMountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StoragePolicyMountClient) UpdateOneID(id uint) *StoragePolicyMountUpdateOne {
+	mutation := newStoragePolicyMountMutation(c.config, OpUpdateOne, withStoragePolicyMountID(id))
+	return &StoragePolicyMountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for StoragePolicy.
-func (c *StoragePolicyClient) Delete() *StoragePolicyDelete {
-	mutation := newStoragePolicyMutation(c.config, OpDelete)
-	return &StoragePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StoragePolicyMount.
+func (c *StoragePolicyMountClient) Delete() *StoragePolicyMountDelete {
+	mutation := newStoragePolicyMountMutation(c.config, OpDelete)
+	return &StoragePolicyMountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *StoragePolicyClient) DeleteOne(_m *StoragePolicy) *StoragePolicyDeleteOne {
+func (c *StoragePolicyMountClient) DeleteOne(_m *StoragePolicyMount) *StoragePolicyMountDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *StoragePolicyClient) DeleteOneID(id uint) *StoragePolicyDeleteOne {
-	builder := c.Delete().Where(storagepolicy.ID(id))
+func (c *StoragePolicyMountClient) DeleteOneID(id uint) *StoragePolicyMountDeleteOne {
+	builder := c.Delete().Where(storagepolicymount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &StoragePolicyDeleteOne{builder}
+	return &StoragePolicyMountDeleteOne{builder}
 }
 
-// Query returns a query builder for StoragePolicy.
-func (c *StoragePolicyClient) Query() *StoragePolicyQuery {
-	return &StoragePolicyQuery{
+// Query returns a query builder for StoragePolicyMount.
+func (c *StoragePolicyMountClient) Query() *StoragePolicyMountQuery {
+	return &StoragePolicyMountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeStoragePolicy},
+		ctx:    &QueryContext{Type: TypeStoragePolicyMount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a StoragePolicy entity by its id.
-func (c *StoragePolicyClient) Get(ctx context.Context, id uint) (*StoragePolicy, error) {
-	return c.Query().Where(storagepolicy.ID(id)).Only(ctx)
+// Get returns a StoragePolicyMount entity by its id.
+func (c *StoragePolicyMountClient) Get(ctx context.Context, id uint) (*StoragePolicyMount, error) {
+	return c.Query().Where(storagepolicymount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *StoragePolicyClient) GetX(ctx context.Context, id uint) *StoragePolicy {
+func (c *StoragePolicyMountClient) GetX(ctx context.Context, id uint) *StoragePolicyMount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3580,28 +6862,27 @@ func (c *StoragePolicyClient) GetX(ctx context.Context, id uint) *StoragePolicy
 }
 
 // Hooks returns the client hooks.
-func (c *StoragePolicyClient) Hooks() []Hook {
-	hooks := c.hooks.StoragePolicy
-	return append(hooks[:len(hooks):len(hooks)], storagepolicy.Hooks[:]...)
+func (c *StoragePolicyMountClient) Hooks() []Hook {
+	return c.hooks.StoragePolicyMount
 }
 
 // Interceptors returns the client interceptors.
-func (c *StoragePolicyClient) Interceptors() []Interceptor {
-	return c.inters.StoragePolicy
+func (c *StoragePolicyMountClient) Interceptors() []Interceptor {
+	return c.inters.StoragePolicyMount
 }
 
-func (c *StoragePolicyClient) mutate(ctx context.Context, m *StoragePolicyMutation) (Value, error) {
+func (c *StoragePolicyMountClient) mutate(ctx context.Context, m *StoragePolicyMountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&StoragePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StoragePolicyMountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&StoragePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StoragePolicyMountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&StoragePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StoragePolicyMountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StoragePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StoragePolicyMountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown StoragePolicy mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StoragePolicyMount mutation op: %q", m.Op())
 	}
 }
 
@@ -4005,6 +7286,139 @@ func (c *URLStatClient) mutate(ctx context.Context, m *URLStatMutation) (Value,
 	}
 }
 
+// UploadSessionClient is a client for the UploadSession schema.
+type UploadSessionClient struct {
+	config
+}
+
+// NewUploadSessionClient returns a client for the UploadSession from the given config.
+func NewUploadSessionClient(c config) *UploadSessionClient {
+	return &UploadSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `uploadsession.Hooks(f(g(h())))`.
+func (c *UploadSessionClient) Use(hooks ...Hook) {
+	c.hooks.UploadSession = append(c.hooks.UploadSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `uploadsession.Intercept(f(g(h())))`.
+func (c *UploadSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UploadSession = append(c.inters.UploadSession, interceptors...)
+}
+
+// Create returns a builder for creating a UploadSession entity.
+func (c *UploadSessionClient) Create() *UploadSessionCreate {
+	mutation := newUploadSessionMutation(c.config, OpCreate)
+	return &UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UploadSession entities.
+func (c *UploadSessionClient) CreateBulk(builders ...*UploadSessionCreate) *UploadSessionCreateBulk {
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UploadSessionClient) MapCreateBulk(slice any, setFunc func(*UploadSessionCreate, int)) *UploadSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UploadSessionCreateBulk{err: fmt.Errorf("calling to UploadSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UploadSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UploadSession.
+func (c *UploadSessionClient) Update() *UploadSessionUpdate {
+	mutation := newUploadSessionMutation(c.config, OpUpdate)
+	return &UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UploadSessionClient) UpdateOne(_m *UploadSession) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSession(_m))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UploadSessionClient) UpdateOneID(id uint) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSessionID(id))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UploadSession.
+func (c *UploadSessionClient) Delete() *UploadSessionDelete {
+	mutation := newUploadSessionMutation(c.config, OpDelete)
+	return &UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UploadSessionClient) DeleteOne(_m *UploadSession) *UploadSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UploadSessionClient) DeleteOneID(id uint) *UploadSessionDeleteOne {
+	builder := c.Delete().Where(uploadsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UploadSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UploadSession.
+func (c *UploadSessionClient) Query() *UploadSessionQuery {
+	return &UploadSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUploadSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UploadSession entity by its id.
+func (c *UploadSessionClient) Get(ctx context.Context, id uint) (*UploadSession, error) {
+	return c.Query().Where(uploadsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UploadSessionClient) GetX(ctx context.Context, id uint) *UploadSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UploadSessionClient) Hooks() []Hook {
+	return c.hooks.UploadSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *UploadSessionClient) Interceptors() []Interceptor {
+	return c.inters.UploadSession
+}
+
+func (c *UploadSessionClient) mutate(ctx context.Context, m *UploadSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UploadSession mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -4219,6 +7633,139 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserBookmarkClient is a client for the UserBookmark schema.
+type UserBookmarkClient struct {
+	config
+}
+
+// NewUserBookmarkClient returns a client for the UserBookmark from the given config.
+func NewUserBookmarkClient(c config) *UserBookmarkClient {
+	return &UserBookmarkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userbookmark.Hooks(f(g(h())))`.
+func (c *UserBookmarkClient) Use(hooks ...Hook) {
+	c.hooks.UserBookmark = append(c.hooks.UserBookmark, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userbookmark.Intercept(f(g(h())))`.
+func (c *UserBookmarkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBookmark = append(c.inters.UserBookmark, interceptors...)
+}
+
+// Create returns a builder for creating a UserBookmark entity.
+func (c *UserBookmarkClient) Create() *UserBookmarkCreate {
+	mutation := newUserBookmarkMutation(c.config, OpCreate)
+	return &UserBookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBookmark entities.
+func (c *UserBookmarkClient) CreateBulk(builders ...*UserBookmarkCreate) *UserBookmarkCreateBulk {
+	return &UserBookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBookmarkClient) MapCreateBulk(slice any, setFunc func(*UserBookmarkCreate, int)) *UserBookmarkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBookmarkCreateBulk{err: fmt.Errorf("calling to UserBookmarkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBookmarkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBookmark.
+func (c *UserBookmarkClient) Update() *UserBookmarkUpdate {
+	mutation := newUserBookmarkMutation(c.config, OpUpdate)
+	return &UserBookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBookmarkClient) UpdateOne(_m *UserBookmark) *UserBookmarkUpdateOne {
+	mutation := newUserBookmarkMutation(c.config, OpUpdateOne, withUserBookmark(_m))
+	return &UserBookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBookmarkClient) UpdateOneID(id uint) *UserBookmarkUpdateOne {
+	mutation := newUserBookmarkMutation(c.config, OpUpdateOne, withUserBookmarkID(id))
+	return &UserBookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBookmark.
+func (c *UserBookmarkClient) Delete() *UserBookmarkDelete {
+	mutation := newUserBookmarkMutation(c.config, OpDelete)
+	return &UserBookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBookmarkClient) DeleteOne(_m *UserBookmark) *UserBookmarkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBookmarkClient) DeleteOneID(id uint) *UserBookmarkDeleteOne {
+	builder := c.Delete().Where(userbookmark.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBookmarkDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBookmark.
+func (c *UserBookmarkClient) Query() *UserBookmarkQuery {
+	return &UserBookmarkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBookmark},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBookmark entity by its id.
+func (c *UserBookmarkClient) Get(ctx context.Context, id uint) (*UserBookmark, error) {
+	return c.Query().Where(userbookmark.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBookmarkClient) GetX(ctx context.Context, id uint) *UserBookmark {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserBookmarkClient) Hooks() []Hook {
+	return c.hooks.UserBookmark
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBookmarkClient) Interceptors() []Interceptor {
+	return c.inters.UserBookmark
+}
+
+func (c *UserBookmarkClient) mutate(ctx context.Context, m *UserBookmarkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserBookmark mutation op: %q", m.Op())
+	}
+}
+
 // UserGroupClient is a client for the UserGroup schema.
 type UserGroupClient struct {
 	config
@@ -4369,6 +7916,139 @@ func (c *UserGroupClient) mutate(ctx context.Context, m *UserGroupMutation) (Val
 	}
 }
 
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(_m *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(_m))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id uint) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(_m *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id uint) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id uint) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserIdentityClient) GetX(ctx context.Context, id uint) *UserIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
+}
+
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserIdentity mutation op: %q", m.Op())
+	}
+}
+
 // UserInstalledThemeClient is a client for the UserInstalledTheme schema.
 type UserInstalledThemeClient struct {
 	config
@@ -4953,17 +8633,28 @@ func (c *VisitorStatClient) mutate(ctx context.Context, m *VisitorStatMutation)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Album, AlbumCategory, Article, ArticleHistory, Comment, DirectLink, DocSeries,
-		Entity, File, FileEntity, Link, LinkCategory, LinkTag, Metadata,
-		NotificationType, Page, PostCategory, PostTag, Setting, StoragePolicy,
-		Subscriber, Tag, URLStat, User, UserGroup, UserInstalledTheme,
-		UserNotificationConfig, VisitorLog, VisitorStat []ent.Hook
+		AccessToken, Album, AlbumCategory, Article, ArticleAudio, ArticleCollection,
+		ArticleHistory, ArticleReaction, ArticleReviewNote, ArticleTemplate, AuditLog,
+		ChangelogEntry, Comment, CommentReaction, CommentSubscription, CommenterTrust,
+		ContentSnippet, DirectLink, DisposableEmailStat, DocSeries, Entity, File,
+		FileEntity, InvitationCode, Link, LinkCategory, LinkCheckRecord, LinkTag,
+		MailTemplateVersion, Metadata, Moment, MusicPlayStat, NotificationDelivery,
+		NotificationType, Page, PostCategory, PostTag, ReadingProgress, RecycleItem,
+		Setting, SpamToken, StoragePolicy, StoragePolicyMount, Subscriber, Tag,
+		URLStat, UploadSession, User, UserBookmark, UserGroup, UserIdentity,
+		UserInstalledTheme, UserNotificationConfig, VisitorLog, VisitorStat []ent.Hook
 	}
 	inters struct {
-		Album, AlbumCategory, Article, ArticleHistory, Comment, DirectLink, DocSeries,
-		Entity, File, FileEntity, Link, LinkCategory, LinkTag, Metadata,
-		NotificationType, Page, PostCategory, PostTag, Setting, StoragePolicy,
-		Subscriber, Tag, URLStat, User, UserGroup, UserInstalledTheme,
-		UserNotificationConfig, VisitorLog, VisitorStat []ent.Interceptor
+		AccessToken, Album, AlbumCategory, Article, ArticleAudio, ArticleCollection,
+		ArticleHistory, ArticleReaction, ArticleReviewNote, ArticleTemplate, AuditLog,
+		ChangelogEntry, Comment, CommentReaction, CommentSubscription, CommenterTrust,
+		ContentSnippet, DirectLink, DisposableEmailStat, DocSeries, Entity, File,
+		FileEntity, InvitationCode, Link, LinkCategory, LinkCheckRecord, LinkTag,
+		MailTemplateVersion, Metadata, Moment, MusicPlayStat, NotificationDelivery,
+		NotificationType, Page, PostCategory, PostTag, ReadingProgress, RecycleItem,
+		Setting, SpamToken, StoragePolicy, StoragePolicyMount, Subscriber, Tag,
+		URLStat, UploadSession, User, UserBookmark, UserGroup, UserIdentity,
+		UserInstalledTheme, UserNotificationConfig, VisitorLog,
+		VisitorStat []ent.Interceptor
 	}
 )
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -46,7 +47,7 @@ type Comment struct {
 	Content string `json:"content,omitempty"`
 	// 经后端安全处理后的HTML格式评论内容
 	ContentHTML string `json:"content_html,omitempty"`
-	// 评论状态 1:已发布 2:待审核
+	// 评论状态 1:已发布 2:待审核 3:垃圾评论
 	Status int `json:"status,omitempty"`
 	// 是否为博主/管理员的评论
 	IsAdminComment bool `json:"is_admin_comment,omitempty"`
@@ -60,6 +61,8 @@ type Comment struct {
 	IPLocation *string `json:"ip_location,omitempty"`
 	// 点赞数
 	LikeCount int `json:"like_count,omitempty"`
+	// 各类表情回应的数量，由回应表汇总得出
+	ReactionCounts map[string]int `json:"reaction_counts,omitempty"`
 	// 评论置顶时间，为NULL表示未置顶
 	PinnedAt *time.Time `json:"pinned_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -118,6 +121,8 @@ func (*Comment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case comment.FieldReactionCounts:
+			values[i] = new([]byte)
 		case comment.FieldIsAdminComment, comment.FieldIsAnonymous:
 			values[i] = new(sql.NullBool)
 		case comment.FieldID, comment.FieldUserID, comment.FieldParentID, comment.FieldReplyToID, comment.FieldStatus, comment.FieldLikeCount:
@@ -284,6 +289,14 @@ func (_m *Comment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LikeCount = int(value.Int64)
 			}
+		case comment.FieldReactionCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reaction_counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ReactionCounts); err != nil {
+					return fmt.Errorf("unmarshal field reaction_counts: %w", err)
+				}
+			}
 		case comment.FieldPinnedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field pinned_at", values[i])
@@ -430,6 +443,9 @@ func (_m *Comment) String() string {
 	builder.WriteString("like_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LikeCount))
 	builder.WriteString(", ")
+	builder.WriteString("reaction_counts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReactionCounts))
+	builder.WriteString(", ")
 	if v := _m.PinnedAt; v != nil {
 		builder.WriteString("pinned_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -57,6 +57,8 @@ const (
 	FieldIPLocation = "ip_location"
 	// FieldLikeCount holds the string denoting the like_count field in the database.
 	FieldLikeCount = "like_count"
+	// FieldReactionCounts holds the string denoting the reaction_counts field in the database.
+	FieldReactionCounts = "reaction_counts"
 	// FieldPinnedAt holds the string denoting the pinned_at field in the database.
 	FieldPinnedAt = "pinned_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -108,6 +110,7 @@ var Columns = []string{
 	FieldIPAddress,
 	FieldIPLocation,
 	FieldLikeCount,
+	FieldReactionCounts,
 	FieldPinnedAt,
 }
 
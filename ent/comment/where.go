@@ -1270,6 +1270,16 @@ func LikeCountLTE(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldLTE(FieldLikeCount, v))
 }
 
+// ReactionCountsIsNil applies the IsNil predicate on the "reaction_counts" field.
+func ReactionCountsIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldReactionCounts))
+}
+
+// ReactionCountsNotNil applies the NotNil predicate on the "reaction_counts" field.
+func ReactionCountsNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldReactionCounts))
+}
+
 // PinnedAtEQ applies the EQ predicate on the "pinned_at" field.
 func PinnedAtEQ(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldPinnedAt, v))
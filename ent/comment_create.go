@@ -269,6 +269,12 @@ func (_c *CommentCreate) SetNillableLikeCount(v *int) *CommentCreate {
 	return _c
 }
 
+// SetReactionCounts sets the "reaction_counts" field.
+func (_c *CommentCreate) SetReactionCounts(v map[string]int) *CommentCreate {
+	_c.mutation.SetReactionCounts(v)
+	return _c
+}
+
 // SetPinnedAt sets the "pinned_at" field.
 func (_c *CommentCreate) SetPinnedAt(v time.Time) *CommentCreate {
 	_c.mutation.SetPinnedAt(v)
@@ -605,6 +611,10 @@ func (_c *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldLikeCount, field.TypeInt, value)
 		_node.LikeCount = value
 	}
+	if value, ok := _c.mutation.ReactionCounts(); ok {
+		_spec.SetField(comment.FieldReactionCounts, field.TypeJSON, value)
+		_node.ReactionCounts = value
+	}
 	if value, ok := _c.mutation.PinnedAt(); ok {
 		_spec.SetField(comment.FieldPinnedAt, field.TypeTime, value)
 		_node.PinnedAt = &value
@@ -1023,6 +1033,24 @@ func (u *CommentUpsert) AddLikeCount(v int) *CommentUpsert {
 	return u
 }
 
+// SetReactionCounts sets the "reaction_counts" field.
+func (u *CommentUpsert) SetReactionCounts(v map[string]int) *CommentUpsert {
+	u.Set(comment.FieldReactionCounts, v)
+	return u
+}
+
+// UpdateReactionCounts sets the "reaction_counts" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateReactionCounts() *CommentUpsert {
+	u.SetExcluded(comment.FieldReactionCounts)
+	return u
+}
+
+// ClearReactionCounts clears the value of the "reaction_counts" field.
+func (u *CommentUpsert) ClearReactionCounts() *CommentUpsert {
+	u.SetNull(comment.FieldReactionCounts)
+	return u
+}
+
 // SetPinnedAt sets the "pinned_at" field.
 func (u *CommentUpsert) SetPinnedAt(v time.Time) *CommentUpsert {
 	u.Set(comment.FieldPinnedAt, v)
@@ -1456,6 +1484,27 @@ func (u *CommentUpsertOne) UpdateLikeCount() *CommentUpsertOne {
 	})
 }
 
+// SetReactionCounts sets the "reaction_counts" field.
+func (u *CommentUpsertOne) SetReactionCounts(v map[string]int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetReactionCounts(v)
+	})
+}
+
+// UpdateReactionCounts sets the "reaction_counts" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateReactionCounts() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateReactionCounts()
+	})
+}
+
+// ClearReactionCounts clears the value of the "reaction_counts" field.
+func (u *CommentUpsertOne) ClearReactionCounts() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearReactionCounts()
+	})
+}
+
 // SetPinnedAt sets the "pinned_at" field.
 func (u *CommentUpsertOne) SetPinnedAt(v time.Time) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -2058,6 +2107,27 @@ func (u *CommentUpsertBulk) UpdateLikeCount() *CommentUpsertBulk {
 	})
 }
 
+// SetReactionCounts sets the "reaction_counts" field.
+func (u *CommentUpsertBulk) SetReactionCounts(v map[string]int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetReactionCounts(v)
+	})
+}
+
+// UpdateReactionCounts sets the "reaction_counts" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateReactionCounts() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateReactionCounts()
+	})
+}
+
+// ClearReactionCounts clears the value of the "reaction_counts" field.
+func (u *CommentUpsertBulk) ClearReactionCounts() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearReactionCounts()
+	})
+}
+
 // SetPinnedAt sets the "pinned_at" field.
 func (u *CommentUpsertBulk) SetPinnedAt(v time.Time) *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
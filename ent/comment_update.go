@@ -377,6 +377,18 @@ func (_u *CommentUpdate) AddLikeCount(v int) *CommentUpdate {
 	return _u
 }
 
+// SetReactionCounts sets the "reaction_counts" field.
+func (_u *CommentUpdate) SetReactionCounts(v map[string]int) *CommentUpdate {
+	_u.mutation.SetReactionCounts(v)
+	return _u
+}
+
+// ClearReactionCounts clears the value of the "reaction_counts" field.
+func (_u *CommentUpdate) ClearReactionCounts() *CommentUpdate {
+	_u.mutation.ClearReactionCounts()
+	return _u
+}
+
 // SetPinnedAt sets the "pinned_at" field.
 func (_u *CommentUpdate) SetPinnedAt(v time.Time) *CommentUpdate {
 	_u.mutation.SetPinnedAt(v)
@@ -683,6 +695,12 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedLikeCount(); ok {
 		_spec.AddField(comment.FieldLikeCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ReactionCounts(); ok {
+		_spec.SetField(comment.FieldReactionCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.ReactionCountsCleared() {
+		_spec.ClearField(comment.FieldReactionCounts, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.PinnedAt(); ok {
 		_spec.SetField(comment.FieldPinnedAt, field.TypeTime, value)
 	}
@@ -1161,6 +1179,18 @@ func (_u *CommentUpdateOne) AddLikeCount(v int) *CommentUpdateOne {
 	return _u
 }
 
+// SetReactionCounts sets the "reaction_counts" field.
+func (_u *CommentUpdateOne) SetReactionCounts(v map[string]int) *CommentUpdateOne {
+	_u.mutation.SetReactionCounts(v)
+	return _u
+}
+
+// ClearReactionCounts clears the value of the "reaction_counts" field.
+func (_u *CommentUpdateOne) ClearReactionCounts() *CommentUpdateOne {
+	_u.mutation.ClearReactionCounts()
+	return _u
+}
+
 // SetPinnedAt sets the "pinned_at" field.
 func (_u *CommentUpdateOne) SetPinnedAt(v time.Time) *CommentUpdateOne {
 	_u.mutation.SetPinnedAt(v)
@@ -1497,6 +1527,12 @@ func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err er
 	if value, ok := _u.mutation.AddedLikeCount(); ok {
 		_spec.AddField(comment.FieldLikeCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ReactionCounts(); ok {
+		_spec.SetField(comment.FieldReactionCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.ReactionCountsCleared() {
+		_spec.ClearField(comment.FieldReactionCounts, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.PinnedAt(); ok {
 		_spec.SetField(comment.FieldPinnedAt, field.TypeTime, value)
 	}
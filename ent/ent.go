@@ -12,31 +12,57 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
 	"github.com/anzhiyu-c/anheyu-app/ent/album"
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/articleaudio"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlecollection"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereaction"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereviewnote"
+	"github.com/anzhiyu-c/anheyu-app/ent/articletemplate"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/changelogentry"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentertrust"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentreaction"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentsubscription"
+	"github.com/anzhiyu-c/anheyu-app/ent/contentsnippet"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
+	"github.com/anzhiyu-c/anheyu-app/ent/disposableemailstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkcheckrecord"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/mailtemplateversion"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
+	"github.com/anzhiyu-c/anheyu-app/ent/moment"
+	"github.com/anzhiyu-c/anheyu-app/ent/musicplaystat"
+	"github.com/anzhiyu-c/anheyu-app/ent/notificationdelivery"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/readingprogress"
+	"github.com/anzhiyu-c/anheyu-app/ent/recycleitem"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/spamtoken"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
+	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicymount"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/ent/tag"
+	"github.com/anzhiyu-c/anheyu-app/ent/uploadsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/urlstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/ent/userbookmark"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
@@ -101,31 +127,57 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			accesstoken.Table:            accesstoken.ValidColumn,
 			album.Table:                  album.ValidColumn,
 			albumcategory.Table:          albumcategory.ValidColumn,
 			article.Table:                article.ValidColumn,
+			articleaudio.Table:           articleaudio.ValidColumn,
+			articlecollection.Table:      articlecollection.ValidColumn,
 			articlehistory.Table:         articlehistory.ValidColumn,
+			articlereaction.Table:        articlereaction.ValidColumn,
+			articlereviewnote.Table:      articlereviewnote.ValidColumn,
+			articletemplate.Table:        articletemplate.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			changelogentry.Table:         changelogentry.ValidColumn,
 			comment.Table:                comment.ValidColumn,
+			commentreaction.Table:        commentreaction.ValidColumn,
+			commentsubscription.Table:    commentsubscription.ValidColumn,
+			commentertrust.Table:         commentertrust.ValidColumn,
+			contentsnippet.Table:         contentsnippet.ValidColumn,
 			directlink.Table:             directlink.ValidColumn,
+			disposableemailstat.Table:    disposableemailstat.ValidColumn,
 			docseries.Table:              docseries.ValidColumn,
 			entity.Table:                 entity.ValidColumn,
 			file.Table:                   file.ValidColumn,
 			fileentity.Table:             fileentity.ValidColumn,
+			invitationcode.Table:         invitationcode.ValidColumn,
 			link.Table:                   link.ValidColumn,
 			linkcategory.Table:           linkcategory.ValidColumn,
+			linkcheckrecord.Table:        linkcheckrecord.ValidColumn,
 			linktag.Table:                linktag.ValidColumn,
+			mailtemplateversion.Table:    mailtemplateversion.ValidColumn,
 			metadata.Table:               metadata.ValidColumn,
+			moment.Table:                 moment.ValidColumn,
+			musicplaystat.Table:          musicplaystat.ValidColumn,
+			notificationdelivery.Table:   notificationdelivery.ValidColumn,
 			notificationtype.Table:       notificationtype.ValidColumn,
 			page.Table:                   page.ValidColumn,
 			postcategory.Table:           postcategory.ValidColumn,
 			posttag.Table:                posttag.ValidColumn,
+			readingprogress.Table:        readingprogress.ValidColumn,
+			recycleitem.Table:            recycleitem.ValidColumn,
 			setting.Table:                setting.ValidColumn,
+			spamtoken.Table:              spamtoken.ValidColumn,
 			storagepolicy.Table:          storagepolicy.ValidColumn,
+			storagepolicymount.Table:     storagepolicymount.ValidColumn,
 			subscriber.Table:             subscriber.ValidColumn,
 			tag.Table:                    tag.ValidColumn,
 			urlstat.Table:                urlstat.ValidColumn,
+			uploadsession.Table:          uploadsession.ValidColumn,
 			user.Table:                   user.ValidColumn,
+			userbookmark.Table:           userbookmark.ValidColumn,
 			usergroup.Table:              usergroup.ValidColumn,
+			useridentity.Table:           useridentity.ValidColumn,
 			userinstalledtheme.Table:     userinstalledtheme.ValidColumn,
 			usernotificationconfig.Table: usernotificationconfig.ValidColumn,
 			visitorlog.Table:             visitorlog.ValidColumn,
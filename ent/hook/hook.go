@@ -9,6 +9,18 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent"
 )
 
+// The AccessTokenFunc type is an adapter to allow the use of ordinary
+// function as AccessToken mutator.
+type AccessTokenFunc func(context.Context, *ent.AccessTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccessTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccessTokenMutation", m)
+}
+
 // The AlbumFunc type is an adapter to allow the use of ordinary
 // function as Album mutator.
 type AlbumFunc func(context.Context, *ent.AlbumMutation) (ent.Value, error)
@@ -45,6 +57,30 @@ func (f ArticleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleMutation", m)
 }
 
+// The ArticleAudioFunc type is an adapter to allow the use of ordinary
+// function as ArticleAudio mutator.
+type ArticleAudioFunc func(context.Context, *ent.ArticleAudioMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleAudioFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleAudioMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleAudioMutation", m)
+}
+
+// The ArticleCollectionFunc type is an adapter to allow the use of ordinary
+// function as ArticleCollection mutator.
+type ArticleCollectionFunc func(context.Context, *ent.ArticleCollectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleCollectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleCollectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleCollectionMutation", m)
+}
+
 // The ArticleHistoryFunc type is an adapter to allow the use of ordinary
 // function as ArticleHistory mutator.
 type ArticleHistoryFunc func(context.Context, *ent.ArticleHistoryMutation) (ent.Value, error)
@@ -57,6 +93,66 @@ func (f ArticleHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleHistoryMutation", m)
 }
 
+// The ArticleReactionFunc type is an adapter to allow the use of ordinary
+// function as ArticleReaction mutator.
+type ArticleReactionFunc func(context.Context, *ent.ArticleReactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleReactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleReactionMutation", m)
+}
+
+// The ArticleReviewNoteFunc type is an adapter to allow the use of ordinary
+// function as ArticleReviewNote mutator.
+type ArticleReviewNoteFunc func(context.Context, *ent.ArticleReviewNoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleReviewNoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleReviewNoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleReviewNoteMutation", m)
+}
+
+// The ArticleTemplateFunc type is an adapter to allow the use of ordinary
+// function as ArticleTemplate mutator.
+type ArticleTemplateFunc func(context.Context, *ent.ArticleTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleTemplateMutation", m)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
+// The ChangelogEntryFunc type is an adapter to allow the use of ordinary
+// function as ChangelogEntry mutator.
+type ChangelogEntryFunc func(context.Context, *ent.ChangelogEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChangelogEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChangelogEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChangelogEntryMutation", m)
+}
+
 // The CommentFunc type is an adapter to allow the use of ordinary
 // function as Comment mutator.
 type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
@@ -69,6 +165,54 @@ func (f CommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMutation", m)
 }
 
+// The CommentReactionFunc type is an adapter to allow the use of ordinary
+// function as CommentReaction mutator.
+type CommentReactionFunc func(context.Context, *ent.CommentReactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentReactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommentReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentReactionMutation", m)
+}
+
+// The CommentSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as CommentSubscription mutator.
+type CommentSubscriptionFunc func(context.Context, *ent.CommentSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommentSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentSubscriptionMutation", m)
+}
+
+// The CommenterTrustFunc type is an adapter to allow the use of ordinary
+// function as CommenterTrust mutator.
+type CommenterTrustFunc func(context.Context, *ent.CommenterTrustMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommenterTrustFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommenterTrustMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommenterTrustMutation", m)
+}
+
+// The ContentSnippetFunc type is an adapter to allow the use of ordinary
+// function as ContentSnippet mutator.
+type ContentSnippetFunc func(context.Context, *ent.ContentSnippetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentSnippetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContentSnippetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContentSnippetMutation", m)
+}
+
 // The DirectLinkFunc type is an adapter to allow the use of ordinary
 // function as DirectLink mutator.
 type DirectLinkFunc func(context.Context, *ent.DirectLinkMutation) (ent.Value, error)
@@ -81,6 +225,18 @@ func (f DirectLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DirectLinkMutation", m)
 }
 
+// The DisposableEmailStatFunc type is an adapter to allow the use of ordinary
+// function as DisposableEmailStat mutator.
+type DisposableEmailStatFunc func(context.Context, *ent.DisposableEmailStatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DisposableEmailStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DisposableEmailStatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DisposableEmailStatMutation", m)
+}
+
 // The DocSeriesFunc type is an adapter to allow the use of ordinary
 // function as DocSeries mutator.
 type DocSeriesFunc func(context.Context, *ent.DocSeriesMutation) (ent.Value, error)
@@ -129,6 +285,18 @@ func (f FileEntityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileEntityMutation", m)
 }
 
+// The InvitationCodeFunc type is an adapter to allow the use of ordinary
+// function as InvitationCode mutator.
+type InvitationCodeFunc func(context.Context, *ent.InvitationCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvitationCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvitationCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvitationCodeMutation", m)
+}
+
 // The LinkFunc type is an adapter to allow the use of ordinary
 // function as Link mutator.
 type LinkFunc func(context.Context, *ent.LinkMutation) (ent.Value, error)
@@ -153,6 +321,18 @@ func (f LinkCategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkCategoryMutation", m)
 }
 
+// The LinkCheckRecordFunc type is an adapter to allow the use of ordinary
+// function as LinkCheckRecord mutator.
+type LinkCheckRecordFunc func(context.Context, *ent.LinkCheckRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LinkCheckRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LinkCheckRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkCheckRecordMutation", m)
+}
+
 // The LinkTagFunc type is an adapter to allow the use of ordinary
 // function as LinkTag mutator.
 type LinkTagFunc func(context.Context, *ent.LinkTagMutation) (ent.Value, error)
@@ -165,6 +345,18 @@ func (f LinkTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LinkTagMutation", m)
 }
 
+// The MailTemplateVersionFunc type is an adapter to allow the use of ordinary
+// function as MailTemplateVersion mutator.
+type MailTemplateVersionFunc func(context.Context, *ent.MailTemplateVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MailTemplateVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MailTemplateVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MailTemplateVersionMutation", m)
+}
+
 // The MetadataFunc type is an adapter to allow the use of ordinary
 // function as Metadata mutator.
 type MetadataFunc func(context.Context, *ent.MetadataMutation) (ent.Value, error)
@@ -177,6 +369,42 @@ func (f MetadataFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetadataMutation", m)
 }
 
+// The MomentFunc type is an adapter to allow the use of ordinary
+// function as Moment mutator.
+type MomentFunc func(context.Context, *ent.MomentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MomentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MomentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MomentMutation", m)
+}
+
+// The MusicPlayStatFunc type is an adapter to allow the use of ordinary
+// function as MusicPlayStat mutator.
+type MusicPlayStatFunc func(context.Context, *ent.MusicPlayStatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MusicPlayStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MusicPlayStatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MusicPlayStatMutation", m)
+}
+
+// The NotificationDeliveryFunc type is an adapter to allow the use of ordinary
+// function as NotificationDelivery mutator.
+type NotificationDeliveryFunc func(context.Context, *ent.NotificationDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationDeliveryMutation", m)
+}
+
 // The NotificationTypeFunc type is an adapter to allow the use of ordinary
 // function as NotificationType mutator.
 type NotificationTypeFunc func(context.Context, *ent.NotificationTypeMutation) (ent.Value, error)
@@ -225,6 +453,30 @@ func (f PostTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PostTagMutation", m)
 }
 
+// The ReadingProgressFunc type is an adapter to allow the use of ordinary
+// function as ReadingProgress mutator.
+type ReadingProgressFunc func(context.Context, *ent.ReadingProgressMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReadingProgressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReadingProgressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReadingProgressMutation", m)
+}
+
+// The RecycleItemFunc type is an adapter to allow the use of ordinary
+// function as RecycleItem mutator.
+type RecycleItemFunc func(context.Context, *ent.RecycleItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecycleItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecycleItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecycleItemMutation", m)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary
 // function as Setting mutator.
 type SettingFunc func(context.Context, *ent.SettingMutation) (ent.Value, error)
@@ -237,6 +489,18 @@ func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
 }
 
+// The SpamTokenFunc type is an adapter to allow the use of ordinary
+// function as SpamToken mutator.
+type SpamTokenFunc func(context.Context, *ent.SpamTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpamTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpamTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpamTokenMutation", m)
+}
+
 // The StoragePolicyFunc type is an adapter to allow the use of ordinary
 // function as StoragePolicy mutator.
 type StoragePolicyFunc func(context.Context, *ent.StoragePolicyMutation) (ent.Value, error)
@@ -249,6 +513,18 @@ func (f StoragePolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StoragePolicyMutation", m)
 }
 
+// The StoragePolicyMountFunc type is an adapter to allow the use of ordinary
+// function as StoragePolicyMount mutator.
+type StoragePolicyMountFunc func(context.Context, *ent.StoragePolicyMountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StoragePolicyMountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StoragePolicyMountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StoragePolicyMountMutation", m)
+}
+
 // The SubscriberFunc type is an adapter to allow the use of ordinary
 // function as Subscriber mutator.
 type SubscriberFunc func(context.Context, *ent.SubscriberMutation) (ent.Value, error)
@@ -285,6 +561,18 @@ func (f URLStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.URLStatMutation", m)
 }
 
+// The UploadSessionFunc type is an adapter to allow the use of ordinary
+// function as UploadSession mutator.
+type UploadSessionFunc func(context.Context, *ent.UploadSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UploadSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UploadSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UploadSessionMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -297,6 +585,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The UserBookmarkFunc type is an adapter to allow the use of ordinary
+// function as UserBookmark mutator.
+type UserBookmarkFunc func(context.Context, *ent.UserBookmarkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserBookmarkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserBookmarkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserBookmarkMutation", m)
+}
+
 // The UserGroupFunc type is an adapter to allow the use of ordinary
 // function as UserGroup mutator.
 type UserGroupFunc func(context.Context, *ent.UserGroupMutation) (ent.Value, error)
@@ -309,6 +609,18 @@ func (f UserGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserGroupMutation", m)
 }
 
+// The UserIdentityFunc type is an adapter to allow the use of ordinary
+// function as UserIdentity mutator.
+type UserIdentityFunc func(context.Context, *ent.UserIdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserIdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserIdentityMutation", m)
+}
+
 // The UserInstalledThemeFunc type is an adapter to allow the use of ordinary
 // function as UserInstalledTheme mutator.
 type UserInstalledThemeFunc func(context.Context, *ent.UserInstalledThemeMutation) (ent.Value, error)
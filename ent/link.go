@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -41,6 +42,18 @@ type Link struct {
 	SortOrder int `json:"sort_order,omitempty"`
 	// 是否跳过健康检查
 	SkipHealthCheck bool `json:"skip_health_check,omitempty"`
+	// 健康检查间隔（小时），0 表示使用全局设置
+	CheckInterval int `json:"check_interval,omitempty"`
+	// 最近一次健康检查时间
+	LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+	// 连续检查失败次数
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// 最近一次检查的响应耗时（毫秒）
+	LastResponseTime int `json:"last_response_time,omitempty"`
+	// HTTPS 证书过期时间
+	SslExpireAt *time.Time `json:"ssl_expire_at,omitempty"`
+	// 被移入失联分类前所在的分类ID，恢复后移回
+	OriginalCategoryID *int `json:"original_category_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LinkQuery when eager-loading is set.
 	Edges               LinkEdges `json:"edges"`
@@ -86,10 +99,12 @@ func (*Link) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case link.FieldSkipHealthCheck:
 			values[i] = new(sql.NullBool)
-		case link.FieldID, link.FieldSortOrder:
+		case link.FieldID, link.FieldSortOrder, link.FieldCheckInterval, link.FieldConsecutiveFailures, link.FieldLastResponseTime, link.FieldOriginalCategoryID:
 			values[i] = new(sql.NullInt64)
 		case link.FieldName, link.FieldURL, link.FieldLogo, link.FieldDescription, link.FieldStatus, link.FieldSiteshot, link.FieldEmail, link.FieldType, link.FieldOriginalURL, link.FieldUpdateReason:
 			values[i] = new(sql.NullString)
+		case link.FieldLastCheckedAt, link.FieldSslExpireAt:
+			values[i] = new(sql.NullTime)
 		case link.ForeignKeys[0]: // link_category_links
 			values[i] = new(sql.NullInt64)
 		default:
@@ -185,6 +200,45 @@ func (_m *Link) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SkipHealthCheck = value.Bool
 			}
+		case link.FieldCheckInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field check_interval", values[i])
+			} else if value.Valid {
+				_m.CheckInterval = int(value.Int64)
+			}
+		case link.FieldLastCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_checked_at", values[i])
+			} else if value.Valid {
+				_m.LastCheckedAt = new(time.Time)
+				*_m.LastCheckedAt = value.Time
+			}
+		case link.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case link.FieldLastResponseTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_response_time", values[i])
+			} else if value.Valid {
+				_m.LastResponseTime = int(value.Int64)
+			}
+		case link.FieldSslExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ssl_expire_at", values[i])
+			} else if value.Valid {
+				_m.SslExpireAt = new(time.Time)
+				*_m.SslExpireAt = value.Time
+			}
+		case link.FieldOriginalCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_category_id", values[i])
+			} else if value.Valid {
+				_m.OriginalCategoryID = new(int)
+				*_m.OriginalCategoryID = int(value.Int64)
+			}
 		case link.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field link_category_links", value)
@@ -273,6 +327,30 @@ func (_m *Link) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("skip_health_check=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SkipHealthCheck))
+	builder.WriteString(", ")
+	builder.WriteString("check_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CheckInterval))
+	builder.WriteString(", ")
+	if v := _m.LastCheckedAt; v != nil {
+		builder.WriteString("last_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	builder.WriteString("last_response_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastResponseTime))
+	builder.WriteString(", ")
+	if v := _m.SslExpireAt; v != nil {
+		builder.WriteString("ssl_expire_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.OriginalCategoryID; v != nil {
+		builder.WriteString("original_category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
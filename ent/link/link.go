@@ -38,6 +38,18 @@ const (
 	FieldSortOrder = "sort_order"
 	// FieldSkipHealthCheck holds the string denoting the skip_health_check field in the database.
 	FieldSkipHealthCheck = "skip_health_check"
+	// FieldCheckInterval holds the string denoting the check_interval field in the database.
+	FieldCheckInterval = "check_interval"
+	// FieldLastCheckedAt holds the string denoting the last_checked_at field in the database.
+	FieldLastCheckedAt = "last_checked_at"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldLastResponseTime holds the string denoting the last_response_time field in the database.
+	FieldLastResponseTime = "last_response_time"
+	// FieldSslExpireAt holds the string denoting the ssl_expire_at field in the database.
+	FieldSslExpireAt = "ssl_expire_at"
+	// FieldOriginalCategoryID holds the string denoting the original_category_id field in the database.
+	FieldOriginalCategoryID = "original_category_id"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
@@ -73,6 +85,12 @@ var Columns = []string{
 	FieldUpdateReason,
 	FieldSortOrder,
 	FieldSkipHealthCheck,
+	FieldCheckInterval,
+	FieldLastCheckedAt,
+	FieldConsecutiveFailures,
+	FieldLastResponseTime,
+	FieldSslExpireAt,
+	FieldOriginalCategoryID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "links"
@@ -111,6 +129,14 @@ var (
 	DefaultSortOrder int
 	// DefaultSkipHealthCheck holds the default value on creation for the "skip_health_check" field.
 	DefaultSkipHealthCheck bool
+	// DefaultCheckInterval holds the default value on creation for the "check_interval" field.
+	DefaultCheckInterval int
+	// CheckIntervalValidator is a validator for the "check_interval" field. It is called by the builders before save.
+	CheckIntervalValidator func(int) error
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// DefaultLastResponseTime holds the default value on creation for the "last_response_time" field.
+	DefaultLastResponseTime int
 )
 
 // Status defines the type for the "status" enum field.
@@ -232,6 +258,36 @@ func BySkipHealthCheck(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSkipHealthCheck, opts...).ToFunc()
 }
 
+// ByCheckInterval orders the results by the check_interval field.
+func ByCheckInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInterval, opts...).ToFunc()
+}
+
+// ByLastCheckedAt orders the results by the last_checked_at field.
+func ByLastCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastCheckedAt, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByLastResponseTime orders the results by the last_response_time field.
+func ByLastResponseTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastResponseTime, opts...).ToFunc()
+}
+
+// BySslExpireAt orders the results by the ssl_expire_at field.
+func BySslExpireAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSslExpireAt, opts...).ToFunc()
+}
+
+// ByOriginalCategoryID orders the results by the original_category_id field.
+func ByOriginalCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCategoryID, opts...).ToFunc()
+}
+
 // ByCategoryField orders the results by category field.
 func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
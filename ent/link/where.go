@@ -3,6 +3,8 @@
 package link
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
@@ -103,6 +105,36 @@ func SkipHealthCheck(v bool) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldSkipHealthCheck, v))
 }
 
+// CheckInterval applies equality check predicate on the "check_interval" field. It's identical to CheckIntervalEQ.
+func CheckInterval(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCheckInterval, v))
+}
+
+// LastCheckedAt applies equality check predicate on the "last_checked_at" field. It's identical to LastCheckedAtEQ.
+func LastCheckedAt(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// LastResponseTime applies equality check predicate on the "last_response_time" field. It's identical to LastResponseTimeEQ.
+func LastResponseTime(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldLastResponseTime, v))
+}
+
+// SslExpireAt applies equality check predicate on the "ssl_expire_at" field. It's identical to SslExpireAtEQ.
+func SslExpireAt(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSslExpireAt, v))
+}
+
+// OriginalCategoryID applies equality check predicate on the "original_category_id" field. It's identical to OriginalCategoryIDEQ.
+func OriginalCategoryID(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldOriginalCategoryID, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Link {
 	return predicate.Link(sql.FieldEQ(FieldName, v))
@@ -783,6 +815,276 @@ func SkipHealthCheckNEQ(v bool) predicate.Link {
 	return predicate.Link(sql.FieldNEQ(FieldSkipHealthCheck, v))
 }
 
+// CheckIntervalEQ applies the EQ predicate on the "check_interval" field.
+func CheckIntervalEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldCheckInterval, v))
+}
+
+// CheckIntervalNEQ applies the NEQ predicate on the "check_interval" field.
+func CheckIntervalNEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldCheckInterval, v))
+}
+
+// CheckIntervalIn applies the In predicate on the "check_interval" field.
+func CheckIntervalIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldCheckInterval, vs...))
+}
+
+// CheckIntervalNotIn applies the NotIn predicate on the "check_interval" field.
+func CheckIntervalNotIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldCheckInterval, vs...))
+}
+
+// CheckIntervalGT applies the GT predicate on the "check_interval" field.
+func CheckIntervalGT(v int) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldCheckInterval, v))
+}
+
+// CheckIntervalGTE applies the GTE predicate on the "check_interval" field.
+func CheckIntervalGTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldCheckInterval, v))
+}
+
+// CheckIntervalLT applies the LT predicate on the "check_interval" field.
+func CheckIntervalLT(v int) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldCheckInterval, v))
+}
+
+// CheckIntervalLTE applies the LTE predicate on the "check_interval" field.
+func CheckIntervalLTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldCheckInterval, v))
+}
+
+// LastCheckedAtEQ applies the EQ predicate on the "last_checked_at" field.
+func LastCheckedAtEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtNEQ applies the NEQ predicate on the "last_checked_at" field.
+func LastCheckedAtNEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIn applies the In predicate on the "last_checked_at" field.
+func LastCheckedAtIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtNotIn applies the NotIn predicate on the "last_checked_at" field.
+func LastCheckedAtNotIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtGT applies the GT predicate on the "last_checked_at" field.
+func LastCheckedAtGT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtGTE applies the GTE predicate on the "last_checked_at" field.
+func LastCheckedAtGTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLT applies the LT predicate on the "last_checked_at" field.
+func LastCheckedAtLT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLTE applies the LTE predicate on the "last_checked_at" field.
+func LastCheckedAtLTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIsNil applies the IsNil predicate on the "last_checked_at" field.
+func LastCheckedAtIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldLastCheckedAt))
+}
+
+// LastCheckedAtNotNil applies the NotNil predicate on the "last_checked_at" field.
+func LastCheckedAtNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldLastCheckedAt))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// LastResponseTimeEQ applies the EQ predicate on the "last_response_time" field.
+func LastResponseTimeEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldLastResponseTime, v))
+}
+
+// LastResponseTimeNEQ applies the NEQ predicate on the "last_response_time" field.
+func LastResponseTimeNEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldLastResponseTime, v))
+}
+
+// LastResponseTimeIn applies the In predicate on the "last_response_time" field.
+func LastResponseTimeIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldLastResponseTime, vs...))
+}
+
+// LastResponseTimeNotIn applies the NotIn predicate on the "last_response_time" field.
+func LastResponseTimeNotIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldLastResponseTime, vs...))
+}
+
+// LastResponseTimeGT applies the GT predicate on the "last_response_time" field.
+func LastResponseTimeGT(v int) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldLastResponseTime, v))
+}
+
+// LastResponseTimeGTE applies the GTE predicate on the "last_response_time" field.
+func LastResponseTimeGTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldLastResponseTime, v))
+}
+
+// LastResponseTimeLT applies the LT predicate on the "last_response_time" field.
+func LastResponseTimeLT(v int) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldLastResponseTime, v))
+}
+
+// LastResponseTimeLTE applies the LTE predicate on the "last_response_time" field.
+func LastResponseTimeLTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldLastResponseTime, v))
+}
+
+// SslExpireAtEQ applies the EQ predicate on the "ssl_expire_at" field.
+func SslExpireAtEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldSslExpireAt, v))
+}
+
+// SslExpireAtNEQ applies the NEQ predicate on the "ssl_expire_at" field.
+func SslExpireAtNEQ(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldSslExpireAt, v))
+}
+
+// SslExpireAtIn applies the In predicate on the "ssl_expire_at" field.
+func SslExpireAtIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldSslExpireAt, vs...))
+}
+
+// SslExpireAtNotIn applies the NotIn predicate on the "ssl_expire_at" field.
+func SslExpireAtNotIn(vs ...time.Time) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldSslExpireAt, vs...))
+}
+
+// SslExpireAtGT applies the GT predicate on the "ssl_expire_at" field.
+func SslExpireAtGT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldSslExpireAt, v))
+}
+
+// SslExpireAtGTE applies the GTE predicate on the "ssl_expire_at" field.
+func SslExpireAtGTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldSslExpireAt, v))
+}
+
+// SslExpireAtLT applies the LT predicate on the "ssl_expire_at" field.
+func SslExpireAtLT(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldSslExpireAt, v))
+}
+
+// SslExpireAtLTE applies the LTE predicate on the "ssl_expire_at" field.
+func SslExpireAtLTE(v time.Time) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldSslExpireAt, v))
+}
+
+// SslExpireAtIsNil applies the IsNil predicate on the "ssl_expire_at" field.
+func SslExpireAtIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldSslExpireAt))
+}
+
+// SslExpireAtNotNil applies the NotNil predicate on the "ssl_expire_at" field.
+func SslExpireAtNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldSslExpireAt))
+}
+
+// OriginalCategoryIDEQ applies the EQ predicate on the "original_category_id" field.
+func OriginalCategoryIDEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldEQ(FieldOriginalCategoryID, v))
+}
+
+// OriginalCategoryIDNEQ applies the NEQ predicate on the "original_category_id" field.
+func OriginalCategoryIDNEQ(v int) predicate.Link {
+	return predicate.Link(sql.FieldNEQ(FieldOriginalCategoryID, v))
+}
+
+// OriginalCategoryIDIn applies the In predicate on the "original_category_id" field.
+func OriginalCategoryIDIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldIn(FieldOriginalCategoryID, vs...))
+}
+
+// OriginalCategoryIDNotIn applies the NotIn predicate on the "original_category_id" field.
+func OriginalCategoryIDNotIn(vs ...int) predicate.Link {
+	return predicate.Link(sql.FieldNotIn(FieldOriginalCategoryID, vs...))
+}
+
+// OriginalCategoryIDGT applies the GT predicate on the "original_category_id" field.
+func OriginalCategoryIDGT(v int) predicate.Link {
+	return predicate.Link(sql.FieldGT(FieldOriginalCategoryID, v))
+}
+
+// OriginalCategoryIDGTE applies the GTE predicate on the "original_category_id" field.
+func OriginalCategoryIDGTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldGTE(FieldOriginalCategoryID, v))
+}
+
+// OriginalCategoryIDLT applies the LT predicate on the "original_category_id" field.
+func OriginalCategoryIDLT(v int) predicate.Link {
+	return predicate.Link(sql.FieldLT(FieldOriginalCategoryID, v))
+}
+
+// OriginalCategoryIDLTE applies the LTE predicate on the "original_category_id" field.
+func OriginalCategoryIDLTE(v int) predicate.Link {
+	return predicate.Link(sql.FieldLTE(FieldOriginalCategoryID, v))
+}
+
+// OriginalCategoryIDIsNil applies the IsNil predicate on the "original_category_id" field.
+func OriginalCategoryIDIsNil() predicate.Link {
+	return predicate.Link(sql.FieldIsNull(FieldOriginalCategoryID))
+}
+
+// OriginalCategoryIDNotNil applies the NotNil predicate on the "original_category_id" field.
+func OriginalCategoryIDNotNil() predicate.Link {
+	return predicate.Link(sql.FieldNotNull(FieldOriginalCategoryID))
+}
+
 // HasCategory applies the HasEdge predicate on the "category" edge.
 func HasCategory() predicate.Link {
 	return predicate.Link(func(s *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -175,6 +176,90 @@ func (_c *LinkCreate) SetNillableSkipHealthCheck(v *bool) *LinkCreate {
 	return _c
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (_c *LinkCreate) SetCheckInterval(v int) *LinkCreate {
+	_c.mutation.SetCheckInterval(v)
+	return _c
+}
+
+// SetNillableCheckInterval sets the "check_interval" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableCheckInterval(v *int) *LinkCreate {
+	if v != nil {
+		_c.SetCheckInterval(*v)
+	}
+	return _c
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_c *LinkCreate) SetLastCheckedAt(v time.Time) *LinkCreate {
+	_c.mutation.SetLastCheckedAt(v)
+	return _c
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableLastCheckedAt(v *time.Time) *LinkCreate {
+	if v != nil {
+		_c.SetLastCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *LinkCreate) SetConsecutiveFailures(v int) *LinkCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableConsecutiveFailures(v *int) *LinkCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetLastResponseTime sets the "last_response_time" field.
+func (_c *LinkCreate) SetLastResponseTime(v int) *LinkCreate {
+	_c.mutation.SetLastResponseTime(v)
+	return _c
+}
+
+// SetNillableLastResponseTime sets the "last_response_time" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableLastResponseTime(v *int) *LinkCreate {
+	if v != nil {
+		_c.SetLastResponseTime(*v)
+	}
+	return _c
+}
+
+// SetSslExpireAt sets the "ssl_expire_at" field.
+func (_c *LinkCreate) SetSslExpireAt(v time.Time) *LinkCreate {
+	_c.mutation.SetSslExpireAt(v)
+	return _c
+}
+
+// SetNillableSslExpireAt sets the "ssl_expire_at" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableSslExpireAt(v *time.Time) *LinkCreate {
+	if v != nil {
+		_c.SetSslExpireAt(*v)
+	}
+	return _c
+}
+
+// SetOriginalCategoryID sets the "original_category_id" field.
+func (_c *LinkCreate) SetOriginalCategoryID(v int) *LinkCreate {
+	_c.mutation.SetOriginalCategoryID(v)
+	return _c
+}
+
+// SetNillableOriginalCategoryID sets the "original_category_id" field if the given value is not nil.
+func (_c *LinkCreate) SetNillableOriginalCategoryID(v *int) *LinkCreate {
+	if v != nil {
+		_c.SetOriginalCategoryID(*v)
+	}
+	return _c
+}
+
 // SetCategoryID sets the "category" edge to the LinkCategory entity by ID.
 func (_c *LinkCreate) SetCategoryID(id int) *LinkCreate {
 	_c.mutation.SetCategoryID(id)
@@ -248,6 +333,18 @@ func (_c *LinkCreate) defaults() {
 		v := link.DefaultSkipHealthCheck
 		_c.mutation.SetSkipHealthCheck(v)
 	}
+	if _, ok := _c.mutation.CheckInterval(); !ok {
+		v := link.DefaultCheckInterval
+		_c.mutation.SetCheckInterval(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := link.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := _c.mutation.LastResponseTime(); !ok {
+		v := link.DefaultLastResponseTime
+		_c.mutation.SetLastResponseTime(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -287,6 +384,20 @@ func (_c *LinkCreate) check() error {
 	if _, ok := _c.mutation.SkipHealthCheck(); !ok {
 		return &ValidationError{Name: "skip_health_check", err: errors.New(`ent: missing required field "Link.skip_health_check"`)}
 	}
+	if _, ok := _c.mutation.CheckInterval(); !ok {
+		return &ValidationError{Name: "check_interval", err: errors.New(`ent: missing required field "Link.check_interval"`)}
+	}
+	if v, ok := _c.mutation.CheckInterval(); ok {
+		if err := link.CheckIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "check_interval", err: fmt.Errorf(`ent: validator failed for field "Link.check_interval": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "Link.consecutive_failures"`)}
+	}
+	if _, ok := _c.mutation.LastResponseTime(); !ok {
+		return &ValidationError{Name: "last_response_time", err: errors.New(`ent: missing required field "Link.last_response_time"`)}
+	}
 	if len(_c.mutation.CategoryIDs()) == 0 {
 		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "Link.category"`)}
 	}
@@ -365,6 +476,30 @@ func (_c *LinkCreate) createSpec() (*Link, *sqlgraph.CreateSpec) {
 		_spec.SetField(link.FieldSkipHealthCheck, field.TypeBool, value)
 		_node.SkipHealthCheck = value
 	}
+	if value, ok := _c.mutation.CheckInterval(); ok {
+		_spec.SetField(link.FieldCheckInterval, field.TypeInt, value)
+		_node.CheckInterval = value
+	}
+	if value, ok := _c.mutation.LastCheckedAt(); ok {
+		_spec.SetField(link.FieldLastCheckedAt, field.TypeTime, value)
+		_node.LastCheckedAt = &value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(link.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.LastResponseTime(); ok {
+		_spec.SetField(link.FieldLastResponseTime, field.TypeInt, value)
+		_node.LastResponseTime = value
+	}
+	if value, ok := _c.mutation.SslExpireAt(); ok {
+		_spec.SetField(link.FieldSslExpireAt, field.TypeTime, value)
+		_node.SslExpireAt = &value
+	}
+	if value, ok := _c.mutation.OriginalCategoryID(); ok {
+		_spec.SetField(link.FieldOriginalCategoryID, field.TypeInt, value)
+		_node.OriginalCategoryID = &value
+	}
 	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -642,6 +777,120 @@ func (u *LinkUpsert) UpdateSkipHealthCheck() *LinkUpsert {
 	return u
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (u *LinkUpsert) SetCheckInterval(v int) *LinkUpsert {
+	u.Set(link.FieldCheckInterval, v)
+	return u
+}
+
+// UpdateCheckInterval sets the "check_interval" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateCheckInterval() *LinkUpsert {
+	u.SetExcluded(link.FieldCheckInterval)
+	return u
+}
+
+// AddCheckInterval adds v to the "check_interval" field.
+func (u *LinkUpsert) AddCheckInterval(v int) *LinkUpsert {
+	u.Add(link.FieldCheckInterval, v)
+	return u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *LinkUpsert) SetLastCheckedAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldLastCheckedAt, v)
+	return u
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateLastCheckedAt() *LinkUpsert {
+	u.SetExcluded(link.FieldLastCheckedAt)
+	return u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *LinkUpsert) ClearLastCheckedAt() *LinkUpsert {
+	u.SetNull(link.FieldLastCheckedAt)
+	return u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *LinkUpsert) SetConsecutiveFailures(v int) *LinkUpsert {
+	u.Set(link.FieldConsecutiveFailures, v)
+	return u
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateConsecutiveFailures() *LinkUpsert {
+	u.SetExcluded(link.FieldConsecutiveFailures)
+	return u
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *LinkUpsert) AddConsecutiveFailures(v int) *LinkUpsert {
+	u.Add(link.FieldConsecutiveFailures, v)
+	return u
+}
+
+// SetLastResponseTime sets the "last_response_time" field.
+func (u *LinkUpsert) SetLastResponseTime(v int) *LinkUpsert {
+	u.Set(link.FieldLastResponseTime, v)
+	return u
+}
+
+// UpdateLastResponseTime sets the "last_response_time" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateLastResponseTime() *LinkUpsert {
+	u.SetExcluded(link.FieldLastResponseTime)
+	return u
+}
+
+// AddLastResponseTime adds v to the "last_response_time" field.
+func (u *LinkUpsert) AddLastResponseTime(v int) *LinkUpsert {
+	u.Add(link.FieldLastResponseTime, v)
+	return u
+}
+
+// SetSslExpireAt sets the "ssl_expire_at" field.
+func (u *LinkUpsert) SetSslExpireAt(v time.Time) *LinkUpsert {
+	u.Set(link.FieldSslExpireAt, v)
+	return u
+}
+
+// UpdateSslExpireAt sets the "ssl_expire_at" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateSslExpireAt() *LinkUpsert {
+	u.SetExcluded(link.FieldSslExpireAt)
+	return u
+}
+
+// ClearSslExpireAt clears the value of the "ssl_expire_at" field.
+func (u *LinkUpsert) ClearSslExpireAt() *LinkUpsert {
+	u.SetNull(link.FieldSslExpireAt)
+	return u
+}
+
+// SetOriginalCategoryID sets the "original_category_id" field.
+func (u *LinkUpsert) SetOriginalCategoryID(v int) *LinkUpsert {
+	u.Set(link.FieldOriginalCategoryID, v)
+	return u
+}
+
+// UpdateOriginalCategoryID sets the "original_category_id" field to the value that was provided on create.
+func (u *LinkUpsert) UpdateOriginalCategoryID() *LinkUpsert {
+	u.SetExcluded(link.FieldOriginalCategoryID)
+	return u
+}
+
+// AddOriginalCategoryID adds v to the "original_category_id" field.
+func (u *LinkUpsert) AddOriginalCategoryID(v int) *LinkUpsert {
+	u.Add(link.FieldOriginalCategoryID, v)
+	return u
+}
+
+// ClearOriginalCategoryID clears the value of the "original_category_id" field.
+func (u *LinkUpsert) ClearOriginalCategoryID() *LinkUpsert {
+	u.SetNull(link.FieldOriginalCategoryID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -906,6 +1155,139 @@ func (u *LinkUpsertOne) UpdateSkipHealthCheck() *LinkUpsertOne {
 	})
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (u *LinkUpsertOne) SetCheckInterval(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCheckInterval(v)
+	})
+}
+
+// AddCheckInterval adds v to the "check_interval" field.
+func (u *LinkUpsertOne) AddCheckInterval(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddCheckInterval(v)
+	})
+}
+
+// UpdateCheckInterval sets the "check_interval" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateCheckInterval() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCheckInterval()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *LinkUpsertOne) SetLastCheckedAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateLastCheckedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *LinkUpsertOne) ClearLastCheckedAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearLastCheckedAt()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *LinkUpsertOne) SetConsecutiveFailures(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *LinkUpsertOne) AddConsecutiveFailures(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateConsecutiveFailures() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetLastResponseTime sets the "last_response_time" field.
+func (u *LinkUpsertOne) SetLastResponseTime(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetLastResponseTime(v)
+	})
+}
+
+// AddLastResponseTime adds v to the "last_response_time" field.
+func (u *LinkUpsertOne) AddLastResponseTime(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddLastResponseTime(v)
+	})
+}
+
+// UpdateLastResponseTime sets the "last_response_time" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateLastResponseTime() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateLastResponseTime()
+	})
+}
+
+// SetSslExpireAt sets the "ssl_expire_at" field.
+func (u *LinkUpsertOne) SetSslExpireAt(v time.Time) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSslExpireAt(v)
+	})
+}
+
+// UpdateSslExpireAt sets the "ssl_expire_at" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateSslExpireAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSslExpireAt()
+	})
+}
+
+// ClearSslExpireAt clears the value of the "ssl_expire_at" field.
+func (u *LinkUpsertOne) ClearSslExpireAt() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSslExpireAt()
+	})
+}
+
+// SetOriginalCategoryID sets the "original_category_id" field.
+func (u *LinkUpsertOne) SetOriginalCategoryID(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetOriginalCategoryID(v)
+	})
+}
+
+// AddOriginalCategoryID adds v to the "original_category_id" field.
+func (u *LinkUpsertOne) AddOriginalCategoryID(v int) *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddOriginalCategoryID(v)
+	})
+}
+
+// UpdateOriginalCategoryID sets the "original_category_id" field to the value that was provided on create.
+func (u *LinkUpsertOne) UpdateOriginalCategoryID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateOriginalCategoryID()
+	})
+}
+
+// ClearOriginalCategoryID clears the value of the "original_category_id" field.
+func (u *LinkUpsertOne) ClearOriginalCategoryID() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearOriginalCategoryID()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1334,6 +1716,139 @@ func (u *LinkUpsertBulk) UpdateSkipHealthCheck() *LinkUpsertBulk {
 	})
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (u *LinkUpsertBulk) SetCheckInterval(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetCheckInterval(v)
+	})
+}
+
+// AddCheckInterval adds v to the "check_interval" field.
+func (u *LinkUpsertBulk) AddCheckInterval(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddCheckInterval(v)
+	})
+}
+
+// UpdateCheckInterval sets the "check_interval" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateCheckInterval() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateCheckInterval()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *LinkUpsertBulk) SetLastCheckedAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateLastCheckedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *LinkUpsertBulk) ClearLastCheckedAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearLastCheckedAt()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *LinkUpsertBulk) SetConsecutiveFailures(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *LinkUpsertBulk) AddConsecutiveFailures(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateConsecutiveFailures() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetLastResponseTime sets the "last_response_time" field.
+func (u *LinkUpsertBulk) SetLastResponseTime(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetLastResponseTime(v)
+	})
+}
+
+// AddLastResponseTime adds v to the "last_response_time" field.
+func (u *LinkUpsertBulk) AddLastResponseTime(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddLastResponseTime(v)
+	})
+}
+
+// UpdateLastResponseTime sets the "last_response_time" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateLastResponseTime() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateLastResponseTime()
+	})
+}
+
+// SetSslExpireAt sets the "ssl_expire_at" field.
+func (u *LinkUpsertBulk) SetSslExpireAt(v time.Time) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetSslExpireAt(v)
+	})
+}
+
+// UpdateSslExpireAt sets the "ssl_expire_at" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateSslExpireAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateSslExpireAt()
+	})
+}
+
+// ClearSslExpireAt clears the value of the "ssl_expire_at" field.
+func (u *LinkUpsertBulk) ClearSslExpireAt() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearSslExpireAt()
+	})
+}
+
+// SetOriginalCategoryID sets the "original_category_id" field.
+func (u *LinkUpsertBulk) SetOriginalCategoryID(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.SetOriginalCategoryID(v)
+	})
+}
+
+// AddOriginalCategoryID adds v to the "original_category_id" field.
+func (u *LinkUpsertBulk) AddOriginalCategoryID(v int) *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.AddOriginalCategoryID(v)
+	})
+}
+
+// UpdateOriginalCategoryID sets the "original_category_id" field to the value that was provided on create.
+func (u *LinkUpsertBulk) UpdateOriginalCategoryID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.UpdateOriginalCategoryID()
+	})
+}
+
+// ClearOriginalCategoryID clears the value of the "original_category_id" field.
+func (u *LinkUpsertBulk) ClearOriginalCategoryID() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.ClearOriginalCategoryID()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -247,6 +248,136 @@ func (_u *LinkUpdate) SetNillableSkipHealthCheck(v *bool) *LinkUpdate {
 	return _u
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (_u *LinkUpdate) SetCheckInterval(v int) *LinkUpdate {
+	_u.mutation.ResetCheckInterval()
+	_u.mutation.SetCheckInterval(v)
+	return _u
+}
+
+// SetNillableCheckInterval sets the "check_interval" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableCheckInterval(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetCheckInterval(*v)
+	}
+	return _u
+}
+
+// AddCheckInterval adds value to the "check_interval" field.
+func (_u *LinkUpdate) AddCheckInterval(v int) *LinkUpdate {
+	_u.mutation.AddCheckInterval(v)
+	return _u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_u *LinkUpdate) SetLastCheckedAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetLastCheckedAt(v)
+	return _u
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableLastCheckedAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetLastCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (_u *LinkUpdate) ClearLastCheckedAt() *LinkUpdate {
+	_u.mutation.ClearLastCheckedAt()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *LinkUpdate) SetConsecutiveFailures(v int) *LinkUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableConsecutiveFailures(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *LinkUpdate) AddConsecutiveFailures(v int) *LinkUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetLastResponseTime sets the "last_response_time" field.
+func (_u *LinkUpdate) SetLastResponseTime(v int) *LinkUpdate {
+	_u.mutation.ResetLastResponseTime()
+	_u.mutation.SetLastResponseTime(v)
+	return _u
+}
+
+// SetNillableLastResponseTime sets the "last_response_time" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableLastResponseTime(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetLastResponseTime(*v)
+	}
+	return _u
+}
+
+// AddLastResponseTime adds value to the "last_response_time" field.
+func (_u *LinkUpdate) AddLastResponseTime(v int) *LinkUpdate {
+	_u.mutation.AddLastResponseTime(v)
+	return _u
+}
+
+// SetSslExpireAt sets the "ssl_expire_at" field.
+func (_u *LinkUpdate) SetSslExpireAt(v time.Time) *LinkUpdate {
+	_u.mutation.SetSslExpireAt(v)
+	return _u
+}
+
+// SetNillableSslExpireAt sets the "ssl_expire_at" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableSslExpireAt(v *time.Time) *LinkUpdate {
+	if v != nil {
+		_u.SetSslExpireAt(*v)
+	}
+	return _u
+}
+
+// ClearSslExpireAt clears the value of the "ssl_expire_at" field.
+func (_u *LinkUpdate) ClearSslExpireAt() *LinkUpdate {
+	_u.mutation.ClearSslExpireAt()
+	return _u
+}
+
+// SetOriginalCategoryID sets the "original_category_id" field.
+func (_u *LinkUpdate) SetOriginalCategoryID(v int) *LinkUpdate {
+	_u.mutation.ResetOriginalCategoryID()
+	_u.mutation.SetOriginalCategoryID(v)
+	return _u
+}
+
+// SetNillableOriginalCategoryID sets the "original_category_id" field if the given value is not nil.
+func (_u *LinkUpdate) SetNillableOriginalCategoryID(v *int) *LinkUpdate {
+	if v != nil {
+		_u.SetOriginalCategoryID(*v)
+	}
+	return _u
+}
+
+// AddOriginalCategoryID adds value to the "original_category_id" field.
+func (_u *LinkUpdate) AddOriginalCategoryID(v int) *LinkUpdate {
+	_u.mutation.AddOriginalCategoryID(v)
+	return _u
+}
+
+// ClearOriginalCategoryID clears the value of the "original_category_id" field.
+func (_u *LinkUpdate) ClearOriginalCategoryID() *LinkUpdate {
+	_u.mutation.ClearOriginalCategoryID()
+	return _u
+}
+
 // SetCategoryID sets the "category" edge to the LinkCategory entity by ID.
 func (_u *LinkUpdate) SetCategoryID(id int) *LinkUpdate {
 	_u.mutation.SetCategoryID(id)
@@ -354,6 +485,11 @@ func (_u *LinkUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Link.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CheckInterval(); ok {
+		if err := link.CheckIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "check_interval", err: fmt.Errorf(`ent: validator failed for field "Link.check_interval": %w`, err)}
+		}
+	}
 	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Link.category"`)
 	}
@@ -438,6 +574,45 @@ func (_u *LinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.SkipHealthCheck(); ok {
 		_spec.SetField(link.FieldSkipHealthCheck, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.CheckInterval(); ok {
+		_spec.SetField(link.FieldCheckInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckInterval(); ok {
+		_spec.AddField(link.FieldCheckInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastCheckedAt(); ok {
+		_spec.SetField(link.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(link.FieldLastCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(link.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(link.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastResponseTime(); ok {
+		_spec.SetField(link.FieldLastResponseTime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastResponseTime(); ok {
+		_spec.AddField(link.FieldLastResponseTime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SslExpireAt(); ok {
+		_spec.SetField(link.FieldSslExpireAt, field.TypeTime, value)
+	}
+	if _u.mutation.SslExpireAtCleared() {
+		_spec.ClearField(link.FieldSslExpireAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OriginalCategoryID(); ok {
+		_spec.SetField(link.FieldOriginalCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalCategoryID(); ok {
+		_spec.AddField(link.FieldOriginalCategoryID, field.TypeInt, value)
+	}
+	if _u.mutation.OriginalCategoryIDCleared() {
+		_spec.ClearField(link.FieldOriginalCategoryID, field.TypeInt)
+	}
 	if _u.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -751,6 +926,136 @@ func (_u *LinkUpdateOne) SetNillableSkipHealthCheck(v *bool) *LinkUpdateOne {
 	return _u
 }
 
+// SetCheckInterval sets the "check_interval" field.
+func (_u *LinkUpdateOne) SetCheckInterval(v int) *LinkUpdateOne {
+	_u.mutation.ResetCheckInterval()
+	_u.mutation.SetCheckInterval(v)
+	return _u
+}
+
+// SetNillableCheckInterval sets the "check_interval" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableCheckInterval(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetCheckInterval(*v)
+	}
+	return _u
+}
+
+// AddCheckInterval adds value to the "check_interval" field.
+func (_u *LinkUpdateOne) AddCheckInterval(v int) *LinkUpdateOne {
+	_u.mutation.AddCheckInterval(v)
+	return _u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_u *LinkUpdateOne) SetLastCheckedAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetLastCheckedAt(v)
+	return _u
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableLastCheckedAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetLastCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (_u *LinkUpdateOne) ClearLastCheckedAt() *LinkUpdateOne {
+	_u.mutation.ClearLastCheckedAt()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *LinkUpdateOne) SetConsecutiveFailures(v int) *LinkUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableConsecutiveFailures(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *LinkUpdateOne) AddConsecutiveFailures(v int) *LinkUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetLastResponseTime sets the "last_response_time" field.
+func (_u *LinkUpdateOne) SetLastResponseTime(v int) *LinkUpdateOne {
+	_u.mutation.ResetLastResponseTime()
+	_u.mutation.SetLastResponseTime(v)
+	return _u
+}
+
+// SetNillableLastResponseTime sets the "last_response_time" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableLastResponseTime(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetLastResponseTime(*v)
+	}
+	return _u
+}
+
+// AddLastResponseTime adds value to the "last_response_time" field.
+func (_u *LinkUpdateOne) AddLastResponseTime(v int) *LinkUpdateOne {
+	_u.mutation.AddLastResponseTime(v)
+	return _u
+}
+
+// SetSslExpireAt sets the "ssl_expire_at" field.
+func (_u *LinkUpdateOne) SetSslExpireAt(v time.Time) *LinkUpdateOne {
+	_u.mutation.SetSslExpireAt(v)
+	return _u
+}
+
+// SetNillableSslExpireAt sets the "ssl_expire_at" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableSslExpireAt(v *time.Time) *LinkUpdateOne {
+	if v != nil {
+		_u.SetSslExpireAt(*v)
+	}
+	return _u
+}
+
+// ClearSslExpireAt clears the value of the "ssl_expire_at" field.
+func (_u *LinkUpdateOne) ClearSslExpireAt() *LinkUpdateOne {
+	_u.mutation.ClearSslExpireAt()
+	return _u
+}
+
+// SetOriginalCategoryID sets the "original_category_id" field.
+func (_u *LinkUpdateOne) SetOriginalCategoryID(v int) *LinkUpdateOne {
+	_u.mutation.ResetOriginalCategoryID()
+	_u.mutation.SetOriginalCategoryID(v)
+	return _u
+}
+
+// SetNillableOriginalCategoryID sets the "original_category_id" field if the given value is not nil.
+func (_u *LinkUpdateOne) SetNillableOriginalCategoryID(v *int) *LinkUpdateOne {
+	if v != nil {
+		_u.SetOriginalCategoryID(*v)
+	}
+	return _u
+}
+
+// AddOriginalCategoryID adds value to the "original_category_id" field.
+func (_u *LinkUpdateOne) AddOriginalCategoryID(v int) *LinkUpdateOne {
+	_u.mutation.AddOriginalCategoryID(v)
+	return _u
+}
+
+// ClearOriginalCategoryID clears the value of the "original_category_id" field.
+func (_u *LinkUpdateOne) ClearOriginalCategoryID() *LinkUpdateOne {
+	_u.mutation.ClearOriginalCategoryID()
+	return _u
+}
+
 // SetCategoryID sets the "category" edge to the LinkCategory entity by ID.
 func (_u *LinkUpdateOne) SetCategoryID(id int) *LinkUpdateOne {
 	_u.mutation.SetCategoryID(id)
@@ -871,6 +1176,11 @@ func (_u *LinkUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Link.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CheckInterval(); ok {
+		if err := link.CheckIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "check_interval", err: fmt.Errorf(`ent: validator failed for field "Link.check_interval": %w`, err)}
+		}
+	}
 	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Link.category"`)
 	}
@@ -972,6 +1282,45 @@ func (_u *LinkUpdateOne) sqlSave(ctx context.Context) (_node *Link, err error) {
 	if value, ok := _u.mutation.SkipHealthCheck(); ok {
 		_spec.SetField(link.FieldSkipHealthCheck, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.CheckInterval(); ok {
+		_spec.SetField(link.FieldCheckInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckInterval(); ok {
+		_spec.AddField(link.FieldCheckInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastCheckedAt(); ok {
+		_spec.SetField(link.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(link.FieldLastCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(link.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(link.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastResponseTime(); ok {
+		_spec.SetField(link.FieldLastResponseTime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastResponseTime(); ok {
+		_spec.AddField(link.FieldLastResponseTime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SslExpireAt(); ok {
+		_spec.SetField(link.FieldSslExpireAt, field.TypeTime, value)
+	}
+	if _u.mutation.SslExpireAtCleared() {
+		_spec.ClearField(link.FieldSslExpireAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OriginalCategoryID(); ok {
+		_spec.SetField(link.FieldOriginalCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalCategoryID(); ok {
+		_spec.AddField(link.FieldOriginalCategoryID, field.TypeInt, value)
+	}
+	if _u.mutation.OriginalCategoryIDCleared() {
+		_spec.ClearField(link.FieldOriginalCategoryID, field.TypeInt)
+	}
 	if _u.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
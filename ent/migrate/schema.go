@@ -8,6 +8,32 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "令牌所属用户ID"},
+		{Name: "name", Type: field.TypeString, Comment: "令牌名称，便于区分用途"},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Comment: "令牌的 SHA-256 哈希，明文只在创建时返回一次"},
+		{Name: "token_prefix", Type: field.TypeString, Comment: "令牌前缀，用于界面展示识别"},
+		{Name: "scopes", Type: field.TypeString, Comment: "空格分隔的权限范围: create update delete media", Default: "create update media"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近使用时间"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Comment:    "个人访问令牌表",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessTokensColumns[2]},
+			},
+		},
+	}
 	// AlbumsColumns holds the columns for the "albums" table.
 	AlbumsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -94,8 +120,9 @@ var (
 		{Name: "copyright_author_href", Type: field.TypeString, Nullable: true, Comment: "版权作者链接"},
 		{Name: "copyright_url", Type: field.TypeString, Nullable: true, Comment: "版权来源链接"},
 		{Name: "keywords", Type: field.TypeString, Nullable: true, Comment: "文章关键词，用于SEO优化"},
+		{Name: "link_url", Type: field.TypeString, Nullable: true, Comment: "链接文章指向的外部URL，非空时该文章为链接文章（正文为点评）"},
 		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true, Comment: "定时发布时间，当status为SCHEDULED时有效"},
-		{Name: "review_status", Type: field.TypeEnum, Comment: "审核状态：NONE-无需审核, PENDING-待审核, APPROVED-已通过, REJECTED-已拒绝", Enums: []string{"NONE", "PENDING", "APPROVED", "REJECTED"}, Default: "NONE"},
+		{Name: "review_status", Type: field.TypeEnum, Comment: "审核状态：NONE-无需审核, PENDING-待审核, CHANGES_REQUESTED-需修改, APPROVED-已通过, REJECTED-已拒绝", Enums: []string{"NONE", "PENDING", "CHANGES_REQUESTED", "APPROVED", "REJECTED"}, Default: "NONE"},
 		{Name: "review_comment", Type: field.TypeString, Nullable: true, Comment: "审核意见"},
 		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true, Comment: "审核时间"},
 		{Name: "reviewed_by", Type: field.TypeUint, Nullable: true, Comment: "审核人ID"},
@@ -121,7 +148,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "articles_doc_series_articles",
-				Columns:    []*schema.Column{ArticlesColumns[44]},
+				Columns:    []*schema.Column{ArticlesColumns[45]},
 				RefColumns: []*schema.Column{DocSeriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -130,7 +157,7 @@ var (
 			{
 				Name:    "article_deleted_at_status_is_takedown_review_status_show_on_home",
 				Unique:  false,
-				Columns: []*schema.Column{ArticlesColumns[1], ArticlesColumns[9], ArticlesColumns[33], ArticlesColumns[29], ArticlesColumns[16]},
+				Columns: []*schema.Column{ArticlesColumns[1], ArticlesColumns[9], ArticlesColumns[34], ArticlesColumns[30], ArticlesColumns[16]},
 			},
 			{
 				Name:    "article_deleted_at_status_pin_sort_created_at",
@@ -145,7 +172,7 @@ var (
 			{
 				Name:    "article_deleted_at_is_doc_doc_series_id_doc_sort",
 				Unique:  false,
-				Columns: []*schema.Column{ArticlesColumns[1], ArticlesColumns[39], ArticlesColumns[44], ArticlesColumns[40]},
+				Columns: []*schema.Column{ArticlesColumns[1], ArticlesColumns[40], ArticlesColumns[45], ArticlesColumns[41]},
 			},
 			{
 				Name:    "article_deleted_at_owner_id_status",
@@ -154,6 +181,54 @@ var (
 			},
 		},
 	}
+	// ArticleAudiosColumns holds the columns for the "article_audios" table.
+	ArticleAudiosColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "article_id", Type: field.TypeUint, Comment: "文章ID"},
+		{Name: "status", Type: field.TypeEnum, Comment: "生成状态", Enums: []string{"PENDING", "READY", "FAILED"}, Default: "PENDING"},
+		{Name: "provider", Type: field.TypeString, Nullable: true, Comment: "TTS 服务提供方"},
+		{Name: "voice", Type: field.TypeString, Nullable: true, Comment: "使用的音色"},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true, Comment: "生成音频时文章朗读文本与音色的哈希，用于判断是否需要重新生成"},
+		{Name: "file_public_id", Type: field.TypeString, Nullable: true, Comment: "音频文件的公共ID"},
+		{Name: "url", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "音频直链地址"},
+		{Name: "char_count", Type: field.TypeInt, Comment: "朗读文本的字符数", Default: 0},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "最近一次生成失败的原因"},
+	}
+	// ArticleAudiosTable holds the schema information for the "article_audios" table.
+	ArticleAudiosTable = &schema.Table{
+		Name:       "article_audios",
+		Comment:    "文章语音朗读版本表",
+		Columns:    ArticleAudiosColumns,
+		PrimaryKey: []*schema.Column{ArticleAudiosColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articleaudio_article_id",
+				Unique:  true,
+				Columns: []*schema.Column{ArticleAudiosColumns[3]},
+			},
+		},
+	}
+	// ArticleCollectionsColumns holds the columns for the "article_collections" table.
+	ArticleCollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "合集名称"},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 64, Comment: "合集标识，前台模块通过它读取合集，如 home-banner / editors-picks"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "合集说明"},
+		{Name: "article_ids", Type: field.TypeJSON, Nullable: true, Comment: "按展示顺序排列的文章ID列表"},
+		{Name: "is_published", Type: field.TypeBool, Comment: "是否在前台公开", Default: true},
+		{Name: "sort", Type: field.TypeInt, Comment: "排序，数值越小越靠前", Default: 0},
+	}
+	// ArticleCollectionsTable holds the schema information for the "article_collections" table.
+	ArticleCollectionsTable = &schema.Table{
+		Name:       "article_collections",
+		Comment:    "文章合集表",
+		Columns:    ArticleCollectionsColumns,
+		PrimaryKey: []*schema.Column{ArticleCollectionsColumns[0]},
+	}
 	// ArticleHistoriesColumns holds the columns for the "article_histories" table.
 	ArticleHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -206,6 +281,156 @@ var (
 			},
 		},
 	}
+	// ArticleReactionsColumns holds the columns for the "article_reactions" table.
+	ArticleReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "article_id", Type: field.TypeUint, Comment: "文章ID"},
+		{Name: "reaction", Type: field.TypeString, Size: 16, Comment: "回应类型，如 like、love"},
+		{Name: "count", Type: field.TypeInt, Comment: "回应次数", Default: 0},
+	}
+	// ArticleReactionsTable holds the schema information for the "article_reactions" table.
+	ArticleReactionsTable = &schema.Table{
+		Name:       "article_reactions",
+		Comment:    "文章表情回应计数表",
+		Columns:    ArticleReactionsColumns,
+		PrimaryKey: []*schema.Column{ArticleReactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articlereaction_article_id_reaction",
+				Unique:  true,
+				Columns: []*schema.Column{ArticleReactionsColumns[2], ArticleReactionsColumns[3]},
+			},
+		},
+	}
+	// ArticleReviewNotesColumns holds the columns for the "article_review_notes" table.
+	ArticleReviewNotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "article_id", Type: field.TypeUint, Comment: "投稿文章ID"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "操作人ID（投稿者或审核人）"},
+		{Name: "action", Type: field.TypeEnum, Comment: "操作类型: submit(提交/重新提交) / comment(审核意见) / request_changes(要求修改) / approve(通过并发布) / reject(拒绝)", Enums: []string{"submit", "comment", "request_changes", "approve", "reject"}},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "审核意见或提交说明"},
+	}
+	// ArticleReviewNotesTable holds the schema information for the "article_review_notes" table.
+	ArticleReviewNotesTable = &schema.Table{
+		Name:       "article_review_notes",
+		Comment:    "投稿审核记录表",
+		Columns:    ArticleReviewNotesColumns,
+		PrimaryKey: []*schema.Column{ArticleReviewNotesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articlereviewnote_article_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArticleReviewNotesColumns[2], ArticleReviewNotesColumns[1]},
+			},
+		},
+	}
+	// ArticleTemplatesColumns holds the columns for the "article_templates" table.
+	ArticleTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "name", Type: field.TypeString, Unique: true, Comment: "模板名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "模板说明"},
+		{Name: "sort", Type: field.TypeInt, Comment: "排序，数值越小越靠前", Default: 0},
+		{Name: "title", Type: field.TypeString, Nullable: true, Comment: "默认标题，支持 {{date}} 等占位符"},
+		{Name: "content_md", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "正文骨架 Markdown，支持占位符"},
+		{Name: "summaries", Type: field.TypeJSON, Nullable: true, Comment: "摘要结构"},
+		{Name: "cover_url", Type: field.TypeString, Nullable: true, Comment: "默认封面图URL"},
+		{Name: "top_img_url", Type: field.TypeString, Nullable: true, Comment: "默认顶部大图URL"},
+		{Name: "post_tag_ids", Type: field.TypeJSON, Nullable: true, Comment: "默认标签公共ID列表"},
+		{Name: "post_category_ids", Type: field.TypeJSON, Nullable: true, Comment: "默认分类公共ID列表"},
+		{Name: "keywords", Type: field.TypeString, Nullable: true, Comment: "默认SEO关键词"},
+		{Name: "copyright", Type: field.TypeBool, Comment: "是否显示版权信息", Default: true},
+		{Name: "is_reprint", Type: field.TypeBool, Comment: "是否为转载", Default: false},
+		{Name: "copyright_author", Type: field.TypeString, Nullable: true, Comment: "版权作者"},
+		{Name: "copyright_author_href", Type: field.TypeString, Nullable: true, Comment: "版权作者链接"},
+		{Name: "copyright_url", Type: field.TypeString, Nullable: true, Comment: "原文链接"},
+	}
+	// ArticleTemplatesTable holds the schema information for the "article_templates" table.
+	ArticleTemplatesTable = &schema.Table{
+		Name:       "article_templates",
+		Comment:    "文章模板表",
+		Columns:    ArticleTemplatesColumns,
+		PrimaryKey: []*schema.Column{ArticleTemplatesColumns[0]},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "记录时间"},
+		{Name: "actor_id", Type: field.TypeUint, Comment: "实际操作者（管理员）用户ID"},
+		{Name: "subject_user_id", Type: field.TypeUint, Comment: "被模拟的用户ID，非模拟操作时为 0", Default: 0},
+		{Name: "action", Type: field.TypeString, Size: 64, Comment: "操作类型，如 impersonation.start / article.update"},
+		{Name: "entity_type", Type: field.TypeString, Nullable: true, Size: 32, Comment: "被修改的对象类型，如 article / setting，非数据变更时为空"},
+		{Name: "entity_id", Type: field.TypeString, Nullable: true, Size: 128, Comment: "被修改对象的公共ID或键名"},
+		{Name: "method", Type: field.TypeString, Nullable: true, Size: 16, Comment: "HTTP 方法"},
+		{Name: "path", Type: field.TypeString, Nullable: true, Size: 512, Comment: "请求路径"},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true, Comment: "响应状态码"},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "操作者 IP"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512, Comment: "操作者 User-Agent"},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "附加说明，如模拟登录的原因"},
+		{Name: "before_data", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "变更前的快照（JSON），新建时为空"},
+		{Name: "after_data", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "变更后的快照（JSON），删除时为空"},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Comment:    "审计日志表",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_subject_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_entity_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[1]},
+			},
+		},
+	}
+	// ChangelogEntriesColumns holds the columns for the "changelog_entries" table.
+	ChangelogEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "version", Type: field.TypeString, Unique: true, Size: 64, Comment: "版本号，同步时对应 Release 的 tag"},
+		{Name: "title", Type: field.TypeString, Size: 255, Comment: "标题", Default: ""},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "更新说明（Markdown）", Default: ""},
+		{Name: "content_html", Type: field.TypeString, Size: 2147483647, Comment: "渲染后的更新说明 HTML", Default: ""},
+		{Name: "url", Type: field.TypeString, Size: 512, Comment: "版本详情链接，如 GitHub Release 页面", Default: ""},
+		{Name: "source", Type: field.TypeEnum, Comment: "来源：github 为自动同步，manual 为手动录入或编辑过", Enums: []string{"github", "manual"}, Default: "manual"},
+		{Name: "is_prerelease", Type: field.TypeBool, Comment: "是否为预发布版本", Default: false},
+		{Name: "is_published", Type: field.TypeBool, Comment: "是否在更新页面公开", Default: true},
+		{Name: "published_at", Type: field.TypeTime, Comment: "发布时间"},
+	}
+	// ChangelogEntriesTable holds the schema information for the "changelog_entries" table.
+	ChangelogEntriesTable = &schema.Table{
+		Name:       "changelog_entries",
+		Comment:    "更新日志表",
+		Columns:    ChangelogEntriesColumns,
+		PrimaryKey: []*schema.Column{ChangelogEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "changelogentry_is_published_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChangelogEntriesColumns[10], ChangelogEntriesColumns[11]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -221,13 +446,14 @@ var (
 		{Name: "website", Type: field.TypeString, Nullable: true, Size: 255, Comment: "评论者个人网站链接"},
 		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "评论内容 (Markdown格式)"},
 		{Name: "content_html", Type: field.TypeString, Size: 2147483647, Comment: "经后端安全处理后的HTML格式评论内容"},
-		{Name: "status", Type: field.TypeInt, Comment: "评论状态 1:已发布 2:待审核", Default: 2},
+		{Name: "status", Type: field.TypeInt, Comment: "评论状态 1:已发布 2:待审核 3:垃圾评论", Default: 2},
 		{Name: "is_admin_comment", Type: field.TypeBool, Comment: "是否为博主/管理员的评论", Default: false},
 		{Name: "is_anonymous", Type: field.TypeBool, Comment: "是否为匿名评论（使用匿名邮箱发表的评论）", Default: false},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512, Comment: "评论者的 User Agent 信息"},
 		{Name: "ip_address", Type: field.TypeString, Size: 45, Comment: "评论者的IP地址"},
 		{Name: "ip_location", Type: field.TypeString, Nullable: true, Size: 255, Comment: "IP地址归属地"},
 		{Name: "like_count", Type: field.TypeInt, Comment: "点赞数", Default: 0},
+		{Name: "reaction_counts", Type: field.TypeJSON, Nullable: true, Comment: "各类表情回应的数量，由回应表汇总得出"},
 		{Name: "pinned_at", Type: field.TypeTime, Nullable: true, Comment: "评论置顶时间，为NULL表示未置顶"},
 		{Name: "article_comments", Type: field.TypeUint, Nullable: true},
 		{Name: "parent_id", Type: field.TypeUint, Nullable: true, Comment: "父评论ID (用于嵌套回复)"},
@@ -242,19 +468,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "comments_articles_comments",
-				Columns:    []*schema.Column{CommentsColumns[21]},
+				Columns:    []*schema.Column{CommentsColumns[22]},
 				RefColumns: []*schema.Column{ArticlesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_comments_parent",
-				Columns:    []*schema.Column{CommentsColumns[22]},
+				Columns:    []*schema.Column{CommentsColumns[23]},
 				RefColumns: []*schema.Column{CommentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[23]},
+				Columns:    []*schema.Column{CommentsColumns[24]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -268,20 +494,110 @@ var (
 			{
 				Name:    "comment_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[22]},
+				Columns: []*schema.Column{CommentsColumns[23]},
 			},
 			{
 				Name:    "comment_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[23]},
+				Columns: []*schema.Column{CommentsColumns[24]},
 			},
 			{
 				Name:    "comment_email",
 				Unique:  false,
 				Columns: []*schema.Column{CommentsColumns[8]},
 			},
+			{
+				Name:    "comment_deleted_at_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[1], CommentsColumns[13], CommentsColumns[2]},
+			},
+		},
+	}
+	// CommentReactionsColumns holds the columns for the "comment_reactions" table.
+	CommentReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "comment_id", Type: field.TypeUint, Comment: "评论ID"},
+		{Name: "reaction", Type: field.TypeString, Size: 16, Comment: "回应类型，如 like / love / laugh / wow / sad"},
+		{Name: "visitor_hash", Type: field.TypeString, Size: 64, Comment: "访客标识（Cookie 或 IP+UA 指纹）的加盐哈希"},
+	}
+	// CommentReactionsTable holds the schema information for the "comment_reactions" table.
+	CommentReactionsTable = &schema.Table{
+		Name:       "comment_reactions",
+		Comment:    "评论表情回应表",
+		Columns:    CommentReactionsColumns,
+		PrimaryKey: []*schema.Column{CommentReactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentreaction_comment_id_reaction_visitor_hash",
+				Unique:  true,
+				Columns: []*schema.Column{CommentReactionsColumns[2], CommentReactionsColumns[3], CommentReactionsColumns[4]},
+			},
 		},
 	}
+	// CommentSubscriptionsColumns holds the columns for the "comment_subscriptions" table.
+	CommentSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "target_path", Type: field.TypeString, Size: 255, Comment: "订阅的评论区路径"},
+		{Name: "email", Type: field.TypeString, Size: 255, Comment: "订阅者邮箱（小写）"},
+		{Name: "is_active", Type: field.TypeBool, Comment: "是否有效，退订后为 false", Default: true},
+		{Name: "notified_until", Type: field.TypeTime, Comment: "已通知到的评论时间，摘要只包含此后发布的评论"},
+		{Name: "pending_since", Type: field.TypeTime, Nullable: true, Comment: "有待发送的新评论时记录首条评论的时间，发送摘要后清空"},
+		{Name: "last_sent_at", Type: field.TypeTime, Nullable: true, Comment: "上次发送摘要邮件的时间"},
+	}
+	// CommentSubscriptionsTable holds the schema information for the "comment_subscriptions" table.
+	CommentSubscriptionsTable = &schema.Table{
+		Name:       "comment_subscriptions",
+		Comment:    "评论订阅表",
+		Columns:    CommentSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{CommentSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentsubscription_target_path_email",
+				Unique:  true,
+				Columns: []*schema.Column{CommentSubscriptionsColumns[3], CommentSubscriptionsColumns[4]},
+			},
+			{
+				Name:    "commentsubscription_pending_since",
+				Unique:  false,
+				Columns: []*schema.Column{CommentSubscriptionsColumns[7]},
+			},
+		},
+	}
+	// CommenterTrustsColumns holds the columns for the "commenter_trusts" table.
+	CommenterTrustsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "email_md5", Type: field.TypeString, Unique: true, Comment: "评论者邮箱的 MD5 哈希"},
+		{Name: "status", Type: field.TypeEnum, Comment: "信任状态: trusted(已信任，评论自动发布) / revoked(管理员撤销信任，评论需审核)", Enums: []string{"trusted", "revoked"}},
+	}
+	// CommenterTrustsTable holds the schema information for the "commenter_trusts" table.
+	CommenterTrustsTable = &schema.Table{
+		Name:       "commenter_trusts",
+		Comment:    "评论者信任状态表",
+		Columns:    CommenterTrustsColumns,
+		PrimaryKey: []*schema.Column{CommenterTrustsColumns[0]},
+	}
+	// ContentSnippetsColumns holds the columns for the "content_snippets" table.
+	ContentSnippetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "name", Type: field.TypeString, Unique: true, Comment: "片段名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "片段说明"},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "片段内容 Markdown"},
+		{Name: "sort", Type: field.TypeInt, Comment: "排序，数值越小越靠前", Default: 0},
+	}
+	// ContentSnippetsTable holds the schema information for the "content_snippets" table.
+	ContentSnippetsTable = &schema.Table{
+		Name:       "content_snippets",
+		Comment:    "编辑器内容片段表",
+		Columns:    ContentSnippetsColumns,
+		PrimaryKey: []*schema.Column{ContentSnippetsColumns[0]},
+	}
 	// DirectLinksColumns holds the columns for the "direct_links" table.
 	DirectLinksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -308,6 +624,29 @@ var (
 			},
 		},
 	}
+	// DisposableEmailStatsColumns holds the columns for the "disposable_email_stats" table.
+	DisposableEmailStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "scope", Type: field.TypeString, Size: 32, Comment: "业务场景：comment / register"},
+		{Name: "action", Type: field.TypeString, Size: 32, Comment: "处理方式：reject / hold"},
+		{Name: "count", Type: field.TypeInt64, Comment: "累计拦截次数", Default: 0},
+	}
+	// DisposableEmailStatsTable holds the schema information for the "disposable_email_stats" table.
+	DisposableEmailStatsTable = &schema.Table{
+		Name:       "disposable_email_stats",
+		Comment:    "一次性邮箱拦截计数表",
+		Columns:    DisposableEmailStatsColumns,
+		PrimaryKey: []*schema.Column{DisposableEmailStatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "disposableemailstat_scope_action",
+				Unique:  true,
+				Columns: []*schema.Column{DisposableEmailStatsColumns[3], DisposableEmailStatsColumns[4]},
+			},
+		},
+	}
 	// DocSeriesColumns holds the columns for the "doc_series" table.
 	DocSeriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -349,6 +688,13 @@ var (
 		Comment:    "存储实体表，存储物理文件信息",
 		Columns:    EntitiesColumns,
 		PrimaryKey: []*schema.Column{EntitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entity_policy_id",
+				Unique:  false,
+				Columns: []*schema.Column{EntitiesColumns[8]},
+			},
+		},
 	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
@@ -431,6 +777,43 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fileentity_file_id",
+				Unique:  false,
+				Columns: []*schema.Column{FileEntitiesColumns[8]},
+			},
+			{
+				Name:    "fileentity_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{FileEntitiesColumns[7]},
+			},
+		},
+	}
+	// InvitationCodesColumns holds the columns for the "invitation_codes" table.
+	InvitationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 32, Comment: "邀请码"},
+		{Name: "max_uses", Type: field.TypeInt, Comment: "最大可使用次数", Default: 1},
+		{Name: "used_count", Type: field.TypeInt, Comment: "已使用次数", Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "created_by", Type: field.TypeUint, Comment: "创建者（管理员）用户ID"},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255, Comment: "备注"},
+	}
+	// InvitationCodesTable holds the schema information for the "invitation_codes" table.
+	InvitationCodesTable = &schema.Table{
+		Name:       "invitation_codes",
+		Comment:    "注册邀请码表",
+		Columns:    InvitationCodesColumns,
+		PrimaryKey: []*schema.Column{InvitationCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitationcode_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationCodesColumns[1]},
+			},
+		},
 	}
 	// LinksColumns holds the columns for the "links" table.
 	LinksColumns = []*schema.Column{
@@ -447,6 +830,12 @@ var (
 		{Name: "update_reason", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "修改类型时的修改原因"},
 		{Name: "sort_order", Type: field.TypeInt, Comment: "排序权重，数字越小越靠前", Default: 0},
 		{Name: "skip_health_check", Type: field.TypeBool, Comment: "是否跳过健康检查", Default: false},
+		{Name: "check_interval", Type: field.TypeInt, Comment: "健康检查间隔（小时），0 表示使用全局设置", Default: 0},
+		{Name: "last_checked_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次健康检查时间"},
+		{Name: "consecutive_failures", Type: field.TypeInt, Comment: "连续检查失败次数", Default: 0},
+		{Name: "last_response_time", Type: field.TypeInt, Comment: "最近一次检查的响应耗时（毫秒）", Default: 0},
+		{Name: "ssl_expire_at", Type: field.TypeTime, Nullable: true, Comment: "HTTPS 证书过期时间"},
+		{Name: "original_category_id", Type: field.TypeInt, Nullable: true, Comment: "被移入失联分类前所在的分类ID，恢复后移回"},
 		{Name: "link_category_links", Type: field.TypeInt},
 	}
 	// LinksTable holds the schema information for the "links" table.
@@ -458,7 +847,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "links_link_categories_links",
-				Columns:    []*schema.Column{LinksColumns[13]},
+				Columns:    []*schema.Column{LinksColumns[19]},
 				RefColumns: []*schema.Column{LinkCategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -478,6 +867,36 @@ var (
 		Columns:    LinkCategoriesColumns,
 		PrimaryKey: []*schema.Column{LinkCategoriesColumns[0]},
 	}
+	// LinkCheckRecordsColumns holds the columns for the "link_check_records" table.
+	LinkCheckRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "link_id", Type: field.TypeInt, Comment: "友链ID"},
+		{Name: "checked_at", Type: field.TypeTime, Comment: "检查时间"},
+		{Name: "healthy", Type: field.TypeBool, Comment: "是否可访问"},
+		{Name: "status_code", Type: field.TypeInt, Comment: "HTTP 状态码，请求失败时为0", Default: 0},
+		{Name: "response_time", Type: field.TypeInt, Comment: "响应耗时（毫秒）", Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 500, Comment: "请求失败原因"},
+		{Name: "ssl_expire_at", Type: field.TypeTime, Nullable: true, Comment: "HTTPS 证书过期时间"},
+	}
+	// LinkCheckRecordsTable holds the schema information for the "link_check_records" table.
+	LinkCheckRecordsTable = &schema.Table{
+		Name:       "link_check_records",
+		Comment:    "友链健康检查记录表",
+		Columns:    LinkCheckRecordsColumns,
+		PrimaryKey: []*schema.Column{LinkCheckRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkcheckrecord_link_id_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkCheckRecordsColumns[1], LinkCheckRecordsColumns[2]},
+			},
+			{
+				Name:    "linkcheckrecord_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{LinkCheckRecordsColumns[2]},
+			},
+		},
+	}
 	// LinkTagsColumns holds the columns for the "link_tags" table.
 	LinkTagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -491,6 +910,27 @@ var (
 		Columns:    LinkTagsColumns,
 		PrimaryKey: []*schema.Column{LinkTagsColumns[0]},
 	}
+	// MailTemplateVersionsColumns holds the columns for the "mail_template_versions" table.
+	MailTemplateVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "保存时间"},
+		{Name: "setting_key", Type: field.TypeString, Size: 100, Comment: "模板对应的配置键"},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "被替换前的模板内容"},
+	}
+	// MailTemplateVersionsTable holds the schema information for the "mail_template_versions" table.
+	MailTemplateVersionsTable = &schema.Table{
+		Name:       "mail_template_versions",
+		Comment:    "邮件模板历史版本表",
+		Columns:    MailTemplateVersionsColumns,
+		PrimaryKey: []*schema.Column{MailTemplateVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mailtemplateversion_setting_key_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MailTemplateVersionsColumns[2], MailTemplateVersionsColumns[1]},
+			},
+		},
+	}
 	// MetadataColumns holds the columns for the "metadata" table.
 	MetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -523,6 +963,94 @@ var (
 			},
 		},
 	}
+	// MomentsColumns holds the columns for the "moments" table.
+	MomentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "发布时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Comment: "说说内容 Markdown 原文"},
+		{Name: "content_html", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "由 content 解析和净化后的 HTML"},
+		{Name: "images", Type: field.TypeJSON, Nullable: true, Comment: "配图URL列表"},
+		{Name: "location", Type: field.TypeString, Nullable: true, Comment: "发布地点"},
+		{Name: "comment_enabled", Type: field.TypeBool, Comment: "是否允许评论", Default: true},
+		{Name: "is_public", Type: field.TypeBool, Comment: "是否公开显示在时间线中", Default: true},
+	}
+	// MomentsTable holds the schema information for the "moments" table.
+	MomentsTable = &schema.Table{
+		Name:       "moments",
+		Comment:    "说说（短动态）表",
+		Columns:    MomentsColumns,
+		PrimaryKey: []*schema.Column{MomentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moment_is_public_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MomentsColumns[8], MomentsColumns[1]},
+			},
+		},
+	}
+	// MusicPlayStatsColumns holds the columns for the "music_play_stats" table.
+	MusicPlayStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "period", Type: field.TypeString, Size: 7, Comment: "统计月份，格式 YYYY-MM（中国时区）"},
+		{Name: "song_id", Type: field.TypeString, Size: 64, Comment: "歌曲ID（网易云歌曲ID或自定义歌单中的ID）"},
+		{Name: "song_name", Type: field.TypeString, Size: 255, Comment: "歌曲名称（最近一次上报）", Default: ""},
+		{Name: "artist", Type: field.TypeString, Size: 255, Comment: "歌手（最近一次上报）", Default: ""},
+		{Name: "play_count", Type: field.TypeInt64, Comment: "播放次数", Default: 0},
+		{Name: "total_duration", Type: field.TypeInt64, Comment: "累计收听时长（秒）", Default: 0},
+	}
+	// MusicPlayStatsTable holds the schema information for the "music_play_stats" table.
+	MusicPlayStatsTable = &schema.Table{
+		Name:       "music_play_stats",
+		Comment:    "音乐播放统计表",
+		Columns:    MusicPlayStatsColumns,
+		PrimaryKey: []*schema.Column{MusicPlayStatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "musicplaystat_period_song_id",
+				Unique:  true,
+				Columns: []*schema.Column{MusicPlayStatsColumns[3], MusicPlayStatsColumns[4]},
+			},
+		},
+	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "channel", Type: field.TypeString, Size: 32, Comment: "投递渠道：email / bark / webhook"},
+		{Name: "kind", Type: field.TypeString, Nullable: true, Size: 64, Comment: "通知类型，如 comment、link_application"},
+		{Name: "recipient", Type: field.TypeString, Nullable: true, Size: 255, Comment: "接收方（邮箱地址或推送目标主机），仅用于展示"},
+		{Name: "subject", Type: field.TypeString, Nullable: true, Size: 255, Comment: "通知标题，仅用于展示"},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Comment: "渲染完成的投递内容（JSON），重试时原样发送"},
+		{Name: "status", Type: field.TypeEnum, Comment: "投递状态：FAILED 表示已达到最大重试次数", Enums: []string{"PENDING", "SENDING", "SENT", "FAILED"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Comment: "已尝试次数", Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Comment: "最大尝试次数", Default: 5},
+		{Name: "next_attempt_at", Type: field.TypeTime, Comment: "下次尝试时间"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "最近一次失败的原因"},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true, Comment: "发送成功时间"},
+	}
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Comment:    "通知投递队列表",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[8], NotificationDeliveriesColumns[11]},
+			},
+			{
+				Name:    "notificationdelivery_channel_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[3], NotificationDeliveriesColumns[1]},
+			},
+		},
+	}
 	// NotificationTypesColumns holds the columns for the "notification_types" table.
 	NotificationTypesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -616,6 +1144,66 @@ var (
 		Columns:    PostTagsColumns,
 		PrimaryKey: []*schema.Column{PostTagsColumns[0]},
 	}
+	// ReadingProgressesColumns holds the columns for the "reading_progresses" table.
+	ReadingProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "用户ID"},
+		{Name: "article_id", Type: field.TypeUint, Comment: "文章ID"},
+		{Name: "progress", Type: field.TypeFloat64, Comment: "阅读进度，0 到 1 之间的比例", Default: 0},
+		{Name: "anchor", Type: field.TypeString, Nullable: true, Size: 255, Comment: "最近阅读位置对应的标题锚点"},
+	}
+	// ReadingProgressesTable holds the schema information for the "reading_progresses" table.
+	ReadingProgressesTable = &schema.Table{
+		Name:       "reading_progresses",
+		Comment:    "用户阅读进度表",
+		Columns:    ReadingProgressesColumns,
+		PrimaryKey: []*schema.Column{ReadingProgressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "readingprogress_user_id_article_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReadingProgressesColumns[2], ReadingProgressesColumns[3]},
+			},
+			{
+				Name:    "readingprogress_user_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReadingProgressesColumns[2], ReadingProgressesColumns[1]},
+			},
+		},
+	}
+	// RecycleItemsColumns holds the columns for the "recycle_items" table.
+	RecycleItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "owner_id", Type: field.TypeUint, Comment: "所有者用户ID"},
+		{Name: "file_id", Type: field.TypeUint, Unique: true, Comment: "被移入回收站的文件/文件夹ID"},
+		{Name: "original_name", Type: field.TypeString, Size: 255, Comment: "移入回收站前的名称"},
+		{Name: "original_parent_id", Type: field.TypeUint, Comment: "移入回收站前的父目录ID"},
+		{Name: "original_path", Type: field.TypeString, Size: 2147483647, Comment: "移入回收站前的完整路径，仅用于展示"},
+		{Name: "file_type", Type: field.TypeInt, Comment: "类型: 1-文件, 2-文件夹"},
+		{Name: "size", Type: field.TypeInt64, Comment: "文件大小（字节），文件夹为0", Default: 0},
+		{Name: "trashed_at", Type: field.TypeTime, Comment: "移入回收站的时间"},
+		{Name: "expire_at", Type: field.TypeTime, Comment: "过期时间，过期后由后台任务彻底删除"},
+	}
+	// RecycleItemsTable holds the schema information for the "recycle_items" table.
+	RecycleItemsTable = &schema.Table{
+		Name:       "recycle_items",
+		Comment:    "回收站表",
+		Columns:    RecycleItemsColumns,
+		PrimaryKey: []*schema.Column{RecycleItemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recycleitem_owner_id_trashed_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecycleItemsColumns[1], RecycleItemsColumns[8]},
+			},
+			{
+				Name:    "recycleitem_expire_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecycleItemsColumns[9]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -633,6 +1221,21 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// SpamTokensColumns holds the columns for the "spam_tokens" table.
+	SpamTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "token", Type: field.TypeString, Unique: true, Size: 64, Comment: "词条，保留词条 __samples__ 记录两类样本总数"},
+		{Name: "spam_count", Type: field.TypeInt, Comment: "在垃圾评论样本中出现的次数", Default: 0},
+		{Name: "ham_count", Type: field.TypeInt, Comment: "在正常评论样本中出现的次数", Default: 0},
+	}
+	// SpamTokensTable holds the schema information for the "spam_tokens" table.
+	SpamTokensTable = &schema.Table{
+		Name:       "spam_tokens",
+		Comment:    "反垃圾评论词条计数表",
+		Columns:    SpamTokensColumns,
+		PrimaryKey: []*schema.Column{SpamTokensColumns[0]},
+	}
 	// StoragePoliciesColumns holds the columns for the "storage_policies" table.
 	StoragePoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -660,6 +1263,35 @@ var (
 		Columns:    StoragePoliciesColumns,
 		PrimaryKey: []*schema.Column{StoragePoliciesColumns[0]},
 	}
+	// StoragePolicyMountsColumns holds the columns for the "storage_policy_mounts" table.
+	StoragePolicyMountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "policy_id", Type: field.TypeUint, Comment: "所属存储策略ID"},
+		{Name: "virtual_path", Type: field.TypeString, Size: 255, Comment: "挂载的虚拟路径，如 /photos"},
+		{Name: "read_only", Type: field.TypeBool, Comment: "是否为只读挂载", Default: false},
+		{Name: "node_id", Type: field.TypeUint, Nullable: true, Comment: "挂载点对应的目录ID"},
+	}
+	// StoragePolicyMountsTable holds the schema information for the "storage_policy_mounts" table.
+	StoragePolicyMountsTable = &schema.Table{
+		Name:       "storage_policy_mounts",
+		Comment:    "存储策略挂载点表（策略主挂载路径之外的别名路径）",
+		Columns:    StoragePolicyMountsColumns,
+		PrimaryKey: []*schema.Column{StoragePolicyMountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "storagepolicymount_virtual_path",
+				Unique:  true,
+				Columns: []*schema.Column{StoragePolicyMountsColumns[4]},
+			},
+			{
+				Name:    "storagepolicymount_policy_id",
+				Unique:  false,
+				Columns: []*schema.Column{StoragePolicyMountsColumns[3]},
+			},
+		},
+	}
 	// SubscribersColumns holds the columns for the "subscribers" table.
 	SubscribersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -744,6 +1376,43 @@ var (
 			},
 		},
 	}
+	// UploadSessionsColumns holds the columns for the "upload_sessions" table.
+	UploadSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "session_id", Type: field.TypeString, Unique: true, Size: 64, Comment: "上传会话ID，与临时物理实体的 upload_session_id 对应"},
+		{Name: "owner_id", Type: field.TypeUint, Comment: "发起上传的用户ID"},
+		{Name: "policy_id", Type: field.TypeString, Size: 64, Comment: "存储策略的公共ID"},
+		{Name: "uri", Type: field.TypeString, Size: 2147483647, Comment: "文件的完整目标URI"},
+		{Name: "chunk_size", Type: field.TypeInt, Comment: "分片大小（字节）"},
+		{Name: "file_size", Type: field.TypeInt64, Comment: "文件总大小（字节）"},
+		{Name: "temp_entity_id", Type: field.TypeUint, Comment: "上传完成前关联的临时物理实体ID"},
+		{Name: "uploaded_chunks", Type: field.TypeJSON, Nullable: true, Comment: "已接收的分片序号"},
+		{Name: "checksum", Type: field.TypeString, Nullable: true, Size: 128, Comment: "客户端提供的整个文件摘要，合并分片后校验"},
+		{Name: "throughput", Type: field.TypeFloat64, Comment: "分片接收速度的指数滑动平均值（字节/秒）", Default: 0},
+		{Name: "version", Type: field.TypeInt, Comment: "乐观锁版本号，并发上传分片时避免相互覆盖已接收的分片", Default: 0},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "last_active_at", Type: field.TypeTime, Comment: "最近一个分片上传完成的时间"},
+		{Name: "expire_at", Type: field.TypeTime, Comment: "过期时间，过期后由后台任务清理"},
+	}
+	// UploadSessionsTable holds the schema information for the "upload_sessions" table.
+	UploadSessionsTable = &schema.Table{
+		Name:       "upload_sessions",
+		Comment:    "分片上传会话表",
+		Columns:    UploadSessionsColumns,
+		PrimaryKey: []*schema.Column{UploadSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uploadsession_owner_id_expire_at",
+				Unique:  false,
+				Columns: []*schema.Column{UploadSessionsColumns[2], UploadSessionsColumns[14]},
+			},
+			{
+				Name:    "uploadsession_expire_at",
+				Unique:  false,
+				Columns: []*schema.Column{UploadSessionsColumns[14]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -754,10 +1423,13 @@ var (
 		{Name: "password_hash", Type: field.TypeString, Size: 255},
 		{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 50, Comment: "用户昵称"},
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Size: 255, Comment: "用户头像URL"},
+		{Name: "avatar_variants", Type: field.TypeJSON, Nullable: true, Comment: "头像各尺寸版本的URL，键为边长像素"},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "个人简介"},
+		{Name: "profile_visibility", Type: field.TypeJSON, Nullable: true, Comment: "公开资料页的可见性设置"},
 		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true, Size: 100, Comment: "用户邮箱"},
 		{Name: "website", Type: field.TypeString, Nullable: true, Size: 255, Comment: "用户个人网站"},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
-		{Name: "status", Type: field.TypeInt, Comment: "用户状态 1:正常 2:未激活 3:已封禁", Default: 2},
+		{Name: "status", Type: field.TypeInt, Comment: "用户状态 1:正常 2:未激活 3:已封禁 4:待审核", Default: 2},
 		{Name: "user_group_id", Type: field.TypeUint},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -769,12 +1441,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_user_groups_users",
-				Columns:    []*schema.Column{UsersColumns[12]},
+				Columns:    []*schema.Column{UsersColumns[15]},
 				RefColumns: []*schema.Column{UserGroupsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// UserBookmarksColumns holds the columns for the "user_bookmarks" table.
+	UserBookmarksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "收藏时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "用户ID"},
+		{Name: "article_id", Type: field.TypeUint, Comment: "文章ID"},
+	}
+	// UserBookmarksTable holds the schema information for the "user_bookmarks" table.
+	UserBookmarksTable = &schema.Table{
+		Name:       "user_bookmarks",
+		Comment:    "用户文章书签表",
+		Columns:    UserBookmarksColumns,
+		PrimaryKey: []*schema.Column{UserBookmarksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userbookmark_user_id_article_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserBookmarksColumns[2], UserBookmarksColumns[3]},
+			},
+			{
+				Name:    "userbookmark_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserBookmarksColumns[2], UserBookmarksColumns[1]},
+			},
+		},
+	}
 	// UserGroupsColumns holds the columns for the "user_groups" table.
 	UserGroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -796,6 +1494,38 @@ var (
 		Columns:    UserGroupsColumns,
 		PrimaryKey: []*schema.Column{UserGroupsColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "本站用户ID"},
+		{Name: "provider", Type: field.TypeString, Size: 32, Comment: "第三方平台标识，如 github、google、qq"},
+		{Name: "provider_user_id", Type: field.TypeString, Size: 191, Comment: "第三方平台的用户唯一标识"},
+		{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 100, Comment: "第三方平台昵称"},
+		{Name: "avatar", Type: field.TypeString, Nullable: true, Size: 512, Comment: "第三方平台头像地址"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255, Comment: "第三方平台邮箱"},
+		{Name: "profile_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "第三方平台个人主页地址"},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Comment:    "用户社交账号绑定表",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_provider_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[4], UserIdentitiesColumns[5]},
+			},
+			{
+				Name:    "useridentity_user_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[3], UserIdentitiesColumns[4]},
+			},
+		},
+	}
 	// UserInstalledThemesColumns holds the columns for the "user_installed_themes" table.
 	UserInstalledThemesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -1058,31 +1788,57 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
 		AlbumsTable,
 		AlbumCategoriesTable,
 		ArticlesTable,
+		ArticleAudiosTable,
+		ArticleCollectionsTable,
 		ArticleHistoriesTable,
+		ArticleReactionsTable,
+		ArticleReviewNotesTable,
+		ArticleTemplatesTable,
+		AuditLogsTable,
+		ChangelogEntriesTable,
 		CommentsTable,
+		CommentReactionsTable,
+		CommentSubscriptionsTable,
+		CommenterTrustsTable,
+		ContentSnippetsTable,
 		DirectLinksTable,
+		DisposableEmailStatsTable,
 		DocSeriesTable,
 		EntitiesTable,
 		FilesTable,
 		FileEntitiesTable,
+		InvitationCodesTable,
 		LinksTable,
 		LinkCategoriesTable,
+		LinkCheckRecordsTable,
 		LinkTagsTable,
+		MailTemplateVersionsTable,
 		MetadataTable,
+		MomentsTable,
+		MusicPlayStatsTable,
+		NotificationDeliveriesTable,
 		NotificationTypesTable,
 		PagesTable,
 		PostCategoriesTable,
 		PostTagsTable,
+		ReadingProgressesTable,
+		RecycleItemsTable,
 		SettingsTable,
+		SpamTokensTable,
 		StoragePoliciesTable,
+		StoragePolicyMountsTable,
 		SubscribersTable,
 		TagsTable,
 		URLStatsTable,
+		UploadSessionsTable,
 		UsersTable,
+		UserBookmarksTable,
 		UserGroupsTable,
+		UserIdentitiesTable,
 		UserInstalledThemesTable,
 		UserNotificationConfigsTable,
 		VisitorLogsTable,
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
 // Album is the predicate function for album builders.
 type Album func(*sql.Selector)
 
@@ -15,15 +18,51 @@ type AlbumCategory func(*sql.Selector)
 // Article is the predicate function for article builders.
 type Article func(*sql.Selector)
 
+// ArticleAudio is the predicate function for articleaudio builders.
+type ArticleAudio func(*sql.Selector)
+
+// ArticleCollection is the predicate function for articlecollection builders.
+type ArticleCollection func(*sql.Selector)
+
 // ArticleHistory is the predicate function for articlehistory builders.
 type ArticleHistory func(*sql.Selector)
 
+// ArticleReaction is the predicate function for articlereaction builders.
+type ArticleReaction func(*sql.Selector)
+
+// ArticleReviewNote is the predicate function for articlereviewnote builders.
+type ArticleReviewNote func(*sql.Selector)
+
+// ArticleTemplate is the predicate function for articletemplate builders.
+type ArticleTemplate func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// ChangelogEntry is the predicate function for changelogentry builders.
+type ChangelogEntry func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// CommentReaction is the predicate function for commentreaction builders.
+type CommentReaction func(*sql.Selector)
+
+// CommentSubscription is the predicate function for commentsubscription builders.
+type CommentSubscription func(*sql.Selector)
+
+// CommenterTrust is the predicate function for commentertrust builders.
+type CommenterTrust func(*sql.Selector)
+
+// ContentSnippet is the predicate function for contentsnippet builders.
+type ContentSnippet func(*sql.Selector)
+
 // DirectLink is the predicate function for directlink builders.
 type DirectLink func(*sql.Selector)
 
+// DisposableEmailStat is the predicate function for disposableemailstat builders.
+type DisposableEmailStat func(*sql.Selector)
+
 // DocSeries is the predicate function for docseries builders.
 type DocSeries func(*sql.Selector)
 
@@ -36,18 +75,36 @@ type File func(*sql.Selector)
 // FileEntity is the predicate function for fileentity builders.
 type FileEntity func(*sql.Selector)
 
+// InvitationCode is the predicate function for invitationcode builders.
+type InvitationCode func(*sql.Selector)
+
 // Link is the predicate function for link builders.
 type Link func(*sql.Selector)
 
 // LinkCategory is the predicate function for linkcategory builders.
 type LinkCategory func(*sql.Selector)
 
+// LinkCheckRecord is the predicate function for linkcheckrecord builders.
+type LinkCheckRecord func(*sql.Selector)
+
 // LinkTag is the predicate function for linktag builders.
 type LinkTag func(*sql.Selector)
 
+// MailTemplateVersion is the predicate function for mailtemplateversion builders.
+type MailTemplateVersion func(*sql.Selector)
+
 // Metadata is the predicate function for metadata builders.
 type Metadata func(*sql.Selector)
 
+// Moment is the predicate function for moment builders.
+type Moment func(*sql.Selector)
+
+// MusicPlayStat is the predicate function for musicplaystat builders.
+type MusicPlayStat func(*sql.Selector)
+
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
 // NotificationType is the predicate function for notificationtype builders.
 type NotificationType func(*sql.Selector)
 
@@ -60,12 +117,24 @@ type PostCategory func(*sql.Selector)
 // PostTag is the predicate function for posttag builders.
 type PostTag func(*sql.Selector)
 
+// ReadingProgress is the predicate function for readingprogress builders.
+type ReadingProgress func(*sql.Selector)
+
+// RecycleItem is the predicate function for recycleitem builders.
+type RecycleItem func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
+// SpamToken is the predicate function for spamtoken builders.
+type SpamToken func(*sql.Selector)
+
 // StoragePolicy is the predicate function for storagepolicy builders.
 type StoragePolicy func(*sql.Selector)
 
+// StoragePolicyMount is the predicate function for storagepolicymount builders.
+type StoragePolicyMount func(*sql.Selector)
+
 // Subscriber is the predicate function for subscriber builders.
 type Subscriber func(*sql.Selector)
 
@@ -75,12 +144,21 @@ type Tag func(*sql.Selector)
 // URLStat is the predicate function for urlstat builders.
 type URLStat func(*sql.Selector)
 
+// UploadSession is the predicate function for uploadsession builders.
+type UploadSession func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserBookmark is the predicate function for userbookmark builders.
+type UserBookmark func(*sql.Selector)
+
 // UserGroup is the predicate function for usergroup builders.
 type UserGroup func(*sql.Selector)
 
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
 // UserInstalledTheme is the predicate function for userinstalledtheme builders.
 type UserInstalledTheme func(*sql.Selector)
 
@@ -110,6 +110,30 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The AccessTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AccessTokenQueryRuleFunc func(context.Context, *ent.AccessTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AccessTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AccessTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AccessTokenQuery", q)
+}
+
+// The AccessTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AccessTokenMutationRuleFunc func(context.Context, *ent.AccessTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AccessTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AccessTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AccessTokenMutation", m)
+}
+
 // The AlbumQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type AlbumQueryRuleFunc func(context.Context, *ent.AlbumQuery) error
@@ -182,6 +206,54 @@ func (f ArticleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleMutation", m)
 }
 
+// The ArticleAudioQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ArticleAudioQueryRuleFunc func(context.Context, *ent.ArticleAudioQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ArticleAudioQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArticleAudioQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ArticleAudioQuery", q)
+}
+
+// The ArticleAudioMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ArticleAudioMutationRuleFunc func(context.Context, *ent.ArticleAudioMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ArticleAudioMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ArticleAudioMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleAudioMutation", m)
+}
+
+// The ArticleCollectionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ArticleCollectionQueryRuleFunc func(context.Context, *ent.ArticleCollectionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ArticleCollectionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArticleCollectionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ArticleCollectionQuery", q)
+}
+
+// The ArticleCollectionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ArticleCollectionMutationRuleFunc func(context.Context, *ent.ArticleCollectionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ArticleCollectionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ArticleCollectionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleCollectionMutation", m)
+}
+
 // The ArticleHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ArticleHistoryQueryRuleFunc func(context.Context, *ent.ArticleHistoryQuery) error
@@ -206,6 +278,126 @@ func (f ArticleHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleHistoryMutation", m)
 }
 
+// The ArticleReactionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ArticleReactionQueryRuleFunc func(context.Context, *ent.ArticleReactionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ArticleReactionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArticleReactionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ArticleReactionQuery", q)
+}
+
+// The ArticleReactionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ArticleReactionMutationRuleFunc func(context.Context, *ent.ArticleReactionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ArticleReactionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ArticleReactionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleReactionMutation", m)
+}
+
+// The ArticleReviewNoteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ArticleReviewNoteQueryRuleFunc func(context.Context, *ent.ArticleReviewNoteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ArticleReviewNoteQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArticleReviewNoteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ArticleReviewNoteQuery", q)
+}
+
+// The ArticleReviewNoteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ArticleReviewNoteMutationRuleFunc func(context.Context, *ent.ArticleReviewNoteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ArticleReviewNoteMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ArticleReviewNoteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleReviewNoteMutation", m)
+}
+
+// The ArticleTemplateQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ArticleTemplateQueryRuleFunc func(context.Context, *ent.ArticleTemplateQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ArticleTemplateQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArticleTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ArticleTemplateQuery", q)
+}
+
+// The ArticleTemplateMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ArticleTemplateMutationRuleFunc func(context.Context, *ent.ArticleTemplateMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ArticleTemplateMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ArticleTemplateMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleTemplateMutation", m)
+}
+
+// The AuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogQueryRuleFunc func(context.Context, *ent.AuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogQuery", q)
+}
+
+// The AuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogMutationRuleFunc func(context.Context, *ent.AuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogMutation", m)
+}
+
+// The ChangelogEntryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ChangelogEntryQueryRuleFunc func(context.Context, *ent.ChangelogEntryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ChangelogEntryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChangelogEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ChangelogEntryQuery", q)
+}
+
+// The ChangelogEntryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ChangelogEntryMutationRuleFunc func(context.Context, *ent.ChangelogEntryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ChangelogEntryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ChangelogEntryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ChangelogEntryMutation", m)
+}
+
 // The CommentQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type CommentQueryRuleFunc func(context.Context, *ent.CommentQuery) error
@@ -230,6 +422,102 @@ func (f CommentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CommentMutation", m)
 }
 
+// The CommentReactionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CommentReactionQueryRuleFunc func(context.Context, *ent.CommentReactionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CommentReactionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommentReactionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CommentReactionQuery", q)
+}
+
+// The CommentReactionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CommentReactionMutationRuleFunc func(context.Context, *ent.CommentReactionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CommentReactionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CommentReactionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CommentReactionMutation", m)
+}
+
+// The CommentSubscriptionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CommentSubscriptionQueryRuleFunc func(context.Context, *ent.CommentSubscriptionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CommentSubscriptionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommentSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CommentSubscriptionQuery", q)
+}
+
+// The CommentSubscriptionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CommentSubscriptionMutationRuleFunc func(context.Context, *ent.CommentSubscriptionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CommentSubscriptionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CommentSubscriptionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CommentSubscriptionMutation", m)
+}
+
+// The CommenterTrustQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CommenterTrustQueryRuleFunc func(context.Context, *ent.CommenterTrustQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CommenterTrustQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommenterTrustQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CommenterTrustQuery", q)
+}
+
+// The CommenterTrustMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CommenterTrustMutationRuleFunc func(context.Context, *ent.CommenterTrustMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CommenterTrustMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CommenterTrustMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CommenterTrustMutation", m)
+}
+
+// The ContentSnippetQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ContentSnippetQueryRuleFunc func(context.Context, *ent.ContentSnippetQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ContentSnippetQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ContentSnippetQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ContentSnippetQuery", q)
+}
+
+// The ContentSnippetMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ContentSnippetMutationRuleFunc func(context.Context, *ent.ContentSnippetMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ContentSnippetMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ContentSnippetMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ContentSnippetMutation", m)
+}
+
 // The DirectLinkQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DirectLinkQueryRuleFunc func(context.Context, *ent.DirectLinkQuery) error
@@ -254,6 +542,30 @@ func (f DirectLinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Muta
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DirectLinkMutation", m)
 }
 
+// The DisposableEmailStatQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DisposableEmailStatQueryRuleFunc func(context.Context, *ent.DisposableEmailStatQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DisposableEmailStatQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DisposableEmailStatQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DisposableEmailStatQuery", q)
+}
+
+// The DisposableEmailStatMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DisposableEmailStatMutationRuleFunc func(context.Context, *ent.DisposableEmailStatMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DisposableEmailStatMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DisposableEmailStatMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DisposableEmailStatMutation", m)
+}
+
 // The DocSeriesQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DocSeriesQueryRuleFunc func(context.Context, *ent.DocSeriesQuery) error
@@ -350,6 +662,30 @@ func (f FileEntityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Muta
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileEntityMutation", m)
 }
 
+// The InvitationCodeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InvitationCodeQueryRuleFunc func(context.Context, *ent.InvitationCodeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InvitationCodeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InvitationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InvitationCodeQuery", q)
+}
+
+// The InvitationCodeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InvitationCodeMutationRuleFunc func(context.Context, *ent.InvitationCodeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InvitationCodeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InvitationCodeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InvitationCodeMutation", m)
+}
+
 // The LinkQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LinkQueryRuleFunc func(context.Context, *ent.LinkQuery) error
@@ -398,6 +734,30 @@ func (f LinkCategoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mu
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LinkCategoryMutation", m)
 }
 
+// The LinkCheckRecordQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LinkCheckRecordQueryRuleFunc func(context.Context, *ent.LinkCheckRecordQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LinkCheckRecordQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LinkCheckRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LinkCheckRecordQuery", q)
+}
+
+// The LinkCheckRecordMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LinkCheckRecordMutationRuleFunc func(context.Context, *ent.LinkCheckRecordMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LinkCheckRecordMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LinkCheckRecordMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LinkCheckRecordMutation", m)
+}
+
 // The LinkTagQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LinkTagQueryRuleFunc func(context.Context, *ent.LinkTagQuery) error
@@ -422,6 +782,30 @@ func (f LinkTagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LinkTagMutation", m)
 }
 
+// The MailTemplateVersionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MailTemplateVersionQueryRuleFunc func(context.Context, *ent.MailTemplateVersionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MailTemplateVersionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MailTemplateVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MailTemplateVersionQuery", q)
+}
+
+// The MailTemplateVersionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MailTemplateVersionMutationRuleFunc func(context.Context, *ent.MailTemplateVersionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MailTemplateVersionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MailTemplateVersionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MailTemplateVersionMutation", m)
+}
+
 // The MetadataQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type MetadataQueryRuleFunc func(context.Context, *ent.MetadataQuery) error
@@ -446,6 +830,78 @@ func (f MetadataMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MetadataMutation", m)
 }
 
+// The MomentQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MomentQueryRuleFunc func(context.Context, *ent.MomentQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MomentQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MomentQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MomentQuery", q)
+}
+
+// The MomentMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MomentMutationRuleFunc func(context.Context, *ent.MomentMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MomentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MomentMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MomentMutation", m)
+}
+
+// The MusicPlayStatQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MusicPlayStatQueryRuleFunc func(context.Context, *ent.MusicPlayStatQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MusicPlayStatQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MusicPlayStatQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MusicPlayStatQuery", q)
+}
+
+// The MusicPlayStatMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MusicPlayStatMutationRuleFunc func(context.Context, *ent.MusicPlayStatMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MusicPlayStatMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MusicPlayStatMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MusicPlayStatMutation", m)
+}
+
+// The NotificationDeliveryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type NotificationDeliveryQueryRuleFunc func(context.Context, *ent.NotificationDeliveryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f NotificationDeliveryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NotificationDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.NotificationDeliveryQuery", q)
+}
+
+// The NotificationDeliveryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type NotificationDeliveryMutationRuleFunc func(context.Context, *ent.NotificationDeliveryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f NotificationDeliveryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.NotificationDeliveryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.NotificationDeliveryMutation", m)
+}
+
 // The NotificationTypeQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type NotificationTypeQueryRuleFunc func(context.Context, *ent.NotificationTypeQuery) error
@@ -542,6 +998,54 @@ func (f PostTagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PostTagMutation", m)
 }
 
+// The ReadingProgressQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReadingProgressQueryRuleFunc func(context.Context, *ent.ReadingProgressQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReadingProgressQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReadingProgressQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReadingProgressQuery", q)
+}
+
+// The ReadingProgressMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReadingProgressMutationRuleFunc func(context.Context, *ent.ReadingProgressMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReadingProgressMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReadingProgressMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReadingProgressMutation", m)
+}
+
+// The RecycleItemQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RecycleItemQueryRuleFunc func(context.Context, *ent.RecycleItemQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RecycleItemQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RecycleItemQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RecycleItemQuery", q)
+}
+
+// The RecycleItemMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RecycleItemMutationRuleFunc func(context.Context, *ent.RecycleItemMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RecycleItemMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RecycleItemMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RecycleItemMutation", m)
+}
+
 // The SettingQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SettingQueryRuleFunc func(context.Context, *ent.SettingQuery) error
@@ -566,6 +1070,30 @@ func (f SettingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SettingMutation", m)
 }
 
+// The SpamTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SpamTokenQueryRuleFunc func(context.Context, *ent.SpamTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SpamTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SpamTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SpamTokenQuery", q)
+}
+
+// The SpamTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SpamTokenMutationRuleFunc func(context.Context, *ent.SpamTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SpamTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SpamTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SpamTokenMutation", m)
+}
+
 // The StoragePolicyQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type StoragePolicyQueryRuleFunc func(context.Context, *ent.StoragePolicyQuery) error
@@ -590,6 +1118,30 @@ func (f StoragePolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.M
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StoragePolicyMutation", m)
 }
 
+// The StoragePolicyMountQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type StoragePolicyMountQueryRuleFunc func(context.Context, *ent.StoragePolicyMountQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f StoragePolicyMountQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StoragePolicyMountQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.StoragePolicyMountQuery", q)
+}
+
+// The StoragePolicyMountMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type StoragePolicyMountMutationRuleFunc func(context.Context, *ent.StoragePolicyMountMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f StoragePolicyMountMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.StoragePolicyMountMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StoragePolicyMountMutation", m)
+}
+
 // The SubscriberQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SubscriberQueryRuleFunc func(context.Context, *ent.SubscriberQuery) error
@@ -662,6 +1214,30 @@ func (f URLStatMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.URLStatMutation", m)
 }
 
+// The UploadSessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UploadSessionQueryRuleFunc func(context.Context, *ent.UploadSessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UploadSessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UploadSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UploadSessionQuery", q)
+}
+
+// The UploadSessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UploadSessionMutationRuleFunc func(context.Context, *ent.UploadSessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UploadSessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UploadSessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UploadSessionMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
@@ -686,6 +1262,30 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
 }
 
+// The UserBookmarkQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserBookmarkQueryRuleFunc func(context.Context, *ent.UserBookmarkQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserBookmarkQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserBookmarkQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserBookmarkQuery", q)
+}
+
+// The UserBookmarkMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserBookmarkMutationRuleFunc func(context.Context, *ent.UserBookmarkMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserBookmarkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserBookmarkMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserBookmarkMutation", m)
+}
+
 // The UserGroupQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserGroupQueryRuleFunc func(context.Context, *ent.UserGroupQuery) error
@@ -710,6 +1310,30 @@ func (f UserGroupMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutat
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserGroupMutation", m)
 }
 
+// The UserIdentityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserIdentityQueryRuleFunc func(context.Context, *ent.UserIdentityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserIdentityQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserIdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserIdentityQuery", q)
+}
+
+// The UserIdentityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserIdentityMutationRuleFunc func(context.Context, *ent.UserIdentityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserIdentityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserIdentityMutation", m)
+}
+
 // The UserInstalledThemeQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserInstalledThemeQueryRuleFunc func(context.Context, *ent.UserInstalledThemeQuery) error
@@ -5,32 +5,58 @@ package runtime
 import (
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
 	"github.com/anzhiyu-c/anheyu-app/ent/album"
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/articleaudio"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlecollection"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereaction"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereviewnote"
+	"github.com/anzhiyu-c/anheyu-app/ent/articletemplate"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/changelogentry"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentertrust"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentreaction"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentsubscription"
+	"github.com/anzhiyu-c/anheyu-app/ent/contentsnippet"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
+	"github.com/anzhiyu-c/anheyu-app/ent/disposableemailstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkcheckrecord"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
+	"github.com/anzhiyu-c/anheyu-app/ent/mailtemplateversion"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
+	"github.com/anzhiyu-c/anheyu-app/ent/moment"
+	"github.com/anzhiyu-c/anheyu-app/ent/musicplaystat"
+	"github.com/anzhiyu-c/anheyu-app/ent/notificationdelivery"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/readingprogress"
+	"github.com/anzhiyu-c/anheyu-app/ent/recycleitem"
 	"github.com/anzhiyu-c/anheyu-app/ent/schema"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/spamtoken"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
+	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicymount"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/ent/tag"
+	"github.com/anzhiyu-c/anheyu-app/ent/uploadsession"
 	"github.com/anzhiyu-c/anheyu-app/ent/urlstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/ent/userbookmark"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
@@ -42,6 +68,20 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[1].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[3].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = accesstokenDescName.Validators[0].(func(string) error)
+	// accesstokenDescScopes is the schema descriptor for scopes field.
+	accesstokenDescScopes := accesstokenFields[6].Descriptor()
+	// accesstoken.DefaultScopes holds the default value on creation for the scopes field.
+	accesstoken.DefaultScopes = accesstokenDescScopes.Default.(string)
 	albumMixin := schema.Album{}.Mixin()
 	albumMixinHooks0 := albumMixin[0].Hooks()
 	album.Hooks[0] = albumMixinHooks0[0]
@@ -213,35 +253,115 @@ func init() {
 	// article.DefaultIsReprint holds the default value on creation for the is_reprint field.
 	article.DefaultIsReprint = articleDescIsReprint.Default.(bool)
 	// articleDescIsTakedown is the schema descriptor for is_takedown field.
-	articleDescIsTakedown := articleFields[32].Descriptor()
+	articleDescIsTakedown := articleFields[33].Descriptor()
 	// article.DefaultIsTakedown holds the default value on creation for the is_takedown field.
 	article.DefaultIsTakedown = articleDescIsTakedown.Default.(bool)
 	// articleDescExcludeFromMembership is the schema descriptor for exclude_from_membership field.
-	articleDescExcludeFromMembership := articleFields[37].Descriptor()
+	articleDescExcludeFromMembership := articleFields[38].Descriptor()
 	// article.DefaultExcludeFromMembership holds the default value on creation for the exclude_from_membership field.
 	article.DefaultExcludeFromMembership = articleDescExcludeFromMembership.Default.(bool)
 	// articleDescIsDoc is the schema descriptor for is_doc field.
-	articleDescIsDoc := articleFields[38].Descriptor()
+	articleDescIsDoc := articleFields[39].Descriptor()
 	// article.DefaultIsDoc holds the default value on creation for the is_doc field.
 	article.DefaultIsDoc = articleDescIsDoc.Default.(bool)
 	// articleDescDocSort is the schema descriptor for doc_sort field.
-	articleDescDocSort := articleFields[40].Descriptor()
+	articleDescDocSort := articleFields[41].Descriptor()
 	// article.DefaultDocSort holds the default value on creation for the doc_sort field.
 	article.DefaultDocSort = articleDescDocSort.Default.(int)
 	// article.DocSortValidator is a validator for the "doc_sort" field. It is called by the builders before save.
 	article.DocSortValidator = articleDescDocSort.Validators[0].(func(int) error)
 	// articleDescShowRewardButton is the schema descriptor for show_reward_button field.
-	articleDescShowRewardButton := articleFields[41].Descriptor()
+	articleDescShowRewardButton := articleFields[42].Descriptor()
 	// article.DefaultShowRewardButton holds the default value on creation for the show_reward_button field.
 	article.DefaultShowRewardButton = articleDescShowRewardButton.Default.(bool)
 	// articleDescShowShareButton is the schema descriptor for show_share_button field.
-	articleDescShowShareButton := articleFields[42].Descriptor()
+	articleDescShowShareButton := articleFields[43].Descriptor()
 	// article.DefaultShowShareButton holds the default value on creation for the show_share_button field.
 	article.DefaultShowShareButton = articleDescShowShareButton.Default.(bool)
 	// articleDescShowSubscribeButton is the schema descriptor for show_subscribe_button field.
-	articleDescShowSubscribeButton := articleFields[43].Descriptor()
+	articleDescShowSubscribeButton := articleFields[44].Descriptor()
 	// article.DefaultShowSubscribeButton holds the default value on creation for the show_subscribe_button field.
 	article.DefaultShowSubscribeButton = articleDescShowSubscribeButton.Default.(bool)
+	articleaudioFields := schema.ArticleAudio{}.Fields()
+	_ = articleaudioFields
+	// articleaudioDescCreatedAt is the schema descriptor for created_at field.
+	articleaudioDescCreatedAt := articleaudioFields[1].Descriptor()
+	// articleaudio.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articleaudio.DefaultCreatedAt = articleaudioDescCreatedAt.Default.(func() time.Time)
+	// articleaudioDescUpdatedAt is the schema descriptor for updated_at field.
+	articleaudioDescUpdatedAt := articleaudioFields[2].Descriptor()
+	// articleaudio.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articleaudio.DefaultUpdatedAt = articleaudioDescUpdatedAt.Default.(func() time.Time)
+	// articleaudio.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articleaudio.UpdateDefaultUpdatedAt = articleaudioDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articleaudioDescURL is the schema descriptor for url field.
+	articleaudioDescURL := articleaudioFields[9].Descriptor()
+	// articleaudio.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	articleaudio.URLValidator = articleaudioDescURL.Validators[0].(func(string) error)
+	// articleaudioDescCharCount is the schema descriptor for char_count field.
+	articleaudioDescCharCount := articleaudioFields[10].Descriptor()
+	// articleaudio.DefaultCharCount holds the default value on creation for the char_count field.
+	articleaudio.DefaultCharCount = articleaudioDescCharCount.Default.(int)
+	// articleaudio.CharCountValidator is a validator for the "char_count" field. It is called by the builders before save.
+	articleaudio.CharCountValidator = articleaudioDescCharCount.Validators[0].(func(int) error)
+	articlecollectionFields := schema.ArticleCollection{}.Fields()
+	_ = articlecollectionFields
+	// articlecollectionDescCreatedAt is the schema descriptor for created_at field.
+	articlecollectionDescCreatedAt := articlecollectionFields[1].Descriptor()
+	// articlecollection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articlecollection.DefaultCreatedAt = articlecollectionDescCreatedAt.Default.(func() time.Time)
+	// articlecollectionDescUpdatedAt is the schema descriptor for updated_at field.
+	articlecollectionDescUpdatedAt := articlecollectionFields[2].Descriptor()
+	// articlecollection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articlecollection.DefaultUpdatedAt = articlecollectionDescUpdatedAt.Default.(func() time.Time)
+	// articlecollection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articlecollection.UpdateDefaultUpdatedAt = articlecollectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articlecollectionDescName is the schema descriptor for name field.
+	articlecollectionDescName := articlecollectionFields[3].Descriptor()
+	// articlecollection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	articlecollection.NameValidator = func() func(string) error {
+		validators := articlecollectionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// articlecollectionDescSlug is the schema descriptor for slug field.
+	articlecollectionDescSlug := articlecollectionFields[4].Descriptor()
+	// articlecollection.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	articlecollection.SlugValidator = func() func(string) error {
+		validators := articlecollectionDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// articlecollectionDescIsPublished is the schema descriptor for is_published field.
+	articlecollectionDescIsPublished := articlecollectionFields[7].Descriptor()
+	// articlecollection.DefaultIsPublished holds the default value on creation for the is_published field.
+	articlecollection.DefaultIsPublished = articlecollectionDescIsPublished.Default.(bool)
+	// articlecollectionDescSort is the schema descriptor for sort field.
+	articlecollectionDescSort := articlecollectionFields[8].Descriptor()
+	// articlecollection.DefaultSort holds the default value on creation for the sort field.
+	articlecollection.DefaultSort = articlecollectionDescSort.Default.(int)
+	// articlecollection.SortValidator is a validator for the "sort" field. It is called by the builders before save.
+	articlecollection.SortValidator = articlecollectionDescSort.Validators[0].(func(int) error)
 	articlehistoryFields := schema.ArticleHistory{}.Fields()
 	_ = articlehistoryFields
 	// articlehistoryDescVersion is the schema descriptor for version field.
@@ -266,6 +386,172 @@ func init() {
 	articlehistoryDescCreatedAt := articlehistoryFields[15].Descriptor()
 	// articlehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	articlehistory.DefaultCreatedAt = articlehistoryDescCreatedAt.Default.(func() time.Time)
+	articlereactionFields := schema.ArticleReaction{}.Fields()
+	_ = articlereactionFields
+	// articlereactionDescUpdatedAt is the schema descriptor for updated_at field.
+	articlereactionDescUpdatedAt := articlereactionFields[1].Descriptor()
+	// articlereaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articlereaction.DefaultUpdatedAt = articlereactionDescUpdatedAt.Default.(func() time.Time)
+	// articlereaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articlereaction.UpdateDefaultUpdatedAt = articlereactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articlereactionDescReaction is the schema descriptor for reaction field.
+	articlereactionDescReaction := articlereactionFields[3].Descriptor()
+	// articlereaction.ReactionValidator is a validator for the "reaction" field. It is called by the builders before save.
+	articlereaction.ReactionValidator = func() func(string) error {
+		validators := articlereactionDescReaction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reaction string) error {
+			for _, fn := range fns {
+				if err := fn(reaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// articlereactionDescCount is the schema descriptor for count field.
+	articlereactionDescCount := articlereactionFields[4].Descriptor()
+	// articlereaction.DefaultCount holds the default value on creation for the count field.
+	articlereaction.DefaultCount = articlereactionDescCount.Default.(int)
+	articlereviewnoteFields := schema.ArticleReviewNote{}.Fields()
+	_ = articlereviewnoteFields
+	// articlereviewnoteDescCreatedAt is the schema descriptor for created_at field.
+	articlereviewnoteDescCreatedAt := articlereviewnoteFields[1].Descriptor()
+	// articlereviewnote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articlereviewnote.DefaultCreatedAt = articlereviewnoteDescCreatedAt.Default.(func() time.Time)
+	articletemplateFields := schema.ArticleTemplate{}.Fields()
+	_ = articletemplateFields
+	// articletemplateDescCreatedAt is the schema descriptor for created_at field.
+	articletemplateDescCreatedAt := articletemplateFields[1].Descriptor()
+	// articletemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articletemplate.DefaultCreatedAt = articletemplateDescCreatedAt.Default.(func() time.Time)
+	// articletemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	articletemplateDescUpdatedAt := articletemplateFields[2].Descriptor()
+	// articletemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articletemplate.DefaultUpdatedAt = articletemplateDescUpdatedAt.Default.(func() time.Time)
+	// articletemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articletemplate.UpdateDefaultUpdatedAt = articletemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articletemplateDescName is the schema descriptor for name field.
+	articletemplateDescName := articletemplateFields[3].Descriptor()
+	// articletemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	articletemplate.NameValidator = articletemplateDescName.Validators[0].(func(string) error)
+	// articletemplateDescSort is the schema descriptor for sort field.
+	articletemplateDescSort := articletemplateFields[5].Descriptor()
+	// articletemplate.DefaultSort holds the default value on creation for the sort field.
+	articletemplate.DefaultSort = articletemplateDescSort.Default.(int)
+	// articletemplate.SortValidator is a validator for the "sort" field. It is called by the builders before save.
+	articletemplate.SortValidator = articletemplateDescSort.Validators[0].(func(int) error)
+	// articletemplateDescCopyright is the schema descriptor for copyright field.
+	articletemplateDescCopyright := articletemplateFields[14].Descriptor()
+	// articletemplate.DefaultCopyright holds the default value on creation for the copyright field.
+	articletemplate.DefaultCopyright = articletemplateDescCopyright.Default.(bool)
+	// articletemplateDescIsReprint is the schema descriptor for is_reprint field.
+	articletemplateDescIsReprint := articletemplateFields[15].Descriptor()
+	// articletemplate.DefaultIsReprint holds the default value on creation for the is_reprint field.
+	articletemplate.DefaultIsReprint = articletemplateDescIsReprint.Default.(bool)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[1].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescSubjectUserID is the schema descriptor for subject_user_id field.
+	auditlogDescSubjectUserID := auditlogFields[3].Descriptor()
+	// auditlog.DefaultSubjectUserID holds the default value on creation for the subject_user_id field.
+	auditlog.DefaultSubjectUserID = auditlogDescSubjectUserID.Default.(uint)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[4].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescEntityType is the schema descriptor for entity_type field.
+	auditlogDescEntityType := auditlogFields[5].Descriptor()
+	// auditlog.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditlog.EntityTypeValidator = auditlogDescEntityType.Validators[0].(func(string) error)
+	// auditlogDescEntityID is the schema descriptor for entity_id field.
+	auditlogDescEntityID := auditlogFields[6].Descriptor()
+	// auditlog.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	auditlog.EntityIDValidator = auditlogDescEntityID.Validators[0].(func(string) error)
+	// auditlogDescMethod is the schema descriptor for method field.
+	auditlogDescMethod := auditlogFields[7].Descriptor()
+	// auditlog.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	auditlog.MethodValidator = auditlogDescMethod.Validators[0].(func(string) error)
+	// auditlogDescPath is the schema descriptor for path field.
+	auditlogDescPath := auditlogFields[8].Descriptor()
+	// auditlog.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	auditlog.PathValidator = auditlogDescPath.Validators[0].(func(string) error)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[10].Descriptor()
+	// auditlog.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	auditlog.IPValidator = auditlogDescIP.Validators[0].(func(string) error)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[11].Descriptor()
+	// auditlog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	auditlog.UserAgentValidator = auditlogDescUserAgent.Validators[0].(func(string) error)
+	changelogentryFields := schema.ChangelogEntry{}.Fields()
+	_ = changelogentryFields
+	// changelogentryDescCreatedAt is the schema descriptor for created_at field.
+	changelogentryDescCreatedAt := changelogentryFields[1].Descriptor()
+	// changelogentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	changelogentry.DefaultCreatedAt = changelogentryDescCreatedAt.Default.(func() time.Time)
+	// changelogentryDescUpdatedAt is the schema descriptor for updated_at field.
+	changelogentryDescUpdatedAt := changelogentryFields[2].Descriptor()
+	// changelogentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	changelogentry.DefaultUpdatedAt = changelogentryDescUpdatedAt.Default.(func() time.Time)
+	// changelogentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	changelogentry.UpdateDefaultUpdatedAt = changelogentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// changelogentryDescVersion is the schema descriptor for version field.
+	changelogentryDescVersion := changelogentryFields[3].Descriptor()
+	// changelogentry.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	changelogentry.VersionValidator = func() func(string) error {
+		validators := changelogentryDescVersion.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(version string) error {
+			for _, fn := range fns {
+				if err := fn(version); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// changelogentryDescTitle is the schema descriptor for title field.
+	changelogentryDescTitle := changelogentryFields[4].Descriptor()
+	// changelogentry.DefaultTitle holds the default value on creation for the title field.
+	changelogentry.DefaultTitle = changelogentryDescTitle.Default.(string)
+	// changelogentry.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	changelogentry.TitleValidator = changelogentryDescTitle.Validators[0].(func(string) error)
+	// changelogentryDescContent is the schema descriptor for content field.
+	changelogentryDescContent := changelogentryFields[5].Descriptor()
+	// changelogentry.DefaultContent holds the default value on creation for the content field.
+	changelogentry.DefaultContent = changelogentryDescContent.Default.(string)
+	// changelogentryDescContentHTML is the schema descriptor for content_html field.
+	changelogentryDescContentHTML := changelogentryFields[6].Descriptor()
+	// changelogentry.DefaultContentHTML holds the default value on creation for the content_html field.
+	changelogentry.DefaultContentHTML = changelogentryDescContentHTML.Default.(string)
+	// changelogentryDescURL is the schema descriptor for url field.
+	changelogentryDescURL := changelogentryFields[7].Descriptor()
+	// changelogentry.DefaultURL holds the default value on creation for the url field.
+	changelogentry.DefaultURL = changelogentryDescURL.Default.(string)
+	// changelogentry.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	changelogentry.URLValidator = changelogentryDescURL.Validators[0].(func(string) error)
+	// changelogentryDescIsPrerelease is the schema descriptor for is_prerelease field.
+	changelogentryDescIsPrerelease := changelogentryFields[9].Descriptor()
+	// changelogentry.DefaultIsPrerelease holds the default value on creation for the is_prerelease field.
+	changelogentry.DefaultIsPrerelease = changelogentryDescIsPrerelease.Default.(bool)
+	// changelogentryDescIsPublished is the schema descriptor for is_published field.
+	changelogentryDescIsPublished := changelogentryFields[10].Descriptor()
+	// changelogentry.DefaultIsPublished holds the default value on creation for the is_published field.
+	changelogentry.DefaultIsPublished = changelogentryDescIsPublished.Default.(bool)
+	// changelogentryDescPublishedAt is the schema descriptor for published_at field.
+	changelogentryDescPublishedAt := changelogentryFields[11].Descriptor()
+	// changelogentry.DefaultPublishedAt holds the default value on creation for the published_at field.
+	changelogentry.DefaultPublishedAt = changelogentryDescPublishedAt.Default.(func() time.Time)
 	commentMixin := schema.Comment{}.Mixin()
 	commentMixinHooks0 := commentMixin[0].Hooks()
 	comment.Hooks[0] = commentMixinHooks0[0]
@@ -357,6 +643,110 @@ func init() {
 	comment.DefaultLikeCount = commentDescLikeCount.Default.(int)
 	// comment.LikeCountValidator is a validator for the "like_count" field. It is called by the builders before save.
 	comment.LikeCountValidator = commentDescLikeCount.Validators[0].(func(int) error)
+	commentreactionFields := schema.CommentReaction{}.Fields()
+	_ = commentreactionFields
+	// commentreactionDescCreatedAt is the schema descriptor for created_at field.
+	commentreactionDescCreatedAt := commentreactionFields[1].Descriptor()
+	// commentreaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentreaction.DefaultCreatedAt = commentreactionDescCreatedAt.Default.(func() time.Time)
+	// commentreactionDescReaction is the schema descriptor for reaction field.
+	commentreactionDescReaction := commentreactionFields[3].Descriptor()
+	// commentreaction.ReactionValidator is a validator for the "reaction" field. It is called by the builders before save.
+	commentreaction.ReactionValidator = func() func(string) error {
+		validators := commentreactionDescReaction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reaction string) error {
+			for _, fn := range fns {
+				if err := fn(reaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// commentreactionDescVisitorHash is the schema descriptor for visitor_hash field.
+	commentreactionDescVisitorHash := commentreactionFields[4].Descriptor()
+	// commentreaction.VisitorHashValidator is a validator for the "visitor_hash" field. It is called by the builders before save.
+	commentreaction.VisitorHashValidator = func() func(string) error {
+		validators := commentreactionDescVisitorHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(visitor_hash string) error {
+			for _, fn := range fns {
+				if err := fn(visitor_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	commentsubscriptionFields := schema.CommentSubscription{}.Fields()
+	_ = commentsubscriptionFields
+	// commentsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	commentsubscriptionDescCreatedAt := commentsubscriptionFields[1].Descriptor()
+	// commentsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentsubscription.DefaultCreatedAt = commentsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// commentsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	commentsubscriptionDescUpdatedAt := commentsubscriptionFields[2].Descriptor()
+	// commentsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commentsubscription.DefaultUpdatedAt = commentsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// commentsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commentsubscription.UpdateDefaultUpdatedAt = commentsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentsubscriptionDescTargetPath is the schema descriptor for target_path field.
+	commentsubscriptionDescTargetPath := commentsubscriptionFields[3].Descriptor()
+	// commentsubscription.TargetPathValidator is a validator for the "target_path" field. It is called by the builders before save.
+	commentsubscription.TargetPathValidator = commentsubscriptionDescTargetPath.Validators[0].(func(string) error)
+	// commentsubscriptionDescEmail is the schema descriptor for email field.
+	commentsubscriptionDescEmail := commentsubscriptionFields[4].Descriptor()
+	// commentsubscription.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	commentsubscription.EmailValidator = commentsubscriptionDescEmail.Validators[0].(func(string) error)
+	// commentsubscriptionDescIsActive is the schema descriptor for is_active field.
+	commentsubscriptionDescIsActive := commentsubscriptionFields[5].Descriptor()
+	// commentsubscription.DefaultIsActive holds the default value on creation for the is_active field.
+	commentsubscription.DefaultIsActive = commentsubscriptionDescIsActive.Default.(bool)
+	commentertrustFields := schema.CommenterTrust{}.Fields()
+	_ = commentertrustFields
+	// commentertrustDescCreatedAt is the schema descriptor for created_at field.
+	commentertrustDescCreatedAt := commentertrustFields[1].Descriptor()
+	// commentertrust.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentertrust.DefaultCreatedAt = commentertrustDescCreatedAt.Default.(func() time.Time)
+	// commentertrustDescUpdatedAt is the schema descriptor for updated_at field.
+	commentertrustDescUpdatedAt := commentertrustFields[2].Descriptor()
+	// commentertrust.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commentertrust.DefaultUpdatedAt = commentertrustDescUpdatedAt.Default.(func() time.Time)
+	// commentertrust.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commentertrust.UpdateDefaultUpdatedAt = commentertrustDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentertrustDescEmailMd5 is the schema descriptor for email_md5 field.
+	commentertrustDescEmailMd5 := commentertrustFields[3].Descriptor()
+	// commentertrust.EmailMd5Validator is a validator for the "email_md5" field. It is called by the builders before save.
+	commentertrust.EmailMd5Validator = commentertrustDescEmailMd5.Validators[0].(func(string) error)
+	contentsnippetFields := schema.ContentSnippet{}.Fields()
+	_ = contentsnippetFields
+	// contentsnippetDescCreatedAt is the schema descriptor for created_at field.
+	contentsnippetDescCreatedAt := contentsnippetFields[1].Descriptor()
+	// contentsnippet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentsnippet.DefaultCreatedAt = contentsnippetDescCreatedAt.Default.(func() time.Time)
+	// contentsnippetDescUpdatedAt is the schema descriptor for updated_at field.
+	contentsnippetDescUpdatedAt := contentsnippetFields[2].Descriptor()
+	// contentsnippet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contentsnippet.DefaultUpdatedAt = contentsnippetDescUpdatedAt.Default.(func() time.Time)
+	// contentsnippet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contentsnippet.UpdateDefaultUpdatedAt = contentsnippetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contentsnippetDescName is the schema descriptor for name field.
+	contentsnippetDescName := contentsnippetFields[3].Descriptor()
+	// contentsnippet.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	contentsnippet.NameValidator = contentsnippetDescName.Validators[0].(func(string) error)
+	// contentsnippetDescSort is the schema descriptor for sort field.
+	contentsnippetDescSort := contentsnippetFields[6].Descriptor()
+	// contentsnippet.DefaultSort holds the default value on creation for the sort field.
+	contentsnippet.DefaultSort = contentsnippetDescSort.Default.(int)
+	// contentsnippet.SortValidator is a validator for the "sort" field. It is called by the builders before save.
+	contentsnippet.SortValidator = contentsnippetDescSort.Validators[0].(func(int) error)
 	directlinkMixin := schema.DirectLink{}.Mixin()
 	directlinkMixinHooks0 := directlinkMixin[0].Hooks()
 	directlink.Hooks[0] = directlinkMixinHooks0[0]
@@ -398,6 +788,58 @@ func init() {
 	directlinkDescDownloads := directlinkFields[6].Descriptor()
 	// directlink.DefaultDownloads holds the default value on creation for the downloads field.
 	directlink.DefaultDownloads = directlinkDescDownloads.Default.(int64)
+	disposableemailstatFields := schema.DisposableEmailStat{}.Fields()
+	_ = disposableemailstatFields
+	// disposableemailstatDescCreatedAt is the schema descriptor for created_at field.
+	disposableemailstatDescCreatedAt := disposableemailstatFields[1].Descriptor()
+	// disposableemailstat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	disposableemailstat.DefaultCreatedAt = disposableemailstatDescCreatedAt.Default.(func() time.Time)
+	// disposableemailstatDescUpdatedAt is the schema descriptor for updated_at field.
+	disposableemailstatDescUpdatedAt := disposableemailstatFields[2].Descriptor()
+	// disposableemailstat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	disposableemailstat.DefaultUpdatedAt = disposableemailstatDescUpdatedAt.Default.(func() time.Time)
+	// disposableemailstat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	disposableemailstat.UpdateDefaultUpdatedAt = disposableemailstatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// disposableemailstatDescScope is the schema descriptor for scope field.
+	disposableemailstatDescScope := disposableemailstatFields[3].Descriptor()
+	// disposableemailstat.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	disposableemailstat.ScopeValidator = func() func(string) error {
+		validators := disposableemailstatDescScope.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(scope string) error {
+			for _, fn := range fns {
+				if err := fn(scope); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// disposableemailstatDescAction is the schema descriptor for action field.
+	disposableemailstatDescAction := disposableemailstatFields[4].Descriptor()
+	// disposableemailstat.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	disposableemailstat.ActionValidator = func() func(string) error {
+		validators := disposableemailstatDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// disposableemailstatDescCount is the schema descriptor for count field.
+	disposableemailstatDescCount := disposableemailstatFields[5].Descriptor()
+	// disposableemailstat.DefaultCount holds the default value on creation for the count field.
+	disposableemailstat.DefaultCount = disposableemailstatDescCount.Default.(int64)
 	docseriesFields := schema.DocSeries{}.Fields()
 	_ = docseriesFields
 	// docseriesDescCreatedAt is the schema descriptor for created_at field.
@@ -504,6 +946,28 @@ func init() {
 	fileentityDescIsCurrent := fileentityFields[6].Descriptor()
 	// fileentity.DefaultIsCurrent holds the default value on creation for the is_current field.
 	fileentity.DefaultIsCurrent = fileentityDescIsCurrent.Default.(bool)
+	invitationcodeFields := schema.InvitationCode{}.Fields()
+	_ = invitationcodeFields
+	// invitationcodeDescCreatedAt is the schema descriptor for created_at field.
+	invitationcodeDescCreatedAt := invitationcodeFields[1].Descriptor()
+	// invitationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitationcode.DefaultCreatedAt = invitationcodeDescCreatedAt.Default.(func() time.Time)
+	// invitationcodeDescCode is the schema descriptor for code field.
+	invitationcodeDescCode := invitationcodeFields[2].Descriptor()
+	// invitationcode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invitationcode.CodeValidator = invitationcodeDescCode.Validators[0].(func(string) error)
+	// invitationcodeDescMaxUses is the schema descriptor for max_uses field.
+	invitationcodeDescMaxUses := invitationcodeFields[3].Descriptor()
+	// invitationcode.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invitationcode.DefaultMaxUses = invitationcodeDescMaxUses.Default.(int)
+	// invitationcodeDescUsedCount is the schema descriptor for used_count field.
+	invitationcodeDescUsedCount := invitationcodeFields[4].Descriptor()
+	// invitationcode.DefaultUsedCount holds the default value on creation for the used_count field.
+	invitationcode.DefaultUsedCount = invitationcodeDescUsedCount.Default.(int)
+	// invitationcodeDescNote is the schema descriptor for note field.
+	invitationcodeDescNote := invitationcodeFields[7].Descriptor()
+	// invitationcode.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	invitationcode.NoteValidator = invitationcodeDescNote.Validators[0].(func(string) error)
 	linkFields := schema.Link{}.Fields()
 	_ = linkFields
 	// linkDescName is the schema descriptor for name field.
@@ -522,12 +986,44 @@ func init() {
 	linkDescSkipHealthCheck := linkFields[11].Descriptor()
 	// link.DefaultSkipHealthCheck holds the default value on creation for the skip_health_check field.
 	link.DefaultSkipHealthCheck = linkDescSkipHealthCheck.Default.(bool)
+	// linkDescCheckInterval is the schema descriptor for check_interval field.
+	linkDescCheckInterval := linkFields[12].Descriptor()
+	// link.DefaultCheckInterval holds the default value on creation for the check_interval field.
+	link.DefaultCheckInterval = linkDescCheckInterval.Default.(int)
+	// link.CheckIntervalValidator is a validator for the "check_interval" field. It is called by the builders before save.
+	link.CheckIntervalValidator = linkDescCheckInterval.Validators[0].(func(int) error)
+	// linkDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	linkDescConsecutiveFailures := linkFields[14].Descriptor()
+	// link.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	link.DefaultConsecutiveFailures = linkDescConsecutiveFailures.Default.(int)
+	// linkDescLastResponseTime is the schema descriptor for last_response_time field.
+	linkDescLastResponseTime := linkFields[15].Descriptor()
+	// link.DefaultLastResponseTime holds the default value on creation for the last_response_time field.
+	link.DefaultLastResponseTime = linkDescLastResponseTime.Default.(int)
 	linkcategoryFields := schema.LinkCategory{}.Fields()
 	_ = linkcategoryFields
 	// linkcategoryDescName is the schema descriptor for name field.
 	linkcategoryDescName := linkcategoryFields[0].Descriptor()
 	// linkcategory.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	linkcategory.NameValidator = linkcategoryDescName.Validators[0].(func(string) error)
+	linkcheckrecordFields := schema.LinkCheckRecord{}.Fields()
+	_ = linkcheckrecordFields
+	// linkcheckrecordDescCheckedAt is the schema descriptor for checked_at field.
+	linkcheckrecordDescCheckedAt := linkcheckrecordFields[1].Descriptor()
+	// linkcheckrecord.DefaultCheckedAt holds the default value on creation for the checked_at field.
+	linkcheckrecord.DefaultCheckedAt = linkcheckrecordDescCheckedAt.Default.(func() time.Time)
+	// linkcheckrecordDescStatusCode is the schema descriptor for status_code field.
+	linkcheckrecordDescStatusCode := linkcheckrecordFields[3].Descriptor()
+	// linkcheckrecord.DefaultStatusCode holds the default value on creation for the status_code field.
+	linkcheckrecord.DefaultStatusCode = linkcheckrecordDescStatusCode.Default.(int)
+	// linkcheckrecordDescResponseTime is the schema descriptor for response_time field.
+	linkcheckrecordDescResponseTime := linkcheckrecordFields[4].Descriptor()
+	// linkcheckrecord.DefaultResponseTime holds the default value on creation for the response_time field.
+	linkcheckrecord.DefaultResponseTime = linkcheckrecordDescResponseTime.Default.(int)
+	// linkcheckrecordDescError is the schema descriptor for error field.
+	linkcheckrecordDescError := linkcheckrecordFields[5].Descriptor()
+	// linkcheckrecord.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	linkcheckrecord.ErrorValidator = linkcheckrecordDescError.Validators[0].(func(string) error)
 	linktagFields := schema.LinkTag{}.Fields()
 	_ = linktagFields
 	// linktagDescName is the schema descriptor for name field.
@@ -538,6 +1034,16 @@ func init() {
 	linktagDescColor := linktagFields[1].Descriptor()
 	// linktag.DefaultColor holds the default value on creation for the color field.
 	linktag.DefaultColor = linktagDescColor.Default.(string)
+	mailtemplateversionFields := schema.MailTemplateVersion{}.Fields()
+	_ = mailtemplateversionFields
+	// mailtemplateversionDescCreatedAt is the schema descriptor for created_at field.
+	mailtemplateversionDescCreatedAt := mailtemplateversionFields[1].Descriptor()
+	// mailtemplateversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mailtemplateversion.DefaultCreatedAt = mailtemplateversionDescCreatedAt.Default.(func() time.Time)
+	// mailtemplateversionDescSettingKey is the schema descriptor for setting_key field.
+	mailtemplateversionDescSettingKey := mailtemplateversionFields[2].Descriptor()
+	// mailtemplateversion.SettingKeyValidator is a validator for the "setting_key" field. It is called by the builders before save.
+	mailtemplateversion.SettingKeyValidator = mailtemplateversionDescSettingKey.Validators[0].(func(string) error)
 	metadataMixin := schema.Metadata{}.Mixin()
 	metadataMixinHooks0 := metadataMixin[0].Hooks()
 	metadata.Hooks[0] = metadataMixinHooks0[0]
@@ -571,6 +1077,142 @@ func init() {
 			return nil
 		}
 	}()
+	momentFields := schema.Moment{}.Fields()
+	_ = momentFields
+	// momentDescCreatedAt is the schema descriptor for created_at field.
+	momentDescCreatedAt := momentFields[1].Descriptor()
+	// moment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moment.DefaultCreatedAt = momentDescCreatedAt.Default.(func() time.Time)
+	// momentDescUpdatedAt is the schema descriptor for updated_at field.
+	momentDescUpdatedAt := momentFields[2].Descriptor()
+	// moment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	moment.DefaultUpdatedAt = momentDescUpdatedAt.Default.(func() time.Time)
+	// moment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	moment.UpdateDefaultUpdatedAt = momentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// momentDescContent is the schema descriptor for content field.
+	momentDescContent := momentFields[3].Descriptor()
+	// moment.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	moment.ContentValidator = momentDescContent.Validators[0].(func(string) error)
+	// momentDescCommentEnabled is the schema descriptor for comment_enabled field.
+	momentDescCommentEnabled := momentFields[7].Descriptor()
+	// moment.DefaultCommentEnabled holds the default value on creation for the comment_enabled field.
+	moment.DefaultCommentEnabled = momentDescCommentEnabled.Default.(bool)
+	// momentDescIsPublic is the schema descriptor for is_public field.
+	momentDescIsPublic := momentFields[8].Descriptor()
+	// moment.DefaultIsPublic holds the default value on creation for the is_public field.
+	moment.DefaultIsPublic = momentDescIsPublic.Default.(bool)
+	musicplaystatFields := schema.MusicPlayStat{}.Fields()
+	_ = musicplaystatFields
+	// musicplaystatDescCreatedAt is the schema descriptor for created_at field.
+	musicplaystatDescCreatedAt := musicplaystatFields[1].Descriptor()
+	// musicplaystat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	musicplaystat.DefaultCreatedAt = musicplaystatDescCreatedAt.Default.(func() time.Time)
+	// musicplaystatDescUpdatedAt is the schema descriptor for updated_at field.
+	musicplaystatDescUpdatedAt := musicplaystatFields[2].Descriptor()
+	// musicplaystat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	musicplaystat.DefaultUpdatedAt = musicplaystatDescUpdatedAt.Default.(func() time.Time)
+	// musicplaystat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	musicplaystat.UpdateDefaultUpdatedAt = musicplaystatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// musicplaystatDescPeriod is the schema descriptor for period field.
+	musicplaystatDescPeriod := musicplaystatFields[3].Descriptor()
+	// musicplaystat.PeriodValidator is a validator for the "period" field. It is called by the builders before save.
+	musicplaystat.PeriodValidator = func() func(string) error {
+		validators := musicplaystatDescPeriod.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(period string) error {
+			for _, fn := range fns {
+				if err := fn(period); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// musicplaystatDescSongID is the schema descriptor for song_id field.
+	musicplaystatDescSongID := musicplaystatFields[4].Descriptor()
+	// musicplaystat.SongIDValidator is a validator for the "song_id" field. It is called by the builders before save.
+	musicplaystat.SongIDValidator = func() func(string) error {
+		validators := musicplaystatDescSongID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(song_id string) error {
+			for _, fn := range fns {
+				if err := fn(song_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// musicplaystatDescSongName is the schema descriptor for song_name field.
+	musicplaystatDescSongName := musicplaystatFields[5].Descriptor()
+	// musicplaystat.DefaultSongName holds the default value on creation for the song_name field.
+	musicplaystat.DefaultSongName = musicplaystatDescSongName.Default.(string)
+	// musicplaystat.SongNameValidator is a validator for the "song_name" field. It is called by the builders before save.
+	musicplaystat.SongNameValidator = musicplaystatDescSongName.Validators[0].(func(string) error)
+	// musicplaystatDescArtist is the schema descriptor for artist field.
+	musicplaystatDescArtist := musicplaystatFields[6].Descriptor()
+	// musicplaystat.DefaultArtist holds the default value on creation for the artist field.
+	musicplaystat.DefaultArtist = musicplaystatDescArtist.Default.(string)
+	// musicplaystat.ArtistValidator is a validator for the "artist" field. It is called by the builders before save.
+	musicplaystat.ArtistValidator = musicplaystatDescArtist.Validators[0].(func(string) error)
+	// musicplaystatDescPlayCount is the schema descriptor for play_count field.
+	musicplaystatDescPlayCount := musicplaystatFields[7].Descriptor()
+	// musicplaystat.DefaultPlayCount holds the default value on creation for the play_count field.
+	musicplaystat.DefaultPlayCount = musicplaystatDescPlayCount.Default.(int64)
+	// musicplaystatDescTotalDuration is the schema descriptor for total_duration field.
+	musicplaystatDescTotalDuration := musicplaystatFields[8].Descriptor()
+	// musicplaystat.DefaultTotalDuration holds the default value on creation for the total_duration field.
+	musicplaystat.DefaultTotalDuration = musicplaystatDescTotalDuration.Default.(int64)
+	notificationdeliveryFields := schema.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notificationdeliveryDescCreatedAt := notificationdeliveryFields[1].Descriptor()
+	// notificationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdelivery.DefaultCreatedAt = notificationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notificationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdeliveryDescUpdatedAt := notificationdeliveryFields[2].Descriptor()
+	// notificationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdelivery.DefaultUpdatedAt = notificationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notificationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdelivery.UpdateDefaultUpdatedAt = notificationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationdeliveryDescChannel is the schema descriptor for channel field.
+	notificationdeliveryDescChannel := notificationdeliveryFields[3].Descriptor()
+	// notificationdelivery.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	notificationdelivery.ChannelValidator = notificationdeliveryDescChannel.Validators[0].(func(string) error)
+	// notificationdeliveryDescKind is the schema descriptor for kind field.
+	notificationdeliveryDescKind := notificationdeliveryFields[4].Descriptor()
+	// notificationdelivery.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notificationdelivery.KindValidator = notificationdeliveryDescKind.Validators[0].(func(string) error)
+	// notificationdeliveryDescRecipient is the schema descriptor for recipient field.
+	notificationdeliveryDescRecipient := notificationdeliveryFields[5].Descriptor()
+	// notificationdelivery.RecipientValidator is a validator for the "recipient" field. It is called by the builders before save.
+	notificationdelivery.RecipientValidator = notificationdeliveryDescRecipient.Validators[0].(func(string) error)
+	// notificationdeliveryDescSubject is the schema descriptor for subject field.
+	notificationdeliveryDescSubject := notificationdeliveryFields[6].Descriptor()
+	// notificationdelivery.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	notificationdelivery.SubjectValidator = notificationdeliveryDescSubject.Validators[0].(func(string) error)
+	// notificationdeliveryDescAttempts is the schema descriptor for attempts field.
+	notificationdeliveryDescAttempts := notificationdeliveryFields[9].Descriptor()
+	// notificationdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	notificationdelivery.DefaultAttempts = notificationdeliveryDescAttempts.Default.(int)
+	// notificationdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	notificationdelivery.AttemptsValidator = notificationdeliveryDescAttempts.Validators[0].(func(int) error)
+	// notificationdeliveryDescMaxAttempts is the schema descriptor for max_attempts field.
+	notificationdeliveryDescMaxAttempts := notificationdeliveryFields[10].Descriptor()
+	// notificationdelivery.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	notificationdelivery.DefaultMaxAttempts = notificationdeliveryDescMaxAttempts.Default.(int)
+	// notificationdelivery.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	notificationdelivery.MaxAttemptsValidator = notificationdeliveryDescMaxAttempts.Validators[0].(func(int) error)
+	// notificationdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	notificationdeliveryDescNextAttemptAt := notificationdeliveryFields[11].Descriptor()
+	// notificationdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	notificationdelivery.DefaultNextAttemptAt = notificationdeliveryDescNextAttemptAt.Default.(func() time.Time)
 	notificationtypeFields := schema.NotificationType{}.Fields()
 	_ = notificationtypeFields
 	// notificationtypeDescCreatedAt is the schema descriptor for created_at field.
@@ -754,6 +1396,52 @@ func init() {
 	posttag.DefaultCount = posttagDescCount.Default.(int)
 	// posttag.CountValidator is a validator for the "count" field. It is called by the builders before save.
 	posttag.CountValidator = posttagDescCount.Validators[0].(func(int) error)
+	readingprogressFields := schema.ReadingProgress{}.Fields()
+	_ = readingprogressFields
+	// readingprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	readingprogressDescUpdatedAt := readingprogressFields[1].Descriptor()
+	// readingprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	readingprogress.DefaultUpdatedAt = readingprogressDescUpdatedAt.Default.(func() time.Time)
+	// readingprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	readingprogress.UpdateDefaultUpdatedAt = readingprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// readingprogressDescProgress is the schema descriptor for progress field.
+	readingprogressDescProgress := readingprogressFields[4].Descriptor()
+	// readingprogress.DefaultProgress holds the default value on creation for the progress field.
+	readingprogress.DefaultProgress = readingprogressDescProgress.Default.(float64)
+	// readingprogress.ProgressValidator is a validator for the "progress" field. It is called by the builders before save.
+	readingprogress.ProgressValidator = func() func(float64) error {
+		validators := readingprogressDescProgress.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(progress float64) error {
+			for _, fn := range fns {
+				if err := fn(progress); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// readingprogressDescAnchor is the schema descriptor for anchor field.
+	readingprogressDescAnchor := readingprogressFields[5].Descriptor()
+	// readingprogress.AnchorValidator is a validator for the "anchor" field. It is called by the builders before save.
+	readingprogress.AnchorValidator = readingprogressDescAnchor.Validators[0].(func(string) error)
+	recycleitemFields := schema.RecycleItem{}.Fields()
+	_ = recycleitemFields
+	// recycleitemDescOriginalName is the schema descriptor for original_name field.
+	recycleitemDescOriginalName := recycleitemFields[3].Descriptor()
+	// recycleitem.OriginalNameValidator is a validator for the "original_name" field. It is called by the builders before save.
+	recycleitem.OriginalNameValidator = recycleitemDescOriginalName.Validators[0].(func(string) error)
+	// recycleitemDescSize is the schema descriptor for size field.
+	recycleitemDescSize := recycleitemFields[7].Descriptor()
+	// recycleitem.DefaultSize holds the default value on creation for the size field.
+	recycleitem.DefaultSize = recycleitemDescSize.Default.(int64)
+	// recycleitemDescTrashedAt is the schema descriptor for trashed_at field.
+	recycleitemDescTrashedAt := recycleitemFields[8].Descriptor()
+	// recycleitem.DefaultTrashedAt holds the default value on creation for the trashed_at field.
+	recycleitem.DefaultTrashedAt = recycleitemDescTrashedAt.Default.(func() time.Time)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinHooks0 := settingMixin[0].Hooks()
 	setting.Hooks[0] = settingMixinHooks0[0]
@@ -791,6 +1479,26 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	spamtokenFields := schema.SpamToken{}.Fields()
+	_ = spamtokenFields
+	// spamtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	spamtokenDescUpdatedAt := spamtokenFields[1].Descriptor()
+	// spamtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	spamtoken.DefaultUpdatedAt = spamtokenDescUpdatedAt.Default.(func() time.Time)
+	// spamtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	spamtoken.UpdateDefaultUpdatedAt = spamtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// spamtokenDescToken is the schema descriptor for token field.
+	spamtokenDescToken := spamtokenFields[2].Descriptor()
+	// spamtoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	spamtoken.TokenValidator = spamtokenDescToken.Validators[0].(func(string) error)
+	// spamtokenDescSpamCount is the schema descriptor for spam_count field.
+	spamtokenDescSpamCount := spamtokenFields[3].Descriptor()
+	// spamtoken.DefaultSpamCount holds the default value on creation for the spam_count field.
+	spamtoken.DefaultSpamCount = spamtokenDescSpamCount.Default.(int)
+	// spamtokenDescHamCount is the schema descriptor for ham_count field.
+	spamtokenDescHamCount := spamtokenFields[4].Descriptor()
+	// spamtoken.DefaultHamCount holds the default value on creation for the ham_count field.
+	spamtoken.DefaultHamCount = spamtokenDescHamCount.Default.(int)
 	storagepolicyMixin := schema.StoragePolicy{}.Mixin()
 	storagepolicyMixinHooks0 := storagepolicyMixin[0].Hooks()
 	storagepolicy.Hooks[0] = storagepolicyMixinHooks0[0]
@@ -862,6 +1570,40 @@ func init() {
 	storagepolicyDescVirtualPath := storagepolicyFields[13].Descriptor()
 	// storagepolicy.VirtualPathValidator is a validator for the "virtual_path" field. It is called by the builders before save.
 	storagepolicy.VirtualPathValidator = storagepolicyDescVirtualPath.Validators[0].(func(string) error)
+	storagepolicymountFields := schema.StoragePolicyMount{}.Fields()
+	_ = storagepolicymountFields
+	// storagepolicymountDescCreatedAt is the schema descriptor for created_at field.
+	storagepolicymountDescCreatedAt := storagepolicymountFields[1].Descriptor()
+	// storagepolicymount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	storagepolicymount.DefaultCreatedAt = storagepolicymountDescCreatedAt.Default.(func() time.Time)
+	// storagepolicymountDescUpdatedAt is the schema descriptor for updated_at field.
+	storagepolicymountDescUpdatedAt := storagepolicymountFields[2].Descriptor()
+	// storagepolicymount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	storagepolicymount.DefaultUpdatedAt = storagepolicymountDescUpdatedAt.Default.(func() time.Time)
+	// storagepolicymount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	storagepolicymount.UpdateDefaultUpdatedAt = storagepolicymountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// storagepolicymountDescVirtualPath is the schema descriptor for virtual_path field.
+	storagepolicymountDescVirtualPath := storagepolicymountFields[4].Descriptor()
+	// storagepolicymount.VirtualPathValidator is a validator for the "virtual_path" field. It is called by the builders before save.
+	storagepolicymount.VirtualPathValidator = func() func(string) error {
+		validators := storagepolicymountDescVirtualPath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(virtual_path string) error {
+			for _, fn := range fns {
+				if err := fn(virtual_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// storagepolicymountDescReadOnly is the schema descriptor for read_only field.
+	storagepolicymountDescReadOnly := storagepolicymountFields[5].Descriptor()
+	// storagepolicymount.DefaultReadOnly holds the default value on creation for the read_only field.
+	storagepolicymount.DefaultReadOnly = storagepolicymountDescReadOnly.Default.(bool)
 	subscriberFields := schema.Subscriber{}.Fields()
 	_ = subscriberFields
 	// subscriberDescEmail is the schema descriptor for email field.
@@ -969,6 +1711,36 @@ func init() {
 	urlstatDescAvgDuration := urlstatFields[8].Descriptor()
 	// urlstat.DefaultAvgDuration holds the default value on creation for the avg_duration field.
 	urlstat.DefaultAvgDuration = urlstatDescAvgDuration.Default.(float64)
+	uploadsessionFields := schema.UploadSession{}.Fields()
+	_ = uploadsessionFields
+	// uploadsessionDescSessionID is the schema descriptor for session_id field.
+	uploadsessionDescSessionID := uploadsessionFields[1].Descriptor()
+	// uploadsession.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	uploadsession.SessionIDValidator = uploadsessionDescSessionID.Validators[0].(func(string) error)
+	// uploadsessionDescPolicyID is the schema descriptor for policy_id field.
+	uploadsessionDescPolicyID := uploadsessionFields[3].Descriptor()
+	// uploadsession.PolicyIDValidator is a validator for the "policy_id" field. It is called by the builders before save.
+	uploadsession.PolicyIDValidator = uploadsessionDescPolicyID.Validators[0].(func(string) error)
+	// uploadsessionDescChecksum is the schema descriptor for checksum field.
+	uploadsessionDescChecksum := uploadsessionFields[9].Descriptor()
+	// uploadsession.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	uploadsession.ChecksumValidator = uploadsessionDescChecksum.Validators[0].(func(string) error)
+	// uploadsessionDescThroughput is the schema descriptor for throughput field.
+	uploadsessionDescThroughput := uploadsessionFields[10].Descriptor()
+	// uploadsession.DefaultThroughput holds the default value on creation for the throughput field.
+	uploadsession.DefaultThroughput = uploadsessionDescThroughput.Default.(float64)
+	// uploadsessionDescVersion is the schema descriptor for version field.
+	uploadsessionDescVersion := uploadsessionFields[11].Descriptor()
+	// uploadsession.DefaultVersion holds the default value on creation for the version field.
+	uploadsession.DefaultVersion = uploadsessionDescVersion.Default.(int)
+	// uploadsessionDescCreatedAt is the schema descriptor for created_at field.
+	uploadsessionDescCreatedAt := uploadsessionFields[12].Descriptor()
+	// uploadsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	uploadsession.DefaultCreatedAt = uploadsessionDescCreatedAt.Default.(func() time.Time)
+	// uploadsessionDescLastActiveAt is the schema descriptor for last_active_at field.
+	uploadsessionDescLastActiveAt := uploadsessionFields[13].Descriptor()
+	// uploadsession.DefaultLastActiveAt holds the default value on creation for the last_active_at field.
+	uploadsession.DefaultLastActiveAt = uploadsessionDescLastActiveAt.Default.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks0 := userMixin[0].Hooks()
 	user.Hooks[0] = userMixinHooks0[0]
@@ -1029,17 +1801,23 @@ func init() {
 	// user.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
 	user.AvatarValidator = userDescAvatar.Validators[0].(func(string) error)
 	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[7].Descriptor()
+	userDescEmail := userFields[10].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescWebsite is the schema descriptor for website field.
-	userDescWebsite := userFields[8].Descriptor()
+	userDescWebsite := userFields[11].Descriptor()
 	// user.WebsiteValidator is a validator for the "website" field. It is called by the builders before save.
 	user.WebsiteValidator = userDescWebsite.Validators[0].(func(string) error)
 	// userDescStatus is the schema descriptor for status field.
-	userDescStatus := userFields[10].Descriptor()
+	userDescStatus := userFields[13].Descriptor()
 	// user.DefaultStatus holds the default value on creation for the status field.
 	user.DefaultStatus = userDescStatus.Default.(int)
+	userbookmarkFields := schema.UserBookmark{}.Fields()
+	_ = userbookmarkFields
+	// userbookmarkDescCreatedAt is the schema descriptor for created_at field.
+	userbookmarkDescCreatedAt := userbookmarkFields[1].Descriptor()
+	// userbookmark.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userbookmark.DefaultCreatedAt = userbookmarkDescCreatedAt.Default.(func() time.Time)
 	usergroupMixin := schema.UserGroup{}.Mixin()
 	usergroupMixinHooks0 := usergroupMixin[0].Hooks()
 	usergroup.Hooks[0] = usergroupMixinHooks0[0]
@@ -1089,6 +1867,42 @@ func init() {
 	usergroupDescSettings := usergroupFields[8].Descriptor()
 	// usergroup.DefaultSettings holds the default value on creation for the settings field.
 	usergroup.DefaultSettings = usergroupDescSettings.Default.(*model.GroupSettings)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[1].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityFields[2].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
+	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useridentity.UpdateDefaultUpdatedAt = useridentityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[4].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = useridentityDescProvider.Validators[0].(func(string) error)
+	// useridentityDescProviderUserID is the schema descriptor for provider_user_id field.
+	useridentityDescProviderUserID := useridentityFields[5].Descriptor()
+	// useridentity.ProviderUserIDValidator is a validator for the "provider_user_id" field. It is called by the builders before save.
+	useridentity.ProviderUserIDValidator = useridentityDescProviderUserID.Validators[0].(func(string) error)
+	// useridentityDescNickname is the schema descriptor for nickname field.
+	useridentityDescNickname := useridentityFields[6].Descriptor()
+	// useridentity.NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
+	useridentity.NicknameValidator = useridentityDescNickname.Validators[0].(func(string) error)
+	// useridentityDescAvatar is the schema descriptor for avatar field.
+	useridentityDescAvatar := useridentityFields[7].Descriptor()
+	// useridentity.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
+	useridentity.AvatarValidator = useridentityDescAvatar.Validators[0].(func(string) error)
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[8].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescProfileURL is the schema descriptor for profile_url field.
+	useridentityDescProfileURL := useridentityFields[9].Descriptor()
+	// useridentity.ProfileURLValidator is a validator for the "profile_url" field. It is called by the builders before save.
+	useridentity.ProfileURLValidator = useridentityDescProfileURL.Validators[0].(func(string) error)
 	userinstalledthemeMixin := schema.UserInstalledTheme{}.Mixin()
 	userinstalledthemeMixinHooks0 := userinstalledthemeMixin[0].Hooks()
 	userinstalledtheme.Hooks[0] = userinstalledthemeMixinHooks0[0]
@@ -0,0 +1,79 @@
+/*
+ * @Description: 个人访问令牌表（供外部编辑器、脚本发布文章）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessToken holds the schema definition for the AccessToken entity.
+type AccessToken struct {
+	ent.Schema
+}
+
+// Annotations of the AccessToken.
+func (AccessToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("个人访问令牌表"),
+	}
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Uint("user_id").
+			Comment("令牌所属用户ID"),
+
+		field.String("name").
+			Comment("令牌名称，便于区分用途").
+			NotEmpty(),
+
+		field.String("token_hash").
+			Comment("令牌的 SHA-256 哈希，明文只在创建时返回一次").
+			Unique().
+			Sensitive(),
+
+		field.String("token_prefix").
+			Comment("令牌前缀，用于界面展示识别"),
+
+		field.String("scopes").
+			Comment("空格分隔的权限范围: create update delete media").
+			Default("create update media"),
+
+		field.Time("last_used_at").
+			Comment("最近使用时间").
+			Optional().
+			Nillable(),
+
+		field.Time("expires_at").
+			Comment("过期时间，为空表示永不过期").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the AccessToken.
+func (AccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -108,6 +108,9 @@ func (Article) Fields() []ent.Field {
 		field.String("keywords").
 			Comment("文章关键词，用于SEO优化").
 			Optional(),
+		field.String("link_url").
+			Comment("链接文章指向的外部URL，非空时该文章为链接文章（正文为点评）").
+			Optional(),
 
 		// --- 定时发布相关字段 ---
 		field.Time("scheduled_at").
@@ -117,8 +120,8 @@ func (Article) Fields() []ent.Field {
 
 		// --- 审核相关字段（多人共创功能） ---
 		field.Enum("review_status").
-			Values("NONE", "PENDING", "APPROVED", "REJECTED").
-			Comment("审核状态：NONE-无需审核, PENDING-待审核, APPROVED-已通过, REJECTED-已拒绝").
+			Values("NONE", "PENDING", "CHANGES_REQUESTED", "APPROVED", "REJECTED").
+			Comment("审核状态：NONE-无需审核, PENDING-待审核, CHANGES_REQUESTED-需修改, APPROVED-已通过, REJECTED-已拒绝").
 			Default("NONE"),
 		field.String("review_comment").
 			Comment("审核意见").
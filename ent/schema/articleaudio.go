@@ -0,0 +1,93 @@
+/*
+ * @Description: 文章语音朗读版本表（TTS 生成的音频文件）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleAudio holds the schema definition for the ArticleAudio entity.
+type ArticleAudio struct {
+	ent.Schema
+}
+
+// Annotations of the ArticleAudio.
+func (ArticleAudio) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("文章语音朗读版本表"),
+	}
+}
+
+// Fields of the ArticleAudio.
+func (ArticleAudio) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.Uint("article_id").
+			Comment("文章ID"),
+
+		field.Enum("status").
+			Values("PENDING", "READY", "FAILED").
+			Default("PENDING").
+			Comment("生成状态"),
+
+		field.String("provider").
+			Optional().
+			Comment("TTS 服务提供方"),
+
+		field.String("voice").
+			Optional().
+			Comment("使用的音色"),
+
+		field.String("content_hash").
+			Optional().
+			Comment("生成音频时文章朗读文本与音色的哈希，用于判断是否需要重新生成"),
+
+		field.String("file_public_id").
+			Optional().
+			Comment("音频文件的公共ID"),
+
+		field.String("url").
+			MaxLen(1024).
+			Optional().
+			Comment("音频直链地址"),
+
+		field.Int("char_count").
+			Default(0).
+			NonNegative().
+			Comment("朗读文本的字符数"),
+
+		field.Text("error_message").
+			Optional().
+			Comment("最近一次生成失败的原因"),
+	}
+}
+
+// Indexes of the ArticleAudio.
+func (ArticleAudio) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id").Unique(),
+	}
+}
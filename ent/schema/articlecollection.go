@@ -0,0 +1,80 @@
+/*
+ * @Description: 文章合集表，保存首页横幅、编辑精选等模块展示的有序文章列表
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 01:00:00
+ * @LastEditTime: 2026-10-18 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleCollection holds the schema definition for the ArticleCollection entity.
+type ArticleCollection struct {
+	ent.Schema
+}
+
+// Annotations of the ArticleCollection.
+func (ArticleCollection) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("文章合集表"),
+	}
+}
+
+// Fields of the ArticleCollection.
+func (ArticleCollection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("name").
+			Comment("合集名称").
+			NotEmpty().
+			MaxLen(100),
+
+		field.String("slug").
+			Comment("合集标识，前台模块通过它读取合集，如 home-banner / editors-picks").
+			Unique().
+			NotEmpty().
+			MaxLen(64),
+
+		field.String("description").
+			Comment("合集说明").
+			Optional(),
+
+		field.JSON("article_ids", []uint{}).
+			Comment("按展示顺序排列的文章ID列表").
+			Optional(),
+
+		field.Bool("is_published").
+			Comment("是否在前台公开").
+			Default(true),
+
+		field.Int("sort").
+			Comment("排序，数值越小越靠前").
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the ArticleCollection.
+func (ArticleCollection) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * @Description: 文章表情回应计数表（按文章、按回应类型汇总回应次数）
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 05:00:00
+ * @LastEditTime: 2026-10-18 05:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleReaction holds the schema definition for the ArticleReaction entity.
+type ArticleReaction struct {
+	ent.Schema
+}
+
+// Annotations of the ArticleReaction.
+func (ArticleReaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("文章表情回应计数表"),
+	}
+}
+
+// Fields of the ArticleReaction.
+func (ArticleReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.Uint("article_id").
+			Comment("文章ID"),
+
+		field.String("reaction").
+			MaxLen(16).
+			NotEmpty().
+			Comment("回应类型，如 like、love"),
+
+		field.Int("count").
+			Default(0).
+			Comment("回应次数"),
+	}
+}
+
+// Indexes of the ArticleReaction.
+func (ArticleReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id", "reaction").Unique(),
+	}
+}
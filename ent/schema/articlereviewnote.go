@@ -0,0 +1,64 @@
+/*
+ * @Description: 投稿审核记录表，保存投稿的提交、审核意见与审核结果，供投稿者与管理员查看处理进度
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 04:00:00
+ * @LastEditTime: 2026-10-18 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleReviewNote holds the schema definition for the ArticleReviewNote entity.
+type ArticleReviewNote struct {
+	ent.Schema
+}
+
+// Annotations of the ArticleReviewNote.
+func (ArticleReviewNote) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("投稿审核记录表"),
+	}
+}
+
+// Fields of the ArticleReviewNote.
+func (ArticleReviewNote) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Uint("article_id").
+			Comment("投稿文章ID"),
+
+		field.Uint("user_id").
+			Comment("操作人ID（投稿者或审核人）"),
+
+		field.Enum("action").
+			Values("submit", "comment", "request_changes", "approve", "reject").
+			Comment("操作类型: submit(提交/重新提交) / comment(审核意见) / request_changes(要求修改) / approve(通过并发布) / reject(拒绝)"),
+
+		field.Text("content").
+			Comment("审核意见或提交说明").
+			Optional(),
+	}
+}
+
+// Indexes of the ArticleReviewNote.
+func (ArticleReviewNote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id", "created_at"),
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * @Description: 文章模板表
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleTemplate holds the schema definition for the ArticleTemplate entity.
+type ArticleTemplate struct {
+	ent.Schema
+}
+
+// Annotations of the ArticleTemplate.
+func (ArticleTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("文章模板表"),
+	}
+}
+
+// Fields of the ArticleTemplate.
+func (ArticleTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("name").
+			Comment("模板名称").
+			Unique().
+			NotEmpty(),
+
+		field.String("description").
+			Comment("模板说明").
+			Optional(),
+
+		field.Int("sort").
+			Comment("排序，数值越小越靠前").
+			Default(0).
+			NonNegative(),
+
+		// --- 新草稿的默认值 ---
+		field.String("title").
+			Comment("默认标题，支持 {{date}} 等占位符").
+			Optional(),
+
+		field.Text("content_md").
+			Comment("正文骨架 Markdown，支持占位符").
+			Optional(),
+
+		field.JSON("summaries", []string{}).
+			Comment("摘要结构").
+			Optional(),
+
+		field.String("cover_url").
+			Comment("默认封面图URL").
+			Optional(),
+
+		field.String("top_img_url").
+			Comment("默认顶部大图URL").
+			Optional(),
+
+		field.JSON("post_tag_ids", []string{}).
+			Comment("默认标签公共ID列表").
+			Optional(),
+
+		field.JSON("post_category_ids", []string{}).
+			Comment("默认分类公共ID列表").
+			Optional(),
+
+		field.String("keywords").
+			Comment("默认SEO关键词").
+			Optional(),
+
+		// --- 版权信息块 ---
+		field.Bool("copyright").
+			Comment("是否显示版权信息").
+			Default(true),
+
+		field.Bool("is_reprint").
+			Comment("是否为转载").
+			Default(false),
+
+		field.String("copyright_author").
+			Comment("版权作者").
+			Optional(),
+
+		field.String("copyright_author_href").
+			Comment("版权作者链接").
+			Optional(),
+
+		field.String("copyright_url").
+			Comment("原文链接").
+			Optional(),
+	}
+}
+
+// Edges of the ArticleTemplate.
+func (ArticleTemplate) Edges() []ent.Edge {
+	return nil
+}
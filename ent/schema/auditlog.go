@@ -0,0 +1,110 @@
+/*
+ * @Description: 审计日志表，记录管理员的敏感操作（如模拟登录用户）与后台数据变更
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Annotations of the AuditLog.
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("审计日志表"),
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("记录时间"),
+
+		field.Uint("actor_id").
+			Comment("实际操作者（管理员）用户ID"),
+
+		field.Uint("subject_user_id").
+			Comment("被模拟的用户ID，非模拟操作时为 0").
+			Default(0),
+
+		field.String("action").
+			Comment("操作类型，如 impersonation.start / article.update").
+			MaxLen(64),
+
+		field.String("entity_type").
+			Comment("被修改的对象类型，如 article / setting，非数据变更时为空").
+			Optional().
+			MaxLen(32),
+
+		field.String("entity_id").
+			Comment("被修改对象的公共ID或键名").
+			Optional().
+			MaxLen(128),
+
+		field.String("method").
+			Comment("HTTP 方法").
+			Optional().
+			MaxLen(16),
+
+		field.String("path").
+			Comment("请求路径").
+			Optional().
+			MaxLen(512),
+
+		field.Int("status_code").
+			Comment("响应状态码").
+			Optional(),
+
+		field.String("ip").
+			Comment("操作者 IP").
+			Optional().
+			MaxLen(64),
+
+		field.String("user_agent").
+			Comment("操作者 User-Agent").
+			Optional().
+			MaxLen(512),
+
+		field.Text("detail").
+			Comment("附加说明，如模拟登录的原因").
+			Optional(),
+
+		field.Text("before_data").
+			Comment("变更前的快照（JSON），新建时为空").
+			Optional(),
+
+		field.Text("after_data").
+			Comment("变更后的快照（JSON），删除时为空").
+			Optional(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor_id", "created_at"),
+		index.Fields("subject_user_id", "created_at"),
+		index.Fields("action"),
+		index.Fields("entity_type", "created_at"),
+	}
+}
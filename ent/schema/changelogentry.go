@@ -0,0 +1,96 @@
+/*
+ * @Description: 更新日志表（从 GitHub Releases 同步或手动录入的版本说明）
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ChangelogEntry holds the schema definition for the ChangelogEntry entity.
+type ChangelogEntry struct {
+	ent.Schema
+}
+
+// Annotations of the ChangelogEntry.
+func (ChangelogEntry) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("更新日志表"),
+	}
+}
+
+// Fields of the ChangelogEntry.
+func (ChangelogEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("version").
+			MaxLen(64).
+			NotEmpty().
+			Unique().
+			Comment("版本号，同步时对应 Release 的 tag"),
+
+		field.String("title").
+			MaxLen(255).
+			Default("").
+			Comment("标题"),
+
+		field.Text("content").
+			Default("").
+			Comment("更新说明（Markdown）"),
+
+		field.Text("content_html").
+			Default("").
+			Comment("渲染后的更新说明 HTML"),
+
+		field.String("url").
+			MaxLen(512).
+			Default("").
+			Comment("版本详情链接，如 GitHub Release 页面"),
+
+		field.Enum("source").
+			Values("github", "manual").
+			Default("manual").
+			Comment("来源：github 为自动同步，manual 为手动录入或编辑过"),
+
+		field.Bool("is_prerelease").
+			Default(false).
+			Comment("是否为预发布版本"),
+
+		field.Bool("is_published").
+			Default(true).
+			Comment("是否在更新页面公开"),
+
+		field.Time("published_at").
+			Default(time.Now).
+			Comment("发布时间"),
+	}
+}
+
+// Indexes of the ChangelogEntry.
+func (ChangelogEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_published", "published_at"),
+	}
+}
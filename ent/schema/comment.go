@@ -101,8 +101,8 @@ func (Comment) Fields() []ent.Field {
 
 		// --- 状态与元数据 ---
 		field.Int("status").
-			Default(2). // 1: 已发布, 2: 待审核
-			Comment("评论状态 1:已发布 2:待审核"),
+			Default(2). // 1: 已发布, 2: 待审核, 3: 垃圾评论
+			Comment("评论状态 1:已发布 2:待审核 3:垃圾评论"),
 		field.Bool("is_admin_comment").
 			Default(false).
 			Comment("是否为博主/管理员的评论"),
@@ -131,6 +131,10 @@ func (Comment) Fields() []ent.Field {
 			Min(0).
 			Comment("点赞数"),
 
+		field.JSON("reaction_counts", map[string]int{}).
+			Optional().
+			Comment("各类表情回应的数量，由回应表汇总得出"),
+
 		// --- 置顶 ---
 		field.Time("pinned_at").
 			Comment("评论置顶时间，为NULL表示未置顶").
@@ -172,5 +176,8 @@ func (Comment) Indexes() []ent.Index {
 
 		// 用于通过邮箱查找评论者（可选）。
 		index.Fields("email"),
+
+		// 后台按状态分页与超期待审核评论清理。
+		index.Fields("deleted_at", "status", "created_at"),
 	}
 }
@@ -0,0 +1,56 @@
+/*
+ * @Description: 评论者信任状态表（首评审核通过后自动放行后续评论）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-15 23:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// CommenterTrust holds the schema definition for the CommenterTrust entity.
+type CommenterTrust struct {
+	ent.Schema
+}
+
+// Annotations of the CommenterTrust.
+func (CommenterTrust) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("评论者信任状态表"),
+	}
+}
+
+// Fields of the CommenterTrust.
+func (CommenterTrust) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("email_md5").
+			Comment("评论者邮箱的 MD5 哈希").
+			Unique().
+			NotEmpty(),
+
+		field.Enum("status").
+			Values("trusted", "revoked").
+			Comment("信任状态: trusted(已信任，评论自动发布) / revoked(管理员撤销信任，评论需审核)"),
+	}
+}
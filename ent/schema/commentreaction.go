@@ -0,0 +1,63 @@
+/*
+ * @Description: 评论表情回应表，按访客哈希记录每种回应，保证同一访客对同一评论的每种回应只计一次
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 03:00:00
+ * @LastEditTime: 2026-10-18 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CommentReaction holds the schema definition for the CommentReaction entity.
+type CommentReaction struct {
+	ent.Schema
+}
+
+// Annotations of the CommentReaction.
+func (CommentReaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("评论表情回应表"),
+	}
+}
+
+// Fields of the CommentReaction.
+func (CommentReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Uint("comment_id").
+			Comment("评论ID"),
+
+		field.String("reaction").
+			Comment("回应类型，如 like / love / laugh / wow / sad").
+			NotEmpty().
+			MaxLen(16),
+
+		field.String("visitor_hash").
+			Comment("访客标识（Cookie 或 IP+UA 指纹）的加盐哈希").
+			NotEmpty().
+			MaxLen(64),
+	}
+}
+
+// Indexes of the CommentReaction.
+func (CommentReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("comment_id", "reaction", "visitor_hash").Unique(),
+	}
+}
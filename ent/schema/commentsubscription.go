@@ -0,0 +1,81 @@
+/*
+ * @Description: 评论订阅表，访客订阅某个页面的评论后按摘要邮件接收新评论
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 19:00:00
+ * @LastEditTime: 2026-10-16 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CommentSubscription holds the schema definition for the CommentSubscription entity.
+type CommentSubscription struct {
+	ent.Schema
+}
+
+// Annotations of the CommentSubscription.
+func (CommentSubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("评论订阅表"),
+	}
+}
+
+// Fields of the CommentSubscription.
+func (CommentSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("target_path").
+			Comment("订阅的评论区路径").
+			MaxLen(255),
+
+		field.String("email").
+			Comment("订阅者邮箱（小写）").
+			MaxLen(255),
+
+		field.Bool("is_active").
+			Comment("是否有效，退订后为 false").
+			Default(true),
+
+		field.Time("notified_until").
+			Comment("已通知到的评论时间，摘要只包含此后发布的评论"),
+
+		field.Time("pending_since").
+			Comment("有待发送的新评论时记录首条评论的时间，发送摘要后清空").
+			Optional().
+			Nillable(),
+
+		field.Time("last_sent_at").
+			Comment("上次发送摘要邮件的时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the CommentSubscription.
+func (CommentSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_path", "email").Unique(),
+		index.Fields("pending_since"),
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * @Description: 编辑器内容片段表
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentSnippet holds the schema definition for the ContentSnippet entity.
+type ContentSnippet struct {
+	ent.Schema
+}
+
+// Annotations of the ContentSnippet.
+func (ContentSnippet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("编辑器内容片段表"),
+	}
+}
+
+// Fields of the ContentSnippet.
+func (ContentSnippet) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("name").
+			Comment("片段名称").
+			Unique().
+			NotEmpty(),
+
+		field.String("description").
+			Comment("片段说明").
+			Optional(),
+
+		field.Text("content").
+			Comment("片段内容 Markdown"),
+
+		field.Int("sort").
+			Comment("排序，数值越小越靠前").
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the ContentSnippet.
+func (ContentSnippet) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+ * @Description: 一次性邮箱拦截计数表（按场景与处理方式累计拦截次数）
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 10:00:00
+ * @LastEditTime: 2026-10-18 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DisposableEmailStat holds the schema definition for the DisposableEmailStat entity.
+type DisposableEmailStat struct {
+	ent.Schema
+}
+
+// Annotations of the DisposableEmailStat.
+func (DisposableEmailStat) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("一次性邮箱拦截计数表"),
+	}
+}
+
+// Fields of the DisposableEmailStat.
+func (DisposableEmailStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("scope").
+			MaxLen(32).
+			NotEmpty().
+			Comment("业务场景：comment / register"),
+
+		field.String("action").
+			MaxLen(32).
+			NotEmpty().
+			Comment("处理方式：reject / hold"),
+
+		field.Int64("count").
+			Default(0).
+			Comment("累计拦截次数"),
+	}
+}
+
+// Indexes of the DisposableEmailStat.
+func (DisposableEmailStat) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个场景与处理方式一行
+		index.Fields("scope", "action").Unique(),
+	}
+}
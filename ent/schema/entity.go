@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Entity holds the schema definition for the Entity entity.
@@ -96,3 +97,11 @@ func (Entity) Edges() []ent.Edge {
 		edge.To("file_versions", FileEntity.Type),
 	}
 }
+
+// Indexes of the Entity.
+func (Entity) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按存储策略统计、分批清理实体。
+		index.Fields("policy_id"),
+	}
+}
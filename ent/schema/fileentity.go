@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // FileEntity holds the schema definition for the FileEntity entity.
@@ -76,3 +77,12 @@ func (FileEntity) Edges() []ent.Edge {
 			Field("entity_id"),
 	}
 }
+
+// Indexes of the FileEntity.
+func (FileEntity) Indexes() []ent.Index {
+	return []ent.Index{
+		// 查询文件的版本列表，以及实体是否仍被引用。
+		index.Fields("file_id"),
+		index.Fields("entity_id"),
+	}
+}
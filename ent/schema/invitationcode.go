@@ -0,0 +1,76 @@
+/*
+ * @Description: 邀请码表，开启邀请注册后新用户需要凭邀请码注册
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvitationCode holds the schema definition for the InvitationCode entity.
+type InvitationCode struct {
+	ent.Schema
+}
+
+// Annotations of the InvitationCode.
+func (InvitationCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("注册邀请码表"),
+	}
+}
+
+// Fields of the InvitationCode.
+func (InvitationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.String("code").
+			Comment("邀请码").
+			MaxLen(32).
+			Unique(),
+
+		field.Int("max_uses").
+			Comment("最大可使用次数").
+			Default(1),
+
+		field.Int("used_count").
+			Comment("已使用次数").
+			Default(0),
+
+		field.Time("expires_at").
+			Comment("过期时间，为空表示永不过期").
+			Optional().
+			Nillable(),
+
+		field.Uint("created_by").
+			Comment("创建者（管理员）用户ID"),
+
+		field.String("note").
+			Comment("备注").
+			Optional().
+			MaxLen(255),
+	}
+}
+
+// Indexes of the InvitationCode.
+func (InvitationCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
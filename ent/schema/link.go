@@ -2,7 +2,7 @@
  * @Description:
  * @Author: 安知鱼
  * @Date: 2025-08-18 15:05:27
- * @LastEditTime: 2025-10-02 00:39:21
+ * @LastEditTime: 2026-10-17 14:00:00
  * @LastEditors: 安知鱼
  */
 // ent/schema/link.go
@@ -62,6 +62,28 @@ func (Link) Fields() []ent.Field {
 		field.Bool("skip_health_check").
 			Comment("是否跳过健康检查").
 			Default(false),
+		field.Int("check_interval").
+			Comment("健康检查间隔（小时），0 表示使用全局设置").
+			NonNegative().
+			Default(0),
+		field.Time("last_checked_at").
+			Comment("最近一次健康检查时间").
+			Optional().
+			Nillable(),
+		field.Int("consecutive_failures").
+			Comment("连续检查失败次数").
+			Default(0),
+		field.Int("last_response_time").
+			Comment("最近一次检查的响应耗时（毫秒）").
+			Default(0),
+		field.Time("ssl_expire_at").
+			Comment("HTTPS 证书过期时间").
+			Optional().
+			Nillable(),
+		field.Int("original_category_id").
+			Comment("被移入失联分类前所在的分类ID，恢复后移回").
+			Optional().
+			Nillable(),
 	}
 }
 
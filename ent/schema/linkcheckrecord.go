@@ -0,0 +1,74 @@
+/*
+ * @Description: 友链健康检查记录表，保存每次检查的响应耗时、状态码与证书过期时间
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 14:00:00
+ * @LastEditTime: 2026-10-17 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LinkCheckRecord holds the schema definition for the LinkCheckRecord entity.
+type LinkCheckRecord struct {
+	ent.Schema
+}
+
+// Annotations of the LinkCheckRecord.
+func (LinkCheckRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("友链健康检查记录表"),
+	}
+}
+
+// Fields of the LinkCheckRecord.
+func (LinkCheckRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("link_id").
+			Immutable().
+			Comment("友链ID"),
+
+		field.Time("checked_at").
+			Default(time.Now).
+			Immutable().
+			Comment("检查时间"),
+
+		field.Bool("healthy").
+			Comment("是否可访问"),
+
+		field.Int("status_code").
+			Default(0).
+			Comment("HTTP 状态码，请求失败时为0"),
+
+		field.Int("response_time").
+			Default(0).
+			Comment("响应耗时（毫秒）"),
+
+		field.String("error").
+			Optional().
+			MaxLen(500).
+			Comment("请求失败原因"),
+
+		field.Time("ssl_expire_at").
+			Optional().
+			Nillable().
+			Comment("HTTPS 证书过期时间"),
+	}
+}
+
+// Indexes of the LinkCheckRecord.
+func (LinkCheckRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("link_id", "checked_at"),
+		index.Fields("checked_at"),
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * @Description: 邮件模板历史版本表，保存模板修改前的内容用于回滚
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MailTemplateVersion holds the schema definition for the MailTemplateVersion entity.
+type MailTemplateVersion struct {
+	ent.Schema
+}
+
+// Annotations of the MailTemplateVersion.
+func (MailTemplateVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("邮件模板历史版本表"),
+	}
+}
+
+// Fields of the MailTemplateVersion.
+func (MailTemplateVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("保存时间"),
+
+		field.String("setting_key").
+			Comment("模板对应的配置键").
+			MaxLen(100),
+
+		field.Text("content").
+			Comment("被替换前的模板内容"),
+	}
+}
+
+// Indexes of the MailTemplateVersion.
+func (MailTemplateVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("setting_key", "created_at"),
+	}
+}
@@ -0,0 +1,84 @@
+/*
+ * @Description: 说说（短动态）表
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Moment holds the schema definition for the Moment entity.
+type Moment struct {
+	ent.Schema
+}
+
+// Annotations of the Moment.
+func (Moment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("说说（短动态）表"),
+	}
+}
+
+// Fields of the Moment.
+func (Moment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("发布时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.Text("content").
+			Comment("说说内容 Markdown 原文").
+			NotEmpty(),
+
+		field.Text("content_html").
+			Comment("由 content 解析和净化后的 HTML").
+			Optional(),
+
+		field.JSON("images", []string{}).
+			Comment("配图URL列表").
+			Optional(),
+
+		field.String("location").
+			Comment("发布地点").
+			Optional(),
+
+		field.Bool("comment_enabled").
+			Comment("是否允许评论").
+			Default(true),
+
+		field.Bool("is_public").
+			Comment("是否公开显示在时间线中").
+			Default(true),
+	}
+}
+
+// Edges of the Moment.
+func (Moment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Moment.
+func (Moment) Indexes() []ent.Index {
+	return []ent.Index{
+		// 公开时间线查询
+		index.Fields("is_public", "created_at"),
+	}
+}
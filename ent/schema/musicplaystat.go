@@ -0,0 +1,84 @@
+/*
+ * @Description: 音乐播放统计表（按歌曲、按月汇总播放次数和收听时长）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 15:00:00
+ * @LastEditTime: 2026-10-15 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MusicPlayStat holds the schema definition for the MusicPlayStat entity.
+type MusicPlayStat struct {
+	ent.Schema
+}
+
+// Annotations of the MusicPlayStat.
+func (MusicPlayStat) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("音乐播放统计表"),
+	}
+}
+
+// Fields of the MusicPlayStat.
+func (MusicPlayStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("period").
+			MaxLen(7).
+			NotEmpty().
+			Comment("统计月份，格式 YYYY-MM（中国时区）"),
+
+		field.String("song_id").
+			MaxLen(64).
+			NotEmpty().
+			Comment("歌曲ID（网易云歌曲ID或自定义歌单中的ID）"),
+
+		field.String("song_name").
+			MaxLen(255).
+			Default("").
+			Comment("歌曲名称（最近一次上报）"),
+
+		field.String("artist").
+			MaxLen(255).
+			Default("").
+			Comment("歌手（最近一次上报）"),
+
+		field.Int64("play_count").
+			Default(0).
+			Comment("播放次数"),
+
+		field.Int64("total_duration").
+			Default(0).
+			Comment("累计收听时长（秒）"),
+	}
+}
+
+// Indexes of the MusicPlayStat.
+func (MusicPlayStat) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每首歌每月一行
+		index.Fields("period", "song_id").Unique(),
+	}
+}
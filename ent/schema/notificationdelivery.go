@@ -0,0 +1,106 @@
+/*
+ * @Description: 通知投递队列表（即时推送与通知邮件的持久化发送记录）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationDelivery holds the schema definition for the NotificationDelivery entity.
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+// Annotations of the NotificationDelivery.
+func (NotificationDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("通知投递队列表"),
+	}
+}
+
+// Fields of the NotificationDelivery.
+func (NotificationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("channel").
+			MaxLen(32).
+			Comment("投递渠道：email / bark / webhook"),
+
+		field.String("kind").
+			MaxLen(64).
+			Optional().
+			Comment("通知类型，如 comment、link_application"),
+
+		field.String("recipient").
+			MaxLen(255).
+			Optional().
+			Comment("接收方（邮箱地址或推送目标主机），仅用于展示"),
+
+		field.String("subject").
+			MaxLen(255).
+			Optional().
+			Comment("通知标题，仅用于展示"),
+
+		field.Text("payload").
+			Comment("渲染完成的投递内容（JSON），重试时原样发送"),
+
+		field.Enum("status").
+			Values("PENDING", "SENDING", "SENT", "FAILED").
+			Default("PENDING").
+			Comment("投递状态：FAILED 表示已达到最大重试次数"),
+
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("已尝试次数"),
+
+		field.Int("max_attempts").
+			Default(5).
+			Positive().
+			Comment("最大尝试次数"),
+
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("下次尝试时间"),
+
+		field.Text("last_error").
+			Optional().
+			Comment("最近一次失败的原因"),
+
+		field.Time("sent_at").
+			Optional().
+			Nillable().
+			Comment("发送成功时间"),
+	}
+}
+
+// Indexes of the NotificationDelivery.
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("channel", "created_at"),
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * @Description: 用户阅读进度表（记录登录用户在每篇文章中的阅读位置，跨设备恢复）
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReadingProgress holds the schema definition for the ReadingProgress entity.
+type ReadingProgress struct {
+	ent.Schema
+}
+
+// Annotations of the ReadingProgress.
+func (ReadingProgress) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("用户阅读进度表"),
+	}
+}
+
+// Fields of the ReadingProgress.
+func (ReadingProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.Uint("user_id").
+			Comment("用户ID"),
+
+		field.Uint("article_id").
+			Comment("文章ID"),
+
+		field.Float("progress").
+			Default(0).
+			Min(0).
+			Max(1).
+			Comment("阅读进度，0 到 1 之间的比例"),
+
+		field.String("anchor").
+			MaxLen(255).
+			Optional().
+			Comment("最近阅读位置对应的标题锚点"),
+	}
+}
+
+// Indexes of the ReadingProgress.
+func (ReadingProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "article_id").Unique(),
+		index.Fields("user_id", "updated_at"),
+	}
+}
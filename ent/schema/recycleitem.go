@@ -0,0 +1,80 @@
+/*
+ * @Description: 回收站表，记录被移入回收站的文件/文件夹及其原始位置，过期后由后台任务彻底删除
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 12:00:00
+ * @LastEditTime: 2026-10-17 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecycleItem holds the schema definition for the RecycleItem entity.
+type RecycleItem struct {
+	ent.Schema
+}
+
+// Annotations of the RecycleItem.
+func (RecycleItem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("回收站表"),
+	}
+}
+
+// Fields of the RecycleItem.
+func (RecycleItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Uint("owner_id").
+			Immutable().
+			Comment("所有者用户ID"),
+
+		field.Uint("file_id").
+			Unique().
+			Immutable().
+			Comment("被移入回收站的文件/文件夹ID"),
+
+		field.String("original_name").
+			MaxLen(255).
+			Comment("移入回收站前的名称"),
+
+		field.Uint("original_parent_id").
+			Comment("移入回收站前的父目录ID"),
+
+		field.Text("original_path").
+			Comment("移入回收站前的完整路径，仅用于展示"),
+
+		field.Int("file_type").
+			Comment("类型: 1-文件, 2-文件夹"),
+
+		field.Int64("size").
+			Default(0).
+			Comment("文件大小（字节），文件夹为0"),
+
+		field.Time("trashed_at").
+			Default(time.Now).
+			Immutable().
+			Comment("移入回收站的时间"),
+
+		field.Time("expire_at").
+			Comment("过期时间，过期后由后台任务彻底删除"),
+	}
+}
+
+// Indexes of the RecycleItem.
+func (RecycleItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "trashed_at"),
+		index.Fields("expire_at"),
+	}
+}
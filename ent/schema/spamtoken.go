@@ -0,0 +1,55 @@
+/*
+ * @Description: 贝叶斯反垃圾过滤器的词条计数表
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 20:00:00
+ * @LastEditTime: 2026-10-16 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamToken holds the schema definition for the SpamToken entity.
+type SpamToken struct {
+	ent.Schema
+}
+
+// Annotations of the SpamToken.
+func (SpamToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("反垃圾评论词条计数表"),
+	}
+}
+
+// Fields of the SpamToken.
+func (SpamToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.String("token").
+			MaxLen(64).
+			Unique().
+			Comment("词条，保留词条 __samples__ 记录两类样本总数"),
+
+		field.Int("spam_count").
+			Default(0).
+			Comment("在垃圾评论样本中出现的次数"),
+
+		field.Int("ham_count").
+			Default(0).
+			Comment("在正常评论样本中出现的次数"),
+	}
+}
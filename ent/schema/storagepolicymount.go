@@ -0,0 +1,73 @@
+/*
+ * @Description: 存储策略挂载点表（同一策略可挂载到多个虚拟路径，支持只读挂载）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StoragePolicyMount holds the schema definition for the StoragePolicyMount entity.
+type StoragePolicyMount struct {
+	ent.Schema
+}
+
+// Annotations of the StoragePolicyMount.
+func (StoragePolicyMount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("存储策略挂载点表（策略主挂载路径之外的别名路径）"),
+	}
+}
+
+// Fields of the StoragePolicyMount.
+func (StoragePolicyMount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.Uint("policy_id").
+			Comment("所属存储策略ID"),
+
+		field.String("virtual_path").
+			MaxLen(255).
+			NotEmpty().
+			Comment("挂载的虚拟路径，如 /photos"),
+
+		field.Bool("read_only").
+			Default(false).
+			Comment("是否为只读挂载"),
+
+		field.Uint("node_id").
+			Optional().
+			Nillable().
+			Comment("挂载点对应的目录ID"),
+	}
+}
+
+// Indexes of the StoragePolicyMount.
+func (StoragePolicyMount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("virtual_path").Unique(),
+		index.Fields("policy_id"),
+	}
+}
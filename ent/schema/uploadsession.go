@@ -0,0 +1,101 @@
+/*
+ * @Description: 分片上传会话表，持久化上传进度，服务重启后客户端仍可继续上传未完成的分片
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 09:00:00
+ * @LastEditTime: 2026-10-17 09:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UploadSession holds the schema definition for the UploadSession entity.
+type UploadSession struct {
+	ent.Schema
+}
+
+// Annotations of the UploadSession.
+func (UploadSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("分片上传会话表"),
+	}
+}
+
+// Fields of the UploadSession.
+func (UploadSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.String("session_id").
+			MaxLen(64).
+			Unique().
+			Immutable().
+			Comment("上传会话ID，与临时物理实体的 upload_session_id 对应"),
+
+		field.Uint("owner_id").
+			Immutable().
+			Comment("发起上传的用户ID"),
+
+		field.String("policy_id").
+			MaxLen(64).
+			Comment("存储策略的公共ID"),
+
+		field.Text("uri").
+			Comment("文件的完整目标URI"),
+
+		field.Int("chunk_size").
+			Comment("分片大小（字节）"),
+
+		field.Int64("file_size").
+			Comment("文件总大小（字节）"),
+
+		field.Uint("temp_entity_id").
+			Comment("上传完成前关联的临时物理实体ID"),
+
+		field.JSON("uploaded_chunks", []int{}).
+			Optional().
+			Comment("已接收的分片序号"),
+
+		field.String("checksum").
+			MaxLen(128).
+			Optional().
+			Comment("客户端提供的整个文件摘要，合并分片后校验"),
+
+		field.Float("throughput").
+			Default(0).
+			Comment("分片接收速度的指数滑动平均值（字节/秒）"),
+
+		field.Int("version").
+			Default(0).
+			Comment("乐观锁版本号，并发上传分片时避免相互覆盖已接收的分片"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("last_active_at").
+			Default(time.Now).
+			Comment("最近一个分片上传完成的时间"),
+
+		field.Time("expire_at").
+			Comment("过期时间，过期后由后台任务清理"),
+	}
+}
+
+// Indexes of the UploadSession.
+func (UploadSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "expire_at"),
+		index.Fields("expire_at"),
+	}
+}
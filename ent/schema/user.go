@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent/schema/mixin"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -66,6 +67,15 @@ func (User) Fields() []ent.Field {
 			MaxLen(255).
 			Optional().
 			Comment("用户头像URL"),
+		field.JSON("avatar_variants", map[string]string{}).
+			Optional().
+			Comment("头像各尺寸版本的URL，键为边长像素"),
+		field.Text("bio").
+			Optional().
+			Comment("个人简介"),
+		field.JSON("profile_visibility", model.ProfileVisibility{}).
+			Optional().
+			Comment("公开资料页的可见性设置"),
 		field.String("email").
 			MaxLen(100).
 			Unique().
@@ -80,7 +90,7 @@ func (User) Fields() []ent.Field {
 			Nillable(),
 		field.Int("status").
 			Default(2).
-			Comment("用户状态 1:正常 2:未激活 3:已封禁"),
+			Comment("用户状态 1:正常 2:未激活 3:已封禁 4:待审核"),
 	}
 }
 
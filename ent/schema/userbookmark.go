@@ -0,0 +1,57 @@
+/*
+ * @Description: 用户文章书签表（登录用户收藏的文章，跨设备同步）
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserBookmark holds the schema definition for the UserBookmark entity.
+type UserBookmark struct {
+	ent.Schema
+}
+
+// Annotations of the UserBookmark.
+func (UserBookmark) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("用户文章书签表"),
+	}
+}
+
+// Fields of the UserBookmark.
+func (UserBookmark) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("收藏时间"),
+
+		field.Uint("user_id").
+			Comment("用户ID"),
+
+		field.Uint("article_id").
+			Comment("文章ID"),
+	}
+}
+
+// Indexes of the UserBookmark.
+func (UserBookmark) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "article_id").Unique(),
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * @Description: 用户社交账号绑定表，记录第三方登录（GitHub、Google、QQ）账号与本站用户的关联
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity.
+type UserIdentity struct {
+	ent.Schema
+}
+
+// Annotations of the UserIdentity.
+func (UserIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("用户社交账号绑定表"),
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+
+		field.Uint("user_id").
+			Comment("本站用户ID"),
+
+		field.String("provider").
+			Comment("第三方平台标识，如 github、google、qq").
+			MaxLen(32),
+
+		field.String("provider_user_id").
+			Comment("第三方平台的用户唯一标识").
+			MaxLen(191),
+
+		field.String("nickname").
+			Comment("第三方平台昵称").
+			MaxLen(100).
+			Optional(),
+
+		field.String("avatar").
+			Comment("第三方平台头像地址").
+			MaxLen(512).
+			Optional(),
+
+		field.String("email").
+			Comment("第三方平台邮箱").
+			MaxLen(255).
+			Optional(),
+
+		field.String("profile_url").
+			Comment("第三方平台个人主页地址").
+			MaxLen(512).
+			Optional(),
+	}
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "provider_user_id").Unique(),
+		index.Fields("user_id", "provider").Unique(),
+	}
+}
@@ -12,18 +12,44 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// AlbumCategory is the client for interacting with the AlbumCategory builders.
 	AlbumCategory *AlbumCategoryClient
 	// Article is the client for interacting with the Article builders.
 	Article *ArticleClient
+	// ArticleAudio is the client for interacting with the ArticleAudio builders.
+	ArticleAudio *ArticleAudioClient
+	// ArticleCollection is the client for interacting with the ArticleCollection builders.
+	ArticleCollection *ArticleCollectionClient
 	// ArticleHistory is the client for interacting with the ArticleHistory builders.
 	ArticleHistory *ArticleHistoryClient
+	// ArticleReaction is the client for interacting with the ArticleReaction builders.
+	ArticleReaction *ArticleReactionClient
+	// ArticleReviewNote is the client for interacting with the ArticleReviewNote builders.
+	ArticleReviewNote *ArticleReviewNoteClient
+	// ArticleTemplate is the client for interacting with the ArticleTemplate builders.
+	ArticleTemplate *ArticleTemplateClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// ChangelogEntry is the client for interacting with the ChangelogEntry builders.
+	ChangelogEntry *ChangelogEntryClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentReaction is the client for interacting with the CommentReaction builders.
+	CommentReaction *CommentReactionClient
+	// CommentSubscription is the client for interacting with the CommentSubscription builders.
+	CommentSubscription *CommentSubscriptionClient
+	// CommenterTrust is the client for interacting with the CommenterTrust builders.
+	CommenterTrust *CommenterTrustClient
+	// ContentSnippet is the client for interacting with the ContentSnippet builders.
+	ContentSnippet *ContentSnippetClient
 	// DirectLink is the client for interacting with the DirectLink builders.
 	DirectLink *DirectLinkClient
+	// DisposableEmailStat is the client for interacting with the DisposableEmailStat builders.
+	DisposableEmailStat *DisposableEmailStatClient
 	// DocSeries is the client for interacting with the DocSeries builders.
 	DocSeries *DocSeriesClient
 	// Entity is the client for interacting with the Entity builders.
@@ -32,14 +58,26 @@ type Tx struct {
 	File *FileClient
 	// FileEntity is the client for interacting with the FileEntity builders.
 	FileEntity *FileEntityClient
+	// InvitationCode is the client for interacting with the InvitationCode builders.
+	InvitationCode *InvitationCodeClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
 	LinkCategory *LinkCategoryClient
+	// LinkCheckRecord is the client for interacting with the LinkCheckRecord builders.
+	LinkCheckRecord *LinkCheckRecordClient
 	// LinkTag is the client for interacting with the LinkTag builders.
 	LinkTag *LinkTagClient
+	// MailTemplateVersion is the client for interacting with the MailTemplateVersion builders.
+	MailTemplateVersion *MailTemplateVersionClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
+	// Moment is the client for interacting with the Moment builders.
+	Moment *MomentClient
+	// MusicPlayStat is the client for interacting with the MusicPlayStat builders.
+	MusicPlayStat *MusicPlayStatClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
 	// NotificationType is the client for interacting with the NotificationType builders.
 	NotificationType *NotificationTypeClient
 	// Page is the client for interacting with the Page builders.
@@ -48,20 +86,34 @@ type Tx struct {
 	PostCategory *PostCategoryClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
+	// ReadingProgress is the client for interacting with the ReadingProgress builders.
+	ReadingProgress *ReadingProgressClient
+	// RecycleItem is the client for interacting with the RecycleItem builders.
+	RecycleItem *RecycleItemClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// SpamToken is the client for interacting with the SpamToken builders.
+	SpamToken *SpamTokenClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
 	StoragePolicy *StoragePolicyClient
+	// StoragePolicyMount is the client for interacting with the StoragePolicyMount builders.
+	StoragePolicyMount *StoragePolicyMountClient
 	// Subscriber is the client for interacting with the Subscriber builders.
 	Subscriber *SubscriberClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// URLStat is the client for interacting with the URLStat builders.
 	URLStat *URLStatClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBookmark is the client for interacting with the UserBookmark builders.
+	UserBookmark *UserBookmarkClient
 	// UserGroup is the client for interacting with the UserGroup builders.
 	UserGroup *UserGroupClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserInstalledTheme is the client for interacting with the UserInstalledTheme builders.
 	UserInstalledTheme *UserInstalledThemeClient
 	// UserNotificationConfig is the client for interacting with the UserNotificationConfig builders.
@@ -201,31 +253,57 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
 	tx.Album = NewAlbumClient(tx.config)
 	tx.AlbumCategory = NewAlbumCategoryClient(tx.config)
 	tx.Article = NewArticleClient(tx.config)
+	tx.ArticleAudio = NewArticleAudioClient(tx.config)
+	tx.ArticleCollection = NewArticleCollectionClient(tx.config)
 	tx.ArticleHistory = NewArticleHistoryClient(tx.config)
+	tx.ArticleReaction = NewArticleReactionClient(tx.config)
+	tx.ArticleReviewNote = NewArticleReviewNoteClient(tx.config)
+	tx.ArticleTemplate = NewArticleTemplateClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.ChangelogEntry = NewChangelogEntryClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.CommentReaction = NewCommentReactionClient(tx.config)
+	tx.CommentSubscription = NewCommentSubscriptionClient(tx.config)
+	tx.CommenterTrust = NewCommenterTrustClient(tx.config)
+	tx.ContentSnippet = NewContentSnippetClient(tx.config)
 	tx.DirectLink = NewDirectLinkClient(tx.config)
+	tx.DisposableEmailStat = NewDisposableEmailStatClient(tx.config)
 	tx.DocSeries = NewDocSeriesClient(tx.config)
 	tx.Entity = NewEntityClient(tx.config)
 	tx.File = NewFileClient(tx.config)
 	tx.FileEntity = NewFileEntityClient(tx.config)
+	tx.InvitationCode = NewInvitationCodeClient(tx.config)
 	tx.Link = NewLinkClient(tx.config)
 	tx.LinkCategory = NewLinkCategoryClient(tx.config)
+	tx.LinkCheckRecord = NewLinkCheckRecordClient(tx.config)
 	tx.LinkTag = NewLinkTagClient(tx.config)
+	tx.MailTemplateVersion = NewMailTemplateVersionClient(tx.config)
 	tx.Metadata = NewMetadataClient(tx.config)
+	tx.Moment = NewMomentClient(tx.config)
+	tx.MusicPlayStat = NewMusicPlayStatClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
 	tx.NotificationType = NewNotificationTypeClient(tx.config)
 	tx.Page = NewPageClient(tx.config)
 	tx.PostCategory = NewPostCategoryClient(tx.config)
 	tx.PostTag = NewPostTagClient(tx.config)
+	tx.ReadingProgress = NewReadingProgressClient(tx.config)
+	tx.RecycleItem = NewRecycleItemClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
+	tx.SpamToken = NewSpamTokenClient(tx.config)
 	tx.StoragePolicy = NewStoragePolicyClient(tx.config)
+	tx.StoragePolicyMount = NewStoragePolicyMountClient(tx.config)
 	tx.Subscriber = NewSubscriberClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
 	tx.URLStat = NewURLStatClient(tx.config)
+	tx.UploadSession = NewUploadSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserBookmark = NewUserBookmarkClient(tx.config)
 	tx.UserGroup = NewUserGroupClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 	tx.UserInstalledTheme = NewUserInstalledThemeClient(tx.config)
 	tx.UserNotificationConfig = NewUserNotificationConfigClient(tx.config)
 	tx.VisitorLog = NewVisitorLogClient(tx.config)
@@ -239,7 +317,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Album.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
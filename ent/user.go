@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // 用户表
@@ -32,13 +34,19 @@ type User struct {
 	Nickname string `json:"nickname,omitempty"`
 	// 用户头像URL
 	Avatar string `json:"avatar,omitempty"`
+	// 头像各尺寸版本的URL，键为边长像素
+	AvatarVariants map[string]string `json:"avatar_variants,omitempty"`
+	// 个人简介
+	Bio string `json:"bio,omitempty"`
+	// 公开资料页的可见性设置
+	ProfileVisibility model.ProfileVisibility `json:"profile_visibility,omitempty"`
 	// 用户邮箱
 	Email string `json:"email,omitempty"`
 	// 用户个人网站
 	Website string `json:"website,omitempty"`
 	// LastLoginAt holds the value of the "last_login_at" field.
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
-	// 用户状态 1:正常 2:未激活 3:已封禁
+	// 用户状态 1:正常 2:未激活 3:已封禁 4:待审核
 	Status int `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
@@ -116,9 +124,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldAvatarVariants, user.FieldProfileVisibility:
+			values[i] = new([]byte)
 		case user.FieldID, user.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPasswordHash, user.FieldNickname, user.FieldAvatar, user.FieldEmail, user.FieldWebsite:
+		case user.FieldUsername, user.FieldPasswordHash, user.FieldNickname, user.FieldAvatar, user.FieldBio, user.FieldEmail, user.FieldWebsite:
 			values[i] = new(sql.NullString)
 		case user.FieldDeletedAt, user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastLoginAt:
 			values[i] = new(sql.NullTime)
@@ -188,6 +198,28 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Avatar = value.String
 			}
+		case user.FieldAvatarVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AvatarVariants); err != nil {
+					return fmt.Errorf("unmarshal field avatar_variants: %w", err)
+				}
+			}
+		case user.FieldBio:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio", values[i])
+			} else if value.Valid {
+				_m.Bio = value.String
+			}
+		case user.FieldProfileVisibility:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_visibility", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ProfileVisibility); err != nil {
+					return fmt.Errorf("unmarshal field profile_visibility: %w", err)
+				}
+			}
 		case user.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
@@ -303,6 +335,15 @@ func (_m *User) String() string {
 	builder.WriteString("avatar=")
 	builder.WriteString(_m.Avatar)
 	builder.WriteString(", ")
+	builder.WriteString("avatar_variants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AvatarVariants))
+	builder.WriteString(", ")
+	builder.WriteString("bio=")
+	builder.WriteString(_m.Bio)
+	builder.WriteString(", ")
+	builder.WriteString("profile_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProfileVisibility))
+	builder.WriteString(", ")
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
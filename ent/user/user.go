@@ -29,6 +29,12 @@ const (
 	FieldNickname = "nickname"
 	// FieldAvatar holds the string denoting the avatar field in the database.
 	FieldAvatar = "avatar"
+	// FieldAvatarVariants holds the string denoting the avatar_variants field in the database.
+	FieldAvatarVariants = "avatar_variants"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldProfileVisibility holds the string denoting the profile_visibility field in the database.
+	FieldProfileVisibility = "profile_visibility"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
 	// FieldWebsite holds the string denoting the website field in the database.
@@ -96,6 +102,9 @@ var Columns = []string{
 	FieldPasswordHash,
 	FieldNickname,
 	FieldAvatar,
+	FieldAvatarVariants,
+	FieldBio,
+	FieldProfileVisibility,
 	FieldEmail,
 	FieldWebsite,
 	FieldLastLoginAt,
@@ -195,6 +204,11 @@ func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
 }
 
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
 // ByEmail orders the results by the email field.
 func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
@@ -17,6 +17,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -109,6 +110,40 @@ func (_c *UserCreate) SetNillableAvatar(v *string) *UserCreate {
 	return _c
 }
 
+// SetAvatarVariants sets the "avatar_variants" field.
+func (_c *UserCreate) SetAvatarVariants(v map[string]string) *UserCreate {
+	_c.mutation.SetAvatarVariants(v)
+	return _c
+}
+
+// SetBio sets the "bio" field.
+func (_c *UserCreate) SetBio(v string) *UserCreate {
+	_c.mutation.SetBio(v)
+	return _c
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_c *UserCreate) SetNillableBio(v *string) *UserCreate {
+	if v != nil {
+		_c.SetBio(*v)
+	}
+	return _c
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (_c *UserCreate) SetProfileVisibility(v model.ProfileVisibility) *UserCreate {
+	_c.mutation.SetProfileVisibility(v)
+	return _c
+}
+
+// SetNillableProfileVisibility sets the "profile_visibility" field if the given value is not nil.
+func (_c *UserCreate) SetNillableProfileVisibility(v *model.ProfileVisibility) *UserCreate {
+	if v != nil {
+		_c.SetProfileVisibility(*v)
+	}
+	return _c
+}
+
 // SetEmail sets the "email" field.
 func (_c *UserCreate) SetEmail(v string) *UserCreate {
 	_c.mutation.SetEmail(v)
@@ -411,6 +446,18 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 		_node.Avatar = value
 	}
+	if value, ok := _c.mutation.AvatarVariants(); ok {
+		_spec.SetField(user.FieldAvatarVariants, field.TypeJSON, value)
+		_node.AvatarVariants = value
+	}
+	if value, ok := _c.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+		_node.Bio = value
+	}
+	if value, ok := _c.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeJSON, value)
+		_node.ProfileVisibility = value
+	}
 	if value, ok := _c.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
@@ -650,6 +697,60 @@ func (u *UserUpsert) ClearAvatar() *UserUpsert {
 	return u
 }
 
+// SetAvatarVariants sets the "avatar_variants" field.
+func (u *UserUpsert) SetAvatarVariants(v map[string]string) *UserUpsert {
+	u.Set(user.FieldAvatarVariants, v)
+	return u
+}
+
+// UpdateAvatarVariants sets the "avatar_variants" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAvatarVariants() *UserUpsert {
+	u.SetExcluded(user.FieldAvatarVariants)
+	return u
+}
+
+// ClearAvatarVariants clears the value of the "avatar_variants" field.
+func (u *UserUpsert) ClearAvatarVariants() *UserUpsert {
+	u.SetNull(user.FieldAvatarVariants)
+	return u
+}
+
+// SetBio sets the "bio" field.
+func (u *UserUpsert) SetBio(v string) *UserUpsert {
+	u.Set(user.FieldBio, v)
+	return u
+}
+
+// UpdateBio sets the "bio" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBio() *UserUpsert {
+	u.SetExcluded(user.FieldBio)
+	return u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (u *UserUpsert) ClearBio() *UserUpsert {
+	u.SetNull(user.FieldBio)
+	return u
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (u *UserUpsert) SetProfileVisibility(v model.ProfileVisibility) *UserUpsert {
+	u.Set(user.FieldProfileVisibility, v)
+	return u
+}
+
+// UpdateProfileVisibility sets the "profile_visibility" field to the value that was provided on create.
+func (u *UserUpsert) UpdateProfileVisibility() *UserUpsert {
+	u.SetExcluded(user.FieldProfileVisibility)
+	return u
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (u *UserUpsert) ClearProfileVisibility() *UserUpsert {
+	u.SetNull(user.FieldProfileVisibility)
+	return u
+}
+
 // SetEmail sets the "email" field.
 func (u *UserUpsert) SetEmail(v string) *UserUpsert {
 	u.Set(user.FieldEmail, v)
@@ -878,6 +979,69 @@ func (u *UserUpsertOne) ClearAvatar() *UserUpsertOne {
 	})
 }
 
+// SetAvatarVariants sets the "avatar_variants" field.
+func (u *UserUpsertOne) SetAvatarVariants(v map[string]string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAvatarVariants(v)
+	})
+}
+
+// UpdateAvatarVariants sets the "avatar_variants" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAvatarVariants() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAvatarVariants()
+	})
+}
+
+// ClearAvatarVariants clears the value of the "avatar_variants" field.
+func (u *UserUpsertOne) ClearAvatarVariants() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAvatarVariants()
+	})
+}
+
+// SetBio sets the "bio" field.
+func (u *UserUpsertOne) SetBio(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBio(v)
+	})
+}
+
+// UpdateBio sets the "bio" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBio() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBio()
+	})
+}
+
+// ClearBio clears the value of the "bio" field.
+func (u *UserUpsertOne) ClearBio() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBio()
+	})
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (u *UserUpsertOne) SetProfileVisibility(v model.ProfileVisibility) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetProfileVisibility(v)
+	})
+}
+
+// UpdateProfileVisibility sets the "profile_visibility" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateProfileVisibility() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateProfileVisibility()
+	})
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (u *UserUpsertOne) ClearProfileVisibility() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearProfileVisibility()
+	})
+}
+
 // SetEmail sets the "email" field.
 func (u *UserUpsertOne) SetEmail(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1284,6 +1448,69 @@ func (u *UserUpsertBulk) ClearAvatar() *UserUpsertBulk {
 	})
 }
 
+// SetAvatarVariants sets the "avatar_variants" field.
+func (u *UserUpsertBulk) SetAvatarVariants(v map[string]string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAvatarVariants(v)
+	})
+}
+
+// UpdateAvatarVariants sets the "avatar_variants" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAvatarVariants() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAvatarVariants()
+	})
+}
+
+// ClearAvatarVariants clears the value of the "avatar_variants" field.
+func (u *UserUpsertBulk) ClearAvatarVariants() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAvatarVariants()
+	})
+}
+
+// SetBio sets the "bio" field.
+func (u *UserUpsertBulk) SetBio(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBio(v)
+	})
+}
+
+// UpdateBio sets the "bio" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBio() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBio()
+	})
+}
+
+// ClearBio clears the value of the "bio" field.
+func (u *UserUpsertBulk) ClearBio() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBio()
+	})
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (u *UserUpsertBulk) SetProfileVisibility(v model.ProfileVisibility) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetProfileVisibility(v)
+	})
+}
+
+// UpdateProfileVisibility sets the "profile_visibility" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateProfileVisibility() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateProfileVisibility()
+	})
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (u *UserUpsertBulk) ClearProfileVisibility() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearProfileVisibility()
+	})
+}
+
 // SetEmail sets the "email" field.
 func (u *UserUpsertBulk) SetEmail(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -18,6 +18,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -128,6 +129,58 @@ func (_u *UserUpdate) ClearAvatar() *UserUpdate {
 	return _u
 }
 
+// SetAvatarVariants sets the "avatar_variants" field.
+func (_u *UserUpdate) SetAvatarVariants(v map[string]string) *UserUpdate {
+	_u.mutation.SetAvatarVariants(v)
+	return _u
+}
+
+// ClearAvatarVariants clears the value of the "avatar_variants" field.
+func (_u *UserUpdate) ClearAvatarVariants() *UserUpdate {
+	_u.mutation.ClearAvatarVariants()
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserUpdate) SetBio(v string) *UserUpdate {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBio(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *UserUpdate) ClearBio() *UserUpdate {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (_u *UserUpdate) SetProfileVisibility(v model.ProfileVisibility) *UserUpdate {
+	_u.mutation.SetProfileVisibility(v)
+	return _u
+}
+
+// SetNillableProfileVisibility sets the "profile_visibility" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableProfileVisibility(v *model.ProfileVisibility) *UserUpdate {
+	if v != nil {
+		_u.SetProfileVisibility(*v)
+	}
+	return _u
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (_u *UserUpdate) ClearProfileVisibility() *UserUpdate {
+	_u.mutation.ClearProfileVisibility()
+	return _u
+}
+
 // SetEmail sets the "email" field.
 func (_u *UserUpdate) SetEmail(v string) *UserUpdate {
 	_u.mutation.SetEmail(v)
@@ -500,6 +553,24 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AvatarCleared() {
 		_spec.ClearField(user.FieldAvatar, field.TypeString)
 	}
+	if value, ok := _u.mutation.AvatarVariants(); ok {
+		_spec.SetField(user.FieldAvatarVariants, field.TypeJSON, value)
+	}
+	if _u.mutation.AvatarVariantsCleared() {
+		_spec.ClearField(user.FieldAvatarVariants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeJSON, value)
+	}
+	if _u.mutation.ProfileVisibilityCleared() {
+		_spec.ClearField(user.FieldProfileVisibility, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
@@ -849,6 +920,58 @@ func (_u *UserUpdateOne) ClearAvatar() *UserUpdateOne {
 	return _u
 }
 
+// SetAvatarVariants sets the "avatar_variants" field.
+func (_u *UserUpdateOne) SetAvatarVariants(v map[string]string) *UserUpdateOne {
+	_u.mutation.SetAvatarVariants(v)
+	return _u
+}
+
+// ClearAvatarVariants clears the value of the "avatar_variants" field.
+func (_u *UserUpdateOne) ClearAvatarVariants() *UserUpdateOne {
+	_u.mutation.ClearAvatarVariants()
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserUpdateOne) SetBio(v string) *UserUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBio(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *UserUpdateOne) ClearBio() *UserUpdateOne {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (_u *UserUpdateOne) SetProfileVisibility(v model.ProfileVisibility) *UserUpdateOne {
+	_u.mutation.SetProfileVisibility(v)
+	return _u
+}
+
+// SetNillableProfileVisibility sets the "profile_visibility" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableProfileVisibility(v *model.ProfileVisibility) *UserUpdateOne {
+	if v != nil {
+		_u.SetProfileVisibility(*v)
+	}
+	return _u
+}
+
+// ClearProfileVisibility clears the value of the "profile_visibility" field.
+func (_u *UserUpdateOne) ClearProfileVisibility() *UserUpdateOne {
+	_u.mutation.ClearProfileVisibility()
+	return _u
+}
+
 // SetEmail sets the "email" field.
 func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
 	_u.mutation.SetEmail(v)
@@ -1251,6 +1374,24 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.AvatarCleared() {
 		_spec.ClearField(user.FieldAvatar, field.TypeString)
 	}
+	if value, ok := _u.mutation.AvatarVariants(); ok {
+		_spec.SetField(user.FieldAvatarVariants, field.TypeJSON, value)
+	}
+	if _u.mutation.AvatarVariantsCleared() {
+		_spec.ClearField(user.FieldAvatarVariants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeJSON, value)
+	}
+	if _u.mutation.ProfileVisibilityCleared() {
+		_spec.ClearField(user.FieldProfileVisibility, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
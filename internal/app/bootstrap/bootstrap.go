@@ -44,6 +44,7 @@ func (b *Bootstrapper) InitializeDatabase() error {
 	log.Println("--- 数据库 Schema 同步成功 ---")
 
 	b.syncSettings()
+	b.ensureSecrets()
 	b.initUserGroups()
 	b.initStoragePolicies()
 	b.initLinks()
@@ -96,13 +97,7 @@ func (b *Bootstrapper) syncSettings() {
 		// 如果配置项在数据库中不存在，则创建它
 		if !exists {
 			value := def.Value
-			// 特殊处理需要动态生成的密钥
-			if def.Key == constant.KeyJWTSecret {
-				value, _ = utils.GenerateRandomString(32)
-			}
-			if def.Key == constant.KeyLocalFileSigningSecret {
-				value, _ = utils.GenerateRandomString(32)
-			}
+			// 需要动态生成的密钥由 ensureSecrets 统一处理
 
 			// 检查环境变量覆盖
 			envKey := "AN_SETTING_DEFAULT_" + strings.ToUpper(string(def.Key))
@@ -133,6 +128,43 @@ func (b *Bootstrapper) syncSettings() {
 	}
 }
 
+// secretKeys 需要在首次启动时随机生成的密钥配置项
+var secretKeys = []constant.SettingKey{
+	constant.KeyJWTSecret,
+	constant.KeyLocalFileSigningSecret,
+	constant.KeyCommentAnonymousSalt,
+}
+
+// secretLength 随机生成的密钥长度（Base64 URL 字符数）
+const secretLength = 64
+
+// ensureSecrets 为值为空的密钥配置项生成强随机密钥。
+// 旧版本升级或数据库被手动清空时同样会补齐，避免使用空密钥签发令牌。
+func (b *Bootstrapper) ensureSecrets() {
+	ctx := context.Background()
+	for _, key := range secretKeys {
+		item, err := b.entClient.Setting.Query().Where(setting.ConfigKey(key.String())).Only(ctx)
+		if err != nil {
+			log.Printf("⚠️ 失败: 查询密钥配置项 '%s' 失败: %v", key, err)
+			continue
+		}
+		if strings.TrimSpace(item.Value) != "" {
+			continue
+		}
+
+		secret, err := utils.GenerateRandomString(secretLength)
+		if err != nil {
+			log.Printf("⚠️ 失败: 生成密钥 '%s' 失败: %v", key, err)
+			continue
+		}
+		if err := item.Update().SetValue(secret).Exec(ctx); err != nil {
+			log.Printf("⚠️ 失败: 写入密钥 '%s' 失败: %v", key, err)
+			continue
+		}
+		log.Printf("    - 已为空的密钥配置项 '%s' 生成随机值。", key)
+	}
+}
+
 // initUserGroups 检查并初始化默认用户组。
 func (b *Bootstrapper) initUserGroups() {
 	log.Println("--- 开始初始化默认用户组 (UserGroup 表) ---")
@@ -156,11 +188,43 @@ func (b *Bootstrapper) initUserGroups() {
 			if createErr != nil {
 				log.Printf("⚠️ 失败: 创建默认用户组 '%s' (ID: %d) 失败: %v", groupData.Name, groupData.ID, createErr)
 			}
+			continue
 		}
+		b.migrateUserGroupPermissions(ctx, groupData)
 	}
 	log.Println("--- 默认用户组 (UserGroup 表) 初始化完成。---")
 }
 
+// migrateUserGroupPermissions 为已存在的默认用户组补齐新增的资源权限（如 article:write），
+// 只添加缺失的权限位，不会撤销管理员手动调整过的权限
+func (b *Bootstrapper) migrateUserGroupPermissions(ctx context.Context, groupData configdef.UserGroupDefinition) {
+	group, err := b.entClient.UserGroup.Get(ctx, groupData.ID)
+	if err != nil {
+		log.Printf("⚠️ 失败: 读取用户组 ID: %d 失败: %v", groupData.ID, err)
+		return
+	}
+	// 旧版本中只有管理员组拥有后台权限，非管理员组不自动获得新增权限
+	if !group.Permissions.Enabled(model.PermissionAdmin) {
+		return
+	}
+	permissions := append(model.Boolset{}, group.Permissions...)
+	changed := false
+	for _, def := range model.AllPermissions {
+		if groupData.Permissions.Enabled(def.Bit) && !permissions.Enabled(def.Bit) {
+			permissions.Set(def.Bit, true)
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := b.entClient.UserGroup.UpdateOneID(groupData.ID).SetPermissions(permissions).Exec(ctx); err != nil {
+		log.Printf("⚠️ 失败: 迁移用户组 '%s' (ID: %d) 的权限失败: %v", group.Name, groupData.ID, err)
+		return
+	}
+	log.Printf("用户组 '%s' (ID: %d) 已补齐新增的资源权限", group.Name, groupData.ID)
+}
+
 func (b *Bootstrapper) initStoragePolicies() {
 	log.Println("--- 开始初始化默认存储策略 (StoragePolicy 表) ---")
 	ctx := context.Background()
@@ -450,7 +514,7 @@ func (b *Bootstrapper) checkUserTable() {
 	if err != nil {
 		log.Printf("❌ 错误: 查询 User 表记录数量失败: %v", err)
 	} else if userCount == 0 {
-		log.Println("User 表为空，第一个注册的用户将成为管理员。")
+		log.Println("User 表为空，请通过初始化向导创建管理员账户。")
 	}
 }
 
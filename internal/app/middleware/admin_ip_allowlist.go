@@ -0,0 +1,144 @@
+/*
+ * @Description: 管理接口 IP 白名单中间件，在 JWT 与管理员权限校验之外再限制管理接口的来源 IP
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 11:00:00
+ * @LastEditTime: 2026-10-18 09:00:00
+ * @LastEditors: 安知鱼
+ */
+package middleware
+
+import (
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// adminIPGuardedPrefixes 受 IP 白名单保护的路由前缀；其余需要管理或内容权限的接口在 RequirePermission 中校验
+var adminIPGuardedPrefixes = []string{"/api/admin/", "/api/settings/"}
+
+var (
+	adminIPAllowlistProvider   func() string
+	adminIPAllowlistProviderMu sync.RWMutex
+
+	// 缓存最近一次解析的白名单，配置未变化时无需重复解析
+	adminIPAllowlistCache struct {
+		sync.Mutex
+		raw  string
+		nets []*net.IPNet
+	}
+)
+
+// SetAdminIPAllowlistProvider 配置管理接口 IP 白名单的来源，返回逗号分隔的 IP 或 CIDR，空字符串表示不限制。
+// 每个请求都会调用，站点配置变更后立即生效。
+func SetAdminIPAllowlistProvider(provider func() string) {
+	adminIPAllowlistProviderMu.Lock()
+	defer adminIPAllowlistProviderMu.Unlock()
+	adminIPAllowlistProvider = provider
+}
+
+// parseIPAllowlist 解析逗号分隔的 IP 与 CIDR 列表，单个 IP 视为 /32 或 /128，无法解析的条目会被忽略
+func parseIPAllowlist(raw string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				log.Printf("[管理接口白名单] 忽略无效的 IP: %s", entry)
+				continue
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			log.Printf("[管理接口白名单] 忽略无效的 CIDR: %s", entry)
+			continue
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+// adminIPAllowlist 返回当前生效的白名单，未配置时返回 nil
+func adminIPAllowlist() []*net.IPNet {
+	adminIPAllowlistProviderMu.RLock()
+	provider := adminIPAllowlistProvider
+	adminIPAllowlistProviderMu.RUnlock()
+	if provider == nil {
+		return nil
+	}
+	raw := strings.TrimSpace(provider())
+
+	cache := &adminIPAllowlistCache
+	cache.Lock()
+	defer cache.Unlock()
+	if raw != cache.raw {
+		cache.raw = raw
+		cache.nets = parseIPAllowlist(raw)
+	}
+	return cache.nets
+}
+
+// adminIPAllowed 判断客户端 IP 是否允许访问管理接口。未配置白名单时不限制；
+// 直连的本机请求始终放行，配置错误时仍可在服务器上恢复访问。经代理转发的请求
+// （携带转发头部）不享受该豁免，否则客户端伪造 X-Forwarded-For: 127.0.0.1 即可绕过白名单。
+func adminIPAllowed(c *gin.Context) bool {
+	allowlist := adminIPAllowlist()
+	if len(allowlist) == 0 {
+		return true
+	}
+	if peer := net.ParseIP(c.RemoteIP()); peer != nil && peer.IsLoopback() && !util.HasForwardingHeaders(c) {
+		return true
+	}
+	ip := net.ParseIP(util.GetRealClientIP(c))
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range allowlist {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// rejectAdminIP 在客户端 IP 不在白名单内时返回 403 并终止请求，返回是否已拒绝
+func rejectAdminIP(c *gin.Context) bool {
+	if adminIPAllowed(c) {
+		return false
+	}
+	log.Printf("[管理接口白名单] 拒绝来自 %s 的请求: %s %s", util.GetRealClientIP(c), c.Request.Method, c.Request.URL.Path)
+	response.Fail(c, http.StatusForbidden, "当前 IP 不允许访问管理接口")
+	c.Abort()
+	return true
+}
+
+// AdminIPAllowlist 限制 /api/admin/ 与 /api/settings/ 下的接口只能从白名单内的 IP 访问
+func AdminIPAllowlist() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		for _, prefix := range adminIPGuardedPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				if rejectAdminIP(c) {
+					return
+				}
+				break
+			}
+		}
+		c.Next()
+	}
+}
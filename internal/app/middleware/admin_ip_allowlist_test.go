@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAdminIPAllowlist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	allowlist := "203.0.113.7, 198.51.100.0/24, invalid"
+	SetAdminIPAllowlistProvider(func() string { return allowlist })
+	t.Cleanup(func() { SetAdminIPAllowlistProvider(nil) })
+
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(util.TrustedProxyCIDRs); err != nil {
+		t.Fatal(err)
+	}
+	engine.Use(AdminIPAllowlist())
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	engine.GET("/api/admin/users", ok)
+	engine.GET("/api/public/articles", ok)
+
+	do := func(path, remoteAddr, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	cases := []struct {
+		name, path, remote, xff string
+		want                    int
+	}{
+		{"白名单内的单个 IP", "/api/admin/users", "203.0.113.7:1234", "", http.StatusOK},
+		{"白名单内的网段", "/api/admin/users", "198.51.100.20:1234", "", http.StatusOK},
+		{"白名单外的 IP", "/api/admin/users", "192.0.2.1:1234", "", http.StatusForbidden},
+		{"可信代理转发的真实 IP", "/api/admin/users", "10.0.0.2:1234", "198.51.100.9", http.StatusOK},
+		{"非可信来源伪造转发头", "/api/admin/users", "192.0.2.1:1234", "203.0.113.7", http.StatusForbidden},
+		{"本机直连始终放行", "/api/admin/users", "127.0.0.1:1234", "", http.StatusOK},
+		{"可信代理转发的回环地址不放行", "/api/admin/users", "10.0.0.2:1234", "127.0.0.1", http.StatusForbidden},
+		{"本机代理转发的外部 IP 按白名单校验", "/api/admin/users", "127.0.0.1:1234", "192.0.2.1", http.StatusForbidden},
+		{"非管理接口不受限制", "/api/public/articles", "192.0.2.1:1234", "", http.StatusOK},
+	}
+	for _, tc := range cases {
+		if got := do(tc.path, tc.remote, tc.xff); got != tc.want {
+			t.Errorf("%s: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+
+	allowlist = ""
+	if got := do("/api/admin/users", "192.0.2.1:1234", ""); got != http.StatusOK {
+		t.Errorf("未配置白名单时不应限制，实际 %d", got)
+	}
+}
+
+func TestRequirePermissionChecksAdminIPAllowlist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	if err := idgen.InitSqidsEncoderWithSeed("admin-ip-allowlist-test"); err != nil {
+		t.Fatal(err)
+	}
+	groupID, err := idgen.GeneratePublicID(1, idgen.EntityTypeUserGroup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetAdminIPAllowlistProvider(func() string { return "203.0.113.7" })
+	t.Cleanup(func() { SetAdminIPAllowlistProvider(nil) })
+
+	m := NewMiddleware(nil)
+	engine := gin.New()
+	setClaims := func(c *gin.Context) { c.Set(auth.ClaimsKey, &auth.CustomClaims{UserGroupID: groupID}) }
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	// 不在 /api/admin/ 下、只需要内容权限的接口同样受白名单限制
+	engine.POST("/api/articles", setClaims, m.RequirePermission(model.PermissionArticleWrite), ok)
+
+	do := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/articles", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	if got := do("203.0.113.7:1234"); got != http.StatusOK {
+		t.Errorf("白名单内的 IP 应放行，实际 %d", got)
+	}
+	if got := do("192.0.2.1:1234"); got != http.StatusForbidden {
+		t.Errorf("白名单外的 IP 应拒绝，实际 %d", got)
+	}
+}
@@ -2,26 +2,96 @@
 package middleware
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/access_token"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	service_auth "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
 
 type Middleware struct {
-	tokenSvc service_auth.TokenService
+	tokenSvc       service_auth.TokenService
+	auditSvc       *audit.Service
+	accessTokenSvc *access_token.Service
+	userGroupRepo  repository.UserGroupRepository
 }
 
 func NewMiddleware(tokenSvc service_auth.TokenService) *Middleware {
 	return &Middleware{tokenSvc: tokenSvc}
 }
 
+// SetAuditService 注入审计日志服务，用于记录模拟登录令牌发起的请求
+func (m *Middleware) SetAuditService(svc *audit.Service) {
+	m.auditSvc = svc
+}
+
+// SetAccessTokenService 注入个人访问令牌服务，注入后认证中间件接受 ahy_ 开头的访问令牌代替 JWT
+func (m *Middleware) SetAccessTokenService(svc *access_token.Service) {
+	m.accessTokenSvc = svc
+}
+
+// SetUserGroupRepository 注入用户组仓库，注入后权限校验按用户组当前的权限进行
+func (m *Middleware) SetUserGroupRepository(repo repository.UserGroupRepository) {
+	m.userGroupRepo = repo
+}
+
+// fileUploadPathPrefix 文件分片上传接口的路由前缀，访问令牌需要 file_upload 权限
+const fileUploadPathPrefix = "/api/file/upload"
+
+// handleAccessToken 处理个人访问令牌认证：raw 不是访问令牌时返回 false，由调用方继续按 JWT 处理；
+// 否则校验令牌与权限范围，通过时写入用户信息并继续执行后续处理器，失败时终止请求，返回 true
+func (m *Middleware) handleAccessToken(c *gin.Context, raw string, upload bool) bool {
+	if m.accessTokenSvc == nil || !access_token.IsAccessToken(raw) {
+		return false
+	}
+	token, user, err := m.accessTokenSvc.Authenticate(c.Request.Context(), raw)
+	if err != nil {
+		log.Printf("[JWTAuth] 访问令牌校验失败: %v", err)
+		response.Fail(c, http.StatusUnauthorized, "无效或过期的访问令牌")
+		c.Abort()
+		return true
+	}
+	if !token.AllowsAPI(c.Request.Method, upload) || !tokenRouteAllowed(c, upload) {
+		response.Fail(c, http.StatusForbidden, "访问令牌的权限范围不允许此操作")
+		c.Abort()
+		return true
+	}
+	claims, err := auth.NewAccessTokenClaims(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, token.Scopes)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "访问令牌认证失败")
+		c.Abort()
+		return true
+	}
+	c.Set(auth.ClaimsKey, claims)
+	c.Next()
+	return true
+}
+
+// tokenRouteAllowed 访问令牌的写请求只能落在内容接口上，避免 content_write 令牌修改用户、配置等管理数据；
+// 管理类接口（/api/admin、/api/config）即使是只读请求也默认拒绝，仅放行 TokenAdminReadRoutes 白名单
+func tokenRouteAllowed(c *gin.Context, upload bool) bool {
+	if upload {
+		return true
+	}
+	route := c.FullPath()
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return !model.IsTokenAdminRoute(route) || model.IsTokenAdminReadRoute(route)
+	}
+	return model.IsTokenContentWriteRoute(route)
+}
+
 // JWTAuth 是一个强制性的JWT认证中间件
 func (m *Middleware) JWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -40,6 +110,9 @@ func (m *Middleware) JWTAuth() gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
+		if m.handleAccessToken(c, tokenString, strings.HasPrefix(c.FullPath(), fileUploadPathPrefix)) {
+			return
+		}
 		claims, err := m.tokenSvc.ParseAccessToken(c.Request.Context(), tokenString)
 		if err != nil {
 			log.Printf("[JWTAuth] JWT token解析失败: %v", err)
@@ -47,9 +120,15 @@ func (m *Middleware) JWTAuth() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
+		if claims.IsUploadToken() {
+			response.Fail(c, http.StatusForbidden, "上传令牌只能用于图片上传")
+			c.Abort()
+			return
+		}
 
 		c.Set(auth.ClaimsKey, claims)
 		c.Next()
+		m.auditImpersonation(c, claims)
 	}
 }
 
@@ -70,6 +149,9 @@ func (m *Middleware) JWTAuthOptional() gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
+		if m.handleAccessToken(c, tokenString, false) {
+			return
+		}
 		claims, err := m.tokenSvc.ParseAccessToken(c.Request.Context(), tokenString)
 		if err != nil {
 			// Token无效或过期，返回401触发前端自动刷新token
@@ -78,16 +160,71 @@ func (m *Middleware) JWTAuthOptional() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
+		if claims.IsUploadToken() {
+			response.Fail(c, http.StatusForbidden, "上传令牌只能用于图片上传")
+			c.Abort()
+			return
+		}
 
 		// Token有效，将用户信息存入context
 		c.Set(auth.ClaimsKey, claims)
 		c.Next()
+		m.auditImpersonation(c, claims)
+	}
+}
+
+// UploadAuth 是上传接口的认证中间件，除完整的会话令牌外，还接受 scope 与 policyFlag 一致的上传令牌。
+// optional 为 true 时未携带令牌按游客放行，行为与 JWTAuthOptional 一致。
+func (m *Middleware) UploadAuth(policyFlag string, optional bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.Request.Header.Get("Authorization")
+		parts := strings.SplitN(authHeader, " ", 2)
+		if !(len(parts) == 2 && parts[0] == "Bearer") {
+			if optional {
+				c.Next()
+				return
+			}
+			response.Fail(c, http.StatusUnauthorized, "请求未携带Token，无权限访问")
+			c.Abort()
+			return
+		}
+
+		if m.handleAccessToken(c, parts[1], true) {
+			return
+		}
+		claims, err := m.tokenSvc.ParseAccessToken(c.Request.Context(), parts[1])
+		if err != nil {
+			log.Printf("[UploadAuth] Token解析失败: %v", err)
+			response.Fail(c, http.StatusUnauthorized, "无效或过期的Token")
+			c.Abort()
+			return
+		}
+		if claims.IsUploadToken() && claims.UploadScope != policyFlag {
+			response.Fail(c, http.StatusForbidden, "上传令牌不适用于此上传接口")
+			c.Abort()
+			return
+		}
+
+		c.Set(auth.ClaimsKey, claims)
+		c.Next()
+		m.auditImpersonation(c, claims)
 	}
 }
 
 // AdminAuth 是一个管理员权限验证中间件
 func (m *Middleware) AdminAuth() gin.HandlerFunc {
+	return m.RequirePermission(model.PermissionAdmin)
+}
+
+// RequirePermission 要求当前用户所在的用户组拥有指定权限，需放在 JWTAuth 之后。
+// 管理员组（ID 为 1）与拥有管理员权限的用户组始终通过；注入用户组仓库后按用户组当前的权限判断，
+// 修改用户组权限后无需等待令牌刷新即可生效，未注入时使用令牌中的权限。
+// 所有管理权限的接口都受管理接口 IP 白名单限制。
+func (m *Middleware) RequirePermission(perm uint) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if rejectAdminIP(c) {
+			return
+		}
 		claimsValue, exists := c.Get(auth.ClaimsKey)
 		if !exists {
 			response.Fail(c, http.StatusForbidden, "权限信息获取失败")
@@ -102,6 +239,13 @@ func (m *Middleware) AdminAuth() gin.HandlerFunc {
 			return
 		}
 
+		// 模拟登录令牌始终不具备管理权限
+		if claims.IsImpersonation() {
+			response.Fail(c, http.StatusForbidden, "模拟登录期间无法访问管理接口")
+			c.Abort()
+			return
+		}
+
 		userGroupID, entityType, err := idgen.DecodePublicID(claims.UserGroupID)
 		if err != nil || entityType != idgen.EntityTypeUserGroup {
 			response.Fail(c, http.StatusForbidden, "权限信息无效：用户组ID无法解析")
@@ -109,12 +253,90 @@ func (m *Middleware) AdminAuth() gin.HandlerFunc {
 			return
 		}
 
-		if userGroupID != 1 {
-			response.Fail(c, http.StatusForbidden, "权限不足：此操作需要管理员权限")
-			c.Abort()
-			return
+		isAdmin := userGroupID == 1
+		if !isAdmin {
+			perms := m.groupPermissions(c.Request.Context(), userGroupID, claims)
+			isAdmin = perms.Enabled(model.PermissionAdmin)
+			if !perms.Has(perm) {
+				if perm == model.PermissionAdmin {
+					response.Fail(c, http.StatusForbidden, "权限不足：此操作需要管理员权限")
+				} else {
+					response.Fail(c, http.StatusForbidden, "权限不足：此操作需要 "+model.PermissionName(perm)+" 权限")
+				}
+				c.Abort()
+				return
+			}
+		}
+		c.Set(auth.IsAdminKey, isAdmin)
+
+		// 记录操作者，供业务服务写入数据变更的审计日志
+		if userID, _, err := idgen.DecodePublicID(claims.UserID); err == nil {
+			c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), &audit.Actor{
+				UserID:    userID,
+				Method:    c.Request.Method,
+				Path:      c.Request.URL.Path,
+				IP:        util.GetRealClientIP(c),
+				UserAgent: c.Request.UserAgent(),
+			}))
+		}
+
+		c.Next()
+	}
+}
+
+// groupPermissions 返回用户组当前的权限，查询失败时按无权限处理
+func (m *Middleware) groupPermissions(ctx context.Context, groupID uint, claims *auth.CustomClaims) model.Boolset {
+	if m.userGroupRepo == nil {
+		return model.Boolset(claims.Permissions)
+	}
+	group, err := m.userGroupRepo.FindByID(ctx, groupID)
+	if err != nil || group == nil {
+		if err != nil {
+			log.Printf("[RequirePermission] 查询用户组 %d 失败: %v", groupID, err)
 		}
+		return nil
+	}
+	return group.Permissions
+}
 
+// DenyImpersonation 拒绝模拟登录令牌与个人访问令牌访问，用于修改密码、创建访问令牌等账户敏感操作
+func (m *Middleware) DenyImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if claims, ok := c.Get(auth.ClaimsKey); ok {
+			if cc, ok := claims.(*auth.CustomClaims); ok && cc.IsImpersonation() {
+				response.Fail(c, http.StatusForbidden, "模拟登录期间不允许执行此操作")
+				c.Abort()
+				return
+			}
+			if cc, ok := claims.(*auth.CustomClaims); ok && cc.IsAccessToken() {
+				response.Fail(c, http.StatusForbidden, "访问令牌不能用于此操作，请登录后操作")
+				c.Abort()
+				return
+			}
+		}
 		c.Next()
 	}
 }
+
+// auditImpersonation 为模拟登录令牌发起的请求写入审计日志
+func (m *Middleware) auditImpersonation(c *gin.Context, claims *auth.CustomClaims) {
+	if m.auditSvc == nil || !claims.IsImpersonation() {
+		return
+	}
+	adminID, _, err := idgen.DecodePublicID(claims.ImpersonatorID)
+	if err != nil {
+		log.Printf("[JWTAuth] 模拟令牌中的管理员ID无法解析: %v", err)
+		return
+	}
+	userID, _, _ := idgen.DecodePublicID(claims.UserID)
+	_ = m.auditSvc.Record(context.WithoutCancel(c.Request.Context()), &model.AuditLog{
+		ActorID:       adminID,
+		SubjectUserID: userID,
+		Action:        model.AuditActionImpersonationRequest,
+		Method:        c.Request.Method,
+		Path:          c.Request.URL.Path,
+		StatusCode:    c.Writer.Status(),
+		IP:            util.GetRealClientIP(c),
+		UserAgent:     c.Request.UserAgent(),
+	})
+}
@@ -9,9 +9,42 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// WidgetPathPrefix is the prefix of public statistics widget endpoints.
+// These endpoints are meant to be embedded by third-party pages and use
+// their own CORS policy instead of the site-origin one.
+const WidgetPathPrefix = "/api/public/widget/"
+
+// PublicPathPrefix is the prefix of public API endpoints. Together with SearchPath
+// they may be consumed by headless frontends hosted on other domains, governed by
+// the configurable public CORS policy.
+const PublicPathPrefix = "/api/public/"
+
+// SearchPath is the public article search endpoint.
+const SearchPath = "/api/search"
+
+const (
+	defaultPublicCORSMethods = "GET, POST, OPTIONS"
+	defaultPublicCORSHeaders = "Content-Type"
+)
+
+// PublicCORSPolicy is the CORS policy applied to public API endpoints for
+// origins other than the site itself.
+type PublicCORSPolicy struct {
+	AllowedOrigins   string // comma-separated origin list; "*" allows any origin, empty disables cross-origin access
+	AllowedMethods   string // value of Access-Control-Allow-Methods; empty uses "GET, POST, OPTIONS"
+	AllowedHeaders   string // value of Access-Control-Allow-Headers; empty uses "Content-Type"
+	AllowCredentials bool   // whether cookies and Authorization headers may be sent cross-origin
+}
+
 var (
 	corsAllowedOrigins   []string
 	corsAllowedOriginsMu sync.RWMutex
+
+	widgetOriginsProvider   func() string
+	widgetOriginsProviderMu sync.RWMutex
+
+	publicPolicyProvider   func() PublicCORSPolicy
+	publicPolicyProviderMu sync.RWMutex
 )
 
 // SetCORSAllowedOrigins configures the allowed origins for CORS.
@@ -23,6 +56,135 @@ func SetCORSAllowedOrigins(origins []string) {
 	corsAllowedOrigins = origins
 }
 
+// SetWidgetCORSOriginsProvider configures where the widget CORS policy is read from.
+// The provider returns a comma-separated origin list; "*" allows any origin.
+// It is called per request so changes in the site configuration apply immediately.
+func SetWidgetCORSOriginsProvider(provider func() string) {
+	widgetOriginsProviderMu.Lock()
+	defer widgetOriginsProviderMu.Unlock()
+	widgetOriginsProvider = provider
+}
+
+// SetPublicCORSPolicyProvider configures where the public API CORS policy is read from.
+// It is called per request so changes in the site configuration apply immediately.
+func SetPublicCORSPolicyProvider(provider func() PublicCORSPolicy) {
+	publicPolicyProviderMu.Lock()
+	defer publicPolicyProviderMu.Unlock()
+	publicPolicyProvider = provider
+}
+
+// widgetAllowOrigin returns the value for Access-Control-Allow-Origin, or "" if not allowed.
+func widgetAllowOrigin(origin string) string {
+	widgetOriginsProviderMu.RLock()
+	provider := widgetOriginsProvider
+	widgetOriginsProviderMu.RUnlock()
+	if provider == nil || origin == "" {
+		return ""
+	}
+	return matchOrigin(provider(), origin)
+}
+
+// matchOrigin matches origin against a comma-separated origin list.
+// It returns "*" for a wildcard entry, origin for an exact match and "" otherwise.
+func matchOrigin(list, origin string) string {
+	for _, allowed := range strings.Split(list, ",") {
+		allowed = strings.TrimRight(strings.TrimSpace(allowed), "/")
+		if allowed == "*" {
+			return "*"
+		}
+		if allowed != "" && strings.EqualFold(origin, allowed) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// widgetCors handles CORS for widget endpoints: read-only and without credentials.
+func widgetCors(c *gin.Context) {
+	allowOrigin := widgetAllowOrigin(c.Request.Header.Get("Origin"))
+	if allowOrigin == "" {
+		if c.Request.Method == http.MethodOptions {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		c.Next()
+		return
+	}
+
+	c.Header("Access-Control-Allow-Origin", allowOrigin)
+	if allowOrigin != "*" {
+		c.Header("Vary", "Origin")
+	}
+	c.Header("Access-Control-Allow-Methods", "GET, OPTIONS")
+	c.Header("Access-Control-Max-Age", "86400")
+
+	if c.Request.Method == http.MethodOptions {
+		c.AbortWithStatus(http.StatusNoContent)
+		return
+	}
+
+	c.Next()
+}
+
+// isPublicAPIPath reports whether path is covered by the public CORS policy.
+func isPublicAPIPath(path string) bool {
+	return strings.HasPrefix(path, PublicPathPrefix) || path == SearchPath
+}
+
+// publicCors handles CORS for public API endpoints requested from origins other than the site.
+func publicCors(c *gin.Context) {
+	publicPolicyProviderMu.RLock()
+	provider := publicPolicyProvider
+	publicPolicyProviderMu.RUnlock()
+
+	var policy PublicCORSPolicy
+	if provider != nil {
+		policy = provider()
+	}
+	origin := c.Request.Header.Get("Origin")
+	allowOrigin := matchOrigin(policy.AllowedOrigins, origin)
+	if allowOrigin == "" {
+		if c.Request.Method == http.MethodOptions {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		c.Next()
+		return
+	}
+	// Browsers reject "*" together with credentials, so echo the origin instead.
+	if allowOrigin == "*" && policy.AllowCredentials {
+		allowOrigin = origin
+	}
+
+	methods := strings.TrimSpace(policy.AllowedMethods)
+	if methods == "" {
+		methods = defaultPublicCORSMethods
+	}
+	headers := strings.TrimSpace(policy.AllowedHeaders)
+	if headers == "" {
+		headers = defaultPublicCORSHeaders
+	}
+
+	c.Header("Access-Control-Allow-Origin", allowOrigin)
+	if allowOrigin != "*" {
+		c.Header("Vary", "Origin")
+	}
+	if policy.AllowCredentials {
+		c.Header("Access-Control-Allow-Credentials", "true")
+	}
+	c.Header("Access-Control-Allow-Methods", methods)
+	c.Header("Access-Control-Allow-Headers", headers)
+	c.Header("Access-Control-Expose-Headers", "Content-Length, Link, X-Total-Count, X-Total-Pages")
+	c.Header("Access-Control-Max-Age", "86400")
+
+	if c.Request.Method == http.MethodOptions {
+		c.AbortWithStatus(http.StatusNoContent)
+		return
+	}
+
+	c.Next()
+}
+
 func isOriginAllowed(origin string) bool {
 	if origin == "" {
 		return false
@@ -52,7 +214,16 @@ func Cors() gin.HandlerFunc {
 			return
 		}
 
+		if strings.HasPrefix(c.Request.URL.Path, WidgetPathPrefix) {
+			widgetCors(c)
+			return
+		}
+
 		origin := c.Request.Header.Get("Origin")
+		if origin != "" && !isOriginAllowed(origin) && isPublicAPIPath(c.Request.URL.Path) {
+			publicCors(c)
+			return
+		}
 		if origin == "" || !isOriginAllowed(origin) {
 			if c.Request.Method == http.MethodOptions {
 				c.AbortWithStatus(http.StatusForbidden)
@@ -65,8 +236,8 @@ func Cors() gin.HandlerFunc {
 		c.Header("Access-Control-Allow-Origin", origin)
 		c.Header("Access-Control-Allow-Credentials", "true")
 		c.Header("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-		c.Header("Access-Control-Allow-Headers", "Authorization, Content-Type, X-CSRF-Token, X-Requested-With, Range, Accept-Ranges, Content-Range, Content-Length, Content-Disposition")
-		c.Header("Access-Control-Expose-Headers", "Authorization, Content-Range, Content-Length, Content-Disposition")
+		c.Header("Access-Control-Allow-Headers", "Authorization, Content-Type, X-CSRF-Token, X-Requested-With, Range, Accept-Ranges, Content-Range, Content-Length, Content-Disposition, X-Chunk-Checksum")
+		c.Header("Access-Control-Expose-Headers", "Authorization, Content-Range, Content-Length, Content-Disposition, Link, X-Total-Count, X-Total-Pages")
 
 		if c.Request.Method == http.MethodOptions {
 			c.AbortWithStatus(http.StatusNoContent)
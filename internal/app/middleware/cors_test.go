@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPublicCors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	SetCORSAllowedOrigins([]string{"https://blog.example.com"})
+	policy := PublicCORSPolicy{AllowedOrigins: "https://app.example.com", AllowedHeaders: "Content-Type, X-Api-Key"}
+	SetPublicCORSPolicyProvider(func() PublicCORSPolicy { return policy })
+	t.Cleanup(func() {
+		SetCORSAllowedOrigins(nil)
+		SetPublicCORSPolicyProvider(nil)
+	})
+
+	engine := gin.New()
+	engine.Use(Cors())
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	engine.GET("/api/public/articles", ok)
+	engine.GET("/api/search", ok)
+	engine.GET("/api/articles", ok)
+
+	do := func(method, path, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Origin", origin)
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodOptions, "/api/search", "https://app.example.com")
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Access-Control-Allow-Origin") != "https://app.example.com" {
+		t.Fatalf("配置的来源应通过预检: %d %v", rec.Code, rec.Header())
+	}
+	if rec.Header().Get("Access-Control-Allow-Headers") != "Content-Type, X-Api-Key" || rec.Header().Get("Access-Control-Allow-Credentials") != "" {
+		t.Errorf("应使用配置的请求头且默认不允许携带凭据: %v", rec.Header())
+	}
+	if rec := do(http.MethodGet, "/api/articles", "https://app.example.com"); rec.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("非公开接口不应对其他来源开放跨域")
+	}
+	if rec := do(http.MethodOptions, "/api/public/articles", "https://evil.example.com"); rec.Code != http.StatusForbidden {
+		t.Errorf("未配置的来源预检应被拒绝，实际 %d", rec.Code)
+	}
+	if rec := do(http.MethodGet, "/api/public/articles", "https://blog.example.com"); rec.Header().Get("Access-Control-Allow-Credentials") != "true" {
+		t.Error("站点自身仍应使用站点跨域策略")
+	}
+
+	policy = PublicCORSPolicy{AllowedOrigins: "*", AllowCredentials: true}
+	rec = do(http.MethodGet, "/api/public/articles", "https://any.example.com")
+	if rec.Header().Get("Access-Control-Allow-Origin") != "https://any.example.com" || rec.Header().Get("Access-Control-Allow-Credentials") != "true" {
+		t.Errorf("允许凭据时通配符应回显请求来源: %v", rec.Header())
+	}
+}
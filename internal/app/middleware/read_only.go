@@ -0,0 +1,91 @@
+/*
+ * @Description: 站点只读模式中间件，用于数据库迁移或恢复期间拒绝所有写操作
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ReadOnlyTogglePath 是切换只读模式的接口路由，只读模式下依然放行
+const ReadOnlyTogglePath = "/api/settings/read-only"
+
+// defaultReadOnlyMessage 未配置提示语时返回的默认信息
+const defaultReadOnlyMessage = "站点正在维护中，暂时只能浏览，请稍后再试"
+
+// readOnlyAllowedPaths 只读模式下依然放行的非 GET 接口：
+// 登录、第三方登录凭证兑换与刷新令牌（管理员需要登录后才能关闭只读模式），以及使用 POST 的只读查询/导出接口
+var readOnlyAllowedPaths = map[string]bool{
+	ReadOnlyTogglePath:                 true,
+	"/api/auth/login":                  true,
+	"/api/auth/oauth/exchange":         true,
+	"/api/auth/refresh-token":          true,
+	"/api/settings/get-by-keys":        true,
+	"/api/articles/primary-color":      true,
+	"/api/articles/export":             true,
+	"/api/comments/export":             true,
+	"/api/albums/export":               true,
+	"/api/public/music/song-resources": true,
+}
+
+// isReadOnlyGuardedPath 判断请求路径是否受只读模式限制
+func isReadOnlyGuardedPath(path string) bool {
+	return strings.HasPrefix(path, "/api/") || path == "/dav" || strings.HasPrefix(path, "/dav/")
+}
+
+var (
+	readOnlyProvider   func() (bool, string)
+	readOnlyProviderMu sync.RWMutex
+)
+
+// SetReadOnlyProvider 配置只读模式状态的来源，返回是否启用及提示信息。
+// 每个请求都会调用，站点配置变更后立即生效。
+func SetReadOnlyProvider(provider func() (bool, string)) {
+	readOnlyProviderMu.Lock()
+	defer readOnlyProviderMu.Unlock()
+	readOnlyProvider = provider
+}
+
+// ReadOnly 是只读模式中间件。启用后，/api 与 WebDAV（/dav）下除 GET/HEAD/OPTIONS/PROPFIND 与白名单外的请求一律返回 503。
+func ReadOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, "PROPFIND":
+			c.Next()
+			return
+		}
+		if !isReadOnlyGuardedPath(c.Request.URL.Path) || readOnlyAllowedPaths[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		readOnlyProviderMu.RLock()
+		provider := readOnlyProvider
+		readOnlyProviderMu.RUnlock()
+		if provider == nil {
+			c.Next()
+			return
+		}
+		enabled, message := provider()
+		if !enabled {
+			c.Next()
+			return
+		}
+
+		if strings.TrimSpace(message) == "" {
+			message = defaultReadOnlyMessage
+		}
+		c.Header("Retry-After", "300")
+		response.Fail(c, http.StatusServiceUnavailable, message)
+		c.Abort()
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestReadOnly_GuardsWebDAV(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	SetReadOnlyProvider(func() (bool, string) { return true, "" })
+	t.Cleanup(func() { SetReadOnlyProvider(nil) })
+
+	engine := gin.New()
+	engine.Use(ReadOnly())
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	methods := []string{http.MethodGet, http.MethodPut, http.MethodDelete, "PROPFIND", "PROPPATCH", "MKCOL", "COPY", "MOVE"}
+	for _, method := range methods {
+		engine.Handle(method, "/dav/*path", ok)
+		engine.Handle(method, "/assets/*path", ok)
+	}
+
+	for _, method := range methods {
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, httptest.NewRequest(method, "/dav/docs/a.txt", nil))
+		want := http.StatusServiceUnavailable
+		if method == http.MethodGet || method == "PROPFIND" {
+			want = http.StatusOK
+		}
+		if rec.Code != want {
+			t.Errorf("%s /dav: 状态码 %d, want %d", method, rec.Code, want)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/assets/a.txt", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("只读模式不应影响 /api 与 /dav 之外的路径, 实际状态码 %d", rec.Code)
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * @Description: 请求 ID 中间件，为每个请求生成或沿用关联 ID，写入响应头与日志上下文
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 20:00:00
+ * @LastEditTime: 2026-10-17 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader 携带请求 ID 的请求头与响应头
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength 沿用上游请求 ID 的最大长度，过长或包含非法字符时重新生成
+const maxRequestIDLength = 64
+
+// RequestID 为每个请求分配请求 ID：优先沿用反向代理传入的 X-Request-ID，否则随机生成。
+// 请求 ID 会写入响应头、gin 上下文（request_id）以及请求的 context，
+// 使用 slog.*Context 记录的日志会自动附加该字段，便于串联同一请求的所有日志。
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Header(RequestIDHeader, id)
+		c.Set(logger.RequestIDKey, id)
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validRequestID 只接受字母、数字与 -_.: 组成的请求 ID，避免日志注入
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == ':') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var seen string
+	engine := gin.New()
+	engine.Use(RequestID())
+	engine.GET("/api/ping", func(c *gin.Context) {
+		seen = logger.RequestID(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	do := func(header string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/ping", nil)
+		if header != "" {
+			req.Header.Set(RequestIDHeader, header)
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do("")
+	if got := w.Header().Get(RequestIDHeader); len(got) != 24 || got != seen {
+		t.Errorf("应生成请求 ID 并写入上下文, header=%q ctx=%q", got, seen)
+	}
+
+	if w := do("upstream-abc.1"); w.Header().Get(RequestIDHeader) != "upstream-abc.1" || seen != "upstream-abc.1" {
+		t.Errorf("应沿用上游的请求 ID, header=%q ctx=%q", w.Header().Get(RequestIDHeader), seen)
+	}
+
+	if w := do("bad id\nforged=1"); w.Header().Get(RequestIDHeader) == "bad id\nforged=1" {
+		t.Error("包含非法字符的请求 ID 应重新生成")
+	}
+}
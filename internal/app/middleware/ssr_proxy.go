@@ -14,6 +14,7 @@ import (
 
 	frontend_runtime "github.com/anzhiyu-c/anheyu-app/internal/frontend"
 	"github.com/anzhiyu-c/anheyu-app/pkg/ssr"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -104,7 +105,7 @@ func SSRProxyMiddleware(ssrManager *ssr.Manager) gin.HandlerFunc {
 			req.Host = req.URL.Host
 			// 添加代理标识头
 			req.Header.Set("X-Forwarded-Host", c.Request.Host)
-			req.Header.Set("X-Real-IP", c.ClientIP())
+			req.Header.Set("X-Real-IP", util.GetRealClientIP(c))
 		}
 
 		// 错误处理：当 SSR 进程不可用时返回友好错误
@@ -7,19 +7,27 @@ import (
 	"os"
 	"runtime"
 	"runtime/debug"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
 	configsvc "github.com/anzhiyu-c/anheyu-app/pkg/service/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/db_maintenance"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/delivery"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/privacy"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/site_stats"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/thumbnail"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
 
 	"github.com/robfig/cron/v3"
 )
@@ -31,7 +39,7 @@ type Broker struct {
 	uploadSvc         file.IUploadService
 	thumbnailSvc      *thumbnail.ThumbnailService
 	cleanupSvc        cleanup.ICleanupService
-	jobQueue          chan Job
+	jobQueue          chan Job                     // 依赖回调等无法序列化参数的任务，只在进程内排队
 	articleRepo       repository.ArticleRepository // 保留，用于其他任务
 	commentRepo       repository.CommentRepository
 	emailSvc          utility.EmailService
@@ -43,8 +51,55 @@ type Broker struct {
 	statService       statistics.VisitorStatService
 	articleHistorySvc article_history_service.Service
 	backupSvc         configsvc.BackupService
+	privacySvc        *privacy.Service
+	primaryColorSvc   *utility.PrimaryColorService
+	deliverySvc       *delivery.Service
+
+	// scheduledPublishHook 定时文章发布后的处理函数，由文章服务注入
+	scheduledPublishHook func(ctx context.Context, publicID string)
+	// wordpressImportRunner 执行 WordPress 导入任务的函数，由迁移服务注入
+	wordpressImportRunner func(taskID string)
+	// commentDigestRunner 发送评论订阅摘要邮件的函数，由评论服务注入
+	commentDigestRunner func(ctx context.Context) (int, error)
+	// disposableEmailUpdater 更新一次性邮箱域名列表的函数，由一次性邮箱服务注入
+	disposableEmailUpdater func(ctx context.Context) (int, error)
+	// disposableEmailStatsFlusher 将缓存中的一次性邮箱拦截次数写入数据库的函数，由一次性邮箱服务注入
+	disposableEmailStatsFlusher func(ctx context.Context) (int, error)
+	// changelogSyncer 从 GitHub Releases 同步更新日志的函数，由更新日志服务注入
+	changelogSyncer func(ctx context.Context) (int, error)
+	// staleUploadCleaner 清理远程未完成上传的函数，由远程未完成上传清理服务注入
+	staleUploadCleaner func(ctx context.Context) (*volume.StaleUploadReport, error)
+	// recycleBinCleaner 彻底删除回收站过期条目的函数，由文件服务注入
+	recycleBinCleaner func(ctx context.Context) (int, error)
+	// integrityChecker 执行数据一致性检查的函数，由一致性检查服务注入
+	integrityChecker func(ctx context.Context) (*volume.IntegrityReport, error)
+	// linkHealthChecker 检查到期友链健康状态的函数，由友链服务注入
+	linkHealthChecker func(ctx context.Context) (*model.LinkHealthCheckResponse, error)
+	// siteStatsRefresher 刷新侧边栏站点统计快照的函数，由站点统计服务注入
+	siteStatsRefresher func(ctx context.Context) (*site_stats.Snapshot, error)
+	// dbMaintenanceSvc 数据库维护服务，由应用启动时注入
+	dbMaintenanceSvc *db_maintenance.Service
+
+	// queue 可持久化任务的队列，由 factories 按任务类型重建任务后执行
+	queue       Queue
+	factories   map[string]jobFactory
+	queueCancel context.CancelFunc
+	queueWG     sync.WaitGroup
+	// running 正在执行的队列任务的取消函数，管理员取消任务时调用
+	runningMu sync.Mutex
+	running   map[string]context.CancelFunc
+
+	deliveryPending atomic.Bool // 已有投递任务在队列中等待执行
+
+	warmupMu    sync.Mutex
+	warmupTimer *time.Timer // 等待派发的预热任务，期间的重复触发会被合并
+	stopped     bool
 }
 
+// cacheWarmupDebounce 缓存失效后延迟派发预热任务的时间：
+// 合并短时间内的多次失效，并等待异步的 CDN 刷新完成后再预热
+const cacheWarmupDebounce = 30 * time.Second
+
 // NewBroker 是 Broker 的构造函数。
 func NewBroker(
 	uploadSvc file.IUploadService,
@@ -61,10 +116,11 @@ func NewBroker(
 	statService statistics.VisitorStatService,
 	articleHistorySvc article_history_service.Service,
 	backupSvc configsvc.BackupService,
+	queue Queue,
 ) *Broker {
 
-	slogHandler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
-	logger := slog.New(slogHandler).With("system", "task_broker")
+	// 使用全局日志记录器，跟随后台设置的日志级别与输出格式
+	logger := slog.Default().With("system", "task_broker")
 
 	c := cron.New(
 		cron.WithSeconds(),
@@ -75,7 +131,10 @@ func NewBroker(
 		),
 	)
 
-	jobQueue := make(chan Job, 1000)
+	jobQueue := make(chan Job, defaultQueueSize)
+	if queue == nil {
+		queue = NewMemoryQueue(defaultQueueSize)
+	}
 
 	broker := &Broker{
 		cron:              c,
@@ -95,7 +154,9 @@ func NewBroker(
 		statService:       statService,
 		articleHistorySvc: articleHistorySvc,
 		backupSvc:         backupSvc,
+		queue:             queue,
 	}
+	broker.factories = broker.jobFactories()
 
 	broker.startWorkerPool()
 
@@ -104,10 +165,7 @@ func NewBroker(
 
 // startWorkerPool 启动固定数量的 worker goroutine 来处理任务。
 func (b *Broker) startWorkerPool() {
-	workerCount := runtime.NumCPU()
-	if workerCount <= 0 {
-		workerCount = 4
-	}
+	workerCount := defaultWorkerCount()
 	b.logger.Info("Starting task worker pool", "concurrency", workerCount)
 
 	for i := 0; i < workerCount; i++ {
@@ -129,18 +187,27 @@ func (b *Broker) startWorkerPool() {
 	}
 }
 
+// defaultWorkerCount 返回后台任务的并发数，与 CPU 核数一致
+func defaultWorkerCount() int {
+	workerCount := runtime.NumCPU()
+	if workerCount <= 0 {
+		workerCount = 4
+	}
+	return workerCount
+}
+
 // DispatchCommentNotification 派发评论通知任务的方法。
 func (b *Broker) DispatchCommentNotification(newCommentID uint) {
-	job := NewCommentNotificationJob(b.emailSvc, b.commentRepo, newCommentID)
-	b.Dispatch(job)
-	b.logger.Info("Successfully queued comment notification job", "comment_id", newCommentID)
+	if b.enqueue(JobTypeCommentNotification, commentNotificationPayload{CommentID: newCommentID}) {
+		b.logger.Info("Successfully queued comment notification job", "comment_id", newCommentID)
+	}
 }
 
 // DispatchOrphanCleanup 创建一个清理孤立项的任务并将其派发到后台执行。
 func (b *Broker) DispatchOrphanCleanup() {
-	job := NewCleanupOrphanedItemsJob(b.cleanupSvc)
-	b.Dispatch(job)
-	b.logger.Info("Successfully queued orphaned items cleanup job")
+	if b.enqueue(JobTypeOrphanCleanup, struct{}{}) {
+		b.logger.Info("Successfully queued orphaned items cleanup job")
+	}
 }
 
 // RegisterCronJobs 注册所有周期性任务。
@@ -172,17 +239,18 @@ func (b *Broker) RegisterCronJobs() {
 	}
 	b.logger.Info("-> Successfully registered 'StatisticsAggregationJob'", "schedule", "every day at 1:00:00 AM")
 
-	// 添加友链健康检查任务
-	linkHealthCheckJob := NewLinkHealthCheckJob(b.linkRepo, b.logger)
-	_, err = b.cron.AddJob("0 0 3 * * *", linkHealthCheckJob) // 每天凌晨3点执行
-	if err != nil {
-		b.logger.Error("Failed to add 'LinkHealthCheckJob'", slog.Any("error", err))
-		os.Exit(1)
+	// 添加友链健康检查任务 - 每小时执行，由友链服务按检查间隔筛选到期的友链
+	if b.linkHealthChecker != nil {
+		_, err = b.cron.AddJob("0 10 * * * *", NewLinkHealthCheckJob(b.linkHealthChecker, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'LinkHealthCheckJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'LinkHealthCheckJob'", "schedule", "every hour at minute 10")
+		}
 	}
-	b.logger.Info("-> Successfully registered 'LinkHealthCheckJob'", "schedule", "every day at 3:00:00 AM")
 
 	// 添加定时发布文章任务 - 每分钟检查一次
-	scheduledPublishJob := NewScheduledPublishJob(b.articleRepo, b.cacheSvc, b.logger)
+	scheduledPublishJob := NewScheduledPublishJob(b.articleRepo, b.cacheSvc, b.logger, b.onScheduledPublished)
 	_, err = b.cron.AddJob("0 * * * * *", scheduledPublishJob) // 每分钟的第0秒执行
 	if err != nil {
 		b.logger.Error("Failed to add 'ScheduledPublishJob'", slog.Any("error", err))
@@ -213,6 +281,144 @@ func (b *Broker) RegisterCronJobs() {
 		}
 	}
 
+	// 添加访客IP保留期限任务 - 每天凌晨4:30执行，保留天数为0时任务内部直接跳过
+	if b.privacySvc != nil {
+		ipRetentionJob := NewVisitorIPRetentionJob(b.privacySvc, b.logger)
+		_, err = b.cron.AddJob("0 30 4 * * *", ipRetentionJob)
+		if err != nil {
+			b.logger.Error("Failed to add 'VisitorIPRetentionJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'VisitorIPRetentionJob'", "schedule", "every day at 4:30:00 AM")
+		}
+	}
+
+	// 添加评论清理任务 - 每天凌晨4:15执行，未启用时任务内部直接跳过
+	commentCleanupJob := NewCommentCleanupJob(b.cleanupSvc, b.settingSvc, b.logger)
+	_, err = b.cron.AddJob("0 15 4 * * *", commentCleanupJob)
+	if err != nil {
+		b.logger.Error("Failed to add 'CommentCleanupJob'", slog.Any("error", err))
+	} else {
+		b.logger.Info("-> Successfully registered 'CommentCleanupJob'", "schedule", "every day at 4:15:00 AM")
+	}
+
+	// 添加热点页面预热任务 - 每30分钟执行一次，未启用时任务内部直接跳过
+	cacheWarmupJob := NewCacheWarmupJob(b.articleRepo, b.settingSvc, b.logger)
+	_, err = b.cron.AddJob("0 */30 * * * *", cacheWarmupJob)
+	if err != nil {
+		b.logger.Error("Failed to add 'CacheWarmupJob'", slog.Any("error", err))
+	} else {
+		b.logger.Info("-> Successfully registered 'CacheWarmupJob'", "schedule", "every 30 minutes")
+	}
+
+	// 添加通知投递任务 - 每15秒轮询一次到期的重试，入队时也会立即派发
+	if b.deliverySvc != nil {
+		deliveryJob := NewNotificationDeliveryJob(b.deliverySvc, b.logger, nil)
+		_, err = b.cron.AddJob("*/15 * * * * *", deliveryJob)
+		if err != nil {
+			b.logger.Error("Failed to add 'NotificationDeliveryJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'NotificationDeliveryJob'", "schedule", "every 15 seconds")
+		}
+
+		deliveryCleanupJob := NewNotificationDeliveryCleanupJob(b.deliverySvc, b.logger)
+		_, err = b.cron.AddJob("0 0 5 * * *", deliveryCleanupJob)
+		if err != nil {
+			b.logger.Error("Failed to add 'NotificationDeliveryCleanupJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'NotificationDeliveryCleanupJob'", "schedule", "every day at 5:00:00 AM")
+		}
+	}
+
+	// 添加评论订阅摘要任务 - 每5分钟检查一次，同一订阅的发送间隔由配置控制
+	if b.commentDigestRunner != nil {
+		_, err = b.cron.AddJob("0 */5 * * * *", NewCommentDigestJob(b.commentDigestRunner, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'CommentDigestJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'CommentDigestJob'", "schedule", "every 5 minutes")
+		}
+	}
+
+	// 添加一次性邮箱域名列表更新任务 - 每天凌晨5点30分执行
+	if b.disposableEmailUpdater != nil {
+		_, err = b.cron.AddJob("0 30 5 * * *", NewDisposableEmailUpdateJob(b.disposableEmailUpdater, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'DisposableEmailUpdateJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'DisposableEmailUpdateJob'", "schedule", "every day at 5:30:00 AM")
+		}
+	}
+
+	// 添加一次性邮箱拦截次数写入任务 - 每5分钟执行一次
+	if b.disposableEmailStatsFlusher != nil {
+		_, err = b.cron.AddJob("0 */5 * * * *", NewDisposableEmailStatsFlushJob(b.disposableEmailStatsFlusher, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'DisposableEmailStatsFlushJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'DisposableEmailStatsFlushJob'", "schedule", "every 5 minutes")
+		}
+	}
+
+	// 添加更新日志同步任务 - 每小时第10分钟执行
+	if b.changelogSyncer != nil {
+		_, err = b.cron.AddJob("0 10 * * * *", NewChangelogSyncJob(b.changelogSyncer, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'ChangelogSyncJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'ChangelogSyncJob'", "schedule", "every hour at minute 10")
+		}
+	}
+
+	// 添加远程未完成上传清理任务 - 每天凌晨3点45分执行
+	if b.staleUploadCleaner != nil {
+		_, err = b.cron.AddJob("0 45 3 * * *", NewStaleUploadCleanupJob(b.staleUploadCleaner, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'StaleUploadCleanupJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'StaleUploadCleanupJob'", "schedule", "every day at 3:45:00 AM")
+		}
+	}
+
+	// 添加回收站过期条目清理任务 - 每天凌晨3点20分执行
+	if b.recycleBinCleaner != nil {
+		_, err = b.cron.AddJob("0 20 3 * * *", NewRecycleBinCleanupJob(b.recycleBinCleaner, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'RecycleBinCleanupJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'RecycleBinCleanupJob'", "schedule", "every day at 3:20:00 AM")
+		}
+	}
+
+	// 添加数据一致性检查任务 - 每天凌晨4点15分执行，在未完成上传清理之后
+	if b.integrityChecker != nil {
+		_, err = b.cron.AddJob("0 15 4 * * *", NewIntegrityCheckJob(b.integrityChecker, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'IntegrityCheckJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'IntegrityCheckJob'", "schedule", "every day at 4:15:00 AM")
+		}
+	}
+
+	// 添加站点统计快照任务 - 每10分钟执行一次，兜底事件驱动刷新遗漏的变化
+	if b.siteStatsRefresher != nil {
+		_, err = b.cron.AddJob("0 */10 * * * *", NewSiteStatsSnapshotJob(b.siteStatsRefresher, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'SiteStatsSnapshotJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'SiteStatsSnapshotJob'", "schedule", "every 10 minutes")
+		}
+	}
+
+	// 添加数据库维护任务 - 每5分钟检查一次是否处于维护时段，每个时段只执行一次
+	if b.dbMaintenanceSvc != nil {
+		_, err = b.cron.AddJob("0 */5 * * * *", NewDBMaintenanceJob(b.dbMaintenanceSvc, b.logger))
+		if err != nil {
+			b.logger.Error("Failed to add 'DBMaintenanceJob'", slog.Any("error", err))
+		} else {
+			b.logger.Info("-> Successfully registered 'DBMaintenanceJob'", "schedule", "every 5 minutes within the maintenance window")
+		}
+	}
+
 	b.logger.Info("All periodic jobs registered.")
 }
 
@@ -221,20 +427,144 @@ func (b *Broker) SetBackupService(svc configsvc.BackupService) {
 	b.backupSvc = svc
 }
 
-// Dispatch 将任务发送到队列中。
+// SetPrivacyService 设置隐私数据服务（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetPrivacyService(svc *privacy.Service) {
+	b.privacySvc = svc
+}
+
+// SetPrimaryColorService 设置主色调服务（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetPrimaryColorService(svc *utility.PrimaryColorService) {
+	b.primaryColorSvc = svc
+}
+
+// SetNotificationDeliveryService 设置通知投递队列服务（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetNotificationDeliveryService(svc *delivery.Service) {
+	b.deliverySvc = svc
+}
+
+// SetWordPressImportRunner 设置执行 WordPress 导入任务的函数（用于延迟注入，避免与迁移服务循环依赖）
+func (b *Broker) SetWordPressImportRunner(fn func(taskID string)) {
+	b.wordpressImportRunner = fn
+}
+
+// SetCommentDigestRunner 设置发送评论订阅摘要邮件的函数（用于延迟注入，避免与评论服务循环依赖）
+func (b *Broker) SetCommentDigestRunner(fn func(ctx context.Context) (int, error)) {
+	b.commentDigestRunner = fn
+}
+
+// SetDisposableEmailUpdater 设置更新一次性邮箱域名列表的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetDisposableEmailUpdater(fn func(ctx context.Context) (int, error)) {
+	b.disposableEmailUpdater = fn
+}
+
+// SetDisposableEmailStatsFlusher 设置将一次性邮箱拦截次数写入数据库的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetDisposableEmailStatsFlusher(fn func(ctx context.Context) (int, error)) {
+	b.disposableEmailStatsFlusher = fn
+}
+
+// SetChangelogSyncer 设置从 GitHub Releases 同步更新日志的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetChangelogSyncer(fn func(ctx context.Context) (int, error)) {
+	b.changelogSyncer = fn
+}
+
+// SetStaleUploadCleaner 设置清理远程未完成上传的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetStaleUploadCleaner(fn func(ctx context.Context) (*volume.StaleUploadReport, error)) {
+	b.staleUploadCleaner = fn
+}
+
+// SetRecycleBinCleaner 设置彻底删除回收站过期条目的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetRecycleBinCleaner(fn func(ctx context.Context) (int, error)) {
+	b.recycleBinCleaner = fn
+}
+
+// SetIntegrityChecker 设置执行数据一致性检查的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetIntegrityChecker(fn func(ctx context.Context) (*volume.IntegrityReport, error)) {
+	b.integrityChecker = fn
+}
+
+// SetSiteStatsRefresher 设置刷新站点统计快照的函数（用于延迟注入，避免初始化顺序问题）
+func (b *Broker) SetSiteStatsRefresher(fn func(ctx context.Context) (*site_stats.Snapshot, error)) {
+	b.siteStatsRefresher = fn
+}
+
+// SetDBMaintenanceService 设置数据库维护服务
+func (b *Broker) SetDBMaintenanceService(svc *db_maintenance.Service) {
+	b.dbMaintenanceSvc = svc
+}
+
+// SetLinkHealthChecker 设置检查到期友链健康状态的函数（用于延迟注入，避免与友链服务循环依赖）
+func (b *Broker) SetLinkHealthChecker(fn func(ctx context.Context) (*model.LinkHealthCheckResponse, error)) {
+	b.linkHealthChecker = fn
+}
+
+// SetScheduledPublishHook 设置定时文章发布后的处理函数（用于延迟注入，避免与文章服务循环依赖）
+func (b *Broker) SetScheduledPublishHook(fn func(ctx context.Context, publicID string)) {
+	b.scheduledPublishHook = fn
+}
+
+// onScheduledPublished 调用已注入的定时发布处理函数，未注入时为空操作
+func (b *Broker) onScheduledPublished(ctx context.Context, publicID string) {
+	if b.scheduledPublishHook != nil {
+		b.scheduledPublishHook(ctx, publicID)
+	}
+}
+
+// DispatchNotificationDelivery 派发一次通知投递任务。
+// 队列中已有等待执行的投递任务时直接返回，避免批量入队时重复派发。
+func (b *Broker) DispatchNotificationDelivery() {
+	if b.deliverySvc == nil || !b.deliveryPending.CompareAndSwap(false, true) {
+		return
+	}
+	b.warmupMu.Lock()
+	defer b.warmupMu.Unlock()
+	if b.stopped {
+		return
+	}
+	b.Dispatch(NewNotificationDeliveryJob(b.deliverySvc, b.logger, func() { b.deliveryPending.Store(false) }))
+}
+
+// CanExtractPrimaryColor 是否可以将主色调提取派发到后台执行
+func (b *Broker) CanExtractPrimaryColor() bool {
+	return b.primaryColorSvc != nil
+}
+
+// DispatchPrimaryColorExtraction 创建一个文章主色调提取任务并派发到后台执行。
+func (b *Broker) DispatchPrimaryColorExtraction(publicID, imageURL string, onUpdated func(color string)) {
+	job := NewPrimaryColorJob(b.primaryColorSvc, b.articleRepo, b.logger, publicID, imageURL, onUpdated)
+	b.Dispatch(job)
+	b.logger.Info("Successfully queued primary color extraction job", slog.String("article_id", publicID))
+}
+
+// DispatchImagePaletteExtraction 创建一个图片配色提取任务并派发到后台执行，未注入主色调服务时返回 false。
+func (b *Broker) DispatchImagePaletteExtraction(publicID string, size int, onDone func(palette *utility.Palette, err error)) bool {
+	if b.primaryColorSvc == nil {
+		return false
+	}
+	b.Dispatch(NewImagePaletteJob(b.primaryColorSvc, b.logger, publicID, size, onDone))
+	return true
+}
+
+// DispatchWordPressImport 创建一个 WordPress 导入任务并派发到后台执行。
+func (b *Broker) DispatchWordPressImport(taskID string) {
+	b.Dispatch(NewWordPressImportJob(b.wordpressImportRunner, b.logger, taskID))
+	b.logger.Info("Successfully queued WordPress import job", slog.String("task_id", taskID))
+}
+
+// Dispatch 将任务发送到进程内队列中。需要在进程重启后继续执行的任务应通过 enqueue 放入任务队列。
 func (b *Broker) Dispatch(job Job) {
 	b.jobQueue <- job
 }
 
 // DispatchThumbnailGeneration 创建一个缩略图生成任务并将其派发到后台执行。
 func (b *Broker) DispatchThumbnailGeneration(fileID uint) {
-	job := NewThumbnailGenerationJob(b.thumbnailSvc, fileID)
-	b.Dispatch(job)
-	b.logger.Info("Successfully queued thumbnail generation job", slog.Uint64("file_id", uint64(fileID)))
+	if b.enqueue(JobTypeThumbnailGeneration, thumbnailPayload{FileID: fileID}) {
+		b.logger.Info("Successfully queued thumbnail generation job", slog.Uint64("file_id", uint64(fileID)))
+	}
 }
 
-// Start 启动 cron 调度器。
+// Start 启动 cron 调度器和任务队列的消费者。
 func (b *Broker) Start() {
+	b.startQueueConsumers(defaultWorkerCount())
 	b.logger.Info("Task broker started.")
 	b.cron.Start()
 }
@@ -242,22 +572,58 @@ func (b *Broker) Start() {
 // Stop 优雅地停止 cron 调度器和所有 worker。
 func (b *Broker) Stop() {
 	b.logger.Info("Stopping task broker...")
+	b.warmupMu.Lock()
+	b.stopped = true
+	if b.warmupTimer != nil {
+		b.warmupTimer.Stop()
+	}
+	b.warmupMu.Unlock()
 	ctx := b.cron.Stop()
 	<-ctx.Done()
+	// 停止消费任务队列并等待执行中的任务完成，Redis 队列中尚未执行的任务会在下次启动时继续
+	if b.queueCancel != nil {
+		b.queueCancel()
+	}
+	b.queue.Close()
+	b.queueWG.Wait()
 	close(b.jobQueue)
 	b.logger.Info("Task broker gracefully stopped.")
 }
 
 // DispatchLinkCleanup 创建一个清理友链相关数据的任务并派发到后台。
 func (b *Broker) DispatchLinkCleanup() {
-	job := NewLinkCleanupJob(b.linkCategoryRepo, b.linkTagRepo, b.settingSvc)
-	b.Dispatch(job)
-	b.logger.Info("Successfully queued link cleanup job")
+	if b.enqueue(JobTypeLinkCleanup, struct{}{}) {
+		b.logger.Info("Successfully queued link cleanup job")
+	}
+}
+
+// DispatchCacheWarmup 在部署启动或缓存清除后派发热点页面预热任务。
+// 任务延迟 cacheWarmupDebounce 后入队，等待期间的重复调用只会触发一次预热。
+func (b *Broker) DispatchCacheWarmup() {
+	b.warmupMu.Lock()
+	defer b.warmupMu.Unlock()
+	if b.warmupTimer != nil || b.stopped {
+		return
+	}
+
+	b.warmupTimer = time.AfterFunc(cacheWarmupDebounce, func() {
+		b.warmupMu.Lock()
+		defer b.warmupMu.Unlock()
+		b.warmupTimer = nil
+		if b.stopped {
+			return
+		}
+		b.Dispatch(NewCacheWarmupJob(b.articleRepo, b.settingSvc, b.logger))
+		b.logger.Info("Successfully queued cache warmup job")
+	})
 }
 
 // DispatchLinkHealthCheck 创建一个友链健康检查任务并派发到后台。
 func (b *Broker) DispatchLinkHealthCheck() {
-	job := NewLinkHealthCheckJob(b.linkRepo, b.logger)
+	if b.linkHealthChecker == nil {
+		return
+	}
+	job := NewLinkHealthCheckJob(b.linkHealthChecker, b.logger)
 	b.Dispatch(job)
 	b.logger.Info("Successfully queued link health check job")
 }
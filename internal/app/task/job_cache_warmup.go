@@ -0,0 +1,155 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// defaultCacheWarmupTopN 未配置时预热的热门文章数量
+	defaultCacheWarmupTopN = 10
+	// maxCacheWarmupTopN 预热热门文章数量上限，避免一次请求过多页面
+	maxCacheWarmupTopN = 100
+	// cacheWarmupConcurrency 同时发起的预热请求数
+	cacheWarmupConcurrency = 4
+)
+
+// CacheWarmupJob 预热热点页面：依次请求首页、归档、RSS 以及浏览量最高的文章，
+// 让 CDN 与服务端缓存在失效后先被任务填充，而不是由第一位访客承担冷渲染的开销。
+type CacheWarmupJob struct {
+	articleRepo repository.ArticleRepository
+	settingSvc  setting.SettingService
+	logger      *slog.Logger
+}
+
+// NewCacheWarmupJob 创建热点页面预热任务实例
+func NewCacheWarmupJob(articleRepo repository.ArticleRepository, settingSvc setting.SettingService, logger *slog.Logger) *CacheWarmupJob {
+	return &CacheWarmupJob{
+		articleRepo: articleRepo,
+		settingSvc:  settingSvc,
+		logger:      logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *CacheWarmupJob) Name() string {
+	return "CacheWarmupJob"
+}
+
+// Run 执行热点页面预热
+func (j *CacheWarmupJob) Run() {
+	if !j.settingSvc.GetBool(constant.KeyCacheWarmupEnable.String()) {
+		return
+	}
+	baseURL := strings.TrimRight(strings.TrimSpace(j.settingSvc.Get(constant.KeySiteURL.String())), "/")
+	if baseURL == "" {
+		j.logger.Warn("未配置站点URL，跳过缓存预热")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	urls := []string{baseURL + "/", baseURL + "/archives", baseURL + "/rss.xml"}
+	for _, path := range j.topArticlePaths(ctx) {
+		urls = append(urls, baseURL+path)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failed := 0
+	semaphore := make(chan struct{}, cacheWarmupConcurrency)
+	for _, u := range urls {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(u string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			if err := warmURL(ctx, client, u); err != nil {
+				j.logger.Warn("预热页面失败", slog.String("url", u), slog.Any("error", err))
+				mu.Lock()
+				failed++
+				mu.Unlock()
+			}
+		}(u)
+	}
+	wg.Wait()
+
+	j.logger.Info("缓存预热完成", slog.Int("total", len(urls)), slog.Int("failed", failed))
+}
+
+// topArticlePaths 返回浏览量最高的已发布文章路径，优先使用 abbrlink
+func (j *CacheWarmupJob) topArticlePaths(ctx context.Context) []string {
+	topN, err := strconv.Atoi(strings.TrimSpace(j.settingSvc.Get(constant.KeyCacheWarmupTopN.String())))
+	if err != nil || topN < 0 {
+		topN = defaultCacheWarmupTopN
+	}
+	if topN > maxCacheWarmupTopN {
+		topN = maxCacheWarmupTopN
+	}
+	if topN == 0 {
+		return nil
+	}
+
+	articles, _, err := j.articleRepo.List(ctx, &model.ListArticlesOptions{
+		Page:     1,
+		PageSize: 10000,
+		Status:   "PUBLISHED",
+	})
+	if err != nil {
+		j.logger.Error("获取热门文章失败，仅预热固定页面", slog.Any("error", err))
+		return nil
+	}
+	sort.SliceStable(articles, func(a, b int) bool {
+		return articles[a].ViewCount > articles[b].ViewCount
+	})
+	if len(articles) > topN {
+		articles = articles[:topN]
+	}
+
+	paths := make([]string, 0, len(articles))
+	for _, a := range articles {
+		slug := a.Abbrlink
+		if slug == "" {
+			slug = a.ID
+		}
+		paths = append(paths, "/posts/"+slug)
+	}
+	return paths
+}
+
+// warmURL 请求页面并读完响应体，使缓存完整写入；预热请求头让文章页不计入浏览量
+func warmURL(ctx context.Context, client *http.Client, u string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "anheyu-cache-warmup/1.0")
+	req.Header.Set(constant.HeaderCacheWarmup, "1")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
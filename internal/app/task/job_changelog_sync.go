@@ -0,0 +1,37 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ChangelogSyncJob 每小时从 GitHub Releases 同步更新日志，是否同步由更新日志服务按配置决定
+type ChangelogSyncJob struct {
+	run    func(ctx context.Context) (int, error)
+	logger *slog.Logger
+}
+
+// NewChangelogSyncJob 创建更新日志同步任务实例
+func NewChangelogSyncJob(run func(ctx context.Context) (int, error), logger *slog.Logger) *ChangelogSyncJob {
+	return &ChangelogSyncJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *ChangelogSyncJob) Name() string {
+	return "ChangelogSyncJob"
+}
+
+// Run 执行同步任务，失败时保留已有的更新日志
+func (j *ChangelogSyncJob) Run() {
+	count, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Changelog sync failed", slog.Any("error", err))
+		return
+	}
+	if count > 0 {
+		j.logger.Info("Changelog synced from GitHub releases", slog.Int("changed", count))
+	}
+}
@@ -28,14 +28,21 @@ func NewCleanupOrphanedItemsJob(cleanupSvc cleanup.ICleanupService) *CleanupOrph
 
 // Run 是 Job 接口要求实现的方法。
 func (j *CleanupOrphanedItemsJob) Run() {
-	deletedTags, deletedCategories, err := j.cleanupSvc.CleanupOrphanedItems(context.Background())
-	if err != nil {
+	if err := j.Execute(context.Background()); err != nil {
 		log.Printf("任务 '%s' 在执行业务逻辑时捕获到错误: %v", j.Name(), err)
-	} else {
-		log.Printf("任务 '%s' 业务逻辑执行完毕，共清理了 %d 个标签和 %d 个分类。", j.Name(), deletedTags, deletedCategories)
 	}
 }
 
+// Execute 清理孤立的标签和分类，失败时返回错误，以便任务队列稍后重试。
+func (j *CleanupOrphanedItemsJob) Execute(ctx context.Context) error {
+	deletedTags, deletedCategories, err := j.cleanupSvc.CleanupOrphanedItems(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("任务 '%s' 业务逻辑执行完毕，共清理了 %d 个标签和 %d 个分类。", j.Name(), deletedTags, deletedCategories)
+	return nil
+}
+
 // Name 方法让日志包装器可以打印出更有意义的任务名。
 func (j *CleanupOrphanedItemsJob) Name() string {
 	return "CleanupOrphanedTagsAndCategoriesJob"
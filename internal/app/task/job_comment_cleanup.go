@@ -0,0 +1,53 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// CommentCleanupJob 按 comment.cleanup.* 配置的规则定期清理评论，未启用时直接跳过
+type CommentCleanupJob struct {
+	cleanupSvc cleanup.ICleanupService
+	settingSvc setting.SettingService
+	logger     *slog.Logger
+}
+
+// NewCommentCleanupJob 创建评论清理任务实例
+func NewCommentCleanupJob(cleanupSvc cleanup.ICleanupService, settingSvc setting.SettingService, logger *slog.Logger) *CommentCleanupJob {
+	return &CommentCleanupJob{
+		cleanupSvc: cleanupSvc,
+		settingSvc: settingSvc,
+		logger:     logger,
+	}
+}
+
+// Run 执行评论清理
+func (j *CommentCleanupJob) Run() {
+	if !j.settingSvc.GetBool(constant.KeyCommentCleanupEnable.String()) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	report, err := j.cleanupSvc.CleanupComments(ctx, false)
+	if err != nil {
+		j.logger.Error("评论清理失败", slog.Any("error", err))
+		return
+	}
+	for _, warning := range report.Warnings {
+		j.logger.Warn("评论清理规则无效", slog.String("detail", warning))
+	}
+	if report.Deleted > 0 || report.Reparented > 0 {
+		j.logger.Info("评论清理完成", slog.Int("deleted", report.Deleted), slog.Int("reparented", report.Reparented))
+	}
+}
+
+// Name 返回任务名称
+func (j *CommentCleanupJob) Name() string {
+	return "CommentCleanupJob"
+}
@@ -0,0 +1,37 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// CommentDigestJob 定期为到期的评论订阅发送摘要邮件，发送间隔由评论服务按订阅控制
+type CommentDigestJob struct {
+	run    func(ctx context.Context) (int, error)
+	logger *slog.Logger
+}
+
+// NewCommentDigestJob 创建评论订阅摘要任务实例
+func NewCommentDigestJob(run func(ctx context.Context) (int, error), logger *slog.Logger) *CommentDigestJob {
+	return &CommentDigestJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *CommentDigestJob) Name() string {
+	return "CommentDigestJob"
+}
+
+// Run 执行摘要发送任务
+func (j *CommentDigestJob) Run() {
+	sent, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Comment digest job failed", slog.Any("error", err))
+		return
+	}
+	if sent > 0 {
+		j.logger.Info("Comment digest emails sent", slog.Int("count", sent))
+	}
+}
@@ -40,13 +40,17 @@ func NewCommentNotificationJob(
 
 // Run 方法执行发送邮件的逻辑。
 func (j *CommentNotificationJob) Run() {
-	ctx := context.Background()
+	if err := j.Execute(context.Background()); err != nil {
+		log.Printf("错误: 任务 '%s' 执行失败: %v", j.Name(), err)
+	}
+}
 
+// Execute 发送评论通知邮件，获取新评论失败时返回错误，以便任务队列稍后重试。
+func (j *CommentNotificationJob) Execute(ctx context.Context) error {
 	// 1. 获取新评论的完整信息
 	newComment, err := j.commentRepo.FindByID(ctx, j.newCommentID)
 	if err != nil {
-		log.Printf("错误: 任务 '%s' 获取新评论失败: %v", j.Name(), err)
-		return
+		return fmt.Errorf("获取新评论失败: %w", err)
 	}
 
 	// 2. 如果是回复，获取父评论信息
@@ -60,6 +64,7 @@ func (j *CommentNotificationJob) Run() {
 
 	// 3. 调用邮件服务，传递已有的通用元信息
 	j.emailSvc.SendCommentNotification(newComment, parentComment)
+	return nil
 }
 
 // Name 方法返回任务的可读名称。
@@ -0,0 +1,65 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/db_maintenance"
+)
+
+// dbMaintenanceTimeout 单次数据库维护的最长执行时间
+const dbMaintenanceTimeout = 2 * time.Hour
+
+// DBMaintenanceJob 在配置的维护时段内执行一次数据库维护，结果记录在后台任务面板中
+type DBMaintenanceJob struct {
+	svc    *db_maintenance.Service
+	logger *slog.Logger
+}
+
+// NewDBMaintenanceJob 创建数据库维护任务实例
+func NewDBMaintenanceJob(svc *db_maintenance.Service, logger *slog.Logger) *DBMaintenanceJob {
+	return &DBMaintenanceJob{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *DBMaintenanceJob) Name() string {
+	return "DBMaintenanceJob"
+}
+
+// Run 检查是否处于维护时段，是则执行维护
+func (j *DBMaintenanceJob) Run() {
+	if !j.svc.Due(time.Now()) {
+		return
+	}
+	runDBMaintenance(j.svc, db_maintenance.TriggerScheduled, j.logger)
+}
+
+func runDBMaintenance(svc *db_maintenance.Service, trigger string, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), dbMaintenanceTimeout)
+	defer cancel()
+
+	report, err := svc.Run(ctx, trigger)
+	if report == nil {
+		logger.Error("Database maintenance failed", slog.Any("error", err))
+		return
+	}
+	attrs := []any{
+		slog.String("trigger", trigger),
+		slog.Int64("duration_ms", report.DurationMs),
+		slog.Int64("purged", report.Purged),
+	}
+	if err != nil {
+		for _, step := range report.Steps {
+			if step.Error != "" {
+				logger.Error("Database maintenance step failed", slog.String("step", step.Name), slog.String("error", step.Error))
+			}
+		}
+		logger.Warn("Database maintenance finished with errors", append(attrs, slog.Int("failed", report.Failed))...)
+		return
+	}
+	logger.Info("Database maintenance finished", attrs...)
+}
@@ -0,0 +1,37 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DisposableEmailStatsFlushJob 定期将缓存中累加的一次性邮箱拦截次数写入数据库
+type DisposableEmailStatsFlushJob struct {
+	run    func(ctx context.Context) (int, error)
+	logger *slog.Logger
+}
+
+// NewDisposableEmailStatsFlushJob 创建一次性邮箱拦截次数写入任务实例
+func NewDisposableEmailStatsFlushJob(run func(ctx context.Context) (int, error), logger *slog.Logger) *DisposableEmailStatsFlushJob {
+	return &DisposableEmailStatsFlushJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *DisposableEmailStatsFlushJob) Name() string {
+	return "DisposableEmailStatsFlushJob"
+}
+
+// Run 执行写入任务，写入失败的次数留在缓存中等待下次执行
+func (j *DisposableEmailStatsFlushJob) Run() {
+	count, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Disposable email block counters flush failed", slog.Any("error", err))
+		return
+	}
+	if count > 0 {
+		j.logger.Info("Disposable email block counters flushed", slog.Int("blocked", count))
+	}
+}
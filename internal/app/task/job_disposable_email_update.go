@@ -0,0 +1,37 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// DisposableEmailUpdateJob 每天更新一次性邮箱域名列表，是否更新由一次性邮箱服务按配置决定
+type DisposableEmailUpdateJob struct {
+	run    func(ctx context.Context) (int, error)
+	logger *slog.Logger
+}
+
+// NewDisposableEmailUpdateJob 创建一次性邮箱域名列表更新任务实例
+func NewDisposableEmailUpdateJob(run func(ctx context.Context) (int, error), logger *slog.Logger) *DisposableEmailUpdateJob {
+	return &DisposableEmailUpdateJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *DisposableEmailUpdateJob) Name() string {
+	return "DisposableEmailUpdateJob"
+}
+
+// Run 执行列表更新任务，失败时继续使用已有列表
+func (j *DisposableEmailUpdateJob) Run() {
+	count, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Disposable email domain list update failed", slog.Any("error", err))
+		return
+	}
+	if count > 0 {
+		j.logger.Info("Disposable email domain list updated", slog.Int("domains", count))
+	}
+}
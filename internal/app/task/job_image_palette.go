@@ -0,0 +1,48 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// ImagePaletteJob 在后台读取图片文件并提取配色，结果通过 onDone 交给调用方缓存
+type ImagePaletteJob struct {
+	primaryColorSvc *utility.PrimaryColorService
+	logger          *slog.Logger
+	publicID        string
+	size            int
+	onDone          func(palette *utility.Palette, err error)
+}
+
+// NewImagePaletteJob 创建图片配色提取任务实例
+func NewImagePaletteJob(primaryColorSvc *utility.PrimaryColorService, logger *slog.Logger, publicID string, size int, onDone func(palette *utility.Palette, err error)) *ImagePaletteJob {
+	return &ImagePaletteJob{
+		primaryColorSvc: primaryColorSvc,
+		logger:          logger,
+		publicID:        publicID,
+		size:            size,
+		onDone:          onDone,
+	}
+}
+
+// Name 返回任务名称
+func (j *ImagePaletteJob) Name() string {
+	return "ImagePaletteJob"
+}
+
+// Run 提取配色并回调，失败时同样回调以便调用方记录失败状态
+func (j *ImagePaletteJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	palette, err := j.primaryColorSvc.GetFilePalette(ctx, j.publicID, j.size)
+	if err != nil {
+		j.logger.Warn("提取图片配色失败", slog.String("file_id", j.publicID), slog.Any("error", err))
+	} else {
+		j.logger.Info("图片配色已提取", slog.String("file_id", j.publicID), slog.String("dominant", palette.Dominant))
+	}
+	j.onDone(palette, err)
+}
@@ -0,0 +1,50 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+// IntegrityCheckJob 每天检查文件、实体与存储策略之间的数据一致性，按设置自动修复
+type IntegrityCheckJob struct {
+	run    func(ctx context.Context) (*volume.IntegrityReport, error)
+	logger *slog.Logger
+}
+
+// NewIntegrityCheckJob 创建数据一致性检查任务实例
+func NewIntegrityCheckJob(run func(ctx context.Context) (*volume.IntegrityReport, error), logger *slog.Logger) *IntegrityCheckJob {
+	return &IntegrityCheckJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *IntegrityCheckJob) Name() string {
+	return "IntegrityCheckJob"
+}
+
+// Run 执行一致性检查，只在发现问题或出错时记录日志
+func (j *IntegrityCheckJob) Run() {
+	report, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Integrity check failed", slog.Any("error", err))
+		return
+	}
+	for _, e := range report.Errors {
+		j.logger.Warn("Integrity check error", slog.String("error", e))
+	}
+	issues := report.OrphanedEntities.Count + report.FilesMissingEntity.Count + report.DanglingVersions.Count +
+		report.EntitiesWithDeletedPolicy.Count + report.ChildrenCount.Count
+	if issues > 0 {
+		j.logger.Warn("Integrity check found inconsistencies",
+			slog.Bool("repair", report.Repair),
+			slog.Int("orphaned_entities", report.OrphanedEntities.Count),
+			slog.Int("files_missing_entity", report.FilesMissingEntity.Count),
+			slog.Int("dangling_versions", report.DanglingVersions.Count),
+			slog.Int("entities_with_deleted_policy", report.EntitiesWithDeletedPolicy.Count),
+			slog.Int("children_count", report.ChildrenCount.Count))
+	}
+}
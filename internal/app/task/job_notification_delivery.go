@@ -0,0 +1,78 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/delivery"
+)
+
+// NotificationDeliveryJob 投递通知队列中已到期的邮件与推送
+type NotificationDeliveryJob struct {
+	deliverySvc *delivery.Service
+	logger      *slog.Logger
+	onStart     func() // 开始执行时回调，用于合并等待中的重复派发
+}
+
+// NewNotificationDeliveryJob 创建通知投递任务实例
+func NewNotificationDeliveryJob(deliverySvc *delivery.Service, logger *slog.Logger, onStart func()) *NotificationDeliveryJob {
+	return &NotificationDeliveryJob{
+		deliverySvc: deliverySvc,
+		logger:      logger,
+		onStart:     onStart,
+	}
+}
+
+// Run 执行到期通知的投递
+func (j *NotificationDeliveryJob) Run() {
+	if j.onStart != nil {
+		j.onStart()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	count, err := j.deliverySvc.ProcessDue(ctx)
+	if err != nil {
+		j.logger.Error("投递通知失败", slog.Any("error", err), slog.Int("processed", count))
+	}
+}
+
+// Name 返回任务名称
+func (j *NotificationDeliveryJob) Name() string {
+	return "NotificationDeliveryJob"
+}
+
+// NotificationDeliveryCleanupJob 清理超过保留期限的投递记录
+type NotificationDeliveryCleanupJob struct {
+	deliverySvc *delivery.Service
+	logger      *slog.Logger
+}
+
+// NewNotificationDeliveryCleanupJob 创建投递记录清理任务实例
+func NewNotificationDeliveryCleanupJob(deliverySvc *delivery.Service, logger *slog.Logger) *NotificationDeliveryCleanupJob {
+	return &NotificationDeliveryCleanupJob{
+		deliverySvc: deliverySvc,
+		logger:      logger,
+	}
+}
+
+// Run 执行投递记录清理
+func (j *NotificationDeliveryCleanupJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	count, err := j.deliverySvc.Cleanup(ctx)
+	if err != nil {
+		j.logger.Error("清理投递记录失败", slog.Any("error", err))
+		return
+	}
+	if count > 0 {
+		j.logger.Info("投递记录清理完成", slog.Int("deleted", count))
+	}
+}
+
+// Name 返回任务名称
+func (j *NotificationDeliveryCleanupJob) Name() string {
+	return "NotificationDeliveryCleanupJob"
+}
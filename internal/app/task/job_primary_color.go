@@ -0,0 +1,64 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// PrimaryColorJob 在后台下载文章头图/封面并提取主色调，完成后更新文章。
+// 文章保存时先返回临时主色，避免同步下载图片拖慢保存。
+type PrimaryColorJob struct {
+	primaryColorSvc *utility.PrimaryColorService
+	articleRepo     repository.ArticleRepository
+	logger          *slog.Logger
+	publicID        string
+	imageURL        string
+	onUpdated       func(color string)
+}
+
+// NewPrimaryColorJob 创建主色调提取任务实例，onUpdated 在主色调实际写入后调用（可为 nil）
+func NewPrimaryColorJob(primaryColorSvc *utility.PrimaryColorService, articleRepo repository.ArticleRepository, logger *slog.Logger, publicID, imageURL string, onUpdated func(color string)) *PrimaryColorJob {
+	return &PrimaryColorJob{
+		primaryColorSvc: primaryColorSvc,
+		articleRepo:     articleRepo,
+		logger:          logger,
+		publicID:        publicID,
+		imageURL:        imageURL,
+		onUpdated:       onUpdated,
+	}
+}
+
+// Name 返回任务名称
+func (j *PrimaryColorJob) Name() string {
+	return "PrimaryColorJob"
+}
+
+// Run 提取主色调并写回文章；取色失败时写入库默认主色，与同步取色的行为一致
+func (j *PrimaryColorJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	color := j.primaryColorSvc.GetPrimaryColorFromURL(ctx, j.imageURL)
+	if color == "" {
+		color = utility.DefaultFallbackPrimaryColor()
+	}
+
+	// 取色期间文章可能被改为手动主色或更换了图片，此时放弃写入
+	updated, err := j.articleRepo.UpdateAutoPrimaryColor(ctx, j.publicID, j.imageURL, color)
+	if err != nil {
+		j.logger.Error("更新文章主色调失败", slog.String("article_id", j.publicID), slog.Any("error", err))
+		return
+	}
+	if !updated {
+		j.logger.Info("文章主色调已手动设置或图片已变更，跳过更新", slog.String("article_id", j.publicID))
+		return
+	}
+	j.logger.Info("文章主色调已更新", slog.String("article_id", j.publicID), slog.String("color", color))
+	if j.onUpdated != nil {
+		j.onUpdated(color)
+	}
+}
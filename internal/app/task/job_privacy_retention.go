@@ -0,0 +1,43 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/privacy"
+)
+
+// VisitorIPRetentionJob 按保留期限匿名化访问日志中的 IP
+type VisitorIPRetentionJob struct {
+	privacySvc *privacy.Service
+	logger     *slog.Logger
+}
+
+// NewVisitorIPRetentionJob 创建访客 IP 保留期限任务实例
+func NewVisitorIPRetentionJob(privacySvc *privacy.Service, logger *slog.Logger) *VisitorIPRetentionJob {
+	return &VisitorIPRetentionJob{
+		privacySvc: privacySvc,
+		logger:     logger,
+	}
+}
+
+// Run 执行访客 IP 匿名化
+func (j *VisitorIPRetentionJob) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	count, err := j.privacySvc.AnonymizeExpiredVisitorIPs(ctx)
+	if err != nil {
+		j.logger.Error("匿名化过期访客IP失败", slog.Any("error", err), slog.Int("anonymized", count))
+		return
+	}
+	if count > 0 {
+		j.logger.Info("访客IP匿名化完成", slog.Int("anonymized", count))
+	}
+}
+
+// Name 返回任务名称
+func (j *VisitorIPRetentionJob) Name() string {
+	return "VisitorIPRetentionJob"
+}
@@ -0,0 +1,37 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+)
+
+// RecycleBinCleanupJob 每天彻底删除回收站中超过保留期限的文件及其物理文件
+type RecycleBinCleanupJob struct {
+	run    func(ctx context.Context) (int, error)
+	logger *slog.Logger
+}
+
+// NewRecycleBinCleanupJob 创建回收站过期条目清理任务实例
+func NewRecycleBinCleanupJob(run func(ctx context.Context) (int, error), logger *slog.Logger) *RecycleBinCleanupJob {
+	return &RecycleBinCleanupJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *RecycleBinCleanupJob) Name() string {
+	return "RecycleBinCleanupJob"
+}
+
+// Run 执行清理任务，删除失败的条目会在下次执行时重试
+func (j *RecycleBinCleanupJob) Run() {
+	purged, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Recycle bin cleanup failed", slog.Int("purged", purged), slog.Any("error", err))
+		return
+	}
+	if purged > 0 {
+		j.logger.Info("Expired recycle bin items purged", slog.Int("purged", purged))
+	}
+}
@@ -21,18 +21,22 @@ type ScheduledPublishJob struct {
 	articleRepo repository.ArticleRepository
 	cacheSvc    utility.CacheService
 	logger      *slog.Logger
+	// onPublished 每篇文章发布成功后调用，用于更新搜索索引、通知订阅者等
+	onPublished func(ctx context.Context, publicID string)
 }
 
-// NewScheduledPublishJob 创建定时发布任务实例
+// NewScheduledPublishJob 创建定时发布任务实例，onPublished 可为 nil
 func NewScheduledPublishJob(
 	articleRepo repository.ArticleRepository,
 	cacheSvc utility.CacheService,
 	logger *slog.Logger,
+	onPublished func(ctx context.Context, publicID string),
 ) *ScheduledPublishJob {
 	return &ScheduledPublishJob{
 		articleRepo: articleRepo,
 		cacheSvc:    cacheSvc,
 		logger:      logger,
+		onPublished: onPublished,
 	}
 }
 
@@ -98,6 +102,10 @@ func (j *ScheduledPublishJob) Run() {
 
 		// 清除相关缓存
 		j.invalidateArticleCache(ctx, article.ID, article.Abbrlink)
+
+		if j.onPublished != nil {
+			j.onPublished(ctx, article.ID)
+		}
 	}
 
 	j.logger.Info("定时发布任务执行完成",
@@ -0,0 +1,34 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/site_stats"
+)
+
+// SiteStatsSnapshotJob 定期重新聚合侧边栏的文章数、字数与评论数快照
+type SiteStatsSnapshotJob struct {
+	run    func(ctx context.Context) (*site_stats.Snapshot, error)
+	logger *slog.Logger
+}
+
+// NewSiteStatsSnapshotJob 创建站点统计快照任务实例
+func NewSiteStatsSnapshotJob(run func(ctx context.Context) (*site_stats.Snapshot, error), logger *slog.Logger) *SiteStatsSnapshotJob {
+	return &SiteStatsSnapshotJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *SiteStatsSnapshotJob) Name() string {
+	return "SiteStatsSnapshotJob"
+}
+
+// Run 刷新站点统计快照
+func (j *SiteStatsSnapshotJob) Run() {
+	if _, err := j.run(context.Background()); err != nil {
+		j.logger.Error("Failed to refresh site stats snapshot", slog.Any("error", err))
+	}
+}
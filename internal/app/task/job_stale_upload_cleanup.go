@@ -0,0 +1,50 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+// StaleUploadCleanupJob 每天中止远程存储中超时未完成的分片上传或上传会话，并记录释放的空间
+type StaleUploadCleanupJob struct {
+	run    func(ctx context.Context) (*volume.StaleUploadReport, error)
+	logger *slog.Logger
+}
+
+// NewStaleUploadCleanupJob 创建远程未完成上传清理任务实例
+func NewStaleUploadCleanupJob(run func(ctx context.Context) (*volume.StaleUploadReport, error), logger *slog.Logger) *StaleUploadCleanupJob {
+	return &StaleUploadCleanupJob{
+		run:    run,
+		logger: logger,
+	}
+}
+
+// Name 返回任务名称
+func (j *StaleUploadCleanupJob) Name() string {
+	return "StaleUploadCleanupJob"
+}
+
+// Run 执行清理任务，未开启自动清理时 run 返回 nil
+func (j *StaleUploadCleanupJob) Run() {
+	report, err := j.run(context.Background())
+	if err != nil {
+		j.logger.Error("Stale remote upload cleanup failed", slog.Any("error", err))
+		return
+	}
+	if report == nil {
+		return
+	}
+	for _, p := range report.Policies {
+		if p.Error != "" {
+			j.logger.Warn("Stale remote upload cleanup failed for policy", slog.String("policy", p.PolicyName), slog.String("error", p.Error))
+		}
+	}
+	if report.Aborted > 0 {
+		j.logger.Info("Stale remote uploads aborted",
+			slog.Int("aborted", report.Aborted),
+			slog.Int64("reclaimed_bytes", report.ReclaimedBytes),
+			slog.Int("max_age_hours", report.MaxAgeHours))
+	}
+}
@@ -32,12 +32,19 @@ func NewThumbnailGenerationJob(thumbnailService *thumbnail.ThumbnailService, fil
 
 // Run 是 Job 接口要求实现的方法
 func (j *ThumbnailGenerationJob) Run() {
+	_ = j.Execute(context.Background())
+}
+
+// Execute 生成缩略图。生成失败由 ThumbnailService 自行记录状态并重试，
+// 只有任务被取消时才返回错误。
+func (j *ThumbnailGenerationJob) Execute(ctx context.Context) error {
 	// 创建一个带超时的 context，例如 5 分钟
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	genCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel() // 确保资源被释放
 
 	// 使用带超时的 ctx 调用 ThumbnailService
-	j.thumbnailService.Generate(ctx, j.fileID)
+	j.thumbnailService.Generate(genCtx, j.fileID)
+	return ctx.Err()
 }
 
 // Name 方法让日志包装器可以打印出更有意义的任务名
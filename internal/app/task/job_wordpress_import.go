@@ -0,0 +1,32 @@
+package task
+
+import (
+	"log/slog"
+)
+
+// WordPressImportJob 在后台执行 WordPress 导入，进度由迁移服务记录并供前端轮询
+type WordPressImportJob struct {
+	run    func(taskID string)
+	logger *slog.Logger
+	taskID string
+}
+
+// NewWordPressImportJob 创建 WordPress 导入任务实例
+func NewWordPressImportJob(run func(taskID string), logger *slog.Logger, taskID string) *WordPressImportJob {
+	return &WordPressImportJob{
+		run:    run,
+		logger: logger,
+		taskID: taskID,
+	}
+}
+
+// Name 返回任务名称
+func (j *WordPressImportJob) Name() string {
+	return "WordPressImportJob"
+}
+
+// Run 执行导入任务
+func (j *WordPressImportJob) Run() {
+	j.logger.Info("Starting WordPress import job", slog.String("task_id", j.taskID))
+	j.run(j.taskID)
+}
@@ -0,0 +1,140 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// 队列后端类型，通过配置 Queue.Backend 选择
+const (
+	QueueBackendAuto   = "auto"
+	QueueBackendMemory = "memory"
+	QueueBackendRedis  = "redis"
+)
+
+// ErrQueueClosed 表示队列已关闭或等待消息时上下文被取消
+var ErrQueueClosed = errors.New("任务队列已关闭")
+
+// QueueMessage 是队列中的一条任务消息，只保存重建任务所需的类型与参数，
+// 以便进程重启后仍能由对应的任务工厂重新创建任务。
+type QueueMessage struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload"`
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"last_error,omitempty"`
+	EnqueuedAt time.Time       `json:"enqueued_at"`
+	FailedAt   *time.Time      `json:"failed_at,omitempty"`
+
+	// raw 是消息出队时的原始内容，Redis 后端确认消息时用它从处理中列表里移除
+	raw string
+}
+
+// NewQueueMessage 创建一条待入队的任务消息，payload 会被序列化为 JSON。
+func NewQueueMessage(jobType string, payload any) (*QueueMessage, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &QueueMessage{
+		ID:         uuid.New().String(),
+		Type:       jobType,
+		Payload:    data,
+		EnqueuedAt: time.Now(),
+	}, nil
+}
+
+// QueueStats 是队列各状态的消息数量
+type QueueStats struct {
+	Backend    string `json:"backend"`
+	Pending    int64  `json:"pending"`
+	Processing int64  `json:"processing"`
+	Delayed    int64  `json:"delayed"`
+	Dead       int64  `json:"dead"`
+}
+
+// 队列中任务的状态
+const (
+	TaskStatePending  = "pending"
+	TaskStateRunning  = "running"
+	TaskStateRetrying = "retrying"
+	TaskStateFailed   = "failed"
+)
+
+// queueListLimit 列出任务时最多返回的消息数，避免积压严重时一次读取整个队列
+const queueListLimit = 500
+
+// QueuedTask 是队列中某个状态下的一条消息
+type QueuedTask struct {
+	Message *QueueMessage
+	State   string
+	// RunAt 等待重试的消息下次执行的时间
+	RunAt *time.Time
+}
+
+// Queue 是后台任务队列的存储后端，投递语义为至少一次：
+// Dequeue 取出的消息在 Ack 之前处于处理中状态，进程崩溃后由 Recover 重新放回待处理队列，
+// 因此任务需要能够容忍重复执行。
+type Queue interface {
+	// Backend 返回后端类型
+	Backend() string
+	// Enqueue 将消息放入待处理队列
+	Enqueue(ctx context.Context, msg *QueueMessage) error
+	// Dequeue 阻塞等待下一条消息，队列关闭或 ctx 取消时返回 ErrQueueClosed
+	Dequeue(ctx context.Context) (*QueueMessage, error)
+	// Ack 确认消息已处理完成
+	Ack(ctx context.Context, msg *QueueMessage) error
+	// Retry 将处理失败的消息在 delay 之后重新放回待处理队列
+	Retry(ctx context.Context, msg *QueueMessage, delay time.Duration) error
+	// DeadLetter 将多次失败的消息移入死信队列，等待管理员处理
+	DeadLetter(ctx context.Context, msg *QueueMessage) error
+	// Recover 将上次运行遗留在处理中状态的消息放回待处理队列，返回恢复的数量
+	Recover(ctx context.Context) (int, error)
+	// Stats 返回队列各状态的消息数量
+	Stats(ctx context.Context) (*QueueStats, error)
+	// List 返回指定状态的消息，state 为空时返回所有状态，最多返回 queueListLimit 条
+	List(ctx context.Context, state string) ([]*QueuedTask, error)
+	// ListDeadLetters 按失败时间倒序返回死信队列中的消息
+	ListDeadLetters(ctx context.Context) ([]*QueueMessage, error)
+	// RetryDeadLetter 将死信消息清零尝试次数后重新放回待处理队列
+	RetryDeadLetter(ctx context.Context, id string) error
+	// RetryNow 立即执行等待重试的消息，死信消息则清零尝试次数后重新入队
+	RetryNow(ctx context.Context, id string) error
+	// DeleteDeadLetter 从死信队列中删除消息
+	DeleteDeadLetter(ctx context.Context, id string) error
+	// Remove 删除尚未执行的消息（待处理、等待重试或死信），处理中的消息不受影响
+	Remove(ctx context.Context, id string) error
+	// Close 关闭队列，阻塞中的 Dequeue 会立即返回
+	Close() error
+}
+
+// NewQueue 按配置的后端类型创建任务队列。
+// auto（默认）在 Redis 可用时使用 Redis 持久化队列，否则降级为内存队列；
+// 指定 redis 但 Redis 不可用时同样降级，并记录警告。
+func NewQueue(backend string, redisClient *redis.Client) Queue {
+	backend = strings.ToLower(strings.TrimSpace(backend))
+	switch backend {
+	case "", QueueBackendAuto, QueueBackendRedis:
+		if redisClient != nil {
+			log.Println("✅ 后台任务队列使用 Redis 持久化存储")
+			return NewRedisQueue(redisClient)
+		}
+		if backend == QueueBackendRedis {
+			log.Println("⚠️  后台任务队列配置为 Redis，但 Redis 不可用，降级到内存队列（进程重启会丢失未完成的任务）")
+		} else {
+			log.Println("🔄 后台任务队列使用内存存储（进程重启会丢失未完成的任务）")
+		}
+	case QueueBackendMemory:
+		log.Println("🔄 后台任务队列使用内存存储（进程重启会丢失未完成的任务）")
+	default:
+		log.Printf("⚠️  未知的后台任务队列后端 '%s'，使用内存队列", backend)
+	}
+	return NewMemoryQueue(defaultQueueSize)
+}
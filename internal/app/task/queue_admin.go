@@ -0,0 +1,156 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/db_maintenance"
+)
+
+// taskSummaryMaxLen 任务参数摘要的最大字符数
+const taskSummaryMaxLen = 120
+
+// TaskInfo 是任务看板中的一条队列任务
+type TaskInfo struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	State string `json:"state"`
+	// Summary 任务参数的摘要，如 {"file_id":12}
+	Summary    string     `json:"summary"`
+	Attempts   int        `json:"attempts"`
+	LastError  string     `json:"last_error,omitempty"`
+	EnqueuedAt time.Time  `json:"enqueued_at"`
+	NextRunAt  *time.Time `json:"next_run_at,omitempty"`
+	FailedAt   *time.Time `json:"failed_at,omitempty"`
+}
+
+var taskStateRank = map[string]int{
+	TaskStateRunning:  0,
+	TaskStatePending:  1,
+	TaskStateRetrying: 2,
+	TaskStateFailed:   3,
+}
+
+// ListTasks 列出任务队列中的任务，state 为空时返回所有状态。
+// 依赖回调的进程内任务（如 WordPress 导入）不经过任务队列，不会出现在列表中。
+func (b *Broker) ListTasks(ctx context.Context, state string) ([]*TaskInfo, error) {
+	if _, ok := taskStateRank[state]; state != "" && !ok {
+		return nil, fmt.Errorf("未知的任务状态 '%s': %w", state, constant.ErrBadRequest)
+	}
+	tasks, err := b.queue.List(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*TaskInfo, 0, len(tasks))
+	for _, t := range tasks {
+		msg := t.Message
+		list = append(list, &TaskInfo{
+			ID:         msg.ID,
+			Type:       msg.Type,
+			State:      t.State,
+			Summary:    summarizePayload(msg.Payload),
+			Attempts:   msg.Attempts,
+			LastError:  msg.LastError,
+			EnqueuedAt: msg.EnqueuedAt,
+			NextRunAt:  t.RunAt,
+			FailedAt:   msg.FailedAt,
+		})
+	}
+	// 同一状态内：执行中按入队时间，失败按失败时间倒序，其余保持队列中的执行顺序
+	sort.SliceStable(list, func(i, j int) bool {
+		a, c := list[i], list[j]
+		if a.State != c.State {
+			return taskStateRank[a.State] < taskStateRank[c.State]
+		}
+		switch a.State {
+		case TaskStateRunning:
+			return a.EnqueuedAt.Before(c.EnqueuedAt)
+		case TaskStateFailed:
+			return a.FailedAt != nil && (c.FailedAt == nil || a.FailedAt.After(*c.FailedAt))
+		case TaskStateRetrying:
+			return a.NextRunAt != nil && c.NextRunAt != nil && a.NextRunAt.Before(*c.NextRunAt)
+		}
+		return false
+	})
+	return list, nil
+}
+
+// RetryTask 立即执行等待重试的任务，或将失败的任务清零尝试次数后重新入队
+func (b *Broker) RetryTask(ctx context.Context, id string) error {
+	if b.isRunning(id) {
+		return fmt.Errorf("任务正在执行，无法重试: %w", constant.ErrBadRequest)
+	}
+	return b.queue.RetryNow(ctx, id)
+}
+
+// CancelTask 取消任务：执行中的任务会取消其上下文且不再重试，尚未执行的任务直接从队列中删除。
+// 执行中的任务需要响应上下文取消才能提前结束，否则会继续运行到完成。
+func (b *Broker) CancelTask(ctx context.Context, id string) error {
+	b.runningMu.Lock()
+	cancel, ok := b.running[id]
+	b.runningMu.Unlock()
+	if ok {
+		cancel()
+		return nil
+	}
+	return b.queue.Remove(ctx, id)
+}
+
+func (b *Broker) isRunning(id string) bool {
+	b.runningMu.Lock()
+	defer b.runningMu.Unlock()
+	_, ok := b.running[id]
+	return ok
+}
+
+// MaintenanceStatus 是任务看板中的数据库维护状态
+type MaintenanceStatus struct {
+	Enabled bool   `json:"enabled"`
+	Window  string `json:"window"`
+	Running bool   `json:"running"`
+	// Runs 最近的维护记录，按时间倒序
+	Runs []*db_maintenance.Report `json:"runs"`
+}
+
+// MaintenanceStatus 返回数据库维护的配置与最近的执行记录
+func (b *Broker) MaintenanceStatus() (*MaintenanceStatus, error) {
+	if b.dbMaintenanceSvc == nil {
+		return nil, fmt.Errorf("数据库维护服务未启用: %w", constant.ErrNotFound)
+	}
+	return &MaintenanceStatus{
+		Enabled: b.settingSvc.Get(constant.KeyDBMaintenanceEnable.String()) == "true",
+		Window:  b.settingSvc.Get(constant.KeyDBMaintenanceWindow.String()),
+		Running: b.dbMaintenanceSvc.Running(),
+		Runs:    b.dbMaintenanceSvc.Runs(),
+	}, nil
+}
+
+// RunMaintenance 立即在后台执行一次数据库维护，不受维护时段与开关限制
+func (b *Broker) RunMaintenance() error {
+	if b.dbMaintenanceSvc == nil {
+		return fmt.Errorf("数据库维护服务未启用: %w", constant.ErrNotFound)
+	}
+	if b.dbMaintenanceSvc.Running() {
+		return fmt.Errorf("数据库维护正在执行中: %w", constant.ErrConflict)
+	}
+	go runDBMaintenance(b.dbMaintenanceSvc, db_maintenance.TriggerManual, b.logger)
+	return nil
+}
+
+// summarizePayload 返回任务参数的摘要，过长时截断
+func summarizePayload(payload []byte) string {
+	s := string(payload)
+	if s == "" || s == "null" || s == "{}" {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= taskSummaryMaxLen {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:taskSummaryMaxLen]) + "…"
+}
@@ -0,0 +1,297 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// defaultQueueSize 内存队列的容量，队列满时入队会阻塞
+const defaultQueueSize = 1000
+
+// memoryQueue 是进程内的任务队列，未配置 Redis 时使用。
+// 重试与死信同样在内存中进行，进程重启后所有未完成的任务都会丢失。
+type memoryQueue struct {
+	slots     chan struct{} // 容量信号量，入队时占用、出队时释放
+	notify    chan struct{} // 有新消息时唤醒等待中的 Dequeue
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mu         sync.Mutex
+	pending    []*QueueMessage
+	processing map[string]*QueueMessage
+	delayed    map[string]*delayedMessage
+	dead       map[string]*QueueMessage
+}
+
+// delayedMessage 是等待重试的消息及其定时器
+type delayedMessage struct {
+	msg   *QueueMessage
+	runAt time.Time
+	timer *time.Timer
+}
+
+// NewMemoryQueue 创建容量为 size 的内存任务队列。
+func NewMemoryQueue(size int) Queue {
+	if size <= 0 {
+		size = defaultQueueSize
+	}
+	return &memoryQueue{
+		slots:      make(chan struct{}, size),
+		notify:     make(chan struct{}, 1),
+		closed:     make(chan struct{}),
+		processing: make(map[string]*QueueMessage),
+		delayed:    make(map[string]*delayedMessage),
+		dead:       make(map[string]*QueueMessage),
+	}
+}
+
+func (q *memoryQueue) Backend() string { return QueueBackendMemory }
+
+func (q *memoryQueue) Enqueue(ctx context.Context, msg *QueueMessage) error {
+	select {
+	case q.slots <- struct{}{}:
+	case <-q.closed:
+		return ErrQueueClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	q.mu.Lock()
+	q.pending = append(q.pending, msg)
+	q.mu.Unlock()
+	q.wake()
+	return nil
+}
+
+func (q *memoryQueue) Dequeue(ctx context.Context) (*QueueMessage, error) {
+	for {
+		q.mu.Lock()
+		if len(q.pending) > 0 {
+			msg := q.pending[0]
+			q.pending[0] = nil
+			q.pending = q.pending[1:]
+			q.processing[msg.ID] = msg
+			remaining := len(q.pending)
+			q.mu.Unlock()
+			<-q.slots
+			// 唤醒信号只有一个，仍有消息时继续唤醒其他等待中的消费者
+			if remaining > 0 {
+				q.wake()
+			}
+			return msg, nil
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-q.notify:
+		case <-q.closed:
+			return nil, ErrQueueClosed
+		case <-ctx.Done():
+			return nil, ErrQueueClosed
+		}
+	}
+}
+
+func (q *memoryQueue) wake() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *memoryQueue) Ack(ctx context.Context, msg *QueueMessage) error {
+	q.mu.Lock()
+	delete(q.processing, msg.ID)
+	q.mu.Unlock()
+	return nil
+}
+
+func (q *memoryQueue) Retry(ctx context.Context, msg *QueueMessage, delay time.Duration) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.processing, msg.ID)
+	q.delayed[msg.ID] = &delayedMessage{
+		msg:   msg,
+		runAt: time.Now().Add(delay),
+		timer: time.AfterFunc(delay, func() { q.promote(msg.ID) }),
+	}
+	return nil
+}
+
+// promote 将等待重试的消息放回待处理队列，消息已被取消或提前重试时返回 false
+func (q *memoryQueue) promote(id string) bool {
+	q.mu.Lock()
+	entry, ok := q.delayed[id]
+	delete(q.delayed, id)
+	q.mu.Unlock()
+	if !ok {
+		return false
+	}
+	entry.timer.Stop()
+	_ = q.Enqueue(context.Background(), entry.msg)
+	return true
+}
+
+func (q *memoryQueue) DeadLetter(ctx context.Context, msg *QueueMessage) error {
+	if msg.FailedAt == nil {
+		now := time.Now()
+		msg.FailedAt = &now
+	}
+	q.mu.Lock()
+	delete(q.processing, msg.ID)
+	q.dead[msg.ID] = msg
+	q.mu.Unlock()
+	return nil
+}
+
+// Recover 内存队列不会在进程之间保留消息，无需恢复
+func (q *memoryQueue) Recover(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (q *memoryQueue) Stats(ctx context.Context) (*QueueStats, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return &QueueStats{
+		Backend:    QueueBackendMemory,
+		Pending:    int64(len(q.pending)),
+		Processing: int64(len(q.processing)),
+		Delayed:    int64(len(q.delayed)),
+		Dead:       int64(len(q.dead)),
+	}, nil
+}
+
+func (q *memoryQueue) List(ctx context.Context, state string) ([]*QueuedTask, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var list []*QueuedTask
+	add := func(msg *QueueMessage, state string, runAt *time.Time) {
+		if len(list) < queueListLimit {
+			list = append(list, &QueuedTask{Message: msg, State: state, RunAt: runAt})
+		}
+	}
+	if state == "" || state == TaskStatePending {
+		for _, msg := range q.pending {
+			add(msg, TaskStatePending, nil)
+		}
+	}
+	if state == "" || state == TaskStateRunning {
+		for _, msg := range q.processing {
+			add(msg, TaskStateRunning, nil)
+		}
+	}
+	if state == "" || state == TaskStateRetrying {
+		for _, entry := range q.delayed {
+			runAt := entry.runAt
+			add(entry.msg, TaskStateRetrying, &runAt)
+		}
+	}
+	if state == "" || state == TaskStateFailed {
+		for _, msg := range q.dead {
+			add(msg, TaskStateFailed, nil)
+		}
+	}
+	return list, nil
+}
+
+func (q *memoryQueue) ListDeadLetters(ctx context.Context) ([]*QueueMessage, error) {
+	q.mu.Lock()
+	list := make([]*QueueMessage, 0, len(q.dead))
+	for _, msg := range q.dead {
+		list = append(list, msg)
+	}
+	q.mu.Unlock()
+	sortDeadLetters(list)
+	return list, nil
+}
+
+func (q *memoryQueue) RetryNow(ctx context.Context, id string) error {
+	if q.promote(id) {
+		return nil
+	}
+	return retryDeadLetterNow(ctx, q, id)
+}
+
+func (q *memoryQueue) RetryDeadLetter(ctx context.Context, id string) error {
+	q.mu.Lock()
+	msg, ok := q.dead[id]
+	delete(q.dead, id)
+	q.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("死信任务不存在: %w", constant.ErrNotFound)
+	}
+	resetForRetry(msg)
+	return q.Enqueue(ctx, msg)
+}
+
+func (q *memoryQueue) DeleteDeadLetter(ctx context.Context, id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.dead[id]; !ok {
+		return fmt.Errorf("死信任务不存在: %w", constant.ErrNotFound)
+	}
+	delete(q.dead, id)
+	return nil
+}
+
+func (q *memoryQueue) Remove(ctx context.Context, id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, msg := range q.pending {
+		if msg.ID == id {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			<-q.slots
+			return nil
+		}
+	}
+	if entry, ok := q.delayed[id]; ok {
+		entry.timer.Stop()
+		delete(q.delayed, id)
+		return nil
+	}
+	if _, ok := q.dead[id]; ok {
+		delete(q.dead, id)
+		return nil
+	}
+	return fmt.Errorf("等待中的任务不存在: %w", constant.ErrNotFound)
+}
+
+func (q *memoryQueue) Close() error {
+	q.closeOnce.Do(func() { close(q.closed) })
+	return nil
+}
+
+// sortDeadLetters 按失败时间倒序排列死信消息
+func sortDeadLetters(list []*QueueMessage) {
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i].FailedAt, list[j].FailedAt
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.After(*b)
+	})
+}
+
+// retryDeadLetterNow 重新执行死信消息，消息不存在时返回更准确的错误信息
+func retryDeadLetterNow(ctx context.Context, q Queue, id string) error {
+	if err := q.RetryDeadLetter(ctx, id); err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return fmt.Errorf("等待重试或失败的任务不存在: %w", constant.ErrNotFound)
+		}
+		return err
+	}
+	return nil
+}
+
+// resetForRetry 清除消息的失败记录，使其像新任务一样重新计算重试次数
+func resetForRetry(msg *QueueMessage) {
+	msg.Attempts = 0
+	msg.LastError = ""
+	msg.FailedAt = nil
+	msg.EnqueuedAt = time.Now()
+}
@@ -0,0 +1,383 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisQueuePendingKey    = "anheyu:task_queue:pending"
+	redisQueueProcessingKey = "anheyu:task_queue:processing"
+	redisQueueDelayedKey    = "anheyu:task_queue:delayed"
+	redisQueueDeadKey       = "anheyu:task_queue:dead"
+
+	// redisDequeueBlock 单次阻塞等待消息的时间，超时后会先检查到期的重试任务再继续等待
+	redisDequeueBlock = time.Second
+	// redisPromoteBatch 每次最多从延迟集合移回待处理队列的消息数
+	redisPromoteBatch = 100
+)
+
+// promoteDelayedScript 原子地将到期的重试消息从延迟集合移回待处理队列
+var promoteDelayedScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, item in ipairs(items) do
+	redis.call('ZREM', KEYS[1], item)
+	redis.call('LPUSH', KEYS[2], item)
+end
+return #items
+`)
+
+// redisQueue 是基于 Redis 列表的持久化任务队列：
+// 待处理消息在 pending 列表中，出队时原子地移入 processing 列表，处理完成后才移除；
+// 失败重试的消息按到期时间放在 delayed 有序集合中，多次失败的消息放入 dead 哈希表。
+// 启动时 processing 中遗留的消息即为上次进程崩溃时未完成的任务，由 Recover 放回 pending。
+type redisQueue struct {
+	client    *redis.Client
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewRedisQueue 创建基于 Redis 的持久化任务队列，Redis 连接由调用方管理。
+func NewRedisQueue(client *redis.Client) Queue {
+	return &redisQueue{
+		client: client,
+		closed: make(chan struct{}),
+	}
+}
+
+func (q *redisQueue) Backend() string { return QueueBackendRedis }
+
+func (q *redisQueue) Enqueue(ctx context.Context, msg *QueueMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("序列化任务消息失败: %w", err)
+	}
+	return q.client.LPush(ctx, redisQueuePendingKey, data).Err()
+}
+
+func (q *redisQueue) Dequeue(ctx context.Context) (*QueueMessage, error) {
+	for {
+		select {
+		case <-q.closed:
+			return nil, ErrQueueClosed
+		case <-ctx.Done():
+			return nil, ErrQueueClosed
+		default:
+		}
+
+		if err := q.promoteDelayed(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("[任务队列] 移回到期的重试任务失败: %v", err)
+		}
+
+		raw, err := q.client.BLMove(ctx, redisQueuePendingKey, redisQueueProcessingKey, "RIGHT", "LEFT", redisDequeueBlock).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ErrQueueClosed
+			}
+			log.Printf("[任务队列] 从 Redis 读取任务失败: %v", err)
+			select {
+			case <-time.After(redisDequeueBlock):
+			case <-q.closed:
+			case <-ctx.Done():
+			}
+			continue
+		}
+
+		var msg QueueMessage
+		if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+			log.Printf("[任务队列] 丢弃无法解析的任务消息: %v", err)
+			q.client.LRem(ctx, redisQueueProcessingKey, 1, raw)
+			continue
+		}
+		msg.raw = raw
+		return &msg, nil
+	}
+}
+
+func (q *redisQueue) Ack(ctx context.Context, msg *QueueMessage) error {
+	return q.client.LRem(ctx, redisQueueProcessingKey, 1, msg.raw).Err()
+}
+
+func (q *redisQueue) Retry(ctx context.Context, msg *QueueMessage, delay time.Duration) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("序列化任务消息失败: %w", err)
+	}
+	readyAt := float64(time.Now().Add(delay).UnixMilli())
+	_, err = q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, redisQueueDelayedKey, redis.Z{Score: readyAt, Member: data})
+		pipe.LRem(ctx, redisQueueProcessingKey, 1, msg.raw)
+		return nil
+	})
+	return err
+}
+
+func (q *redisQueue) DeadLetter(ctx context.Context, msg *QueueMessage) error {
+	if msg.FailedAt == nil {
+		now := time.Now()
+		msg.FailedAt = &now
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("序列化任务消息失败: %w", err)
+	}
+	_, err = q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, redisQueueDeadKey, msg.ID, data)
+		pipe.LRem(ctx, redisQueueProcessingKey, 1, msg.raw)
+		return nil
+	})
+	return err
+}
+
+// Recover 将 processing 中遗留的消息全部放回 pending。
+// 应用为单实例部署，启动时 processing 中的消息只可能来自上次异常退出的进程。
+func (q *redisQueue) Recover(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		err := q.client.LMove(ctx, redisQueueProcessingKey, redisQueuePendingKey, "LEFT", "RIGHT").Err()
+		if errors.Is(err, redis.Nil) {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+func (q *redisQueue) Stats(ctx context.Context) (*QueueStats, error) {
+	pipe := q.client.Pipeline()
+	pending := pipe.LLen(ctx, redisQueuePendingKey)
+	processing := pipe.LLen(ctx, redisQueueProcessingKey)
+	delayed := pipe.ZCard(ctx, redisQueueDelayedKey)
+	dead := pipe.HLen(ctx, redisQueueDeadKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &QueueStats{
+		Backend:    QueueBackendRedis,
+		Pending:    pending.Val(),
+		Processing: processing.Val(),
+		Delayed:    delayed.Val(),
+		Dead:       dead.Val(),
+	}, nil
+}
+
+func (q *redisQueue) List(ctx context.Context, state string) ([]*QueuedTask, error) {
+	var list []*QueuedTask
+	add := func(raw, state string, runAt *time.Time) {
+		if len(list) >= queueListLimit {
+			return
+		}
+		var msg QueueMessage
+		if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+			return
+		}
+		msg.raw = raw
+		list = append(list, &QueuedTask{Message: &msg, State: state, RunAt: runAt})
+	}
+
+	if state == "" || state == TaskStatePending {
+		// 消息从左侧入队、右侧出队，倒序遍历使列表按执行顺序排列
+		values, err := q.client.LRange(ctx, redisQueuePendingKey, -queueListLimit, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+		for i := len(values) - 1; i >= 0; i-- {
+			add(values[i], TaskStatePending, nil)
+		}
+	}
+	if state == "" || state == TaskStateRunning {
+		values, err := q.client.LRange(ctx, redisQueueProcessingKey, 0, queueListLimit-1).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range values {
+			add(raw, TaskStateRunning, nil)
+		}
+	}
+	if state == "" || state == TaskStateRetrying {
+		values, err := q.client.ZRangeWithScores(ctx, redisQueueDelayedKey, 0, queueListLimit-1).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, z := range values {
+			runAt := time.UnixMilli(int64(z.Score))
+			add(fmt.Sprint(z.Member), TaskStateRetrying, &runAt)
+		}
+	}
+	if state == "" || state == TaskStateFailed {
+		values, err := q.client.HVals(ctx, redisQueueDeadKey).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range values {
+			add(raw, TaskStateFailed, nil)
+		}
+	}
+	return list, nil
+}
+
+func (q *redisQueue) ListDeadLetters(ctx context.Context) ([]*QueueMessage, error) {
+	values, err := q.client.HVals(ctx, redisQueueDeadKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*QueueMessage, 0, len(values))
+	for _, raw := range values {
+		var msg QueueMessage
+		if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+			continue
+		}
+		list = append(list, &msg)
+	}
+	sortDeadLetters(list)
+	return list, nil
+}
+
+func (q *redisQueue) RetryDeadLetter(ctx context.Context, id string) error {
+	raw, err := q.client.HGet(ctx, redisQueueDeadKey, id).Result()
+	if errors.Is(err, redis.Nil) {
+		return fmt.Errorf("死信任务不存在: %w", constant.ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	var msg QueueMessage
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		return fmt.Errorf("解析死信任务失败: %w", err)
+	}
+	resetForRetry(&msg)
+	data, err := json.Marshal(&msg)
+	if err != nil {
+		return fmt.Errorf("序列化任务消息失败: %w", err)
+	}
+	_, err = q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, redisQueueDeadKey, id)
+		pipe.LPush(ctx, redisQueuePendingKey, data)
+		return nil
+	})
+	return err
+}
+
+func (q *redisQueue) RetryNow(ctx context.Context, id string) error {
+	raw, err := q.findDelayed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if raw == "" {
+		return retryDeadLetterNow(ctx, q, id)
+	}
+	// ZRem 成功才入队，避免与到期移回的脚本同时处理同一条消息
+	removed, err := q.client.ZRem(ctx, redisQueueDelayedKey, raw).Result()
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return fmt.Errorf("任务已开始执行: %w", constant.ErrBadRequest)
+	}
+	return q.client.LPush(ctx, redisQueuePendingKey, raw).Err()
+}
+
+func (q *redisQueue) DeleteDeadLetter(ctx context.Context, id string) error {
+	deleted, err := q.client.HDel(ctx, redisQueueDeadKey, id).Result()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("死信任务不存在: %w", constant.ErrNotFound)
+	}
+	return nil
+}
+
+func (q *redisQueue) Remove(ctx context.Context, id string) error {
+	values, err := q.client.LRange(ctx, redisQueuePendingKey, 0, -1).Result()
+	if err != nil {
+		return err
+	}
+	for _, raw := range values {
+		if messageID(raw) != id {
+			continue
+		}
+		removed, err := q.client.LRem(ctx, redisQueuePendingKey, 1, raw).Result()
+		if err != nil {
+			return err
+		}
+		if removed == 0 {
+			return fmt.Errorf("任务已开始执行: %w", constant.ErrBadRequest)
+		}
+		return nil
+	}
+
+	raw, err := q.findDelayed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if raw != "" {
+		removed, err := q.client.ZRem(ctx, redisQueueDelayedKey, raw).Result()
+		if err != nil {
+			return err
+		}
+		if removed == 0 {
+			return fmt.Errorf("任务已开始执行: %w", constant.ErrBadRequest)
+		}
+		return nil
+	}
+
+	deleted, err := q.client.HDel(ctx, redisQueueDeadKey, id).Result()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("等待中的任务不存在: %w", constant.ErrNotFound)
+	}
+	return nil
+}
+
+func (q *redisQueue) Close() error {
+	q.closeOnce.Do(func() { close(q.closed) })
+	return nil
+}
+
+func (q *redisQueue) promoteDelayed(ctx context.Context) error {
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	return promoteDelayedScript.Run(ctx, q.client,
+		[]string{redisQueueDelayedKey, redisQueuePendingKey}, now, redisPromoteBatch).Err()
+}
+
+// findDelayed 在延迟集合中查找指定 ID 的消息，返回其原始内容，不存在时返回空字符串
+func (q *redisQueue) findDelayed(ctx context.Context, id string) (string, error) {
+	values, err := q.client.ZRange(ctx, redisQueueDelayedKey, 0, -1).Result()
+	if err != nil {
+		return "", err
+	}
+	for _, raw := range values {
+		if messageID(raw) == id {
+			return raw, nil
+		}
+	}
+	return "", nil
+}
+
+// messageID 解析原始消息内容中的 ID，无法解析时返回空字符串
+func messageID(raw string) string {
+	var msg struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		return ""
+	}
+	return msg.ID
+}
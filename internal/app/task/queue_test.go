@@ -0,0 +1,226 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+type failingJob struct {
+	err   error
+	panic bool
+}
+
+func (j *failingJob) Run() {}
+
+func (j *failingJob) Name() string { return "failingJob" }
+
+func (j *failingJob) Execute(ctx context.Context) error {
+	if j.panic {
+		panic("boom")
+	}
+	return j.err
+}
+
+func newTestBroker(job *failingJob) *Broker {
+	return &Broker{
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		queue:  NewMemoryQueue(10),
+		factories: map[string]jobFactory{
+			"test": func(json.RawMessage) (Job, error) { return job, nil },
+		},
+	}
+}
+
+func dequeue(t *testing.T, q Queue) *QueueMessage {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	msg, err := q.Dequeue(ctx)
+	if err != nil {
+		t.Fatalf("出队失败: %v", err)
+	}
+	return msg
+}
+
+func TestProcessMessageRetriesThenDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	job := &failingJob{err: errors.New("数据库暂时不可用")}
+	b := newTestBroker(job)
+	if !b.enqueue("test", map[string]int{"id": 1}) {
+		t.Fatal("入队失败")
+	}
+
+	// 未达到最大次数：进入等待重试状态
+	msg := dequeue(t, b.queue)
+	b.processMessage(ctx, msg)
+	stats, _ := b.queue.Stats(ctx)
+	if stats.Delayed != 1 || stats.Processing != 0 || stats.Dead != 0 {
+		t.Fatalf("失败的任务应等待重试: %+v", stats)
+	}
+	if msg.Attempts != 1 || msg.LastError != "数据库暂时不可用" {
+		t.Errorf("应记录尝试次数与错误: %+v", msg)
+	}
+
+	// 达到最大次数：移入死信队列
+	msg.Attempts = queueMaxAttempts - 1
+	b.queue.(*memoryQueue).processing[msg.ID] = msg
+	b.processMessage(ctx, msg)
+	dead, _ := b.ListDeadLetters(ctx)
+	if len(dead) != 1 || dead[0].ID != msg.ID || dead[0].FailedAt == nil {
+		t.Fatalf("多次失败的任务应移入死信队列: %+v", dead)
+	}
+
+	// 管理员重试：清零尝试次数并重新入队，成功后确认
+	if err := b.RetryDeadLetter(ctx, msg.ID); err != nil {
+		t.Fatal(err)
+	}
+	job.err = nil
+	retried := dequeue(t, b.queue)
+	if retried.Attempts != 0 || retried.LastError != "" {
+		t.Errorf("重试的死信任务应清零失败记录: %+v", retried)
+	}
+	b.processMessage(ctx, retried)
+	stats, _ = b.queue.Stats(ctx)
+	if stats.Processing != 0 || stats.Dead != 0 {
+		t.Errorf("成功的任务应被确认: %+v", stats)
+	}
+
+	if err := b.RetryDeadLetter(ctx, msg.ID); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("重试不存在的死信任务应返回 ErrNotFound，实际 %v", err)
+	}
+}
+
+func TestProcessMessagePermanentFailures(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBroker(&failingJob{panic: true})
+
+	// panic 视为失败并重试，不会导致 worker 崩溃
+	b.enqueue("test", nil)
+	b.processMessage(ctx, dequeue(t, b.queue))
+	if stats, _ := b.queue.Stats(ctx); stats.Delayed != 1 {
+		t.Errorf("panic 的任务应等待重试: %+v", stats)
+	}
+
+	// 未知任务类型无法重建，直接移入死信队列
+	b.enqueue("unknown", nil)
+	b.processMessage(ctx, dequeue(t, b.queue))
+	dead, _ := b.ListDeadLetters(ctx)
+	if len(dead) != 1 || dead[0].Type != "unknown" || dead[0].Attempts != 0 {
+		t.Fatalf("未知类型的任务应直接移入死信队列: %+v", dead)
+	}
+	if err := b.DeleteDeadLetter(ctx, dead[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if stats, _ := b.queue.Stats(ctx); stats.Dead != 0 {
+		t.Errorf("删除后死信队列应为空: %+v", stats)
+	}
+}
+
+func TestQueueRetryDelay(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  10 * time.Second,
+		2:  20 * time.Second,
+		4:  80 * time.Second,
+		10: queueRetryMaxDelay,
+	}
+	for attempts, want := range cases {
+		if got := queueRetryDelay(attempts); got != want {
+			t.Errorf("第 %d 次失败后应等待 %v，实际 %v", attempts, want, got)
+		}
+	}
+}
+
+func TestListRetryAndCancelTasks(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBroker(&failingJob{err: errors.New("存储不可用")})
+	b.enqueue("test", map[string]int{"file_id": 12})
+	b.enqueue("test", nil)
+
+	// 第一条失败后等待重试，第二条仍在等待执行
+	b.processMessage(ctx, dequeue(t, b.queue))
+	tasks, err := b.ListTasks(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 2 || tasks[0].State != TaskStatePending || tasks[1].State != TaskStateRetrying {
+		t.Fatalf("任务状态不正确: %+v", tasks)
+	}
+	retrying := tasks[1]
+	if retrying.Summary != `{"file_id":12}` || retrying.Attempts != 1 || retrying.LastError != "存储不可用" || retrying.NextRunAt == nil {
+		t.Errorf("等待重试的任务信息不完整: %+v", retrying)
+	}
+	if _, err := b.ListTasks(ctx, "unknown"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("未知状态应返回 ErrBadRequest，实际 %v", err)
+	}
+
+	// 取消等待执行的任务，立即重试等待重试的任务
+	if err := b.CancelTask(ctx, tasks[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.RetryTask(ctx, retrying.ID); err != nil {
+		t.Fatal(err)
+	}
+	pending, _ := b.ListTasks(ctx, TaskStatePending)
+	if len(pending) != 1 || pending[0].ID != retrying.ID || pending[0].Attempts != 1 {
+		t.Fatalf("立即重试的任务应回到待处理队列并保留尝试次数: %+v", pending)
+	}
+	if err := b.CancelTask(ctx, tasks[0].ID); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("取消不存在的任务应返回 ErrNotFound，实际 %v", err)
+	}
+}
+
+type blockingJob struct {
+	started chan struct{}
+}
+
+func (j *blockingJob) Run() {}
+
+func (j *blockingJob) Name() string { return "blockingJob" }
+
+func (j *blockingJob) Execute(ctx context.Context) error {
+	close(j.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestCancelRunningTask(t *testing.T) {
+	ctx := context.Background()
+	job := &blockingJob{started: make(chan struct{})}
+	b := newTestBroker(nil)
+	b.factories["test"] = func(json.RawMessage) (Job, error) { return job, nil }
+	b.enqueue("test", nil)
+
+	msg := dequeue(t, b.queue)
+	done := make(chan struct{})
+	go func() {
+		b.processMessage(ctx, msg)
+		close(done)
+	}()
+	<-job.started
+
+	if running, _ := b.ListTasks(ctx, TaskStateRunning); len(running) != 1 {
+		t.Fatalf("应有一个执行中的任务: %+v", running)
+	}
+	if err := b.RetryTask(ctx, msg.ID); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("执行中的任务不能重试，实际 %v", err)
+	}
+	if err := b.CancelTask(ctx, msg.ID); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("取消后任务应结束")
+	}
+	// 被取消的任务直接确认，不进入重试或死信
+	if stats, _ := b.queue.Stats(ctx); stats.Processing != 0 || stats.Delayed != 0 || stats.Dead != 0 {
+		t.Errorf("被取消的任务不应重试: %+v", stats)
+	}
+}
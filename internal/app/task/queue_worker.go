@@ -0,0 +1,252 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+// 可持久化的任务类型。这些任务只依赖可序列化的参数，会经由任务队列投递，
+// 使用 Redis 队列时进程崩溃或重启也不会丢失。
+const (
+	JobTypeThumbnailGeneration = "thumbnail_generation"
+	JobTypeCommentNotification = "comment_notification"
+	JobTypeOrphanCleanup       = "orphan_cleanup"
+	JobTypeLinkCleanup         = "link_cleanup"
+)
+
+const (
+	// queueMaxAttempts 队列任务的最大执行次数，超过后移入死信队列
+	queueMaxAttempts = 5
+	// queueRetryBaseDelay 首次重试的等待时间，之后每次翻倍
+	queueRetryBaseDelay = 10 * time.Second
+	// queueRetryMaxDelay 重试等待时间的上限
+	queueRetryMaxDelay = 10 * time.Minute
+)
+
+// FallibleJob 是可以报告执行失败的任务。经由任务队列执行时，返回错误的任务会按退避时间重试，
+// 多次失败后移入死信队列；未实现该接口的任务只有在 panic 时才视为失败。
+type FallibleJob interface {
+	Job
+	Execute(ctx context.Context) error
+}
+
+// jobFactory 根据队列消息的参数重建任务
+type jobFactory func(payload json.RawMessage) (Job, error)
+
+type thumbnailPayload struct {
+	FileID uint `json:"file_id"`
+}
+
+type commentNotificationPayload struct {
+	CommentID uint `json:"comment_id"`
+}
+
+// jobFactories 返回各持久化任务类型的任务工厂
+func (b *Broker) jobFactories() map[string]jobFactory {
+	return map[string]jobFactory{
+		JobTypeThumbnailGeneration: func(payload json.RawMessage) (Job, error) {
+			var p thumbnailPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return nil, err
+			}
+			return NewThumbnailGenerationJob(b.thumbnailSvc, p.FileID), nil
+		},
+		JobTypeCommentNotification: func(payload json.RawMessage) (Job, error) {
+			var p commentNotificationPayload
+			if err := json.Unmarshal(payload, &p); err != nil {
+				return nil, err
+			}
+			return NewCommentNotificationJob(b.emailSvc, b.commentRepo, p.CommentID), nil
+		},
+		JobTypeOrphanCleanup: func(json.RawMessage) (Job, error) {
+			return NewCleanupOrphanedItemsJob(b.cleanupSvc), nil
+		},
+		JobTypeLinkCleanup: func(json.RawMessage) (Job, error) {
+			return NewLinkCleanupJob(b.linkCategoryRepo, b.linkTagRepo, b.settingSvc), nil
+		},
+	}
+}
+
+// enqueue 将可持久化的任务放入任务队列，入队失败只记录日志，与派发内存任务的行为保持一致。
+func (b *Broker) enqueue(jobType string, payload any) bool {
+	msg, err := NewQueueMessage(jobType, payload)
+	if err != nil {
+		b.logger.Error("Failed to build queue message", slog.String("job_type", jobType), slog.Any("error", err))
+		return false
+	}
+	if err := b.queue.Enqueue(context.Background(), msg); err != nil {
+		b.logger.Error("Failed to enqueue job", slog.String("job_type", jobType), slog.Any("error", err))
+		return false
+	}
+	return true
+}
+
+// startQueueConsumers 恢复上次运行未完成的任务，并启动消费任务队列的 worker。
+func (b *Broker) startQueueConsumers(workerCount int) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.queueCancel = cancel
+
+	if recovered, err := b.queue.Recover(ctx); err != nil {
+		b.logger.Error("Failed to recover in-flight queue jobs", slog.Any("error", err))
+	} else if recovered > 0 {
+		b.logger.Info("Recovered in-flight queue jobs from last run", slog.Int("count", recovered))
+	}
+
+	b.logger.Info("Starting task queue consumers", "backend", b.queue.Backend(), "concurrency", workerCount)
+	for i := 0; i < workerCount; i++ {
+		b.queueWG.Add(1)
+		go func() {
+			defer b.queueWG.Done()
+			for {
+				msg, err := b.queue.Dequeue(ctx)
+				if err != nil {
+					return
+				}
+				b.processMessage(ctx, msg)
+			}
+		}()
+	}
+}
+
+// processMessage 执行一条队列消息：成功时确认，失败时按退避时间重试，达到最大次数后移入死信队列。
+// 无法识别的任务类型或参数无法解析属于永久性错误，直接移入死信队列。
+func (b *Broker) processMessage(ctx context.Context, msg *QueueMessage) {
+	// 队列关闭后仍需完成确认，避免任务在 Redis 中一直停留在处理中状态
+	ctx = context.WithoutCancel(ctx)
+	logger := b.logger.With(slog.String("job_type", msg.Type), slog.String("message_id", msg.ID))
+
+	factory, ok := b.factories[msg.Type]
+	if !ok {
+		b.deadLetter(ctx, logger, msg, fmt.Errorf("未知的任务类型: %s", msg.Type))
+		return
+	}
+	job, err := factory(msg.Payload)
+	if err != nil {
+		b.deadLetter(ctx, logger, msg, fmt.Errorf("解析任务参数失败: %w", err))
+		return
+	}
+
+	msg.Attempts++
+	jobCtx, cancel := context.WithCancel(ctx)
+	b.trackRunning(msg.ID, cancel)
+	err = b.runQueuedJob(jobCtx, job)
+	b.untrackRunning(msg.ID)
+	// ctx 不会随队列关闭而取消，jobCtx 被取消只可能是管理员手动取消了任务，此时不再重试
+	cancelled := jobCtx.Err() != nil
+	cancel()
+	if cancelled {
+		logger.Warn("Queue job cancelled by admin", slog.Int("attempts", msg.Attempts), slog.Any("error", err))
+		err = nil
+	}
+	if err == nil {
+		if err := b.queue.Ack(ctx, msg); err != nil {
+			logger.Error("Failed to ack queue job", slog.Any("error", err))
+		}
+		return
+	}
+
+	if msg.Attempts >= queueMaxAttempts {
+		b.deadLetter(ctx, logger, msg, err)
+		return
+	}
+	msg.LastError = err.Error()
+	delay := queueRetryDelay(msg.Attempts)
+	logger.Warn("Queue job failed, will retry", slog.Int("attempts", msg.Attempts), slog.Duration("delay", delay), slog.Any("error", err))
+	if err := b.queue.Retry(ctx, msg, delay); err != nil {
+		logger.Error("Failed to schedule queue job retry", slog.Any("error", err))
+	}
+}
+
+func (b *Broker) deadLetter(ctx context.Context, logger *slog.Logger, msg *QueueMessage, cause error) {
+	msg.LastError = cause.Error()
+	logger.Error("Queue job moved to dead letter queue", slog.Int("attempts", msg.Attempts), slog.Any("error", cause))
+	if err := b.queue.DeadLetter(ctx, msg); err != nil {
+		logger.Error("Failed to move queue job to dead letter queue", slog.Any("error", err))
+	}
+}
+
+// runQueuedJob 通过日志装饰器执行任务，并将任务返回的错误或 panic 转换为错误返回。
+func (b *Broker) runQueuedJob(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.Error("Job panicked",
+				slog.String("job_name", job.Name()),
+				slog.Any("panic", r),
+				slog.String("stack_trace", string(debug.Stack())),
+			)
+			err = fmt.Errorf("任务执行时发生 panic: %v", r)
+		}
+	}()
+
+	runner := &queuedJobRunner{ctx: ctx, job: job}
+	NewLoggingWrapper(b.logger)(runner).Run()
+	return runner.err
+}
+
+// queuedJobRunner 执行任务并记录 FallibleJob 返回的错误，Name 透传给日志装饰器
+type queuedJobRunner struct {
+	ctx context.Context
+	job Job
+	err error
+}
+
+func (r *queuedJobRunner) Run() {
+	if fallible, ok := r.job.(FallibleJob); ok {
+		r.err = fallible.Execute(r.ctx)
+		return
+	}
+	r.job.Run()
+}
+
+func (r *queuedJobRunner) Name() string { return r.job.Name() }
+
+func (b *Broker) trackRunning(id string, cancel context.CancelFunc) {
+	b.runningMu.Lock()
+	defer b.runningMu.Unlock()
+	if b.running == nil {
+		b.running = make(map[string]context.CancelFunc)
+	}
+	b.running[id] = cancel
+}
+
+func (b *Broker) untrackRunning(id string) {
+	b.runningMu.Lock()
+	delete(b.running, id)
+	b.runningMu.Unlock()
+}
+
+// queueRetryDelay 返回第 attempts 次失败后的重试等待时间
+func queueRetryDelay(attempts int) time.Duration {
+	delay := queueRetryBaseDelay
+	for i := 1; i < attempts && delay < queueRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > queueRetryMaxDelay {
+		delay = queueRetryMaxDelay
+	}
+	return delay
+}
+
+// QueueStats 返回任务队列各状态的消息数量
+func (b *Broker) QueueStats(ctx context.Context) (*QueueStats, error) {
+	return b.queue.Stats(ctx)
+}
+
+// ListDeadLetters 返回死信队列中的任务
+func (b *Broker) ListDeadLetters(ctx context.Context) ([]*QueueMessage, error) {
+	return b.queue.ListDeadLetters(ctx)
+}
+
+// RetryDeadLetter 将死信任务重新放回任务队列
+func (b *Broker) RetryDeadLetter(ctx context.Context, id string) error {
+	return b.queue.RetryDeadLetter(ctx, id)
+}
+
+// DeleteDeadLetter 删除死信任务
+func (b *Broker) DeleteDeadLetter(ctx context.Context, id string) error {
+	return b.queue.DeleteDeadLetter(ctx, id)
+}
@@ -62,6 +62,9 @@ var AllSettings = []Definition{
 	{Key: constant.KeyDefaultGravatarType, Value: "mp", Comment: "Gravatar默认头像类型", IsPublic: true},
 	{Key: constant.KeyUploadAllowedExtensions, Value: "", Comment: "允许上传的文件后缀名白名单，逗号分隔", IsPublic: true},
 	{Key: constant.KeyUploadDeniedExtensions, Value: "", Comment: "禁止上传的文件后缀名黑名单，在白名单未启用时生效", IsPublic: true},
+	{Key: constant.KeyUploadStaleMaxAgeHours, Value: "24", Comment: "远程存储中未完成的分片上传或上传会话超过多少小时后被定时任务中止并释放空间，0 表示不自动清理", IsPublic: false},
+	{Key: constant.KeyRecycleBinRetentionDays, Value: "30", Comment: "删除的文件在回收站中保留的天数，过期后由定时任务彻底删除，0 表示不使用回收站、删除时直接永久删除", IsPublic: false},
+	{Key: constant.KeyIntegrityAutoRepair, Value: "false", Comment: "每日数据一致性检查发现问题时是否自动修复 (true/false)，关闭时只生成报告", IsPublic: false},
 	{Key: constant.KeyEnableExternalLinkWarning, Value: "false", Comment: "是否开启外链跳转提示 (true/false)，开启后跳转外链会显示中间提示页面", IsPublic: true},
 	{Key: constant.KeyRespectReducedMotion, Value: "false", Comment: "是否尊重系统减弱动效偏好，开启后在用户开启了系统减弱动效时降低前台动画 (true/false)", IsPublic: true},
 	// --- 缩略图生成器配置 ---
@@ -149,6 +152,7 @@ var AllSettings = []Definition{
 
 	{Key: constant.KeyIPAPI, Value: `https://v1.nsuuu.com/api/ipip`, Comment: "获取IP信息 API 地址（全球IPv4/IPv6信息查询）", IsPublic: false},
 	{Key: constant.KeyIPAPIToKen, Value: ``, Comment: "获取IP信息 API Token", IsPublic: false},
+	{Key: constant.KeyOutboundAllowlist, Value: ``, Comment: "出站请求白名单，允许访问的内网主机，逗号分隔，支持域名、*.后缀通配、IP 与 CIDR", IsPublic: false},
 	{Key: constant.KeyPostDefaultCover, Value: ``, Comment: "文章默认封面", IsPublic: true},
 	{Key: constant.KeyPostDefaultDoubleColumn, Value: "true", Comment: "文章默认双栏", IsPublic: true},
 	{Key: constant.KeyPostDefaultPageSize, Value: "12", Comment: "文章默认分页大小", IsPublic: true},
@@ -180,6 +184,7 @@ var AllSettings = []Definition{
 
 	// 文章页面波浪区域配置
 	{Key: constant.KeyPostWavesEnable, Value: "true", Comment: "是否显示文章页面波浪区域 (true/false)，默认显示", IsPublic: true},
+	{Key: constant.KeyPostAutoKeywordsEnable, Value: "true", Comment: "保存文章时关键词为空是否自动提取关键词 (true/false)", IsPublic: false},
 
 	// 文章底部版权声明配置
 	{Key: constant.KeyPostCopyrightOriginalTemplate, Value: "", Comment: "原创文章版权声明模板，支持变量：{license}许可协议、{licenseUrl}协议链接、{author}作者、{siteUrl}站点链接", IsPublic: true},
@@ -219,12 +224,33 @@ var AllSettings = []Definition{
 	{Key: constant.KeyCommentEmojiCDN, Value: "https://npm.elemecdn.com/anzhiyu-theme-static@1.1.3/twikoo/twikoo.json", Comment: "评论表情 cdn链接", IsPublic: true},
 	{Key: constant.KeyCommentBloggerEmail, Value: "me@anheyu.com", Comment: "博主邮箱，用于博主标识", IsPublic: true},
 	{Key: constant.KeyCommentAnonymousEmail, Value: "", Comment: "收取匿名评论邮箱，为空时使用前台网站拥有者邮箱", IsPublic: true},
+	{Key: constant.KeyCommentAnonymousSalt, Value: "", Comment: "匿名评论化名的加盐密钥（首次启动时自动生成），修改后所有匿名化名都会改变", IsPublic: false},
+	{Key: constant.KeyCommentAnonymousDisabledPaths, Value: "", Comment: "禁止匿名评论的路径，逗号或换行分隔，以 * 结尾表示前缀匹配（如 /posts/*）", IsPublic: true},
 	{Key: constant.KeyCommentShowUA, Value: "true", Comment: "是否显示评论者操作系统和浏览器信息", IsPublic: true},
 	{Key: constant.KeyCommentShowRegion, Value: "true", Comment: "是否显示评论者IP归属地", IsPublic: true},
+	{Key: constant.KeyCommentRegionGranularity, Value: "city", Comment: "评论者IP归属地的展示粒度：country（仅国家）、province（省份）、city（城市），调低粒度时会同步改写已保存的归属地", IsPublic: false},
 	{Key: constant.KeyCommentAllowImageUpload, Value: "true", Comment: "是否允许在评论中上传图片", IsPublic: true},
 	{Key: constant.KeyCommentLimitPerMinute, Value: "5", Comment: "单个IP每分钟允许提交的评论数", IsPublic: false},
 	{Key: constant.KeyCommentLimitLength, Value: "10000", Comment: "单条评论最大字数", IsPublic: true},
-	{Key: constant.KeyCommentForbiddenWords, Value: "习近平,空包,毛泽东,代发", Comment: "违禁词列表，逗号分隔，匹配到的评论将进入待审", IsPublic: false},
+	{Key: constant.KeyCommentCodeMaxLength, Value: "3000", Comment: "单条评论中代码块的总字符数上限，0 表示不限制；代码块超过 post.code_block.code_max_lines 行时默认折叠", IsPublic: true},
+	{Key: constant.KeyCommentCleanupEnable, Value: "false", Comment: "是否每天凌晨 4:15 自动执行评论清理规则", IsPublic: false},
+	{Key: constant.KeyCommentCleanupPendingDays, Value: "0", Comment: "待审核评论与垃圾评论超过该天数后自动删除，0 表示不清理", IsPublic: false},
+	{Key: constant.KeyCommentCleanupBannedEmails, Value: "", Comment: "清理这些邮箱发表的评论，逗号或换行分隔，不区分大小写，以 @ 开头表示整个域名（如 @spam.com）", IsPublic: false},
+	{Key: constant.KeyCommentCleanupBannedIPs, Value: "", Comment: "清理这些 IP 发表的评论，逗号或换行分隔，支持 CIDR 网段（如 10.0.0.0/8）", IsPublic: false},
+	{Key: constant.KeyCommentCleanupCollapseOrphans, Value: "true", Comment: "清理时把父评论已删除的回复挂到最近的未删除祖先下，没有祖先时提升为顶级评论", IsPublic: false},
+	{Key: constant.KeyCommentSubscribeEnable, Value: "false", Comment: "是否允许访客留下邮箱订阅评论区，有新评论时按摘要邮件通知", IsPublic: true},
+	{Key: constant.KeyCommentSubscribeDigestInterval, Value: "60", Comment: "同一订阅两封摘要邮件的最短间隔（分钟），间隔内的新评论合并到下一封摘要中", IsPublic: false},
+	{Key: constant.KeyCommentSubscribeMailSubject, Value: "【{{.SITE_NAME}}】「{{.TARGET_TITLE}}」有 {{.COUNT}} 条新评论", Comment: "评论订阅摘要邮件主题模板，支持变量：{{.SITE_NAME}}站点名称、{{.TARGET_TITLE}}页面标题、{{.COUNT}}新评论数", IsPublic: false},
+	{Key: constant.KeyCommentSubscribeMailTemplate, Value: `<div style="max-width:600px;margin:0 auto;padding:20px;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Roboto,sans-serif;"><div style="text-align:center;padding:20px 0;border-bottom:1px solid #eee;"><h1 style="margin:0;color:#333;font-size:24px;">{{.SITE_NAME}}</h1></div><div style="padding:30px 0;"><h2 style="margin:0 0 20px;color:#333;font-size:18px;">您订阅的「<a href="{{.POST_URL}}" style="color:#1a73e8;text-decoration:none;">{{.TARGET_TITLE}}</a>」有 {{.COUNT}} 条新评论</h2>{{range .COMMENTS}}<div style="background:#f8f9fa;border-radius:8px;padding:15px 20px;margin-bottom:12px;"><p style="margin:0 0 8px;color:#666;font-size:13px;"><strong style="color:#333;">{{.NICK}}</strong> · {{.TIME}}</p><div style="color:#333;font-size:14px;line-height:1.6;">{{.COMMENT}}</div></div>{{end}}<a href="{{.POST_URL}}#post-comment" style="display:inline-block;background:#1a73e8;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:500;">查看评论</a></div><div style="padding:20px 0;border-top:1px solid #eee;text-align:center;color:#999;font-size:12px;"><p style="margin:0 0 10px;">您收到此邮件是因为您订阅了该页面的评论。</p><p style="margin:0;"><a href="{{.UNSUBSCRIBE_URL}}" style="color:#999;">退订此页面的评论通知</a></p></div></div>`, Comment: "评论订阅摘要邮件HTML模板，支持变量：{{.SITE_NAME}}站点名称、{{.TARGET_TITLE}}页面标题、{{.POST_URL}}页面链接、{{.COUNT}}新评论数、{{.COMMENTS}}评论列表（每项含 NICK、TIME、COMMENT）、{{.UNSUBSCRIBE_URL}}退订链接", IsPublic: false},
+	{Key: constant.KeyCommentForbiddenWords, Value: "习近平,空包,毛泽东,代发", Comment: "违禁词规则，支持逗号分隔的关键词（命中进入待审）或 JSON 规则列表（keyword/wildcard/regex，动作 pending/reject/replace）", IsPublic: false},
+	{Key: constant.KeyCommentProfileEnable, Value: "true", Comment: "是否公开评论者资料卡片（评论数、首次/最近评论时间、最近评论），只统计已发布的非匿名评论", IsPublic: true},
+	{Key: constant.KeyCommentProfileRecentCount, Value: "5", Comment: "评论者资料卡片展示的最近评论数（0-20），0 表示不展示", IsPublic: false},
+	{Key: constant.KeyCommentFirstCommentReview, Value: "false", Comment: "访客首次评论需审核，通过后同一邮箱的后续评论自动发布；匿名评论在开启后总是需要审核", IsPublic: false},
+	{Key: constant.KeyCommentSpamHoneypotEnable, Value: "false", Comment: "是否启用蜜罐字段：前端在评论表单中渲染对用户不可见的输入框，提交时填写了该字段的评论判定为垃圾评论", IsPublic: true},
+	{Key: constant.KeyCommentSpamAkismetKey, Value: "", Comment: "Akismet API Key，填写后新评论会提交给 Akismet 检测，管理员标记垃圾/正常评论时同步反馈给 Akismet；留空表示不使用", IsPublic: false},
+	{Key: constant.KeyCommentSpamBayesEnable, Value: "false", Comment: "是否启用本地贝叶斯过滤器，管理员标记垃圾/正常评论时自动训练", IsPublic: false},
+	{Key: constant.KeyCommentSpamBayesThreshold, Value: "0.9", Comment: "贝叶斯过滤器判定为垃圾评论的概率阈值 (0-1)，越大越保守", IsPublic: false},
+	{Key: constant.KeyCommentSpamBayesMinSamples, Value: "20", Comment: "垃圾与正常样本各至少标记该数量后贝叶斯过滤器才开始判定，避免样本太少时误判", IsPublic: false},
 	{Key: constant.KeyCommentAIDetectEnable, Value: "false", Comment: "是否启用AI违禁词检测", IsPublic: false},
 	{Key: constant.KeyCommentAIDetectAPIURL, Value: "https://v1.nsuuu.com/api/AiDetect", Comment: "AI违禁词检测API地址", IsPublic: false},
 	{Key: constant.KeyCommentAIDetectAction, Value: "pending", Comment: "检测到违禁词时的处理方式: pending(待审), reject(拒绝)", IsPublic: false},
@@ -233,6 +259,8 @@ var AllSettings = []Definition{
 	{Key: constant.KeyCommentQQAPIKey, Value: "", Comment: "QQ信息查询API密钥", IsPublic: false},
 	{Key: constant.KeyCommentNotifyAdmin, Value: "false", Comment: "是否在收到评论时邮件通知博主", IsPublic: false},
 	{Key: constant.KeyCommentNotifyReply, Value: "true", Comment: "是否开启评论回复邮件通知功能", IsPublic: false},
+	{Key: constant.KeyCommentNotifyMention, Value: "true", Comment: "是否在评论中被 @ 提及时通知对方（注册用户按其通知偏好设置）", IsPublic: false},
+	{Key: constant.KeyCommentNotifyApproved, Value: "false", Comment: "待审核的评论通过后是否邮件通知评论者（注册用户按其通知偏好设置）", IsPublic: false},
 	{Key: constant.KeyPushooChannel, Value: "", Comment: "即时消息推送平台名称，支持：bark, webhook", IsPublic: false},
 	{Key: constant.KeyPushooURL, Value: "", Comment: "即时消息推送URL地址 (支持模板变量)", IsPublic: false},
 	{Key: constant.KeyWebhookRequestBody, Value: `{"title":"#{TITLE}","content":"#{BODY}","site_name":"#{SITE_NAME}","comment_author":"#{NICK}","comment_content":"#{COMMENT}","parent_author":"#{PARENT_NICK}","parent_content":"#{PARENT_COMMENT}","post_url":"#{POST_URL}","author_email":"#{MAIL}","author_ip":"#{IP}","time":"#{TIME}"}`, Comment: "Webhook自定义请求体模板，支持变量替换：#{TITLE}, #{BODY}, #{SITE_NAME}, #{NICK}, #{COMMENT}, #{PARENT_NICK}, #{PARENT_COMMENT}, #{POST_URL}, #{MAIL}, #{IP}, #{TIME}", IsPublic: false},
@@ -242,6 +270,10 @@ var AllSettings = []Definition{
 	{Key: constant.KeyCommentMailSubjectAdmin, Value: "您的博客 [{{.SITE_NAME}}] 上有新评论了", Comment: "博主收到新评论的邮件主题模板", IsPublic: false},
 	{Key: constant.KeyCommentMailTemplate, Value: `<div class="flex-col page"><div class="flex-col box_3" style="display: flex;position: relative;width: 100%;height: 206px;background: #ef859d2e;top: 0;left: 0;justify-content: center;"><div class="flex-col section_1" style="background-image: url('{{.PARENT_IMG}}');position: absolute;width: 152px;height: 152px;display: flex;top: 130px;background-size: cover;border-radius: 50%;"></div></div><div class="flex-col box_4" style="margin-top: 92px;display: flex;flex-direction: column;align-items: center;"><div class="flex-col justify-between text-group_5" style="display: flex;flex-direction: column;align-items: center;margin: 0 20px;"><span class="text_1" style="font-size: 26px;font-family: PingFang-SC-Bold, PingFang-SC;font-weight: bold;color: #000000;line-height: 37px;text-align: center;">嘿！你在&nbsp;{{.SITE_NAME}}&nbsp;博客中收到一条新回复。</span><span class="text_2" style="font-size: 16px;font-family: PingFang-SC-Bold, PingFang-SC;font-weight: bold;color: #00000030;line-height: 22px;margin-top: 21px;text-align: center;">你之前的评论&nbsp;在&nbsp;{{.SITE_NAME}} 博客中收到来自&nbsp;{{.NICK}}&nbsp;的回复</span></div><div class="flex-row box_2" style="margin: 0 20px;min-height: 128px;background: #F7F7F7;border-radius: 12px;margin-top: 34px;display: flex;flex-direction: column;align-items: flex-start;padding: 32px 16px;width: calc(100% - 40px);"><div class="flex-col justify-between text-wrapper_4" style="display: flex;flex-direction: column;margin-left: 30px;margin-bottom: 16px;"><span class="text_3" style="height: 22px;font-size: 16px;font-family: PingFang-SC-Bold, PingFang-SC;font-weight: bold;color: #C5343E;line-height: 22px;">{{.PARENT_NICK}}</span><span class="text_4" style="margin-top: 6px;margin-right: 22px;font-size: 16px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #000000;line-height: 22px;">{{.PARENT_COMMENT}}</span></div><hr style="display: flex;position: relative;border: 1px dashed #ef859d2e;box-sizing: content-box;height: 0px;overflow: visible;width: 100%;"><div class="flex-col justify-between text-wrapper_4" style="display: flex;flex-direction: column;margin-left: 30px;"><hr><span class="text_3" style="height: 22px;font-size: 16px;font-family: PingFang-SC-Bold, PingFang-SC;font-weight: bold;color: #C5343E;line-height: 22px;">{{.NICK}}</span><span class="text_4" style="margin-top: 6px;margin-right: 22px;font-size: 16px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #000000;line-height: 22px;">{{.COMMENT}}</span></div><a class="flex-col text-wrapper_2" style="min-width: 106px;height: 38px;background: #ef859d38;border-radius: 32px;display: flex;align-items: center;justify-content: center;text-decoration: none;margin: auto;margin-top: 32px;" href="{{.POST_URL}}"><span class="text_5" style="color: #DB214B;">查看详情</span></a></div><div class="flex-col justify-between text-group_6" style="display: flex;flex-direction: column;align-items: center;margin-top: 34px;"><span class="text_6" style="height: 17px;font-size: 12px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #00000045;line-height: 17px;">此邮件由评论服务自动发出，直接回复无效。</span><a class="text_7" style="height: 17px;font-size: 12px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #DB214B;line-height: 17px;margin-top: 6px;text-decoration: none;" href="{{.SITE_URL}}">前往博客</a></div></div></div>`, Comment: "用户收到回复的邮件HTML模板", IsPublic: false},
 	{Key: constant.KeyCommentMailTemplateAdmin, Value: `<div class="flex-col page"><div class="flex-col box_3" style="display: flex;position: relative;width: 100%;height: 206px;background: #ef859d2e;top: 0;left: 0;justify-content: center;"><div class="flex-col section_1" style="background-image: url('{{.IMG}}');position: absolute;width: 152px;height: 152px;display: flex;top: 130px;background-size: cover;border-radius: 50%;"></div></div><div class="flex-col box_4" style="margin-top: 92px;display: flex;flex-direction: column;align-items: center;"><div class="flex-col justify-between text-group_5" style="display: flex;flex-direction: column;align-items: center;margin: 0 20px;"><span class="text_1" style="font-size: 26px;font-family: PingFang-SC-Bold, PingFang-SC;font-weight: bold;color: #000000;line-height: 37px;text-align: center;">嘿！你的&nbsp;{{.SITE_NAME}}&nbsp;博客中收到一条新消息。</span></div><div class="flex-row box_2" style="margin: 0 20px;min-height: 128px;background: #F7F7F7;border-radius: 12px;margin-top: 34px;display: flex;flex-direction: column;align-items: flex-start;padding: 32px 16px;"><div class="flex-col justify-between text-wrapper_4" style="display: flex;flex-direction: column;margin-left: 30px;"><hr><span class="text_3" style="height: 22px;font-size: 16px;font-family: PingFang-SC-Bold, PingFang-SC;font-weight: bold;color: #C5343E;line-height: 22px;">{{.NICK}} ({{.MAIL}}, {{.IP}})</span><span class="text_4" style="margin-top: 6px;margin-right: 22px;font-size: 16px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #000000;line-height: 22px;">{{.COMMENT}}</span></div><a class="flex-col text-wrapper_2" style="min-width: 106px;height: 38px;background: #ef859d38;border-radius: 32px;display: flex;align-items: center;justify-content: center;text-decoration: none;margin: auto;margin-top: 32px;" href="{{.POST_URL}}"><span class="text_5" style="color: #DB214B;">查看详情</span></a></div><div class="flex-col justify-between text-group_6" style="display: flex;flex-direction: column;align-items: center;margin-top: 34px;"><span class="text_6" style="height: 17px;font-size: 12px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #00000045;line-height: 17px;">此邮件由评论服务自动发出，直接回复无效。</span><a class="text_7" style="height: 17px;font-size: 12px;font-family: PingFangSC-Regular, PingFang SC;font-weight: 400;color: #DB214B;line-height: 17px;margin-top: 6px;text-decoration: none;" href="{{.SITE_URL}}">前往博客</a></div></div></div>`, Comment: "博主收到新评论的邮件HTML模板", IsPublic: false},
+	{Key: constant.KeyCommentMailSubjectMention, Value: "{{.NICK}} 在 [{{.SITE_NAME}}] 的评论中提到了您", Comment: "评论提及通知邮件主题模板，支持变量：{{.SITE_NAME}}站点名称、{{.NICK}}评论者昵称、{{.TARGET_TITLE}}页面标题", IsPublic: false},
+	{Key: constant.KeyCommentMailTemplateMention, Value: `<div style="max-width:600px;margin:0 auto;padding:20px;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Roboto,sans-serif;"><div style="text-align:center;padding:20px 0;border-bottom:1px solid #eee;"><h1 style="margin:0;color:#333;font-size:24px;">{{.SITE_NAME}}</h1></div><div style="padding:30px 0;"><h2 style="margin:0 0 20px;color:#333;font-size:18px;">{{.MENTIONED_NICK}}，{{.NICK}} 在「<a href="{{.POST_URL}}" style="color:#1a73e8;text-decoration:none;">{{.TARGET_TITLE}}</a>」的评论中提到了您</h2><div style="background:#f8f9fa;border-radius:8px;padding:15px 20px;margin-bottom:20px;"><p style="margin:0 0 8px;color:#666;font-size:13px;"><img src="{{.IMG}}" alt="" style="width:20px;height:20px;border-radius:50%;vertical-align:middle;margin-right:6px;"><strong style="color:#333;">{{.NICK}}</strong> · {{.TIME}}</p><div style="color:#333;font-size:14px;line-height:1.6;">{{.COMMENT}}</div></div><a href="{{.POST_URL}}" style="display:inline-block;background:#1a73e8;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:500;">查看评论</a></div><div style="padding:20px 0;border-top:1px solid #eee;text-align:center;color:#999;font-size:12px;"><p style="margin:0;">此邮件由系统自动发送，请勿直接回复。</p></div></div>`, Comment: "评论提及通知邮件HTML模板，支持变量：{{.SITE_NAME}}站点名称、{{.POST_URL}}评论链接、{{.TARGET_TITLE}}页面标题、{{.MENTIONED_NICK}}被提及者昵称、{{.NICK}}评论者昵称、{{.IMG}}评论者头像、{{.TIME}}评论时间、{{.COMMENT}}评论内容", IsPublic: false},
+	{Key: constant.KeyCommentMailSubjectApproved, Value: "您在 [{{.SITE_NAME}}] 的评论已通过审核", Comment: "评论审核通过通知邮件主题模板，支持变量：{{.SITE_NAME}}站点名称、{{.NICK}}评论者昵称、{{.TARGET_TITLE}}页面标题", IsPublic: false},
+	{Key: constant.KeyCommentMailTemplateApproved, Value: `<div style="max-width:600px;margin:0 auto;padding:20px;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Roboto,sans-serif;"><div style="text-align:center;padding:20px 0;border-bottom:1px solid #eee;"><h1 style="margin:0;color:#333;font-size:24px;">{{.SITE_NAME}}</h1></div><div style="padding:30px 0;"><h2 style="margin:0 0 20px;color:#333;font-size:18px;">{{.NICK}}，您在「<a href="{{.POST_URL}}" style="color:#1a73e8;text-decoration:none;">{{.TARGET_TITLE}}</a>」发表的评论已通过审核</h2><div style="background:#f8f9fa;border-radius:8px;padding:15px 20px;margin-bottom:20px;"><p style="margin:0 0 8px;color:#666;font-size:13px;"><img src="{{.IMG}}" alt="" style="width:20px;height:20px;border-radius:50%;vertical-align:middle;margin-right:6px;"><strong style="color:#333;">{{.NICK}}</strong> · {{.TIME}}</p><div style="color:#333;font-size:14px;line-height:1.6;">{{.COMMENT}}</div></div><p style="margin:0 0 20px;color:#666;font-size:14px;">您的评论现在已对所有访客可见，感谢您的参与！</p><a href="{{.COMMENT_URL}}" style="display:inline-block;background:#1a73e8;color:#fff;padding:12px 24px;border-radius:6px;text-decoration:none;font-weight:500;">查看评论</a></div><div style="padding:20px 0;border-top:1px solid #eee;text-align:center;color:#999;font-size:12px;"><p style="margin:0;">此邮件由系统自动发送，请勿直接回复。</p></div></div>`, Comment: "评论审核通过通知邮件HTML模板，支持变量：{{.SITE_NAME}}站点名称、{{.POST_URL}}页面链接、{{.COMMENT_URL}}评论链接、{{.TARGET_TITLE}}页面标题、{{.NICK}}评论者昵称、{{.IMG}}评论者头像、{{.TIME}}评论时间、{{.COMMENT}}评论内容", IsPublic: false},
 
 	// 评论 SMTP 配置（独立于系统SMTP，用于评论通知）
 	{Key: constant.KeyCommentSmtpSenderName, Value: "", Comment: "评论邮件发送人名称（留空使用系统SMTP配置）", IsPublic: false},
@@ -265,8 +297,9 @@ var AllSettings = []Definition{
 	{Key: constant.KeySidebarTagsEnable, Value: "true", Comment: "是否启用侧边栏标签卡片", IsPublic: true},
 	{Key: constant.KeySidebarTagsHighlight, Value: "[]", Comment: "侧边栏高亮标签", IsPublic: true},
 	{Key: constant.KeySidebarSiteInfoRuntimeEnable, Value: "true", Comment: "是否在侧边栏显示建站天数", IsPublic: true},
-	{Key: constant.KeySidebarSiteInfoTotalPostCount, Value: "0", Comment: "侧边栏网站信息-文章总数 (此值由系统自动更新)", IsPublic: true},
-	{Key: constant.KeySidebarSiteInfoTotalWordCount, Value: "0", Comment: "侧边栏网站信息-全站总字数 (此值由系统自动更新)", IsPublic: true},
+	{Key: constant.KeySidebarSiteInfoTotalPostCount, Value: "0", Comment: "侧边栏网站信息-文章总数 (设置为 -1 时不显示，数值由站点统计接口提供)", IsPublic: true},
+	{Key: constant.KeySidebarSiteInfoTotalWordCount, Value: "0", Comment: "侧边栏网站信息-全站总字数 (设置为 -1 时不显示，数值由站点统计接口提供)", IsPublic: true},
+	{Key: constant.KeySidebarSiteInfoTotalCommentCount, Value: "0", Comment: "侧边栏网站信息-评论总数 (设置为 -1 时不显示，数值由站点统计接口提供)", IsPublic: true},
 	{Key: constant.KeySidebarArchiveCount, Value: "0", Comment: "侧边栏归档个数", IsPublic: true},
 	{Key: constant.KeySidebarCustomShowInPost, Value: "false", Comment: "自定义侧边栏是否在文章页显示", IsPublic: true},
 	{Key: constant.KeySidebarTocCollapseMode, Value: "false", Comment: "目录折叠模式 (true/false)，开启后目录会根据当前阅读位置自动折叠展开子标题", IsPublic: true},
@@ -396,22 +429,52 @@ var AllSettings = []Definition{
 	{Key: constant.KeyFriendLinkReviewMailTemplateApproved, Value: "", Comment: "友链审核通过邮件HTML模板（留空使用默认模板）", IsPublic: false},
 	{Key: constant.KeyFriendLinkReviewMailSubjectRejected, Value: "【{{.SITE_NAME}}】友链申请未通过", Comment: "友链审核拒绝邮件主题模板", IsPublic: false},
 	{Key: constant.KeyFriendLinkReviewMailTemplateRejected, Value: "", Comment: "友链审核拒绝邮件HTML模板（留空使用默认模板）", IsPublic: false},
+	// 友链健康检查配置
+	{Key: constant.KeyFriendLinkHealthCheckInterval, Value: "24", Comment: "友链健康检查的默认间隔（小时），单个友链可单独覆盖，0 表示只检查单独设置了间隔的友链", IsPublic: false},
+	{Key: constant.KeyFriendLinkSickThreshold, Value: "3", Comment: "友链连续检查失败多少次后标记为失联并移入失联分类", IsPublic: false},
+	{Key: constant.KeyFriendLinkSickCategoryName, Value: "失联友链", Comment: "失联友链移入的分类名称，不存在时自动创建，留空表示只标记失联、不移动分类", IsPublic: false},
+	{Key: constant.KeyFriendLinkSSLWarnDays, Value: "14", Comment: "友链 HTTPS 证书剩余有效天数低于该值时在检查结果中提示", IsPublic: false},
+	{Key: constant.KeyFriendLinkSickMailEnable, Value: "false", Comment: "友链被标记为失联时是否邮件通知友链站长 (true/false)", IsPublic: false},
+	{Key: constant.KeyFriendLinkSickMailSubject, Value: "【{{.SITE_NAME}}】您的友链暂时无法访问", Comment: "友链失联通知邮件主题模板", IsPublic: false},
+	{Key: constant.KeyFriendLinkSickMailTemplate, Value: "", Comment: "友链失联通知邮件HTML模板（留空使用默认模板）", IsPublic: false},
 
 	// --- 内部或敏感配置 ---
-	{Key: constant.KeyJWTSecret, Value: "", Comment: "JWT密钥", IsPublic: false},
-	{Key: constant.KeyLocalFileSigningSecret, Value: "", Comment: "本地文件签名密钥", IsPublic: false},
+	{Key: constant.KeyJWTSecret, Value: "", Comment: "JWT密钥（首次启动时自动生成）", IsPublic: false},
+	{Key: constant.KeyJWTSecretPrevious, Value: "", Comment: "轮换前的JWT密钥，宽限期内仍可验证旧令牌", IsPublic: false},
+	{Key: constant.KeyJWTPreviousExpiresAt, Value: "", Comment: "旧JWT密钥的宽限期截止时间 (Unix 秒)", IsPublic: false},
+	{Key: constant.KeyLocalFileSigningSecret, Value: "", Comment: "本地文件签名密钥（首次启动时自动生成）", IsPublic: false},
 	{Key: constant.KeyResetPasswordSubject, Value: "【{{.AppName}}】重置您的账户密码", Comment: "重置密码邮件主题模板", IsPublic: false},
 	{Key: constant.KeyResetPasswordTemplate, Value: `<!DOCTYPE html><html><head><title>重置密码</title></head><body><p>您好, {{.Nickname}}！</p><p>您正在请求重置您在 <strong>{{.AppName}}</strong> 的账户密码。</p><p>请点击以下链接以完成重置（此链接24小时内有效）：</p><p><a href="{{.ResetLink}}">重置我的密码</a></p><p>如果链接无法点击，请将其复制到浏览器地址栏中打开。</p><p>如果您没有请求重置密码，请忽略此邮件。</p><br/><p>感谢, <br/>{{.AppName}} 团队</p></body></html>`, Comment: "重置密码邮件HTML模板", IsPublic: false},
 	{Key: constant.KeyActivateAccountSubject, Value: "【{{.AppName}}】激活您的账户", Comment: "用户激活邮件主题模板", IsPublic: false},
 	{Key: constant.KeyActivateAccountTemplate, Value: `<!DOCTYPE html><html><head><title>激活您的账户</title></head><body><p>您好, {{.Nickname}}！</p><p>欢迎注册 <strong>{{.AppName}}</strong>！</p><p>请点击以下链接以激活您的账户（此链接24小时内有效）：</p><p><a href="{{.ActivateLink}}">激活我的账户</a></p><p>如果链接无法点击，请将其复制到浏览器地址栏中打开。</p><p>如果您并未注册，请忽略此邮件。</p><br/><p>感谢, <br/>{{.AppName}} 团队</p></body></html>`, Comment: "用户激活邮件HTML模板", IsPublic: false},
 	{Key: constant.KeyEnableUserActivation, Value: "false", Comment: "是否开启新用户邮箱激活功能 (true/false)", IsPublic: false},
 	{Key: constant.KeyEnableRegistration, Value: "true", Comment: "是否开启用户注册功能 (true/false)", IsPublic: true},
+	{Key: constant.KeyRegistrationInvite, Value: "false", Comment: "是否仅允许凭邀请码注册 (true/false)", IsPublic: true},
+	{Key: constant.KeyRegistrationDomains, Value: "", Comment: "允许注册的邮箱域名，多个用逗号分隔，留空表示不限制", IsPublic: true},
+	{Key: constant.KeySetupCompleted, Value: "false", Comment: "是否已完成初始化向导 (true/false)，完成后向导接口关闭", IsPublic: false},
+	{Key: constant.KeyOAuthGithubEnable, Value: "false", Comment: "是否启用 GitHub 登录 (true/false)，回调地址为 {站点地址}/api/auth/oauth/github/callback", IsPublic: true},
+	{Key: constant.KeyOAuthGithubClientID, Value: "", Comment: "GitHub OAuth App Client ID", IsPublic: false},
+	{Key: constant.KeyOAuthGithubClientSecret, Value: "", Comment: "GitHub OAuth App Client Secret", IsPublic: false},
+	{Key: constant.KeyOAuthGoogleEnable, Value: "false", Comment: "是否启用 Google 登录 (true/false)，回调地址为 {站点地址}/api/auth/oauth/google/callback", IsPublic: true},
+	{Key: constant.KeyOAuthGoogleClientID, Value: "", Comment: "Google OAuth 客户端 ID", IsPublic: false},
+	{Key: constant.KeyOAuthGoogleClientSecret, Value: "", Comment: "Google OAuth 客户端密钥", IsPublic: false},
+	{Key: constant.KeyOAuthQQEnable, Value: "false", Comment: "是否启用 QQ 登录 (true/false)，回调地址为 {站点地址}/api/auth/oauth/qq/callback", IsPublic: true},
+	{Key: constant.KeyOAuthQQClientID, Value: "", Comment: "QQ 互联 APP ID", IsPublic: false},
+	{Key: constant.KeyOAuthQQClientSecret, Value: "", Comment: "QQ 互联 APP Key", IsPublic: false},
+	{Key: constant.KeyDisposableEmailCommentAction, Value: "off", Comment: "评论使用一次性邮箱时的处理方式 (off: 不处理, reject: 拒绝, hold: 进入待审核)", IsPublic: false},
+	{Key: constant.KeyDisposableEmailRegisterAction, Value: "off", Comment: "注册使用一次性邮箱时的处理方式 (off: 不处理, reject: 拒绝, hold: 账户需管理员审核后启用)", IsPublic: false},
+	{Key: constant.KeyDisposableEmailAllowlist, Value: "", Comment: "不视为一次性邮箱的域名，多个用逗号或换行分隔，同时放行其子域名", IsPublic: false},
+	{Key: constant.KeyDisposableEmailAutoUpdate, Value: "true", Comment: "是否每天自动更新一次性邮箱域名列表 (true/false)", IsPublic: false},
+	{Key: constant.KeyDisposableEmailListURL, Value: "https://raw.githubusercontent.com/disposable-email-domains/disposable-email-domains/main/disposable_email_blocklist.conf", Comment: "一次性邮箱域名列表的下载地址，每行一个域名，# 开头为注释", IsPublic: false},
+	{Key: constant.KeyChangelogGitHubRepo, Value: "", Comment: "同步更新日志的 GitHub 仓库，格式为 owner/repo，留空则只使用手动录入的更新日志", IsPublic: false},
+	{Key: constant.KeyChangelogAutoSync, Value: "true", Comment: "是否每小时自动同步 GitHub Releases 到更新日志 (true/false)", IsPublic: false},
+	{Key: constant.KeyChangelogIncludePrerelease, Value: "false", Comment: "同步 GitHub Releases 时是否包含预发布版本 (true/false)", IsPublic: false},
 	{Key: constant.KeySmtpHost, Value: "smtp.qq.com", Comment: "SMTP 服务器地址", IsPublic: false},
 	{Key: constant.KeySmtpPort, Value: "587", Comment: "SMTP 服务器端口 (587 for STARTTLS, 465 for SSL)", IsPublic: false},
-	{Key: constant.KeySmtpUsername, Value: "user@example.com", Comment: "SMTP 登录用户名", IsPublic: false},
+	{Key: constant.KeySmtpUsername, Value: "", Comment: "SMTP 登录用户名", IsPublic: false},
 	{Key: constant.KeySmtpPassword, Value: "", Comment: "SMTP 登录密码", IsPublic: false},
 	{Key: constant.KeySmtpSenderName, Value: "安和鱼", Comment: "邮件发送人名称", IsPublic: false},
-	{Key: constant.KeySmtpSenderEmail, Value: "user@example.com", Comment: "邮件发送人邮箱地址", IsPublic: false},
+	{Key: constant.KeySmtpSenderEmail, Value: "", Comment: "邮件发送人邮箱地址", IsPublic: false},
 	{Key: constant.KeySmtpReplyToEmail, Value: "", Comment: "回信邮箱地址", IsPublic: false},
 	{Key: constant.KeySmtpForceSSL, Value: "false", Comment: "是否强制使用 SSL (设为true通常配合465端口)", IsPublic: false},
 
@@ -462,6 +525,20 @@ var AllSettings = []Definition{
 	{Key: constant.KeyMusicPlayerCustomPlaylist, Value: "", Comment: "自定义音乐歌单JSON文件链接（音乐馆页面使用）", IsPublic: true},
 	{Key: constant.KeyMusicCapsuleCustomPlaylist, Value: "", Comment: "音乐胶囊自定义歌单JSON文件链接（胶囊播放器使用，独立于音乐馆配置）", IsPublic: true},
 	{Key: constant.KeyMusicAPIBaseURL, Value: "https://metings.qjqq.cn", Comment: "音乐API基础地址（不带末尾斜杠）", IsPublic: true},
+	{Key: constant.KeyMusicAPIPlaylistEndpoint, Value: "/Playlist", Comment: "歌单接口地址，以 / 开头时拼接在音乐API基础地址之后，也可填写完整 URL", IsPublic: false},
+	{Key: constant.KeyMusicAPISongEndpoint, Value: "/Song_V1", Comment: "高音质歌曲/歌词解析接口地址，规则同歌单接口", IsPublic: false},
+	{Key: constant.KeyMusicAPIHighQualityEnable, Value: "true", Comment: "是否启用高音质歌曲解析接口，关闭后歌曲资源接口返回空结果 (true/false)", IsPublic: false},
+	{Key: constant.KeyMusicAPITimeout, Value: "15", Comment: "音乐API单次请求超时时间（秒，1-120）", IsPublic: false},
+	{Key: constant.KeyMusicAPIPicTimeout, Value: "3", Comment: "封面图片地址解析超时时间（秒，1-120）", IsPublic: false},
+	{Key: constant.KeyMusicAPIUserAgent, Value: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/141.0.0.0 Safari/537.36", Comment: "请求音乐API时使用的 User-Agent", IsPublic: false},
+	{Key: constant.KeyMusicAPIHeaders, Value: "", Comment: `请求音乐API时附加的请求头 (JSON对象，如 {"Referer":"https://example.com/"})，会覆盖默认请求头`, IsPublic: false},
+	{Key: constant.KeyMusicSourceNeteaseEnable, Value: "true", Comment: "歌单来源：是否启用网易云歌单（music.player.playlist_id）(true/false)", IsPublic: false},
+	{Key: constant.KeyMusicSourceQQEnable, Value: "false", Comment: "歌单来源：是否启用QQ音乐歌单 (true/false)", IsPublic: false},
+	{Key: constant.KeyMusicSourceQQPlaylistID, Value: "", Comment: "QQ音乐歌单ID", IsPublic: false},
+	{Key: constant.KeyMusicSourceQQAPIURL, Value: "https://api.i-meto.com/meting/api?server=tencent&type=playlist&id={id}", Comment: "QQ音乐歌单解析接口（Meting API 格式，{id} 会被替换为歌单ID）", IsPublic: false},
+	{Key: constant.KeyMusicSourceCustomEnable, Value: "false", Comment: "歌单来源：是否合并自定义歌单JSON（music.player.custom_playlist）(true/false)", IsPublic: false},
+	{Key: constant.KeyMusicSourceLocalEnable, Value: "false", Comment: "歌单来源：是否启用本地文件夹中的音乐 (true/false)", IsPublic: false},
+	{Key: constant.KeyMusicSourceLocalFolder, Value: "/music", Comment: "本地音乐文件夹路径（站长网盘中的目录，同名 .lrc 文件作为歌词）", IsPublic: false},
 	{Key: constant.KeyMusicVinylBackground, Value: "/static/img/music-vinyl-background.png", Comment: "音乐播放器唱片背景图", IsPublic: true},
 	{Key: constant.KeyMusicVinylOuter, Value: "/static/img/music-vinyl-outer.png", Comment: "音乐播放器唱片外圈图", IsPublic: true},
 	{Key: constant.KeyMusicVinylInner, Value: "/static/img/music-vinyl-inner.png", Comment: "音乐播放器唱片内圈图", IsPublic: true},
@@ -493,8 +570,113 @@ var AllSettings = []Definition{
 	{Key: constant.KeyAlbumPageDefaultBigParam, Value: "", Comment: "相册大图处理参数", IsPublic: true},
 	{Key: constant.KeyAlbumPageAboutLink, Value: "", Comment: "相册页面关于按钮链接，留空则使用全局关于链接", IsPublic: true},
 
+	// --- 隐私与数据保留配置 ---
+	{Key: constant.KeyPrivacyVisitorIPRetentionDays, Value: "0", Comment: "访问日志 IP 保留天数，超过后 IPv4 截断为 /24、IPv6 截断为 /48，0 表示不处理", IsPublic: false},
+
+	// --- 只读模式配置 ---
+	{Key: constant.KeyReadOnlyModeEnable, Value: "false", Comment: "是否开启站点只读模式，开启后所有写操作返回 503，仅可浏览", IsPublic: true},
+	{Key: constant.KeyReadOnlyModeMessage, Value: "站点正在维护中，暂时只能浏览，请稍后再试", Comment: "只读模式下的提示信息", IsPublic: true},
+
+	// --- 说说配置 ---
+	{Key: constant.KeyMomentsIncludeInRSS, Value: "false", Comment: "是否在 RSS 订阅中包含公开的说说，与文章按时间混排", IsPublic: false},
+
+	// --- RSS 订阅配置 ---
+	{Key: constant.KeyRSSItemCount, Value: "20", Comment: "文章 RSS 订阅输出的条目数（1-100）", IsPublic: false},
+	{Key: constant.KeyRSSCommentItemCount, Value: "20", Comment: "评论 Atom 订阅输出的条目数（1-100）", IsPublic: false},
+	{Key: constant.KeyRSSFullText, Value: "false", Comment: "文章 RSS 是否通过 content:encoded 输出全文，关闭时仅输出摘要", IsPublic: false},
+
+	// --- 外部平台资料聚合配置 ---
+	{Key: constant.KeyProfileBilibiliUID, Value: "", Comment: "Bilibili 用户 UID，用于展示粉丝数，为空则不启用", IsPublic: false},
+	{Key: constant.KeyProfileDoubanUserID, Value: "", Comment: "豆瓣用户 ID，用于展示看过的电影，为空则不启用", IsPublic: false},
+	{Key: constant.KeyProfileSteamID, Value: "", Comment: "Steam 64 位 ID，用于展示最近游玩，为空则不启用", IsPublic: false},
+	{Key: constant.KeyProfileSteamAPIKey, Value: "", Comment: "Steam Web API Key，仅在服务端使用，不会下发给浏览器", IsPublic: false},
+	{Key: constant.KeyProfileRefreshMinutes, Value: "60", Comment: "外部资料的刷新间隔（分钟），第三方接口失败时继续返回上次的数据", IsPublic: false},
+
+	// --- 天气代理配置 ---
+	{Key: constant.KeyWeatherProvider, Value: "", Comment: "天气服务商，可选 qweather（和风天气）或 openweather，为空则不启用天气代理", IsPublic: true},
+	{Key: constant.KeyWeatherQWeatherKey, Value: "", Comment: "和风天气 API Key，仅在服务端使用，不会下发给浏览器", IsPublic: false},
+	{Key: constant.KeyWeatherQWeatherHost, Value: "devapi.qweather.com", Comment: "和风天气 API Host，新账号请填写控制台中的专属 Host", IsPublic: false},
+	{Key: constant.KeyWeatherOpenWeatherKey, Value: "", Comment: "OpenWeather API Key，仅在服务端使用，不会下发给浏览器", IsPublic: false},
+	{Key: constant.KeyWeatherCacheMinutes, Value: "30", Comment: "同一位置（约 1 公里范围）天气数据的缓存时长（分钟）", IsPublic: false},
+
+	// --- 文章语音朗读（TTS）配置 ---
+	{Key: constant.KeyTTSProvider, Value: "", Comment: "语音合成服务商，可选 openai、azure 或 edge（OpenAI 兼容的 Edge-TTS 网关，如 openai-edge-tts），为空则不生成文章音频", IsPublic: false},
+	{Key: constant.KeyTTSAPIURL, Value: "", Comment: "语音合成服务地址：openai/edge 填写 Base URL（openai 为空时使用官方地址），azure 填写语音服务区域，如 eastasia", IsPublic: false},
+	{Key: constant.KeyTTSAPIKey, Value: "", Comment: "语音合成 API Key，仅在服务端使用，不会下发给浏览器", IsPublic: false},
+	{Key: constant.KeyTTSModel, Value: "tts-1", Comment: "OpenAI 兼容接口使用的语音合成模型", IsPublic: false},
+	{Key: constant.KeyTTSVoice, Value: "", Comment: "发音人，为空时使用服务商默认值（openai: alloy，azure/edge: zh-CN-XiaoxiaoNeural）", IsPublic: false},
+	{Key: constant.KeyTTSMaxChars, Value: "20000", Comment: "单篇文章参与朗读的最大字符数，超出部分会被截断", IsPublic: false},
+
+	// --- 通知投递队列配置 ---
+	{Key: constant.KeyNotificationDeliveryMaxAttempts, Value: "5", Comment: "即时推送与通知邮件的最大尝试次数，失败后按指数退避重试（30秒起，最长1小时）", IsPublic: false},
+	{Key: constant.KeyNotificationDeliveryConcurrency, Value: `{"email":2,"bark":2,"webhook":2}`, Comment: "各投递渠道同时发送的最大数量 (JSON格式)，未列出的渠道为 2", IsPublic: false},
+	{Key: constant.KeyNotificationDeliveryRetentionDays, Value: "14", Comment: "发送成功或最终失败的投递记录保留天数，0 表示不清理", IsPublic: false},
+
+	// --- 密码策略配置 ---
+	{Key: constant.KeyPasswordMinLength, Value: "6", Comment: "密码最小长度，不小于 6", IsPublic: false},
+	{Key: constant.KeyPasswordRequireUpper, Value: "false", Comment: "密码是否必须包含大写字母 (true/false)", IsPublic: false},
+	{Key: constant.KeyPasswordRequireLower, Value: "false", Comment: "密码是否必须包含小写字母 (true/false)", IsPublic: false},
+	{Key: constant.KeyPasswordRequireDigit, Value: "false", Comment: "密码是否必须包含数字 (true/false)", IsPublic: false},
+	{Key: constant.KeyPasswordRequireSymbol, Value: "false", Comment: "密码是否必须包含特殊字符 (true/false)", IsPublic: false},
+	{Key: constant.KeyPasswordBlockCommon, Value: "true", Comment: "是否禁止使用内置列表中的常见弱密码 (true/false)", IsPublic: false},
+	{Key: constant.KeyPasswordBannedList, Value: "", Comment: "额外禁用的密码，多个用逗号分隔，不区分大小写", IsPublic: false},
+	{Key: constant.KeyPasswordBreachCheck, Value: "false", Comment: "是否通过 Have I Been Pwned 的 k-匿名接口检查密码是否已泄露（仅发送哈希前 5 位，接口不可用时跳过）", IsPublic: false},
+
+	// --- 站点地图配置 ---
+	{Key: constant.KeySitemapPingURLs, Value: "", Comment: "文章发布（含定时发布）后以 GET 请求通知的站点地图提交地址，多个用逗号分隔，地址中的 {sitemap} 会替换为 URL 编码后的站点地图地址，留空则不通知", IsPublic: false},
+
+	// --- 缓存预热配置 ---
+	{Key: constant.KeyCacheWarmupEnable, Value: "true", Comment: "是否预热热点页面：每30分钟、部署启动及文章缓存清除后请求首页、归档、RSS 与热门文章", IsPublic: false},
+	{Key: constant.KeyCacheWarmupTopN, Value: "10", Comment: "预热的热门文章数量（按浏览量排序，最多100，0 表示只预热固定页面）", IsPublic: false},
+
+	// --- 后台任务协程池配置 ---
+	{Key: constant.KeyWorkerPools, Value: `{"notification":{"workers":4,"queue_size":500,"overflow":"block"},"indexing":{"workers":2,"queue_size":200,"overflow":"block"},"cache":{"workers":2,"queue_size":100,"overflow":"drop"}}`, Comment: "后台任务协程池配置 (JSON格式)：按类别(notification/indexing/cache)设置 workers 并发数、queue_size 队列长度、overflow 队列满时策略(block 阻塞/drop 丢弃/caller_runs 同步执行)，重启后生效", IsPublic: false},
+
+	// --- 慢查询记录配置 ---
+	{Key: constant.KeySlowQueryThresholdMs, Value: "0", Comment: "慢查询阈值（毫秒）：执行时间超过该值的 SQL 会写入日志并在诊断页按语句汇总，修改后立即生效，0 表示关闭", IsPublic: false},
+
+	// --- 日志配置 ---
+	{Key: constant.KeyLogLevel, Value: "info", Comment: "日志级别: debug / info / warn / error，修改后立即生效；debug 会输出音乐接口请求详情等调试信息，排查问题后请改回 info", IsPublic: false},
+
+	// --- WebDAV 配置 ---
+	{Key: constant.KeyWebDAVEnable, Value: "false", Comment: "是否开放 WebDAV 访问 (true/false)，开启后用户可在 /dav/ 使用邮箱密码或个人访问令牌挂载自己的文件", IsPublic: false},
+
+	// --- 文章正文压缩配置 ---
+	{Key: constant.KeyArticleContentCompression, Value: "true", Comment: "是否压缩存储文章正文 (true/false)，超过 1KB 的 Markdown 与 HTML 使用 zstd 压缩后入库，关闭后仅影响新写入的内容，已压缩的内容仍可正常读取", IsPublic: false},
+
+	// --- 数据库维护配置 ---
+	{Key: constant.KeyDBMaintenanceEnable, Value: "false", Comment: "是否在维护时段内自动执行数据库维护 (true/false)：清理过期的软删除数据，并按数据库类型执行 ANALYZE / OPTIMIZE TABLE / VACUUM，结果可在后台任务面板查看", IsPublic: false},
+	{Key: constant.KeyDBMaintenanceWindow, Value: "04:00-05:00", Comment: "数据库维护时段（HH:MM-HH:MM，服务器本地时间，可跨越零点），每个时段最多执行一次，SQLite 执行 VACUUM 期间写入会被阻塞，建议选择访问量低的时间", IsPublic: false},
+	{Key: constant.KeyDBMaintenanceSoftDeleteRetentionDays, Value: "0", Comment: "已删除的文章、评论与页面在数据库中保留的天数，超过后在维护时永久删除（含文章的历史版本、音频与标签分类关联），0 表示不清理", IsPublic: false},
+
+	// --- 公开统计挂件配置 ---
+	{Key: constant.KeyWidgetCORSAllowedOrigins, Value: "*", Comment: "允许跨域嵌入统计挂件的来源，逗号分隔，* 表示任意来源，留空则禁止跨域", IsPublic: false},
+
+	// --- 公开接口跨域配置 ---
+	{Key: constant.KeyPublicAPICORSAllowedOrigins, Value: "", Comment: "允许跨域访问公开接口（/api/public/* 与 /api/search）的来源，逗号分隔，* 表示任意来源，留空则仅允许站点自身，用于部署在其他域名的无头前端或挂件", IsPublic: false},
+	{Key: constant.KeyPublicAPICORSAllowedMethods, Value: "GET, POST, OPTIONS", Comment: "公开接口跨域允许的请求方法，逗号分隔", IsPublic: false},
+	{Key: constant.KeyPublicAPICORSAllowedHeaders, Value: "Content-Type, Authorization, X-Requested-With", Comment: "公开接口跨域允许的请求头，逗号分隔", IsPublic: false},
+	{Key: constant.KeyPublicAPICORSAllowCredentials, Value: "false", Comment: "公开接口跨域请求是否允许携带 Cookie 与认证信息 (true/false)", IsPublic: false},
+
+	// --- 管理接口 IP 白名单 ---
+	{Key: constant.KeyAdminIPAllowlist, Value: "", Comment: "允许访问管理接口（/api/admin/*、/api/settings/* 及其他需要管理员权限的接口）的 IP 或 CIDR，逗号分隔，如 203.0.113.7, 10.0.0.0/8；留空则不限制，本机回环地址始终允许", IsPublic: false},
+
+	// --- 页面缓存时间配置 ---
+	{Key: constant.KeyCacheTTLArticle, Value: `{"max_age":180,"s_maxage":60,"stale_while_revalidate":60}`, Comment: "文章详情页（服务端渲染与 /api/public/articles/:id）的缓存时间（JSON，单位秒）：max_age 浏览器缓存、s_maxage CDN 缓存、stale_while_revalidate 过期后仍可使用旧内容的时间，max_age 为 0 表示不缓存", IsPublic: false},
+	{Key: constant.KeyCacheTTLHome, Value: `{"max_age":300,"s_maxage":120,"stale_while_revalidate":30}`, Comment: "首页（服务端渲染与 /api/public/articles/home）的缓存时间（JSON，单位秒），字段含义同文章详情页", IsPublic: false},
+	{Key: constant.KeyCacheTTLStatic, Value: `{"max_age":1800,"s_maxage":600,"stale_while_revalidate":120}`, Comment: "自定义页面（服务端渲染与 /api/public/pages/*）的缓存时间（JSON，单位秒），字段含义同文章详情页", IsPublic: false},
+	{Key: constant.KeyCacheTTLDefault, Value: `{"max_age":180,"s_maxage":60,"stale_while_revalidate":30}`, Comment: "其他前台页面（归档、分类、标签等）的缓存时间（JSON，单位秒），字段含义同文章详情页", IsPublic: false},
+
+	// --- 登录防暴力破解 ---
+	{Key: constant.KeyLoginGuardEnable, Value: "true", Comment: "是否启用登录失败锁定 (true/false)，同一账号或同一 IP 在统计窗口内失败次数达到上限后暂时禁止登录", IsPublic: false},
+	{Key: constant.KeyLoginGuardMaxAttempts, Value: "5", Comment: "统计窗口内允许的最大登录失败次数，按账号与 IP 分别统计，最小为 1", IsPublic: false},
+	{Key: constant.KeyLoginGuardWindowMinutes, Value: "15", Comment: "登录失败次数的统计窗口（分钟），最小为 1", IsPublic: false},
+	{Key: constant.KeyLoginGuardLockoutMinutes, Value: "15", Comment: "达到失败上限后的锁定时长（分钟），最小为 1", IsPublic: false},
+	{Key: constant.KeyLoginGuardNotifyEmail, Value: "false", Comment: "账号因登录失败次数过多被锁定时，是否发送邮件提醒该用户 (true/false)", IsPublic: false},
+
 	// --- 人机验证配置 ---
-	{Key: constant.KeyCaptchaProvider, Value: "none", Comment: "人机验证方式: none(不启用) / turnstile(Cloudflare Turnstile) / geetest(极验4.0) / image(系统图形验证码)", IsPublic: true},
+	{Key: constant.KeyCaptchaProvider, Value: "none", Comment: "人机验证方式: none(不启用) / turnstile(Cloudflare Turnstile) / hcaptcha(hCaptcha) / recaptcha(Google reCAPTCHA v2/v3) / geetest(极验4.0) / image(系统图形验证码)", IsPublic: true},
+	{Key: constant.KeyCaptchaScenes, Value: "login,register,forgot_password,subscribe", Comment: "启用人机验证后需要验证的表单，逗号分隔: login(登录) / register(注册) / forgot_password(找回密码) / subscribe(订阅) / comment(游客发表评论) / link_apply(申请友链)", IsPublic: true},
 
 	// --- 微信分享配置 ---
 	{Key: constant.KeyWechatShareEnable, Value: "false", Comment: "是否启用微信分享功能 (true/false)", IsPublic: true},
@@ -506,6 +688,15 @@ var AllSettings = []Definition{
 	{Key: constant.KeyTurnstileSiteKey, Value: "", Comment: "Turnstile Site Key（公钥，前端使用，从 Cloudflare 控制台获取）", IsPublic: true},
 	{Key: constant.KeyTurnstileSecretKey, Value: "", Comment: "Turnstile Secret Key（私钥，后端验证使用，从 Cloudflare 控制台获取）", IsPublic: false},
 
+	// --- hCaptcha 人机验证配置 ---
+	{Key: constant.KeyHCaptchaSiteKey, Value: "", Comment: "hCaptcha Site Key（公钥，前端使用，从 hCaptcha 控制台获取）", IsPublic: true},
+	{Key: constant.KeyHCaptchaSecretKey, Value: "", Comment: "hCaptcha Secret Key（私钥，后端验证使用，从 hCaptcha 控制台获取）", IsPublic: false},
+
+	// --- Google reCAPTCHA 人机验证配置 ---
+	{Key: constant.KeyRecaptchaSiteKey, Value: "", Comment: "reCAPTCHA Site Key（公钥，前端使用，从 Google reCAPTCHA 管理后台获取）", IsPublic: true},
+	{Key: constant.KeyRecaptchaSecretKey, Value: "", Comment: "reCAPTCHA Secret Key（私钥，后端验证使用，从 Google reCAPTCHA 管理后台获取）", IsPublic: false},
+	{Key: constant.KeyRecaptchaMinScore, Value: "0.5", Comment: "reCAPTCHA v3 的最低得分（0~1，默认 0.5），低于该分数视为机器人；v2 不返回得分，不受此项影响", IsPublic: false},
+
 	// --- 极验 GeeTest 4.0 人机验证配置 ---
 	{Key: constant.KeyGeetestCaptchaId, Value: "", Comment: "极验验证 ID（公钥，前端使用，从极验后台获取）", IsPublic: true},
 	{Key: constant.KeyGeetestCaptchaKey, Value: "", Comment: "极验验证 Key（私钥，后端验证使用，从极验后台获取）", IsPublic: false},
@@ -527,7 +718,7 @@ var AllUserGroups = []UserGroupDefinition{
 		ID:          1,
 		Name:        "管理员",
 		Description: "拥有所有权限的系统管理员",
-		Permissions: model.NewBoolset(model.PermissionAdmin, model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionDeleteFile),
+		Permissions: model.NewBoolset(model.PermissionAdmin, model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionDeleteFile, model.PermissionArticleWrite, model.PermissionCommentModerate, model.PermissionFileManage, model.PermissionSettingsWrite),
 		MaxStorage:  0, // 0 代表无限容量
 		SpeedLimit:  0,
 		Settings:    model.GroupSettings{SourceBatch: 100, PolicyOrdering: []uint{1}, RedirectedSource: true},
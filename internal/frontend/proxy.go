@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 )
 
 // ProxyMiddleware creates a reverse proxy middleware that forwards
@@ -51,7 +53,7 @@ func ProxyMiddleware(launcher *Launcher) gin.HandlerFunc {
 			req.Host = req.URL.Host
 			req.Header.Set("X-Forwarded-Host", c.Request.Host)
 			req.Header.Set("X-Forwarded-Proto", scheme(c))
-			req.Header.Set("X-Real-IP", c.ClientIP())
+			req.Header.Set("X-Real-IP", util.GetRealClientIP(c))
 		}
 
 		proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, proxyErr error) {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/migrate"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/slowquery"
 	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 
 	"entgo.io/ent/dialect"
@@ -126,8 +127,8 @@ func NewEntClient(db *sql.DB, cfg *config.Config) (*ent.Client, error) {
 
 	var entOptions []ent.Option
 
-	// 1. 始终添加 Driver 选项
-	entOptions = append(entOptions, ent.Driver(drv))
+	// 1. 始终添加 Driver 选项，并统计慢查询（阈值为 0 时不记录）
+	entOptions = append(entOptions, ent.Driver(slowquery.Wrap(drv)))
 
 	// 2. 根据配置决定是否添加 Debug 选项
 	if cfg.GetBool(config.KeyDBDebug) {
@@ -0,0 +1,145 @@
+/*
+ * @Description: 个人访问令牌仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type accessTokenRepo struct {
+	db *ent.Client
+}
+
+// NewAccessTokenRepo 是 accessTokenRepo 的构造函数。
+func NewAccessTokenRepo(db *ent.Client) repository.AccessTokenRepository {
+	return &accessTokenRepo{db: db}
+}
+
+// toModel 将 ent 实体转换为领域模型。
+func (r *accessTokenRepo) toModel(t *ent.AccessToken) *model.AccessToken {
+	if t == nil {
+		return nil
+	}
+	return &model.AccessToken{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      strings.Fields(t.Scopes),
+		CreatedAt:   t.CreatedAt,
+		LastUsedAt:  t.LastUsedAt,
+		ExpiresAt:   t.ExpiresAt,
+	}
+}
+
+// Create 保存新令牌（仅保存哈希）
+func (r *accessTokenRepo) Create(ctx context.Context, token *model.AccessToken, tokenHash string) (*model.AccessToken, error) {
+	created, err := r.db.AccessToken.Create().
+		SetUserID(token.UserID).
+		SetName(token.Name).
+		SetTokenHash(tokenHash).
+		SetTokenPrefix(token.TokenPrefix).
+		SetScopes(strings.Join(token.Scopes, " ")).
+		SetNillableExpiresAt(token.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// ListByUserID 列出用户的全部令牌
+func (r *accessTokenRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.AccessToken, error) {
+	entities, err := r.db.AccessToken.Query().
+		Where(accesstoken.UserID(userID)).
+		Order(ent.Desc(accesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*model.AccessToken, len(entities))
+	for i, entity := range entities {
+		models[i] = r.toModel(entity)
+	}
+	return models, nil
+}
+
+// FindByHash 根据令牌哈希查找令牌
+func (r *accessTokenRepo) FindByHash(ctx context.Context, tokenHash string) (*model.AccessToken, error) {
+	entity, err := r.db.AccessToken.Query().
+		Where(accesstoken.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// Delete 删除用户的指定令牌
+func (r *accessTokenRepo) Delete(ctx context.Context, userID, id uint) error {
+	n, err := r.db.AccessToken.Delete().
+		Where(accesstoken.ID(id), accesstoken.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return constant.ErrNotFound
+	}
+	return nil
+}
+
+// List 分页列出全部令牌，userID 非 0 时只列出该用户的令牌
+func (r *accessTokenRepo) List(ctx context.Context, userID uint, page, pageSize int) ([]*model.AccessToken, int, error) {
+	query := r.db.AccessToken.Query()
+	if userID != 0 {
+		query = query.Where(accesstoken.UserID(userID))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entities, err := query.
+		Order(ent.Desc(accesstoken.FieldID)).
+		Limit(pageSize).
+		Offset((page - 1) * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	models := make([]*model.AccessToken, len(entities))
+	for i, entity := range entities {
+		models[i] = r.toModel(entity)
+	}
+	return models, total, nil
+}
+
+// DeleteByID 删除任意用户的令牌
+func (r *accessTokenRepo) DeleteByID(ctx context.Context, id uint) error {
+	err := r.db.AccessToken.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return constant.ErrNotFound
+	}
+	return err
+}
+
+// TouchLastUsed 更新令牌最近使用时间
+func (r *accessTokenRepo) TouchLastUsed(ctx context.Context, id uint, at time.Time) error {
+	return r.db.AccessToken.UpdateOneID(id).SetLastUsedAt(at).Exec(ctx)
+}
@@ -0,0 +1,88 @@
+/*
+ * @Description: 文章语音朗读版本仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/articleaudio"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type entArticleAudioRepo struct {
+	client *ent.Client
+}
+
+// NewEntArticleAudioRepository 是 entArticleAudioRepo 的构造函数
+func NewEntArticleAudioRepository(client *ent.Client) repository.ArticleAudioRepository {
+	return &entArticleAudioRepo{client: client}
+}
+
+func (r *entArticleAudioRepo) FindByArticleID(ctx context.Context, articleID uint) (*model.ArticleAudio, error) {
+	po, err := r.client.ArticleAudio.Query().
+		Where(articleaudio.ArticleID(articleID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainArticleAudio(po), nil
+}
+
+func (r *entArticleAudioRepo) Save(ctx context.Context, audio *model.ArticleAudio) error {
+	err := r.client.ArticleAudio.Create().
+		SetArticleID(audio.ArticleID).
+		SetStatus(articleaudio.Status(audio.Status)).
+		SetProvider(audio.Provider).
+		SetVoice(audio.Voice).
+		SetContentHash(audio.ContentHash).
+		SetFilePublicID(audio.FilePublicID).
+		SetURL(audio.URL).
+		SetCharCount(audio.CharCount).
+		SetErrorMessage(audio.ErrorMessage).
+		OnConflictColumns(articleaudio.FieldArticleID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	saved, err := r.FindByArticleID(ctx, audio.ArticleID)
+	if err != nil || saved == nil {
+		return err
+	}
+	*audio = *saved
+	return nil
+}
+
+func (r *entArticleAudioRepo) DeleteByArticleID(ctx context.Context, articleID uint) error {
+	_, err := r.client.ArticleAudio.Delete().
+		Where(articleaudio.ArticleID(articleID)).
+		Exec(ctx)
+	return err
+}
+
+func toDomainArticleAudio(po *ent.ArticleAudio) *model.ArticleAudio {
+	return &model.ArticleAudio{
+		ID:           po.ID,
+		CreatedAt:    po.CreatedAt,
+		UpdatedAt:    po.UpdatedAt,
+		ArticleID:    po.ArticleID,
+		Status:       string(po.Status),
+		Provider:     po.Provider,
+		Voice:        po.Voice,
+		ContentHash:  po.ContentHash,
+		FilePublicID: po.FilePublicID,
+		URL:          po.URL,
+		CharCount:    po.CharCount,
+		ErrorMessage: po.ErrorMessage,
+	}
+}
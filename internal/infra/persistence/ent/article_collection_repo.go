@@ -0,0 +1,184 @@
+/*
+ * @Description: 文章合集仓库的 ent 实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 01:00:00
+ * @LastEditTime: 2026-10-18 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlecollection"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+type articleCollectionRepo struct {
+	db *ent.Client
+}
+
+// NewArticleCollectionRepo 是 articleCollectionRepo 的构造函数。
+func NewArticleCollectionRepo(db *ent.Client) repository.ArticleCollectionRepository {
+	return &articleCollectionRepo{db: db}
+}
+
+// toModel 将 ent 实体转换为领域模型，文章ID转换为公共ID。
+func (r *articleCollectionRepo) toModel(c *ent.ArticleCollection) *model.ArticleCollection {
+	if c == nil {
+		return nil
+	}
+	publicID, _ := idgen.GeneratePublicID(c.ID, idgen.EntityTypeArticleCollection)
+	articleIDs := make([]string, 0, len(c.ArticleIds))
+	for _, id := range c.ArticleIds {
+		if articleID, err := idgen.GeneratePublicID(id, idgen.EntityTypeArticle); err == nil {
+			articleIDs = append(articleIDs, articleID)
+		}
+	}
+	return &model.ArticleCollection{
+		ID:          publicID,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		ArticleIDs:  articleIDs,
+		IsPublished: c.IsPublished,
+		Sort:        c.Sort,
+	}
+}
+
+// decodeArticleIDs 将文章公共ID解码为数据库ID
+func decodeArticleIDs(publicIDs []string) ([]uint, error) {
+	ids := make([]uint, 0, len(publicIDs))
+	for _, publicID := range publicIDs {
+		id, typ, err := idgen.DecodePublicID(publicID)
+		if err != nil || typ != idgen.EntityTypeArticle {
+			return nil, fmt.Errorf("无效的文章ID '%s': %w", publicID, constant.ErrBadRequest)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Create 创建文章合集
+func (r *articleCollectionRepo) Create(ctx context.Context, req *model.SaveArticleCollectionRequest) (*model.ArticleCollection, error) {
+	articleIDs, err := decodeArticleIDs(req.ArticleIDs)
+	if err != nil {
+		return nil, err
+	}
+	create := r.db.ArticleCollection.Create().
+		SetName(req.Name).
+		SetSlug(req.Slug).
+		SetDescription(req.Description).
+		SetArticleIds(articleIDs).
+		SetSort(req.Sort)
+	if req.IsPublished != nil {
+		create.SetIsPublished(*req.IsPublished)
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("标识 '%s' 已存在: %w", req.Slug, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 整体更新文章合集
+func (r *articleCollectionRepo) Update(ctx context.Context, publicID string, req *model.SaveArticleCollectionRequest) (*model.ArticleCollection, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeArticleCollection)
+	if err != nil {
+		return nil, err
+	}
+	articleIDs, err := decodeArticleIDs(req.ArticleIDs)
+	if err != nil {
+		return nil, err
+	}
+	update := r.db.ArticleCollection.UpdateOneID(dbID).
+		SetName(req.Name).
+		SetSlug(req.Slug).
+		SetDescription(req.Description).
+		SetArticleIds(articleIDs).
+		SetSort(req.Sort)
+	if req.IsPublished != nil {
+		update.SetIsPublished(*req.IsPublished)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("标识 '%s' 已存在: %w", req.Slug, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除文章合集
+func (r *articleCollectionRepo) Delete(ctx context.Context, publicID string) error {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeArticleCollection)
+	if err != nil {
+		return err
+	}
+	if err := r.db.ArticleCollection.DeleteOneID(dbID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return constant.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// List 获取全部文章合集
+func (r *articleCollectionRepo) List(ctx context.Context) ([]*model.ArticleCollection, error) {
+	entities, err := r.db.ArticleCollection.Query().
+		Order(ent.Asc(articlecollection.FieldSort), ent.Asc(articlecollection.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*model.ArticleCollection, len(entities))
+	for i, entity := range entities {
+		models[i] = r.toModel(entity)
+	}
+	return models, nil
+}
+
+// GetByID 根据ID获取文章合集
+func (r *articleCollectionRepo) GetByID(ctx context.Context, publicID string) (*model.ArticleCollection, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeArticleCollection)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.db.ArticleCollection.Get(ctx, dbID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// GetBySlug 根据标识获取文章合集
+func (r *articleCollectionRepo) GetBySlug(ctx context.Context, slug string) (*model.ArticleCollection, error) {
+	entity, err := r.db.ArticleCollection.Query().
+		Where(articlecollection.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
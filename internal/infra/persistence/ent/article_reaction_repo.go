@@ -0,0 +1,76 @@
+/*
+ * @Description: 文章表情回应计数仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 05:00:00
+ * @LastEditTime: 2026-10-18 05:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereaction"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type articleReactionRepo struct {
+	db *ent.Client
+}
+
+// NewArticleReactionRepo 是 articleReactionRepo 的构造函数。
+func NewArticleReactionRepo(db *ent.Client) repository.ArticleReactionRepository {
+	return &articleReactionRepo{db: db}
+}
+
+// Increment 使用 upsert 原子地为文章的某种回应加一
+func (r *articleReactionRepo) Increment(ctx context.Context, articleID uint, reaction string) error {
+	return r.db.ArticleReaction.Create().
+		SetArticleID(articleID).
+		SetReaction(reaction).
+		SetCount(1).
+		OnConflictColumns(articlereaction.FieldArticleID, articlereaction.FieldReaction).
+		Update(func(u *ent.ArticleReactionUpsert) {
+			u.AddCount(1)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
+
+// Decrement 原子地为文章的某种回应减一，计数已为 0 时不做任何修改
+func (r *articleReactionRepo) Decrement(ctx context.Context, articleID uint, reaction string) error {
+	_, err := r.db.ArticleReaction.Update().
+		Where(
+			articlereaction.ArticleIDEQ(articleID),
+			articlereaction.ReactionEQ(reaction),
+			articlereaction.CountGT(0),
+		).
+		AddCount(-1).
+		Save(ctx)
+	return err
+}
+
+// CountsByArticleIDs 批量获取文章的回应计数
+func (r *articleReactionRepo) CountsByArticleIDs(ctx context.Context, articleIDs []uint) (map[uint]map[string]int, error) {
+	result := make(map[uint]map[string]int)
+	if len(articleIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.ArticleReaction.Query().
+		Where(
+			articlereaction.ArticleIDIn(articleIDs...),
+			articlereaction.CountGT(0),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if result[row.ArticleID] == nil {
+			result[row.ArticleID] = make(map[string]int)
+		}
+		result[row.ArticleID][row.Reaction] = row.Count
+	}
+	return result, nil
+}
@@ -3,7 +3,7 @@ package ent
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"math/rand"
 	"strings"
 	"time"
@@ -13,6 +13,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/compression"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
@@ -51,7 +52,7 @@ func (r *articleRepo) toModel(a *ent.Article) (*model.Article, error) {
 		for _, t := range a.Edges.PostTags {
 			tagPublicID, idErr := idgen.GeneratePublicID(t.ID, idgen.EntityTypePostTag)
 			if idErr != nil {
-				log.Printf("[严重错误] 生成标签公共ID失败: dbID=%d, error=%v", t.ID, idErr)
+				slog.Error("生成标签公共ID失败", slog.Uint64("db_id", uint64(t.ID)), slog.Any("error", idErr))
 				continue
 			}
 			tags = append(tags, &model.PostTag{ID: tagPublicID, CreatedAt: t.CreatedAt, UpdatedAt: t.UpdatedAt, Name: t.Name, Count: t.Count})
@@ -63,7 +64,7 @@ func (r *articleRepo) toModel(a *ent.Article) (*model.Article, error) {
 		for _, c := range a.Edges.PostCategories {
 			categoryPublicID, idErr := idgen.GeneratePublicID(c.ID, idgen.EntityTypePostCategory)
 			if idErr != nil {
-				log.Printf("[严重错误] 生成分类公共ID失败: dbID=%d, error=%v", c.ID, idErr)
+				slog.Error("生成分类公共ID失败", slog.Uint64("db_id", uint64(c.ID)), slog.Any("error", idErr))
 				continue
 			}
 			categories = append(categories, &model.PostCategory{ID: categoryPublicID, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt, Name: c.Name, Description: c.Description, Count: c.Count, IsSeries: c.IsSeries})
@@ -81,8 +82,8 @@ func (r *articleRepo) toModel(a *ent.Article) (*model.Article, error) {
 		CreatedAt:            a.CreatedAt,
 		UpdatedAt:            a.UpdatedAt,
 		Title:                a.Title,
-		ContentMd:            a.ContentMd,
-		ContentHTML:          a.ContentHTML,
+		ContentMd:            compression.DecompressTextOrRaw(a.ContentMd),
+		ContentHTML:          compression.DecompressTextOrRaw(a.ContentHTML),
 		CoverURL:             a.CoverURL,
 		Status:               string(a.Status),
 		ViewCount:            a.ViewCount,
@@ -105,6 +106,7 @@ func (r *articleRepo) toModel(a *ent.Article) (*model.Article, error) {
 		CopyrightAuthorHref:  a.CopyrightAuthorHref,
 		CopyrightURL:         a.CopyrightURL,
 		Keywords:             a.Keywords,
+		LinkURL:              a.LinkURL,
 		// 审核相关字段
 		ReviewStatus:  string(a.ReviewStatus),
 		ReviewComment: a.ReviewComment,
@@ -135,16 +137,45 @@ func convertExtraConfig(config map[string]interface{}) *model.ArticleExtraConfig
 	if enableAIPodcast, ok := config["enable_ai_podcast"].(bool); ok {
 		result.EnableAIPodcast = &enableAIPodcast
 	}
-	if customJS, ok := config["custom_js"].(string); ok {
-		customJSCopy := customJS
-		result.CustomJS = &customJSCopy
-	}
-	if result.EnableAIPodcast == nil && result.CustomJS == nil {
+	result.CustomJS = extraString(config, "custom_js")
+	result.OGTitle = extraString(config, "og_title")
+	result.OGDescription = extraString(config, "og_description")
+	result.OGImage = extraString(config, "og_image")
+	result.TwitterCard = extraString(config, "twitter_card")
+	if *result == (model.ArticleExtraConfig{}) {
 		return nil
 	}
 	return result
 }
 
+// extraString 读取扩展配置中的字符串项，不存在时返回 nil
+func extraString(config map[string]interface{}, key string) *string {
+	if value, ok := config[key].(string); ok {
+		return &value
+	}
+	return nil
+}
+
+// setExtraStrings 将请求中的字符串项写入扩展配置，nil 表示不修改，空字符串表示删除该项
+func setExtraStrings(config map[string]interface{}, req *model.ArticleExtraConfig) {
+	for key, value := range map[string]*string{
+		"custom_js":      req.CustomJS,
+		"og_title":       req.OGTitle,
+		"og_description": req.OGDescription,
+		"og_image":       req.OGImage,
+		"twitter_card":   req.TwitterCard,
+	} {
+		if value == nil {
+			continue
+		}
+		if strings.TrimSpace(*value) == "" {
+			delete(config, key)
+		} else {
+			config[key] = *value
+		}
+	}
+}
+
 // toModelSlice 将 ent.Article 切片转换为 model.Article 切片，减少代码重复。
 func (r *articleRepo) toModelSlice(entities []*ent.Article) ([]*model.Article, error) {
 	models := make([]*model.Article, 0, len(entities))
@@ -374,7 +405,7 @@ func (r *articleRepo) GetBySlugOrID(ctx context.Context, slugOrID string) (*mode
 		Only(ctx)
 
 	if err != nil {
-		log.Printf("[GetBySlugOrID] 查询失败: %v", err)
+		slog.DebugContext(ctx, "按 slug 或 ID 查询文章失败", slog.String("slug_or_id", slugOrID), slog.Any("error", err))
 		return nil, err
 	}
 
@@ -402,7 +433,7 @@ func (r *articleRepo) GetBySlugOrIDForPreview(ctx context.Context, slugOrID stri
 		Only(ctx)
 
 	if err != nil {
-		log.Printf("[GetBySlugOrIDForPreview] 查询失败: %v", err)
+		slog.DebugContext(ctx, "按 slug 或 ID 查询预览文章失败", slog.String("slug_or_id", slugOrID), slog.Any("error", err))
 		return nil, err
 	}
 
@@ -474,11 +505,37 @@ func (r *articleRepo) IncrementViewCount(ctx context.Context, publicID string) e
 	}
 	_, err = r.db.Article.UpdateOneID(dbID).AddViewCount(1).Save(ctx)
 	if err != nil {
-		log.Printf("[ERROR] IncrementViewCount: 未能更新文章 (DB ID %d) 的浏览次数: %v", dbID, err)
+		slog.ErrorContext(ctx, "更新文章浏览次数失败", slog.Uint64("db_id", uint64(dbID)), slog.Any("error", err))
 	}
 	return err
 }
 
+// UpdateAutoPrimaryColor 在文章仍为自动取色且取色图片未变化时更新主色调
+func (r *articleRepo) UpdateAutoPrimaryColor(ctx context.Context, publicID, imageURL, color string) (bool, error) {
+	dbID, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return false, err
+	}
+	n, err := r.db.Article.Update().
+		Where(
+			article.ID(dbID),
+			article.IsPrimaryColorManual(false),
+			article.Or(
+				article.TopImgURL(imageURL),
+				article.And(
+					article.Or(article.TopImgURLIsNil(), article.TopImgURL("")),
+					article.CoverURL(imageURL),
+				),
+			),
+		).
+		SetPrimaryColor(color).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
 // Create 创建新文章
 func (r *articleRepo) Create(ctx context.Context, params *model.CreateArticleParams) (*model.Article, error) {
 	topImgURL := params.TopImgURL
@@ -495,8 +552,8 @@ func (r *articleRepo) Create(ctx context.Context, params *model.CreateArticlePar
 	creator := r.db.Article.Create().
 		SetTitle(params.Title).
 		SetOwnerID(ownerID). // 保存文章作者ID
-		SetContentMd(params.ContentMd).
-		SetContentHTML(params.ContentHTML).
+		SetContentMd(compression.MaybeCompressText(params.ContentMd)).
+		SetContentHTML(compression.MaybeCompressText(params.ContentHTML)).
 		SetCoverURL(params.CoverURL).
 		AddPostTagIDs(params.PostTagIDs...).
 		AddPostCategoryIDs(params.PostCategoryIDs...).
@@ -515,7 +572,8 @@ func (r *articleRepo) Create(ctx context.Context, params *model.CreateArticlePar
 		SetCopyrightAuthor(params.CopyrightAuthor).
 		SetCopyrightAuthorHref(params.CopyrightAuthorHref).
 		SetCopyrightURL(params.CopyrightURL).
-		SetKeywords(params.Keywords)
+		SetKeywords(params.Keywords).
+		SetLinkURL(params.LinkURL)
 
 	if params.Abbrlink != "" {
 		creator.SetAbbrlink(params.Abbrlink)
@@ -539,11 +597,7 @@ func (r *articleRepo) Create(ctx context.Context, params *model.CreateArticlePar
 		if params.ExtraConfig.EnableAIPodcast != nil {
 			extraConfigMap["enable_ai_podcast"] = *params.ExtraConfig.EnableAIPodcast
 		}
-		if params.ExtraConfig.CustomJS != nil {
-			if strings.TrimSpace(*params.ExtraConfig.CustomJS) != "" {
-				extraConfigMap["custom_js"] = *params.ExtraConfig.CustomJS
-			}
-		}
+		setExtraStrings(extraConfigMap, params.ExtraConfig)
 		if len(extraConfigMap) > 0 {
 			creator.SetExtraConfig(extraConfigMap)
 		}
@@ -570,7 +624,7 @@ func (r *articleRepo) Create(ctx context.Context, params *model.CreateArticlePar
 
 	newEntity, err := creator.Save(ctx)
 	if err != nil {
-		log.Printf("[Repository.Create] 保存失败: %v", err)
+		slog.ErrorContext(ctx, "保存新文章失败", slog.Any("error", err))
 		return nil, err
 	}
 
@@ -589,7 +643,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 		updater.SetTitle(*req.Title)
 	}
 	if req.ContentMd != nil {
-		updater.SetContentMd(*req.ContentMd)
+		updater.SetContentMd(compression.MaybeCompressText(*req.ContentMd))
 	}
 	if req.CoverURL != nil {
 		updater.SetCoverURL(*req.CoverURL)
@@ -657,6 +711,9 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 	if req.Keywords != nil {
 		updater.SetKeywords(*req.Keywords)
 	}
+	if req.LinkURL != nil {
+		updater.SetLinkURL(*req.LinkURL)
+	}
 	if req.ReviewStatus != nil {
 		updater.SetReviewStatus(article.ReviewStatus(*req.ReviewStatus))
 	}
@@ -673,13 +730,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 		if req.ExtraConfig.EnableAIPodcast != nil {
 			extraConfigMap["enable_ai_podcast"] = *req.ExtraConfig.EnableAIPodcast
 		}
-		if req.ExtraConfig.CustomJS != nil {
-			if strings.TrimSpace(*req.ExtraConfig.CustomJS) == "" {
-				delete(extraConfigMap, "custom_js")
-			} else {
-				extraConfigMap["custom_js"] = *req.ExtraConfig.CustomJS
-			}
-		}
+		setExtraStrings(extraConfigMap, req.ExtraConfig)
 		updater.SetExtraConfig(extraConfigMap)
 	}
 	// 更新文档模式相关字段
@@ -695,7 +746,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 		} else {
 			seriesDBID, _, err := idgen.DecodePublicID(*req.DocSeriesID)
 			if err != nil {
-				log.Printf("[Repository.Update] 解析文档系列ID失败: id=%s, error=%v", *req.DocSeriesID, err)
+				slog.WarnContext(ctx, "解析文档系列ID失败", slog.String("doc_series_id", *req.DocSeriesID), slog.Any("error", err))
 				return nil, fmt.Errorf("无效的文档系列ID: %s", *req.DocSeriesID)
 			}
 			updater.SetDocSeriesID(seriesDBID)
@@ -708,7 +759,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 			if scheduledTime, parseErr := time.Parse(time.RFC3339, *req.ScheduledAt); parseErr == nil {
 				updater.SetScheduledAt(scheduledTime)
 			} else {
-				log.Printf("[Repository.Update] 解析定时发布时间失败: %v", parseErr)
+				slog.WarnContext(ctx, "解析定时发布时间失败，忽略该字段", slog.String("scheduled_at", *req.ScheduledAt), slog.Any("error", parseErr))
 			}
 		}
 	}
@@ -719,7 +770,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 			updater.SetReadingTime(computed.ReadingTime)
 		}
 		if req.ContentHTML != nil {
-			updater.SetContentHTML(computed.ContentHTML)
+			updater.SetContentHTML(compression.MaybeCompressText(computed.ContentHTML))
 		}
 		if computed.PrimaryColor != nil {
 			updater.SetPrimaryColor(*computed.PrimaryColor)
@@ -732,7 +783,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 		if customTime, parseErr := time.Parse(time.RFC3339, *req.CustomPublishedAt); parseErr == nil {
 			updater.SetCreatedAt(customTime)
 		} else {
-			log.Printf("[Repository.Update] 解析自定义发布时间失败: %v", parseErr)
+			slog.WarnContext(ctx, "解析自定义发布时间失败，忽略该字段", slog.String("custom_published_at", *req.CustomPublishedAt), slog.Any("error", parseErr))
 		}
 	}
 
@@ -740,7 +791,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 		if customTime, parseErr := time.Parse(time.RFC3339, *req.CustomUpdatedAt); parseErr == nil {
 			updater.SetUpdatedAt(customTime)
 		} else {
-			log.Printf("[Repository.Update] 解析自定义更新时间失败，使用当前时间: %v", parseErr)
+			slog.WarnContext(ctx, "解析自定义更新时间失败，使用当前时间", slog.String("custom_updated_at", *req.CustomUpdatedAt), slog.Any("error", parseErr))
 			updater.SetUpdatedAt(time.Now())
 		}
 	} else {
@@ -749,7 +800,7 @@ func (r *articleRepo) Update(ctx context.Context, publicID string, req *model.Up
 
 	_, err = updater.Save(ctx)
 	if err != nil {
-		log.Printf("[Repository.Update] 保存失败: %v", err)
+		slog.ErrorContext(ctx, "保存文章更新失败", slog.String("article_id", publicID), slog.Any("error", err))
 		return nil, err
 	}
 
@@ -837,6 +888,7 @@ func (r *articleRepo) ListPublic(ctx context.Context, options *model.ListPublicA
 			article.FieldShowOnHome, article.FieldHomeSort, article.FieldPinSort, article.FieldTopImgURL,
 			article.FieldSummaries, article.FieldAbbrlink, article.FieldCopyright,
 			article.FieldCopyrightAuthor, article.FieldCopyrightAuthorHref, article.FieldCopyrightURL,
+			article.FieldLinkURL,
 			article.FieldIsDoc, article.FieldDocSeriesID, // 文档模式相关字段
 		).All(ctx)
 	}
@@ -864,6 +916,13 @@ func (r *articleRepo) List(ctx context.Context, options *model.ListArticlesOptio
 	if options.AuthorID != nil {
 		query = query.Where(article.OwnerIDEQ(*options.AuthorID))
 	}
+	// 按审核状态过滤（投稿审核功能）
+	if options.ReviewStatus != "" {
+		query = query.Where(article.ReviewStatusEQ(article.ReviewStatus(options.ReviewStatus)))
+	}
+	if options.Reviewable {
+		query = query.Where(article.ReviewStatusNEQ(article.ReviewStatusNONE))
+	}
 	// 按分类名称过滤（支持 slug 或 name 匹配，与 ListPublic 一致）
 	if options.CategoryName != "" {
 		query = query.Where(article.HasPostCategoriesWith(
@@ -882,12 +941,20 @@ func (r *articleRepo) List(ctx context.Context, options *model.ListArticlesOptio
 			),
 		))
 	}
+	// 只看待定时发布的文章
+	if options.Scheduled {
+		query = query.Where(article.ScheduledAtNotNil())
+	}
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	q := query.Order(ent.Desc(article.FieldCreatedAt)).
+	order := ent.Desc(article.FieldCreatedAt)
+	if options.Scheduled {
+		order = ent.Asc(article.FieldScheduledAt)
+	}
+	q := query.Order(order).
 		WithPostTags().
 		WithPostCategories()
 
@@ -905,6 +972,7 @@ func (r *articleRepo) List(ctx context.Context, options *model.ListArticlesOptio
 			article.FieldShowOnHome, article.FieldHomeSort, article.FieldPinSort, article.FieldTopImgURL,
 			article.FieldSummaries, article.FieldAbbrlink, article.FieldCopyright,
 			article.FieldCopyrightAuthor, article.FieldCopyrightAuthorHref, article.FieldCopyrightURL,
+			article.FieldLinkURL,
 			article.FieldReviewStatus,   // 审核状态（多人共创功能）
 			article.FieldOwnerID,        // 发布者ID（多人共创功能）
 			article.FieldIsTakedown,     // 下架状态（PRO版管理员功能）
@@ -955,6 +1023,31 @@ func (r *articleRepo) ListHome(ctx context.Context) ([]*model.Article, error) {
 	return r.toModelSlice(entities)
 }
 
+// ListPublishedByIDs 按ID批量获取可公开访问的文章，筛选条件与首页推荐一致
+func (r *articleRepo) ListPublishedByIDs(ctx context.Context, ids []uint) ([]*model.Article, error) {
+	if len(ids) == 0 {
+		return []*model.Article{}, nil
+	}
+	entities, err := r.db.Article.Query().
+		Where(
+			article.IDIn(ids...),
+			article.StatusEQ(article.StatusPUBLISHED),
+			article.DeletedAtIsNil(),
+			article.IsTakedownEQ(false),
+			article.Or(
+				article.ReviewStatusEQ(article.ReviewStatusAPPROVED),
+				article.ReviewStatusEQ(article.ReviewStatusNONE),
+			),
+		).
+		WithPostTags().
+		WithPostCategories().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModelSlice(entities)
+}
+
 // GetByID 根据公共ID获取单个文章
 func (r *articleRepo) GetByID(ctx context.Context, publicID string) (*model.Article, error) {
 	dbID, _, err := idgen.DecodePublicID(publicID)
@@ -1055,7 +1148,7 @@ func (r *articleRepo) PublishScheduledArticle(ctx context.Context, articleID uin
 		return fmt.Errorf("发布定时文章 %d 失败: %w", articleID, err)
 	}
 
-	log.Printf("[定时发布] 文章 %d 已成功发布", articleID)
+	slog.InfoContext(ctx, "定时文章已发布", slog.Uint64("db_id", uint64(articleID)))
 	return nil
 }
 
@@ -0,0 +1,109 @@
+/*
+ * @Description: 投稿审核仓库的 ent 实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 04:00:00
+ * @LastEditTime: 2026-10-18 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlereviewnote"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type articleReviewRepo struct {
+	db *ent.Client
+}
+
+// NewArticleReviewRepo 是 articleReviewRepo 的构造函数。
+func NewArticleReviewRepo(db *ent.Client) repository.ArticleReviewRepository {
+	return &articleReviewRepo{db: db}
+}
+
+func (r *articleReviewRepo) toModel(n *ent.ArticleReviewNote) *model.ArticleReviewNote {
+	return &model.ArticleReviewNote{
+		ID:        n.ID,
+		CreatedAt: n.CreatedAt,
+		ArticleID: n.ArticleID,
+		UserID:    n.UserID,
+		Action:    string(n.Action),
+		Content:   n.Content,
+	}
+}
+
+// AddNote 追加一条审核记录
+func (r *articleReviewRepo) AddNote(ctx context.Context, note *model.ArticleReviewNote) (*model.ArticleReviewNote, error) {
+	created, err := r.db.ArticleReviewNote.Create().
+		SetArticleID(note.ArticleID).
+		SetUserID(note.UserID).
+		SetAction(articlereviewnote.Action(note.Action)).
+		SetContent(note.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// ListNotes 按时间顺序获取文章的全部审核记录
+func (r *articleReviewRepo) ListNotes(ctx context.Context, articleID uint) ([]*model.ArticleReviewNote, error) {
+	entities, err := r.db.ArticleReviewNote.Query().
+		Where(articlereviewnote.ArticleIDEQ(articleID)).
+		Order(ent.Asc(articlereviewnote.FieldCreatedAt), ent.Asc(articlereviewnote.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notes := make([]*model.ArticleReviewNote, len(entities))
+	for i, entity := range entities {
+		notes[i] = r.toModel(entity)
+	}
+	return notes, nil
+}
+
+// SetReviewResult 写入文章的审核状态、审核意见、审核人与审核时间
+func (r *articleReviewRepo) SetReviewResult(ctx context.Context, articleID uint, result *model.ContributionReviewResult) error {
+	err := r.db.Article.UpdateOneID(articleID).
+		Where(article.DeletedAtIsNil()).
+		SetReviewStatus(article.ReviewStatus(result.Status)).
+		SetReviewComment(result.Comment).
+		SetReviewedAt(result.ReviewedAt).
+		SetReviewedBy(result.ReviewerID).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return constant.ErrNotFound
+	}
+	return err
+}
+
+// GetReviewResult 获取文章当前的审核状态与最近一次审核意见
+func (r *articleReviewRepo) GetReviewResult(ctx context.Context, articleID uint) (*model.ContributionReviewResult, error) {
+	a, err := r.db.Article.Query().
+		Where(article.IDEQ(articleID), article.DeletedAtIsNil()).
+		Select(article.FieldReviewStatus, article.FieldReviewComment, article.FieldReviewedAt, article.FieldReviewedBy).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	result := &model.ContributionReviewResult{
+		Status:  string(a.ReviewStatus),
+		Comment: a.ReviewComment,
+	}
+	if a.ReviewedAt != nil {
+		result.ReviewedAt = *a.ReviewedAt
+	}
+	if a.ReviewedBy != nil {
+		result.ReviewerID = *a.ReviewedBy
+	}
+	return result, nil
+}
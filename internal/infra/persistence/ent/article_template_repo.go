@@ -0,0 +1,300 @@
+/*
+ * @Description: 文章模板与内容片段仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/articletemplate"
+	"github.com/anzhiyu-c/anheyu-app/ent/contentsnippet"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// decodeTypedID 解码公共ID并校验实体类型，类型不符时视为不存在
+func decodeTypedID(publicID string, entityType uint64) (uint, error) {
+	dbID, typ, err := idgen.DecodePublicID(publicID)
+	if err != nil || typ != entityType {
+		return 0, fmt.Errorf("无效的ID '%s': %w", publicID, constant.ErrNotFound)
+	}
+	return dbID, nil
+}
+
+// --- 文章模板 ---
+
+type articleTemplateRepo struct {
+	db *ent.Client
+}
+
+// NewArticleTemplateRepo 是 articleTemplateRepo 的构造函数。
+func NewArticleTemplateRepo(db *ent.Client) repository.ArticleTemplateRepository {
+	return &articleTemplateRepo{db: db}
+}
+
+// toModel 将 ent 实体转换为领域模型。
+func (r *articleTemplateRepo) toModel(t *ent.ArticleTemplate) *model.ArticleTemplate {
+	if t == nil {
+		return nil
+	}
+	publicID, _ := idgen.GeneratePublicID(t.ID, idgen.EntityTypeArticleTemplate)
+	return &model.ArticleTemplate{
+		ID:                  publicID,
+		CreatedAt:           t.CreatedAt,
+		UpdatedAt:           t.UpdatedAt,
+		Name:                t.Name,
+		Description:         t.Description,
+		Sort:                t.Sort,
+		Title:               t.Title,
+		ContentMd:           t.ContentMd,
+		Summaries:           t.Summaries,
+		CoverURL:            t.CoverURL,
+		TopImgURL:           t.TopImgURL,
+		PostTagIDs:          t.PostTagIds,
+		PostCategoryIDs:     t.PostCategoryIds,
+		Keywords:            t.Keywords,
+		Copyright:           t.Copyright,
+		IsReprint:           t.IsReprint,
+		CopyrightAuthor:     t.CopyrightAuthor,
+		CopyrightAuthorHref: t.CopyrightAuthorHref,
+		CopyrightURL:        t.CopyrightURL,
+	}
+}
+
+// Create 创建文章模板
+func (r *articleTemplateRepo) Create(ctx context.Context, req *model.SaveArticleTemplateRequest) (*model.ArticleTemplate, error) {
+	creator := r.db.ArticleTemplate.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetSort(req.Sort).
+		SetTitle(req.Title).
+		SetContentMd(req.ContentMd).
+		SetSummaries(req.Summaries).
+		SetCoverURL(req.CoverURL).
+		SetTopImgURL(req.TopImgURL).
+		SetPostTagIds(req.PostTagIDs).
+		SetPostCategoryIds(req.PostCategoryIDs).
+		SetKeywords(req.Keywords).
+		SetNillableCopyright(req.Copyright).
+		SetIsReprint(req.IsReprint).
+		SetCopyrightAuthor(req.CopyrightAuthor).
+		SetCopyrightAuthorHref(req.CopyrightAuthorHref).
+		SetCopyrightURL(req.CopyrightURL)
+	created, err := creator.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("名称 '%s' 已存在: %w", req.Name, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 整体更新文章模板
+func (r *articleTemplateRepo) Update(ctx context.Context, publicID string, req *model.SaveArticleTemplateRequest) (*model.ArticleTemplate, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeArticleTemplate)
+	if err != nil {
+		return nil, err
+	}
+	copyright := true
+	if req.Copyright != nil {
+		copyright = *req.Copyright
+	}
+	updated, err := r.db.ArticleTemplate.UpdateOneID(dbID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetSort(req.Sort).
+		SetTitle(req.Title).
+		SetContentMd(req.ContentMd).
+		SetSummaries(req.Summaries).
+		SetCoverURL(req.CoverURL).
+		SetTopImgURL(req.TopImgURL).
+		SetPostTagIds(req.PostTagIDs).
+		SetPostCategoryIds(req.PostCategoryIDs).
+		SetKeywords(req.Keywords).
+		SetCopyright(copyright).
+		SetIsReprint(req.IsReprint).
+		SetCopyrightAuthor(req.CopyrightAuthor).
+		SetCopyrightAuthorHref(req.CopyrightAuthorHref).
+		SetCopyrightURL(req.CopyrightURL).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("名称 '%s' 已存在: %w", req.Name, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除文章模板
+func (r *articleTemplateRepo) Delete(ctx context.Context, publicID string) error {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeArticleTemplate)
+	if err != nil {
+		return err
+	}
+	if err := r.db.ArticleTemplate.DeleteOneID(dbID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return constant.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// List 获取全部文章模板
+func (r *articleTemplateRepo) List(ctx context.Context) ([]*model.ArticleTemplate, error) {
+	entities, err := r.db.ArticleTemplate.Query().
+		Order(ent.Asc(articletemplate.FieldSort), ent.Asc(articletemplate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*model.ArticleTemplate, len(entities))
+	for i, entity := range entities {
+		models[i] = r.toModel(entity)
+	}
+	return models, nil
+}
+
+// GetByID 根据ID获取文章模板
+func (r *articleTemplateRepo) GetByID(ctx context.Context, publicID string) (*model.ArticleTemplate, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeArticleTemplate)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.db.ArticleTemplate.Get(ctx, dbID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// --- 内容片段 ---
+
+type contentSnippetRepo struct {
+	db *ent.Client
+}
+
+// NewContentSnippetRepo 是 contentSnippetRepo 的构造函数。
+func NewContentSnippetRepo(db *ent.Client) repository.ContentSnippetRepository {
+	return &contentSnippetRepo{db: db}
+}
+
+// toModel 将 ent 实体转换为领域模型。
+func (r *contentSnippetRepo) toModel(s *ent.ContentSnippet) *model.ContentSnippet {
+	if s == nil {
+		return nil
+	}
+	publicID, _ := idgen.GeneratePublicID(s.ID, idgen.EntityTypeContentSnippet)
+	return &model.ContentSnippet{
+		ID:          publicID,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+		Name:        s.Name,
+		Description: s.Description,
+		Content:     s.Content,
+		Sort:        s.Sort,
+	}
+}
+
+// Create 创建内容片段
+func (r *contentSnippetRepo) Create(ctx context.Context, req *model.SaveContentSnippetRequest) (*model.ContentSnippet, error) {
+	created, err := r.db.ContentSnippet.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetContent(req.Content).
+		SetSort(req.Sort).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("名称 '%s' 已存在: %w", req.Name, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 整体更新内容片段
+func (r *contentSnippetRepo) Update(ctx context.Context, publicID string, req *model.SaveContentSnippetRequest) (*model.ContentSnippet, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeContentSnippet)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.db.ContentSnippet.UpdateOneID(dbID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetContent(req.Content).
+		SetSort(req.Sort).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("名称 '%s' 已存在: %w", req.Name, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除内容片段
+func (r *contentSnippetRepo) Delete(ctx context.Context, publicID string) error {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeContentSnippet)
+	if err != nil {
+		return err
+	}
+	if err := r.db.ContentSnippet.DeleteOneID(dbID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return constant.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// List 获取全部内容片段
+func (r *contentSnippetRepo) List(ctx context.Context) ([]*model.ContentSnippet, error) {
+	entities, err := r.db.ContentSnippet.Query().
+		Order(ent.Asc(contentsnippet.FieldSort), ent.Asc(contentsnippet.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*model.ContentSnippet, len(entities))
+	for i, entity := range entities {
+		models[i] = r.toModel(entity)
+	}
+	return models, nil
+}
+
+// GetByID 根据ID获取内容片段
+func (r *contentSnippetRepo) GetByID(ctx context.Context, publicID string) (*model.ContentSnippet, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeContentSnippet)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.db.ContentSnippet.Get(ctx, dbID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
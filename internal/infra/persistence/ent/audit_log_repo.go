@@ -0,0 +1,108 @@
+/*
+ * @Description: 审计日志仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type entAuditLogRepo struct {
+	client *ent.Client
+}
+
+// NewEntAuditLogRepository 是 entAuditLogRepo 的构造函数
+func NewEntAuditLogRepository(client *ent.Client) repository.AuditLogRepository {
+	return &entAuditLogRepo{client: client}
+}
+
+func (r *entAuditLogRepo) Create(ctx context.Context, entry *model.AuditLog) error {
+	po, err := r.client.AuditLog.Create().
+		SetActorID(entry.ActorID).
+		SetSubjectUserID(entry.SubjectUserID).
+		SetAction(entry.Action).
+		SetEntityType(entry.EntityType).
+		SetEntityID(entry.EntityID).
+		SetMethod(entry.Method).
+		SetPath(entry.Path).
+		SetStatusCode(entry.StatusCode).
+		SetIP(entry.IP).
+		SetUserAgent(entry.UserAgent).
+		SetDetail(entry.Detail).
+		SetBeforeData(entry.Before).
+		SetAfterData(entry.After).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	entry.ID = po.ID
+	entry.CreatedAt = po.CreatedAt
+	return nil
+}
+
+func (r *entAuditLogRepo) List(ctx context.Context, q *model.AuditLogQuery) ([]*model.AuditLog, int, error) {
+	query := r.client.AuditLog.Query()
+	if q.ActorID != 0 {
+		query = query.Where(auditlog.ActorID(q.ActorID))
+	}
+	if q.SubjectUserID != 0 {
+		query = query.Where(auditlog.SubjectUserID(q.SubjectUserID))
+	}
+	if q.Action != "" {
+		query = query.Where(auditlog.Action(q.Action))
+	}
+	if q.EntityType != "" {
+		query = query.Where(auditlog.EntityType(q.EntityType))
+	}
+	if q.EntityID != "" {
+		query = query.Where(auditlog.EntityID(q.EntityID))
+	}
+	if q.StartTime != nil {
+		query = query.Where(auditlog.CreatedAtGTE(*q.StartTime))
+	}
+	if q.EndTime != nil {
+		query = query.Where(auditlog.CreatedAtLT(*q.EndTime))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	pos, err := query.
+		Order(ent.Desc(auditlog.FieldID)).
+		Limit(q.PageSize).
+		Offset((q.Page - 1) * q.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.AuditLog, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, &model.AuditLog{
+			ID:            po.ID,
+			CreatedAt:     po.CreatedAt,
+			ActorID:       po.ActorID,
+			SubjectUserID: po.SubjectUserID,
+			Action:        po.Action,
+			EntityType:    po.EntityType,
+			EntityID:      po.EntityID,
+			Method:        po.Method,
+			Path:          po.Path,
+			StatusCode:    po.StatusCode,
+			IP:            po.IP,
+			UserAgent:     po.UserAgent,
+			Detail:        po.Detail,
+			Before:        po.BeforeData,
+			After:         po.AfterData,
+		})
+	}
+	return result, total, nil
+}
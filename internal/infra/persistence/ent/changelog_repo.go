@@ -0,0 +1,169 @@
+/*
+ * @Description: 更新日志仓库的 ent 实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/changelogentry"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+type changelogRepo struct {
+	db *ent.Client
+}
+
+// NewChangelogRepo 是 changelogRepo 的构造函数。
+func NewChangelogRepo(db *ent.Client) repository.ChangelogRepository {
+	return &changelogRepo{db: db}
+}
+
+// toModel 将 ent 实体转换为领域模型
+func (r *changelogRepo) toModel(e *ent.ChangelogEntry) *model.ChangelogEntry {
+	publicID, _ := idgen.GeneratePublicID(e.ID, idgen.EntityTypeChangelog)
+	return &model.ChangelogEntry{
+		ID:           publicID,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+		Version:      e.Version,
+		Title:        e.Title,
+		Content:      e.Content,
+		ContentHTML:  e.ContentHTML,
+		URL:          e.URL,
+		Source:       string(e.Source),
+		IsPrerelease: e.IsPrerelease,
+		IsPublished:  e.IsPublished,
+		PublishedAt:  e.PublishedAt,
+	}
+}
+
+// Create 创建更新日志
+func (r *changelogRepo) Create(ctx context.Context, entry *model.ChangelogEntry) (*model.ChangelogEntry, error) {
+	created, err := r.db.ChangelogEntry.Create().
+		SetVersion(entry.Version).
+		SetTitle(entry.Title).
+		SetContent(entry.Content).
+		SetContentHTML(entry.ContentHTML).
+		SetURL(entry.URL).
+		SetSource(changelogentry.Source(entry.Source)).
+		SetIsPrerelease(entry.IsPrerelease).
+		SetIsPublished(entry.IsPublished).
+		SetPublishedAt(entry.PublishedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("版本 '%s' 已存在: %w", entry.Version, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 整体更新更新日志
+func (r *changelogRepo) Update(ctx context.Context, publicID string, entry *model.ChangelogEntry) (*model.ChangelogEntry, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeChangelog)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := r.db.ChangelogEntry.UpdateOneID(dbID).
+		SetVersion(entry.Version).
+		SetTitle(entry.Title).
+		SetContent(entry.Content).
+		SetContentHTML(entry.ContentHTML).
+		SetURL(entry.URL).
+		SetSource(changelogentry.Source(entry.Source)).
+		SetIsPrerelease(entry.IsPrerelease).
+		SetIsPublished(entry.IsPublished).
+		SetPublishedAt(entry.PublishedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("版本 '%s' 已存在: %w", entry.Version, constant.ErrConflict)
+		}
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除更新日志
+func (r *changelogRepo) Delete(ctx context.Context, publicID string) error {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeChangelog)
+	if err != nil {
+		return err
+	}
+	if err := r.db.ChangelogEntry.DeleteOneID(dbID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return constant.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID 根据ID获取更新日志
+func (r *changelogRepo) GetByID(ctx context.Context, publicID string) (*model.ChangelogEntry, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeChangelog)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.db.ChangelogEntry.Get(ctx, dbID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// GetByVersion 根据版本号获取更新日志
+func (r *changelogRepo) GetByVersion(ctx context.Context, version string) (*model.ChangelogEntry, error) {
+	entity, err := r.db.ChangelogEntry.Query().
+		Where(changelogentry.VersionEQ(version)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// List 按发布时间倒序分页获取更新日志
+func (r *changelogRepo) List(ctx context.Context, opts *model.ListChangelogOptions) ([]*model.ChangelogEntry, int, error) {
+	query := r.db.ChangelogEntry.Query()
+	if opts.PublishedOnly {
+		query = query.Where(changelogentry.IsPublishedEQ(true))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entities, err := query.
+		Order(ent.Desc(changelogentry.FieldPublishedAt), ent.Desc(changelogentry.FieldID)).
+		Offset((opts.Page - 1) * opts.PageSize).
+		Limit(opts.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entries := make([]*model.ChangelogEntry, len(entities))
+	for i, entity := range entities {
+		entries[i] = r.toModel(entity)
+	}
+	return entries, total, nil
+}
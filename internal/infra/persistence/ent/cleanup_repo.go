@@ -10,10 +10,16 @@ package ent
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 )
 
@@ -73,3 +79,156 @@ func (r *cleanupRepo) CleanupOrphanedTagsAndCategories(ctx context.Context) (int
 
 	return deletedTagsCount, deletedCategoriesCount, nil
 }
+
+// FindStalePendingComments 查找超期未审核的评论（含垃圾评论）。
+func (r *cleanupRepo) FindStalePendingComments(ctx context.Context, before time.Time) ([]uint, error) {
+	ids, err := r.db.Comment.Query().
+		Where(
+			comment.DeletedAtIsNil(),
+			comment.StatusIn(int(model.StatusPending), int(model.StatusSpam)),
+			comment.CreatedAtLT(before),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找超期待审核评论时出错: %w", err)
+	}
+	return ids, nil
+}
+
+// FindCommentsByAuthor 查找命中邮箱或IP规则的评论。
+// 邮箱后缀先用不区分大小写的包含匹配缩小范围，再在内存中确认后缀；IP 段需要逐条比较，仅在配置了网段时扫描。
+func (r *cleanupRepo) FindCommentsByAuthor(ctx context.Context, emails, emailDomains, ips []string, networks []*net.IPNet) ([]uint, error) {
+	var preds []predicate.Comment
+	for _, email := range emails {
+		preds = append(preds, comment.EmailEqualFold(email))
+	}
+	for _, domain := range emailDomains {
+		preds = append(preds, comment.EmailContainsFold(domain))
+	}
+	if len(ips) > 0 {
+		preds = append(preds, comment.IPAddressIn(ips...))
+	}
+	if len(networks) > 0 {
+		preds = append(preds, comment.IPAddressNEQ(""))
+	}
+	if len(preds) == 0 {
+		return nil, nil
+	}
+
+	candidates, err := r.db.Comment.Query().
+		Where(comment.DeletedAtIsNil(), comment.Or(preds...)).
+		Select(comment.FieldID, comment.FieldEmail, comment.FieldIPAddress).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找命中屏蔽规则的评论时出错: %w", err)
+	}
+
+	var ids []uint
+	for _, c := range candidates {
+		if authorMatches(c, emails, emailDomains, ips, networks) {
+			ids = append(ids, c.ID)
+		}
+	}
+	return ids, nil
+}
+
+func authorMatches(c *ent.Comment, emails, emailDomains, ips []string, networks []*net.IPNet) bool {
+	email := ""
+	if c.Email != nil {
+		email = strings.ToLower(*c.Email)
+	}
+	if email != "" {
+		for _, e := range emails {
+			if email == strings.ToLower(e) {
+				return true
+			}
+		}
+		for _, domain := range emailDomains {
+			if strings.HasSuffix(email, strings.ToLower(domain)) {
+				return true
+			}
+		}
+	}
+	if c.IPAddress == "" {
+		return false
+	}
+	for _, ip := range ips {
+		if c.IPAddress == ip {
+			return true
+		}
+	}
+	if addr := net.ParseIP(c.IPAddress); addr != nil {
+		for _, network := range networks {
+			if network.Contains(addr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FindOrphanedReplies 查找父评论已删除的回复，并沿父链向上找到最近一个未删除的祖先。
+func (r *cleanupRepo) FindOrphanedReplies(ctx context.Context) ([]repository.OrphanedReply, error) {
+	orphans, err := r.db.Comment.Query().
+		Where(
+			comment.DeletedAtIsNil(),
+			comment.HasParentWith(comment.DeletedAtNotNil()),
+		).
+		Select(comment.FieldID, comment.FieldParentID, comment.FieldReplyToID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找孤立回复时出错: %w", err)
+	}
+
+	// 祖先节点缓存，同一线程下的多条孤立回复只查询一次
+	nodes := make(map[uint]*ent.Comment)
+	load := func(id uint) (*ent.Comment, error) {
+		if n, ok := nodes[id]; ok {
+			return n, nil
+		}
+		n, err := r.db.Comment.Query().
+			Where(comment.ID(id)).
+			Select(comment.FieldID, comment.FieldParentID, comment.FieldDeletedAt).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			n, err = nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		nodes[id] = n
+		return n, nil
+	}
+
+	result := make([]repository.OrphanedReply, 0, len(orphans))
+	for _, o := range orphans {
+		reply := repository.OrphanedReply{ID: o.ID}
+		visited := map[uint]bool{o.ID: true}
+		for cur := o.ParentID; cur != nil && !visited[*cur]; {
+			visited[*cur] = true
+			n, err := load(*cur)
+			if err != nil {
+				return nil, fmt.Errorf("查找评论 %d 的祖先时出错: %w", o.ID, err)
+			}
+			if n == nil {
+				break
+			}
+			if n.DeletedAt == nil {
+				id := n.ID
+				reply.NewParentID = &id
+				break
+			}
+			cur = n.ParentID
+		}
+
+		if o.ReplyToID != nil {
+			target, err := load(*o.ReplyToID)
+			if err != nil {
+				return nil, fmt.Errorf("查找评论 %d 的回复目标时出错: %w", o.ID, err)
+			}
+			reply.ResetReplyTo = target == nil || target.DeletedAt != nil
+		}
+		result = append(result, reply)
+	}
+	return result, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	entcomment "github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentreaction"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 
@@ -45,24 +46,34 @@ func toDomain(c *ent.Comment) *model.Comment {
 		user = toDomainUser(c.Edges.User)
 	}
 
+	// like 回应的数量保存在 like_count 中（兼容旧版本的点赞数），其余回应保存在 reaction_counts 中
+	reactionCounts := make(map[string]int, len(c.ReactionCounts)+1)
+	for reaction, count := range c.ReactionCounts {
+		reactionCounts[reaction] = count
+	}
+	if c.LikeCount > 0 {
+		reactionCounts[model.ReactionLike] = c.LikeCount
+	}
+
 	domainComment := &model.Comment{
-		ID:            c.ID,
-		TargetPath:    c.TargetPath,
-		TargetTitle:   c.TargetTitle,
-		ParentID:      c.ParentID,
-		ReplyToID:     c.ReplyToID, // 添加 reply_to_id 映射
-		UserID:        c.UserID,
-		User:          user, // 添加关联的用户信息
-		Author:        model.Author{Nickname: c.Nickname, Email: c.Email, Website: c.Website, IP: c.IPAddress, UserAgent: ua, Location: loc},
-		Content:       c.Content,
-		ContentHTML:   c.ContentHTML,
-		LikeCount:     c.LikeCount,
-		Status:        model.Status(c.Status),
-		IsAdminAuthor: c.IsAdminComment,
-		IsAnonymous:   c.IsAnonymous,
-		CreatedAt:     c.CreatedAt,
-		UpdatedAt:     c.UpdatedAt,
-		PinnedAt:      c.PinnedAt,
+		ID:             c.ID,
+		TargetPath:     c.TargetPath,
+		TargetTitle:    c.TargetTitle,
+		ParentID:       c.ParentID,
+		ReplyToID:      c.ReplyToID, // 添加 reply_to_id 映射
+		UserID:         c.UserID,
+		User:           user, // 添加关联的用户信息
+		Author:         model.Author{Nickname: c.Nickname, Email: c.Email, Website: c.Website, IP: c.IPAddress, UserAgent: ua, Location: loc},
+		Content:        c.Content,
+		ContentHTML:    c.ContentHTML,
+		LikeCount:      c.LikeCount,
+		ReactionCounts: reactionCounts,
+		Status:         model.Status(c.Status),
+		IsAdminAuthor:  c.IsAdminComment,
+		IsAnonymous:    c.IsAnonymous,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+		PinnedAt:       c.PinnedAt,
 	}
 	return domainComment
 }
@@ -193,6 +204,25 @@ func (r *commentRepo) FindManyByIDs(ctx context.Context, ids []uint) ([]*model.C
 	return domainComments, nil
 }
 
+// FindAfterID 按ID升序返回ID大于 afterID 的未删除评论，父评论总是先于其回复返回。
+func (r *commentRepo) FindAfterID(ctx context.Context, afterID uint, limit int) ([]*model.Comment, error) {
+	entComments, err := r.db.Comment.Query().
+		Where(entcomment.DeletedAtIsNil(), entcomment.IDGT(afterID)).
+		Order(ent.Asc(entcomment.FieldID)).
+		Limit(limit).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainComments := make([]*model.Comment, len(entComments))
+	for i, c := range entComments {
+		domainComments[i] = toDomain(c)
+	}
+	return domainComments, nil
+}
+
 // FindAllPublishedPaginated 分页查找所有已发布的评论，按创建时间降序。
 func (r *commentRepo) FindAllPublishedPaginated(ctx context.Context, page, pageSize int) ([]*model.Comment, int64, error) {
 	// 构建基础查询，筛选未删除的、已发布的评论
@@ -229,19 +259,78 @@ func (r *commentRepo) FindAllPublishedPaginated(ctx context.Context, page, pageS
 	return domainComments, int64(total), nil
 }
 
-func (r *commentRepo) IncrementLikeCount(ctx context.Context, id uint) (*model.Comment, error) {
-	_, err := r.db.Comment.UpdateOneID(id).AddLikeCount(1).Save(ctx)
+func (r *commentRepo) AddReaction(ctx context.Context, id uint, reaction, visitorHash string) (*model.Comment, bool, error) {
+	err := r.db.CommentReaction.Create().
+		SetCommentID(id).
+		SetReaction(reaction).
+		SetVisitorHash(visitorHash).
+		Exec(ctx)
+	added := err == nil
+	if err != nil && !ent.IsConstraintError(err) {
+		return nil, false, err
+	}
+	// 唯一索引冲突说明该访客已经给出过这种回应，不重复计数
+	if added {
+		if err := r.applyReactionChange(ctx, id, reaction, 1); err != nil {
+			return nil, false, err
+		}
+	}
+	c, err := r.FindByID(ctx, id)
+	return c, added, err
+}
+
+func (r *commentRepo) RemoveReaction(ctx context.Context, id uint, reaction, visitorHash string) (*model.Comment, bool, error) {
+	n, err := r.db.CommentReaction.Delete().
+		Where(
+			commentreaction.CommentID(id),
+			commentreaction.Reaction(reaction),
+			commentreaction.VisitorHash(visitorHash),
+		).
+		Exec(ctx)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return r.FindByID(ctx, id)
+	if n > 0 {
+		if err := r.applyReactionChange(ctx, id, reaction, -1); err != nil {
+			return nil, false, err
+		}
+	}
+	c, err := r.FindByID(ctx, id)
+	return c, n > 0, err
 }
-func (r *commentRepo) DecrementLikeCount(ctx context.Context, id uint) (*model.Comment, error) {
-	_, err := r.db.Comment.UpdateOneID(id).AddLikeCount(-1).Save(ctx)
+
+// applyReactionChange 在回应记录变化后更新评论上的计数：
+// like 原子地增减 like_count，以保留旧版本无访客记录的点赞数；其余回应按回应表重新汇总
+func (r *commentRepo) applyReactionChange(ctx context.Context, id uint, reaction string, delta int) error {
+	if reaction == model.ReactionLike {
+		update := r.db.Comment.UpdateOneID(id).AddLikeCount(delta)
+		if delta < 0 {
+			update.Where(entcomment.LikeCountGT(0))
+		}
+		err := update.Exec(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	var rows []struct {
+		Reaction string `json:"reaction"`
+		Count    int    `json:"count"`
+	}
+	err := r.db.CommentReaction.Query().
+		Where(commentreaction.CommentID(id), commentreaction.ReactionNEQ(model.ReactionLike)).
+		GroupBy(commentreaction.FieldReaction).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return r.FindByID(ctx, id)
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Reaction] = row.Count
+	}
+	return r.db.Comment.UpdateOneID(id).SetReactionCounts(counts).Exec(ctx)
 }
 func (r *commentRepo) FindWithConditions(ctx context.Context, params repository.AdminListParams) ([]*model.Comment, int64, error) {
 	query := r.db.Comment.Query().Where(entcomment.DeletedAtIsNil())
@@ -267,6 +356,32 @@ func (r *commentRepo) FindWithConditions(ctx context.Context, params repository.
 	if params.Status != nil {
 		query = query.Where(entcomment.StatusEQ(*params.Status))
 	}
+	if params.EmailMD5 != nil && *params.EmailMD5 != "" {
+		query = query.Where(entcomment.EmailMd5EQ(*params.EmailMD5))
+	}
+	if params.HasLinks {
+		query = query.Where(entcomment.Or(
+			entcomment.ContentContains("http://"),
+			entcomment.ContentContains("https://"),
+			entcomment.ContentContains("www."),
+		))
+	}
+	if params.FirstTime {
+		// 除本条外，同一邮箱哈希没有任何未删除的已发布评论
+		query = query.Where(func(s *sql.Selector) {
+			other := sql.Table(entcomment.Table).As("c_prev")
+			s.Where(sql.NotExists(
+				sql.Select(other.C(entcomment.FieldID)).
+					From(other).
+					Where(sql.And(
+						sql.ColumnsEQ(other.C(entcomment.FieldEmailMd5), s.C(entcomment.FieldEmailMd5)),
+						sql.ColumnsNEQ(other.C(entcomment.FieldID), s.C(entcomment.FieldID)),
+						sql.EQ(other.C(entcomment.FieldStatus), int(model.StatusPublished)),
+						sql.IsNull(other.C(entcomment.FieldDeletedAt)),
+					)),
+			))
+		})
+	}
 
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -324,6 +439,120 @@ func (r *commentRepo) UpdateStatus(ctx context.Context, id uint, status model.St
 	}
 	return r.FindByID(ctx, id)
 }
+
+// UpdateStatusByIDs 批量更新评论状态
+func (r *commentRepo) UpdateStatusByIDs(ctx context.Context, ids []uint, status model.Status) (int, error) {
+	return r.db.Comment.Update().
+		Where(entcomment.IDIn(ids...), entcomment.DeletedAtIsNil()).
+		SetStatus(int(status)).
+		Save(ctx)
+}
+
+// CommenterStats 统计某位评论者的历史评论概况
+func (r *commentRepo) CommenterStats(ctx context.Context, emailMD5 string) (*model.CommenterStats, error) {
+	entComments, err := r.db.Comment.Query().
+		Where(entcomment.EmailMd5EQ(emailMD5), entcomment.DeletedAtIsNil()).
+		Select(entcomment.FieldNickname, entcomment.FieldStatus, entcomment.FieldIPAddress, entcomment.FieldCreatedAt).
+		Order(ent.Desc(entcomment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.CommenterStats{
+		EmailMD5:    emailMD5,
+		Nicknames:   []string{},
+		IPAddresses: []string{},
+	}
+	seenNick := make(map[string]bool)
+	seenIP := make(map[string]bool)
+	for i, c := range entComments {
+		switch model.Status(c.Status) {
+		case model.StatusPublished:
+			stats.PublishedCount++
+		case model.StatusPending:
+			stats.PendingCount++
+		}
+		if c.Nickname != "" && !seenNick[c.Nickname] {
+			seenNick[c.Nickname] = true
+			stats.Nicknames = append(stats.Nicknames, c.Nickname)
+		}
+		if c.IPAddress != "" && !seenIP[c.IPAddress] {
+			seenIP[c.IPAddress] = true
+			stats.IPAddresses = append(stats.IPAddresses, c.IPAddress)
+		}
+		createdAt := c.CreatedAt
+		if i == 0 {
+			stats.LastCommentAt = &createdAt
+		}
+		stats.FirstCommentAt = &createdAt
+	}
+	return stats, nil
+}
+
+func (r *commentRepo) CommenterFootprint(ctx context.Context, emailMD5 string, recentLimit int) (*model.CommenterFootprint, error) {
+	query := r.db.Comment.Query().
+		Where(
+			entcomment.EmailMd5EQ(emailMD5),
+			entcomment.StatusEQ(int(model.StatusPublished)),
+			entcomment.IsAnonymousEQ(false),
+			entcomment.DeletedAtIsNil(),
+		)
+	footprint := &model.CommenterFootprint{EmailMD5: emailMD5, Recent: []*model.Comment{}}
+	return r.footprint(ctx, query, footprint, recentLimit)
+}
+
+func (r *commentRepo) UserFootprint(ctx context.Context, userID uint, recentLimit int) (*model.CommenterFootprint, error) {
+	query := r.db.Comment.Query().
+		Where(
+			entcomment.UserIDEQ(userID),
+			entcomment.StatusEQ(int(model.StatusPublished)),
+			entcomment.IsAnonymousEQ(false),
+			entcomment.DeletedAtIsNil(),
+		)
+	footprint := &model.CommenterFootprint{Recent: []*model.Comment{}}
+	return r.footprint(ctx, query, footprint, recentLimit)
+}
+
+// footprint 统计 query 命中的评论数、首次/最近评论时间，并取最近 recentLimit 条评论
+func (r *commentRepo) footprint(ctx context.Context, query *ent.CommentQuery, footprint *model.CommenterFootprint, recentLimit int) (*model.CommenterFootprint, error) {
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	footprint.CommentCount = count
+	if count == 0 {
+		return footprint, nil
+	}
+
+	first, err := query.Clone().
+		Select(entcomment.FieldCreatedAt).
+		Order(ent.Asc(entcomment.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	firstAt := first.CreatedAt
+	footprint.FirstCommentAt = &firstAt
+
+	if recentLimit < 1 {
+		recentLimit = 1 // 至少取一条，用于最近评论时间与当前昵称
+	}
+	recent, err := query.Clone().
+		Order(ent.Desc(entcomment.FieldCreatedAt)).
+		Limit(recentLimit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastAt := recent[0].CreatedAt
+	footprint.LastCommentAt = &lastAt
+	for _, c := range recent {
+		footprint.Recent = append(footprint.Recent, toDomain(c))
+	}
+	return footprint, nil
+}
+
 func (r *commentRepo) SetPin(ctx context.Context, id uint, pinTime *time.Time) (*model.Comment, error) {
 	updater := r.db.Comment.UpdateOneID(id)
 	if pinTime != nil {
@@ -386,6 +615,21 @@ func (r *commentRepo) UpdatePath(ctx context.Context, oldPath, newPath string) (
 		Save(ctx)
 	return info, err
 }
+
+func (r *commentRepo) DistinctIPLocations(ctx context.Context) ([]string, error) {
+	return r.db.Comment.Query().
+		Where(entcomment.IPLocationNotNil(), entcomment.IPLocationNEQ("")).
+		Unique(true).
+		Select(entcomment.FieldIPLocation).
+		Strings(ctx)
+}
+
+func (r *commentRepo) ReplaceIPLocation(ctx context.Context, oldLocation, newLocation string) (int, error) {
+	return r.db.Comment.Update().
+		Where(entcomment.IPLocation(oldLocation)).
+		SetIPLocation(newLocation).
+		Save(ctx)
+}
 func (r *commentRepo) FindPublishedChildrenByParentID(ctx context.Context, parentID uint, page, pageSize int) ([]*model.Comment, int64, error) {
 	query := r.db.Comment.Query().
 		Where(
@@ -424,6 +668,16 @@ func min(a, b int) int {
 	return b
 }
 
+// CountPublished 统计全站已发布的评论数量
+func (r *commentRepo) CountPublished(ctx context.Context) (int, error) {
+	return r.db.Comment.Query().
+		Where(
+			entcomment.StatusEQ(int(model.StatusPublished)),
+			entcomment.DeletedAtIsNil(),
+		).
+		Count(ctx)
+}
+
 // CountByTargetPaths 批量统计多个文章的评论数量
 func (r *commentRepo) CountByTargetPaths(ctx context.Context, targetPaths []string) (map[string]int, error) {
 	if len(targetPaths) == 0 {
@@ -461,3 +715,106 @@ func (r *commentRepo) CountByTargetPaths(ctx context.Context, targetPaths []stri
 
 	return countMap, nil
 }
+
+// StatsByTargetPaths 批量统计多个文章的已发布/待审核评论数及最近评论时间。
+// 计数与最新评论ID在一次 GROUP BY 中完成，再按ID批量取回创建时间，避免依赖各数据库对 MAX(时间) 的返回格式差异。
+func (r *commentRepo) StatsByTargetPaths(ctx context.Context, targetPaths []string) (map[string]*model.CommentTargetStats, error) {
+	statsMap := make(map[string]*model.CommentTargetStats)
+	if len(targetPaths) == 0 {
+		return statsMap, nil
+	}
+
+	var results []struct {
+		TargetPath string `json:"target_path"`
+		Published  int    `json:"published"`
+		Pending    int    `json:"pending"`
+		LastID     uint   `json:"last_id"`
+	}
+
+	err := r.db.Comment.Query().
+		Where(
+			entcomment.TargetPathIn(targetPaths...),
+			entcomment.DeletedAtIsNil(),
+		).
+		Modify(func(s *sql.Selector) {
+			status := s.C(entcomment.FieldStatus)
+			s.Select(
+				s.C(entcomment.FieldTargetPath),
+				fmt.Sprintf("COUNT(CASE WHEN %s = %d THEN 1 END) AS published", status, model.StatusPublished),
+				fmt.Sprintf("COUNT(CASE WHEN %s = %d THEN 1 END) AS pending", status, model.StatusPending),
+				fmt.Sprintf("MAX(%s) AS last_id", s.C(entcomment.FieldID)),
+			).GroupBy(s.C(entcomment.FieldTargetPath))
+		}).
+		Scan(ctx, &results)
+	if err != nil {
+		log.Printf("[ERROR] StatsByTargetPaths: 统计查询失败: %v", err)
+		return nil, err
+	}
+	if len(results) == 0 {
+		return statsMap, nil
+	}
+
+	lastIDs := make([]uint, 0, len(results))
+	for _, result := range results {
+		statsMap[result.TargetPath] = &model.CommentTargetStats{
+			PublishedCount: result.Published,
+			PendingCount:   result.Pending,
+		}
+		lastIDs = append(lastIDs, result.LastID)
+	}
+
+	lastComments, err := r.db.Comment.Query().
+		Where(entcomment.IDIn(lastIDs...)).
+		Select(entcomment.FieldTargetPath, entcomment.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		log.Printf("[ERROR] StatsByTargetPaths: 查询最近评论时间失败: %v", err)
+		return nil, err
+	}
+	for _, c := range lastComments {
+		if stats, ok := statsMap[c.TargetPath]; ok {
+			createdAt := c.CreatedAt
+			stats.LastCommentAt = &createdAt
+		}
+	}
+
+	return statsMap, nil
+}
+
+// ReparentComments 在一个事务中调整孤立回复的父评论；提升为顶级评论时一并清空回复目标。
+func (r *commentRepo) ReparentComments(ctx context.Context, replies []repository.OrphanedReply) (int, error) {
+	if len(replies) == 0 {
+		return 0, nil
+	}
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	for _, reply := range replies {
+		update := tx.Comment.UpdateOneID(reply.ID)
+		switch {
+		case reply.NewParentID == nil:
+			update.ClearParentID().ClearReplyToID()
+		case reply.ResetReplyTo:
+			update.SetParentID(*reply.NewParentID).SetReplyToID(*reply.NewParentID)
+		default:
+			update.SetParentID(*reply.NewParentID)
+		}
+		if err := update.Exec(ctx); err != nil {
+			if rberr := tx.Rollback(); rberr != nil {
+				return 0, fmt.Errorf("调整评论 %d 失败后，回滚事务也失败: update_err=%v, rollback_err=%v", reply.ID, err, rberr)
+			}
+			return 0, fmt.Errorf("调整评论 %d 的父评论失败: %w", reply.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return len(replies), nil
+}
@@ -0,0 +1,142 @@
+/*
+ * @Description: 评论订阅仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 19:00:00
+ * @LastEditTime: 2026-10-16 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentsubscription"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type commentSubscriptionRepo struct {
+	client *ent.Client
+}
+
+// NewCommentSubscriptionRepo 是 commentSubscriptionRepo 的构造函数
+func NewCommentSubscriptionRepo(client *ent.Client) repository.CommentSubscriptionRepository {
+	return &commentSubscriptionRepo{client: client}
+}
+
+func (r *commentSubscriptionRepo) toModel(po *ent.CommentSubscription) *model.CommentSubscription {
+	return &model.CommentSubscription{
+		ID:            po.ID,
+		CreatedAt:     po.CreatedAt,
+		TargetPath:    po.TargetPath,
+		Email:         po.Email,
+		IsActive:      po.IsActive,
+		NotifiedUntil: po.NotifiedUntil,
+		PendingSince:  po.PendingSince,
+		LastSentAt:    po.LastSentAt,
+	}
+}
+
+func (r *commentSubscriptionRepo) Subscribe(ctx context.Context, targetPath, email string, now time.Time) (*model.CommentSubscription, error) {
+	existing, err := r.client.CommentSubscription.Query().
+		Where(
+			commentsubscription.TargetPathEQ(targetPath),
+			commentsubscription.EmailEQ(email),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.IsActive {
+			return r.toModel(existing), nil
+		}
+		po, err := r.client.CommentSubscription.UpdateOne(existing).
+			SetIsActive(true).
+			SetNotifiedUntil(now).
+			ClearPendingSince().
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return r.toModel(po), nil
+	}
+
+	po, err := r.client.CommentSubscription.Create().
+		SetTargetPath(targetPath).
+		SetEmail(email).
+		SetNotifiedUntil(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(po), nil
+}
+
+func (r *commentSubscriptionRepo) FindByID(ctx context.Context, id uint) (*model.CommentSubscription, error) {
+	po, err := r.client.CommentSubscription.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(po), nil
+}
+
+func (r *commentSubscriptionRepo) Deactivate(ctx context.Context, id uint) error {
+	return r.client.CommentSubscription.UpdateOneID(id).
+		SetIsActive(false).
+		ClearPendingSince().
+		Exec(ctx)
+}
+
+func (r *commentSubscriptionRepo) MarkPending(ctx context.Context, targetPath string, at time.Time) (int, error) {
+	return r.client.CommentSubscription.Update().
+		Where(
+			commentsubscription.TargetPathEQ(targetPath),
+			commentsubscription.IsActive(true),
+			commentsubscription.PendingSinceIsNil(),
+		).
+		SetPendingSince(at).
+		Save(ctx)
+}
+
+func (r *commentSubscriptionRepo) ListDue(ctx context.Context, sentBefore time.Time, limit int) ([]*model.CommentSubscription, error) {
+	pos, err := r.client.CommentSubscription.Query().
+		Where(
+			commentsubscription.IsActive(true),
+			commentsubscription.PendingSinceNotNil(),
+			commentsubscription.Or(
+				commentsubscription.LastSentAtIsNil(),
+				commentsubscription.LastSentAtLT(sentBefore),
+			),
+		).
+		Order(ent.Asc(commentsubscription.FieldPendingSince)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CommentSubscription, len(pos))
+	for i, po := range pos {
+		result[i] = r.toModel(po)
+	}
+	return result, nil
+}
+
+func (r *commentSubscriptionRepo) MarkSent(ctx context.Context, id uint, notifiedUntil time.Time, sentAt *time.Time) error {
+	update := r.client.CommentSubscription.UpdateOneID(id).
+		SetNotifiedUntil(notifiedUntil).
+		ClearPendingSince()
+	if sentAt != nil {
+		update.SetLastSentAt(*sentAt)
+	}
+	return update.Exec(ctx)
+}
+
+func (r *commentSubscriptionRepo) UpdatePath(ctx context.Context, oldPath, newPath string) (int, error) {
+	return r.client.CommentSubscription.Update().
+		Where(commentsubscription.TargetPathEQ(oldPath)).
+		SetTargetPath(newPath).
+		Save(ctx)
+}
@@ -0,0 +1,69 @@
+/*
+ * @Description: 评论者信任状态仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-15 23:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentertrust"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type commenterTrustRepo struct {
+	db *ent.Client
+}
+
+// NewCommenterTrustRepo 是 commenterTrustRepo 的构造函数。
+func NewCommenterTrustRepo(db *ent.Client) repository.CommenterTrustRepository {
+	return &commenterTrustRepo{db: db}
+}
+
+// GetStatus 获取评论者的信任状态，没有记录时返回空字符串
+func (r *commenterTrustRepo) GetStatus(ctx context.Context, emailMD5 string) (string, error) {
+	entity, err := r.db.CommenterTrust.Query().
+		Where(commentertrust.EmailMd5EQ(emailMD5)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(entity.Status), nil
+}
+
+// SetStatus 设置评论者的信任状态（不存在则创建）
+func (r *commenterTrustRepo) SetStatus(ctx context.Context, emailMD5, status string) error {
+	return r.db.CommenterTrust.Create().
+		SetEmailMd5(emailMD5).
+		SetStatus(commentertrust.Status(status)).
+		OnConflictColumns(commentertrust.FieldEmailMd5).
+		UpdateStatus().
+		UpdateUpdatedAt().
+		Exec(ctx)
+}
+
+// TrustIfAbsent 仅在尚无记录时将评论者标记为已信任
+func (r *commenterTrustRepo) TrustIfAbsent(ctx context.Context, emailMD5 string) error {
+	exists, err := r.db.CommenterTrust.Query().
+		Where(commentertrust.EmailMd5EQ(emailMD5)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	err = r.db.CommenterTrust.Create().
+		SetEmailMd5(emailMD5).
+		SetStatus(commentertrust.StatusTrusted).
+		Exec(ctx)
+	// 并发审核同一评论者时可能已被其他请求写入，视为成功
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,52 @@
+/*
+ * @Description: 一次性邮箱拦截计数仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 10:00:00
+ * @LastEditTime: 2026-10-18 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/disposableemailstat"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type disposableEmailStatRepo struct {
+	db *ent.Client
+}
+
+// NewDisposableEmailStatRepo 是 disposableEmailStatRepo 的构造函数。
+func NewDisposableEmailStatRepo(db *ent.Client) repository.DisposableEmailStatRepository {
+	return &disposableEmailStatRepo{db: db}
+}
+
+// Add 使用 upsert 原子地累加拦截次数
+func (r *disposableEmailStatRepo) Add(ctx context.Context, scope, action string, delta int64) error {
+	return r.db.DisposableEmailStat.Create().
+		SetScope(scope).
+		SetAction(action).
+		SetCount(delta).
+		OnConflictColumns(disposableemailstat.FieldScope, disposableemailstat.FieldAction).
+		Update(func(u *ent.DisposableEmailStatUpsert) {
+			u.AddCount(delta)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
+
+// Counts 返回所有累计的拦截次数
+func (r *disposableEmailStatRepo) Counts(ctx context.Context) (map[string]int64, error) {
+	entities, err := r.db.DisposableEmailStat.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(entities))
+	for _, e := range entities {
+		counts[e.Scope+":"+e.Action] = e.Count
+	}
+	return counts, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
+	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 )
 
 // entEntityRepository 是 EntityRepository 接口的 Ent 实现。
@@ -209,6 +210,44 @@ func (r *entEntityRepository) FindUploadingByOwnerID(ctx context.Context, ownerI
 	return domainEntities, nil
 }
 
+// ListByStoragePolicyIDAfter 按 ID 升序分批查找指定存储策略下 ID 大于 afterID 的实体。
+func (r *entEntityRepository) ListByStoragePolicyIDAfter(ctx context.Context, policyID, afterID uint, limit int) ([]*model.FileStorageEntity, error) {
+	entEntities, err := r.client.Entity.Query().
+		Where(entity.PolicyID(policyID), entity.IDGT(afterID)).
+		Order(ent.Asc(entity.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("分批查找存储策略下的实体失败: %w", err)
+	}
+
+	domainEntities := make([]*model.FileStorageEntity, len(entEntities))
+	for i, e := range entEntities {
+		domainEntities[i] = toDomainEntity(e)
+	}
+	return domainEntities, nil
+}
+
+// HardDeleteBatch 在事务中先删除文件版本关联，再永久删除实体记录。
+func (r *entEntityRepository) HardDeleteBatch(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.FileEntity.Delete().Where(fileentity.EntityIDIn(ids...)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("删除文件版本关联失败: %w", err)
+	}
+	if _, err := tx.Entity.Delete().Where(entity.IDIn(ids...)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("删除实体记录失败: %w", err)
+	}
+	return tx.Commit()
+}
+
 // Transaction 在一个数据库事务中执行一系列操作。
 // 如果函数返回错误，事务将回滚；否则，事务将提交。
 func (r *entEntityRepository) Transaction(ctx context.Context, fn func(repo repository.EntityRepository) error) error {
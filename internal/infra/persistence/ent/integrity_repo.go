@@ -0,0 +1,247 @@
+/*
+ * @Description: 文件、物理实体与存储策略之间的数据一致性检查
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 04:00:00
+ * @LastEditTime: 2026-10-17 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/entity"
+	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
+	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// integrityRepo 是 IntegrityRepository 的 Ent 实现。
+type integrityRepo struct {
+	db *ent.Client
+}
+
+// NewIntegrityRepo 是 integrityRepo 的构造函数。
+func NewIntegrityRepo(db *ent.Client) repository.IntegrityRepository {
+	return &integrityRepo{db: db}
+}
+
+// notPrimaryEntity 排除被任意文件（含已软删除的文件）作为主实体引用的实体。
+func notPrimaryEntity(s *sql.Selector) {
+	t := sql.Table(file.Table)
+	s.Where(sql.NotIn(
+		s.C(entity.FieldID),
+		sql.Select(t.C(file.FieldPrimaryEntityID)).
+			From(t).
+			Where(sql.NotNull(t.C(file.FieldPrimaryEntityID))),
+	))
+}
+
+// FindOrphanedEntities 查找没有任何文件引用的实体，上传中的临时实体由上传会话清理负责，这里不处理。
+func (r *integrityRepo) FindOrphanedEntities(ctx context.Context, before time.Time) ([]*model.FileStorageEntity, error) {
+	entities, err := r.db.Entity.Query().
+		Where(
+			entity.Or(entity.UploadSessionIDIsNil(), entity.UploadSessionIDEQ("")),
+			entity.CreatedAtLT(before),
+			entity.Not(entity.HasFileVersionsWith(fileentity.DeletedAtIsNil())),
+			notPrimaryEntity,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找孤立实体时出错: %w", err)
+	}
+	result := make([]*model.FileStorageEntity, len(entities))
+	for i, e := range entities {
+		result[i] = toDomainEntity(e)
+	}
+	return result, nil
+}
+
+// FindFilesWithMissingEntity 查找主实体已被删除的文件。
+func (r *integrityRepo) FindFilesWithMissingEntity(ctx context.Context) ([]*model.File, error) {
+	files, err := r.db.File.Query().
+		Where(
+			file.DeletedAtIsNil(),
+			file.Type(int(model.FileTypeFile)),
+			file.PrimaryEntityIDNotNil(),
+			file.Not(file.HasPrimaryEntity()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找缺失实体的文件时出错: %w", err)
+	}
+	result := make([]*model.File, len(files))
+	for i, f := range files {
+		result[i] = toDomainFile(f)
+	}
+	return result, nil
+}
+
+// FindDanglingFileVersions 查找实体已不存在的有效文件版本关联。
+func (r *integrityRepo) FindDanglingFileVersions(ctx context.Context) ([]uint, error) {
+	ids, err := r.db.FileEntity.Query().
+		Where(
+			fileentity.DeletedAtIsNil(),
+			fileentity.Not(fileentity.HasEntity()),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找无效文件版本关联时出错: %w", err)
+	}
+	return ids, nil
+}
+
+// FindEntitiesWithDeletedPolicy 查找存储策略已删除或不存在的实体。
+func (r *integrityRepo) FindEntitiesWithDeletedPolicy(ctx context.Context) ([]*model.FileStorageEntity, error) {
+	policyIDs, err := r.db.StoragePolicy.Query().
+		Where(storagepolicy.DeletedAtIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询存储策略时出错: %w", err)
+	}
+	query := r.db.Entity.Query()
+	if len(policyIDs) > 0 {
+		query = query.Where(entity.PolicyIDNotIn(policyIDs...))
+	}
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找策略已删除的实体时出错: %w", err)
+	}
+	result := make([]*model.FileStorageEntity, len(entities))
+	for i, e := range entities {
+		result[i] = toDomainEntity(e)
+	}
+	return result, nil
+}
+
+// FindChildrenCountMismatches 按 parent_id 统计未删除的直属子项，与目录记录的 children_count 比较。
+func (r *integrityRepo) FindChildrenCountMismatches(ctx context.Context) ([]repository.ChildrenCountMismatch, error) {
+	var counts []struct {
+		ParentID uint  `json:"parent_id"`
+		Count    int64 `json:"count"`
+	}
+	err := r.db.File.Query().
+		Where(file.DeletedAtIsNil(), file.ParentIDNotNil()).
+		GroupBy(file.FieldParentID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("统计目录子项数量时出错: %w", err)
+	}
+	actual := make(map[uint]int64, len(counts))
+	for _, c := range counts {
+		actual[c.ParentID] = c.Count
+	}
+
+	dirs, err := r.db.File.Query().
+		Where(file.DeletedAtIsNil(), file.Type(int(model.FileTypeDir))).
+		Select(file.FieldID, file.FieldOwnerID, file.FieldName, file.FieldChildrenCount).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询目录时出错: %w", err)
+	}
+	var result []repository.ChildrenCountMismatch
+	for _, d := range dirs {
+		if d.ChildrenCount == actual[d.ID] {
+			continue
+		}
+		result = append(result, repository.ChildrenCountMismatch{
+			FileID:   d.ID,
+			OwnerID:  d.OwnerID,
+			Name:     d.Name,
+			Recorded: d.ChildrenCount,
+			Actual:   actual[d.ID],
+		})
+	}
+	return result, nil
+}
+
+// RelinkOrTrashFile 在事务中把文件的主实体改为最新的有效版本，并同步文件大小与当前版本标记。
+func (r *integrityRepo) RelinkOrTrashFile(ctx context.Context, fileID uint) (bool, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("开启事务失败: %w", err)
+	}
+	relinked, err := relinkOrTrashFile(ctx, tx, fileID)
+	if err != nil {
+		if rberr := tx.Rollback(); rberr != nil {
+			return false, fmt.Errorf("修复文件 %d 失败后，回滚事务也失败: update_err=%v, rollback_err=%v", fileID, err, rberr)
+		}
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return relinked, nil
+}
+
+func relinkOrTrashFile(ctx context.Context, tx *ent.Tx, fileID uint) (bool, error) {
+	version, err := tx.FileEntity.Query().
+		Where(
+			fileentity.FileID(fileID),
+			fileentity.DeletedAtIsNil(),
+			fileentity.HasEntity(),
+		).
+		WithEntity().
+		Order(ent.Desc(fileentity.FieldCreatedAt), ent.Desc(fileentity.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		if err := tx.File.UpdateOneID(fileID).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+			return false, fmt.Errorf("软删除文件 %d 失败: %w", fileID, err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("查找文件 %d 的可用版本失败: %w", fileID, err)
+	}
+
+	if err := tx.File.UpdateOneID(fileID).
+		SetPrimaryEntityID(version.EntityID).
+		SetSize(version.Edges.Entity.Size).
+		Exec(ctx); err != nil {
+		return false, fmt.Errorf("重新关联文件 %d 的实体失败: %w", fileID, err)
+	}
+	if _, err := tx.FileEntity.Update().
+		Where(fileentity.FileID(fileID), fileentity.DeletedAtIsNil()).
+		SetIsCurrent(false).
+		Save(ctx); err != nil {
+		return false, fmt.Errorf("重置文件 %d 的版本标记失败: %w", fileID, err)
+	}
+	if err := tx.FileEntity.UpdateOneID(version.ID).SetIsCurrent(true).Exec(ctx); err != nil {
+		return false, fmt.Errorf("设置文件 %d 的当前版本失败: %w", fileID, err)
+	}
+	return true, nil
+}
+
+// DeleteFileVersions 删除指定的文件版本关联记录。
+func (r *integrityRepo) DeleteFileVersions(ctx context.Context, ids []uint) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	n, err := r.db.FileEntity.Delete().
+		Where(fileentity.IDIn(ids...), fileentity.DeletedAtIsNil()).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("删除文件版本关联时出错: %w", err)
+	}
+	return n, nil
+}
+
+// SetChildrenCounts 逐个更新目录的 children_count。
+func (r *integrityRepo) SetChildrenCounts(ctx context.Context, items []repository.ChildrenCountMismatch) (int, error) {
+	updated := 0
+	for _, item := range items {
+		if err := r.db.File.UpdateOneID(item.FileID).SetChildrenCount(item.Actual).Exec(ctx); err != nil {
+			return updated, fmt.Errorf("更新目录 %d 的子项数量失败: %w", item.FileID, err)
+		}
+		updated++
+	}
+	return updated, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * @Description: 注册邀请码仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type invitationCodeRepo struct {
+	client *ent.Client
+}
+
+// NewInvitationCodeRepo 是 invitationCodeRepo 的构造函数
+func NewInvitationCodeRepo(client *ent.Client) repository.InvitationCodeRepository {
+	return &invitationCodeRepo{client: client}
+}
+
+func (r *invitationCodeRepo) toModel(po *ent.InvitationCode) *model.InvitationCode {
+	return &model.InvitationCode{
+		ID:        po.ID,
+		CreatedAt: po.CreatedAt,
+		Code:      po.Code,
+		MaxUses:   po.MaxUses,
+		UsedCount: po.UsedCount,
+		ExpiresAt: po.ExpiresAt,
+		CreatedBy: po.CreatedBy,
+		Note:      po.Note,
+	}
+}
+
+func (r *invitationCodeRepo) Create(ctx context.Context, code *model.InvitationCode) error {
+	po, err := r.client.InvitationCode.Create().
+		SetCode(code.Code).
+		SetMaxUses(code.MaxUses).
+		SetNillableExpiresAt(code.ExpiresAt).
+		SetCreatedBy(code.CreatedBy).
+		SetNote(code.Note).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	*code = *r.toModel(po)
+	return nil
+}
+
+func (r *invitationCodeRepo) List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error) {
+	query := r.client.InvitationCode.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	pos, err := query.
+		Order(ent.Desc(invitationcode.FieldID)).
+		Limit(pageSize).
+		Offset((page - 1) * pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.InvitationCode, len(pos))
+	for i, po := range pos {
+		result[i] = r.toModel(po)
+	}
+	return result, total, nil
+}
+
+func (r *invitationCodeRepo) Delete(ctx context.Context, id uint) error {
+	err := r.client.InvitationCode.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return constant.ErrNotFound
+	}
+	return err
+}
+
+// Consume 通过带条件的 UPDATE 占用一次使用次数，并发注册时不会超额使用
+func (r *invitationCodeRepo) Consume(ctx context.Context, code string, now time.Time) (bool, error) {
+	affected, err := r.client.InvitationCode.Update().
+		Where(
+			invitationcode.Code(code),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invitationcode.FieldUsedCount), s.C(invitationcode.FieldMaxUses)))
+			},
+			invitationcode.Or(
+				invitationcode.ExpiresAtIsNil(),
+				invitationcode.ExpiresAtGT(now),
+			),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * @Description: 友链健康检查记录仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 14:00:00
+ * @LastEditTime: 2026-10-17 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/linkcheckrecord"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type linkCheckRecordRepo struct {
+	client *ent.Client
+}
+
+// NewLinkCheckRecordRepo 是 linkCheckRecordRepo 的构造函数。
+func NewLinkCheckRecordRepo(client *ent.Client) repository.LinkCheckRecordRepository {
+	return &linkCheckRecordRepo{client: client}
+}
+
+func (r *linkCheckRecordRepo) Create(ctx context.Context, record *model.LinkCheckRecordDTO) error {
+	create := r.client.LinkCheckRecord.Create().
+		SetLinkID(record.LinkID).
+		SetHealthy(record.Healthy).
+		SetStatusCode(record.StatusCode).
+		SetResponseTime(record.ResponseTime).
+		SetNillableSslExpireAt(record.SSLExpireAt)
+
+	if !record.CheckedAt.IsZero() {
+		create.SetCheckedAt(record.CheckedAt)
+	}
+	if record.Error != "" {
+		errMsg := []rune(record.Error)
+		if len(errMsg) > 500 {
+			errMsg = errMsg[:500]
+		}
+		create.SetError(string(errMsg))
+	}
+
+	saved, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	record.ID = saved.ID
+	record.CheckedAt = saved.CheckedAt
+	return nil
+}
+
+func (r *linkCheckRecordRepo) ListByLinkID(ctx context.Context, linkID int, limit int) ([]*model.LinkCheckRecordDTO, error) {
+	entities, err := r.client.LinkCheckRecord.Query().
+		Where(linkcheckrecord.LinkIDEQ(linkID)).
+		Order(ent.Desc(linkcheckrecord.FieldCheckedAt), ent.Desc(linkcheckrecord.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*model.LinkCheckRecordDTO, len(entities))
+	for i, e := range entities {
+		records[i] = &model.LinkCheckRecordDTO{
+			ID:           e.ID,
+			LinkID:       e.LinkID,
+			CheckedAt:    e.CheckedAt,
+			Healthy:      e.Healthy,
+			StatusCode:   e.StatusCode,
+			ResponseTime: e.ResponseTime,
+			Error:        e.Error,
+			SSLExpireAt:  e.SslExpireAt,
+		}
+	}
+	return records, nil
+}
+
+func (r *linkCheckRecordRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.LinkCheckRecord.Delete().
+		Where(linkcheckrecord.CheckedAtLT(before)).
+		Exec(ctx)
+}
+
+func (r *linkCheckRecordRepo) DeleteByLinkID(ctx context.Context, linkID int) error {
+	_, err := r.client.LinkCheckRecord.Delete().
+		Where(linkcheckrecord.LinkIDEQ(linkID)).
+		Exec(ctx)
+	return err
+}
@@ -119,7 +119,8 @@ func (r *linkRepo) AdminCreate(ctx context.Context, req *model.AdminCreateLinkRe
 		SetSiteshot(req.Siteshot).
 		SetCategoryID(req.CategoryID).
 		SetSortOrder(req.SortOrder).
-		SetSkipHealthCheck(req.SkipHealthCheck)
+		SetSkipHealthCheck(req.SkipHealthCheck).
+		SetCheckInterval(req.CheckInterval)
 
 	// 处理单个标签，验证标签是否存在
 	if req.TagID != nil {
@@ -188,6 +189,7 @@ func (r *linkRepo) Update(ctx context.Context, id int, req *model.AdminUpdateLin
 		SetCategoryID(req.CategoryID).
 		SetSortOrder(req.SortOrder).
 		SetSkipHealthCheck(req.SkipHealthCheck).
+		SetCheckInterval(req.CheckInterval).
 		ClearTags()
 
 	// 设置申请类型相关字段
@@ -334,6 +336,13 @@ func mapEntLinkToDTO(entLink *ent.Link) *model.LinkDTO {
 		UpdateReason:    entLink.UpdateReason,
 		SortOrder:       entLink.SortOrder,
 		SkipHealthCheck: entLink.SkipHealthCheck,
+
+		CheckInterval:       entLink.CheckInterval,
+		LastCheckedAt:       entLink.LastCheckedAt,
+		ConsecutiveFailures: entLink.ConsecutiveFailures,
+		LastResponseTime:    entLink.LastResponseTime,
+		SSLExpireAt:         entLink.SslExpireAt,
+		OriginalCategoryID:  entLink.OriginalCategoryID,
 	}
 	if entLink.Edges.Category != nil {
 		dto.Category = &model.LinkCategoryDTO{
@@ -503,3 +512,26 @@ func (r *linkRepo) BatchUpdateStatus(ctx context.Context, linkIDs []int, status
 		Save(ctx)
 	return err
 }
+
+// UpdateHealthState 写回一次健康检查后的统计数据，并按需更新状态与分类
+func (r *linkRepo) UpdateHealthState(ctx context.Context, id int, update *model.LinkHealthUpdate) error {
+	updater := r.client.Link.UpdateOneID(id).
+		SetLastCheckedAt(update.CheckedAt).
+		SetConsecutiveFailures(update.ConsecutiveFailures).
+		SetLastResponseTime(update.ResponseTime).
+		SetNillableSslExpireAt(update.SSLExpireAt)
+
+	if update.Status != "" {
+		updater.SetStatus(link.Status(update.Status))
+	}
+	if update.CategoryID != nil {
+		updater.SetCategoryID(*update.CategoryID)
+	}
+	if update.ClearOriginalCategory {
+		updater.ClearOriginalCategoryID()
+	} else if update.OriginalCategoryID != nil {
+		updater.SetOriginalCategoryID(*update.OriginalCategoryID)
+	}
+
+	return updater.Exec(ctx)
+}
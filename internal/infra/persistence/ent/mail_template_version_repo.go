@@ -0,0 +1,109 @@
+/*
+ * @Description: 邮件模板历史版本仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/mailtemplateversion"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type mailTemplateVersionRepo struct {
+	client *ent.Client
+}
+
+// NewMailTemplateVersionRepo 是 mailTemplateVersionRepo 的构造函数
+func NewMailTemplateVersionRepo(client *ent.Client) repository.MailTemplateVersionRepository {
+	return &mailTemplateVersionRepo{client: client}
+}
+
+func (r *mailTemplateVersionRepo) toModel(po *ent.MailTemplateVersion) *model.MailTemplateVersion {
+	return &model.MailTemplateVersion{
+		ID:        po.ID,
+		Key:       po.SettingKey,
+		Content:   po.Content,
+		CreatedAt: po.CreatedAt,
+	}
+}
+
+func (r *mailTemplateVersionRepo) Create(ctx context.Context, key, content string, keep int) (*model.MailTemplateVersion, error) {
+	po, err := r.client.MailTemplateVersion.Create().
+		SetSettingKey(key).
+		SetContent(content).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 清理超出保留数量的旧版本
+	staleIDs, err := r.client.MailTemplateVersion.Query().
+		Where(mailtemplateversion.SettingKeyEQ(key)).
+		Order(ent.Desc(mailtemplateversion.FieldCreatedAt), ent.Desc(mailtemplateversion.FieldID)).
+		Offset(keep).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(staleIDs) > 0 {
+		if _, err := r.client.MailTemplateVersion.Delete().
+			Where(mailtemplateversion.IDIn(staleIDs...)).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return r.toModel(po), nil
+}
+
+func (r *mailTemplateVersionRepo) ListByKey(ctx context.Context, key string) ([]*model.MailTemplateVersion, error) {
+	pos, err := r.client.MailTemplateVersion.Query().
+		Where(mailtemplateversion.SettingKeyEQ(key)).
+		Order(ent.Desc(mailtemplateversion.FieldCreatedAt), ent.Desc(mailtemplateversion.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.MailTemplateVersion, len(pos))
+	for i, po := range pos {
+		result[i] = r.toModel(po)
+	}
+	return result, nil
+}
+
+func (r *mailTemplateVersionRepo) CountByKeys(ctx context.Context, keys []string) (map[string]int, error) {
+	var rows []struct {
+		SettingKey string `json:"setting_key"`
+		Count      int    `json:"count"`
+	}
+	err := r.client.MailTemplateVersion.Query().
+		Where(mailtemplateversion.SettingKeyIn(keys...)).
+		GroupBy(mailtemplateversion.FieldSettingKey).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]int, len(rows))
+	for _, row := range rows {
+		result[row.SettingKey] = row.Count
+	}
+	return result, nil
+}
+
+func (r *mailTemplateVersionRepo) FindByID(ctx context.Context, id uint) (*model.MailTemplateVersion, error) {
+	po, err := r.client.MailTemplateVersion.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(po), nil
+}
@@ -28,6 +28,7 @@ func (r *entMetadataRepo) ResetThumbnailMetadataForFileIDs(ctx context.Context,
 		string(model.MetaKeyThumbError),
 		string(model.MetaKeyThumbRetryCount),
 		string(model.MetaKeyThumbFormat),
+		string(model.MetaKeyThumbVariants),
 	}
 
 	// 使用 Ent 的批量删除功能
@@ -0,0 +1,159 @@
+/*
+ * @Description: 说说仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/moment"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+type momentRepo struct {
+	db *ent.Client
+}
+
+// NewMomentRepo 是 momentRepo 的构造函数。
+func NewMomentRepo(db *ent.Client) repository.MomentRepository {
+	return &momentRepo{db: db}
+}
+
+// toModel 将 ent 实体转换为领域模型。
+func (r *momentRepo) toModel(m *ent.Moment) *model.Moment {
+	if m == nil {
+		return nil
+	}
+	publicID, _ := idgen.GeneratePublicID(m.ID, idgen.EntityTypeMoment)
+	images := m.Images
+	if images == nil {
+		images = []string{}
+	}
+	return &model.Moment{
+		ID:             publicID,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		Content:        m.Content,
+		ContentHTML:    m.ContentHTML,
+		Images:         images,
+		Location:       m.Location,
+		CommentEnabled: m.CommentEnabled,
+		IsPublic:       m.IsPublic,
+	}
+}
+
+// Create 发布说说
+func (r *momentRepo) Create(ctx context.Context, req *model.SaveMomentRequest, createdAt *time.Time) (*model.Moment, error) {
+	created, err := r.db.Moment.Create().
+		SetContent(req.Content).
+		SetContentHTML(req.ContentHTML).
+		SetImages(req.Images).
+		SetLocation(req.Location).
+		SetNillableCommentEnabled(req.CommentEnabled).
+		SetNillableIsPublic(req.IsPublic).
+		SetNillableCreatedAt(createdAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 整体更新说说，createdAt 为空时保留原发布时间
+func (r *momentRepo) Update(ctx context.Context, publicID string, req *model.SaveMomentRequest, createdAt *time.Time) (*model.Moment, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeMoment)
+	if err != nil {
+		return nil, err
+	}
+	commentEnabled := true
+	if req.CommentEnabled != nil {
+		commentEnabled = *req.CommentEnabled
+	}
+	isPublic := true
+	if req.IsPublic != nil {
+		isPublic = *req.IsPublic
+	}
+	updated, err := r.db.Moment.UpdateOneID(dbID).
+		SetContent(req.Content).
+		SetContentHTML(req.ContentHTML).
+		SetImages(req.Images).
+		SetLocation(req.Location).
+		SetCommentEnabled(commentEnabled).
+		SetIsPublic(isPublic).
+		SetNillableCreatedAt(createdAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除说说
+func (r *momentRepo) Delete(ctx context.Context, publicID string) error {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeMoment)
+	if err != nil {
+		return err
+	}
+	if err := r.db.Moment.DeleteOneID(dbID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return constant.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID 根据ID获取说说
+func (r *momentRepo) GetByID(ctx context.Context, publicID string) (*model.Moment, error) {
+	dbID, err := decodeTypedID(publicID, idgen.EntityTypeMoment)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.db.Moment.Get(ctx, dbID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// List 按发布时间倒序分页查询说说
+func (r *momentRepo) List(ctx context.Context, opts *model.ListMomentsOptions) ([]*model.Moment, int, error) {
+	query := r.db.Moment.Query()
+	if opts.PublicOnly {
+		query = query.Where(moment.IsPublic(true))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entities, err := query.
+		Order(ent.Desc(moment.FieldCreatedAt), ent.Desc(moment.FieldID)).
+		Limit(opts.PageSize).
+		Offset((opts.Page - 1) * opts.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	models := make([]*model.Moment, len(entities))
+	for i, entity := range entities {
+		models[i] = r.toModel(entity)
+	}
+	return models, total, nil
+}
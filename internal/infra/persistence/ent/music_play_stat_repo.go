@@ -0,0 +1,76 @@
+/*
+ * @Description: 音乐播放统计仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 15:00:00
+ * @LastEditTime: 2026-10-15 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/musicplaystat"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type musicPlayStatRepo struct {
+	db *ent.Client
+}
+
+// NewMusicPlayStatRepo 是 musicPlayStatRepo 的构造函数。
+func NewMusicPlayStatRepo(db *ent.Client) repository.MusicPlayStatRepository {
+	return &musicPlayStatRepo{db: db}
+}
+
+// Record 使用 upsert 原子地累加播放次数和收听时长
+func (r *musicPlayStatRepo) Record(ctx context.Context, period string, report *model.MusicPlayReport) error {
+	return r.db.MusicPlayStat.Create().
+		SetPeriod(period).
+		SetSongID(report.SongID).
+		SetSongName(report.Name).
+		SetArtist(report.Artist).
+		SetPlayCount(1).
+		SetTotalDuration(int64(report.Duration)).
+		OnConflictColumns(musicplaystat.FieldPeriod, musicplaystat.FieldSongID).
+		Update(func(u *ent.MusicPlayStatUpsert) {
+			u.AddPlayCount(1)
+			u.AddTotalDuration(int64(report.Duration))
+			if report.Name != "" {
+				u.SetSongName(report.Name)
+			}
+			if report.Artist != "" {
+				u.SetArtist(report.Artist)
+			}
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
+
+// ListByPeriods 返回月份区间内的所有汇总行
+func (r *musicPlayStatRepo) ListByPeriods(ctx context.Context, from, to string) ([]*model.MusicPlayStat, error) {
+	entities, err := r.db.MusicPlayStat.Query().
+		Where(
+			musicplaystat.PeriodGTE(from),
+			musicplaystat.PeriodLTE(to),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*model.MusicPlayStat, len(entities))
+	for i, e := range entities {
+		stats[i] = &model.MusicPlayStat{
+			Period:        e.Period,
+			SongID:        e.SongID,
+			Name:          e.SongName,
+			Artist:        e.Artist,
+			PlayCount:     e.PlayCount,
+			TotalDuration: e.TotalDuration,
+		}
+	}
+	return stats, nil
+}
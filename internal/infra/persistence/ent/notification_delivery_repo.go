@@ -0,0 +1,202 @@
+/*
+ * @Description: 通知投递队列仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/notificationdelivery"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type entNotificationDeliveryRepo struct {
+	client *ent.Client
+}
+
+// NewEntNotificationDeliveryRepository 是 entNotificationDeliveryRepo 的构造函数
+func NewEntNotificationDeliveryRepository(client *ent.Client) repository.NotificationDeliveryRepository {
+	return &entNotificationDeliveryRepo{client: client}
+}
+
+func (r *entNotificationDeliveryRepo) Create(ctx context.Context, d *model.NotificationDelivery) error {
+	create := r.client.NotificationDelivery.Create().
+		SetChannel(d.Channel).
+		SetKind(d.Kind).
+		SetRecipient(d.Recipient).
+		SetSubject(d.Subject).
+		SetPayload(d.Payload).
+		SetMaxAttempts(d.MaxAttempts)
+	if !d.NextAttemptAt.IsZero() {
+		create.SetNextAttemptAt(d.NextAttemptAt)
+	}
+	po, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	*d = *toDomainNotificationDelivery(po)
+	return nil
+}
+
+func (r *entNotificationDeliveryRepo) FindByID(ctx context.Context, id uint) (*model.NotificationDelivery, error) {
+	po, err := r.client.NotificationDelivery.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainNotificationDelivery(po), nil
+}
+
+func (r *entNotificationDeliveryRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.NotificationDelivery, error) {
+	pos, err := r.client.NotificationDelivery.Query().
+		Where(
+			notificationdelivery.StatusEQ(notificationdelivery.StatusPENDING),
+			notificationdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(notificationdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainNotificationDeliveries(pos), nil
+}
+
+func (r *entNotificationDeliveryRepo) Claim(ctx context.Context, id uint) (bool, error) {
+	n, err := r.client.NotificationDelivery.Update().
+		Where(
+			notificationdelivery.ID(id),
+			notificationdelivery.StatusEQ(notificationdelivery.StatusPENDING),
+		).
+		SetStatus(notificationdelivery.StatusSENDING).
+		AddAttempts(1).
+		Save(ctx)
+	return n == 1, err
+}
+
+func (r *entNotificationDeliveryRepo) MarkSent(ctx context.Context, id uint, sentAt time.Time) error {
+	return r.client.NotificationDelivery.UpdateOneID(id).
+		SetStatus(notificationdelivery.StatusSENT).
+		SetSentAt(sentAt).
+		ClearLastError().
+		Exec(ctx)
+}
+
+func (r *entNotificationDeliveryRepo) MarkRetry(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+	return r.client.NotificationDelivery.UpdateOneID(id).
+		SetStatus(notificationdelivery.StatusPENDING).
+		SetNextAttemptAt(nextAttemptAt).
+		SetLastError(lastError).
+		Exec(ctx)
+}
+
+func (r *entNotificationDeliveryRepo) MarkFailed(ctx context.Context, id uint, lastError string) error {
+	return r.client.NotificationDelivery.UpdateOneID(id).
+		SetStatus(notificationdelivery.StatusFAILED).
+		SetLastError(lastError).
+		Exec(ctx)
+}
+
+func (r *entNotificationDeliveryRepo) Requeue(ctx context.Context, id uint) error {
+	return r.client.NotificationDelivery.UpdateOneID(id).
+		SetStatus(notificationdelivery.StatusPENDING).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *entNotificationDeliveryRepo) ResetSending(ctx context.Context) (int, error) {
+	return r.client.NotificationDelivery.Update().
+		Where(notificationdelivery.StatusEQ(notificationdelivery.StatusSENDING)).
+		SetStatus(notificationdelivery.StatusPENDING).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+}
+
+func (r *entNotificationDeliveryRepo) List(ctx context.Context, q *model.NotificationDeliveryQuery) ([]*model.NotificationDelivery, int, error) {
+	query := r.client.NotificationDelivery.Query()
+	if q.Channel != "" {
+		query = query.Where(notificationdelivery.Channel(q.Channel))
+	}
+	if q.Status != "" {
+		query = query.Where(notificationdelivery.StatusEQ(notificationdelivery.Status(q.Status)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	pos, err := query.
+		Order(ent.Desc(notificationdelivery.FieldID)).
+		Limit(q.PageSize).
+		Offset((q.Page - 1) * q.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return toDomainNotificationDeliveries(pos), total, nil
+}
+
+func (r *entNotificationDeliveryRepo) CountByChannelAndStatus(ctx context.Context) ([]*model.NotificationDeliveryStat, error) {
+	var rows []struct {
+		Channel string `json:"channel"`
+		Status  string `json:"status"`
+		Count   int    `json:"count"`
+	}
+	err := r.client.NotificationDelivery.Query().
+		GroupBy(notificationdelivery.FieldChannel, notificationdelivery.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]*model.NotificationDeliveryStat, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, &model.NotificationDeliveryStat{Channel: row.Channel, Status: row.Status, Count: row.Count})
+	}
+	return stats, nil
+}
+
+func (r *entNotificationDeliveryRepo) DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.NotificationDelivery.Delete().
+		Where(
+			notificationdelivery.StatusIn(notificationdelivery.StatusSENT, notificationdelivery.StatusFAILED),
+			notificationdelivery.UpdatedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+func toDomainNotificationDeliveries(pos []*ent.NotificationDelivery) []*model.NotificationDelivery {
+	result := make([]*model.NotificationDelivery, 0, len(pos))
+	for _, po := range pos {
+		result = append(result, toDomainNotificationDelivery(po))
+	}
+	return result
+}
+
+func toDomainNotificationDelivery(po *ent.NotificationDelivery) *model.NotificationDelivery {
+	return &model.NotificationDelivery{
+		ID:            po.ID,
+		CreatedAt:     po.CreatedAt,
+		UpdatedAt:     po.UpdatedAt,
+		Channel:       po.Channel,
+		Kind:          po.Kind,
+		Recipient:     po.Recipient,
+		Subject:       po.Subject,
+		Payload:       po.Payload,
+		Status:        string(po.Status),
+		Attempts:      po.Attempts,
+		MaxAttempts:   po.MaxAttempts,
+		NextAttemptAt: po.NextAttemptAt,
+		LastError:     po.LastError,
+		SentAt:        po.SentAt,
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * @Description: 用户阅读进度仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/readingprogress"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type readingProgressRepo struct {
+	db *ent.Client
+}
+
+// NewReadingProgressRepo 是 readingProgressRepo 的构造函数。
+func NewReadingProgressRepo(db *ent.Client) repository.ReadingProgressRepository {
+	return &readingProgressRepo{db: db}
+}
+
+func (r *readingProgressRepo) toModel(p *ent.ReadingProgress) *model.ReadingProgress {
+	return &model.ReadingProgress{
+		ArticleID: p.ArticleID,
+		Progress:  p.Progress,
+		Anchor:    p.Anchor,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// Save 使用 upsert 写入阅读进度，同一用户同一文章只保留一条记录
+func (r *readingProgressRepo) Save(ctx context.Context, userID uint, progress *model.ReadingProgress) (*model.ReadingProgress, error) {
+	err := r.db.ReadingProgress.Create().
+		SetUserID(userID).
+		SetArticleID(progress.ArticleID).
+		SetProgress(progress.Progress).
+		SetAnchor(progress.Anchor).
+		OnConflictColumns(readingprogress.FieldUserID, readingprogress.FieldArticleID).
+		Update(func(u *ent.ReadingProgressUpsert) {
+			u.UpdateProgress()
+			u.UpdateAnchor()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.Get(ctx, userID, progress.ArticleID)
+}
+
+// Get 获取用户在文章中的阅读进度
+func (r *readingProgressRepo) Get(ctx context.Context, userID, articleID uint) (*model.ReadingProgress, error) {
+	entity, err := r.db.ReadingProgress.Query().
+		Where(
+			readingprogress.UserIDEQ(userID),
+			readingprogress.ArticleIDEQ(articleID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, constant.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(entity), nil
+}
+
+// Delete 删除用户在文章中的阅读进度
+func (r *readingProgressRepo) Delete(ctx context.Context, userID, articleID uint) error {
+	_, err := r.db.ReadingProgress.Delete().
+		Where(
+			readingprogress.UserIDEQ(userID),
+			readingprogress.ArticleIDEQ(articleID),
+		).
+		Exec(ctx)
+	return err
+}
+
+// List 按最近阅读时间倒序分页获取用户的阅读进度
+func (r *readingProgressRepo) List(ctx context.Context, userID uint, page, pageSize int) ([]*model.ReadingProgress, int, error) {
+	query := r.db.ReadingProgress.Query().Where(readingprogress.UserIDEQ(userID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entities, err := query.
+		Order(ent.Desc(readingprogress.FieldUpdatedAt), ent.Desc(readingprogress.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*model.ReadingProgress, len(entities))
+	for i, entity := range entities {
+		list[i] = r.toModel(entity)
+	}
+	return list, total, nil
+}
@@ -0,0 +1,133 @@
+/*
+ * @Description: 回收站仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 12:00:00
+ * @LastEditTime: 2026-10-17 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/recycleitem"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type recycleItemRepo struct {
+	db *ent.Client
+}
+
+// NewRecycleItemRepo 是 recycleItemRepo 的构造函数。
+func NewRecycleItemRepo(db *ent.Client) repository.RecycleItemRepository {
+	return &recycleItemRepo{db: db}
+}
+
+func toDomainRecycleItem(e *ent.RecycleItem) *model.RecycleItem {
+	return &model.RecycleItem{
+		ID:               e.ID,
+		OwnerID:          e.OwnerID,
+		FileID:           e.FileID,
+		OriginalName:     e.OriginalName,
+		OriginalParentID: e.OriginalParentID,
+		OriginalPath:     e.OriginalPath,
+		FileType:         model.FileType(e.FileType),
+		Size:             e.Size,
+		TrashedAt:        e.TrashedAt,
+		ExpireAt:         e.ExpireAt,
+	}
+}
+
+func toDomainRecycleItems(entities []*ent.RecycleItem) []*model.RecycleItem {
+	items := make([]*model.RecycleItem, len(entities))
+	for i, e := range entities {
+		items[i] = toDomainRecycleItem(e)
+	}
+	return items
+}
+
+func (r *recycleItemRepo) Create(ctx context.Context, item *model.RecycleItem) error {
+	builder := r.db.RecycleItem.Create().
+		SetOwnerID(item.OwnerID).
+		SetFileID(item.FileID).
+		SetOriginalName(item.OriginalName).
+		SetOriginalParentID(item.OriginalParentID).
+		SetOriginalPath(item.OriginalPath).
+		SetFileType(int(item.FileType)).
+		SetSize(item.Size).
+		SetExpireAt(item.ExpireAt)
+	if !item.TrashedAt.IsZero() {
+		builder.SetTrashedAt(item.TrashedAt)
+	}
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	item.ID = created.ID
+	item.TrashedAt = created.TrashedAt
+	return nil
+}
+
+func (r *recycleItemRepo) FindByFileID(ctx context.Context, fileID uint) (*model.RecycleItem, error) {
+	e, err := r.db.RecycleItem.Query().
+		Where(recycleitem.FileID(fileID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainRecycleItem(e), nil
+}
+
+func (r *recycleItemRepo) ListByOwnerID(ctx context.Context, ownerID uint, page, pageSize int) ([]*model.RecycleItem, int, error) {
+	query := r.db.RecycleItem.Query().Where(recycleitem.OwnerID(ownerID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entities, err := query.
+		Order(ent.Desc(recycleitem.FieldTrashedAt), ent.Desc(recycleitem.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return toDomainRecycleItems(entities), total, nil
+}
+
+func (r *recycleItemRepo) ListAllByOwnerID(ctx context.Context, ownerID uint) ([]*model.RecycleItem, error) {
+	entities, err := r.db.RecycleItem.Query().
+		Where(recycleitem.OwnerID(ownerID)).
+		Order(ent.Asc(recycleitem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRecycleItems(entities), nil
+}
+
+func (r *recycleItemRepo) FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.RecycleItem, error) {
+	entities, err := r.db.RecycleItem.Query().
+		Where(recycleitem.ExpireAtLT(before)).
+		Order(ent.Asc(recycleitem.FieldExpireAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRecycleItems(entities), nil
+}
+
+func (r *recycleItemRepo) DeleteByFileID(ctx context.Context, fileID uint) error {
+	_, err := r.db.RecycleItem.Delete().
+		Where(recycleitem.FileID(fileID)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,72 @@
+/*
+ * @Description: 贝叶斯反垃圾过滤器词条计数仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 20:00:00
+ * @LastEditTime: 2026-10-16 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/spamtoken"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type spamTokenRepo struct {
+	db *ent.Client
+}
+
+// NewSpamTokenRepo 是 spamTokenRepo 的构造函数。
+func NewSpamTokenRepo(db *ent.Client) repository.SpamTokenRepository {
+	return &spamTokenRepo{db: db}
+}
+
+// Counts 获取给定词条的计数
+func (r *spamTokenRepo) Counts(ctx context.Context, tokens []string) (map[string]model.SpamTokenCount, error) {
+	result := make(map[string]model.SpamTokenCount, len(tokens))
+	if len(tokens) == 0 {
+		return result, nil
+	}
+	entities, err := r.db.SpamToken.Query().
+		Where(spamtoken.TokenIn(tokens...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entities {
+		result[e.Token] = model.SpamTokenCount{Spam: e.SpamCount, Ham: e.HamCount}
+	}
+	return result, nil
+}
+
+// Train 将一条样本的词条计入垃圾或正常类别（不存在的词条自动创建）
+func (r *spamTokenRepo) Train(ctx context.Context, tokens []string, spam bool) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	builders := make([]*ent.SpamTokenCreate, len(tokens))
+	for i, token := range tokens {
+		builder := r.db.SpamToken.Create().SetToken(token)
+		if spam {
+			builder.SetSpamCount(1)
+		} else {
+			builder.SetHamCount(1)
+		}
+		builders[i] = builder
+	}
+	return r.db.SpamToken.CreateBulk(builders...).
+		OnConflictColumns(spamtoken.FieldToken).
+		Update(func(u *ent.SpamTokenUpsert) {
+			if spam {
+				u.AddSpamCount(1)
+			} else {
+				u.AddHamCount(1)
+			}
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+}
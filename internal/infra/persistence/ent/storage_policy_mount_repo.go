@@ -0,0 +1,135 @@
+/*
+ * @Description: 存储策略挂载点仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicymount"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type entStoragePolicyMountRepo struct {
+	client *ent.Client
+}
+
+// NewEntStoragePolicyMountRepository 是 entStoragePolicyMountRepo 的构造函数
+func NewEntStoragePolicyMountRepository(client *ent.Client) repository.StoragePolicyMountRepository {
+	return &entStoragePolicyMountRepo{client: client}
+}
+
+func (r *entStoragePolicyMountRepo) Create(ctx context.Context, mount *model.StoragePolicyMount) error {
+	created, err := r.client.StoragePolicyMount.Create().
+		SetPolicyID(mount.PolicyID).
+		SetVirtualPath(mount.VirtualPath).
+		SetReadOnly(mount.ReadOnly).
+		SetNillableNodeID(mount.NodeID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	mount.ID = created.ID
+	mount.CreatedAt = created.CreatedAt
+	mount.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *entStoragePolicyMountRepo) Update(ctx context.Context, mount *model.StoragePolicyMount) error {
+	updated, err := r.client.StoragePolicyMount.UpdateOneID(mount.ID).
+		SetVirtualPath(mount.VirtualPath).
+		SetReadOnly(mount.ReadOnly).
+		SetNillableNodeID(mount.NodeID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	mount.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *entStoragePolicyMountRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.StoragePolicyMount.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *entStoragePolicyMountRepo) FindByID(ctx context.Context, id uint) (*model.StoragePolicyMount, error) {
+	po, err := r.client.StoragePolicyMount.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainStoragePolicyMount(po), nil
+}
+
+func (r *entStoragePolicyMountRepo) FindByVirtualPath(ctx context.Context, path string) (*model.StoragePolicyMount, error) {
+	po, err := r.client.StoragePolicyMount.Query().
+		Where(storagepolicymount.VirtualPath(path)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainStoragePolicyMount(po), nil
+}
+
+func (r *entStoragePolicyMountRepo) ListAll(ctx context.Context) ([]*model.StoragePolicyMount, error) {
+	pos, err := r.client.StoragePolicyMount.Query().
+		Order(ent.Asc(storagepolicymount.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainStoragePolicyMounts(pos), nil
+}
+
+func (r *entStoragePolicyMountRepo) ListByPolicyID(ctx context.Context, policyID uint) ([]*model.StoragePolicyMount, error) {
+	pos, err := r.client.StoragePolicyMount.Query().
+		Where(storagepolicymount.PolicyID(policyID)).
+		Order(ent.Asc(storagepolicymount.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainStoragePolicyMounts(pos), nil
+}
+
+func (r *entStoragePolicyMountRepo) DeleteByPolicyID(ctx context.Context, policyID uint) ([]*model.StoragePolicyMount, error) {
+	mounts, err := r.ListByPolicyID(ctx, policyID)
+	if err != nil || len(mounts) == 0 {
+		return mounts, err
+	}
+	_, err = r.client.StoragePolicyMount.Delete().
+		Where(storagepolicymount.PolicyID(policyID)).
+		Exec(ctx)
+	return mounts, err
+}
+
+func toDomainStoragePolicyMount(po *ent.StoragePolicyMount) *model.StoragePolicyMount {
+	return &model.StoragePolicyMount{
+		ID:          po.ID,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+		PolicyID:    po.PolicyID,
+		VirtualPath: po.VirtualPath,
+		ReadOnly:    po.ReadOnly,
+		NodeID:      po.NodeID,
+	}
+}
+
+func toDomainStoragePolicyMounts(pos []*ent.StoragePolicyMount) []*model.StoragePolicyMount {
+	mounts := make([]*model.StoragePolicyMount, len(pos))
+	for i, po := range pos {
+		mounts[i] = toDomainStoragePolicyMount(po)
+	}
+	return mounts
+}
@@ -67,6 +67,11 @@ func (tm *entTransactionManager) Do(ctx context.Context, fn func(repos repositor
 		Link:           NewLinkRepo(tx.Client(), tm.dbType),
 		LinkCategory:   NewLinkCategoryRepo(tx.Client()),
 		LinkTag:        NewLinkTagRepo(tx.Client()),
+
+		StoragePolicyMount: NewEntStoragePolicyMountRepository(tx.Client()),
+		InvitationCode:     NewInvitationCodeRepo(tx.Client()),
+		UploadSession:      NewUploadSessionRepo(tx.Client()),
+		RecycleItem:        NewRecycleItemRepo(tx.Client()),
 	}
 
 	// 执行业务逻辑
@@ -0,0 +1,194 @@
+/*
+ * @Description: 分片上传会话仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 09:00:00
+ * @LastEditTime: 2026-10-17 09:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/uploadsession"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// maxUploadSessionUpdateRetries 并发更新同一会话时的最大重试次数，足以覆盖客户端常见的并发分片数
+const maxUploadSessionUpdateRetries = 20
+
+type uploadSessionRepo struct {
+	db *ent.Client
+}
+
+// NewUploadSessionRepo 是 uploadSessionRepo 的构造函数。
+func NewUploadSessionRepo(db *ent.Client) repository.UploadSessionRepository {
+	return &uploadSessionRepo{db: db}
+}
+
+func toDomainUploadSession(e *ent.UploadSession) *model.UploadSession {
+	chunks := make(map[int]bool, len(e.UploadedChunks))
+	for _, index := range e.UploadedChunks {
+		chunks[index] = true
+	}
+	return &model.UploadSession{
+		SessionID:      e.SessionID,
+		OwnerID:        e.OwnerID,
+		PolicyID:       e.PolicyID,
+		URI:            e.URI,
+		ChunkSize:      e.ChunkSize,
+		FileSize:       e.FileSize,
+		TempEntityID:   e.TempEntityID,
+		UploadedChunks: chunks,
+		ExpireAt:       e.ExpireAt,
+		Checksum:       e.Checksum,
+		CreatedAt:      e.CreatedAt,
+		LastActiveAt:   e.LastActiveAt,
+		Throughput:     e.Throughput,
+	}
+}
+
+// chunkIndexes 将已上传分片集合转换为有序的序号列表
+func chunkIndexes(chunks map[int]bool) []int {
+	indexes := make([]int, 0, len(chunks))
+	for index, ok := range chunks {
+		if ok {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func (r *uploadSessionRepo) Create(ctx context.Context, session *model.UploadSession) error {
+	builder := r.db.UploadSession.Create().
+		SetSessionID(session.SessionID).
+		SetOwnerID(session.OwnerID).
+		SetPolicyID(session.PolicyID).
+		SetURI(session.URI).
+		SetChunkSize(session.ChunkSize).
+		SetFileSize(session.FileSize).
+		SetTempEntityID(session.TempEntityID).
+		SetUploadedChunks(chunkIndexes(session.UploadedChunks)).
+		SetChecksum(session.Checksum).
+		SetThroughput(session.Throughput).
+		SetExpireAt(session.ExpireAt)
+	if !session.CreatedAt.IsZero() {
+		builder.SetCreatedAt(session.CreatedAt)
+	}
+	if !session.LastActiveAt.IsZero() {
+		builder.SetLastActiveAt(session.LastActiveAt)
+	}
+	return builder.Exec(ctx)
+}
+
+func (r *uploadSessionRepo) FindBySessionID(ctx context.Context, sessionID string) (*model.UploadSession, error) {
+	e, err := r.db.UploadSession.Query().
+		Where(uploadsession.SessionID(sessionID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainUploadSession(e), nil
+}
+
+// Update 以版本号作为乐观锁：写回时要求版本号未变化，否则重新读取并再次调用 mutate
+func (r *uploadSessionRepo) Update(ctx context.Context, sessionID string, mutate func(session *model.UploadSession)) (*model.UploadSession, error) {
+	for attempt := 0; attempt < maxUploadSessionUpdateRetries; attempt++ {
+		e, err := r.db.UploadSession.Query().
+			Where(uploadsession.SessionID(sessionID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, constant.ErrNotFound
+			}
+			return nil, err
+		}
+		session := toDomainUploadSession(e)
+		mutate(session)
+
+		n, err := r.db.UploadSession.Update().
+			Where(uploadsession.SessionID(sessionID), uploadsession.Version(e.Version)).
+			SetUploadedChunks(chunkIndexes(session.UploadedChunks)).
+			SetThroughput(session.Throughput).
+			SetLastActiveAt(session.LastActiveAt).
+			SetExpireAt(session.ExpireAt).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			return session, nil
+		}
+	}
+	return nil, fmt.Errorf("上传会话 %s 并发更新冲突，请重试", sessionID)
+}
+
+func (r *uploadSessionRepo) ListActiveByOwnerID(ctx context.Context, ownerID uint) ([]*model.UploadSession, error) {
+	entities, err := r.db.UploadSession.Query().
+		Where(
+			uploadsession.OwnerID(ownerID),
+			uploadsession.ExpireAtGT(time.Now()),
+		).
+		Order(ent.Desc(uploadsession.FieldLastActiveAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*model.UploadSession, len(entities))
+	for i, e := range entities {
+		sessions[i] = toDomainUploadSession(e)
+	}
+	return sessions, nil
+}
+
+func (r *uploadSessionRepo) FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.UploadSession, error) {
+	entities, err := r.db.UploadSession.Query().
+		Where(uploadsession.ExpireAtLT(before)).
+		Order(ent.Asc(uploadsession.FieldExpireAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*model.UploadSession, len(entities))
+	for i, e := range entities {
+		sessions[i] = toDomainUploadSession(e)
+	}
+	return sessions, nil
+}
+
+func (r *uploadSessionRepo) ExistingSessionIDs(ctx context.Context, sessionIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return existing, nil
+	}
+	ids, err := r.db.UploadSession.Query().
+		Where(uploadsession.SessionIDIn(sessionIDs...)).
+		Select(uploadsession.FieldSessionID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		existing[id] = true
+	}
+	return existing, nil
+}
+
+func (r *uploadSessionRepo) Delete(ctx context.Context, sessionID string) error {
+	_, err := r.db.UploadSession.Delete().
+		Where(uploadsession.SessionID(sessionID)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,82 @@
+/*
+ * @Description: 用户文章书签仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/userbookmark"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type userBookmarkRepo struct {
+	db *ent.Client
+}
+
+// NewUserBookmarkRepo 是 userBookmarkRepo 的构造函数。
+func NewUserBookmarkRepo(db *ent.Client) repository.UserBookmarkRepository {
+	return &userBookmarkRepo{db: db}
+}
+
+// Add 收藏文章，命中唯一索引时保留原记录
+func (r *userBookmarkRepo) Add(ctx context.Context, userID, articleID uint) error {
+	return r.db.UserBookmark.Create().
+		SetUserID(userID).
+		SetArticleID(articleID).
+		OnConflictColumns(userbookmark.FieldUserID, userbookmark.FieldArticleID).
+		Ignore().
+		Exec(ctx)
+}
+
+// Remove 取消收藏
+func (r *userBookmarkRepo) Remove(ctx context.Context, userID, articleID uint) error {
+	_, err := r.db.UserBookmark.Delete().
+		Where(
+			userbookmark.UserIDEQ(userID),
+			userbookmark.ArticleIDEQ(articleID),
+		).
+		Exec(ctx)
+	return err
+}
+
+// Exists 判断用户是否已收藏文章
+func (r *userBookmarkRepo) Exists(ctx context.Context, userID, articleID uint) (bool, error) {
+	return r.db.UserBookmark.Query().
+		Where(
+			userbookmark.UserIDEQ(userID),
+			userbookmark.ArticleIDEQ(articleID),
+		).
+		Exist(ctx)
+}
+
+// List 按收藏时间倒序分页获取用户的书签
+func (r *userBookmarkRepo) List(ctx context.Context, userID uint, page, pageSize int) ([]*model.UserBookmark, int, error) {
+	query := r.db.UserBookmark.Query().Where(userbookmark.UserIDEQ(userID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	entities, err := query.
+		Order(ent.Desc(userbookmark.FieldCreatedAt), ent.Desc(userbookmark.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	bookmarks := make([]*model.UserBookmark, len(entities))
+	for i, entity := range entities {
+		bookmarks[i] = &model.UserBookmark{
+			ArticleID: entity.ArticleID,
+			CreatedAt: entity.CreatedAt,
+		}
+	}
+	return bookmarks, total, nil
+}
@@ -0,0 +1,113 @@
+/*
+ * @Description: 用户社交账号绑定仓库实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type userIdentityRepo struct {
+	client *ent.Client
+}
+
+// NewUserIdentityRepo 是 userIdentityRepo 的构造函数
+func NewUserIdentityRepo(client *ent.Client) repository.UserIdentityRepository {
+	return &userIdentityRepo{client: client}
+}
+
+func (r *userIdentityRepo) toModel(po *ent.UserIdentity) *model.UserIdentity {
+	return &model.UserIdentity{
+		ID:             po.ID,
+		UserID:         po.UserID,
+		Provider:       po.Provider,
+		ProviderUserID: po.ProviderUserID,
+		Nickname:       po.Nickname,
+		Avatar:         po.Avatar,
+		Email:          po.Email,
+		ProfileURL:     po.ProfileURL,
+		CreatedAt:      po.CreatedAt,
+		UpdatedAt:      po.UpdatedAt,
+	}
+}
+
+func (r *userIdentityRepo) FindByProviderUserID(ctx context.Context, provider, providerUserID string) (*model.UserIdentity, error) {
+	po, err := r.client.UserIdentity.Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.ProviderUserIDEQ(providerUserID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(po), nil
+}
+
+func (r *userIdentityRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.UserIdentity, error) {
+	pos, err := r.client.UserIdentity.Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Order(ent.Asc(useridentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.UserIdentity, len(pos))
+	for i, po := range pos {
+		result[i] = r.toModel(po)
+	}
+	return result, nil
+}
+
+func (r *userIdentityRepo) Create(ctx context.Context, identity *model.UserIdentity) error {
+	po, err := r.client.UserIdentity.Create().
+		SetUserID(identity.UserID).
+		SetProvider(identity.Provider).
+		SetProviderUserID(identity.ProviderUserID).
+		SetNickname(identity.Nickname).
+		SetAvatar(identity.Avatar).
+		SetEmail(identity.Email).
+		SetProfileURL(identity.ProfileURL).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	identity.ID = po.ID
+	identity.CreatedAt = po.CreatedAt
+	identity.UpdatedAt = po.UpdatedAt
+	return nil
+}
+
+func (r *userIdentityRepo) UpdateProfile(ctx context.Context, identity *model.UserIdentity) error {
+	return r.client.UserIdentity.UpdateOneID(identity.ID).
+		SetNickname(identity.Nickname).
+		SetAvatar(identity.Avatar).
+		SetEmail(identity.Email).
+		SetProfileURL(identity.ProfileURL).
+		Exec(ctx)
+}
+
+func (r *userIdentityRepo) Delete(ctx context.Context, userID uint, provider string) (bool, error) {
+	n, err := r.client.UserIdentity.Delete().
+		Where(
+			useridentity.UserIDEQ(userID),
+			useridentity.ProviderEQ(provider),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -103,6 +103,30 @@ func (r *entUserRepository) Count(ctx context.Context) (int64, error) {
 	return int64(count), err
 }
 
+// FindByNicknames 根据昵称批量查找用户
+func (r *entUserRepository) FindByNicknames(ctx context.Context, nicknames []string) ([]*model.User, error) {
+	if len(nicknames) == 0 {
+		return nil, nil
+	}
+	entUsers, err := r.client.User.
+		Query().
+		Where(
+			user.NicknameIn(nicknames...),
+			user.DeletedAtIsNil(),
+		).
+		WithUserGroup().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainUsers := make([]*model.User, len(entUsers))
+	for i, u := range entUsers {
+		domainUsers[i] = toDomainUser(u)
+	}
+	return domainUsers, nil
+}
+
 // FindByGroupID 根据用户组ID查找用户列表
 func (r *entUserRepository) FindByGroupID(ctx context.Context, groupID uint) ([]*model.User, error) {
 	entUsers, err := r.client.User.
@@ -164,6 +188,7 @@ func (r *entUserRepository) Create(ctx context.Context, user *model.User) error
 		SetPasswordHash(user.PasswordHash).
 		SetNickname(user.Nickname).
 		SetAvatar(user.Avatar).
+		SetWebsite(user.Website).
 		SetEmail(user.Email).
 		SetStatus(user.Status).
 		SetUserGroupID(user.UserGroupID)
@@ -196,6 +221,10 @@ func (r *entUserRepository) Update(ctx context.Context, user *model.User) error
 		SetPasswordHash(user.PasswordHash).
 		SetNickname(user.Nickname).
 		SetAvatar(user.Avatar).
+		SetAvatarVariants(user.AvatarVariants).
+		SetBio(user.Bio).
+		SetProfileVisibility(user.ProfileVisibility).
+		SetWebsite(user.Website).
 		SetEmail(user.Email).
 		SetStatus(user.Status).
 		SetUserGroupID(user.UserGroupID)
@@ -373,16 +402,20 @@ func toDomainUser(u *ent.User) *model.User {
 		return nil
 	}
 	domainUser := &model.User{
-		ID:           u.ID,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
-		Username:     u.Username,
-		PasswordHash: u.PasswordHash,
-		Nickname:     u.Nickname,
-		Avatar:       u.Avatar,
-		Email:        u.Email,
-		LastLoginAt:  u.LastLoginAt,
-		Status:       u.Status,
+		ID:                u.ID,
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+		Username:          u.Username,
+		PasswordHash:      u.PasswordHash,
+		Nickname:          u.Nickname,
+		Avatar:            u.Avatar,
+		AvatarVariants:    u.AvatarVariants,
+		Bio:               u.Bio,
+		ProfileVisibility: u.ProfileVisibility,
+		Website:           u.Website,
+		Email:             u.Email,
+		LastLoginAt:       u.LastLoginAt,
+		Status:            u.Status,
 	}
 	// Edges 是 Ent 用于存储关联模型的地方
 	if u.Edges.UserGroup != nil {
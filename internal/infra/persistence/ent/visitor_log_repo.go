@@ -108,6 +108,18 @@ func (r *entVisitorLogRepository) GetByTimeRange(ctx context.Context, startTime,
 		All(ctx)
 }
 
+func (r *entVisitorLogRepository) GetByTimeRangeAfterID(ctx context.Context, startTime, endTime time.Time, afterID uint, limit int) ([]*ent.VisitorLog, error) {
+	return r.client.VisitorLog.Query().
+		Where(
+			visitorlog.CreatedAtGTE(startTime),
+			visitorlog.CreatedAtLTE(endTime),
+			visitorlog.IDGT(afterID),
+		).
+		Order(ent.Asc(visitorlog.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
 func (r *entVisitorLogRepository) GetByVisitorID(ctx context.Context, visitorID string, limit int) ([]*ent.VisitorLog, error) {
 	return r.client.VisitorLog.Query().
 		Where(visitorlog.VisitorIDEQ(visitorID)).
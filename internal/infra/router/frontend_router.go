@@ -18,14 +18,18 @@ import (
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/cachepolicy"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/parser"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/strutil"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
 	"github.com/anzhiyu-c/anheyu-app/pkg/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/social_card"
+	theme_service "github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 
 	"github.com/gin-gonic/gin"
@@ -54,6 +58,7 @@ type PageSEOData struct {
 	Description string // 页面描述
 	Keywords    string // 页面关键词（可选）
 	OgType      string // Open Graph 类型
+	CustomPage  bool   // 是否为后台创建的自定义页面，按静态页面缓存
 }
 
 // 内置页面的 SEO 配置映射
@@ -248,6 +253,7 @@ func getPageSEOData(ctx context.Context, path string, settingSvc setting.Setting
 				Title:       pageData.Title,
 				Description: description,
 				OgType:      "article",
+				CustomPage:  true,
 			}
 		}
 	}
@@ -313,61 +319,21 @@ func generateContentETag(content interface{}) string {
 	return fmt.Sprintf(`"ctx7-%x"`, hash)
 }
 
-// ：设置智能缓存策略（针对CDN优化）
-func setSmartCacheHeaders(c *gin.Context, pageType string, etag string, maxAge int) {
-	// 检测是否通过CDN访问
-	isCDN := c.GetHeader("CF-Ray") != "" || // Cloudflare
-		c.GetHeader("X-Amz-Cf-Id") != "" || // CloudFront
-		c.GetHeader("X-Cache") != "" || // 通用CDN标识
-		c.GetHeader("X-Served-By") != "" // Fastly等
-
-	switch pageType {
-	case "article_detail":
-		if isCDN {
-			// CDN环境：更短的缓存时间，强制验证
-			c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, s-maxage=%d, must-revalidate, stale-while-revalidate=60",
-				min(maxAge, 180), min(maxAge/2, 60))) // CDN缓存时间更短
-		} else {
-			// 直连环境：正常缓存策略
-			c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, must-revalidate", maxAge))
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
+// setSmartCacheHeaders 按页面类型设置缓存响应头，缓存时间读取后台的页面缓存时间配置，与对应的公开接口保持一致
+func setSmartCacheHeaders(c *gin.Context, settingSvc setting.SettingService, class cachepolicy.Class, etag string) {
+	cachepolicy.Apply(c, settingSvc, class)
+	c.Header("ETag", etag)
+
+	// 添加缓存标签，便于CDN批量清除
+	switch class {
+	case cachepolicy.ClassArticle:
 		c.Header("X-Content-Type-Options", "nosniff")
-		// 添加缓存标签，便于CDN批量清除
 		c.Header("Cache-Tag", fmt.Sprintf("article-detail,article-%s", extractArticleIDFromPath(c.Request.URL.Path)))
-
-	case "home_page":
-		if isCDN {
-			// 首页：CDN缓存2分钟，浏览器缓存5分钟
-			c.Header("Cache-Control", "public, max-age=300, s-maxage=120, must-revalidate, stale-while-revalidate=30")
-		} else {
-			c.Header("Cache-Control", "public, max-age=300, must-revalidate") // 5分钟
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
+	case cachepolicy.ClassHome:
 		c.Header("Cache-Tag", "home-page,article-list")
-
-	case "static_page":
-		if isCDN {
-			// 静态页面：CDN缓存10分钟，浏览器缓存30分钟
-			c.Header("Cache-Control", "public, max-age=1800, s-maxage=600, must-revalidate, stale-while-revalidate=120")
-		} else {
-			c.Header("Cache-Control", "public, max-age=1800, must-revalidate") // 30分钟
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
+	case cachepolicy.ClassStatic:
 		c.Header("Cache-Tag", "static-page")
-
 	default:
-		if isCDN {
-			// 默认：CDN缓存1分钟，浏览器缓存3分钟
-			c.Header("Cache-Control", "public, max-age=180, s-maxage=60, must-revalidate, stale-while-revalidate=30")
-		} else {
-			c.Header("Cache-Control", "public, max-age=180, must-revalidate") // 3分钟
-		}
-		c.Header("ETag", etag)
-		c.Header("Vary", "Accept-Encoding")
 		c.Header("Cache-Tag", "default")
 	}
 
@@ -379,12 +345,9 @@ func setSmartCacheHeaders(c *gin.Context, pageType string, etag string, maxAge i
 	c.Header("X-App-Version", getAppVersion())
 }
 
-// min 返回两个整数中的较小值
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
+// pageCacheETag 根据渲染模板使用的数据生成页面 ETag，包含应用版本以便升级后前端资源变化时缓存失效
+func pageCacheETag(data gin.H) string {
+	return generateContentETag([]interface{}{version.GetVersion(), data})
 }
 
 // extractArticleIDFromPath 从URL路径中提取文章ID
@@ -772,6 +735,7 @@ func shouldReturnIndexHTML(path string) bool {
 		"/api/",        // API 接口
 		"/f/",          // 文件服务
 		"/needcache/",  // 缓存服务
+		"/dav/",        // WebDAV
 		"/static/",     // 静态资源
 		"/robots.txt",  // 搜索引擎爬虫文件
 		"/sitemap.xml", // 网站地图
@@ -915,6 +879,8 @@ func SetupFrontend(engine *gin.Engine, settingSvc setting.SettingService, articl
 			a, _ := json.Marshal(v)
 			return template.JS(a)
 		},
+		// sri 返回资源的 integrity 值，内嵌模板没有哈希清单，始终为空
+		"sri": func(src string) string { return "" },
 	}
 
 	// 预加载嵌入式资源，避免每次请求都处理
@@ -1208,7 +1174,13 @@ func SetupFrontend(engine *gin.Engine, settingSvc setting.SettingService, articl
 				debugLog("动态路由：前台页面使用外部主题模式，路径: %s", path)
 				// 每次都重新解析外部模板，确保获取最新内容
 				overrideDir := "static"
-				parsedTemplates, err := template.New("index.html").Funcs(funcMap).ParseFiles(filepath.Join(overrideDir, "index.html"))
+				// 外部主题安装时生成了 integrity.json，模板可用 {{sri "/static/app.js"}} 输出 integrity 属性
+				hashes, err := theme_service.LoadIntegrity(overrideDir)
+				if err != nil {
+					debugLog("读取主题资源哈希失败: %v", err)
+				}
+				themeFuncs := template.FuncMap{"sri": theme_service.IntegrityLookup(hashes)}
+				parsedTemplates, err := template.New("index.html").Funcs(funcMap).Funcs(themeFuncs).ParseFiles(filepath.Join(overrideDir, "index.html"))
 				if err != nil {
 					debugLog("解析外部HTML模板失败: %v，回退到内嵌模板", err)
 					templateInstance = embeddedTemplates
@@ -1257,6 +1229,27 @@ func SetupFrontend(engine *gin.Engine, settingSvc setting.SettingService, articl
 	debugLog("动态前端路由系统配置完成")
 }
 
+// articleRequestContext 返回获取文章详情使用的 context，缓存预热请求不计入浏览量
+func articleRequestContext(c *gin.Context) context.Context {
+	if c.GetHeader(constant.HeaderCacheWarmup) != "" {
+		return article_service.WithoutViewCount(c.Request.Context())
+	}
+	return c.Request.Context()
+}
+
+// linkPostRel 链接文章出站链接的 rel 属性：标记为外部链接，不传递权重，不泄露来源页面
+const linkPostRel = "external nofollow noopener noreferrer"
+
+// buildLinkPostHTML 为链接文章生成指向外部 URL 的出站链接，渲染在点评正文之前
+func buildLinkPostHTML(linkURL string) template.HTML {
+	text := linkURL
+	if u, err := url.Parse(linkURL); err == nil && u.Host != "" {
+		text = u.Host
+	}
+	return template.HTML(fmt.Sprintf(`<p class="link-post-source">🔗 <a href="%s" rel="%s" target="_blank">%s</a></p>`,
+		template.HTMLEscapeString(linkURL), linkPostRel, template.HTMLEscapeString(text)))
+}
+
 // ensureScriptTagsClosed 确保HTML中的script标签正确闭合
 // 这个函数会检测未闭合的script标签并自动添加闭合标签
 func ensureScriptTagsClosed(html string) string {
@@ -1614,18 +1607,13 @@ func renderHTMLPageWithAdminRewrite(c *gin.Context, settingSvc setting.SettingSe
 
 // renderHTMLPage 渲染HTML页面的通用函数（版本）
 func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSvc article_service.Service, templates *template.Template) {
-	// 🚫 强制禁用HTML页面的所有缓存
-	c.Header("Cache-Control", "no-cache, no-store, must-revalidate, private, max-age=0")
-	c.Header("Pragma", "no-cache")
-	c.Header("Expires", "0")
-
 	// 获取用于 SEO 的规范 URL（优先使用 SITE_URL 配置）
 	fullURL := getCanonicalURL(c, settingSvc)
 
 	isPostDetail, _ := regexp.MatchString(`^/posts/([^/]+)$`, c.Request.URL.Path)
 	if isPostDetail {
 		slug := strings.TrimPrefix(c.Request.URL.Path, "/posts/")
-		articleResponse, err := articleSvc.GetPublicBySlugOrID(c.Request.Context(), slug)
+		articleResponse, err := articleSvc.GetPublicBySlugOrID(articleRequestContext(c), slug)
 		if err != nil {
 			// 文章不存在或已删除，返回 index.html 让前端处理404
 			debugLog("文章未找到或已删除: %s, 错误: %v，交给前端处理", slug, err)
@@ -1634,17 +1622,16 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 
 			pageTitle := fmt.Sprintf("%s - %s", articleResponse.Title, settingSvc.Get(constant.KeyAppName.String()))
 
-			var pageDescription string
-			if len(articleResponse.Summaries) > 0 && articleResponse.Summaries[0] != "" {
-				pageDescription = articleResponse.Summaries[0]
-			} else {
-				plainText := parser.StripHTML(articleResponse.ContentHTML)
-				plainText = strings.Join(strings.Fields(plainText), " ")
-				pageDescription = strutil.Truncate(plainText, 150)
-			}
+			pageDescription := social_card.ArticleDescription(articleResponse.Summaries, articleResponse.ContentHTML)
 			if pageDescription == "" {
 				pageDescription = settingSvc.Get(constant.KeySiteDescription.String())
 			}
+			// 分享卡片：应用文章的 OG / Twitter 覆盖配置
+			card := social_card.ApplyOverrides(articleResponse.ExtraConfig, social_card.Card{
+				Title:       pageTitle,
+				Description: pageDescription,
+				Image:       articleResponse.CoverURL,
+			})
 
 			// 构建文章标签列表
 			articleTags := make([]string, len(articleResponse.PostTags))
@@ -1682,9 +1669,7 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 			// 生成社交媒体链接
 			socialMediaLinks := generateSocialMediaLinks(settingSvc)
 
-			// 使用传入的模板实例渲染
-			render := CustomHTMLRender{Templates: templates}
-			c.Render(http.StatusOK, render.Instance("index.html", gin.H{
+			data := gin.H{
 				// --- 基础 SEO 和页面信息 ---
 				"pageTitle":       pageTitle,
 				"pageDescription": pageDescription,
@@ -1692,15 +1677,16 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 				"author":          settingSvc.Get(constant.KeyFrontDeskSiteOwnerName.String()),
 				"themeColor":      articleResponse.PrimaryColor,
 				"favicon":         settingSvc.Get(constant.KeyIconURL.String()),
-				// --- 用于 Vue 水合的数据（包含时间戳） ---
-				"initialData":   initialDataWithTimestamp,
+				// --- 用于 Vue 水合的数据 ---
+				"initialData":   articleResponse,
 				"ogType":        "article",
 				"ogUrl":         fullURL,
-				"ogTitle":       pageTitle,
-				"ogDescription": pageDescription,
-				"ogImage":       articleResponse.CoverURL,
+				"ogTitle":       card.Title,
+				"ogDescription": card.Description,
+				"ogImage":       card.Image,
 				"ogSiteName":    settingSvc.Get(constant.KeyAppName.String()),
 				"ogLocale":      "zh_CN",
+				"twitterCard":   card.TwitterCard,
 				// --- Article 元标签数据 ---
 				"articlePublishedTime": articleResponse.CreatedAt.Format(time.RFC3339),
 				"articleModifiedTime":  articleResponse.UpdatedAt.Format(time.RFC3339),
@@ -1710,10 +1696,17 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 				"breadcrumbList": breadcrumbList,
 				// --- 社交媒体链接 ---
 				"socialMediaLinks": socialMediaLinks,
+				// --- 链接文章的出站链接（普通文章为空） ---
+				"articleLinkURL": articleResponse.LinkURL,
+				"articleLinkRel": linkPostRel,
 				// --- 自定义HTML（包含CSS/JS） ---
 				"customHeaderHTML": template.HTML(customHeaderHTML),
 				"customFooterHTML": template.HTML(customFooterHTML),
-			}))
+			}
+			// 水合数据中的时间戳每次请求都不同，不参与 ETag 计算
+			etag := pageCacheETag(data)
+			data["initialData"] = initialDataWithTimestamp
+			renderFrontendPage(c, settingSvc, templates, cachepolicy.ClassArticle, data, etag)
 			return
 		}
 	}
@@ -1725,6 +1718,7 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 	defaultDescription := settingSvc.Get(constant.KeySiteDescription.String())
 	defaultImage := settingSvc.Get(constant.KeyLogoURL512.String())
 	ogType := "website"
+	cacheClass := cachepolicy.ClassDefault
 
 	// 🆕 尝试获取页面特定的 SEO 数据
 	pageSEO := getPageSEOData(c.Request.Context(), c.Request.URL.Path, settingSvc)
@@ -1737,11 +1731,30 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 		if pageSEO.OgType != "" {
 			ogType = pageSEO.OgType
 		}
+		if pageSEO.CustomPage {
+			cacheClass = cachepolicy.ClassStatic
+		}
 		debugLog("🎯 页面 SEO 优化: path=%s, title=%s", c.Request.URL.Path, defaultTitle)
 	}
 
+	// 列表页（首页分页、归档、分类、标签）：规范链接去掉查询参数，并输出上一页/下一页链接
+	var prevURL, nextURL, paginationLinks string
+	if listing, ok := parseListingPath(c.Request.URL.Path); ok {
+		listingSEO := getListingSEOData(articleRequestContext(c), c, listing, settingSvc, articleSvc)
+		if listing.basePath != "" || listing.page > 1 {
+			defaultTitle = listingSEO.Title
+			defaultDescription = listingSEO.Description
+		}
+		fullURL = listingSEO.CanonicalURL
+		if listing.basePath == "" {
+			cacheClass = cachepolicy.ClassHome
+		}
+		prevURL, nextURL = listingSEO.PrevURL, listingSEO.NextURL
+		paginationLinks = listingSEO.linkTags()
+	}
+
 	// 处理自定义HTML，确保script标签正确闭合
-	customHeaderHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomHeaderHTML.String()))
+	customHeaderHTML := paginationLinks + ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomHeaderHTML.String()))
 	customFooterHTML := ensureScriptTagsClosed(settingSvc.Get(constant.KeyCustomFooterHTML.String()))
 
 	// 生成面包屑导航数据
@@ -1751,9 +1764,7 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 	// 生成社交媒体链接
 	socialMediaLinks := generateSocialMediaLinks(settingSvc)
 
-	// 使用传入的模板实例渲染
-	render := CustomHTMLRender{Templates: templates}
-	c.Render(http.StatusOK, render.Instance("index.html", gin.H{
+	data := gin.H{
 		// --- 基础 SEO 和页面信息 ---
 		"pageTitle":       defaultTitle,
 		"pageDescription": defaultDescription,
@@ -1775,6 +1786,10 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 		"articleModifiedTime":  nil,
 		"articleAuthor":        nil,
 		"articleTags":          nil,
+		// --- 列表页分页链接（非列表页为空） ---
+		"canonicalUrl": fullURL,
+		"prevUrl":      prevURL,
+		"nextUrl":      nextURL,
 		// --- 面包屑导航数据 ---
 		"breadcrumbList": breadcrumbList,
 		// --- 社交媒体链接 ---
@@ -1782,7 +1797,25 @@ func renderHTMLPage(c *gin.Context, settingSvc setting.SettingService, articleSv
 		// --- 自定义HTML（包含CSS/JS） ---
 		"customHeaderHTML": template.HTML(customHeaderHTML),
 		"customFooterHTML": template.HTML(customFooterHTML),
-	}))
+	}
+	renderFrontendPage(c, settingSvc, templates, cacheClass, data, pageCacheETag(data))
+}
+
+// renderFrontendPage 按页面类型设置缓存响应头后渲染 index.html，客户端缓存仍然有效时直接返回 304。
+// 后台页面（未使用外部主题时同样经由此处渲染）始终禁用缓存。
+func renderFrontendPage(c *gin.Context, settingSvc setting.SettingService, templates *template.Template, class cachepolicy.Class, data gin.H, etag string) {
+	if isAdminPath(c.Request.URL.Path) {
+		c.Header("Cache-Control", "no-cache, no-store, must-revalidate, private, max-age=0")
+		c.Header("Pragma", "no-cache")
+		c.Header("Expires", "0")
+	} else {
+		setSmartCacheHeaders(c, settingSvc, class, etag)
+		if handleConditionalRequest(c, etag) {
+			return
+		}
+	}
+	render := CustomHTMLRender{Templates: templates}
+	c.Render(http.StatusOK, render.Instance("index.html", data))
 }
 
 // getPageHTMLPath 根据请求路径获取对应的 HTML 文件路径
@@ -1918,23 +1951,21 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 		if isPostDetail && articleSvc != nil {
 			slug := strings.TrimPrefix(c.Request.URL.Path, "/posts/")
 			debugLog("serveStaticHTMLFile: 检测到文章详情页，获取文章数据: %s", slug)
-			articleResponse, err := articleSvc.GetPublicBySlugOrID(c.Request.Context(), slug)
+			articleResponse, err := articleSvc.GetPublicBySlugOrID(articleRequestContext(c), slug)
 			if err != nil {
 				debugLog("serveStaticHTMLFile: 获取文章失败: %s, 错误: %v", slug, err)
 			} else if articleResponse != nil {
 				// 更新 SEO 数据
 				pageTitle := fmt.Sprintf("%s - %s", articleResponse.Title, settingSvc.Get(constant.KeyAppName.String()))
-				var pageDescription string
-				if len(articleResponse.Summaries) > 0 && articleResponse.Summaries[0] != "" {
-					pageDescription = articleResponse.Summaries[0]
-				} else {
-					plainText := parser.StripHTML(articleResponse.ContentHTML)
-					plainText = strings.Join(strings.Fields(plainText), " ")
-					pageDescription = strutil.Truncate(plainText, 150)
-				}
+				pageDescription := social_card.ArticleDescription(articleResponse.Summaries, articleResponse.ContentHTML)
 				if pageDescription == "" {
 					pageDescription = defaultDescription
 				}
+				card := social_card.ApplyOverrides(articleResponse.ExtraConfig, social_card.Card{
+					Title:       pageTitle,
+					Description: pageDescription,
+					Image:       articleResponse.CoverURL,
+				})
 
 				// 构建文章标签列表
 				articleTags := make([]string, len(articleResponse.PostTags))
@@ -1964,9 +1995,10 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 				data["themeColor"] = articleResponse.PrimaryColor
 				data["initialData"] = initialDataWithTimestamp
 				data["ogType"] = "article"
-				data["ogTitle"] = pageTitle
-				data["ogDescription"] = pageDescription
-				data["ogImage"] = articleResponse.CoverURL
+				data["ogTitle"] = card.Title
+				data["ogDescription"] = card.Description
+				data["ogImage"] = card.Image
+				data["twitterCard"] = card.TwitterCard
 				data["articlePublishedTime"] = articleResponse.CreatedAt
 				data["articleModifiedTime"] = articleResponse.UpdatedAt
 				data["articleAuthor"] = settingSvc.Get(constant.KeyFrontDeskSiteOwnerName.String())
@@ -1982,6 +2014,13 @@ func serveStaticHTMLFile(c *gin.Context, filePath string, settingSvc setting.Set
 				data["articlePrimaryColor"] = articleResponse.PrimaryColor
 				data["currentYear"] = time.Now().Year()
 
+				// 链接文章：正文是点评，在正文前加上指向外部 URL 的出站链接
+				if articleResponse.IsLinkPost {
+					data["articleLinkURL"] = articleResponse.LinkURL
+					data["articleLinkRel"] = linkPostRel
+					data["articleContent"] = buildLinkPostHTML(articleResponse.LinkURL) + template.HTML(articleResponse.ContentHTML)
+				}
+
 				// 文章分类
 				if len(articleResponse.PostCategories) > 0 {
 					data["articleCategory"] = articleResponse.PostCategories[0].Name
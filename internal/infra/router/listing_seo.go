@@ -0,0 +1,159 @@
+/*
+ * @Description: 列表页（首页分页、归档、分类、标签）的 SSR 元信息与分页 rel 链接
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 18:00:00
+ * @LastEditTime: 2026-10-16 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package router
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// listingPathPattern 匹配文章列表页：/、/page/2、/archives[/2025[/01]][/page/2]、/categories/{name}[/page/2]、/tags/{name}[/page/2]
+var listingPathPattern = regexp.MustCompile(`^(/archives(?:/(\d{4})(?:/(\d{1,2}))?)?|/categories/([^/]+)|/tags/([^/]+))?(?:/page/(\d+))?$`)
+
+// listingPage 解析后的列表页
+type listingPage struct {
+	basePath string // 不含分页部分的路径，首页为空字符串
+	page     int
+	options  model.ListPublicArticlesOptions
+}
+
+// listingSEO 列表页的 SEO 数据
+type listingSEO struct {
+	Title        string
+	Description  string
+	CanonicalURL string
+	PrevURL      string
+	NextURL      string
+}
+
+// parseListingPath 解析文章列表页路径，不是列表页或页码无效时返回 false
+func parseListingPath(path string) (*listingPage, bool) {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if path == "/" {
+		return &listingPage{page: 1}, true
+	}
+	matches := listingPathPattern.FindStringSubmatch(path)
+	if matches == nil || path == "" {
+		return nil, false
+	}
+
+	listing := &listingPage{basePath: matches[1], page: 1}
+	if matches[6] != "" {
+		page, err := strconv.Atoi(matches[6])
+		if err != nil || page < 1 {
+			return nil, false
+		}
+		listing.page = page
+	}
+	if matches[2] != "" {
+		listing.options.Year, _ = strconv.Atoi(matches[2])
+	}
+	if matches[3] != "" {
+		month, _ := strconv.Atoi(matches[3])
+		if month < 1 || month > 12 {
+			return nil, false
+		}
+		listing.options.Month = month
+	}
+	if matches[4] != "" {
+		listing.options.CategoryName, _ = decodeURLPath(matches[4])
+	}
+	if matches[5] != "" {
+		listing.options.TagName, _ = decodeURLPath(matches[5])
+	}
+	return listing, true
+}
+
+// pagePath 返回列表第 page 页的路径，第一页不带 /page/1
+func (l *listingPage) pagePath(page int) string {
+	if page <= 1 {
+		if l.basePath == "" {
+			return "/"
+		}
+		return l.basePath
+	}
+	return fmt.Sprintf("%s/page/%d", l.basePath, page)
+}
+
+// getListingSEOData 生成列表页的标题、描述、规范链接以及上一页/下一页链接。
+// 标题与描述沿用 getPageSEOData 对归档、分类、标签页的配置，第 2 页起在标题后追加页码，
+// 避免搜索引擎把分页视为重复内容。
+func getListingSEOData(ctx context.Context, c *gin.Context, listing *listingPage, settingSvc setting.SettingService, articleSvc article_service.Service) *listingSEO {
+	siteName := settingSvc.Get(constant.KeyAppName.String())
+	seo := &listingSEO{
+		Title:       fmt.Sprintf("%s - %s", siteName, settingSvc.Get(constant.KeySubTitle.String())),
+		Description: settingSvc.Get(constant.KeySiteDescription.String()),
+	}
+	if listing.basePath != "" {
+		if pageSEO := getPageSEOData(ctx, listing.basePath, settingSvc); pageSEO != nil {
+			seo.Title = fmt.Sprintf("%s - %s", pageSEO.Title, siteName)
+			if pageSEO.Description != "" {
+				seo.Description = pageSEO.Description
+			}
+		}
+	}
+	if listing.page > 1 {
+		seo.Title = fmt.Sprintf("第 %d 页 - %s", listing.page, seo.Title)
+	}
+
+	baseURL := siteBaseURL(c, settingSvc)
+	seo.CanonicalURL = baseURL + listing.pagePath(listing.page)
+
+	pageSize, _ := strconv.Atoi(settingSvc.Get(constant.KeyPostDefaultPageSize.String()))
+	if pageSize <= 0 {
+		pageSize = 12
+	}
+	totalPages := 0
+	opts := listing.options
+	opts.Page, opts.PageSize = 1, 1
+	if result, err := articleSvc.ListPublic(ctx, &opts); err == nil {
+		totalPages = int((result.Total + int64(pageSize) - 1) / int64(pageSize))
+	} else {
+		debugLog("查询列表页文章总数失败: path=%s, 错误: %v", listing.basePath, err)
+	}
+
+	if listing.page > 1 && listing.page <= totalPages+1 {
+		seo.PrevURL = baseURL + listing.pagePath(listing.page-1)
+	}
+	if listing.page < totalPages {
+		seo.NextURL = baseURL + listing.pagePath(listing.page+1)
+	}
+	return seo
+}
+
+// linkTags 生成分页的 prev/next link 标签，注入到 <head> 中
+func (s *listingSEO) linkTags() string {
+	var b strings.Builder
+	if s.PrevURL != "" {
+		fmt.Fprintf(&b, "<link rel=\"prev\" href=\"%s\">\n", html.EscapeString(s.PrevURL))
+	}
+	if s.NextURL != "" {
+		fmt.Fprintf(&b, "<link rel=\"next\" href=\"%s\">\n", html.EscapeString(s.NextURL))
+	}
+	return b.String()
+}
+
+// siteBaseURL 返回站点根地址（不含末尾斜杠），优先使用 SITE_URL 配置，与 getCanonicalURL 一致
+func siteBaseURL(c *gin.Context, settingSvc setting.SettingService) string {
+	if siteURL := settingSvc.Get(constant.KeySiteURL.String()); siteURL != "" {
+		return strings.TrimSuffix(siteURL, "/")
+	}
+	return fmt.Sprintf("%s://%s", getRequestScheme(c), c.Request.Host)
+}
@@ -0,0 +1,52 @@
+package router
+
+import "testing"
+
+func TestParseListingPath(t *testing.T) {
+	cases := []struct {
+		path     string
+		ok       bool
+		base     string
+		page     int
+		category string
+		tag      string
+		year     int
+		month    int
+	}{
+		{path: "/", ok: true, page: 1},
+		{path: "/page/3", ok: true, page: 3},
+		{path: "/archives", ok: true, base: "/archives", page: 1},
+		{path: "/archives/2025/01/page/2/", ok: true, base: "/archives/2025/01", page: 2, year: 2025, month: 1},
+		{path: "/categories/%E6%8A%80%E6%9C%AF/page/2", ok: true, base: "/categories/%E6%8A%80%E6%9C%AF", page: 2, category: "技术"},
+		{path: "/tags/go", ok: true, base: "/tags/go", page: 1, tag: "go"},
+		{path: "/page/0"},
+		{path: "/archives/2025/13"},
+		{path: "/posts/hello"},
+		{path: "/categories"},
+	}
+	for _, tc := range cases {
+		listing, ok := parseListingPath(tc.path)
+		if ok != tc.ok {
+			t.Errorf("%s: ok = %v, want %v", tc.path, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if listing.basePath != tc.base || listing.page != tc.page || listing.options.CategoryName != tc.category ||
+			listing.options.TagName != tc.tag || listing.options.Year != tc.year || listing.options.Month != tc.month {
+			t.Errorf("%s: unexpected listing %+v", tc.path, listing)
+		}
+	}
+}
+
+func TestListingPagePath(t *testing.T) {
+	home := &listingPage{}
+	if home.pagePath(1) != "/" || home.pagePath(2) != "/page/2" {
+		t.Errorf("unexpected home paths: %q %q", home.pagePath(1), home.pagePath(2))
+	}
+	tag := &listingPage{basePath: "/tags/go"}
+	if tag.pagePath(1) != "/tags/go" || tag.pagePath(3) != "/tags/go/page/3" {
+		t.Errorf("unexpected tag paths: %q %q", tag.pagePath(1), tag.pagePath(3))
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/middleware"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	album_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album"
 	album_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album_category"
 	article_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article"
@@ -22,6 +24,7 @@ import (
 	captcha_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/captcha"
 	comment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/comment"
 	config_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/config"
+	diagnostic_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/diagnostic"
 	direct_link_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/direct_link"
 	doc_series_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/doc_series"
 	file_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/file"
@@ -37,6 +40,7 @@ import (
 	rss_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/rss"
 	search_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/search"
 	setting_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setting"
+	setup_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setup"
 	sitemap_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sitemap"
 	ssrtheme_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/ssrtheme"
 	statistics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/statistics"
@@ -46,6 +50,32 @@ import (
 	thumbnail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/thumbnail"
 	user_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/user"
 	version_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/version"
+	widget_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/widget"
+	privacy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/privacy"
+	media_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/media"
+	micropub_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/micropub"
+	moment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/moment"
+	profile_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/profile"
+	tts_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/tts"
+	delivery_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/delivery"
+	audit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/audit"
+	member_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/member"
+	invitation_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/invitation"
+	migration_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/migration"
+	mail_template_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/mail_template"
+	disposable_email_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/disposable_email"
+	access_token_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/access_token"
+	weather_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/weather"
+	task_queue_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/task_queue"
+	url_migration_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/url_migration"
+	social_card_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/social_card"
+	image_palette_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/image_palette"
+	webdav_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webdav"
+	article_template_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_template"
+	article_collection_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_collection"
+	contribution_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/contribution"
+	reading_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/reading"
+	changelog_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/changelog"
 )
 
 // NoCacheMiddleware 全局反缓存中间件，确保所有API响应都不会被CDN缓存
@@ -100,6 +130,34 @@ type Router struct {
 	subscriberHandler         *subscriber_handler.Handler
 	captchaHandler            *captcha_handler.Handler
 	imageHandler              *image_handler.Handler
+	diagnosticHandler         *diagnostic_handler.Handler
+	widgetHandler             *widget_handler.Handler
+	privacyHandler            *privacy_handler.Handler
+	mediaHandler              *media_handler.Handler
+	articleTemplateHandler    *article_template_handler.Handler
+	micropubHandler           *micropub_handler.Handler
+	momentHandler             *moment_handler.Handler
+	profileHandler            *profile_handler.Handler
+	weatherHandler            *weather_handler.Handler
+	ttsHandler                *tts_handler.Handler
+	deliveryHandler           *delivery_handler.Handler
+	auditHandler              *audit_handler.Handler
+	memberHandler             *member_handler.Handler
+	invitationHandler         *invitation_handler.Handler
+	migrationHandler          *migration_handler.Handler
+	setupHandler              *setup_handler.Handler
+	mailTemplateHandler       *mail_template_handler.Handler
+	disposableEmailHandler    *disposable_email_handler.Handler
+	accessTokenHandler        *access_token_handler.Handler
+	webdavHandler             *webdav_handler.Handler
+	taskQueueHandler          *task_queue_handler.Handler
+	urlMigrationHandler       *url_migration_handler.Handler
+	socialCardHandler         *social_card_handler.Handler
+	imagePaletteHandler       *image_palette_handler.Handler
+	articleCollectionHandler  *article_collection_handler.Handler
+	contributionHandler       *contribution_handler.Handler
+	readingHandler            *reading_handler.Handler
+	changelogHandler          *changelog_handler.Handler
 }
 
 // NewRouter 是 Router 的构造函数，通过依赖注入接收所有处理器。
@@ -138,6 +196,34 @@ func NewRouter(
 	subscriberHandler *subscriber_handler.Handler,
 	captchaHandler *captcha_handler.Handler,
 	imageHandler *image_handler.Handler,
+	diagnosticHandler *diagnostic_handler.Handler,
+	widgetHandler *widget_handler.Handler,
+	privacyHandler *privacy_handler.Handler,
+	mediaHandler *media_handler.Handler,
+	articleTemplateHandler *article_template_handler.Handler,
+	micropubHandler *micropub_handler.Handler,
+	momentHandler *moment_handler.Handler,
+	profileHandler *profile_handler.Handler,
+	weatherHandler *weather_handler.Handler,
+	ttsHandler *tts_handler.Handler,
+	deliveryHandler *delivery_handler.Handler,
+	auditHandler *audit_handler.Handler,
+	memberHandler *member_handler.Handler,
+	invitationHandler *invitation_handler.Handler,
+	migrationHandler *migration_handler.Handler,
+	setupHandler *setup_handler.Handler,
+	mailTemplateHandler *mail_template_handler.Handler,
+	disposableEmailHandler *disposable_email_handler.Handler,
+	accessTokenHandler *access_token_handler.Handler,
+	webdavHandler *webdav_handler.Handler,
+	taskQueueHandler *task_queue_handler.Handler,
+	urlMigrationHandler *url_migration_handler.Handler,
+	socialCardHandler *social_card_handler.Handler,
+	imagePaletteHandler *image_palette_handler.Handler,
+	articleCollectionHandler *article_collection_handler.Handler,
+	contributionHandler *contribution_handler.Handler,
+	readingHandler *reading_handler.Handler,
+	changelogHandler *changelog_handler.Handler,
 ) *Router {
 	return &Router{
 		authHandler:               authHandler,
@@ -174,6 +260,34 @@ func NewRouter(
 		subscriberHandler:         subscriberHandler,
 		captchaHandler:            captchaHandler,
 		imageHandler:              imageHandler,
+		diagnosticHandler:         diagnosticHandler,
+		widgetHandler:             widgetHandler,
+		privacyHandler:            privacyHandler,
+		mediaHandler:              mediaHandler,
+		articleTemplateHandler:    articleTemplateHandler,
+		micropubHandler:           micropubHandler,
+		momentHandler:             momentHandler,
+		profileHandler:            profileHandler,
+		weatherHandler:            weatherHandler,
+		ttsHandler:                ttsHandler,
+		deliveryHandler:           deliveryHandler,
+		auditHandler:              auditHandler,
+		memberHandler:             memberHandler,
+		invitationHandler:         invitationHandler,
+		migrationHandler:          migrationHandler,
+		setupHandler:              setupHandler,
+		mailTemplateHandler:       mailTemplateHandler,
+		disposableEmailHandler:    disposableEmailHandler,
+		accessTokenHandler:        accessTokenHandler,
+		webdavHandler:             webdavHandler,
+		taskQueueHandler:          taskQueueHandler,
+		urlMigrationHandler:       urlMigrationHandler,
+		socialCardHandler:         socialCardHandler,
+		imagePaletteHandler:       imagePaletteHandler,
+		articleCollectionHandler:  articleCollectionHandler,
+		contributionHandler:       contributionHandler,
+		readingHandler:            readingHandler,
+		changelogHandler:          changelogHandler,
 	}
 }
 
@@ -227,8 +341,426 @@ func (r *Router) Setup(engine *gin.Engine) {
 	r.registerConfigBackupRoutes(apiGroup)
 	r.registerSitemapRoutes(engine)     // 直接注册到engine，不使用/api前缀
 	r.registerRSSRoutes(engine)         // RSS/atom/feed 始终注册，与 SkipFrontend 无关
+	r.registerWebDAVRoutes(engine)      // WebDAV 挂载在 /dav，不使用/api前缀
 	r.registerSSRThemeRoutes(apiGroup)  // 注册 SSR 主题管理路由
 	r.registerImageStyleRoutes(apiGroup)
+	r.registerDiagnosticRoutes(apiGroup)
+	r.registerWidgetRoutes(apiGroup)
+	r.registerPrivacyRoutes(apiGroup)
+	r.registerMediaRoutes(apiGroup)
+	r.registerArticleTemplateRoutes(apiGroup)
+	r.registerArticleCollectionRoutes(apiGroup)
+	r.registerContributionRoutes(apiGroup)
+	r.registerReadingRoutes(apiGroup)
+	r.registerChangelogRoutes(apiGroup)
+	r.registerMicropubRoutes(apiGroup)
+	r.registerMomentRoutes(apiGroup)
+	r.registerProfileRoutes(apiGroup)
+	r.registerWeatherRoutes(apiGroup)
+	r.registerDeliveryRoutes(apiGroup)
+	r.registerTaskQueueRoutes(apiGroup)
+	r.registerAuditRoutes(apiGroup)
+	r.registerMemberRoutes(apiGroup)
+	r.registerInvitationRoutes(apiGroup)
+	r.registerMigrationRoutes(apiGroup)
+	r.registerURLMigrationRoutes(apiGroup)
+	r.registerMailTemplateRoutes(apiGroup)
+	r.registerDisposableEmailRoutes(apiGroup)
+	r.registerAccessTokenRoutes(apiGroup)
+	r.registerSetupRoutes(apiGroup)
+}
+
+// registerMigrationRoutes 注册 Hexo / Hugo / WordPress 博客迁移路由
+func (r *Router) registerMigrationRoutes(api *gin.RouterGroup) {
+	if r.migrationHandler == nil {
+		return
+	}
+	migrationAdmin := api.Group("/admin/migration").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		migrationAdmin.POST("/import", r.migrationHandler.Import)
+		migrationAdmin.POST("/wordpress", r.migrationHandler.ImportWordPress)
+		migrationAdmin.GET("/wordpress/:taskId", r.migrationHandler.GetWordPressImport)
+	}
+}
+
+// registerURLMigrationRoutes 注册站点地址迁移路由
+func (r *Router) registerURLMigrationRoutes(api *gin.RouterGroup) {
+	if r.urlMigrationHandler == nil {
+		return
+	}
+	urlMigrationAdmin := api.Group("/admin/url-migration").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		urlMigrationAdmin.POST("/preview", r.urlMigrationHandler.Preview)
+		urlMigrationAdmin.POST("/apply", r.urlMigrationHandler.Apply)
+	}
+}
+
+// registerInvitationRoutes 注册邀请码管理路由
+func (r *Router) registerInvitationRoutes(api *gin.RouterGroup) {
+	if r.invitationHandler == nil {
+		return
+	}
+	invitationAdmin := api.Group("/admin/invitation-codes").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		invitationAdmin.GET("", r.invitationHandler.List)
+		invitationAdmin.POST("", r.invitationHandler.Create)
+		invitationAdmin.DELETE("/:id", r.invitationHandler.Delete)
+	}
+}
+
+// registerMemberRoutes 注册注册用户公开资料页路由，供主题实现成员主页
+func (r *Router) registerMemberRoutes(api *gin.RouterGroup) {
+	if r.memberHandler == nil {
+		return
+	}
+	members := api.Group("/public/users").Use(middleware.CustomRateLimit(60, 20))
+	{
+		members.GET("/:id", r.memberHandler.GetProfile)
+	}
+}
+
+// registerAuditRoutes 注册审计日志查询路由
+func (r *Router) registerAuditRoutes(api *gin.RouterGroup) {
+	if r.auditHandler == nil {
+		return
+	}
+	auditAdmin := api.Group("/admin/audit-logs").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		auditAdmin.GET("", r.auditHandler.List)
+	}
+}
+
+// registerDeliveryRoutes 注册通知投递队列管理路由
+func (r *Router) registerDeliveryRoutes(api *gin.RouterGroup) {
+	if r.deliveryHandler == nil {
+		return
+	}
+	deliveryAdmin := api.Group("/admin/notification-deliveries").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		deliveryAdmin.GET("", r.deliveryHandler.List)
+		deliveryAdmin.GET("/stats", r.deliveryHandler.Stats)
+		deliveryAdmin.POST("/:id/retry", r.deliveryHandler.Retry)
+	}
+}
+
+// registerTaskQueueRoutes 注册后台任务队列管理路由
+func (r *Router) registerTaskQueueRoutes(api *gin.RouterGroup) {
+	if r.taskQueueHandler == nil {
+		return
+	}
+	taskQueueAdmin := api.Group("/admin/task-queue").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		taskQueueAdmin.GET("/stats", r.taskQueueHandler.Stats)
+		taskQueueAdmin.GET("/dead-letters", r.taskQueueHandler.ListDeadLetters)
+		taskQueueAdmin.POST("/dead-letters/:id/retry", r.taskQueueHandler.RetryDeadLetter)
+		taskQueueAdmin.DELETE("/dead-letters/:id", r.taskQueueHandler.DeleteDeadLetter)
+	}
+
+	tasksAdmin := api.Group("/admin/tasks").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		tasksAdmin.GET("", r.taskQueueHandler.ListTasks)
+		tasksAdmin.POST("/:id/retry", r.taskQueueHandler.RetryTask)
+		tasksAdmin.POST("/:id/cancel", r.taskQueueHandler.CancelTask)
+		tasksAdmin.GET("/maintenance", r.taskQueueHandler.MaintenanceStatus)
+		tasksAdmin.POST("/maintenance/run", r.taskQueueHandler.RunMaintenance)
+	}
+}
+
+// registerMailTemplateRoutes 注册评论通知邮件模板管理路由
+func (r *Router) registerMailTemplateRoutes(api *gin.RouterGroup) {
+	if r.mailTemplateHandler == nil {
+		return
+	}
+	mailTemplateAdmin := api.Group("/admin/mail-templates").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		mailTemplateAdmin.GET("", r.mailTemplateHandler.List)
+		mailTemplateAdmin.PUT("/:key", r.mailTemplateHandler.Update)
+		mailTemplateAdmin.POST("/:key/preview", r.mailTemplateHandler.Preview)
+		mailTemplateAdmin.GET("/:key/versions", r.mailTemplateHandler.ListVersions)
+		mailTemplateAdmin.POST("/:key/versions/:id/rollback", r.mailTemplateHandler.Rollback)
+	}
+}
+
+// registerDisposableEmailRoutes 注册一次性邮箱拦截管理路由
+func (r *Router) registerDisposableEmailRoutes(api *gin.RouterGroup) {
+	if r.disposableEmailHandler == nil {
+		return
+	}
+	disposableEmailAdmin := api.Group("/admin/disposable-email").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		disposableEmailAdmin.GET("/stats", r.disposableEmailHandler.Stats)
+		disposableEmailAdmin.POST("/refresh", r.disposableEmailHandler.Refresh)
+	}
+}
+
+// registerSetupRoutes 注册初始化向导路由，完成初始化后向导接口返回 403
+func (r *Router) registerSetupRoutes(api *gin.RouterGroup) {
+	if r.setupHandler == nil {
+		return
+	}
+	api.GET("/public/setup/state", r.setupHandler.GetState)
+
+	setup := api.Group("/setup").Use(middleware.CustomRateLimit(10, 5), r.setupHandler.RequireToken())
+	{
+		setup.POST("/admin", r.setupHandler.CreateAdmin)
+		setup.POST("/site", r.setupHandler.ConfigureSite)
+		setup.POST("/storage", r.setupHandler.ConfigureStorage)
+		setup.POST("/smtp/test", r.setupHandler.TestSMTP)
+		setup.POST("/complete", r.setupHandler.Complete)
+	}
+}
+
+// registerWeatherRoutes 注册天气代理路由（IP 定位路由在评论路由中注册）
+func (r *Router) registerWeatherRoutes(api *gin.RouterGroup) {
+	if r.weatherHandler == nil {
+		return
+	}
+	weather := api.Group("/public/weather").Use(middleware.CustomRateLimit(30, 10))
+	{
+		weather.GET("/now", r.weatherHandler.Now)
+	}
+}
+
+// registerProfileRoutes 注册外部平台资料聚合路由
+func (r *Router) registerProfileRoutes(api *gin.RouterGroup) {
+	if r.profileHandler == nil {
+		return
+	}
+	profiles := api.Group("/public/profiles").Use(middleware.CustomRateLimit(30, 10))
+	{
+		profiles.GET("/:provider", r.profileHandler.Get)
+	}
+}
+
+// registerMomentRoutes 注册说说路由
+func (r *Router) registerMomentRoutes(api *gin.RouterGroup) {
+	if r.momentHandler == nil {
+		return
+	}
+	momentsPublic := api.Group("/public/moments")
+	{
+		momentsPublic.GET("", r.momentHandler.ListPublic)
+		momentsPublic.GET("/:id", r.momentHandler.GetPublic)
+	}
+
+	momentsAdmin := api.Group("/moments").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		momentsAdmin.GET("", r.momentHandler.List)
+		momentsAdmin.POST("", r.momentHandler.Create)
+		momentsAdmin.PUT("/:id", r.momentHandler.Update)
+		momentsAdmin.DELETE("/:id", r.momentHandler.Delete)
+	}
+}
+
+// registerMicropubRoutes 注册 Micropub 发布接口路由
+func (r *Router) registerMicropubRoutes(api *gin.RouterGroup) {
+	if r.micropubHandler == nil {
+		return
+	}
+	// 外部编辑器使用个人访问令牌调用，不走 JWT 登录态
+	micropub := api.Group("/micropub").Use(middleware.CustomRateLimit(60, 20), r.micropubHandler.TokenAuth())
+	{
+		micropub.GET("", r.micropubHandler.Query)
+		micropub.POST("", r.micropubHandler.Publish)
+		micropub.POST("/media", r.micropubHandler.UploadMedia)
+	}
+}
+
+// registerAccessTokenRoutes 注册个人访问令牌管理路由，令牌不能用来管理令牌
+func (r *Router) registerAccessTokenRoutes(api *gin.RouterGroup) {
+	if r.accessTokenHandler == nil {
+		return
+	}
+	tokens := api.Group("/user/tokens").Use(r.mw.JWTAuth(), r.mw.DenyImpersonation())
+	{
+		tokens.GET("", r.accessTokenHandler.ListTokens)
+		tokens.POST("", r.accessTokenHandler.CreateToken)
+		tokens.DELETE("/:id", r.accessTokenHandler.RevokeToken)
+	}
+	tokensAdmin := api.Group("/admin/access-tokens").Use(r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.mw.AdminAuth())
+	{
+		tokensAdmin.GET("", r.accessTokenHandler.AdminList)
+		tokensAdmin.DELETE("/:id", r.accessTokenHandler.AdminRevoke)
+	}
+}
+
+// registerArticleTemplateRoutes 注册文章模板与内容片段路由
+func (r *Router) registerArticleTemplateRoutes(api *gin.RouterGroup) {
+	if r.articleTemplateHandler == nil {
+		return
+	}
+	// 编辑器使用的接口：登录用户可读取模板/片段，并从模板新建自己的草稿
+	templatesUser := api.Group("/article-templates").Use(r.mw.JWTAuth())
+	{
+		templatesUser.GET("", r.articleTemplateHandler.ListTemplates)
+		templatesUser.GET("/:id", r.articleTemplateHandler.GetTemplate)
+		templatesUser.POST("/:id/instantiate", r.articleTemplateHandler.Instantiate)
+	}
+	snippetsUser := api.Group("/content-snippets").Use(r.mw.JWTAuth())
+	{
+		snippetsUser.GET("", r.articleTemplateHandler.ListSnippets)
+	}
+
+	// 管理员接口：维护模板与片段
+	templatesAdmin := api.Group("/article-templates").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
+	{
+		templatesAdmin.POST("", r.articleTemplateHandler.CreateTemplate)
+		templatesAdmin.PUT("/:id", r.articleTemplateHandler.UpdateTemplate)
+		templatesAdmin.DELETE("/:id", r.articleTemplateHandler.DeleteTemplate)
+	}
+	snippetsAdmin := api.Group("/content-snippets").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
+	{
+		snippetsAdmin.POST("", r.articleTemplateHandler.CreateSnippet)
+		snippetsAdmin.PUT("/:id", r.articleTemplateHandler.UpdateSnippet)
+		snippetsAdmin.DELETE("/:id", r.articleTemplateHandler.DeleteSnippet)
+	}
+}
+
+// registerArticleCollectionRoutes 注册文章合集管理路由
+func (r *Router) registerArticleCollectionRoutes(api *gin.RouterGroup) {
+	if r.articleCollectionHandler == nil {
+		return
+	}
+	collectionsAdmin := api.Group("/article-collections").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
+	{
+		collectionsAdmin.GET("", r.articleCollectionHandler.List)
+		collectionsAdmin.GET("/:id", r.articleCollectionHandler.Get)
+		collectionsAdmin.POST("", r.articleCollectionHandler.Create)
+		collectionsAdmin.PUT("/:id", r.articleCollectionHandler.Update)
+		collectionsAdmin.DELETE("/:id", r.articleCollectionHandler.Delete)
+	}
+}
+
+// registerContributionRoutes 注册文章投稿与投稿审核路由
+func (r *Router) registerContributionRoutes(api *gin.RouterGroup) {
+	if r.contributionHandler == nil {
+		return
+	}
+	contributions := api.Group("/contributions").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleContribute))
+	{
+		contributions.GET("", r.contributionHandler.ListMine)
+		contributions.POST("", r.contributionHandler.Submit)
+		contributions.GET("/:id", r.contributionHandler.GetMine)
+		contributions.PUT("/:id", r.contributionHandler.Resubmit)
+	}
+
+	reviews := api.Group("/contribution-reviews").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
+	{
+		reviews.GET("", r.contributionHandler.ListQueue)
+		reviews.GET("/:id", r.contributionHandler.Get)
+		reviews.POST("/:id/comments", r.contributionHandler.Comment)
+		reviews.POST("/:id/request-changes", r.contributionHandler.RequestChanges)
+		reviews.POST("/:id/approve", r.contributionHandler.Approve)
+		reviews.POST("/:id/reject", r.contributionHandler.Reject)
+	}
+}
+
+// registerReadingRoutes 注册用户书签与阅读进度同步路由（需要登录）
+func (r *Router) registerReadingRoutes(api *gin.RouterGroup) {
+	if r.readingHandler == nil {
+		return
+	}
+	bookmarks := api.Group("/user/bookmarks").Use(r.mw.JWTAuth())
+	{
+		bookmarks.GET("", r.readingHandler.ListBookmarks)
+		bookmarks.POST("", r.readingHandler.AddBookmark)
+		bookmarks.GET("/:articleId", r.readingHandler.GetBookmarkStatus)
+		bookmarks.DELETE("/:articleId", r.readingHandler.RemoveBookmark)
+	}
+
+	progress := api.Group("/user/reading-progress").Use(r.mw.JWTAuth())
+	{
+		progress.GET("", r.readingHandler.ListProgress)
+		progress.GET("/:articleId", r.readingHandler.GetProgress)
+		progress.PUT("/:articleId", r.readingHandler.SaveProgress)
+		progress.DELETE("/:articleId", r.readingHandler.DeleteProgress)
+	}
+}
+
+// registerChangelogRoutes 注册更新日志管理路由（管理员专用）
+func (r *Router) registerChangelogRoutes(api *gin.RouterGroup) {
+	if r.changelogHandler == nil {
+		return
+	}
+	changelogsAdmin := api.Group("/changelogs").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		changelogsAdmin.GET("", r.changelogHandler.List)
+		changelogsAdmin.POST("/sync", r.changelogHandler.Sync)
+		changelogsAdmin.GET("/:id", r.changelogHandler.Get)
+		changelogsAdmin.POST("", r.changelogHandler.Create)
+		changelogsAdmin.PUT("/:id", r.changelogHandler.Update)
+		changelogsAdmin.DELETE("/:id", r.changelogHandler.Delete)
+	}
+}
+
+// registerMediaRoutes 注册媒体库路由（管理员专用）
+func (r *Router) registerMediaRoutes(api *gin.RouterGroup) {
+	if r.mediaHandler == nil {
+		return
+	}
+	mediaAdmin := api.Group("/admin/media").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionFileManage))
+	{
+		// 媒体库列表: GET /api/admin/media?policy_flag=article_image&orphan=true
+		mediaAdmin.GET("", r.mediaHandler.List)
+
+		// 试运行孤立图片清理: GET /api/admin/media/orphans/cleanup/preview
+		mediaAdmin.GET("/orphans/cleanup/preview", r.mediaHandler.PreviewOrphanCleanup)
+		// 清理孤立图片（移入回收站）: POST /api/admin/media/orphans/cleanup
+		mediaAdmin.POST("/orphans/cleanup", r.mediaHandler.CleanupOrphans)
+	}
+}
+
+// registerPrivacyRoutes 注册隐私数据管理路由（管理员专用）
+func (r *Router) registerPrivacyRoutes(api *gin.RouterGroup) {
+	if r.privacyHandler == nil {
+		return
+	}
+	privacyAdmin := api.Group("/admin/privacy").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// 导出评论者数据: GET /api/admin/privacy/commenter/export?email=xxx
+		privacyAdmin.GET("/commenter/export", r.privacyHandler.ExportCommenterData)
+
+		// 擦除评论者个人信息: POST /api/admin/privacy/commenter/erase
+		privacyAdmin.POST("/commenter/erase", r.privacyHandler.EraseCommenterData)
+	}
+}
+
+// registerWidgetRoutes 注册公开统计挂件路由，供外部页面和 README 徽章嵌入
+// 跨域策略由 middleware.Cors 按 widget.cors_allowed_origins 配置单独处理
+func (r *Router) registerWidgetRoutes(api *gin.RouterGroup) {
+	if r.widgetHandler == nil {
+		return
+	}
+	widgetPublic := api.Group("/public/widget").Use(middleware.CustomRateLimit(120, 30))
+	{
+		// 站点计数: GET /api/public/widget/site
+		widgetPublic.GET("/site", r.widgetHandler.GetSiteCounters)
+
+		// 文章浏览量: GET /api/public/widget/articles/:id/views
+		widgetPublic.GET("/articles/:id/views", r.widgetHandler.GetArticleViews)
+
+		// 站点计数徽章: GET /api/public/widget/badge/posts.svg
+		widgetPublic.GET("/badge/:metric", r.widgetHandler.GetSiteBadge)
+
+		// 文章浏览量徽章: GET /api/public/widget/badge/articles/:id
+		widgetPublic.GET("/badge/articles/:id", r.widgetHandler.GetArticleViewsBadge)
+	}
+}
+
+// registerDiagnosticRoutes 注册运行时诊断路由（管理员专用）
+func (r *Router) registerDiagnosticRoutes(api *gin.RouterGroup) {
+	if r.diagnosticHandler == nil {
+		return
+	}
+	diagnosticAdmin := api.Group("/admin/diagnostics").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// 获取诊断信息（含外部调用熔断器状态）: GET /api/admin/diagnostics
+		diagnosticAdmin.GET("", r.diagnosticHandler.GetDiagnostics)
+		// 慢查询汇总: GET /api/admin/diagnostics/slow-queries?limit=20
+		diagnosticAdmin.GET("/slow-queries", r.diagnosticHandler.GetSlowQueries)
+		// 清空慢查询汇总: DELETE /api/admin/diagnostics/slow-queries
+		diagnosticAdmin.DELETE("/slow-queries", r.diagnosticHandler.ResetSlowQueries)
+	}
 }
 
 // registerImageStyleRoutes 注册图片样式处理入口：
@@ -257,26 +789,49 @@ func (r *Router) registerCommentRoutes(api *gin.RouterGroup) {
 
 		commentsPublic.GET("/qq-info", r.commentHandler.GetQQInfo)         // 获取QQ昵称和头像
 		commentsPublic.GET("/ip-location", r.commentHandler.GetIPLocation) // 获取IP定位信息（用于天气组件）
+		commentsPublic.GET("/commenters/:email_md5", middleware.CustomRateLimit(60, 20), r.commentHandler.GetCommenterProfile)
 
 		commentsPublic.POST("", r.mw.JWTAuthOptional(), r.commentHandler.Create)
-		commentsPublic.POST("/upload", r.mw.JWTAuthOptional(), r.commentHandler.UploadCommentImage)
+		// 评论图片上传同时接受 comment_image 范围的编辑器上传令牌
+		commentsPublic.POST("/upload", r.mw.UploadAuth(constant.PolicyFlagCommentImage, true), r.commentHandler.UploadCommentImage)
 		commentsPublic.POST("/:id/like", r.commentHandler.LikeComment)
 		commentsPublic.POST("/:id/unlike", r.commentHandler.UnlikeComment)
+		commentsPublic.POST("/:id/reactions/:reaction", middleware.CustomRateLimit(30, 10), r.commentHandler.AddReaction)
+		commentsPublic.DELETE("/:id/reactions/:reaction", middleware.CustomRateLimit(30, 10), r.commentHandler.RemoveReaction)
+		// 评论区订阅：新评论按间隔合并为摘要邮件，邮件中的签名链接可直接退订
+		commentsPublic.POST("/subscriptions", middleware.CustomRateLimit(3, 3), r.commentHandler.Subscribe)
+		commentsPublic.GET("/subscriptions/:id/unsubscribe", r.commentHandler.Unsubscribe)
 	}
 
+	// 实时评论流（WebSocket），按 target_path 订阅新评论、状态变更与点赞数
+	api.GET("/ws/comments", middleware.CustomRateLimit(30, 10), r.commentHandler.Stream)
+
 	// 天气组件专用路径（与评论 IP 定位共用实现，前端请求 /api/public/weather/ip-location）
 	api.Group("/public/weather").GET("/ip-location", r.commentHandler.GetIPLocation)
 
-	// 管理员专属的评论接口
-	commentsAdmin := api.Group("/comments").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 评论管理接口，需要 comment:moderate 权限
+	commentsAdmin := api.Group("/comments").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionCommentModerate))
 	{
 		commentsAdmin.GET("", r.commentHandler.AdminList)
 		commentsAdmin.DELETE("", r.commentHandler.Delete)
+		commentsAdmin.POST("/batch/approve", r.commentHandler.BatchApprove)
+		commentsAdmin.POST("/batch/reject", r.commentHandler.BatchReject)
+		commentsAdmin.POST("/batch/spam", r.commentHandler.MarkSpam)
+		commentsAdmin.POST("/batch/ham", r.commentHandler.MarkHam)
+		commentsAdmin.GET("/clusters", r.commentHandler.ListClusters)
+		commentsAdmin.GET("/commenters/:email_md5", r.commentHandler.CommenterHistory)
+		commentsAdmin.PUT("/commenters/:email_md5/trust", r.commentHandler.SetCommenterTrust)
+		commentsAdmin.GET("/word-rules/export", r.commentHandler.ExportWordRules)
+		commentsAdmin.POST("/word-rules/import", r.commentHandler.ImportWordRules)
+		commentsAdmin.POST("/word-rules/test", r.commentHandler.TestWordRules)
+		commentsAdmin.GET("/cleanup/preview", r.commentHandler.PreviewCleanup)
+		commentsAdmin.POST("/cleanup", r.commentHandler.RunCleanup)
 		commentsAdmin.PUT("/:id", r.commentHandler.UpdateContent)
 		commentsAdmin.PUT("/:id/info", r.commentHandler.UpdateCommentInfo)
 		commentsAdmin.PUT("/:id/status", r.commentHandler.UpdateStatus)
 		commentsAdmin.PUT("/:id/pin", r.commentHandler.SetPin)
 		commentsAdmin.POST("/export", r.commentHandler.ExportComments)
+		commentsAdmin.GET("/export/stream", r.commentHandler.ExportCommentsStream)
 		commentsAdmin.POST("/import", r.commentHandler.ImportComments)
 	}
 }
@@ -290,7 +845,7 @@ func (r *Router) registerPostTagRoutes(api *gin.RouterGroup) {
 	}
 
 	// 创建、更新、删除通常需要管理员权限
-	postTagsAdmin := api.Group("/post-tags").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	postTagsAdmin := api.Group("/post-tags").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
 	{
 		postTagsAdmin.POST("", r.postTagHandler.Create)
 		postTagsAdmin.PUT("/:id", r.postTagHandler.Update)
@@ -305,7 +860,7 @@ func (r *Router) registerPostCategoryRoutes(api *gin.RouterGroup) {
 		// postCategoriesPublic.GET("/:id", r.postCategoryHandler.Get)
 	}
 
-	postCategoriesAdmin := api.Group("/post-categories").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	postCategoriesAdmin := api.Group("/post-categories").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
 	{
 		postCategoriesAdmin.POST("", r.postCategoryHandler.Create)
 		postCategoriesAdmin.PUT("/:id", r.postCategoryHandler.Update)
@@ -323,7 +878,7 @@ func (r *Router) registerDocSeriesRoutes(api *gin.RouterGroup) {
 	}
 
 	// 管理员接口：创建、更新、删除文档系列
-	docSeriesAdmin := api.Group("/doc-series").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	docSeriesAdmin := api.Group("/doc-series").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
 	{
 		docSeriesAdmin.GET("", r.docSeriesHandler.List)
 		docSeriesAdmin.GET("/:id", r.docSeriesHandler.Get)
@@ -334,6 +889,9 @@ func (r *Router) registerDocSeriesRoutes(api *gin.RouterGroup) {
 }
 
 func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
+	// 上传文章图片（支持普通用户，用于多人共创场景），同时接受 article_image 范围的编辑器上传令牌
+	api.POST("/articles/upload", r.mw.UploadAuth(constant.PolicyFlagArticleImage, false), r.articleHandler.UploadImage)
+
 	// 文章列表和创建接口：支持多人共创功能，普通用户也可以访问
 	articlesUser := api.Group("/articles").Use(r.mw.JWTAuth())
 	{
@@ -341,8 +899,8 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		articlesUser.GET("", r.articleHandler.List)
 		// 创建文章（支持普通用户，需要检查多人共创配置，权限在handler层校验）
 		articlesUser.POST("", r.articleHandler.Create)
-		// 上传文章图片（支持普通用户，用于多人共创场景）
-		articlesUser.POST("/upload", r.articleHandler.UploadImage)
+		// 编辑器辅助：关键词提取与站内链接推荐
+		articlesUser.POST("/editor-assist", r.articleHandler.EditorAssist)
 		// 更新文章（普通用户只能更新自己的文章，权限在handler层校验）
 		articlesUser.PUT("/:id", r.articleHandler.Update)
 		// 删除文章（普通用户只能删除自己的文章，权限在handler层校验）
@@ -360,15 +918,34 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		}
 	}
 
-	// 后台管理接口，需要认证和管理员权限（保留用于向后兼容）
-	articlesAdmin := api.Group("/articles").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 后台管理接口，需要认证和 article:write 权限
+	articlesAdmin := api.Group("/articles").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleWrite))
 	{
 		articlesAdmin.POST("/primary-color", r.articleHandler.GetPrimaryColor)
 		// 文章导入导出功能（仅管理员可用）
 		articlesAdmin.POST("/export", r.articleHandler.ExportArticles)
+		articlesAdmin.GET("/export/stream", r.articleHandler.ExportArticlesStream)
 		articlesAdmin.POST("/import", r.articleHandler.ImportArticles)
+		// 导出单篇 Markdown: GET /api/articles/:id/export
+		articlesAdmin.GET("/:id/export", r.articleHandler.ExportMarkdown)
 		// 批量删除文章（仅管理员可用）
 		articlesAdmin.DELETE("/batch", r.articleHandler.BatchDelete)
+		// 文章语音朗读：查看生成状态与手动重新生成
+		if r.ttsHandler != nil {
+			articlesAdmin.GET("/:id/audio", r.ttsHandler.GetAudio)
+			articlesAdmin.POST("/:id/audio", r.ttsHandler.RegenerateAudio)
+		}
+		// 分享卡片预览：检查线上页面的 OG / Twitter 标签与分享图片
+		if r.socialCardHandler != nil {
+			articlesAdmin.GET("/:id/social-preview", r.socialCardHandler.Preview)
+		}
+		// 文章修订版本：查看、对比并直接恢复
+		if r.articleHistoryHandler != nil {
+			articlesAdmin.GET("/:id/revisions", r.articleHistoryHandler.ListHistory)
+			articlesAdmin.GET("/:id/revisions/diff", r.articleHistoryHandler.DiffRevisions)
+			articlesAdmin.GET("/:id/revisions/:version", r.articleHistoryHandler.GetVersion)
+			articlesAdmin.POST("/:id/revisions/:version/restore", r.articleHistoryHandler.RestoreRevision)
+		}
 	}
 
 	articlesPublic := api.Group("/public/articles")
@@ -381,6 +958,8 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		articlesPublic.GET("/by-url", r.articleHandler.GetByURL)
 		// 注意：把带参数的路由放在最后，避免路由冲突
 		articlesPublic.GET("/:id", r.articleHandler.GetPublic)
+		articlesPublic.POST("/:id/reactions/:reaction", middleware.CustomRateLimit(30, 10), r.articleHandler.AddReaction)
+		articlesPublic.DELETE("/:id/reactions/:reaction", middleware.CustomRateLimit(30, 10), r.articleHandler.RemoveReaction)
 	}
 }
 
@@ -411,6 +990,24 @@ func (r *Router) registerAuthRoutes(api *gin.RouterGroup) {
 		auth.POST("/forgot-password", middleware.CustomRateLimit(5, 3), r.authHandler.ForgotPasswordRequest)
 		auth.POST("/reset-password", middleware.CustomRateLimit(5, 3), r.authHandler.ResetPassword)
 		auth.GET("/check-email", middleware.CustomRateLimit(10, 5), r.authHandler.CheckEmail)
+		auth.GET("/password-policy", r.authHandler.GetPasswordPolicy)
+		// 编辑器上传令牌：仅能调用对应策略的图片上传接口
+		auth.POST("/upload-token", middleware.CustomRateLimit(30, 10), r.mw.JWTAuth(), r.authHandler.IssueUploadToken)
+
+		// 第三方登录（GitHub、Google、QQ）
+		auth.GET("/oauth/providers", r.authHandler.OAuthProviders)
+		auth.GET("/oauth/:provider/login", middleware.CustomRateLimit(10, 5), r.authHandler.OAuthLogin)
+		auth.GET("/oauth/:provider/callback", middleware.CustomRateLimit(10, 5), r.authHandler.OAuthCallback)
+		auth.POST("/oauth/:provider/bind", middleware.CustomRateLimit(10, 5), r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.authHandler.OAuthBind)
+		auth.POST("/oauth/exchange", middleware.CustomRateLimit(10, 5), r.authHandler.OAuthExchange)
+		auth.GET("/oauth/identities", r.mw.JWTAuth(), r.authHandler.OAuthIdentities)
+		auth.DELETE("/oauth/identities/:provider", r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.authHandler.OAuthUnlink)
+	}
+
+	securityAdmin := api.Group("/admin/security").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// 轮换 JWT 密钥（旧密钥在宽限期内仍有效）: POST /api/admin/security/jwt-secret/rotate
+		securityAdmin.POST("/jwt-secret/rotate", r.authHandler.RotateJWTSecret)
 	}
 }
 
@@ -449,11 +1046,13 @@ func (r *Router) registerSettingRoutes(api *gin.RouterGroup) {
 	{
 		settings.POST("/get-by-keys", r.settingHandler.GetSettingsByKeys)
 	}
-	// 更新配置和测试邮件需要管理员权限
-	settingsAdmin := api.Group("/settings").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 更新配置和测试邮件需要 settings:write 权限
+	settingsAdmin := api.Group("/settings").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionSettingsWrite))
 	{
 		settingsAdmin.POST("/update", r.settingHandler.UpdateSettings)
 		settingsAdmin.POST("/test-email", r.settingHandler.TestEmail)
+		// 只读模式开关（只读模式下依然可用，见 middleware.ReadOnlyTogglePath）
+		settingsAdmin.PUT("/read-only", r.settingHandler.SetReadOnlyMode)
 	}
 }
 
@@ -463,13 +1062,13 @@ func (r *Router) registerUserRoutes(api *gin.RouterGroup) {
 	user := api.Group("/user").Use(r.mw.JWTAuth())
 	{
 		user.GET("/info", r.userHandler.GetUserInfo)
-		user.POST("/update-password", r.userHandler.UpdateUserPassword)
+		user.POST("/update-password", r.mw.DenyImpersonation(), r.userHandler.UpdateUserPassword)
 		user.PUT("/profile", r.userHandler.UpdateUserProfile)
 		user.POST("/avatar", r.userHandler.UploadAvatar)
 	}
 
 	// 管理员用户管理路由（需要登录且为管理员）
-	adminUsers := api.Group("/admin/users").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	adminUsers := api.Group("/admin/users").Use(r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.mw.AdminAuth())
 	{
 		// 用户列表
 		adminUsers.GET("", r.userHandler.AdminListUsers)
@@ -483,13 +1082,21 @@ func (r *Router) registerUserRoutes(api *gin.RouterGroup) {
 		adminUsers.POST("/:id/reset-password", r.userHandler.AdminResetPassword)
 		// 更新用户状态
 		adminUsers.PUT("/:id/status", r.userHandler.AdminUpdateUserStatus)
+		// 模拟登录用户（签发短期令牌，全程写入审计日志）
+		adminUsers.POST("/:id/impersonate", r.userHandler.AdminImpersonateUser)
 	}
 
 	// 用户组管理路由（需要登录且为管理员）
-	adminUserGroups := api.Group("/admin/user-groups").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	adminUserGroups := api.Group("/admin/user-groups").Use(r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.mw.AdminAuth())
 	{
 		// 获取用户组列表
 		adminUserGroups.GET("", r.userHandler.GetUserGroups)
+		// 获取可分配的权限列表
+		adminUserGroups.GET("/permissions", r.userHandler.GetPermissionDefinitions)
+		// 更新用户组权限
+		adminUserGroups.PUT("/:id/permissions", r.userHandler.AdminUpdateUserGroupPermissions)
+		// 更新用户组上传限制
+		adminUserGroups.PUT("/:id/upload-policy", r.userHandler.AdminUpdateUserGroupUploadPolicy)
 	}
 }
 
@@ -512,20 +1119,50 @@ func (r *Router) registerPublicRoutes(api *gin.RouterGroup) {
 		public.POST("/subscribe/code", middleware.CustomRateLimit(3, 3), r.subscriberHandler.SendVerificationCode)
 		public.POST("/unsubscribe", r.subscriberHandler.Unsubscribe)
 		public.GET("/unsubscribe/:token", r.subscriberHandler.UnsubscribeByToken)
+
+		// 图片配色（相册与文章卡片配色）
+		if r.imagePaletteHandler != nil {
+			public.GET("/palette/:id", middleware.CustomRateLimit(30, 30), r.imagePaletteHandler.GetPalette)
+		}
+
+		// 文章合集（首页横幅、编辑精选等模块）
+		if r.articleCollectionHandler != nil {
+			public.GET("/collections/:slug", r.articleCollectionHandler.GetPublic)
+		}
+
+		// 更新日志（更新页面）
+		if r.changelogHandler != nil {
+			public.GET("/changelog", r.changelogHandler.ListPublic)
+		}
 	}
 }
 
 // registerStoragePolicyRoutes 注册存储策略相关的路由
 func (r *Router) registerStoragePolicyRoutes(api *gin.RouterGroup) {
-	policies := api.Group("/policies").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 创建、修改、删除策略与挂载点可指定任意存储路径或清除远程数据，OneDrive 授权会写入凭据，仅限管理员
+	policiesAdmin := api.Group("/policies").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		policiesAdmin.POST("", r.storagePolicyHandler.Create)
+		policiesAdmin.PUT("/:id", r.storagePolicyHandler.Update)
+		policiesAdmin.DELETE("/:id", r.storagePolicyHandler.Delete)
+		policiesAdmin.GET("/connect/onedrive/:id", r.storagePolicyHandler.ConnectOneDrive)
+		policiesAdmin.POST("/authorize/onedrive", r.storagePolicyHandler.AuthorizeOneDrive)
+		policiesAdmin.POST("/:id/mounts", r.storagePolicyHandler.AddMount)
+		policiesAdmin.PUT("/:id/mounts/:mountId", r.storagePolicyHandler.UpdateMount)
+		policiesAdmin.DELETE("/:id/mounts/:mountId", r.storagePolicyHandler.DeleteMount)
+	}
+
+	// 拥有 file:manage 权限的用户可查看策略与维护远程数据，列表与详情对非管理员隐藏访问密钥
+	policies := api.Group("/policies").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionFileManage))
 	{
-		policies.POST("", r.storagePolicyHandler.Create)
 		policies.GET("", r.storagePolicyHandler.List)
-		policies.GET("/connect/onedrive/:id", r.storagePolicyHandler.ConnectOneDrive)
-		policies.POST("/authorize/onedrive", r.storagePolicyHandler.AuthorizeOneDrive)
 		policies.GET("/:id", r.storagePolicyHandler.Get)
-		policies.PUT("/:id", r.storagePolicyHandler.Update)
-		policies.DELETE("/:id", r.storagePolicyHandler.Delete)
+		policies.GET("/:id/purge-preview", r.storagePolicyHandler.PurgePreview)
+		policies.GET("/purge-tasks/:taskId", r.storagePolicyHandler.GetPurgeTask)
+		policies.POST("/stale-uploads/cleanup", r.storagePolicyHandler.CleanupStaleUploads)
+		policies.POST("/integrity/check", r.storagePolicyHandler.CheckIntegrity)
+		policies.GET("/integrity/report", r.storagePolicyHandler.DownloadIntegrityReport)
+		policies.GET("/:id/mounts", r.storagePolicyHandler.ListMounts)
 	}
 }
 
@@ -573,6 +1210,10 @@ func (r *Router) registerFileRoutes(api *gin.RouterGroup) {
 		// GET /api/file/upload/session/{sessionId}
 		uploadGroup.GET("/session/:sessionId", r.fileHandler.GetUploadSessionStatus)
 
+		// 列出当前用户所有进行中的上传会话
+		// GET /api/file/upload/sessions
+		uploadGroup.GET("/sessions", r.fileHandler.ListUploadSessions)
+
 		// 上传文件块，:sessionId 和 :index 是路径参数
 		// POST /api/file/upload/some-uuid-string/0
 		uploadGroup.POST("/:sessionId/:index", r.fileHandler.UploadChunk)
@@ -596,6 +1237,18 @@ func (r *Router) registerFileRoutes(api *gin.RouterGroup) {
 		folderGroup.POST("/move", r.fileHandler.MoveItems)
 		folderGroup.POST("/copy", r.fileHandler.CopyItems)
 	}
+
+	// --- 回收站路由 ---
+	recycleBinGroup := api.Group("/recycle-bin")
+	recycleBinGroup.Use(r.mw.JWTAuth())
+	{
+		// GET /api/recycle-bin?page=1&pageSize=20
+		recycleBinGroup.GET("", r.fileHandler.ListRecycleBin)
+		// POST /api/recycle-bin/restore
+		recycleBinGroup.POST("/restore", r.fileHandler.RestoreRecycleItems)
+		// POST /api/recycle-bin/purge，ids 为空时清空回收站
+		recycleBinGroup.POST("/purge", r.fileHandler.PurgeRecycleItems)
+	}
 }
 
 func (r *Router) registerDirectLinkRoutes(api *gin.RouterGroup) {
@@ -649,6 +1302,7 @@ func (r *Router) registerLinkRoutes(api *gin.RouterGroup) {
 		linksAdmin.GET("/export", r.linkHandler.ExportLinks)                       // GET /api/links/export
 		linksAdmin.POST("/health-check", r.linkHandler.CheckLinksHealth)           // POST /api/links/health-check
 		linksAdmin.GET("/health-check/status", r.linkHandler.GetHealthCheckStatus) // GET /api/links/health-check/status
+		linksAdmin.GET("/:id/health-history", r.linkHandler.GetLinkHealthHistory)  // GET /api/links/:id/health-history
 		linksAdmin.PUT("/sort", r.linkHandler.BatchUpdateLinkSort)                 // PUT /api/links/sort
 
 		// 分类管理
@@ -672,6 +1326,9 @@ func (r *Router) registerStatisticsRoutes(api *gin.RouterGroup) {
 		// 获取基础统计数据: GET /api/public/statistics/basic
 		statisticsPublic.GET("/basic", r.statisticsHandler.GetBasicStatistics)
 
+		// 获取站点统计快照: GET /api/public/statistics/site
+		statisticsPublic.GET("/site", r.statisticsHandler.GetSiteStatistics)
+
 		// 记录访问: POST /api/public/statistics/visit
 		statisticsPublic.POST("/visit", r.statisticsHandler.RecordVisit)
 	}
@@ -693,6 +1350,9 @@ func (r *Router) registerStatisticsRoutes(api *gin.RouterGroup) {
 
 		// 获取访客访问日志: GET /api/statistics/visitor-logs
 		statisticsAdmin.GET("/visitor-logs", r.statisticsHandler.GetVisitorLogs)
+
+		// 流式导出访客访问日志: GET /api/statistics/visitor-logs/export
+		statisticsAdmin.GET("/visitor-logs/export", r.statisticsHandler.ExportVisitorLogs)
 	}
 }
 
@@ -802,6 +1462,19 @@ func (r *Router) registerMusicRoutes(api *gin.RouterGroup) {
 
 		// 获取歌曲资源: POST /api/public/music/song-resources
 		musicPublic.POST("/song-resources", r.musicHandler.GetSongResources)
+
+		// 本月最常播放: GET /api/public/music/top
+		musicPublic.GET("/top", r.musicHandler.GetTopThisMonth)
+	}
+
+	// 上报播放: POST /api/public/music/plays（限流，防止刷量）
+	api.POST("/public/music/plays", middleware.CustomRateLimit(30, 10), r.musicHandler.RecordPlay)
+
+	// --- 后台音乐统计接口 ---
+	musicAdmin := api.Group("/music").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		// 播放统计图表: GET /api/music/stats
+		musicAdmin.GET("/stats", r.musicHandler.GetPlayChart)
 	}
 }
 
@@ -825,6 +1498,22 @@ func (r *Router) registerRSSRoutes(engine *gin.Engine) {
 	engine.GET("/rss.xml", r.rssHandler.GetRSSFeed)
 	engine.GET("/feed.xml", r.rssHandler.GetRSSFeed)
 	engine.GET("/atom.xml", r.rssHandler.GetRSSFeed)
+	engine.GET("/comments.atom", r.rssHandler.GetCommentFeed)
+	engine.GET("/posts/:slug/comments.atom", r.rssHandler.GetCommentFeed)
+	engine.GET("/update/rss.xml", r.rssHandler.GetChangelogFeed)
+}
+
+// registerWebDAVRoutes 注册 WebDAV 路由，/dav 与 /dav/* 下的所有 WebDAV 方法都交给同一个处理器
+func (r *Router) registerWebDAVRoutes(engine *gin.Engine) {
+	if r.webdavHandler == nil {
+		return
+	}
+	// 客户端会并发发起大量请求，频率限制放宽到主要用于防止密码暴力破解
+	dav := engine.Group(webdav_handler.Prefix, middleware.CustomRateLimit(1200, 200))
+	for _, method := range webdav_handler.Methods {
+		dav.Handle(method, "", r.webdavHandler.Serve)
+		dav.Handle(method, "/*path", r.webdavHandler.Serve)
+	}
 }
 
 // registerVersionRoutes 注册版本信息相关路由
@@ -862,8 +1551,8 @@ func (r *Router) registerNotificationRoutes(api *gin.RouterGroup) {
 
 // registerConfigBackupRoutes 注册配置备份相关路由
 func (r *Router) registerConfigBackupRoutes(api *gin.RouterGroup) {
-	// 配置备份管理路由 - 需要管理员权限
-	configBackupGroup := api.Group("/config/backup").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 配置备份管理路由 - 备份中包含 JWT 密钥等敏感配置，仅限管理员
+	configBackupGroup := api.Group("/config/backup").Use(r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.mw.AdminAuth())
 	{
 		// 创建备份
 		configBackupGroup.POST("/create", r.configBackupHandler.CreateBackup)
@@ -881,8 +1570,8 @@ func (r *Router) registerConfigBackupRoutes(api *gin.RouterGroup) {
 		configBackupGroup.POST("/clean", r.configBackupHandler.CleanOldBackups)
 	}
 
-	// 配置导入导出路由 - 需要管理员权限
-	configGroup := api.Group("/config").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 配置导入导出路由 - 导出内容包含 JWT 密钥等敏感配置，仅限管理员
+	configGroup := api.Group("/config").Use(r.mw.JWTAuth(), r.mw.DenyImpersonation(), r.mw.AdminAuth())
 	{
 		// 导出配置
 		configGroup.GET("/export", r.configImportExportHandler.ExportConfig)
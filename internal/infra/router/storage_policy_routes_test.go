@@ -0,0 +1,126 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/app/middleware"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	storage_policy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/storage_policy"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	service_auth "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+// fakeRouteTokens 把 Bearer 后的字符串当作用户组ID：1 为管理员组，2 为只拥有 file:manage 的用户组
+type fakeRouteTokens struct {
+	service_auth.TokenService
+}
+
+func (fakeRouteTokens) ParseAccessToken(ctx context.Context, token string) (*auth.CustomClaims, error) {
+	perms := model.Boolset{}
+	groupID := uint(1)
+	if token == "manager" {
+		groupID = 2
+		perms.Set(model.PermissionFileManage, true)
+	}
+	userID, _ := idgen.GeneratePublicID(groupID, idgen.EntityTypeUser)
+	groupPublicID, _ := idgen.GeneratePublicID(groupID, idgen.EntityTypeUserGroup)
+	return &auth.CustomClaims{UserID: userID, UserGroupID: groupPublicID, Permissions: perms}, nil
+}
+
+type fakeRoutePolicies struct {
+	volume.IStoragePolicyService
+}
+
+func (fakeRoutePolicies) GetPolicyByID(ctx context.Context, id string) (*model.StoragePolicy, error) {
+	return &model.StoragePolicy{ID: 3, Name: "s3", AccessKey: "AK", SecretKey: "SK"}, nil
+}
+
+func (fakeRoutePolicies) ListPolicies(ctx context.Context, page, pageSize int) ([]*model.StoragePolicy, int64, error) {
+	return []*model.StoragePolicy{{ID: 3, Name: "s3", AccessKey: "AK", SecretKey: "SK"}}, 1, nil
+}
+
+func newStoragePolicyTestEngine(t *testing.T) *gin.Engine {
+	t.Helper()
+	if err := idgen.InitSqidsEncoderWithSeed("router-policy-test"); err != nil {
+		t.Fatal(err)
+	}
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	r := &Router{
+		mw:                   middleware.NewMiddleware(fakeRouteTokens{}),
+		storagePolicyHandler: storage_policy_handler.NewStoragePolicyHandler(fakeRoutePolicies{}),
+	}
+	r.registerStoragePolicyRoutes(engine.Group("/api"))
+	return engine
+}
+
+func servePolicyRoute(engine *gin.Engine, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestStoragePolicyRoutes_AdminOnlyForFileManagers(t *testing.T) {
+	engine := newStoragePolicyTestEngine(t)
+	routes := []struct{ method, path string }{
+		{http.MethodPost, "/api/policies"},
+		{http.MethodPut, "/api/policies/p1"},
+		{http.MethodDelete, "/api/policies/p1"},
+		{http.MethodGet, "/api/policies/connect/onedrive/p1"},
+		{http.MethodPost, "/api/policies/authorize/onedrive"},
+		{http.MethodPost, "/api/policies/p1/mounts"},
+		{http.MethodPut, "/api/policies/p1/mounts/m1"},
+		{http.MethodDelete, "/api/policies/p1/mounts/m1"},
+	}
+	for _, route := range routes {
+		if w := servePolicyRoute(engine, route.method, route.path, "manager"); w.Code != http.StatusForbidden {
+			t.Errorf("%s %s: file:manage 用户应被拒绝, 实际状态码 %d", route.method, route.path, w.Code)
+		}
+	}
+}
+
+func TestStoragePolicyRoutes_HideCredentialsFromFileManagers(t *testing.T) {
+	engine := newStoragePolicyTestEngine(t)
+	for _, path := range []string{"/api/policies", "/api/policies/p1"} {
+		for token, wantSecrets := range map[string]bool{"manager": false, "admin": true} {
+			w := servePolicyRoute(engine, http.MethodGet, path, token)
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET %s (%s): 状态码 %d", path, token, w.Code)
+			}
+			var body struct {
+				Data json.RawMessage `json:"data"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			var item *model.StoragePolicyResponse
+			if path == "/api/policies" {
+				var list storage_policy_handler.PolicyListResponse
+				if err := json.Unmarshal(body.Data, &list); err != nil || len(list.List) != 1 {
+					t.Fatalf("GET %s: 无法解析列表 %s", path, body.Data)
+				}
+				item = list.List[0]
+			} else if err := json.Unmarshal(body.Data, &item); err != nil {
+				t.Fatal(err)
+			}
+			hasSecrets := item.AccessKey == "AK" && item.SecretKey == "SK"
+			leaked := item.AccessKey != "" || item.SecretKey != ""
+			if wantSecrets && !hasSecrets {
+				t.Errorf("GET %s: 管理员应能看到访问密钥", path)
+			}
+			if !wantSecrets && leaked {
+				t.Errorf("GET %s: file:manage 用户不应看到访问密钥", path)
+			}
+		}
+	}
+}
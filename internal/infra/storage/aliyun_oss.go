@@ -867,3 +867,58 @@ func (p *AliOSSProvider) GetCORSConfig(ctx context.Context, policy *model.Storag
 
 	return result.CORSRules, nil
 }
+
+// AbortStaleUploads 中止策略基础路径下发起时间早于 before 的未完成分片上传
+func (p *AliOSSProvider) AbortStaleUploads(ctx context.Context, policy *model.StoragePolicy, before time.Time) (*StaleUploadResult, error) {
+	_, bucket, err := p.getOSSClient(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StaleUploadResult{}
+	var keyMarker, uploadIDMarker string
+	for {
+		output, err := bucket.ListMultipartUploads(oss.Prefix(staleUploadPrefix(policy)), oss.KeyMarker(keyMarker), oss.UploadIDMarker(uploadIDMarker), oss.WithContext(ctx))
+		if err != nil {
+			return result, fmt.Errorf("列出阿里云OSS未完成的分片上传失败: %w", err)
+		}
+		for _, upload := range output.Uploads {
+			if !upload.Initiated.Before(before) {
+				continue
+			}
+			imur := oss.InitiateMultipartUploadResult{Bucket: policy.BucketName, Key: upload.Key, UploadID: upload.UploadID}
+			size := p.uploadedPartsSize(ctx, bucket, imur)
+			if err := bucket.AbortMultipartUpload(imur, oss.WithContext(ctx)); err != nil {
+				log.Printf("[阿里云OSS] 中止分片上传失败 - key: %s, uploadId: %s, 错误: %v", upload.Key, upload.UploadID, err)
+				continue
+			}
+			result.Aborted++
+			result.ReclaimedBytes += size
+		}
+		if !output.IsTruncated {
+			return result, nil
+		}
+		keyMarker = output.NextKeyMarker
+		uploadIDMarker = output.NextUploadIDMarker
+	}
+}
+
+// uploadedPartsSize 统计分片上传中已上传分片的总大小，查询失败时返回已统计的部分
+func (p *AliOSSProvider) uploadedPartsSize(ctx context.Context, bucket *oss.Bucket, imur oss.InitiateMultipartUploadResult) int64 {
+	var total int64
+	marker := 0
+	for {
+		output, err := bucket.ListUploadedParts(imur, oss.PartNumberMarker(marker), oss.WithContext(ctx))
+		if err != nil {
+			return total
+		}
+		for _, part := range output.UploadedParts {
+			total += int64(part.Size)
+		}
+		next, err := strconv.Atoi(output.NextPartNumberMarker)
+		if !output.IsTruncated || err != nil || next <= marker {
+			return total
+		}
+		marker = next
+	}
+}
@@ -774,3 +774,65 @@ func (p *AWSS3Provider) CreatePresignedUploadURL(ctx context.Context, policy *mo
 		ContentType:        "", // AWS S3不需要指定Content-Type
 	}, nil
 }
+
+// AbortStaleUploads 中止策略基础路径下发起时间早于 before 的未完成分片上传。
+// 浏览器直传或 SDK 中断后残留的分片会一直计费，直到上传被完成或中止。
+func (p *AWSS3Provider) AbortStaleUploads(ctx context.Context, policy *model.StoragePolicy, before time.Time) (*StaleUploadResult, error) {
+	client, err := p.getS3Client(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StaleUploadResult{}
+	input := &s3.ListMultipartUploadsInput{Bucket: aws.String(policy.BucketName)}
+	if prefix := staleUploadPrefix(policy); prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	for {
+		output, err := client.ListMultipartUploads(ctx, input)
+		if err != nil {
+			return result, fmt.Errorf("列出AWS S3未完成的分片上传失败: %w", err)
+		}
+		for _, upload := range output.Uploads {
+			if upload.Initiated == nil || !upload.Initiated.Before(before) {
+				continue
+			}
+			size := p.uploadedPartsSize(ctx, client, policy.BucketName, upload.Key, upload.UploadId)
+			_, err := client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(policy.BucketName),
+				Key:      upload.Key,
+				UploadId: upload.UploadId,
+			})
+			if err != nil {
+				log.Printf("[AWS S3] 中止分片上传失败 - key: %s, uploadId: %s, 错误: %v", aws.ToString(upload.Key), aws.ToString(upload.UploadId), err)
+				continue
+			}
+			result.Aborted++
+			result.ReclaimedBytes += size
+		}
+		if !aws.ToBool(output.IsTruncated) {
+			return result, nil
+		}
+		input.KeyMarker = output.NextKeyMarker
+		input.UploadIdMarker = output.NextUploadIdMarker
+	}
+}
+
+// uploadedPartsSize 统计分片上传中已上传分片的总大小，查询失败时返回已统计的部分
+func (p *AWSS3Provider) uploadedPartsSize(ctx context.Context, client *s3.Client, bucket string, key, uploadID *string) int64 {
+	var total int64
+	input := &s3.ListPartsInput{Bucket: aws.String(bucket), Key: key, UploadId: uploadID}
+	for {
+		output, err := client.ListParts(ctx, input)
+		if err != nil {
+			return total
+		}
+		for _, part := range output.Parts {
+			total += aws.ToInt64(part.Size)
+		}
+		if !aws.ToBool(output.IsTruncated) {
+			return total
+		}
+		input.PartNumberMarker = output.NextPartNumberMarker
+	}
+}
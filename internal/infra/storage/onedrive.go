@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -154,6 +155,21 @@ var azureChinaCloudEndpoint = oauth2.Endpoint{
 // OneDriveProvider 实现了 IStorageProvider 接口，用于处理与 Microsoft OneDrive 的所有交互。
 type OneDriveProvider struct {
 	policyRepo repository.StoragePolicyRepository // 注入存储策略仓库，用于解析路径
+	// uploadSessions 记录本进程创建的上传会话（uploadURL => *trackedUploadSession）。
+	// Graph API 无法列出进行中的上传会话，清理时只能依赖此记录；重启后丢失的会话由 OneDrive 到期自动回收。
+	uploadSessions sync.Map
+}
+
+// trackedUploadSession 是一个已创建但尚未确认完成的上传会话
+type trackedUploadSession struct {
+	policyID  uint
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+// graphUploadSessionStatus 是查询上传会话得到的进度
+type graphUploadSessionStatus struct {
+	NextExpectedRanges []string `json:"nextExpectedRanges"`
 }
 
 // NewOneDriveProvider 是 OneDriveProvider 的构造函数。
@@ -903,6 +919,7 @@ func (p *OneDriveProvider) resumableUpload(ctx context.Context, file io.Reader,
 		json.Unmarshal(body, &errResp)
 		return nil, fmt.Errorf("OneDrive分片上传失败, 状态码: %d, 错误: %s", resp.StatusCode, errResp.Error.Message)
 	}
+	p.uploadSessions.Delete(presignedResult.UploadURL)
 	return p.parseUploadResponse(body, policy, virtualPath, fileSize)
 }
 
@@ -944,6 +961,11 @@ func (p *OneDriveProvider) CreatePresignedUploadURL(ctx context.Context, policy
 		return nil, fmt.Errorf("解析上传会话响应失败: %w", err)
 	}
 	expTime, _ := time.Parse(time.RFC3339, sessionResp.ExpirationDateTime)
+	p.uploadSessions.Store(sessionResp.UploadURL, &trackedUploadSession{
+		policyID:  policy.ID,
+		createdAt: time.Now(),
+		expiresAt: expTime,
+	})
 	return &PresignedUploadResult{
 		UploadURL:          sessionResp.UploadURL,
 		ExpirationDateTime: expTime,
@@ -1020,3 +1042,95 @@ func (p *OneDriveProvider) parseUploadResponse(body []byte, policy *model.Storag
 		MimeType: mimeType,
 	}, nil
 }
+
+// AbortStaleUploads 取消本进程为该策略创建、且创建时间早于 before 的未完成上传会话。
+// 已完成或已失效的会话查询时返回 404，只移除记录不计入统计；已过期的会话由 OneDrive 自动回收。
+func (p *OneDriveProvider) AbortStaleUploads(ctx context.Context, policy *model.StoragePolicy, before time.Time) (*StaleUploadResult, error) {
+	result := &StaleUploadResult{}
+	now := time.Now()
+	p.uploadSessions.Range(func(key, value interface{}) bool {
+		uploadURL := key.(string)
+		session := value.(*trackedUploadSession)
+		if session.policyID != policy.ID || !session.createdAt.Before(before) {
+			return true
+		}
+		if !session.expiresAt.IsZero() && session.expiresAt.Before(now) {
+			p.uploadSessions.Delete(uploadURL)
+			return true
+		}
+
+		uploaded, found, err := p.uploadSessionProgress(ctx, uploadURL)
+		if err != nil {
+			log.Printf("[OneDrive] 查询上传会话进度失败: %v", err)
+			return ctx.Err() == nil
+		}
+		if !found {
+			p.uploadSessions.Delete(uploadURL)
+			return true
+		}
+		if err := p.cancelUploadSession(ctx, uploadURL); err != nil {
+			log.Printf("[OneDrive] 取消上传会话失败: %v", err)
+			return ctx.Err() == nil
+		}
+		p.uploadSessions.Delete(uploadURL)
+		result.Aborted++
+		result.ReclaimedBytes += uploaded
+		return true
+	})
+	return result, ctx.Err()
+}
+
+// uploadSessionProgress 查询上传会话已接收的字节数，会话不存在（已完成或已失效）时 found 为 false。
+// 上传地址自带鉴权信息，请求时不能附加 Authorization 头。
+func (p *OneDriveProvider) uploadSessionProgress(ctx context.Context, uploadURL string) (uploaded int64, found bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uploadURL, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, false, fmt.Errorf("状态码: %d", resp.StatusCode)
+	}
+	var status graphUploadSessionStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, false, fmt.Errorf("解析上传会话进度失败: %w", err)
+	}
+	return uploadedFromExpectedRanges(status.NextExpectedRanges), true, nil
+}
+
+// uploadedFromExpectedRanges 按首个待上传区间的起点估算已上传的字节数（分片按顺序上传）
+func uploadedFromExpectedRanges(ranges []string) int64 {
+	if len(ranges) == 0 {
+		return 0
+	}
+	start, _, _ := strings.Cut(ranges[0], "-")
+	n, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// cancelUploadSession 取消上传会话，OneDrive 会删除已上传的分片
+func (p *OneDriveProvider) cancelUploadSession(ctx context.Context, uploadURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uploadURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("状态码: %d", resp.StatusCode)
+	}
+	return nil
+}
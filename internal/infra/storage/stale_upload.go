@@ -0,0 +1,40 @@
+/*
+ * @Description: 远程未完成上传的清理接口：中止被遗弃的分片上传或上传会话，释放其占用的存储空间
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 01:00:00
+ * @LastEditTime: 2026-10-17 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// StaleUploadResult 汇总单个存储策略中被中止的未完成上传
+type StaleUploadResult struct {
+	Aborted        int   // 中止的上传数
+	ReclaimedBytes int64 // 已上传分片的总大小（字节），无法获取时不计入
+}
+
+// IStaleUploadCleaner 由能够列出并中止未完成分片上传（或上传会话）的存储驱动实现。
+// 不支持的驱动无需实现，清理服务会直接跳过。
+type IStaleUploadCleaner interface {
+	// AbortStaleUploads 中止策略下发起时间早于 before 的未完成上传。
+	// 单个上传中止失败不会中断清理，只有列出失败时返回错误（同时返回已完成部分的统计）。
+	AbortStaleUploads(ctx context.Context, policy *model.StoragePolicy, before time.Time) (*StaleUploadResult, error)
+}
+
+// staleUploadPrefix 返回列出未完成上传时使用的对象键前缀，限定在策略的基础路径内，
+// 避免中止同一存储桶中其他应用的上传
+func staleUploadPrefix(policy *model.StoragePolicy) string {
+	basePath := strings.Trim(policy.BasePath, "/")
+	if basePath == "" {
+		return ""
+	}
+	return basePath + "/"
+}
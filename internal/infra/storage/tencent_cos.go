@@ -929,3 +929,57 @@ func (p *TencentCOSProvider) CreatePresignedUploadURL(ctx context.Context, polic
 		ContentType:        "", // 腾讯云COS不需要指定Content-Type
 	}, nil
 }
+
+// AbortStaleUploads 中止策略基础路径下发起时间早于 before 的未完成分块上传
+func (p *TencentCOSProvider) AbortStaleUploads(ctx context.Context, policy *model.StoragePolicy, before time.Time) (*StaleUploadResult, error) {
+	client, err := p.getCOSClient(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StaleUploadResult{}
+	opt := &cos.ListMultipartUploadsOptions{Prefix: staleUploadPrefix(policy)}
+	for {
+		output, _, err := client.Bucket.ListMultipartUploads(ctx, opt)
+		if err != nil {
+			return result, fmt.Errorf("列出腾讯云COS未完成的分块上传失败: %w", err)
+		}
+		for _, upload := range output.Uploads {
+			initiated, err := time.Parse(time.RFC3339, upload.Initiated)
+			if err != nil || !initiated.Before(before) {
+				continue
+			}
+			size := p.uploadedPartsSize(ctx, client, upload.Key, upload.UploadID)
+			if _, err := client.Object.AbortMultipartUpload(ctx, upload.Key, upload.UploadID); err != nil {
+				log.Printf("[腾讯云COS] 中止分块上传失败 - key: %s, uploadId: %s, 错误: %v", upload.Key, upload.UploadID, err)
+				continue
+			}
+			result.Aborted++
+			result.ReclaimedBytes += size
+		}
+		if !output.IsTruncated {
+			return result, nil
+		}
+		opt.KeyMarker = output.NextKeyMarker
+		opt.UploadIDMarker = output.NextUploadIDMarker
+	}
+}
+
+// uploadedPartsSize 统计分块上传中已上传分块的总大小，查询失败时返回已统计的部分
+func (p *TencentCOSProvider) uploadedPartsSize(ctx context.Context, client *cos.Client, key, uploadID string) int64 {
+	var total int64
+	opt := &cos.ObjectListPartsOptions{}
+	for {
+		output, _, err := client.Object.ListParts(ctx, key, uploadID, opt)
+		if err != nil {
+			return total
+		}
+		for _, part := range output.Parts {
+			total += part.Size
+		}
+		if !output.IsTruncated {
+			return total
+		}
+		opt.PartNumberMarker = output.NextPartNumberMarker
+	}
+}
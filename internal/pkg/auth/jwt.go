@@ -52,6 +52,73 @@ func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey
 	return token.SignedString(secretKey)
 }
 
+// GenerateImpersonationToken 生成管理员模拟登录用的短期 Access Token。
+// 令牌以目标用户的身份和权限签发，并在 claims 中标记签发的管理员。
+func GenerateImpersonationToken(userID uint, permissions []byte, userGroupID uint, impersonatorID uint, ttl time.Duration, secretKey []byte) (string, error) {
+	if len(secretKey) == 0 {
+		return "", fmt.Errorf("JWT Secret 不能为空")
+	}
+
+	publicUserID, err := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
+	if err != nil {
+		return "", fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	publicUserGroupID, err := idgen.GeneratePublicID(userGroupID, idgen.EntityTypeUserGroup)
+	if err != nil {
+		return "", fmt.Errorf("生成用户组公共ID失败: %w", err)
+	}
+	publicImpersonatorID, err := idgen.GeneratePublicID(impersonatorID, idgen.EntityTypeUser)
+	if err != nil {
+		return "", fmt.Errorf("生成管理员公共ID失败: %w", err)
+	}
+
+	now := time.Now()
+	claims := CustomClaims{
+		UserID:         publicUserID,
+		UserGroupID:    publicUserGroupID,
+		Permissions:    permissions,
+		ImpersonatorID: publicImpersonatorID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    "anheyu-app",
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(secretKey)
+}
+
+// GenerateUploadToken 基于当前会话的 claims 签发只能用于指定策略上传的短期令牌。
+// 身份与权限沿用 base，模拟登录标记也会保留以便继续审计。
+func GenerateUploadToken(base *CustomClaims, policyFlag string, ttl time.Duration, secretKey []byte) (string, error) {
+	if len(secretKey) == 0 {
+		return "", fmt.Errorf("JWT Secret 不能为空")
+	}
+	if policyFlag == "" {
+		return "", fmt.Errorf("上传令牌必须指定存储策略")
+	}
+
+	now := time.Now()
+	claims := CustomClaims{
+		UserID:         base.UserID,
+		UserGroupID:    base.UserGroupID,
+		Permissions:    base.Permissions,
+		ImpersonatorID: base.ImpersonatorID,
+		UploadScope:    policyFlag,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    "anheyu-app",
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(secretKey)
+}
+
 // GenerateRefreshToken 生成一个新的 JWT Refresh Token
 func GenerateRefreshToken(userID uint, secretKey []byte) (string, error) {
 	if len(secretKey) == 0 {
@@ -79,6 +146,30 @@ func GenerateRefreshToken(userID uint, secretKey []byte) (string, error) {
 	return token.SignedString(secretKey)
 }
 
+// NewAccessTokenClaims 为个人访问令牌构造与会话令牌一致的用户信息，供认证中间件写入上下文，不会签发 JWT
+func NewAccessTokenClaims(userID uint, permissions []byte, userGroupID uint, scopes []string) (*CustomClaims, error) {
+	publicUserID, err := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	publicUserGroupID, err := idgen.GeneratePublicID(userGroupID, idgen.EntityTypeUserGroup)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户组公共ID失败: %w", err)
+	}
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &CustomClaims{
+		UserID:            publicUserID,
+		UserGroupID:       publicUserGroupID,
+		Permissions:       permissions,
+		AccessTokenScopes: scopes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer: "anheyu-app",
+		},
+	}, nil
+}
+
 // ParseToken 解析 JWT Token
 func ParseToken(tokenStr string, secretKey []byte) (*CustomClaims, error) {
 	if len(secretKey) == 0 {
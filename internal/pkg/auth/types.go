@@ -12,11 +12,35 @@ import "github.com/golang-jwt/jwt/v5"
 // ClaimsKey 是用于在 gin.Context 中存储和检索整个用户信息结构体的键。
 const ClaimsKey = "user_claims"
 
+// IsAdminKey 由 RequirePermission 写入，表示当前用户是否拥有管理员权限，供接口在细分权限之外再做判断。
+const IsAdminKey = "is_admin"
+
 // CustomClaims 定义了 JWT 的自定义 Claims 结构体
 // UserID 和 UserGroupID 现在存储的是其公共 ID 字符串表示。
 type CustomClaims struct {
 	UserID      string `json:"user_id"`       // 用户公共ID
 	UserGroupID string `json:"user_group_id"` // 用户组公共ID
 	Permissions []byte `json:"permissions"`   // 用户的权限信息
+	// ImpersonatorID 模拟登录时签发令牌的管理员公共ID，普通登录令牌为空
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
+	// UploadScope 上传令牌限定的存储策略标识，非空时令牌只能用于对应的上传接口
+	UploadScope string `json:"upload_scope,omitempty"`
+	// AccessTokenScopes 使用个人访问令牌认证时令牌的权限范围，不会写入 JWT
+	AccessTokenScopes []string `json:"-"`
 	jwt.RegisteredClaims
 }
+
+// IsImpersonation 判断令牌是否为管理员模拟登录签发
+func (c *CustomClaims) IsImpersonation() bool {
+	return c.ImpersonatorID != ""
+}
+
+// IsUploadToken 判断令牌是否为编辑器会话签发的受限上传令牌
+func (c *CustomClaims) IsUploadToken() bool {
+	return c.UploadScope != ""
+}
+
+// IsAccessToken 判断当前请求是否使用个人访问令牌认证
+func (c *CustomClaims) IsAccessToken() bool {
+	return c.AccessTokenScopes != nil
+}
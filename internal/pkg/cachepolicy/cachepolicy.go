@@ -0,0 +1,129 @@
+/*
+ * @Description: 前台页面与公开接口的缓存策略，按页面类型读取后台配置的缓存时间
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 22:00:00
+ * @LastEditTime: 2026-10-17 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package cachepolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/gin-gonic/gin"
+)
+
+// Class 页面类型，服务端渲染的页面与对应的公开接口使用同一类型的缓存时间
+type Class string
+
+const (
+	ClassArticle Class = "article"
+	ClassHome    Class = "home"
+	ClassStatic  Class = "static"
+	ClassDefault Class = "default"
+)
+
+// maxTTL 单项缓存时间的上限（一年）
+const maxTTL = 365 * 24 * 3600
+
+// TTL 一类页面的缓存时间，单位秒
+type TTL struct {
+	// MaxAge 浏览器缓存时间，0 表示不缓存
+	MaxAge int `json:"max_age"`
+	// SMaxAge CDN 等共享缓存的缓存时间
+	SMaxAge int `json:"s_maxage"`
+	// StaleWhileRevalidate 过期后在后台重新验证期间仍可使用旧内容的时间
+	StaleWhileRevalidate int `json:"stale_while_revalidate"`
+}
+
+// settingKeys 各类页面对应的配置项
+var settingKeys = map[Class]constant.SettingKey{
+	ClassArticle: constant.KeyCacheTTLArticle,
+	ClassHome:    constant.KeyCacheTTLHome,
+	ClassStatic:  constant.KeyCacheTTLStatic,
+	ClassDefault: constant.KeyCacheTTLDefault,
+}
+
+// defaults 配置缺失或无效时使用的缓存时间，与配置项默认值一致
+var defaults = map[Class]TTL{
+	ClassArticle: {MaxAge: 180, SMaxAge: 60, StaleWhileRevalidate: 60},
+	ClassHome:    {MaxAge: 300, SMaxAge: 120, StaleWhileRevalidate: 30},
+	ClassStatic:  {MaxAge: 1800, SMaxAge: 600, StaleWhileRevalidate: 120},
+	ClassDefault: {MaxAge: 180, SMaxAge: 60, StaleWhileRevalidate: 30},
+}
+
+// Getter 读取配置项，setting.SettingService 实现了该接口
+type Getter interface {
+	Get(key string) string
+}
+
+// Parse 解析并校验缓存时间配置
+func Parse(raw string) (TTL, error) {
+	var ttl TTL
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&ttl); err != nil {
+		return TTL{}, fmt.Errorf("缓存时间配置不是有效的 JSON: %w", err)
+	}
+	fields := []struct {
+		name  string
+		value int
+	}{
+		{"max_age", ttl.MaxAge},
+		{"s_maxage", ttl.SMaxAge},
+		{"stale_while_revalidate", ttl.StaleWhileRevalidate},
+	}
+	for _, f := range fields {
+		if f.value < 0 || f.value > maxTTL {
+			return TTL{}, fmt.Errorf("%s 必须在 0 到 %d 秒之间", f.name, maxTTL)
+		}
+	}
+	return ttl, nil
+}
+
+// Resolve 返回一类页面当前的缓存时间，配置无效时使用默认值
+func Resolve(settings Getter, class Class) TTL {
+	key, ok := settingKeys[class]
+	if !ok {
+		class, key = ClassDefault, settingKeys[ClassDefault]
+	}
+	if ttl, err := Parse(settings.Get(key.String())); err == nil {
+		return ttl
+	}
+	return defaults[class]
+}
+
+// Header 返回 Cache-Control 响应头的值
+func (t TTL) Header() string {
+	if t.MaxAge == 0 {
+		return "no-cache"
+	}
+	return fmt.Sprintf("public, max-age=%d, s-maxage=%d, must-revalidate, stale-while-revalidate=%d",
+		t.MaxAge, t.SMaxAge, t.StaleWhileRevalidate)
+}
+
+// Apply 按页面类型设置 Cache-Control 与 Vary 响应头，
+// 并移除全局反缓存中间件写入的 Pragma/Expires，避免与 Cache-Control 冲突
+func Apply(c *gin.Context, settings Getter, class Class) {
+	c.Writer.Header().Del("Pragma")
+	c.Writer.Header().Del("Expires")
+	c.Header("Cache-Control", Resolve(settings, class).Header())
+	c.Header("Vary", "Accept-Encoding")
+}
+
+// ValidateSettings 校验待更新配置中的缓存时间配置项
+func ValidateSettings(values map[string]string) error {
+	for _, key := range settingKeys {
+		raw, ok := values[key.String()]
+		if !ok {
+			continue
+		}
+		if _, err := Parse(raw); err != nil {
+			return fmt.Errorf("配置项 %s 无效: %w", key, err)
+		}
+	}
+	return nil
+}
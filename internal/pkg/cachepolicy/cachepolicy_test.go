@@ -0,0 +1,103 @@
+package cachepolicy
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+	"github.com/gin-gonic/gin"
+)
+
+type mapGetter map[string]string
+
+func (m mapGetter) Get(key string) string { return m[key] }
+
+func TestDefaultsMatchDefinitions(t *testing.T) {
+	for class, key := range settingKeys {
+		found := false
+		for _, def := range configdef.AllSettings {
+			if def.Key != key {
+				continue
+			}
+			found = true
+			ttl, err := Parse(def.Value)
+			if err != nil {
+				t.Fatalf("%s 默认值无效: %v", key, err)
+			}
+			if ttl != defaults[class] {
+				t.Errorf("%s 默认值 %+v 与代码中的 %+v 不一致", key, ttl, defaults[class])
+			}
+		}
+		if !found {
+			t.Errorf("缺少配置项定义: %s", key)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		raw     string
+		wantErr bool
+	}{
+		{`{"max_age":60,"s_maxage":30,"stale_while_revalidate":10}`, false},
+		{`{"max_age":0}`, false},
+		{`{"max_age":-1}`, true},
+		{`{"max_age":31536001}`, true},
+		{`{"max_age":60,"ttl":1}`, true},
+		{`not json`, true},
+	}
+	for _, tc := range cases {
+		if _, err := Parse(tc.raw); (err != nil) != tc.wantErr {
+			t.Errorf("Parse(%q) err = %v, wantErr %v", tc.raw, err, tc.wantErr)
+		}
+	}
+}
+
+func TestResolveFallsBackToDefaults(t *testing.T) {
+	settings := mapGetter{
+		"cache_ttl.article": `{"max_age":10,"s_maxage":5,"stale_while_revalidate":1}`,
+		"cache_ttl.home":    `{"max_age":-5}`,
+	}
+	if got := Resolve(settings, ClassArticle); got != (TTL{MaxAge: 10, SMaxAge: 5, StaleWhileRevalidate: 1}) {
+		t.Errorf("article = %+v", got)
+	}
+	if got := Resolve(settings, ClassHome); got != defaults[ClassHome] {
+		t.Errorf("无效配置应回退到默认值，得到 %+v", got)
+	}
+	if got := Resolve(settings, Class("unknown")); got != defaults[ClassDefault] {
+		t.Errorf("未知类型应使用 default，得到 %+v", got)
+	}
+}
+
+func TestApply(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Header("Pragma", "no-cache")
+	c.Header("Expires", "0")
+
+	Apply(c, mapGetter{"cache_ttl.static": `{"max_age":600,"s_maxage":300,"stale_while_revalidate":60}`}, ClassStatic)
+
+	if got := w.Header().Get("Cache-Control"); got != "public, max-age=600, s-maxage=300, must-revalidate, stale-while-revalidate=60" {
+		t.Errorf("Cache-Control = %q", got)
+	}
+	if w.Header().Get("Pragma") != "" || w.Header().Get("Expires") != "" {
+		t.Error("应移除反缓存中间件写入的 Pragma/Expires")
+	}
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	Apply(c, mapGetter{"cache_ttl.default": `{"max_age":0}`}, ClassDefault)
+	if got := w.Header().Get("Cache-Control"); got != "no-cache" {
+		t.Errorf("max_age 为 0 时应为 no-cache，得到 %q", got)
+	}
+}
+
+func TestValidateSettings(t *testing.T) {
+	if err := ValidateSettings(map[string]string{"site_name": "x", "cache_ttl.home": `{"max_age":60}`}); err != nil {
+		t.Errorf("有效配置不应报错: %v", err)
+	}
+	if err := ValidateSettings(map[string]string{"cache_ttl.home": `{"max_age":"60"}`}); err == nil {
+		t.Error("字段类型错误应报错")
+	}
+}
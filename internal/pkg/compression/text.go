@@ -0,0 +1,96 @@
+/*
+ * @Description: 文本压缩 - 使用 zstd 压缩较长的正文后以 base64 存入文本列，读取时按前缀识别并解压
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 07:00:00
+ * @LastEditTime: 2026-10-17 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package compression
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Prefix 压缩后内容的前缀。压缩结果以 base64 编码存储，兼容 PostgreSQL 等不允许任意字节的文本列
+const Prefix = "zstd:b64:"
+
+// MinSize 小于该字节数的内容不压缩，压缩收益无法抵消编码开销
+const MinSize = 1024
+
+var (
+	enabled atomic.Bool
+
+	encoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	decoder, _ = zstd.NewReader(nil)
+)
+
+func init() {
+	enabled.Store(true)
+}
+
+// SetEnabled 设置写入时是否压缩。关闭后新写入的内容保持原文，已压缩的内容读取时依然正常解压
+func SetEnabled(on bool) {
+	enabled.Store(on)
+}
+
+// Enabled 返回写入时是否压缩
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// IsCompressed 判断内容是否为 CompressText 的压缩结果
+func IsCompressed(s string) bool {
+	return strings.HasPrefix(s, Prefix)
+}
+
+// CompressText 压缩文本。内容过短、已压缩或压缩后没有变小时原样返回
+func CompressText(s string) string {
+	if len(s) < MinSize || IsCompressed(s) {
+		return s
+	}
+	compressed := Prefix + base64.StdEncoding.EncodeToString(encoder.EncodeAll([]byte(s), nil))
+	if len(compressed) >= len(s) {
+		return s
+	}
+	return compressed
+}
+
+// MaybeCompressText 在开启压缩时压缩文本，供仓库层写入时调用
+func MaybeCompressText(s string) string {
+	if !Enabled() {
+		return s
+	}
+	return CompressText(s)
+}
+
+// DecompressText 解压 CompressText 的结果，未压缩的内容原样返回
+func DecompressText(s string) (string, error) {
+	if !IsCompressed(s) {
+		return s, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(s[len(Prefix):])
+	if err != nil {
+		return "", fmt.Errorf("解码压缩内容失败: %w", err)
+	}
+	out, err := decoder.DecodeAll(raw, nil)
+	if err != nil {
+		return "", fmt.Errorf("解压内容失败: %w", err)
+	}
+	return string(out), nil
+}
+
+// DecompressTextOrRaw 与 DecompressText 相同，解压失败时记录日志并原样返回，避免单条损坏的数据导致整个查询失败
+func DecompressTextOrRaw(s string) string {
+	out, err := DecompressText(s)
+	if err != nil {
+		log.Printf("[压缩] %v", err)
+		return s
+	}
+	return out
+}
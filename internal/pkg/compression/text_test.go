@@ -0,0 +1,45 @@
+package compression
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompressTextRoundTrip(t *testing.T) {
+	t.Cleanup(func() { SetEnabled(true) })
+	long := strings.Repeat("## 标题\n\n这是一段用于测试压缩的正文内容。\n", 100)
+
+	compressed := MaybeCompressText(long)
+	if !IsCompressed(compressed) || len(compressed) >= len(long) {
+		t.Fatalf("较长的正文应被压缩: %d -> %d", len(long), len(compressed))
+	}
+	if CompressText(compressed) != compressed {
+		t.Error("已压缩的内容不应重复压缩")
+	}
+	got, err := DecompressText(compressed)
+	if err != nil || got != long {
+		t.Fatalf("解压结果不一致: %v", err)
+	}
+
+	if short := "短文本"; CompressText(short) != short {
+		t.Error("短文本应原样返回")
+	}
+	if got, _ := DecompressText("未压缩的内容"); got != "未压缩的内容" {
+		t.Error("未压缩的内容应原样返回")
+	}
+
+	SetEnabled(false)
+	if MaybeCompressText(long) != long {
+		t.Error("关闭压缩后应原样写入")
+	}
+}
+
+func TestDecompressCorruptText(t *testing.T) {
+	corrupt := Prefix + "not-base64!"
+	if _, err := DecompressText(corrupt); err == nil {
+		t.Fatal("损坏的内容应返回错误")
+	}
+	if got := DecompressTextOrRaw(corrupt); got != corrupt {
+		t.Errorf("DecompressTextOrRaw 应原样返回损坏的内容: %q", got)
+	}
+}
@@ -29,6 +29,9 @@ const (
 	ArticleDeleted   Topic = "article:deleted"
 	ArticlePublished Topic = "article:published"
 
+	// 评论事件（用于站点统计）：已发布评论的数量可能发生变化
+	CommentCountChanged Topic = "comment:count-changed"
+
 	// 配置事件
 	SiteConfigUpdated Topic = "site-config:updated"
 
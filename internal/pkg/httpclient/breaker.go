@@ -0,0 +1,231 @@
+/*
+ * @Description: 外部调用熔断器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 10:00:00
+ * @LastEditTime: 2026-10-17 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package httpclient
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen 在熔断器处于打开状态时返回，调用方应直接走降级逻辑。
+var ErrCircuitOpen = errors.New("外部服务熔断中，请稍后再试")
+
+// BreakerState 熔断器状态
+type BreakerState string
+
+const (
+	StateClosed   BreakerState = "closed"    // 正常放行
+	StateOpen     BreakerState = "open"      // 熔断，直接拒绝
+	StateHalfOpen BreakerState = "half_open" // 冷却结束，放行一次探测请求
+)
+
+// Breaker 是一个基于连续失败次数的简单熔断器。
+// 连续失败达到阈值后打开；经过 openTimeout 后进入半开状态，
+// 半开状态只放行一个探测请求，成功则关闭，失败则重新打开。
+type Breaker struct {
+	mu               sync.Mutex
+	name             string
+	host             string
+	failureThreshold int
+	openTimeout      time.Duration
+
+	state            BreakerState
+	consecutiveFails int
+	openedAt         time.Time
+	probing          bool
+
+	totalRequests int64
+	totalFailures int64
+	lastError     string
+	lastFailureAt time.Time
+}
+
+func newBreaker(name, host string, failureThreshold int, openTimeout time.Duration) *Breaker {
+	return &Breaker{
+		name:             name,
+		host:             host,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		state:            StateClosed,
+	}
+}
+
+// Allow 判断当前是否允许发起请求
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return ErrCircuitOpen
+		}
+		b.state = StateHalfOpen
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record 记录一次请求结果
+func (b *Breaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.totalRequests++
+	if err == nil {
+		b.consecutiveFails = 0
+		b.state = StateClosed
+		b.probing = false
+		return
+	}
+
+	b.totalFailures++
+	b.consecutiveFails++
+	b.lastError = err.Error()
+	b.lastFailureAt = time.Now()
+
+	if b.state == StateHalfOpen || (b.failureThreshold > 0 && b.consecutiveFails >= b.failureThreshold) {
+		b.state = StateOpen
+		b.openedAt = time.Now()
+		b.probing = false
+	}
+}
+
+// release 在请求被调用方取消时释放半开探测名额，不计入成功或失败
+func (b *Breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// BreakerStatus 熔断器状态快照，供诊断接口展示
+type BreakerStatus struct {
+	Name             string       `json:"name"`
+	Host             string       `json:"host"`
+	State            BreakerState `json:"state"`
+	ConsecutiveFails int          `json:"consecutive_fails"`
+	TotalRequests    int64        `json:"total_requests"`
+	TotalFailures    int64        `json:"total_failures"`
+	LastError        string       `json:"last_error,omitempty"`
+	LastFailureAt    *time.Time   `json:"last_failure_at,omitempty"`
+	OpenedAt         *time.Time   `json:"opened_at,omitempty"`
+}
+
+func (b *Breaker) status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state := b.state
+	if state == StateOpen && time.Since(b.openedAt) >= b.openTimeout {
+		state = StateHalfOpen
+	}
+	s := BreakerStatus{
+		Name:             b.name,
+		Host:             b.host,
+		State:            state,
+		ConsecutiveFails: b.consecutiveFails,
+		TotalRequests:    b.totalRequests,
+		TotalFailures:    b.totalFailures,
+		LastError:        b.lastError,
+	}
+	if !b.lastFailureAt.IsZero() {
+		t := b.lastFailureAt
+		s.LastFailureAt = &t
+	}
+	if b.state == StateOpen {
+		t := b.openedAt
+		s.OpenedAt = &t
+	}
+	return s
+}
+
+const (
+	// maxBreakers 熔断器数量上限。图片主色、友链检测等客户端访问用户提供的主机，
+	// 按主机创建的熔断器需要淘汰，避免在进程生命周期内无限增长
+	maxBreakers = 1024
+	// breakerIdleTTL 超过该时长未被使用的熔断器在容量满时优先淘汰
+	breakerIdleTTL = time.Hour
+)
+
+type breakerEntry struct {
+	breaker  *Breaker
+	lastUsed time.Time
+}
+
+// registry 以 "客户端名称 + 目标主机" 为键保存所有熔断器
+var registry = struct {
+	sync.Mutex
+	breakers map[string]*breakerEntry
+}{breakers: make(map[string]*breakerEntry)}
+
+func getBreaker(name, host string, policy Policy) *Breaker {
+	key := name + "|" + host
+	now := time.Now()
+	registry.Lock()
+	defer registry.Unlock()
+	if e, ok := registry.breakers[key]; ok {
+		e.lastUsed = now
+		return e.breaker
+	}
+	if len(registry.breakers) >= maxBreakers {
+		evictBreakersLocked(now)
+	}
+	b := newBreaker(name, host, policy.FailureThreshold, policy.OpenTimeout)
+	registry.breakers[key] = &breakerEntry{breaker: b, lastUsed: now}
+	return b
+}
+
+// evictBreakersLocked 淘汰空闲超过 breakerIdleTTL 的熔断器，仍然满时淘汰最久未使用的一个，调用方需持有 registry 锁
+func evictBreakersLocked(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, e := range registry.breakers {
+		if now.Sub(e.lastUsed) > breakerIdleTTL {
+			delete(registry.breakers, key)
+			continue
+		}
+		if oldestKey == "" || e.lastUsed.Before(oldest) {
+			oldestKey, oldest = key, e.lastUsed
+		}
+	}
+	if len(registry.breakers) >= maxBreakers && oldestKey != "" {
+		delete(registry.breakers, oldestKey)
+	}
+}
+
+// Snapshot 返回所有熔断器的当前状态，按名称和主机排序
+func Snapshot() []BreakerStatus {
+	registry.Lock()
+	list := make([]*Breaker, 0, len(registry.breakers))
+	for _, e := range registry.breakers {
+		list = append(list, e.breaker)
+	}
+	registry.Unlock()
+
+	result := make([]BreakerStatus, 0, len(list))
+	for _, b := range list {
+		result = append(result, b.status())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Host < result[j].Host
+	})
+	return result
+}
@@ -0,0 +1,227 @@
+/*
+ * @Description: 带超时、重试与熔断的共享 HTTP 客户端
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 10:00:00
+ * @LastEditTime: 2026-10-15 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Policy 描述某一类外部调用（如音乐 API、IP 属地查询）的容错策略。
+// 同一个 Policy 下不同目标主机拥有各自独立的熔断器。
+type Policy struct {
+	// Timeout 单次尝试的超时时间（包含读取响应体）
+	Timeout time.Duration
+	// MaxRetries 最大重试次数，仅对幂等请求（GET/HEAD/OPTIONS）生效
+	MaxRetries int
+	// RetryBaseDelay 重试退避的基础时长，实际等待时间按指数增长并加入随机抖动
+	RetryBaseDelay time.Duration
+	// MaxBackoff 单次退避的上限
+	MaxBackoff time.Duration
+	// FailureThreshold 连续失败多少次后熔断，<=0 表示不熔断
+	FailureThreshold int
+	// OpenTimeout 熔断后的冷却时间，之后进入半开状态放行探测请求
+	OpenTimeout time.Duration
+}
+
+// DefaultPolicy 返回一个适用于大多数外部调用的默认策略
+func DefaultPolicy() Policy {
+	return Policy{
+		Timeout:          10 * time.Second,
+		MaxRetries:       2,
+		RetryBaseDelay:   200 * time.Millisecond,
+		MaxBackoff:       2 * time.Second,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+}
+
+func (p Policy) withDefaults() Policy {
+	def := DefaultPolicy()
+	if p.Timeout <= 0 {
+		p.Timeout = def.Timeout
+	}
+	if p.MaxRetries < 0 {
+		p.MaxRetries = 0
+	}
+	if p.RetryBaseDelay <= 0 {
+		p.RetryBaseDelay = def.RetryBaseDelay
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = def.MaxBackoff
+	}
+	if p.OpenTimeout <= 0 {
+		p.OpenTimeout = def.OpenTimeout
+	}
+	return p
+}
+
+// Option 用于定制客户端
+type Option func(*transport)
+
+// WithBaseTransport 指定底层 RoundTripper（例如需要自定义 TLS 配置时）
+func WithBaseTransport(rt http.RoundTripper) Option {
+	return func(t *transport) {
+		if rt != nil {
+			t.base = rt
+		}
+	}
+}
+
+// New 创建一个带容错能力的 *http.Client。
+// name 用于区分调用方，会出现在诊断接口的熔断器列表中。
+func New(name string, policy Policy, opts ...Option) *http.Client {
+	policy = policy.withDefaults()
+	t := &transport{
+		name:   name,
+		policy: policy,
+		base:   http.DefaultTransport,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	// 整体超时 = 所有尝试的超时之和 + 所有退避的上限，仅作为兜底
+	overall := policy.Timeout*time.Duration(policy.MaxRetries+1) + policy.MaxBackoff*time.Duration(policy.MaxRetries)
+	return &http.Client{
+		Timeout:   overall,
+		Transport: t,
+	}
+}
+
+// transport 实现了 http.RoundTripper，为每次请求附加超时、重试和熔断
+type transport struct {
+	name   string
+	policy Policy
+	base   http.RoundTripper
+}
+
+// RoundTrip 实现 http.RoundTripper 接口
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	breaker := getBreaker(t.name, req.URL.Host, t.policy)
+	if err := breaker.Allow(); err != nil {
+		return nil, fmt.Errorf("%s(%s): %w", t.name, req.URL.Host, err)
+	}
+
+	attempts := 1
+	if isRetryable(req) {
+		attempts += t.policy.MaxRetries
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithContext(req.Context(), t.backoff(attempt)); err != nil {
+				breaker.release()
+				return nil, err
+			}
+		}
+
+		resp, err := t.roundTripOnce(req)
+		if err != nil {
+			if req.Context().Err() != nil {
+				// 调用方主动取消，不计入熔断统计
+				breaker.release()
+				return nil, err
+			}
+			lastErr = err
+			continue
+		}
+
+		if !isFailureStatus(resp.StatusCode) {
+			breaker.Record(nil)
+			return resp, nil
+		}
+
+		lastErr = fmt.Errorf("%s 返回状态码 %d", req.URL.Host, resp.StatusCode)
+		if attempt == attempts-1 {
+			// 最后一次尝试仍然失败时把响应交给调用方，由其决定如何处理错误状态码
+			breaker.Record(lastErr)
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		resp.Body.Close()
+	}
+
+	breaker.Record(lastErr)
+	return nil, lastErr
+}
+
+// roundTripOnce 以单次尝试的超时执行一次请求，超时上下文在响应体关闭时释放
+func (t *transport) roundTripOnce(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.policy.Timeout)
+	attemptReq := req.Clone(ctx)
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attemptReq.Body = body
+	}
+
+	resp, err := t.base.RoundTrip(attemptReq)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff 计算第 attempt 次重试前的等待时长（指数退避 + 抖动）
+func (t *transport) backoff(attempt int) time.Duration {
+	d := t.policy.RetryBaseDelay << (attempt - 1)
+	if d <= 0 || d > t.policy.MaxBackoff {
+		d = t.policy.MaxBackoff
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isRetryable 仅幂等且请求体可重放的请求才允许重试
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// isFailureStatus 判断状态码是否视为目标服务故障（计入熔断并触发重试）
+func isFailureStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnClose 在响应体关闭时释放单次尝试的超时上下文
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,149 @@
+/*
+ * @Description: 容错 HTTP 客户端行为测试
+ * @Author: 安知鱼
+ */
+package httpclient
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testPolicy() Policy {
+	return Policy{
+		Timeout:          time.Second,
+		MaxRetries:       2,
+		RetryBaseDelay:   time.Millisecond,
+		MaxBackoff:       2 * time.Millisecond,
+		FailureThreshold: 3,
+		OpenTimeout:      50 * time.Millisecond,
+	}
+}
+
+func TestClient_RetriesIdempotentRequestOnServerError(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := New(t.Name(), testPolicy())
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("期望最终成功返回 200，实际 %d", resp.StatusCode)
+	}
+	if hits != 3 {
+		t.Errorf("期望共请求 3 次（2 次重试），实际 %d", hits)
+	}
+}
+
+func TestClient_DoesNotRetryPost(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := New(t.Name(), testPolicy())
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	resp.Body.Close()
+	if hits != 1 {
+		t.Errorf("非幂等请求不应重试，实际请求 %d 次", hits)
+	}
+}
+
+func TestClient_OpensCircuitAndRecovers(t *testing.T) {
+	var healthy atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	policy := testPolicy()
+	policy.MaxRetries = 0
+	client := New(t.Name(), policy)
+
+	for i := 0; i < policy.FailureThreshold; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("第 %d 次请求不应被熔断: %v", i+1, err)
+		}
+		resp.Body.Close()
+	}
+
+	if _, err := client.Get(srv.URL); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("连续失败达到阈值后应熔断，实际 err=%v", err)
+	}
+
+	var found bool
+	for _, s := range Snapshot() {
+		if s.Name == t.Name() {
+			found = true
+			if s.State != StateOpen {
+				t.Errorf("诊断快照中熔断器应为 open，实际 %s", s.State)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("诊断快照中未找到熔断器 %s", t.Name())
+	}
+
+	healthy.Store(true)
+	time.Sleep(policy.OpenTimeout + 10*time.Millisecond)
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("冷却后探测请求应被放行: %v", err)
+	}
+	resp.Body.Close()
+
+	for _, s := range Snapshot() {
+		if s.Name == t.Name() && s.State != StateClosed {
+			t.Errorf("探测成功后熔断器应关闭，实际 %s", s.State)
+		}
+	}
+}
+
+func TestGetBreaker_EvictsWhenFull(t *testing.T) {
+	policy := testPolicy()
+	first := getBreaker("evict-test", "first.example", policy)
+	for i := 0; i < maxBreakers; i++ {
+		getBreaker("evict-test", "host-"+strconv.Itoa(i)+".example", policy)
+	}
+
+	registry.Lock()
+	size := len(registry.breakers)
+	_, kept := registry.breakers["evict-test|first.example"]
+	registry.Unlock()
+	if size > maxBreakers {
+		t.Fatalf("熔断器数量应不超过 %d, 实际为 %d", maxBreakers, size)
+	}
+	if kept {
+		t.Fatal("容量满时应淘汰最久未使用的熔断器")
+	}
+	if getBreaker("evict-test", "first.example", policy) == first {
+		t.Fatal("被淘汰的熔断器应重新创建")
+	}
+}
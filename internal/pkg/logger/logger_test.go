@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestJSONLoggerAddsRequestID(t *testing.T) {
+	defer SetLevel("info")
+	var buf bytes.Buffer
+	l := New(&buf, FormatJSON).With("system", "test")
+
+	l.InfoContext(WithRequestID(context.Background(), "req-1"), "hello", slog.Int("count", 2))
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("输出不是 JSON: %q", buf.String())
+	}
+	if entry[RequestIDKey] != "req-1" || entry["system"] != "test" || entry["msg"] != "hello" || entry["count"] != float64(2) {
+		t.Errorf("日志字段不正确: %v", entry)
+	}
+
+	buf.Reset()
+	l.Info("no request")
+	if strings.Contains(buf.String(), RequestIDKey) {
+		t.Errorf("没有请求 ID 时不应输出该字段: %q", buf.String())
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	defer SetLevel("info")
+	var buf bytes.Buffer
+	l := New(&buf, FormatText)
+
+	l.Debug("hidden")
+	if buf.Len() != 0 {
+		t.Errorf("默认级别不应输出 DEBUG 日志: %q", buf.String())
+	}
+	if !SetLevel("DEBUG") || Level() != slog.LevelDebug {
+		t.Fatal("应能设置为 DEBUG 级别")
+	}
+	l.Debug("shown")
+	if !strings.Contains(buf.String(), "shown") {
+		t.Errorf("调整级别后应立即输出 DEBUG 日志: %q", buf.String())
+	}
+	if SetLevel("verbose") || Level() != slog.LevelDebug {
+		t.Error("无法识别的级别应被忽略")
+	}
+}
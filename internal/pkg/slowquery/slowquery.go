@@ -0,0 +1,216 @@
+/*
+ * @Description: 慢查询记录 - 在 Ent 驱动层统计执行时间超过阈值的 SQL，按语句聚合供后台分析索引
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 05:00:00
+ * @LastEditTime: 2026-10-17 05:00:00
+ * @LastEditors: 安知鱼
+ */
+package slowquery
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// maxStatements 最多聚合的不同语句数，超出后淘汰累计耗时最少的语句，避免内存无限增长
+const maxStatements = 200
+
+// QueryStat 单条 SQL 语句的慢查询统计。Ent 生成的语句使用占位符，相同语句的不同参数会合并统计
+type QueryStat struct {
+	Query string `json:"query"`
+	// Tables 语句涉及的表，用于判断需要为哪些表补充索引
+	Tables   []string  `json:"tables"`
+	Count    int64     `json:"count"`
+	TotalMs  int64     `json:"total_ms"`
+	AvgMs    int64     `json:"avg_ms"`
+	MaxMs    int64     `json:"max_ms"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+var (
+	threshold atomic.Int64 // 纳秒，0 表示关闭
+
+	mu    sync.Mutex
+	stats = make(map[string]*QueryStat)
+)
+
+// SetThreshold 设置慢查询阈值，d <= 0 时关闭记录
+func SetThreshold(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	threshold.Store(int64(d))
+}
+
+// Threshold 返回当前的慢查询阈值
+func Threshold() time.Duration {
+	return time.Duration(threshold.Load())
+}
+
+// ParseThresholdMs 解析以毫秒为单位的阈值配置，空值或非法值视为关闭
+func ParseThresholdMs(raw string) time.Duration {
+	ms, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// observe 在语句执行完成后调用，超过阈值时写日志并计入统计
+func observe(query string, start time.Time) {
+	limit := threshold.Load()
+	if limit <= 0 {
+		return
+	}
+	elapsed := time.Since(start)
+	if int64(elapsed) < limit {
+		return
+	}
+	log.Printf("[慢查询] 耗时 %v: %s", elapsed.Round(time.Millisecond), query)
+	record(query, elapsed, time.Now())
+}
+
+func record(query string, elapsed time.Duration, now time.Time) {
+	ms := elapsed.Milliseconds()
+
+	mu.Lock()
+	defer mu.Unlock()
+	s, ok := stats[query]
+	if !ok {
+		if len(stats) >= maxStatements {
+			evictLocked()
+		}
+		s = &QueryStat{Query: query, Tables: tablesOf(query)}
+		stats[query] = s
+	}
+	s.Count++
+	s.TotalMs += ms
+	if ms > s.MaxMs {
+		s.MaxMs = ms
+	}
+	s.LastSeen = now
+}
+
+// evictLocked 淘汰累计耗时最少的语句，调用方需持有锁
+func evictLocked() {
+	var victim string
+	var least int64 = -1
+	for q, s := range stats {
+		if least < 0 || s.TotalMs < least {
+			victim, least = q, s.TotalMs
+		}
+	}
+	delete(stats, victim)
+}
+
+var tablePattern = regexp.MustCompile("(?i)\\b(?:FROM|JOIN|UPDATE|INTO)\\s+[`\"]?([A-Za-z0-9_]+)")
+
+// tablesOf 从语句中提取 FROM/JOIN/UPDATE/INTO 之后的表名
+func tablesOf(query string) []string {
+	seen := make(map[string]bool)
+	tables := []string{}
+	for _, m := range tablePattern.FindAllStringSubmatch(query, -1) {
+		if name := m[1]; !seen[name] {
+			seen[name] = true
+			tables = append(tables, name)
+		}
+	}
+	return tables
+}
+
+// Snapshot 返回按累计耗时降序排列的最慢语句，limit <= 0 时返回全部
+func Snapshot(limit int) []QueryStat {
+	mu.Lock()
+	result := make([]QueryStat, 0, len(stats))
+	for _, s := range stats {
+		c := *s
+		c.AvgMs = c.TotalMs / c.Count
+		result = append(result, c)
+	}
+	mu.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TotalMs != result[j].TotalMs {
+			return result[i].TotalMs > result[j].TotalMs
+		}
+		return result[i].Query < result[j].Query
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// Reset 清空已聚合的统计
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	stats = make(map[string]*QueryStat)
+}
+
+// Wrap 包装 Ent 驱动，统计经由该驱动（包括事务内）执行的所有语句
+func Wrap(drv dialect.Driver) dialect.Driver {
+	return &driver{Driver: drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	defer observe(query, time.Now())
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	defer observe(query, time.Now())
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{Tx: tx}, nil
+}
+
+// BeginTx 供 ent.Client.BeginTx 使用，底层驱动不支持时返回错误
+func (d *driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{Tx: tx}, nil
+}
+
+type txDriver struct {
+	dialect.Tx
+}
+
+func (t *txDriver) Exec(ctx context.Context, query string, args, v any) error {
+	defer observe(query, time.Now())
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+func (t *txDriver) Query(ctx context.Context, query string, args, v any) error {
+	defer observe(query, time.Now())
+	return t.Tx.Query(ctx, query, args, v)
+}
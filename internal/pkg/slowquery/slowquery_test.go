@@ -0,0 +1,94 @@
+package slowquery
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+type fakeDriver struct {
+	dialect.Driver
+	delay time.Duration
+}
+
+func (f *fakeDriver) Query(ctx context.Context, query string, args, v any) error {
+	time.Sleep(f.delay)
+	return nil
+}
+
+func TestWrapRecordsSlowQueries(t *testing.T) {
+	t.Cleanup(func() {
+		SetThreshold(0)
+		Reset()
+	})
+	Reset()
+	drv := Wrap(&fakeDriver{delay: 5 * time.Millisecond})
+	ctx := context.Background()
+	query := "SELECT `articles`.`id` FROM `articles` JOIN `post_tags` ON 1 = 1 WHERE `status` = ?"
+
+	if err := drv.Query(ctx, query, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := Snapshot(0); len(got) != 0 {
+		t.Fatalf("未设置阈值时不应记录: %+v", got)
+	}
+
+	SetThreshold(time.Millisecond)
+	for i := 0; i < 2; i++ {
+		if err := drv.Query(ctx, query, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := Snapshot(0)
+	if len(got) != 1 || got[0].Count != 2 || got[0].MaxMs < 5 || got[0].AvgMs < 5 {
+		t.Fatalf("相同语句应合并统计: %+v", got)
+	}
+	if want := []string{"articles", "post_tags"}; !reflect.DeepEqual(got[0].Tables, want) {
+		t.Errorf("tables = %v, want %v", got[0].Tables, want)
+	}
+
+	SetThreshold(time.Hour)
+	if err := drv.Query(ctx, "SELECT 1", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(Snapshot(0)) != 1 {
+		t.Error("低于阈值的语句不应记录")
+	}
+}
+
+func TestSnapshotOrderAndEviction(t *testing.T) {
+	t.Cleanup(Reset)
+	Reset()
+	now := time.Now()
+	for i := 0; i < maxStatements; i++ {
+		record(fmt.Sprintf("SELECT %d", i), time.Duration(i+1)*time.Millisecond, now)
+	}
+	record("SELECT slowest", time.Second, now)
+
+	all := Snapshot(0)
+	if len(all) != maxStatements {
+		t.Fatalf("超过上限后应淘汰旧语句: %d", len(all))
+	}
+	for _, s := range all {
+		if s.Query == "SELECT 0" {
+			t.Error("应淘汰累计耗时最少的语句")
+		}
+	}
+	top := Snapshot(2)
+	if len(top) != 2 || top[0].Query != "SELECT slowest" || top[1].Query != fmt.Sprintf("SELECT %d", maxStatements-1) {
+		t.Fatalf("应按累计耗时降序返回: %+v", top)
+	}
+}
+
+func TestParseThresholdMs(t *testing.T) {
+	cases := map[string]time.Duration{"": 0, "abc": 0, "-5": 0, "0": 0, " 200 ": 200 * time.Millisecond}
+	for raw, want := range cases {
+		if got := ParseThresholdMs(raw); got != want {
+			t.Errorf("ParseThresholdMs(%q) = %v, want %v", raw, got, want)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+/*
+ * @Description: 出站请求 SSRF 防护，拦截对内网、链路本地及云厂商元数据地址的访问
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 14:00:00
+ * @LastEditTime: 2026-10-15 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrBlocked 目标地址被 SSRF 防护拦截
+var ErrBlocked = errors.New("目标地址不允许访问")
+
+var (
+	// blockedIPNets 默认禁止访问的网段
+	blockedIPNets = mustParseCIDRs(
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10", // 运营商级 NAT，阿里云元数据 100.100.100.200 位于此段
+		"127.0.0.0/8",
+		"169.254.0.0/16", // 链路本地，AWS/GCP/Azure 元数据 169.254.169.254 位于此段
+		"172.16.0.0/12",
+		"192.0.0.0/24",
+		"192.168.0.0/16",
+		"198.18.0.0/15",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	)
+
+	// metadataIPs 云厂商元数据服务地址，即使配置了白名单也始终拦截
+	metadataIPs = []net.IP{
+		net.ParseIP("169.254.169.254"),
+		net.ParseIP("169.254.170.2"),
+		net.ParseIP("100.100.100.200"),
+		net.ParseIP("fd00:ec2::254"),
+	}
+
+	// metadataHosts 元数据服务常用主机名
+	metadataHosts = map[string]struct{}{
+		"metadata":                 {},
+		"metadata.google.internal": {},
+		"metadata.tencentyun.com":  {},
+	}
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("invalid CIDR in ssrf: %s", cidr))
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+// Guard 出站请求守卫。
+// 白名单通过 allowlist 函数动态读取（通常来自站点配置），修改配置后无需重启即可生效。
+type Guard struct {
+	allowlist func() string
+	dialer    *net.Dialer
+}
+
+// NewGuard 创建 SSRF 守卫，allowlist 返回逗号分隔的白名单，可为 nil。
+// 白名单条目支持：完整域名、"*.example.com" 后缀通配、单个 IP 以及 CIDR 网段。
+func NewGuard(allowlist func() string) *Guard {
+	return &Guard{
+		allowlist: allowlist,
+		dialer:    &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second},
+	}
+}
+
+// rules 解析后的白名单
+type rules struct {
+	hosts    map[string]struct{}
+	suffixes []string
+	nets     []*net.IPNet
+}
+
+func (g *Guard) rules() rules {
+	r := rules{hosts: make(map[string]struct{})}
+	if g == nil || g.allowlist == nil {
+		return r
+	}
+	for _, item := range strings.Split(g.allowlist(), ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" {
+			continue
+		}
+		if strings.Contains(item, "/") {
+			if _, ipNet, err := net.ParseCIDR(item); err == nil {
+				r.nets = append(r.nets, ipNet)
+			}
+			continue
+		}
+		if ip := net.ParseIP(item); ip != nil {
+			bits := 32
+			if ip.To4() == nil {
+				bits = 128
+			}
+			r.nets = append(r.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if strings.HasPrefix(item, "*.") {
+			r.suffixes = append(r.suffixes, item[1:])
+			continue
+		}
+		r.hosts[item] = struct{}{}
+	}
+	return r
+}
+
+func (r rules) allowHost(host string) bool {
+	if _, ok := r.hosts[host]; ok {
+		return true
+	}
+	for _, suffix := range r.suffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r rules) allowIP(ip net.IP) bool {
+	for _, ipNet := range r.nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBlockedIP 判断 IP 是否属于默认禁止访问的范围（内网、回环、链路本地、组播等）
+func IsBlockedIP(ip net.IP) bool {
+	for _, ipNet := range blockedIPNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+}
+
+func isMetadataIP(ip net.IP) bool {
+	for _, m := range metadataIPs {
+		if m.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.Trim(host, "[]")), ".")
+}
+
+// CheckURL 在发起请求前校验 URL：仅允许 http/https，且主机名不能是元数据服务或被禁止的 IP 字面量。
+// 域名最终解析到的地址由 DialContext 在建立连接时再次校验。
+func (g *Guard) CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("无效的URL格式: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("只支持http和https协议")
+	}
+	host := normalizeHost(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("URL缺少主机名")
+	}
+	return g.checkHost(host)
+}
+
+func (g *Guard) checkHost(host string) error {
+	if _, ok := metadataHosts[host]; ok {
+		return ErrBlocked
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil
+	}
+	if isMetadataIP(ip) {
+		return ErrBlocked
+	}
+	if IsBlockedIP(ip) && !g.rules().allowIP(ip) {
+		return ErrBlocked
+	}
+	return nil
+}
+
+// DialContext 解析 DNS 后校验所有目标 IP 再直连已解析的地址，
+// 在连接层拦截内网地址，防止 DNS rebinding（TOCTOU）绕过。
+func (g *Guard) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	host = normalizeHost(host)
+	if err := g.checkHost(host); err != nil {
+		return nil, err
+	}
+
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("DNS解析失败: %w", err)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("DNS解析无结果: %s", host)
+	}
+
+	r := g.rules()
+	hostAllowed := r.allowHost(host)
+	for _, ipAddr := range ips {
+		if isMetadataIP(ipAddr.IP) {
+			return nil, ErrBlocked
+		}
+		if IsBlockedIP(ipAddr.IP) && !hostAllowed && !r.allowIP(ipAddr.IP) {
+			return nil, ErrBlocked
+		}
+	}
+
+	// 直接连接到已解析的 IP，防止 DNS rebinding
+	return g.dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
+}
+
+// Transport 返回一个使用 DialContext 的 http.Transport。
+// 不走系统代理，否则连接目标会变为代理地址而绕过校验。
+func (g *Guard) Transport() *http.Transport {
+	return &http.Transport{
+		DialContext:           g.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
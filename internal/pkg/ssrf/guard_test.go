@@ -0,0 +1,66 @@
+/*
+ * @Description: SSRF 防护测试
+ * @Author: 安知鱼
+ */
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGuard_CheckURL(t *testing.T) {
+	guard := NewGuard(func() string { return "10.1.2.0/24, img.internal" })
+
+	cases := []struct {
+		url     string
+		blocked bool
+	}{
+		{"https://example.com/a.png", false},
+		{"ftp://example.com/a.png", true},
+		{"http://127.0.0.1/", true},
+		{"http://[::1]/", true},
+		{"http://192.168.1.1/", true},
+		{"http://169.254.169.254/latest/meta-data/", true},
+		{"http://100.100.100.200/", true},
+		{"http://metadata.google.internal/computeMetadata/v1/", true},
+		{"http://10.1.2.3/a.png", false},
+		{"http://10.1.3.3/a.png", true},
+	}
+	for _, tc := range cases {
+		err := guard.CheckURL(tc.url)
+		if (err != nil) != tc.blocked {
+			t.Errorf("CheckURL(%q) err=%v, 期望拦截=%v", tc.url, err, tc.blocked)
+		}
+	}
+}
+
+func TestGuard_MetadataIPIgnoresAllowlist(t *testing.T) {
+	guard := NewGuard(func() string { return "169.254.0.0/16" })
+	if err := guard.CheckURL("http://169.254.169.254/"); !errors.Is(err, ErrBlocked) {
+		t.Fatalf("元数据地址不应被白名单放行，实际 err=%v", err)
+	}
+}
+
+func TestGuard_DialContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	addr := srv.Listener.Addr().String()
+
+	if _, err := NewGuard(nil).DialContext(context.Background(), "tcp", addr); !errors.Is(err, ErrBlocked) {
+		t.Fatalf("未配置白名单时应拦截回环地址，实际 err=%v", err)
+	}
+
+	host, _, _ := net.SplitHostPort(addr)
+	conn, err := NewGuard(func() string { return host }).DialContext(context.Background(), "tcp", addr)
+	if err != nil {
+		t.Fatalf("白名单内的地址应允许连接: %v", err)
+	}
+	conn.Close()
+}
@@ -0,0 +1,188 @@
+/*
+ * @Description: 流式导出 - 以 NDJSON 或 CSV 格式逐行写出数据并分块发送，导出大量数据时内存占用与数据量无关
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 10:00:00
+ * @LastEditTime: 2026-10-17 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package streamexport
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+)
+
+// Format 导出格式
+type Format string
+
+const (
+	FormatNDJSON Format = "ndjson" // 每行一个 JSON 对象
+	FormatCSV    Format = "csv"
+)
+
+// flushEvery 每写出多少行主动刷新一次，使数据以分块的形式尽快发送给客户端
+const flushEvery = 200
+
+// ParseFormat 解析导出格式，空字符串默认为 NDJSON
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FormatNDJSON, "jsonl":
+		return FormatNDJSON, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("不支持的导出格式 %q，仅支持 ndjson 与 csv", s)
+	}
+}
+
+// ContentType 返回格式对应的 Content-Type
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/x-ndjson; charset=utf-8"
+}
+
+// CSVRecord 以 CSV 格式导出的记录需要实现该接口，字段顺序与表头一致
+type CSVRecord interface {
+	CSVRecord() []string
+}
+
+// Writer 逐行写出导出记录。每次写入前检查 ctx，客户端断开或请求被取消后立即停止导出
+type Writer struct {
+	ctx     context.Context
+	format  Format
+	out     io.Writer
+	flusher http.Flusher
+	json    *json.Encoder
+	csv     *csv.Writer
+	header  []string
+	rows    int
+
+	headerWritten bool
+}
+
+// NewWriter 创建 Writer。header 为 CSV 表头，NDJSON 格式忽略该参数
+func NewWriter(ctx context.Context, out io.Writer, format Format, header []string) *Writer {
+	w := &Writer{ctx: ctx, format: format, out: out, header: header}
+	if f, ok := out.(http.Flusher); ok {
+		w.flusher = f
+	}
+	if format == FormatCSV {
+		w.csv = csv.NewWriter(out)
+	} else {
+		w.json = json.NewEncoder(out)
+		w.json.SetEscapeHTML(false)
+	}
+	return w
+}
+
+// Write 写出一条记录
+func (w *Writer) Write(record any) error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	if w.format == FormatCSV {
+		row, ok := record.(CSVRecord)
+		if !ok {
+			return fmt.Errorf("记录类型 %T 不支持 CSV 导出", record)
+		}
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+		if err := w.csv.Write(row.CSVRecord()); err != nil {
+			return err
+		}
+	} else if err := w.json.Encode(record); err != nil {
+		return err
+	}
+	w.rows++
+	if w.rows%flushEvery == 0 {
+		return w.Flush()
+	}
+	return nil
+}
+
+// writeHeader 在第一行之前写出 CSV 表头
+func (w *Writer) writeHeader() error {
+	if w.csv == nil || w.headerWritten || len(w.header) == 0 {
+		return nil
+	}
+	w.headerWritten = true
+	return w.csv.Write(w.header)
+}
+
+// Close 写出剩余的数据，没有任何记录的 CSV 也会包含表头
+func (w *Writer) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// Flush 将缓冲的数据发送给客户端
+func (w *Writer) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+	return nil
+}
+
+// Rows 返回已写出的记录数
+func (w *Writer) Rows() int {
+	return w.rows
+}
+
+// Serve 以附件形式流式输出导出数据，filename 不含扩展名。
+// 响应不设置 Content-Length，使用分块传输编码。produce 在尚未发送任何数据前失败时返回 JSON 错误；
+// 已开始发送后失败则中断连接，让客户端感知到下载不完整，而不是得到一个被截断却看似完整的文件。
+func Serve(c *gin.Context, format Format, filename string, header []string, produce func(w *Writer) error) {
+	name := filename + "." + string(format)
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q; filename*=UTF-8''%s", name, url.PathEscape(name)))
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Content-Type-Options", "nosniff")
+	// 关闭 Nginx 等反向代理的响应缓冲，否则分块输出会被代理完整缓存后才发送
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	w := NewWriter(c.Request.Context(), c.Writer, format, header)
+	err := produce(w)
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		return
+	}
+	if c.Request.Context().Err() != nil {
+		log.Printf("[流式导出] %s 已被客户端取消，已写出 %d 行", name, w.Rows())
+		return
+	}
+	log.Printf("[流式导出] %s 在写出 %d 行后失败: %v", name, w.Rows(), err)
+	if !c.Writer.Written() {
+		c.Header("Content-Disposition", "")
+		c.Header("Content-Type", "")
+		response.Fail(c, http.StatusInternalServerError, "导出失败: "+err.Error())
+		return
+	}
+	if conn, _, hijackErr := c.Writer.Hijack(); hijackErr == nil {
+		_ = conn.Close()
+	}
+}
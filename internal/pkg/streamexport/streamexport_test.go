@@ -0,0 +1,95 @@
+package streamexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type row struct {
+	Name string `json:"name"`
+}
+
+func (r row) CSVRecord() []string { return []string{r.Name} }
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]Format{"": FormatNDJSON, "jsonl": FormatNDJSON, " CSV ": FormatCSV} {
+		if got, err := ParseFormat(in); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v", in, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("不支持的格式应返回错误")
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(context.Background(), &buf, FormatNDJSON, nil)
+	for _, name := range []string{"a", "<b>"} {
+		if err := w.Write(row{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "{\"name\":\"a\"}\n{\"name\":\"<b>\"}\n" {
+		t.Errorf("unexpected ndjson output %q", got)
+	}
+
+	buf.Reset()
+	w = NewWriter(context.Background(), &buf, FormatCSV, []string{"name"})
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "name\n" {
+		t.Errorf("没有记录时 CSV 也应包含表头，实际 %q", buf.String())
+	}
+	if err := w.Write(struct{}{}); err == nil {
+		t.Error("未实现 CSVRecord 的记录应返回错误")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w = NewWriter(ctx, &buf, FormatNDJSON, nil)
+	if err := w.Write(row{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("请求取消后应停止写出，实际 %v", err)
+	}
+}
+
+func TestServe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/export", nil)
+	Serve(c, FormatCSV, "导出", []string{"name"}, func(w *Writer) error {
+		return w.Write(row{Name: "a,b"})
+	})
+	if rec.Code != http.StatusOK || rec.Body.String() != "name\n\"a,b\"\n" {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Body.String())
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "filename*=UTF-8''%E5%AF%BC%E5%87%BA.csv") {
+		t.Errorf("unexpected Content-Disposition %q", cd)
+	}
+
+	rec = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/export", nil)
+	Serve(c, FormatNDJSON, "export", nil, func(w *Writer) error {
+		return errors.New("db down")
+	})
+	if rec.Code != http.StatusInternalServerError || !strings.Contains(rec.Body.String(), "db down") {
+		t.Fatalf("写出前失败应返回 JSON 错误，实际 %d %q", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Content-Disposition") != "" || !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/json") {
+		t.Errorf("错误响应应为普通 JSON 而不是附件: %v", rec.Header())
+	}
+}
@@ -14,6 +14,7 @@ var (
 	Commit    = "unknown"         // Git commit hash
 	Date      = "unknown"         // 构建时间
 	GoVersion = runtime.Version() // Go 版本
+	Tags      = ""                // 构建标签，逗号分隔，与 go build -tags 保持一致
 )
 
 const CommunityModulePath = "github.com/anzhiyu-c/anheyu-app"
@@ -55,6 +56,8 @@ func GetBuildInfo() BuildInfo {
 		Commit:    GetCommit(),
 		Date:      GetBuildDate(),
 		GoVersion: GoVersion,
+		BuildTags: GetBuildTags(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
 	}
 }
 
@@ -106,6 +109,38 @@ func GetBuildDate() string {
 	return "unknown"
 }
 
+// GetBuildTags 返回编译时启用的构建标签
+func GetBuildTags() []string {
+	if Tags != "" {
+		return splitTags(Tags)
+	}
+
+	// 未通过 ldflags 注入时，从构建信息中读取 -tags 参数
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return []string{}
+	}
+
+	for _, setting := range buildInfo.Settings {
+		if setting.Key == "-tags" {
+			return splitTags(setting.Value)
+		}
+	}
+
+	return []string{}
+}
+
+// splitTags 按逗号或空格拆分构建标签（旧版 go build 允许用空格分隔）
+func splitTags(raw string) []string {
+	tags := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
 // GetVersionString 返回完整的版本字符串
 func GetVersionString() string {
 	version := GetVersion()
@@ -123,13 +158,19 @@ func GetVersionString() string {
 		parts = append(parts, fmt.Sprintf("built at %s", date))
 	}
 
+	if tags := GetBuildTags(); len(tags) > 0 {
+		parts = append(parts, fmt.Sprintf("tags %s", strings.Join(tags, ",")))
+	}
+
 	return strings.Join(parts, ", ")
 }
 
 // BuildInfo 包含构建信息
 type BuildInfo struct {
-	Version   string `json:"version"`
-	Commit    string `json:"commit"`
-	Date      string `json:"date"`
-	GoVersion string `json:"go_version"`
+	Version   string   `json:"version"`
+	Commit    string   `json:"commit"`
+	Date      string   `json:"date"`
+	GoVersion string   `json:"go_version"`
+	BuildTags []string `json:"build_tags"`
+	Platform  string   `json:"platform"`
 }
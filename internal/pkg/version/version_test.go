@@ -0,0 +1,28 @@
+package version
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetBuildTagsPrefersInjectedTags(t *testing.T) {
+	old := Tags
+	defer func() { Tags = old }()
+
+	Tags = "netgo, osusergo"
+	if got := GetBuildTags(); !reflect.DeepEqual(got, []string{"netgo", "osusergo"}) {
+		t.Errorf("GetBuildTags() = %v", got)
+	}
+	if info := GetBuildInfo(); len(info.BuildTags) != 2 || info.Platform == "" {
+		t.Errorf("GetBuildInfo() = %+v", info)
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	if got := splitTags(""); got == nil || len(got) != 0 {
+		t.Errorf("splitTags(\"\") = %#v, want empty slice", got)
+	}
+	if got := splitTags("a b,c"); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("splitTags() = %v", got)
+	}
+}
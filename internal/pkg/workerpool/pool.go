@@ -0,0 +1,234 @@
+/*
+ * @Description: 有界后台任务协程池，按类别限制并发数与排队长度，替代随处启动的无界 goroutine
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 14:00:00
+ * @LastEditTime: 2026-10-16 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package workerpool
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// 后台任务类别
+const (
+	CategoryNotification = "notification" // 邮件、即时通知、站内通知
+	CategoryIndexing     = "indexing"     // 搜索索引更新
+	CategoryCache        = "cache"        // 缓存与 CDN 失效
+)
+
+// OverflowPolicy 队列已满时的处理策略
+type OverflowPolicy string
+
+const (
+	OverflowBlock      OverflowPolicy = "block"       // 阻塞提交方，直到队列有空位
+	OverflowDrop       OverflowPolicy = "drop"        // 丢弃新任务并记录日志
+	OverflowCallerRuns OverflowPolicy = "caller_runs" // 在提交方的 goroutine 中直接执行
+)
+
+// Config 单个类别协程池的配置
+type Config struct {
+	Workers   int            `json:"workers"`    // 并发执行的 worker 数量
+	QueueSize int            `json:"queue_size"` // 排队任务数上限
+	Overflow  OverflowPolicy `json:"overflow"`   // 队列已满时的处理策略
+}
+
+// DefaultConfig 返回未单独配置的类别使用的默认配置
+func DefaultConfig() Config {
+	return Config{Workers: 4, QueueSize: 256, Overflow: OverflowBlock}
+}
+
+func (c Config) withDefaults() Config {
+	d := DefaultConfig()
+	if c.Workers <= 0 {
+		c.Workers = d.Workers
+	}
+	if c.QueueSize < 0 {
+		c.QueueSize = d.QueueSize
+	}
+	switch c.Overflow {
+	case OverflowBlock, OverflowDrop, OverflowCallerRuns:
+	default:
+		c.Overflow = d.Overflow
+	}
+	return c
+}
+
+// Pool 是一个有界协程池：固定数量的 worker 从队列中取任务执行
+type Pool struct {
+	name  string
+	cfg   Config
+	queue chan func()
+
+	submitted  int64
+	completed  int64
+	dropped    int64
+	callerRuns int64
+	panics     int64
+	running    int64
+}
+
+func newPool(name string, cfg Config) *Pool {
+	cfg = cfg.withDefaults()
+	p := &Pool{
+		name:  name,
+		cfg:   cfg,
+		queue: make(chan func(), cfg.QueueSize),
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *Pool) worker() {
+	for fn := range p.queue {
+		p.run(fn)
+	}
+}
+
+// run 执行任务并恢复 panic，避免单个任务拖垮 worker
+func (p *Pool) run(fn func()) {
+	atomic.AddInt64(&p.running, 1)
+	defer func() {
+		atomic.AddInt64(&p.running, -1)
+		atomic.AddInt64(&p.completed, 1)
+		if r := recover(); r != nil {
+			atomic.AddInt64(&p.panics, 1)
+			log.Printf("[WorkerPool] 类别 %s 的任务发生 panic: %v\n%s", p.name, r, debug.Stack())
+		}
+	}()
+	fn()
+}
+
+// Submit 提交一个任务，返回任务是否被接受（仅 drop 策略在队列已满时返回 false）
+func (p *Pool) Submit(fn func()) bool {
+	atomic.AddInt64(&p.submitted, 1)
+	select {
+	case p.queue <- fn:
+		return true
+	default:
+	}
+
+	switch p.cfg.Overflow {
+	case OverflowDrop:
+		atomic.AddInt64(&p.dropped, 1)
+		log.Printf("[WorkerPool] 类别 %s 的队列已满（%d），丢弃任务", p.name, p.cfg.QueueSize)
+		return false
+	case OverflowCallerRuns:
+		atomic.AddInt64(&p.callerRuns, 1)
+		p.run(fn)
+		return true
+	default:
+		p.queue <- fn
+		return true
+	}
+}
+
+// PoolStatus 协程池状态快照，供诊断接口展示
+type PoolStatus struct {
+	Name       string         `json:"name"`
+	Workers    int            `json:"workers"`
+	QueueSize  int            `json:"queue_size"`
+	Overflow   OverflowPolicy `json:"overflow"`
+	Queued     int            `json:"queued"`
+	Running    int64          `json:"running"`
+	Submitted  int64          `json:"submitted"`
+	Completed  int64          `json:"completed"`
+	Dropped    int64          `json:"dropped"`
+	CallerRuns int64          `json:"caller_runs"`
+	Panics     int64          `json:"panics"`
+}
+
+func (p *Pool) status() PoolStatus {
+	return PoolStatus{
+		Name:       p.name,
+		Workers:    p.cfg.Workers,
+		QueueSize:  p.cfg.QueueSize,
+		Overflow:   p.cfg.Overflow,
+		Queued:     len(p.queue),
+		Running:    atomic.LoadInt64(&p.running),
+		Submitted:  atomic.LoadInt64(&p.submitted),
+		Completed:  atomic.LoadInt64(&p.completed),
+		Dropped:    atomic.LoadInt64(&p.dropped),
+		CallerRuns: atomic.LoadInt64(&p.callerRuns),
+		Panics:     atomic.LoadInt64(&p.panics),
+	}
+}
+
+var (
+	registryMu sync.Mutex
+	configs    = map[string]Config{}
+	pools      = map[string]*Pool{}
+)
+
+// Configure 设置各类别的协程池配置。协程池在首次使用时按配置创建，
+// 因此应在启动阶段、提交任何任务之前调用；已创建的协程池不受影响。
+func Configure(cfgs map[string]Config) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for name, cfg := range cfgs {
+		if _, exists := pools[name]; exists {
+			log.Printf("[WorkerPool] 类别 %s 的协程池已在使用，新配置将在重启后生效", name)
+			continue
+		}
+		configs[name] = cfg
+	}
+}
+
+// ParseConfigs 解析 JSON 格式的类别配置，如 {"notification":{"workers":4,"queue_size":500,"overflow":"block"}}
+func ParseConfigs(raw string) (map[string]Config, error) {
+	cfgs := map[string]Config{}
+	if raw == "" {
+		return cfgs, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &cfgs); err != nil {
+		return nil, fmt.Errorf("解析协程池配置失败: %w", err)
+	}
+	return cfgs, nil
+}
+
+// Get 返回指定类别的协程池，不存在时按配置创建
+func Get(category string) *Pool {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if p, ok := pools[category]; ok {
+		return p
+	}
+	cfg, ok := configs[category]
+	if !ok {
+		cfg = DefaultConfig()
+	}
+	p := newPool(category, cfg)
+	pools[category] = p
+	return p
+}
+
+// Go 将任务提交到指定类别的协程池执行
+func Go(category string, fn func()) bool {
+	return Get(category).Submit(fn)
+}
+
+// Snapshot 返回所有已创建协程池的状态，按名称排序
+func Snapshot() []PoolStatus {
+	registryMu.Lock()
+	list := make([]*Pool, 0, len(pools))
+	for _, p := range pools {
+		list = append(list, p)
+	}
+	registryMu.Unlock()
+
+	statuses := make([]PoolStatus, 0, len(list))
+	for _, p := range list {
+		statuses = append(statuses, p.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
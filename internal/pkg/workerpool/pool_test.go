@@ -0,0 +1,92 @@
+/*
+ * @Description: 有界协程池行为测试
+ * @Author: 安知鱼
+ */
+package workerpool
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockWorkers 占满协程池的所有 worker，返回用于放行的 channel
+func blockWorkers(p *Pool) chan struct{} {
+	release := make(chan struct{})
+	var started sync.WaitGroup
+	started.Add(p.cfg.Workers)
+	for i := 0; i < p.cfg.Workers; i++ {
+		// 直接写入队列（阻塞直到 worker 就绪），不经过溢出策略
+		p.queue <- func() {
+			started.Done()
+			<-release
+		}
+	}
+	started.Wait()
+	return release
+}
+
+func TestPool_DropPolicyRejectsWhenQueueFull(t *testing.T) {
+	p := newPool(t.Name(), Config{Workers: 1, QueueSize: 1, Overflow: OverflowDrop})
+	release := blockWorkers(p)
+	defer close(release)
+
+	if !p.Submit(func() {}) {
+		t.Fatal("队列未满时应接受任务")
+	}
+	if p.Submit(func() {}) {
+		t.Fatal("队列已满时 drop 策略应拒绝任务")
+	}
+	if st := p.status(); st.Dropped != 1 || st.Queued != 1 {
+		t.Errorf("状态错误: %+v", st)
+	}
+}
+
+func TestPool_CallerRunsPolicyExecutesInline(t *testing.T) {
+	p := newPool(t.Name(), Config{Workers: 1, QueueSize: 0, Overflow: OverflowCallerRuns})
+	release := blockWorkers(p)
+	defer close(release)
+
+	ran := false
+	if !p.Submit(func() { ran = true }) {
+		t.Fatal("caller_runs 策略应接受任务")
+	}
+	if !ran {
+		t.Error("队列已满时任务应在提交方直接执行")
+	}
+	if st := p.status(); st.CallerRuns != 1 {
+		t.Errorf("状态错误: %+v", st)
+	}
+}
+
+func TestPool_RecoversFromPanic(t *testing.T) {
+	p := newPool(t.Name(), Config{Workers: 1, QueueSize: 4})
+	done := make(chan struct{})
+	p.Submit(func() { panic("boom") })
+	p.Submit(func() { close(done) })
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("任务 panic 后 worker 应继续处理后续任务")
+	}
+	if st := p.status(); st.Panics != 1 {
+		t.Errorf("状态错误: %+v", st)
+	}
+}
+
+func TestParseConfigs(t *testing.T) {
+	cfgs, err := ParseConfigs(`{"notification":{"workers":2,"queue_size":10,"overflow":"drop"}}`)
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if got := cfgs[CategoryNotification]; got.Workers != 2 || got.QueueSize != 10 || got.Overflow != OverflowDrop {
+		t.Errorf("配置错误: %+v", got)
+	}
+	if _, err := ParseConfigs("not json"); err == nil {
+		t.Error("非法 JSON 应返回错误")
+	}
+	if got := (Config{Overflow: "unknown"}).withDefaults(); got.Workers != 4 || got.Overflow != OverflowBlock {
+		t.Errorf("默认值错误: %+v", got)
+	}
+}
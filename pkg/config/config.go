@@ -20,24 +20,29 @@ import (
 
 // 定义所有已知的配置键
 var allKeys = []string{
-	KeyServerPort, KeyServerDebug,
+	KeyServerPort, KeyServerDebug, KeyTrustedProxies,
 	KeyDBType, KeyDBHost, KeyDBPort, KeyDBUser, KeyDBPassword, KeyDBName, KeyDBDebug,
 	KeyRedisAddr, KeyRedisPassword, KeyRedisDB,
+	KeyQueueBackend,
+	KeyLogFormat,
 }
 
 const (
-	KeyServerPort    = "System.Port"
-	KeyServerDebug   = "System.Debug"
-	KeyDBType        = "Database.Type"
-	KeyDBHost        = "Database.Host"
-	KeyDBPort        = "Database.Port"
-	KeyDBUser        = "Database.User"
-	KeyDBPassword    = "Database.Password"
-	KeyDBName        = "Database.Name"
-	KeyDBDebug       = "Database.Debug"
-	KeyRedisAddr     = "Redis.Addr"
-	KeyRedisPassword = "Redis.Password"
-	KeyRedisDB       = "Redis.DB"
+	KeyServerPort     = "System.Port"
+	KeyServerDebug    = "System.Debug"
+	KeyTrustedProxies = "System.TrustedProxies"
+	KeyDBType         = "Database.Type"
+	KeyDBHost         = "Database.Host"
+	KeyDBPort         = "Database.Port"
+	KeyDBUser         = "Database.User"
+	KeyDBPassword     = "Database.Password"
+	KeyDBName         = "Database.Name"
+	KeyDBDebug        = "Database.Debug"
+	KeyRedisAddr      = "Redis.Addr"
+	KeyRedisPassword  = "Redis.Password"
+	KeyRedisDB        = "Redis.DB"
+	KeyQueueBackend   = "Queue.Backend"
+	KeyLogFormat      = "Log.Format"
 )
 
 type Config struct {
@@ -131,6 +136,9 @@ func createDefaultConfigFile(filePath string) error {
 	defaultConfig := `[System]
 Port = 8091
 Debug = false
+# 可信代理（可选）：反向代理或 CDN 的 IP/CIDR，逗号分隔，只有来自这些地址的 X-Forwarded-For 才会被采信
+# 留空默认信任本机与私有网段；直接暴露在公网时可设为 none
+TrustedProxies =
 
 [Database]
 Type = sqlite
@@ -144,6 +152,15 @@ Debug = false
 Addr = 
 Password =
 DB = 0
+
+# 后台任务队列（可选）：auto 在 Redis 可用时使用 Redis 持久化队列，否则使用内存队列
+# 可选值 auto / redis / memory；使用内存队列时进程重启会丢失未完成的缩略图、通知等任务
+[Queue]
+Backend = auto
+
+# 日志输出格式（可选）：text 或 json，json 便于日志采集系统解析；日志级别可在后台设置中实时调整
+[Log]
+Format = text
 `
 
 	// 写入文件
@@ -7,7 +7,10 @@
  */
 package constant
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // 定义业务逻辑相关的标准错误
 var (
@@ -47,6 +50,9 @@ var (
 	// ErrInvalidOperation 表示不允许的操作，可以由 Handler 转换为 403
 	ErrInvalidOperation = errors.New("不允许的操作")
 
+	// ErrMountReadOnly 表示目标位于只读挂载点，包装了 ErrForbidden，可以由 Handler 转换为 403
+	ErrMountReadOnly = fmt.Errorf("挂载点为只读: %w", ErrForbidden)
+
 	// ErrInvalidPolicyType 表示无效的存储策略类型，可以由 Handler 转换为 400
 	ErrInvalidPolicyType = errors.New("无效的存储策略类型")
 
@@ -59,6 +65,9 @@ var (
 	// ErrPolicySettingsInvalid 表示存储策略设置无效，可以由 Handler 转换为 400
 	ErrPolicySettingsInvalid = errors.New("存储策略设置无效")
 
+	// ErrChecksumMismatch 表示上传数据的校验和与客户端提供的不一致，可以由 Handler 转换为 400
+	ErrChecksumMismatch = errors.New("数据校验失败")
+
 	// ErrPolicyNameConflict 表示存储策略名称冲突，可以由 Handler 转换为 409
 	ErrPolicyNameConflict = errors.New("存储策略名称冲突")
 
@@ -73,4 +82,25 @@ var (
 
 	// ErrAdminEmailUsedByGuest 表示匿名用户尝试使用管理员邮箱发表评论
 	ErrAdminEmailUsedByGuest = errors.New("此邮箱为管理员专属，请登录后发表评论")
+
+	// ErrCommentRejectedByWordRule 表示评论命中了动作为拒绝的违禁词规则，可以由 Handler 转换为 400
+	ErrCommentRejectedByWordRule = errors.New("评论内容包含违禁词，请修改后重新提交")
+
+	// ErrAnonymousCommentDisabled 表示当前页面禁止匿名评论，可以由 Handler 转换为 403
+	ErrAnonymousCommentDisabled = errors.New("当前页面不允许匿名评论")
+
+	// ErrCommentCodeTooLong 表示评论中代码块的总长度超过上限，可以由 Handler 转换为 400
+	ErrCommentCodeTooLong = errors.New("评论中的代码过长")
+
+	// ErrDisposableEmail 表示评论或注册使用了一次性邮箱且配置为拒绝，可以由 Handler 转换为 400
+	ErrDisposableEmail = errors.New("不支持使用一次性邮箱，请更换常用邮箱")
+
+	// ErrUploadExtensionNotAllowed 表示文件后缀不在允许上传的范围内，可以由 Handler 转换为 415
+	ErrUploadExtensionNotAllowed = errors.New("不支持的文件类型")
+
+	// ErrUploadFileTooLarge 表示文件超过了用户组或存储策略的大小上限，可以由 Handler 转换为 413
+	ErrUploadFileTooLarge = errors.New("文件大小超出限制")
+
+	// ErrUploadDailyLimitExceeded 表示用户当天的上传次数已达用户组上限，可以由 Handler 转换为 429
+	ErrUploadDailyLimitExceeded = errors.New("今日上传次数已达上限")
 )
@@ -0,0 +1,4 @@
+package constant
+
+// HeaderCacheWarmup 缓存预热任务请求页面时携带的请求头，带有该请求头的请求不计入文章浏览量
+const HeaderCacheWarmup = "X-Anheyu-Cache-Warmup"
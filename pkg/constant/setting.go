@@ -55,6 +55,9 @@ const (
 	KeyCreativity                SettingKey = "CREATIVITY"
 	KeyUploadAllowedExtensions   SettingKey = "UPLOAD_ALLOWED_EXTENSIONS"
 	KeyUploadDeniedExtensions    SettingKey = "UPLOAD_DENIED_EXTENSIONS"
+	KeyUploadStaleMaxAgeHours    SettingKey = "UPLOAD_STALE_MAX_AGE_HOURS"
+	KeyRecycleBinRetentionDays   SettingKey = "RECYCLE_BIN_RETENTION_DAYS"
+	KeyIntegrityAutoRepair       SettingKey = "INTEGRITY_AUTO_REPAIR"
 	KeyEnableExternalLinkWarning SettingKey = "ENABLE_EXTERNAL_LINK_WARNING"
 	KeyRespectReducedMotion     SettingKey = "RESPECT_REDUCED_MOTION"
 	KeyEnableVipsGenerator       SettingKey = "ENABLE_VIPS_GENERATOR"
@@ -131,6 +134,15 @@ const (
 	KeyFriendLinkReviewMailSubjectRejected  SettingKey = "FRIEND_LINK_REVIEW_MAIL_SUBJECT_REJECTED"
 	KeyFriendLinkReviewMailTemplateRejected SettingKey = "FRIEND_LINK_REVIEW_MAIL_TEMPLATE_REJECTED"
 
+	// 友链健康检查配置
+	KeyFriendLinkHealthCheckInterval SettingKey = "FRIEND_LINK_HEALTH_CHECK_INTERVAL"
+	KeyFriendLinkSickThreshold       SettingKey = "FRIEND_LINK_SICK_THRESHOLD"
+	KeyFriendLinkSickCategoryName    SettingKey = "FRIEND_LINK_SICK_CATEGORY_NAME"
+	KeyFriendLinkSSLWarnDays         SettingKey = "FRIEND_LINK_SSL_WARN_DAYS"
+	KeyFriendLinkSickMailEnable      SettingKey = "FRIEND_LINK_SICK_MAIL_ENABLE"
+	KeyFriendLinkSickMailSubject     SettingKey = "FRIEND_LINK_SICK_MAIL_SUBJECT"
+	KeyFriendLinkSickMailTemplate    SettingKey = "FRIEND_LINK_SICK_MAIL_TEMPLATE"
+
 	// --- 缩略图生成队列配置 ---
 	KeyQueueThumbConcurrency   SettingKey = "QUEUE_THUMB_CONCURRENCY"
 	KeyQueueThumbMaxExecTime   SettingKey = "QUEUE_THUMB_MAX_EXEC_TIME"
@@ -198,6 +210,9 @@ const (
 	// 文章页面波浪区域配置
 	KeyPostWavesEnable SettingKey = "post.waves.enable" // 是否显示文章页面波浪区域
 
+	// 文章关键词自动提取配置
+	KeyPostAutoKeywordsEnable SettingKey = "post.auto_keywords.enable" // 保存文章时关键词为空则按 TF-IDF 自动提取
+
 	// 文章底部版权声明配置
 	KeyPostCopyrightOriginalTemplate          SettingKey = "post.copyright.original_template"            // 原创文章版权声明模板
 	KeyPostCopyrightReprintTemplateWithUrl    SettingKey = "post.copyright.reprint_template_with_url"    // 转载文章版权声明模板（有原文链接）
@@ -239,10 +254,31 @@ const (
 	KeyCommentAnonymousEmail    SettingKey = "comment.anonymous_email"
 	KeyCommentShowUA            SettingKey = "comment.show_ua"
 	KeyCommentShowRegion        SettingKey = "comment.show_region"
+	KeyCommentRegionGranularity SettingKey = "comment.region_granularity" // 评论IP归属地展示粒度：country/province/city
 	KeyCommentAllowImageUpload  SettingKey = "comment.allow_image_upload"
 	KeyCommentLimitPerMinute    SettingKey = "comment.limit_per_minute"
 	KeyCommentLimitLength       SettingKey = "comment.limit_length"
 	KeyCommentForbiddenWords    SettingKey = "comment.forbidden_words"
+	KeyCommentFirstCommentReview SettingKey = "comment.first_comment_review" // 访客首次评论需审核，通过后同一邮箱的后续评论自动发布
+	KeyCommentProfileEnable      SettingKey = "comment.profile.enable"       // 是否公开评论者资料卡片（按邮箱哈希聚合评论足迹）
+	KeyCommentProfileRecentCount SettingKey = "comment.profile.recent_count" // 评论者资料卡片展示的最近评论数，0 表示不展示
+	KeyCommentAnonymousSalt      SettingKey = "comment.anonymous_salt"       // 匿名评论化名的加盐密钥（首次启动时自动生成）
+	KeyCommentAnonymousDisabledPaths SettingKey = "comment.anonymous_disabled_paths" // 禁止匿名评论的路径，逗号或换行分隔，* 结尾表示前缀匹配
+	KeyCommentCodeMaxLength      SettingKey = "comment.code_max_length"      // 单条评论中代码块的总字符数上限，0 表示不限制
+	KeyCommentCleanupEnable         SettingKey = "comment.cleanup.enable"          // 是否每天自动执行评论清理规则
+	KeyCommentCleanupPendingDays    SettingKey = "comment.cleanup.pending_days"    // 待审核评论与垃圾评论超过该天数后删除，0 表示不清理
+	KeyCommentCleanupBannedEmails   SettingKey = "comment.cleanup.banned_emails"   // 需要清理的邮箱，逗号或换行分隔，以 @ 开头表示整个域名
+	KeyCommentCleanupBannedIPs      SettingKey = "comment.cleanup.banned_ips"      // 需要清理的 IP，逗号或换行分隔，支持 CIDR 网段
+	KeyCommentCleanupCollapseOrphans SettingKey = "comment.cleanup.collapse_orphans" // 是否把父评论已删除的回复挂到最近的未删除祖先下
+	KeyCommentSubscribeEnable         SettingKey = "comment.subscribe.enable"          // 是否允许访客订阅评论区的新评论
+	KeyCommentSubscribeDigestInterval SettingKey = "comment.subscribe.digest_interval" // 同一订阅两封摘要邮件的最短间隔（分钟）
+	KeyCommentSubscribeMailSubject    SettingKey = "comment.subscribe.mail_subject"    // 评论订阅摘要邮件主题模板
+	KeyCommentSubscribeMailTemplate   SettingKey = "comment.subscribe.mail_template"   // 评论订阅摘要邮件HTML模板
+	KeyCommentSpamHoneypotEnable  SettingKey = "comment.spam.honeypot_enable"  // 是否启用蜜罐字段，前端渲染隐藏输入框，填写了的提交判定为垃圾评论
+	KeyCommentSpamAkismetKey      SettingKey = "comment.spam.akismet_key"      // Akismet API Key，留空表示不使用 Akismet
+	KeyCommentSpamBayesEnable     SettingKey = "comment.spam.bayes_enable"     // 是否启用本地贝叶斯过滤器（由管理员标记的垃圾/正常评论训练）
+	KeyCommentSpamBayesThreshold  SettingKey = "comment.spam.bayes_threshold"  // 贝叶斯过滤器判定为垃圾评论的概率阈值 (0-1)
+	KeyCommentSpamBayesMinSamples SettingKey = "comment.spam.bayes_min_samples" // 垃圾与正常样本各至少达到该数量后贝叶斯过滤器才开始判定
 	KeyCommentAIDetectEnable    SettingKey = "comment.ai_detect_enable"     // 是否启用AI违禁词检测
 	KeyCommentAIDetectAPIURL    SettingKey = "comment.ai_detect_api_url"    // AI违禁词检测API地址
 	KeyCommentAIDetectAction    SettingKey = "comment.ai_detect_action"     // 检测到违禁词时的处理方式: pending(待审), reject(拒绝)
@@ -251,6 +287,8 @@ const (
 	KeyCommentQQAPIKey          SettingKey = "comment.qq_api_key"
 	KeyCommentNotifyAdmin       SettingKey = "comment.notify_admin"
 	KeyCommentNotifyReply       SettingKey = "comment.notify_reply"
+	KeyCommentNotifyMention     SettingKey = "comment.notify_mention"
+	KeyCommentNotifyApproved    SettingKey = "comment.notify_approved" // 待审核评论通过后是否邮件通知评论者
 	KeyPushooChannel            SettingKey = "pushoo.channel"
 	KeyPushooURL                SettingKey = "pushoo.url"
 	KeyWebhookRequestBody       SettingKey = "webhook.request_body"
@@ -267,6 +305,10 @@ const (
 	KeyCommentMailTemplate      SettingKey = "comment.mail_template"
 	KeyCommentMailSubjectAdmin  SettingKey = "comment.mail_subject_admin"
 	KeyCommentMailTemplateAdmin SettingKey = "comment.mail_template_admin"
+	KeyCommentMailSubjectMention  SettingKey = "comment.mail_subject_mention"  // 评论提及通知邮件主题模板
+	KeyCommentMailTemplateMention SettingKey = "comment.mail_template_mention" // 评论提及通知邮件HTML模板
+	KeyCommentMailSubjectApproved  SettingKey = "comment.mail_subject_approved"  // 评论审核通过通知邮件主题模板
+	KeyCommentMailTemplateApproved SettingKey = "comment.mail_template_approved" // 评论审核通过通知邮件HTML模板
 
 	// 侧边栏配置 ---
 	KeySidebarAuthorEnable           SettingKey = "sidebar.author.enable"
@@ -284,6 +326,7 @@ const (
 	KeySidebarSiteInfoRuntimeEnable  SettingKey = "sidebar.siteinfo.runtimeEnable"
 	KeySidebarSiteInfoTotalPostCount SettingKey = "sidebar.siteinfo.totalPostCount"
 	KeySidebarSiteInfoTotalWordCount SettingKey = "sidebar.siteinfo.totalWordCount"
+	KeySidebarSiteInfoTotalCommentCount SettingKey = "sidebar.siteinfo.totalCommentCount"
 	KeySidebarArchiveCount           SettingKey = "sidebar.archive.displayMonths"
 	KeySidebarCustomShowInPost       SettingKey = "sidebar.custom.showInPost"
 	KeySidebarTocCollapseMode        SettingKey = "sidebar.toc.collapseMode"
@@ -293,12 +336,39 @@ const (
 
 	// --- 站点敏感或内部配置 (不暴露给前端) ---
 	KeyJWTSecret               SettingKey = "JWT_SECRET"
+	KeyJWTSecretPrevious       SettingKey = "JWT_SECRET_PREVIOUS"
+	KeyJWTPreviousExpiresAt    SettingKey = "JWT_SECRET_PREVIOUS_EXPIRES_AT"
 	KeyResetPasswordSubject    SettingKey = "DEFAULT_RESET_PASSWORD_SUBJECT"
 	KeyResetPasswordTemplate   SettingKey = "DEFAULT_RESET_PASSWORD_TEMPLATE"
 	KeyActivateAccountSubject  SettingKey = "DEFAULT_ACTIVATE_ACCOUNT_SUBJECT"
 	KeyActivateAccountTemplate SettingKey = "DEFAULT_ACTIVATE_ACCOUNT_TEMPLATE"
 	KeyEnableUserActivation    SettingKey = "ENABLE_USER_ACTIVATION"
 	KeyEnableRegistration      SettingKey = "ENABLE_REGISTRATION"
+	KeyRegistrationInvite      SettingKey = "REGISTRATION_INVITE_ONLY"
+	KeyRegistrationDomains     SettingKey = "REGISTRATION_ALLOWED_EMAIL_DOMAINS"
+	KeySetupCompleted          SettingKey = "setup.completed"
+	KeyOAuthGithubEnable       SettingKey = "oauth.github.enable"        // 是否启用 GitHub 登录
+	KeyOAuthGithubClientID     SettingKey = "oauth.github.client_id"     // GitHub OAuth App Client ID
+	KeyOAuthGithubClientSecret SettingKey = "oauth.github.client_secret" // GitHub OAuth App Client Secret
+	KeyOAuthGoogleEnable       SettingKey = "oauth.google.enable"        // 是否启用 Google 登录
+	KeyOAuthGoogleClientID     SettingKey = "oauth.google.client_id"     // Google OAuth 客户端 ID
+	KeyOAuthGoogleClientSecret SettingKey = "oauth.google.client_secret" // Google OAuth 客户端密钥
+	KeyOAuthQQEnable           SettingKey = "oauth.qq.enable"            // 是否启用 QQ 登录
+	KeyOAuthQQClientID         SettingKey = "oauth.qq.client_id"         // QQ 互联 APP ID
+	KeyOAuthQQClientSecret     SettingKey = "oauth.qq.client_secret"     // QQ 互联 APP Key
+
+	// 一次性邮箱拦截
+	KeyDisposableEmailCommentAction  SettingKey = "disposable_email.comment_action"  // 评论使用一次性邮箱时的处理方式：off/reject/hold
+	KeyDisposableEmailRegisterAction SettingKey = "disposable_email.register_action" // 注册使用一次性邮箱时的处理方式：off/reject/hold
+	KeyDisposableEmailAllowlist      SettingKey = "disposable_email.allowlist"       // 不视为一次性邮箱的域名
+	KeyDisposableEmailAutoUpdate     SettingKey = "disposable_email.auto_update"     // 是否每天自动更新一次性邮箱域名列表
+	KeyDisposableEmailListURL        SettingKey = "disposable_email.list_url"        // 一次性邮箱域名列表的下载地址
+
+	// 更新日志
+	KeyChangelogGitHubRepo        SettingKey = "changelog.github_repo"        // 同步更新日志的 GitHub 仓库，格式为 owner/repo
+	KeyChangelogAutoSync          SettingKey = "changelog.auto_sync"          // 是否每小时自动同步 GitHub Releases
+	KeyChangelogIncludePrerelease SettingKey = "changelog.include_prerelease" // 同步时是否包含预发布版本
+
 	KeySmtpHost                SettingKey = "SMTP_HOST"
 	KeySmtpPort                SettingKey = "SMTP_PORT"
 	KeySmtpUsername            SettingKey = "SMTP_USERNAME"
@@ -310,6 +380,7 @@ const (
 	KeyLocalFileSigningSecret  SettingKey = "LOCAL_FILE_SIGNING_SECRET"
 	KeyIPAPI                   SettingKey = "IP_API"
 	KeyIPAPIToKen              SettingKey = "IP_API_TOKEN"
+	KeyOutboundAllowlist       SettingKey = "OUTBOUND_ALLOWLIST" // 出站请求白名单（内网图床等），逗号分隔
 
 	// --- 关于页面配置 ---
 	KeyAboutPageName                 SettingKey = "about.page.name"
@@ -357,6 +428,20 @@ const (
 	KeyMusicPlayerCustomPlaylist  SettingKey = "music.player.custom_playlist"
 	KeyMusicCapsuleCustomPlaylist SettingKey = "music.capsule.custom_playlist"
 	KeyMusicAPIBaseURL            SettingKey = "music.api.base_url"
+	KeyMusicAPIPlaylistEndpoint   SettingKey = "music.api.playlist_endpoint"
+	KeyMusicAPISongEndpoint       SettingKey = "music.api.song_endpoint"
+	KeyMusicAPIHighQualityEnable  SettingKey = "music.api.high_quality_enable"
+	KeyMusicAPITimeout            SettingKey = "music.api.timeout"
+	KeyMusicAPIPicTimeout         SettingKey = "music.api.pic_timeout"
+	KeyMusicAPIUserAgent          SettingKey = "music.api.user_agent"
+	KeyMusicAPIHeaders            SettingKey = "music.api.headers"
+	KeyMusicSourceNeteaseEnable   SettingKey = "music.source.netease.enable"
+	KeyMusicSourceQQEnable        SettingKey = "music.source.qq.enable"
+	KeyMusicSourceQQPlaylistID    SettingKey = "music.source.qq.playlist_id"
+	KeyMusicSourceQQAPIURL        SettingKey = "music.source.qq.api_url"
+	KeyMusicSourceCustomEnable    SettingKey = "music.source.custom.enable"
+	KeyMusicSourceLocalEnable     SettingKey = "music.source.local.enable"
+	KeyMusicSourceLocalFolder     SettingKey = "music.source.local.folder"
 	KeyMusicVinylBackground       SettingKey = "music.vinyl.background"
 	KeyMusicVinylOuter            SettingKey = "music.vinyl.outer"
 	KeyMusicVinylInner            SettingKey = "music.vinyl.inner"
@@ -388,8 +473,113 @@ const (
 	KeyAlbumPageDefaultBigParam      SettingKey = "album.default_big_param"
 	KeyAlbumPageAboutLink            SettingKey = "album.about_link"
 
+	// --- 隐私与数据保留配置 ---
+	KeyPrivacyVisitorIPRetentionDays SettingKey = "privacy.visitor_ip_retention_days" // 访问日志 IP 保留天数，超过后截断为网段，0 表示不处理
+
+	// --- 只读模式配置 ---
+	KeyReadOnlyModeEnable  SettingKey = "read_only.enable"  // 是否开启站点只读模式（迁移或恢复数据库时使用）
+	KeyReadOnlyModeMessage SettingKey = "read_only.message" // 只读模式下写操作返回的提示信息
+
+	// --- 说说配置 ---
+	KeyMomentsIncludeInRSS SettingKey = "moments.include_in_rss" // 是否在 RSS 订阅中包含公开的说说
+
+	// --- RSS 订阅配置 ---
+	KeyRSSItemCount        SettingKey = "rss.item_count"         // 文章 RSS 订阅输出的条目数
+	KeyRSSCommentItemCount SettingKey = "rss.comment_item_count" // 评论 Atom 订阅输出的条目数
+	KeyRSSFullText         SettingKey = "rss.full_text"          // 文章 RSS 是否输出全文（否则仅输出摘要）
+
+	// --- 外部平台资料聚合配置 ---
+	KeyProfileBilibiliUID    SettingKey = "profile.bilibili.uid"    // Bilibili 用户 UID，为空则不启用
+	KeyProfileDoubanUserID   SettingKey = "profile.douban.user_id"  // 豆瓣用户 ID，为空则不启用
+	KeyProfileSteamID        SettingKey = "profile.steam.steam_id"  // Steam 64 位 ID，为空则不启用
+	KeyProfileSteamAPIKey    SettingKey = "profile.steam.api_key"   // Steam Web API Key（仅服务端使用）
+	KeyProfileRefreshMinutes SettingKey = "profile.refresh_minutes" // 外部资料刷新间隔（分钟），拉取失败时继续返回旧数据
+
+	// --- 天气代理配置 ---
+	KeyWeatherProvider       SettingKey = "weather.provider"        // 天气服务商：qweather / openweather，为空则不启用
+	KeyWeatherQWeatherKey    SettingKey = "weather.qweather.key"    // 和风天气 API Key（仅服务端使用）
+	KeyWeatherQWeatherHost   SettingKey = "weather.qweather.host"   // 和风天气 API Host
+	KeyWeatherOpenWeatherKey SettingKey = "weather.openweather.key" // OpenWeather API Key（仅服务端使用）
+	KeyWeatherCacheMinutes   SettingKey = "weather.cache_minutes"   // 同一位置天气数据的缓存时长（分钟）
+
+	// --- 文章语音朗读（TTS）配置 ---
+	KeyTTSProvider SettingKey = "tts.provider"  // 语音合成服务商：openai / azure / edge，为空则不生成文章音频
+	KeyTTSAPIURL   SettingKey = "tts.api_url"   // 服务地址：OpenAI 兼容接口的 Base URL，或 Azure 语音服务的区域（如 eastasia）
+	KeyTTSAPIKey   SettingKey = "tts.api_key"   // 语音合成 API Key（仅服务端使用）
+	KeyTTSModel    SettingKey = "tts.model"     // OpenAI 兼容接口使用的模型
+	KeyTTSVoice    SettingKey = "tts.voice"     // 发音人，为空时使用服务商默认值
+	KeyTTSMaxChars SettingKey = "tts.max_chars" // 单篇文章参与朗读的最大字符数，超出部分截断
+
+	// --- 通知投递队列配置 ---
+	KeyNotificationDeliveryMaxAttempts   SettingKey = "notification_delivery.max_attempts"   // 即时推送与通知邮件的最大尝试次数
+	KeyNotificationDeliveryConcurrency   SettingKey = "notification_delivery.concurrency"    // 各投递渠道的并发发送数（JSON）
+	KeyNotificationDeliveryRetentionDays SettingKey = "notification_delivery.retention_days" // 已结束投递记录的保留天数
+
+	// --- 密码策略配置 ---
+	KeyPasswordMinLength     SettingKey = "password_policy.min_length"        // 密码最小长度（不小于 6）
+	KeyPasswordRequireUpper  SettingKey = "password_policy.require_uppercase" // 是否要求包含大写字母
+	KeyPasswordRequireLower  SettingKey = "password_policy.require_lowercase" // 是否要求包含小写字母
+	KeyPasswordRequireDigit  SettingKey = "password_policy.require_digit"     // 是否要求包含数字
+	KeyPasswordRequireSymbol SettingKey = "password_policy.require_symbol"    // 是否要求包含特殊字符
+	KeyPasswordBlockCommon   SettingKey = "password_policy.block_common"      // 是否禁止使用常见弱密码
+	KeyPasswordBannedList    SettingKey = "password_policy.banned_list"       // 额外禁用的密码，逗号分隔
+	KeyPasswordBreachCheck   SettingKey = "password_policy.breach_check"      // 是否通过 HIBP 检查密码是否已泄露
+
+	// --- 站点地图配置 ---
+	KeySitemapPingURLs SettingKey = "sitemap.ping_urls" // 文章发布后通知的站点地图提交地址，逗号分隔，{sitemap} 替换为站点地图 URL
+
+	// --- 缓存预热配置 ---
+	KeyCacheWarmupEnable SettingKey = "cache_warmup.enable" // 是否定时及在缓存清除后预热首页、归档、RSS 与热门文章
+	KeyCacheWarmupTopN   SettingKey = "cache_warmup.top_n"  // 预热的热门文章数量（按浏览量）
+
+	// --- 后台任务协程池配置 ---
+	KeyWorkerPools SettingKey = "worker_pool.categories" // 各类后台任务的并发数、队列长度与溢出策略（JSON，重启后生效）
+
+	// --- 慢查询记录配置 ---
+	KeySlowQueryThresholdMs SettingKey = "slow_query.threshold_ms" // 记录执行时间超过该毫秒数的 SQL，0 表示关闭
+
+	// --- 日志配置 ---
+	KeyLogLevel SettingKey = "log.level" // 日志级别：debug / info / warn / error，修改后立即生效
+
+	// --- WebDAV 配置 ---
+	KeyWebDAVEnable SettingKey = "webdav.enable" // 是否开放 /dav/ 下的 WebDAV 文件访问
+
+	// --- 文章正文压缩配置 ---
+	KeyArticleContentCompression SettingKey = "article.content_compression" // 写入文章正文时是否使用 zstd 压缩
+
+	// --- 数据库维护配置 ---
+	KeyDBMaintenanceEnable                  SettingKey = "db_maintenance.enable"                     // 是否在维护时段内自动执行数据库维护
+	KeyDBMaintenanceWindow                  SettingKey = "db_maintenance.window"                     // 维护时段（HH:MM-HH:MM，服务器本地时间）
+	KeyDBMaintenanceSoftDeleteRetentionDays SettingKey = "db_maintenance.soft_delete_retention_days" // 软删除数据的保留天数，过期后永久删除，0 表示不清理
+
+	// --- 公开统计挂件配置 ---
+	KeyWidgetCORSAllowedOrigins SettingKey = "widget.cors_allowed_origins" // 允许跨域嵌入统计挂件的来源，逗号分隔，* 表示任意来源
+
+	// --- 公开接口跨域配置 ---
+	KeyPublicAPICORSAllowedOrigins   SettingKey = "public_api.cors_allowed_origins"   // 允许跨域访问 /api/public/* 与搜索接口的来源，逗号分隔，* 表示任意来源
+	KeyPublicAPICORSAllowedMethods   SettingKey = "public_api.cors_allowed_methods"   // 跨域允许的请求方法
+	KeyPublicAPICORSAllowedHeaders   SettingKey = "public_api.cors_allowed_headers"   // 跨域允许的请求头
+	KeyPublicAPICORSAllowCredentials SettingKey = "public_api.cors_allow_credentials" // 跨域请求是否允许携带 Cookie 与认证信息
+
+	// --- 管理接口 IP 白名单 ---
+	KeyAdminIPAllowlist SettingKey = "admin.ip_allowlist" // 允许访问管理接口的 IP 或 CIDR，逗号分隔，留空则不限制
+
+	// --- 页面缓存时间配置 ---
+	KeyCacheTTLArticle SettingKey = "cache_ttl.article" // 文章详情页及文章接口的缓存时间（JSON）
+	KeyCacheTTLHome    SettingKey = "cache_ttl.home"    // 首页及首页文章列表接口的缓存时间（JSON）
+	KeyCacheTTLStatic  SettingKey = "cache_ttl.static"  // 自定义页面及页面接口的缓存时间（JSON）
+	KeyCacheTTLDefault SettingKey = "cache_ttl.default" // 其他前台页面的缓存时间（JSON）
+
+	// --- 登录防暴力破解 ---
+	KeyLoginGuardEnable         SettingKey = "login_guard.enable"          // 是否启用登录失败锁定
+	KeyLoginGuardMaxAttempts    SettingKey = "login_guard.max_attempts"    // 统计窗口内允许的最大失败次数（按账号与 IP 分别统计）
+	KeyLoginGuardWindowMinutes  SettingKey = "login_guard.window_minutes"  // 失败次数的统计窗口（分钟）
+	KeyLoginGuardLockoutMinutes SettingKey = "login_guard.lockout_minutes" // 达到上限后的锁定时长（分钟）
+	KeyLoginGuardNotifyEmail    SettingKey = "login_guard.notify_email"    // 账号被锁定时是否邮件通知用户
+
 	// --- 人机验证配置 ---
-	KeyCaptchaProvider SettingKey = "captcha.provider" // 人机验证方式：turnstile / geetest / image / none
+	KeyCaptchaProvider SettingKey = "captcha.provider" // 人机验证方式：turnstile / hcaptcha / recaptcha / geetest / image / none
+	KeyCaptchaScenes   SettingKey = "captcha.scenes"   // 需要人机验证的表单，逗号分隔：login / register / forgot_password / subscribe / comment / link_apply
 
 	// --- 微信分享配置 ---
 	KeyWechatShareEnable    SettingKey = "wechat.share.enable"     // 是否启用微信分享功能
@@ -401,6 +591,15 @@ const (
 	KeyTurnstileSiteKey   SettingKey = "turnstile.site_key"   // Turnstile Site Key（公钥，前端使用）
 	KeyTurnstileSecretKey SettingKey = "turnstile.secret_key" // Turnstile Secret Key（私钥，后端验证使用）
 
+	// --- hCaptcha 人机验证配置 ---
+	KeyHCaptchaSiteKey   SettingKey = "hcaptcha.site_key"   // hCaptcha Site Key（公钥，前端使用）
+	KeyHCaptchaSecretKey SettingKey = "hcaptcha.secret_key" // hCaptcha Secret Key（私钥，后端验证使用）
+
+	// --- Google reCAPTCHA 人机验证配置 ---
+	KeyRecaptchaSiteKey   SettingKey = "recaptcha.site_key"   // reCAPTCHA Site Key（公钥，前端使用）
+	KeyRecaptchaSecretKey SettingKey = "recaptcha.secret_key" // reCAPTCHA Secret Key（私钥，后端验证使用）
+	KeyRecaptchaMinScore  SettingKey = "recaptcha.min_score"  // reCAPTCHA v3 的最低得分（0~1），v2 不返回得分时不检查
+
 	// --- 极验 GeeTest 4.0 人机验证配置 ---
 	KeyGeetestCaptchaId  SettingKey = "geetest.captcha_id"  // 极验验证 ID（公钥，前端使用）
 	KeyGeetestCaptchaKey SettingKey = "geetest.captcha_key" // 极验验证 Key（私钥，后端验证使用）
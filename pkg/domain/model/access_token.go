@@ -0,0 +1,139 @@
+/*
+ * @Description: 个人访问令牌领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-17 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// 访问令牌的权限范围，与 Micropub 规范中的 scope 保持一致
+const (
+	TokenScopeCreate = "create"
+	TokenScopeUpdate = "update"
+	TokenScopeDelete = "delete"
+	TokenScopeMedia  = "media"
+)
+
+// 通用 API 令牌的权限范围，令牌可代替 JWT 调用站点接口
+const (
+	// TokenScopeRead 只读：仅允许 GET/HEAD/OPTIONS 请求
+	TokenScopeRead = "read"
+	// TokenScopeContentWrite 内容读写：包含只读权限，写请求仅限 TokenContentWriteRoutes 中的内容接口
+	TokenScopeContentWrite = "content_write"
+	// TokenScopeFileUpload 文件上传：允许上传接口，以及 WebDAV 中写入、删除、移动等修改文件的请求
+	TokenScopeFileUpload = "file_upload"
+)
+
+// TokenContentWriteRoutes 是 content_write 令牌允许写入的内容接口路由前缀，
+// 用户、用户组、站点配置、存储策略等管理接口不在其中，访问令牌只能读取
+var TokenContentWriteRoutes = []string{
+	"/api/articles",
+	"/api/article-templates",
+	"/api/content-snippets",
+	"/api/article-collections",
+	"/api/post-tags",
+	"/api/post-categories",
+	"/api/doc-series",
+	"/api/pages",
+	"/api/moments",
+}
+
+// IsTokenContentWriteRoute 判断路由是否属于 content_write 令牌可写入的内容接口
+func IsTokenContentWriteRoute(route string) bool {
+	return matchRoutePrefix(route, TokenContentWriteRoutes)
+}
+
+// TokenAdminRoutePrefixes 是访问令牌默认不能访问的管理类接口前缀，
+// 配置导出等接口会返回 JWT 密钥，只读令牌也不能放行
+var TokenAdminRoutePrefixes = []string{
+	"/api/admin",
+	"/api/config",
+}
+
+// TokenAdminReadRoutes 是管理类接口中明确允许访问令牌只读访问的路由
+var TokenAdminReadRoutes = []string{
+	"/api/admin/media",
+}
+
+// IsTokenAdminRoute 判断路由是否属于访问令牌默认拒绝的管理类接口
+func IsTokenAdminRoute(route string) bool {
+	return matchRoutePrefix(route, TokenAdminRoutePrefixes)
+}
+
+// IsTokenAdminReadRoute 判断管理类路由是否在访问令牌的只读白名单中
+func IsTokenAdminReadRoute(route string) bool {
+	return matchRoutePrefix(route, TokenAdminReadRoutes)
+}
+
+func matchRoutePrefix(route string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if route == prefix || strings.HasPrefix(route, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// AccessToken 是个人访问令牌的领域模型（不含明文与哈希）
+type AccessToken struct {
+	ID          uint       `json:"id"`
+	UserID      uint       `json:"-"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	// Owner 令牌所属用户，仅在管理员查看全部令牌时返回
+	Owner *AccessTokenOwner `json:"owner,omitempty"`
+}
+
+// AccessTokenOwner 是管理员令牌列表中展示的所属用户信息
+type AccessTokenOwner struct {
+	ID       string `json:"id"`
+	Nickname string `json:"nickname"`
+	Email    string `json:"email"`
+}
+
+// HasScope 判断令牌是否拥有指定权限
+func (t *AccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsAPI 判断令牌能否用于指定的站点接口请求：上传接口需要 file_upload，
+// 只读请求需要 read 或 content_write，其余请求需要 content_write
+func (t *AccessToken) AllowsAPI(method string, upload bool) bool {
+	if upload {
+		return t.HasScope(TokenScopeFileUpload)
+	}
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return t.HasScope(TokenScopeRead) || t.HasScope(TokenScopeContentWrite)
+	default:
+		return t.HasScope(TokenScopeContentWrite)
+	}
+}
+
+// CreateAccessTokenRequest 定义了创建访问令牌的请求体
+type CreateAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=64"`
+	Scopes        []string `json:"scopes" binding:"omitempty,dive,oneof=create update delete media read content_write file_upload"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"min=0,max=3650"` // 0 表示永不过期
+}
+
+// CreatedAccessTokenResponse 创建令牌的响应，明文令牌仅在此时返回一次
+type CreatedAccessTokenResponse struct {
+	*AccessToken
+	Token string `json:"token"`
+}
@@ -7,7 +7,10 @@
  */
 package model
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // --- 文章扩展配置 (Extra Config) ---
 
@@ -16,6 +19,11 @@ import "time"
 type ArticleExtraConfig struct {
 	EnableAIPodcast *bool   `json:"enable_ai_podcast,omitempty"` // AI播客开关
 	CustomJS        *string `json:"custom_js,omitempty"`         // 单文章自定义 JS（仅管理员）
+	// 社交分享卡片覆盖配置，未设置时使用文章标题、摘要与封面
+	OGTitle       *string `json:"og_title,omitempty"`       // 分享卡片标题
+	OGDescription *string `json:"og_description,omitempty"` // 分享卡片描述
+	OGImage       *string `json:"og_image,omitempty"`       // 分享卡片图片
+	TwitterCard   *string `json:"twitter_card,omitempty"`   // Twitter 卡片类型：summary / summary_large_image
 	// 未来可扩展更多配置...
 }
 
@@ -52,12 +60,13 @@ type Article struct {
 	CopyrightAuthorHref  string
 	CopyrightURL         string
 	Keywords             string
+	LinkURL              string // 链接文章指向的外部 URL，非空即为链接文章
 
 	// --- 定时发布相关字段 ---
 	ScheduledAt *time.Time // 定时发布时间，当状态为SCHEDULED时有效
 
 	// --- 审核相关字段（多人共创功能） ---
-	ReviewStatus  string     // 审核状态：NONE-无需审核, PENDING-待审核, APPROVED-已通过, REJECTED-已拒绝
+	ReviewStatus  string     // 审核状态：NONE-无需审核, PENDING-待审核, CHANGES_REQUESTED-需修改, APPROVED-已通过, REJECTED-已拒绝
 	ReviewComment string     // 审核意见
 	ReviewedAt    *time.Time // 审核时间
 	ReviewedBy    *uint      // 审核人ID
@@ -78,6 +87,25 @@ type Article struct {
 	DocSeries   *DocSeries // 关联的文档系列信息
 }
 
+// IsEmbargoed 判断文章是否不应出现在 RSS、站点地图等公开聚合输出中：
+// 未发布、定时发布时间未到、发布时间在 now 之后、已下架或未通过审核的文章都视为禁止公开。
+func (a *Article) IsEmbargoed(now time.Time) bool {
+	if a.Status != "PUBLISHED" || a.IsTakedown {
+		return true
+	}
+	if a.ScheduledAt != nil && a.ScheduledAt.After(now) {
+		return true
+	}
+	if a.CreatedAt.After(now) {
+		return true
+	}
+	return a.ReviewStatus != "" && a.ReviewStatus != "NONE" && a.ReviewStatus != "APPROVED"
+}
+
+// ArticleRestrictionChecker 判断文章是否为受限内容（如密码保护、会员专享，由 PRO 版注入），
+// 受限文章不会出现在 RSS 与站点地图中。
+type ArticleRestrictionChecker func(ctx context.Context, a *Article) bool
+
 // --- API 数据传输对象 (Data Transfer Objects) ---
 
 // CreateArticleRequest 定义了创建文章的请求体
@@ -107,6 +135,7 @@ type CreateArticleRequest struct {
 	CustomPublishedAt    *string             `json:"custom_published_at,omitempty"`
 	CustomUpdatedAt      *string             `json:"custom_updated_at,omitempty"`
 	Keywords             string              `json:"keywords,omitempty"`
+	LinkURL              string              `json:"link_url,omitempty"`      // 链接文章指向的外部 URL
 	OwnerID              uint                `json:"owner_id,omitempty"`      // 文章作者ID（多人共创功能）
 	ReviewStatus         string              `json:"review_status,omitempty"` // 审核状态（多人共创功能）
 	ExtraConfig          *ArticleExtraConfig `json:"extra_config,omitempty"`  // 文章扩展配置
@@ -116,6 +145,8 @@ type CreateArticleRequest struct {
 	IsDoc       bool   `json:"is_doc,omitempty"`        // 是否为文档模式
 	DocSeriesID string `json:"doc_series_id,omitempty"` // 文档系列ID (公共ID)
 	DocSort     int    `json:"doc_sort,omitempty"`      // 文档在系列中的排序
+	// SuppressNotifications 为 true 时发布后不通知订阅者、不推送站点地图，供批量迁移等内部调用使用
+	SuppressNotifications bool `json:"-"`
 }
 
 // UpdateArticleRequest 定义了更新文章的请求体
@@ -145,6 +176,7 @@ type UpdateArticleRequest struct {
 	CustomPublishedAt    *string             `json:"custom_published_at,omitempty"`
 	CustomUpdatedAt      *string             `json:"custom_updated_at,omitempty"`
 	Keywords             *string             `json:"keywords"`
+	LinkURL              *string             `json:"link_url"`                // 链接文章指向的外部 URL，设为空字符串则转为普通文章
 	ReviewStatus         *string             `json:"review_status,omitempty"` // 审核状态（多人共创功能）
 	ExtraConfig          *ArticleExtraConfig `json:"extra_config,omitempty"`  // 文章扩展配置
 	// 定时发布相关字段
@@ -153,6 +185,8 @@ type UpdateArticleRequest struct {
 	IsDoc       *bool   `json:"is_doc,omitempty"`        // 是否为文档模式
 	DocSeriesID *string `json:"doc_series_id,omitempty"` // 文档系列ID (公共ID)
 	DocSort     *int    `json:"doc_sort,omitempty"`      // 文档在系列中的排序
+	// ChangeNote 本次保存的变更说明，记录到历史版本中
+	ChangeNote *string `json:"change_note,omitempty"`
 }
 
 // ArticleResponse 定义了文章信息的标准 API 响应结构
@@ -185,7 +219,13 @@ type ArticleResponse struct {
 	CopyrightAuthorHref  string                  `json:"copyright_author_href"`
 	CopyrightURL         string                  `json:"copyright_url"`
 	Keywords             string                  `json:"keywords"`
+	LinkURL              string                  `json:"link_url,omitempty"` // 链接文章指向的外部 URL
+	IsLinkPost           bool                    `json:"is_link_post"`       // 是否为链接文章
 	CommentCount         int                     `json:"comment_count"`
+	Reactions            map[string]int          `json:"reactions,omitempty"` // 各类表情回应的数量
+	// 评论统计（仅后台文章列表返回）
+	PendingCommentCount int        `json:"pending_comment_count,omitempty"` // 待审核评论数
+	LastCommentAt       *time.Time `json:"last_comment_at,omitempty"`       // 最近一条评论时间
 	// 定时发布相关字段
 	ScheduledAt *time.Time `json:"scheduled_at,omitempty"` // 定时发布时间，当状态为SCHEDULED时有效
 	// 审核状态（多人共创功能）
@@ -228,6 +268,7 @@ type ArticleDetailResponse struct {
 	PrevArticle     *SimpleArticleResponse   `json:"prev_article"`
 	NextArticle     *SimpleArticleResponse   `json:"next_article"`
 	RelatedArticles []*SimpleArticleResponse `json:"related_articles"`
+	AudioURL        string                   `json:"audio_url,omitempty"` // 文章语音朗读音频地址，未生成时为空
 }
 
 // ArticleListResponse 定义了文章列表的 API 响应结构
@@ -247,6 +288,9 @@ type ListArticlesOptions struct {
 	AuthorID     *uint  // 按作者ID过滤（多人共创功能：普通用户只能查看自己的文章）
 	CategoryName string // 按分类名称过滤
 	TagName      string // 按标签名称过滤
+	Scheduled    bool   // 只列出设置了定时发布时间的文章，按定时发布时间升序
+	ReviewStatus string // 按审核状态过滤（投稿审核功能）
+	Reviewable   bool   // 只列出进入过审核流程的文章（审核状态不为 NONE）
 }
 
 type ListPublicArticlesOptions struct {
@@ -273,6 +317,7 @@ type ArticleStatistics struct {
 	CategoryStats  []CategoryStatItem  `json:"category_stats"`   // 分类统计
 	TagStats       []TagStatItem       `json:"tag_stats"`        // 标签统计
 	TopViewedPosts []TopViewedPostItem `json:"top_viewed_posts"` // 热门文章
+	TopLovedPosts  []TopLovedPostItem  `json:"top_loved_posts"`  // 表情回应最多的文章
 	PublishTrend   []PublishTrendItem  `json:"publish_trend"`    // 发布趋势
 }
 
@@ -296,6 +341,15 @@ type TopViewedPostItem struct {
 	CoverURL string `json:"cover_url"` // 封面图
 }
 
+// TopLovedPostItem 表情回应最多的文章项
+type TopLovedPostItem struct {
+	ID        string         `json:"id"`        // 文章ID
+	Title     string         `json:"title"`     // 文章标题
+	CoverURL  string         `json:"cover_url"` // 封面图
+	Total     int            `json:"total"`     // 回应总数
+	Reactions map[string]int `json:"reactions"` // 各类回应的数量
+}
+
 // PublishTrendItem 发布趋势项
 type PublishTrendItem struct {
 	Month string `json:"month"` // 月份 (格式: "2025-01")
@@ -340,6 +394,7 @@ type CreateArticleParams struct {
 	CustomPublishedAt    *time.Time
 	CustomUpdatedAt      *time.Time
 	Keywords             string
+	LinkURL              string              // 链接文章指向的外部 URL
 	ReviewStatus         string              // 审核状态（多人共创功能）：NONE-无需审核, PENDING-待审核
 	ExtraConfig          *ArticleExtraConfig // 文章扩展配置
 	// 定时发布相关字段
@@ -0,0 +1,33 @@
+/*
+ * @Description: 文章语音朗读版本领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// 文章语音的生成状态
+const (
+	ArticleAudioPending = "PENDING"
+	ArticleAudioReady   = "READY"
+	ArticleAudioFailed  = "FAILED"
+)
+
+// ArticleAudio 是文章通过 TTS 生成的语音朗读版本，每篇文章至多一条
+type ArticleAudio struct {
+	ID           uint      `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	ArticleID    uint      `json:"-"`
+	Status       string    `json:"status"`
+	Provider     string    `json:"provider"`
+	Voice        string    `json:"voice"`
+	ContentHash  string    `json:"-"`
+	FilePublicID string    `json:"file_id,omitempty"`
+	URL          string    `json:"url,omitempty"`
+	CharCount    int       `json:"char_count"`
+	ErrorMessage string    `json:"error_message,omitempty"`
+}
@@ -0,0 +1,41 @@
+/*
+ * @Description: 文章合集领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 01:00:00
+ * @LastEditTime: 2026-10-18 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// ArticleCollection 是文章合集的核心领域模型，保存按展示顺序排列的文章
+type ArticleCollection struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	ArticleIDs  []string  `json:"article_ids"`
+	IsPublished bool      `json:"is_published"`
+	Sort        int       `json:"sort"`
+}
+
+// SaveArticleCollectionRequest 定义了创建/更新文章合集的请求体（更新时为整体替换）
+type SaveArticleCollectionRequest struct {
+	Name        string   `json:"name" binding:"required,max=100"`
+	Slug        string   `json:"slug" binding:"required,max=64"`
+	Description string   `json:"description"`
+	ArticleIDs  []string `json:"article_ids"`
+	IsPublished *bool    `json:"is_published"`
+	Sort        int      `json:"sort" binding:"min=0"`
+}
+
+// PublicArticleCollection 是前台读取的文章合集，只包含已发布且可公开访问的文章
+type PublicArticleCollection struct {
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug"`
+	Description string            `json:"description"`
+	Articles    []ArticleResponse `json:"articles"`
+}
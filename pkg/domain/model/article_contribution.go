@@ -0,0 +1,75 @@
+/*
+ * @Description: 文章投稿与审核流程的领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 04:00:00
+ * @LastEditTime: 2026-10-18 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// 文章审核状态
+const (
+	ReviewStatusNone             = "NONE"              // 无需审核（管理员发布的文章）
+	ReviewStatusPending          = "PENDING"           // 待审核
+	ReviewStatusChangesRequested = "CHANGES_REQUESTED" // 需修改后重新提交
+	ReviewStatusApproved         = "APPROVED"          // 已通过并发布
+	ReviewStatusRejected         = "REJECTED"          // 已拒绝
+)
+
+// 投稿审核记录的操作类型
+const (
+	ContributionActionSubmit         = "submit"          // 投稿者提交或重新提交
+	ContributionActionComment        = "comment"         // 管理员发表审核意见，不改变审核状态
+	ContributionActionRequestChanges = "request_changes" // 管理员要求修改
+	ContributionActionApprove        = "approve"         // 管理员通过并发布
+	ContributionActionReject         = "reject"          // 管理员拒绝
+)
+
+// ArticleReviewNote 是投稿的一条审核记录
+type ArticleReviewNote struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ArticleID uint      `json:"-"`
+	UserID    uint      `json:"-"`
+	Action    string    `json:"action"`
+	Content   string    `json:"content"`
+	// 以下字段由服务层填充
+	UserNickname string `json:"user_nickname,omitempty"`
+}
+
+// ContributionReviewResult 是写入文章的审核结果
+type ContributionReviewResult struct {
+	Status     string
+	Comment    string
+	ReviewerID uint
+	ReviewedAt time.Time
+}
+
+// SubmitContributionRequest 是投稿者提交或修改投稿的请求体，只包含投稿者可以设置的字段
+type SubmitContributionRequest struct {
+	Title           string   `json:"title" binding:"required"`
+	ContentMd       string   `json:"content_md"`
+	ContentHTML     string   `json:"content_html"`
+	CoverURL        string   `json:"cover_url"`
+	PostTagIDs      []string `json:"post_tag_ids"`
+	PostCategoryIDs []string `json:"post_category_ids"`
+	Summaries       []string `json:"summaries"`
+	Keywords        string   `json:"keywords"`
+	Note            string   `json:"note"` // 提交说明，记录到审核记录中
+}
+
+// ReviewContributionRequest 是管理员处理投稿的请求体
+type ReviewContributionRequest struct {
+	Content string `json:"content"`
+}
+
+// ContributionDetail 是投稿详情，包含文章与完整的审核记录
+type ContributionDetail struct {
+	Article       *ArticleResponse     `json:"article"`
+	ReviewStatus  string               `json:"review_status"`
+	ReviewComment string               `json:"review_comment"`
+	ReviewedAt    *time.Time           `json:"reviewed_at,omitempty"`
+	Notes         []*ArticleReviewNote `json:"notes"`
+}
@@ -81,3 +81,34 @@ type RestoreHistoryRequest struct {
 type ArticleHistoryCountResponse struct {
 	Count int `json:"count"`
 }
+
+// ArticleRevisionFieldChange 版本间发生变化的字段，正文类字段只标记变化不返回内容
+type ArticleRevisionFieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old,omitempty"`
+	New   interface{} `json:"new,omitempty"`
+}
+
+// ArticleRevisionDiffLine 正文差异中的一行
+type ArticleRevisionDiffLine struct {
+	Op   string `json:"op"` // equal / insert / delete
+	Text string `json:"text"`
+}
+
+// ArticleRevisionDiffHunk 正文差异片段，行号从1开始
+type ArticleRevisionDiffHunk struct {
+	OldStart int                       `json:"old_start"`
+	OldLines int                       `json:"old_lines"`
+	NewStart int                       `json:"new_start"`
+	NewLines int                       `json:"new_lines"`
+	Lines    []ArticleRevisionDiffLine `json:"lines"`
+}
+
+// ArticleRevisionDiffResponse 两个历史版本的差异
+type ArticleRevisionDiffResponse struct {
+	From   int                          `json:"from"`
+	To     int                          `json:"to"`
+	Fields []ArticleRevisionFieldChange `json:"fields"`
+	Hunks  []ArticleRevisionDiffHunk    `json:"hunks"`
+	Coarse bool                         `json:"coarse"` // 正文过大时退化为整段替换
+}
@@ -0,0 +1,81 @@
+/*
+ * @Description: 文章模板与内容片段领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// --- 核心领域对象 (Domain Object) ---
+
+// ArticleTemplate 是文章模板的核心领域模型，保存新草稿的默认值
+type ArticleTemplate struct {
+	ID                  string    `json:"id"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+	Name                string    `json:"name"`
+	Description         string    `json:"description"`
+	Sort                int       `json:"sort"`
+	Title               string    `json:"title"`
+	ContentMd           string    `json:"content_md"`
+	Summaries           []string  `json:"summaries"`
+	CoverURL            string    `json:"cover_url"`
+	TopImgURL           string    `json:"top_img_url"`
+	PostTagIDs          []string  `json:"post_tag_ids"`
+	PostCategoryIDs     []string  `json:"post_category_ids"`
+	Keywords            string    `json:"keywords"`
+	Copyright           bool      `json:"copyright"`
+	IsReprint           bool      `json:"is_reprint"`
+	CopyrightAuthor     string    `json:"copyright_author"`
+	CopyrightAuthorHref string    `json:"copyright_author_href"`
+	CopyrightURL        string    `json:"copyright_url"`
+}
+
+// ContentSnippet 是编辑器可插入的可复用内容片段
+type ContentSnippet struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Content     string    `json:"content"`
+	Sort        int       `json:"sort"`
+}
+
+// --- API 数据传输对象 (Data Transfer Objects) ---
+
+// SaveArticleTemplateRequest 定义了创建/更新文章模板的请求体（更新时为整体替换）
+type SaveArticleTemplateRequest struct {
+	Name                string   `json:"name" binding:"required"`
+	Description         string   `json:"description"`
+	Sort                int      `json:"sort" binding:"min=0"`
+	Title               string   `json:"title"`
+	ContentMd           string   `json:"content_md"`
+	Summaries           []string `json:"summaries"`
+	CoverURL            string   `json:"cover_url"`
+	TopImgURL           string   `json:"top_img_url"`
+	PostTagIDs          []string `json:"post_tag_ids"`
+	PostCategoryIDs     []string `json:"post_category_ids"`
+	Keywords            string   `json:"keywords"`
+	Copyright           *bool    `json:"copyright"`
+	IsReprint           bool     `json:"is_reprint"`
+	CopyrightAuthor     string   `json:"copyright_author"`
+	CopyrightAuthorHref string   `json:"copyright_author_href"`
+	CopyrightURL        string   `json:"copyright_url"`
+}
+
+// SaveContentSnippetRequest 定义了创建/更新内容片段的请求体
+type SaveContentSnippetRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	Content     string `json:"content" binding:"required"`
+	Sort        int    `json:"sort" binding:"min=0"`
+}
+
+// InstantiateTemplateRequest 定义了从模板创建草稿的请求体，非空字段会覆盖模板中的值
+type InstantiateTemplateRequest struct {
+	Title string `json:"title"`
+}
@@ -0,0 +1,86 @@
+/*
+ * @Description: 审计日志领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-17 23:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 审计日志的操作类型
+const (
+	AuditActionImpersonationStart   = "impersonation.start"   // 管理员签发模拟登录令牌
+	AuditActionImpersonationRequest = "impersonation.request" // 使用模拟登录令牌发起的请求
+
+	AuditActionSettingsUpdate  = "settings.update"       // 修改站点设置
+	AuditActionArticleCreate   = "article.create"        // 创建文章
+	AuditActionArticleUpdate   = "article.update"        // 修改文章
+	AuditActionArticleDelete   = "article.delete"        // 删除文章
+	AuditActionCommentModerate = "comment.moderate"      // 审核、置顶或编辑评论
+	AuditActionCommentDelete   = "comment.delete"        // 删除评论
+	AuditActionPolicyCreate    = "storage_policy.create" // 创建存储策略
+	AuditActionPolicyUpdate    = "storage_policy.update" // 修改存储策略
+	AuditActionPolicyDelete    = "storage_policy.delete" // 删除存储策略
+	AuditActionUserCreate      = "user.create"           // 管理员创建用户
+	AuditActionUserUpdate      = "user.update"           // 管理员修改用户信息、状态或密码
+	AuditActionUserDelete      = "user.delete"           // 管理员删除用户
+	AuditActionUserGroupUpdate = "user_group.update"     // 修改用户组权限或上传限制
+
+	AuditActionLoginFailed  = "auth.login_failed"  // 登录失败（账号或密码错误）
+	AuditActionLoginLockout = "auth.login_lockout" // 登录失败次数过多，账号或 IP 被暂时锁定
+)
+
+// 审计日志记录的对象类型
+const (
+	AuditEntitySetting       = "setting"
+	AuditEntityArticle       = "article"
+	AuditEntityComment       = "comment"
+	AuditEntityStoragePolicy = "storage_policy"
+	AuditEntityUser          = "user"
+	AuditEntityUserGroup     = "user_group"
+)
+
+// AuditLog 是审计日志的领域模型
+type AuditLog struct {
+	ID            uint      `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	ActorID       uint      `json:"-"`
+	SubjectUserID uint      `json:"-"`
+	Action        string    `json:"action"`
+	EntityType    string    `json:"entity_type,omitempty"`
+	EntityID      string    `json:"entity_id,omitempty"`
+	Method        string    `json:"method,omitempty"`
+	Path          string    `json:"path,omitempty"`
+	StatusCode    int       `json:"status_code,omitempty"`
+	IP            string    `json:"ip,omitempty"`
+	UserAgent     string    `json:"user_agent,omitempty"`
+	Detail        string    `json:"detail,omitempty"`
+	Before        string    `json:"-"`
+	After         string    `json:"-"`
+
+	// 变更前后的快照，响应时由 Before/After 解析得到
+	BeforeData json.RawMessage `json:"before,omitempty"`
+	AfterData  json.RawMessage `json:"after,omitempty"`
+
+	// 以下为响应时填充的公共ID
+	ActorPublicID   string `json:"actor_id"`
+	SubjectPublicID string `json:"subject_user_id,omitempty"`
+}
+
+// AuditLogQuery 审计日志查询条件
+type AuditLogQuery struct {
+	ActorID       uint
+	SubjectUserID uint
+	Action        string
+	EntityType    string
+	EntityID      string
+	StartTime     *time.Time // 包含
+	EndTime       *time.Time // 不包含
+	Page          int
+	PageSize      int
+}
@@ -0,0 +1,69 @@
+/*
+ * @Description: 更新日志领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// 更新日志来源
+const (
+	// ChangelogSourceGitHub 从 GitHub Releases 同步，后续同步会覆盖内容
+	ChangelogSourceGitHub = "github"
+	// ChangelogSourceManual 手动录入或手动编辑过，同步时不再覆盖
+	ChangelogSourceManual = "manual"
+)
+
+// ChangelogEntry 是更新日志的核心领域模型，每个版本一条
+type ChangelogEntry struct {
+	ID           string    `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Version      string    `json:"version"`
+	Title        string    `json:"title"`
+	Content      string    `json:"content,omitempty"`
+	ContentHTML  string    `json:"content_html"`
+	URL          string    `json:"url"`
+	Source       string    `json:"source"`
+	IsPrerelease bool      `json:"is_prerelease"`
+	IsPublished  bool      `json:"is_published"`
+	PublishedAt  time.Time `json:"published_at"`
+}
+
+// SaveChangelogRequest 定义了创建/更新更新日志的请求体（更新时为整体替换）
+type SaveChangelogRequest struct {
+	Version      string     `json:"version" binding:"required,max=64"`
+	Title        string     `json:"title" binding:"max=255"`
+	Content      string     `json:"content"`
+	URL          string     `json:"url" binding:"max=512"`
+	IsPrerelease bool       `json:"is_prerelease"`
+	IsPublished  *bool      `json:"is_published"`
+	PublishedAt  *time.Time `json:"published_at"`
+}
+
+// ListChangelogOptions 更新日志分页查询选项
+type ListChangelogOptions struct {
+	Page     int
+	PageSize int
+	// PublishedOnly 只返回公开的更新日志
+	PublishedOnly bool
+}
+
+// ChangelogListResponse 是更新日志的分页响应，按发布时间倒序
+type ChangelogListResponse struct {
+	List     []*ChangelogEntry `json:"list"`
+	Total    int64             `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"pageSize"`
+}
+
+// ChangelogSyncResult 是一次 GitHub Releases 同步的结果
+type ChangelogSyncResult struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	// Skipped 未变化、手动编辑过或被过滤的版本数
+	Skipped int `json:"skipped"`
+}
@@ -16,8 +16,16 @@ type Status int
 const (
 	StatusPublished Status = 1 // 已发布
 	StatusPending   Status = 2 // 待审核
+	StatusSpam      Status = 3 // 垃圾评论：被反垃圾检测拦截或由管理员标记，不公开展示
 )
 
+// CommentTargetStats 是某个目标路径下评论的聚合统计，用于后台列表展示。
+type CommentTargetStats struct {
+	PublishedCount int        // 已发布评论数
+	PendingCount   int        // 待审核评论数
+	LastCommentAt  *time.Time // 最近一条评论的时间（含待审核）
+}
+
 // Comment 是评论的核心领域模型。
 // 它已经与任何具体实体（如文章、页面）解耦，通过路径进行关联。
 type Comment struct {
@@ -40,6 +48,8 @@ type Comment struct {
 	Content     string // Markdown 原文
 	ContentHTML string // 渲染后的 HTML
 	LikeCount   int
+	// 各类表情回应的数量（键为回应类型），其中 like 与 LikeCount 一致
+	ReactionCounts map[string]int
 
 	// --- 元数据 ---
 	Status        Status
@@ -50,6 +60,51 @@ type Comment struct {
 	PinnedAt      *time.Time
 }
 
+// 评论与文章支持的表情回应类型
+const (
+	ReactionLike  = "like"  // 👍
+	ReactionLove  = "love"  // ❤️
+	ReactionLaugh = "laugh" // 😂
+	ReactionWow   = "wow"   // 😮
+	ReactionSad   = "sad"   // 😢
+)
+
+// CommentReactions 按展示顺序列出全部表情回应类型
+var CommentReactions = []string{ReactionLike, ReactionLove, ReactionLaugh, ReactionWow, ReactionSad}
+
+// 评论者信任状态
+const (
+	CommenterTrusted = "trusted" // 已信任：后续评论自动发布
+	CommenterRevoked = "revoked" // 管理员撤销信任：后续评论一律进入审核
+)
+
+// CommenterStats 汇总了某位评论者（按邮箱哈希区分）的历史评论情况，用于审核时判断是否可信。
+type CommenterStats struct {
+	EmailMD5       string
+	Nicknames      []string // 曾使用过的昵称，按最近使用排序
+	PublishedCount int
+	PendingCount   int
+	FirstCommentAt *time.Time
+	LastCommentAt  *time.Time
+	IPAddresses    []string // 曾使用过的 IP，按最近使用排序
+	TrustStatus    string   // 信任状态：trusted / revoked，空表示尚无记录
+}
+
+// SpamTokenCount 是贝叶斯反垃圾过滤器中某个词条在垃圾/正常评论样本中出现的次数
+type SpamTokenCount struct {
+	Spam int
+	Ham  int
+}
+
+// CommenterFootprint 汇总了某位评论者公开可见的评论足迹，只统计已发布的非匿名评论。
+type CommenterFootprint struct {
+	EmailMD5       string
+	CommentCount   int
+	FirstCommentAt *time.Time
+	LastCommentAt  *time.Time
+	Recent         []*Comment // 最近的评论，按创建时间降序；有评论时至少包含一条
+}
+
 // Author 代表了评论的作者信息
 type Author struct {
 	Nickname  string
@@ -0,0 +1,22 @@
+/*
+ * @Description: 评论订阅领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 19:00:00
+ * @LastEditTime: 2026-10-16 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// CommentSubscription 是访客对某个评论区的订阅
+type CommentSubscription struct {
+	ID            uint
+	CreatedAt     time.Time
+	TargetPath    string
+	Email         string
+	IsActive      bool
+	NotifiedUntil time.Time  // 摘要只包含此时间之后发布的评论
+	PendingSince  *time.Time // 有未发送的新评论时不为空
+	LastSentAt    *time.Time
+}
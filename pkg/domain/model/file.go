@@ -212,6 +212,8 @@ type CreateFileRequest struct {
 // DeleteItemsRequest 对应删除文件/文件夹的请求体
 type DeleteItemsRequest struct {
 	IDs []string `json:"ids" binding:"required,min=1"`
+	// Permanent 为 true 时跳过回收站直接永久删除，用于无法移入回收站的项目
+	Permanent bool `json:"permanent"`
 }
 
 // RenameItemRequest 对应重命名文件或文件夹的请求体
@@ -0,0 +1,38 @@
+/*
+ * @Description: 注册邀请码领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// InvitationCode 是注册邀请码的领域模型
+type InvitationCode struct {
+	ID        uint       `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	Code      string     `json:"code"`
+	MaxUses   int        `json:"max_uses"`
+	UsedCount int        `json:"used_count"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy uint       `json:"-"`
+	Note      string     `json:"note,omitempty"`
+}
+
+// Usable 判断邀请码在给定时间点是否仍可用于注册
+func (c *InvitationCode) Usable(now time.Time) bool {
+	if c.UsedCount >= c.MaxUses {
+		return false
+	}
+	return c.ExpiresAt == nil || now.Before(*c.ExpiresAt)
+}
+
+// CreateInvitationCodeRequest 定义了批量生成邀请码的请求体
+type CreateInvitationCodeRequest struct {
+	Count          int    `json:"count" binding:"omitempty,min=1,max=100"`              // 生成数量，默认 1
+	MaxUses        int    `json:"max_uses" binding:"omitempty,min=1,max=10000"`         // 每个邀请码可使用次数，默认 1
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=0,max=87600"` // 0 表示永不过期
+	Note           string `json:"note" binding:"omitempty,max=255"`
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 // PaginationInput 是分页输入的基础结构，可被其他请求 DTO 嵌入。
 type PaginationInput struct {
 	Page     int `form:"page" binding:"omitempty,gte=1"`
@@ -51,6 +53,14 @@ type LinkDTO struct {
 	SkipHealthCheck bool             `json:"skip_health_check"`
 	Category        *LinkCategoryDTO `json:"category"`
 	Tag             *LinkTagDTO      `json:"tag"` // 改为单个标签
+
+	// 健康检查状态
+	CheckInterval       int        `json:"check_interval"`                 // 检查间隔（小时），0 表示使用全局设置
+	LastCheckedAt       *time.Time `json:"last_checked_at,omitempty"`      // 最近一次检查时间
+	ConsecutiveFailures int        `json:"consecutive_failures"`           // 连续检查失败次数
+	LastResponseTime    int        `json:"last_response_time"`             // 最近一次响应耗时（毫秒）
+	SSLExpireAt         *time.Time `json:"ssl_expire_at,omitempty"`        // HTTPS 证书过期时间
+	OriginalCategoryID  *int       `json:"original_category_id,omitempty"` // 被移入失联分类前的分类ID
 }
 
 // --- API 请求/响应 DTO ---
@@ -97,6 +107,7 @@ type AdminCreateLinkRequest struct {
 	UpdateReason    string `json:"update_reason"`                             // 修改原因，可选
 	SortOrder       int    `json:"sort_order"`
 	SkipHealthCheck bool   `json:"skip_health_check"`
+	CheckInterval   int    `json:"check_interval" binding:"omitempty,gte=0"` // 检查间隔（小时），0 表示使用全局设置
 }
 
 // ReviewLinkRequest 是后台管理员审核友链的请求结构。
@@ -149,6 +160,7 @@ type AdminUpdateLinkRequest struct {
 	UpdateReason    string `json:"update_reason"`                             // 修改原因，可选
 	SortOrder       int    `json:"sort_order"`
 	SkipHealthCheck bool   `json:"skip_health_check"`
+	CheckInterval   int    `json:"check_interval" binding:"omitempty,gte=0"` // 检查间隔（小时），0 表示使用全局设置
 }
 
 // UpdateLinkCategoryRequest 是后台管理员更新友链分类的请求结构。
@@ -216,6 +228,36 @@ type LinkHealthCheckResponse struct {
 	Healthy      int   `json:"healthy"`       // 健康的友链数量
 	Unhealthy    int   `json:"unhealthy"`     // 失联的友链数量
 	UnhealthyIDs []int `json:"unhealthy_ids"` // 失联的友链ID列表
+	Recovered    int   `json:"recovered"`     // 本次恢复的友链数量
+	MovedToSick  []int `json:"moved_to_sick"` // 本次被移入失联分类的友链ID列表
+	SSLExpiring  []int `json:"ssl_expiring"`  // 证书即将过期的友链ID列表
+}
+
+// LinkCheckRecordDTO 是单次友链健康检查的记录。
+type LinkCheckRecordDTO struct {
+	ID           int        `json:"id"`
+	LinkID       int        `json:"link_id"`
+	CheckedAt    time.Time  `json:"checked_at"`
+	Healthy      bool       `json:"healthy"`
+	StatusCode   int        `json:"status_code"`
+	ResponseTime int        `json:"response_time"` // 响应耗时（毫秒）
+	Error        string     `json:"error,omitempty"`
+	SSLExpireAt  *time.Time `json:"ssl_expire_at,omitempty"`
+}
+
+// LinkHealthUpdate 是一次健康检查后需要写回友链的状态。
+type LinkHealthUpdate struct {
+	CheckedAt           time.Time
+	ConsecutiveFailures int
+	ResponseTime        int
+	SSLExpireAt         *time.Time
+	// Status 非空时更新友链状态
+	Status string
+	// CategoryID 非空时将友链移动到该分类
+	CategoryID *int
+	// OriginalCategoryID 非空时记录移入失联分类前的分类；ClearOriginalCategory 为 true 时清空该记录
+	OriginalCategoryID    *int
+	ClearOriginalCategory bool
 }
 
 // LinkSortItem 是单个友链排序项。
@@ -0,0 +1,40 @@
+/*
+ * @Description: 邮件模板领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// MailTemplateVariable 是模板中可用的变量说明
+type MailTemplateVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// MailTemplate 是一个可在后台编辑的邮件模板（主题或正文）
+type MailTemplate struct {
+	Key         string                 `json:"key"`
+	Name        string                 `json:"name"`
+	Kind        string                 `json:"kind"` // subject 或 body
+	Content     string                 `json:"content"`
+	Default     string                 `json:"default"`
+	Variables   []MailTemplateVariable `json:"variables"`
+	VersionSize int                    `json:"versionSize"` // 可回滚的历史版本数
+}
+
+// MailTemplateVersion 是模板被修改前保存的历史版本
+type MailTemplateVersion struct {
+	ID        uint      `json:"id"`
+	Key       string    `json:"key"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// MailTemplatePreview 是模板使用示例数据渲染后的结果
+type MailTemplatePreview struct {
+	Rendered string `json:"rendered"`
+}
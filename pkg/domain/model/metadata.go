@@ -26,6 +26,7 @@ type Metadata struct {
 const (
 	MetaKeyThumbSource     = "thumb_source"      // 缩略图来源
 	MetaKeyThumbFormat     = "thumb_format"      // 缩略图格式
+	MetaKeyThumbVariants   = "thumb_variants"    // 缩略图可用的多格式变体（逗号分隔，用于按 Accept 协商）
 	MetaKeyPhysicalName    = "physical_name"     // 物理文件名
 	MetaKeyThumbStatus     = "thumb_status"      // 缩略图状态
 	MetaKeyThumbError      = "thumb_error"       // 缩略图生成错误信息
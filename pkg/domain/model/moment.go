@@ -0,0 +1,68 @@
+/*
+ * @Description: 说说（短动态）领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// MomentCommentPathPrefix 说说评论的目标路径前缀，单条说说的评论路径为 /moments/{id}
+const MomentCommentPathPrefix = "/moments/"
+
+// --- 核心领域对象 (Domain Object) ---
+
+// Moment 是说说的核心领域模型
+type Moment struct {
+	ID             string    `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	Content        string    `json:"content"`
+	ContentHTML    string    `json:"content_html"`
+	Images         []string  `json:"images"`
+	Location       string    `json:"location"`
+	CommentEnabled bool      `json:"comment_enabled"`
+	IsPublic       bool      `json:"is_public"`
+}
+
+// CommentPath 返回该说说在评论系统中使用的目标路径
+func (m *Moment) CommentPath() string {
+	return MomentCommentPathPrefix + m.ID
+}
+
+// --- API 数据传输对象 (Data Transfer Objects) ---
+
+// SaveMomentRequest 定义了发布/编辑说说的请求体（编辑时为整体替换）
+type SaveMomentRequest struct {
+	Content        string   `json:"content" binding:"required"`
+	Images         []string `json:"images"`
+	Location       string   `json:"location"`
+	CommentEnabled *bool    `json:"comment_enabled"` // 为空时默认允许评论
+	IsPublic       *bool    `json:"is_public"`       // 为空时默认公开
+	CreatedAt      *string  `json:"created_at"`      // 可选的发布时间 (RFC3339格式)，用于补录
+	ContentHTML    string   `json:"-"`               // 由服务层渲染
+}
+
+// ListMomentsOptions 定义了说说列表的查询参数
+type ListMomentsOptions struct {
+	Page       int
+	PageSize   int
+	PublicOnly bool // 仅返回公开的说说（前台时间线）
+}
+
+// MomentResponse 是说说的 API 响应结构，附带评论信息
+type MomentResponse struct {
+	Moment
+	CommentPath  string `json:"comment_path"`  // 评论系统使用的目标路径
+	CommentCount int    `json:"comment_count"` // 已发布评论数
+}
+
+// MomentListResponse 是说说分页列表的 API 响应结构
+type MomentListResponse struct {
+	List     []*MomentResponse `json:"list"`
+	Total    int               `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"pageSize"`
+}
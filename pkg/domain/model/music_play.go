@@ -0,0 +1,41 @@
+/*
+ * @Description: 音乐播放统计相关模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 15:00:00
+ * @LastEditTime: 2026-10-15 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+// MusicPlayReport 播放器上报的一次歌曲播放
+type MusicPlayReport struct {
+	SongID    string `json:"songId" binding:"required,max=64"`
+	Name      string `json:"name" binding:"max=255"`
+	Artist    string `json:"artist" binding:"max=255"`
+	Duration  int    `json:"duration" binding:"min=0"`   // 本次收听时长（秒）
+	SessionID string `json:"sessionId" binding:"max=64"` // 播放器会话ID，用于同一会话内去重
+}
+
+// MusicPlayStat 某首歌在某个月的播放汇总
+type MusicPlayStat struct {
+	Period        string `json:"period"`
+	SongID        string `json:"songId"`
+	Name          string `json:"name"`
+	Artist        string `json:"artist"`
+	PlayCount     int64  `json:"playCount"`
+	TotalDuration int64  `json:"totalDuration"` // 累计收听时长（秒）
+}
+
+// MusicMonthlyStat 某个月的整体播放汇总
+type MusicMonthlyStat struct {
+	Period        string `json:"period"`
+	PlayCount     int64  `json:"playCount"`
+	TotalDuration int64  `json:"totalDuration"`
+	SongCount     int    `json:"songCount"`
+}
+
+// MusicPlayChart 后台音乐播放统计图表数据
+type MusicPlayChart struct {
+	Months   []*MusicMonthlyStat `json:"months"`
+	TopSongs []*MusicPlayStat    `json:"topSongs"` // 统计区间内按播放次数排序，Period 为空
+}
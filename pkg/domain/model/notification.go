@@ -10,8 +10,10 @@ import "time"
 // 通知类型常量
 const (
 	// 评论相关
-	NotificationTypeCommentReply = "comment_reply" // 评论回复
-	NotificationTypeCommentNew   = "comment_new"   // 新评论（博主）
+	NotificationTypeCommentReply    = "comment_reply"    // 评论回复
+	NotificationTypeCommentNew      = "comment_new"      // 新评论（博主）
+	NotificationTypeCommentMention  = "comment_mention"  // 评论中被 @ 提及
+	NotificationTypeCommentApproved = "comment_approved" // 评论通过审核
 
 	// 系统相关
 	NotificationTypeSystemUpdate   = "system_update"   // 系统更新
@@ -111,6 +113,24 @@ func DefaultNotificationTypes() []*NotificationType {
 			DefaultEnabled:    true,
 			SupportedChannels: []string{NotificationChannelEmail, NotificationChannelPush},
 		},
+		{
+			Code:              NotificationTypeCommentMention,
+			Name:              "评论提及通知",
+			Description:       "当有人在评论中 @ 您时通知您",
+			Category:          NotificationCategoryComment,
+			IsActive:          true,
+			DefaultEnabled:    true,
+			SupportedChannels: []string{NotificationChannelEmail},
+		},
+		{
+			Code:              NotificationTypeCommentApproved,
+			Name:              "评论审核通过通知",
+			Description:       "当您待审核的评论通过审核并公开显示时通知您",
+			Category:          NotificationCategoryComment,
+			IsActive:          true,
+			DefaultEnabled:    true,
+			SupportedChannels: []string{NotificationChannelEmail},
+		},
 		{
 			Code:              NotificationTypeSystemUpdate,
 			Name:              "系统更新通知",
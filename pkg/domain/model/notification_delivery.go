@@ -0,0 +1,58 @@
+/*
+ * @Description: 通知投递队列领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// 通知投递渠道
+const (
+	DeliveryChannelEmail   = "email"
+	DeliveryChannelBark    = "bark"
+	DeliveryChannelWebhook = "webhook"
+)
+
+// 通知投递状态
+const (
+	DeliveryPending = "PENDING"
+	DeliverySending = "SENDING"
+	DeliverySent    = "SENT"
+	DeliveryFailed  = "FAILED" // 已达到最大尝试次数，不再自动重试
+)
+
+// NotificationDelivery 是一条持久化的通知投递记录，Payload 为渲染完成的发送内容
+type NotificationDelivery struct {
+	ID            uint       `json:"id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Channel       string     `json:"channel"`
+	Kind          string     `json:"kind"`
+	Recipient     string     `json:"recipient"`
+	Subject       string     `json:"subject"`
+	Payload       string     `json:"-"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	MaxAttempts   int        `json:"max_attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LastError     string     `json:"last_error,omitempty"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+}
+
+// NotificationDeliveryQuery 投递记录的查询条件
+type NotificationDeliveryQuery struct {
+	Channel  string
+	Status   string
+	Page     int
+	PageSize int
+}
+
+// NotificationDeliveryStat 按渠道与状态分组的投递数量
+type NotificationDeliveryStat struct {
+	Channel string `json:"channel"`
+	Status  string `json:"status"`
+	Count   int    `json:"count"`
+}
@@ -0,0 +1,76 @@
+/*
+ * @Description: 用户组权限的名称定义，供管理接口以 资源:操作 的形式读写权限位
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 02:00:00
+ * @LastEditTime: 2026-10-17 02:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "fmt"
+
+// PermissionDefinition 描述一个可分配给用户组的权限
+type PermissionDefinition struct {
+	Bit         uint   `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// AllPermissions 是全部权限的定义，按权限位排序
+var AllPermissions = []PermissionDefinition{
+	{Bit: PermissionAdmin, Name: "admin", Description: "系统管理员，拥有全部权限"},
+	{Bit: PermissionCreateShare, Name: "share:create", Description: "创建分享"},
+	{Bit: PermissionAccessShare, Name: "share:access", Description: "访问分享"},
+	{Bit: PermissionUploadFile, Name: "file:upload", Description: "上传文件"},
+	{Bit: PermissionDeleteFile, Name: "file:delete", Description: "删除文件"},
+	{Bit: PermissionArticleWrite, Name: "article:write", Description: "管理文章、分类、标签与模板"},
+	{Bit: PermissionCommentModerate, Name: "comment:moderate", Description: "审核与管理评论"},
+	{Bit: PermissionFileManage, Name: "file:manage", Description: "管理存储策略与媒体库"},
+	{Bit: PermissionSettingsWrite, Name: "settings:write", Description: "修改站点设置"},
+	{Bit: PermissionArticleContribute, Name: "article:contribute", Description: "投稿文章，投稿经审核后发布"},
+}
+
+// PermissionName 返回权限位对应的名称，未定义时返回空字符串
+func PermissionName(bit uint) string {
+	for _, p := range AllPermissions {
+		if p.Bit == bit {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// Has 判断是否拥有指定权限，拥有管理员权限时视为拥有全部权限
+func (bs Boolset) Has(perm uint) bool {
+	return bs.Enabled(PermissionAdmin) || bs.Enabled(perm)
+}
+
+// Names 返回已启用权限的名称列表，忽略未定义的权限位
+func (bs Boolset) Names() []string {
+	names := []string{}
+	for _, p := range AllPermissions {
+		if bs.Enabled(p.Bit) {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// ParsePermissions 将权限名称列表转换为 Boolset，包含未定义的名称时返回错误
+func ParsePermissions(names []string) (Boolset, error) {
+	bs := Boolset{}
+	for _, name := range names {
+		found := false
+		for _, p := range AllPermissions {
+			if p.Name == name {
+				bs.Set(p.Bit, true)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("未知的权限: %s", name)
+		}
+	}
+	return bs, nil
+}
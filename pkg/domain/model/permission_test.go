@@ -0,0 +1,35 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoolsetHas(t *testing.T) {
+	editor := NewBoolset(PermissionArticleWrite, PermissionUploadFile)
+	if !editor.Has(PermissionArticleWrite) || editor.Has(PermissionSettingsWrite) {
+		t.Errorf("普通用户组只拥有显式授予的权限: %v", editor.Names())
+	}
+	admin := NewBoolset(PermissionAdmin)
+	for _, p := range AllPermissions {
+		if !admin.Has(p.Bit) {
+			t.Errorf("管理员应拥有 %s 权限", p.Name)
+		}
+	}
+}
+
+func TestParsePermissions(t *testing.T) {
+	bs, err := ParsePermissions([]string{"comment:moderate", "file:upload", "comment:moderate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bs.Names(), []string{"file:upload", "comment:moderate"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if _, err := ParsePermissions([]string{"article:delete"}); err == nil {
+		t.Error("未知的权限名称应返回错误")
+	}
+	if names := (Boolset{}).Names(); names == nil || len(names) != 0 {
+		t.Errorf("空权限应返回空列表: %v", names)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * @Description: 用户书签与阅读进度领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// UserBookmark 是用户收藏文章的记录
+type UserBookmark struct {
+	ArticleID uint
+	CreatedAt time.Time
+}
+
+// ReadingProgress 是用户在某篇文章中的阅读进度记录
+type ReadingProgress struct {
+	ArticleID uint
+	Progress  float64
+	Anchor    string
+	UpdatedAt time.Time
+}
+
+// AddBookmarkRequest 定义了添加书签的请求体
+type AddBookmarkRequest struct {
+	ArticleID string `json:"article_id" binding:"required"`
+}
+
+// SaveReadingProgressRequest 定义了保存阅读进度的请求体
+type SaveReadingProgressRequest struct {
+	Progress float64 `json:"progress" binding:"min=0,max=1"`
+	Anchor   string  `json:"anchor" binding:"max=255"`
+}
+
+// BookmarkItem 是书签列表中的一项，附带文章摘要
+type BookmarkItem struct {
+	Article   ArticleResponse `json:"article"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// BookmarkListResponse 是书签列表的分页响应
+type BookmarkListResponse struct {
+	List     []BookmarkItem `json:"list"`
+	Total    int64          `json:"total"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"pageSize"`
+}
+
+// BookmarkStatus 表示某篇文章是否已被当前用户收藏
+type BookmarkStatus struct {
+	ArticleID  string `json:"article_id"`
+	Bookmarked bool   `json:"bookmarked"`
+}
+
+// ReadingProgressResponse 是返回给前端的阅读进度，列表中会附带文章摘要
+type ReadingProgressResponse struct {
+	ArticleID string           `json:"article_id"`
+	Progress  float64          `json:"progress"`
+	Anchor    string           `json:"anchor"`
+	UpdatedAt time.Time        `json:"updated_at"`
+	Article   *ArticleResponse `json:"article,omitempty"`
+}
+
+// ReadingProgressListResponse 是阅读进度列表的分页响应，按最近阅读时间倒序
+type ReadingProgressListResponse struct {
+	List     []ReadingProgressResponse `json:"list"`
+	Total    int64                     `json:"total"`
+	Page     int                       `json:"page"`
+	PageSize int                       `json:"pageSize"`
+}
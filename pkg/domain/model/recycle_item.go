@@ -0,0 +1,50 @@
+/*
+ * @Description: 回收站领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 12:00:00
+ * @LastEditTime: 2026-10-17 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// RecycleItem 是回收站中一个条目的领域模型。
+// 被删除的文件/文件夹记录本身以软删除状态保留，并被重命名以让出原名称；RecycleItem 记录其原始位置以便还原
+type RecycleItem struct {
+	ID               uint
+	OwnerID          uint
+	FileID           uint
+	OriginalName     string
+	OriginalParentID uint
+	OriginalPath     string
+	FileType         FileType
+	Size             int64
+	TrashedAt        time.Time
+	ExpireAt         time.Time
+}
+
+// RecycleItemDTO 是回收站条目返回给前端的结构
+type RecycleItemDTO struct {
+	ID           string    `json:"id"` // 被删除文件的公共ID，还原与彻底删除时使用
+	Name         string    `json:"name"`
+	OriginalPath string    `json:"original_path"`
+	Type         int       `json:"type"`
+	Size         int64     `json:"size"`
+	TrashedAt    time.Time `json:"trashed_at"`
+	ExpireAt     time.Time `json:"expire_at"`
+}
+
+// RecycleItemListResponse 是回收站列表的响应体
+type RecycleItemListResponse struct {
+	List     []*RecycleItemDTO `json:"list"`
+	Total    int               `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"pageSize"`
+}
+
+// RecycleBinActionRequest 是还原或彻底删除回收站条目的请求体
+type RecycleBinActionRequest struct {
+	IDs []string `json:"ids"` // 被删除文件的公共ID列表
+	All bool     `json:"all"` // 仅用于彻底删除：为 true 时清空整个回收站，防止缺少 ids 的请求误清空
+}
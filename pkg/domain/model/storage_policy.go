@@ -80,6 +80,22 @@ type StoragePolicy struct {
 	VirtualPath string                     `json:"virtual_path"`
 	Settings    StoragePolicySettings      `json:"settings"`
 	NodeID      *uint                      `json:"node_id"`
+
+	// 以下字段仅在 VFS 通过别名挂载点解析时填充，不落库
+	MountID  uint `json:"mount_id,omitempty"`
+	ReadOnly bool `json:"read_only,omitempty"`
+}
+
+// StoragePolicyMount 是存储策略别名挂载点的领域模型。
+// 同一个策略除了自身的 VirtualPath 外，还可以挂载到多个别名路径，别名路径可设为只读。
+type StoragePolicyMount struct {
+	ID          uint      `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	PolicyID    uint      `json:"policy_id"`
+	VirtualPath string    `json:"virtual_path"`
+	ReadOnly    bool      `json:"read_only"`
+	NodeID      *uint     `json:"node_id"`
 }
 
 // StoragePolicyResponse 是用于API响应的存储策略数据传输对象 (DTO)。
@@ -100,3 +116,13 @@ type StoragePolicyResponse struct {
 	VirtualPath string                 `json:"virtual_path,omitempty"`
 	Settings    map[string]interface{} `json:"settings,omitempty"`
 }
+
+// StoragePolicyMountResponse 是用于API响应的挂载点数据传输对象 (DTO)。
+type StoragePolicyMountResponse struct {
+	ID          uint      `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	PolicyID    string    `json:"policy_id"`
+	VirtualPath string    `json:"virtual_path"`
+	ReadOnly    bool      `json:"read_only"`
+}
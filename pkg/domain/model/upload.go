@@ -17,6 +17,8 @@ type CreateUploadRequest struct {
 	Size      int64  `json:"size" binding:"required,min=0"`
 	PolicyID  string `json:"policy_id" binding:"required"`
 	Overwrite bool   `json:"overwrite,omitempty"`
+	// Checksum 整个文件的摘要（可选），格式为 "sha256=<hex>" 或 "crc32c=<hex>"，所有分片合并后会进行校验
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // FinalizeUploadRequest 定义了客户端直传完成后，通知服务器时需要携带的数据
@@ -60,9 +62,19 @@ type StoragePolicyInfo struct {
 type UploadSessionStatusResponse struct {
 	SessionID      string    `json:"session_id"`
 	IsValid        bool      `json:"is_valid"`
+	URI            string    `json:"uri"`
+	PolicyID       string    `json:"policy_id"`
+	FileSize       int64     `json:"file_size"`
 	ChunkSize      int64     `json:"chunk_size"`
 	TotalChunks    int       `json:"total_chunks"`
 	UploadedChunks []int     `json:"uploaded_chunks"`
+	ChunkBitmap    string    `json:"chunk_bitmap"`   // 分片完成位图，第 i 个字符为 '1' 表示第 i 个分片已上传
+	UploadedBytes  int64     `json:"uploaded_bytes"` // 已上传的字节数
+	Progress       float64   `json:"progress"`       // 上传进度百分比 (0-100)
+	Throughput     int64     `json:"throughput"`     // 实测上传速度 (字节/秒)，尚无数据时为 0
+	ETASeconds     int64     `json:"eta_seconds"`    // 预计剩余秒数，无法估算时为 -1
+	CreatedAt      time.Time `json:"created_at"`
+	LastActiveAt   time.Time `json:"last_active_at"`
 	ExpiresAt      time.Time `json:"expires_at"`
 }
 
@@ -73,7 +85,7 @@ type UploadSessionInvalidResponse struct {
 
 // --- 内部领域模型 ---
 
-// UploadSession 定义了文件分块上传会话的模型 (存储在Redis中)。
+// UploadSession 定义了文件分块上传会话的模型 (持久化在数据库 upload_sessions 表中)。
 // 这个会话现在与一个临时的物理实体（Entity）绑定，而不是一个逻辑文件（File）。
 type UploadSession struct {
 	SessionID      string       `json:"session_id"`
@@ -85,4 +97,10 @@ type UploadSession struct {
 	TempEntityID   uint         `json:"temp_entity_id"`
 	UploadedChunks map[int]bool `json:"uploaded_chunks"`
 	ExpireAt       time.Time    `json:"expire_at"`
+	Checksum       string       `json:"checksum,omitempty"` // 客户端提供的整个文件摘要，合并分片后校验
+
+	// --- 进度统计 ---
+	CreatedAt    time.Time `json:"created_at"`
+	LastActiveAt time.Time `json:"last_active_at"` // 最近一个分片上传完成的时间
+	Throughput   float64   `json:"throughput"`     // 分片接收速度的指数滑动平均值 (字节/秒)
 }
@@ -6,18 +6,26 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 )
 
 // ========= 业务常量 (与数据库实现无关) =========
 
-// 权限常量定义了用户操作的权限位
+// 权限常量定义了用户操作的权限位，PermissionAdmin 隐含其余全部权限
 const (
 	PermissionAdmin       uint = 0
 	PermissionCreateShare uint = 1
 	PermissionAccessShare uint = 2
 	PermissionUploadFile  uint = 3
 	PermissionDeleteFile  uint = 4
+
+	// 以下为后台资源的操作权限，授予非管理员用户组后可访问对应的管理接口
+	PermissionArticleWrite      uint = 5 // 管理文章、分类、标签、模板等内容
+	PermissionCommentModerate   uint = 6 // 审核与管理评论
+	PermissionFileManage        uint = 7 // 管理存储策略与媒体库
+	PermissionSettingsWrite     uint = 8 // 修改站点设置
+	PermissionArticleContribute uint = 9 // 投稿文章，投稿需经管理员审核后发布
 )
 
 // 用户状态常量定义了用户的几种不同状态
@@ -25,30 +33,79 @@ const (
 	UserStatusActive   = 1
 	UserStatusInactive = 2
 	UserStatusBanned   = 3
+	// UserStatusPendingReview 使用一次性邮箱注册、等待管理员审核启用的账户，不能通过激活链接自行激活
+	UserStatusPendingReview = 4
 )
 
 // ========= 领域模型定义 =========
 
 type User struct {
-	ID           uint       `json:"id"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
-	Username     string     `json:"username"`
-	PasswordHash string     `json:"-"`
-	Nickname     string     `json:"nickname"`
-	Avatar       string     `json:"avatar"`
-	Email        string     `json:"email"`
-	Website      string     `json:"website"`
-	LastLoginAt  *time.Time `json:"lastLoginAt"`
-	UserGroupID  uint       `json:"userGroupID"`
-	UserGroup    UserGroup  `json:"userGroup"`
-	Status       int        `json:"status"`
+	ID           uint      `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"-"`
+	Nickname     string    `json:"nickname"`
+	Avatar       string    `json:"avatar"`
+	// AvatarVariants 上传头像时生成的正方形缩略版本，键为边长像素（如 "128"）
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"`
+	Bio            string            `json:"bio"`
+	// ProfileVisibility 公开资料页的可见性设置
+	ProfileVisibility ProfileVisibility `json:"profileVisibility"`
+	Email             string            `json:"email"`
+	Website           string            `json:"website"`
+	LastLoginAt       *time.Time        `json:"lastLoginAt"`
+	UserGroupID       uint              `json:"userGroupID"`
+	UserGroup         UserGroup         `json:"userGroup"`
+	Status            int               `json:"status"`
+}
+
+// ProfileVisibility 用户公开资料页的可见性设置，默认全部关闭。
+// Public 为 false 时资料页不可访问，其余开关控制各字段是否对外展示（昵称始终展示）。
+type ProfileVisibility struct {
+	Public       bool `json:"public"`
+	ShowAvatar   bool `json:"showAvatar"`
+	ShowBio      bool `json:"showBio"`
+	ShowWebsite  bool `json:"showWebsite"`
+	ShowComments bool `json:"showComments"`
 }
 
 type GroupSettings struct {
 	SourceBatch      int    `json:"source_batch"`
 	PolicyOrdering   []uint `json:"policy_ordering"`
 	RedirectedSource bool   `json:"redirected_source"`
+	// UploadPolicy 用户组的上传限制，为 nil 时只受全局设置与存储策略限制
+	UploadPolicy *GroupUploadPolicy `json:"upload_policy,omitempty"`
+}
+
+// GroupUploadPolicy 是叠加在全局上传设置之上的用户组上传限制，只能收紧不能放宽全局限制
+type GroupUploadPolicy struct {
+	// AllowedExtensions 允许的后缀名（小写、不带点），非空时文件还需同时满足全局白名单
+	AllowedExtensions []string `json:"allowed_extensions"`
+	// DeniedExtensions 禁止的后缀名，与全局黑名单合并生效
+	DeniedExtensions []string `json:"denied_extensions"`
+	// MaxFileSize 单个文件的大小上限（字节），0 表示不限制
+	MaxFileSize int64 `json:"max_file_size"`
+	// MaxDailyUploads 每个用户每天可创建的上传次数，0 表示不限制
+	MaxDailyUploads int `json:"max_daily_uploads"`
+}
+
+// NormalizeExtensions 将后缀名统一为小写、去掉前导点并去重，忽略空项
+func NormalizeExtensions(list []string) []string {
+	result := make([]string, 0, len(list))
+	seen := make(map[string]struct{}, len(list))
+	for _, ext := range list {
+		ext = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), "."))
+		if ext == "" {
+			continue
+		}
+		if _, ok := seen[ext]; ok {
+			continue
+		}
+		seen[ext] = struct{}{}
+		result = append(result, ext)
+	}
+	return result
 }
 
 func (s GroupSettings) Value() (driver.Value, error) {
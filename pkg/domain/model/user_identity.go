@@ -0,0 +1,31 @@
+/*
+ * @Description: 用户社交账号绑定领域模型
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package model
+
+import "time"
+
+// 支持的第三方登录平台
+const (
+	OAuthProviderGithub = "github"
+	OAuthProviderGoogle = "google"
+	OAuthProviderQQ     = "qq"
+)
+
+// UserIdentity 是本站用户绑定的一个第三方账号
+type UserIdentity struct {
+	ID             uint      `json:"-"`
+	UserID         uint      `json:"-"`
+	Provider       string    `json:"provider"`
+	ProviderUserID string    `json:"-"`
+	Nickname       string    `json:"nickname"`
+	Avatar         string    `json:"avatar"`
+	Email          string    `json:"email"`
+	ProfileURL     string    `json:"profileUrl"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
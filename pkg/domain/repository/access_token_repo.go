@@ -0,0 +1,28 @@
+/*
+ * @Description: 个人访问令牌仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// AccessTokenRepository 定义了个人访问令牌的数据仓库接口。
+type AccessTokenRepository interface {
+	Create(ctx context.Context, token *model.AccessToken, tokenHash string) (*model.AccessToken, error)
+	ListByUserID(ctx context.Context, userID uint) ([]*model.AccessToken, error)
+	FindByHash(ctx context.Context, tokenHash string) (*model.AccessToken, error)
+	Delete(ctx context.Context, userID, id uint) error
+	// List 分页列出全部令牌，userID 非 0 时只列出该用户的令牌
+	List(ctx context.Context, userID uint, page, pageSize int) ([]*model.AccessToken, int, error)
+	// DeleteByID 删除任意用户的令牌，供管理员吊销
+	DeleteByID(ctx context.Context, id uint) error
+	TouchLastUsed(ctx context.Context, id uint, at time.Time) error
+}
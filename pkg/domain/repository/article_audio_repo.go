@@ -0,0 +1,23 @@
+/*
+ * @Description: 文章语音朗读版本仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ArticleAudioRepository 定义了文章语音朗读版本的持久化操作接口
+type ArticleAudioRepository interface {
+	// FindByArticleID 按文章ID查找，不存在时返回 nil, nil
+	FindByArticleID(ctx context.Context, articleID uint) (*model.ArticleAudio, error)
+	// Save 按文章ID创建或更新记录
+	Save(ctx context.Context, audio *model.ArticleAudio) error
+	DeleteByArticleID(ctx context.Context, articleID uint) error
+}
@@ -0,0 +1,24 @@
+/*
+ * @Description: 文章合集仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 01:00:00
+ * @LastEditTime: 2026-10-18 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ArticleCollectionRepository 定义了文章合集的数据仓库接口。
+type ArticleCollectionRepository interface {
+	Create(ctx context.Context, req *model.SaveArticleCollectionRequest) (*model.ArticleCollection, error)
+	Update(ctx context.Context, id string, req *model.SaveArticleCollectionRequest) (*model.ArticleCollection, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*model.ArticleCollection, error)
+	GetByID(ctx context.Context, id string) (*model.ArticleCollection, error)
+	GetBySlug(ctx context.Context, slug string) (*model.ArticleCollection, error)
+}
@@ -0,0 +1,20 @@
+/*
+ * @Description: 文章表情回应计数仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 05:00:00
+ * @LastEditTime: 2026-10-18 05:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import "context"
+
+// ArticleReactionRepository 定义了文章表情回应计数的数据仓库接口。
+type ArticleReactionRepository interface {
+	// Increment 原子地为文章的某种回应加一
+	Increment(ctx context.Context, articleID uint, reaction string) error
+	// Decrement 原子地为文章的某种回应减一，计数不会小于 0
+	Decrement(ctx context.Context, articleID uint, reaction string) error
+	// CountsByArticleIDs 批量获取文章的回应计数，返回 文章ID -> 回应类型 -> 数量，没有回应的文章不出现在结果中
+	CountsByArticleIDs(ctx context.Context, articleIDs []uint) (map[uint]map[string]int, error)
+}
@@ -46,6 +46,9 @@ type ArticleRepository interface {
 	// ListHome 获取首页推荐文章列表。
 	ListHome(ctx context.Context) ([]*model.Article, error)
 
+	// ListPublishedByIDs 按ID批量获取可公开访问的文章（已发布、未删除、未下架且审核通过），不保证顺序。
+	ListPublishedByIDs(ctx context.Context, ids []uint) ([]*model.Article, error)
+
 	// ListPublic 根据选项获取公开的文章列表，通常用于前端展示。
 	ListPublic(ctx context.Context, options *model.ListPublicArticlesOptions) ([]*model.Article, int, error)
 
@@ -58,6 +61,10 @@ type ArticleRepository interface {
 	// UpdateViewCounts 批量更新文章的浏览量。
 	UpdateViewCounts(ctx context.Context, updates map[uint]int) error
 
+	// UpdateAutoPrimaryColor 仅当文章仍为自动取色模式、且取色图片（头图，头图为空时为封面）仍为 imageURL 时更新主色调，
+	// 返回是否实际更新，用于后台取色完成后避免覆盖期间的手动修改或换图。
+	UpdateAutoPrimaryColor(ctx context.Context, publicID, imageURL, color string) (bool, error)
+
 	// GetBySlugOrID 根据文章的 slug 或 ID 获取文章详情。
 	GetBySlugOrID(ctx context.Context, slugOrID string) (*model.Article, error)
 
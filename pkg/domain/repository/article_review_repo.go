@@ -0,0 +1,26 @@
+/*
+ * @Description: 投稿审核仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 04:00:00
+ * @LastEditTime: 2026-10-18 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ArticleReviewRepository 定义了投稿审核记录与审核结果的数据仓库接口。
+type ArticleReviewRepository interface {
+	// AddNote 追加一条审核记录
+	AddNote(ctx context.Context, note *model.ArticleReviewNote) (*model.ArticleReviewNote, error)
+	// ListNotes 按时间顺序获取文章的全部审核记录
+	ListNotes(ctx context.Context, articleID uint) ([]*model.ArticleReviewNote, error)
+	// SetReviewResult 写入文章的审核状态、审核意见、审核人与审核时间
+	SetReviewResult(ctx context.Context, articleID uint, result *model.ContributionReviewResult) error
+	// GetReviewResult 获取文章当前的审核状态与最近一次审核意见
+	GetReviewResult(ctx context.Context, articleID uint) (*model.ContributionReviewResult, error)
+}
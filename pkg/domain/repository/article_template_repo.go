@@ -0,0 +1,32 @@
+/*
+ * @Description: 文章模板与内容片段仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ArticleTemplateRepository 定义了文章模板的数据仓库接口。
+type ArticleTemplateRepository interface {
+	Create(ctx context.Context, req *model.SaveArticleTemplateRequest) (*model.ArticleTemplate, error)
+	Update(ctx context.Context, id string, req *model.SaveArticleTemplateRequest) (*model.ArticleTemplate, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*model.ArticleTemplate, error)
+	GetByID(ctx context.Context, id string) (*model.ArticleTemplate, error)
+}
+
+// ContentSnippetRepository 定义了编辑器内容片段的数据仓库接口。
+type ContentSnippetRepository interface {
+	Create(ctx context.Context, req *model.SaveContentSnippetRequest) (*model.ContentSnippet, error)
+	Update(ctx context.Context, id string, req *model.SaveContentSnippetRequest) (*model.ContentSnippet, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*model.ContentSnippet, error)
+	GetByID(ctx context.Context, id string) (*model.ContentSnippet, error)
+}
@@ -0,0 +1,20 @@
+/*
+ * @Description: 审计日志仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-15 23:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// AuditLogRepository 定义了审计日志的数据仓库接口。
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *model.AuditLog) error
+	List(ctx context.Context, query *model.AuditLogQuery) ([]*model.AuditLog, int, error)
+}
@@ -0,0 +1,28 @@
+/*
+ * @Description: 更新日志仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ChangelogRepository 定义了更新日志的数据仓库接口。
+type ChangelogRepository interface {
+	// Create 创建更新日志，版本号已存在时返回 constant.ErrConflict
+	Create(ctx context.Context, entry *model.ChangelogEntry) (*model.ChangelogEntry, error)
+	// Update 整体更新更新日志，版本号与其他记录冲突时返回 constant.ErrConflict
+	Update(ctx context.Context, id string, entry *model.ChangelogEntry) (*model.ChangelogEntry, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*model.ChangelogEntry, error)
+	// GetByVersion 根据版本号获取更新日志，不存在时返回 constant.ErrNotFound
+	GetByVersion(ctx context.Context, version string) (*model.ChangelogEntry, error)
+	// List 按发布时间倒序分页获取更新日志
+	List(ctx context.Context, opts *model.ListChangelogOptions) ([]*model.ChangelogEntry, int, error)
+}
@@ -7,11 +7,32 @@
  */
 package repository
 
-import "context"
+import (
+	"context"
+	"net"
+	"time"
+)
 
 // CleanupRepository 定义了清理操作的接口。
 type CleanupRepository interface {
 	// CleanupOrphanedTagsAndCategories 负责移除未被任何文章引用的标签和分类。
 	// 它会分别返回被删除的标签和分类的数量。
 	CleanupOrphanedTagsAndCategories(ctx context.Context) (int, int, error)
+
+	// FindStalePendingComments 返回创建时间早于 before 的待审核评论与垃圾评论ID。
+	FindStalePendingComments(ctx context.Context, before time.Time) ([]uint, error)
+	// FindCommentsByAuthor 返回命中邮箱或IP规则的评论ID。
+	// emails 为完整邮箱，emailDomains 为以 @ 开头的邮箱后缀，比较均不区分大小写。
+	FindCommentsByAuthor(ctx context.Context, emails, emailDomains, ips []string, networks []*net.IPNet) ([]uint, error)
+	// FindOrphanedReplies 返回父评论已被删除的评论，以及它们应挂靠的最近一个未删除祖先。
+	FindOrphanedReplies(ctx context.Context) ([]OrphanedReply, error)
+}
+
+// OrphanedReply 描述一条父评论已被删除的回复。
+type OrphanedReply struct {
+	ID uint
+	// NewParentID 为最近一个未删除的祖先，为 nil 时该回复提升为顶级评论。
+	NewParentID *uint
+	// ResetReplyTo 为 true 表示回复目标也已删除，需要改为指向新的父评论。
+	ResetReplyTo bool
 }
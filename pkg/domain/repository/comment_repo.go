@@ -47,6 +47,9 @@ type AdminListParams struct {
 	Content    *string
 	TargetPath *string
 	Status     *int
+	EmailMD5   *string // 按邮箱哈希精确匹配，用于查看某位评论者的全部历史
+	HasLinks   bool    // 仅返回内容中包含链接的评论
+	FirstTime  bool    // 仅返回首次评论者（除本条外没有任何已发布评论）的评论
 }
 
 // UpdateCommentInfoParams 定义了更新评论信息的参数
@@ -73,23 +76,42 @@ type CommentRepository interface {
 	// 根据一组数据库ID查找多条评论，用于批量查询
 	FindManyByIDs(ctx context.Context, ids []uint) ([]*model.Comment, error)
 
-	// 增加评论的点赞数
-	IncrementLikeCount(ctx context.Context, id uint) (*model.Comment, error)
+	// 为评论添加一种表情回应，同一访客对同一评论的每种回应只记录一次；
+	// 返回更新后的评论以及本次是否新增了回应（已存在时为 false）
+	AddReaction(ctx context.Context, id uint, reaction, visitorHash string) (*model.Comment, bool, error)
 
-	// 减少评论的点赞数
-	DecrementLikeCount(ctx context.Context, id uint) (*model.Comment, error)
+	// 撤销访客对评论的某种表情回应，返回更新后的评论以及本次是否删除了回应
+	RemoveReaction(ctx context.Context, id uint, reaction, visitorHash string) (*model.Comment, bool, error)
 
 	// --- 管理员方法 ---
 
 	// 根据多种条件分页查询评论列表
 	FindWithConditions(ctx context.Context, params AdminListParams) ([]*model.Comment, int64, error)
 
+	// 按ID升序返回ID大于 afterID 的未删除评论，用于分批流式导出
+	FindAfterID(ctx context.Context, afterID uint, limit int) ([]*model.Comment, error)
+
 	// 根据ID列表批量（软）删除评论
 	DeleteByIDs(ctx context.Context, ids []uint) (int, error)
 
+	// 在一个事务中把孤立回复挂到新的父评论下，返回调整的数量
+	ReparentComments(ctx context.Context, replies []OrphanedReply) (int, error)
+
 	// 更新单条评论的状态
 	UpdateStatus(ctx context.Context, id uint, status model.Status) (*model.Comment, error)
 
+	// 批量更新评论的状态，返回实际更新的数量
+	UpdateStatusByIDs(ctx context.Context, ids []uint, status model.Status) (int, error)
+
+	// 统计某位评论者（按邮箱哈希）的评论概况
+	CommenterStats(ctx context.Context, emailMD5 string) (*model.CommenterStats, error)
+
+	// 统计某位评论者公开可见的评论足迹（已发布的非匿名评论），并返回最近的 recentLimit 条评论（至少一条）
+	CommenterFootprint(ctx context.Context, emailMD5 string, recentLimit int) (*model.CommenterFootprint, error)
+
+	// 统计注册用户已发布的非匿名评论足迹，用于公开资料页
+	UserFootprint(ctx context.Context, userID uint, recentLimit int) (*model.CommenterFootprint, error)
+
 	// 设置或取消评论的置顶状态
 	SetPin(ctx context.Context, id uint, pinTime *time.Time) (*model.Comment, error)
 
@@ -102,12 +124,45 @@ type CommentRepository interface {
 	// 更新评论的路径（用于处理文章或页面slug变更的情况）
 	UpdatePath(ctx context.Context, oldPath, newPath string) (int, error)
 
+	// 获取所有评论（包括已删除评论）中不重复的IP归属地
+	DistinctIPLocations(ctx context.Context) ([]string, error)
+
+	// 将指定的IP归属地替换为新值（用于调整归属地展示粒度）
+	ReplaceIPLocation(ctx context.Context, oldLocation, newLocation string) (int, error)
+
 	// 根据父评论ID分页查找已发布的子评论
 	FindPublishedChildrenByParentID(ctx context.Context, parentID uint, page, pageSize int) ([]*model.Comment, int64, error)
 
 	// 分页查找所有已发布的评论，按创建时间降序
 	FindAllPublishedPaginated(ctx context.Context, page, pageSize int) ([]*model.Comment, int64, error)
 
+	// 统计全站已发布的评论数量
+	CountPublished(ctx context.Context) (int, error)
+
 	// 批量统计多个文章的评论数量
 	CountByTargetPaths(ctx context.Context, targetPaths []string) (map[string]int, error)
+
+	// 批量统计多个文章的已发布/待审核评论数及最近评论时间
+	StatsByTargetPaths(ctx context.Context, targetPaths []string) (map[string]*model.CommentTargetStats, error)
+}
+
+// CommenterTrustRepository 定义了评论者信任状态（按邮箱哈希）的持久化操作接口。
+type CommenterTrustRepository interface {
+	// 获取评论者的信任状态，没有记录时返回空字符串
+	GetStatus(ctx context.Context, emailMD5 string) (string, error)
+
+	// 设置评论者的信任状态（不存在则创建）
+	SetStatus(ctx context.Context, emailMD5, status string) error
+
+	// 仅在尚无记录时将评论者标记为已信任，不会覆盖管理员的撤销操作
+	TrustIfAbsent(ctx context.Context, emailMD5 string) error
+}
+
+// SpamTokenRepository 定义了贝叶斯反垃圾过滤器词条计数的持久化操作接口。
+type SpamTokenRepository interface {
+	// 获取给定词条的计数，从未出现过的词条不包含在结果中
+	Counts(ctx context.Context, tokens []string) (map[string]model.SpamTokenCount, error)
+
+	// 将一条样本的词条计入垃圾或正常类别
+	Train(ctx context.Context, tokens []string, spam bool) error
 }
@@ -0,0 +1,31 @@
+/*
+ * @Description: 评论订阅仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 19:00:00
+ * @LastEditTime: 2026-10-16 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// CommentSubscriptionRepository 定义了评论订阅的数据仓库接口。
+type CommentSubscriptionRepository interface {
+	// Subscribe 创建订阅；已存在（包括已退订）时重新激活，并只通知此后发布的评论
+	Subscribe(ctx context.Context, targetPath, email string, now time.Time) (*model.CommentSubscription, error)
+	FindByID(ctx context.Context, id uint) (*model.CommentSubscription, error)
+	Deactivate(ctx context.Context, id uint) error
+	// MarkPending 标记评论区的有效订阅有待发送的新评论，已标记的订阅保持原时间
+	MarkPending(ctx context.Context, targetPath string, at time.Time) (int, error)
+	// ListDue 返回有待发送评论、且上次发送早于 sentBefore 的有效订阅，按等待时间先后排序
+	ListDue(ctx context.Context, sentBefore time.Time, limit int) ([]*model.CommentSubscription, error)
+	// MarkSent 记录摘要已处理：推进通知游标并清除待发送标记，sentAt 为 nil 时表示没有实际发信
+	MarkSent(ctx context.Context, id uint, notifiedUntil time.Time, sentAt *time.Time) error
+	// UpdatePath 评论区路径变更（如文章修改永久链接）时同步迁移订阅
+	UpdatePath(ctx context.Context, oldPath, newPath string) (int, error)
+}
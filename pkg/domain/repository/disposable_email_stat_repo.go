@@ -0,0 +1,18 @@
+/*
+ * @Description: 一次性邮箱拦截计数仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 10:00:00
+ * @LastEditTime: 2026-10-18 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import "context"
+
+// DisposableEmailStatRepository 定义了一次性邮箱拦截计数的数据仓库接口。
+type DisposableEmailStatRepository interface {
+	// Add 为场景与处理方式累加拦截次数，不存在则创建
+	Add(ctx context.Context, scope, action string, delta int64) error
+	// Counts 返回所有累计的拦截次数，键为 场景:处理方式
+	Counts(ctx context.Context) (map[string]int64, error)
+}
@@ -50,4 +50,10 @@ type EntityRepository interface {
 
 	// DeleteByStoragePolicyID 删除指定存储策略下的所有实体记录。
 	DeleteByStoragePolicyID(ctx context.Context, policyID uint) error
+
+	// ListByStoragePolicyIDAfter 按 ID 升序分批查找指定存储策略下 ID 大于 afterID 的实体，用于处理大量实体。
+	ListByStoragePolicyIDAfter(ctx context.Context, policyID, afterID uint, limit int) ([]*model.FileStorageEntity, error)
+
+	// HardDeleteBatch 在事务中永久删除一批实体及其文件版本关联记录。
+	HardDeleteBatch(ctx context.Context, ids []uint) error
 }
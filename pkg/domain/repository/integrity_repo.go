@@ -0,0 +1,48 @@
+/*
+ * @Description: 文件、物理实体与存储策略之间的数据一致性检查
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 04:00:00
+ * @LastEditTime: 2026-10-17 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// IntegrityRepository 定义了文件、实体与存储策略一致性检查所需的查询和修复操作。
+type IntegrityRepository interface {
+	// FindOrphanedEntities 返回创建时间早于 before、不属于任何上传会话，
+	// 且既不是任何文件（含回收站中文件）的主实体、也没有有效文件版本关联的实体。
+	FindOrphanedEntities(ctx context.Context, before time.Time) ([]*model.FileStorageEntity, error)
+	// FindFilesWithMissingEntity 返回主实体记录已不存在的未删除文件。
+	FindFilesWithMissingEntity(ctx context.Context) ([]*model.File, error)
+	// FindDanglingFileVersions 返回指向不存在实体的有效文件版本关联ID。
+	FindDanglingFileVersions(ctx context.Context) ([]uint, error)
+	// FindEntitiesWithDeletedPolicy 返回所属存储策略已删除或不存在的实体。
+	FindEntitiesWithDeletedPolicy(ctx context.Context) ([]*model.FileStorageEntity, error)
+	// FindChildrenCountMismatches 返回 children_count 与实际未删除直属子项数量不一致的目录。
+	FindChildrenCountMismatches(ctx context.Context) ([]ChildrenCountMismatch, error)
+
+	// RelinkOrTrashFile 把主实体缺失的文件重新指向最新的有效版本实体；
+	// 没有可用版本时软删除该文件。relinked 为 true 表示已重新关联。
+	RelinkOrTrashFile(ctx context.Context, fileID uint) (relinked bool, err error)
+	// DeleteFileVersions 删除指定的文件版本关联记录。
+	DeleteFileVersions(ctx context.Context, ids []uint) (int, error)
+	// SetChildrenCounts 把目录的 children_count 更新为实际值。
+	SetChildrenCounts(ctx context.Context, items []ChildrenCountMismatch) (int, error)
+}
+
+// ChildrenCountMismatch 描述一个子项计数错误的目录。
+type ChildrenCountMismatch struct {
+	FileID  uint
+	OwnerID uint
+	Name    string
+	// Recorded 为数据库中记录的 children_count，Actual 为实际统计的直属子项数量。
+	Recorded int64
+	Actual   int64
+}
@@ -0,0 +1,24 @@
+/*
+ * @Description: 注册邀请码仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// InvitationCodeRepository 定义了注册邀请码的数据仓库接口。
+type InvitationCodeRepository interface {
+	Create(ctx context.Context, code *model.InvitationCode) error
+	List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error)
+	Delete(ctx context.Context, id uint) error
+	// Consume 原子地占用邀请码的一次使用次数；邀请码不存在、已用尽或已过期时返回 false
+	Consume(ctx context.Context, code string, now time.Time) (bool, error)
+}
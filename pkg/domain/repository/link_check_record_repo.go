@@ -0,0 +1,25 @@
+/*
+ * @Description: 友链健康检查记录仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 14:00:00
+ * @LastEditTime: 2026-10-17 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// LinkCheckRecordRepository 定义了友链健康检查记录的持久化操作接口。
+type LinkCheckRecordRepository interface {
+	Create(ctx context.Context, record *model.LinkCheckRecordDTO) error
+	// ListByLinkID 按检查时间倒序返回友链最近的 limit 条记录
+	ListByLinkID(ctx context.Context, linkID int, limit int) ([]*model.LinkCheckRecordDTO, error)
+	// DeleteBefore 删除检查时间早于 before 的记录，返回删除的条数
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+	DeleteByLinkID(ctx context.Context, linkID int) error
+}
@@ -32,6 +32,8 @@ type LinkRepository interface {
 	GetAllApprovedLinks(ctx context.Context) ([]*model.LinkDTO, error)
 	GetAllInvalidLinks(ctx context.Context) ([]*model.LinkDTO, error)
 	BatchUpdateStatus(ctx context.Context, linkIDs []int, status string) error
+	// UpdateHealthState 写回一次健康检查后的统计数据，并按需更新状态与分类
+	UpdateHealthState(ctx context.Context, id int, update *model.LinkHealthUpdate) error
 	// 批量更新友链排序
 	BatchUpdateSortOrder(ctx context.Context, items []model.LinkSortItem) error
 	// 获取所有友链申请（公开接口）
@@ -0,0 +1,25 @@
+/*
+ * @Description: 邮件模板历史版本仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// MailTemplateVersionRepository 定义了邮件模板历史版本的数据仓库接口。
+type MailTemplateVersionRepository interface {
+	// Create 保存一个历史版本，并只保留该模板最近的 keep 个版本
+	Create(ctx context.Context, key, content string, keep int) (*model.MailTemplateVersion, error)
+	// ListByKey 按保存时间倒序返回模板的历史版本
+	ListByKey(ctx context.Context, key string) ([]*model.MailTemplateVersion, error)
+	// CountByKeys 返回各模板的历史版本数
+	CountByKeys(ctx context.Context, keys []string) (map[string]int, error)
+	FindByID(ctx context.Context, id uint) (*model.MailTemplateVersion, error)
+}
@@ -0,0 +1,25 @@
+/*
+ * @Description: 说说仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// MomentRepository 定义了说说的数据仓库接口。
+type MomentRepository interface {
+	Create(ctx context.Context, req *model.SaveMomentRequest, createdAt *time.Time) (*model.Moment, error)
+	Update(ctx context.Context, id string, req *model.SaveMomentRequest, createdAt *time.Time) (*model.Moment, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*model.Moment, error)
+	// List 按发布时间倒序分页查询，返回列表与总数
+	List(ctx context.Context, opts *model.ListMomentsOptions) ([]*model.Moment, int, error)
+}
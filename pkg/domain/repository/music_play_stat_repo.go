@@ -0,0 +1,22 @@
+/*
+ * @Description: 音乐播放统计仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 15:00:00
+ * @LastEditTime: 2026-10-15 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// MusicPlayStatRepository 定义了音乐播放统计的数据仓库接口。
+type MusicPlayStatRepository interface {
+	// Record 为指定月份的歌曲累加一次播放及收听时长，不存在则创建
+	Record(ctx context.Context, period string, report *model.MusicPlayReport) error
+	// ListByPeriods 返回 [from, to] 月份区间内的所有汇总行
+	ListByPeriods(ctx context.Context, from, to string) ([]*model.MusicPlayStat, error)
+}
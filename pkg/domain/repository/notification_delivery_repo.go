@@ -0,0 +1,39 @@
+/*
+ * @Description: 通知投递队列仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// NotificationDeliveryRepository 定义了通知投递队列的持久化操作接口
+type NotificationDeliveryRepository interface {
+	Create(ctx context.Context, delivery *model.NotificationDelivery) error
+	// FindByID 按ID查找，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.NotificationDelivery, error)
+	// ListDue 按下次尝试时间升序返回已到期的待投递记录
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.NotificationDelivery, error)
+	// Claim 将待投递记录标记为发送中并增加尝试次数，记录已被其他 worker 领取时返回 false
+	Claim(ctx context.Context, id uint) (bool, error)
+	MarkSent(ctx context.Context, id uint, sentAt time.Time) error
+	// MarkRetry 记录失败原因并安排下次尝试
+	MarkRetry(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error
+	// MarkFailed 记录失败原因并停止重试
+	MarkFailed(ctx context.Context, id uint, lastError string) error
+	// Requeue 将记录重置为待投递并清零尝试次数，用于手动重试
+	Requeue(ctx context.Context, id uint) error
+	// ResetSending 将发送中的记录恢复为待投递（进程在发送途中退出时遗留），返回恢复的数量
+	ResetSending(ctx context.Context) (int, error)
+	List(ctx context.Context, query *model.NotificationDeliveryQuery) ([]*model.NotificationDelivery, int, error)
+	CountByChannelAndStatus(ctx context.Context) ([]*model.NotificationDeliveryStat, error)
+	// DeleteFinishedBefore 删除指定时间之前已结束（发送成功或最终失败）的记录，返回删除数量
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,26 @@
+/*
+ * @Description: 用户阅读进度仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ReadingProgressRepository 定义了用户阅读进度的数据仓库接口。
+type ReadingProgressRepository interface {
+	// Save 写入用户在文章中的阅读进度，已有记录时覆盖
+	Save(ctx context.Context, userID uint, progress *model.ReadingProgress) (*model.ReadingProgress, error)
+	// Get 获取用户在文章中的阅读进度，没有记录时返回 constant.ErrNotFound
+	Get(ctx context.Context, userID, articleID uint) (*model.ReadingProgress, error)
+	// Delete 删除用户在文章中的阅读进度，记录不存在时不返回错误
+	Delete(ctx context.Context, userID, articleID uint) error
+	// List 按最近阅读时间倒序分页获取用户的阅读进度
+	List(ctx context.Context, userID uint, page, pageSize int) ([]*model.ReadingProgress, int, error)
+}
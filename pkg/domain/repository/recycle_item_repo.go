@@ -0,0 +1,29 @@
+/*
+ * @Description: 回收站仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 12:00:00
+ * @LastEditTime: 2026-10-17 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// RecycleItemRepository 定义了回收站条目的持久化操作接口。
+type RecycleItemRepository interface {
+	Create(ctx context.Context, item *model.RecycleItem) error
+	// FindByFileID 根据被删除文件的ID查找条目，未找到时返回 constant.ErrNotFound
+	FindByFileID(ctx context.Context, fileID uint) (*model.RecycleItem, error)
+	// ListByOwnerID 按移入回收站的时间倒序分页返回用户的条目及总数
+	ListByOwnerID(ctx context.Context, ownerID uint, page, pageSize int) ([]*model.RecycleItem, int, error)
+	// ListAllByOwnerID 返回用户的全部条目，用于清空回收站
+	ListAllByOwnerID(ctx context.Context, ownerID uint) ([]*model.RecycleItem, error)
+	// FindExpired 返回过期时间早于 before 的条目
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.RecycleItem, error)
+	DeleteByFileID(ctx context.Context, fileID uint) error
+}
@@ -0,0 +1,28 @@
+/*
+ * @Description: 存储策略挂载点仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// StoragePolicyMountRepository 定义了存储策略别名挂载点的持久化操作接口
+type StoragePolicyMountRepository interface {
+	Create(ctx context.Context, mount *model.StoragePolicyMount) error
+	Update(ctx context.Context, mount *model.StoragePolicyMount) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*model.StoragePolicyMount, error)
+	// FindByVirtualPath 按挂载路径查找，不存在时返回 nil, nil
+	FindByVirtualPath(ctx context.Context, path string) (*model.StoragePolicyMount, error)
+	ListAll(ctx context.Context) ([]*model.StoragePolicyMount, error)
+	ListByPolicyID(ctx context.Context, policyID uint) ([]*model.StoragePolicyMount, error)
+	// DeleteByPolicyID 删除策略的全部挂载点，返回被删除的挂载点以便清理其目录
+	DeleteByPolicyID(ctx context.Context, policyID uint) ([]*model.StoragePolicyMount, error)
+}
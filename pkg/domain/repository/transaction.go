@@ -28,6 +28,11 @@ type Repositories struct {
 	Link           LinkRepository
 	LinkCategory   LinkCategoryRepository
 	LinkTag        LinkTagRepository
+
+	StoragePolicyMount StoragePolicyMountRepository
+	InvitationCode     InvitationCodeRepository
+	UploadSession      UploadSessionRepository
+	RecycleItem        RecycleItemRepository
 }
 
 // TransactionManager 定义了事务管理器的接口。
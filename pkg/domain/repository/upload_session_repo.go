@@ -0,0 +1,32 @@
+/*
+ * @Description: 分片上传会话仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 09:00:00
+ * @LastEditTime: 2026-10-17 09:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// UploadSessionRepository 定义了分片上传会话的持久化操作接口。
+type UploadSessionRepository interface {
+	Create(ctx context.Context, session *model.UploadSession) error
+	// FindBySessionID 根据会话ID查找会话，未找到时返回 constant.ErrNotFound
+	FindBySessionID(ctx context.Context, sessionID string) (*model.UploadSession, error)
+	// Update 读取会话后调用 mutate 修改并写回。并发修改同一会话时基于版本号自动重试，
+	// mutate 可能被调用多次，每次传入的都是最新读取的会话
+	Update(ctx context.Context, sessionID string, mutate func(session *model.UploadSession)) (*model.UploadSession, error)
+	// ListActiveByOwnerID 返回用户名下所有未过期的会话
+	ListActiveByOwnerID(ctx context.Context, ownerID uint) ([]*model.UploadSession, error)
+	// FindExpired 返回过期时间早于 before 的会话
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.UploadSession, error)
+	// ExistingSessionIDs 返回给定会话ID中仍存在于数据库的部分
+	ExistingSessionIDs(ctx context.Context, sessionIDs []string) (map[string]bool, error)
+	Delete(ctx context.Context, sessionID string) error
+}
@@ -0,0 +1,26 @@
+/*
+ * @Description: 用户文章书签仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// UserBookmarkRepository 定义了用户文章书签的数据仓库接口。
+type UserBookmarkRepository interface {
+	// Add 收藏文章，重复收藏时保持原有收藏时间
+	Add(ctx context.Context, userID, articleID uint) error
+	// Remove 取消收藏，书签不存在时不返回错误
+	Remove(ctx context.Context, userID, articleID uint) error
+	// Exists 判断用户是否已收藏文章
+	Exists(ctx context.Context, userID, articleID uint) (bool, error)
+	// List 按收藏时间倒序分页获取用户的书签
+	List(ctx context.Context, userID uint, page, pageSize int) ([]*model.UserBookmark, int, error)
+}
@@ -0,0 +1,28 @@
+/*
+ * @Description: 用户社交账号绑定仓库接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// UserIdentityRepository 定义了用户社交账号绑定的数据仓库接口。
+type UserIdentityRepository interface {
+	// FindByProviderUserID 按第三方平台与其用户标识查找绑定，不存在时返回 (nil, nil)
+	FindByProviderUserID(ctx context.Context, provider, providerUserID string) (*model.UserIdentity, error)
+	// ListByUserID 返回用户绑定的所有第三方账号
+	ListByUserID(ctx context.Context, userID uint) ([]*model.UserIdentity, error)
+	// Create 新增绑定，ID 与时间字段会被回填
+	Create(ctx context.Context, identity *model.UserIdentity) error
+	// UpdateProfile 更新绑定中保存的第三方资料（昵称、头像、邮箱、主页）
+	UpdateProfile(ctx context.Context, identity *model.UserIdentity) error
+	// Delete 解除用户与某个平台的绑定，返回是否存在该绑定
+	Delete(ctx context.Context, userID uint, provider string) (bool, error)
+}
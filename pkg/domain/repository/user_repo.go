@@ -30,6 +30,9 @@ type UserRepository interface {
 	// FindByGroupID 根据用户组ID查找用户列表
 	FindByGroupID(ctx context.Context, groupID uint) ([]*model.User, error)
 
+	// FindByNicknames 根据昵称批量查找用户（精确匹配），用于解析评论中的 @ 提及
+	FindByNicknames(ctx context.Context, nicknames []string) ([]*model.User, error)
+
 	// List 分页查询用户列表，支持搜索关键词、用户组筛选和状态筛选
 	List(ctx context.Context, page, pageSize int, keyword string, groupID *uint, status *int) ([]*model.User, int64, error)
 
@@ -47,4 +50,7 @@ type UserGroupRepository interface {
 
 	// FindAll 获取所有用户组
 	FindAll(ctx context.Context) ([]*model.UserGroup, error)
+
+	// Save 创建或更新用户组
+	Save(ctx context.Context, group *model.UserGroup) error
 }
@@ -47,6 +47,9 @@ type VisitorLogRepository interface {
 	// 获取指定时间范围的访问日志
 	GetByTimeRange(ctx context.Context, startTime, endTime time.Time) ([]*ent.VisitorLog, error)
 
+	// 按ID升序获取指定时间范围内ID大于 afterID 的访问日志，用于分批流式导出
+	GetByTimeRangeAfterID(ctx context.Context, startTime, endTime time.Time, afterID uint, limit int) ([]*ent.VisitorLog, error)
+
 	// 获取指定访客的访问日志
 	GetByVisitorID(ctx context.Context, visitorID string, limit int) ([]*ent.VisitorLog, error)
 
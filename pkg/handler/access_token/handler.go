@@ -0,0 +1,196 @@
+/*
+ * @Description: 个人访问令牌管理处理器：用户管理自己的令牌，管理员查看与吊销全部令牌
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 00:00:00
+ * @LastEditTime: 2026-10-17 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package access_token
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	access_token_service "github.com/anzhiyu-c/anheyu-app/pkg/service/access_token"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了个人访问令牌管理的 HTTP 处理器。
+type Handler struct {
+	svc *access_token_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *access_token_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// currentUserID 从 JWT 中解析当前用户的数据库ID
+func currentUserID(c *gin.Context) (uint, bool) {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !exists || !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return 0, false
+	}
+	userID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "用户ID解析失败")
+		return 0, false
+	}
+	return userID, true
+}
+
+// ListTokens
+// @Summary      获取我的访问令牌
+// @Description  列出当前用户的个人访问令牌（不含令牌明文）
+// @Tags         访问令牌
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]model.AccessToken} "成功响应"
+// @Failure      401 {object} response.Response "未登录"
+// @Router       /user/tokens [get]
+func (h *Handler) ListTokens(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	tokens, err := h.svc.List(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取令牌列表失败: "+err.Error())
+		return
+	}
+	response.Success(c, tokens, "获取列表成功")
+}
+
+// CreateToken
+// @Summary      创建访问令牌
+// @Description  为当前用户创建长期有效的个人访问令牌，供发布脚本、CI 或外部编辑器调用。权限范围 read、content_write、file_upload 用于站点接口（在 Authorization: Bearer 中代替 JWT），create、update、delete、media 用于 Micropub 接口。令牌明文只在本次响应中返回。
+// @Tags         访问令牌
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.CreateAccessTokenRequest true "令牌信息"
+// @Success      200 {object} response.Response{data=model.CreatedAccessTokenResponse} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未登录"
+// @Router       /user/tokens [post]
+func (h *Handler) CreateToken(c *gin.Context) {
+	var req model.CreateAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	created, err := h.svc.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "创建令牌失败: "+err.Error())
+		return
+	}
+	response.Success(c, created, "创建成功，请妥善保存令牌，它不会再次显示")
+}
+
+// RevokeToken
+// @Summary      吊销访问令牌
+// @Tags         访问令牌
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "令牌ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      401 {object} response.Response "未登录"
+// @Failure      404 {object} response.Response "令牌不存在"
+// @Router       /user/tokens/{id} [delete]
+func (h *Handler) RevokeToken(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, "令牌不存在")
+		return
+	}
+	if err := h.svc.Revoke(c.Request.Context(), userID, uint(id)); err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			response.Fail(c, http.StatusNotFound, "令牌不存在")
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "吊销令牌失败: "+err.Error())
+		return
+	}
+	response.Success(c, nil, "吊销成功")
+}
+
+// AdminList
+// @Summary      获取全部访问令牌
+// @Description  管理员分页查看所有用户的个人访问令牌（不含令牌明文），可按用户筛选
+// @Tags         访问令牌
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query int    false "页码"
+// @Param        pageSize query int    false "每页数量"
+// @Param        user_id  query string false "用户公共ID"
+// @Success      200 {object} response.Response{data=[]model.AccessToken} "成功响应"
+// @Failure      400 {object} response.Response "用户ID无效"
+// @Router       /admin/access-tokens [get]
+func (h *Handler) AdminList(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	var userID uint
+	if raw := c.Query("user_id"); raw != "" {
+		id, entityType, err := idgen.DecodePublicID(raw)
+		if err != nil || entityType != idgen.EntityTypeUser {
+			response.Fail(c, http.StatusBadRequest, "用户ID无效")
+			return
+		}
+		userID = id
+	}
+
+	tokens, total, err := h.svc.ListAll(c.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取令牌列表失败: "+err.Error())
+		return
+	}
+	response.SetPaginationHeaders(c, int64(total), page, pageSize)
+	response.Success(c, gin.H{
+		"list":     tokens,
+		"total":    total,
+		"page":     page,
+		"pageSize": pageSize,
+	}, "获取列表成功")
+}
+
+// AdminRevoke
+// @Summary      吊销任意访问令牌
+// @Tags         访问令牌
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "令牌ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      404 {object} response.Response "令牌不存在"
+// @Router       /admin/access-tokens/{id} [delete]
+func (h *Handler) AdminRevoke(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, "令牌不存在")
+		return
+	}
+	if err := h.svc.RevokeAny(c.Request.Context(), uint(id)); err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			response.Fail(c, http.StatusNotFound, "令牌不存在")
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "吊销令牌失败: "+err.Error())
+		return
+	}
+	response.Success(c, nil, "吊销成功")
+}
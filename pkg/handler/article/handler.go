@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/cachepolicy"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/streamexport"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
@@ -25,7 +28,8 @@ import (
 
 // Handler 封装了所有与文章相关的 HTTP 处理器。
 type Handler struct {
-	svc articleSvc.Service
+	svc      articleSvc.Service
+	settings cachepolicy.Getter
 }
 
 // NewHandler 是 Handler 的构造函数。
@@ -33,6 +37,12 @@ func NewHandler(svc articleSvc.Service) *Handler {
 	return &Handler{svc: svc}
 }
 
+// SetCachePolicySettings 注入配置读取器，公开文章接口据此输出可缓存的响应头。
+// 未注入时保持全局的反缓存策略。
+func (h *Handler) SetCachePolicySettings(settings cachepolicy.Getter) {
+	h.settings = settings
+}
+
 // UploadImage 处理文章图片的上传请求。
 // @Summary      上传文章图片
 // @Description  上传文章中使用的图片文件
@@ -124,6 +134,7 @@ func (h *Handler) UploadImage(c *gin.Context) {
 // @Param        tag query string false "标签名称"
 // @Param        year query int false "年份"
 // @Param        month query int false "月份"
+// @Param        fields query string false "只返回列表项中的指定字段，逗号分隔，如 id,title,cover_url"
 // @Success      200 {object} response.Response{data=model.ArticleListResponse} "成功响应"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/articles [get]
@@ -148,7 +159,8 @@ func (h *Handler) ListPublic(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, result, "获取列表成功")
+	response.SetPaginationHeaders(c, result.Total, result.Page, result.PageSize)
+	response.SuccessWithFields(c, result, "list", "获取列表成功")
 }
 
 // ListArchives
@@ -273,6 +285,7 @@ func (h *Handler) Create(c *gin.Context) {
 // @Description  获取配置为在首页卡片中展示的文章列表 (按 home_sort 排序, 最多6篇)
 // @Tags         公开文章
 // @Produce      json
+// @Param        fields query string false "只返回列表项中的指定字段，逗号分隔，如 id,title,cover_url"
 // @Success      200 {object} response.Response{data=[]model.ArticleResponse} "成功响应"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/articles/home [get]
@@ -282,7 +295,10 @@ func (h *Handler) ListHome(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取首页文章列表失败: "+err.Error())
 		return
 	}
-	response.Success(c, articles, "获取列表成功")
+	if h.settings != nil {
+		cachepolicy.Apply(c, h.settings, cachepolicy.ClassHome)
+	}
+	response.SuccessWithFields(c, articles, "", "获取列表成功")
 }
 
 // GetPublic
@@ -311,6 +327,9 @@ func (h *Handler) GetPublic(c *gin.Context) {
 		return
 	}
 
+	if h.settings != nil {
+		cachepolicy.Apply(c, h.settings, cachepolicy.ClassArticle)
+	}
 	response.Success(c, articleResponse, "获取成功")
 }
 
@@ -497,16 +516,18 @@ func (h *Handler) Delete(c *gin.Context) {
 
 // List
 // @Summary      获取文章列表
-// @Description  根据查询参数获取分页的文章列表
+// @Description  根据查询参数获取分页的文章列表，每篇文章附带已发布/待审核评论数与最近评论时间
 // @Tags         文章管理
 // @Produce      json
 // @Param        page query int false "页码" default(1)
 // @Param        pageSize query int false "每页数量" default(10)
 // @Param        query query string false "搜索关键词 (标题或摘要)"
-// @Param        status query string false "文章状态 (DRAFT, PUBLISHED, ARCHIVED)" Enums(DRAFT, PUBLISHED, ARCHIVED)
+// @Param        status query string false "文章状态 (DRAFT, PUBLISHED, ARCHIVED, SCHEDULED)" Enums(DRAFT, PUBLISHED, ARCHIVED, SCHEDULED)
+// @Param        scheduled query bool false "只列出待定时发布的文章，按发布时间升序"
 // @Param        author_id query string false "作者ID（多人共创功能：普通用户只能查看自己的文章）"
 // @Param        category query string false "分类名称"
 // @Param        tag query string false "标签名称"
+// @Param        fields query string false "只返回列表项中的指定字段，逗号分隔，如 id,title,cover_url"
 // @Success      200 {object} response.Response{data=model.ArticleListResponse} "成功响应"
 // @Failure      403 {object} response.Response "权限不足"
 // @Failure      500 {object} response.Response "服务器内部错误"
@@ -561,6 +582,7 @@ func (h *Handler) List(c *gin.Context) {
 		AuthorID:     authorID,
 		CategoryName: c.Query("category"),
 		TagName:      c.Query("tag"),
+		Scheduled:    c.Query("scheduled") == "true",
 	}
 
 	result, err := h.svc.List(c.Request.Context(), options)
@@ -569,7 +591,8 @@ func (h *Handler) List(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, result, "获取列表成功")
+	response.SetPaginationHeaders(c, result.Total, result.Page, result.PageSize)
+	response.SuccessWithFields(c, result, "list", "获取列表成功")
 }
 
 // getClaims 从 gin.Context 中安全地提取 JWT Claims
@@ -597,6 +620,38 @@ func hasCustomJSConfig(extraConfig *model.ArticleExtraConfig) bool {
 	return extraConfig != nil && extraConfig.CustomJS != nil
 }
 
+// EditorAssist 处理编辑器辅助请求：提取关键词并推荐站内链接。
+// @Summary      编辑器辅助：关键词与站内链接推荐
+// @Description  基于全站已发布文章的 TF-IDF 统计，为编辑中的文章提取候选关键词，并推荐内容相关、可互相引用的已发布文章
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      articleSvc.EditorAssistRequest  true  "文章标题与正文"
+// @Success      200   {object}  response.Response{data=articleSvc.EditorAssistResult}  "获取成功"
+// @Failure      400   {object}  response.Response  "无效的请求参数"
+// @Failure      401   {object}  response.Response  "未授权"
+// @Failure      500   {object}  response.Response  "分析失败"
+// @Router       /articles/editor-assist [post]
+func (h *Handler) EditorAssist(c *gin.Context) {
+	var req articleSvc.EditorAssistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	if strings.TrimSpace(req.Title) == "" && strings.TrimSpace(req.ContentMd) == "" {
+		response.Fail(c, http.StatusBadRequest, "标题和正文不能同时为空")
+		return
+	}
+
+	result, err := h.svc.EditorAssist(c.Request.Context(), &req)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "分析失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "获取成功")
+}
+
 // GetPrimaryColor 处理获取图片主色调的请求。
 // @Summary      获取图片主色调
 // @Description  根据图片URL获取主色调
@@ -729,6 +784,76 @@ func (h *Handler) ExportArticles(c *gin.Context) {
 	c.Data(http.StatusOK, "application/zip", zipData)
 }
 
+// ExportArticlesStream 流式导出文章
+// @Summary      流式导出文章
+// @Description  以 NDJSON 或 CSV 格式分块导出文章（含草稿），管理员导出全部文章，普通用户只导出自己的文章
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      application/x-ndjson,text/csv
+// @Param        format query string false "导出格式：ndjson（默认）或 csv"
+// @Success      200 {file} file "导出文件"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "导出失败"
+// @Router       /articles/export/stream [get]
+func (h *Handler) ExportArticlesStream(c *gin.Context) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	format, err := streamexport.ParseFormat(c.Query("format"))
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var authorID *uint
+	if !isAdminByUserGroup(claims.UserGroupID) {
+		userID, _, err := idgen.DecodePublicID(claims.UserID)
+		if err != nil {
+			response.Fail(c, http.StatusBadRequest, "用户ID解析失败")
+			return
+		}
+		authorID = &userID
+	}
+
+	filename := "articles_export_" + time.Now().Format("20060102_150405")
+	streamexport.Serve(c, format, filename, articleSvc.ArticleCSVHeader, func(w *streamexport.Writer) error {
+		return h.svc.StreamArticles(c.Request.Context(), authorID, func(item articleSvc.ExportArticleItem) error {
+			return w.Write(item)
+		})
+	})
+}
+
+// ExportMarkdown 导出单篇文章为 Markdown 文件
+// @Summary      导出文章 Markdown
+// @Description  导出指定文章为带 front-matter（标题、标签、分类、封面、永久链接、日期）的 Markdown 文件
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      text/markdown
+// @Param        id path string true "文章ID"
+// @Success      200 {file} text/markdown "导出成功"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      404 {object} response.Response "文章不存在"
+// @Router       /articles/{id}/export [get]
+func (h *Handler) ExportMarkdown(c *gin.Context) {
+	if _, err := getClaims(c); err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	filename, content, err := h.svc.ExportArticleMarkdown(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		log.Printf("[Handler.ExportMarkdown] 导出文章 %s 失败: %v", c.Param("id"), err)
+		response.Fail(c, http.StatusNotFound, "导出文章失败: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(filename)))
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", content)
+}
+
 // BatchDelete 批量删除文章
 // @Summary      批量删除文章
 // @Description  根据文章ID列表批量删除文章 (软删除)
@@ -789,12 +914,12 @@ func (h *Handler) BatchDelete(c *gin.Context) {
 
 // ImportArticles 处理文章导入请求
 // @Summary      导入文章
-// @Description  从上传的 JSON 或 ZIP 文件导入文章
+// @Description  从上传的 JSON、ZIP 或带 front-matter 的 Markdown 文件导入文章。ZIP 中没有 articles.json 时按 Markdown 批量导入
 // @Tags         文章管理
 // @Security     BearerAuth
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file formData file true "导入文件（JSON 或 ZIP）"
+// @Param        file formData file true "导入文件（JSON、ZIP 或 Markdown）"
 // @Param        create_categories formData bool false "是否自动创建不存在的分类" default(true)
 // @Param        create_tags formData bool false "是否自动创建不存在的标签" default(true)
 // @Param        skip_existing formData bool false "是否跳过已存在的文章" default(true)
@@ -868,15 +993,18 @@ func (h *Handler) ImportArticles(c *gin.Context) {
 
 	// 5. 根据文件类型调用不同的导入方法
 	var result *articleSvc.ImportResult
-	ext := filepath.Ext(fileHeader.Filename)
+	ext := strings.ToLower(filepath.Ext(fileHeader.Filename))
 
 	switch ext {
 	case ".json":
 		result, err = h.svc.ImportArticlesFromJSON(c.Request.Context(), fileData, importReq)
 	case ".zip":
 		result, err = h.svc.ImportArticlesFromZip(c.Request.Context(), fileData, importReq)
+	case ".md", ".markdown":
+		files := []articleSvc.MarkdownFile{{Name: fileHeader.Filename, Content: fileData}}
+		result, err = h.svc.ImportArticlesFromMarkdown(c.Request.Context(), files, importReq)
 	default:
-		response.Fail(c, http.StatusBadRequest, "不支持的文件格式，仅支持 .json 和 .zip 文件")
+		response.Fail(c, http.StatusBadRequest, "不支持的文件格式，仅支持 .json、.zip 和 .md 文件")
 		return
 	}
 
@@ -0,0 +1,90 @@
+package article
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	articleSvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// reactionVisitorCookie 保存访客标识的 Cookie，与评论回应共用，使同一访客在文章与评论中被识别为同一人
+	reactionVisitorCookie = "anheyu_reaction_visitor"
+	// reactionVisitorMaxAge 访客标识 Cookie 的有效期（一年）
+	reactionVisitorMaxAge = 365 * 24 * 60 * 60
+)
+
+var reactionVisitorPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// reactionVisitorKey 获取访客标识：优先使用 Cookie，没有 Cookie 时使用 IP+UA 指纹并写入 Cookie
+func reactionVisitorKey(c *gin.Context) string {
+	if v, err := c.Cookie(reactionVisitorCookie); err == nil && reactionVisitorPattern.MatchString(v) {
+		return v
+	}
+	sum := md5.Sum([]byte(util.GetRealClientIP(c) + "|" + c.Request.UserAgent()))
+	key := hex.EncodeToString(sum[:])
+	secure := c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(reactionVisitorCookie, key, reactionVisitorMaxAge, "/", "", secure, true)
+	return key
+}
+
+// AddReaction
+// @Summary      为文章添加表情回应
+// @Description  为公开文章添加一种表情回应（like、love、laugh、wow、sad），同一访客对同一文章的每种回应只计一次
+// @Tags         公开文章
+// @Produce      json
+// @Param        id       path string true "文章的公共ID或Abbrlink"
+// @Param        reaction path string true "回应类型" Enums(like, love, laugh, wow, sad)
+// @Success      200 {object} response.Response{data=articleSvc.ReactionResult} "成功响应"
+// @Failure      400 {object} response.Response "不支持的回应类型"
+// @Failure      404 {object} response.Response "文章未找到"
+// @Router       /public/articles/{id}/reactions/{reaction} [post]
+func (h *Handler) AddReaction(c *gin.Context) {
+	result, err := h.svc.React(c.Request.Context(), c.Param("id"), c.Param("reaction"), reactionVisitorKey(c))
+	if err != nil {
+		failReaction(c, err)
+		return
+	}
+	response.Success(c, result, "回应成功")
+}
+
+// RemoveReaction
+// @Summary      撤销文章的表情回应
+// @Tags         公开文章
+// @Produce      json
+// @Param        id       path string true "文章的公共ID或Abbrlink"
+// @Param        reaction path string true "回应类型" Enums(like, love, laugh, wow, sad)
+// @Success      200 {object} response.Response{data=articleSvc.ReactionResult} "成功响应"
+// @Failure      400 {object} response.Response "不支持的回应类型"
+// @Failure      404 {object} response.Response "文章未找到"
+// @Router       /public/articles/{id}/reactions/{reaction} [delete]
+func (h *Handler) RemoveReaction(c *gin.Context) {
+	result, err := h.svc.Unreact(c.Request.Context(), c.Param("id"), c.Param("reaction"), reactionVisitorKey(c))
+	if err != nil {
+		failReaction(c, err)
+		return
+	}
+	response.Success(c, result, "已撤销回应")
+}
+
+func failReaction(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, articleSvc.ErrInvalidReaction), errors.Is(err, articleSvc.ErrReactionVisitorMissing):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, articleSvc.ErrReactionDisabled):
+		response.Fail(c, http.StatusServiceUnavailable, err.Error())
+	case ent.IsNotFound(err):
+		response.Fail(c, http.StatusNotFound, "文章未找到")
+	default:
+		response.Fail(c, http.StatusInternalServerError, "更新文章回应失败: "+err.Error())
+	}
+}
@@ -0,0 +1,175 @@
+/*
+ * @Description: 文章合集 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 01:00:00
+ * @LastEditTime: 2026-10-18 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package article_collection
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/cachepolicy"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	article_collection_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_collection"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了文章合集相关的 HTTP 处理器。
+type Handler struct {
+	svc      *article_collection_service.Service
+	settings cachepolicy.Getter
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *article_collection_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// SetCachePolicySettings 注入缓存策略配置来源，未注入时使用默认 TTL。
+func (h *Handler) SetCachePolicySettings(settings cachepolicy.Getter) {
+	h.settings = settings
+}
+
+// failWithError 根据错误类型返回合适的 HTTP 状态码
+func failWithError(c *gin.Context, err error, prefix string) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, prefix+": 记录不存在")
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, prefix+": "+err.Error())
+	case errors.Is(err, constant.ErrConflict):
+		response.Fail(c, http.StatusConflict, prefix+": "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// List
+// @Summary      获取文章合集列表
+// @Description  获取全部文章合集（包括未公开的合集）
+// @Tags         文章合集
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]model.ArticleCollection} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /article-collections [get]
+func (h *Handler) List(c *gin.Context) {
+	collections, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		failWithError(c, err, "获取合集列表失败")
+		return
+	}
+	response.Success(c, collections, "获取列表成功")
+}
+
+// Get
+// @Summary      获取单个文章合集
+// @Tags         文章合集
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "合集ID"
+// @Success      200 {object} response.Response{data=model.ArticleCollection} "成功响应"
+// @Failure      404 {object} response.Response "合集不存在"
+// @Router       /article-collections/{id} [get]
+func (h *Handler) Get(c *gin.Context) {
+	collection, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWithError(c, err, "获取合集失败")
+		return
+	}
+	response.Success(c, collection, "获取成功")
+}
+
+// Create
+// @Summary      创建文章合集
+// @Tags         文章合集
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SaveArticleCollectionRequest true "合集内容"
+// @Success      200 {object} response.Response{data=model.ArticleCollection} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      409 {object} response.Response "标识已存在"
+// @Router       /article-collections [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req model.SaveArticleCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	collection, err := h.svc.Create(c.Request.Context(), &req)
+	if err != nil {
+		failWithError(c, err, "创建合集失败")
+		return
+	}
+	response.Success(c, collection, "创建成功")
+}
+
+// Update
+// @Summary      更新文章合集
+// @Description  整体替换合集内容，article_ids 的顺序即前台展示顺序
+// @Tags         文章合集
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "合集ID"
+// @Param        body body model.SaveArticleCollectionRequest true "合集内容"
+// @Success      200 {object} response.Response{data=model.ArticleCollection} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "合集不存在"
+// @Failure      409 {object} response.Response "标识已存在"
+// @Router       /article-collections/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req model.SaveArticleCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	collection, err := h.svc.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		failWithError(c, err, "更新合集失败")
+		return
+	}
+	response.Success(c, collection, "更新成功")
+}
+
+// Delete
+// @Summary      删除文章合集
+// @Tags         文章合集
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "合集ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      404 {object} response.Response "合集不存在"
+// @Router       /article-collections/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		failWithError(c, err, "删除合集失败")
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
+
+// GetPublic
+// @Summary      获取前台文章合集
+// @Description  根据标识获取已公开的合集及其文章，供首页横幅、编辑精选等模块使用
+// @Tags         文章合集
+// @Produce      json
+// @Param        slug path string true "合集标识"
+// @Success      200 {object} response.Response{data=model.PublicArticleCollection} "成功响应"
+// @Failure      404 {object} response.Response "合集不存在"
+// @Router       /public/collections/{slug} [get]
+func (h *Handler) GetPublic(c *gin.Context) {
+	collection, err := h.svc.GetPublic(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		failWithError(c, err, "获取合集失败")
+		return
+	}
+	cachepolicy.Apply(c, h.settings, cachepolicy.ClassHome)
+	response.Success(c, collection, "获取成功")
+}
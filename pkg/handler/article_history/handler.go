@@ -16,12 +16,15 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	articleSvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
 	historySvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 )
 
 // Handler 封装了所有与文章历史版本相关的 HTTP 处理器
 type Handler struct {
-	svc historySvc.Service
+	svc        historySvc.Service
+	articleSvc articleSvc.Service
 }
 
 // NewHandler 是 Handler 的构造函数
@@ -29,6 +32,11 @@ func NewHandler(svc historySvc.Service) *Handler {
 	return &Handler{svc: svc}
 }
 
+// SetArticleService 注入文章服务，用于管理端直接恢复历史版本
+func (h *Handler) SetArticleService(svc articleSvc.Service) {
+	h.articleSvc = svc
+}
+
 // getClaims 从请求上下文中获取用户认证信息
 func getClaims(c *gin.Context) (*auth.CustomClaims, error) {
 	claims, exists := c.Get(auth.ClaimsKey)
@@ -243,3 +251,96 @@ func (h *Handler) GetHistoryCount(c *gin.Context) {
 
 	response.Success(c, gin.H{"count": count}, "获取成功")
 }
+
+// DiffRevisions 对比两个版本的差异
+// @Summary      对比两个文章修订版本的差异
+// @Description  返回从 from 版本到 to 版本发生变化的字段，以及 Markdown 正文的逐行差异片段
+// @Tags         文章历史版本
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "文章公共ID"
+// @Param        from query int true "起始版本"
+// @Param        to query int true "目标版本"
+// @Success      200 {object} response.Response{data=model.ArticleRevisionDiffResponse}
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      403 {object} response.Response "无权限"
+// @Failure      404 {object} response.Response "版本不存在"
+// @Router       /articles/{id}/revisions/diff [get]
+func (h *Handler) DiffRevisions(c *gin.Context) {
+	articleID := c.Param("id")
+	if articleID == "" {
+		response.Fail(c, http.StatusBadRequest, "文章ID不能为空")
+		return
+	}
+
+	from, err1 := strconv.Atoi(c.Query("from"))
+	to, err2 := strconv.Atoi(c.Query("to"))
+	if err1 != nil || err2 != nil || from <= 0 || to <= 0 {
+		response.Fail(c, http.StatusBadRequest, "请提供有效的版本号")
+		return
+	}
+
+	result, err := h.svc.DiffVersions(c.Request.Context(), articleID, from, to)
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, "版本对比失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, result, "获取成功")
+}
+
+// RestoreRevision 将文章恢复到指定版本
+// @Summary      恢复文章到指定修订版本
+// @Description  使用历史版本的标题、正文与元数据更新文章，恢复操作本身会生成一个新的版本
+// @Tags         文章历史版本
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "文章公共ID"
+// @Param        version path int true "版本号"
+// @Param        body body model.RestoreHistoryRequest false "恢复请求参数"
+// @Success      200 {object} response.Response{data=model.ArticleResponse}
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      403 {object} response.Response "无权限"
+// @Failure      404 {object} response.Response "版本不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /articles/{id}/revisions/{version}/restore [post]
+func (h *Handler) RestoreRevision(c *gin.Context) {
+	if h.articleSvc == nil {
+		response.Fail(c, http.StatusInternalServerError, "文章服务未初始化")
+		return
+	}
+
+	articleID := c.Param("id")
+	if articleID == "" {
+		response.Fail(c, http.StatusBadRequest, "文章ID不能为空")
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version <= 0 {
+		response.Fail(c, http.StatusBadRequest, "无效的版本号")
+		return
+	}
+
+	// 请求体可选
+	var req model.RestoreHistoryRequest
+	_ = c.ShouldBindJSON(&req)
+
+	history, err := h.svc.RestoreVersion(c.Request.Context(), articleID, version)
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, "获取历史版本失败: "+err.Error())
+		return
+	}
+
+	updateReq := historySvc.BuildRestoreRequest(history, req.ChangeNote)
+	article, err := h.articleSvc.Update(c.Request.Context(), articleID, updateReq, util.GetRealClientIP(c), c.GetHeader("Referer"))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "恢复历史版本失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, article, "恢复成功")
+}
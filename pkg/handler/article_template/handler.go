@@ -0,0 +1,279 @@
+/*
+ * @Description: 文章模板与内容片段 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package article_template
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	article_template_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_template"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了文章模板与内容片段相关的 HTTP 处理器。
+type Handler struct {
+	svc *article_template_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *article_template_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// failWithError 根据错误类型返回合适的 HTTP 状态码
+func failWithError(c *gin.Context, err error, prefix string) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, prefix+": 记录不存在")
+	case errors.Is(err, constant.ErrConflict):
+		response.Fail(c, http.StatusConflict, prefix+": "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// ListTemplates
+// @Summary      获取文章模板列表
+// @Description  获取全部文章模板，供编辑器新建文章时选择
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]model.ArticleTemplate} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /article-templates [get]
+func (h *Handler) ListTemplates(c *gin.Context) {
+	templates, err := h.svc.ListTemplates(c.Request.Context())
+	if err != nil {
+		failWithError(c, err, "获取模板列表失败")
+		return
+	}
+	response.Success(c, templates, "获取列表成功")
+}
+
+// GetTemplate
+// @Summary      获取单个文章模板
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "模板ID"
+// @Success      200 {object} response.Response{data=model.ArticleTemplate} "成功响应"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Router       /article-templates/{id} [get]
+func (h *Handler) GetTemplate(c *gin.Context) {
+	tpl, err := h.svc.GetTemplate(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWithError(c, err, "获取模板失败")
+		return
+	}
+	response.Success(c, tpl, "获取成功")
+}
+
+// CreateTemplate
+// @Summary      创建文章模板
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SaveArticleTemplateRequest true "模板内容"
+// @Success      200 {object} response.Response{data=model.ArticleTemplate} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      409 {object} response.Response "名称已存在"
+// @Router       /article-templates [post]
+func (h *Handler) CreateTemplate(c *gin.Context) {
+	var req model.SaveArticleTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	tpl, err := h.svc.CreateTemplate(c.Request.Context(), &req)
+	if err != nil {
+		failWithError(c, err, "创建模板失败")
+		return
+	}
+	response.Success(c, tpl, "创建成功")
+}
+
+// UpdateTemplate
+// @Summary      更新文章模板
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "模板ID"
+// @Param        body body model.SaveArticleTemplateRequest true "模板内容"
+// @Success      200 {object} response.Response{data=model.ArticleTemplate} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Failure      409 {object} response.Response "名称已存在"
+// @Router       /article-templates/{id} [put]
+func (h *Handler) UpdateTemplate(c *gin.Context) {
+	var req model.SaveArticleTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	tpl, err := h.svc.UpdateTemplate(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		failWithError(c, err, "更新模板失败")
+		return
+	}
+	response.Success(c, tpl, "更新成功")
+}
+
+// DeleteTemplate
+// @Summary      删除文章模板
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "模板ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Router       /article-templates/{id} [delete]
+func (h *Handler) DeleteTemplate(c *gin.Context) {
+	if err := h.svc.DeleteTemplate(c.Request.Context(), c.Param("id")); err != nil {
+		failWithError(c, err, "删除模板失败")
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
+
+// Instantiate
+// @Summary      根据模板新建草稿
+// @Description  使用模板中的默认值（标题、正文骨架、摘要、标签分类、版权信息）为当前用户创建一篇草稿
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "模板ID"
+// @Param        body body model.InstantiateTemplateRequest false "覆盖模板的字段"
+// @Success      200 {object} response.Response{data=model.ArticleResponse} "成功响应"
+// @Failure      401 {object} response.Response "未登录"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /article-templates/{id}/instantiate [post]
+func (h *Handler) Instantiate(c *gin.Context) {
+	var req model.InstantiateTemplateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+			return
+		}
+	}
+
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !exists || !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "用户ID解析失败")
+		return
+	}
+
+	article, err := h.svc.Instantiate(c.Request.Context(), c.Param("id"), ownerID, &req, util.GetRealClientIP(c), c.GetHeader("Referer"))
+	if err != nil {
+		failWithError(c, err, "根据模板创建草稿失败")
+		return
+	}
+	response.Success(c, article, "创建草稿成功")
+}
+
+// ListSnippets
+// @Summary      获取内容片段列表
+// @Description  获取全部可插入编辑器的内容片段
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]model.ContentSnippet} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /content-snippets [get]
+func (h *Handler) ListSnippets(c *gin.Context) {
+	snippets, err := h.svc.ListSnippets(c.Request.Context())
+	if err != nil {
+		failWithError(c, err, "获取片段列表失败")
+		return
+	}
+	response.Success(c, snippets, "获取列表成功")
+}
+
+// CreateSnippet
+// @Summary      创建内容片段
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SaveContentSnippetRequest true "片段内容"
+// @Success      200 {object} response.Response{data=model.ContentSnippet} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      409 {object} response.Response "名称已存在"
+// @Router       /content-snippets [post]
+func (h *Handler) CreateSnippet(c *gin.Context) {
+	var req model.SaveContentSnippetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	snippet, err := h.svc.CreateSnippet(c.Request.Context(), &req)
+	if err != nil {
+		failWithError(c, err, "创建片段失败")
+		return
+	}
+	response.Success(c, snippet, "创建成功")
+}
+
+// UpdateSnippet
+// @Summary      更新内容片段
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "片段ID"
+// @Param        body body model.SaveContentSnippetRequest true "片段内容"
+// @Success      200 {object} response.Response{data=model.ContentSnippet} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "片段不存在"
+// @Failure      409 {object} response.Response "名称已存在"
+// @Router       /content-snippets/{id} [put]
+func (h *Handler) UpdateSnippet(c *gin.Context) {
+	var req model.SaveContentSnippetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	snippet, err := h.svc.UpdateSnippet(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		failWithError(c, err, "更新片段失败")
+		return
+	}
+	response.Success(c, snippet, "更新成功")
+}
+
+// DeleteSnippet
+// @Summary      删除内容片段
+// @Tags         文章模板
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "片段ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      404 {object} response.Response "片段不存在"
+// @Router       /content-snippets/{id} [delete]
+func (h *Handler) DeleteSnippet(c *gin.Context) {
+	if err := h.svc.DeleteSnippet(c.Request.Context(), c.Param("id")); err != nil {
+		failWithError(c, err, "删除片段失败")
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
@@ -0,0 +1,79 @@
+/*
+ * @Description: 审计日志 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	audit_service "github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了审计日志相关的 HTTP 处理器。
+type Handler struct {
+	svc *audit_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *audit_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// List
+// @Summary      获取审计日志
+// @Description  分页查询管理员敏感操作的审计日志，包括模拟登录记录，以及设置、文章、评论、存储策略和用户的变更（含变更前后快照）
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        actorId    query string false "操作者（管理员）公共ID"
+// @Param        subjectId  query string false "被模拟的用户公共ID"
+// @Param        action     query string false "操作类型，如 impersonation.start、article.update"
+// @Param        entityType query string false "对象类型" Enums(setting, article, comment, storage_policy, user, user_group)
+// @Param        entityId   query string false "对象的公共ID或设置键名"
+// @Param        startTime  query string false "起始时间（含），RFC3339 或 2006-01-02"
+// @Param        endTime    query string false "结束时间，RFC3339（不含）或 2006-01-02（含当天）"
+// @Param        page       query int    false "页码" default(1)
+// @Param        pageSize   query int    false "每页数量" default(20)
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/audit-logs [get]
+func (h *Handler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	list, total, err := h.svc.List(c.Request.Context(), &audit_service.ListFilter{
+		ActorID:    c.Query("actorId"),
+		SubjectID:  c.Query("subjectId"),
+		Action:     c.Query("action"),
+		EntityType: c.Query("entityType"),
+		EntityID:   c.Query("entityId"),
+		StartTime:  c.Query("startTime"),
+		EndTime:    c.Query("endTime"),
+		Page:       page,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, gin.H{
+		"list":     list,
+		"total":    total,
+		"page":     page,
+		"pageSize": pageSize,
+	}, "获取成功")
+}
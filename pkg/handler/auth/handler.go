@@ -7,12 +7,17 @@ import (
 	"strings"
 	"time"
 
+	internal_auth "github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/loginguard"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/password"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -23,6 +28,12 @@ type AuthHandler struct {
 	tokenSvc   auth.TokenService
 	settingSvc setting.SettingService
 	captchaSvc captcha.CaptchaService
+	// passwordPolicy 可选，用于下发密码策略提示
+	passwordPolicy *password.Service
+	// oauthSvc 可选，第三方登录服务
+	oauthSvc auth.OAuthService
+	// loginGuard 可选，登录失败次数过多时暂时锁定账号与 IP
+	loginGuard *loginguard.Service
 }
 
 // NewAuthHandler 是 AuthHandler 的构造函数，用于依赖注入
@@ -35,10 +46,24 @@ func NewAuthHandler(authSvc auth.AuthService, tokenSvc auth.TokenService, settin
 	}
 }
 
+// SetPasswordPolicyService 注入密码策略服务
+func (h *AuthHandler) SetPasswordPolicyService(svc *password.Service) {
+	h.passwordPolicy = svc
+}
+
+// SetLoginGuard 注入登录防暴力破解服务
+func (h *AuthHandler) SetLoginGuard(svc *loginguard.Service) {
+	h.loginGuard = svc
+}
+
 // CaptchaParams 统一验证码参数（嵌入到请求中）
 type CaptchaParams struct {
 	// Turnstile 参数
 	TurnstileToken string `json:"turnstile_token,omitempty"`
+	// hCaptcha 参数
+	HCaptchaToken string `json:"hcaptcha_token,omitempty"`
+	// reCAPTCHA 参数
+	RecaptchaToken string `json:"recaptcha_token,omitempty"`
 	// 极验参数
 	GeetestLotNumber     string `json:"geetest_lot_number,omitempty"`
 	GeetestCaptchaOutput string `json:"geetest_captcha_output,omitempty"`
@@ -62,6 +87,7 @@ type RegisterRequest struct {
 	Nickname       string `json:"nickname" binding:"required"`
 	Password       string `json:"password" binding:"required,min=6"`
 	RepeatPassword string `json:"repeat_password" binding:"required"`
+	InvitationCode string `json:"invitation_code"` // 开启邀请注册时必填
 	CaptchaParams
 }
 
@@ -90,6 +116,27 @@ type ResetPasswordRequest struct {
 	RepeatPassword string `json:"repeat_password" binding:"required"`
 }
 
+// UploadTokenRequest 定义了签发编辑器上传令牌请求的结构
+type UploadTokenRequest struct {
+	// Scope 上传令牌适用的存储策略标识：article_image 或 comment_image
+	Scope string `json:"scope" binding:"required"`
+}
+
+// uploadTokenTTL 编辑器上传令牌的有效期，过期后由编辑器重新申请
+const uploadTokenTTL = 30 * time.Minute
+
+// uploadTokenScopes 允许签发上传令牌的存储策略
+var uploadTokenScopes = map[string]bool{
+	constant.PolicyFlagArticleImage: true,
+	constant.PolicyFlagCommentImage: true,
+}
+
+// RotateJWTSecretRequest 定义了轮换 JWT 密钥请求的结构
+type RotateJWTSecretRequest struct {
+	// GraceHours 旧密钥的宽限时长（小时），不传时默认 24，0 表示立即失效
+	GraceHours *int `json:"grace_hours" binding:"omitempty,min=0,max=720"`
+}
+
 // UserGroupResponse 定义了用户组的响应结构，用于嵌套在用户信息中
 type UserGroupResponse struct {
 	ID          string `json:"id"`          // 用户组的公共ID，改为 string 类型
@@ -100,17 +147,18 @@ type UserGroupResponse struct {
 
 // LoginUserInfoResponse 定义了登录成功时返回给客户端的用户信息结构
 type LoginUserInfoResponse struct {
-	ID          string            `json:"id"`          // 用户的公共ID
-	CreatedAt   time.Time         `json:"created_at"`  // 创建时间
-	UpdatedAt   time.Time         `json:"updated_at"`  // 更新时间
-	Username    string            `json:"username"`    // 用户名
-	Nickname    string            `json:"nickname"`    // 昵称
-	Avatar      string            `json:"avatar"`      // 头像URL
-	Email       string            `json:"email"`       // 邮箱
-	LastLoginAt *time.Time        `json:"lastLoginAt"` // 最后登录时间
-	UserGroupID uint              `json:"userGroupID"` // 用户组ID (原始的数据库ID，根据需求决定是否暴露)
-	UserGroup   UserGroupResponse `json:"userGroup"`   // 用户的用户组信息 (嵌套 DTO)
-	Status      int               `json:"status"`      // 用户状态
+	ID             string            `json:"id"`                       // 用户的公共ID
+	CreatedAt      time.Time         `json:"created_at"`               // 创建时间
+	UpdatedAt      time.Time         `json:"updated_at"`               // 更新时间
+	Username       string            `json:"username"`                 // 用户名
+	Nickname       string            `json:"nickname"`                 // 昵称
+	Avatar         string            `json:"avatar"`                   // 头像URL
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"` // 上传头像的各尺寸版本URL，键为边长像素
+	Email          string            `json:"email"`                    // 邮箱
+	LastLoginAt    *time.Time        `json:"lastLoginAt"`              // 最后登录时间
+	UserGroupID    uint              `json:"userGroupID"`              // 用户组ID (原始的数据库ID，根据需求决定是否暴露)
+	UserGroup      UserGroupResponse `json:"userGroup"`                // 用户的用户组信息 (嵌套 DTO)
+	Status         int               `json:"status"`                   // 用户状态
 }
 
 // Login 处理用户登录请求
@@ -123,6 +171,7 @@ type LoginUserInfoResponse struct {
 // @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "登录成功"
 // @Failure      400   {object}  response.Response  "邮箱或密码格式不正确"
 // @Failure      401   {object}  response.Response  "认证失败"
+// @Failure      429   {object}  response.Response  "登录失败次数过多，账号或 IP 已被暂时锁定"
 // @Failure      500   {object}  response.Response  "内部错误"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -135,6 +184,8 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	// 0. 验证人机验证（如果启用）
 	captchaParams := captcha.CaptchaParams{
 		TurnstileToken:       req.TurnstileToken,
+		HCaptchaToken:        req.HCaptchaToken,
+		RecaptchaToken:       req.RecaptchaToken,
 		GeetestLotNumber:     req.GeetestLotNumber,
 		GeetestCaptchaOutput: req.GeetestCaptchaOutput,
 		GeetestPassToken:     req.GeetestPassToken,
@@ -142,16 +193,36 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		ImageCaptchaId:       req.ImageCaptchaId,
 		ImageCaptchaAnswer:   req.ImageCaptchaAnswer,
 	}
-	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, c.ClientIP()); err != nil {
+	if err := h.captchaSvc.VerifyScene(c.Request.Context(), captcha.SceneLogin, captchaParams, util.GetRealClientIP(c)); err != nil {
 		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
+	attempt := loginguard.Attempt{
+		Email:     req.Email,
+		IP:        util.GetRealClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+	}
+	if h.loginGuard != nil {
+		if err := h.loginGuard.Check(c.Request.Context(), attempt); err != nil {
+			failLocked(c, err)
+			return
+		}
+	}
+
 	// 1. 调用认证服务进行登录逻辑处理
 	user, err := h.authSvc.Login(c.Request.Context(), req.Email, req.Password)
 	if err != nil {
 		switch {
 		case errors.Is(err, auth.ErrInvalidCredentials), errors.Is(err, auth.ErrPasswordIncorrect):
+			if h.loginGuard != nil {
+				if lockErr := h.loginGuard.RecordFailure(c.Request.Context(), attempt); lockErr != nil {
+					failLocked(c, lockErr)
+					return
+				}
+			}
 			response.Fail(c, http.StatusUnauthorized, err.Error())
 		case errors.Is(err, auth.ErrAuthServiceBusy):
 			response.Fail(c, http.StatusServiceUnavailable, err.Error())
@@ -160,13 +231,34 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		}
 		return
 	}
+	if h.loginGuard != nil {
+		h.loginGuard.RecordSuccess(c.Request.Context(), attempt)
+	}
 
+	data, err := h.buildLoginData(c, user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, data, "登录成功")
+}
+
+// failLocked 以 429 响应处于锁定期的登录请求，并通过 Retry-After 告知剩余秒数
+func failLocked(c *gin.Context, err error) {
+	var locked *loginguard.LockedError
+	if errors.As(err, &locked) {
+		c.Header("Retry-After", fmt.Sprintf("%d", int(locked.RetryAfter.Seconds()+0.5)))
+	}
+	response.Fail(c, http.StatusTooManyRequests, err.Error())
+}
+
+// buildLoginData 为登录成功的用户签发会话令牌，并构建与登录接口一致的响应数据
+func (h *AuthHandler) buildLoginData(c *gin.Context, user *model.User) (gin.H, error) {
 	// 2. 调用令牌服务生成会话令牌
 	// 注意：这里的 GenerateSessionTokens 内部也需要更新为使用 GeneratePublicID
 	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user)
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "生成令牌失败: "+err.Error())
-		return
+		return nil, fmt.Errorf("生成令牌失败: %w", err)
 	}
 
 	// 3. 构建 roles 数组
@@ -175,15 +267,13 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	// 4. 生成用户的公共 ID
 	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser) // 统一使用 GeneratePublicID
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "生成用户公共ID失败")
-		return
+		return nil, errors.New("生成用户公共ID失败")
 	}
 
 	// 5. 生成用户组的公共 ID
 	publicUserGroupID, err := idgen.GeneratePublicID(user.UserGroup.ID, idgen.EntityTypeUserGroup) // 统一使用 GeneratePublicID
 	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, "生成用户组公共ID失败")
-		return
+		return nil, errors.New("生成用户组公共ID失败")
 	}
 
 	// 处理头像URL：如果是完整URL则直接使用，否则拼接gravatar URL
@@ -195,15 +285,16 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 	// 6. 构建 LoginUserInfoResponse DTO，只包含需要暴露给客户端的字段
 	userInfoResp := LoginUserInfoResponse{
-		ID:          publicUserID, // 返回公共ID
-		CreatedAt:   user.CreatedAt,
-		UpdatedAt:   user.UpdatedAt,
-		Username:    user.Username,
-		Nickname:    user.Nickname,
-		Avatar:      avatar,
-		Email:       user.Email,
-		LastLoginAt: user.LastLoginAt,
-		UserGroupID: user.UserGroupID,
+		ID:             publicUserID, // 返回公共ID
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		Username:       user.Username,
+		Nickname:       user.Nickname,
+		Avatar:         avatar,
+		AvatarVariants: user.AvatarVariants,
+		Email:          user.Email,
+		LastLoginAt:    user.LastLoginAt,
+		UserGroupID:    user.UserGroupID,
 		UserGroup: UserGroupResponse{
 			ID:          publicUserGroupID, // 返回用户组的公共ID
 			Name:        user.UserGroup.Name,
@@ -212,25 +303,26 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Status: user.Status,
 	}
 
-	// 7. 返回成功响应
-	response.Success(c, gin.H{
+	// 7. 返回响应数据
+	return gin.H{
 		"userInfo":     userInfoResp, // 返回包含公共ID和用户组信息的 DTO
 		"roles":        roles,
 		"accessToken":  accessToken,
 		"refreshToken": refreshToken,
 		"expires":      expires,
-	}, "登录成功")
+	}, nil
 }
 
 // Register 处理用户注册请求
 // @Summary      用户注册
-// @Description  创建新用户账号
+// @Description  创建新用户账号；站点可关闭注册、限制邮箱域名、拦截一次性邮箱或要求填写邀请码
 // @Tags         用户认证
 // @Accept       json
 // @Produce      json
 // @Param        body  body      RegisterRequest  true  "注册信息"
-// @Success      200   {object}  response.Response  "注册成功"
-// @Failure      400   {object}  response.Response  "参数错误"
+// @Success      200   {object}  response.Response{data=auth.RegisterResult}  "注册成功"
+// @Failure      400   {object}  response.Response  "参数错误或邀请码无效"
+// @Failure      403   {object}  response.Response  "注册已关闭、邮箱域名不允许或使用了一次性邮箱"
 // @Failure      500   {object}  response.Response  "内部错误"
 // @Router       /auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
@@ -247,6 +339,8 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	// 验证人机验证（如果启用）
 	captchaParams := captcha.CaptchaParams{
 		TurnstileToken:       req.TurnstileToken,
+		HCaptchaToken:        req.HCaptchaToken,
+		RecaptchaToken:       req.RecaptchaToken,
 		GeetestLotNumber:     req.GeetestLotNumber,
 		GeetestCaptchaOutput: req.GeetestCaptchaOutput,
 		GeetestPassToken:     req.GeetestPassToken,
@@ -254,22 +348,39 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		ImageCaptchaId:       req.ImageCaptchaId,
 		ImageCaptchaAnswer:   req.ImageCaptchaAnswer,
 	}
-	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, c.ClientIP()); err != nil {
+	if err := h.captchaSvc.VerifyScene(c.Request.Context(), captcha.SceneRegister, captchaParams, util.GetRealClientIP(c)); err != nil {
 		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	activationRequired, err := h.authSvc.Register(c.Request.Context(), req.Email, req.Nickname, req.Password)
+	result, err := h.authSvc.Register(c.Request.Context(), req.Email, req.Nickname, req.Password, req.InvitationCode)
 	if err != nil {
+		switch {
+		case errors.Is(err, password.ErrWeakPassword):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, auth.ErrSetupRequired),
+			errors.Is(err, auth.ErrRegistrationClosed),
+			errors.Is(err, auth.ErrEmailDomainNotAllowed),
+			errors.Is(err, constant.ErrDisposableEmail):
+			response.Fail(c, http.StatusForbidden, err.Error())
+			return
+		case errors.Is(err, auth.ErrInvitationCodeRequired),
+			errors.Is(err, auth.ErrInvitationCodeInvalid):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusConflict, err.Error())
 		return
 	}
 
 	message := "注册成功"
-	if activationRequired {
+	if result.ReviewRequired {
+		message = "注册成功，账户需等待管理员审核后方可登录"
+	} else if result.ActivationRequired {
 		message = "注册成功，请查收激活邮件以完成注册"
 	}
-	response.Success(c, gin.H{"activation_required": activationRequired}, message)
+	response.Success(c, result, message)
 }
 
 // RefreshToken 刷新访问 Token
@@ -387,15 +498,16 @@ func (h *AuthHandler) ActivateUser(c *gin.Context) {
 
 	// 构建用户信息响应
 	userInfoResp := LoginUserInfoResponse{
-		ID:          publicUserID,
-		CreatedAt:   user.CreatedAt,
-		UpdatedAt:   user.UpdatedAt,
-		Username:    user.Username,
-		Nickname:    user.Nickname,
-		Avatar:      avatar,
-		Email:       user.Email,
-		LastLoginAt: user.LastLoginAt,
-		UserGroupID: user.UserGroupID,
+		ID:             publicUserID,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		Username:       user.Username,
+		Nickname:       user.Nickname,
+		Avatar:         avatar,
+		AvatarVariants: user.AvatarVariants,
+		Email:          user.Email,
+		LastLoginAt:    user.LastLoginAt,
+		UserGroupID:    user.UserGroupID,
 		UserGroup: UserGroupResponse{
 			ID:          publicUserGroupID,
 			Name:        user.UserGroup.Name,
@@ -434,6 +546,8 @@ func (h *AuthHandler) ForgotPasswordRequest(c *gin.Context) {
 	// 验证人机验证（如果启用）
 	captchaParams := captcha.CaptchaParams{
 		TurnstileToken:       req.TurnstileToken,
+		HCaptchaToken:        req.HCaptchaToken,
+		RecaptchaToken:       req.RecaptchaToken,
 		GeetestLotNumber:     req.GeetestLotNumber,
 		GeetestCaptchaOutput: req.GeetestCaptchaOutput,
 		GeetestPassToken:     req.GeetestPassToken,
@@ -441,7 +555,7 @@ func (h *AuthHandler) ForgotPasswordRequest(c *gin.Context) {
 		ImageCaptchaId:       req.ImageCaptchaId,
 		ImageCaptchaAnswer:   req.ImageCaptchaAnswer,
 	}
-	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, c.ClientIP()); err != nil {
+	if err := h.captchaSvc.VerifyScene(c.Request.Context(), captcha.SceneForgotPassword, captchaParams, util.GetRealClientIP(c)); err != nil {
 		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -485,6 +599,10 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 	}
 
 	if err := h.authSvc.PerformPasswordReset(c.Request.Context(), userID, req.Sign, req.Password); err != nil { // 传递数据库ID
+		if errors.Is(err, password.ErrWeakPassword) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusUnauthorized, err.Error())
 		return
 	}
@@ -492,6 +610,21 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 	response.Success(c, nil, "密码重置成功，请使用新密码登录。")
 }
 
+// GetPasswordPolicy 获取当前的密码策略
+// @Summary      获取密码策略
+// @Description  返回注册、重置与修改密码时生效的密码强度要求，供前端在输入时提示
+// @Tags         用户认证
+// @Produce      json
+// @Success      200  {object}  response.Response{data=password.Policy}  "获取成功"
+// @Router       /auth/password-policy [get]
+func (h *AuthHandler) GetPasswordPolicy(c *gin.Context) {
+	if h.passwordPolicy == nil {
+		response.Fail(c, http.StatusNotFound, "密码策略未启用")
+		return
+	}
+	response.Success(c, h.passwordPolicy.Policy(), "获取成功")
+}
+
 // CheckEmail 检查邮箱是否已被注册
 // @Summary      检查邮箱
 // @Description  检查邮箱是否已被注册
@@ -518,3 +651,76 @@ func (h *AuthHandler) CheckEmail(c *gin.Context) {
 	response.Success(c, gin.H{"exists": exists}, "查询成功")
 
 }
+
+// RotateJWTSecret 轮换 JWT 密钥
+// @Summary      轮换 JWT 密钥
+// @Description  生成新的 JWT 密钥，旧密钥在宽限期内仍可验证已签发的令牌；宽限期为 0 时所有用户需要重新登录
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      RotateJWTSecretRequest  false  "宽限时长"
+// @Success      200   {object}  response.Response{data=object{previous_expires_at=time.Time}}  "轮换成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      500   {object}  response.Response  "轮换失败"
+// @Router       /admin/security/jwt-secret/rotate [post]
+func (h *AuthHandler) RotateJWTSecret(c *gin.Context) {
+	var req RotateJWTSecretRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Fail(c, http.StatusBadRequest, "参数错误：宽限时长需在 0 到 720 小时之间")
+			return
+		}
+	}
+	graceHours := 24
+	if req.GraceHours != nil {
+		graceHours = *req.GraceHours
+	}
+
+	expiresAt, err := h.tokenSvc.RotateJWTSecret(c.Request.Context(), time.Duration(graceHours)*time.Hour)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, gin.H{"previous_expires_at": expiresAt}, "JWT 密钥已轮换")
+}
+
+// IssueUploadToken 为编辑会话签发受限上传令牌
+// @Summary      签发编辑器上传令牌
+// @Description  基于当前登录会话签发 30 分钟有效的上传令牌，令牌只能调用对应策略的图片上传接口，不能访问其他接口或刷新
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      UploadTokenRequest  true  "令牌用途"
+// @Success      200   {object}  response.Response{data=object{uploadToken=string,scope=string,expires=int}}  "签发成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "未登录"
+// @Failure      500   {object}  response.Response  "签发失败"
+// @Router       /auth/upload-token [post]
+func (h *AuthHandler) IssueUploadToken(c *gin.Context) {
+	var req UploadTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !uploadTokenScopes[req.Scope] {
+		response.Fail(c, http.StatusBadRequest, "参数错误：不支持的上传令牌用途")
+		return
+	}
+
+	value, ok := c.Get(internal_auth.ClaimsKey)
+	claims, _ := value.(*internal_auth.CustomClaims)
+	if !ok || claims == nil {
+		response.Fail(c, http.StatusUnauthorized, "用户未登录")
+		return
+	}
+
+	token, expires, err := h.tokenSvc.GenerateUploadToken(c.Request.Context(), claims, req.Scope, uploadTokenTTL)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"uploadToken": token,
+		"scope":       req.Scope,
+		"expires":     expires,
+	}, "签发上传令牌成功")
+}
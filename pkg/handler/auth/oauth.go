@@ -0,0 +1,295 @@
+/*
+ * @Description: 第三方登录（GitHub、Google、QQ）相关的 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package auth_handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	internal_auth "github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// oauthStateCookie 保存 state 的 Cookie，回调时校验 state 由同一浏览器发起，防止登录 CSRF
+	oauthStateCookie = "anheyu_oauth_state"
+	// oauthCookiePath Cookie 只在第三方登录接口下发送
+	oauthCookiePath = "/api/auth/oauth"
+	// oauthCookieMaxAge 与服务端 state 有效期一致（秒）
+	oauthCookieMaxAge = 600
+)
+
+// OAuthBindRequest 定义了发起第三方账号绑定请求的结构
+type OAuthBindRequest struct {
+	// Redirect 绑定完成后跳转的站内路径
+	Redirect string `json:"redirect"`
+}
+
+// OAuthExchangeRequest 定义了兑换第三方登录凭证请求的结构
+type OAuthExchangeRequest struct {
+	Ticket string `json:"ticket" binding:"required"`
+}
+
+// SetOAuthService 注入第三方登录服务，未注入时第三方登录接口返回 404
+func (h *AuthHandler) SetOAuthService(svc auth.OAuthService) {
+	h.oauthSvc = svc
+}
+
+// OAuthProviders 获取可用的第三方登录方式
+// @Summary      获取第三方登录方式
+// @Description  返回已启用且配置完整的第三方登录平台
+// @Tags         用户认证
+// @Produce      json
+// @Success      200  {object}  response.Response{data=object{providers=[]string}}  "成功"
+// @Router       /auth/oauth/providers [get]
+func (h *AuthHandler) OAuthProviders(c *gin.Context) {
+	providers := []string{}
+	if h.oauthSvc != nil {
+		providers = h.oauthSvc.EnabledProviders()
+	}
+	response.Success(c, gin.H{"providers": providers}, "获取成功")
+}
+
+// OAuthLogin 跳转到第三方平台授权页
+// @Summary      第三方登录
+// @Description  浏览器跳转到此地址发起第三方登录，完成后回到 redirect 指定的站内路径，并携带 oauth_ticket 或 oauth_error 参数
+// @Tags         用户认证
+// @Param        provider  path   string  true   "平台"  Enums(github, google, qq)
+// @Param        redirect  query  string  false  "登录完成后跳转的站内路径"  default(/)
+// @Success      302  "跳转到第三方平台授权页"
+// @Router       /auth/oauth/{provider}/login [get]
+func (h *AuthHandler) OAuthLogin(c *gin.Context) {
+	redirect := auth.SanitizeOAuthRedirect(c.Query("redirect"))
+	if h.oauthSvc == nil {
+		c.Redirect(http.StatusFound, withQuery(redirect, "oauth_error", auth.ErrOAuthProviderDisabled.Error()))
+		return
+	}
+	authURL, state, err := h.oauthSvc.AuthorizeURL(c.Request.Context(), c.Param("provider"), redirect, 0)
+	if err != nil {
+		c.Redirect(http.StatusFound, withQuery(redirect, "oauth_error", err.Error()))
+		return
+	}
+	h.setOAuthStateCookie(c, state, oauthCookieMaxAge)
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OAuthBind 为当前登录用户生成绑定第三方账号的授权地址
+// @Summary      绑定第三方账号
+// @Description  返回第三方平台授权地址，前端在同一浏览器中跳转；完成后回到 redirect 并携带 oauth_bound 或 oauth_error 参数
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        provider  path  string            true   "平台"  Enums(github, google, qq)
+// @Param        body      body  OAuthBindRequest  false  "绑定参数"
+// @Success      200  {object}  response.Response{data=object{url=string}}  "成功"
+// @Failure      400  {object}  response.Response  "该登录方式未启用"
+// @Failure      401  {object}  response.Response  "未登录"
+// @Router       /auth/oauth/{provider}/bind [post]
+func (h *AuthHandler) OAuthBind(c *gin.Context) {
+	if h.oauthSvc == nil {
+		response.Fail(c, http.StatusNotFound, auth.ErrOAuthProviderDisabled.Error())
+		return
+	}
+	userID, ok := currentUserID(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户未登录")
+		return
+	}
+	var req OAuthBindRequest
+	_ = c.ShouldBindJSON(&req)
+
+	authURL, state, err := h.oauthSvc.AuthorizeURL(c.Request.Context(), c.Param("provider"), req.Redirect, userID)
+	if err != nil {
+		h.oauthFail(c, err)
+		return
+	}
+	h.setOAuthStateCookie(c, state, oauthCookieMaxAge)
+	response.Success(c, gin.H{"url": authURL}, "获取成功")
+}
+
+// OAuthCallback 处理第三方平台的授权回调
+// @Summary      第三方登录回调
+// @Description  第三方平台授权完成后回调此地址，处理后跳转回发起时指定的站内路径
+// @Tags         用户认证
+// @Param        provider  path   string  true  "平台"  Enums(github, google, qq)
+// @Param        code      query  string  true  "授权码"
+// @Param        state     query  string  true  "state"
+// @Success      302  "跳转回站内，携带 oauth_ticket、oauth_bound 或 oauth_error 参数"
+// @Router       /auth/oauth/{provider}/callback [get]
+func (h *AuthHandler) OAuthCallback(c *gin.Context) {
+	if h.oauthSvc == nil {
+		c.Redirect(http.StatusFound, withQuery("/", "oauth_error", auth.ErrOAuthProviderDisabled.Error()))
+		return
+	}
+	state := c.Query("state")
+	cookieState, _ := c.Cookie(oauthStateCookie)
+	h.setOAuthStateCookie(c, "", -1)
+	if state == "" || cookieState != state {
+		c.Redirect(http.StatusFound, withQuery("/", "oauth_error", auth.ErrOAuthStateInvalid.Error()))
+		return
+	}
+
+	provider := c.Param("provider")
+	result, err := h.oauthSvc.HandleCallback(c.Request.Context(), provider, c.Query("code"), state)
+	redirect := "/"
+	if result != nil {
+		redirect = result.Redirect
+	}
+	switch {
+	case err != nil:
+		c.Redirect(http.StatusFound, withQuery(redirect, "oauth_error", err.Error()))
+	case result.Bound:
+		c.Redirect(http.StatusFound, withQuery(redirect, "oauth_bound", provider))
+	default:
+		c.Redirect(http.StatusFound, withQuery(redirect, "oauth_ticket", result.Ticket))
+	}
+}
+
+// OAuthExchange 使用第三方登录凭证换取会话令牌
+// @Summary      兑换第三方登录凭证
+// @Description  回调跳转携带的 oauth_ticket 一次有效，兑换得到与登录接口相同的数据，并附带第三方资料（oauthProfile）用于评论身份自动填充
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body      OAuthExchangeRequest  true  "登录凭证"
+// @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string,oauthProfile=model.UserIdentity}}  "登录成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "凭证无效或已过期"
+// @Failure      403   {object}  response.Response  "账户已被封禁"
+// @Router       /auth/oauth/exchange [post]
+func (h *AuthHandler) OAuthExchange(c *gin.Context) {
+	if h.oauthSvc == nil {
+		response.Fail(c, http.StatusNotFound, auth.ErrOAuthProviderDisabled.Error())
+		return
+	}
+	var req OAuthExchangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：缺少登录凭证")
+		return
+	}
+	user, profile, err := h.oauthSvc.RedeemTicket(c.Request.Context(), req.Ticket)
+	if err != nil {
+		h.oauthFail(c, err)
+		return
+	}
+	data, err := h.buildLoginData(c, user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	data["oauthProfile"] = profile
+	response.Success(c, data, "登录成功")
+}
+
+// OAuthIdentities 获取当前用户绑定的第三方账号
+// @Summary      获取已绑定的第三方账号
+// @Description  返回当前用户绑定的第三方账号及其资料，可用于评论身份自动填充
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]model.UserIdentity}  "成功"
+// @Failure      401  {object}  response.Response  "未登录"
+// @Router       /auth/oauth/identities [get]
+func (h *AuthHandler) OAuthIdentities(c *gin.Context) {
+	if h.oauthSvc == nil {
+		response.Fail(c, http.StatusNotFound, auth.ErrOAuthProviderDisabled.Error())
+		return
+	}
+	userID, ok := currentUserID(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户未登录")
+		return
+	}
+	identities, err := h.oauthSvc.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, identities, "获取成功")
+}
+
+// OAuthUnlink 解除当前用户与某个平台的绑定
+// @Summary      解除第三方账号绑定
+// @Tags         用户认证
+// @Security     BearerAuth
+// @Produce      json
+// @Param        provider  path  string  true  "平台"  Enums(github, google, qq)
+// @Success      200  {object}  response.Response  "解除成功"
+// @Failure      401  {object}  response.Response  "未登录"
+// @Failure      404  {object}  response.Response  "未绑定该平台"
+// @Router       /auth/oauth/identities/{provider} [delete]
+func (h *AuthHandler) OAuthUnlink(c *gin.Context) {
+	if h.oauthSvc == nil {
+		response.Fail(c, http.StatusNotFound, auth.ErrOAuthProviderDisabled.Error())
+		return
+	}
+	userID, ok := currentUserID(c)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户未登录")
+		return
+	}
+	if err := h.oauthSvc.Unlink(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		h.oauthFail(c, err)
+		return
+	}
+	response.Success(c, nil, "解除绑定成功")
+}
+
+func (h *AuthHandler) oauthFail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, auth.ErrOAuthProviderDisabled):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, auth.ErrOAuthTicketInvalid):
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, auth.ErrOAuthUserBanned), errors.Is(err, auth.ErrOAuthUserPendingReview), errors.Is(err, auth.ErrOAuthUserInactive):
+		response.Fail(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, auth.ErrOAuthNotBound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// setOAuthStateCookie 写入或清除（maxAge < 0）保存 state 的 Cookie
+func (h *AuthHandler) setOAuthStateCookie(c *gin.Context, state string, maxAge int) {
+	secure := c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, state, maxAge, oauthCookiePath, "", secure, true)
+}
+
+// currentUserID 从 JWT 声明中解析当前登录用户的数据库 ID
+func currentUserID(c *gin.Context) (uint, bool) {
+	value, ok := c.Get(internal_auth.ClaimsKey)
+	claims, _ := value.(*internal_auth.CustomClaims)
+	if !ok || claims == nil {
+		return 0, false
+	}
+	userID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || userID == 0 {
+		return 0, false
+	}
+	return userID, true
+}
+
+// withQuery 在站内路径上追加一个查询参数
+func withQuery(path, key, value string) string {
+	u, err := url.Parse(path)
+	if err != nil {
+		u = &url.URL{Path: "/"}
+	}
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
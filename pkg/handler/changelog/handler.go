@@ -0,0 +1,203 @@
+/*
+ * @Description: 更新日志 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package changelog
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/cachepolicy"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	changelog_service "github.com/anzhiyu-c/anheyu-app/pkg/service/changelog"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了更新日志相关的 HTTP 处理器。
+type Handler struct {
+	svc      *changelog_service.Service
+	settings cachepolicy.Getter
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *changelog_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// SetCachePolicySettings 注入缓存策略配置来源，未注入时使用默认 TTL。
+func (h *Handler) SetCachePolicySettings(settings cachepolicy.Getter) {
+	h.settings = settings
+}
+
+// failWithError 根据错误类型返回合适的 HTTP 状态码
+func failWithError(c *gin.Context, err error, prefix string) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, prefix+": 记录不存在")
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, prefix+": "+err.Error())
+	case errors.Is(err, constant.ErrConflict):
+		response.Fail(c, http.StatusConflict, prefix+": "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// pagination 解析分页参数
+func pagination(c *gin.Context) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	return page, pageSize
+}
+
+// List
+// @Summary      获取更新日志列表
+// @Description  按发布时间倒序获取全部更新日志（包括未公开的）
+// @Tags         更新日志
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(10)
+// @Success      200 {object} response.Response{data=model.ChangelogListResponse} "成功响应"
+// @Router       /changelogs [get]
+func (h *Handler) List(c *gin.Context) {
+	page, pageSize := pagination(c)
+	result, err := h.svc.List(c.Request.Context(), page, pageSize)
+	if err != nil {
+		failWithError(c, err, "获取更新日志失败")
+		return
+	}
+	response.Success(c, result, "获取列表成功")
+}
+
+// Get
+// @Summary      获取单条更新日志
+// @Tags         更新日志
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "更新日志ID"
+// @Success      200 {object} response.Response{data=model.ChangelogEntry} "成功响应"
+// @Failure      404 {object} response.Response "更新日志不存在"
+// @Router       /changelogs/{id} [get]
+func (h *Handler) Get(c *gin.Context) {
+	entry, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWithError(c, err, "获取更新日志失败")
+		return
+	}
+	response.Success(c, entry, "获取成功")
+}
+
+// Create
+// @Summary      手动录入更新日志
+// @Tags         更新日志
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SaveChangelogRequest true "更新日志内容"
+// @Success      200 {object} response.Response{data=model.ChangelogEntry} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      409 {object} response.Response "版本已存在"
+// @Router       /changelogs [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req model.SaveChangelogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	entry, err := h.svc.Create(c.Request.Context(), &req)
+	if err != nil {
+		failWithError(c, err, "创建更新日志失败")
+		return
+	}
+	response.Success(c, entry, "创建成功")
+}
+
+// Update
+// @Summary      更新更新日志
+// @Description  整体替换更新日志内容，编辑过的同步条目之后不再被 GitHub 同步覆盖
+// @Tags         更新日志
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "更新日志ID"
+// @Param        body body model.SaveChangelogRequest true "更新日志内容"
+// @Success      200 {object} response.Response{data=model.ChangelogEntry} "成功响应"
+// @Failure      404 {object} response.Response "更新日志不存在"
+// @Failure      409 {object} response.Response "版本已存在"
+// @Router       /changelogs/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req model.SaveChangelogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	entry, err := h.svc.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		failWithError(c, err, "更新更新日志失败")
+		return
+	}
+	response.Success(c, entry, "更新成功")
+}
+
+// Delete
+// @Summary      删除更新日志
+// @Tags         更新日志
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "更新日志ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      404 {object} response.Response "更新日志不存在"
+// @Router       /changelogs/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		failWithError(c, err, "删除更新日志失败")
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
+
+// Sync
+// @Summary      立即同步 GitHub Releases
+// @Description  从 changelog.github_repo 配置的仓库同步 Releases 到更新日志
+// @Tags         更新日志
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=model.ChangelogSyncResult} "成功响应"
+// @Failure      400 {object} response.Response "未配置仓库或仓库不可访问"
+// @Router       /changelogs/sync [post]
+func (h *Handler) Sync(c *gin.Context) {
+	result, err := h.svc.Sync(c.Request.Context())
+	if err != nil {
+		failWithError(c, err, "同步更新日志失败")
+		return
+	}
+	response.Success(c, result, "同步完成")
+}
+
+// ListPublic
+// @Summary      获取公开的更新日志
+// @Description  按发布时间倒序分页获取公开的更新日志，供更新页面使用
+// @Tags         更新日志
+// @Produce      json
+// @Param        page     query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(10)
+// @Success      200 {object} response.Response{data=model.ChangelogListResponse} "成功响应"
+// @Router       /public/changelog [get]
+func (h *Handler) ListPublic(c *gin.Context) {
+	page, pageSize := pagination(c)
+	result, err := h.svc.ListPublic(c.Request.Context(), page, pageSize)
+	if err != nil {
+		failWithError(c, err, "获取更新日志失败")
+		return
+	}
+	cachepolicy.Apply(c, h.settings, cachepolicy.ClassStatic)
+	response.Success(c, result, "获取列表成功")
+}
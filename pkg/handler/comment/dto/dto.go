@@ -32,6 +32,10 @@ type CreateRequest struct {
 
 	// 是否为匿名评论（前端明确标识）。
 	IsAnonymous bool `json:"is_anonymous"`
+
+	// 蜜罐字段：开启 comment.spam.honeypot_enable 后前端渲染为对用户不可见的输入框，
+	// 正常用户不会填写，填写了的提交判定为垃圾评论。
+	Homepage string `json:"homepage" binding:"omitempty,max=255"`
 }
 
 // AdminListRequest 定义了管理员在后台查询评论列表时使用的参数。
@@ -54,8 +58,17 @@ type AdminListRequest struct {
 	// 按评论内容模糊搜索。
 	Content *string `form:"content"`
 
-	// 按评论状态筛选 (1: 已发布, 2: 待审核)。
-	Status *int `form:"status" binding:"omitempty,oneof=1 2"`
+	// 按评论状态筛选 (1: 已发布, 2: 待审核, 3: 垃圾评论)。
+	Status *int `form:"status" binding:"omitempty,oneof=1 2 3"`
+
+	// 按邮箱哈希精确筛选，用于查看同一评论者的全部评论。
+	EmailMD5 *string `form:"email_md5"`
+
+	// 仅显示内容中包含链接的评论。
+	HasLinks bool `form:"has_links"`
+
+	// 仅显示首次评论者（此前没有已发布评论）的评论。
+	FirstTime bool `form:"first_time"`
 }
 
 // DeleteRequest 定义了批量删除评论的API请求体。
@@ -68,6 +81,47 @@ type UpdateStatusRequest struct {
 	Status int `json:"status" binding:"required,oneof=1 2"` // 1: 已发布, 2: 待审核
 }
 
+// BatchModerateRequest 定义了批量审核评论的API请求体。
+type BatchModerateRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1,max=500"`
+}
+
+// SetCommenterTrustRequest 定义了设置评论者信任状态的API请求体。
+type SetCommenterTrustRequest struct {
+	Trusted bool `json:"trusted"`
+}
+
+// ClusterListRequest 定义了查询相似评论分组时使用的参数。
+type ClusterListRequest struct {
+	// 按评论状态筛选，默认只在待审核评论中查找 (1: 已发布, 2: 待审核, 3: 垃圾评论)。
+	Status *int `form:"status" binding:"omitempty,oneof=1 2 3"`
+
+	// 相似度阈值 (0-1)，默认 0.7。
+	Threshold float64 `form:"threshold" binding:"omitempty,gt=0,lte=1"`
+}
+
+// ClusterResponse 表示一组内容相近的评论，常用于批量处理刷屏或垃圾评论。
+type ClusterResponse struct {
+	Size      int         `json:"size"`
+	Sample    string      `json:"sample"` // 组内最新一条评论的 Markdown 原文
+	IDs       []string    `json:"ids"`
+	Nicknames []string    `json:"nicknames"`
+	Comments  []*Response `json:"comments"`
+}
+
+// CommenterHistoryResponse 定义了某位评论者历史记录的API响应结构。
+type CommenterHistoryResponse struct {
+	EmailMD5       string        `json:"email_md5"`
+	TrustStatus    string        `json:"trust_status"` // trusted / revoked，空表示尚无记录
+	Nicknames      []string      `json:"nicknames"`
+	IPAddresses    []string      `json:"ip_addresses"`
+	PublishedCount int           `json:"published_count"`
+	PendingCount   int           `json:"pending_count"`
+	FirstCommentAt *time.Time    `json:"first_comment_at,omitempty"`
+	LastCommentAt  *time.Time    `json:"last_comment_at,omitempty"`
+	Comments       *ListResponse `json:"comments"`
+}
+
 // SetPinRequest 定义了设置评论置顶状态的API请求体。
 type SetPinRequest struct {
 	Pinned *bool `json:"pinned" binding:"required"`
@@ -93,27 +147,29 @@ type UpdateCommentRequest struct {
 // Response 定义了单条评论的API响应结构。
 // 这个结构是为前端展示专门设计的。
 type Response struct {
-	ID             string      `json:"id"`
-	CreatedAt      time.Time   `json:"created_at"`
-	PinnedAt       *time.Time  `json:"pinned_at,omitempty"`
-	Nickname       string      `json:"nickname"`
-	EmailMD5       string      `json:"email_md5"`
-	QQNumber       *string     `json:"qq_number,omitempty"`  // QQ号（如果邮箱是QQ邮箱格式，用于前端显示QQ头像）
-	AvatarURL      *string     `json:"avatar_url,omitempty"` // 用户自定义头像URL（如果有关联用户且用户上传了头像）
-	Website        *string     `json:"website,omitempty"`
-	ContentHTML    string      `json:"content_html"`
-	IsAdminComment bool        `json:"is_admin_comment"`
-	IsAnonymous    bool        `json:"is_anonymous"`
-	IPLocation     string      `json:"ip_location,omitempty"`
-	UserAgent      *string     `json:"user_agent,omitempty"`
-	TargetPath     string      `json:"target_path"`            // 返回评论所属的路径
-	TargetTitle    *string     `json:"target_title,omitempty"` // 返回目标页面的标题
-	ParentID       *string     `json:"parent_id,omitempty"`
-	ReplyToID      *string     `json:"reply_to_id,omitempty"`
-	ReplyToNick    *string     `json:"reply_to_nick,omitempty"`
-	LikeCount      int         `json:"like_count"`
-	TotalChildren  int64       `json:"total_children"`
-	Children       []*Response `json:"children,omitempty"`
+	ID             string         `json:"id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	PinnedAt       *time.Time     `json:"pinned_at,omitempty"`
+	Nickname       string         `json:"nickname"`
+	EmailMD5       string         `json:"email_md5"`
+	QQNumber       *string        `json:"qq_number,omitempty"`  // QQ号（如果邮箱是QQ邮箱格式，用于前端显示QQ头像）
+	AvatarURL      *string        `json:"avatar_url,omitempty"` // 用户自定义头像URL（如果有关联用户且用户上传了头像）
+	Website        *string        `json:"website,omitempty"`
+	ContentHTML    string         `json:"content_html"`
+	IsAdminComment bool           `json:"is_admin_comment"`
+	IsAnonymous    bool           `json:"is_anonymous"`
+	IPLocation     string         `json:"ip_location,omitempty"`
+	UserAgent      *string        `json:"user_agent,omitempty"`
+	TargetPath     string         `json:"target_path"`            // 返回评论所属的路径
+	TargetTitle    *string        `json:"target_title,omitempty"` // 返回目标页面的标题
+	ParentID       *string        `json:"parent_id,omitempty"`
+	ReplyToID      *string        `json:"reply_to_id,omitempty"`
+	ReplyToNick    *string        `json:"reply_to_nick,omitempty"`
+	LikeCount      int            `json:"like_count"`
+	Reactions      map[string]int `json:"reactions"` // 各类表情回应的数量，键为 like/love/laugh/wow/sad
+	TotalChildren  int64          `json:"total_children"`
+	CodeBlocks     []*CodeBlock   `json:"code_blocks,omitempty"` // 评论中的代码块信息，顺序与 HTML 中一致
+	Children       []*Response    `json:"children,omitempty"`
 
 	// --- 仅限管理员视图的字段 ---
 	Email     *string `json:"email,omitempty"`
@@ -122,6 +178,14 @@ type Response struct {
 	Status    *int    `json:"status,omitempty"`
 }
 
+// CodeBlock 描述评论中的一个代码块。
+type CodeBlock struct {
+	Index    int    `json:"index"`
+	Language string `json:"language,omitempty"`
+	Lines    int    `json:"lines"`
+	Folded   bool   `json:"folded"` // 行数超过代码块最大行数设置，前端应默认折叠
+}
+
 // ListResponse 定义了评论列表的API响应结构。
 type ListResponse struct {
 	List              []*Response `json:"list"`
@@ -157,3 +221,67 @@ type ImportResult struct {
 	FailedCount   int      `json:"failed_count"`   // 失败数
 	ErrorMessages []string `json:"error_messages"` // 错误信息列表
 }
+
+// WordRule 定义了一条评论违禁词规则。
+type WordRule struct {
+	// 匹配模式：keyword 为普通关键词，wildcard 支持 * 与 ?，regex 为 Go 正则表达式。
+	Pattern string `json:"pattern" binding:"required"`
+	// 规则类型 (keyword / wildcard / regex)，默认 keyword。
+	Type string `json:"type,omitempty" binding:"omitempty,oneof=keyword wildcard regex"`
+	// 命中后的动作 (pending: 进入待审核, reject: 拒绝提交, replace: 替换为 ***)，默认 pending。
+	Action string `json:"action,omitempty" binding:"omitempty,oneof=pending reject replace"`
+	Remark string `json:"remark,omitempty"`
+}
+
+// WordRuleImportRequest 定义了导入违禁词规则的API请求体。
+type WordRuleImportRequest struct {
+	Rules   []WordRule `json:"rules" binding:"required,dive"`
+	Replace bool       `json:"replace"` // 为 true 时覆盖现有规则，否则追加（相同类型与模式的规则会被更新）
+}
+
+// WordRuleTestRequest 定义了预览违禁词规则命中情况的API请求体。
+type WordRuleTestRequest struct {
+	Content string     `json:"content" binding:"required"`
+	Rules   []WordRule `json:"rules" binding:"omitempty,dive"` // 为空时使用当前已保存的规则
+}
+
+// WordRuleHit 表示一条被命中的违禁词规则。
+type WordRuleHit struct {
+	Index   int      `json:"index"` // 规则在列表中的下标
+	Rule    WordRule `json:"rule"`
+	Matches []string `json:"matches"` // 命中的原文片段（去重）
+}
+
+// WordRuleTestResponse 定义了违禁词规则预览的API响应结构。
+type WordRuleTestResponse struct {
+	Action  string         `json:"action"`  // 最终动作：pass / pending / reject / replace
+	Content string         `json:"content"` // 应用替换规则后的内容
+	Hits    []*WordRuleHit `json:"hits"`
+}
+
+// CommenterProfileComment 是评论者资料卡片中的一条最近评论。
+type CommenterProfileComment struct {
+	ID          string    `json:"id"`
+	TargetPath  string    `json:"target_path"`
+	TargetTitle *string   `json:"target_title,omitempty"`
+	Excerpt     string    `json:"excerpt"` // 纯文本摘要
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CommenterProfileResponse 定义了公开的评论者资料卡片，只包含已发布的非匿名评论，不含邮箱、IP 等隐私信息。
+type CommenterProfileResponse struct {
+	EmailMD5       string                     `json:"email_md5"`
+	Nickname       string                     `json:"nickname"` // 最近一条评论使用的昵称
+	Website        *string                    `json:"website,omitempty"`
+	IsAdmin        bool                       `json:"is_admin"`
+	CommentCount   int                        `json:"comment_count"`
+	FirstCommentAt *time.Time                 `json:"first_comment_at,omitempty"`
+	LastCommentAt  *time.Time                 `json:"last_comment_at,omitempty"`
+	Recent         []*CommenterProfileComment `json:"recent"`
+}
+
+// SubscribeRequest 定义了订阅评论区新评论的请求体
+type SubscribeRequest struct {
+	TargetPath string `json:"target_path" binding:"required,max=255"`
+	Email      string `json:"email" binding:"required,email,max=255"`
+}
@@ -10,12 +10,17 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/streamexport"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/util"
@@ -29,6 +34,8 @@ const keyWeatherRectangle = "sidebar.weather.rectangle"
 type Handler struct {
 	svc        *comment.Service
 	settingSvc setting.SettingService // 可选，用于天气 IP 定位返回 default_rectangle
+	cleanupSvc cleanup.ICleanupService
+	captchaSvc captcha.CaptchaService // 可选，游客发表评论时的人机验证
 }
 
 // NewHandler 创建评论处理器。settingSvc 可选，传入时在天气 IP 定位（局域网/无经纬度）响应中会带 default_rectangle
@@ -36,6 +43,22 @@ func NewHandler(svc *comment.Service, settingSvc setting.SettingService) *Handle
 	return &Handler{svc: svc, settingSvc: settingSvc}
 }
 
+// SetCleanupService 注入清理服务，用于评论清理规则的试运行与执行
+func (h *Handler) SetCleanupService(cleanupSvc cleanup.ICleanupService) {
+	h.cleanupSvc = cleanupSvc
+}
+
+// SetCaptchaService 注入人机验证服务，captcha.scenes 包含 comment 时游客评论需要通过验证
+func (h *Handler) SetCaptchaService(captchaSvc captcha.CaptchaService) {
+	h.captchaSvc = captchaSvc
+}
+
+// createRequest 发表评论的请求体，在评论字段之外附带人机验证参数
+type createRequest struct {
+	dto.CreateRequest
+	captcha.CaptchaParams
+}
+
 // ListChildren
 // @Summary      获取指定评论的子评论列表（分页）
 // @Description  分页获取指定根评论下的所有回复评论
@@ -70,6 +93,7 @@ func (h *Handler) ListChildren(c *gin.Context) {
 		return
 	}
 
+	response.SetPaginationHeaders(c, childrenResponse.Total, childrenResponse.Page, childrenResponse.PageSize)
 	response.Success(c, childrenResponse, "获取成功")
 }
 
@@ -146,6 +170,7 @@ func (h *Handler) ListLatest(c *gin.Context) {
 		return
 	}
 
+	response.SetPaginationHeaders(c, commentsResponse.Total, commentsResponse.Page, commentsResponse.PageSize)
 	response.Success(c, commentsResponse, "获取成功")
 }
 
@@ -239,11 +264,11 @@ func (h *Handler) UpdateStatus(c *gin.Context) {
 // @Produce      json
 // @Param        comment_request body dto.CreateRequest true "创建评论的请求体"
 // @Success      200 {object} response.Response{data=dto.Response} "成功响应"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      400 {object} response.Response "请求参数错误或人机验证未通过"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/comments [post]
 func (h *Handler) Create(c *gin.Context) {
-	var req dto.CreateRequest
+	var req createRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
 		return
@@ -283,13 +308,24 @@ func (h *Handler) Create(c *gin.Context) {
 		claims, _ = userClaim.(*auth.CustomClaims)
 	}
 
+	// 已登录用户无需人机验证
+	if claims == nil && h.captchaSvc != nil {
+		if err := h.captchaSvc.VerifyScene(c.Request.Context(), captcha.SceneComment, req.CaptchaParams, ip); err != nil {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
 	// 获取客户端 Referer，用于 NSUUU API 白名单验证
 	referer := c.GetHeader("Referer")
 
-	commentDTO, err := h.svc.Create(c.Request.Context(), &req, ip, ua, referer, claims)
+	commentDTO, err := h.svc.Create(c.Request.Context(), &req.CreateRequest, ip, ua, referer, claims)
 	if err != nil {
-		if errors.Is(err, constant.ErrAdminEmailUsedByGuest) {
+		if errors.Is(err, constant.ErrAdminEmailUsedByGuest) || errors.Is(err, constant.ErrAnonymousCommentDisabled) {
 			response.Fail(c, http.StatusForbidden, err.Error())
+		} else if errors.Is(err, constant.ErrCommentRejectedByWordRule) || errors.Is(err, constant.ErrCommentCodeTooLong) ||
+			errors.Is(err, constant.ErrDisposableEmail) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
 		} else {
 			response.Fail(c, http.StatusInternalServerError, "创建评论失败: "+err.Error())
 		}
@@ -299,6 +335,104 @@ func (h *Handler) Create(c *gin.Context) {
 	response.Success(c, commentDTO, "评论发布成功")
 }
 
+// GetCommenterProfile
+// @Summary      获取评论者资料卡片
+// @Description  按邮箱哈希返回评论者公开的评论足迹（评论数、首次/最近评论时间、最近评论），只统计已发布的非匿名评论，用于悬停头像时展示
+// @Tags         公开评论
+// @Produce      json
+// @Param        email_md5 path string true "评论者邮箱的 MD5 哈希"
+// @Success      200 {object} response.Response{data=dto.CommenterProfileResponse} "成功响应"
+// @Failure      400 {object} response.Response "邮箱哈希无效"
+// @Failure      404 {object} response.Response "资料卡片未开启或评论者不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/comments/commenters/{email_md5} [get]
+func (h *Handler) GetCommenterProfile(c *gin.Context) {
+	profile, err := h.svc.CommenterProfile(c.Request.Context(), c.Param("email_md5"))
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, "获取评论者资料失败: "+err.Error())
+		}
+		return
+	}
+
+	response.Success(c, profile, "获取成功")
+}
+
+// Subscribe
+// @Summary      订阅评论区
+// @Description  订阅指定路径的新评论，新评论会按配置的间隔合并为摘要邮件发送，邮件中附带退订链接；重复订阅不会产生多条记录
+// @Tags         公开评论
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.SubscribeRequest true "订阅请求"
+// @Success      200 {object} response.Response "订阅成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "评论订阅未开启或该路径不允许评论"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/comments/subscriptions [post]
+func (h *Handler) Subscribe(c *gin.Context) {
+	var req dto.SubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	if err := h.svc.Subscribe(c.Request.Context(), req.TargetPath, req.Email); err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrForbidden):
+			response.Fail(c, http.StatusForbidden, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, nil, "订阅成功")
+}
+
+// Unsubscribe
+// @Summary      退订评论区
+// @Description  通过摘要邮件中的签名链接退订，无需登录
+// @Tags         公开评论
+// @Produce      json
+// @Param        id path int true "订阅ID"
+// @Param        sign query string true "退订签名"
+// @Success      200 {object} response.Response "退订成功"
+// @Failure      400 {object} response.Response "链接无效或已过期"
+// @Failure      404 {object} response.Response "订阅不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/comments/subscriptions/{id}/unsubscribe [get]
+func (h *Handler) Unsubscribe(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的订阅ID")
+		return
+	}
+
+	if err := h.svc.Unsubscribe(c.Request.Context(), uint(id), c.Query("sign")); err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, constant.ErrForbidden):
+			response.Fail(c, http.StatusForbidden, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, nil, "已退订该评论区的新评论通知")
+}
+
 // ListByPath
 // @Summary      获取指定路径的评论列表（分页）
 // @Description  分页获取指定路径下的根评论，并附带其所有子评论
@@ -333,59 +467,111 @@ func (h *Handler) ListByPath(c *gin.Context) {
 		return
 	}
 
+	response.SetPaginationHeaders(c, commentsResponse.Total, commentsResponse.Page, commentsResponse.PageSize)
 	response.Success(c, commentsResponse, "获取成功")
 }
 
 // LikeComment
 // @Summary      点赞评论
-// @Description  为指定ID的评论增加一次点赞
+// @Description  为指定ID的评论添加 like 回应，同一访客重复点赞不会重复计数
 // @Tags         公开评论
 // @Produce      json
 // @Param        id path string true "评论的公共ID"
 // @Success      200 {object} response.Response{data=integer} "成功响应，返回最新的点赞数"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "评论不存在"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/comments/{id}/like [post]
 func (h *Handler) LikeComment(c *gin.Context) {
-	commentID := c.Param("id")
-	if commentID == "" {
-		response.Fail(c, http.StatusBadRequest, "评论ID不能为空")
+	result, ok := h.changeReaction(c, c.Param("id"), model.ReactionLike, true)
+	if !ok {
 		return
 	}
-
-	newLikeCount, err := h.svc.LikeComment(c.Request.Context(), commentID)
-	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
-		return
-	}
-
-	response.Success(c, newLikeCount, "点赞成功")
+	response.Success(c, result.LikeCount, "点赞成功")
 }
 
 // UnlikeComment
 // @Summary      取消点赞评论
-// @Description  为指定ID的评论减少一次点赞
+// @Description  撤销当前访客对指定评论的 like 回应
 // @Tags         公开评论
 // @Produce      json
 // @Param        id path string true "评论的公共ID"
 // @Success      200 {object} response.Response{data=integer} "成功响应，返回最新的点赞数"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "评论不存在"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/comments/{id}/unlike [post]
 func (h *Handler) UnlikeComment(c *gin.Context) {
-	commentID := c.Param("id")
-	if commentID == "" {
-		response.Fail(c, http.StatusBadRequest, "评论ID不能为空")
+	result, ok := h.changeReaction(c, c.Param("id"), model.ReactionLike, false)
+	if !ok {
 		return
 	}
+	response.Success(c, result.LikeCount, "取消点赞成功")
+}
 
-	newLikeCount, err := h.svc.UnlikeComment(c.Request.Context(), commentID)
-	if err != nil {
-		response.Fail(c, http.StatusInternalServerError, err.Error())
+// AddReaction
+// @Summary      添加评论表情回应
+// @Description  为指定评论添加表情回应（like/love/laugh/wow/sad），同一访客对每种回应只计一次
+// @Tags         公开评论
+// @Produce      json
+// @Param        id       path string true "评论的公共ID"
+// @Param        reaction path string true "回应类型" Enums(like, love, laugh, wow, sad)
+// @Success      200 {object} response.Response{data=comment.ReactionResult} "成功响应，返回最新的回应数"
+// @Failure      400 {object} response.Response "不支持的回应类型"
+// @Failure      404 {object} response.Response "评论不存在"
+// @Router       /public/comments/{id}/reactions/{reaction} [post]
+func (h *Handler) AddReaction(c *gin.Context) {
+	result, ok := h.changeReaction(c, c.Param("id"), c.Param("reaction"), true)
+	if !ok {
 		return
 	}
+	response.Success(c, result, "回应成功")
+}
 
-	response.Success(c, newLikeCount, "取消点赞成功")
+// RemoveReaction
+// @Summary      撤销评论表情回应
+// @Description  撤销当前访客对指定评论的某种表情回应
+// @Tags         公开评论
+// @Produce      json
+// @Param        id       path string true "评论的公共ID"
+// @Param        reaction path string true "回应类型" Enums(like, love, laugh, wow, sad)
+// @Success      200 {object} response.Response{data=comment.ReactionResult} "成功响应，返回最新的回应数"
+// @Failure      400 {object} response.Response "不支持的回应类型"
+// @Failure      404 {object} response.Response "评论不存在"
+// @Router       /public/comments/{id}/reactions/{reaction} [delete]
+func (h *Handler) RemoveReaction(c *gin.Context) {
+	result, ok := h.changeReaction(c, c.Param("id"), c.Param("reaction"), false)
+	if !ok {
+		return
+	}
+	response.Success(c, result, "已撤销回应")
+}
+
+// changeReaction 识别访客并添加或撤销回应，失败时写入错误响应并返回 false
+func (h *Handler) changeReaction(c *gin.Context, commentID, reaction string, add bool) (*comment.ReactionResult, bool) {
+	if commentID == "" {
+		response.Fail(c, http.StatusBadRequest, "评论ID不能为空")
+		return nil, false
+	}
+	visitorKey := h.reactionVisitorKey(c)
+	var (
+		result *comment.ReactionResult
+		err    error
+	)
+	if add {
+		result, err = h.svc.React(c.Request.Context(), commentID, reaction, visitorKey)
+	} else {
+		result, err = h.svc.Unreact(c.Request.Context(), commentID, reaction, visitorKey)
+	}
+	switch {
+	case err == nil:
+		return result, true
+	case errors.Is(err, comment.ErrInvalidReaction), errors.Is(err, comment.ErrReactionVisitorMissing):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, comment.ErrReactionCommentNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+	return nil, false
 }
 
 // --- Admin Handlers ---
@@ -416,6 +602,7 @@ func (h *Handler) AdminList(c *gin.Context) {
 		return
 	}
 
+	response.SetPaginationHeaders(c, commentsResponse.Total, commentsResponse.Page, commentsResponse.PageSize)
 	response.Success(c, commentsResponse, "获取成功")
 }
 
@@ -448,6 +635,346 @@ func (h *Handler) Delete(c *gin.Context) {
 	response.Success(c, deletedCount, fmt.Sprintf("成功删除 %d 条评论", deletedCount))
 }
 
+// BatchApprove
+// @Summary      管理员批量通过评论
+// @Description  将一组评论的状态设为已发布
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.BatchModerateRequest true "评论公共ID列表"
+// @Success      200 {object} response.Response{data=integer} "成功响应，返回通过的数量"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/batch/approve [post]
+func (h *Handler) BatchApprove(c *gin.Context) {
+	var req dto.BatchModerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	count, err := h.svc.BatchApprove(c.Request.Context(), req.IDs)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.Success(c, count, fmt.Sprintf("成功通过 %d 条评论", count))
+}
+
+// MarkSpam
+// @Summary      管理员标记垃圾评论
+// @Description  将一组评论标记为垃圾评论（状态 3，不公开展示），并反馈给 Akismet 与本地贝叶斯过滤器
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.BatchModerateRequest true "评论公共ID列表"
+// @Success      200 {object} response.Response{data=integer} "成功响应，返回标记的数量"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/batch/spam [post]
+func (h *Handler) MarkSpam(c *gin.Context) {
+	var req dto.BatchModerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	count, err := h.svc.MarkSpam(c.Request.Context(), req.IDs)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, count, fmt.Sprintf("已将 %d 条评论标记为垃圾评论", count))
+}
+
+// MarkHam
+// @Summary      管理员标记正常评论
+// @Description  将一组评论（通常是被误判的垃圾评论）标记为正常并发布，并反馈给 Akismet 与本地贝叶斯过滤器
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.BatchModerateRequest true "评论公共ID列表"
+// @Success      200 {object} response.Response{data=integer} "成功响应，返回标记的数量"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/batch/ham [post]
+func (h *Handler) MarkHam(c *gin.Context) {
+	var req dto.BatchModerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	count, err := h.svc.MarkHam(c.Request.Context(), req.IDs)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		} else {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, count, fmt.Sprintf("已将 %d 条评论标记为正常评论", count))
+}
+
+// BatchReject
+// @Summary      管理员批量拒绝评论
+// @Description  拒绝一组评论，被拒绝的评论将被删除
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.BatchModerateRequest true "评论公共ID列表"
+// @Success      200 {object} response.Response{data=integer} "成功响应，返回拒绝的数量"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/batch/reject [post]
+func (h *Handler) BatchReject(c *gin.Context) {
+	var req dto.BatchModerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	count, err := h.svc.BatchReject(c.Request.Context(), req.IDs)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "拒绝评论失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, count, fmt.Sprintf("成功拒绝 %d 条评论", count))
+}
+
+// ListClusters
+// @Summary      管理员查询相似评论分组
+// @Description  将内容相近的评论（默认仅待审核评论）聚成一组，便于批量处理刷屏或垃圾评论
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        query query dto.ClusterListRequest false "查询参数"
+// @Success      200 {object} response.Response{data=[]dto.ClusterResponse} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/clusters [get]
+func (h *Handler) ListClusters(c *gin.Context) {
+	var req dto.ClusterListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	clusters, err := h.svc.ListClusters(c.Request.Context(), &req)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取相似评论分组失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, clusters, "获取成功")
+}
+
+// CommenterHistory
+// @Summary      管理员查看评论者历史
+// @Description  根据邮箱哈希查看某位评论者使用过的昵称和IP、已发布/待审核数量以及全部评论
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        email_md5 path  string true  "评论者邮箱的 MD5 哈希"
+// @Param        page      query int    false "页码"
+// @Param        pageSize  query int    false "每页数量"
+// @Success      200 {object} response.Response{data=dto.CommenterHistoryResponse} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/commenters/{email_md5} [get]
+func (h *Handler) CommenterHistory(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	history, err := h.svc.CommenterHistory(c.Request.Context(), c.Param("email_md5"), page, pageSize)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取评论者历史失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, history, "获取成功")
+}
+
+// SetCommenterTrust
+// @Summary      管理员设置评论者信任状态
+// @Description  信任后该邮箱的评论自动发布；撤销信任后其评论一律进入待审核
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        email_md5 path string true "评论者邮箱的 MD5 哈希"
+// @Param        body body dto.SetCommenterTrustRequest true "信任状态"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/commenters/{email_md5}/trust [put]
+func (h *Handler) SetCommenterTrust(c *gin.Context) {
+	var req dto.SetCommenterTrustRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	if err := h.svc.SetCommenterTrust(c.Request.Context(), c.Param("email_md5"), req.Trusted); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if req.Trusted {
+		response.Success(c, nil, "已信任该评论者")
+		return
+	}
+	response.Success(c, nil, "已撤销对该评论者的信任")
+}
+
+// ExportWordRules
+// @Summary      管理员导出违禁词规则
+// @Description  以 JSON 文件导出当前的评论违禁词规则，旧版逗号分隔的配置会转换为规则列表
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      application/json
+// @Success      200 {file} file "JSON文件下载"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/word-rules/export [get]
+func (h *Handler) ExportWordRules(c *gin.Context) {
+	rules, err := h.svc.ExportWordRules(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "导出违禁词规则失败: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=comment_word_rules.json")
+	c.IndentedJSON(http.StatusOK, rules)
+}
+
+// ImportWordRules
+// @Summary      管理员导入违禁词规则
+// @Description  导入评论违禁词规则，可选择覆盖或追加；任一规则无效时整体不保存
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.WordRuleImportRequest true "规则列表及导入方式"
+// @Success      200 {object} response.Response{data=int} "导入成功，返回保存后的规则总数"
+// @Failure      400 {object} response.Response "规则无效"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/word-rules/import [post]
+func (h *Handler) ImportWordRules(c *gin.Context) {
+	var req dto.WordRuleImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	total, err := h.svc.ImportWordRules(c.Request.Context(), &req)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		} else {
+			response.Fail(c, http.StatusInternalServerError, "导入违禁词规则失败: "+err.Error())
+		}
+		return
+	}
+
+	response.Success(c, total, "导入成功")
+}
+
+// TestWordRules
+// @Summary      管理员预览违禁词规则命中情况
+// @Description  检查示例评论会命中哪些规则、最终动作以及替换后的内容；请求中携带规则时使用请求中的规则，不会保存
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.WordRuleTestRequest true "示例评论及可选的待验证规则"
+// @Success      200 {object} response.Response{data=dto.WordRuleTestResponse} "成功响应"
+// @Failure      400 {object} response.Response "请求参数或规则无效"
+// @Failure      401 {object} response.Response "未授权"
+// @Router       /comments/word-rules/test [post]
+func (h *Handler) TestWordRules(c *gin.Context) {
+	var req dto.WordRuleTestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	result, err := h.svc.TestWordRules(c.Request.Context(), &req)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Success(c, result, "获取成功")
+}
+
+// PreviewCleanup
+// @Summary      管理员试运行评论清理规则
+// @Description  按 comment.cleanup.* 配置统计超期待审核评论、屏蔽邮箱/IP 的评论与孤立回复，不修改数据
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=cleanup.CommentCleanupReport} "试运行报告"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/cleanup/preview [get]
+func (h *Handler) PreviewCleanup(c *gin.Context) {
+	h.runCleanup(c, true)
+}
+
+// RunCleanup
+// @Summary      管理员执行评论清理规则
+// @Description  删除超期待审核评论与屏蔽邮箱/IP 的评论，并把父评论已删除的回复挂到最近的未删除祖先下
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=cleanup.CommentCleanupReport} "清理报告"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/cleanup [post]
+func (h *Handler) RunCleanup(c *gin.Context) {
+	h.runCleanup(c, false)
+}
+
+func (h *Handler) runCleanup(c *gin.Context, dryRun bool) {
+	if h.cleanupSvc == nil {
+		response.Fail(c, http.StatusInternalServerError, "评论清理功能未初始化")
+		return
+	}
+	report, err := h.cleanupSvc.CleanupComments(c.Request.Context(), dryRun)
+	if err != nil {
+		log.Printf("[Handler.RunCleanup] 评论清理失败: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "评论清理失败: "+err.Error())
+		return
+	}
+	if dryRun {
+		response.Success(c, report, "试运行完成")
+		return
+	}
+	response.Success(c, report, "清理完成")
+}
+
 // UpdateContent
 // @Summary      管理员更新评论内容
 // @Description  根据评论ID更新评论的内容
@@ -638,14 +1165,41 @@ func (h *Handler) ExportComments(c *gin.Context) {
 	c.Data(http.StatusOK, "application/zip", zipData)
 }
 
+// ExportCommentsStream
+// @Summary      管理员流式导出评论
+// @Description  以 NDJSON 或 CSV 格式分块导出所有评论，适合评论数量较多的站点
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      application/x-ndjson,text/csv
+// @Param        format query string false "导出格式：ndjson（默认）或 csv"
+// @Success      200 {file} file "导出文件"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/export/stream [get]
+func (h *Handler) ExportCommentsStream(c *gin.Context) {
+	format, err := streamexport.ParseFormat(c.Query("format"))
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filename := "comments_export_" + time.Now().Format("20060102150405")
+	streamexport.Serve(c, format, filename, comment.CommentCSVHeader, func(w *streamexport.Writer) error {
+		return h.svc.StreamComments(c.Request.Context(), func(item comment.ExportCommentItem) error {
+			return w.Write(item)
+		})
+	})
+}
+
 // ImportComments
 // @Summary      管理员导入评论
-// @Description  从 JSON 或 ZIP 文件导入评论
+// @Description  从 JSON、NDJSON 或 ZIP 文件导入评论，NDJSON 文件逐行流式导入
 // @Tags         评论管理
 // @Security     BearerAuth
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file formData file true "评论数据文件（JSON或ZIP格式）"
+// @Param        file formData file true "评论数据文件（JSON、NDJSON或ZIP格式）"
 // @Param        skip_existing formData bool false "是否跳过已存在的评论"
 // @Param        default_status formData int false "默认状态（1:已发布, 2:待审核）"
 // @Param        keep_create_time formData bool false "是否保留原创建时间"
@@ -676,13 +1230,6 @@ func (h *Handler) ImportComments(c *gin.Context) {
 	}
 	defer file.Close()
 
-	fileData, err := io.ReadAll(file)
-	if err != nil {
-		log.Printf("[Handler.ImportComments] 读取文件失败: %v", err)
-		response.Fail(c, http.StatusInternalServerError, "读取文件失败")
-		return
-	}
-
 	// 解析导入选项
 	skipExisting := c.DefaultPostForm("skip_existing", "true") == "true"
 	keepCreateTime := c.DefaultPostForm("keep_create_time", "true") == "true"
@@ -708,12 +1255,23 @@ func (h *Handler) ImportComments(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	switch ext {
-	case ".json":
-		result, err = h.svc.ImportCommentsFromJSON(ctx, fileData, importReq)
-	case ".zip":
-		result, err = h.svc.ImportCommentsFromZip(ctx, fileData, importReq)
+	case ".ndjson", ".jsonl":
+		// NDJSON 逐行解析，不需要把整个文件读入内存
+		result, err = h.svc.ImportCommentsFromNDJSON(ctx, file, importReq)
+	case ".json", ".zip":
+		fileData, readErr := io.ReadAll(file)
+		if readErr != nil {
+			log.Printf("[Handler.ImportComments] 读取文件失败: %v", readErr)
+			response.Fail(c, http.StatusInternalServerError, "读取文件失败")
+			return
+		}
+		if ext == ".json" {
+			result, err = h.svc.ImportCommentsFromJSON(ctx, fileData, importReq)
+		} else {
+			result, err = h.svc.ImportCommentsFromZip(ctx, fileData, importReq)
+		}
 	default:
-		response.Fail(c, http.StatusBadRequest, "不支持的文件格式，仅支持 .json 和 .zip 文件")
+		response.Fail(c, http.StatusBadRequest, "不支持的文件格式，仅支持 .json、.ndjson 和 .zip 文件")
 		return
 	}
 
@@ -0,0 +1,40 @@
+// anheyu-app/pkg/handler/comment/reaction.go
+package comment
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// reactionVisitorCookie 保存访客标识的 Cookie，用于限制同一访客对每种回应只计一次
+	reactionVisitorCookie = "anheyu_reaction_visitor"
+	// reactionVisitorMaxAge 访客标识 Cookie 的有效期（一年）
+	reactionVisitorMaxAge = 365 * 24 * 60 * 60
+)
+
+var reactionVisitorPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// reactionVisitorKey 获取访客标识：优先使用服务端签发并签名的 Cookie，Cookie 缺失或签名无效时使用 IP+UA 指纹，
+// 并把签名后的指纹写入 Cookie，使访客更换网络后仍被识别为同一人；客户端无法伪造或轮换标识来重复回应
+func (h *Handler) reactionVisitorKey(c *gin.Context) string {
+	if v, err := c.Cookie(reactionVisitorCookie); err == nil {
+		if key, ok := h.svc.VerifyReactionVisitor(v); ok && reactionVisitorPattern.MatchString(key) {
+			return key
+		}
+	}
+	sum := md5.Sum([]byte(util.GetRealClientIP(c) + "|" + c.Request.UserAgent()))
+	key := hex.EncodeToString(sum[:])
+	if signed := h.svc.SignReactionVisitor(key); signed != "" {
+		secure := c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+		c.SetSameSite(http.SameSiteLaxMode)
+		c.SetCookie(reactionVisitorCookie, signed, reactionVisitorMaxAge, "/", "", secure, true)
+	}
+	return key
+}
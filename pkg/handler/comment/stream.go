@@ -0,0 +1,131 @@
+// anheyu-app/pkg/handler/comment/stream.go
+package comment
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// streamPingInterval 服务端心跳间隔，同时用于发现已断开的连接
+	streamPingInterval = 30 * time.Second
+	// streamWriteTimeout 单条消息的写超时
+	streamWriteTimeout = 10 * time.Second
+	// streamMaxMessageBytes 客户端消息的最大长度
+	streamMaxMessageBytes = 4096
+)
+
+// streamClientMessage 客户端发送的订阅指令
+type streamClientMessage struct {
+	Action string `json:"action"` // subscribe / unsubscribe
+	Path   string `json:"path"`
+}
+
+// streamServerMessage 服务端对指令的回复与心跳
+type streamServerMessage struct {
+	Type    string `json:"type"` // subscribed / unsubscribed / error / ping
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Stream
+// @Summary      实时评论流
+// @Description  升级为 WebSocket 连接，实时接收已订阅路径的新评论（comment.created）、状态变更（comment.status）与点赞数（comment.like）。
+// @Description  可通过 path 查询参数在建立连接时订阅，也可在连接后发送 {"action":"subscribe","path":"/posts/xxx"} 或 {"action":"unsubscribe","path":"/posts/xxx"}；单个连接最多订阅 20 个路径。
+// @Tags         公开评论
+// @Param        path query []string false "要订阅的评论目标路径，可重复" collectionFormat(multi)
+// @Success      101 "切换为 WebSocket 协议"
+// @Failure      400 {object} response.Response "订阅路径无效"
+// @Failure      404 {object} response.Response "未启用实时评论推送"
+// @Failure      503 {object} response.Response "连接数已达上限"
+// @Router       /ws/comments [get]
+func (h *Handler) Stream(c *gin.Context) {
+	sub, err := h.svc.OpenStream()
+	if err != nil {
+		if errors.Is(err, comment.ErrStreamDisabled) {
+			response.Fail(c, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	for _, path := range c.QueryArray("path") {
+		if err := sub.Subscribe(path); err != nil {
+			sub.Close()
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// 推送的都是公开数据，不校验 Origin，便于主题部署在独立域名下
+	server := websocket.Server{Handler: func(ws *websocket.Conn) {
+		defer sub.Close()
+		serveStream(ws, sub)
+	}}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// serveStream 读取客户端的订阅指令，并把订阅事件与心跳写回客户端，任一方向出错即结束连接
+func serveStream(ws *websocket.Conn, sub *comment.StreamSubscription) {
+	defer ws.Close()
+	ws.MaxPayloadBytes = streamMaxMessageBytes
+
+	send := func(v interface{}) error {
+		ws.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		return websocket.JSON.Send(ws, v)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			var msg streamClientMessage
+			if err := websocket.JSON.Receive(ws, &msg); err != nil {
+				return
+			}
+			reply := streamServerMessage{Path: msg.Path}
+			switch msg.Action {
+			case "subscribe":
+				if err := sub.Subscribe(msg.Path); err != nil {
+					reply.Type, reply.Message = "error", err.Error()
+				} else {
+					reply.Type = "subscribed"
+				}
+			case "unsubscribe":
+				sub.Unsubscribe(msg.Path)
+				reply.Type = "unsubscribed"
+			default:
+				reply.Type, reply.Message = "error", "未知的指令: "+msg.Action
+			}
+			if err := send(reply); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(streamPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if err := send(ev); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := send(streamServerMessage{Type: "ping"}); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ * @Description: 文章投稿处理器：投稿者提交与跟踪投稿，管理员处理审核队列
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 04:00:00
+ * @LastEditTime: 2026-10-18 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package contribution
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	contribution_service "github.com/anzhiyu-c/anheyu-app/pkg/service/contribution"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了文章投稿与审核的 HTTP 处理器。
+type Handler struct {
+	svc *contribution_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *contribution_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// currentUserID 从 JWT 中解析当前用户的数据库ID
+func currentUserID(c *gin.Context) (uint, bool) {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !exists || !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return 0, false
+	}
+	userID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "用户ID解析失败")
+		return 0, false
+	}
+	return userID, true
+}
+
+// failWithError 根据错误类型返回合适的 HTTP 状态码
+func failWithError(c *gin.Context, err error, prefix string) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, prefix+": 投稿不存在")
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, prefix+": "+err.Error())
+	case errors.Is(err, constant.ErrConflict):
+		response.Fail(c, http.StatusConflict, prefix+": "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// pagination 解析分页参数
+func pagination(c *gin.Context) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	return page, pageSize
+}
+
+// Submit
+// @Summary      提交投稿
+// @Description  提交一篇投稿，投稿保存为草稿并进入审核队列，同时邮件通知站长
+// @Tags         文章投稿
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SubmitContributionRequest true "投稿内容"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Router       /contributions [post]
+func (h *Handler) Submit(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req model.SubmitContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	detail, err := h.svc.Submit(c.Request.Context(), userID, &req, util.GetRealClientIP(c), c.GetHeader("Referer"))
+	if err != nil {
+		failWithError(c, err, "提交投稿失败")
+		return
+	}
+	response.Success(c, detail, "投稿已提交，请等待审核")
+}
+
+// Resubmit
+// @Summary      修改并重新提交投稿
+// @Description  在待审核或需修改状态下修改投稿内容，投稿重新进入审核队列
+// @Tags         文章投稿
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "投稿文章ID"
+// @Param        body body model.SubmitContributionRequest true "投稿内容"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      404 {object} response.Response "投稿不存在"
+// @Failure      409 {object} response.Response "投稿已审核完成"
+// @Router       /contributions/{id} [put]
+func (h *Handler) Resubmit(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req model.SubmitContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	detail, err := h.svc.Resubmit(c.Request.Context(), userID, c.Param("id"), &req, util.GetRealClientIP(c), c.GetHeader("Referer"))
+	if err != nil {
+		failWithError(c, err, "重新提交投稿失败")
+		return
+	}
+	response.Success(c, detail, "投稿已重新提交，请等待审核")
+}
+
+// ListMine
+// @Summary      获取我的投稿
+// @Tags         文章投稿
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page         query int    false "页码" default(1)
+// @Param        pageSize     query int    false "每页数量" default(10)
+// @Param        review_status query string false "审核状态" Enums(PENDING, CHANGES_REQUESTED, APPROVED, REJECTED)
+// @Success      200 {object} response.Response{data=model.ArticleListResponse} "成功响应"
+// @Router       /contributions [get]
+func (h *Handler) ListMine(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	page, pageSize := pagination(c)
+	result, err := h.svc.ListMine(c.Request.Context(), userID, page, pageSize, c.Query("review_status"))
+	if err != nil {
+		failWithError(c, err, "获取投稿列表失败")
+		return
+	}
+	response.Success(c, result, "获取列表成功")
+}
+
+// GetMine
+// @Summary      获取我的投稿详情
+// @Description  返回投稿内容、当前审核状态与完整的审核记录
+// @Tags         文章投稿
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "投稿文章ID"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      404 {object} response.Response "投稿不存在"
+// @Router       /contributions/{id} [get]
+func (h *Handler) GetMine(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	detail, err := h.svc.GetMine(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		failWithError(c, err, "获取投稿失败")
+		return
+	}
+	response.Success(c, detail, "获取成功")
+}
+
+// ListQueue
+// @Summary      获取投稿审核队列
+// @Tags         投稿审核
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page         query int    false "页码" default(1)
+// @Param        pageSize     query int    false "每页数量" default(10)
+// @Param        review_status query string false "审核状态，为空时返回全部投稿" Enums(PENDING, CHANGES_REQUESTED, APPROVED, REJECTED)
+// @Success      200 {object} response.Response{data=model.ArticleListResponse} "成功响应"
+// @Router       /contribution-reviews [get]
+func (h *Handler) ListQueue(c *gin.Context) {
+	page, pageSize := pagination(c)
+	result, err := h.svc.ListQueue(c.Request.Context(), page, pageSize, c.Query("review_status"))
+	if err != nil {
+		failWithError(c, err, "获取审核队列失败")
+		return
+	}
+	response.Success(c, result, "获取列表成功")
+}
+
+// Get
+// @Summary      获取投稿详情（审核）
+// @Tags         投稿审核
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "投稿文章ID"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      404 {object} response.Response "投稿不存在"
+// @Router       /contribution-reviews/{id} [get]
+func (h *Handler) Get(c *gin.Context) {
+	detail, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWithError(c, err, "获取投稿失败")
+		return
+	}
+	response.Success(c, detail, "获取成功")
+}
+
+// reviewAction 是各审核操作共用的处理流程
+func (h *Handler) reviewAction(c *gin.Context, action func(ctx context.Context, reviewerID uint, publicID, content string) (*model.ContributionDetail, error), failPrefix, successMsg string) {
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	// 通过投稿时意见可选，允许不带请求体
+	var req model.ReviewContributionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	detail, err := action(c.Request.Context(), reviewerID, c.Param("id"), req.Content)
+	if err != nil {
+		failWithError(c, err, failPrefix)
+		return
+	}
+	response.Success(c, detail, successMsg)
+}
+
+// Comment
+// @Summary      发表审核意见
+// @Description  对投稿发表意见，不改变审核状态，并邮件通知投稿者
+// @Tags         投稿审核
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "投稿文章ID"
+// @Param        body body model.ReviewContributionRequest true "审核意见"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      409 {object} response.Response "投稿已审核完成"
+// @Router       /contribution-reviews/{id}/comments [post]
+func (h *Handler) Comment(c *gin.Context) {
+	h.reviewAction(c, h.svc.Comment, "发表审核意见失败", "审核意见已发送")
+}
+
+// RequestChanges
+// @Summary      要求修改投稿
+// @Tags         投稿审核
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "投稿文章ID"
+// @Param        body body model.ReviewContributionRequest true "修改意见"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      409 {object} response.Response "当前状态不允许该操作"
+// @Router       /contribution-reviews/{id}/request-changes [post]
+func (h *Handler) RequestChanges(c *gin.Context) {
+	h.reviewAction(c, h.svc.RequestChanges, "要求修改失败", "已要求作者修改")
+}
+
+// Approve
+// @Summary      通过并发布投稿
+// @Tags         投稿审核
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "投稿文章ID"
+// @Param        body body model.ReviewContributionRequest true "审核意见（可选）"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      409 {object} response.Response "当前状态不允许该操作"
+// @Router       /contribution-reviews/{id}/approve [post]
+func (h *Handler) Approve(c *gin.Context) {
+	h.reviewAction(c, h.svc.Approve, "通过投稿失败", "投稿已通过并发布")
+}
+
+// Reject
+// @Summary      拒绝投稿
+// @Tags         投稿审核
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "投稿文章ID"
+// @Param        body body model.ReviewContributionRequest true "拒绝原因"
+// @Success      200 {object} response.Response{data=model.ContributionDetail} "成功响应"
+// @Failure      409 {object} response.Response "投稿已审核完成"
+// @Router       /contribution-reviews/{id}/reject [post]
+func (h *Handler) Reject(c *gin.Context) {
+	h.reviewAction(c, h.svc.Reject, "拒绝投稿失败", "投稿已拒绝")
+}
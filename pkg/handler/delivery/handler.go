@@ -0,0 +1,121 @@
+/*
+ * @Description: 通知投递队列管理 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package delivery
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	delivery_service "github.com/anzhiyu-c/anheyu-app/pkg/service/delivery"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了通知投递队列管理相关的 HTTP 处理器。
+type Handler struct {
+	svc *delivery_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *delivery_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// List
+// @Summary      获取通知投递记录
+// @Description  分页查询邮件与即时推送的投递记录，可按渠道与状态筛选
+// @Tags         通知投递
+// @Security     BearerAuth
+// @Produce      json
+// @Param        channel  query string false "投递渠道" Enums(email, bark, webhook)
+// @Param        status   query string false "投递状态" Enums(PENDING, SENDING, SENT, FAILED)
+// @Param        page     query int    false "页码" default(1)
+// @Param        pageSize query int    false "每页数量" default(20)
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/notification-deliveries [get]
+func (h *Handler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	query := &model.NotificationDeliveryQuery{
+		Channel:  c.Query("channel"),
+		Status:   c.Query("status"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	list, total, err := h.svc.List(c.Request.Context(), query)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, gin.H{
+		"list":     list,
+		"total":    total,
+		"page":     query.Page,
+		"pageSize": query.PageSize,
+	}, "获取成功")
+}
+
+// Stats
+// @Summary      获取通知投递统计
+// @Description  返回按渠道与状态分组的投递数量
+// @Tags         通知投递
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]model.NotificationDeliveryStat} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/notification-deliveries/stats [get]
+func (h *Handler) Stats(c *gin.Context) {
+	stats, err := h.svc.Stats(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, stats, "获取成功")
+}
+
+// Retry
+// @Summary      重试通知投递
+// @Description  将等待重试或已失败的投递记录清零尝试次数并立即重新投递
+// @Tags         通知投递
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "投递记录ID"
+// @Success      200 {object} response.Response "已重新加入队列"
+// @Failure      400 {object} response.Response "记录已发送或正在发送"
+// @Failure      404 {object} response.Response "记录不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/notification-deliveries/{id}/retry [post]
+func (h *Handler) Retry(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的投递记录ID")
+		return
+	}
+	if err := h.svc.Retry(c.Request.Context(), uint(id)); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "已重新加入投递队列")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * @Description: 运行时诊断信息处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 10:00:00
+ * @LastEditTime: 2026-10-17 05:00:00
+ * @LastEditors: 安知鱼
+ */
+package diagnostic
+
+import (
+	"runtime"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/slowquery"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/version"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 诊断信息处理器
+type Handler struct {
+	settingSvc setting.SettingService
+}
+
+// NewHandler 创建诊断信息处理器实例
+func NewHandler(settingSvc setting.SettingService) *Handler {
+	return &Handler{settingSvc: settingSvc}
+}
+
+// DiagnosticsResponse 诊断信息响应
+type DiagnosticsResponse struct {
+	// Build 当前运行的构建信息，便于在问题反馈中定位具体版本
+	Build           version.BuildInfo          `json:"build"`
+	Goroutines      int                        `json:"goroutines"`
+	HeapAllocBytes  uint64                     `json:"heap_alloc_bytes"`
+	CircuitBreakers []httpclient.BreakerStatus `json:"circuit_breakers"`
+	WorkerPools     []workerpool.PoolStatus    `json:"worker_pools"`
+	// SafeMode 为 true 表示启动时存在非法配置并已回退为默认值
+	SafeMode        bool                     `json:"safe_mode"`
+	InvalidSettings []setting.InvalidSetting `json:"invalid_settings"`
+}
+
+// GetDiagnostics 获取运行时诊断信息
+// @Summary      获取运行时诊断信息
+// @Description  返回构建信息（版本、commit、构建时间、Go 版本与构建标签）、goroutine 数量、堆内存占用、外部调用熔断器状态、后台任务协程池状态以及安全模式下的非法配置列表
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=DiagnosticsResponse}  "获取成功"
+// @Router       /admin/diagnostics [get]
+func (h *Handler) GetDiagnostics(c *gin.Context) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	invalid := h.settingSvc.InvalidSettings()
+	if invalid == nil {
+		invalid = []setting.InvalidSetting{}
+	}
+
+	response.Success(c, DiagnosticsResponse{
+		Build:           version.GetBuildInfo(),
+		Goroutines:      runtime.NumGoroutine(),
+		HeapAllocBytes:  mem.HeapAlloc,
+		CircuitBreakers: httpclient.Snapshot(),
+		WorkerPools:     workerpool.Snapshot(),
+		SafeMode:        len(invalid) > 0,
+		InvalidSettings: invalid,
+	}, "获取诊断信息成功")
+}
+
+// maxSlowQueryLimit 慢查询列表单次最多返回的语句数
+const maxSlowQueryLimit = 200
+
+// SlowQueriesResponse 慢查询汇总响应
+type SlowQueriesResponse struct {
+	// ThresholdMs 当前的慢查询阈值，0 表示未开启记录
+	ThresholdMs int64                 `json:"threshold_ms"`
+	Queries     []slowquery.QueryStat `json:"queries"`
+}
+
+// GetSlowQueries 获取慢查询汇总
+// @Summary      获取慢查询汇总
+// @Description  按 SQL 语句汇总自进程启动（或上次清空）以来超过阈值的查询，按累计耗时降序排列，附带涉及的表，用于判断需要补充的索引。阈值通过配置 slow_query.threshold_ms 设置
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        limit  query  int  false  "返回的语句数，最多 200"  default(20)
+// @Success      200  {object}  response.Response{data=SlowQueriesResponse}  "获取成功"
+// @Router       /admin/diagnostics/slow-queries [get]
+func (h *Handler) GetSlowQueries(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	if limit > maxSlowQueryLimit {
+		limit = maxSlowQueryLimit
+	}
+	response.Success(c, SlowQueriesResponse{
+		ThresholdMs: slowquery.Threshold().Milliseconds(),
+		Queries:     slowquery.Snapshot(limit),
+	}, "获取慢查询汇总成功")
+}
+
+// ResetSlowQueries 清空慢查询汇总
+// @Summary      清空慢查询汇总
+// @Description  清空已汇总的慢查询统计，通常在补充索引后调用以观察效果
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "清空成功"
+// @Router       /admin/diagnostics/slow-queries [delete]
+func (h *Handler) ResetSlowQueries(c *gin.Context) {
+	slowquery.Reset()
+	response.Success(c, nil, "已清空慢查询汇总")
+}
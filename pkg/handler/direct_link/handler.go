@@ -64,7 +64,7 @@ type DirectLinkHandler struct {
 	// 修改为 direct_link.Service (接口)。
 	svc              direct_link.Service
 	storageProviders map[constant.StoragePolicyType]storage.IStorageProvider
-	// styleSvc 可选；非 nil 且 URL 含 "!styleName" 形式后缀时，
+	// styleSvc 可选；非 nil 且 URL 含 "!styleName" 形式后缀或动态处理参数时，
 	// 本地策略的 HandleDirectDownload 会走 ImageStyleService 处理并流式返回。
 	// 未注入或请求无样式后缀时，走原有流式下载逻辑（不影响云存储 302 路径）。
 	styleSvc image_style.ImageStyleService
@@ -82,7 +82,7 @@ func NewDirectLinkHandler(
 }
 
 // SetImageStyleService 注入图片样式服务（可选）。
-// 注入后，本地策略的直链下载在解析到 "!styleName" 样式后缀时，
+// 注入后，本地策略的直链下载在解析到 "!styleName" 样式后缀或 ?w=&h=&fm= 等动态参数时，
 // 会把请求委托给 ImageStyleService 走缓存 + 处理流程，不再直接返回原图。
 func (h *DirectLinkHandler) SetImageStyleService(svc image_style.ImageStyleService) {
 	h.styleSvc = svc
@@ -188,10 +188,11 @@ func (h *DirectLinkHandler) HandleDirectDownload(c *gin.Context) {
 
 	if policy.Type == constant.PolicyTypeLocal {
 		// 本地存储：先判断是否为图片样式请求。
-		// 路径形如 `/filename!styleName` 时走 ImageStyleService；
+		// 路径形如 `/filename!styleName`，或 query 带 `?w=800&fm=webp` 等动态参数时走 ImageStyleService；
 		// 其他情况（无样式 / 未注入 styleSvc）回落到原始流式下载。
 		if h.styleSvc != nil {
-			if styleName := extractLocalStyleName(c.Param("filename"), filename); styleName != "" {
+			styleName := extractLocalStyleName(c.Param("filename"), filename)
+			if styleName != "" || image_style.HasDynamicOpts(c.Request.URL.Query()) {
 				if handled := h.serveStyledLocal(c, file, policy, filename, styleName); handled {
 					return
 				}
@@ -341,6 +342,9 @@ func (h *DirectLinkHandler) serveStyledLocal(
 	defer result.Reader.Close()
 
 	etag := `"` + result.StyleHash + `"`
+	c.Header("ETag", etag)
+	// 样式产物是幂等的（由 style_hash 决定），缓存 7 天与 /api/image 接口保持一致。
+	c.Header("Cache-Control", "public, max-age=604800")
 	// If-None-Match 命中 → 304
 	if match := c.GetHeader("If-None-Match"); match == etag {
 		c.Status(http.StatusNotModified)
@@ -348,9 +352,6 @@ func (h *DirectLinkHandler) serveStyledLocal(
 	}
 
 	c.Header("Content-Type", result.ContentType)
-	c.Header("ETag", etag)
-	// 样式产物是幂等的（由 style_hash 决定），缓存 7 天与 /api/image 接口保持一致。
-	c.Header("Cache-Control", "public, max-age=604800")
 	if !result.LastModified.IsZero() {
 		c.Header("Last-Modified", result.LastModified.UTC().Format(http.TimeFormat))
 	}
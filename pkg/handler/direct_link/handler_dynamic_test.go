@@ -0,0 +1,114 @@
+/*
+ * @Description: 直链 handler 动态图片处理参数测试
+ * @Author: 安知鱼
+ *
+ * 覆盖本地策略下 ?w=&fm= 等动态参数走 ImageStyleService 的分支，
+ * 以及 ETag / Cache-Control / 304 协商缓存行为。
+ */
+package direct_link
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/image_style"
+)
+
+type fakeDirectLinkSvc struct {
+	direct_link.Service
+	file   *model.File
+	policy *model.StoragePolicy
+}
+
+func (f *fakeDirectLinkSvc) PrepareDownload(ctx context.Context, publicID string) (*model.File, string, *model.StoragePolicy, int64, error) {
+	return f.file, f.file.Name, f.policy, 0, nil
+}
+
+type fakeStyleSvc struct {
+	image_style.ImageStyleService
+	lastReq *image_style.StyleRequest
+}
+
+func (f *fakeStyleSvc) Process(ctx context.Context, req *image_style.StyleRequest) (*image_style.StyleResult, error) {
+	f.lastReq = req
+	return &image_style.StyleResult{
+		ContentType: "image/webp",
+		Reader:      io.NopCloser(strings.NewReader("webp-bytes")),
+		Size:        int64(len("webp-bytes")),
+		StyleHash:   "abc123",
+	}, nil
+}
+
+type fakeLocalProvider struct {
+	storage.IStorageProvider
+}
+
+func (f *fakeLocalProvider) Stream(ctx context.Context, policy *model.StoragePolicy, source string, w io.Writer) error {
+	_, err := io.WriteString(w, "original")
+	return err
+}
+
+func newDynamicTestRouter(styleSvc *fakeStyleSvc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	file := &model.File{ID: 7, Name: "photo.jpg", Size: int64(len("original")), PrimaryEntity: &model.FileStorageEntity{}}
+	h := NewDirectLinkHandler(
+		&fakeDirectLinkSvc{file: file, policy: &model.StoragePolicy{ID: 1, Type: constant.PolicyTypeLocal}},
+		map[constant.StoragePolicyType]storage.IStorageProvider{constant.PolicyTypeLocal: &fakeLocalProvider{}},
+	)
+	h.SetImageStyleService(styleSvc)
+	r := gin.New()
+	r.GET("/f/:publicID/*filename", h.HandleDirectDownload)
+	return r
+}
+
+func TestHandleDirectDownload_DynamicParams(t *testing.T) {
+	styleSvc := &fakeStyleSvc{}
+	r := newDynamicTestRouter(styleSvc)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/f/abc/photo.jpg?w=800&fm=webp", nil))
+	if w.Code != http.StatusOK || w.Body.String() != "webp-bytes" {
+		t.Fatalf("动态参数应返回处理后的图片，实际 %d %q", w.Code, w.Body.String())
+	}
+	if styleSvc.lastReq == nil || styleSvc.lastReq.StyleName != "" || styleSvc.lastReq.DynamicOpts.Get("w") != "800" {
+		t.Fatalf("应以纯动态参数调用 ImageStyleService：%+v", styleSvc.lastReq)
+	}
+	if got := w.Header().Get("ETag"); got != `"abc123"` {
+		t.Errorf("ETag = %q", got)
+	}
+	if got := w.Header().Get("Cache-Control"); got != "public, max-age=604800" {
+		t.Errorf("Cache-Control = %q", got)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/f/abc/photo.jpg?w=800&fm=webp", nil)
+	req.Header.Set("If-None-Match", `"abc123"`)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNotModified || w.Header().Get("ETag") != `"abc123"` {
+		t.Errorf("If-None-Match 命中应返回带 ETag 的 304，实际 %d", w.Code)
+	}
+}
+
+func TestHandleDirectDownload_NoParamsStreamsOriginal(t *testing.T) {
+	styleSvc := &fakeStyleSvc{}
+	r := newDynamicTestRouter(styleSvc)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/f/abc/photo.jpg?download=1", nil))
+	if w.Body.String() != "original" {
+		t.Fatalf("无处理参数时应返回原图，实际 %q", w.Body.String())
+	}
+	if styleSvc.lastReq != nil {
+		t.Error("无处理参数时不应调用 ImageStyleService")
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * @Description: 一次性邮箱拦截 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 23:00:00
+ * @LastEditTime: 2026-10-16 23:00:00
+ * @LastEditors: 安知鱼
+ */
+package disposable_email
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	disposable_email_service "github.com/anzhiyu-c/anheyu-app/pkg/service/disposable_email"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了一次性邮箱拦截相关的 HTTP 处理器。
+type Handler struct {
+	svc *disposable_email_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *disposable_email_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Stats
+// @Summary      获取一次性邮箱拦截统计
+// @Description  返回评论与注册的处理方式、域名列表规模与最后更新时间，以及各场景的拒绝与审核次数
+// @Tags         一次性邮箱
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=disposable_email_service.Stats} "成功响应"
+// @Router       /admin/disposable-email/stats [get]
+func (h *Handler) Stats(c *gin.Context) {
+	response.Success(c, h.svc.Stats(c.Request.Context()), "获取成功")
+}
+
+// Refresh
+// @Summary      立即更新一次性邮箱域名列表
+// @Description  从配置的下载地址获取最新列表并与内置列表合并，下载失败时继续使用已有列表
+// @Tags         一次性邮箱
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=disposable_email_service.Stats} "更新成功"
+// @Failure      400 {object} response.Response "未配置下载地址"
+// @Failure      502 {object} response.Response "下载或解析列表失败"
+// @Router       /admin/disposable-email/refresh [post]
+func (h *Handler) Refresh(c *gin.Context) {
+	if _, err := h.svc.Refresh(c.Request.Context()); err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusBadGateway, err.Error())
+		return
+	}
+	response.Success(c, h.svc.Stats(c.Request.Context()), "更新成功")
+}
@@ -165,14 +165,14 @@ func (h *FileHandler) CreateEmptyFile(c *gin.Context) {
 
 // DeleteItems 处理删除文件或文件夹的请求 (DELETE /api/files)
 // @Summary      删除文件/文件夹
-// @Description  删除一个或多个文件/文件夹
+// @Description  删除一个或多个文件/文件夹。回收站启用时移入回收站，无法移入回收站的项目返回 400，可设置 permanent 永久删除
 // @Tags         文件管理
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
 // @Param        body  body  model.DeleteItemsRequest  true  "删除请求"
 // @Success      200  {object}  response.Response  "项目已删除"
-// @Failure      400  {object}  response.Response  "请求参数无效"
+// @Failure      400  {object}  response.Response  "请求参数无效或项目无法移入回收站"
 // @Failure      401  {object}  response.Response  "未授权"
 // @Failure      403  {object}  response.Response  "删除失败：无权限"
 // @Failure      500  {object}  response.Response  "删除失败"
@@ -195,11 +195,18 @@ func (h *FileHandler) DeleteItems(c *gin.Context) {
 		return
 	}
 
-	err = h.fileSvc.DeleteItems(c.Request.Context(), ownerID, req.IDs)
+	if req.Permanent {
+		err = h.fileSvc.DeleteItemsPermanently(c.Request.Context(), ownerID, req.IDs)
+	} else {
+		err = h.fileSvc.DeleteItems(c.Request.Context(), ownerID, req.IDs)
+	}
 	if err != nil {
-		if errors.Is(err, constant.ErrForbidden) {
+		switch {
+		case errors.Is(err, constant.ErrForbidden):
 			response.Fail(c, http.StatusForbidden, "删除失败: "+err.Error())
-		} else {
+		case errors.Is(err, constant.ErrInvalidOperation):
+			response.Fail(c, http.StatusBadRequest, "删除失败: "+err.Error())
+		default:
 			response.Fail(c, http.StatusInternalServerError, "删除失败: "+err.Error())
 		}
 		return
@@ -33,6 +33,7 @@ import (
 // @Produce      json
 // @Param        uri         query  string  false  "虚拟路径URI"  default(anzhiyu://my/)
 // @Param        next_token  query  string  false  "分页令牌"
+// @Param        fields      query  string  false  "只返回文件项中的指定字段，逗号分隔，如 id,name,url"
 // @Success      200  {object}  response.Response  "获取成功"
 // @Failure      400  {object}  response.Response  "URI格式无效"
 // @Failure      401  {object}  response.Response  "未授权"
@@ -77,8 +78,11 @@ func (h *FileHandler) GetFilesByPath(c *gin.Context) {
 		return
 	}
 
-	// 6. 返回成功响应
-	response.Success(c, fileListResponse, "文件列表获取成功")
+	// 6. 返回成功响应，游标分页时通过 Link 头给出下一页地址
+	if fileListResponse.Pagination != nil {
+		response.SetCursorPaginationHeaders(c, "next_token", fileListResponse.Pagination.NextToken)
+	}
+	response.SuccessWithFields(c, fileListResponse, "files", "文件列表获取成功")
 }
 
 // GetFileInfo 处理获取单个文件或文件夹详细信息的请求 (GET /api/file/:id)
@@ -0,0 +1,139 @@
+package file
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentOwnerID 从 JWT 中解析当前用户的数据库ID，失败时已写出 401 响应
+func currentOwnerID(c *gin.Context) (uint, bool) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return 0, false
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return ownerID, true
+}
+
+// failRecycleBin 将回收站操作的错误映射为对应的 HTTP 状态码
+func failRecycleBin(c *gin.Context, action string, err error) {
+	switch {
+	case errors.Is(err, constant.ErrInvalidPublicID):
+		response.Fail(c, http.StatusBadRequest, action+"失败: "+err.Error())
+	case errors.Is(err, constant.ErrForbidden):
+		response.Fail(c, http.StatusForbidden, action+"失败: "+err.Error())
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, action+"失败: 回收站中不存在该项目")
+	case errors.Is(err, constant.ErrConflict):
+		response.Fail(c, http.StatusConflict, action+"失败: "+err.Error())
+	case errors.Is(err, constant.ErrInvalidOperation):
+		response.Fail(c, http.StatusBadRequest, action+"失败: "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, action+"失败: "+err.Error())
+	}
+}
+
+// ListRecycleBin 处理列出回收站条目的请求 (GET /api/recycle-bin)
+// @Summary      列出回收站
+// @Description  分页列出当前用户回收站中的文件和文件夹，按删除时间倒序排列
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page      query  int  false  "页码，默认 1"
+// @Param        pageSize  query  int  false  "每页数量，默认 20，最大 100"
+// @Success      200  {object}  response.Response{data=model.RecycleItemListResponse}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /recycle-bin [get]
+func (h *FileHandler) ListRecycleBin(c *gin.Context) {
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	result, err := h.fileSvc.ListRecycleBin(c.Request.Context(), ownerID, page, pageSize)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, result, "获取回收站成功")
+}
+
+// RestoreRecycleItems 处理还原回收站条目的请求 (POST /api/recycle-bin/restore)
+// @Summary      还原回收站条目
+// @Description  将回收站中的文件或文件夹还原到原位置，原位置存在同名项目或原文件夹已被删除时返回 409，存储路径无法同步还原时返回 400
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  model.RecycleBinActionRequest  true  "要还原的文件ID列表"
+// @Success      200  {object}  response.Response  "还原成功"
+// @Failure      400  {object}  response.Response  "请求参数无效"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      404  {object}  response.Response  "回收站中不存在该项目"
+// @Failure      409  {object}  response.Response  "原位置存在同名项目"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /recycle-bin/restore [post]
+func (h *FileHandler) RestoreRecycleItems(c *gin.Context) {
+	var req model.RecycleBinActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil || len(req.IDs) == 0 {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: 需要提供要还原的项目ID")
+		return
+	}
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.fileSvc.RestoreRecycleItems(c.Request.Context(), ownerID, req.IDs); err != nil {
+		failRecycleBin(c, "还原", err)
+		return
+	}
+	response.Success(c, nil, "还原成功")
+}
+
+// PurgeRecycleItems 处理彻底删除回收站条目的请求 (POST /api/recycle-bin/purge)
+// @Summary      彻底删除回收站条目
+// @Description  永久删除回收站中的文件或文件夹及其物理文件；all 为 true 时清空整个回收站
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  model.RecycleBinActionRequest  true  "要彻底删除的文件ID列表，或 all: true 清空回收站"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      400  {object}  response.Response  "请求参数无效"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /recycle-bin/purge [post]
+func (h *FileHandler) PurgeRecycleItems(c *gin.Context) {
+	var req model.RecycleBinActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil || (!req.All && len(req.IDs) == 0) {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: 需要提供要彻底删除的项目ID，或使用 all: true 清空回收站")
+		return
+	}
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.fileSvc.PurgeRecycleItems(c.Request.Context(), ownerID, req.IDs, req.All); err != nil {
+		failRecycleBin(c, "彻底删除", err)
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
@@ -26,6 +26,9 @@ import (
 // @Failure      401  {object}  response.Response  "未授权"
 // @Failure      404  {object}  response.Response  "目标路径不存在"
 // @Failure      409  {object}  response.Response  "文件已存在"
+// @Failure      413  {object}  response.Response  "文件大小超出用户组或存储策略限制"
+// @Failure      415  {object}  response.Response  "文件类型不允许上传"
+// @Failure      429  {object}  response.Response  "今日上传次数已达用户组上限"
 // @Failure      500  {object}  response.Response  "创建失败"
 // @Router       /file/upload [put]
 func (h *FileHandler) CreateUploadSession(c *gin.Context) {
@@ -49,8 +52,16 @@ func (h *FileHandler) CreateUploadSession(c *gin.Context) {
 	if err != nil {
 		if errors.Is(err, constant.ErrConflict) {
 			response.Fail(c, http.StatusConflict, "创建失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrUploadExtensionNotAllowed) {
+			response.Fail(c, http.StatusUnsupportedMediaType, "创建失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrUploadFileTooLarge) {
+			response.Fail(c, http.StatusRequestEntityTooLarge, "创建失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrUploadDailyLimitExceeded) {
+			response.Fail(c, http.StatusTooManyRequests, "创建失败: "+err.Error())
 		} else if errors.Is(err, constant.ErrNotFound) {
 			response.Fail(c, http.StatusNotFound, "创建失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, "创建失败: "+err.Error())
 		} else {
 			response.Fail(c, http.StatusInternalServerError, "创建失败: "+err.Error())
 		}
@@ -66,7 +77,7 @@ func (h *FileHandler) CreateUploadSession(c *gin.Context) {
 // @Security     BearerAuth
 // @Produce      json
 // @Param        sessionId  path  string  true  "会话ID"
-// @Success      200  {object}  response.Response  "会话有效"
+// @Success      200  {object}  response.Response{data=model.UploadSessionStatusResponse}  "会话有效"
 // @Failure      400  {object}  response.Response  "缺少sessionId"
 // @Failure      401  {object}  response.Response  "未授权"
 // @Failure      403  {object}  response.Response  "无权访问此上传会话"
@@ -116,6 +127,37 @@ func (h *FileHandler) GetUploadSessionStatus(c *gin.Context) {
 	response.Success(c, sessionStatus, "会话有效")
 }
 
+// ListUploadSessions 处理列出当前用户所有进行中上传会话的请求 (GET /api/file/upload/sessions)
+// @Summary      列出进行中的上传会话
+// @Description  返回当前用户所有未过期的上传会话及其进度，供前端刷新页面后恢复上传
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]model.UploadSessionStatusResponse}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /file/upload/sessions [get]
+func (h *FileHandler) ListUploadSessions(c *gin.Context) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return
+	}
+
+	sessions, err := h.uploadSvc.ListUploadSessions(c.Request.Context(), ownerID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取上传会话失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, sessions, "获取上传会话成功")
+}
+
 // UploadChunk 处理上传文件分片的请求 (POST /api/file/upload/:sessionId/:index)
 // @Summary      上传文件分片
 // @Description  上传文件的某个分片
@@ -126,8 +168,9 @@ func (h *FileHandler) GetUploadSessionStatus(c *gin.Context) {
 // @Param        sessionId  path  string  true  "会话ID"
 // @Param        index      path  int     true  "分片索引（从0开始）"
 // @Param        chunk      body  string  true  "分片数据"
+// @Param        X-Chunk-Checksum  header  string  false  "分片摘要，格式为 sha256=<hex> 或 crc32c=<hex>"
 // @Success      200  {object}  response.Response  "文件块上传成功"
-// @Failure      400  {object}  response.Response  "无效的分块索引"
+// @Failure      400  {object}  response.Response  "无效的分块索引或分片校验失败"
 // @Failure      500  {object}  response.Response  "文件块上传失败"
 // @Router       /file/upload/{sessionId}/{index} [post]
 func (h *FileHandler) UploadChunk(c *gin.Context) {
@@ -150,12 +193,16 @@ func (h *FileHandler) UploadChunk(c *gin.Context) {
 		return
 	}
 
-	err = h.uploadSvc.UploadChunk(c.Request.Context(), ownerID, sessionID, index, c.Request.Body)
+	err = h.uploadSvc.UploadChunk(c.Request.Context(), ownerID, sessionID, index, c.Request.Body, c.GetHeader("X-Chunk-Checksum"))
 	if err != nil {
 		if errors.Is(err, constant.ErrForbidden) {
 			response.Fail(c, http.StatusForbidden, "无权操作此上传会话")
 			return
 		}
+		if errors.Is(err, constant.ErrChecksumMismatch) || errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, "文件块上传失败: "+err.Error())
+			return
+		}
 		response.Fail(c, http.StatusInternalServerError, "文件块上传失败: "+err.Error())
 		return
 	}
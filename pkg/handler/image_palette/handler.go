@@ -0,0 +1,63 @@
+/*
+ * @Description: 图片配色 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 21:00:00
+ * @LastEditTime: 2026-10-17 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package image_palette
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	image_palette_service "github.com/anzhiyu-c/anheyu-app/pkg/service/image_palette"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了图片配色相关的 HTTP 处理器。
+type Handler struct {
+	svc *image_palette_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *image_palette_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetPalette
+// @Summary      获取图片配色
+// @Description  返回系统内图片的主色与强调色，供相册与文章卡片配色。首次请求时在后台提取并返回 202，稍后重试即可获取结果
+// @Tags         公共接口
+// @Produce      json
+// @Param        id path string true "文件或直链公共ID"
+// @Success      200 {object} response.Response{data=image_palette_service.Result} "配色已就绪"
+// @Success      202 {object} response.Response{data=image_palette_service.Result} "配色提取中"
+// @Failure      400 {object} response.Response "图片ID无效"
+// @Failure      422 {object} response.Response "图片无法提取配色"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/palette/{id} [get]
+func (h *Handler) GetPalette(c *gin.Context) {
+	result, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	switch result.Status {
+	case image_palette_service.StatusPending:
+		response.SuccessWithStatus(c, http.StatusAccepted, result, "配色提取中，请稍后重试")
+	case image_palette_service.StatusFailed:
+		response.Fail(c, http.StatusUnprocessableEntity, result.Error)
+	default:
+		// 配色随图片内容固定，允许浏览器与 CDN 缓存
+		c.Header("Cache-Control", "public, max-age=86400")
+		response.Success(c, result, "获取成功")
+	}
+}
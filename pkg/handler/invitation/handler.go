@@ -0,0 +1,127 @@
+/*
+ * @Description: 注册邀请码 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package invitation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	invitation_service "github.com/anzhiyu-c/anheyu-app/pkg/service/invitation"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了注册邀请码相关的 HTTP 处理器。
+type Handler struct {
+	svc *invitation_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *invitation_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// List
+// @Summary      获取邀请码列表
+// @Description  分页列出管理员生成的注册邀请码及其使用情况
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page      query int false "页码" default(1)
+// @Param        pageSize  query int false "每页数量" default(20)
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/invitation-codes [get]
+func (h *Handler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	list, total, err := h.svc.List(c.Request.Context(), page, pageSize)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取邀请码失败: "+err.Error())
+		return
+	}
+	response.Success(c, gin.H{
+		"list":     list,
+		"total":    total,
+		"page":     page,
+		"pageSize": pageSize,
+	}, "获取成功")
+}
+
+// Create
+// @Summary      生成邀请码
+// @Description  批量生成注册邀请码，可限制每个邀请码的使用次数与有效期
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.CreateInvitationCodeRequest true "生成参数"
+// @Success      200 {object} response.Response{data=[]model.InvitationCode} "生成成功"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/invitation-codes [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req model.CreateInvitationCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：生成数量需在 1 到 100 之间，使用次数需在 1 到 10000 之间")
+		return
+	}
+
+	claims, ok := c.MustGet(auth.ClaimsKey).(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户信息格式不正确")
+		return
+	}
+	adminID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "用户ID无效")
+		return
+	}
+
+	codes, err := h.svc.Create(c.Request.Context(), adminID, &req)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, codes, "生成成功")
+}
+
+// Delete
+// @Summary      删除邀请码
+// @Description  删除（作废）指定的邀请码，已使用该邀请码注册的用户不受影响
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "邀请码ID"
+// @Success      200 {object} response.Response "删除成功"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      404 {object} response.Response "邀请码不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/invitation-codes/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		response.Fail(c, http.StatusBadRequest, "邀请码ID无效")
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), uint(id)); err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			response.Fail(c, http.StatusNotFound, "邀请码不存在")
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "删除邀请码失败: "+err.Error())
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
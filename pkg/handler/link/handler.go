@@ -10,7 +10,9 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/link"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -31,7 +33,8 @@ var (
 
 // Handler 负责处理友链相关的 API 请求。
 type Handler struct {
-	linkSvc link.Service
+	linkSvc    link.Service
+	captchaSvc captcha.CaptchaService // 可选，申请友链时的人机验证
 }
 
 // NewHandler 是 Handler 的构造函数。
@@ -39,6 +42,17 @@ func NewHandler(linkSvc link.Service) *Handler {
 	return &Handler{linkSvc: linkSvc}
 }
 
+// SetCaptchaService 注入人机验证服务，captcha.scenes 包含 link_apply 时申请友链需要通过验证。
+func (h *Handler) SetCaptchaService(captchaSvc captcha.CaptchaService) {
+	h.captchaSvc = captchaSvc
+}
+
+// applyLinkRequest 友链申请的请求体，在申请信息之外附带人机验证参数。
+type applyLinkRequest struct {
+	model.ApplyLinkRequest
+	captcha.CaptchaParams
+}
+
 // --- 前台公开接口 ---
 
 // GetRandomLinks 处理随机获取友链的请求。
@@ -70,17 +84,24 @@ func (h *Handler) GetRandomLinks(c *gin.Context) {
 // @Produce      json
 // @Param        body  body  model.ApplyLinkRequest  true  "友链申请信息"
 // @Success      200  {object}  response.Response  "申请已提交"
-// @Failure      400  {object}  response.Response  "参数无效"
+// @Failure      400  {object}  response.Response  "参数无效或人机验证未通过"
 // @Failure      500  {object}  response.Response  "申请失败"
 // @Router       /public/links [post]
 func (h *Handler) ApplyLink(c *gin.Context) {
-	var req model.ApplyLinkRequest
+	var req applyLinkRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.Fail(c, http.StatusBadRequest, "参数无效: "+err.Error())
 		return
 	}
 
-	_, err := h.linkSvc.ApplyLink(c.Request.Context(), &req)
+	if h.captchaSvc != nil {
+		if err := h.captchaSvc.VerifyScene(c.Request.Context(), captcha.SceneLinkApply, req.CaptchaParams, util.GetRealClientIP(c)); err != nil {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	_, err := h.linkSvc.ApplyLink(c.Request.Context(), &req.ApplyLinkRequest)
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "申请失败: "+err.Error())
 		return
@@ -136,6 +157,7 @@ func (h *Handler) ListPublicLinks(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取列表失败: "+err.Error())
 		return
 	}
+	response.SetPaginationHeaders(c, result.Total, result.Page, result.PageSize)
 	response.Success(c, result, "获取成功")
 }
 
@@ -164,6 +186,7 @@ func (h *Handler) ListAllApplications(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取申请列表失败: "+err.Error())
 		return
 	}
+	response.SetPaginationHeaders(c, result.Total, result.Page, result.PageSize)
 	response.Success(c, result, "获取成功")
 }
 
@@ -276,6 +299,7 @@ func (h *Handler) ListLinks(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取列表失败: "+err.Error())
 		return
 	}
+	response.SetPaginationHeaders(c, result.Total, result.Page, result.PageSize)
 	response.Success(c, result, "获取成功")
 }
 
@@ -690,6 +714,34 @@ func (h *Handler) GetHealthCheckStatus(c *gin.Context) {
 	response.Success(c, status, "获取成功")
 }
 
+// GetLinkHealthHistory 获取单个友链的健康检查记录，用于展示响应耗时趋势和证书过期时间。
+// @Summary      获取友链健康检查记录
+// @Description  按检查时间倒序返回友链最近的健康检查记录
+// @Tags         友链管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path   string  true   "友链ID"
+// @Param        limit  query  int     false  "返回条数，默认 50，最大 500"
+// @Success      200  {object}  response.Response{data=[]model.LinkCheckRecordDTO}  "获取成功"
+// @Failure      400  {object}  response.Response  "参数无效"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /links/{id}/health-history [get]
+func (h *Handler) GetLinkHealthHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "ID 格式无效")
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	records, err := h.linkSvc.GetLinkHealthHistory(c.Request.Context(), id, limit)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取失败: "+err.Error())
+		return
+	}
+	response.Success(c, records, "获取成功")
+}
+
 // BatchUpdateLinkSort 批量更新友链排序。
 // @Summary      批量更新友链排序
 // @Description  批量更新友链的显示顺序
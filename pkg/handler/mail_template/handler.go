@@ -0,0 +1,167 @@
+/*
+ * @Description: 评论通知邮件模板管理 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package mail_template
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	mail_template_service "github.com/anzhiyu-c/anheyu-app/pkg/service/mail_template"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了邮件模板管理相关的 HTTP 处理器。
+type Handler struct {
+	svc *mail_template_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *mail_template_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// contentRequest 是保存与预览模板的请求体
+type contentRequest struct {
+	Content string `json:"content"`
+}
+
+// List
+// @Summary      获取邮件模板列表
+// @Description  返回所有可编辑的评论通知邮件模板，包含当前内容、默认内容、可用变量与历史版本数
+// @Tags         邮件模板
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]model.MailTemplate} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/mail-templates [get]
+func (h *Handler) List(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// Update
+// @Summary      保存邮件模板
+// @Description  校验模板语法与变量后保存，修改前的内容会存为历史版本
+// @Tags         邮件模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        key  path string         true "模板配置键"
+// @Param        body body contentRequest true "模板内容"
+// @Success      200 {object} response.Response{data=model.MailTemplate} "保存成功"
+// @Failure      400 {object} response.Response "模板校验失败"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/mail-templates/{key} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req contentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	tpl, err := h.svc.Update(c.Request.Context(), c.Param("key"), req.Content)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, tpl, "保存成功")
+}
+
+// Preview
+// @Summary      预览邮件模板
+// @Description  使用示例数据渲染模板，未提供内容时渲染当前保存的模板
+// @Tags         邮件模板
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        key  path string         true  "模板配置键"
+// @Param        body body contentRequest false "待预览的模板内容"
+// @Success      200 {object} response.Response{data=model.MailTemplatePreview} "成功响应"
+// @Failure      400 {object} response.Response "模板校验失败"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Router       /admin/mail-templates/{key}/preview [post]
+func (h *Handler) Preview(c *gin.Context) {
+	var req contentRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Fail(c, http.StatusBadRequest, "参数错误")
+			return
+		}
+	}
+	preview, err := h.svc.Preview(c.Param("key"), req.Content)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, preview, "渲染成功")
+}
+
+// ListVersions
+// @Summary      获取邮件模板历史版本
+// @Description  返回模板被修改前保存的历史版本，最新的在前
+// @Tags         邮件模板
+// @Security     BearerAuth
+// @Produce      json
+// @Param        key path string true "模板配置键"
+// @Success      200 {object} response.Response{data=[]model.MailTemplateVersion} "成功响应"
+// @Failure      404 {object} response.Response "模板不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/mail-templates/{key}/versions [get]
+func (h *Handler) ListVersions(c *gin.Context) {
+	versions, err := h.svc.ListVersions(c.Request.Context(), c.Param("key"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, versions, "获取成功")
+}
+
+// Rollback
+// @Summary      回滚邮件模板
+// @Description  将模板恢复为指定的历史版本，当前内容会存为新的历史版本
+// @Tags         邮件模板
+// @Security     BearerAuth
+// @Produce      json
+// @Param        key path string true "模板配置键"
+// @Param        id  path int    true "历史版本ID"
+// @Success      200 {object} response.Response{data=model.MailTemplate} "回滚成功"
+// @Failure      400 {object} response.Response "历史版本无法通过校验"
+// @Failure      404 {object} response.Response "模板或历史版本不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/mail-templates/{key}/versions/{id}/rollback [post]
+func (h *Handler) Rollback(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的历史版本ID")
+		return
+	}
+	tpl, err := h.svc.Rollback(c.Request.Context(), c.Param("key"), uint(id))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, tpl, "回滚成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
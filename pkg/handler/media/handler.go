@@ -0,0 +1,115 @@
+/*
+ * @Description: 媒体库处理器（文章/评论图片汇总、引用查询与孤立图片清理）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 19:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package media
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
+	media_service "github.com/anzhiyu-c/anheyu-app/pkg/service/media"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 媒体库处理器
+type Handler struct {
+	svc        *media_service.Service
+	cleanupSvc cleanup.ICleanupService
+}
+
+// NewHandler 创建媒体库处理器
+func NewHandler(svc *media_service.Service, cleanupSvc cleanup.ICleanupService) *Handler {
+	return &Handler{
+		svc:        svc,
+		cleanupSvc: cleanupSvc,
+	}
+}
+
+// List 获取媒体库列表
+// @Summary      获取媒体库列表
+// @Description  列出文章图片与评论图片策略下的所有文件，附带引用它们的文章/页面/评论，并标记孤立图片（上传超过24小时且无任何引用）
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page         query     int     false  "页码"  default(1)
+// @Param        pageSize     query     int     false  "每页数量"  default(50)
+// @Param        policy_flag  query     string  false  "策略类型"  Enums(article_image, comment_image)
+// @Param        orphan       query     bool    false  "仅显示孤立图片"
+// @Param        fields       query     string  false  "只返回列表项中的指定字段，逗号分隔"
+// @Success      200  {object}  response.Response{data=media_service.ListResult}  "获取成功"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /admin/media [get]
+func (h *Handler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+	orphanOnly, _ := strconv.ParseBool(c.Query("orphan"))
+
+	result, err := h.svc.List(c.Request.Context(), media_service.ListOptions{
+		Page:       page,
+		PageSize:   pageSize,
+		PolicyFlag: c.Query("policy_flag"),
+		OrphanOnly: orphanOnly,
+	})
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "获取媒体库失败: "+err.Error())
+		return
+	}
+	response.SuccessWithFields(c, result, "list", "获取媒体库成功")
+}
+
+// PreviewOrphanCleanup 试运行孤立图片清理
+// @Summary      试运行孤立图片清理
+// @Description  统计当前会被清理的孤立图片数量，不修改数据
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=cleanup.MediaCleanupReport}  "试运行报告"
+// @Failure      500  {object}  response.Response  "统计失败"
+// @Router       /admin/media/orphans/cleanup/preview [get]
+func (h *Handler) PreviewOrphanCleanup(c *gin.Context) {
+	h.runOrphanCleanup(c, true)
+}
+
+// CleanupOrphans 清理孤立图片
+// @Summary      清理孤立图片
+// @Description  把所有未被引用的文章/评论图片移入回收站，回收站未启用时拒绝执行
+// @Tags         媒体库
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=cleanup.MediaCleanupReport}  "清理成功"
+// @Failure      400  {object}  response.Response  "回收站未启用"
+// @Failure      500  {object}  response.Response  "清理失败"
+// @Router       /admin/media/orphans/cleanup [post]
+func (h *Handler) CleanupOrphans(c *gin.Context) {
+	h.runOrphanCleanup(c, false)
+}
+
+func (h *Handler) runOrphanCleanup(c *gin.Context, dryRun bool) {
+	report, err := h.cleanupSvc.CleanupOrphanedMedia(c.Request.Context(), dryRun)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "清理孤立图片失败: "+err.Error())
+		return
+	}
+	if dryRun {
+		response.Success(c, report, "试运行完成")
+		return
+	}
+	response.Success(c, report, "清理孤立图片成功")
+}
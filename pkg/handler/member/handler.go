@@ -0,0 +1,55 @@
+/*
+ * @Description: 注册用户公开资料页 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package member
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	member_service "github.com/anzhiyu-c/anheyu-app/pkg/service/member"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了公开资料页相关的 HTTP 处理器。
+type Handler struct {
+	svc *member_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *member_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetProfile
+// @Summary      获取用户公开资料
+// @Description  返回注册用户的公开资料（昵称、头像、简介、网站、最近评论），各字段是否返回由用户在个人设置中的可见性开关决定；用户未公开资料页时返回 404
+// @Tags         公开用户
+// @Produce      json
+// @Param        id path string true "用户公共ID"
+// @Success      200 {object} response.Response{data=member.Profile} "成功响应"
+// @Failure      400 {object} response.Response "用户ID无效"
+// @Failure      404 {object} response.Response "用户不存在或未公开资料"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/users/{id} [get]
+func (h *Handler) GetProfile(c *gin.Context) {
+	profile, err := h.svc.Profile(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, "获取用户资料失败: "+err.Error())
+		}
+		return
+	}
+	response.Success(c, profile, "获取成功")
+}
@@ -0,0 +1,261 @@
+/*
+ * @Description: Micropub 发布接口处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-17 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package micropub
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	access_token_service "github.com/anzhiyu-c/anheyu-app/pkg/service/access_token"
+	micropub_service "github.com/anzhiyu-c/anheyu-app/pkg/service/micropub"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	tokenContextKey = "micropub_token"
+	userContextKey  = "micropub_user"
+	// maxJSONBodySize JSON 请求体的大小上限
+	maxJSONBodySize = 10 << 20
+)
+
+// Handler 封装了 Micropub 发布接口的 HTTP 处理器，请求使用个人访问令牌认证。
+type Handler struct {
+	svc      *micropub_service.Service
+	tokenSvc *access_token_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *micropub_service.Service, tokenSvc *access_token_service.Service) *Handler {
+	return &Handler{svc: svc, tokenSvc: tokenSvc}
+}
+
+// micropubError 按 Micropub 规范返回错误：{"error": "...", "error_description": "..."}
+func micropubError(c *gin.Context, status int, code, description string) {
+	c.AbortWithStatusJSON(status, gin.H{"error": code, "error_description": description})
+}
+
+// failWithError 根据业务错误类型返回 Micropub 错误
+func failWithError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constant.ErrBadRequest):
+		micropubError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	case errors.Is(err, constant.ErrNotFound):
+		micropubError(c, http.StatusBadRequest, "invalid_request", "文章不存在")
+	case errors.Is(err, constant.ErrForbidden):
+		micropubError(c, http.StatusForbidden, "forbidden", err.Error())
+	default:
+		micropubError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+}
+
+// TokenAuth 是校验个人访问令牌的中间件。
+// 令牌可以通过 Authorization: Bearer <token> 请求头传递，也可以按 Micropub 规范放在表单字段 access_token 中。
+func (h *Handler) TokenAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw := ""
+		if header := c.GetHeader("Authorization"); strings.HasPrefix(header, "Bearer ") {
+			raw = strings.TrimPrefix(header, "Bearer ")
+		} else if !strings.HasPrefix(c.ContentType(), "application/json") {
+			raw = c.PostForm("access_token")
+		}
+		if raw == "" {
+			micropubError(c, http.StatusUnauthorized, "unauthorized", "缺少访问令牌")
+			return
+		}
+
+		token, user, err := h.tokenSvc.Authenticate(c.Request.Context(), raw)
+		if err != nil {
+			if errors.Is(err, constant.ErrInvalidToken) {
+				micropubError(c, http.StatusUnauthorized, "unauthorized", err.Error())
+				return
+			}
+			micropubError(c, http.StatusInternalServerError, "server_error", "校验访问令牌失败")
+			return
+		}
+		c.Set(tokenContextKey, token)
+		c.Set(userContextKey, user)
+		c.Next()
+	}
+}
+
+// requireScope 校验当前令牌是否拥有指定权限，返回令牌所属用户
+func requireScope(c *gin.Context, scope string) (*model.User, bool) {
+	token, _ := c.MustGet(tokenContextKey).(*model.AccessToken)
+	user, _ := c.MustGet(userContextKey).(*model.User)
+	if token == nil || user == nil {
+		micropubError(c, http.StatusUnauthorized, "unauthorized", "缺少访问令牌")
+		return nil, false
+	}
+	if !token.HasScope(scope) {
+		micropubError(c, http.StatusForbidden, "insufficient_scope", "令牌缺少 "+scope+" 权限")
+		return nil, false
+	}
+	return user, true
+}
+
+// Query
+// @Summary      Micropub 查询
+// @Description  支持 q=config、q=source（需 url 参数，可用 properties[] 过滤属性）与 q=syndicate-to
+// @Tags         Micropub
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q   query string true  "查询类型" Enums(config, source, syndicate-to)
+// @Param        url query string false "文章链接（q=source 时必填）"
+// @Success      200 {object} map[string]interface{} "查询结果"
+// @Failure      400 {object} map[string]string "请求参数错误"
+// @Failure      401 {object} map[string]string "令牌无效"
+// @Router       /micropub [get]
+func (h *Handler) Query(c *gin.Context) {
+	switch c.Query("q") {
+	case "config":
+		c.JSON(http.StatusOK, h.svc.Config())
+	case "syndicate-to":
+		c.JSON(http.StatusOK, gin.H{"syndicate-to": []interface{}{}})
+	case "source":
+		user, ok := requireScope(c, model.TokenScopeUpdate)
+		if !ok {
+			return
+		}
+		props := c.QueryArray("properties[]")
+		if len(props) == 0 {
+			props = c.QueryArray("properties")
+		}
+		source, err := h.svc.Source(c.Request.Context(), user, c.Query("url"), props)
+		if err != nil {
+			failWithError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, source)
+	default:
+		micropubError(c, http.StatusBadRequest, "invalid_request", "不支持的查询类型")
+	}
+}
+
+// Publish
+// @Summary      Micropub 发布
+// @Description  创建、更新或删除文章。支持表单编码与 JSON 两种格式，创建成功时返回 201 并在 Location 头中给出文章链接。
+// @Description  post-status=draft 创建草稿；category 为标签名，不存在时自动创建；mp-slug 为文章永久链接。
+// @Tags         Micropub
+// @Security     BearerAuth
+// @Accept       json,x-www-form-urlencoded,mpfd
+// @Produce      json
+// @Success      201 "创建成功，Location 头为文章链接"
+// @Success      200 "更新成功"
+// @Success      204 "删除成功"
+// @Failure      400 {object} map[string]string "请求参数错误"
+// @Failure      401 {object} map[string]string "令牌无效"
+// @Failure      403 {object} map[string]string "权限不足"
+// @Router       /micropub [post]
+func (h *Handler) Publish(c *gin.Context) {
+	var (
+		req *micropub_service.Request
+		err error
+	)
+	if strings.HasPrefix(c.ContentType(), "application/json") {
+		body, readErr := io.ReadAll(io.LimitReader(c.Request.Body, maxJSONBodySize))
+		if readErr != nil {
+			micropubError(c, http.StatusBadRequest, "invalid_request", "读取请求体失败")
+			return
+		}
+		req, err = micropub_service.ParseJSON(body)
+	} else {
+		if parseErr := c.Request.ParseMultipartForm(32 << 20); parseErr != nil && !errors.Is(parseErr, http.ErrNotMultipart) {
+			micropubError(c, http.StatusBadRequest, "invalid_request", "解析表单失败")
+			return
+		}
+		req, err = micropub_service.ParseForm(c.Request.PostForm)
+	}
+	if err != nil {
+		failWithError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	switch req.Action {
+	case micropub_service.ActionCreate:
+		user, ok := requireScope(c, model.TokenScopeCreate)
+		if !ok {
+			return
+		}
+		location, err := h.svc.Create(ctx, user, req, util.GetRealClientIP(c), c.GetHeader("Referer"))
+		if err != nil {
+			failWithError(c, err)
+			return
+		}
+		c.Header("Location", location)
+		c.Status(http.StatusCreated)
+	case micropub_service.ActionUpdate:
+		user, ok := requireScope(c, model.TokenScopeUpdate)
+		if !ok {
+			return
+		}
+		location, err := h.svc.Update(ctx, user, req)
+		if err != nil {
+			failWithError(c, err)
+			return
+		}
+		c.Header("Location", location)
+		c.Status(http.StatusOK)
+	case micropub_service.ActionDelete:
+		user, ok := requireScope(c, model.TokenScopeDelete)
+		if !ok {
+			return
+		}
+		if err := h.svc.Delete(ctx, user, req); err != nil {
+			failWithError(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	default:
+		micropubError(c, http.StatusBadRequest, "invalid_request", "不支持的操作: "+req.Action)
+	}
+}
+
+// UploadMedia
+// @Summary      Micropub 媒体上传
+// @Description  上传图片，成功时返回 201 并在 Location 头中给出图片地址
+// @Tags         Micropub
+// @Security     BearerAuth
+// @Accept       mpfd
+// @Produce      json
+// @Param        file formData file true "图片文件"
+// @Success      201 "上传成功，Location 头为图片地址"
+// @Failure      400 {object} map[string]string "请求参数错误"
+// @Failure      401 {object} map[string]string "令牌无效"
+// @Failure      403 {object} map[string]string "权限不足"
+// @Router       /micropub/media [post]
+func (h *Handler) UploadMedia(c *gin.Context) {
+	user, ok := requireScope(c, model.TokenScopeMedia)
+	if !ok {
+		return
+	}
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		micropubError(c, http.StatusBadRequest, "invalid_request", "缺少 file 字段")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		micropubError(c, http.StatusBadRequest, "invalid_request", "读取文件失败")
+		return
+	}
+	defer file.Close()
+
+	fileURL, err := h.svc.UploadMedia(c.Request.Context(), user, file, fileHeader.Filename)
+	if err != nil {
+		failWithError(c, err)
+		return
+	}
+	c.Header("Location", fileURL)
+	c.JSON(http.StatusCreated, gin.H{"url": fileURL})
+}
@@ -0,0 +1,203 @@
+/*
+ * @Description: 博客迁移 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 15:00:00
+ * @LastEditTime: 2026-10-16 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package migration
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	migration_service "github.com/anzhiyu-c/anheyu-app/pkg/service/migration"
+	"github.com/gin-gonic/gin"
+)
+
+// maxArchiveSize 源码包与 WordPress 导出文件的大小上限
+const maxArchiveSize = 200 << 20
+
+// Handler 封装了博客迁移相关的 HTTP 处理器。
+type Handler struct {
+	svc *migration_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *migration_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Import
+// @Summary      从 Hexo / Hugo 迁移文章
+// @Description  上传 Hexo 或 Hugo 站点源码的 ZIP 包，迁移其中的文章、分类标签与图片，并返回逐篇迁移报告。迁移的文章不会通知订阅者
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file                   formData file   true  "站点源码 ZIP 包"
+// @Param        source                 formData string false "源站类型" Enums(auto, hexo, hugo) default(auto)
+// @Param        download_remote_images formData bool   false "是否下载外链图片并转存到本站" default(true)
+// @Param        skip_existing          formData bool   false "是否跳过永久链接或标题已存在的文章" default(true)
+// @Success      200 {object} response.Response{data=migration_service.Report} "迁移完成"
+// @Failure      400 {object} response.Response "请求参数错误或无法识别源码包"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/migration/import [post]
+func (h *Handler) Import(c *gin.Context) {
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	source := strings.ToLower(c.DefaultPostForm("source", "auto"))
+	switch source {
+	case "auto":
+		source = ""
+	case migration_service.SourceHexo, migration_service.SourceHugo:
+	default:
+		response.Fail(c, http.StatusBadRequest, "不支持的源站类型，仅支持 hexo 和 hugo")
+		return
+	}
+
+	archive, filename, ok := readUpload(c, ".zip", "站点源码包")
+	if !ok {
+		return
+	}
+
+	opts := migration_service.Options{
+		Source:               source,
+		OwnerID:              ownerID,
+		DownloadRemoteImages: c.DefaultPostForm("download_remote_images", "true") == "true",
+		SkipExisting:         c.DefaultPostForm("skip_existing", "true") == "true",
+	}
+	log.Printf("[Handler.MigrationImport] 开始迁移 %s (%d bytes), 源站类型: %s", filename, len(archive), c.DefaultPostForm("source", "auto"))
+
+	report, err := h.svc.Migrate(c.Request.Context(), archive, opts)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "迁移失败: "+err.Error())
+		return
+	}
+	response.Success(c, report, "迁移完成")
+}
+
+// ImportWordPress
+// @Summary      从 WordPress 导入
+// @Description  上传 WordPress 后台「工具 - 导出」生成的 WXR 文件，在后台导入文章、分类标签、评论与附件。接口立即返回任务进度，通过查询接口轮询导入结果；同一时间只执行一个导入任务
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file                 formData file true  "WordPress 导出的 .xml 文件"
+// @Param        download_attachments formData bool false "是否把正文引用的附件与特色图片转存到本站" default(true)
+// @Param        skip_existing        formData bool false "是否跳过永久链接或标题已存在的文章" default(true)
+// @Param        import_comments      formData bool false "是否导入评论" default(true)
+// @Success      200 {object} response.Response{data=migration_service.WordPressImportProgress} "导入任务已创建"
+// @Failure      400 {object} response.Response "请求参数错误或无法解析导出文件"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/migration/wordpress [post]
+func (h *Handler) ImportWordPress(c *gin.Context) {
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	data, filename, ok := readUpload(c, ".xml", "WordPress 导出文件")
+	if !ok {
+		return
+	}
+
+	opts := migration_service.WordPressOptions{
+		OwnerID:             ownerID,
+		DownloadAttachments: c.DefaultPostForm("download_attachments", "true") == "true",
+		SkipExisting:        c.DefaultPostForm("skip_existing", "true") == "true",
+		ImportComments:      c.DefaultPostForm("import_comments", "true") == "true",
+	}
+	progress, err := h.svc.StartWordPressImport(data, opts)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	log.Printf("[Handler.ImportWordPress] %s (%d bytes) 导入任务: %s", filename, len(data), progress.TaskID)
+	response.Success(c, progress, "导入任务已创建")
+}
+
+// GetWordPressImport
+// @Summary      查询 WordPress 导入进度
+// @Description  查询 WordPress 导入任务的进度与逐篇导入结果，任务结束后保留 24 小时
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        taskId path string true "导入任务ID"
+// @Success      200 {object} response.Response{data=migration_service.WordPressImportProgress} "获取成功"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      404 {object} response.Response "任务不存在或已过期"
+// @Router       /admin/migration/wordpress/{taskId} [get]
+func (h *Handler) GetWordPressImport(c *gin.Context) {
+	progress, err := h.svc.WordPressImportProgress(c.Param("taskId"))
+	if err != nil {
+		if errors.Is(err, migration_service.ErrImportTaskNotFound) {
+			response.Fail(c, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, progress, "获取成功")
+}
+
+// currentUserID 解析当前登录用户的数据库ID，失败时已写入响应
+func currentUserID(c *gin.Context) (uint, bool) {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	if !exists {
+		response.Fail(c, http.StatusUnauthorized, "无法获取用户信息，请确认是否已登录")
+		return 0, false
+	}
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户信息格式不正确")
+		return 0, false
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return ownerID, true
+}
+
+// readUpload 读取表单中的 file 字段，校验扩展名与大小，失败时已写入响应
+func readUpload(c *gin.Context, ext, label string) ([]byte, string, bool) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的文件上传请求")
+		return nil, "", false
+	}
+	if strings.ToLower(filepath.Ext(fileHeader.Filename)) != ext {
+		response.Fail(c, http.StatusBadRequest, "仅支持 "+ext+" 格式的"+label)
+		return nil, "", false
+	}
+	if fileHeader.Size > maxArchiveSize {
+		response.Fail(c, http.StatusBadRequest, label+"不能超过 200 MB")
+		return nil, "", false
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "无法处理上传的文件")
+		return nil, "", false
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxArchiveSize))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "读取文件失败")
+		return nil, "", false
+	}
+	return data, fileHeader.Filename, true
+}
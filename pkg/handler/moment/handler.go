@@ -0,0 +1,174 @@
+/*
+ * @Description: 说说（短动态）HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package moment
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	moment_service "github.com/anzhiyu-c/anheyu-app/pkg/service/moment"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了说说相关的 HTTP 处理器。
+type Handler struct {
+	svc *moment_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *moment_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// failWithError 根据错误类型返回合适的 HTTP 状态码
+func failWithError(c *gin.Context, err error, prefix string) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, prefix+": 说说不存在")
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, prefix+": "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// pageParams 解析分页参数，非法值交由服务层修正为默认值
+func pageParams(c *gin.Context) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	return page, pageSize
+}
+
+// ListPublic
+// @Summary      获取说说时间线
+// @Description  分页获取公开的说说，按发布时间倒序，附带评论路径与评论数
+// @Tags         说说
+// @Produce      json
+// @Param        page     query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量（最大50）" default(10)
+// @Success      200 {object} response.Response{data=model.MomentListResponse} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/moments [get]
+func (h *Handler) ListPublic(c *gin.Context) {
+	page, pageSize := pageParams(c)
+	list, err := h.svc.ListPublic(c.Request.Context(), page, pageSize)
+	if err != nil {
+		failWithError(c, err, "获取说说失败")
+		return
+	}
+	response.Success(c, list, "获取列表成功")
+}
+
+// GetPublic
+// @Summary      获取单条说说
+// @Tags         说说
+// @Produce      json
+// @Param        id path string true "说说ID"
+// @Success      200 {object} response.Response{data=model.MomentResponse} "成功响应"
+// @Failure      404 {object} response.Response "说说不存在"
+// @Router       /public/moments/{id} [get]
+func (h *Handler) GetPublic(c *gin.Context) {
+	m, err := h.svc.GetPublic(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		failWithError(c, err, "获取说说失败")
+		return
+	}
+	response.Success(c, m, "获取成功")
+}
+
+// List
+// @Summary      管理员获取说说列表
+// @Description  分页获取全部说说（含未公开的）
+// @Tags         说说
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量（最大50）" default(10)
+// @Success      200 {object} response.Response{data=model.MomentListResponse} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /moments [get]
+func (h *Handler) List(c *gin.Context) {
+	page, pageSize := pageParams(c)
+	list, err := h.svc.List(c.Request.Context(), page, pageSize)
+	if err != nil {
+		failWithError(c, err, "获取说说失败")
+		return
+	}
+	response.Success(c, list, "获取列表成功")
+}
+
+// Create
+// @Summary      发布说说
+// @Tags         说说
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SaveMomentRequest true "说说内容"
+// @Success      200 {object} response.Response{data=model.Moment} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Router       /moments [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req model.SaveMomentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	m, err := h.svc.Create(c.Request.Context(), &req)
+	if err != nil {
+		failWithError(c, err, "发布说说失败")
+		return
+	}
+	response.Success(c, m, "发布成功")
+}
+
+// Update
+// @Summary      编辑说说
+// @Tags         说说
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path string true "说说ID"
+// @Param        body body model.SaveMomentRequest true "说说内容"
+// @Success      200 {object} response.Response{data=model.Moment} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "说说不存在"
+// @Router       /moments/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	var req model.SaveMomentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	m, err := h.svc.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		failWithError(c, err, "更新说说失败")
+		return
+	}
+	response.Success(c, m, "更新成功")
+}
+
+// Delete
+// @Summary      删除说说
+// @Tags         说说
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "说说ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      404 {object} response.Response "说说不存在"
+// @Router       /moments/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		failWithError(c, err, "删除说说失败")
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
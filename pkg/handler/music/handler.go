@@ -6,23 +6,29 @@
 package music_handler
 
 import (
+	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/music"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 )
 
 // MusicHandler 音乐处理器
 type MusicHandler struct {
-	musicSvc music.MusicService
+	musicSvc    music.MusicService
+	playStatSvc *music.PlayStatService
 }
 
 // NewMusicHandler 创建新的音乐处理器
-func NewMusicHandler(musicSvc music.MusicService) *MusicHandler {
+func NewMusicHandler(musicSvc music.MusicService, playStatSvc *music.PlayStatService) *MusicHandler {
 	return &MusicHandler{
-		musicSvc: musicSvc,
+		musicSvc:    musicSvc,
+		playStatSvc: playStatSvc,
 	}
 }
 
@@ -88,3 +94,87 @@ func (h *MusicHandler) GetSongResources(c *gin.Context) {
 type GetSongResourcesRequest struct {
 	NeteaseID string `json:"neteaseId" binding:"required"`
 }
+
+// RecordPlay 记录歌曲播放
+// @Summary      上报歌曲播放
+// @Description  播放器在歌曲播放结束或切歌时上报，同一会话 30 分钟内重复上报同一首歌只计一次
+// @Tags         音乐播放
+// @Accept       json
+// @Produce      json
+// @Param        body  body  model.MusicPlayReport  true  "播放信息"
+// @Success      200  {object}  response.Response{data=object{counted=bool}}  "上报成功"
+// @Failure      400  {object}  response.Response  "请求参数错误"
+// @Failure      500  {object}  response.Response  "服务器错误"
+// @Router       /public/music/plays [post]
+func (h *MusicHandler) RecordPlay(c *gin.Context) {
+	var req model.MusicPlayReport
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数错误: "+err.Error())
+		return
+	}
+
+	counted, err := h.playStatSvc.RecordPlay(c.Request.Context(), util.GetRealClientIP(c), c.GetHeader("User-Agent"), &req)
+	if err != nil {
+		log.Printf("[MUSIC_STATS] 记录播放失败: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "记录播放失败")
+		return
+	}
+
+	response.Success(c, gin.H{"counted": counted}, "上报成功")
+}
+
+// GetTopThisMonth 获取本月播放最多的歌曲
+// @Summary      本月最常播放
+// @Description  获取本月播放次数最多的歌曲排行
+// @Tags         音乐播放
+// @Produce      json
+// @Param        limit  query  int  false  "返回数量(1-50)"  default(10)
+// @Success      200  {object}  response.Response{data=[]model.MusicPlayStat}  "获取成功"
+// @Failure      500  {object}  response.Response  "服务器错误"
+// @Router       /public/music/top [get]
+func (h *MusicHandler) GetTopThisMonth(c *gin.Context) {
+	limit := queryIntInRange(c, "limit", 10, 1, 50)
+
+	top, err := h.playStatSvc.TopThisMonth(c.Request.Context(), limit)
+	if err != nil {
+		log.Printf("[MUSIC_STATS] 获取本月排行失败: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "获取排行失败")
+		return
+	}
+
+	response.Success(c, top, "获取成功")
+}
+
+// GetPlayChart 获取音乐播放统计图表数据（后台）
+// @Summary      音乐播放统计
+// @Description  获取最近若干个月的月度播放汇总和区间内播放最多的歌曲
+// @Tags         音乐播放
+// @Security     BearerAuth
+// @Produce      json
+// @Param        months  query  int  false  "统计月数(1-24)"  default(6)
+// @Param        limit   query  int  false  "热门歌曲数量(1-100)"  default(20)
+// @Success      200  {object}  response.Response{data=model.MusicPlayChart}  "获取成功"
+// @Failure      500  {object}  response.Response  "服务器错误"
+// @Router       /music/stats [get]
+func (h *MusicHandler) GetPlayChart(c *gin.Context) {
+	months := queryIntInRange(c, "months", 6, 1, 24)
+	limit := queryIntInRange(c, "limit", 20, 1, 100)
+
+	chart, err := h.playStatSvc.Chart(c.Request.Context(), months, limit)
+	if err != nil {
+		log.Printf("[MUSIC_STATS] 获取播放统计失败: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "获取播放统计失败")
+		return
+	}
+
+	response.Success(c, chart, "获取成功")
+}
+
+// queryIntInRange 读取整数查询参数，缺省或非法时使用默认值，并限制在 [lo, hi] 内
+func queryIntInRange(c *gin.Context, key string, def, lo, hi int) int {
+	v, err := strconv.Atoi(c.Query(key))
+	if err != nil {
+		return def
+	}
+	return min(max(v, lo), hi)
+}
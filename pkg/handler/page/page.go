@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/cachepolicy"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/page"
@@ -15,6 +16,7 @@ import (
 // Handler 页面处理器
 type Handler struct {
 	pageService page.Service
+	settings    cachepolicy.Getter
 }
 
 // NewHandler 创建页面处理器
@@ -24,6 +26,11 @@ func NewHandler(pageService page.Service) *Handler {
 	}
 }
 
+// SetCachePolicySettings 注入配置读取器，公开页面接口据此输出可缓存的响应头
+func (h *Handler) SetCachePolicySettings(settings cachepolicy.Getter) {
+	h.settings = settings
+}
+
 // Create 创建页面
 // @Summary      创建页面
 // @Description  创建新的页面
@@ -139,6 +146,9 @@ func (h *Handler) GetByPath(c *gin.Context) {
 		return
 	}
 
+	if h.settings != nil {
+		cachepolicy.Apply(c, h.settings, cachepolicy.ClassStatic)
+	}
 	response.Success(c, page, "获取页面成功")
 }
 
@@ -0,0 +1,88 @@
+/*
+ * @Description: 隐私数据管理处理器（评论者数据导出与擦除）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 18:00:00
+ * @LastEditTime: 2026-10-15 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package privacy
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	privacy_service "github.com/anzhiyu-c/anheyu-app/pkg/service/privacy"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 隐私数据管理处理器
+type Handler struct {
+	svc *privacy_service.Service
+}
+
+// NewHandler 创建隐私数据管理处理器
+func NewHandler(svc *privacy_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// EraseCommenterRequest 擦除评论者数据请求
+type EraseCommenterRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ExportCommenterData 导出评论者数据
+// @Summary      导出评论者数据
+// @Description  导出与指定邮箱关联的全部评论（含已删除）与订阅信息
+// @Tags         隐私管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        email  query     string  true  "评论者邮箱"
+// @Success      200    {object}  response.Response{data=privacy_service.CommenterExport}  "导出成功"
+// @Failure      400    {object}  response.Response  "参数错误"
+// @Failure      500    {object}  response.Response  "导出失败"
+// @Router       /admin/privacy/commenter/export [get]
+func (h *Handler) ExportCommenterData(c *gin.Context) {
+	data, err := h.svc.ExportCommenterData(c.Request.Context(), c.Query("email"))
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "导出评论者数据失败: "+err.Error())
+		return
+	}
+	response.Success(c, data, "导出评论者数据成功")
+}
+
+// EraseCommenterData 擦除评论者个人信息
+// @Summary      擦除评论者个人信息
+// @Description  清空指定邮箱在评论中的昵称、邮箱、网站、IP、UA 并删除其订阅，评论内容与楼层结构保留
+// @Tags         隐私管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      EraseCommenterRequest  true  "擦除请求"
+// @Success      200   {object}  response.Response{data=privacy_service.EraseResult}  "擦除成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      500   {object}  response.Response  "擦除失败"
+// @Router       /admin/privacy/commenter/erase [post]
+func (h *Handler) EraseCommenterData(c *gin.Context) {
+	var req EraseCommenterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	result, err := h.svc.EraseCommenterData(c.Request.Context(), req.Email)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "擦除评论者数据失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "擦除评论者数据成功")
+}
@@ -0,0 +1,52 @@
+/*
+ * @Description: 外部平台资料聚合 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 11:00:00
+ * @LastEditTime: 2026-10-16 11:00:00
+ * @LastEditors: 安知鱼
+ */
+package profile
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	profile_service "github.com/anzhiyu-c/anheyu-app/pkg/service/profile"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了外部平台资料聚合相关的 HTTP 处理器。
+type Handler struct {
+	svc *profile_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *profile_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Get
+// @Summary      获取外部平台资料
+// @Description  由服务端拉取并缓存 Bilibili 资料、豆瓣最近看过、Steam 最近游玩，第三方接口不可用时返回上次成功拉取的数据（stale=true）
+// @Tags         外部平台资料
+// @Produce      json
+// @Param        provider path string true "平台" Enums(bilibili, douban, steam)
+// @Success      200 {object} response.Response{data=profile_service.Result} "成功响应"
+// @Failure      404 {object} response.Response "平台不支持或未启用"
+// @Failure      502 {object} response.Response "第三方接口请求失败"
+// @Router       /public/profiles/{provider} [get]
+func (h *Handler) Get(c *gin.Context) {
+	result, err := h.svc.Get(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		switch {
+		case errors.Is(err, profile_service.ErrUnknownProvider), errors.Is(err, profile_service.ErrProviderDisabled):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusBadGateway, err.Error())
+		}
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	response.Success(c, result, "获取成功")
+}
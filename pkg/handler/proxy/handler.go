@@ -8,86 +8,32 @@
 package proxy
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"log"
-	"net"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
 	"github.com/gin-gonic/gin"
 )
 
 const maxProxyResponseBytes = 100 << 20 // 100MB
 
 // ProxyHandler 代理处理器
-type ProxyHandler struct{}
-
-// NewHandler 创建代理处理器
-func NewHandler() *ProxyHandler {
-	return &ProxyHandler{}
-}
-
-var privateIPNets []*net.IPNet
-
-func init() {
-	cidrs := []string{
-		"10.0.0.0/8",
-		"172.16.0.0/12",
-		"192.168.0.0/16",
-		"127.0.0.0/8",
-		"169.254.0.0/16",
-		"::1/128",
-		"fc00::/7",
-		"fe80::/10",
-	}
-	for _, cidr := range cidrs {
-		_, ipNet, err := net.ParseCIDR(cidr)
-		if err != nil {
-			panic(fmt.Sprintf("invalid CIDR in privateIPNets: %s", cidr))
-		}
-		privateIPNets = append(privateIPNets, ipNet)
-	}
+type ProxyHandler struct {
+	guard *ssrf.Guard
 }
 
-func isPrivateOrReservedIP(ip net.IP) bool {
-	for _, ipNet := range privateIPNets {
-		if ipNet.Contains(ip) {
-			return true
-		}
-	}
-	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
-}
-
-// safeDialContext resolves DNS and connects to the target, rejecting private/reserved IPs
-// at the connection level to prevent DNS rebinding (TOCTOU) attacks.
-func safeDialContext(ctx context.Context, network, addr string) (net.Conn, error) {
-	host, port, err := net.SplitHostPort(addr)
-	if err != nil {
-		return nil, err
-	}
-
-	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
-	if err != nil {
-		return nil, fmt.Errorf("DNS解析失败: %w", err)
-	}
-	if len(ips) == 0 {
-		return nil, fmt.Errorf("DNS解析无结果: %s", host)
-	}
-
-	for _, ipAddr := range ips {
-		if isPrivateOrReservedIP(ipAddr.IP) {
-			return nil, fmt.Errorf("目标地址不允许访问")
-		}
+// NewHandler 创建代理处理器
+func NewHandler(guard *ssrf.Guard) *ProxyHandler {
+	if guard == nil {
+		guard = ssrf.NewGuard(nil)
 	}
-
-	// 直接连接到已解析的 IP，防止 DNS rebinding
-	dialer := &net.Dialer{Timeout: 10 * time.Second}
-	return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
+	return &ProxyHandler{guard: guard}
 }
 
 func sanitizeFilenameForHeader(filename string) string {
@@ -118,28 +64,19 @@ func (h *ProxyHandler) HandleDownload(c *gin.Context) {
 		return
 	}
 
-	parsedURL, err := url.Parse(targetURL)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的URL格式"})
-		return
-	}
-
-	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "只支持http和https协议"})
+	if err := h.guard.CheckURL(targetURL); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	transport := &http.Transport{
-		DialContext: safeDialContext,
-	}
 	client := &http.Client{
 		Timeout:   60 * time.Second,
-		Transport: transport,
+		Transport: h.guard.Transport(),
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if len(via) >= 10 {
 				return fmt.Errorf("重定向次数过多")
 			}
-			return nil
+			return h.guard.CheckURL(req.URL.String())
 		},
 	}
 
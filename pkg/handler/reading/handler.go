@@ -0,0 +1,255 @@
+/*
+ * @Description: 阅读同步处理器：登录用户的文章书签与阅读进度
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package reading
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	reading_service "github.com/anzhiyu-c/anheyu-app/pkg/service/reading"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了书签与阅读进度的 HTTP 处理器。
+type Handler struct {
+	svc *reading_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *reading_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// currentUserID 从 JWT 中解析当前用户的数据库ID
+func currentUserID(c *gin.Context) (uint, bool) {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !exists || !ok {
+		response.Fail(c, http.StatusUnauthorized, "未登录")
+		return 0, false
+	}
+	userID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "用户ID解析失败")
+		return 0, false
+	}
+	return userID, true
+}
+
+// failWithError 根据错误类型返回合适的 HTTP 状态码
+func failWithError(c *gin.Context, err error, prefix string) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, prefix+": 文章不存在或未公开")
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, prefix+": "+err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// pagination 解析分页参数
+func pagination(c *gin.Context) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	return page, pageSize
+}
+
+// ListBookmarks
+// @Summary      获取我的书签
+// @Description  按收藏时间倒序返回书签及文章摘要，已下架或删除的文章不会出现在列表中
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(10)
+// @Success      200 {object} response.Response{data=model.BookmarkListResponse} "成功响应"
+// @Router       /user/bookmarks [get]
+func (h *Handler) ListBookmarks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	page, pageSize := pagination(c)
+	result, err := h.svc.ListBookmarks(c.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		failWithError(c, err, "获取书签失败")
+		return
+	}
+	response.Success(c, result, "获取列表成功")
+}
+
+// AddBookmark
+// @Summary      收藏文章
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.AddBookmarkRequest true "文章ID"
+// @Success      200 {object} response.Response{data=model.BookmarkStatus} "成功响应"
+// @Failure      404 {object} response.Response "文章不存在或未公开"
+// @Router       /user/bookmarks [post]
+func (h *Handler) AddBookmark(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req model.AddBookmarkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	if err := h.svc.AddBookmark(c.Request.Context(), userID, req.ArticleID); err != nil {
+		failWithError(c, err, "收藏失败")
+		return
+	}
+	response.Success(c, model.BookmarkStatus{ArticleID: req.ArticleID, Bookmarked: true}, "收藏成功")
+}
+
+// GetBookmarkStatus
+// @Summary      查询文章是否已收藏
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Produce      json
+// @Param        articleId path string true "文章ID"
+// @Success      200 {object} response.Response{data=model.BookmarkStatus} "成功响应"
+// @Router       /user/bookmarks/{articleId} [get]
+func (h *Handler) GetBookmarkStatus(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	status, err := h.svc.GetBookmarkStatus(c.Request.Context(), userID, c.Param("articleId"))
+	if err != nil {
+		failWithError(c, err, "查询书签失败")
+		return
+	}
+	response.Success(c, status, "获取成功")
+}
+
+// RemoveBookmark
+// @Summary      取消收藏
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Produce      json
+// @Param        articleId path string true "文章ID"
+// @Success      200 {object} response.Response{data=model.BookmarkStatus} "成功响应"
+// @Router       /user/bookmarks/{articleId} [delete]
+func (h *Handler) RemoveBookmark(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	articleID := c.Param("articleId")
+	if err := h.svc.RemoveBookmark(c.Request.Context(), userID, articleID); err != nil {
+		failWithError(c, err, "取消收藏失败")
+		return
+	}
+	response.Success(c, model.BookmarkStatus{ArticleID: articleID, Bookmarked: false}, "已取消收藏")
+}
+
+// ListProgress
+// @Summary      获取我的阅读进度
+// @Description  按最近阅读时间倒序返回阅读进度及文章摘要，用于“继续阅读”
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page     query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(10)
+// @Success      200 {object} response.Response{data=model.ReadingProgressListResponse} "成功响应"
+// @Router       /user/reading-progress [get]
+func (h *Handler) ListProgress(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	page, pageSize := pagination(c)
+	result, err := h.svc.ListProgress(c.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		failWithError(c, err, "获取阅读进度失败")
+		return
+	}
+	response.Success(c, result, "获取列表成功")
+}
+
+// GetProgress
+// @Summary      获取文章的阅读进度
+// @Description  没有阅读记录时 data 为 null
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Produce      json
+// @Param        articleId path string true "文章ID"
+// @Success      200 {object} response.Response{data=model.ReadingProgressResponse} "成功响应"
+// @Router       /user/reading-progress/{articleId} [get]
+func (h *Handler) GetProgress(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	progress, err := h.svc.GetProgress(c.Request.Context(), userID, c.Param("articleId"))
+	if err != nil {
+		failWithError(c, err, "获取阅读进度失败")
+		return
+	}
+	response.Success(c, progress, "获取成功")
+}
+
+// SaveProgress
+// @Summary      保存文章的阅读进度
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        articleId path string true "文章ID"
+// @Param        body      body model.SaveReadingProgressRequest true "阅读进度"
+// @Success      200 {object} response.Response{data=model.ReadingProgressResponse} "成功响应"
+// @Failure      404 {object} response.Response "文章不存在或未公开"
+// @Router       /user/reading-progress/{articleId} [put]
+func (h *Handler) SaveProgress(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req model.SaveReadingProgressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	progress, err := h.svc.SaveProgress(c.Request.Context(), userID, c.Param("articleId"), &req)
+	if err != nil {
+		failWithError(c, err, "保存阅读进度失败")
+		return
+	}
+	response.Success(c, progress, "保存成功")
+}
+
+// DeleteProgress
+// @Summary      删除文章的阅读进度
+// @Tags         阅读同步
+// @Security     BearerAuth
+// @Produce      json
+// @Param        articleId path string true "文章ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Router       /user/reading-progress/{articleId} [delete]
+func (h *Handler) DeleteProgress(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	if err := h.svc.DeleteProgress(c.Request.Context(), userID, c.Param("articleId")); err != nil {
+		failWithError(c, err, "删除阅读进度失败")
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
@@ -8,6 +8,7 @@
 package rss
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -49,8 +50,8 @@ func (h *Handler) GetRSSFeed(c *gin.Context) {
 	baseURL := h.getSiteURL(c)
 
 	// 生成 RSS feed
+	// 条目数由 rss.item_count 配置决定
 	opts := &rss.RSSOptions{
-		ItemCount: 20,
 		BaseURL:   baseURL,
 		BuildTime: time.Now(),
 	}
@@ -79,6 +80,78 @@ func (h *Handler) GetRSSFeed(c *gin.Context) {
 	c.String(http.StatusOK, xmlContent)
 }
 
+// GetCommentFeed 获取评论 Atom feed
+// @Summary      获取评论订阅源
+// @Description  获取全站或单篇文章最新已发布评论的 Atom 订阅源，不包含邮箱、IP 等隐私信息
+// @Tags         辅助工具
+// @Produce      xml
+// @Param        slug  path      string  false  "文章 abbrlink 或公共 ID（仅文章评论订阅）"
+// @Success      200  {string}  string  "Atom XML内容"
+// @Failure      404  {string}  string  "文章不存在或评论功能已关闭"
+// @Failure      500  {string}  string  "生成评论feed失败"
+// @Router       /comments.atom [get]
+// @Router       /posts/{slug}/comments.atom [get]
+func (h *Handler) GetCommentFeed(c *gin.Context) {
+	// 条目数由 rss.comment_item_count 配置决定
+	opts := &rss.CommentFeedOptions{
+		BaseURL: h.getSiteURL(c),
+		Slug:    c.Param("slug"),
+	}
+
+	feed, err := h.rssService.GenerateCommentFeed(c.Request.Context(), opts)
+	if err != nil {
+		c.Header("Content-Type", "text/plain; charset=utf-8")
+		if errors.Is(err, constant.ErrNotFound) {
+			c.String(http.StatusNotFound, "评论订阅源不存在")
+			return
+		}
+		log.Printf("[RSS Handler] 生成评论 feed 失败: %v", err)
+		c.String(http.StatusInternalServerError, "生成评论feed失败")
+		return
+	}
+
+	c.Header("Content-Type", "application/atom+xml; charset=utf-8")
+	c.Header("Cache-Control", "public, max-age=600") // 评论更新较频繁，缓存10分钟
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	c.String(http.StatusOK, h.rssService.GenerateAtomXML(feed))
+}
+
+// GetChangelogFeed 获取更新日志 RSS feed
+// @Summary      获取更新日志订阅源
+// @Description  获取公开更新日志的 RSS 订阅源，与更新页面内容一致
+// @Tags         辅助工具
+// @Produce      xml
+// @Success      200  {string}  string  "RSS XML内容"
+// @Failure      404  {string}  string  "未启用更新日志"
+// @Failure      500  {string}  string  "生成更新日志feed失败"
+// @Router       /update/rss.xml [get]
+func (h *Handler) GetChangelogFeed(c *gin.Context) {
+	// 条目数由 rss.item_count 配置决定
+	opts := &rss.RSSOptions{
+		BaseURL:   h.getSiteURL(c),
+		BuildTime: time.Now(),
+	}
+
+	feed, err := h.rssService.GenerateChangelogFeed(c.Request.Context(), opts)
+	if err != nil {
+		c.Header("Content-Type", "text/plain; charset=utf-8")
+		if errors.Is(err, constant.ErrNotFound) {
+			c.String(http.StatusNotFound, "更新日志订阅源不存在")
+			return
+		}
+		log.Printf("[RSS Handler] 生成更新日志 feed 失败: %v", err)
+		c.String(http.StatusInternalServerError, "生成更新日志feed失败")
+		return
+	}
+
+	c.Header("Content-Type", "application/rss+xml; charset=utf-8")
+	c.Header("Cache-Control", "public, max-age=3600") // 缓存1小时
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	c.String(http.StatusOK, h.rssService.GenerateXML(feed))
+}
+
 // getSiteURL 获取站点 URL
 func (h *Handler) getSiteURL(c *gin.Context) string {
 	// 优先从配置中获取站点 URL
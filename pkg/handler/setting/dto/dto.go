@@ -10,3 +10,9 @@ package dto
 type TestEmailRequest struct {
 	ToEmail string `json:"to_email" binding:"required,email"`
 }
+
+// ReadOnlyModeRequest 定义了切换站点只读模式的请求体
+type ReadOnlyModeRequest struct {
+	Enable  bool    `json:"enable"`
+	Message *string `json:"message"` // 为空时保持原提示信息不变
+}
@@ -8,15 +8,24 @@
 package setting_handler
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/cachepolicy"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/handler/setting/dto"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/config"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/music"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 
@@ -29,6 +38,11 @@ type SettingHandler struct {
 	emailSvc        utility.EmailService
 	cdnSvc          cdn.CDNService
 	configBackupSvc config.BackupService
+
+	// 站点配置响应体缓存，按配置版本号失效
+	siteConfigMu          sync.RWMutex
+	siteConfigBodyCache   []byte
+	siteConfigBodyVersion int64
 }
 
 // NewSettingHandler 是 SettingHandler 的构造函数
@@ -82,8 +96,65 @@ func (h *SettingHandler) TestEmail(c *gin.Context) {
 // @Success      200  {object}  response.Response  "获取成功"
 // @Router       /public/site-config [get]
 func (h *SettingHandler) GetSiteConfig(c *gin.Context) {
-	siteConfig := h.settingSvc.GetSiteConfig()
-	response.Success(c, siteConfig, "获取站点配置成功")
+	version := h.settingSvc.GetConfigVersion()
+	etag := fmt.Sprintf(`"site-config-%d"`, version)
+
+	// 允许浏览器保存响应，但每次都需要带 If-None-Match 重新校验
+	c.Header("Cache-Control", "no-cache, private")
+	c.Header("Pragma", "")
+	c.Header("ETag", etag)
+	if ifNoneMatch(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	body, err := h.siteConfigBody(version)
+	if err != nil {
+		log.Printf("序列化站点配置失败: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "获取站点配置失败")
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// siteConfigBody 返回指定版本的站点配置响应体。同一版本只序列化一次，配置更新后版本号变化即自动失效。
+func (h *SettingHandler) siteConfigBody(version int64) ([]byte, error) {
+	h.siteConfigMu.RLock()
+	if h.siteConfigBodyVersion == version && h.siteConfigBodyCache != nil {
+		body := h.siteConfigBodyCache
+		h.siteConfigMu.RUnlock()
+		return body, nil
+	}
+	h.siteConfigMu.RUnlock()
+
+	body, err := json.Marshal(response.Response{
+		Code:    http.StatusOK,
+		Message: "获取站点配置成功",
+		Data:    h.settingSvc.GetSiteConfig(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.siteConfigMu.Lock()
+	h.siteConfigBodyCache = body
+	h.siteConfigBodyVersion = version
+	h.siteConfigMu.Unlock()
+	return body, nil
+}
+
+// ifNoneMatch 判断 If-None-Match 请求头是否命中当前 ETag（兼容弱校验前缀 W/ 与多个值）
+func ifNoneMatch(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
 }
 
 // GetConfigVersion 返回当前配置版本号，供前端轻量级缓存校验
@@ -160,7 +231,7 @@ func (h *SettingHandler) GetSettingsByKeys(c *gin.Context) {
 
 // UpdateSettings 处理批量更新配置项的请求
 // @Summary      批量更新配置
-// @Description  批量更新站点配置项（需要管理员权限）
+// @Description  批量更新站点配置项（需要 settings:write 权限，签名密钥、管理接口白名单、OAuth/SMTP 等敏感配置仅管理员可修改）
 // @Tags         站点设置
 // @Security     BearerAuth
 // @Accept       json
@@ -168,6 +239,7 @@ func (h *SettingHandler) GetSettingsByKeys(c *gin.Context) {
 // @Param        body  body      map[string]string  true  "配置项键值对"
 // @Success      200   {object}  response.Response  "更新成功"
 // @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      403   {object}  response.Response  "无权修改敏感配置"
 // @Failure      500   {object}  response.Response  "更新失败"
 // @Router       /settings/update [post]
 func (h *SettingHandler) UpdateSettings(c *gin.Context) {
@@ -182,6 +254,30 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 		return
 	}
 
+	if !c.GetBool(auth.IsAdminKey) {
+		for key := range settingsToUpdate {
+			if h.settingSvc.IsAdminOnlySetting(key) {
+				response.Fail(c, http.StatusForbidden, "权限不足：修改配置项 "+key+" 需要管理员权限")
+				return
+			}
+		}
+	}
+
+	if err := music.ValidateSettings(settingsToUpdate); err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := cachepolicy.ValidateSettings(settingsToUpdate); err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := comment.ValidateRegionGranularity(settingsToUpdate); err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	// 在更新配置前，自动创建备份（如果备份服务可用）
 	if h.configBackupSvc != nil {
 		_, err := h.configBackupSvc.CreateBackup(c.Request.Context(), "配置更新前自动备份", true)
@@ -229,6 +325,46 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 	response.Success(c, nil, "更新配置成功")
 }
 
+// SetReadOnlyMode
+// @Summary      切换站点只读模式
+// @Description  开启后所有公开浏览接口照常可用，但评论、上传及后台修改等写操作将返回 503，适用于数据库迁移或恢复期间。本接口在只读模式下依然可用。
+// @Tags         设置管理
+// @Accept       json
+// @Produce      json
+// @Param        body body dto.ReadOnlyModeRequest true "只读模式开关"
+// @Success      200 {object} response.Response "切换成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "切换失败"
+// @Security     ApiKeyAuth
+// @Router       /settings/read-only [put]
+func (h *SettingHandler) SetReadOnlyMode(c *gin.Context) {
+	var req dto.ReadOnlyModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数格式错误")
+		return
+	}
+
+	settingsToUpdate := map[string]string{
+		constant.KeyReadOnlyModeEnable.String(): strconv.FormatBool(req.Enable),
+	}
+	if req.Message != nil {
+		settingsToUpdate[constant.KeyReadOnlyModeMessage.String()] = strings.TrimSpace(*req.Message)
+	}
+	if err := h.settingSvc.UpdateSettings(c.Request.Context(), settingsToUpdate); err != nil {
+		log.Printf("切换只读模式时发生错误: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "切换只读模式失败，请查看服务器日志")
+		return
+	}
+
+	if req.Enable {
+		log.Printf("⚠️ 站点已进入只读模式")
+		response.Success(c, nil, "已开启只读模式")
+		return
+	}
+	log.Printf("✅ 站点已退出只读模式")
+	response.Success(c, nil, "已关闭只读模式")
+}
+
 // checkIfNeedsPurgeCDN 检查更新的配置项中是否包含需要清除CDN缓存的配置
 func (h *SettingHandler) checkIfNeedsPurgeCDN(settingsToUpdate map[string]string) bool {
 	// 只有直接影响HTML渲染（SSR）的配置才需要清除CDN缓存
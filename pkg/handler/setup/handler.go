@@ -0,0 +1,208 @@
+/*
+ * @Description: 初始化向导 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 18:00:00
+ * @LastEditTime: 2026-10-15 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package setup
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/password"
+	setup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/setup"
+	"github.com/gin-gonic/gin"
+)
+
+// TokenHeader 携带初始化令牌的请求头
+const TokenHeader = "X-Setup-Token"
+
+// Handler 封装了初始化向导相关的 HTTP 处理器。
+type Handler struct {
+	svc *setup_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *setup_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// RequireToken 校验初始化令牌；初始化完成后所有向导接口返回 403
+func (h *Handler) RequireToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := h.svc.Authorize(c.GetHeader(TokenHeader)); err != nil {
+			h.fail(c, err)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// GetState
+// @Summary      获取初始化状态
+// @Description  返回站点是否已完成初始化；未完成时额外返回各步骤的当前配置，供前端展示初始化向导
+// @Tags         初始化向导
+// @Produce      json
+// @Success      200 {object} response.Response{data=setup_service.State} "成功响应"
+// @Router       /public/setup/state [get]
+func (h *Handler) GetState(c *gin.Context) {
+	state, err := h.svc.State(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, state, "获取成功")
+}
+
+// CreateAdmin
+// @Summary      创建管理员账户
+// @Description  初始化向导第一步：创建第一个管理员账户（直接激活），仅在系统中没有任何用户时可用
+// @Tags         初始化向导
+// @Accept       json
+// @Produce      json
+// @Param        X-Setup-Token header string true "初始化令牌（见服务启动日志）"
+// @Param        body body setup_service.AdminRequest true "管理员账户信息"
+// @Success      200 {object} response.Response "创建成功"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      401 {object} response.Response "初始化令牌无效"
+// @Failure      403 {object} response.Response "已完成初始化"
+// @Failure      409 {object} response.Response "管理员账户已存在"
+// @Router       /setup/admin [post]
+func (h *Handler) CreateAdmin(c *gin.Context) {
+	var req setup_service.AdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：请填写有效的邮箱和至少8位的密码")
+		return
+	}
+	if err := h.svc.CreateAdmin(c.Request.Context(), &req); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "管理员账户创建成功")
+}
+
+// ConfigureSite
+// @Summary      配置站点信息
+// @Description  初始化向导：设置站点地址与站点名称
+// @Tags         初始化向导
+// @Accept       json
+// @Produce      json
+// @Param        X-Setup-Token header string true "初始化令牌（见服务启动日志）"
+// @Param        body body setup_service.SiteRequest true "站点信息"
+// @Success      200 {object} response.Response "保存成功"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      401 {object} response.Response "初始化令牌无效"
+// @Failure      403 {object} response.Response "已完成初始化"
+// @Router       /setup/site [post]
+func (h *Handler) ConfigureSite(c *gin.Context) {
+	var req setup_service.SiteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：站点地址不能为空")
+		return
+	}
+	if err := h.svc.ConfigureSite(c.Request.Context(), &req); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "站点信息保存成功")
+}
+
+// ConfigureStorage
+// @Summary      配置存储目录
+// @Description  初始化向导：设置本机存储根目录（绝对路径），会自动创建目录并检查是否可写
+// @Tags         初始化向导
+// @Accept       json
+// @Produce      json
+// @Param        X-Setup-Token header string true "初始化令牌（见服务启动日志）"
+// @Param        body body setup_service.StorageRequest true "存储目录"
+// @Success      200 {object} response.Response "保存成功"
+// @Failure      400 {object} response.Response "目录无效或不可写"
+// @Failure      401 {object} response.Response "初始化令牌无效"
+// @Failure      403 {object} response.Response "已完成初始化"
+// @Router       /setup/storage [post]
+func (h *Handler) ConfigureStorage(c *gin.Context) {
+	var req setup_service.StorageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：存储目录不能为空")
+		return
+	}
+	root, err := h.svc.ConfigureStorage(c.Request.Context(), &req)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, gin.H{"storage_root": root}, "存储目录保存成功")
+}
+
+// TestSMTP
+// @Summary      配置并测试邮件服务
+// @Description  初始化向导：保存 SMTP 配置并向指定邮箱发送一封测试邮件
+// @Tags         初始化向导
+// @Accept       json
+// @Produce      json
+// @Param        X-Setup-Token header string true "初始化令牌（见服务启动日志）"
+// @Param        body body setup_service.SMTPRequest true "SMTP 配置"
+// @Success      200 {object} response.Response "测试邮件发送成功"
+// @Failure      400 {object} response.Response "参数错误"
+// @Failure      401 {object} response.Response "初始化令牌无效"
+// @Failure      403 {object} response.Response "已完成初始化"
+// @Failure      502 {object} response.Response "测试邮件发送失败"
+// @Router       /setup/smtp/test [post]
+func (h *Handler) TestSMTP(c *gin.Context) {
+	var req setup_service.SMTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：请填写完整的 SMTP 配置和测试收件邮箱")
+		return
+	}
+	if err := h.svc.TestSMTP(c.Request.Context(), &req); err != nil {
+		if errors.Is(err, setup_service.ErrSetupCompleted) {
+			h.fail(c, err)
+			return
+		}
+		response.Fail(c, http.StatusBadGateway, err.Error())
+		return
+	}
+	response.Success(c, nil, "测试邮件发送成功")
+}
+
+// Complete
+// @Summary      完成初始化
+// @Description  初始化向导最后一步：标记站点已初始化，之后所有向导接口关闭
+// @Tags         初始化向导
+// @Produce      json
+// @Param        X-Setup-Token header string true "初始化令牌（见服务启动日志）"
+// @Success      200 {object} response.Response "初始化完成"
+// @Failure      400 {object} response.Response "尚未创建管理员账户"
+// @Failure      401 {object} response.Response "初始化令牌无效"
+// @Failure      403 {object} response.Response "已完成初始化"
+// @Router       /setup/complete [post]
+func (h *Handler) Complete(c *gin.Context) {
+	if err := h.svc.Complete(c.Request.Context()); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "初始化完成")
+}
+
+// fail 将向导服务的错误转换为对应的 HTTP 状态码
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, setup_service.ErrSetupCompleted):
+		response.Fail(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, setup_service.ErrInvalidToken):
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, auth.ErrAdminAlreadyExists):
+		response.Fail(c, http.StatusConflict, err.Error())
+	case errors.Is(err, setup_service.ErrAdminRequired), errors.Is(err, constant.ErrBadRequest),
+		errors.Is(err, password.ErrWeakPassword):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
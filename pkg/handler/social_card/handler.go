@@ -0,0 +1,56 @@
+/*
+ * @Description: 文章分享卡片预览 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 18:00:00
+ * @LastEditTime: 2026-10-17 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package social_card
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	social_card_service "github.com/anzhiyu-c/anheyu-app/pkg/service/social_card"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了文章分享卡片预览相关的 HTTP 处理器。
+type Handler struct {
+	svc *social_card_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *social_card_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Preview
+// @Summary      预览文章分享卡片
+// @Description  抓取文章的线上页面，解析社交平台读取的 og:* 与 twitter:* 标签，并检查分享图片能否访问及尺寸是否达标，返回预期卡片、实际卡片与警告列表
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "文章公共ID"
+// @Success      200 {object} response.Response{data=social_card_service.Preview} "预览结果"
+// @Failure      400 {object} response.Response "文章ID无效"
+// @Failure      404 {object} response.Response "文章不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /articles/{id}/social-preview [get]
+func (h *Handler) Preview(c *gin.Context) {
+	preview, err := h.svc.Preview(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	response.Success(c, preview, "获取成功")
+}
@@ -8,14 +8,18 @@
 package statistics
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/streamexport"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/site_stats"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
 
 	"github.com/gin-gonic/gin"
@@ -23,13 +27,15 @@ import (
 
 // StatisticsHandler 统计API处理器
 type StatisticsHandler struct {
-	statService statistics.VisitorStatService
+	statService  statistics.VisitorStatService
+	siteStatsSvc *site_stats.Service
 }
 
 // NewStatisticsHandler 创建统计处理器实例
-func NewStatisticsHandler(statService statistics.VisitorStatService) *StatisticsHandler {
+func NewStatisticsHandler(statService statistics.VisitorStatService, siteStatsSvc *site_stats.Service) *StatisticsHandler {
 	return &StatisticsHandler{
-		statService: statService,
+		statService:  statService,
+		siteStatsSvc: siteStatsSvc,
 	}
 }
 
@@ -51,6 +57,27 @@ func (h *StatisticsHandler) GetBasicStatistics(c *gin.Context) {
 	response.Success(c, stats, "获取统计数据成功")
 }
 
+// GetSiteStatistics 获取站点统计快照（前台接口）
+// @Summary      获取站点统计快照
+// @Description  获取侧边栏展示的文章数、字数与评论数快照及其更新时间；侧边栏配置为 -1 的项不返回
+// @Tags         访问统计
+// @Produce      json
+// @Success      200  {object}  response.Response{data=site_stats.Counters}  "获取成功"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /public/statistics/site [get]
+func (h *StatisticsHandler) GetSiteStatistics(c *gin.Context) {
+	counters, err := h.siteStatsSvc.GetCounters(c.Request.Context())
+	if err != nil {
+		log.Printf("[statistics] GetSiteStatistics service error: %v", err)
+		response.Fail(c, http.StatusInternalServerError, "获取站点统计失败")
+		return
+	}
+
+	// 快照在后台刷新，允许浏览器与 CDN 短时间缓存
+	c.Header("Cache-Control", "public, max-age=60")
+	response.Success(c, counters, "获取站点统计成功")
+}
+
 // RecordVisit 记录访问（前台接口）
 // @Summary      记录访问
 // @Description  记录用户访问行为（异步处理，快速响应）
@@ -278,25 +305,9 @@ func (h *StatisticsHandler) GetVisitorLogs(c *gin.Context) {
 		pageSize = 200
 	}
 
-	// 默认查询最近7天
-	endDate := time.Now()
-	startDate := endDate.AddDate(0, 0, -7)
-
-	if startDateStr != "" {
-		if t, err := time.Parse("2006-01-02", startDateStr); err == nil {
-			startDate = t
-		} else {
-			response.Fail(c, http.StatusBadRequest, "开始日期格式错误")
-			return
-		}
-	}
-	if endDateStr != "" {
-		if t, err := time.Parse("2006-01-02", endDateStr); err == nil {
-			endDate = t
-		} else {
-			response.Fail(c, http.StatusBadRequest, "结束日期格式错误")
-			return
-		}
+	startDate, endDate, ok := parseVisitorLogDateRange(c, startDateStr, endDateStr)
+	if !ok {
+		return
 	}
 
 	logs, err := h.statService.GetVisitorLogs(c.Request.Context(), startDate, endDate)
@@ -354,6 +365,124 @@ func (h *StatisticsHandler) GetVisitorLogs(c *gin.Context) {
 	}, "获取访客日志成功")
 }
 
+// parseVisitorLogDateRange 解析访问日志查询的日期范围，默认最近7天。格式错误时已写出错误响应并返回 false
+func parseVisitorLogDateRange(c *gin.Context, startDateStr, endDateStr string) (time.Time, time.Time, bool) {
+	endDate := time.Now()
+	startDate := endDate.AddDate(0, 0, -7)
+
+	if startDateStr != "" {
+		if t, err := time.Parse("2006-01-02", startDateStr); err == nil {
+			startDate = t
+		} else {
+			response.Fail(c, http.StatusBadRequest, "开始日期格式错误")
+			return startDate, endDate, false
+		}
+	}
+	if endDateStr != "" {
+		if t, err := time.Parse("2006-01-02", endDateStr); err == nil {
+			endDate = t
+		} else {
+			response.Fail(c, http.StatusBadRequest, "结束日期格式错误")
+			return startDate, endDate, false
+		}
+	}
+	return startDate, endDate, true
+}
+
+// visitorLogCSVHeader 访问日志 CSV 导出的表头
+var visitorLogCSVHeader = []string{
+	"id", "created_at", "visitor_id", "session_id", "ip_address", "url_path", "referer",
+	"country", "region", "city", "browser", "os", "device", "duration", "is_bounce", "user_agent",
+}
+
+// visitorLogExportItem 导出的单条访问日志
+type visitorLogExportItem struct {
+	ID        uint   `json:"id"`
+	CreatedAt string `json:"created_at"`
+	VisitorID string `json:"visitor_id"`
+	SessionID string `json:"session_id,omitempty"`
+	IPAddress string `json:"ip_address"`
+	URLPath   string `json:"url_path"`
+	Referer   string `json:"referer,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Region    string `json:"region,omitempty"`
+	City      string `json:"city,omitempty"`
+	Browser   string `json:"browser,omitempty"`
+	OS        string `json:"os,omitempty"`
+	Device    string `json:"device,omitempty"`
+	Duration  int    `json:"duration"`
+	IsBounce  bool   `json:"is_bounce"`
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+func newVisitorLogExportItem(lg *ent.VisitorLog) visitorLogExportItem {
+	deref := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+	return visitorLogExportItem{
+		ID:        lg.ID,
+		CreatedAt: lg.CreatedAt.Format(time.RFC3339),
+		VisitorID: lg.VisitorID,
+		SessionID: deref(lg.SessionID),
+		IPAddress: lg.IPAddress,
+		URLPath:   lg.URLPath,
+		Referer:   deref(lg.Referer),
+		Country:   deref(lg.Country),
+		Region:    deref(lg.Region),
+		City:      deref(lg.City),
+		Browser:   deref(lg.Browser),
+		OS:        deref(lg.Os),
+		Device:    deref(lg.Device),
+		Duration:  lg.Duration,
+		IsBounce:  lg.IsBounce,
+		UserAgent: deref(lg.UserAgent),
+	}
+}
+
+// CSVRecord 返回访问日志的 CSV 行
+func (i visitorLogExportItem) CSVRecord() []string {
+	return []string{
+		strconv.FormatUint(uint64(i.ID), 10), i.CreatedAt, i.VisitorID, i.SessionID, i.IPAddress,
+		i.URLPath, i.Referer, i.Country, i.Region, i.City, i.Browser, i.OS, i.Device,
+		strconv.Itoa(i.Duration), strconv.FormatBool(i.IsBounce), i.UserAgent,
+	}
+}
+
+// ExportVisitorLogs 流式导出访客访问日志（后台接口）
+// @Summary      导出访客访问日志
+// @Description  以 NDJSON 或 CSV 格式分块导出指定时间范围内的全部访问日志（默认最近7天）
+// @Tags         统计管理
+// @Security     BearerAuth
+// @Produce      application/x-ndjson,text/csv
+// @Param        format      query  string  false  "导出格式：ndjson（默认）或 csv"
+// @Param        start_date  query  string  false  "开始日期 (YYYY-MM-DD)"
+// @Param        end_date    query  string  false  "结束日期 (YYYY-MM-DD)"
+// @Success      200  {file}    file               "导出文件"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "导出失败"
+// @Router       /statistics/visitor-logs/export [get]
+func (h *StatisticsHandler) ExportVisitorLogs(c *gin.Context) {
+	format, err := streamexport.ParseFormat(c.Query("format"))
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	startDate, endDate, ok := parseVisitorLogDateRange(c, c.Query("start_date"), c.Query("end_date"))
+	if !ok {
+		return
+	}
+
+	filename := fmt.Sprintf("visitor_logs_%s_%s", startDate.Format("20060102"), endDate.Format("20060102"))
+	streamexport.Serve(c, format, filename, visitorLogCSVHeader, func(w *streamexport.Writer) error {
+		return h.statService.StreamVisitorLogs(c.Request.Context(), startDate, endDate, func(lg *ent.VisitorLog) error {
+			return w.Write(newVisitorLogExportItem(lg))
+		})
+	})
+}
+
 // StatisticsSummary 统计概览数据结构
 type StatisticsSummary struct {
 	BasicStats *model.VisitorStatistics `json:"basic_stats"`
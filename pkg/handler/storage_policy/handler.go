@@ -11,6 +11,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -55,7 +57,12 @@ type PolicyListResponse struct {
 
 // StoragePolicyHandler 负责处理所有与存储策略相关的HTTP请求
 type StoragePolicyHandler struct {
-	svc volume.IStoragePolicyService
+	svc      volume.IStoragePolicyService
+	purgeSvc *volume.PolicyPurgeService
+	mountSvc *volume.MountService
+	staleSvc *volume.StaleUploadService
+
+	integritySvc *volume.IntegrityService
 }
 
 // NewStoragePolicyHandler 是 StoragePolicyHandler 的构造函数
@@ -63,6 +70,21 @@ func NewStoragePolicyHandler(svc volume.IStoragePolicyService) *StoragePolicyHan
 	return &StoragePolicyHandler{svc: svc}
 }
 
+// SetPurgeService 注入远程对象清理服务（可选），未注入时删除策略只支持分离模式。
+func (h *StoragePolicyHandler) SetPurgeService(svc *volume.PolicyPurgeService) {
+	h.purgeSvc = svc
+}
+
+// SetStaleUploadService 注入远程未完成上传清理服务（可选）
+func (h *StoragePolicyHandler) SetStaleUploadService(svc *volume.StaleUploadService) {
+	h.staleSvc = svc
+}
+
+// SetIntegrityService 注入数据一致性检查服务（可选）
+func (h *StoragePolicyHandler) SetIntegrityService(svc *volume.IntegrityService) {
+	h.integritySvc = svc
+}
+
 // Create 处理创建存储策略的请求
 // @Summary      创建存储策略
 // @Description  创建新的存储策略
@@ -162,6 +184,7 @@ func (h *StoragePolicyHandler) Get(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "构建响应失败: "+err.Error())
 		return
 	}
+	redactPolicyCredentials(c, responseItem)
 	response.Success(c, responseItem, "获取成功")
 }
 
@@ -212,6 +235,7 @@ func (h *StoragePolicyHandler) List(c *gin.Context) {
 			response.Fail(c, http.StatusInternalServerError, "构建策略列表项失败: "+buildErr.Error())
 			return
 		}
+		redactPolicyCredentials(c, item)
 		responseList[i] = item
 	}
 
@@ -223,12 +247,13 @@ func (h *StoragePolicyHandler) List(c *gin.Context) {
 
 // Delete 处理删除存储策略的请求
 // @Summary      删除存储策略
-// @Description  根据ID删除存储策略
+// @Description  根据ID删除存储策略。mode=detach（默认）仅删除策略并保留远程数据；mode=purge 异步清除全部远程对象，完成后再删除策略，返回清理任务进度
 // @Tags         存储策略
 // @Security     BearerAuth
-// @Param        id  path  string  true  "策略公共ID"
-// @Success      200  {object}  response.Response  "删除成功"
-// @Failure      400  {object}  response.Response  "ID不能为空"
+// @Param        id    path   string  true   "策略公共ID"
+// @Param        mode  query  string  false  "删除模式：detach 或 purge"
+// @Success      200  {object}  response.Response{data=volume.PurgeProgress}  "删除成功或清理任务已启动"
+// @Failure      400  {object}  response.Response  "ID不能为空或删除模式无效"
 // @Failure      500  {object}  response.Response  "删除失败"
 // @Router       /storage-policies/{id} [delete]
 func (h *StoragePolicyHandler) Delete(c *gin.Context) {
@@ -238,6 +263,25 @@ func (h *StoragePolicyHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	switch c.DefaultQuery("mode", volume.DeleteModeDetach) {
+	case volume.DeleteModeDetach:
+	case volume.DeleteModePurge:
+		if h.purgeSvc == nil {
+			response.Fail(c, http.StatusBadRequest, "当前不支持清除远程对象")
+			return
+		}
+		progress, err := h.purgeSvc.Start(c.Request.Context(), publicID)
+		if err != nil {
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+			return
+		}
+		response.Success(c, progress, "清理任务已启动，远程对象清除完成后将删除策略")
+		return
+	default:
+		response.Fail(c, http.StatusBadRequest, "删除模式无效，可选值为 detach 或 purge")
+		return
+	}
+
 	if err := h.svc.DeletePolicy(c.Request.Context(), publicID); err != nil {
 		response.Fail(c, http.StatusInternalServerError, err.Error())
 		return
@@ -418,3 +462,163 @@ func (h *StoragePolicyHandler) buildStoragePolicyResponseItem(policy *model.Stor
 		Settings:    policy.Settings,
 	}, nil
 }
+
+// redactPolicyCredentials 非管理员（仅拥有 file:manage 权限）查看策略时清空访问密钥，
+// 避免存储凭据泄露给非管理员
+func redactPolicyCredentials(c *gin.Context, item *model.StoragePolicyResponse) {
+	if c.GetBool(auth.IsAdminKey) {
+		return
+	}
+	item.AccessKey = ""
+	item.SecretKey = ""
+}
+
+// PurgePreview 预览清除策略远程对象的影响范围
+// @Summary      预览清除远程对象
+// @Description  统计删除策略时将被清除的对象数量、总大小和部分对象路径，不会修改任何数据
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "策略公共ID"
+// @Success      200  {object}  response.Response{data=volume.PurgeReport}  "预览成功"
+// @Failure      500  {object}  response.Response  "预览失败"
+// @Router       /policies/{id}/purge-preview [get]
+func (h *StoragePolicyHandler) PurgePreview(c *gin.Context) {
+	if h.purgeSvc == nil {
+		response.Fail(c, http.StatusBadRequest, "当前不支持清除远程对象")
+		return
+	}
+	report, err := h.purgeSvc.Preview(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, report, "预览成功")
+}
+
+// CleanupStaleUploads 立即清理远程存储中未完成的分片上传或上传会话
+// @Summary      清理远程未完成上传
+// @Description  中止各存储策略中超过指定时长仍未完成的分片上传（S3/COS/OSS）或上传会话（OneDrive），返回中止数量与释放的空间
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        max_age_hours  query  int  false  "清理多少小时前发起的上传，默认使用系统设置，最少 1 小时"
+// @Success      200  {object}  response.Response{data=volume.StaleUploadReport}  "清理完成"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      500  {object}  response.Response  "清理失败"
+// @Router       /policies/stale-uploads/cleanup [post]
+func (h *StoragePolicyHandler) CleanupStaleUploads(c *gin.Context) {
+	if h.staleSvc == nil {
+		response.Fail(c, http.StatusBadRequest, "当前不支持清理远程未完成上传")
+		return
+	}
+	maxAge := h.staleSvc.MaxAge()
+	if raw := c.Query("max_age_hours"); raw != "" {
+		hours, err := strconv.Atoi(raw)
+		if err != nil {
+			response.Fail(c, http.StatusBadRequest, "max_age_hours 必须是整数")
+			return
+		}
+		maxAge = time.Duration(hours) * time.Hour
+	}
+	if maxAge == 0 {
+		response.Fail(c, http.StatusBadRequest, "未配置清理时长，请指定 max_age_hours")
+		return
+	}
+
+	report, err := h.staleSvc.Cleanup(c.Request.Context(), maxAge)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, report, "清理完成")
+}
+
+// CheckIntegrity 立即执行一次数据一致性检查
+// @Summary      数据一致性检查
+// @Description  检查孤立实体、主实体缺失的文件、无效的文件版本关联、策略已删除的实体以及错误的目录子项计数；repair=true 时自动修复可处理的问题
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        repair  query  bool  false  "是否自动修复，默认只检查"
+// @Success      200  {object}  response.Response{data=volume.IntegrityReport}  "检查完成"
+// @Failure      400  {object}  response.Response  "参数错误"
+// @Failure      409  {object}  response.Response  "已有检查正在执行"
+// @Router       /policies/integrity/check [post]
+func (h *StoragePolicyHandler) CheckIntegrity(c *gin.Context) {
+	if h.integritySvc == nil {
+		response.Fail(c, http.StatusBadRequest, "当前不支持数据一致性检查")
+		return
+	}
+	repair := false
+	if raw := c.Query("repair"); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.Fail(c, http.StatusBadRequest, "repair 必须是布尔值")
+			return
+		}
+		repair = v
+	}
+
+	report, err := h.integritySvc.Check(c.Request.Context(), repair)
+	if err != nil {
+		if errors.Is(err, volume.ErrIntegrityCheckRunning) {
+			response.Fail(c, http.StatusConflict, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, report, "检查完成")
+}
+
+// DownloadIntegrityReport 下载最近一次数据一致性检查的报告
+// @Summary      下载一致性检查报告
+// @Description  以 JSON 文件下载最近一次（手动或定时）一致性检查的完整报告，报告只保存在内存中，重启后需重新检查
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      application/json
+// @Success      200  {file}    file  "报告文件"
+// @Failure      404  {object}  response.Response  "尚未执行过检查"
+// @Router       /policies/integrity/report [get]
+func (h *StoragePolicyHandler) DownloadIntegrityReport(c *gin.Context) {
+	if h.integritySvc == nil {
+		response.Fail(c, http.StatusBadRequest, "当前不支持数据一致性检查")
+		return
+	}
+	report := h.integritySvc.LastReport()
+	if report == nil {
+		response.Fail(c, http.StatusNotFound, "尚未执行过数据一致性检查")
+		return
+	}
+	filename := fmt.Sprintf("integrity-report-%s.json", report.StartedAt.Format("20060102-150405"))
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.IndentedJSON(http.StatusOK, report)
+}
+
+// GetPurgeTask 查询远程对象清理任务进度
+// @Summary      查询清理任务进度
+// @Description  查询删除策略时启动的远程对象清理任务进度，任务结束后保留 24 小时
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        taskId  path  string  true  "任务ID"
+// @Success      200  {object}  response.Response{data=volume.PurgeProgress}  "获取成功"
+// @Failure      404  {object}  response.Response  "任务不存在"
+// @Router       /policies/purge-tasks/{taskId} [get]
+func (h *StoragePolicyHandler) GetPurgeTask(c *gin.Context) {
+	if h.purgeSvc == nil {
+		response.Fail(c, http.StatusNotFound, volume.ErrPurgeTaskNotFound.Error())
+		return
+	}
+	progress, err := h.purgeSvc.Progress(c.Param("taskId"))
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	response.Success(c, progress, "获取成功")
+}
@@ -0,0 +1,203 @@
+/*
+ * @Description: 存储策略别名挂载点管理接口
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package storage_policy_handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+// SetMountService 注入别名挂载点管理服务（可选），未注入时挂载点接口返回 404。
+func (h *StoragePolicyHandler) SetMountService(svc *volume.MountService) {
+	h.mountSvc = svc
+}
+
+// ListMounts 列出策略的别名挂载点
+// @Summary      列出挂载点
+// @Description  列出存储策略除主路径外的全部别名挂载点
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "策略公共ID"
+// @Success      200  {object}  response.Response{data=[]model.StoragePolicyMountResponse}  "获取成功"
+// @Failure      404  {object}  response.Response  "策略未找到"
+// @Router       /policies/{id}/mounts [get]
+func (h *StoragePolicyHandler) ListMounts(c *gin.Context) {
+	if h.mountSvc == nil {
+		response.Fail(c, http.StatusNotFound, "当前不支持别名挂载点")
+		return
+	}
+	mounts, err := h.mountSvc.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.failMount(c, err)
+		return
+	}
+	items := make([]*model.StoragePolicyMountResponse, len(mounts))
+	for i, mount := range mounts {
+		items[i] = buildMountResponse(c.Param("id"), mount)
+	}
+	response.Success(c, items, "获取成功")
+}
+
+// AddMount 为策略新增别名挂载点
+// @Summary      新增挂载点
+// @Description  将存储策略额外挂载到一个一级虚拟路径，可设为只读；VFS 按最长前缀匹配路由
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string               true  "策略公共ID"
+// @Param        body  body  volume.MountRequest  true  "挂载点信息"
+// @Success      200  {object}  response.Response{data=model.StoragePolicyMountResponse}  "新增成功"
+// @Failure      400  {object}  response.Response  "挂载路径无效"
+// @Failure      404  {object}  response.Response  "策略未找到"
+// @Failure      409  {object}  response.Response  "路径已被占用"
+// @Router       /policies/{id}/mounts [post]
+func (h *StoragePolicyHandler) AddMount(c *gin.Context) {
+	if h.mountSvc == nil {
+		response.Fail(c, http.StatusNotFound, "当前不支持别名挂载点")
+		return
+	}
+	var req volume.MountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数无效: "+err.Error())
+		return
+	}
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	mount, err := h.mountSvc.Add(c.Request.Context(), ownerID, c.Param("id"), &req)
+	if err != nil {
+		h.failMount(c, err)
+		return
+	}
+	response.Success(c, buildMountResponse(c.Param("id"), mount), "新增成功")
+}
+
+// UpdateMount 修改别名挂载点
+// @Summary      修改挂载点
+// @Description  修改别名挂载点的路径或只读属性，修改路径时挂载目录必须为空
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string               true  "策略公共ID"
+// @Param        mountId  path  int                  true  "挂载点ID"
+// @Param        body     body  volume.MountRequest  true  "挂载点信息"
+// @Success      200  {object}  response.Response{data=model.StoragePolicyMountResponse}  "更新成功"
+// @Failure      400  {object}  response.Response  "挂载路径无效"
+// @Failure      404  {object}  response.Response  "挂载点未找到"
+// @Failure      409  {object}  response.Response  "路径已被占用或挂载目录不为空"
+// @Router       /policies/{id}/mounts/{mountId} [put]
+func (h *StoragePolicyHandler) UpdateMount(c *gin.Context) {
+	if h.mountSvc == nil {
+		response.Fail(c, http.StatusNotFound, "当前不支持别名挂载点")
+		return
+	}
+	mountID, err := strconv.ParseUint(c.Param("mountId"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的挂载点ID")
+		return
+	}
+	var req volume.MountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数无效: "+err.Error())
+		return
+	}
+	mount, err := h.mountSvc.Update(c.Request.Context(), c.Param("id"), uint(mountID), &req)
+	if err != nil {
+		h.failMount(c, err)
+		return
+	}
+	response.Success(c, buildMountResponse(c.Param("id"), mount), "更新成功")
+}
+
+// DeleteMount 删除别名挂载点
+// @Summary      删除挂载点
+// @Description  删除别名挂载点，挂载目录保留为普通目录；挂载目录不为空时拒绝删除
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id       path  string  true  "策略公共ID"
+// @Param        mountId  path  int     true  "挂载点ID"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "挂载点未找到"
+// @Failure      409  {object}  response.Response  "挂载目录不为空"
+// @Router       /policies/{id}/mounts/{mountId} [delete]
+func (h *StoragePolicyHandler) DeleteMount(c *gin.Context) {
+	if h.mountSvc == nil {
+		response.Fail(c, http.StatusNotFound, "当前不支持别名挂载点")
+		return
+	}
+	mountID, err := strconv.ParseUint(c.Param("mountId"), 10, 64)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的挂载点ID")
+		return
+	}
+	if err := h.mountSvc.Remove(c.Request.Context(), c.Param("id"), uint(mountID)); err != nil {
+		h.failMount(c, err)
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
+
+// failMount 将挂载点服务的错误转换为对应的 HTTP 状态码
+func (h *StoragePolicyHandler) failMount(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constant.ErrNotFound), errors.Is(err, constant.ErrPolicyNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, constant.ErrConflict):
+		response.Fail(c, http.StatusConflict, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// currentUserID 从 JWT 声明中解析当前用户的数据库ID，失败时已写入错误响应
+func currentUserID(c *gin.Context) (uint, bool) {
+	claims, exists := c.Get(auth.ClaimsKey)
+	if !exists {
+		response.Fail(c, http.StatusUnauthorized, "无法获取用户信息")
+		return 0, false
+	}
+	authClaims, ok := claims.(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusInternalServerError, "用户信息格式不正确")
+		return 0, false
+	}
+	userID, _, err := idgen.DecodePublicID(authClaims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return userID, true
+}
+
+func buildMountResponse(publicPolicyID string, mount *model.StoragePolicyMount) *model.StoragePolicyMountResponse {
+	return &model.StoragePolicyMountResponse{
+		ID:          mount.ID,
+		CreatedAt:   mount.CreatedAt,
+		UpdatedAt:   mount.UpdatedAt,
+		PolicyID:    publicPolicyID,
+		VirtualPath: mount.VirtualPath,
+		ReadOnly:    mount.ReadOnly,
+	}
+}
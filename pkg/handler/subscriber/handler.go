@@ -7,6 +7,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -135,6 +136,10 @@ func (h *Handler) UnsubscribeByToken(c *gin.Context) {
 type CaptchaParams struct {
 	// Turnstile 参数
 	TurnstileToken string `json:"turnstile_token,omitempty"`
+	// hCaptcha 参数
+	HCaptchaToken string `json:"hcaptcha_token,omitempty"`
+	// reCAPTCHA 参数
+	RecaptchaToken string `json:"recaptcha_token,omitempty"`
 	// 极验参数
 	GeetestLotNumber     string `json:"geetest_lot_number,omitempty"`
 	GeetestCaptchaOutput string `json:"geetest_captcha_output,omitempty"`
@@ -172,6 +177,8 @@ func (h *Handler) SendVerificationCode(c *gin.Context) {
 	// 验证人机验证（如果启用）
 	captchaParams := captcha.CaptchaParams{
 		TurnstileToken:       req.TurnstileToken,
+		HCaptchaToken:        req.HCaptchaToken,
+		RecaptchaToken:       req.RecaptchaToken,
 		GeetestLotNumber:     req.GeetestLotNumber,
 		GeetestCaptchaOutput: req.GeetestCaptchaOutput,
 		GeetestPassToken:     req.GeetestPassToken,
@@ -179,7 +186,7 @@ func (h *Handler) SendVerificationCode(c *gin.Context) {
 		ImageCaptchaId:       req.ImageCaptchaId,
 		ImageCaptchaAnswer:   req.ImageCaptchaAnswer,
 	}
-	if err := h.captchaSvc.Verify(c.Request.Context(), captchaParams, c.ClientIP()); err != nil {
+	if err := h.captchaSvc.VerifyScene(c.Request.Context(), captcha.SceneSubscribe, captchaParams, util.GetRealClientIP(c)); err != nil {
 		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
 	}
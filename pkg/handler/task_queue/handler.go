@@ -0,0 +1,214 @@
+/*
+ * @Description: 后台任务队列管理 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 15:00:00
+ * @LastEditTime: 2026-10-17 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package task_queue
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了后台任务队列管理相关的 HTTP 处理器。
+type Handler struct {
+	broker *task.Broker
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(broker *task.Broker) *Handler {
+	return &Handler{broker: broker}
+}
+
+// Stats
+// @Summary      获取后台任务队列状态
+// @Description  返回任务队列使用的存储后端，以及待处理、处理中、等待重试和死信任务的数量
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=task.QueueStats} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/task-queue/stats [get]
+func (h *Handler) Stats(c *gin.Context) {
+	stats, err := h.broker.QueueStats(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, stats, "获取成功")
+}
+
+// ListDeadLetters
+// @Summary      获取死信任务
+// @Description  按失败时间倒序返回多次重试仍失败的后台任务（缩略图生成、评论通知、清理任务等），包含最后一次的错误信息
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=[]task.QueueMessage} "成功响应"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/task-queue/dead-letters [get]
+func (h *Handler) ListDeadLetters(c *gin.Context) {
+	list, err := h.broker.ListDeadLetters(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// RetryDeadLetter
+// @Summary      重试死信任务
+// @Description  将死信任务清零尝试次数并重新放回任务队列
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "任务ID"
+// @Success      200 {object} response.Response "已重新加入队列"
+// @Failure      404 {object} response.Response "任务不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/task-queue/dead-letters/{id}/retry [post]
+func (h *Handler) RetryDeadLetter(c *gin.Context) {
+	if err := h.broker.RetryDeadLetter(c.Request.Context(), c.Param("id")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "已重新加入任务队列")
+}
+
+// DeleteDeadLetter
+// @Summary      删除死信任务
+// @Description  放弃执行并从死信队列中删除任务
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "任务ID"
+// @Success      200 {object} response.Response "删除成功"
+// @Failure      404 {object} response.Response "任务不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/task-queue/dead-letters/{id} [delete]
+func (h *Handler) DeleteDeadLetter(c *gin.Context) {
+	if err := h.broker.DeleteDeadLetter(c.Request.Context(), c.Param("id")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "删除成功")
+}
+
+// ListTasks
+// @Summary      获取任务队列中的任务
+// @Description  列出执行中、待处理、等待重试和失败的队列任务，包含任务类型、参数摘要、尝试次数与最后一次的错误信息，用于排查卡住的缩略图与清理任务
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        state query string false "任务状态" Enums(pending, running, retrying, failed)
+// @Success      200 {object} response.Response{data=[]task.TaskInfo} "成功响应"
+// @Failure      400 {object} response.Response "未知的任务状态"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/tasks [get]
+func (h *Handler) ListTasks(c *gin.Context) {
+	list, err := h.broker.ListTasks(c.Request.Context(), c.Query("state"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// RetryTask
+// @Summary      重试任务
+// @Description  立即执行等待重试的任务；失败的任务清零尝试次数后重新加入队列
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "任务ID"
+// @Success      200 {object} response.Response "已重新加入队列"
+// @Failure      400 {object} response.Response "任务正在执行"
+// @Failure      404 {object} response.Response "任务不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/tasks/{id}/retry [post]
+func (h *Handler) RetryTask(c *gin.Context) {
+	if err := h.broker.RetryTask(c.Request.Context(), c.Param("id")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "已重新加入任务队列")
+}
+
+// CancelTask
+// @Summary      取消任务
+// @Description  从队列中删除尚未执行的任务；执行中的任务会收到取消信号且不再重试
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "任务ID"
+// @Success      200 {object} response.Response "已取消"
+// @Failure      400 {object} response.Response "任务已开始执行"
+// @Failure      404 {object} response.Response "任务不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/tasks/{id}/cancel [post]
+func (h *Handler) CancelTask(c *gin.Context) {
+	if err := h.broker.CancelTask(c.Request.Context(), c.Param("id")); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "任务已取消")
+}
+
+// MaintenanceStatus
+// @Summary      获取数据库维护状态
+// @Description  返回数据库维护的开关、维护时段、是否正在执行，以及最近的维护记录（各步骤耗时、清理的软删除行数与错误信息）
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response{data=task.MaintenanceStatus} "成功响应"
+// @Failure      404 {object} response.Response "数据库维护服务未启用"
+// @Router       /admin/tasks/maintenance [get]
+func (h *Handler) MaintenanceStatus(c *gin.Context) {
+	status, err := h.broker.MaintenanceStatus()
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, status, "获取成功")
+}
+
+// RunMaintenance
+// @Summary      立即执行数据库维护
+// @Description  在后台立即执行一次数据库维护，不受维护时段与开关限制，完成后可在维护记录中查看结果
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} response.Response "已开始执行"
+// @Failure      404 {object} response.Response "数据库维护服务未启用"
+// @Failure      409 {object} response.Response "数据库维护正在执行中"
+// @Router       /admin/tasks/maintenance/run [post]
+func (h *Handler) RunMaintenance(c *gin.Context) {
+	if err := h.broker.RunMaintenance(); err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, nil, "数据库维护已开始执行")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	if errors.Is(err, constant.ErrNotFound) {
+		response.Fail(c, http.StatusNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, constant.ErrBadRequest) {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, constant.ErrConflict) {
+		response.Fail(c, http.StatusConflict, err.Error())
+		return
+	}
+	response.Fail(c, http.StatusInternalServerError, err.Error())
+}
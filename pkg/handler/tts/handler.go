@@ -0,0 +1,80 @@
+/*
+ * @Description: 文章语音朗读 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package tts
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	tts_service "github.com/anzhiyu-c/anheyu-app/pkg/service/tts"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了文章语音朗读相关的 HTTP 处理器。
+type Handler struct {
+	svc *tts_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *tts_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetAudio
+// @Summary      获取文章语音状态
+// @Description  返回文章语音朗读版本的生成状态、服务商、发音人与音频地址，尚未生成时 data 为 null
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "文章公共ID"
+// @Success      200 {object} response.Response{data=model.ArticleAudio} "成功响应"
+// @Failure      400 {object} response.Response "文章ID无效"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /articles/{id}/audio [get]
+func (h *Handler) GetAudio(c *gin.Context) {
+	audio, err := h.svc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, audio, "获取成功")
+}
+
+// RegenerateAudio
+// @Summary      重新生成文章语音
+// @Description  立即调用语音合成服务为已发布的文章重新生成音频，完成后替换旧音频文件
+// @Tags         文章管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path string true "文章公共ID"
+// @Success      200 {object} response.Response{data=model.ArticleAudio} "生成成功"
+// @Failure      400 {object} response.Response "文章ID无效或文章未发布"
+// @Failure      404 {object} response.Response "语音合成服务未配置"
+// @Failure      502 {object} response.Response "语音合成失败"
+// @Router       /articles/{id}/audio [post]
+func (h *Handler) RegenerateAudio(c *gin.Context) {
+	audio, err := h.svc.Generate(c.Request.Context(), c.Param("id"), true)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	response.Success(c, audio, "生成成功")
+}
+
+func (h *Handler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constant.ErrBadRequest):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, tts_service.ErrNotConfigured):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	default:
+		response.Fail(c, http.StatusBadGateway, err.Error())
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * @Description: 站点地址迁移 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 17:00:00
+ * @LastEditTime: 2026-10-17 17:00:00
+ * @LastEditors: 安知鱼
+ */
+package url_migration
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	url_migration_service "github.com/anzhiyu-c/anheyu-app/pkg/service/url_migration"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了站点地址迁移相关的 HTTP 处理器。
+type Handler struct {
+	svc *url_migration_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *url_migration_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Preview
+// @Summary      预览站点地址迁移
+// @Description  统计文章（Markdown、HTML、封面等链接字段）、评论内容与配置值中以旧地址开头的绝对 URL，返回逐字段的替换前后对比片段，不修改任何数据
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body url_migration_service.Options true "地址迁移选项"
+// @Success      200 {object} response.Response{data=url_migration_service.Report} "预览结果"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/url-migration/preview [post]
+func (h *Handler) Preview(c *gin.Context) {
+	h.run(c, h.svc.Preview, "预览成功")
+}
+
+// Apply
+// @Summary      执行站点地址迁移
+// @Description  将文章、评论与配置中以旧地址开头的绝对 URL 替换为新地址，完成后自动清除文章缓存并重新建立搜索索引
+// @Tags         系统管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body url_migration_service.Options true "地址迁移选项"
+// @Success      200 {object} response.Response{data=url_migration_service.Report} "迁移结果"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/url-migration/apply [post]
+func (h *Handler) Apply(c *gin.Context) {
+	h.run(c, h.svc.Apply, "地址迁移完成")
+}
+
+func (h *Handler) run(c *gin.Context, fn func(ctx context.Context, opts url_migration_service.Options) (*url_migration_service.Report, error), message string) {
+	var opts url_migration_service.Options
+	if err := c.ShouldBindJSON(&opts); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数错误: "+err.Error())
+		return
+	}
+	report, err := fn(c.Request.Context(), opts)
+	if err != nil {
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, report, message)
+}
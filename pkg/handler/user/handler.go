@@ -8,10 +8,10 @@
 package user_handler
 
 import (
+	"bytes"
 	"errors"
 	"log"
 	"net/http"
-	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -19,13 +19,17 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/image_style"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/impersonation"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/password"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/user"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -38,6 +42,8 @@ type UserHandler struct {
 	directLinkSvc direct_link.Service
 	// styleSvc 可选；非 nil 且策略配置了 default_style 时，头像 URL 自动拼 "!style"。
 	styleSvc image_style.ImageStyleService
+	// impersonationSvc 可选；为 nil 时模拟登录接口不可用。
+	impersonationSvc *impersonation.Service
 }
 
 // SetImageStyleService 注入图片样式服务（可选），用于头像上传 URL 自动拼默认样式后缀。
@@ -45,6 +51,11 @@ func (h *UserHandler) SetImageStyleService(svc image_style.ImageStyleService) {
 	h.styleSvc = svc
 }
 
+// SetImpersonationService 注入模拟登录服务（可选），用于管理员以指定用户身份排查问题。
+func (h *UserHandler) SetImpersonationService(svc *impersonation.Service) {
+	h.impersonationSvc = svc
+}
+
 // NewUserHandler 是 UserHandler 的构造函数
 func NewUserHandler(userSvc user.UserService, settingSvc setting.SettingService, fileSvc file_service.FileService, directLinkSvc direct_link.Service) *UserHandler {
 	return &UserHandler{
@@ -65,18 +76,24 @@ type UserGroup struct {
 
 // GetUserInfoResponse 用于定义获取用户信息时的响应结构体，包含公共ID
 type GetUserInfoResponse struct {
-	ID          string    `json:"id"`          // 用户的公共ID
-	CreatedAt   string    `json:"created_at"`  // 创建时间
-	UpdatedAt   string    `json:"updated_at"`  // 更新时间
-	Username    string    `json:"username"`    // 用户名
-	Nickname    string    `json:"nickname"`    // 昵称
-	Avatar      string    `json:"avatar"`      // 头像URL
-	Email       string    `json:"email"`       // 邮箱
-	Website     string    `json:"website"`     // 个人网站
-	LastLoginAt *string   `json:"lastLoginAt"` // 最后登录时间
-	UserGroupID uint      `json:"userGroupID"` // 原始用户组ID (数字类型)，根据需求决定是否暴露
-	UserGroup   UserGroup `json:"userGroup"`   // 用户的用户组信息 (嵌套 DTO)
-	Status      int       `json:"status"`      // 用户状态
+	ID             string            `json:"id"`                       // 用户的公共ID
+	CreatedAt      string            `json:"created_at"`               // 创建时间
+	UpdatedAt      string            `json:"updated_at"`               // 更新时间
+	Username       string            `json:"username"`                 // 用户名
+	Nickname       string            `json:"nickname"`                 // 昵称
+	Avatar         string            `json:"avatar"`                   // 头像URL
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"` // 上传头像的各尺寸版本URL，键为边长像素
+	Email          string            `json:"email"`                    // 邮箱
+	Website        string            `json:"website"`                  // 个人网站
+	LastLoginAt    *string           `json:"lastLoginAt"`              // 最后登录时间
+	UserGroupID    uint              `json:"userGroupID"`              // 原始用户组ID (数字类型)，根据需求决定是否暴露
+	UserGroup      UserGroup         `json:"userGroup"`                // 用户的用户组信息 (嵌套 DTO)
+	Status         int               `json:"status"`                   // 用户状态
+	Bio            string            `json:"bio"`                      // 个人简介
+	// ProfileVisibility 公开资料页的可见性设置
+	ProfileVisibility model.ProfileVisibility `json:"profileVisibility"`
+	// ImpersonatorID 当前为模拟登录时，签发令牌的管理员公共ID，前端据此显示模拟提示
+	ImpersonatorID string `json:"impersonatorID,omitempty"`
 }
 
 // GetUserInfo 获取当前登录用户的信息
@@ -149,22 +166,26 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 	}
 
 	resp := GetUserInfoResponse{
-		ID:          publicUserID,
-		CreatedAt:   utils.ToChina(user.CreatedAt).Format("2006-01-02 15:04:05"),
-		UpdatedAt:   utils.ToChina(user.UpdatedAt).Format("2006-01-02 15:04:05"),
-		Username:    user.Username,
-		Nickname:    user.Nickname,
-		Avatar:      avatar,
-		Email:       user.Email,
-		Website:     user.Website,
-		LastLoginAt: lastLoginAtStr,
-		UserGroupID: user.UserGroupID, // 保留原始 UserGroupID (数字类型)
+		ID:             publicUserID,
+		CreatedAt:      utils.ToChina(user.CreatedAt).Format("2006-01-02 15:04:05"),
+		UpdatedAt:      utils.ToChina(user.UpdatedAt).Format("2006-01-02 15:04:05"),
+		Username:       user.Username,
+		Nickname:       user.Nickname,
+		Avatar:         avatar,
+		AvatarVariants: user.AvatarVariants,
+		Email:          user.Email,
+		Website:        user.Website,
+		LastLoginAt:    lastLoginAtStr,
+		UserGroupID:    user.UserGroupID, // 保留原始 UserGroupID (数字类型)
 		UserGroup: UserGroup{
 			ID:          publicUserGroupID, // 用户组的公共ID
 			Name:        user.UserGroup.Name,
 			Description: user.UserGroup.Description,
 		},
-		Status: user.Status,
+		Status:            user.Status,
+		Bio:               user.Bio,
+		ProfileVisibility: user.ProfileVisibility,
+		ImpersonatorID:    claims.ImpersonatorID,
 	}
 
 	response.Success(c, resp, "获取用户信息成功")
@@ -219,6 +240,10 @@ func (h *UserHandler) UpdateUserPassword(c *gin.Context) {
 	// 4. 调用 Service
 	err = h.userSvc.UpdateUserPasswordByID(c.Request.Context(), internalUserID, req.OldPassword, req.NewPassword)
 	if err != nil {
+		if errors.Is(err, password.ErrWeakPassword) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusUnauthorized, err.Error())
 		return
 	}
@@ -231,11 +256,14 @@ func (h *UserHandler) UpdateUserPassword(c *gin.Context) {
 type UpdateUserProfileRequest struct {
 	Nickname *string `json:"nickname" binding:"omitempty,min=2,max=50"`
 	Website  *string `json:"website" binding:"omitempty,url"`
+	Bio      *string `json:"bio" binding:"omitempty,max=500"`
+	// ProfileVisibility 公开资料页的可见性设置，整体替换
+	ProfileVisibility *model.ProfileVisibility `json:"profileVisibility"`
 }
 
 // UpdateUserProfile 用于已登录用户修改自己的基本信息
 // @Summary      更新用户信息
-// @Description  当前登录用户更新自己的基本信息（昵称、网站等）
+// @Description  当前登录用户更新自己的基本信息（昵称、网站、个人简介）以及公开资料页的可见性设置
 // @Tags         用户管理
 // @Security     BearerAuth
 // @Accept       json
@@ -249,7 +277,7 @@ func (h *UserHandler) UpdateUserProfile(c *gin.Context) {
 	// 1. 解析参数
 	var req UpdateUserProfileRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Fail(c, http.StatusBadRequest, "参数错误：昵称长度需在2-50个字符，网站需为有效URL")
+		response.Fail(c, http.StatusBadRequest, "参数错误：昵称长度需在2-50个字符，网站需为有效URL，简介不超过500个字符")
 		return
 	}
 
@@ -274,7 +302,7 @@ func (h *UserHandler) UpdateUserProfile(c *gin.Context) {
 	}
 
 	// 4. 调用 Service
-	err = h.userSvc.UpdateUserProfileByID(c.Request.Context(), internalUserID, req.Nickname, req.Website)
+	err = h.userSvc.UpdateUserProfileByID(c.Request.Context(), internalUserID, req.Nickname, req.Website, req.Bio, req.ProfileVisibility)
 	if err != nil {
 		response.Fail(c, http.StatusBadRequest, err.Error())
 		return
@@ -292,7 +320,7 @@ type AdminListUsersRequest struct {
 	PageSize int    `form:"pageSize" binding:"omitempty,min=1,max=100"`
 	Keyword  string `form:"keyword"`
 	GroupID  *uint  `form:"groupID"`
-	Status   *int   `form:"status" binding:"omitempty,min=1,max=3"`
+	Status   *int   `form:"status" binding:"omitempty,min=1,max=4"`
 }
 
 // AdminListUsersResponse 管理员查询用户列表的响应
@@ -305,18 +333,19 @@ type AdminListUsersResponse struct {
 
 // AdminUserDTO 管理员用户列表的用户DTO
 type AdminUserDTO struct {
-	ID          string    `json:"id"`
-	CreatedAt   string    `json:"created_at"`
-	UpdatedAt   string    `json:"updated_at"`
-	Username    string    `json:"username"`
-	Nickname    string    `json:"nickname"`
-	Avatar      string    `json:"avatar"`
-	Email       string    `json:"email"`
-	Website     string    `json:"website"`
-	LastLoginAt *string   `json:"lastLoginAt"`
-	UserGroupID string    `json:"userGroupID"`
-	UserGroup   UserGroup `json:"userGroup"`
-	Status      int       `json:"status"`
+	ID             string            `json:"id"`
+	CreatedAt      string            `json:"created_at"`
+	UpdatedAt      string            `json:"updated_at"`
+	Username       string            `json:"username"`
+	Nickname       string            `json:"nickname"`
+	Avatar         string            `json:"avatar"`
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"` // 上传头像的各尺寸版本URL，键为边长像素
+	Email          string            `json:"email"`
+	Website        string            `json:"website"`
+	LastLoginAt    *string           `json:"lastLoginAt"`
+	UserGroupID    string            `json:"userGroupID"`
+	UserGroup      UserGroup         `json:"userGroup"`
+	Status         int               `json:"status"`
 }
 
 // AdminListUsers 管理员获取用户列表
@@ -329,7 +358,7 @@ type AdminUserDTO struct {
 // @Param        pageSize  query     int     false  "每页数量，默认10"
 // @Param        keyword   query     string  false  "搜索关键词（用户名、昵称、邮箱）"
 // @Param        groupID   query     int     false  "用户组ID筛选"
-// @Param        status    query     int     false  "用户状态筛选（1:正常 2:未激活 3:已封禁）"
+// @Param        status    query     int     false  "用户状态筛选（1:正常 2:未激活 3:已封禁 4:待审核）"
 // @Success      200  {object}  response.Response{data=AdminListUsersResponse}  "查询成功"
 // @Failure      400  {object}  response.Response  "参数错误"
 // @Failure      401  {object}  response.Response  "未授权"
@@ -384,16 +413,17 @@ func (h *UserHandler) AdminListUsers(c *gin.Context) {
 		}
 
 		userDTOs[i] = AdminUserDTO{
-			ID:          publicUserID,
-			CreatedAt:   utils.ToChina(user.CreatedAt).Format("2006-01-02 15:04:05"),
-			UpdatedAt:   utils.ToChina(user.UpdatedAt).Format("2006-01-02 15:04:05"),
-			Username:    user.Username,
-			Nickname:    user.Nickname,
-			Avatar:      avatar,
-			Email:       user.Email,
-			Website:     user.Website,
-			LastLoginAt: lastLoginAtStr,
-			UserGroupID: publicGroupID,
+			ID:             publicUserID,
+			CreatedAt:      utils.ToChina(user.CreatedAt).Format("2006-01-02 15:04:05"),
+			UpdatedAt:      utils.ToChina(user.UpdatedAt).Format("2006-01-02 15:04:05"),
+			Username:       user.Username,
+			Nickname:       user.Nickname,
+			Avatar:         avatar,
+			AvatarVariants: user.AvatarVariants,
+			Email:          user.Email,
+			Website:        user.Website,
+			LastLoginAt:    lastLoginAtStr,
+			UserGroupID:    publicGroupID,
 			UserGroup: UserGroup{
 				ID:          publicGroupID,
 				Name:        user.UserGroup.Name,
@@ -480,16 +510,17 @@ func (h *UserHandler) AdminCreateUser(c *gin.Context) {
 	}
 
 	userDTO := AdminUserDTO{
-		ID:          publicUserID,
-		CreatedAt:   utils.ToChina(user.CreatedAt).Format("2006-01-02 15:04:05"),
-		UpdatedAt:   utils.ToChina(user.UpdatedAt).Format("2006-01-02 15:04:05"),
-		Username:    user.Username,
-		Nickname:    user.Nickname,
-		Avatar:      avatar,
-		Email:       user.Email,
-		Website:     user.Website,
-		LastLoginAt: lastLoginAtStr,
-		UserGroupID: publicGroupID,
+		ID:             publicUserID,
+		CreatedAt:      utils.ToChina(user.CreatedAt).Format("2006-01-02 15:04:05"),
+		UpdatedAt:      utils.ToChina(user.UpdatedAt).Format("2006-01-02 15:04:05"),
+		Username:       user.Username,
+		Nickname:       user.Nickname,
+		Avatar:         avatar,
+		AvatarVariants: user.AvatarVariants,
+		Email:          user.Email,
+		Website:        user.Website,
+		LastLoginAt:    lastLoginAtStr,
+		UserGroupID:    publicGroupID,
 		UserGroup: UserGroup{
 			ID:          publicGroupID,
 			Name:        user.UserGroup.Name,
@@ -691,11 +722,116 @@ func (h *UserHandler) AdminUpdateUserStatus(c *gin.Context) {
 	response.Success(c, nil, "用户状态更新成功")
 }
 
+// AdminImpersonateUserRequest 管理员模拟登录用户的请求体
+type AdminImpersonateUserRequest struct {
+	Reason     string `json:"reason" binding:"required,max=500"`           // 模拟原因，写入审计日志
+	TTLMinutes int    `json:"ttlMinutes" binding:"omitempty,min=1,max=60"` // 令牌有效期（分钟），默认 15
+}
+
+// AdminImpersonateUserResponse 模拟登录签发的令牌
+type AdminImpersonateUserResponse struct {
+	AccessToken string `json:"accessToken"` // 以目标用户身份访问的短期令牌，不附带刷新令牌
+	Expires     int64  `json:"expires"`     // 过期时间（毫秒时间戳）
+	UserID      string `json:"userID"`      // 目标用户公共ID
+	Username    string `json:"username"`    // 目标用户名
+}
+
+// AdminImpersonateUser 管理员模拟登录用户
+// @Summary      管理员模拟登录用户
+// @Description  签发以指定用户身份访问的短期令牌，用于复现用户相关的存储与权限问题。令牌中带有模拟标记，无法访问管理接口或修改账户凭据，期间的所有请求都会写入审计日志；不允许模拟管理员。
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                       true  "用户ID"
+// @Param        body  body      AdminImpersonateUserRequest  true  "模拟原因与有效期"
+// @Success      200   {object}  response.Response{data=AdminImpersonateUserResponse}  "签发成功"
+// @Failure      400   {object}  response.Response  "参数错误或用户状态异常"
+// @Failure      403   {object}  response.Response  "不允许模拟管理员"
+// @Failure      404   {object}  response.Response  "用户不存在"
+// @Router       /admin/users/:id/impersonate [post]
+func (h *UserHandler) AdminImpersonateUser(c *gin.Context) {
+	if h.impersonationSvc == nil {
+		response.Fail(c, http.StatusNotFound, "模拟登录功能未启用")
+		return
+	}
+
+	userID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusBadRequest, "用户ID无效")
+		return
+	}
+
+	var req AdminImpersonateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：请填写模拟原因，有效期需在 1 到 60 分钟之间")
+		return
+	}
+
+	claims, ok := c.MustGet(auth.ClaimsKey).(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户信息格式不正确")
+		return
+	}
+	if claims.IsImpersonation() || claims.IsAccessToken() {
+		response.Fail(c, http.StatusForbidden, "请使用管理员账号登录后发起模拟登录")
+		return
+	}
+	adminID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "用户ID无效")
+		return
+	}
+
+	result, err := h.impersonationSvc.Start(c.Request.Context(), impersonation.StartRequest{
+		AdminID:   adminID,
+		TargetID:  userID,
+		Reason:    req.Reason,
+		TTL:       time.Duration(req.TTLMinutes) * time.Minute,
+		IP:        util.GetRealClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrForbidden):
+			response.Fail(c, http.StatusForbidden, err.Error())
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, AdminImpersonateUserResponse{
+		AccessToken: result.AccessToken,
+		Expires:     result.ExpiresAt,
+		UserID:      c.Param("id"),
+		Username:    result.User.Username,
+	}, "模拟令牌签发成功")
+}
+
 // UserGroupDTO 用户组数据传输对象
 type UserGroupDTO struct {
-	ID          string `json:"id"`          // 用户组公共ID
-	Name        string `json:"name"`        // 用户组名称
-	Description string `json:"description"` // 用户组描述
+	ID           string                   `json:"id"`                     // 用户组公共ID
+	Name         string                   `json:"name"`                   // 用户组名称
+	Description  string                   `json:"description"`            // 用户组描述
+	Permissions  []string                 `json:"permissions"`            // 权限名称列表，如 article:write
+	UploadPolicy *model.GroupUploadPolicy `json:"uploadPolicy,omitempty"` // 用户组上传限制，未设置时省略
+}
+
+// newUserGroupDTO 将用户组转换为响应 DTO
+func newUserGroupDTO(group *model.UserGroup) UserGroupDTO {
+	publicGroupID, _ := idgen.GeneratePublicID(group.ID, idgen.EntityTypeUserGroup)
+	return UserGroupDTO{
+		ID:           publicGroupID,
+		Name:         group.Name,
+		Description:  group.Description,
+		Permissions:  group.Permissions.Names(),
+		UploadPolicy: group.Settings.UploadPolicy,
+	}
 }
 
 // GetUserGroups 获取所有用户组列表
@@ -718,28 +854,132 @@ func (h *UserHandler) GetUserGroups(c *gin.Context) {
 	// 2. 转换为 DTO（包含公共ID）
 	groupDTOs := make([]UserGroupDTO, len(groups))
 	for i, group := range groups {
-		publicGroupID, _ := idgen.GeneratePublicID(group.ID, idgen.EntityTypeUserGroup)
-		groupDTOs[i] = UserGroupDTO{
-			ID:          publicGroupID,
-			Name:        group.Name,
-			Description: group.Description,
-		}
+		groupDTOs[i] = newUserGroupDTO(group)
 	}
 
 	// 3. 返回响应
 	response.Success(c, groupDTOs, "获取用户组列表成功")
 }
 
+// AdminUpdateUserGroupUploadPolicyRequest 管理员更新用户组上传限制的请求体，policy 为 null 时清除限制
+type AdminUpdateUserGroupUploadPolicyRequest struct {
+	Policy *model.GroupUploadPolicy `json:"policy"`
+}
+
+// AdminUpdateUserGroupUploadPolicy 管理员更新用户组的上传限制
+// @Summary      更新用户组上传限制
+// @Description  设置用户组允许/禁止的文件后缀、单个文件大小上限与每日上传次数，叠加在全局上传设置之上
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                                   true  "用户组ID"
+// @Param        body  body      AdminUpdateUserGroupUploadPolicyRequest  true  "上传限制"
+// @Success      200   {object}  response.Response{data=UserGroupDTO}  "更新成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "未授权"
+// @Failure      404   {object}  response.Response  "用户组不存在"
+// @Router       /admin/user-groups/{id}/upload-policy [put]
+func (h *UserHandler) AdminUpdateUserGroupUploadPolicy(c *gin.Context) {
+	groupID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUserGroup {
+		response.Fail(c, http.StatusBadRequest, "用户组ID无效")
+		return
+	}
+
+	var req AdminUpdateUserGroupUploadPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	group, err := h.userSvc.UpdateUserGroupUploadPolicy(c.Request.Context(), groupID, req.Policy)
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, "用户组不存在")
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, newUserGroupDTO(group), "用户组上传限制更新成功")
+}
+
+// GetPermissionDefinitions 获取可分配给用户组的全部权限
+// @Summary      获取权限列表
+// @Description  获取可分配给用户组的全部权限名称及说明
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]model.PermissionDefinition}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /admin/user-groups/permissions [get]
+func (h *UserHandler) GetPermissionDefinitions(c *gin.Context) {
+	response.Success(c, model.AllPermissions, "获取权限列表成功")
+}
+
+// AdminUpdateUserGroupPermissionsRequest 管理员更新用户组权限的请求体
+type AdminUpdateUserGroupPermissionsRequest struct {
+	Permissions []string `json:"permissions"`
+}
+
+// AdminUpdateUserGroupPermissions 管理员更新用户组的权限
+// @Summary      更新用户组权限
+// @Description  以权限名称列表替换用户组的全部权限，修改后立即对该组用户生效；管理员组必须保留 admin 权限
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                                  true  "用户组ID"
+// @Param        body  body      AdminUpdateUserGroupPermissionsRequest  true  "权限名称列表"
+// @Success      200   {object}  response.Response{data=UserGroupDTO}  "更新成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "未授权"
+// @Failure      404   {object}  response.Response  "用户组不存在"
+// @Router       /admin/user-groups/{id}/permissions [put]
+func (h *UserHandler) AdminUpdateUserGroupPermissions(c *gin.Context) {
+	groupID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUserGroup {
+		response.Fail(c, http.StatusBadRequest, "用户组ID无效")
+		return
+	}
+
+	var req AdminUpdateUserGroupPermissionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	group, err := h.userSvc.UpdateUserGroupPermissions(c.Request.Context(), groupID, req.Permissions)
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, "用户组不存在")
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, newUserGroupDTO(group), "用户组权限更新成功")
+}
+
 // UploadAvatar 处理用户头像上传请求
 // @Summary      上传用户头像
-// @Description  上传并设置用户自定义头像
+// @Description  上传并设置用户自定义头像，图片会按 EXIF 方向矫正后居中裁剪为正方形，并额外生成 256/128/64 像素的版本
 // @Tags         用户管理
 // @Security     BearerAuth
 // @Accept       multipart/form-data
 // @Produce      json
 // @Param        file  formData  file  true  "头像图片文件"
-// @Success      200   {object}  response.Response{data=object{url=string}}  "上传成功"
-// @Failure      400   {object}  response.Response  "无效的文件上传请求"
+// @Success      200   {object}  response.Response{data=object{url=string,variants=map[string]string}}  "上传成功"
+// @Failure      400   {object}  response.Response  "无效的文件上传请求或图片无法识别"
 // @Failure      401   {object}  response.Response  "未授权"
 // @Failure      500   {object}  response.Response  "头像上传失败"
 // @Router       /user/avatar [post]
@@ -782,50 +1022,72 @@ func (h *UserHandler) UploadAvatar(c *gin.Context) {
 	}
 	log.Printf("[Handler.UploadAvatar] 解析用户ID成功, ownerID: %d", ownerID)
 
-	// 4. 生成唯一文件名
-	ext := path.Ext(fileHeader.Filename)
-	uniqueFilename := strconv.FormatInt(time.Now().UnixNano(), 10) + ext
-
-	// 5. 调用文件服务上传到用户头像存储策略
-	log.Printf("[Handler.UploadAvatar] 开始上传头像文件")
-	fileItem, err := h.fileSvc.UploadFileByPolicyFlag(c.Request.Context(), ownerID, fileReader, constant.PolicyFlagUserAvatar, uniqueFilename)
+	// 4. 居中裁剪为正方形并生成各尺寸版本
+	images, err := user.ProcessAvatar(c.Request.Context(), fileReader)
 	if err != nil {
-		log.Printf("[Handler.UploadAvatar] 文件上传失败: %v", err)
-		response.Fail(c, http.StatusInternalServerError, "头像上传失败: "+err.Error())
+		log.Printf("[Handler.UploadAvatar] 处理头像图片失败: %v", err)
+		if errors.Is(err, constant.ErrBadRequest) {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "头像处理失败: "+err.Error())
 		return
 	}
-	log.Printf("[Handler.UploadAvatar] 头像文件上传成功, FileItem ID: %s", fileItem.ID)
 
-	// 6. 将文件的公共ID解码为数据库ID
-	dbFileID, _, err := idgen.DecodePublicID(fileItem.ID)
-	if err != nil {
-		log.Printf("[Handler.UploadAvatar] 解码文件公共ID '%s' 失败: %v", fileItem.ID, err)
-		response.Fail(c, http.StatusInternalServerError, "无效的文件ID")
-		return
+	// 5. 逐个上传到用户头像存储策略，文件名共用同一前缀便于识别
+	baseName := strconv.FormatInt(time.Now().UnixNano(), 10)
+	dbFileIDs := make([]uint, 0, len(images))
+	for i, img := range images {
+		filename := baseName + img.Ext
+		if i > 0 {
+			filename = baseName + "_" + strconv.Itoa(img.Size) + img.Ext
+		}
+		fileItem, err := h.fileSvc.UploadFileByPolicyFlag(c.Request.Context(), ownerID, bytes.NewReader(img.Data), constant.PolicyFlagUserAvatar, filename)
+		if err != nil {
+			log.Printf("[Handler.UploadAvatar] 文件 %s 上传失败: %v", filename, err)
+			response.Fail(c, http.StatusInternalServerError, "头像上传失败: "+err.Error())
+			return
+		}
+		dbFileID, _, err := idgen.DecodePublicID(fileItem.ID)
+		if err != nil {
+			log.Printf("[Handler.UploadAvatar] 解码文件公共ID '%s' 失败: %v", fileItem.ID, err)
+			response.Fail(c, http.StatusInternalServerError, "无效的文件ID")
+			return
+		}
+		dbFileIDs = append(dbFileIDs, dbFileID)
 	}
+	log.Printf("[Handler.UploadAvatar] 头像文件上传成功, 共 %d 个尺寸", len(dbFileIDs))
 
-	// 7. 为上传成功的头像创建永久直链
-	linksMap, err := h.directLinkSvc.GetOrCreateDirectLinks(c.Request.Context(), ownerID, []uint{dbFileID})
+	// 6. 为上传成功的头像创建永久直链
+	linksMap, err := h.directLinkSvc.GetOrCreateDirectLinks(c.Request.Context(), ownerID, dbFileIDs)
 	if err != nil {
-		log.Printf("[Handler.UploadAvatar] 为文件 %d 创建直链时发生错误: %v", dbFileID, err)
+		log.Printf("[Handler.UploadAvatar] 创建头像直链时发生错误: %v", err)
 		response.Fail(c, http.StatusInternalServerError, "创建头像直链失败: "+err.Error())
 		return
 	}
 
-	// 8. 从 map 中获取直链结果
-	linkResult, ok := linksMap[dbFileID]
-	if !ok || linkResult.URL == "" {
-		log.Printf("[Handler.UploadAvatar] directLinkSvc 未能返回文件 %d 的直链结果", dbFileID)
-		response.Fail(c, http.StatusInternalServerError, "获取头像直链URL失败")
-		return
+	// 7. 从 map 中获取直链结果
+	urls := make([]string, len(dbFileIDs))
+	for i, id := range dbFileIDs {
+		linkResult, ok := linksMap[id]
+		if !ok || linkResult.URL == "" {
+			log.Printf("[Handler.UploadAvatar] directLinkSvc 未能返回文件 %d 的直链结果", id)
+			response.Fail(c, http.StatusInternalServerError, "获取头像直链URL失败")
+			return
+		}
+		urls[i] = linkResult.URL
 	}
 
-	avatarURL := linkResult.URL
+	avatarURL := urls[0]
+	variants := make(map[string]string, len(images)-1)
+	for i := 1; i < len(images); i++ {
+		variants[strconv.Itoa(images[i].Size)] = urls[i]
+	}
 
-	// 若启用了图片样式处理，自动把 default_style 拼到 URL 后（如 "!avatar_128"）
+	// 若启用了图片样式处理，自动把 default_style 拼到主头像 URL 后（如 "!avatar_128"）
 	if h.styleSvc != nil {
 		if policy, perr := h.fileSvc.GetPolicyByFlag(c.Request.Context(), constant.PolicyFlagUserAvatar); perr == nil && policy != nil {
-			if suffix := h.styleSvc.ResolveUploadURLSuffix(policy, fileHeader.Filename); suffix != "" {
+			if suffix := h.styleSvc.ResolveUploadURLSuffix(policy, baseName+images[0].Ext); suffix != "" {
 				avatarURL = avatarURL + suffix
 				log.Printf("[Handler.UploadAvatar] 自动拼默认样式: suffix=%s", suffix)
 			}
@@ -833,8 +1095,8 @@ func (h *UserHandler) UploadAvatar(c *gin.Context) {
 	}
 	log.Printf("[Handler.UploadAvatar] 成功获取头像直链URL: %s", avatarURL)
 
-	// 9. 更新用户头像字段
-	err = h.userSvc.UpdateUserAvatar(c.Request.Context(), ownerID, avatarURL)
+	// 8. 更新用户头像字段
+	err = h.userSvc.UpdateUserAvatar(c.Request.Context(), ownerID, avatarURL, variants)
 	if err != nil {
 		log.Printf("[Handler.UploadAvatar] 更新用户头像字段失败: %v", err)
 		response.Fail(c, http.StatusInternalServerError, "更新用户头像失败: "+err.Error())
@@ -842,9 +1104,10 @@ func (h *UserHandler) UploadAvatar(c *gin.Context) {
 	}
 	log.Printf("[Handler.UploadAvatar] 用户头像更新成功, URL: %s", avatarURL)
 
-	// 10. 成功响应，返回头像URL
+	// 9. 成功响应，返回头像URL及各尺寸版本
 	response.Success(c, gin.H{
-		"url": avatarURL,
+		"url":      avatarURL,
+		"variants": variants,
 	}, "头像上传成功")
 }
 
@@ -24,7 +24,7 @@ func NewHandler() *Handler {
 
 // GetVersion 获取版本信息
 // @Summary      获取版本信息
-// @Description  获取应用的详细版本信息
+// @Description  获取应用的详细版本信息，包括版本号、commit、构建时间、Go 版本、构建标签与运行平台
 // @Tags         辅助工具
 // @Produce      json
 // @Success      200  {object}  object{code=int,message=string,data=object}  "版本信息"
@@ -0,0 +1,56 @@
+/*
+ * @Description: 天气代理 HTTP 处理器
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 12:00:00
+ * @LastEditTime: 2026-10-16 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package weather
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	weather_service "github.com/anzhiyu-c/anheyu-app/pkg/service/weather"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 封装了天气代理相关的 HTTP 处理器。
+type Handler struct {
+	svc *weather_service.Service
+}
+
+// NewHandler 是 Handler 的构造函数。
+func NewHandler(svc *weather_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Now
+// @Summary      获取实时天气
+// @Description  由服务端携带 API Key 请求和风天气或 OpenWeather，按位置缓存，供导航栏天气与时钟组件使用。返回的 server_time 可用于校准时钟。
+// @Tags         天气
+// @Produce      json
+// @Param        location query string true "位置，格式为「经度,纬度」，如 116.41,39.92"
+// @Success      200 {object} response.Response{data=weather_service.Response} "成功响应"
+// @Failure      400 {object} response.Response "位置参数无效"
+// @Failure      404 {object} response.Response "天气服务未配置"
+// @Failure      502 {object} response.Response "天气服务请求失败"
+// @Router       /public/weather/now [get]
+func (h *Handler) Now(c *gin.Context) {
+	result, err := h.svc.Now(c.Request.Context(), c.Query("location"))
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrBadRequest):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, weather_service.ErrNotConfigured):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		default:
+			response.Fail(c, http.StatusBadGateway, err.Error())
+		}
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	response.Success(c, result, "获取成功")
+}
@@ -0,0 +1,339 @@
+/*
+ * @Description: WebDAV 访问处理器 - 在 /dav/ 下以 WebDAV 协议开放用户的文件，供 Finder、资源管理器与 rclone 等客户端挂载
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 06:00:00
+ * @LastEditTime: 2026-10-18 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package webdav
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	xwebdav "golang.org/x/net/webdav"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	access_token_service "github.com/anzhiyu-c/anheyu-app/pkg/service/access_token"
+	auth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/loginguard"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	webdav_service "github.com/anzhiyu-c/anheyu-app/pkg/service/webdav"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+)
+
+// Prefix WebDAV 的挂载路径，不使用 /api 前缀，便于在客户端中直接填写
+const Prefix = "/dav"
+
+const (
+	realm = "anheyu WebDAV"
+	// credentialTTL 邮箱密码校验通过后的缓存时间，避免客户端的每个请求都进行一次密码哈希校验；
+	// 命中缓存时仍会核对用户状态与密码哈希，修改密码或封禁后立即失效
+	credentialTTL = 5 * time.Minute
+	// maxCachedCredentials 缓存的凭据数上限，超出后先清理已过期的条目
+	maxCachedCredentials = 1000
+)
+
+// Methods 需要注册到路由的 HTTP 方法
+var Methods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions,
+	"PROPFIND", "PROPPATCH", "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK",
+}
+
+// readMethods 不修改文件的方法，个人访问令牌拥有 read 或 content_write 权限即可调用，其余方法需要 file_upload 权限
+var readMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodOptions: true, "PROPFIND": true,
+}
+
+type cachedCredential struct {
+	userID       uint
+	passwordHash string
+	expiresAt    time.Time
+}
+
+// Handler 处理 /dav 下的所有 WebDAV 请求。
+// 支持 HTTP Basic 认证（邮箱 + 密码，或任意用户名 + 个人访问令牌）与 Bearer 个人访问令牌认证。
+type Handler struct {
+	svc            *webdav_service.Service
+	settingSvc     setting.SettingService
+	authSvc        auth_service.AuthService
+	accessTokenSvc *access_token_service.Service
+	// loginGuard 为 nil 时不限制邮箱密码的失败次数
+	loginGuard *loginguard.Service
+
+	// locks 所有用户共用的内存锁，通过 userLockSystem 按用户隔离
+	locks xwebdav.LockSystem
+
+	mu          sync.Mutex
+	credentials map[string]cachedCredential
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(
+	svc *webdav_service.Service,
+	settingSvc setting.SettingService,
+	authSvc auth_service.AuthService,
+	accessTokenSvc *access_token_service.Service,
+) *Handler {
+	return &Handler{
+		svc:            svc,
+		settingSvc:     settingSvc,
+		authSvc:        authSvc,
+		accessTokenSvc: accessTokenSvc,
+		locks:          xwebdav.NewMemLS(),
+		credentials:    make(map[string]cachedCredential),
+	}
+}
+
+// SetLoginGuard 注入登录防暴力破解服务，邮箱密码认证与登录接口共用失败计数与锁定
+func (h *Handler) SetLoginGuard(svc *loginguard.Service) {
+	h.loginGuard = svc
+}
+
+// Serve 处理 WebDAV 请求，未开启 WebDAV 时返回 404；站点只读模式下修改文件的请求返回 503
+func (h *Handler) Serve(c *gin.Context) {
+	if enabled, _ := strconv.ParseBool(h.settingSvc.Get(constant.KeyWebDAVEnable.String())); !enabled {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if !readMethods[c.Request.Method] && h.settingSvc.GetBool(constant.KeyReadOnlyModeEnable.String()) {
+		c.Header("Retry-After", "300")
+		c.AbortWithStatus(http.StatusServiceUnavailable)
+		return
+	}
+	userID, ok := h.authenticate(c)
+	if !ok {
+		return
+	}
+
+	fs := h.svc.ForUser(userID)
+	ls := h.lockSystem(userID)
+	if c.Request.Method == "COPY" && serveCopy(c, fs, ls) {
+		return
+	}
+	dav := &xwebdav.Handler{
+		Prefix:     Prefix,
+		FileSystem: fs,
+		LockSystem: ls,
+		Logger: func(r *http.Request, err error) {
+			if err != nil && !os.IsNotExist(err) {
+				log.Printf("[WebDAV] 用户 %d %s %s 失败: %v", userID, r.Method, r.URL.Path, err)
+			}
+		},
+	}
+	dav.ServeHTTP(c.Writer, c.Request)
+}
+
+// unauthorized 要求客户端重新提供凭据
+func unauthorized(c *gin.Context) {
+	c.Header("WWW-Authenticate", `Basic realm="`+realm+`", charset="UTF-8"`)
+	c.AbortWithStatus(http.StatusUnauthorized)
+}
+
+// authenticate 校验请求凭据，返回用户ID。失败时已写入响应
+func (h *Handler) authenticate(c *gin.Context) (uint, bool) {
+	header := c.GetHeader("Authorization")
+	if raw, ok := strings.CutPrefix(header, "Bearer "); ok {
+		return h.authenticateToken(c, raw)
+	}
+	email, password, ok := c.Request.BasicAuth()
+	if !ok || password == "" {
+		unauthorized(c)
+		return 0, false
+	}
+	if access_token_service.IsAccessToken(password) {
+		return h.authenticateToken(c, password)
+	}
+
+	key := credentialKey(email, password)
+	if cred, ok := h.cachedUser(key); ok {
+		if h.credentialValid(c, cred) {
+			return cred.userID, true
+		}
+		h.forgetUser(key)
+	}
+	attempt := loginguard.Attempt{
+		Email:     email,
+		IP:        util.GetRealClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+	}
+	if h.loginGuard != nil {
+		if err := h.loginGuard.Check(c.Request.Context(), attempt); err != nil {
+			locked(c, err)
+			return 0, false
+		}
+	}
+	user, err := h.authSvc.Login(c.Request.Context(), email, password)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth_service.ErrAuthServiceBusy):
+			c.AbortWithStatus(http.StatusServiceUnavailable)
+		case errors.Is(err, auth_service.ErrInvalidCredentials), errors.Is(err, auth_service.ErrPasswordIncorrect):
+			if h.loginGuard != nil {
+				if lockErr := h.loginGuard.RecordFailure(c.Request.Context(), attempt); lockErr != nil {
+					locked(c, lockErr)
+					return 0, false
+				}
+			}
+			unauthorized(c)
+		default:
+			unauthorized(c)
+		}
+		return 0, false
+	}
+	if h.loginGuard != nil {
+		h.loginGuard.RecordSuccess(c.Request.Context(), attempt)
+	}
+	h.cacheUser(key, user.ID, user.PasswordHash)
+	return user.ID, true
+}
+
+// locked 以 429 响应处于锁定期的认证请求，并通过 Retry-After 告知剩余秒数
+func locked(c *gin.Context, err error) {
+	var lockedErr *loginguard.LockedError
+	if errors.As(err, &lockedErr) {
+		c.Header("Retry-After", fmt.Sprintf("%d", int(lockedErr.RetryAfter.Seconds()+0.5)))
+	}
+	c.AbortWithStatus(http.StatusTooManyRequests)
+}
+
+// authenticateToken 校验个人访问令牌：只读请求需要 read 或 content_write 权限；
+// 写入、删除、移动等修改文件的请求需要 file_upload 权限，content_write 只能写入内容接口，不能修改文件
+func (h *Handler) authenticateToken(c *gin.Context, raw string) (uint, bool) {
+	if h.accessTokenSvc == nil {
+		unauthorized(c)
+		return 0, false
+	}
+	token, user, err := h.accessTokenSvc.Authenticate(c.Request.Context(), raw)
+	if err != nil {
+		if errors.Is(err, constant.ErrInvalidToken) {
+			unauthorized(c)
+		} else {
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+		return 0, false
+	}
+	allowed := token.HasScope(model.TokenScopeFileUpload)
+	if readMethods[c.Request.Method] {
+		allowed = token.AllowsAPI(http.MethodGet, false)
+	}
+	if !allowed {
+		c.AbortWithStatus(http.StatusForbidden)
+		return 0, false
+	}
+	return user.ID, true
+}
+
+// credentialKey 以哈希形式缓存凭据，内存中不保留明文密码
+func credentialKey(email, password string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email)) + "\x00" + password))
+	return hex.EncodeToString(sum[:])
+}
+
+func (h *Handler) cachedUser(key string) (cachedCredential, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	cred, ok := h.credentials[key]
+	if !ok || time.Now().After(cred.expiresAt) {
+		return cachedCredential{}, false
+	}
+	return cred, true
+}
+
+// credentialValid 核对缓存的凭据是否仍然有效：用户仍为正常状态且密码哈希未变
+func (h *Handler) credentialValid(c *gin.Context, cred cachedCredential) bool {
+	user, err := h.authSvc.GetUserByID(c.Request.Context(), cred.userID)
+	if err != nil {
+		return false
+	}
+	return user.Status == model.UserStatusActive && user.PasswordHash == cred.passwordHash
+}
+
+func (h *Handler) forgetUser(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.credentials, key)
+}
+
+func (h *Handler) cacheUser(key string, userID uint, passwordHash string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := time.Now()
+	if len(h.credentials) >= maxCachedCredentials {
+		for k, cred := range h.credentials {
+			if now.After(cred.expiresAt) {
+				delete(h.credentials, k)
+			}
+		}
+		if len(h.credentials) >= maxCachedCredentials {
+			h.credentials = make(map[string]cachedCredential)
+		}
+	}
+	h.credentials[key] = cachedCredential{userID: userID, passwordHash: passwordHash, expiresAt: now.Add(credentialTTL)}
+}
+
+// lockSystem 返回用户视角的锁，不同用户的同名路径与锁令牌互不影响
+func (h *Handler) lockSystem(userID uint) xwebdav.LockSystem {
+	return newUserLockSystem(h.locks, userID)
+}
+
+// serveCopy 尝试使用服务端复制处理 COPY 请求，复用物理实体而不是逐个文件下载再上传。
+// 返回 false 时交给 webdav 包处理：目标名称与源不同、Depth 为 0，或请求携带 If 条件需要校验锁令牌
+func serveCopy(c *gin.Context, fs *webdav_service.FileSystem, ls xwebdav.LockSystem) bool {
+	r := c.Request
+	if r.Header.Get("If") != "" {
+		return false
+	}
+	if depth := r.Header.Get("Depth"); depth != "" && depth != "infinity" {
+		return false
+	}
+	dest, err := url.Parse(r.Header.Get("Destination"))
+	if err != nil || (dest.Host != "" && dest.Host != r.Host) {
+		return false
+	}
+	src, ok := strings.CutPrefix(r.URL.Path, Prefix+"/")
+	if !ok {
+		return false
+	}
+	dst, ok := strings.CutPrefix(dest.Path, Prefix+"/")
+	if !ok {
+		return false
+	}
+
+	release, err := ls.Confirm(time.Now(), "", "/"+dst)
+	if err != nil {
+		if !errors.Is(err, xwebdav.ErrConfirmationFailed) {
+			return false
+		}
+		c.AbortWithStatus(http.StatusLocked)
+		return true
+	}
+	defer release()
+
+	status, err := fs.Copy(r.Context(), src, dst, r.Header.Get("Overwrite") != "F")
+	if errors.Is(err, webdav_service.ErrCopyUnsupported) {
+		return false
+	}
+	if err != nil && !os.IsNotExist(err) {
+		log.Printf("[WebDAV] 复制 %s 到 %s 失败: %v", src, dst, err)
+	}
+	c.Status(status)
+	if status != http.StatusNoContent {
+		c.Writer.WriteString(xwebdav.StatusText(status))
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package webdav
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+func TestServe_ReadOnlyModeRejectsWrites(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := NewHandler(nil, &fakeSettings{values: map[string]string{
+		constant.KeyWebDAVEnable.String():       "true",
+		constant.KeyReadOnlyModeEnable.String(): "true",
+	}}, nil, nil)
+	engine := gin.New()
+	for _, method := range Methods {
+		engine.Handle(method, Prefix+"/*path", h.Serve)
+	}
+
+	for _, method := range Methods {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, Prefix+"/docs/a.txt", nil))
+		if readMethods[method] {
+			// 只读请求继续进入认证流程，未携带凭据时返回 401
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("%s: 只读请求不应被只读模式拒绝, 实际状态码 %d", method, w.Code)
+			}
+			continue
+		}
+		if w.Code != http.StatusServiceUnavailable {
+			t.Errorf("%s: 只读模式下应返回 503, 实际状态码 %d", method, w.Code)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * @Description: WebDAV 锁 - 所有用户共用一个内存锁，按用户隔离路径与锁令牌
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 12:00:00
+ * @LastEditTime: 2026-10-18 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package webdav
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	xwebdav "golang.org/x/net/webdav"
+)
+
+// userLockSystem 把一个用户的锁请求映射到共享的 LockSystem：
+// 路径加上 /<用户ID> 前缀，使不同用户的同名路径互不影响；锁令牌加上 <用户ID>: 前缀，
+// 内存锁的令牌是递增数字，不加前缀时其他用户可以猜到并刷新或解除他人的锁。
+// 共享锁会在每次操作时清理过期的锁，不会随用户数量增长。
+type userLockSystem struct {
+	shared xwebdav.LockSystem
+	prefix string
+}
+
+func newUserLockSystem(shared xwebdav.LockSystem, userID uint) *userLockSystem {
+	return &userLockSystem{shared: shared, prefix: strconv.FormatUint(uint64(userID), 10)}
+}
+
+func (l *userLockSystem) name(name string) string {
+	if name == "" {
+		return ""
+	}
+	return "/" + l.prefix + "/" + strings.TrimPrefix(name, "/")
+}
+
+func (l *userLockSystem) root(name string) string {
+	root := strings.TrimPrefix(name, "/"+l.prefix)
+	if root == "" {
+		return "/"
+	}
+	return root
+}
+
+func (l *userLockSystem) token(token string) string {
+	return l.prefix + ":" + token
+}
+
+// innerToken 去掉令牌的用户前缀，令牌不属于该用户时返回 false
+func (l *userLockSystem) innerToken(token string) (string, bool) {
+	return strings.CutPrefix(token, l.prefix+":")
+}
+
+func (l *userLockSystem) Confirm(now time.Time, name0, name1 string, conditions ...xwebdav.Condition) (func(), error) {
+	mapped := make([]xwebdav.Condition, len(conditions))
+	for i, cond := range conditions {
+		mapped[i] = cond
+		if cond.Token == "" {
+			continue
+		}
+		if inner, ok := l.innerToken(cond.Token); ok {
+			mapped[i].Token = inner
+		} else {
+			// 其他用户的令牌不能匹配任何锁
+			mapped[i].Token = "-"
+		}
+	}
+	return l.shared.Confirm(now, l.name(name0), l.name(name1), mapped...)
+}
+
+func (l *userLockSystem) Create(now time.Time, details xwebdav.LockDetails) (string, error) {
+	details.Root = l.name(details.Root)
+	token, err := l.shared.Create(now, details)
+	if err != nil {
+		return "", err
+	}
+	return l.token(token), nil
+}
+
+func (l *userLockSystem) Refresh(now time.Time, token string, duration time.Duration) (xwebdav.LockDetails, error) {
+	inner, ok := l.innerToken(token)
+	if !ok {
+		return xwebdav.LockDetails{}, xwebdav.ErrNoSuchLock
+	}
+	details, err := l.shared.Refresh(now, inner, duration)
+	if err != nil {
+		return details, err
+	}
+	details.Root = l.root(details.Root)
+	return details, nil
+}
+
+func (l *userLockSystem) Unlock(now time.Time, token string) error {
+	inner, ok := l.innerToken(token)
+	if !ok {
+		return xwebdav.ErrNoSuchLock
+	}
+	return l.shared.Unlock(now, inner)
+}
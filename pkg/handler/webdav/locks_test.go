@@ -0,0 +1,57 @@
+package webdav
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	xwebdav "golang.org/x/net/webdav"
+)
+
+func TestUserLockSystem_IsolatesUsers(t *testing.T) {
+	shared := xwebdav.NewMemLS()
+	alice, bob := newUserLockSystem(shared, 1), newUserLockSystem(shared, 2)
+	now := time.Now()
+
+	token, err := alice.Create(now, xwebdav.LockDetails{Root: "/docs", Duration: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bob.Create(now, xwebdav.LockDetails{Root: "/docs", Duration: time.Minute}); err != nil {
+		t.Fatalf("不同用户的同名路径应可分别加锁: %v", err)
+	}
+	if _, err := alice.Create(now, xwebdav.LockDetails{Root: "/docs/a.txt", Duration: time.Minute}); !errors.Is(err, xwebdav.ErrLocked) {
+		t.Fatalf("同一用户已锁定的目录下不应再加锁, 实际为 %v", err)
+	}
+
+	if _, err := bob.Refresh(now, token, time.Minute); !errors.Is(err, xwebdav.ErrNoSuchLock) {
+		t.Errorf("其他用户不能刷新锁, 实际为 %v", err)
+	}
+	if err := bob.Unlock(now, token); !errors.Is(err, xwebdav.ErrNoSuchLock) {
+		t.Errorf("其他用户不能解除锁, 实际为 %v", err)
+	}
+	if _, err := bob.Confirm(now, "/docs", "", xwebdav.Condition{Token: token}); err == nil {
+		t.Error("其他用户的锁令牌不应通过校验")
+	}
+
+	details, err := alice.Refresh(now, token, time.Minute)
+	if err != nil || details.Root != "/docs" {
+		t.Fatalf("刷新锁应返回用户视角的路径: %+v, %v", details, err)
+	}
+	release, err := alice.Confirm(now, "/docs/a.txt", "", xwebdav.Condition{Token: token})
+	if err != nil {
+		t.Fatalf("持有令牌时应通过校验: %v", err)
+	}
+	release()
+	if err := alice.Unlock(now, token); err != nil {
+		t.Fatalf("解除锁失败: %v", err)
+	}
+
+	rootToken, err := alice.Create(now, xwebdav.LockDetails{Root: "/", Duration: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details, _ := alice.Refresh(now, rootToken, time.Minute); details.Root != "/" {
+		t.Errorf("根目录锁应返回 /, 实际为 %q", details.Root)
+	}
+}
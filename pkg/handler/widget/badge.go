@@ -0,0 +1,95 @@
+/*
+ * @Description: shields 风格的 SVG 徽章渲染
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 16:00:00
+ * @LastEditTime: 2026-10-15 16:00:00
+ * @LastEditors: 安知鱼
+ */
+package widget
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	badgeHeight       = 20
+	badgeHorizPadding = 6
+	defaultLabelColor = "#555"
+	defaultValueColor = "#4c71f1"
+)
+
+// namedColors 徽章支持的颜色别名，与 shields.io 保持一致
+var namedColors = map[string]string{
+	"brightgreen": "#4c1",
+	"green":       "#97ca00",
+	"yellow":      "#dfb317",
+	"orange":      "#fe7d37",
+	"red":         "#e05d44",
+	"blue":        "#007ec6",
+	"grey":        "#555",
+	"gray":        "#555",
+	"lightgrey":   "#9f9f9f",
+}
+
+var hexColorPattern = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// normalizeColor 仅接受颜色别名或十六进制颜色，防止注入任意 SVG 属性
+func normalizeColor(value, fallback string) string {
+	if c, ok := namedColors[value]; ok {
+		return c
+	}
+	if m := hexColorPattern.FindStringSubmatch(value); m != nil {
+		return "#" + m[1]
+	}
+	return fallback
+}
+
+// textWidth 粗略估算 11px Verdana 下的文本宽度，CJK 等宽字符按两倍计算
+func textWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		if r >= utf8.RuneSelf {
+			width += 12
+		} else {
+			width += 7
+		}
+	}
+	return width
+}
+
+// renderBadge 渲染一个左侧标签、右侧数值的扁平徽章
+func renderBadge(label, value, labelColor, valueColor string) string {
+	labelWidth := textWidth(label) + badgeHorizPadding*2
+	valueWidth := textWidth(value) + badgeHorizPadding*2
+	total := labelWidth + valueWidth
+	label = html.EscapeString(label)
+	value = html.EscapeString(value)
+
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="%[2]d" role="img" aria-label="%[3]s: %[4]s">`+
+		`<title>%[3]s: %[4]s</title>`+
+		`<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`+
+		`<clipPath id="r"><rect width="%[1]d" height="%[2]d" rx="3" fill="#fff"/></clipPath>`+
+		`<g clip-path="url(#r)"><rect width="%[5]d" height="%[2]d" fill="%[6]s"/><rect x="%[5]d" width="%[7]d" height="%[2]d" fill="%[8]s"/><rect width="%[1]d" height="%[2]d" fill="url(#s)"/></g>`+
+		`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`+
+		`<text x="%[9]d" y="14">%[3]s</text><text x="%[10]d" y="14">%[4]s</text></g></svg>`,
+		total, badgeHeight, label, value,
+		labelWidth, labelColor, valueWidth, valueColor,
+		labelWidth/2, labelWidth+valueWidth/2,
+	)
+}
+
+// formatCount 将较大的数字格式化为 1.2k / 3.4M 的紧凑形式
+func formatCount(n int) string {
+	switch {
+	case n >= 1_000_000:
+		return strconv.FormatFloat(float64(n)/1_000_000, 'f', 1, 64) + "M"
+	case n >= 10_000:
+		return strconv.FormatFloat(float64(n)/1_000, 'f', 1, 64) + "k"
+	default:
+		return strconv.Itoa(n)
+	}
+}
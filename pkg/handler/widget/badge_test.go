@@ -0,0 +1,43 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderBadge_EscapesText(t *testing.T) {
+	svg := renderBadge(`<script>alert(1)</script>`, "42", defaultLabelColor, defaultValueColor)
+	if strings.Contains(svg, "<script>") {
+		t.Fatalf("徽章文字未转义: %s", svg)
+	}
+}
+
+func TestNormalizeColor(t *testing.T) {
+	cases := map[string]string{
+		"blue":                  "#007ec6",
+		"fff":                   "#fff",
+		"#4c71f1":               "#4c71f1",
+		`red" onload="alert(1)`: defaultValueColor,
+		"url(javascript:alert)": defaultValueColor,
+		"":                      defaultValueColor,
+	}
+	for in, want := range cases {
+		if got := normalizeColor(in, defaultValueColor); got != want {
+			t.Errorf("normalizeColor(%q) = %q, 期望 %q", in, got, want)
+		}
+	}
+}
+
+func TestFormatCount(t *testing.T) {
+	cases := map[int]string{
+		0:         "0",
+		9999:      "9999",
+		12345:     "12.3k",
+		1_234_567: "1.2M",
+	}
+	for in, want := range cases {
+		if got := formatCount(in); got != want {
+			t.Errorf("formatCount(%d) = %q, 期望 %q", in, got, want)
+		}
+	}
+}
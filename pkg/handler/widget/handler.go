@@ -0,0 +1,173 @@
+/*
+ * @Description: 公开统计挂件处理器，提供可嵌入外部页面的 JSON 计数与 SVG 徽章
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 16:00:00
+ * @LastEditTime: 2026-10-15 16:00:00
+ * @LastEditors: 安知鱼
+ */
+package widget
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	widget_service "github.com/anzhiyu-c/anheyu-app/pkg/service/widget"
+	"github.com/gin-gonic/gin"
+)
+
+// widgetCacheControl 挂件数据允许浏览器和 CDN 缓存 5 分钟
+const widgetCacheControl = "public, max-age=300"
+
+// Handler 公开统计挂件处理器
+type Handler struct {
+	svc widget_service.Service
+}
+
+// NewHandler 创建公开统计挂件处理器
+func NewHandler(svc widget_service.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// GetSiteCounters 获取站点公开计数
+// @Summary      获取站点公开计数
+// @Description  返回文章总数、全站字数、运行天数，被站长禁用的计数不会返回
+// @Tags         统计挂件
+// @Produce      json
+// @Success      200  {object}  response.Response{data=widget_service.SiteCounters}  "获取成功"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /public/widget/site [get]
+func (h *Handler) GetSiteCounters(c *gin.Context) {
+	counters, err := h.svc.GetSiteCounters(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取站点计数失败: "+err.Error())
+		return
+	}
+	setCacheable(c)
+	response.Success(c, counters, "获取站点计数成功")
+}
+
+// GetArticleViews 获取文章浏览量
+// @Summary      获取文章浏览量
+// @Description  返回已发布文章的浏览量，不会增加浏览次数
+// @Tags         统计挂件
+// @Produce      json
+// @Param        id   path      string  true  "文章ID或slug"
+// @Success      200  {object}  response.Response{data=widget_service.ArticleViews}  "获取成功"
+// @Failure      404  {object}  response.Response  "文章不存在"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /public/widget/articles/{id}/views [get]
+func (h *Handler) GetArticleViews(c *gin.Context) {
+	views, err := h.svc.GetArticleViews(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "文章不存在")
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "获取文章浏览量失败: "+err.Error())
+		return
+	}
+	setCacheable(c)
+	response.Success(c, views, "获取文章浏览量成功")
+}
+
+// GetSiteBadge 获取站点计数徽章
+// @Summary      获取站点计数徽章
+// @Description  以 SVG 徽章形式返回站点计数，可直接嵌入 README。metric 可选 posts / words / days，可带 .svg 后缀
+// @Tags         统计挂件
+// @Produce      image/svg+xml
+// @Param        metric       path   string  true   "计数类型：posts / words / days"
+// @Param        label        query  string  false  "左侧标签文字"
+// @Param        color        query  string  false  "右侧颜色，支持颜色名或十六进制"
+// @Param        label_color  query  string  false  "左侧颜色，支持颜色名或十六进制"
+// @Success      200  {string}  string  "SVG 徽章"
+// @Failure      404  {object}  response.Response  "不支持的计数类型或该计数已被禁用"
+// @Router       /public/widget/badge/{metric} [get]
+func (h *Handler) GetSiteBadge(c *gin.Context) {
+	metric := strings.TrimSuffix(c.Param("metric"), ".svg")
+
+	counters, err := h.svc.GetSiteCounters(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取站点计数失败: "+err.Error())
+		return
+	}
+
+	var label string
+	var value *int
+	switch metric {
+	case "posts":
+		label, value = "posts", counters.TotalPosts
+	case "words":
+		label, value = "words", counters.TotalWords
+	case "days":
+		label, value = "running", counters.RunningDays
+	default:
+		response.Fail(c, http.StatusNotFound, "不支持的计数类型")
+		return
+	}
+	if value == nil {
+		response.Fail(c, http.StatusNotFound, "该计数未公开")
+		return
+	}
+
+	text := formatCount(*value)
+	if metric == "days" {
+		text = fmt.Sprintf("%d days", *value)
+	}
+	h.writeBadge(c, label, text)
+}
+
+// GetArticleViewsBadge 获取文章浏览量徽章
+// @Summary      获取文章浏览量徽章
+// @Description  以 SVG 徽章形式返回单篇文章的浏览量，不会增加浏览次数
+// @Tags         统计挂件
+// @Produce      image/svg+xml
+// @Param        id           path   string  true   "文章ID或slug，可带 .svg 后缀"
+// @Param        label        query  string  false  "左侧标签文字"
+// @Param        color        query  string  false  "右侧颜色，支持颜色名或十六进制"
+// @Param        label_color  query  string  false  "左侧颜色，支持颜色名或十六进制"
+// @Success      200  {string}  string  "SVG 徽章"
+// @Failure      404  {object}  response.Response  "文章不存在"
+// @Router       /public/widget/badge/articles/{id} [get]
+func (h *Handler) GetArticleViewsBadge(c *gin.Context) {
+	id := strings.TrimSuffix(c.Param("id"), ".svg")
+	views, err := h.svc.GetArticleViews(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "文章不存在")
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, "获取文章浏览量失败: "+err.Error())
+		return
+	}
+	h.writeBadge(c, "views", formatCount(views.Views))
+}
+
+func (h *Handler) writeBadge(c *gin.Context, defaultLabel, value string) {
+	label := strings.TrimSpace(c.Query("label"))
+	if label == "" {
+		label = defaultLabel
+	}
+	if len([]rune(label)) > 32 {
+		label = string([]rune(label)[:32])
+	}
+	svg := renderBadge(
+		label,
+		value,
+		normalizeColor(c.Query("label_color"), defaultLabelColor),
+		normalizeColor(c.Query("color"), defaultValueColor),
+	)
+
+	setCacheable(c)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, "image/svg+xml; charset=utf-8", []byte(svg))
+}
+
+// setCacheable 覆盖 /api 分组默认的禁止缓存响应头，允许浏览器与 CDN 缓存挂件数据
+func setCacheable(c *gin.Context) {
+	c.Header("Cache-Control", widgetCacheControl)
+	c.Header("Pragma", "")
+	c.Header("Expires", "")
+}
@@ -45,6 +45,11 @@ const (
 	EntityTypeTicketMessage   uint64 = 19 // 工单消息实体的类型标识
 	EntityTypeNotification    uint64 = 20 // 通知实体的类型标识
 	EntityTypeArticleHistory  uint64 = 21 // 文章历史版本实体的类型标识
+	EntityTypeArticleTemplate uint64 = 22 // 文章模板实体的类型标识
+	EntityTypeContentSnippet  uint64 = 23 // 内容片段实体的类型标识
+	EntityTypeMoment          uint64 = 24 // 说说实体的类型标识
+	EntityTypeArticleCollection uint64 = 25 // 文章合集实体的类型标识
+	EntityTypeChangelog         uint64 = 26 // 更新日志实体的类型标识
 )
 
 // GenerateRandomSeed 生成一个随机的 16 字节种子（返回 32 字符的十六进制字符串）
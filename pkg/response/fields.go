@@ -0,0 +1,96 @@
+/*
+ * @Description: 列表接口的字段筛选（?fields=id,title,cover_url）
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 16:00:00
+ * @LastEditTime: 2026-10-16 16:00:00
+ * @LastEditors: 安知鱼
+ */
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldsQueryKey 指定返回字段的查询参数名
+const FieldsQueryKey = "fields"
+
+// SuccessWithFields 成功响应，并按请求中的 ?fields= 参数裁剪列表项的字段，减少侧边栏、小组件等场景的响应体积。
+// listKey 为列表在 data 中的 JSON 键名，data 本身就是数组时传空字符串；id 字段始终保留。
+// 未传 fields 时与 Success 完全一致，不认识的字段名会被忽略。
+func SuccessWithFields(c *gin.Context, data interface{}, listKey string, message string) {
+	fields := ParseFields(c.Query(FieldsQueryKey))
+	if len(fields) == 0 {
+		Success(c, data, message)
+		return
+	}
+	filtered, err := SelectFields(data, listKey, fields)
+	if err != nil {
+		Fail(c, http.StatusInternalServerError, "筛选返回字段失败: "+err.Error())
+		return
+	}
+	Success(c, filtered, message)
+}
+
+// ParseFields 解析逗号分隔的字段列表，去除空白与重复项
+func ParseFields(raw string) []string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, f := range strings.Split(raw, ",") {
+		f = strings.TrimSpace(f)
+		if f != "" && !seen[f] {
+			seen[f] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// SelectFields 只保留列表项中指定的 JSON 字段，data 中列表以外的字段（如分页信息）原样保留
+func SelectFields(data interface{}, listKey string, fields []string) (json.RawMessage, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if listKey == "" {
+		return filterItems(raw, fields)
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, err
+	}
+	list, ok := object[listKey]
+	if !ok {
+		return raw, nil
+	}
+	if object[listKey], err = filterItems(list, fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(object)
+}
+
+func filterItems(list json.RawMessage, fields []string) (json.RawMessage, error) {
+	var items []map[string]json.RawMessage
+	if err := json.Unmarshal(list, &items); err != nil {
+		return nil, err
+	}
+	if items == nil {
+		return list, nil
+	}
+	keep := map[string]bool{"id": true}
+	for _, f := range fields {
+		keep[f] = true
+	}
+	for _, item := range items {
+		for key := range item {
+			if !keep[key] {
+				delete(item, key)
+			}
+		}
+	}
+	return json.Marshal(items)
+}
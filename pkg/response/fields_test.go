@@ -0,0 +1,72 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testItem struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	CoverURL string `json:"cover_url"`
+	Summary  string `json:"summary"`
+}
+
+type testList struct {
+	List  []testItem `json:"list"`
+	Total int        `json:"total"`
+}
+
+func TestSuccessWithFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	data := testList{List: []testItem{{ID: "a1", Title: "T", CoverURL: "c.png", Summary: "long"}}, Total: 1}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?fields=title,%20cover_url,unknown", nil)
+	SuccessWithFields(c, data, "list", "ok")
+
+	var body struct {
+		Data struct {
+			List  []map[string]interface{} `json:"list"`
+			Total int                      `json:"total"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Data.Total != 1 || len(body.Data.List) != 1 {
+		t.Fatalf("pagination fields should be kept: %s", w.Body.String())
+	}
+	item := body.Data.List[0]
+	if len(item) != 3 || item["id"] != "a1" || item["title"] != "T" || item["cover_url"] != "c.png" {
+		t.Errorf("unexpected item: %v", item)
+	}
+}
+
+func TestSelectFieldsOnArray(t *testing.T) {
+	out, err := SelectFields([]testItem{{ID: "a1", Title: "T", Summary: "s"}}, "", []string{"title"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `[{"id":"a1","title":"T"}]` {
+		t.Errorf("SelectFields() = %s", out)
+	}
+}
+
+func TestSuccessWithFieldsWithoutParam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	SuccessWithFields(c, testList{List: []testItem{{ID: "a1", Summary: "s"}}}, "list", "ok")
+
+	if !json.Valid(w.Body.Bytes()) || !strings.Contains(w.Body.String(), `"summary":"s"`) {
+		t.Errorf("response should be unchanged without fields: %s", w.Body.String())
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * @Description: 列表接口的分页响应头（X-Total-Count、X-Total-Pages 与 RFC 5988 Link）
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package response
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeaderTotalCount 列表总条数
+	HeaderTotalCount = "X-Total-Count"
+	// HeaderTotalPages 列表总页数
+	HeaderTotalPages = "X-Total-Pages"
+)
+
+// SetPaginationHeaders 为基于页码（?page=&pageSize=）的列表接口设置分页响应头，
+// 通用客户端无需解析响应体即可翻页。Link 中的地址沿用当前请求的路径与其余查询参数，
+// 只替换 page，以相对地址给出（RFC 8288 允许，按请求地址解析）。
+func SetPaginationHeaders(c *gin.Context, total int64, page, pageSize int) {
+	if page < 1 {
+		page = 1
+	}
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+	}
+	c.Header(HeaderTotalCount, strconv.FormatInt(total, 10))
+	c.Header(HeaderTotalPages, strconv.Itoa(totalPages))
+
+	var links []string
+	addLink := func(rel string, p int) {
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, pageURL(c, "page", strconv.Itoa(p)), rel))
+	}
+	if page < totalPages {
+		addLink("next", page+1)
+	}
+	if page > 1 {
+		addLink("prev", min(page-1, max(totalPages, 1)))
+	}
+	addLink("first", 1)
+	addLink("last", max(totalPages, 1))
+	c.Header("Link", strings.Join(links, ", "))
+}
+
+// SetCursorPaginationHeaders 为基于游标的列表接口设置 Link 响应头，只有 next 关系；
+// nextToken 为空表示没有更多数据，不设置响应头。
+func SetCursorPaginationHeaders(c *gin.Context, tokenKey, nextToken string) {
+	if nextToken == "" {
+		return
+	}
+	c.Header("Link", fmt.Sprintf(`<%s>; rel="next"`, pageURL(c, tokenKey, nextToken)))
+}
+
+// pageURL 返回把查询参数 key 替换为 value 后的当前请求地址
+func pageURL(c *gin.Context, key, value string) string {
+	query := c.Request.URL.Query()
+	query.Set(key, value)
+	return c.Request.URL.Path + "?" + query.Encode()
+}
@@ -0,0 +1,62 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSetPaginationHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/public/articles?page=2&pageSize=10&tag=go", nil)
+	SetPaginationHeaders(c, 35, 2, 10)
+
+	if got := w.Header().Get(HeaderTotalCount); got != "35" {
+		t.Errorf("X-Total-Count = %q", got)
+	}
+	if got := w.Header().Get(HeaderTotalPages); got != "4" {
+		t.Errorf("X-Total-Pages = %q", got)
+	}
+	want := `</api/public/articles?page=3&pageSize=10&tag=go>; rel="next", ` +
+		`</api/public/articles?page=1&pageSize=10&tag=go>; rel="prev", ` +
+		`</api/public/articles?page=1&pageSize=10&tag=go>; rel="first", ` +
+		`</api/public/articles?page=4&pageSize=10&tag=go>; rel="last"`
+	if got := w.Header().Get("Link"); got != want {
+		t.Errorf("Link = %s", got)
+	}
+}
+
+func TestSetPaginationHeadersEmptyList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/links", nil)
+	SetPaginationHeaders(c, 0, 1, 10)
+
+	if got := w.Header().Get(HeaderTotalPages); got != "0" {
+		t.Errorf("X-Total-Pages = %q", got)
+	}
+	if got := w.Header().Get("Link"); got != `</api/links?page=1>; rel="first", </api/links?page=1>; rel="last"` {
+		t.Errorf("Link = %s", got)
+	}
+}
+
+func TestSetCursorPaginationHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/files?uri=anzhiyu%3A%2F%2Fmy%2F", nil)
+
+	SetCursorPaginationHeaders(c, "next_token", "")
+	if got := w.Header().Get("Link"); got != "" {
+		t.Errorf("no Link header expected on the last page, got %s", got)
+	}
+	SetCursorPaginationHeaders(c, "next_token", "abc")
+	if got := w.Header().Get("Link"); got != `</api/files?next_token=abc&uri=anzhiyu%3A%2F%2Fmy%2F>; rel="next"` {
+		t.Errorf("Link = %s", got)
+	}
+}
@@ -0,0 +1,178 @@
+/*
+ * @Description: 个人访问令牌服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-17 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package access_token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	// tokenPrefix 令牌明文的固定前缀，便于在日志或代码仓库中识别泄露的令牌
+	tokenPrefix = "ahy_"
+	// displayPrefixLen 在界面上展示的令牌前缀长度
+	displayPrefixLen = 12
+	// touchInterval 最近使用时间的最小更新间隔，避免每个请求都写库
+	touchInterval = time.Minute
+)
+
+// defaultScopes 未指定权限范围时授予的默认权限
+var defaultScopes = []string{model.TokenScopeCreate, model.TokenScopeUpdate, model.TokenScopeMedia}
+
+// Service 封装了个人访问令牌的业务逻辑。
+type Service struct {
+	repo     repository.AccessTokenRepository
+	userRepo repository.UserRepository
+}
+
+// NewService 是访问令牌 Service 的构造函数。
+func NewService(repo repository.AccessTokenRepository, userRepo repository.UserRepository) *Service {
+	return &Service{repo: repo, userRepo: userRepo}
+}
+
+// Create 为用户生成新令牌，明文只在返回值中出现一次。
+func (s *Service) Create(ctx context.Context, userID uint, req *model.CreateAccessTokenRequest) (*model.CreatedAccessTokenResponse, error) {
+	raw, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("生成令牌失败: %w", err)
+	}
+
+	scopes := req.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+	token := &model.AccessToken{
+		UserID:      userID,
+		Name:        strings.TrimSpace(req.Name),
+		TokenPrefix: raw[:displayPrefixLen],
+		Scopes:      scopes,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	created, err := s.repo.Create(ctx, token, hashToken(raw))
+	if err != nil {
+		return nil, fmt.Errorf("保存令牌失败: %w", err)
+	}
+	log.Printf("[AccessToken] 用户 %d 创建了访问令牌 '%s' (%s...)", userID, created.Name, created.TokenPrefix)
+	return &model.CreatedAccessTokenResponse{AccessToken: created, Token: raw}, nil
+}
+
+// List 列出用户的全部令牌（不含明文）。
+func (s *Service) List(ctx context.Context, userID uint) ([]*model.AccessToken, error) {
+	return s.repo.ListByUserID(ctx, userID)
+}
+
+// Revoke 吊销用户的指定令牌。
+func (s *Service) Revoke(ctx context.Context, userID, id uint) error {
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// ListAll 分页列出全部用户的令牌并附带所属用户，userID 非 0 时只列出该用户的令牌，供管理员审查
+func (s *Service) ListAll(ctx context.Context, userID uint, page, pageSize int) ([]*model.AccessToken, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	tokens, total, err := s.repo.List(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	owners := make(map[uint]*model.AccessTokenOwner)
+	for _, token := range tokens {
+		owner, ok := owners[token.UserID]
+		if !ok {
+			if user, err := s.userRepo.FindByID(ctx, token.UserID); err == nil && user != nil {
+				publicID, _ := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+				owner = &model.AccessTokenOwner{ID: publicID, Nickname: user.Nickname, Email: user.Email}
+			}
+			owners[token.UserID] = owner
+		}
+		token.Owner = owner
+	}
+	return tokens, total, nil
+}
+
+// RevokeAny 吊销任意用户的令牌，供管理员在令牌泄露或滥用时使用。
+func (s *Service) RevokeAny(ctx context.Context, id uint) error {
+	if err := s.repo.DeleteByID(ctx, id); err != nil {
+		return err
+	}
+	log.Printf("[AccessToken] 管理员吊销了访问令牌 %d", id)
+	return nil
+}
+
+// IsAccessToken 判断 Bearer 令牌是否为个人访问令牌（而非 JWT）
+func IsAccessToken(raw string) bool {
+	return strings.HasPrefix(strings.TrimSpace(raw), tokenPrefix)
+}
+
+// Authenticate 校验令牌明文，返回令牌及其所属用户。
+// 令牌不存在、已过期或用户已被禁用时返回 constant.ErrInvalidToken。
+func (s *Service) Authenticate(ctx context.Context, raw string) (*model.AccessToken, *model.User, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, tokenPrefix) {
+		return nil, nil, constant.ErrInvalidToken
+	}
+
+	token, err := s.repo.FindByHash(ctx, hashToken(raw))
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil, nil, constant.ErrInvalidToken
+		}
+		return nil, nil, err
+	}
+	now := time.Now()
+	if token.ExpiresAt != nil && now.After(*token.ExpiresAt) {
+		return nil, nil, fmt.Errorf("%w: 令牌已过期", constant.ErrInvalidToken)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, token.UserID)
+	if err != nil || user == nil || user.Status != model.UserStatusActive {
+		return nil, nil, fmt.Errorf("%w: 令牌所属用户不可用", constant.ErrInvalidToken)
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > touchInterval {
+		if err := s.repo.TouchLastUsed(ctx, token.ID, now); err != nil {
+			log.Printf("[AccessToken] 更新令牌 %d 最近使用时间失败: %v", token.ID, err)
+		}
+	}
+	return token, user, nil
+}
+
+// generateToken 生成形如 ahy_xxxxxxxx 的随机令牌（256 位熵）
+func generateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken 计算令牌的 SHA-256 哈希。令牌本身是高熵随机值，无需加盐慢哈希。
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
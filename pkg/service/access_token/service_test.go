@@ -0,0 +1,193 @@
+package access_token
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakeTokenRepo struct {
+	tokens map[string]*model.AccessToken
+	nextID uint
+}
+
+func newFakeTokenRepo() *fakeTokenRepo {
+	return &fakeTokenRepo{tokens: map[string]*model.AccessToken{}}
+}
+
+func (f *fakeTokenRepo) Create(ctx context.Context, token *model.AccessToken, tokenHash string) (*model.AccessToken, error) {
+	f.nextID++
+	token.ID = f.nextID
+	token.CreatedAt = time.Now()
+	f.tokens[tokenHash] = token
+	return token, nil
+}
+
+func (f *fakeTokenRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.AccessToken, error) {
+	list, _, err := f.List(ctx, userID, 1, 100)
+	return list, err
+}
+
+func (f *fakeTokenRepo) FindByHash(ctx context.Context, tokenHash string) (*model.AccessToken, error) {
+	if t, ok := f.tokens[tokenHash]; ok {
+		return t, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (f *fakeTokenRepo) Delete(ctx context.Context, userID, id uint) error {
+	for hash, t := range f.tokens {
+		if t.ID == id && t.UserID == userID {
+			delete(f.tokens, hash)
+			return nil
+		}
+	}
+	return constant.ErrNotFound
+}
+
+func (f *fakeTokenRepo) List(ctx context.Context, userID uint, page, pageSize int) ([]*model.AccessToken, int, error) {
+	var list []*model.AccessToken
+	for _, t := range f.tokens {
+		if userID == 0 || t.UserID == userID {
+			list = append(list, t)
+		}
+	}
+	return list, len(list), nil
+}
+
+func (f *fakeTokenRepo) DeleteByID(ctx context.Context, id uint) error {
+	for hash, t := range f.tokens {
+		if t.ID == id {
+			delete(f.tokens, hash)
+			return nil
+		}
+	}
+	return constant.ErrNotFound
+}
+
+func (f *fakeTokenRepo) TouchLastUsed(ctx context.Context, id uint, at time.Time) error {
+	for _, t := range f.tokens {
+		if t.ID == id {
+			t.LastUsedAt = &at
+		}
+	}
+	return nil
+}
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users map[uint]*model.User
+}
+
+func (f *fakeUserRepo) FindByID(ctx context.Context, id uint) (*model.User, error) {
+	return f.users[id], nil
+}
+
+func TestAuthenticateAndRevoke(t *testing.T) {
+	repo := newFakeTokenRepo()
+	users := &fakeUserRepo{users: map[uint]*model.User{
+		1: {ID: 1, Nickname: "admin", Email: "admin@example.com", Status: model.UserStatusActive},
+		2: {ID: 2, Nickname: "writer", Email: "writer@example.com", Status: model.UserStatusActive},
+	}}
+	svc := NewService(repo, users)
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, 2, &model.CreateAccessTokenRequest{Name: "ci", Scopes: []string{model.TokenScopeRead}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsAccessToken(created.Token) || IsAccessToken("eyJhbGciOiJIUzI1NiJ9.e30.x") {
+		t.Fatal("应能区分访问令牌与 JWT")
+	}
+
+	token, user, err := svc.Authenticate(ctx, created.Token)
+	if err != nil || user.ID != 2 || token.LastUsedAt == nil {
+		t.Fatalf("校验令牌失败: %v %+v", err, token)
+	}
+	if _, _, err := svc.Authenticate(ctx, created.Token+"x"); !errors.Is(err, constant.ErrInvalidToken) {
+		t.Fatalf("错误的令牌应校验失败: %v", err)
+	}
+
+	tokens, total, err := svc.ListAll(ctx, 0, 0, 0)
+	if err != nil || total != 1 || tokens[0].Owner == nil || tokens[0].Owner.Email != "writer@example.com" {
+		t.Fatalf("管理员列表应附带所属用户: %v %+v", err, tokens)
+	}
+
+	if err := svc.RevokeAny(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := svc.Authenticate(ctx, created.Token); !errors.Is(err, constant.ErrInvalidToken) {
+		t.Fatalf("吊销后令牌应失效: %v", err)
+	}
+	if err := svc.RevokeAny(ctx, created.ID); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("重复吊销应返回不存在: %v", err)
+	}
+}
+
+func TestAllowsAPI(t *testing.T) {
+	cases := []struct {
+		scopes []string
+		method string
+		upload bool
+		want   bool
+	}{
+		{[]string{model.TokenScopeRead}, http.MethodGet, false, true},
+		{[]string{model.TokenScopeRead}, http.MethodPost, false, false},
+		{[]string{model.TokenScopeContentWrite}, http.MethodGet, false, true},
+		{[]string{model.TokenScopeContentWrite}, http.MethodPut, false, true},
+		{[]string{model.TokenScopeContentWrite}, http.MethodPost, true, false},
+		{[]string{model.TokenScopeFileUpload}, http.MethodPost, true, true},
+		{[]string{model.TokenScopeFileUpload}, http.MethodGet, false, false},
+		{[]string{model.TokenScopeCreate, model.TokenScopeMedia}, http.MethodGet, false, false},
+	}
+	for _, tc := range cases {
+		token := &model.AccessToken{Scopes: tc.scopes}
+		if got := token.AllowsAPI(tc.method, tc.upload); got != tc.want {
+			t.Errorf("%v %s upload=%v = %v, want %v", tc.scopes, tc.method, tc.upload, got, tc.want)
+		}
+	}
+}
+
+func TestIsTokenContentWriteRoute(t *testing.T) {
+	cases := map[string]bool{
+		"/api/articles":                    true,
+		"/api/articles/:id":                true,
+		"/api/post-tags/:id":               true,
+		"/api/articles-export":             false,
+		"/api/admin/users/:id":             false,
+		"/api/admin/user-groups":           false,
+		"/api/settings/update":             false,
+		"/api/admin/users/:id/impersonate": false,
+	}
+	for route, want := range cases {
+		if got := model.IsTokenContentWriteRoute(route); got != want {
+			t.Errorf("IsTokenContentWriteRoute(%q) = %v, want %v", route, got, want)
+		}
+	}
+}
+
+func TestIsTokenAdminRoute(t *testing.T) {
+	cases := map[string]struct{ admin, allowed bool }{
+		"/api/config/export":      {true, false},
+		"/api/config/backup/list": {true, false},
+		"/api/admin/users":        {true, false},
+		"/api/admin/media":        {true, true},
+		"/api/admin-panel":        {false, false},
+		"/api/configuration":      {false, false},
+		"/api/articles/:id":       {false, false},
+	}
+	for route, want := range cases {
+		if got := model.IsTokenAdminRoute(route); got != want.admin {
+			t.Errorf("IsTokenAdminRoute(%q) = %v, want %v", route, got, want.admin)
+		}
+		if got := model.IsTokenAdminReadRoute(route); got != want.allowed {
+			t.Errorf("IsTokenAdminReadRoute(%q) = %v, want %v", route, got, want.allowed)
+		}
+	}
+}
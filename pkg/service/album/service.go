@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
@@ -172,6 +173,7 @@ type albumService struct {
 	tagRepo           repository.TagRepository
 	albumCategoryRepo repository.AlbumCategoryRepository
 	settingSvc        setting.SettingService
+	outboundGuard     *ssrf.Guard
 }
 
 // NewAlbumService 是 albumService 的构造函数
@@ -186,6 +188,9 @@ func NewAlbumService(
 		tagRepo:           tagRepo,
 		albumCategoryRepo: albumCategoryRepo,
 		settingSvc:        settingSvc,
+		outboundGuard: ssrf.NewGuard(func() string {
+			return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+		}),
 	}
 }
 
@@ -458,9 +463,15 @@ type ImageMetadata struct {
 
 // fetchImageMetadata 获取图片元数据
 func (s *albumService) fetchImageMetadata(url string) (*ImageMetadata, error) {
+	// 图片地址由用户提供，请求前及建立连接时都需经过 SSRF 校验
+	if err := s.outboundGuard.CheckURL(url); err != nil {
+		return nil, fmt.Errorf("图片地址不允许访问: %w", err)
+	}
+
 	// 创建HTTP客户端，设置超时
 	client := &http.Client{
-		Timeout: 60 * time.Second,
+		Timeout:   60 * time.Second,
+		Transport: s.outboundGuard.Transport(),
 	}
 
 	// 创建请求
@@ -0,0 +1,79 @@
+/*
+ * @Description: 文章变更的审计日志快照
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 03:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package article
+
+import (
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+)
+
+// articleAuditSnapshot 审计日志中记录的文章字段。正文不写入审计日志，正文的变化可在历史版本中对比
+type articleAuditSnapshot struct {
+	Title        string     `json:"title"`
+	Abbrlink     string     `json:"abbrlink,omitempty"`
+	Status       string     `json:"status"`
+	OwnerID      uint       `json:"owner_id"`
+	Tags         []string   `json:"tags"`
+	Categories   []string   `json:"categories"`
+	CoverURL     string     `json:"cover_url,omitempty"`
+	TopImgURL    string     `json:"top_img_url,omitempty"`
+	Summaries    []string   `json:"summaries,omitempty"`
+	Keywords     string     `json:"keywords,omitempty"`
+	LinkURL      string     `json:"link_url,omitempty"`
+	ShowOnHome   bool       `json:"show_on_home"`
+	HomeSort     int        `json:"home_sort"`
+	PinSort      int        `json:"pin_sort"`
+	IsReprint    bool       `json:"is_reprint"`
+	IsTakedown   bool       `json:"is_takedown"`
+	IsDoc        bool       `json:"is_doc"`
+	ScheduledAt  *time.Time `json:"scheduled_at,omitempty"`
+	WordCount    int        `json:"word_count"`
+	ContentBytes int        `json:"content_bytes"`
+}
+
+func newArticleAuditSnapshot(a *model.Article) *articleAuditSnapshot {
+	if a == nil {
+		return nil
+	}
+	snapshot := &articleAuditSnapshot{
+		Title:        a.Title,
+		Abbrlink:     a.Abbrlink,
+		Status:       a.Status,
+		OwnerID:      a.OwnerID,
+		Tags:         make([]string, 0, len(a.PostTags)),
+		Categories:   make([]string, 0, len(a.PostCategories)),
+		CoverURL:     a.CoverURL,
+		TopImgURL:    a.TopImgURL,
+		Summaries:    a.Summaries,
+		Keywords:     a.Keywords,
+		LinkURL:      a.LinkURL,
+		ShowOnHome:   a.ShowOnHome,
+		HomeSort:     a.HomeSort,
+		PinSort:      a.PinSort,
+		IsReprint:    a.IsReprint,
+		IsTakedown:   a.IsTakedown,
+		IsDoc:        a.IsDoc,
+		ScheduledAt:  a.ScheduledAt,
+		WordCount:    a.WordCount,
+		ContentBytes: len(a.ContentMd),
+	}
+	for _, t := range a.PostTags {
+		snapshot.Tags = append(snapshot.Tags, t.Name)
+	}
+	for _, c := range a.PostCategories {
+		snapshot.Categories = append(snapshot.Categories, c.Name)
+	}
+	return snapshot
+}
+
+// SetAuditService 注入审计日志服务，记录后台对文章的创建、修改与删除
+func (s *serviceImpl) SetAuditService(svc *audit.Service) {
+	s.auditSvc = svc
+}
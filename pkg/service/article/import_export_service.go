@@ -44,6 +44,7 @@ type ExportArticleItem struct {
 	// 摘要和元数据
 	Summaries []string `json:"summaries,omitempty"`
 	Keywords  string   `json:"keywords,omitempty"`
+	LinkURL   string   `json:"link_url,omitempty"` // 链接文章指向的外部 URL
 
 	// 排序和显示
 	HomeSort int `json:"home_sort"`
@@ -79,6 +80,9 @@ type ImportArticleRequest struct {
 	OwnerID           uint              `json:"owner_id"`           // 导入文章的所有者ID
 	DefaultStatus     string            `json:"default_status"`     // 默认状态（如果数据中没有指定）
 	SkipExisting      bool              `json:"skip_existing"`      // 是否跳过已存在的文章
+
+	// SuppressNotifications 导入已发布文章时不通知订阅者，用于从其他博客系统迁移历史文章
+	SuppressNotifications bool `json:"-"`
 }
 
 // ImportResult 导入结果
@@ -113,53 +117,56 @@ func (s *serviceImpl) ExportArticles(ctx context.Context, articleIDs []string) (
 			continue
 		}
 
-		// 提取分类名称
-		categories := make([]string, 0, len(article.PostCategories))
-		for _, cat := range article.PostCategories {
-			categories = append(categories, cat.Name)
-		}
+		exportData.Articles = append(exportData.Articles, newExportArticleItem(article))
+	}
 
-		// 提取标签名称
-		tags := make([]string, 0, len(article.PostTags))
-		for _, tag := range article.PostTags {
-			tags = append(tags, tag.Name)
-		}
+	log.Printf("[导出文章] 成功导出 %d 篇文章", len(exportData.Articles))
+	return exportData, nil
+}
 
-		// 构建导出项
-		exportItem := ExportArticleItem{
-			Title:                article.Title,
-			ContentMd:            article.ContentMd,
-			ContentHTML:          article.ContentHTML,
-			Status:               article.Status,
-			CreatedAt:            article.CreatedAt,
-			UpdatedAt:            article.UpdatedAt,
-			CoverURL:             article.CoverURL,
-			TopImgURL:            article.TopImgURL,
-			Categories:           categories,
-			Tags:                 tags,
-			Summaries:            article.Summaries,
-			Keywords:             article.Keywords,
-			HomeSort:             article.HomeSort,
-			PinSort:              article.PinSort,
-			Copyright:            article.Copyright,
-			IsReprint:            article.IsReprint,
-			CopyrightAuthor:      article.CopyrightAuthor,
-			CopyrightAuthorHref:  article.CopyrightAuthorHref,
-			CopyrightURL:         article.CopyrightURL,
-			PrimaryColor:         article.PrimaryColor,
-			IsPrimaryColorManual: article.IsPrimaryColorManual,
-			ViewCount:            article.ViewCount,
-			WordCount:            article.WordCount,
-			ReadingTime:          article.ReadingTime,
-			IPLocation:           article.IPLocation,
-			Abbrlink:             article.Abbrlink,
-		}
+// newExportArticleItem 将文章转换为导出项
+func newExportArticleItem(article *model.Article) ExportArticleItem {
+	// 提取分类名称
+	categories := make([]string, 0, len(article.PostCategories))
+	for _, cat := range article.PostCategories {
+		categories = append(categories, cat.Name)
+	}
 
-		exportData.Articles = append(exportData.Articles, exportItem)
+	// 提取标签名称
+	tags := make([]string, 0, len(article.PostTags))
+	for _, tag := range article.PostTags {
+		tags = append(tags, tag.Name)
 	}
 
-	log.Printf("[导出文章] 成功导出 %d 篇文章", len(exportData.Articles))
-	return exportData, nil
+	return ExportArticleItem{
+		Title:                article.Title,
+		ContentMd:            article.ContentMd,
+		ContentHTML:          article.ContentHTML,
+		Status:               article.Status,
+		CreatedAt:            article.CreatedAt,
+		UpdatedAt:            article.UpdatedAt,
+		CoverURL:             article.CoverURL,
+		TopImgURL:            article.TopImgURL,
+		Categories:           categories,
+		Tags:                 tags,
+		Summaries:            article.Summaries,
+		Keywords:             article.Keywords,
+		LinkURL:              article.LinkURL,
+		HomeSort:             article.HomeSort,
+		PinSort:              article.PinSort,
+		Copyright:            article.Copyright,
+		IsReprint:            article.IsReprint,
+		CopyrightAuthor:      article.CopyrightAuthor,
+		CopyrightAuthorHref:  article.CopyrightAuthorHref,
+		CopyrightURL:         article.CopyrightURL,
+		PrimaryColor:         article.PrimaryColor,
+		IsPrimaryColorManual: article.IsPrimaryColorManual,
+		ViewCount:            article.ViewCount,
+		WordCount:            article.WordCount,
+		ReadingTime:          article.ReadingTime,
+		IPLocation:           article.IPLocation,
+		Abbrlink:             article.Abbrlink,
+	}
 }
 
 // ExportArticlesToZip 导出文章为 ZIP 压缩包
@@ -227,7 +234,8 @@ func (s *serviceImpl) ExportArticlesToZip(ctx context.Context, articleIDs []stri
 
 ## 导入说明
 
-使用本系统的导入功能，选择 articles.json 文件即可导入所有文章。
+使用本系统的导入功能，选择本压缩包或 articles.json 文件即可导入所有文章。
+也可以单独导入 markdown/ 目录下的文件，或将它们重新打包为 ZIP 批量导入。
 `,
 			exportData.ExportAt.Format("2006-01-02 15:04:05"),
 			exportData.Version,
@@ -394,11 +402,25 @@ func (s *serviceImpl) ImportArticles(ctx context.Context, req *ImportArticleRequ
 			status = "DRAFT" // 默认为草稿
 		}
 
+		// Markdown 导入没有 HTML，使用解析服务渲染
+		contentHTML := articleData.ContentHTML
+		if contentHTML == "" && articleData.ContentMd != "" && s.parserSvc != nil {
+			rendered, err := s.parserSvc.ToHTML(ctx, articleData.ContentMd)
+			if err != nil {
+				errMsg := fmt.Sprintf("渲染文章 '%s' 失败: %v", articleData.Title, err)
+				log.Printf("[导入文章] %s", errMsg)
+				result.Errors = append(result.Errors, errMsg)
+				result.FailedCount++
+				continue
+			}
+			contentHTML = rendered
+		}
+
 		// 创建文章请求
 		createReq := &model.CreateArticleRequest{
 			Title:                articleData.Title,
 			ContentMd:            articleData.ContentMd,
-			ContentHTML:          articleData.ContentHTML,
+			ContentHTML:          contentHTML,
 			Status:               status,
 			PostCategoryIDs:      categoryIDs,
 			PostTagIDs:           tagIDs,
@@ -417,6 +439,9 @@ func (s *serviceImpl) ImportArticles(ctx context.Context, req *ImportArticleRequ
 			PrimaryColor:         articleData.PrimaryColor,
 			Abbrlink:             articleData.Abbrlink,
 			Keywords:             articleData.Keywords,
+			LinkURL:              articleData.LinkURL,
+
+			SuppressNotifications: req.SuppressNotifications,
 		}
 
 		// 如果导入数据包含自定义时间，使用它们
@@ -487,8 +512,16 @@ func (s *serviceImpl) ImportArticlesFromZip(ctx context.Context, zipData []byte,
 		}
 	}
 
+	// 没有 articles.json 时按 Markdown 批量导入
 	if jsonData == nil {
-		return nil, fmt.Errorf("ZIP 文件中未找到 articles.json")
+		files, err := readZipMarkdownFiles(zipReader)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("ZIP 文件中未找到 articles.json 或 Markdown 文件")
+		}
+		return s.ImportArticlesFromMarkdown(ctx, files, req)
 	}
 
 	return s.ImportArticlesFromJSON(ctx, jsonData, req)
@@ -520,47 +553,3 @@ func sanitizeFilename(name string) string {
 
 	return name
 }
-
-// buildMarkdownWithFrontmatter 构建带有 frontmatter 的 Markdown 内容
-func buildMarkdownWithFrontmatter(article ExportArticleItem) string {
-	var buf bytes.Buffer
-
-	// 写入 YAML frontmatter
-	buf.WriteString("---\n")
-	buf.WriteString(fmt.Sprintf("title: \"%s\"\n", strings.ReplaceAll(article.Title, "\"", "\\\"")))
-	buf.WriteString(fmt.Sprintf("date: %s\n", article.CreatedAt.Format("2006-01-02 15:04:05")))
-	buf.WriteString(fmt.Sprintf("updated: %s\n", article.UpdatedAt.Format("2006-01-02 15:04:05")))
-
-	if len(article.Tags) > 0 {
-		buf.WriteString("tags:\n")
-		for _, tag := range article.Tags {
-			buf.WriteString(fmt.Sprintf("  - %s\n", tag))
-		}
-	}
-
-	if len(article.Categories) > 0 {
-		buf.WriteString("categories:\n")
-		for _, cat := range article.Categories {
-			buf.WriteString(fmt.Sprintf("  - %s\n", cat))
-		}
-	}
-
-	if article.CoverURL != "" {
-		buf.WriteString(fmt.Sprintf("cover: %s\n", article.CoverURL))
-	}
-
-	if article.Abbrlink != "" {
-		buf.WriteString(fmt.Sprintf("abbrlink: %s\n", article.Abbrlink))
-	}
-
-	if article.Keywords != "" {
-		buf.WriteString(fmt.Sprintf("keywords: %s\n", article.Keywords))
-	}
-
-	buf.WriteString("---\n\n")
-
-	// 写入 Markdown 内容
-	buf.WriteString(article.ContentMd)
-
-	return buf.String()
-}
@@ -0,0 +1,404 @@
+/*
+ * @Description: 文章关键词自动提取与站内链接推荐（基于全站已发布文章的 TF-IDF）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	maxAutoKeywords     = 8                // 自动填充的关键词数量上限
+	maxLinkSuggestions  = 5                // 返回的站内链接推荐数量上限
+	minLinkSimilarity   = 0.05             // 低于该相似度的文章不推荐
+	titleTermWeight     = 3                // 标题中的词按出现 3 次计
+	keywordCorpusTTL    = 10 * time.Minute // 语料统计缓存时长，文章变更时会提前失效
+	keywordCorpusPageSz = 200              // 构建语料时分页读取文章的大小
+)
+
+var (
+	reMdCodeBlock  = regexp.MustCompile("(?s)```.*?```")
+	reMdInlineCode = regexp.MustCompile("`[^`]*`")
+	reMdLinkTarget = regexp.MustCompile(`\]\([^)]*\)`)
+	reMdURL        = regexp.MustCompile(`https?://\S+`)
+	reMdHTMLTag    = regexp.MustCompile(`<[^>]+>`)
+	reEnglishTerm  = regexp.MustCompile(`[a-z][a-z0-9+#]*(?:[-.][a-z0-9+#]+)*`)
+)
+
+// stopRunes 含有这些字的中文双字词几乎都是虚词组合，不作为候选关键词
+var stopRunes = map[rune]struct{}{}
+
+// stopWords 常见英文停用词
+var stopWords = map[string]struct{}{}
+
+func init() {
+	for _, r := range "的了是在和与或也就都而及这那个们我你他她它着把被让给对从到为以之其有没不很还又一些么吗呢吧啊上下中" {
+		stopRunes[r] = struct{}{}
+	}
+	for _, w := range strings.Fields("the and for are but not you all any can had her was one our out has have him his how its may new now see two way who did get let say she too use that this with from they will would there their what about which when make like time just know take into than them only some could other then these also more been were your such here should does") {
+		stopWords[w] = struct{}{}
+	}
+}
+
+// EditorAssistRequest 定义了编辑器辅助接口的请求参数
+type EditorAssistRequest struct {
+	ArticleID string `json:"article_id"` // 正在编辑的文章ID（新文章留空），推荐时排除自身
+	Title     string `json:"title"`
+	ContentMd string `json:"content_md"`
+}
+
+// KeywordScore 是一个候选关键词及其 TF-IDF 得分
+type KeywordScore struct {
+	Keyword string  `json:"keyword"`
+	Score   float64 `json:"score"`
+}
+
+// LinkSuggestion 是一条站内链接推荐
+type LinkSuggestion struct {
+	ID              string   `json:"id"`
+	Title           string   `json:"title"`
+	URL             string   `json:"url"`
+	Score           float64  `json:"score"`
+	MatchedKeywords []string `json:"matched_keywords"`
+}
+
+// EditorAssistResult 是编辑器辅助接口的返回结果
+type EditorAssistResult struct {
+	Keywords    []KeywordScore   `json:"keywords"`
+	Suggestions []LinkSuggestion `json:"suggestions"`
+}
+
+// corpusDoc 是语料中一篇已发布文章的词频统计
+type corpusDoc struct {
+	id    string
+	title string
+	url   string
+	terms map[string]int
+}
+
+// keywordCorpus 缓存全站已发布文章的词频与文档频率，供 TF-IDF 计算使用
+type keywordCorpus struct {
+	mu      sync.Mutex
+	docs    []*corpusDoc
+	df      map[string]int
+	builtAt time.Time
+}
+
+// invalidate 使语料缓存失效，下次使用时重新构建
+func (c *keywordCorpus) invalidate() {
+	c.mu.Lock()
+	c.builtAt = time.Time{}
+	c.mu.Unlock()
+}
+
+// load 返回当前语料，过期时通过 list 分页重新读取全部已发布文章
+func (c *keywordCorpus) load(list func(page int) ([]*model.Article, error)) ([]*corpusDoc, map[string]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.builtAt.IsZero() && time.Since(c.builtAt) < keywordCorpusTTL {
+		return c.docs, c.df, nil
+	}
+
+	var docs []*corpusDoc
+	df := make(map[string]int)
+	for page := 1; ; page++ {
+		articles, err := list(page)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, a := range articles {
+			doc := &corpusDoc{
+				id:    a.ID,
+				title: a.Title,
+				url:   articleURL(a),
+				terms: termFrequencies(a.Title, a.ContentMd),
+			}
+			for term := range doc.terms {
+				df[term]++
+			}
+			docs = append(docs, doc)
+		}
+		if len(articles) < keywordCorpusPageSz {
+			break
+		}
+	}
+
+	c.docs, c.df, c.builtAt = docs, df, time.Now()
+	return docs, df, nil
+}
+
+// articleURL 返回文章的站内访问路径，优先使用自定义链接
+func articleURL(a *model.Article) string {
+	if a.Abbrlink != "" {
+		return "/posts/" + a.Abbrlink
+	}
+	return "/posts/" + a.ID
+}
+
+// EditorAssist 为正在编辑的文章提取候选关键词，并推荐可以互相引用的站内已发布文章
+func (s *serviceImpl) EditorAssist(ctx context.Context, req *EditorAssistRequest) (*EditorAssistResult, error) {
+	docs, df, err := s.loadKeywordCorpus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return analyzeArticle(req, docs, df), nil
+}
+
+// autoKeywords 在关键词为空且功能开启时，返回按 TF-IDF 提取的关键词（逗号分隔）；失败时返回空字符串
+func (s *serviceImpl) autoKeywords(ctx context.Context, articleID, title, contentMd string) string {
+	if s.settingSvc != nil && !s.settingSvc.GetBool(constant.KeyPostAutoKeywordsEnable.String()) {
+		return ""
+	}
+	docs, df, err := s.loadKeywordCorpus(ctx)
+	if err != nil {
+		log.Printf("[关键词提取] 构建语料失败，跳过自动提取: %v", err)
+		return ""
+	}
+	result := analyzeArticle(&EditorAssistRequest{ArticleID: articleID, Title: title, ContentMd: contentMd}, docs, df)
+	keywords := make([]string, len(result.Keywords))
+	for i, k := range result.Keywords {
+		keywords[i] = k.Keyword
+	}
+	return strings.Join(keywords, ",")
+}
+
+// fillAutoKeywordsForUpdate 更新后的关键词仍为空时，按更新后的标题与正文自动提取关键词
+func (s *serviceImpl) fillAutoKeywordsForUpdate(ctx context.Context, publicID string, req *model.UpdateArticleRequest) {
+	if req.Keywords != nil && strings.TrimSpace(*req.Keywords) != "" {
+		return
+	}
+	existing, err := s.repo.GetByID(ctx, publicID)
+	if err != nil {
+		return
+	}
+	if req.Keywords == nil && strings.TrimSpace(existing.Keywords) != "" {
+		return
+	}
+	title, content := existing.Title, existing.ContentMd
+	if req.Title != nil {
+		title = *req.Title
+	}
+	if req.ContentMd != nil {
+		content = *req.ContentMd
+	}
+	if keywords := s.autoKeywords(ctx, publicID, title, content); keywords != "" {
+		req.Keywords = &keywords
+	}
+}
+
+func (s *serviceImpl) loadKeywordCorpus(ctx context.Context) ([]*corpusDoc, map[string]int, error) {
+	docs, df, err := s.corpus.load(func(page int) ([]*model.Article, error) {
+		articles, _, err := s.repo.List(ctx, &model.ListArticlesOptions{
+			Page:        page,
+			PageSize:    keywordCorpusPageSz,
+			Status:      "PUBLISHED",
+			WithContent: true,
+		})
+		return articles, err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取文章语料失败: %w", err)
+	}
+	return docs, df, nil
+}
+
+// analyzeArticle 计算文章的 TF-IDF 向量，取得分最高的词作为关键词，
+// 并以余弦相似度排序语料中的其他文章作为站内链接推荐
+func analyzeArticle(req *EditorAssistRequest, docs []*corpusDoc, df map[string]int) *EditorAssistResult {
+	result := &EditorAssistResult{Keywords: []KeywordScore{}, Suggestions: []LinkSuggestion{}}
+	terms := termFrequencies(req.Title, req.ContentMd)
+	if len(terms) == 0 {
+		return result
+	}
+
+	// 语料中已包含正在编辑的文章时，将其从文档数与文档频率中扣除
+	n := len(docs)
+	var self *corpusDoc
+	for _, doc := range docs {
+		if req.ArticleID != "" && doc.id == req.ArticleID {
+			self = doc
+			n--
+			break
+		}
+	}
+	idf := func(term string) float64 {
+		count := df[term]
+		if self != nil && self.terms[term] > 0 {
+			count--
+		}
+		return math.Log(float64(n+1)/float64(count+1)) + 1
+	}
+
+	vector := tfidfVector(terms, idf)
+	scored := make([]KeywordScore, 0, len(vector))
+	for term, score := range vector {
+		// 正文中只出现一次的词通常是偶然用词（标题中的词已按权重计数，不受影响）
+		if terms[term] < 2 {
+			continue
+		}
+		scored = append(scored, KeywordScore{Keyword: term, Score: round4(score)})
+	}
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].Score != scored[j].Score {
+			return scored[i].Score > scored[j].Score
+		}
+		return scored[i].Keyword < scored[j].Keyword
+	})
+	result.Keywords = pickKeywords(scored, maxAutoKeywords)
+
+	topTerms := make(map[string]struct{}, len(scored))
+	for i, k := range scored {
+		if i >= maxAutoKeywords*3 {
+			break
+		}
+		topTerms[k.Keyword] = struct{}{}
+	}
+
+	for _, doc := range docs {
+		if doc == self {
+			continue
+		}
+		other := tfidfVector(doc.terms, idf)
+		similarity := cosine(vector, other)
+		if similarity < minLinkSimilarity {
+			continue
+		}
+		var matched []string
+		for term := range topTerms {
+			if doc.terms[term] > 0 {
+				matched = append(matched, term)
+			}
+		}
+		sort.Slice(matched, func(i, j int) bool { return vector[matched[i]] > vector[matched[j]] })
+		if len(matched) > 5 {
+			matched = matched[:5]
+		}
+		result.Suggestions = append(result.Suggestions, LinkSuggestion{
+			ID:              doc.id,
+			Title:           doc.title,
+			URL:             doc.url,
+			Score:           round4(similarity),
+			MatchedKeywords: matched,
+		})
+	}
+	sort.Slice(result.Suggestions, func(i, j int) bool { return result.Suggestions[i].Score > result.Suggestions[j].Score })
+	if len(result.Suggestions) > maxLinkSuggestions {
+		result.Suggestions = result.Suggestions[:maxLinkSuggestions]
+	}
+	return result
+}
+
+// pickKeywords 按得分取前 limit 个关键词，跳过被更高分关键词包含的词（如已选"数据库"时跳过"数据"）
+func pickKeywords(scored []KeywordScore, limit int) []KeywordScore {
+	picked := make([]KeywordScore, 0, limit)
+	for _, candidate := range scored {
+		if len(picked) >= limit {
+			break
+		}
+		overlapped := false
+		for _, p := range picked {
+			if strings.Contains(p.Keyword, candidate.Keyword) || strings.Contains(candidate.Keyword, p.Keyword) {
+				overlapped = true
+				break
+			}
+		}
+		if !overlapped {
+			picked = append(picked, candidate)
+		}
+	}
+	return picked
+}
+
+func tfidfVector(terms map[string]int, idf func(string) float64) map[string]float64 {
+	total := 0
+	for _, count := range terms {
+		total += count
+	}
+	vector := make(map[string]float64, len(terms))
+	for term, count := range terms {
+		vector[term] = float64(count) / float64(total) * idf(term)
+	}
+	return vector
+}
+
+func cosine(a, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for term, va := range a {
+		normA += va * va
+		if vb, ok := b[term]; ok {
+			dot += va * vb
+		}
+	}
+	for _, vb := range b {
+		normB += vb * vb
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+func round4(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
+
+// termFrequencies 统计标题与 Markdown 正文的词频：英文按单词切分，中文按连续汉字的双字组合切分。
+// 代码块、链接地址和 HTML 标签不参与统计，标题中的词按 titleTermWeight 倍计数。
+func termFrequencies(title, contentMd string) map[string]int {
+	terms := make(map[string]int)
+	addTerms(terms, title, titleTermWeight)
+	addTerms(terms, stripMarkdown(contentMd), 1)
+	return terms
+}
+
+func stripMarkdown(md string) string {
+	md = reMdCodeBlock.ReplaceAllString(md, " ")
+	md = reMdInlineCode.ReplaceAllString(md, " ")
+	md = reMdLinkTarget.ReplaceAllString(md, "] ")
+	md = reMdURL.ReplaceAllString(md, " ")
+	return reMdHTMLTag.ReplaceAllString(md, " ")
+}
+
+func addTerms(terms map[string]int, text string, weight int) {
+	lower := strings.ToLower(text)
+	for _, word := range reEnglishTerm.FindAllString(lower, -1) {
+		if len(word) < 3 {
+			continue
+		}
+		if _, stop := stopWords[word]; stop {
+			continue
+		}
+		terms[word] += weight
+	}
+
+	runes := []rune(lower)
+	for i := 0; i+1 < len(runes); i++ {
+		a, b := runes[i], runes[i+1]
+		if !unicode.Is(unicode.Han, a) || !unicode.Is(unicode.Han, b) {
+			continue
+		}
+		if _, stop := stopRunes[a]; stop {
+			continue
+		}
+		if _, stop := stopRunes[b]; stop {
+			continue
+		}
+		terms[string([]rune{a, b})] += weight
+	}
+}
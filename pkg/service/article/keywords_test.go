@@ -0,0 +1,70 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+func buildTestCorpus(t *testing.T, articles []*model.Article) ([]*corpusDoc, map[string]int) {
+	t.Helper()
+	corpus := &keywordCorpus{}
+	docs, df, err := corpus.load(func(page int) ([]*model.Article, error) {
+		if page > 1 {
+			return nil, nil
+		}
+		return articles, nil
+	})
+	if err != nil {
+		t.Fatalf("构建语料失败: %v", err)
+	}
+	return docs, df
+}
+
+func TestAnalyzeArticle_ExtractsDistinctiveKeywords(t *testing.T) {
+	docs, df := buildTestCorpus(t, []*model.Article{
+		{ID: "a1", Title: "Redis 缓存实践", Abbrlink: "redis-cache", ContentMd: "使用 redis 做缓存，redis 的过期策略与缓存穿透。我们的博客。"},
+		{ID: "a2", Title: "旅行日记", ContentMd: "这次旅行去了海边，旅行很开心。我们的博客。"},
+		{ID: "a3", Title: "摄影入门", ContentMd: "摄影需要了解光圈和快门，摄影是一门艺术。我们的博客。"},
+	})
+
+	result := analyzeArticle(&EditorAssistRequest{
+		Title:     "Redis 缓存雪崩",
+		ContentMd: "缓存雪崩是指大量缓存同时过期。redis 集群可以缓解缓存雪崩。我们的博客。\n```go\nfunc main() {}\n```",
+	}, docs, df)
+
+	if len(result.Keywords) == 0 || len(result.Keywords) > maxAutoKeywords {
+		t.Fatalf("关键词数量错误: %+v", result.Keywords)
+	}
+	found := map[string]bool{}
+	for _, k := range result.Keywords {
+		found[k.Keyword] = true
+	}
+	if !found["redis"] || !found["雪崩"] {
+		t.Errorf("应提取出文章特有的关键词: %+v", result.Keywords)
+	}
+	if found["博客"] || found["func"] {
+		t.Errorf("全站通用词或代码块内容不应成为关键词: %+v", result.Keywords)
+	}
+
+	if len(result.Suggestions) == 0 || result.Suggestions[0].ID != "a1" || result.Suggestions[0].URL != "/posts/redis-cache" {
+		t.Errorf("应优先推荐内容相关的文章: %+v", result.Suggestions)
+	}
+}
+
+func TestAnalyzeArticle_ExcludesSelfFromSuggestions(t *testing.T) {
+	docs, df := buildTestCorpus(t, []*model.Article{
+		{ID: "a1", Title: "Go 并发", ContentMd: "goroutine 与 channel，goroutine 调度。"},
+		{ID: "a2", Title: "Go 并发进阶", ContentMd: "goroutine 泄漏排查，channel 关闭。"},
+	})
+
+	result := analyzeArticle(&EditorAssistRequest{ArticleID: "a1", Title: "Go 并发", ContentMd: "goroutine 与 channel，goroutine 调度。"}, docs, df)
+	for _, s := range result.Suggestions {
+		if s.ID == "a1" {
+			t.Fatalf("不应推荐正在编辑的文章自身: %+v", result.Suggestions)
+		}
+	}
+	if len(result.Suggestions) != 1 || result.Suggestions[0].ID != "a2" {
+		t.Errorf("推荐结果错误: %+v", result.Suggestions)
+	}
+}
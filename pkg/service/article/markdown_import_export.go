@@ -0,0 +1,293 @@
+/*
+ * @Description: 带 front-matter 的 Markdown 文章导入导出
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 13:00:00
+ * @LastEditTime: 2026-10-16 13:00:00
+ * @LastEditors: 安知鱼
+ */
+package article
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// maxMarkdownFileSize 单个 Markdown 文件的大小上限，防止压缩包内的超大文件耗尽内存
+	maxMarkdownFileSize = 10 << 20
+	// maxMarkdownFilesPerZip 单个压缩包最多导入的 Markdown 文件数
+	maxMarkdownFilesPerZip = 1000
+)
+
+// frontMatterTimeLayouts front-matter 中日期字段支持的格式，未带时区的按服务器本地时区解析
+var frontMatterTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006/01/02 15:04:05",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// MarkdownFile 待导入的单个 Markdown 文件
+type MarkdownFile struct {
+	Name    string
+	Content []byte
+}
+
+// markdownFrontMatter Markdown 文件头部的元数据，兼容 Hexo 的常用字段
+type markdownFrontMatter struct {
+	Title       string    `yaml:"title"`
+	Date        yaml.Node `yaml:"date"`
+	Updated     yaml.Node `yaml:"updated"`
+	Tags        yaml.Node `yaml:"tags"`
+	Categories  yaml.Node `yaml:"categories"`
+	Cover       string    `yaml:"cover"`
+	TopImg      string    `yaml:"top_img"`
+	Abbrlink    string    `yaml:"abbrlink"`
+	Keywords    string    `yaml:"keywords"`
+	Description string    `yaml:"description"`
+	Draft       bool      `yaml:"draft"`
+}
+
+// markdownExportFrontMatter 导出时写入的 front-matter，字段顺序即输出顺序
+type markdownExportFrontMatter struct {
+	Title       string   `yaml:"title"`
+	Date        string   `yaml:"date"`
+	Updated     string   `yaml:"updated"`
+	Tags        []string `yaml:"tags,omitempty"`
+	Categories  []string `yaml:"categories,omitempty"`
+	Cover       string   `yaml:"cover,omitempty"`
+	TopImg      string   `yaml:"top_img,omitempty"`
+	Abbrlink    string   `yaml:"abbrlink,omitempty"`
+	Keywords    string   `yaml:"keywords,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Draft       bool     `yaml:"draft,omitempty"`
+}
+
+// ParseMarkdownArticle 解析带 front-matter 的 Markdown 文件，缺少标题时使用文件名
+func ParseMarkdownArticle(filename string, data []byte) (*ExportArticleItem, error) {
+	meta, body, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var fm markdownFrontMatter
+	if meta != nil {
+		if err := yaml.Unmarshal(meta, &fm); err != nil {
+			return nil, fmt.Errorf("解析 front-matter 失败: %w", err)
+		}
+	}
+
+	item := &ExportArticleItem{
+		Title:      strings.TrimSpace(fm.Title),
+		ContentMd:  body,
+		CoverURL:   strings.TrimSpace(fm.Cover),
+		TopImgURL:  strings.TrimSpace(fm.TopImg),
+		Abbrlink:   strings.TrimSpace(fm.Abbrlink),
+		Keywords:   strings.TrimSpace(fm.Keywords),
+		Tags:       nodeStrings(&fm.Tags),
+		Categories: nodeStrings(&fm.Categories),
+	}
+	if item.Title == "" {
+		base := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+		item.Title = strings.TrimSuffix(base, path.Ext(base))
+	}
+	if item.Title == "" {
+		return nil, fmt.Errorf("文章标题不能为空")
+	}
+	if desc := strings.TrimSpace(fm.Description); desc != "" {
+		item.Summaries = []string{desc}
+	}
+	if fm.Draft {
+		item.Status = "DRAFT"
+	}
+	if item.CreatedAt, err = parseFrontMatterTime(&fm.Date); err != nil {
+		return nil, fmt.Errorf("date 字段无效: %w", err)
+	}
+	if item.UpdatedAt, err = parseFrontMatterTime(&fm.Updated); err != nil {
+		return nil, fmt.Errorf("updated 字段无效: %w", err)
+	}
+	return item, nil
+}
+
+// splitFrontMatter 拆分文件头部 --- 包裹的 YAML 与正文，没有 front-matter 时 meta 为 nil
+func splitFrontMatter(data []byte) ([]byte, string, error) {
+	content := strings.ReplaceAll(string(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))), "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return nil, content, nil
+	}
+	lines := strings.Split(content, "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimRight(lines[i], " \t") == "---" {
+			meta := strings.Join(lines[1:i], "\n")
+			body := strings.TrimLeft(strings.Join(lines[i+1:], "\n"), "\n")
+			return []byte(meta), body, nil
+		}
+	}
+	return nil, "", fmt.Errorf("front-matter 缺少结束标记 ---")
+}
+
+// nodeStrings 把列表或 Hexo 的嵌套分类列表展开为去重后的字符串列表，单个值按逗号拆分
+func nodeStrings(node *yaml.Node) []string {
+	var result []string
+	seen := make(map[string]bool)
+	add := func(v string) {
+		v = strings.TrimSpace(v)
+		if v != "" && !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		switch n.Kind {
+		case yaml.ScalarNode:
+			add(n.Value)
+		case yaml.SequenceNode:
+			for _, child := range n.Content {
+				walk(child)
+			}
+		}
+	}
+	if node.Kind == yaml.ScalarNode {
+		for _, part := range strings.Split(node.Value, ",") {
+			add(part)
+		}
+		return result
+	}
+	walk(node)
+	return result
+}
+
+func parseFrontMatterTime(node *yaml.Node) (time.Time, error) {
+	value := strings.TrimSpace(node.Value)
+	if node.Kind != yaml.ScalarNode || value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range frontMatterTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无法识别的时间格式 %q", value)
+}
+
+// buildMarkdownWithFrontmatter 构建带有 frontmatter 的 Markdown 内容，输出可由 ParseMarkdownArticle 重新导入
+func buildMarkdownWithFrontmatter(article ExportArticleItem) string {
+	fm := markdownExportFrontMatter{
+		Title:      article.Title,
+		Date:       article.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+		Updated:    article.UpdatedAt.Local().Format("2006-01-02 15:04:05"),
+		Tags:       article.Tags,
+		Categories: article.Categories,
+		Cover:      article.CoverURL,
+		TopImg:     article.TopImgURL,
+		Abbrlink:   article.Abbrlink,
+		Keywords:   article.Keywords,
+		Draft:      article.Status == "DRAFT",
+	}
+	if len(article.Summaries) > 0 {
+		fm.Description = article.Summaries[0]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(fm); err != nil {
+		log.Printf("[导出文章] 序列化 front-matter 失败: %v", err)
+	}
+	encoder.Close()
+	buf.WriteString("---\n\n")
+	buf.WriteString(article.ContentMd)
+	return buf.String()
+}
+
+// ExportArticleMarkdown 导出单篇文章为带 front-matter 的 Markdown，返回建议的文件名与内容
+func (s *serviceImpl) ExportArticleMarkdown(ctx context.Context, articleID string) (string, []byte, error) {
+	exportData, err := s.ExportArticles(ctx, []string{articleID})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(exportData.Articles) == 0 {
+		return "", nil, fmt.Errorf("文章不存在")
+	}
+	article := exportData.Articles[0]
+
+	filename := sanitizeFilename(article.Title)
+	if filename == "" {
+		filename = "article"
+	}
+	return filename + ".md", []byte(buildMarkdownWithFrontmatter(article)), nil
+}
+
+// ImportArticlesFromMarkdown 从 Markdown 文件导入文章，解析失败的文件计入失败数，不影响其余文件
+func (s *serviceImpl) ImportArticlesFromMarkdown(ctx context.Context, files []MarkdownFile, req *ImportArticleRequest) (*ImportResult, error) {
+	var parseErrors []string
+	items := make([]ExportArticleItem, 0, len(files))
+	for _, file := range files {
+		item, err := ParseMarkdownArticle(file.Name, file.Content)
+		if err != nil {
+			parseErrors = append(parseErrors, fmt.Sprintf("解析文件 '%s' 失败: %v", file.Name, err))
+			continue
+		}
+		items = append(items, *item)
+	}
+
+	req.Data = ExportArticleData{Version: "1.0", ExportAt: time.Now(), Articles: items}
+	result, err := s.ImportArticles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result.TotalCount += len(parseErrors)
+	result.FailedCount += len(parseErrors)
+	result.Errors = append(parseErrors, result.Errors...)
+	return result, nil
+}
+
+// readZipMarkdownFiles 读取压缩包内全部 .md/.markdown 文件，忽略 macOS 附带的元数据目录
+func readZipMarkdownFiles(zipReader *zip.Reader) ([]MarkdownFile, error) {
+	var files []MarkdownFile
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() || strings.HasPrefix(file.Name, "__MACOSX/") || !IsMarkdownFilename(file.Name) {
+			continue
+		}
+		if len(files) >= maxMarkdownFilesPerZip {
+			return nil, fmt.Errorf("压缩包内的 Markdown 文件超过 %d 个", maxMarkdownFilesPerZip)
+		}
+		if file.UncompressedSize64 > maxMarkdownFileSize {
+			return nil, fmt.Errorf("文件 %s 超过大小上限", file.Name)
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("打开 %s 失败: %w", file.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(rc, maxMarkdownFileSize+1))
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("读取 %s 失败: %w", file.Name, err)
+		}
+		if len(content) > maxMarkdownFileSize {
+			return nil, fmt.Errorf("文件 %s 超过大小上限", file.Name)
+		}
+		files = append(files, MarkdownFile{Name: file.Name, Content: content})
+	}
+	return files, nil
+}
+
+// IsMarkdownFilename 判断文件名是否为 Markdown 文件
+func IsMarkdownFilename(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".md" || ext == ".markdown"
+}
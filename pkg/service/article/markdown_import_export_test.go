@@ -0,0 +1,92 @@
+package article
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseMarkdownArticle(t *testing.T) {
+	data := []byte("\xef\xbb\xbf---\r\n" +
+		"title: \"Go: 并发\"\r\n" +
+		"date: 2024-03-05 10:20:30\r\n" +
+		"tags: Go, 并发\r\n" +
+		"categories:\r\n  - [后端, Go]\r\n  - 笔记\r\n" +
+		"abbrlink: 12345\r\n" +
+		"cover: https://example.com/c.png\r\n" +
+		"draft: true\r\n" +
+		"---\r\n\r\n# 正文\r\n---\r\n结尾")
+
+	item, err := ParseMarkdownArticle("posts/go.md", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Title != "Go: 并发" || item.Abbrlink != "12345" || item.CoverURL != "https://example.com/c.png" {
+		t.Errorf("基础字段解析错误: %+v", item)
+	}
+	if !reflect.DeepEqual(item.Tags, []string{"Go", "并发"}) {
+		t.Errorf("标签解析错误: %v", item.Tags)
+	}
+	if !reflect.DeepEqual(item.Categories, []string{"后端", "Go", "笔记"}) {
+		t.Errorf("嵌套分类应展开去重: %v", item.Categories)
+	}
+	want := time.Date(2024, 3, 5, 10, 20, 30, 0, time.Local)
+	if !item.CreatedAt.Equal(want) || !item.UpdatedAt.IsZero() {
+		t.Errorf("日期解析错误: %v / %v", item.CreatedAt, item.UpdatedAt)
+	}
+	if item.Status != "DRAFT" {
+		t.Errorf("draft: true 应导入为草稿，实际 %q", item.Status)
+	}
+	if item.ContentMd != "# 正文\n---\n结尾" {
+		t.Errorf("正文中的分隔线不应被截断: %q", item.ContentMd)
+	}
+}
+
+func TestParseMarkdownArticleWithoutFrontMatter(t *testing.T) {
+	item, err := ParseMarkdownArticle("dir/我的文章.md", []byte("正文"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Title != "我的文章" || item.ContentMd != "正文" {
+		t.Errorf("无 front-matter 时应使用文件名作为标题: %+v", item)
+	}
+
+	if _, err := ParseMarkdownArticle("a.md", []byte("---\ntitle: x\n正文")); err == nil {
+		t.Error("缺少结束标记时应返回错误")
+	}
+	if _, err := ParseMarkdownArticle("a.md", []byte("---\ndate: 昨天\n---\n")); err == nil {
+		t.Error("无法识别的日期应返回错误")
+	}
+}
+
+func TestMarkdownExportRoundTrip(t *testing.T) {
+	original := ExportArticleItem{
+		Title:      "标题: 带冒号 \"引号\"",
+		ContentMd:  "# Hello\n\n内容",
+		CreatedAt:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.Local),
+		UpdatedAt:  time.Date(2023, 2, 3, 4, 5, 6, 0, time.Local),
+		Tags:       []string{"a: b", "c, d"},
+		Categories: []string{"分类"},
+		CoverURL:   "https://example.com/c.png",
+		Abbrlink:   "007",
+		Summaries:  []string{"摘要"},
+		Status:     "PUBLISHED",
+	}
+
+	parsed, err := ParseMarkdownArticle("x.md", []byte(buildMarkdownWithFrontmatter(original)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Title != original.Title || parsed.ContentMd != original.ContentMd || parsed.Abbrlink != original.Abbrlink {
+		t.Errorf("往返后字段不一致: %+v", parsed)
+	}
+	if !reflect.DeepEqual(parsed.Tags, original.Tags) || !reflect.DeepEqual(parsed.Categories, original.Categories) {
+		t.Errorf("往返后标签或分类不一致: %v %v", parsed.Tags, parsed.Categories)
+	}
+	if !parsed.CreatedAt.Equal(original.CreatedAt) || !parsed.UpdatedAt.Equal(original.UpdatedAt) {
+		t.Errorf("往返后日期不一致: %v %v", parsed.CreatedAt, parsed.UpdatedAt)
+	}
+	if parsed.Status != "" || !reflect.DeepEqual(parsed.Summaries, original.Summaries) {
+		t.Errorf("已发布文章不应标记为草稿: %+v", parsed)
+	}
+}
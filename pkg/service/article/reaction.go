@@ -0,0 +1,210 @@
+/*
+ * @Description: 文章表情回应：访客可为公开文章点赞、比心等，同一访客的重复回应通过缓存去重
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 05:00:00
+ * @LastEditTime: 2026-10-18 05:00:00
+ * @LastEditors: 安知鱼
+ */
+package article
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	// articleReactionDedupKeyPrefix 访客回应去重键前缀
+	articleReactionDedupKeyPrefix = ArticleKeyNamespace + "article:reaction:"
+	// articleReactionDedupWindow 去重记录的保留时间，期间同一访客对同一文章的同种回应只计一次
+	articleReactionDedupWindow = 30 * 24 * time.Hour
+	// topLovedPostsLimit 统计中展示的回应最多的文章数量
+	topLovedPostsLimit = 10
+)
+
+var (
+	// ErrInvalidReaction 不支持的回应类型
+	ErrInvalidReaction = errors.New("不支持的回应类型")
+	// ErrReactionVisitorMissing 无法识别访客
+	ErrReactionVisitorMissing = errors.New("无法识别访客身份")
+	// ErrReactionDisabled 未注入回应计数仓储
+	ErrReactionDisabled = errors.New("文章回应功能未启用")
+)
+
+// ReactionResult 是添加或撤销文章回应后返回给前端的结果
+type ReactionResult struct {
+	Reactions map[string]int `json:"reactions"`
+	Changed   bool           `json:"changed"` // 本次操作是否改变了回应（重复回应或撤销不存在的回应时为 false）
+}
+
+// SetArticleReactionRepo 设置文章回应计数仓储（可选注入，未注入时不返回回应计数）
+func (s *serviceImpl) SetArticleReactionRepo(reactionRepo repository.ArticleReactionRepository) {
+	s.reactionRepo = reactionRepo
+}
+
+// React 为公开文章添加一种表情回应，visitorKey 为访客的 IP+UA 指纹
+func (s *serviceImpl) React(ctx context.Context, slugOrID, reaction, visitorKey string) (*ReactionResult, error) {
+	return s.changeReaction(ctx, slugOrID, reaction, visitorKey, true)
+}
+
+// Unreact 撤销访客对公开文章的某种表情回应
+func (s *serviceImpl) Unreact(ctx context.Context, slugOrID, reaction, visitorKey string) (*ReactionResult, error) {
+	return s.changeReaction(ctx, slugOrID, reaction, visitorKey, false)
+}
+
+func (s *serviceImpl) changeReaction(ctx context.Context, slugOrID, reaction, visitorKey string, add bool) (*ReactionResult, error) {
+	if s.reactionRepo == nil {
+		return nil, ErrReactionDisabled
+	}
+	if !slices.Contains(model.CommentReactions, reaction) {
+		return nil, ErrInvalidReaction
+	}
+	if visitorKey == "" {
+		return nil, ErrReactionVisitorMissing
+	}
+
+	// 只能回应公开可见的文章
+	article, err := s.repo.GetBySlugOrID(ctx, slugOrID)
+	if err != nil {
+		return nil, err
+	}
+	articleID, _, err := idgen.DecodePublicID(article.ID)
+	if err != nil {
+		return nil, fmt.Errorf("解析文章ID失败: %w", err)
+	}
+
+	sum := sha1.Sum([]byte(visitorKey))
+	dedupKey := fmt.Sprintf("%s%d:%s:%s", articleReactionDedupKeyPrefix, articleID, reaction, hex.EncodeToString(sum[:]))
+
+	changed := false
+	if add {
+		changed, err = s.addReaction(ctx, articleID, reaction, dedupKey)
+	} else {
+		changed, err = s.removeReaction(ctx, articleID, reaction, dedupKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.reactionRepo.CountsByArticleIDs(ctx, []uint{articleID})
+	if err != nil {
+		return nil, fmt.Errorf("获取文章回应失败: %w", err)
+	}
+	reactions := counts[articleID]
+	if reactions == nil {
+		reactions = map[string]int{}
+	}
+	return &ReactionResult{Reactions: reactions, Changed: changed}, nil
+}
+
+// addReaction 通过缓存计数判断访客是否已回应过，首次回应才累加计数
+func (s *serviceImpl) addReaction(ctx context.Context, articleID uint, reaction, dedupKey string) (bool, error) {
+	count, err := s.cacheSvc.Increment(ctx, dedupKey)
+	if err != nil {
+		// 去重失败时仍记录回应，计数允许少量误差
+		log.Printf("[文章回应] 去重检查失败: %v", err)
+	} else {
+		if count == 1 {
+			if err := s.cacheSvc.Expire(ctx, dedupKey, articleReactionDedupWindow); err != nil {
+				log.Printf("[文章回应] 设置去重过期时间失败: %v", err)
+			}
+		}
+		if count > 1 {
+			return false, nil
+		}
+	}
+	if err := s.reactionRepo.Increment(ctx, articleID, reaction); err != nil {
+		// 计数失败时清除去重记录，允许访客重试
+		_ = s.cacheSvc.Delete(ctx, dedupKey)
+		return false, fmt.Errorf("记录文章回应失败: %w", err)
+	}
+	return true, nil
+}
+
+// removeReaction 仅在访客确有回应记录时撤销并减少计数
+func (s *serviceImpl) removeReaction(ctx context.Context, articleID uint, reaction, dedupKey string) (bool, error) {
+	val, err := s.cacheSvc.Get(ctx, dedupKey)
+	if err != nil || val == "" {
+		return false, nil
+	}
+	if err := s.cacheSvc.Delete(ctx, dedupKey); err != nil {
+		return false, fmt.Errorf("撤销文章回应失败: %w", err)
+	}
+	if err := s.reactionRepo.Decrement(ctx, articleID, reaction); err != nil {
+		return false, fmt.Errorf("撤销文章回应失败: %w", err)
+	}
+	return true, nil
+}
+
+// reactionCounts 批量查询文章的回应计数，未注入仓储或查询失败时返回 nil
+func (s *serviceImpl) reactionCounts(ctx context.Context, articles []*model.Article) map[uint]map[string]int {
+	if s.reactionRepo == nil || len(articles) == 0 {
+		return nil
+	}
+	ids := make([]uint, 0, len(articles))
+	for _, a := range articles {
+		if id, _, err := idgen.DecodePublicID(a.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	counts, err := s.reactionRepo.CountsByArticleIDs(ctx, ids)
+	if err != nil {
+		log.Printf("[文章回应] 查询回应计数失败: %v", err)
+		return nil
+	}
+	return counts
+}
+
+// fillReactions 为文章响应填充回应计数，list 与 articles 一一对应
+func (s *serviceImpl) fillReactions(ctx context.Context, articles []*model.Article, list []model.ArticleResponse) {
+	counts := s.reactionCounts(ctx, articles)
+	if counts == nil {
+		return
+	}
+	for i, a := range articles {
+		if id, _, err := idgen.DecodePublicID(a.ID); err == nil {
+			list[i].Reactions = counts[id]
+		}
+	}
+}
+
+// topLovedPosts 按回应总数排序，返回回应最多的文章
+func (s *serviceImpl) topLovedPosts(ctx context.Context, articles []*model.Article, limit int) []model.TopLovedPostItem {
+	items := []model.TopLovedPostItem{}
+	counts := s.reactionCounts(ctx, articles)
+	for _, a := range articles {
+		id, _, err := idgen.DecodePublicID(a.ID)
+		if err != nil || counts[id] == nil {
+			continue
+		}
+		total := 0
+		for _, n := range counts[id] {
+			total += n
+		}
+		if total == 0 {
+			continue
+		}
+		items = append(items, model.TopLovedPostItem{
+			ID:        a.ID,
+			Title:     a.Title,
+			CoverURL:  a.CoverURL,
+			Total:     total,
+			Reactions: counts[id],
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Total > items[j].Total })
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
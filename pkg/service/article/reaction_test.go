@@ -0,0 +1,134 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeReactionArticleRepo struct {
+	repository.ArticleRepository
+	article *model.Article
+}
+
+func (f *fakeReactionArticleRepo) GetBySlugOrID(ctx context.Context, slugOrID string) (*model.Article, error) {
+	if slugOrID != f.article.ID && slugOrID != f.article.Abbrlink {
+		return nil, &ent.NotFoundError{}
+	}
+	return f.article, nil
+}
+
+type fakeArticleReactionRepo struct {
+	counts map[uint]map[string]int
+}
+
+func (f *fakeArticleReactionRepo) Increment(ctx context.Context, articleID uint, reaction string) error {
+	if f.counts[articleID] == nil {
+		f.counts[articleID] = map[string]int{}
+	}
+	f.counts[articleID][reaction]++
+	return nil
+}
+
+func (f *fakeArticleReactionRepo) Decrement(ctx context.Context, articleID uint, reaction string) error {
+	if f.counts[articleID][reaction] > 0 {
+		f.counts[articleID][reaction]--
+	}
+	return nil
+}
+
+func (f *fakeArticleReactionRepo) CountsByArticleIDs(ctx context.Context, articleIDs []uint) (map[uint]map[string]int, error) {
+	result := map[uint]map[string]int{}
+	for _, id := range articleIDs {
+		if counts, ok := f.counts[id]; ok {
+			copied := map[string]int{}
+			for k, v := range counts {
+				copied[k] = v
+			}
+			result[id] = copied
+		}
+	}
+	return result, nil
+}
+
+func TestArticleReact(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("article-reaction-test"); err != nil {
+		t.Fatal(err)
+	}
+	publicID, _ := idgen.GeneratePublicID(5, idgen.EntityTypeArticle)
+	reactions := &fakeArticleReactionRepo{counts: map[uint]map[string]int{}}
+	s := &serviceImpl{
+		repo:     &fakeReactionArticleRepo{article: &model.Article{ID: publicID, Abbrlink: "hello"}},
+		cacheSvc: utility.NewMemoryCacheService(),
+	}
+	ctx := context.Background()
+
+	if _, err := s.React(ctx, "hello", model.ReactionLike, "visitor-a"); !errors.Is(err, ErrReactionDisabled) {
+		t.Fatalf("未注入仓储时应返回 ErrReactionDisabled, 实际为 %v", err)
+	}
+	s.SetArticleReactionRepo(reactions)
+
+	result, err := s.React(ctx, "hello", model.ReactionLove, "visitor-a")
+	if err != nil || !result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("首次回应应计数: %+v, %v", result, err)
+	}
+	if result, _ := s.React(ctx, publicID, model.ReactionLove, "visitor-a"); result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("同一访客重复回应不应重复计数: %+v", result)
+	}
+	if result, _ := s.React(ctx, "hello", model.ReactionLike, "visitor-a"); !result.Changed || result.Reactions[model.ReactionLike] != 1 {
+		t.Fatalf("同一访客的不同回应应分别计数: %+v", result)
+	}
+	if result, _ := s.React(ctx, "hello", model.ReactionLove, "visitor-b"); result.Reactions[model.ReactionLove] != 2 {
+		t.Fatalf("不同访客的回应应分别计数: %+v", result)
+	}
+
+	if result, _ := s.Unreact(ctx, "hello", model.ReactionLove, "visitor-a"); !result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("撤销回应后应减少计数: %+v", result)
+	}
+	if result, _ := s.Unreact(ctx, "hello", model.ReactionLove, "visitor-a"); result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("撤销不存在的回应不应改变计数: %+v", result)
+	}
+	if result, _ := s.React(ctx, "hello", model.ReactionLove, "visitor-a"); !result.Changed {
+		t.Fatalf("撤销后应允许再次回应: %+v", result)
+	}
+
+	if _, err := s.React(ctx, "hello", "angry", "visitor-a"); !errors.Is(err, ErrInvalidReaction) {
+		t.Errorf("不支持的回应应返回 ErrInvalidReaction, 实际为 %v", err)
+	}
+	if _, err := s.React(ctx, "hello", model.ReactionLike, ""); !errors.Is(err, ErrReactionVisitorMissing) {
+		t.Errorf("缺少访客标识应返回 ErrReactionVisitorMissing, 实际为 %v", err)
+	}
+	if _, err := s.React(ctx, "missing", model.ReactionLike, "visitor-a"); !ent.IsNotFound(err) {
+		t.Errorf("不存在的文章应返回未找到, 实际为 %v", err)
+	}
+}
+
+func TestTopLovedPosts(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("article-reaction-test"); err != nil {
+		t.Fatal(err)
+	}
+	var articles []*model.Article
+	for i := uint(1); i <= 3; i++ {
+		id, _ := idgen.GeneratePublicID(i, idgen.EntityTypeArticle)
+		articles = append(articles, &model.Article{ID: id, Title: id})
+	}
+	s := &serviceImpl{reactionRepo: &fakeArticleReactionRepo{counts: map[uint]map[string]int{
+		1: {model.ReactionLike: 1},
+		2: {model.ReactionLike: 2, model.ReactionLove: 3},
+		3: {model.ReactionLike: 0},
+	}}}
+
+	top := s.topLovedPosts(context.Background(), articles, 10)
+	if len(top) != 2 || top[0].ID != articles[1].ID || top[0].Total != 5 || top[1].ID != articles[0].ID {
+		t.Fatalf("应按回应总数排序并忽略没有回应的文章: %+v", top)
+	}
+	if top := s.topLovedPosts(context.Background(), articles, 1); len(top) != 1 {
+		t.Fatalf("应限制返回数量: %+v", top)
+	}
+}
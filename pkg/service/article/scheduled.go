@@ -0,0 +1,57 @@
+/*
+ * @Description: 定时发布文章的发布后处理
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 11:00:00
+ * @LastEditTime: 2026-10-16 11:00:00
+ * @LastEditors: 安知鱼
+ */
+package article
+
+import (
+	"context"
+	"log"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/sitemap"
+)
+
+// SetSitemapService 注入站点地图服务（可选），文章发布后通知配置的站点地图提交地址
+func (s *serviceImpl) SetSitemapService(svc sitemap.Service) {
+	s.sitemapSvc = svc
+}
+
+// pingSitemap 异步通知站点地图已更新
+func (s *serviceImpl) pingSitemap() {
+	if s.sitemapSvc == nil {
+		return
+	}
+	workerpool.Go(workerpool.CategoryNotification, func() { s.sitemapSvc.Ping(context.Background()) })
+}
+
+// HandleScheduledPublished 在定时任务把文章状态改为已发布后执行与手动发布一致的后续处理：
+// 清除缓存、更新搜索索引、通知订阅者、记录历史版本并通知站点地图。
+func (s *serviceImpl) HandleScheduledPublished(ctx context.Context, publicID string) {
+	published, err := s.repo.GetByID(ctx, publicID)
+	if err != nil {
+		log.Printf("[定时发布] 获取文章 %s 失败: %v", publicID, err)
+		return
+	}
+
+	s.publishArticleEvent(event.ArticleUpdated, published.Abbrlink, publicID)
+	s.invalidateArticleCache(ctx, publicID, published.Abbrlink)
+	workerpool.Go(workerpool.CategoryCache, func() { s.invalidateRelatedCaches(context.Background()) })
+
+	workerpool.Go(workerpool.CategoryIndexing, func() {
+		if err := s.searchSvc.IndexArticle(context.Background(), published); err != nil {
+			log.Printf("[警告] 更新搜索索引失败: %v", err)
+		}
+	})
+
+	if err := s.subscriberSvc.NotifyArticlePublished(ctx, published); err != nil {
+		log.Printf("[定时发布] 触发订阅通知失败: %v", err)
+	}
+
+	s.createArticleHistory(ctx, published, published.OwnerID, "定时发布")
+	s.pingSitemap()
+}
@@ -9,19 +9,24 @@ import (
 	"log"
 	"math"
 	"net/http"
+	"net/url"
 	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
@@ -29,6 +34,7 @@ import (
 	appParser "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/search"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/sitemap"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 )
@@ -86,9 +92,12 @@ type Service interface {
 	// 导入导出功能
 	ExportArticles(ctx context.Context, articleIDs []string) (*ExportArticleData, error)
 	ExportArticlesToZip(ctx context.Context, articleIDs []string) ([]byte, error)
+	StreamArticles(ctx context.Context, authorID *uint, emit func(item ExportArticleItem) error) error
 	ImportArticles(ctx context.Context, req *ImportArticleRequest) (*ImportResult, error)
 	ImportArticlesFromJSON(ctx context.Context, jsonData []byte, req *ImportArticleRequest) (*ImportResult, error)
 	ImportArticlesFromZip(ctx context.Context, zipData []byte, req *ImportArticleRequest) (*ImportResult, error)
+	ImportArticlesFromMarkdown(ctx context.Context, files []MarkdownFile, req *ImportArticleRequest) (*ImportResult, error)
+	ExportArticleMarkdown(ctx context.Context, articleID string) (string, []byte, error)
 
 	// SetHistoryRepo 设置文章历史版本仓储（可选注入，用于文章发布时自动记录历史版本）
 	SetHistoryRepo(historyRepo repository.ArticleHistoryRepository)
@@ -99,8 +108,32 @@ type Service interface {
 	// SetImageStyleService 注入图片样式服务，使上传响应 URL 自动拼默认样式后缀（Plan B Phase 1）
 	SetImageStyleService(svc image_style.ImageStyleService)
 
+	// SetArticleAudioRepo 设置文章语音仓储（可选注入，用于在文章详情中返回朗读音频地址）
+	SetArticleAudioRepo(audioRepo repository.ArticleAudioRepository)
+
+	// SetArticleReactionRepo 设置文章回应计数仓储（可选注入，用于表情回应与回应统计）
+	SetArticleReactionRepo(reactionRepo repository.ArticleReactionRepository)
+	// React 为公开文章添加表情回应，同一访客的重复回应不会重复计数
+	React(ctx context.Context, slugOrID, reaction, visitorKey string) (*ReactionResult, error)
+	// Unreact 撤销访客对公开文章的表情回应
+	Unreact(ctx context.Context, slugOrID, reaction, visitorKey string) (*ReactionResult, error)
+
+	// SetSitemapService 设置站点地图服务（可选注入，用于文章发布后通知站点地图提交地址）
+	SetSitemapService(svc sitemap.Service)
+
+	// SetAuditService 设置审计日志服务（可选注入，用于记录后台对文章的修改）
+	SetAuditService(svc *audit.Service)
+
+	// HandleScheduledPublished 定时任务发布文章后执行缓存清理、索引更新、订阅通知等后续处理
+	HandleScheduledPublished(ctx context.Context, publicID string)
+	// HandleContentRewritten 文章内容被批量改写（如站点地址迁移）后清理缓存并更新搜索索引
+	HandleContentRewritten(ctx context.Context, publicIDs []string)
+
 	// GetArticleStatistics 获取文章统计数据（用于前台展示）
 	GetArticleStatistics(ctx context.Context) (*model.ArticleStatistics, error)
+
+	// EditorAssist 基于全站 TF-IDF 为编辑中的文章提取关键词并推荐站内链接
+	EditorAssist(ctx context.Context, req *EditorAssistRequest) (*EditorAssistResult, error)
 }
 
 type serviceImpl struct {
@@ -124,10 +157,16 @@ type serviceImpl struct {
 	cdnSvc           cdn.CDNService
 	subscriberSvc    *subscriber.Service
 
-	userRepo    repository.UserRepository
-	historyRepo repository.ArticleHistoryRepository // 文章历史版本仓储
-	eventBus    *event.EventBus
-	styleSvc    image_style.ImageStyleService // 可选，用于上传响应 URL 自动拼默认样式后缀
+	userRepo     repository.UserRepository
+	historyRepo  repository.ArticleHistoryRepository  // 文章历史版本仓储
+	historyMu    sync.Mutex                           // 串行化历史版本写入，避免连续保存时版本号冲突
+	audioRepo    repository.ArticleAudioRepository    // 文章语音仓储
+	reactionRepo repository.ArticleReactionRepository // 可选，文章表情回应计数
+	eventBus     *event.EventBus
+	sitemapSvc   sitemap.Service               // 可选，文章发布后通知站点地图提交地址
+	styleSvc     image_style.ImageStyleService // 可选，用于上传响应 URL 自动拼默认样式后缀
+	corpus       *keywordCorpus                // 关键词提取与链接推荐使用的语料缓存
+	auditSvc     *audit.Service                // 可选，记录后台对文章的修改
 }
 
 func NewService(
@@ -172,6 +211,7 @@ func NewService(
 		cdnSvc:           cdnSvc,
 		subscriberSvc:    subscriberSvc,
 		userRepo:         userRepo,
+		corpus:           &keywordCorpus{},
 	}
 }
 
@@ -192,6 +232,27 @@ func (s *serviceImpl) SetImageStyleService(svc image_style.ImageStyleService) {
 	s.styleSvc = svc
 }
 
+// SetArticleAudioRepo 设置文章语音仓储（可选注入）
+func (s *serviceImpl) SetArticleAudioRepo(audioRepo repository.ArticleAudioRepository) {
+	s.audioRepo = audioRepo
+}
+
+// articleAudioURL 返回文章已生成的朗读音频地址，未启用或尚未生成时返回空字符串
+func (s *serviceImpl) articleAudioURL(ctx context.Context, articleDbID uint) string {
+	if s.audioRepo == nil {
+		return ""
+	}
+	audio, err := s.audioRepo.FindByArticleID(ctx, articleDbID)
+	if err != nil {
+		log.Printf("[警告] 获取文章 %d 的语音失败: %v", articleDbID, err)
+		return ""
+	}
+	if audio == nil || audio.Status != model.ArticleAudioReady {
+		return ""
+	}
+	return audio.URL
+}
+
 func (s *serviceImpl) publishArticleEvent(topic event.Topic, abbrlink, publicID string) {
 	if s.eventBus == nil {
 		return
@@ -200,6 +261,7 @@ func (s *serviceImpl) publishArticleEvent(topic event.Topic, abbrlink, publicID
 }
 
 // createArticleHistory 创建文章历史版本（内部方法）
+// 每次保存都会记录快照，内容与最新版本完全一致时跳过，避免反复保存挤掉有效版本。
 func (s *serviceImpl) createArticleHistory(ctx context.Context, article *model.Article, editorID uint, changeNote string) {
 	if s.historyRepo == nil {
 		return // 历史版本功能未启用
@@ -218,6 +280,9 @@ func (s *serviceImpl) createArticleHistory(ctx context.Context, article *model.A
 	go func() {
 		bgCtx := context.Background()
 
+		s.historyMu.Lock()
+		defer s.historyMu.Unlock()
+
 		// 获取最新版本号
 		articleDBID, _, err := idgen.DecodePublicID(article.ID)
 		if err != nil {
@@ -230,6 +295,12 @@ func (s *serviceImpl) createArticleHistory(ctx context.Context, article *model.A
 			log.Printf("[createArticleHistory] 获取最新版本号失败: %v", err)
 			return
 		}
+		if latestVersion > 0 {
+			latest, err := s.historyRepo.GetByArticleAndVersion(bgCtx, articleDBID, latestVersion)
+			if err == nil && sameHistorySnapshot(latest, article) {
+				return
+			}
+		}
 		newVersion := latestVersion + 1
 
 		// 创建历史记录
@@ -248,6 +319,7 @@ func (s *serviceImpl) createArticleHistory(ctx context.Context, article *model.A
 			EditorID:       editorID,
 			EditorNickname: editorNickname,
 			ChangeNote:     changeNote,
+			ExtraData:      historyMetadata(article),
 		}
 
 		_, err = s.historyRepo.Create(bgCtx, params)
@@ -266,6 +338,39 @@ func (s *serviceImpl) createArticleHistory(ctx context.Context, article *model.A
 	}()
 }
 
+// historyMetadata 提取随快照保存的文章元数据，供版本对比展示
+func historyMetadata(article *model.Article) map[string]interface{} {
+	tagIDs := make([]string, 0, len(article.PostTags))
+	for _, t := range article.PostTags {
+		tagIDs = append(tagIDs, t.ID)
+	}
+	categoryIDs := make([]string, 0, len(article.PostCategories))
+	for _, c := range article.PostCategories {
+		categoryIDs = append(categoryIDs, c.ID)
+	}
+	return map[string]interface{}{
+		"status":            article.Status,
+		"abbrlink":          article.Abbrlink,
+		"post_tag_ids":      tagIDs,
+		"post_category_ids": categoryIDs,
+	}
+}
+
+// sameHistorySnapshot 判断文章当前内容是否与某个历史版本一致
+func sameHistorySnapshot(h *model.ArticleHistory, article *model.Article) bool {
+	if h == nil {
+		return false
+	}
+	return h.Title == article.Title &&
+		h.ContentMd == article.ContentMd &&
+		h.ContentHTML == article.ContentHTML &&
+		h.CoverURL == article.CoverURL &&
+		h.TopImgURL == article.TopImgURL &&
+		h.PrimaryColor == article.PrimaryColor &&
+		h.Keywords == article.Keywords &&
+		slices.Equal(h.Summaries, article.Summaries)
+}
+
 // UploadArticleImage 处理文章图片的上传，并为其创建直链。
 // 此方法不检查用户组权限，仅供系统内部调用。
 func (s *serviceImpl) UploadArticleImage(ctx context.Context, ownerID uint, fileReader io.Reader, originalFilename string) (string, string, error) {
@@ -370,44 +475,33 @@ func (s *serviceImpl) primaryColorForAutoMode(ctx context.Context, topImgURL, co
 	return c
 }
 
-// updateSiteStatsInBackground 异步更新全站的文章和字数统计配置。
-func (s *serviceImpl) updateSiteStatsInBackground() {
-	go func() {
-		ctx := context.Background()
-		stats, err := s.repo.GetSiteStats(ctx)
-		if err != nil {
-			log.Printf("[错误] updateSiteStats: 无法获取站点统计数据: %v", err)
-			return
-		}
-
-		settingsToUpdate := make(map[string]string)
-
-		postCountKey := constant.KeySidebarSiteInfoTotalPostCount.String()
-		currentPostCountStr := s.settingSvc.Get(postCountKey)
-		if currentPostCountStr != "-1" {
-			settingsToUpdate[postCountKey] = strconv.Itoa(stats.TotalPosts)
-		} else {
-			log.Printf("[信息] 跳过文章总数更新，因为其在后台被设置为禁用 (-1)。")
-		}
-
-		wordCountKey := constant.KeySidebarSiteInfoTotalWordCount.String()
-		currentWordCountStr := s.settingSvc.Get(wordCountKey)
-		if currentWordCountStr != "-1" {
-			settingsToUpdate[wordCountKey] = strconv.Itoa(stats.TotalWords)
-		} else {
-			log.Printf("[信息] 跳过全站字数更新，因为其在后台被设置为禁用 (-1)。")
-		}
+// autoPrimaryColor 计算自动模式下保存文章时写入的主色调。
+// 有可用图片且任务队列可用时不再同步下载图片，而是返回临时主色（keep 为空时为库默认主色）
+// 并返回需要后台取色的图片 URL，由调用方在保存成功后派发取色任务。
+func (s *serviceImpl) autoPrimaryColor(ctx context.Context, topImgURL, coverURL, keep string) (color, deferredImageURL string) {
+	imageURL := topImgURL
+	if imageURL == "" {
+		imageURL = coverURL
+	}
+	if imageURL == "" || s.broker == nil || !s.broker.CanExtractPrimaryColor() {
+		return s.primaryColorForAutoMode(ctx, topImgURL, coverURL), ""
+	}
+	if strings.TrimSpace(keep) == "" {
+		keep = utility.DefaultFallbackPrimaryColor()
+	}
+	return keep, imageURL
+}
 
-		if len(settingsToUpdate) > 0 {
-			if err := s.settingSvc.UpdateSettings(ctx, settingsToUpdate); err != nil {
-				log.Printf("[错误] updateSiteStats: 更新站点统计配置失败: %v", err)
-			} else {
-				log.Printf("[信息] 站点统计已更新：%v", settingsToUpdate)
-			}
-		} else {
-			log.Printf("[信息] 无需更新站点统计，所有项均被禁用。")
-		}
-	}()
+// dispatchPrimaryColor 派发后台取色任务，取色结果写入后清除文章缓存
+func (s *serviceImpl) dispatchPrimaryColor(publicID, abbrlink, imageURL string) {
+	if imageURL == "" {
+		return
+	}
+	s.broker.DispatchPrimaryColorExtraction(publicID, imageURL, func(color string) {
+		ctx := context.Background()
+		s.invalidateArticleCache(ctx, publicID, abbrlink)
+		s.invalidateRelatedCaches(ctx)
+	})
 }
 
 // GetArticleStatistics 获取文章统计数据（用于前台展示）
@@ -417,6 +511,7 @@ func (s *serviceImpl) GetArticleStatistics(ctx context.Context) (*model.ArticleS
 		CategoryStats:  []model.CategoryStatItem{},
 		TagStats:       []model.TagStatItem{},
 		TopViewedPosts: []model.TopViewedPostItem{},
+		TopLovedPosts:  []model.TopLovedPostItem{},
 		PublishTrend:   []model.PublishTrendItem{},
 	}
 
@@ -513,6 +608,9 @@ func (s *serviceImpl) GetArticleStatistics(ctx context.Context) (*model.ArticleS
 				CoverURL: article.CoverURL,
 			})
 		}
+
+		// 按表情回应总数获取最受喜爱的文章
+		stats.TopLovedPosts = s.topLovedPosts(ctx, allArticles, topLovedPostsLimit)
 	}
 
 	// 5. 获取发布趋势（最近12个月）
@@ -627,6 +725,54 @@ func (s *serviceImpl) validateAbbrlink(ctx context.Context, abbrlink string, exc
 	return nil
 }
 
+// validateLinkURL 验证链接文章的外部 URL，必须是 http/https 的绝对地址，空字符串表示普通文章
+func validateLinkURL(linkURL string) error {
+	if linkURL == "" {
+		return nil
+	}
+	if len(linkURL) > 2048 {
+		return fmt.Errorf("链接地址长度不能超过2048个字符")
+	}
+	u, err := url.Parse(linkURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("链接地址 '%s' 无效，必须是以 http:// 或 https:// 开头的完整地址", linkURL)
+	}
+	return nil
+}
+
+// validateSocialOverrides 验证分享卡片覆盖配置：图片必须是 http/https 绝对地址或站内路径，
+// Twitter 卡片类型只能是 summary 或 summary_large_image
+func validateSocialOverrides(cfg *model.ArticleExtraConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.OGTitle != nil && utf8.RuneCountInString(*cfg.OGTitle) > 200 {
+		return fmt.Errorf("分享卡片标题不能超过200个字符")
+	}
+	if cfg.OGDescription != nil && utf8.RuneCountInString(*cfg.OGDescription) > 500 {
+		return fmt.Errorf("分享卡片描述不能超过500个字符")
+	}
+	if cfg.OGImage != nil {
+		image := strings.TrimSpace(*cfg.OGImage)
+		if image != "" && !strings.HasPrefix(image, "/") {
+			u, err := url.Parse(image)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("分享卡片图片 '%s' 无效，必须是以 http:// 或 https:// 开头的完整地址或以 / 开头的站内路径", image)
+			}
+		}
+		cfg.OGImage = &image
+	}
+	if cfg.TwitterCard != nil {
+		switch card := strings.TrimSpace(*cfg.TwitterCard); card {
+		case "", "summary", "summary_large_image":
+			cfg.TwitterCard = &card
+		default:
+			return fmt.Errorf("Twitter 卡片类型 '%s' 无效，只能是 summary 或 summary_large_image", card)
+		}
+	}
+	return nil
+}
+
 // ToAPIResponse 将领域模型转换为用于API响应的DTO。
 func (s *serviceImpl) ToAPIResponse(a *model.Article, useAbbrlinkAsID bool, includeHTML bool) *model.ArticleResponse {
 	if a == nil {
@@ -679,6 +825,8 @@ func (s *serviceImpl) ToAPIResponse(a *model.Article, useAbbrlinkAsID bool, incl
 		CopyrightAuthorHref:  a.CopyrightAuthorHref,
 		CopyrightURL:         a.CopyrightURL,
 		Keywords:             a.Keywords,
+		LinkURL:              a.LinkURL,
+		IsLinkPost:           a.LinkURL != "",
 		ScheduledAt:          a.ScheduledAt,    // 定时发布时间
 		ReviewStatus:         a.ReviewStatus,   // 审核状态（多人共创功能）
 		OwnerID:              a.OwnerID,        // 发布者ID（多人共创功能）
@@ -736,6 +884,20 @@ func toSimpleAPIResponse(a *model.Article) *model.SimpleArticleResponse {
 	}
 }
 
+// skipViewCountKey 是 context 中「不计入浏览量」标记的键
+type skipViewCountKey struct{}
+
+// WithoutViewCount 返回一个不计入文章浏览量的 context，用于缓存预热等非访客请求
+func WithoutViewCount(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipViewCountKey{}, true)
+}
+
+// skipViewCount 判断本次请求是否不计入浏览量
+func skipViewCount(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipViewCountKey{}).(bool)
+	return skip
+}
+
 // getCacheKey 生成文章渲染结果的 Redis 缓存键。
 func (s *serviceImpl) getCacheKey(publicID string) string {
 	return fmt.Sprintf("article:html:%s", publicID)
@@ -845,7 +1007,13 @@ func (s *serviceImpl) invalidateRelatedCaches(ctx context.Context) {
 		}
 	}
 
+	s.corpus.invalidate()
 	log.Printf("[信息] 已清除文章相关缓存，包括RSS和首页缓存")
+
+	// 缓存清除后预热首页、归档、RSS 与热门文章
+	if s.broker != nil {
+		s.broker.DispatchCacheWarmup()
+	}
 }
 
 // invalidateArticleCache 清除特定文章的缓存（包括CDN缓存）
@@ -866,7 +1034,7 @@ func (s *serviceImpl) invalidateArticleCache(ctx context.Context, articleID, abb
 	}
 
 	// 异步清除CDN缓存
-	go func() {
+	workerpool.Go(workerpool.CategoryCache, func() {
 		if s.cdnSvc != nil {
 			// 使用文章ID清除CDN缓存（优先使用abbrlink）
 			cacheID := articleID
@@ -880,11 +1048,34 @@ func (s *serviceImpl) invalidateArticleCache(ctx context.Context, articleID, abb
 				log.Printf("[信息] CDN缓存清除成功，文章ID: %s", cacheID)
 			}
 		}
-	}()
+	})
 
 	log.Printf("[信息] 已清除文章 %s 的相关缓存（包括CDN）", articleID)
 }
 
+// HandleContentRewritten 文章内容被批量改写后，逐篇发布更新事件、清除文章缓存并重新索引，
+// 最后统一清除列表相关缓存。不创建历史版本，也不触发订阅通知。
+func (s *serviceImpl) HandleContentRewritten(ctx context.Context, publicIDs []string) {
+	for _, publicID := range publicIDs {
+		rewritten, err := s.repo.GetByID(ctx, publicID)
+		if err != nil {
+			log.Printf("[内容改写] 获取文章 %s 失败: %v", publicID, err)
+			continue
+		}
+
+		s.publishArticleEvent(event.ArticleUpdated, rewritten.Abbrlink, publicID)
+		s.invalidateArticleCache(ctx, publicID, rewritten.Abbrlink)
+		workerpool.Go(workerpool.CategoryIndexing, func() {
+			if err := s.searchSvc.IndexArticle(context.Background(), rewritten); err != nil {
+				log.Printf("[警告] 更新搜索索引失败: %v", err)
+			}
+		})
+	}
+	if len(publicIDs) > 0 {
+		workerpool.Go(workerpool.CategoryCache, func() { s.invalidateRelatedCaches(context.Background()) })
+	}
+}
+
 // GetPublicBySlugOrID 为公开浏览，通过 slug 或 ID 获取单篇文章，并处理浏览量。
 func (s *serviceImpl) GetPublicBySlugOrID(ctx context.Context, slugOrID string) (*model.ArticleDetailResponse, error) {
 	article, err := s.repo.GetBySlugOrID(ctx, slugOrID)
@@ -917,11 +1108,13 @@ func (s *serviceImpl) GetPublicBySlugOrID(ctx context.Context, slugOrID string)
 	}()
 
 	viewCacheKey := s.getArticleViewCacheKey(article.ID)
-	go func() {
-		if _, err := s.cacheSvc.Increment(context.Background(), viewCacheKey); err != nil {
-			log.Printf("[错误] 无法在 Redis 中为文章 %s 增加浏览次数: %v", article.ID, err)
-		}
-	}()
+	if !skipViewCount(ctx) {
+		go func() {
+			if _, err := s.cacheSvc.Increment(context.Background(), viewCacheKey); err != nil {
+				log.Printf("[错误] 无法在 Redis 中为文章 %s 增加浏览次数: %v", article.ID, err)
+			}
+		}()
+	}
 
 	redisIncrStr, err := s.cacheSvc.Get(ctx, viewCacheKey)
 	if err != nil {
@@ -972,6 +1165,7 @@ func (s *serviceImpl) GetPublicBySlugOrID(ctx context.Context, slugOrID string)
 	// abbrlink 信息仍然通过 Abbrlink 字段返回
 	mainArticleResponse := s.ToAPIResponse(article, false, true)
 	s.fillOwnerNickname(ctx, mainArticleResponse, nil)
+	mainArticleResponse.Reactions = s.reactionCounts(ctx, []*model.Article{article})[currentArticleDbID]
 	relatedResponses := make([]*model.SimpleArticleResponse, 0, len(relatedArticles))
 	for _, rel := range relatedArticles {
 		relatedResponses = append(relatedResponses, toSimpleAPIResponse(rel))
@@ -982,6 +1176,7 @@ func (s *serviceImpl) GetPublicBySlugOrID(ctx context.Context, slugOrID string)
 		PrevArticle:     toSimpleAPIResponse(finalPrevArticle),
 		NextArticle:     toSimpleAPIResponse(finalNextArticle),
 		RelatedArticles: relatedResponses,
+		AudioURL:        s.articleAudioURL(ctx, currentArticleDbID),
 	}
 
 	return detailResponse, nil
@@ -1007,6 +1202,9 @@ func (s *serviceImpl) GetBySlugOrIDForPreview(ctx context.Context, slugOrID stri
 		NextArticle:     nil,
 		RelatedArticles: nil,
 	}
+	if dbID, _, err := idgen.DecodePublicID(article.ID); err == nil {
+		detailResponse.AudioURL = s.articleAudioURL(ctx, dbID)
+	}
 
 	return detailResponse, nil
 }
@@ -1018,8 +1216,19 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 	if err := s.validateAbbrlink(ctx, req.Abbrlink, 0); err != nil {
 		return nil, err
 	}
+	req.LinkURL = strings.TrimSpace(req.LinkURL)
+	if err := validateLinkURL(req.LinkURL); err != nil {
+		return nil, err
+	}
+	if err := validateSocialOverrides(req.ExtraConfig); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Keywords) == "" {
+		req.Keywords = s.autoKeywords(ctx, "", req.Title, req.ContentMd)
+	}
 
 	var newArticle *model.Article
+	var colorImageURL string // 需要后台取色的图片，为空表示无需派发取色任务
 	sanitizedHTML := s.parserSvc.SanitizeHTML(req.ContentHTML)
 
 	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
@@ -1081,7 +1290,7 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 			isManual = true
 			primaryColor = req.PrimaryColor
 		} else {
-			primaryColor = s.primaryColorForAutoMode(ctx, req.TopImgURL, coverURL)
+			primaryColor, colorImageURL = s.autoPrimaryColor(ctx, req.TopImgURL, coverURL, "")
 		}
 
 		copyright := true
@@ -1194,6 +1403,7 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 			CustomPublishedAt:    customPublishedAt,
 			CustomUpdatedAt:      customUpdatedAt,
 			Keywords:             req.Keywords,
+			LinkURL:              req.LinkURL,
 			ReviewStatus:         req.ReviewStatus, // 审核状态（多人共创功能）
 			ExtraConfig:          req.ExtraConfig,  // 文章扩展配置
 			ScheduledAt:          scheduledAt,      // 定时发布时间
@@ -1234,28 +1444,33 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 	}
 
 	s.publishArticleEvent(event.ArticleCreated, newArticle.Abbrlink, newArticle.ID)
-
-	s.updateSiteStatsInBackground()
+	s.dispatchPrimaryColor(newArticle.ID, newArticle.Abbrlink, colorImageURL)
 
 	// 清除相关缓存（包括 RSS feed）
-	go s.invalidateRelatedCaches(context.Background())
+	workerpool.Go(workerpool.CategoryCache, func() { s.invalidateRelatedCaches(context.Background()) })
 
 	// 异步更新搜索索引
-	go func() {
+	workerpool.Go(workerpool.CategoryIndexing, func() {
 		if err := s.searchSvc.IndexArticle(context.Background(), newArticle); err != nil {
 			log.Printf("[警告] 更新搜索索引失败: %v", err)
 		}
-	}()
+	})
 
 	// 如果文章发布成功，触发订阅通知
-	if newArticle.Status == "PUBLISHED" {
+	if newArticle.Status == "PUBLISHED" && !req.SuppressNotifications {
 		if err := s.subscriberSvc.NotifyArticlePublished(ctx, newArticle); err != nil {
 			log.Printf("[Create] 触发订阅通知失败: %v", err)
 		}
+		s.pingSitemap()
+	}
 
-		// 创建历史版本记录（仅在发布时记录）
-		s.createArticleHistory(ctx, newArticle, req.OwnerID, "初次发布")
+	// 创建历史版本记录
+	createNote := "创建草稿"
+	if newArticle.Status == "PUBLISHED" {
+		createNote = "初次发布"
 	}
+	s.createArticleHistory(ctx, newArticle, req.OwnerID, createNote)
+	s.auditSvc.RecordChange(ctx, model.AuditActionArticleCreate, model.AuditEntityArticle, newArticle.ID, nil, newArticleAuditSnapshot(newArticle))
 
 	// includeHTML=true：管理端创建后若跳转编辑页，前端需要 content_html 与列表接口（无正文）区分
 	resp := s.ToAPIResponse(newArticle, false, true)
@@ -1272,6 +1487,9 @@ func (s *serviceImpl) Get(ctx context.Context, publicID string) (*model.ArticleR
 	// includeHTML=true：后台编辑器用 Tiptap 依赖 content_html 初始化；List 仍不带正文以减小体积
 	resp := s.ToAPIResponse(article, false, true)
 	s.fillOwnerNickname(ctx, resp, nil)
+	if dbID, _, err := idgen.DecodePublicID(article.ID); err == nil {
+		resp.Reactions = s.reactionCounts(ctx, []*model.Article{article})[dbID]
+	}
 	return resp, nil
 }
 
@@ -1333,9 +1551,22 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 			return nil, err
 		}
 	}
+	if req.LinkURL != nil {
+		linkURL := strings.TrimSpace(*req.LinkURL)
+		if err := validateLinkURL(linkURL); err != nil {
+			return nil, err
+		}
+		req.LinkURL = &linkURL
+	}
+	if err := validateSocialOverrides(req.ExtraConfig); err != nil {
+		return nil, err
+	}
+	s.fillAutoKeywordsForUpdate(ctx, publicID, req)
 
 	var updatedArticle *model.Article
+	var colorImageURL string // 需要后台取色的图片，为空表示无需派发取色任务
 	var oldStatus string
+	var oldSnapshot *articleAuditSnapshot
 
 	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		oldArticle, err := repos.Article.GetByID(ctx, publicID)
@@ -1343,6 +1574,7 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 			return err
 		}
 		oldStatus = oldArticle.Status
+		oldSnapshot = newArticleAuditSnapshot(oldArticle)
 		oldTagIDs := make([]uint, len(oldArticle.PostTags))
 		for i, t := range oldArticle.PostTags {
 			oldTagIDs[i], _, _ = idgen.DecodePublicID(t.ID)
@@ -1436,7 +1668,13 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 			if explicitAutoInRequest || imageChangedInAuto || staleAutoPrimary {
 				log.Printf("[信息] 文章 %s 重新获取主色调: 请求自动=%t, 封面/头图变更=%t, 自动模式待补算=%t",
 					publicID, explicitAutoInRequest, imageChangedInAuto, staleAutoPrimary)
-				newColor := s.primaryColorForAutoMode(ctx, newTopImgURL, newCoverURL)
+				// 图片未变时沿用原主色作为临时值，换图后旧主色已不匹配，改用库默认主色
+				keep := oldArticle.PrimaryColor
+				if imageChangedInAuto {
+					keep = ""
+				}
+				var newColor string
+				newColor, colorImageURL = s.autoPrimaryColor(ctx, newTopImgURL, newCoverURL, keep)
 				computedParams.PrimaryColor = &newColor
 			}
 		}
@@ -1589,37 +1827,41 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 	}
 
 	s.publishArticleEvent(event.ArticleUpdated, updatedArticle.Abbrlink, publicID)
+	s.dispatchPrimaryColor(publicID, updatedArticle.Abbrlink, colorImageURL)
 
 	// 清除特定文章的缓存
 	s.invalidateArticleCache(ctx, publicID, updatedArticle.Abbrlink)
 
-	s.updateSiteStatsInBackground()
-
 	// 清除相关缓存（包括 RSS feed 和首页缓存）
-	go s.invalidateRelatedCaches(context.Background())
+	workerpool.Go(workerpool.CategoryCache, func() { s.invalidateRelatedCaches(context.Background()) })
 
 	// 异步更新搜索索引
-	go func() {
+	workerpool.Go(workerpool.CategoryIndexing, func() {
 		if err := s.searchSvc.IndexArticle(context.Background(), updatedArticle); err != nil {
 			log.Printf("[警告] 更新搜索索引失败: %v", err)
 		}
-	}()
+	})
 
 	// 如果文章状态从非发布变为发布，触发订阅通知
 	if oldStatus != "PUBLISHED" && updatedArticle.Status == "PUBLISHED" {
 		if err := s.subscriberSvc.NotifyArticlePublished(ctx, updatedArticle); err != nil {
 			log.Printf("[Update] 触发订阅通知失败: %v", err)
 		}
+		s.pingSitemap()
 	}
 
-	// 创建历史版本记录（仅在发布状态时记录）
-	if updatedArticle.Status == "PUBLISHED" {
-		changeNote := "更新发布"
-		if oldStatus != "PUBLISHED" {
-			changeNote = "首次发布"
-		}
-		s.createArticleHistory(ctx, updatedArticle, updatedArticle.OwnerID, changeNote)
+	// 创建历史版本记录，每次保存都会留下快照
+	changeNote := "保存草稿"
+	switch {
+	case req.ChangeNote != nil && strings.TrimSpace(*req.ChangeNote) != "":
+		changeNote = strings.TrimSpace(*req.ChangeNote)
+	case updatedArticle.Status == "PUBLISHED" && oldStatus != "PUBLISHED":
+		changeNote = "首次发布"
+	case updatedArticle.Status == "PUBLISHED":
+		changeNote = "更新发布"
 	}
+	s.createArticleHistory(ctx, updatedArticle, updatedArticle.OwnerID, changeNote)
+	s.auditSvc.RecordChange(ctx, model.AuditActionArticleUpdate, model.AuditEntityArticle, publicID, oldSnapshot, newArticleAuditSnapshot(updatedArticle))
 
 	resp := s.ToAPIResponse(updatedArticle, false, true)
 	s.fillOwnerNickname(ctx, resp, nil)
@@ -1629,12 +1871,14 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 // Delete 处理删除文章的业务逻辑。
 func (s *serviceImpl) Delete(ctx context.Context, publicID string) error {
 	var articleSlug string // 保存 slug 用于事务后发布事件
+	var deletedSnapshot *articleAuditSnapshot
 	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		article, err := repos.Article.GetByID(ctx, publicID)
 		if err != nil {
 			return err
 		}
 		articleSlug = article.Abbrlink
+		deletedSnapshot = newArticleAuditSnapshot(article)
 		tagIDs := make([]uint, len(article.PostTags))
 		for i, t := range article.PostTags {
 			tagIDs[i], _, _ = idgen.DecodePublicID(t.ID)
@@ -1695,18 +1939,17 @@ func (s *serviceImpl) Delete(ctx context.Context, publicID string) error {
 	}
 
 	s.publishArticleEvent(event.ArticleDeleted, articleSlug, publicID)
-
-	s.updateSiteStatsInBackground()
+	s.auditSvc.RecordChange(ctx, model.AuditActionArticleDelete, model.AuditEntityArticle, publicID, deletedSnapshot, nil)
 
 	// 清除相关缓存（包括 RSS feed）
-	go s.invalidateRelatedCaches(context.Background())
+	workerpool.Go(workerpool.CategoryCache, func() { s.invalidateRelatedCaches(context.Background()) })
 
 	// 异步删除搜索索引
-	go func() {
+	workerpool.Go(workerpool.CategoryIndexing, func() {
 		if err := s.searchSvc.DeleteArticle(context.Background(), publicID); err != nil {
 			log.Printf("[警告] 删除搜索索引失败: %v", err)
 		}
-	}()
+	})
 
 	return nil
 }
@@ -1773,9 +2016,41 @@ func (s *serviceImpl) List(ctx context.Context, options *model.ListArticlesOptio
 			resp.ID, resp.OwnerID, resp.OwnerNickname, resp.OwnerAvatar, resp.OwnerEmail)
 		list[i] = *resp
 	}
+	s.fillCommentStats(ctx, articles, list)
+	s.fillReactions(ctx, articles, list)
 	return &model.ArticleListResponse{List: list, Total: int64(total), Page: options.Page, PageSize: options.PageSize}, nil
 }
 
+// fillCommentStats 批量填充后台文章列表的评论统计（已发布数、待审核数、最近评论时间）。
+// 统计失败不影响列表返回，仅记录日志。
+func (s *serviceImpl) fillCommentStats(ctx context.Context, articles []*model.Article, list []model.ArticleResponse) {
+	if len(articles) == 0 {
+		return
+	}
+	targetPaths := make([]string, len(articles))
+	for i, a := range articles {
+		// 与评论区的 target_path 保持一致：优先使用abbrlink，否则使用公共ID
+		if a.Abbrlink != "" {
+			targetPaths[i] = fmt.Sprintf("/posts/%s", a.Abbrlink)
+		} else {
+			targetPaths[i] = fmt.Sprintf("/posts/%s", a.ID)
+		}
+	}
+
+	stats, err := s.commentRepo.StatsByTargetPaths(ctx, targetPaths)
+	if err != nil {
+		log.Printf("[List] 查询评论统计失败: %v", err)
+		return
+	}
+	for i := range list {
+		if st, ok := stats[targetPaths[i]]; ok {
+			list[i].CommentCount = st.PublishedCount
+			list[i].PendingCommentCount = st.PendingCount
+			list[i].LastCommentAt = st.LastCommentAt
+		}
+	}
+}
+
 // GetRandom 获取一篇随机文章。
 func (s *serviceImpl) GetRandom(ctx context.Context) (*model.ArticleResponse, error) {
 	article, err := s.repo.GetRandom(ctx)
@@ -1801,6 +2076,7 @@ func (s *serviceImpl) ListHome(ctx context.Context) ([]model.ArticleResponse, er
 		s.fillOwnerInfo(ctx, resp, ownerCache)
 		list[i] = *resp
 	}
+	s.fillReactions(ctx, articles, list)
 	return list, nil
 }
 
@@ -1849,6 +2125,7 @@ func (s *serviceImpl) ListPublic(ctx context.Context, options *model.ListPublicA
 			}
 		}
 	}
+	s.fillReactions(ctx, articles, list)
 
 	return &model.ArticleListResponse{List: list, Total: int64(total), Page: options.Page, PageSize: options.PageSize}, nil
 }
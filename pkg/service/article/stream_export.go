@@ -0,0 +1,73 @@
+// anheyu-app/pkg/service/article/stream_export.go
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// articleExportPageSize 流式导出时每页读取的文章数
+const articleExportPageSize = 100
+
+// ArticleCSVHeader 文章以 CSV 格式导出时的表头，与 ExportArticleItem.CSVRecord 的字段顺序一致
+var ArticleCSVHeader = []string{
+	"title", "status", "created_at", "updated_at", "abbrlink", "categories", "tags",
+	"keywords", "cover_url", "view_count", "word_count", "reading_time", "content_md",
+}
+
+// CSVRecord 返回文章的 CSV 行，多个分类或标签以竖线分隔
+func (a ExportArticleItem) CSVRecord() []string {
+	return []string{
+		a.Title,
+		a.Status,
+		a.CreatedAt.Format(time.RFC3339),
+		a.UpdatedAt.Format(time.RFC3339),
+		a.Abbrlink,
+		strings.Join(a.Categories, "|"),
+		strings.Join(a.Tags, "|"),
+		a.Keywords,
+		a.CoverURL,
+		strconv.Itoa(a.ViewCount),
+		strconv.Itoa(a.WordCount),
+		strconv.Itoa(a.ReadingTime),
+		a.ContentMd,
+	}
+}
+
+// StreamArticles 分页读取文章并逐条交给 emit，同一时刻只有一篇文章的正文在内存中。
+// authorID 不为空时只导出该作者的文章。
+func (s *serviceImpl) StreamArticles(ctx context.Context, authorID *uint, emit func(item ExportArticleItem) error) error {
+	for page := 1; ; page++ {
+		articles, _, err := s.repo.List(ctx, &model.ListArticlesOptions{
+			Page:     page,
+			PageSize: articleExportPageSize,
+			AuthorID: authorID,
+		})
+		if err != nil {
+			return fmt.Errorf("获取文章列表失败: %w", err)
+		}
+		for _, summary := range articles {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// 列表不包含正文，逐篇读取完整内容（ForPreview 不过滤状态，草稿也会导出）
+			article, err := s.repo.GetBySlugOrIDForPreview(ctx, summary.ID)
+			if err != nil {
+				log.Printf("[导出文章] 获取文章 %s 失败: %v", summary.ID, err)
+				continue
+			}
+			if err := emit(newExportArticleItem(article)); err != nil {
+				return err
+			}
+		}
+		if len(articles) < articleExportPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,150 @@
+/*
+ * @Description: 文章合集服务，为首页横幅、编辑精选等模块提供结构化的文章列表
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 01:00:00
+ * @LastEditTime: 2026-10-18 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package article_collection
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+)
+
+// maxArticles 单个合集最多包含的文章数量
+const maxArticles = 50
+
+// slugPattern 合集标识只允许小写字母、数字、连字符与下划线
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Service 封装了文章合集的业务逻辑。
+type Service struct {
+	repo        repository.ArticleCollectionRepository
+	articleRepo repository.ArticleRepository
+	articleSvc  article_service.Service
+}
+
+// NewService 是文章合集 Service 的构造函数。
+func NewService(
+	repo repository.ArticleCollectionRepository,
+	articleRepo repository.ArticleRepository,
+	articleSvc article_service.Service,
+) *Service {
+	return &Service{
+		repo:        repo,
+		articleRepo: articleRepo,
+		articleSvc:  articleSvc,
+	}
+}
+
+// List 获取全部文章合集。
+func (s *Service) List(ctx context.Context) ([]*model.ArticleCollection, error) {
+	return s.repo.List(ctx)
+}
+
+// Get 根据ID获取文章合集。
+func (s *Service) Get(ctx context.Context, id string) (*model.ArticleCollection, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建文章合集。
+func (s *Service) Create(ctx context.Context, req *model.SaveArticleCollectionRequest) (*model.ArticleCollection, error) {
+	if err := normalizeRequest(req); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, req)
+}
+
+// Update 整体更新文章合集。
+func (s *Service) Update(ctx context.Context, id string, req *model.SaveArticleCollectionRequest) (*model.ArticleCollection, error) {
+	if err := normalizeRequest(req); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, id, req)
+}
+
+// Delete 删除文章合集。
+func (s *Service) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// GetPublic 根据标识获取前台展示的合集，未公开的合集视为不存在；
+// 合集中已删除、未发布或下架的文章会被跳过，其余文章保持后台设置的顺序。
+func (s *Service) GetPublic(ctx context.Context, slug string) (*model.PublicArticleCollection, error) {
+	collection, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.IsPublished {
+		return nil, constant.ErrNotFound
+	}
+
+	ids := make([]uint, 0, len(collection.ArticleIDs))
+	for _, publicID := range collection.ArticleIDs {
+		if id, _, err := idgen.DecodePublicID(publicID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	articles, err := s.articleRepo.ListPublishedByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("获取合集文章失败: %w", err)
+	}
+	byID := make(map[string]*model.Article, len(articles))
+	for _, a := range articles {
+		byID[a.ID] = a
+	}
+
+	result := &model.PublicArticleCollection{
+		Name:        collection.Name,
+		Slug:        collection.Slug,
+		Description: collection.Description,
+		Articles:    make([]model.ArticleResponse, 0, len(articles)),
+	}
+	for _, publicID := range collection.ArticleIDs {
+		a, ok := byID[publicID]
+		if !ok {
+			continue
+		}
+		a.ContentMd = ""
+		result.Articles = append(result.Articles, *s.articleSvc.ToAPIResponse(a, true, false))
+	}
+	return result, nil
+}
+
+// normalizeRequest 清理并校验合集请求，文章ID去重且保持原有顺序
+func normalizeRequest(req *model.SaveArticleCollectionRequest) error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Slug = strings.ToLower(strings.TrimSpace(req.Slug))
+	req.Description = strings.TrimSpace(req.Description)
+	if req.Name == "" {
+		return fmt.Errorf("合集名称不能为空: %w", constant.ErrBadRequest)
+	}
+	if !slugPattern.MatchString(req.Slug) {
+		return fmt.Errorf("合集标识只能包含小写字母、数字、连字符与下划线: %w", constant.ErrBadRequest)
+	}
+
+	seen := make(map[string]bool, len(req.ArticleIDs))
+	ids := make([]string, 0, len(req.ArticleIDs))
+	for _, id := range req.ArticleIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) > maxArticles {
+		return fmt.Errorf("单个合集最多包含 %d 篇文章: %w", maxArticles, constant.ErrBadRequest)
+	}
+	req.ArticleIDs = ids
+	return nil
+}
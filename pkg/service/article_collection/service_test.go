@@ -0,0 +1,128 @@
+package article_collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+)
+
+type fakeCollectionRepo struct {
+	repository.ArticleCollectionRepository
+	collection *model.ArticleCollection
+}
+
+func (f *fakeCollectionRepo) GetBySlug(_ context.Context, slug string) (*model.ArticleCollection, error) {
+	if f.collection == nil || f.collection.Slug != slug {
+		return nil, constant.ErrNotFound
+	}
+	return f.collection, nil
+}
+
+type fakeArticleRepo struct {
+	repository.ArticleRepository
+	articles []*model.Article
+	gotIDs   []uint
+}
+
+func (f *fakeArticleRepo) ListPublishedByIDs(_ context.Context, ids []uint) ([]*model.Article, error) {
+	f.gotIDs = ids
+	return f.articles, nil
+}
+
+type fakeArticleService struct {
+	article_service.Service
+}
+
+func (fakeArticleService) ToAPIResponse(a *model.Article, _ bool, _ bool) *model.ArticleResponse {
+	return &model.ArticleResponse{ID: a.ID, Title: a.Title, ContentMd: a.ContentMd}
+}
+
+func TestNormalizeRequest(t *testing.T) {
+	req := &model.SaveArticleCollectionRequest{
+		Name:       "  编辑精选 ",
+		Slug:       " Editors-Picks ",
+		ArticleIDs: []string{"b", " a ", "b", "", "c"},
+	}
+	if err := normalizeRequest(req); err != nil {
+		t.Fatalf("normalizeRequest() 返回错误: %v", err)
+	}
+	if req.Name != "编辑精选" || req.Slug != "editors-picks" {
+		t.Fatalf("名称或标识未被清理: %q %q", req.Name, req.Slug)
+	}
+	want := []string{"b", "a", "c"}
+	if len(req.ArticleIDs) != len(want) {
+		t.Fatalf("ArticleIDs = %v, 期望 %v", req.ArticleIDs, want)
+	}
+	for i := range want {
+		if req.ArticleIDs[i] != want[i] {
+			t.Fatalf("ArticleIDs = %v, 期望 %v", req.ArticleIDs, want)
+		}
+	}
+
+	for _, slug := range []string{"", "-home", "home banner", "首页"} {
+		err := normalizeRequest(&model.SaveArticleCollectionRequest{Name: "x", Slug: slug})
+		if !errors.Is(err, constant.ErrBadRequest) {
+			t.Fatalf("标识 %q 应返回 ErrBadRequest, 实际为 %v", slug, err)
+		}
+	}
+
+	tooMany := make([]string, maxArticles+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+	err := normalizeRequest(&model.SaveArticleCollectionRequest{Name: "x", Slug: "x", ArticleIDs: tooMany})
+	if !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("文章数量超限应返回 ErrBadRequest, 实际为 %v", err)
+	}
+}
+
+func TestGetPublicKeepsCollectionOrder(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("article-collection-test"); err != nil {
+		t.Fatalf("初始化 idgen 失败: %v", err)
+	}
+	ids := make([]string, 3)
+	for i := range ids {
+		id, err := idgen.GeneratePublicID(uint(i+1), idgen.EntityTypeArticle)
+		if err != nil {
+			t.Fatalf("生成公共ID失败: %v", err)
+		}
+		ids[i] = id
+	}
+
+	collections := &fakeCollectionRepo{collection: &model.ArticleCollection{
+		Slug:        "home-banner",
+		IsPublished: true,
+		ArticleIDs:  []string{ids[2], ids[0], ids[1]},
+	}}
+	// 仓库按 ID 顺序返回，且第 2 篇文章已下架
+	articles := &fakeArticleRepo{articles: []*model.Article{
+		{ID: ids[0], Title: "一", ContentMd: "正文"},
+		{ID: ids[2], Title: "三"},
+	}}
+	svc := NewService(collections, articles, fakeArticleService{})
+
+	got, err := svc.GetPublic(context.Background(), "home-banner")
+	if err != nil {
+		t.Fatalf("GetPublic() 返回错误: %v", err)
+	}
+	if len(articles.gotIDs) != 3 {
+		t.Fatalf("应按全部文章ID查询, 实际为 %v", articles.gotIDs)
+	}
+	if len(got.Articles) != 2 || got.Articles[0].Title != "三" || got.Articles[1].Title != "一" {
+		t.Fatalf("文章顺序不正确: %+v", got.Articles)
+	}
+	if got.Articles[1].ContentMd != "" {
+		t.Fatal("前台合集不应返回 Markdown 正文")
+	}
+
+	collections.collection.IsPublished = false
+	if _, err := svc.GetPublic(context.Background(), "home-banner"); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("未公开的合集应返回 ErrNotFound, 实际为 %v", err)
+	}
+}
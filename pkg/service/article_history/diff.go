@@ -0,0 +1,248 @@
+/*
+ * @Description: 文章历史版本差异计算
+ * @Author: 安知鱼
+ * @Date: 2026-10-16
+ */
+package article_history
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	diffOpEqual  = "equal"
+	diffOpInsert = "insert"
+	diffOpDelete = "delete"
+
+	// diffContextLines 差异片段前后保留的上下文行数
+	diffContextLines = 3
+	// maxDiffCells 逐行 LCS 允许的最大计算量（旧行数×新行数），超出后退化为整段替换
+	maxDiffCells = 4_000_000
+)
+
+// historyMetadataKeys 快照 ExtraData 中参与对比的元数据
+var historyMetadataKeys = []string{"status", "abbrlink", "post_tag_ids", "post_category_ids"}
+
+// DiffVersions 计算从 from 版本到 to 版本的差异
+func (s *serviceImpl) DiffVersions(ctx context.Context, articlePublicID string, from, to int) (*model.ArticleRevisionDiffResponse, error) {
+	articleDBID, _, err := idgen.DecodePublicID(articlePublicID)
+	if err != nil {
+		return nil, fmt.Errorf("解码文章ID失败: %w", err)
+	}
+
+	oldVersion, err := s.historyRepo.GetByArticleAndVersion(ctx, articleDBID, from)
+	if err != nil {
+		return nil, fmt.Errorf("获取版本 %d 失败: %w", from, err)
+	}
+	newVersion, err := s.historyRepo.GetByArticleAndVersion(ctx, articleDBID, to)
+	if err != nil {
+		return nil, fmt.Errorf("获取版本 %d 失败: %w", to, err)
+	}
+
+	return DiffHistories(oldVersion, newVersion), nil
+}
+
+// DiffHistories 对比两个历史快照：元数据逐字段对比，Markdown 正文逐行对比
+func DiffHistories(oldVersion, newVersion *model.ArticleHistory) *model.ArticleRevisionDiffResponse {
+	ops, coarse := diffLines(splitLines(oldVersion.ContentMd), splitLines(newVersion.ContentMd))
+	return &model.ArticleRevisionDiffResponse{
+		From:   oldVersion.Version,
+		To:     newVersion.Version,
+		Fields: diffFields(oldVersion, newVersion),
+		Hunks:  buildHunks(ops, diffContextLines),
+		Coarse: coarse,
+	}
+}
+
+func diffFields(a, b *model.ArticleHistory) []model.ArticleRevisionFieldChange {
+	changes := make([]model.ArticleRevisionFieldChange, 0)
+	add := func(field string, oldVal, newVal interface{}) {
+		if !reflect.DeepEqual(oldVal, newVal) {
+			changes = append(changes, model.ArticleRevisionFieldChange{Field: field, Old: oldVal, New: newVal})
+		}
+	}
+	add("title", a.Title, b.Title)
+	add("cover_url", a.CoverURL, b.CoverURL)
+	add("top_img_url", a.TopImgURL, b.TopImgURL)
+	add("primary_color", a.PrimaryColor, b.PrimaryColor)
+	add("keywords", a.Keywords, b.Keywords)
+	add("summaries", nonNilStrings(a.Summaries), nonNilStrings(b.Summaries))
+	add("word_count", a.WordCount, b.WordCount)
+	for _, key := range historyMetadataKeys {
+		add(key, a.ExtraData[key], b.ExtraData[key])
+	}
+	// 正文只标记是否变化，具体差异见 hunks
+	if a.ContentMd != b.ContentMd {
+		changes = append(changes, model.ArticleRevisionFieldChange{Field: "content_md"})
+	}
+	if a.ContentHTML != b.ContentHTML {
+		changes = append(changes, model.ArticleRevisionFieldChange{Field: "content_html"})
+	}
+	return changes
+}
+
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(s, "\n")
+}
+
+type lineOp struct {
+	op   string
+	text string
+}
+
+// diffLines 计算逐行差异。先去掉公共前后缀，剩余部分用 LCS；计算量超过上限时整段替换并返回 coarse=true。
+func diffLines(a, b []string) ([]lineOp, bool) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]lineOp, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, lineOp{diffOpEqual, line})
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+	coarse := false
+	if len(midA)*len(midB) > maxDiffCells {
+		coarse = true
+		for _, line := range midA {
+			ops = append(ops, lineOp{diffOpDelete, line})
+		}
+		for _, line := range midB {
+			ops = append(ops, lineOp{diffOpInsert, line})
+		}
+	} else {
+		ops = append(ops, lcsDiff(midA, midB)...)
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, lineOp{diffOpEqual, line})
+	}
+	return ops, coarse
+}
+
+func lcsDiff(a, b []string) []lineOp {
+	n, m := len(a), len(b)
+	// dp[i][j] 为 a[i:] 与 b[j:] 的 LCS 长度
+	dp := make([][]int32, n+1)
+	for i := range dp {
+		dp[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else if dp[i+1][j] >= dp[i][j+1] {
+				dp[i][j] = dp[i+1][j]
+			} else {
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]lineOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, lineOp{diffOpEqual, a[i]})
+			i++
+			j++
+		case dp[i+1][j] >= dp[i][j+1]:
+			ops = append(ops, lineOp{diffOpDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, lineOp{diffOpInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, lineOp{diffOpDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, lineOp{diffOpInsert, b[j]})
+	}
+	return ops
+}
+
+// buildHunks 把逐行差异按上下文合并为片段，相距不超过两倍上下文的改动合并到同一片段
+func buildHunks(ops []lineOp, contextLines int) []model.ArticleRevisionDiffHunk {
+	// oldPos/newPos[k] 为第 k 个操作之前已经过的旧/新行数
+	oldPos := make([]int, len(ops)+1)
+	newPos := make([]int, len(ops)+1)
+	for k, op := range ops {
+		oldPos[k+1], newPos[k+1] = oldPos[k], newPos[k]
+		if op.op != diffOpInsert {
+			oldPos[k+1]++
+		}
+		if op.op != diffOpDelete {
+			newPos[k+1]++
+		}
+	}
+
+	hunks := make([]model.ArticleRevisionDiffHunk, 0)
+	n := len(ops)
+	i := 0
+	for i < n {
+		for i < n && ops[i].op == diffOpEqual {
+			i++
+		}
+		if i >= n {
+			break
+		}
+		start := max(i-contextLines, 0)
+		end := i
+		for {
+			for end < n && ops[end].op != diffOpEqual {
+				end++
+			}
+			next := end
+			for next < n && ops[next].op == diffOpEqual {
+				next++
+			}
+			if next < n && next-end <= 2*contextLines {
+				end = next
+				continue
+			}
+			end = min(end+contextLines, n)
+			break
+		}
+
+		lines := make([]model.ArticleRevisionDiffLine, 0, end-start)
+		for _, op := range ops[start:end] {
+			lines = append(lines, model.ArticleRevisionDiffLine{Op: op.op, Text: op.text})
+		}
+		hunks = append(hunks, model.ArticleRevisionDiffHunk{
+			OldStart: oldPos[start] + 1,
+			OldLines: oldPos[end] - oldPos[start],
+			NewStart: newPos[start] + 1,
+			NewLines: newPos[end] - newPos[start],
+			Lines:    lines,
+		})
+		i = end
+	}
+	return hunks
+}
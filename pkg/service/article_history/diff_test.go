@@ -0,0 +1,73 @@
+package article_history
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+func TestDiffHistoriesContentHunks(t *testing.T) {
+	oldMd := strings.Join([]string{"# 标题", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "尾"}, "\n")
+	newMd := strings.Join([]string{"# 新标题", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "尾", "追加"}, "\n")
+
+	diff := DiffHistories(
+		&model.ArticleHistory{Version: 1, Title: "旧", ContentMd: oldMd},
+		&model.ArticleHistory{Version: 2, Title: "新", ContentMd: newMd},
+	)
+
+	if diff.Coarse {
+		t.Fatal("小文本不应退化为整段替换")
+	}
+	if len(diff.Hunks) != 2 {
+		t.Fatalf("期望两个差异片段，实际 %d: %+v", len(diff.Hunks), diff.Hunks)
+	}
+	first := diff.Hunks[0]
+	if first.OldStart != 1 || first.NewStart != 1 || first.OldLines != 4 || first.NewLines != 4 {
+		t.Errorf("第一个片段范围错误: %+v", first)
+	}
+	if first.Lines[0].Op != diffOpDelete || first.Lines[1].Op != diffOpInsert {
+		t.Errorf("第一个片段应先删除旧标题再插入新标题: %+v", first.Lines)
+	}
+	last := diff.Hunks[1]
+	if got := last.Lines[len(last.Lines)-1]; got.Op != diffOpInsert || got.Text != "追加" {
+		t.Errorf("末尾追加行缺失: %+v", got)
+	}
+
+	fields := map[string]bool{}
+	for _, f := range diff.Fields {
+		fields[f.Field] = true
+	}
+	if !fields["title"] || !fields["content_md"] || fields["cover_url"] {
+		t.Errorf("字段差异错误: %+v", diff.Fields)
+	}
+}
+
+func TestDiffLinesCoarseFallback(t *testing.T) {
+	a := make([]string, 3000)
+	b := make([]string, 3000)
+	for i := range a {
+		a[i] = "old"
+		b[i] = "new"
+	}
+	ops, coarse := diffLines(a, b)
+	if !coarse {
+		t.Fatal("超出计算量上限时应整段替换")
+	}
+	if len(ops) != 6000 {
+		t.Errorf("期望 6000 个操作，实际 %d", len(ops))
+	}
+}
+
+func TestBuildRestoreRequest(t *testing.T) {
+	req := BuildRestoreRequest(&model.ArticleHistory{Version: 3, Title: "t", ContentMd: "md"}, "")
+	if *req.Title != "t" || *req.ContentMd != "md" || req.Summaries == nil {
+		t.Errorf("恢复请求字段错误: %+v", req)
+	}
+	if *req.ChangeNote != "恢复到版本 3" {
+		t.Errorf("默认变更说明错误: %s", *req.ChangeNote)
+	}
+	if req.PostTagIDs != nil || req.Status != nil {
+		t.Error("恢复不应修改标签与状态")
+	}
+}
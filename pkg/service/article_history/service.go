@@ -38,6 +38,9 @@ type Service interface {
 	// CompareVersions 对比两个版本
 	CompareVersions(ctx context.Context, articlePublicID string, v1, v2 int) (*model.ArticleHistoryCompareResponse, error)
 
+	// DiffVersions 计算从 from 版本到 to 版本的字段与正文差异
+	DiffVersions(ctx context.Context, articlePublicID string, from, to int) (*model.ArticleRevisionDiffResponse, error)
+
 	// RestoreVersion 恢复到指定版本（返回恢复后的文章数据，由调用方执行实际更新）
 	RestoreVersion(ctx context.Context, articlePublicID string, version int) (*model.ArticleHistory, error)
 
@@ -224,6 +227,29 @@ func (s *serviceImpl) RestoreVersion(ctx context.Context, articlePublicID string
 	return history, nil
 }
 
+// BuildRestoreRequest 根据历史版本构造文章更新请求。
+// 只恢复快照中的内容字段，分类、标签、状态等保持文章当前值；通过文章更新流程执行，恢复本身也会生成新的历史版本。
+func BuildRestoreRequest(history *model.ArticleHistory, changeNote string) *model.UpdateArticleRequest {
+	if changeNote == "" {
+		changeNote = fmt.Sprintf("恢复到版本 %d", history.Version)
+	}
+	summaries := history.Summaries
+	if summaries == nil {
+		summaries = []string{}
+	}
+	return &model.UpdateArticleRequest{
+		Title:        &history.Title,
+		ContentMd:    &history.ContentMd,
+		ContentHTML:  &history.ContentHTML,
+		CoverURL:     &history.CoverURL,
+		TopImgURL:    &history.TopImgURL,
+		PrimaryColor: &history.PrimaryColor,
+		Summaries:    summaries,
+		Keywords:     &history.Keywords,
+		ChangeNote:   &changeNote,
+	}
+}
+
 // GetHistoryCount 获取文章的历史版本数量
 func (s *serviceImpl) GetHistoryCount(ctx context.Context, articlePublicID string) (int, error) {
 	// 解码文章ID
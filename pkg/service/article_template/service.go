@@ -0,0 +1,187 @@
+/*
+ * @Description: 文章模板与内容片段服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-15 20:00:00
+ * @LastEditors: 安知鱼
+ */
+package article_template
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+)
+
+// Service 封装了文章模板与内容片段的业务逻辑。
+type Service struct {
+	templateRepo repository.ArticleTemplateRepository
+	snippetRepo  repository.ContentSnippetRepository
+	articleSvc   article_service.Service
+	parserSvc    *parser.Service
+}
+
+// NewService 是文章模板 Service 的构造函数。
+func NewService(
+	templateRepo repository.ArticleTemplateRepository,
+	snippetRepo repository.ContentSnippetRepository,
+	articleSvc article_service.Service,
+	parserSvc *parser.Service,
+) *Service {
+	return &Service{
+		templateRepo: templateRepo,
+		snippetRepo:  snippetRepo,
+		articleSvc:   articleSvc,
+		parserSvc:    parserSvc,
+	}
+}
+
+// --- 文章模板 ---
+
+// ListTemplates 获取全部文章模板。
+func (s *Service) ListTemplates(ctx context.Context) ([]*model.ArticleTemplate, error) {
+	return s.templateRepo.List(ctx)
+}
+
+// GetTemplate 根据ID获取文章模板。
+func (s *Service) GetTemplate(ctx context.Context, id string) (*model.ArticleTemplate, error) {
+	return s.templateRepo.GetByID(ctx, id)
+}
+
+// CreateTemplate 创建文章模板。
+func (s *Service) CreateTemplate(ctx context.Context, req *model.SaveArticleTemplateRequest) (*model.ArticleTemplate, error) {
+	normalizeTemplateRequest(req)
+	return s.templateRepo.Create(ctx, req)
+}
+
+// UpdateTemplate 更新文章模板。
+func (s *Service) UpdateTemplate(ctx context.Context, id string, req *model.SaveArticleTemplateRequest) (*model.ArticleTemplate, error) {
+	normalizeTemplateRequest(req)
+	return s.templateRepo.Update(ctx, id, req)
+}
+
+// DeleteTemplate 删除文章模板。
+func (s *Service) DeleteTemplate(ctx context.Context, id string) error {
+	return s.templateRepo.Delete(ctx, id)
+}
+
+// Instantiate 根据模板为指定用户创建一篇草稿，返回新文章。
+// 模板中的标题与正文会先替换 {{date}}、{{year}} 等占位符，正文 HTML 由解析服务渲染。
+func (s *Service) Instantiate(ctx context.Context, templateID string, ownerID uint, req *model.InstantiateTemplateRequest, ip, referer string) (*model.ArticleResponse, error) {
+	tpl, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = renderPlaceholders(tpl.Title, now, "")
+	}
+	if title == "" {
+		title = "未命名文章 " + now.Format("2006-01-02 15:04")
+	}
+	contentMd := renderPlaceholders(tpl.ContentMd, now, title)
+
+	contentHTML := ""
+	if contentMd != "" {
+		contentHTML, err = s.parserSvc.ToHTML(ctx, contentMd)
+		if err != nil {
+			return nil, fmt.Errorf("渲染模板正文失败: %w", err)
+		}
+	}
+
+	summaries := make([]string, 0, len(tpl.Summaries))
+	for _, summary := range tpl.Summaries {
+		summaries = append(summaries, renderPlaceholders(summary, now, title))
+	}
+
+	copyright := tpl.Copyright
+	isReprint := tpl.IsReprint
+	createReq := &model.CreateArticleRequest{
+		Title:               title,
+		ContentMd:           contentMd,
+		ContentHTML:         contentHTML,
+		Status:              "DRAFT",
+		CoverURL:            tpl.CoverURL,
+		TopImgURL:           tpl.TopImgURL,
+		Summaries:           summaries,
+		PostTagIDs:          tpl.PostTagIDs,
+		PostCategoryIDs:     tpl.PostCategoryIDs,
+		Keywords:            tpl.Keywords,
+		Copyright:           &copyright,
+		IsReprint:           &isReprint,
+		CopyrightAuthor:     tpl.CopyrightAuthor,
+		CopyrightAuthorHref: tpl.CopyrightAuthorHref,
+		CopyrightURL:        tpl.CopyrightURL,
+		OwnerID:             ownerID,
+	}
+
+	article, err := s.articleSvc.Create(ctx, createReq, ip, referer)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[ArticleTemplate] 已根据模板 '%s' 创建草稿: %s", tpl.Name, article.ID)
+	return article, nil
+}
+
+// --- 内容片段 ---
+
+// ListSnippets 获取全部内容片段。
+func (s *Service) ListSnippets(ctx context.Context) ([]*model.ContentSnippet, error) {
+	return s.snippetRepo.List(ctx)
+}
+
+// CreateSnippet 创建内容片段。
+func (s *Service) CreateSnippet(ctx context.Context, req *model.SaveContentSnippetRequest) (*model.ContentSnippet, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	return s.snippetRepo.Create(ctx, req)
+}
+
+// UpdateSnippet 更新内容片段。
+func (s *Service) UpdateSnippet(ctx context.Context, id string, req *model.SaveContentSnippetRequest) (*model.ContentSnippet, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	return s.snippetRepo.Update(ctx, id, req)
+}
+
+// DeleteSnippet 删除内容片段。
+func (s *Service) DeleteSnippet(ctx context.Context, id string) error {
+	return s.snippetRepo.Delete(ctx, id)
+}
+
+// normalizeTemplateRequest 去除名称首尾空白，并把空列表统一为非 nil，便于 JSON 字段存储
+func normalizeTemplateRequest(req *model.SaveArticleTemplateRequest) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Summaries == nil {
+		req.Summaries = []string{}
+	}
+	if req.PostTagIDs == nil {
+		req.PostTagIDs = []string{}
+	}
+	if req.PostCategoryIDs == nil {
+		req.PostCategoryIDs = []string{}
+	}
+}
+
+// renderPlaceholders 替换模板中的占位符：
+// {{date}} 2006-01-02、{{datetime}} 2006-01-02 15:04、{{year}}、{{month}}、{{day}}、{{title}}
+func renderPlaceholders(text string, now time.Time, title string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	return strings.NewReplacer(
+		"{{date}}", now.Format("2006-01-02"),
+		"{{datetime}}", now.Format("2006-01-02 15:04"),
+		"{{year}}", now.Format("2006"),
+		"{{month}}", now.Format("01"),
+		"{{day}}", now.Format("02"),
+		"{{title}}", title,
+	).Replace(text)
+}
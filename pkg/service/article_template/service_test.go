@@ -0,0 +1,15 @@
+package article_template
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRenderPlaceholders(t *testing.T) {
+	now := time.Date(2026, 3, 7, 9, 5, 0, 0, time.UTC)
+	got := renderPlaceholders("# {{title}}\n\n> {{date}} / {{year}}-{{month}}-{{day}} {{datetime}} {{unknown}}", now, "周报")
+	want := "# 周报\n\n> 2026-03-07 / 2026-03-07 2026-03-07 09:05 {{unknown}}"
+	if got != want {
+		t.Fatalf("renderPlaceholders() = %q, 期望 %q", got, want)
+	}
+}
@@ -0,0 +1,32 @@
+/*
+ * @Description: 审计日志的操作者上下文，由权限中间件写入，业务服务记录变更时读取
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 03:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package audit
+
+import "context"
+
+// Actor 发起后台变更请求的管理员信息
+type Actor struct {
+	UserID    uint
+	Method    string
+	Path      string
+	IP        string
+	UserAgent string
+}
+
+type actorKey struct{}
+
+// WithActor 返回携带操作者信息的上下文
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext 取出上下文中的操作者，定时任务等非后台请求中不存在
+func ActorFromContext(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(*Actor)
+	return actor, ok && actor != nil
+}
@@ -0,0 +1,188 @@
+/*
+ * @Description: 审计日志服务，记录并查询管理员的敏感操作与后台数据变更
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	// maxFieldLength 请求路径与 User-Agent 的最大保存长度，与表结构一致
+	maxFieldLength = 512
+	// maxEntityIDLength 对象ID的最大保存长度，与表结构一致
+	maxEntityIDLength = 128
+)
+
+// Service 审计日志服务
+type Service struct {
+	repo repository.AuditLogRepository
+}
+
+// NewService 创建审计日志服务
+func NewService(repo repository.AuditLogRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// Record 写入一条审计日志
+func (s *Service) Record(ctx context.Context, entry *model.AuditLog) error {
+	entry.Path = truncate(entry.Path, maxFieldLength)
+	entry.UserAgent = truncate(entry.UserAgent, maxFieldLength)
+	entry.EntityID = truncate(entry.EntityID, maxEntityIDLength)
+	if err := s.repo.Create(ctx, entry); err != nil {
+		log.Printf("[审计日志] 写入失败 action=%s actor=%d subject=%d: %v", entry.Action, entry.ActorID, entry.SubjectUserID, err)
+		return fmt.Errorf("写入审计日志失败: %w", err)
+	}
+	return nil
+}
+
+// RecordChange 记录一次后台数据变更，before/after 会序列化为 JSON 快照（新建时 before 为 nil，删除时 after 为 nil）。
+// 只有经过权限中间件的后台请求才会记录，定时任务等没有操作者的调用直接忽略；
+// 写入失败不影响业务操作。s 为 nil 时不做任何事，便于各服务按需注入。
+func (s *Service) RecordChange(ctx context.Context, action, entityType, entityID string, before, after any) {
+	if s == nil {
+		return
+	}
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return
+	}
+	_ = s.Record(context.WithoutCancel(ctx), &model.AuditLog{
+		ActorID:    actor.UserID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Method:     actor.Method,
+		Path:       actor.Path,
+		IP:         actor.IP,
+		UserAgent:  actor.UserAgent,
+		Before:     marshalSnapshot(before),
+		After:      marshalSnapshot(after),
+	})
+}
+
+// ListFilter 审计日志查询条件，用户ID为公共ID，时间支持 RFC3339 或 2006-01-02 格式，均可为空
+type ListFilter struct {
+	ActorID    string
+	SubjectID  string
+	Action     string
+	EntityType string
+	EntityID   string
+	StartTime  string
+	EndTime    string
+	Page       int
+	PageSize   int
+}
+
+// List 分页查询审计日志
+func (s *Service) List(ctx context.Context, filter *ListFilter) ([]*model.AuditLog, int, error) {
+	query := &model.AuditLogQuery{
+		Action:     filter.Action,
+		EntityType: filter.EntityType,
+		EntityID:   filter.EntityID,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	}
+	var err error
+	if query.ActorID, err = decodeUserID(filter.ActorID); err != nil {
+		return nil, 0, err
+	}
+	if query.SubjectUserID, err = decodeUserID(filter.SubjectID); err != nil {
+		return nil, 0, err
+	}
+	if query.StartTime, err = parseTime(filter.StartTime, false); err != nil {
+		return nil, 0, err
+	}
+	if query.EndTime, err = parseTime(filter.EndTime, true); err != nil {
+		return nil, 0, err
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PageSize <= 0 || query.PageSize > 100 {
+		query.PageSize = 20
+	}
+
+	entries, total, err := s.repo.List(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, e := range entries {
+		e.ActorPublicID, _ = idgen.GeneratePublicID(e.ActorID, idgen.EntityTypeUser)
+		if e.SubjectUserID != 0 {
+			e.SubjectPublicID, _ = idgen.GeneratePublicID(e.SubjectUserID, idgen.EntityTypeUser)
+		}
+		if e.Before != "" {
+			e.BeforeData = json.RawMessage(e.Before)
+		}
+		if e.After != "" {
+			e.AfterData = json.RawMessage(e.After)
+		}
+	}
+	return entries, total, nil
+}
+
+// marshalSnapshot 将快照序列化为 JSON，nil 或无法序列化时返回空字符串
+func marshalSnapshot(v any) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("[审计日志] 快照序列化失败: %v", err)
+		return ""
+	}
+	if string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+// parseTime 解析查询时间；只给出日期作为结束时间时包含当天全天
+func parseTime(value string, isEnd bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("无效的时间: %s: %w", value, constant.ErrBadRequest)
+	}
+	if isEnd {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+func decodeUserID(publicID string) (uint, error) {
+	if publicID == "" {
+		return 0, nil
+	}
+	id, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return 0, fmt.Errorf("无效的用户ID: %s: %w", publicID, constant.ErrBadRequest)
+	}
+	return id, nil
+}
+
+func truncate(s string, max int) string {
+	if runes := []rune(s); len(runes) > max {
+		return string(runes[:max])
+	}
+	return s
+}
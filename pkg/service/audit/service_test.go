@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+type fakeAuditRepo struct {
+	entries []*model.AuditLog
+	query   *model.AuditLogQuery
+}
+
+func (f *fakeAuditRepo) Create(ctx context.Context, entry *model.AuditLog) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+func (f *fakeAuditRepo) List(ctx context.Context, query *model.AuditLogQuery) ([]*model.AuditLog, int, error) {
+	f.query = query
+	return f.entries, len(f.entries), nil
+}
+
+func TestRecordChange(t *testing.T) {
+	repo := &fakeAuditRepo{}
+	svc := NewService(repo)
+
+	svc.RecordChange(context.Background(), model.AuditActionArticleUpdate, model.AuditEntityArticle, "a1", nil, map[string]string{"title": "新"})
+	if len(repo.entries) != 0 {
+		t.Fatal("没有操作者的调用（如定时任务）不应记录")
+	}
+	var nilSvc *Service
+	nilSvc.RecordChange(context.Background(), model.AuditActionArticleUpdate, model.AuditEntityArticle, "a1", nil, nil)
+
+	ctx := WithActor(context.Background(), &Actor{UserID: 7, Method: "PUT", Path: "/api/articles/a1", IP: "127.0.0.1"})
+	var deleted *model.Article
+	svc.RecordChange(ctx, model.AuditActionArticleCreate, model.AuditEntityArticle, "a1", deleted, map[string]string{"title": "新"})
+	if len(repo.entries) != 1 {
+		t.Fatalf("应写入一条审计日志: %d", len(repo.entries))
+	}
+	entry := repo.entries[0]
+	if entry.ActorID != 7 || entry.Method != "PUT" || entry.EntityType != model.AuditEntityArticle || entry.EntityID != "a1" {
+		t.Errorf("审计日志字段错误: %+v", entry)
+	}
+	if entry.Before != "" || entry.After != `{"title":"新"}` {
+		t.Errorf("快照错误: before=%q after=%q", entry.Before, entry.After)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	repo := &fakeAuditRepo{entries: []*model.AuditLog{{ActorID: 1, Before: `{"a":1}`}}}
+	svc := NewService(repo)
+	ctx := context.Background()
+
+	list, _, err := svc.List(ctx, &ListFilter{EntityType: model.AuditEntitySetting, StartTime: "2026-10-01", EndTime: "2026-10-02"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(list[0].BeforeData) != `{"a":1}` || list[0].AfterData != nil {
+		t.Errorf("快照应原样返回: %s %s", list[0].BeforeData, list[0].AfterData)
+	}
+	q := repo.query
+	if q.EntityType != model.AuditEntitySetting || q.Page != 1 || q.PageSize != 20 {
+		t.Errorf("查询条件错误: %+v", q)
+	}
+	if got := q.EndTime.Sub(*q.StartTime); got != 48*time.Hour {
+		t.Errorf("只给日期的结束时间应包含当天: %v", got)
+	}
+
+	if _, _, err := svc.List(ctx, &ListFilter{StartTime: "昨天"}); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("无效时间应返回参数错误: %v", err)
+	}
+}
@@ -17,15 +17,19 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/security"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	articleSvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/disposable_email"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/password"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 	"github.com/lib/pq"
@@ -35,12 +39,27 @@ var (
 	ErrInvalidCredentials = errors.New("账号或密码错误")
 	ErrPasswordIncorrect  = errors.New("密码错误，请核对后登录。")
 	ErrAuthServiceBusy    = errors.New("登录服务暂时不可用，请稍后重试")
+	// ErrSetupRequired 站点尚未完成初始化时，管理员账户只能通过初始化向导创建
+	ErrSetupRequired = errors.New("站点尚未完成初始化，请先通过初始化向导创建管理员账户")
+	// ErrAdminAlreadyExists 初始化向导创建管理员时已存在用户
+	ErrAdminAlreadyExists = errors.New("管理员账户已存在")
+	// ErrRegistrationClosed 站点关闭了公开注册
+	ErrRegistrationClosed = errors.New("本站暂未开放注册")
+	// ErrEmailDomainNotAllowed 注册邮箱的域名不在允许列表中
+	ErrEmailDomainNotAllowed = errors.New("该邮箱域名不允许注册")
+	// ErrInvitationCodeRequired 站点要求凭邀请码注册，但未提供邀请码
+	ErrInvitationCodeRequired = errors.New("本站仅允许凭邀请码注册，请填写邀请码")
+	// ErrInvitationCodeInvalid 邀请码不存在、已用尽或已过期
+	ErrInvitationCodeInvalid = errors.New("邀请码无效、已用尽或已过期")
 )
 
 // AuthService 定义了所有认证授权相关的业务逻辑接口
 type AuthService interface {
 	Login(ctx context.Context, email, password string) (*model.User, error)
-	Register(ctx context.Context, email, nickname, password string) (activationRequired bool, err error)
+	// Register 公开注册，会校验注册开关、邮箱域名限制、一次性邮箱与邀请码
+	Register(ctx context.Context, email, nickname, password, invitationCode string) (*RegisterResult, error)
+	// RegisterInitialAdmin 由初始化向导调用，创建第一个（管理员）账户，跳过激活流程
+	RegisterInitialAdmin(ctx context.Context, email, nickname, password string) error
 	// ActivateUser 现在接收内部数据库 ID (uint)
 	ActivateUser(ctx context.Context, userID uint, sign string) error
 	RequestPasswordReset(ctx context.Context, email string) error
@@ -49,6 +68,16 @@ type AuthService interface {
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
 	// GetUserByID 通过用户ID获取用户信息
 	GetUserByID(ctx context.Context, userID uint) (*model.User, error)
+	// RegisterWithOAuth 使用第三方账号资料注册新用户，遵循注册开关、邮箱域名与邀请码限制，账户直接激活
+	RegisterWithOAuth(ctx context.Context, profile *OAuthProfile) (*model.User, error)
+}
+
+// RegisterResult 是公开注册的结果
+type RegisterResult struct {
+	// ActivationRequired 账户需要通过激活邮件激活
+	ActivationRequired bool `json:"activation_required"`
+	// ReviewRequired 账户使用了一次性邮箱，需要管理员审核启用
+	ReviewRequired bool `json:"review_required"`
 }
 
 // authService 是 AuthService 接口的实现
@@ -59,6 +88,10 @@ type authService struct {
 	emailSvc   utility.EmailService
 	txManager  repository.TransactionManager
 	articleSvc articleSvc.Service
+	// passwordPolicy 为 nil 时不做额外的密码强度校验
+	passwordPolicy *password.Service
+	// disposableEmail 为 nil 时不检查一次性邮箱
+	disposableEmail *disposable_email.Service
 }
 
 // NewAuthService 是 authService 的构造函数
@@ -69,14 +102,18 @@ func NewAuthService(
 	emailSvc utility.EmailService,
 	txManager repository.TransactionManager,
 	articleSvc articleSvc.Service,
+	passwordPolicy *password.Service,
+	disposableEmail *disposable_email.Service,
 ) AuthService {
 	return &authService{
-		userRepo:   userRepo,
-		settingSvc: settingSvc,
-		tokenSvc:   tokenSvc,
-		emailSvc:   emailSvc,
-		txManager:  txManager,
-		articleSvc: articleSvc,
+		userRepo:        userRepo,
+		settingSvc:      settingSvc,
+		tokenSvc:        tokenSvc,
+		emailSvc:        emailSvc,
+		txManager:       txManager,
+		articleSvc:      articleSvc,
+		passwordPolicy:  passwordPolicy,
+		disposableEmail: disposableEmail,
 	}
 }
 
@@ -155,6 +192,9 @@ func (s *authService) Login(ctx context.Context, email, password string) (*model
 	if user.Status == model.UserStatusInactive {
 		return nil, fmt.Errorf("您的账户尚未激活，请检查您的邮箱以完成激活流程")
 	}
+	if user.Status == model.UserStatusPendingReview {
+		return nil, fmt.Errorf("您的账户正在等待管理员审核")
+	}
 	if user.Status == model.UserStatusBanned {
 		return nil, fmt.Errorf("您的账户已被封禁，请联系管理员")
 	}
@@ -181,29 +221,123 @@ func isDatabaseTemporarilyUnavailable(err error) bool {
 	return false
 }
 
+// registerRequest 是注册流程的参数
+type registerRequest struct {
+	email          string
+	nickname       string
+	password       string
+	invitationCode string
+	// initialAdmin 为 true 时要求当前没有任何用户，且账户直接激活，并跳过注册开关、邮箱域名与邀请码等公开注册限制
+	initialAdmin bool
+	// oauth 非 nil 表示通过第三方登录注册：邮箱已由第三方平台验证，账户直接激活，
+	// 密码设为随机值（用户可通过找回密码设置），头像与个人主页取自第三方资料
+	oauth *OAuthProfile
+}
+
 // Register 实现了最终的用户注册逻辑
 // 它会为新用户创建根目录，并在首次注册时初始化系统内置的存储策略及其关联的虚拟目录。
-func (s *authService) Register(ctx context.Context, email, nickname, password string) (bool, error) {
+func (s *authService) Register(ctx context.Context, email, nickname, password, invitationCode string) (*RegisterResult, error) {
+	// 邀请码只包含大写字母与数字，输入时不区分大小写
+	_, result, err := s.register(ctx, registerRequest{
+		email:          email,
+		nickname:       nickname,
+		password:       password,
+		invitationCode: strings.ToUpper(strings.TrimSpace(invitationCode)),
+	})
+	return result, err
+}
+
+// RegisterInitialAdmin 创建第一个用户作为管理员，仅允许在系统中还没有任何用户时调用
+func (s *authService) RegisterInitialAdmin(ctx context.Context, email, nickname, password string) error {
+	_, _, err := s.register(ctx, registerRequest{email: email, nickname: nickname, password: password, initialAdmin: true})
+	return err
+}
+
+// RegisterWithOAuth 使用第三方账号资料注册新用户
+func (s *authService) RegisterWithOAuth(ctx context.Context, profile *OAuthProfile) (*model.User, error) {
+	randomPassword, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, fmt.Errorf("生成随机密码失败: %w", err)
+	}
+	user, _, err := s.register(ctx, registerRequest{
+		email:    profile.Email,
+		nickname: profile.Nickname,
+		password: randomPassword,
+		oauth:    profile,
+	})
+	return user, err
+}
+
+// register 是注册流程的公共实现
+func (s *authService) register(ctx context.Context, req registerRequest) (*model.User, *RegisterResult, error) {
+	initialAdmin := req.initialAdmin
+	invitationCode := req.invitationCode
+	password := req.password
 	// email转为小写
-	email = strings.ToLower(strings.TrimSpace(email))
+	email := strings.ToLower(strings.TrimSpace(req.email))
 	// nickname去除首尾空格
-	nickname = strings.TrimSpace(nickname)
+	nickname := strings.TrimSpace(req.nickname)
+
+	inviteRequired := false
+	reviewRequired := false
+	if !initialAdmin {
+		if !s.settingSvc.GetBool(constant.KeyEnableRegistration.String()) {
+			return nil, nil, ErrRegistrationClosed
+		}
+		if domains := parseEmailDomains(s.settingSvc.Get(constant.KeyRegistrationDomains.String())); !emailDomainAllowed(email, domains) {
+			return nil, nil, fmt.Errorf("%w，仅支持以下域名: %s", ErrEmailDomainNotAllowed, strings.Join(domains, ", "))
+		}
+		if s.disposableEmail != nil {
+			switch s.disposableEmail.Match(disposable_email.ScopeRegister, email) {
+			case disposable_email.ActionReject:
+				s.disposableEmail.Record(ctx, disposable_email.ScopeRegister, disposable_email.ActionReject)
+				return nil, nil, constant.ErrDisposableEmail
+			case disposable_email.ActionHold:
+				// 第三方登录注册后会立即登录，无法等待审核，按拒绝处理
+				if req.oauth != nil {
+					s.disposableEmail.Record(ctx, disposable_email.ScopeRegister, disposable_email.ActionReject)
+					return nil, nil, constant.ErrDisposableEmail
+				}
+				reviewRequired = true
+			}
+		}
+		inviteRequired = s.settingSvc.GetBool(constant.KeyRegistrationInvite.String())
+		if inviteRequired && req.oauth != nil {
+			return nil, nil, fmt.Errorf("%w，请先使用邀请码注册后再绑定第三方账号", ErrInvitationCodeRequired)
+		}
+		if inviteRequired && invitationCode == "" {
+			return nil, nil, ErrInvitationCodeRequired
+		}
+	}
+	if req.oauth == nil {
+		if err := s.validatePassword(ctx, password); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	if existing, err := s.userRepo.FindByEmail(ctx, email); err != nil {
-		return false, fmt.Errorf("查询邮箱时数据库出错: %w", err)
+		return nil, nil, fmt.Errorf("查询邮箱时数据库出错: %w", err)
 	} else if existing != nil {
-		return false, fmt.Errorf("该邮箱已被注册")
+		return nil, nil, fmt.Errorf("该邮箱已被注册")
 	}
 	userCount, err := s.userRepo.Count(ctx)
 	if err != nil {
-		return false, fmt.Errorf("获取用户总数失败: %w", err)
+		return nil, nil, fmt.Errorf("获取用户总数失败: %w", err)
 	}
 	isFirstUser := userCount == 0
+	if initialAdmin && !isFirstUser {
+		return nil, nil, ErrAdminAlreadyExists
+	}
+	// 未完成初始化时不允许通过公开注册抢占管理员账户
+	if isFirstUser && !initialAdmin && !s.settingSvc.GetBool(constant.KeySetupCompleted.String()) {
+		return nil, nil, ErrSetupRequired
+	}
 	assignedUserGroupID := uint(2)
 	if isFirstUser {
 		assignedUserGroupID = 1
 	}
-	activationEnabled := s.settingSvc.Get(constant.KeyEnableUserActivation.String()) == "true"
+	// 需要审核的账户由管理员启用，不发送激活邮件
+	activationEnabled := !initialAdmin && req.oauth == nil && !reviewRequired && s.settingSvc.Get(constant.KeyEnableUserActivation.String()) == "true"
 	hashedPassword, _ := security.HashPassword(password)
 	// 如果昵称为空，则使用邮箱前缀作为默认昵称
 	if nickname == "" {
@@ -224,6 +358,15 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 	if activationEnabled {
 		newUser.Status = model.UserStatusInactive
 	}
+	if reviewRequired {
+		newUser.Status = model.UserStatusPendingReview
+	}
+	if req.oauth != nil {
+		if req.oauth.Avatar != "" {
+			newUser.Avatar = req.oauth.Avatar
+		}
+		newUser.Website = req.oauth.ProfileURL
+	}
 
 	// --- 步骤3：在单个事务中执行所有数据库写操作 ---
 	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
@@ -232,12 +375,23 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 		policyRepo := repos.StoragePolicy
 		userGroupRepo := repos.UserGroup
 
-		// 3a: 创建用户记录
+		// 3a: 占用邀请码的一次使用次数，注册失败时随事务回滚
+		if inviteRequired {
+			ok, err := repos.InvitationCode.Consume(ctx, invitationCode, time.Now())
+			if err != nil {
+				return fmt.Errorf("校验邀请码失败: %w", err)
+			}
+			if !ok {
+				return ErrInvitationCodeInvalid
+			}
+		}
+
+		// 3b: 创建用户记录
 		if err := userRepo.Create(ctx, newUser); err != nil {
 			return fmt.Errorf("创建用户失败: %w", err)
 		}
 
-		// 3b: 为新用户创建个人根目录File记录 (ParentID为NULL)
+		// 3c: 为新用户创建个人根目录File记录 (ParentID为NULL)
 		userRootDir := &model.File{
 			OwnerID: newUser.ID,
 			Name:    "", // 根目录的名称约定为空字符串
@@ -247,7 +401,7 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 			return fmt.Errorf("为用户创建根目录失败: %w", err)
 		}
 
-		// 3c: 如果是第一个用户注册，则创建系统内置的存储策略和关联的虚拟目录
+		// 3d: 如果是第一个用户注册，则创建系统内置的存储策略和关联的虚拟目录
 		if isFirstUser {
 			log.Println("检测到是第一个用户注册，正在创建内置存储策略及关联目录...")
 			articleAbsPath, err := filepath.Abs(constant.DefaultArticlePolicyPath)
@@ -353,13 +507,13 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 			log.Printf("内置存储策略 '%s' 创建成功。", avatarPolicy.Name)
 		}
 
-		// 3d: 获取用户组的配置
+		// 3e: 获取用户组的配置
 		userGroup, err := userGroupRepo.FindByID(ctx, newUser.UserGroupID)
 		if err != nil {
 			return fmt.Errorf("查找用户组配置失败 (ID: %d): %w", newUser.UserGroupID, err)
 		}
 
-		// 3e: 将除第一个策略外的其他策略，创建为根目录下的子目录
+		// 3f: 将除第一个策略外的其他策略，创建为根目录下的子目录
 		if len(userGroup.Settings.PolicyOrdering) > 1 {
 			remainingPolicyIDs := userGroup.Settings.PolicyOrdering[1:]
 			for _, policyID := range remainingPolicyIDs {
@@ -385,7 +539,11 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 	})
 
 	if err != nil {
-		return false, err
+		return nil, nil, err
+	}
+
+	if reviewRequired {
+		s.disposableEmail.Record(ctx, disposable_email.ScopeRegister, disposable_email.ActionHold)
 	}
 
 	// 异步为第一个用户（管理员）创建一篇默认文章
@@ -397,17 +555,57 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 	if activationEnabled {
 		publicUserID, err := idgen.GeneratePublicID(newUser.ID, idgen.EntityTypeUser)
 		if err != nil {
-			return false, fmt.Errorf("用户已创建，但生成激活邮件公共ID失败: %w", err)
+			return nil, nil, fmt.Errorf("用户已创建，但生成激活邮件公共ID失败: %w", err)
 		}
 
-		sign, err := s.tokenSvc.GenerateSignedToken(publicUserID, 24*time.Hour)
+		sign, err := s.tokenSvc.GenerateSignedToken(signedTokenIdentifier(tokenPurposeActivation, publicUserID), activationTokenTTL)
 		if err != nil {
-			return false, fmt.Errorf("用户已创建，但生成激活令牌失败: %w", err)
+			return nil, nil, fmt.Errorf("用户已创建，但生成激活令牌失败: %w", err)
 		}
 		go s.emailSvc.SendActivationEmail(context.Background(), newUser.Email, newUser.Nickname, publicUserID, sign)
 	}
 
-	return activationEnabled, nil
+	return newUser, &RegisterResult{ActivationRequired: activationEnabled, ReviewRequired: reviewRequired}, nil
+}
+
+// 签名令牌的用途，签名内容包含用途，激活链接与重置密码链接不能互相替代
+const (
+	tokenPurposeActivation    = "activation"
+	tokenPurposePasswordReset = "password_reset"
+)
+
+// 签名令牌的有效期
+const (
+	activationTokenTTL    = 24 * time.Hour
+	passwordResetTokenTTL = 1 * time.Hour
+)
+
+// signedTokenIdentifier 返回带用途前缀的签名标识
+func signedTokenIdentifier(purpose, publicUserID string) string {
+	return purpose + ":" + publicUserID
+}
+
+// verifyPurposeToken 校验带用途的签名令牌。
+// 升级前发出的激活与重置密码链接签名中没有用途前缀，为避免升级后这些链接全部失效，
+// 剩余有效期不超过该用途有效期的旧签名仍然接受；此后不再签发不带前缀的令牌，旧链接最迟在一个有效期后自然过期。
+// 剩余有效期的限制使尚有 1 小时以上的旧激活链接不能用于重置密码。
+func (s *authService) verifyPurposeToken(purpose, publicUserID, sign string, ttl time.Duration) error {
+	err := s.tokenSvc.VerifySignedToken(signedTokenIdentifier(purpose, publicUserID), sign)
+	if err == nil {
+		return nil
+	}
+	_, expiryStr, ok := strings.Cut(sign, ":")
+	if !ok {
+		return err
+	}
+	expiry, parseErr := strconv.ParseInt(expiryStr, 10, 64)
+	if parseErr != nil || time.Until(time.Unix(expiry, 0)) > ttl {
+		return err
+	}
+	if legacyErr := s.tokenSvc.VerifySignedToken(publicUserID, sign); legacyErr != nil {
+		return err
+	}
+	return nil
 }
 
 // ActivateUser 实现了激活用户的业务逻辑
@@ -419,7 +617,7 @@ func (s *authService) ActivateUser(ctx context.Context, userID uint, sign string
 		return fmt.Errorf("无法为激活验证生成公共用户ID: %w", err)
 	}
 
-	if err := s.tokenSvc.VerifySignedToken(publicUserID, sign); err != nil {
+	if err := s.verifyPurposeToken(tokenPurposeActivation, publicUserID, sign, activationTokenTTL); err != nil {
 		return fmt.Errorf("链接无效或已过期: %w", err)
 	}
 
@@ -453,7 +651,7 @@ func (s *authService) RequestPasswordReset(ctx context.Context, email string) er
 		return fmt.Errorf("生成重置密码邮件公共ID失败: %w", err)
 	}
 
-	sign, err := s.tokenSvc.GenerateSignedToken(publicUserID, 1*time.Hour) // 令牌使用公共 ID
+	sign, err := s.tokenSvc.GenerateSignedToken(signedTokenIdentifier(tokenPurposePasswordReset, publicUserID), passwordResetTokenTTL)
 	if err != nil {
 		return fmt.Errorf("生成重置令牌失败: %w", err)
 	}
@@ -471,7 +669,7 @@ func (s *authService) PerformPasswordReset(ctx context.Context, userID uint, sig
 		return fmt.Errorf("无法为重置密码验证生成公共用户ID: %w", err)
 	}
 
-	if err := s.tokenSvc.VerifySignedToken(publicUserID, sign); err != nil {
+	if err := s.verifyPurposeToken(tokenPurposePasswordReset, publicUserID, sign, passwordResetTokenTTL); err != nil {
 		return fmt.Errorf("链接无效或已过期: %w", err)
 	}
 	// 使用 FindByID 通过内部数据库 ID 查询用户
@@ -482,6 +680,9 @@ func (s *authService) PerformPasswordReset(ctx context.Context, userID uint, sig
 	if user == nil {
 		return fmt.Errorf("用户不存在")
 	}
+	if err := s.validatePassword(ctx, newPassword); err != nil {
+		return err
+	}
 
 	newHashedPassword, _ := security.HashPassword(newPassword)
 	user.PasswordHash = newHashedPassword
@@ -509,3 +710,41 @@ func (s *authService) GetUserByID(ctx context.Context, userID uint) (*model.User
 	}
 	return user, nil
 }
+
+// validatePassword 按站点密码策略校验新密码
+func (s *authService) validatePassword(ctx context.Context, pw string) error {
+	if s.passwordPolicy == nil {
+		return nil
+	}
+	return s.passwordPolicy.Validate(ctx, pw)
+}
+
+// parseEmailDomains 解析逗号分隔的允许注册邮箱域名配置，统一转为小写并去掉可选的 "@" 前缀
+func parseEmailDomains(raw string) []string {
+	var domains []string
+	for _, part := range strings.Split(raw, ",") {
+		domain := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(part)), "@")
+		if domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+// emailDomainAllowed 判断邮箱域名是否在允许列表中，列表为空表示不限制
+func emailDomainAllowed(email string, domains []string) bool {
+	if len(domains) == 0 {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+	for _, allowed := range domains {
+		if domain == allowed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/disposable_email"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+func TestRegister_EnforcesRegistrationSettings(t *testing.T) {
+	cases := []struct {
+		name     string
+		values   map[string]string
+		email    string
+		code     string
+		expected error
+	}{
+		{
+			name:     "registration closed",
+			values:   map[string]string{constant.KeyEnableRegistration.String(): "false"},
+			email:    "user@example.com",
+			expected: ErrRegistrationClosed,
+		},
+		{
+			name: "domain not allowed",
+			values: map[string]string{
+				constant.KeyEnableRegistration.String():  "true",
+				constant.KeyRegistrationDomains.String(): "example.com, @corp.example.org",
+			},
+			email:    "user@gmail.com",
+			expected: ErrEmailDomainNotAllowed,
+		},
+		{
+			name: "invitation code missing",
+			values: map[string]string{
+				constant.KeyEnableRegistration.String():  "true",
+				constant.KeyRegistrationDomains.String(): "corp.example.org",
+				constant.KeyRegistrationInvite.String():  "true",
+			},
+			email:    "User@Corp.Example.org",
+			code:     "  ",
+			expected: ErrInvitationCodeRequired,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAuthService(nil, &fakeSettings{values: tc.values}, nil, nil, nil, nil, nil, nil)
+			_, err := svc.Register(context.Background(), tc.email, "", "password", tc.code)
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestEmailDomainAllowed(t *testing.T) {
+	domains := parseEmailDomains(" Example.com ,, @corp.example.org")
+	if len(domains) != 2 || domains[0] != "example.com" || domains[1] != "corp.example.org" {
+		t.Fatalf("unexpected domains: %v", domains)
+	}
+	if !emailDomainAllowed("a@example.com", domains) || !emailDomainAllowed("b@corp.example.org", domains) {
+		t.Fatal("expected listed domains to be allowed")
+	}
+	if emailDomainAllowed("c@sub.example.com", domains) || emailDomainAllowed("d@gmail.com", domains) {
+		t.Fatal("expected unlisted domains to be rejected")
+	}
+	if !emailDomainAllowed("e@gmail.com", nil) {
+		t.Fatal("expected empty list to allow all domains")
+	}
+}
+
+func TestRegister_RejectsDisposableEmail(t *testing.T) {
+	settings := &fakeSettings{values: map[string]string{
+		constant.KeyEnableRegistration.String():            "true",
+		constant.KeyDisposableEmailRegisterAction.String(): "reject",
+	}}
+	svc := NewAuthService(nil, settings, nil, nil, nil, nil, nil, disposable_email.NewService(settings, utility.NewMemoryCacheService(), nil))
+	if _, err := svc.Register(context.Background(), "user@mailinator.com", "", "password", ""); !errors.Is(err, constant.ErrDisposableEmail) {
+		t.Fatalf("expected disposable email to be rejected, got %v", err)
+	}
+
+	// 第三方登录无法等待审核，hold 同样拒绝
+	settings.values[constant.KeyDisposableEmailRegisterAction.String()] = "hold"
+	if _, err := svc.RegisterWithOAuth(context.Background(), &OAuthProfile{Email: "user@yopmail.com"}); !errors.Is(err, constant.ErrDisposableEmail) {
+		t.Fatalf("expected oauth registration to be rejected, got %v", err)
+	}
+}
+
+func TestActivateUser_RejectsResetTokenAndPendingReview(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("token-service-test"); err != nil {
+		t.Fatal(err)
+	}
+	settings := &fakeSettings{values: map[string]string{constant.KeyJWTSecret.String(): "secret"}}
+	tokenSvc := NewTokenService(nil, settings, nil)
+	repo := &fakeOAuthUserRepo{users: map[uint]*model.User{
+		1: {ID: 1, Status: model.UserStatusInactive},
+		2: {ID: 2, Status: model.UserStatusPendingReview},
+	}}
+	svc := NewAuthService(repo, settings, tokenSvc, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+	sign := func(purpose string, id uint) string {
+		publicID, _ := idgen.GeneratePublicID(id, idgen.EntityTypeUser)
+		s, err := tokenSvc.GenerateSignedToken(signedTokenIdentifier(purpose, publicID), time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	// 重置密码链接中的签名不能用于激活
+	if err := svc.ActivateUser(ctx, 1, sign(tokenPurposePasswordReset, 1)); err == nil {
+		t.Fatal("重置密码令牌不应能激活账户")
+	}
+	// 待审核账户只能由管理员启用
+	if err := svc.ActivateUser(ctx, 2, sign(tokenPurposeActivation, 2)); err == nil || repo.users[2].Status != model.UserStatusPendingReview {
+		t.Fatal("待审核账户不应能通过激活链接启用")
+	}
+	if err := svc.ActivateUser(ctx, 1, sign(tokenPurposeActivation, 1)); err != nil || repo.users[1].Status != model.UserStatusActive {
+		t.Fatalf("激活令牌应能激活账户: %v", err)
+	}
+}
+
+func TestVerifyPurposeToken_AcceptsLegacySignatureWithinTTL(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("token-service-test"); err != nil {
+		t.Fatal(err)
+	}
+	settings := &fakeSettings{values: map[string]string{constant.KeyJWTSecret.String(): "secret"}}
+	tokenSvc := NewTokenService(nil, settings, nil)
+	repo := &fakeOAuthUserRepo{users: map[uint]*model.User{1: {ID: 1, Status: model.UserStatusInactive}}}
+	svc := NewAuthService(repo, settings, tokenSvc, nil, nil, nil, nil, nil).(*authService)
+	publicID, _ := idgen.GeneratePublicID(1, idgen.EntityTypeUser)
+	legacy := func(ttl time.Duration) string {
+		s, err := tokenSvc.GenerateSignedToken(publicID, ttl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	// 升级前签发、不带用途前缀的链接在原有效期内仍可使用
+	if err := svc.verifyPurposeToken(tokenPurposeActivation, publicID, legacy(activationTokenTTL), activationTokenTTL); err != nil {
+		t.Errorf("有效期内的旧激活链接应被接受: %v", err)
+	}
+	if err := svc.verifyPurposeToken(tokenPurposePasswordReset, publicID, legacy(passwordResetTokenTTL), passwordResetTokenTTL); err != nil {
+		t.Errorf("有效期内的旧重置链接应被接受: %v", err)
+	}
+	// 剩余有效期超过 1 小时的旧激活链接不能用于重置密码
+	if err := svc.verifyPurposeToken(tokenPurposePasswordReset, publicID, legacy(activationTokenTTL), passwordResetTokenTTL); err == nil {
+		t.Error("旧激活链接不应能用于重置密码")
+	}
+}
@@ -0,0 +1,370 @@
+/*
+ * @Description: 第三方登录平台抽象，以及 GitHub、Google、QQ 的 OAuth2 授权码流程实现
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// maxOAuthResponseSize 第三方平台接口响应体的最大读取长度
+const maxOAuthResponseSize = 1 << 20
+
+// OAuthProfile 是从第三方平台获取的用户资料
+type OAuthProfile struct {
+	Provider string
+	// ID 第三方平台的用户唯一标识
+	ID       string
+	Nickname string
+	Avatar   string
+	Email    string
+	// EmailVerified 第三方平台是否已验证该邮箱，只有已验证的邮箱才用于关联或注册本站账户
+	EmailVerified bool
+	ProfileURL    string
+}
+
+// OAuthProvider 是一个第三方登录平台
+type OAuthProvider interface {
+	// Name 返回平台标识，如 github
+	Name() string
+	// AuthCodeURL 返回跳转到第三方平台的授权地址
+	AuthCodeURL(state, redirectURI string) string
+	// Exchange 使用授权码换取访问令牌并获取用户资料
+	Exchange(ctx context.Context, code, redirectURI string) (*OAuthProfile, error)
+}
+
+// oauthEndpoints 是平台的接口地址，测试时可替换为本地服务
+type oauthEndpoints struct {
+	AuthURL  string
+	TokenURL string
+	UserURL  string
+	// ExtraURL GitHub 为邮箱列表接口，QQ 为 OpenID 接口
+	ExtraURL string
+}
+
+var defaultOAuthEndpoints = map[string]oauthEndpoints{
+	model.OAuthProviderGithub: {
+		AuthURL:  "https://github.com/login/oauth/authorize",
+		TokenURL: "https://github.com/login/oauth/access_token",
+		UserURL:  "https://api.github.com/user",
+		ExtraURL: "https://api.github.com/user/emails",
+	},
+	model.OAuthProviderGoogle: {
+		AuthURL:  "https://accounts.google.com/o/oauth2/v2/auth",
+		TokenURL: "https://oauth2.googleapis.com/token",
+		UserURL:  "https://openidconnect.googleapis.com/v1/userinfo",
+	},
+	model.OAuthProviderQQ: {
+		AuthURL:  "https://graph.qq.com/oauth2.0/authorize",
+		TokenURL: "https://graph.qq.com/oauth2.0/token",
+		UserURL:  "https://graph.qq.com/user/get_user_info",
+		ExtraURL: "https://graph.qq.com/oauth2.0/me",
+	},
+}
+
+// oauthClient 保存各平台共用的客户端凭据与 HTTP 客户端
+type oauthClient struct {
+	clientID     string
+	clientSecret string
+	endpoints    oauthEndpoints
+	httpClient   *http.Client
+}
+
+// newOAuthProvider 按平台标识创建 OAuthProvider，不支持的平台返回 nil
+func newOAuthProvider(name, clientID, clientSecret string, endpoints oauthEndpoints, httpClient *http.Client) OAuthProvider {
+	c := oauthClient{clientID: clientID, clientSecret: clientSecret, endpoints: endpoints, httpClient: httpClient}
+	switch name {
+	case model.OAuthProviderGithub:
+		return &githubProvider{c}
+	case model.OAuthProviderGoogle:
+		return &googleProvider{c}
+	case model.OAuthProviderQQ:
+		return &qqProvider{c}
+	}
+	return nil
+}
+
+func (c *oauthClient) authCodeURL(params url.Values) string {
+	return c.endpoints.AuthURL + "?" + params.Encode()
+}
+
+// doJSON 发送请求并将 JSON 响应解析到 out，非 2xx 状态码视为失败
+func (c *oauthClient) doJSON(req *http.Request, out interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxOAuthResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s 返回状态码 %d: %s", req.URL.Host, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("解析 %s 响应失败: %w", req.URL.Host, err)
+	}
+	return nil
+}
+
+// postToken 以表单方式请求令牌接口，返回访问令牌
+func (c *oauthClient) postToken(ctx context.Context, form url.Values) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoints.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var token oauthTokenResponse
+	if err := c.doJSON(req, &token); err != nil {
+		return "", err
+	}
+	return token.accessToken()
+}
+
+// getJSON 以 GET 请求接口，accessToken 非空时通过 Authorization 头携带
+func (c *oauthClient) getJSON(ctx context.Context, rawURL, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	return c.doJSON(req, out)
+}
+
+// oauthTokenResponse 是令牌接口的通用响应
+type oauthTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	// QQ 接口的错误字段
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (t *oauthTokenResponse) accessToken() (string, error) {
+	if t.Error != "" {
+		return "", fmt.Errorf("获取访问令牌失败: %s %s", t.Error, t.ErrorDescription)
+	}
+	if t.Code != 0 {
+		return "", fmt.Errorf("获取访问令牌失败: %d %s", t.Code, t.Msg)
+	}
+	if t.AccessToken == "" {
+		return "", fmt.Errorf("获取访问令牌失败: 响应中没有 access_token")
+	}
+	return t.AccessToken, nil
+}
+
+// --- GitHub ---
+
+type githubProvider struct{ oauthClient }
+
+func (p *githubProvider) Name() string { return model.OAuthProviderGithub }
+
+func (p *githubProvider) AuthCodeURL(state, redirectURI string) string {
+	return p.authCodeURL(url.Values{
+		"client_id":    {p.clientID},
+		"redirect_uri": {redirectURI},
+		"scope":        {"read:user user:email"},
+		"state":        {state},
+	})
+}
+
+func (p *githubProvider) Exchange(ctx context.Context, code, redirectURI string) (*OAuthProfile, error) {
+	token, err := p.postToken(ctx, url.Values{
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+		HTMLURL   string `json:"html_url"`
+	}
+	if err := p.getJSON(ctx, p.endpoints.UserURL, token, &user); err != nil {
+		return nil, fmt.Errorf("获取 GitHub 用户信息失败: %w", err)
+	}
+	if user.ID == 0 {
+		return nil, fmt.Errorf("获取 GitHub 用户信息失败: 响应中没有用户ID")
+	}
+	profile := &OAuthProfile{
+		Provider:   p.Name(),
+		ID:         strconv.FormatInt(user.ID, 10),
+		Nickname:   user.Name,
+		Avatar:     user.AvatarURL,
+		ProfileURL: user.HTMLURL,
+	}
+	if profile.Nickname == "" {
+		profile.Nickname = user.Login
+	}
+
+	// 用户资料中的公开邮箱不一定已验证，以邮箱列表接口中的主邮箱为准
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := p.getJSON(ctx, p.endpoints.ExtraURL, token, &emails); err != nil {
+		return nil, fmt.Errorf("获取 GitHub 邮箱失败: %w", err)
+	}
+	for _, e := range emails {
+		if e.Primary {
+			profile.Email = e.Email
+			profile.EmailVerified = e.Verified
+			break
+		}
+	}
+	return profile, nil
+}
+
+// --- Google ---
+
+type googleProvider struct{ oauthClient }
+
+func (p *googleProvider) Name() string { return model.OAuthProviderGoogle }
+
+func (p *googleProvider) AuthCodeURL(state, redirectURI string) string {
+	return p.authCodeURL(url.Values{
+		"client_id":     {p.clientID},
+		"redirect_uri":  {redirectURI},
+		"response_type": {"code"},
+		"scope":         {"openid email profile"},
+		"state":         {state},
+	})
+}
+
+func (p *googleProvider) Exchange(ctx context.Context, code, redirectURI string) (*OAuthProfile, error) {
+	token, err := p.postToken(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var user struct {
+		Sub           string `json:"sub"`
+		Name          string `json:"name"`
+		Picture       string `json:"picture"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	if err := p.getJSON(ctx, p.endpoints.UserURL, token, &user); err != nil {
+		return nil, fmt.Errorf("获取 Google 用户信息失败: %w", err)
+	}
+	if user.Sub == "" {
+		return nil, fmt.Errorf("获取 Google 用户信息失败: 响应中没有用户ID")
+	}
+	return &OAuthProfile{
+		Provider:      p.Name(),
+		ID:            user.Sub,
+		Nickname:      user.Name,
+		Avatar:        user.Picture,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+	}, nil
+}
+
+// --- QQ ---
+
+type qqProvider struct{ oauthClient }
+
+func (p *qqProvider) Name() string { return model.OAuthProviderQQ }
+
+func (p *qqProvider) AuthCodeURL(state, redirectURI string) string {
+	return p.authCodeURL(url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.clientID},
+		"redirect_uri":  {redirectURI},
+		"scope":         {"get_user_info"},
+		"state":         {state},
+	})
+}
+
+// Exchange QQ 互联不提供邮箱，只能登录已绑定的账户或由已登录用户绑定
+func (p *qqProvider) Exchange(ctx context.Context, code, redirectURI string) (*OAuthProfile, error) {
+	tokenURL := p.endpoints.TokenURL + "?" + url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"fmt":           {"json"},
+	}.Encode()
+	var tokenResp oauthTokenResponse
+	if err := p.getJSON(ctx, tokenURL, "", &tokenResp); err != nil {
+		return nil, err
+	}
+	token, err := tokenResp.accessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var me struct {
+		OpenID string `json:"openid"`
+		Error  int    `json:"error"`
+		Desc   string `json:"error_description"`
+	}
+	meURL := p.endpoints.ExtraURL + "?" + url.Values{"access_token": {token}, "fmt": {"json"}}.Encode()
+	if err := p.getJSON(ctx, meURL, "", &me); err != nil {
+		return nil, fmt.Errorf("获取 QQ OpenID 失败: %w", err)
+	}
+	if me.OpenID == "" {
+		return nil, fmt.Errorf("获取 QQ OpenID 失败: %d %s", me.Error, me.Desc)
+	}
+
+	var user struct {
+		Ret          int    `json:"ret"`
+		Msg          string `json:"msg"`
+		Nickname     string `json:"nickname"`
+		FigureURLQQ2 string `json:"figureurl_qq_2"`
+		FigureURLQQ1 string `json:"figureurl_qq_1"`
+	}
+	userURL := p.endpoints.UserURL + "?" + url.Values{
+		"access_token":       {token},
+		"oauth_consumer_key": {p.clientID},
+		"openid":             {me.OpenID},
+	}.Encode()
+	if err := p.getJSON(ctx, userURL, "", &user); err != nil {
+		return nil, fmt.Errorf("获取 QQ 用户信息失败: %w", err)
+	}
+	if user.Ret != 0 {
+		return nil, fmt.Errorf("获取 QQ 用户信息失败: %d %s", user.Ret, user.Msg)
+	}
+	avatar := user.FigureURLQQ2
+	if avatar == "" {
+		avatar = user.FigureURLQQ1
+	}
+	return &OAuthProfile{
+		Provider: p.Name(),
+		ID:       me.OpenID,
+		Nickname: user.Nickname,
+		Avatar:   avatar,
+	}, nil
+}
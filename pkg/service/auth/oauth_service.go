@@ -0,0 +1,456 @@
+/*
+ * @Description: 第三方登录服务：授权跳转、回调处理、按邮箱关联已有账户与社交账号绑定管理
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 22:00:00
+ * @LastEditTime: 2026-10-16 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// oauthStateTTL 授权请求的有效期，超时未完成回调需要重新发起
+	oauthStateTTL = 10 * time.Minute
+	// oauthTicketTTL 登录凭证的有效期，前端拿到凭证后应立即换取会话令牌
+	oauthTicketTTL = 2 * time.Minute
+
+	oauthStateKeyPrefix  = "anheyu:oauth:state:"
+	oauthTicketKeyPrefix = "anheyu:oauth:ticket:"
+)
+
+var (
+	ErrOAuthProviderDisabled = errors.New("该登录方式未启用")
+	ErrOAuthStateInvalid     = errors.New("登录请求已过期或无效，请重新发起登录")
+	ErrOAuthTicketInvalid    = errors.New("登录凭证无效或已过期，请重新登录")
+	// ErrOAuthEmailRequired 第三方账号未绑定本站账户，且无法获取已验证的邮箱用于关联或注册
+	ErrOAuthEmailRequired = errors.New("未能从第三方账号获取已验证的邮箱，请先使用邮箱登录后在个人中心绑定该账号")
+	// ErrOAuthIdentityTaken 第三方账号已绑定其他用户
+	ErrOAuthIdentityTaken = errors.New("该第三方账号已绑定其他用户")
+	// ErrOAuthAlreadyBound 当前用户已绑定该平台的另一个账号
+	ErrOAuthAlreadyBound = errors.New("当前账户已绑定该平台的其他账号，请先解除绑定")
+	ErrOAuthUserBanned   = errors.New("您的账户已被封禁，请联系管理员")
+	// ErrOAuthUserPendingReview 账户使用一次性邮箱注册，等待管理员审核
+	ErrOAuthUserPendingReview = errors.New("您的账户正在等待管理员审核")
+	ErrOAuthNotBound          = errors.New("当前账户未绑定该平台")
+	// ErrOAuthUserInactive 邮箱对应的本站账户尚未激活，不自动关联，防止他人抢注邮箱后借第三方登录激活
+	ErrOAuthUserInactive = errors.New("该邮箱已注册但尚未激活，请先通过激活邮件激活账户，登录后再绑定第三方账号")
+)
+
+// oauthProviderSettings 各平台的配置键
+var oauthProviderSettings = map[string]struct {
+	enable, clientID, clientSecret constant.SettingKey
+}{
+	model.OAuthProviderGithub: {constant.KeyOAuthGithubEnable, constant.KeyOAuthGithubClientID, constant.KeyOAuthGithubClientSecret},
+	model.OAuthProviderGoogle: {constant.KeyOAuthGoogleEnable, constant.KeyOAuthGoogleClientID, constant.KeyOAuthGoogleClientSecret},
+	model.OAuthProviderQQ:     {constant.KeyOAuthQQEnable, constant.KeyOAuthQQClientID, constant.KeyOAuthQQClientSecret},
+}
+
+// oauthProviderOrder 平台的展示顺序
+var oauthProviderOrder = []string{model.OAuthProviderGithub, model.OAuthProviderGoogle, model.OAuthProviderQQ}
+
+// oauthState 是发起授权时保存的请求上下文
+type oauthState struct {
+	Provider string `json:"provider"`
+	Redirect string `json:"redirect"`
+	// BindUserID 非 0 表示由已登录用户发起的绑定请求
+	BindUserID uint `json:"bindUserId,omitempty"`
+}
+
+// oauthTicket 是回调成功后签发的一次性登录凭证内容
+type oauthTicket struct {
+	UserID  uint                `json:"userId"`
+	Profile *model.UserIdentity `json:"profile"`
+}
+
+// OAuthResult 是回调处理的结果
+type OAuthResult struct {
+	// Redirect 处理完成后前端应跳转的站内路径
+	Redirect string
+	// Ticket 登录成功时签发的一次性凭证，前端用它换取会话令牌；绑定请求时为空
+	Ticket string
+	// Bound 为 true 表示完成了一次账号绑定
+	Bound bool
+}
+
+// OAuthService 定义了第三方登录相关的业务逻辑接口
+type OAuthService interface {
+	// EnabledProviders 返回已启用且配置完整的平台
+	EnabledProviders() []string
+	// AuthorizeURL 生成跳转到第三方平台的授权地址，返回地址与 state；bindUserID 非 0 时为绑定请求
+	AuthorizeURL(ctx context.Context, provider, redirect string, bindUserID uint) (authURL, state string, err error)
+	// HandleCallback 处理第三方平台回调。state 有效时即使处理失败也会返回带 Redirect 的结果
+	HandleCallback(ctx context.Context, provider, code, state string) (*OAuthResult, error)
+	// RedeemTicket 兑换一次性登录凭证，返回登录用户与所用的第三方资料
+	RedeemTicket(ctx context.Context, ticket string) (*model.User, *model.UserIdentity, error)
+	// ListIdentities 返回用户绑定的第三方账号
+	ListIdentities(ctx context.Context, userID uint) ([]*model.UserIdentity, error)
+	// Unlink 解除用户与某个平台的绑定
+	Unlink(ctx context.Context, userID uint, provider string) error
+}
+
+// oauthService 是 OAuthService 接口的实现
+type oauthService struct {
+	userRepo     repository.UserRepository
+	identityRepo repository.UserIdentityRepository
+	authSvc      AuthService
+	settingSvc   setting.SettingService
+	cacheSvc     utility.CacheService
+	httpClient   *http.Client
+	endpoints    map[string]oauthEndpoints
+}
+
+// NewOAuthService 是 oauthService 的构造函数。
+// HTTP 客户端不经过出站 SSRF 防护：请求只发往 defaultOAuthEndpoints 中固定的第三方平台地址，管理员无法配置
+func NewOAuthService(
+	userRepo repository.UserRepository,
+	identityRepo repository.UserIdentityRepository,
+	authSvc AuthService,
+	settingSvc setting.SettingService,
+	cacheSvc utility.CacheService,
+) OAuthService {
+	return &oauthService{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		authSvc:      authSvc,
+		settingSvc:   settingSvc,
+		cacheSvc:     cacheSvc,
+		httpClient:   httpclient.New("oauth", httpclient.DefaultPolicy()),
+		endpoints:    defaultOAuthEndpoints,
+	}
+}
+
+// EnabledProviders 返回已启用且配置完整的平台
+func (s *oauthService) EnabledProviders() []string {
+	providers := make([]string, 0, len(oauthProviderOrder))
+	for _, name := range oauthProviderOrder {
+		if s.provider(name) != nil {
+			providers = append(providers, name)
+		}
+	}
+	return providers
+}
+
+// provider 按当前配置创建平台实现，未启用或配置不完整时返回 nil
+func (s *oauthService) provider(name string) OAuthProvider {
+	keys, ok := oauthProviderSettings[name]
+	if !ok || !s.settingSvc.GetBool(keys.enable.String()) {
+		return nil
+	}
+	clientID := strings.TrimSpace(s.settingSvc.Get(keys.clientID.String()))
+	clientSecret := strings.TrimSpace(s.settingSvc.Get(keys.clientSecret.String()))
+	if clientID == "" || clientSecret == "" {
+		return nil
+	}
+	return newOAuthProvider(name, clientID, clientSecret, s.endpoints[name], s.httpClient)
+}
+
+// callbackURL 返回在第三方平台登记的回调地址
+func (s *oauthService) callbackURL(provider string) (string, error) {
+	siteURL := strings.TrimRight(strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String())), "/")
+	if siteURL == "" {
+		return "", fmt.Errorf("站点地址未配置，无法生成第三方登录回调地址")
+	}
+	return siteURL + "/api/auth/oauth/" + provider + "/callback", nil
+}
+
+// AuthorizeURL 生成跳转到第三方平台的授权地址
+func (s *oauthService) AuthorizeURL(ctx context.Context, provider, redirect string, bindUserID uint) (string, string, error) {
+	p := s.provider(provider)
+	if p == nil {
+		return "", "", ErrOAuthProviderDisabled
+	}
+	callbackURL, err := s.callbackURL(provider)
+	if err != nil {
+		return "", "", err
+	}
+	state, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return "", "", fmt.Errorf("生成 state 失败: %w", err)
+	}
+	data, err := json.Marshal(oauthState{Provider: provider, Redirect: SanitizeOAuthRedirect(redirect), BindUserID: bindUserID})
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.cacheSvc.Set(ctx, oauthStateKeyPrefix+state, string(data), oauthStateTTL); err != nil {
+		return "", "", fmt.Errorf("保存登录请求失败: %w", err)
+	}
+	return p.AuthCodeURL(state, callbackURL), state, nil
+}
+
+// HandleCallback 处理第三方平台回调
+func (s *oauthService) HandleCallback(ctx context.Context, provider, code, state string) (*OAuthResult, error) {
+	st, err := s.takeState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if st.Provider != provider {
+		return nil, ErrOAuthStateInvalid
+	}
+	result := &OAuthResult{Redirect: st.Redirect}
+	if code == "" {
+		return result, fmt.Errorf("第三方平台未返回授权码，可能已取消授权")
+	}
+
+	p := s.provider(provider)
+	if p == nil {
+		return result, ErrOAuthProviderDisabled
+	}
+	callbackURL, err := s.callbackURL(provider)
+	if err != nil {
+		return result, err
+	}
+	profile, err := p.Exchange(ctx, code, callbackURL)
+	if err != nil {
+		log.Printf("[WARNING] 第三方登录 %s 获取用户资料失败: %v", provider, err)
+		return result, fmt.Errorf("获取第三方账号信息失败，请稍后重试")
+	}
+	profile.Email = strings.ToLower(strings.TrimSpace(profile.Email))
+
+	identity, err := s.identityRepo.FindByProviderUserID(ctx, provider, profile.ID)
+	if err != nil {
+		return result, fmt.Errorf("查询第三方账号绑定失败: %w", err)
+	}
+
+	if st.BindUserID != 0 {
+		if _, err := s.bind(ctx, st.BindUserID, identity, profile); err != nil {
+			return result, err
+		}
+		result.Bound = true
+		return result, nil
+	}
+
+	user, identity, err := s.login(ctx, identity, profile)
+	if err != nil {
+		return result, err
+	}
+	ticket, err := s.issueTicket(ctx, user.ID, identity)
+	if err != nil {
+		return result, err
+	}
+	result.Ticket = ticket
+	return result, nil
+}
+
+// login 找到第三方账号对应的本站用户：优先使用已有绑定，其次按已验证的邮箱关联已有账户，最后注册新账户。
+// 未激活的账户不按邮箱自动关联：其密码可能由抢注邮箱的他人设置，关联并激活后对方仍可用该密码登录
+func (s *oauthService) login(ctx context.Context, identity *model.UserIdentity, profile *OAuthProfile) (*model.User, *model.UserIdentity, error) {
+	var user *model.User
+	var err error
+	if identity != nil {
+		user, err = s.userRepo.FindByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("查询用户失败: %w", err)
+		}
+		if user == nil {
+			return nil, nil, fmt.Errorf("绑定的用户不存在")
+		}
+		s.refreshIdentity(ctx, identity, profile)
+	} else {
+		if profile.Email == "" || !profile.EmailVerified {
+			return nil, nil, ErrOAuthEmailRequired
+		}
+		user, err = s.userRepo.FindByEmail(ctx, profile.Email)
+		if err != nil {
+			return nil, nil, fmt.Errorf("查询用户失败: %w", err)
+		}
+		if user == nil {
+			user, err = s.authSvc.RegisterWithOAuth(ctx, profile)
+			if err != nil {
+				return nil, nil, err
+			}
+		} else if user.Status == model.UserStatusInactive {
+			return nil, nil, ErrOAuthUserInactive
+		}
+		identity, err = s.createIdentity(ctx, user.ID, profile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if user.Status == model.UserStatusBanned {
+		return nil, nil, ErrOAuthUserBanned
+	}
+	if user.Status == model.UserStatusPendingReview {
+		return nil, nil, ErrOAuthUserPendingReview
+	}
+	// 第三方资料中的个人主页可用于评论身份自动填充
+	if user.Website == "" && profile.ProfileURL != "" {
+		user.Website = profile.ProfileURL
+	}
+	now := time.Now()
+	user.LastLoginAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Printf("[WARNING] 第三方登录更新用户 %d 信息失败: %v", user.ID, err)
+	}
+	return user, identity, nil
+}
+
+// bind 将第三方账号绑定到已登录的用户
+func (s *oauthService) bind(ctx context.Context, userID uint, identity *model.UserIdentity, profile *OAuthProfile) (*model.UserIdentity, error) {
+	if identity != nil {
+		if identity.UserID != userID {
+			return nil, ErrOAuthIdentityTaken
+		}
+		s.refreshIdentity(ctx, identity, profile)
+		return identity, nil
+	}
+	existing, err := s.identityRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询第三方账号绑定失败: %w", err)
+	}
+	for _, e := range existing {
+		if e.Provider == profile.Provider {
+			return nil, ErrOAuthAlreadyBound
+		}
+	}
+	return s.createIdentity(ctx, userID, profile)
+}
+
+func (s *oauthService) createIdentity(ctx context.Context, userID uint, profile *OAuthProfile) (*model.UserIdentity, error) {
+	identity := &model.UserIdentity{UserID: userID, Provider: profile.Provider, ProviderUserID: profile.ID}
+	applyOAuthProfile(identity, profile)
+	if err := s.identityRepo.Create(ctx, identity); err != nil {
+		return nil, fmt.Errorf("保存第三方账号绑定失败: %w", err)
+	}
+	return identity, nil
+}
+
+// refreshIdentity 用最新的第三方资料更新绑定记录，失败只记录日志
+func (s *oauthService) refreshIdentity(ctx context.Context, identity *model.UserIdentity, profile *OAuthProfile) {
+	applyOAuthProfile(identity, profile)
+	if err := s.identityRepo.UpdateProfile(ctx, identity); err != nil {
+		log.Printf("[WARNING] 更新第三方账号 %s/%s 资料失败: %v", identity.Provider, identity.ProviderUserID, err)
+	}
+}
+
+func applyOAuthProfile(identity *model.UserIdentity, profile *OAuthProfile) {
+	identity.Nickname = truncateRunes(profile.Nickname, 100)
+	identity.Avatar = truncateRunes(profile.Avatar, 512)
+	identity.Email = truncateRunes(profile.Email, 255)
+	identity.ProfileURL = truncateRunes(profile.ProfileURL, 512)
+}
+
+func truncateRunes(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+// takeState 读取并删除授权请求，同一个 state 只能使用一次
+func (s *oauthService) takeState(ctx context.Context, state string) (*oauthState, error) {
+	if state == "" {
+		return nil, ErrOAuthStateInvalid
+	}
+	key := oauthStateKeyPrefix + state
+	raw, err := s.cacheSvc.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("读取登录请求失败: %w", err)
+	}
+	if raw == "" {
+		return nil, ErrOAuthStateInvalid
+	}
+	if err := s.cacheSvc.Delete(ctx, key); err != nil {
+		log.Printf("[WARNING] 删除第三方登录 state 失败: %v", err)
+	}
+	var st oauthState
+	if err := json.Unmarshal([]byte(raw), &st); err != nil {
+		return nil, ErrOAuthStateInvalid
+	}
+	return &st, nil
+}
+
+func (s *oauthService) issueTicket(ctx context.Context, userID uint, identity *model.UserIdentity) (string, error) {
+	ticket, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return "", fmt.Errorf("生成登录凭证失败: %w", err)
+	}
+	data, err := json.Marshal(oauthTicket{UserID: userID, Profile: identity})
+	if err != nil {
+		return "", err
+	}
+	if err := s.cacheSvc.Set(ctx, oauthTicketKeyPrefix+ticket, string(data), oauthTicketTTL); err != nil {
+		return "", fmt.Errorf("保存登录凭证失败: %w", err)
+	}
+	return ticket, nil
+}
+
+// RedeemTicket 兑换一次性登录凭证
+func (s *oauthService) RedeemTicket(ctx context.Context, ticket string) (*model.User, *model.UserIdentity, error) {
+	if ticket == "" {
+		return nil, nil, ErrOAuthTicketInvalid
+	}
+	key := oauthTicketKeyPrefix + ticket
+	raw, err := s.cacheSvc.Get(ctx, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取登录凭证失败: %w", err)
+	}
+	if raw == "" {
+		return nil, nil, ErrOAuthTicketInvalid
+	}
+	if err := s.cacheSvc.Delete(ctx, key); err != nil {
+		log.Printf("[WARNING] 删除第三方登录凭证失败: %v", err)
+	}
+	var t oauthTicket
+	if err := json.Unmarshal([]byte(raw), &t); err != nil {
+		return nil, nil, ErrOAuthTicketInvalid
+	}
+	user, err := s.userRepo.FindByID(ctx, t.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, nil, ErrOAuthTicketInvalid
+	}
+	if user.Status == model.UserStatusBanned {
+		return nil, nil, ErrOAuthUserBanned
+	}
+	if user.Status == model.UserStatusPendingReview {
+		return nil, nil, ErrOAuthUserPendingReview
+	}
+	return user, t.Profile, nil
+}
+
+// ListIdentities 返回用户绑定的第三方账号
+func (s *oauthService) ListIdentities(ctx context.Context, userID uint) ([]*model.UserIdentity, error) {
+	return s.identityRepo.ListByUserID(ctx, userID)
+}
+
+// Unlink 解除用户与某个平台的绑定
+func (s *oauthService) Unlink(ctx context.Context, userID uint, provider string) error {
+	deleted, err := s.identityRepo.Delete(ctx, userID, provider)
+	if err != nil {
+		return fmt.Errorf("解除绑定失败: %w", err)
+	}
+	if !deleted {
+		return ErrOAuthNotBound
+	}
+	return nil
+}
+
+// SanitizeOAuthRedirect 只允许站内相对路径作为登录完成后的跳转地址，防止开放重定向
+func SanitizeOAuthRedirect(redirect string) string {
+	redirect = strings.TrimSpace(redirect)
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
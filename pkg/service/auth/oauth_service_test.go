@@ -0,0 +1,303 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeOAuthUserRepo struct {
+	repository.UserRepository
+	users map[uint]*model.User
+}
+
+func (f *fakeOAuthUserRepo) FindByID(ctx context.Context, id uint) (*model.User, error) {
+	return f.users[id], nil
+}
+
+func (f *fakeOAuthUserRepo) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeOAuthUserRepo) Update(ctx context.Context, user *model.User) error {
+	f.users[user.ID] = user
+	return nil
+}
+
+type fakeIdentityRepo struct {
+	repository.UserIdentityRepository
+	identities []*model.UserIdentity
+}
+
+func (f *fakeIdentityRepo) FindByProviderUserID(ctx context.Context, provider, providerUserID string) (*model.UserIdentity, error) {
+	for _, i := range f.identities {
+		if i.Provider == provider && i.ProviderUserID == providerUserID {
+			return i, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeIdentityRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.UserIdentity, error) {
+	var result []*model.UserIdentity
+	for _, i := range f.identities {
+		if i.UserID == userID {
+			result = append(result, i)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeIdentityRepo) Create(ctx context.Context, identity *model.UserIdentity) error {
+	identity.ID = uint(len(f.identities) + 1)
+	f.identities = append(f.identities, identity)
+	return nil
+}
+
+func (f *fakeIdentityRepo) UpdateProfile(ctx context.Context, identity *model.UserIdentity) error {
+	return nil
+}
+
+type fakeOAuthAuthService struct {
+	AuthService
+	users *fakeOAuthUserRepo
+}
+
+func (f *fakeOAuthAuthService) RegisterWithOAuth(ctx context.Context, profile *OAuthProfile) (*model.User, error) {
+	user := &model.User{ID: 100, Email: profile.Email, Nickname: profile.Nickname, Website: profile.ProfileURL, Status: model.UserStatusActive}
+	f.users.users[user.ID] = user
+	return user, nil
+}
+
+// newGithubServer 模拟 GitHub 的令牌、用户与邮箱接口
+func newGithubServer(t *testing.T, userID int64, email string, verified bool) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "good-code" {
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "bad_verification_code"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "tok"})
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id": userID, "login": "octocat", "avatar_url": "https://avatars.example.com/u/1", "html_url": "https://github.com/octocat",
+		})
+	})
+	mux.HandleFunc("/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"email": "other@example.com", "primary": false, "verified": true},
+			{"email": email, "primary": true, "verified": verified},
+		})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestOAuthService(srv *httptest.Server, users map[uint]*model.User) (*oauthService, *fakeIdentityRepo) {
+	settings := &fakeSettings{values: map[string]string{
+		constant.KeySiteURL.String():                 "https://blog.example.com/",
+		constant.KeyOAuthGithubEnable.String():       "true",
+		constant.KeyOAuthGithubClientID.String():     "cid",
+		constant.KeyOAuthGithubClientSecret.String(): "secret",
+	}}
+	userRepo := &fakeOAuthUserRepo{users: users}
+	identityRepo := &fakeIdentityRepo{}
+	svc := NewOAuthService(userRepo, identityRepo, &fakeOAuthAuthService{users: userRepo}, settings, utility.NewMemoryCacheService()).(*oauthService)
+	svc.httpClient = srv.Client()
+	svc.endpoints = map[string]oauthEndpoints{
+		model.OAuthProviderGithub: {
+			AuthURL:  srv.URL + "/authorize",
+			TokenURL: srv.URL + "/token",
+			UserURL:  srv.URL + "/user",
+			ExtraURL: srv.URL + "/emails",
+		},
+	}
+	return svc, identityRepo
+}
+
+func TestOAuthLoginLinksExistingUserByEmail(t *testing.T) {
+	srv := newGithubServer(t, 42, "owner@example.com", true)
+	users := map[uint]*model.User{7: {ID: 7, Email: "owner@example.com", Status: model.UserStatusActive}}
+	svc, identities := newTestOAuthService(srv, users)
+	ctx := context.Background()
+
+	if got := svc.EnabledProviders(); len(got) != 1 || got[0] != model.OAuthProviderGithub {
+		t.Fatalf("启用的平台错误: %v", got)
+	}
+	if _, _, err := svc.AuthorizeURL(ctx, model.OAuthProviderQQ, "/", 0); !errors.Is(err, ErrOAuthProviderDisabled) {
+		t.Fatalf("未启用的平台应返回错误: %v", err)
+	}
+
+	authURL, state, err := svc.AuthorizeURL(ctx, model.OAuthProviderGithub, "https://evil.example.com", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(authURL)
+	if u.Query().Get("state") != state || u.Query().Get("redirect_uri") != "https://blog.example.com/api/auth/oauth/github/callback" {
+		t.Fatalf("授权地址错误: %s", authURL)
+	}
+
+	result, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Redirect != "/" || result.Ticket == "" || result.Bound {
+		t.Fatalf("回调结果错误: %+v", result)
+	}
+	if len(identities.identities) != 1 || identities.identities[0].UserID != 7 || identities.identities[0].ProviderUserID != "42" {
+		t.Fatalf("应按邮箱关联已有用户: %+v", identities.identities)
+	}
+	if users[7].Website != "https://github.com/octocat" || users[7].LastLoginAt == nil {
+		t.Errorf("应补充个人主页并更新登录时间: %+v", users[7])
+	}
+
+	if _, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state); !errors.Is(err, ErrOAuthStateInvalid) {
+		t.Errorf("state 只能使用一次: %v", err)
+	}
+
+	user, profile, err := svc.RedeemTicket(ctx, result.Ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 7 || profile.Nickname != "octocat" || profile.Email != "owner@example.com" {
+		t.Errorf("兑换结果错误: %+v %+v", user, profile)
+	}
+	if _, _, err := svc.RedeemTicket(ctx, result.Ticket); !errors.Is(err, ErrOAuthTicketInvalid) {
+		t.Errorf("登录凭证只能使用一次: %v", err)
+	}
+}
+
+func TestOAuthLoginRequiresVerifiedEmail(t *testing.T) {
+	srv := newGithubServer(t, 42, "new@example.com", false)
+	svc, identities := newTestOAuthService(srv, map[uint]*model.User{})
+	ctx := context.Background()
+
+	_, state, err := svc.AuthorizeURL(ctx, model.OAuthProviderGithub, "/posts/a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state)
+	if !errors.Is(err, ErrOAuthEmailRequired) {
+		t.Fatalf("未验证的邮箱不能用于关联或注册: %v", err)
+	}
+	if result == nil || result.Redirect != "/posts/a" {
+		t.Errorf("失败时仍应返回跳转地址: %+v", result)
+	}
+	if len(identities.identities) != 0 {
+		t.Errorf("不应创建绑定: %+v", identities.identities)
+	}
+}
+
+func TestOAuthLoginDoesNotLinkInactiveUser(t *testing.T) {
+	srv := newGithubServer(t, 42, "owner@example.com", true)
+	users := map[uint]*model.User{7: {ID: 7, Email: "owner@example.com", Status: model.UserStatusInactive}}
+	svc, identities := newTestOAuthService(srv, users)
+	ctx := context.Background()
+
+	_, state, err := svc.AuthorizeURL(ctx, model.OAuthProviderGithub, "/", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state); !errors.Is(err, ErrOAuthUserInactive) {
+		t.Fatalf("未激活的账户不应按邮箱自动关联: %v", err)
+	}
+	if len(identities.identities) != 0 || users[7].Status != model.UserStatusInactive {
+		t.Errorf("不应创建绑定或激活账户: %+v %+v", identities.identities, users[7])
+	}
+}
+
+func TestOAuthLoginRegistersNewUser(t *testing.T) {
+	srv := newGithubServer(t, 42, "New@Example.com", true)
+	users := map[uint]*model.User{}
+	svc, identities := newTestOAuthService(srv, users)
+	ctx := context.Background()
+
+	_, state, _ := svc.AuthorizeURL(ctx, model.OAuthProviderGithub, "/", 0)
+	if _, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state); err != nil {
+		t.Fatal(err)
+	}
+	if users[100] == nil || users[100].Email != "new@example.com" || users[100].Website != "https://github.com/octocat" {
+		t.Fatalf("应使用第三方资料注册新用户: %+v", users[100])
+	}
+	if len(identities.identities) != 1 || identities.identities[0].UserID != 100 {
+		t.Fatalf("应为新用户创建绑定: %+v", identities.identities)
+	}
+}
+
+func TestOAuthBind(t *testing.T) {
+	srv := newGithubServer(t, 42, "a@example.com", true)
+	users := map[uint]*model.User{
+		1: {ID: 1, Email: "a@example.com", Status: model.UserStatusActive},
+		2: {ID: 2, Email: "b@example.com", Status: model.UserStatusActive},
+	}
+	svc, identities := newTestOAuthService(srv, users)
+	ctx := context.Background()
+
+	_, state, _ := svc.AuthorizeURL(ctx, model.OAuthProviderGithub, "/profile", 2)
+	result, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Bound || result.Ticket != "" || result.Redirect != "/profile" {
+		t.Fatalf("绑定结果错误: %+v", result)
+	}
+	if len(identities.identities) != 1 || identities.identities[0].UserID != 2 {
+		t.Fatalf("应绑定到发起请求的用户而非邮箱相同的用户: %+v", identities.identities)
+	}
+
+	_, state, _ = svc.AuthorizeURL(ctx, model.OAuthProviderGithub, "/profile", 1)
+	if _, err := svc.HandleCallback(ctx, model.OAuthProviderGithub, "good-code", state); !errors.Is(err, ErrOAuthIdentityTaken) {
+		t.Errorf("已绑定其他用户的第三方账号不能重复绑定: %v", err)
+	}
+}
+
+func TestRegisterWithOAuthRejectsInviteOnly(t *testing.T) {
+	settings := &fakeSettings{values: map[string]string{
+		constant.KeyEnableRegistration.String(): "true",
+		constant.KeyRegistrationInvite.String(): "true",
+	}}
+	svc := NewAuthService(nil, settings, nil, nil, nil, nil, nil, nil)
+	_, err := svc.RegisterWithOAuth(context.Background(), &OAuthProfile{Email: "a@example.com"})
+	if !errors.Is(err, ErrInvitationCodeRequired) || !strings.Contains(err.Error(), "绑定") {
+		t.Fatalf("仅邀请注册时第三方登录不能注册新账户: %v", err)
+	}
+}
+
+func TestSanitizeOAuthRedirect(t *testing.T) {
+	cases := map[string]string{
+		"":                    "/",
+		"/posts/a?x=1":        "/posts/a?x=1",
+		"https://evil.com":    "/",
+		"//evil.com":          "/",
+		"/\\evil.com":         "/",
+		"javascript:alert(1)": "/",
+		"  /login#oauth  ":    "/login#oauth",
+	}
+	for in, want := range cases {
+		if got := SanitizeOAuthRedirect(in); got != want {
+			t.Errorf("SanitizeOAuthRedirect(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
@@ -19,12 +20,21 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 )
 
+// jwtSecretLength 轮换时生成的 JWT 密钥长度，与首次启动时生成的密钥一致
+const jwtSecretLength = 64
+
 type TokenService interface {
 	GenerateSessionTokens(ctx context.Context, user *model.User) (accessToken, refreshToken string, expiresAt int64, err error)
 	RefreshAccessToken(ctx context.Context, refreshToken string) (accessToken string, expiresAt int64, err error)
+	// GenerateImpersonationToken 为管理员签发以 target 身份访问的短期令牌，不附带刷新令牌
+	GenerateImpersonationToken(ctx context.Context, impersonatorID uint, target *model.User, ttl time.Duration) (accessToken string, expiresAt int64, err error)
+	// GenerateUploadToken 基于调用方的会话签发只能上传到 policyFlag 策略的短期令牌
+	GenerateUploadToken(ctx context.Context, claims *auth.CustomClaims, policyFlag string, ttl time.Duration) (uploadToken string, expiresAt int64, err error)
 	GenerateSignedToken(identifier string, duration time.Duration) (string, error)
 	VerifySignedToken(identifier, sign string) error
 	ParseAccessToken(ctx context.Context, accessToken string) (*auth.CustomClaims, error)
+	// RotateJWTSecret 生成新的 JWT 密钥，旧密钥在宽限期内仍可验证已签发的令牌
+	RotateJWTSecret(ctx context.Context, grace time.Duration) (previousExpiresAt time.Time, err error)
 }
 
 // tokenService 结构体增加了 cacheSvc 依赖
@@ -81,10 +91,17 @@ func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken stri
 		return "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法刷新令牌")
 	}
 
-	claims, err := auth.ParseToken(refreshToken, []byte(jwtSecret))
+	claims, err := s.parseWithFallback(refreshToken, jwtSecret)
 	if err != nil {
 		return "", 0, fmt.Errorf("无效或过期的刷新令牌: %w", err)
 	}
+	// 模拟登录令牌只能在有效期内使用，不能借此换取不带模拟标记的新令牌
+	if claims.IsImpersonation() {
+		return "", 0, fmt.Errorf("模拟登录令牌不能用于刷新")
+	}
+	if claims.IsUploadToken() {
+		return "", 0, fmt.Errorf("上传令牌不能用于刷新")
+	}
 
 	// 1. claims.UserID 包含公共用户 ID，需要将其解码为内部数据库 ID，并验证类型
 	internalUserID, entityType, err := idgen.DecodePublicID(claims.UserID) // 统一使用 DecodePublicID
@@ -112,6 +129,44 @@ func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken stri
 	return accessToken, expiresAt, nil
 }
 
+func (s *tokenService) GenerateImpersonationToken(ctx context.Context, impersonatorID uint, target *model.User, ttl time.Duration) (string, int64, error) {
+	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
+	if jwtSecret == "" {
+		return "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法生成令牌")
+	}
+
+	accessToken, err := auth.GenerateImpersonationToken(target.ID, []byte(target.UserGroup.Permissions), target.UserGroup.ID, impersonatorID, ttl, []byte(jwtSecret))
+	if err != nil {
+		return "", 0, err
+	}
+	claims, err := auth.ParseToken(accessToken, []byte(jwtSecret))
+	if err != nil {
+		return "", 0, err
+	}
+	return accessToken, claims.ExpiresAt.Time.UnixMilli(), nil
+}
+
+func (s *tokenService) GenerateUploadToken(ctx context.Context, claims *auth.CustomClaims, policyFlag string, ttl time.Duration) (string, int64, error) {
+	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
+	if jwtSecret == "" {
+		return "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法生成令牌")
+	}
+	// 上传令牌不能再用来签发新的上传令牌，避免借此无限续期
+	if claims.IsUploadToken() {
+		return "", 0, fmt.Errorf("上传令牌不能用于签发新令牌")
+	}
+
+	uploadToken, err := auth.GenerateUploadToken(claims, policyFlag, ttl, []byte(jwtSecret))
+	if err != nil {
+		return "", 0, err
+	}
+	parsed, err := auth.ParseToken(uploadToken, []byte(jwtSecret))
+	if err != nil {
+		return "", 0, err
+	}
+	return uploadToken, parsed.ExpiresAt.Time.UnixMilli(), nil
+}
+
 // GenerateSignedToken 生成一个新的签名令牌。identifier 预期是公共 ID。
 func (s *tokenService) GenerateSignedToken(identifier string, duration time.Duration) (string, error) {
 	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
@@ -153,20 +208,22 @@ func (s *tokenService) VerifySignedToken(identifier, sign string) error {
 	}
 
 	dataToSign := fmt.Sprintf("%s:%d", identifier, expiry) // identifier 预期是公共 ID
-	h := hmac.New(sha256.New, []byte(s.settingSvc.Get(constant.KeyJWTSecret.String())))
-	h.Write([]byte(dataToSign))
-	expectedSignature := h.Sum(nil)
-
 	signatureFromURL, err := base64.URLEncoding.DecodeString(encodedSignatureFromURL)
 	if err != nil {
 		return fmt.Errorf("令牌签名解码失败")
 	}
 
-	if !hmac.Equal(signatureFromURL, expectedSignature) {
-		return fmt.Errorf("签名无效")
+	for _, secret := range []string{jwtSecret, s.previousSecret()} {
+		if secret == "" {
+			continue
+		}
+		h := hmac.New(sha256.New, []byte(secret))
+		h.Write([]byte(dataToSign))
+		if hmac.Equal(signatureFromURL, h.Sum(nil)) {
+			return nil
+		}
 	}
-
-	return nil
+	return fmt.Errorf("签名无效")
 }
 
 // ParseAccessToken 负责解析和验证 access token
@@ -176,5 +233,56 @@ func (s *tokenService) ParseAccessToken(ctx context.Context, accessToken string)
 		return nil, fmt.Errorf("JWT_SECRET 未配置，无法解析令牌")
 	}
 
-	return auth.ParseToken(accessToken, []byte(jwtSecret))
+	return s.parseWithFallback(accessToken, jwtSecret)
+}
+
+// parseWithFallback 先用当前密钥解析令牌，失败时在宽限期内尝试轮换前的旧密钥
+func (s *tokenService) parseWithFallback(token, jwtSecret string) (*auth.CustomClaims, error) {
+	claims, err := auth.ParseToken(token, []byte(jwtSecret))
+	if err == nil {
+		return claims, nil
+	}
+	if previous := s.previousSecret(); previous != "" {
+		if claims, prevErr := auth.ParseToken(token, []byte(previous)); prevErr == nil {
+			return claims, nil
+		}
+	}
+	return nil, err
+}
+
+// previousSecret 返回仍在宽限期内的旧 JWT 密钥，已过期或未轮换时返回空字符串
+func (s *tokenService) previousSecret() string {
+	previous := s.settingSvc.Get(constant.KeyJWTSecretPrevious.String())
+	if previous == "" {
+		return ""
+	}
+	expiresAt, err := strconv.ParseInt(s.settingSvc.Get(constant.KeyJWTPreviousExpiresAt.String()), 10, 64)
+	if err != nil || time.Now().Unix() >= expiresAt {
+		return ""
+	}
+	return previous
+}
+
+// RotateJWTSecret 生成新的 JWT 密钥并保留旧密钥 grace 时长。
+// grace 为 0 时旧密钥立即失效，所有用户需要重新登录。
+func (s *tokenService) RotateJWTSecret(ctx context.Context, grace time.Duration) (time.Time, error) {
+	current := s.settingSvc.Get(constant.KeyJWTSecret.String())
+	secret, err := utils.GenerateRandomString(jwtSecretLength)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("生成新的 JWT 密钥失败: %w", err)
+	}
+
+	expiresAt := time.Now().Add(grace)
+	previous := current
+	if grace <= 0 {
+		previous = ""
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{
+		constant.KeyJWTSecret.String():            secret,
+		constant.KeyJWTSecretPrevious.String():    previous,
+		constant.KeyJWTPreviousExpiresAt.String(): strconv.FormatInt(expiresAt.Unix(), 10),
+	}); err != nil {
+		return time.Time{}, fmt.Errorf("保存新的 JWT 密钥失败: %w", err)
+	}
+	return expiresAt, nil
 }
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) UpdateSettings(ctx context.Context, values map[string]string) error {
+	for k, v := range values {
+		f.values[k] = v
+	}
+	return nil
+}
+
+func TestRotateJWTSecret_KeepsOldSecretDuringGrace(t *testing.T) {
+	settings := &fakeSettings{values: map[string]string{constant.KeyJWTSecret.String(): "old-secret"}}
+	svc := NewTokenService(nil, settings, nil)
+
+	sign, err := svc.GenerateSignedToken("user-1", time.Hour)
+	if err != nil {
+		t.Fatalf("生成签名令牌失败: %v", err)
+	}
+	if _, err := svc.RotateJWTSecret(context.Background(), time.Hour); err != nil {
+		t.Fatalf("轮换失败: %v", err)
+	}
+	if settings.values[constant.KeyJWTSecret.String()] == "old-secret" {
+		t.Fatal("轮换后应生成新密钥")
+	}
+	if err := svc.VerifySignedToken("user-1", sign); err != nil {
+		t.Errorf("宽限期内旧令牌应有效: %v", err)
+	}
+
+	if _, err := svc.RotateJWTSecret(context.Background(), 0); err != nil {
+		t.Fatalf("轮换失败: %v", err)
+	}
+	if err := svc.VerifySignedToken("user-1", sign); err == nil {
+		t.Error("宽限期为 0 时旧令牌应立即失效")
+	}
+}
+
+func TestImpersonationToken_MarkedAndNotRefreshable(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("token-service-test"); err != nil {
+		t.Fatalf("初始化 ID 编码器失败: %v", err)
+	}
+	settings := &fakeSettings{values: map[string]string{constant.KeyJWTSecret.String(): "secret"}}
+	svc := NewTokenService(nil, settings, nil)
+	target := &model.User{ID: 7, UserGroup: model.UserGroup{ID: 2}}
+
+	token, expiresAt, err := svc.GenerateImpersonationToken(context.Background(), 1, target, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("签发模拟令牌失败: %v", err)
+	}
+	if remaining := time.Until(time.UnixMilli(expiresAt)); remaining > 10*time.Minute || remaining < 9*time.Minute {
+		t.Errorf("模拟令牌有效期应为 10 分钟, 剩余 %s", remaining)
+	}
+
+	claims, err := svc.ParseAccessToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("解析模拟令牌失败: %v", err)
+	}
+	adminID, _, err := idgen.DecodePublicID(claims.ImpersonatorID)
+	if !claims.IsImpersonation() || err != nil || adminID != 1 {
+		t.Errorf("模拟令牌应标记签发的管理员, got %q", claims.ImpersonatorID)
+	}
+	if userID, _, _ := idgen.DecodePublicID(claims.UserID); userID != 7 {
+		t.Errorf("模拟令牌应以目标用户身份签发, got %d", userID)
+	}
+
+	if _, _, err := svc.RefreshAccessToken(context.Background(), token); err == nil {
+		t.Error("模拟令牌不应能用于刷新")
+	}
+}
+
+func TestUploadToken_ScopedAndNotRefreshable(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("token-service-test"); err != nil {
+		t.Fatalf("初始化 ID 编码器失败: %v", err)
+	}
+	settings := &fakeSettings{values: map[string]string{constant.KeyJWTSecret.String(): "secret"}}
+	svc := NewTokenService(nil, settings, nil)
+	user := &model.User{ID: 7, UserGroup: model.UserGroup{ID: 2}}
+
+	access, _, _, err := svc.GenerateSessionTokens(context.Background(), user)
+	if err != nil {
+		t.Fatalf("签发会话令牌失败: %v", err)
+	}
+	session, err := svc.ParseAccessToken(context.Background(), access)
+	if err != nil {
+		t.Fatalf("解析会话令牌失败: %v", err)
+	}
+
+	token, _, err := svc.GenerateUploadToken(context.Background(), session, constant.PolicyFlagArticleImage, 30*time.Minute)
+	if err != nil {
+		t.Fatalf("签发上传令牌失败: %v", err)
+	}
+	claims, err := svc.ParseAccessToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("解析上传令牌失败: %v", err)
+	}
+	if !claims.IsUploadToken() || claims.UploadScope != constant.PolicyFlagArticleImage {
+		t.Errorf("上传令牌应限定策略, got %q", claims.UploadScope)
+	}
+	if claims.UserID != session.UserID || claims.UserGroupID != session.UserGroupID {
+		t.Error("上传令牌应沿用会话的用户身份")
+	}
+
+	if _, _, err := svc.RefreshAccessToken(context.Background(), token); err == nil {
+		t.Error("上传令牌不应能用于刷新")
+	}
+	if _, _, err := svc.GenerateUploadToken(context.Background(), claims, constant.PolicyFlagCommentImage, time.Minute); err == nil {
+		t.Error("上传令牌不应能签发新的上传令牌")
+	}
+}
@@ -9,11 +9,13 @@ package captcha
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/geetest"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/imagecaptcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/siteverify"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/turnstile"
 )
 
@@ -23,15 +25,35 @@ type CaptchaProvider string
 const (
 	ProviderNone      CaptchaProvider = "none"      // 不启用验证
 	ProviderTurnstile CaptchaProvider = "turnstile" // Cloudflare Turnstile
+	ProviderHCaptcha  CaptchaProvider = "hcaptcha"  // hCaptcha
+	ProviderRecaptcha CaptchaProvider = "recaptcha" // Google reCAPTCHA v2/v3
 	ProviderGeetest   CaptchaProvider = "geetest"   // 极验 4.0
 	ProviderImage     CaptchaProvider = "image"     // 系统图形验证码
 )
 
+// Scene 需要人机验证的表单
+type Scene string
+
+const (
+	SceneLogin          Scene = "login"           // 登录
+	SceneRegister       Scene = "register"        // 注册
+	SceneForgotPassword Scene = "forgot_password" // 找回密码
+	SceneSubscribe      Scene = "subscribe"       // 订阅验证码
+	SceneComment        Scene = "comment"         // 游客发表评论
+	SceneLinkApply      Scene = "link_apply"      // 申请友链
+)
+
 // CaptchaConfig 返回给前端的验证码配置
 type CaptchaConfig struct {
 	Provider CaptchaProvider `json:"provider"` // 验证方式
+	// Scenes 需要验证的表单，前端据此决定在哪些表单中渲染验证组件
+	Scenes []Scene `json:"scenes"`
 	// Turnstile 配置
 	TurnstileSiteKey string `json:"turnstile_site_key,omitempty"`
+	// hCaptcha 配置
+	HCaptchaSiteKey string `json:"hcaptcha_site_key,omitempty"`
+	// reCAPTCHA 配置
+	RecaptchaSiteKey string `json:"recaptcha_site_key,omitempty"`
 	// 极验配置
 	GeetestCaptchaId string `json:"geetest_captcha_id,omitempty"`
 	// 系统验证码配置
@@ -42,6 +64,10 @@ type CaptchaConfig struct {
 type CaptchaParams struct {
 	// Turnstile 参数
 	TurnstileToken string `json:"turnstile_token,omitempty"`
+	// hCaptcha 参数
+	HCaptchaToken string `json:"hcaptcha_token,omitempty"`
+	// reCAPTCHA 参数
+	RecaptchaToken string `json:"recaptcha_token,omitempty"`
 	// 极验参数
 	GeetestLotNumber     string `json:"geetest_lot_number,omitempty"`
 	GeetestCaptchaOutput string `json:"geetest_captcha_output,omitempty"`
@@ -68,6 +94,10 @@ type CaptchaService interface {
 	GenerateImageCaptcha(ctx context.Context) (*ImageCaptchaResponse, error)
 	// Verify 统一验证接口
 	Verify(ctx context.Context, params CaptchaParams, remoteIP string) error
+	// VerifyScene 仅当该表单需要人机验证时校验，否则直接通过
+	VerifyScene(ctx context.Context, scene Scene, params CaptchaParams, remoteIP string) error
+	// RequiredFor 检查该表单是否需要人机验证
+	RequiredFor(scene Scene) bool
 	// IsEnabled 检查验证是否启用
 	IsEnabled() bool
 }
@@ -76,6 +106,8 @@ type CaptchaService interface {
 type captchaService struct {
 	settingSvc      setting.SettingService
 	turnstileSvc    turnstile.TurnstileService
+	hcaptchaSvc     siteverify.Verifier
+	recaptchaSvc    siteverify.Verifier
 	geetestSvc      geetest.GeetestService
 	imageCaptchaSvc imagecaptcha.ImageCaptchaService
 }
@@ -84,12 +116,16 @@ type captchaService struct {
 func NewCaptchaService(
 	settingSvc setting.SettingService,
 	turnstileSvc turnstile.TurnstileService,
+	hcaptchaSvc siteverify.Verifier,
+	recaptchaSvc siteverify.Verifier,
 	geetestSvc geetest.GeetestService,
 	imageCaptchaSvc imagecaptcha.ImageCaptchaService,
 ) CaptchaService {
 	return &captchaService{
 		settingSvc:      settingSvc,
 		turnstileSvc:    turnstileSvc,
+		hcaptchaSvc:     hcaptchaSvc,
+		recaptchaSvc:    recaptchaSvc,
 		geetestSvc:      geetestSvc,
 		imageCaptchaSvc: imageCaptchaSvc,
 	}
@@ -100,7 +136,7 @@ func (s *captchaService) GetProvider() CaptchaProvider {
 	provider := s.settingSvc.Get(constant.KeyCaptchaProvider.String())
 
 	switch CaptchaProvider(provider) {
-	case ProviderTurnstile, ProviderGeetest, ProviderImage:
+	case ProviderTurnstile, ProviderHCaptcha, ProviderRecaptcha, ProviderGeetest, ProviderImage:
 		return CaptchaProvider(provider)
 	default:
 		return ProviderNone
@@ -112,16 +148,56 @@ func (s *captchaService) IsEnabled() bool {
 	return s.GetProvider() != ProviderNone
 }
 
+// scenes 读取需要人机验证的表单
+func (s *captchaService) scenes() []Scene {
+	var scenes []Scene
+	for _, name := range strings.Split(s.settingSvc.Get(constant.KeyCaptchaScenes.String()), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			scenes = append(scenes, Scene(name))
+		}
+	}
+	return scenes
+}
+
+// RequiredFor 检查该表单是否需要人机验证
+func (s *captchaService) RequiredFor(scene Scene) bool {
+	if !s.IsEnabled() {
+		return false
+	}
+	for _, sc := range s.scenes() {
+		if sc == scene {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyScene 仅当该表单需要人机验证时校验，否则直接通过
+func (s *captchaService) VerifyScene(ctx context.Context, scene Scene, params CaptchaParams, remoteIP string) error {
+	if !s.RequiredFor(scene) {
+		return nil
+	}
+	return s.Verify(ctx, params, remoteIP)
+}
+
 // GetConfig 获取前端配置
 func (s *captchaService) GetConfig() CaptchaConfig {
 	provider := s.GetProvider()
 	config := CaptchaConfig{
 		Provider: provider,
+		Scenes:   []Scene{},
+	}
+	if provider != ProviderNone {
+		config.Scenes = append(config.Scenes, s.scenes()...)
 	}
 
 	switch provider {
 	case ProviderTurnstile:
 		config.TurnstileSiteKey = s.settingSvc.Get(constant.KeyTurnstileSiteKey.String())
+	case ProviderHCaptcha:
+		config.HCaptchaSiteKey = s.settingSvc.Get(constant.KeyHCaptchaSiteKey.String())
+	case ProviderRecaptcha:
+		config.RecaptchaSiteKey = s.settingSvc.Get(constant.KeyRecaptchaSiteKey.String())
 	case ProviderGeetest:
 		config.GeetestCaptchaId = s.geetestSvc.GetCaptchaId()
 	case ProviderImage:
@@ -162,6 +238,12 @@ func (s *captchaService) Verify(ctx context.Context, params CaptchaParams, remot
 	case ProviderTurnstile:
 		return s.turnstileSvc.Verify(ctx, params.TurnstileToken, remoteIP)
 
+	case ProviderHCaptcha:
+		return s.hcaptchaSvc.Verify(ctx, params.HCaptchaToken, remoteIP)
+
+	case ProviderRecaptcha:
+		return s.recaptchaSvc.Verify(ctx, params.RecaptchaToken, remoteIP)
+
 	case ProviderGeetest:
 		return s.geetestSvc.Verify(
 			ctx,
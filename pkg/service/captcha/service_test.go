@@ -0,0 +1,70 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+type fakeVerifier struct {
+	tokens []string
+}
+
+func (f *fakeVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	f.tokens = append(f.tokens, token)
+	if token != "ok" {
+		return errors.New("人机验证失败")
+	}
+	return nil
+}
+
+func TestVerifyScene(t *testing.T) {
+	hcaptcha := &fakeVerifier{}
+	svc := NewCaptchaService(&fakeSettings{values: map[string]string{
+		constant.KeyCaptchaProvider.String(): "hcaptcha",
+		constant.KeyCaptchaScenes.String():   "register, comment",
+	}}, nil, hcaptcha, &fakeVerifier{}, nil, nil)
+
+	ctx := context.Background()
+	if err := svc.VerifyScene(ctx, SceneLinkApply, CaptchaParams{}, ""); err != nil {
+		t.Errorf("未启用验证的表单应直接通过: %v", err)
+	}
+	if err := svc.VerifyScene(ctx, SceneComment, CaptchaParams{HCaptchaToken: "bad"}, ""); err == nil {
+		t.Error("启用验证的表单应校验 token")
+	}
+	if err := svc.VerifyScene(ctx, SceneRegister, CaptchaParams{HCaptchaToken: "ok"}, ""); err != nil {
+		t.Errorf("token 有效时应通过: %v", err)
+	}
+	if len(hcaptcha.tokens) != 2 {
+		t.Errorf("应只校验启用验证的表单，实际校验 %d 次", len(hcaptcha.tokens))
+	}
+
+	config := svc.GetConfig()
+	if config.Provider != ProviderHCaptcha || len(config.Scenes) != 2 || config.Scenes[1] != SceneComment {
+		t.Errorf("前端配置不正确: %+v", config)
+	}
+}
+
+func TestVerifySceneDisabled(t *testing.T) {
+	svc := NewCaptchaService(&fakeSettings{values: map[string]string{
+		constant.KeyCaptchaProvider.String(): "none",
+		constant.KeyCaptchaScenes.String():   "comment",
+	}}, nil, nil, nil, nil, nil)
+
+	if svc.RequiredFor(SceneComment) {
+		t.Error("未启用人机验证时任何表单都不需要验证")
+	}
+	if scenes := svc.GetConfig().Scenes; len(scenes) != 0 {
+		t.Errorf("未启用时不应下发表单列表: %v", scenes)
+	}
+}
@@ -0,0 +1,323 @@
+/*
+ * @Description: 更新日志服务：从 GitHub Releases 同步或手动录入版本说明，供更新页面与订阅源使用
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package changelog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// defaultAPIBaseURL GitHub API 地址
+	defaultAPIBaseURL = "https://api.github.com"
+	// maxResponseSize Releases 接口响应的大小上限
+	maxResponseSize = 8 << 20
+	// defaultPageSize 默认每页数量
+	defaultPageSize = 10
+	// maxPageSize 每页最大数量
+	maxPageSize = 50
+)
+
+// repoPattern GitHub 仓库只允许 owner/repo 格式
+var repoPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+// HTMLRenderer 把 Markdown 更新说明渲染为安全的 HTML
+type HTMLRenderer interface {
+	ToHTML(ctx context.Context, content string) (string, error)
+}
+
+// githubRelease 是 GitHub Releases 接口返回中用到的字段
+type githubRelease struct {
+	TagName     string     `json:"tag_name"`
+	Name        string     `json:"name"`
+	Body        string     `json:"body"`
+	HTMLURL     string     `json:"html_url"`
+	Draft       bool       `json:"draft"`
+	Prerelease  bool       `json:"prerelease"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+// Service 封装了更新日志的业务逻辑。
+type Service struct {
+	repo       repository.ChangelogRepository
+	settingSvc setting.SettingService
+	renderer   HTMLRenderer
+	httpClient *http.Client
+	apiBaseURL string
+}
+
+// NewService 是更新日志 Service 的构造函数。
+func NewService(
+	repo repository.ChangelogRepository,
+	settingSvc setting.SettingService,
+	renderer HTMLRenderer,
+	httpClient *http.Client,
+) *Service {
+	return &Service{
+		repo:       repo,
+		settingSvc: settingSvc,
+		renderer:   renderer,
+		httpClient: httpClient,
+		apiBaseURL: defaultAPIBaseURL,
+	}
+}
+
+// List 分页获取全部更新日志（包括未公开的）。
+func (s *Service) List(ctx context.Context, page, pageSize int) (*model.ChangelogListResponse, error) {
+	return s.list(ctx, page, pageSize, false)
+}
+
+// ListPublic 分页获取公开的更新日志，只返回渲染后的 HTML。
+func (s *Service) ListPublic(ctx context.Context, page, pageSize int) (*model.ChangelogListResponse, error) {
+	result, err := s.list(ctx, page, pageSize, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range result.List {
+		entry.Content = ""
+	}
+	return result, nil
+}
+
+func (s *Service) list(ctx context.Context, page, pageSize int, publishedOnly bool) (*model.ChangelogListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	entries, total, err := s.repo.List(ctx, &model.ListChangelogOptions{
+		Page:          page,
+		PageSize:      pageSize,
+		PublishedOnly: publishedOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取更新日志失败: %w", err)
+	}
+	return &model.ChangelogListResponse{
+		List:     entries,
+		Total:    int64(total),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// Get 根据ID获取更新日志。
+func (s *Service) Get(ctx context.Context, id string) (*model.ChangelogEntry, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 手动录入一条更新日志。
+func (s *Service) Create(ctx context.Context, req *model.SaveChangelogRequest) (*model.ChangelogEntry, error) {
+	entry := &model.ChangelogEntry{IsPublished: true, PublishedAt: time.Now()}
+	if err := s.applyRequest(ctx, entry, req); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, entry)
+}
+
+// Update 整体更新更新日志；编辑过的同步条目改为手动来源，之后的同步不再覆盖。
+func (s *Service) Update(ctx context.Context, id string, req *model.SaveChangelogRequest) (*model.ChangelogEntry, error) {
+	entry, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyRequest(ctx, entry, req); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, id, entry)
+}
+
+// Delete 删除更新日志；同步来源的版本在下次同步时会重新出现，如需隐藏请改为不公开。
+func (s *Service) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// applyRequest 校验请求并写入条目，未传入的公开状态与发布时间保持原值
+func (s *Service) applyRequest(ctx context.Context, entry *model.ChangelogEntry, req *model.SaveChangelogRequest) error {
+	version := strings.TrimSpace(req.Version)
+	if version == "" {
+		return fmt.Errorf("版本号不能为空: %w", constant.ErrBadRequest)
+	}
+	html, err := s.renderer.ToHTML(ctx, req.Content)
+	if err != nil {
+		return fmt.Errorf("渲染更新说明失败: %w", err)
+	}
+
+	entry.Version = version
+	entry.Title = strings.TrimSpace(req.Title)
+	if entry.Title == "" {
+		entry.Title = version
+	}
+	entry.Content = req.Content
+	entry.ContentHTML = html
+	entry.URL = strings.TrimSpace(req.URL)
+	entry.Source = model.ChangelogSourceManual
+	entry.IsPrerelease = req.IsPrerelease
+	if req.IsPublished != nil {
+		entry.IsPublished = *req.IsPublished
+	}
+	if req.PublishedAt != nil && !req.PublishedAt.IsZero() {
+		entry.PublishedAt = *req.PublishedAt
+	}
+	return nil
+}
+
+// Sync 从配置的 GitHub 仓库同步 Releases：新版本直接公开，已同步的版本在内容变化时更新，
+// 手动录入或编辑过的版本保持不变；草稿始终跳过，预发布版本按配置决定是否同步。
+func (s *Service) Sync(ctx context.Context) (*model.ChangelogSyncResult, error) {
+	repo := strings.TrimSpace(s.settingSvc.Get(constant.KeyChangelogGitHubRepo.String()))
+	if repo == "" {
+		return nil, fmt.Errorf("未配置同步更新日志的 GitHub 仓库: %w", constant.ErrBadRequest)
+	}
+	if !repoPattern.MatchString(repo) {
+		return nil, fmt.Errorf("GitHub 仓库格式应为 owner/repo: %w", constant.ErrBadRequest)
+	}
+	releases, err := s.fetchReleases(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	includePrerelease := s.settingSvc.GetBool(constant.KeyChangelogIncludePrerelease.String())
+	result := &model.ChangelogSyncResult{}
+	for _, release := range releases {
+		version := strings.TrimSpace(release.TagName)
+		if version == "" || release.Draft || (release.Prerelease && !includePrerelease) {
+			result.Skipped++
+			continue
+		}
+		changed, created, err := s.syncRelease(ctx, version, release)
+		if err != nil {
+			return result, fmt.Errorf("同步版本 %s 失败: %w", version, err)
+		}
+		switch {
+		case created:
+			result.Created++
+		case changed:
+			result.Updated++
+		default:
+			result.Skipped++
+		}
+	}
+	return result, nil
+}
+
+// AutoSync 供定时任务调用：未开启自动同步或未配置仓库时跳过，返回新增与更新的版本数
+func (s *Service) AutoSync(ctx context.Context) (int, error) {
+	if !s.settingSvc.GetBool(constant.KeyChangelogAutoSync.String()) {
+		return 0, nil
+	}
+	if strings.TrimSpace(s.settingSvc.Get(constant.KeyChangelogGitHubRepo.String())) == "" {
+		return 0, nil
+	}
+	result, err := s.Sync(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return result.Created + result.Updated, nil
+}
+
+// syncRelease 写入单个 Release，返回是否有变化以及是否为新建
+func (s *Service) syncRelease(ctx context.Context, version string, release githubRelease) (changed bool, created bool, err error) {
+	title := strings.TrimSpace(release.Name)
+	if title == "" {
+		title = version
+	}
+	publishedAt := time.Now()
+	if release.PublishedAt != nil {
+		publishedAt = *release.PublishedAt
+	}
+
+	existing, err := s.repo.GetByVersion(ctx, version)
+	if err != nil && !errors.Is(err, constant.ErrNotFound) {
+		return false, false, err
+	}
+	if existing != nil {
+		if existing.Source != model.ChangelogSourceGitHub {
+			return false, false, nil
+		}
+		if existing.Title == title && existing.Content == release.Body && existing.URL == release.HTMLURL &&
+			existing.IsPrerelease == release.Prerelease && existing.PublishedAt.Equal(publishedAt) {
+			return false, false, nil
+		}
+	}
+
+	html, err := s.renderer.ToHTML(ctx, release.Body)
+	if err != nil {
+		return false, false, fmt.Errorf("渲染更新说明失败: %w", err)
+	}
+	entry := &model.ChangelogEntry{
+		Version:      version,
+		Title:        title,
+		Content:      release.Body,
+		ContentHTML:  html,
+		URL:          release.HTMLURL,
+		Source:       model.ChangelogSourceGitHub,
+		IsPrerelease: release.Prerelease,
+		IsPublished:  true,
+		PublishedAt:  publishedAt,
+	}
+	if existing == nil {
+		_, err = s.repo.Create(ctx, entry)
+		return err == nil, err == nil, err
+	}
+	// 保留管理员设置的公开状态
+	entry.IsPublished = existing.IsPublished
+	_, err = s.repo.Update(ctx, existing.ID, entry)
+	return err == nil, false, err
+}
+
+// fetchReleases 获取仓库最近的 Releases（最多 100 个）
+func (s *Service) fetchReleases(ctx context.Context, repo string) ([]githubRelease, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases?per_page=100", strings.TrimRight(s.apiBaseURL, "/"), repo)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("获取 GitHub Releases 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("GitHub 仓库 %s 不存在或不可访问: %w", repo, constant.ErrBadRequest)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取 GitHub Releases 失败，状态码: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("读取 GitHub Releases 失败: %w", err)
+	}
+	if len(body) > maxResponseSize {
+		return nil, fmt.Errorf("GitHub Releases 响应超过 %d MB", maxResponseSize>>20)
+	}
+	var releases []githubRelease
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, fmt.Errorf("解析 GitHub Releases 失败: %w", err)
+	}
+	return releases, nil
+}
@@ -0,0 +1,229 @@
+package changelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+type fakeRenderer struct{}
+
+func (fakeRenderer) ToHTML(_ context.Context, content string) (string, error) {
+	return "<p>" + content + "</p>", nil
+}
+
+type fakeChangelogRepo struct {
+	repository.ChangelogRepository
+	entries map[string]*model.ChangelogEntry
+	nextID  int
+}
+
+func (f *fakeChangelogRepo) Create(_ context.Context, entry *model.ChangelogEntry) (*model.ChangelogEntry, error) {
+	for _, e := range f.entries {
+		if e.Version == entry.Version {
+			return nil, constant.ErrConflict
+		}
+	}
+	f.nextID++
+	copied := *entry
+	copied.ID = fmt.Sprint(f.nextID)
+	f.entries[copied.ID] = &copied
+	return &copied, nil
+}
+
+func (f *fakeChangelogRepo) Update(_ context.Context, id string, entry *model.ChangelogEntry) (*model.ChangelogEntry, error) {
+	if _, ok := f.entries[id]; !ok {
+		return nil, constant.ErrNotFound
+	}
+	copied := *entry
+	copied.ID = id
+	f.entries[id] = &copied
+	return &copied, nil
+}
+
+func (f *fakeChangelogRepo) GetByID(_ context.Context, id string) (*model.ChangelogEntry, error) {
+	if e, ok := f.entries[id]; ok {
+		copied := *e
+		return &copied, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (f *fakeChangelogRepo) GetByVersion(_ context.Context, version string) (*model.ChangelogEntry, error) {
+	for _, e := range f.entries {
+		if e.Version == version {
+			copied := *e
+			return &copied, nil
+		}
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (f *fakeChangelogRepo) List(_ context.Context, opts *model.ListChangelogOptions) ([]*model.ChangelogEntry, int, error) {
+	var list []*model.ChangelogEntry
+	for _, e := range f.entries {
+		if opts.PublishedOnly && !e.IsPublished {
+			continue
+		}
+		copied := *e
+		list = append(list, &copied)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].PublishedAt.After(list[j].PublishedAt) })
+	return list, len(list), nil
+}
+
+func (f *fakeChangelogRepo) byVersion(version string) *model.ChangelogEntry {
+	e, _ := f.GetByVersion(context.Background(), version)
+	return e
+}
+
+const releasesJSON = `[
+	{"tag_name": "v1.2.0-beta", "name": "", "body": "beta", "html_url": "https://github.com/o/r/releases/v1.2.0-beta", "prerelease": true, "published_at": "2026-10-03T00:00:00Z"},
+	{"tag_name": "v1.1.0", "name": "新功能", "body": "%s", "html_url": "https://github.com/o/r/releases/v1.1.0", "published_at": "2026-10-02T00:00:00Z"},
+	{"tag_name": "v1.0.0", "name": "", "body": "首个版本", "html_url": "https://github.com/o/r/releases/v1.0.0", "published_at": "2026-10-01T00:00:00Z"},
+	{"tag_name": "v0.9.0", "name": "draft", "body": "draft", "draft": true}
+]`
+
+func newTestService(t *testing.T, values map[string]string) (*Service, *fakeChangelogRepo, *string) {
+	t.Helper()
+	body := "改进"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/o/r/releases" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, releasesJSON, body)
+	}))
+	t.Cleanup(server.Close)
+
+	repo := &fakeChangelogRepo{entries: map[string]*model.ChangelogEntry{}}
+	s := NewService(repo, &fakeSettings{values: values}, fakeRenderer{}, server.Client())
+	s.apiBaseURL = server.URL
+	return s, repo, &body
+}
+
+func TestSync(t *testing.T) {
+	s, repo, body := newTestService(t, map[string]string{
+		constant.KeyChangelogGitHubRepo.String(): "o/r",
+	})
+	ctx := context.Background()
+
+	result, err := s.Sync(ctx)
+	if err != nil {
+		t.Fatalf("Sync() 返回错误: %v", err)
+	}
+	if result.Created != 2 || result.Updated != 0 || result.Skipped != 2 {
+		t.Fatalf("首次同步应新增正式版本并跳过草稿与预发布版本: %+v", result)
+	}
+	v100 := repo.byVersion("v1.0.0")
+	if v100 == nil || v100.Title != "v1.0.0" || v100.ContentHTML != "<p>首个版本</p>" || v100.Source != model.ChangelogSourceGitHub || !v100.IsPublished {
+		t.Fatalf("同步的版本内容不正确: %+v", v100)
+	}
+
+	// 管理员隐藏的同步版本在内容更新后仍保持隐藏
+	hidden := repo.byVersion("v1.1.0")
+	hidden.IsPublished = false
+	repo.entries[hidden.ID] = hidden
+	*body = "改进与修复"
+	result, _ = s.Sync(ctx)
+	if result.Created != 0 || result.Updated != 1 {
+		t.Fatalf("内容变化的版本应被更新: %+v", result)
+	}
+	if updated := repo.byVersion("v1.1.0"); updated.Content != "改进与修复" || updated.IsPublished {
+		t.Fatalf("同步应更新内容并保留公开状态: %+v", updated)
+	}
+
+	// 手动编辑过的版本不再被同步覆盖
+	manualReq := &model.SaveChangelogRequest{Version: "v1.0.0", Content: "手动说明"}
+	if _, err := s.Update(ctx, v100.ID, manualReq); err != nil {
+		t.Fatalf("Update() 返回错误: %v", err)
+	}
+	*body = "再次修改"
+	if _, err := s.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if e := repo.byVersion("v1.0.0"); e.Content != "手动说明" || e.Source != model.ChangelogSourceManual {
+		t.Fatalf("手动编辑的版本不应被同步覆盖: %+v", e)
+	}
+}
+
+func TestSyncPrereleaseAndSettings(t *testing.T) {
+	s, repo, _ := newTestService(t, map[string]string{
+		constant.KeyChangelogGitHubRepo.String():        "o/r",
+		constant.KeyChangelogIncludePrerelease.String(): "true",
+	})
+	if result, err := s.Sync(context.Background()); err != nil || result.Created != 3 {
+		t.Fatalf("开启预发布后应同步预发布版本: %+v, %v", result, err)
+	}
+	if e := repo.byVersion("v1.2.0-beta"); e == nil || !e.IsPrerelease {
+		t.Fatalf("预发布版本应被标记: %+v", e)
+	}
+
+	for _, repoName := range []string{"", "not a repo", "o/r/x"} {
+		s, _, _ := newTestService(t, map[string]string{constant.KeyChangelogGitHubRepo.String(): repoName})
+		if _, err := s.Sync(context.Background()); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("仓库 %q 应返回 ErrBadRequest, 实际为 %v", repoName, err)
+		}
+	}
+	s, _, _ = newTestService(t, map[string]string{constant.KeyChangelogGitHubRepo.String(): "o/missing"})
+	if _, err := s.Sync(context.Background()); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("不存在的仓库应返回 ErrBadRequest, 实际为 %v", err)
+	}
+
+	s, repo, _ = newTestService(t, map[string]string{constant.KeyChangelogGitHubRepo.String(): "o/r"})
+	if n, err := s.AutoSync(context.Background()); n != 0 || err != nil || len(repo.entries) != 0 {
+		t.Fatalf("未开启自动同步时应跳过: %d, %v", n, err)
+	}
+}
+
+func TestCreateAndListPublic(t *testing.T) {
+	s, _, _ := newTestService(t, nil)
+	ctx := context.Background()
+
+	if _, err := s.Create(ctx, &model.SaveChangelogRequest{Version: "  "}); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("空版本号应返回 ErrBadRequest, 实际为 %v", err)
+	}
+	entry, err := s.Create(ctx, &model.SaveChangelogRequest{Version: " v2.0.0 ", Content: "大版本"})
+	if err != nil {
+		t.Fatalf("Create() 返回错误: %v", err)
+	}
+	if entry.Version != "v2.0.0" || entry.Title != "v2.0.0" || entry.Source != model.ChangelogSourceManual || !entry.IsPublished || entry.PublishedAt.IsZero() {
+		t.Fatalf("手动录入的更新日志字段不正确: %+v", entry)
+	}
+	hidden := false
+	if _, err := s.Create(ctx, &model.SaveChangelogRequest{Version: "v2.1.0", IsPublished: &hidden}); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := s.ListPublic(ctx, 0, 0)
+	if err != nil || len(list.List) != 1 || list.List[0].Version != "v2.0.0" {
+		t.Fatalf("公开列表只应包含公开的更新日志: %+v, %v", list, err)
+	}
+	if list.List[0].Content != "" || list.List[0].ContentHTML == "" {
+		t.Errorf("公开列表只应返回渲染后的 HTML: %+v", list.List[0])
+	}
+	if list.Page != 1 || list.PageSize != defaultPageSize {
+		t.Errorf("分页参数未规范化: page=%d pageSize=%d", list.Page, list.PageSize)
+	}
+	if all, _ := s.List(ctx, 1, 10); len(all.List) != 2 {
+		t.Errorf("管理列表应包含未公开的更新日志: %+v", all)
+	}
+}
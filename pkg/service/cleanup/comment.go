@@ -0,0 +1,229 @@
+/*
+ * @Description: 评论自动清理规则
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 14:00:00
+ * @LastEditTime: 2026-10-16 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// maxReportSampleIDs 清理报告中每条规则列出的评论ID数量上限
+const maxReportSampleIDs = 50
+
+// CommentCleanupRules 评论清理规则，来自 comment.cleanup.* 配置
+type CommentCleanupRules struct {
+	PendingDays     int      `json:"pending_days"`
+	BannedEmails    []string `json:"banned_emails"`
+	BannedIPs       []string `json:"banned_ips"`
+	CollapseOrphans bool     `json:"collapse_orphans"`
+}
+
+// CommentCleanupRuleResult 单条规则的命中情况
+type CommentCleanupRuleResult struct {
+	Count     int      `json:"count"`
+	SampleIDs []string `json:"sample_ids"`
+}
+
+// CommentCleanupReport 评论清理报告。DryRun 为 true 时仅统计，不修改数据。
+// 试运行时孤立回复只统计当前已存在的；正式执行会在删除之后再查找，因此可能多于报告中的数量。
+type CommentCleanupReport struct {
+	DryRun          bool                     `json:"dry_run"`
+	Rules           CommentCleanupRules      `json:"rules"`
+	StalePending    CommentCleanupRuleResult `json:"stale_pending"`
+	BannedAuthors   CommentCleanupRuleResult `json:"banned_authors"`
+	OrphanedReplies CommentCleanupRuleResult `json:"orphaned_replies"`
+	Deleted         int                      `json:"deleted"`
+	Reparented      int                      `json:"reparented"`
+	Warnings        []string                 `json:"warnings,omitempty"`
+}
+
+// SetCommentCleanup 注入读取评论清理规则所需的配置服务，以及执行删除与整理的评论仓库。
+func (s *CleanupService) SetCommentCleanup(settingSvc setting.SettingService, commentRepo repository.CommentRepository) {
+	s.settingSvc = settingSvc
+	s.commentRepo = commentRepo
+}
+
+// commentCleanupRules 读取当前配置的评论清理规则
+func (s *CleanupService) commentCleanupRules() CommentCleanupRules {
+	days, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyCommentCleanupPendingDays.String())))
+	if err != nil || days < 0 {
+		days = 0
+	}
+	rules := CommentCleanupRules{
+		PendingDays:     days,
+		BannedEmails:    splitRuleList(s.settingSvc.Get(constant.KeyCommentCleanupBannedEmails.String())),
+		BannedIPs:       splitRuleList(s.settingSvc.Get(constant.KeyCommentCleanupBannedIPs.String())),
+		CollapseOrphans: s.settingSvc.GetBool(constant.KeyCommentCleanupCollapseOrphans.String()),
+	}
+	return rules
+}
+
+// CleanupComments 按配置的规则清理评论：删除超期待审核评论与屏蔽邮箱/IP 的评论，再整理孤立回复。
+// dryRun 为 true 时只生成报告。
+func (s *CleanupService) CleanupComments(ctx context.Context, dryRun bool) (*CommentCleanupReport, error) {
+	if s.settingSvc == nil || s.commentRepo == nil {
+		return nil, errors.New("评论清理功能未初始化")
+	}
+	rules := s.commentCleanupRules()
+	report := &CommentCleanupReport{DryRun: dryRun, Rules: rules}
+
+	emails, domains, ips, networks, warnings := parseAuthorRules(rules.BannedEmails, rules.BannedIPs)
+	report.Warnings = warnings
+
+	var staleIDs []uint
+	if rules.PendingDays > 0 {
+		before := time.Now().AddDate(0, 0, -rules.PendingDays)
+		ids, err := s.cleanupRepo.FindStalePendingComments(ctx, before)
+		if err != nil {
+			return nil, err
+		}
+		staleIDs = ids
+	}
+	report.StalePending = ruleResult(staleIDs)
+
+	bannedIDs, err := s.cleanupRepo.FindCommentsByAuthor(ctx, emails, domains, ips, networks)
+	if err != nil {
+		return nil, err
+	}
+	report.BannedAuthors = ruleResult(bannedIDs)
+
+	if !dryRun {
+		if toDelete := uniqueIDs(staleIDs, bannedIDs); len(toDelete) > 0 {
+			if report.Deleted, err = s.commentRepo.DeleteByIDs(ctx, toDelete); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if rules.CollapseOrphans {
+		orphans, err := s.cleanupRepo.FindOrphanedReplies(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if dryRun {
+			orphans = excludeDeleted(orphans, staleIDs, bannedIDs)
+		}
+		orphanIDs := make([]uint, 0, len(orphans))
+		for _, o := range orphans {
+			orphanIDs = append(orphanIDs, o.ID)
+		}
+		report.OrphanedReplies = ruleResult(orphanIDs)
+		if !dryRun {
+			if report.Reparented, err = s.commentRepo.ReparentComments(ctx, orphans); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !dryRun {
+		log.Printf("[Cleanup] 评论清理完成: 删除 %d 条, 整理孤立回复 %d 条", report.Deleted, report.Reparented)
+	}
+	return report, nil
+}
+
+// splitRuleList 按逗号或换行拆分配置项并去掉空白
+func splitRuleList(raw string) []string {
+	fields := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	})
+	result := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// parseAuthorRules 把屏蔽列表拆分为完整邮箱、邮箱域名、单个 IP 与网段，无法识别的条目作为警告返回
+func parseAuthorRules(bannedEmails, bannedIPs []string) (emails, domains, ips []string, networks []*net.IPNet, warnings []string) {
+	for _, e := range bannedEmails {
+		e = strings.ToLower(e)
+		switch {
+		case strings.HasPrefix(e, "@") && len(e) > 1:
+			domains = append(domains, e)
+		case strings.Contains(e, "@"):
+			emails = append(emails, e)
+		default:
+			warnings = append(warnings, fmt.Sprintf("无效的邮箱规则: %s", e))
+		}
+	}
+	for _, entry := range bannedIPs {
+		if strings.Contains(entry, "/") {
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("无效的 IP 网段: %s", entry))
+				continue
+			}
+			networks = append(networks, network)
+			continue
+		}
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			warnings = append(warnings, fmt.Sprintf("无效的 IP: %s", entry))
+			continue
+		}
+		ips = append(ips, ip.String())
+	}
+	return emails, domains, ips, networks, warnings
+}
+
+func ruleResult(ids []uint) CommentCleanupRuleResult {
+	result := CommentCleanupRuleResult{Count: len(ids), SampleIDs: make([]string, 0)}
+	for _, id := range ids {
+		if len(result.SampleIDs) >= maxReportSampleIDs {
+			break
+		}
+		publicID, err := idgen.GeneratePublicID(id, idgen.EntityTypeComment)
+		if err != nil {
+			continue
+		}
+		result.SampleIDs = append(result.SampleIDs, publicID)
+	}
+	return result
+}
+
+func uniqueIDs(groups ...[]uint) []uint {
+	seen := make(map[uint]bool)
+	var result []uint
+	for _, ids := range groups {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
+// excludeDeleted 试运行时去掉本次会被删除的评论，避免同一条评论在报告中重复出现
+func excludeDeleted(orphans []repository.OrphanedReply, groups ...[]uint) []repository.OrphanedReply {
+	deleted := make(map[uint]bool)
+	for _, id := range uniqueIDs(groups...) {
+		deleted[id] = true
+	}
+	result := orphans[:0:0]
+	for _, o := range orphans {
+		if !deleted[o.ID] {
+			result = append(result, o)
+		}
+	}
+	return result
+}
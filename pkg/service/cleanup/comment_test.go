@@ -0,0 +1,123 @@
+package cleanup
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+type fakeCleanupRepo struct {
+	repository.CleanupRepository
+	stale    []uint
+	banned   []uint
+	orphans  []repository.OrphanedReply
+	emails   []string
+	domains  []string
+	ips      []string
+	networks []*net.IPNet
+}
+
+func (f *fakeCleanupRepo) FindStalePendingComments(ctx context.Context, before time.Time) ([]uint, error) {
+	return f.stale, nil
+}
+
+func (f *fakeCleanupRepo) FindCommentsByAuthor(ctx context.Context, emails, emailDomains, ips []string, networks []*net.IPNet) ([]uint, error) {
+	f.emails, f.domains, f.ips, f.networks = emails, emailDomains, ips, networks
+	return f.banned, nil
+}
+
+func (f *fakeCleanupRepo) FindOrphanedReplies(ctx context.Context) ([]repository.OrphanedReply, error) {
+	return f.orphans, nil
+}
+
+type fakeCommentRepo struct {
+	repository.CommentRepository
+	deleted    []uint
+	reparented []repository.OrphanedReply
+}
+
+func (f *fakeCommentRepo) DeleteByIDs(ctx context.Context, ids []uint) (int, error) {
+	f.deleted = append(f.deleted, ids...)
+	return len(ids), nil
+}
+
+func (f *fakeCommentRepo) ReparentComments(ctx context.Context, replies []repository.OrphanedReply) (int, error) {
+	f.reparented = append(f.reparented, replies...)
+	return len(replies), nil
+}
+
+func newCommentCleanupService(repo *fakeCleanupRepo, commentRepo *fakeCommentRepo) *CleanupService {
+	svc := &CleanupService{cleanupRepo: repo}
+	svc.SetCommentCleanup(&fakeSettings{values: map[string]string{
+		constant.KeyCommentCleanupPendingDays.String():     "30",
+		constant.KeyCommentCleanupBannedEmails.String():    "Spam@Example.com\n@Junk.io, not-an-email",
+		constant.KeyCommentCleanupBannedIPs.String():       "1.2.3.4,10.0.0.0/8,bad-ip",
+		constant.KeyCommentCleanupCollapseOrphans.String(): "true",
+	}}, commentRepo)
+	return svc
+}
+
+func TestCleanupCommentsDryRun(t *testing.T) {
+	repo := &fakeCleanupRepo{
+		stale:   []uint{1, 2},
+		banned:  []uint{2, 3},
+		orphans: []repository.OrphanedReply{{ID: 3}, {ID: 4}},
+	}
+	commentRepo := &fakeCommentRepo{}
+	report, err := newCommentCleanupService(repo, commentRepo).CleanupComments(context.Background(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commentRepo.deleted) != 0 || len(commentRepo.reparented) != 0 {
+		t.Fatal("试运行不应修改数据")
+	}
+	if report.StalePending.Count != 2 || report.BannedAuthors.Count != 2 {
+		t.Errorf("规则命中数错误: %+v", report)
+	}
+	if report.OrphanedReplies.Count != 1 {
+		t.Errorf("将被删除的评论不应计入孤立回复: %+v", report.OrphanedReplies)
+	}
+	if len(report.Warnings) != 2 {
+		t.Errorf("期望两条无效规则警告，实际 %v", report.Warnings)
+	}
+	if !reflect.DeepEqual(repo.emails, []string{"spam@example.com"}) || !reflect.DeepEqual(repo.domains, []string{"@junk.io"}) {
+		t.Errorf("邮箱规则解析错误: %v %v", repo.emails, repo.domains)
+	}
+	if !reflect.DeepEqual(repo.ips, []string{"1.2.3.4"}) || len(repo.networks) != 1 {
+		t.Errorf("IP 规则解析错误: %v %v", repo.ips, repo.networks)
+	}
+}
+
+func TestCleanupCommentsApply(t *testing.T) {
+	repo := &fakeCleanupRepo{
+		stale:   []uint{1, 2},
+		banned:  []uint{2, 3},
+		orphans: []repository.OrphanedReply{{ID: 4}},
+	}
+	commentRepo := &fakeCommentRepo{}
+	report, err := newCommentCleanupService(repo, commentRepo).CleanupComments(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(commentRepo.deleted, []uint{1, 2, 3}) {
+		t.Errorf("删除的评论应去重: %v", commentRepo.deleted)
+	}
+	if report.Deleted != 3 || report.Reparented != 1 {
+		t.Errorf("清理结果错误: %+v", report)
+	}
+}
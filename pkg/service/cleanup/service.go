@@ -2,26 +2,57 @@
  * @Description:
  * @Author: 安知鱼
  * @Date: 2025-08-02 16:13:20
- * @LastEditTime: 2025-08-02 16:13:25
+ * @LastEditTime: 2026-10-18 08:00:00
  * @LastEditors: 安知鱼
  */
 package cleanup
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
 
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 )
 
 // ICleanupService 定义了清理服务的接口。
 type ICleanupService interface {
 	CleanupOrphanedItems(ctx context.Context) (int, int, error)
+	CleanupOrphanedMedia(ctx context.Context, dryRun bool) (*MediaCleanupReport, error)
+	SetMediaCleanup(finder OrphanMediaFinder, deleter FileDeleter)
+	CleanupComments(ctx context.Context, dryRun bool) (*CommentCleanupReport, error)
+	SetCommentCleanup(settingSvc setting.SettingService, commentRepo repository.CommentRepository)
+}
+
+// OrphanMediaFinder 查找未被任何内容引用的媒体文件，按所有者ID分组返回文件公共ID。
+type OrphanMediaFinder interface {
+	FindOrphanFiles(ctx context.Context) (map[uint][]string, error)
+}
+
+// FileDeleter 按所有者删除文件，回收站启用时移入回收站。
+type FileDeleter interface {
+	DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error
+}
+
+// MediaCleanupReport 孤立媒体清理报告。DryRun 为 true 时仅统计，不修改数据。
+type MediaCleanupReport struct {
+	DryRun  bool `json:"dry_run"`
+	Orphans int  `json:"orphans"`
+	Trashed int  `json:"trashed"`
 }
 
 // CleanupService 封装了清理相关的业务逻辑。
 type CleanupService struct {
 	cleanupRepo repository.CleanupRepository
+	mediaFinder OrphanMediaFinder
+	fileDeleter FileDeleter
+	settingSvc  setting.SettingService
+	commentRepo repository.CommentRepository
 }
 
 // NewCleanupService 是 Service 的构造函数。
@@ -37,3 +68,54 @@ func (s *CleanupService) CleanupOrphanedItems(ctx context.Context) (int, int, er
 	}
 	return deletedTags, deletedCategories, nil
 }
+
+// SetMediaCleanup 注入孤立媒体的查找与删除能力（文件服务初始化较晚，因此延迟注入）。
+func (s *CleanupService) SetMediaCleanup(finder OrphanMediaFinder, deleter FileDeleter) {
+	s.mediaFinder = finder
+	s.fileDeleter = deleter
+}
+
+// CleanupOrphanedMedia 查找未被任何内容引用的图片。试运行时只统计数量；正式执行时把它们移入回收站，
+// 回收站未启用时拒绝执行，避免误判的图片被永久删除后无法恢复。
+// 单个所有者删除失败不会中断其他所有者的清理，所有错误会合并返回。
+func (s *CleanupService) CleanupOrphanedMedia(ctx context.Context, dryRun bool) (*MediaCleanupReport, error) {
+	if s.mediaFinder == nil || s.fileDeleter == nil {
+		return nil, errors.New("媒体清理功能未初始化")
+	}
+	if !dryRun && !s.recycleBinEnabled() {
+		return nil, fmt.Errorf("%w: 回收站未启用，无法清理孤立媒体，请先设置回收站保留天数", constant.ErrBadRequest)
+	}
+
+	orphans, err := s.mediaFinder.FindOrphanFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找孤立媒体失败: %w", err)
+	}
+
+	report := &MediaCleanupReport{DryRun: dryRun}
+	for _, publicIDs := range orphans {
+		report.Orphans += len(publicIDs)
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	var errs []error
+	for ownerID, publicIDs := range orphans {
+		if err := s.fileDeleter.DeleteItems(ctx, ownerID, publicIDs); err != nil {
+			errs = append(errs, fmt.Errorf("删除用户 %d 的孤立媒体失败: %w", ownerID, err))
+			continue
+		}
+		report.Trashed += len(publicIDs)
+	}
+	log.Printf("[Cleanup] 孤立媒体清理完成: 移入回收站 %d 个文件, 失败 %d 组", report.Trashed, len(errs))
+	return report, errors.Join(errs...)
+}
+
+// recycleBinEnabled 判断回收站是否启用（保留天数大于 0）
+func (s *CleanupService) recycleBinEnabled() bool {
+	if s.settingSvc == nil {
+		return false
+	}
+	days, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyRecycleBinRetentionDays.String())))
+	return err == nil && days > 0
+}
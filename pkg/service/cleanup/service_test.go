@@ -0,0 +1,64 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+type fakeOrphanFinder map[uint][]string
+
+func (f fakeOrphanFinder) FindOrphanFiles(ctx context.Context) (map[uint][]string, error) {
+	return f, nil
+}
+
+type fakeFileDeleter struct {
+	trashed map[uint][]string
+}
+
+func (f *fakeFileDeleter) DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error {
+	if f.trashed == nil {
+		f.trashed = make(map[uint][]string)
+	}
+	f.trashed[ownerID] = append(f.trashed[ownerID], publicIDs...)
+	return nil
+}
+
+func TestCleanupOrphanedMedia(t *testing.T) {
+	finder := fakeOrphanFinder{1: {"a", "b"}, 2: {"c"}}
+	settings := &fakeSettings{values: map[string]string{}}
+	deleter := &fakeFileDeleter{}
+	svc := &CleanupService{}
+	svc.SetCommentCleanup(settings)
+	svc.SetMediaCleanup(finder, deleter)
+	ctx := context.Background()
+
+	report, err := svc.CleanupOrphanedMedia(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.DryRun || report.Orphans != 3 || report.Trashed != 0 || len(deleter.trashed) != 0 {
+		t.Errorf("试运行不应删除文件: %+v, %v", report, deleter.trashed)
+	}
+
+	if _, err := svc.CleanupOrphanedMedia(ctx, false); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("回收站未启用时应拒绝执行，实际 %v", err)
+	}
+	if len(deleter.trashed) != 0 {
+		t.Fatalf("拒绝执行时不应删除文件: %v", deleter.trashed)
+	}
+
+	settings.values[constant.KeyRecycleBinRetentionDays.String()] = "30"
+	report, err = svc.CleanupOrphanedMedia(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.DryRun || report.Orphans != 3 || report.Trashed != 3 {
+		t.Errorf("清理报告不正确: %+v", report)
+	}
+	if len(deleter.trashed[1]) != 2 || len(deleter.trashed[2]) != 1 {
+		t.Errorf("应按所有者移入回收站: %v", deleter.trashed)
+	}
+}
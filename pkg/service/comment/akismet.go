@@ -0,0 +1,124 @@
+// anheyu-app/pkg/service/comment/akismet.go
+package comment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// akismetEndpoint Akismet REST API 地址，API Key 通过 api_key 参数传递
+const akismetEndpoint = "https://rest.akismet.com/1.1"
+
+// AkismetDetector 使用 Akismet 检测垃圾评论，未配置 API Key 时不生效
+type AkismetDetector struct {
+	settingSvc setting.SettingService
+	client     *http.Client
+	endpoint   string
+}
+
+// NewAkismetDetector 创建 Akismet 检测器
+func NewAkismetDetector(settingSvc setting.SettingService) *AkismetDetector {
+	return &AkismetDetector{
+		settingSvc: settingSvc,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		endpoint:   akismetEndpoint,
+	}
+}
+
+// Name 返回检测器名称
+func (d *AkismetDetector) Name() string {
+	return "Akismet"
+}
+
+// Check 调用 comment-check 接口，返回 "true" 表示垃圾评论
+func (d *AkismetDetector) Check(ctx context.Context, c *SpamCandidate) (bool, error) {
+	form, ok := d.form(c)
+	if !ok {
+		return false, nil
+	}
+	body, err := d.post(ctx, "comment-check", form)
+	if err != nil {
+		return false, err
+	}
+	switch body {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf("Akismet 返回了无法识别的结果: %q", body)
+	}
+}
+
+// Feedback 调用 submit-spam / submit-ham 接口纠正 Akismet 的判断
+func (d *AkismetDetector) Feedback(ctx context.Context, c *SpamCandidate, spam bool) error {
+	form, ok := d.form(c)
+	if !ok {
+		return nil
+	}
+	method := "submit-ham"
+	if spam {
+		method = "submit-spam"
+	}
+	_, err := d.post(ctx, method, form)
+	return err
+}
+
+// form 构建 Akismet 请求参数，未配置 API Key 时返回 false
+func (d *AkismetDetector) form(c *SpamCandidate) (url.Values, bool) {
+	apiKey := strings.TrimSpace(d.settingSvc.Get(constant.KeyCommentSpamAkismetKey.String()))
+	if apiKey == "" {
+		return nil, false
+	}
+	siteURL := strings.TrimSuffix(d.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	form := url.Values{}
+	form.Set("api_key", apiKey)
+	form.Set("blog", siteURL)
+	form.Set("permalink", siteURL+c.TargetPath)
+	form.Set("comment_type", "comment")
+	form.Set("comment_author", c.Nickname)
+	form.Set("comment_author_email", c.Email)
+	form.Set("comment_author_url", c.Website)
+	form.Set("comment_content", c.Content)
+	form.Set("user_ip", c.IP)
+	form.Set("user_agent", c.UserAgent)
+	form.Set("referrer", c.Referer)
+	form.Set("blog_charset", "UTF-8")
+	return form, true
+}
+
+func (d *AkismetDetector) post(ctx context.Context, method string, form url.Values) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.endpoint+"/"+method, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("创建 Akismet 请求失败: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", "anheyu-app | Akismet/1.1")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Akismet 请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		return "", fmt.Errorf("读取 Akismet 响应失败: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Akismet 返回状态码: %d", resp.StatusCode)
+	}
+	// API Key 无效时返回 "invalid"，具体原因在 X-akismet-debug-help 头中
+	if help := resp.Header.Get("X-akismet-debug-help"); help != "" {
+		return "", fmt.Errorf("Akismet 请求无效: %s", help)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
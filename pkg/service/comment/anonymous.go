@@ -0,0 +1,76 @@
+// anheyu-app/pkg/service/comment/anonymous.go
+package comment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// 匿名化名由「形容词 + 动物」组成
+var (
+	pseudonymAdjectives = []string{
+		"安静的", "勇敢的", "好奇的", "慵懒的", "机智的", "温柔的", "快乐的", "害羞的",
+		"认真的", "迷糊的", "淡定的", "热心的", "神秘的", "调皮的", "沉思的", "爱笑的",
+	}
+	pseudonymAnimals = []string{
+		"水獭", "狐狸", "熊猫", "海豚", "企鹅", "猫头鹰", "松鼠", "刺猬",
+		"考拉", "浣熊", "鲸鱼", "麋鹿", "兔子", "柴犬", "橘猫", "仓鼠",
+		"海獭", "火烈鸟", "树懒", "羊驼", "小鹿", "河马", "蜂鸟", "雪豹",
+	}
+)
+
+// anonymousIdentity 为匿名评论生成同一页面内稳定的化名与头像哈希。
+// 化名由加盐的 HMAC(目标路径, IP) 派生：同一访客在同一页面下保持一致，
+// 不同页面之间无法关联，也无法从化名反推 IP。没有 IP 的历史评论退回使用评论ID。
+func (s *Service) anonymousIdentity(c *model.Comment) (nickname, avatarHash string) {
+	salt := s.settingSvc.Get(constant.KeyCommentAnonymousSalt.String())
+	source := c.Author.IP
+	if source == "" {
+		source = "#" + strconv.FormatUint(uint64(c.ID), 10)
+	}
+
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(c.TargetPath))
+	mac.Write([]byte{0})
+	mac.Write([]byte(source))
+	sum := mac.Sum(nil)
+
+	adjective := pseudonymAdjectives[binary.BigEndian.Uint32(sum[0:4])%uint32(len(pseudonymAdjectives))]
+	animal := pseudonymAnimals[binary.BigEndian.Uint32(sum[4:8])%uint32(len(pseudonymAnimals))]
+	// 取 16 字节作为头像哈希，与邮箱 MD5 长度一致，Gravatar 会为其生成默认头像
+	return adjective + animal, hex.EncodeToString(sum[16:32])
+}
+
+// anonymousDisabledFor 判断目标路径是否禁止匿名评论。
+// 配置为逗号或换行分隔的路径，以 * 结尾表示前缀匹配，例如 /posts/*。
+func (s *Service) anonymousDisabledFor(targetPath string) bool {
+	raw := s.settingSvc.Get(constant.KeyCommentAnonymousDisabledPaths.String())
+	return matchPathList(raw, targetPath)
+}
+
+func matchPathList(raw, targetPath string) bool {
+	fields := strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' })
+	for _, pattern := range fields {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(targetPath, prefix) {
+				return true
+			}
+			continue
+		}
+		if strings.TrimSuffix(pattern, "/") == strings.TrimSuffix(targetPath, "/") {
+			return true
+		}
+	}
+	return false
+}
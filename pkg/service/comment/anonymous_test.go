@@ -0,0 +1,49 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+func TestAnonymousIdentity(t *testing.T) {
+	settings := &fakeProfileSettings{values: map[string]string{constant.KeyCommentAnonymousSalt.String(): "salt"}}
+	svc := &Service{settingSvc: settings}
+	comment := func(id uint, path, ip string) *model.Comment {
+		return &model.Comment{ID: id, TargetPath: path, Author: model.Author{IP: ip}}
+	}
+
+	name1, hash1 := svc.anonymousIdentity(comment(1, "/posts/a", "1.1.1.1"))
+	name2, hash2 := svc.anonymousIdentity(comment(2, "/posts/a", "1.1.1.1"))
+	if name1 == "" || name1 != name2 || hash1 != hash2 || len(hash1) != 32 {
+		t.Fatalf("同一页面同一访客的化名应保持一致: %s/%s %s/%s", name1, hash1, name2, hash2)
+	}
+	if _, hash := svc.anonymousIdentity(comment(3, "/posts/b", "1.1.1.1")); hash == hash1 {
+		t.Error("不同页面的化名不应相同")
+	}
+	if _, hash := svc.anonymousIdentity(comment(4, "/posts/a", "2.2.2.2")); hash == hash1 {
+		t.Error("不同访客的化名不应相同")
+	}
+
+	settings.values[constant.KeyCommentAnonymousSalt.String()] = "other"
+	if _, hash := svc.anonymousIdentity(comment(1, "/posts/a", "1.1.1.1")); hash == hash1 {
+		t.Error("更换盐值后化名应改变")
+	}
+}
+
+func TestMatchPathList(t *testing.T) {
+	raw := "/about/, /posts/*\n/link"
+	for path, want := range map[string]bool{
+		"/about":        true,
+		"/posts/hello":  true,
+		"/link":         true,
+		"/links":        false,
+		"/moments/1":    false,
+		"/about/friend": false,
+	} {
+		if got := matchPathList(raw, path); got != want {
+			t.Errorf("matchPathList(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// anheyu-app/pkg/service/comment/audit.go
+package comment
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+)
+
+// commentAuditSnapshot 审计日志中记录的评论字段
+type commentAuditSnapshot struct {
+	TargetPath string       `json:"target_path"`
+	Nickname   string       `json:"nickname"`
+	Email      string       `json:"email,omitempty"`
+	Website    string       `json:"website,omitempty"`
+	Content    string       `json:"content"`
+	Status     model.Status `json:"status"`
+	Pinned     bool         `json:"pinned"`
+}
+
+func newCommentAuditSnapshot(c *model.Comment) *commentAuditSnapshot {
+	if c == nil {
+		return nil
+	}
+	snapshot := &commentAuditSnapshot{
+		TargetPath: c.TargetPath,
+		Nickname:   c.Author.Nickname,
+		Content:    c.Content,
+		Status:     c.Status,
+		Pinned:     c.PinnedAt != nil,
+	}
+	if c.Author.Email != nil {
+		snapshot.Email = *c.Author.Email
+	}
+	if c.Author.Website != nil {
+		snapshot.Website = *c.Author.Website
+	}
+	return snapshot
+}
+
+// SetAuditService 注入审计日志服务，记录后台对评论的审核、编辑与删除
+func (s *Service) SetAuditService(svc *audit.Service) {
+	s.auditSvc = svc
+}
+
+// auditEnabled 当前请求是否需要写入审计日志，不需要时可以省去查询修改前快照
+func (s *Service) auditEnabled(ctx context.Context) bool {
+	if s.auditSvc == nil {
+		return false
+	}
+	_, ok := audit.ActorFromContext(ctx)
+	return ok
+}
+
+// commentsBeforeChange 查询即将修改的评论作为修改前快照，不需要审计时返回 nil
+func (s *Service) commentsBeforeChange(ctx context.Context, dbIDs []uint) []*model.Comment {
+	if !s.auditEnabled(ctx) || len(dbIDs) == 0 {
+		return nil
+	}
+	comments, err := s.repo.FindManyByIDs(ctx, dbIDs)
+	if err != nil {
+		slog.WarnContext(ctx, "查询评论修改前快照失败", slog.Any("error", err))
+		return nil
+	}
+	return comments
+}
+
+// commentBeforeChange 查询单条评论的修改前快照，不需要审计时返回 nil
+func (s *Service) commentBeforeChange(ctx context.Context, dbID uint) *model.Comment {
+	if !s.auditEnabled(ctx) {
+		return nil
+	}
+	c, err := s.repo.FindByID(ctx, dbID)
+	if err != nil {
+		slog.WarnContext(ctx, "查询评论修改前快照失败", slog.Uint64("comment_id", uint64(dbID)), slog.Any("error", err))
+		return nil
+	}
+	return c
+}
+
+// auditComment 记录单条评论的变更，after 为 nil 表示删除
+func (s *Service) auditComment(ctx context.Context, action string, before, after *model.Comment) {
+	target := after
+	if target == nil {
+		target = before
+	}
+	if target == nil {
+		return
+	}
+	publicID, _ := idgen.GeneratePublicID(target.ID, idgen.EntityTypeComment)
+	var beforeSnapshot, afterSnapshot *commentAuditSnapshot
+	if before != nil {
+		beforeSnapshot = newCommentAuditSnapshot(before)
+	}
+	if after != nil {
+		afterSnapshot = newCommentAuditSnapshot(after)
+	}
+	s.auditSvc.RecordChange(ctx, action, model.AuditEntityComment, publicID, beforeSnapshot, afterSnapshot)
+}
@@ -0,0 +1,188 @@
+// anheyu-app/pkg/service/comment/bayes.go
+package comment
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// bayesSamplesToken 保留词条，记录垃圾/正常样本总数
+	bayesSamplesToken = "__samples__"
+	// maxBayesTokens 单条评论最多参与计算的词条数
+	maxBayesTokens = 300
+	// bayesInterestingTokens 判定时只使用偏离 0.5 最远的若干词条
+	bayesInterestingTokens = 15
+	defaultBayesThreshold  = 0.9
+	defaultBayesMinSamples = 20
+)
+
+var bayesURLPattern = regexp.MustCompile(`https?://[^\s)\]>"']+`)
+
+// BayesDetector 本地朴素贝叶斯过滤器，由管理员标记的垃圾/正常评论训练。
+// 未开启时仍会记录训练样本，开启后即可直接使用已积累的数据。
+type BayesDetector struct {
+	repo       repository.SpamTokenRepository
+	settingSvc setting.SettingService
+}
+
+// NewBayesDetector 创建贝叶斯过滤器
+func NewBayesDetector(repo repository.SpamTokenRepository, settingSvc setting.SettingService) *BayesDetector {
+	return &BayesDetector{repo: repo, settingSvc: settingSvc}
+}
+
+// Name 返回检测器名称
+func (d *BayesDetector) Name() string {
+	return "Bayes"
+}
+
+// Check 计算评论为垃圾评论的概率，超过阈值即判定为垃圾。样本不足时不做判定。
+func (d *BayesDetector) Check(ctx context.Context, c *SpamCandidate) (bool, error) {
+	if !d.settingSvc.GetBool(constant.KeyCommentSpamBayesEnable.String()) {
+		return false, nil
+	}
+	tokens := spamTokens(c)
+	if len(tokens) == 0 {
+		return false, nil
+	}
+	counts, err := d.repo.Counts(ctx, append(tokens, bayesSamplesToken))
+	if err != nil {
+		return false, err
+	}
+
+	minSamples := defaultBayesMinSamples
+	if v, err := strconv.Atoi(d.settingSvc.Get(constant.KeyCommentSpamBayesMinSamples.String())); err == nil && v >= 0 {
+		minSamples = v
+	}
+	samples := counts[bayesSamplesToken]
+	if samples.Spam < minSamples || samples.Ham < minSamples || samples.Spam == 0 || samples.Ham == 0 {
+		return false, nil
+	}
+
+	threshold := defaultBayesThreshold
+	if v, err := strconv.ParseFloat(d.settingSvc.Get(constant.KeyCommentSpamBayesThreshold.String()), 64); err == nil && v > 0 && v < 1 {
+		threshold = v
+	}
+
+	probs := make([]float64, 0, len(tokens))
+	for _, token := range tokens {
+		count, ok := counts[token]
+		if !ok {
+			continue
+		}
+		probs = append(probs, tokenSpamProbability(count.Spam, count.Ham, samples.Spam, samples.Ham))
+	}
+	return combineSpamProbabilities(probs) >= threshold, nil
+}
+
+// Feedback 将管理员的判定作为训练样本
+func (d *BayesDetector) Feedback(ctx context.Context, c *SpamCandidate, spam bool) error {
+	tokens := spamTokens(c)
+	if len(tokens) == 0 {
+		return nil
+	}
+	return d.repo.Train(ctx, append(tokens, bayesSamplesToken), spam)
+}
+
+// tokenSpamProbability 计算含有某词条的评论为垃圾评论的概率，
+// 按 Robinson 的方法向 0.5 平滑，出现次数越少越接近 0.5。
+func tokenSpamProbability(spam, ham, spamSamples, hamSamples int) float64 {
+	spamFreq := math.Min(1, float64(spam)/float64(spamSamples))
+	hamFreq := math.Min(1, float64(ham)/float64(hamSamples))
+	if spamFreq+hamFreq == 0 {
+		return 0.5
+	}
+	p := spamFreq / (spamFreq + hamFreq)
+	n := float64(spam + ham)
+	p = (0.5 + n*p) / (1 + n)
+	return math.Max(0.01, math.Min(0.99, p))
+}
+
+// combineSpamProbabilities 取偏离 0.5 最远的若干词条概率合并为整体概率
+func combineSpamProbabilities(probs []float64) float64 {
+	if len(probs) == 0 {
+		return 0.5
+	}
+	sort.Slice(probs, func(i, j int) bool {
+		return math.Abs(probs[i]-0.5) > math.Abs(probs[j]-0.5)
+	})
+	if len(probs) > bayesInterestingTokens {
+		probs = probs[:bayesInterestingTokens]
+	}
+	// 在对数空间计算 Πp / (Πp + Π(1-p))，避免连乘下溢
+	var logSpam, logHam float64
+	for _, p := range probs {
+		logSpam += math.Log(p)
+		logHam += math.Log(1 - p)
+	}
+	return 1 / (1 + math.Exp(logHam-logSpam))
+}
+
+// spamTokens 把评论拆成去重后的词条：英文与数字按单词切分，中日韩文字按相邻两字切分，
+// 另外把评论中的链接域名、个人网站域名与邮箱域名作为独立词条。
+func spamTokens(c *SpamCandidate) []string {
+	seen := make(map[string]bool)
+	var tokens []string
+	add := func(token string) {
+		if token == "" || len(token) > 64 || seen[token] || len(tokens) >= maxBayesTokens {
+			return
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+
+	for _, link := range bayesURLPattern.FindAllString(c.Content, -1) {
+		if u, err := url.Parse(link); err == nil && u.Hostname() != "" {
+			add("host:" + strings.ToLower(u.Hostname()))
+		}
+	}
+	if c.Website != "" {
+		if u, err := url.Parse(c.Website); err == nil && u.Hostname() != "" {
+			add("site:" + strings.ToLower(u.Hostname()))
+		}
+	}
+	if at := strings.LastIndex(c.Email, "@"); at >= 0 {
+		add("email:" + strings.ToLower(c.Email[at+1:]))
+	}
+
+	var word []rune
+	var prevCJK rune
+	flushWord := func() {
+		if len(word) >= 2 {
+			add(string(word))
+		}
+		word = word[:0]
+	}
+	for _, r := range strings.ToLower(c.Content) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			if prevCJK != 0 {
+				add(string([]rune{prevCJK, r}))
+			}
+			prevCJK = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flushWord()
+		}
+		prevCJK = 0
+	}
+	flushWord()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,268 @@
+// anheyu-app/pkg/service/comment/code_block.go
+package comment
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+)
+
+// codeBlockRegex 匹配 Markdown 渲染并净化后的围栏代码块
+var codeBlockRegex = regexp.MustCompile(`(?s)<pre><code(?: class="language-([^"]*)")?>(.*?)</code></pre>`)
+
+// codeLang 描述一种语言的高亮规则
+type codeLang struct {
+	lineComments    []string
+	blockComment    [2]string
+	quotes          string
+	keywords        map[string]bool
+	literals        map[string]bool
+	caseInsensitive bool
+	wordPrefixes    string // 可以出现在关键字开头的符号，如 C 的 #include、CSS 的 @media
+	dashInWord      bool   // 标识符中允许出现 '-'（CSS）
+}
+
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+var (
+	cLikeLiterals = wordSet("true false null nil undefined NULL None True False this self super")
+
+	codeLangs = map[string]*codeLang{
+		"go": {lineComments: []string{"//"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"'`",
+			keywords: wordSet("break case chan const continue default defer else fallthrough for func go goto if import interface map package range return select struct switch type var"),
+			literals: wordSet("true false nil iota")},
+		"javascript": {lineComments: []string{"//"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"'`",
+			keywords: wordSet("async await break case catch class const continue debugger default delete do else export extends finally for from function if import in instanceof let new of return static switch throw try typeof var void while with yield interface type enum implements public private protected readonly as"),
+			literals: cLikeLiterals},
+		"python": {lineComments: []string{"#"}, quotes: "\"'",
+			keywords: wordSet("and as assert async await break class continue def del elif else except finally for from global if import in is lambda nonlocal not or pass raise return try while with yield"),
+			literals: wordSet("True False None self")},
+		"java": {lineComments: []string{"//"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"'",
+			keywords: wordSet("abstract assert break case catch class const continue default do else enum extends final finally for if implements import instanceof interface native new package private protected public return static super switch synchronized throw throws transient try void volatile while var record fun val when object override"),
+			literals: cLikeLiterals},
+		"c": {lineComments: []string{"//"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"'",
+			keywords:     wordSet("auto break case catch char class const constexpr continue default delete do double else enum extern float for friend goto if inline int long namespace new operator private protected public register return short signed sizeof static struct switch template throw try typedef typename union unsigned using virtual void volatile while #include #define"),
+			wordPrefixes: "#",
+			literals:     wordSet("true false NULL nullptr this")},
+		"rust": {lineComments: []string{"//"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"",
+			keywords: wordSet("as async await break const continue crate dyn else enum extern fn for if impl in let loop match mod move mut pub ref return static struct trait type unsafe use where while"),
+			literals: wordSet("true false self Self None Some Ok Err")},
+		"php": {lineComments: []string{"//", "#"}, blockComment: [2]string{"/*", "*/"}, quotes: "\"'",
+			keywords: wordSet("abstract and array as break case catch class const continue declare default do echo else elseif empty extends final finally fn for foreach function global if implements include interface isset namespace new or private protected public require return static switch throw trait try unset use var while"),
+			literals: wordSet("true false null TRUE FALSE NULL")},
+		"shell": {lineComments: []string{"#"}, quotes: "\"'",
+			keywords: wordSet("if then else elif fi for while until do done case esac in function return export local echo exit source sudo")},
+		"sql": {lineComments: []string{"--"}, blockComment: [2]string{"/*", "*/"}, quotes: "'\"`", caseInsensitive: true,
+			keywords: wordSet("select from where and or not insert into values update set delete create table alter drop index join left right inner outer on group by order having limit offset as distinct union all primary key foreign references default exists in like between is case when then else end"),
+			literals: wordSet("null true false")},
+		"css": {blockComment: [2]string{"/*", "*/"}, quotes: "\"'", wordPrefixes: "@!", dashInWord: true,
+			keywords: wordSet("@media @import @keyframes @font-face !important")},
+		"json": {quotes: "\"", literals: wordSet("true false null")},
+		"yaml": {lineComments: []string{"#"}, quotes: "\"'", literals: wordSet("true false null yes no on off")},
+	}
+
+	codeLangAliases = map[string]string{
+		"golang": "go", "js": "javascript", "jsx": "javascript", "ts": "javascript", "tsx": "javascript",
+		"typescript": "javascript", "vue": "javascript", "py": "python", "python3": "python",
+		"kotlin": "java", "kt": "java", "scala": "java", "csharp": "java", "cs": "java", "c#": "java",
+		"cpp": "c", "c++": "c", "h": "c", "hpp": "c", "cc": "c", "rs": "rust",
+		"sh": "shell", "bash": "shell", "zsh": "shell", "console": "shell", "dockerfile": "shell",
+		"mysql": "sql", "postgresql": "sql", "sqlite": "sql", "scss": "css", "less": "css",
+		"yml": "yaml", "toml": "yaml", "ini": "yaml",
+	}
+)
+
+// lookupCodeLang 根据代码块声明的语言查找高亮规则，未知语言返回 nil
+func lookupCodeLang(lang string) *codeLang {
+	lang = strings.ToLower(lang)
+	if alias, ok := codeLangAliases[lang]; ok {
+		lang = alias
+	}
+	return codeLangs[lang]
+}
+
+// highlightCode 对代码做轻量的词法高亮，输出已转义的 HTML，使用 highlight.js 兼容的 hljs-* 类名。
+// 只识别注释、字符串、数字与关键字，未知语言只做转义。
+func highlightCode(code string, spec *codeLang) string {
+	if spec == nil {
+		return html.EscapeString(code)
+	}
+	var b strings.Builder
+	span := func(class, text string) {
+		b.WriteString(`<span class="hljs-`)
+		b.WriteString(class)
+		b.WriteString(`">`)
+		b.WriteString(html.EscapeString(text))
+		b.WriteString(`</span>`)
+	}
+
+	for i := 0; i < len(code); {
+		rest := code[i:]
+
+		if spec.blockComment[0] != "" && strings.HasPrefix(rest, spec.blockComment[0]) {
+			end := strings.Index(rest[len(spec.blockComment[0]):], spec.blockComment[1])
+			n := len(rest)
+			if end >= 0 {
+				n = len(spec.blockComment[0]) + end + len(spec.blockComment[1])
+			}
+			span("comment", rest[:n])
+			i += n
+			continue
+		}
+		if prefix := matchPrefix(rest, spec.lineComments); prefix != "" {
+			n := strings.IndexByte(rest, '\n')
+			if n < 0 {
+				n = len(rest)
+			}
+			span("comment", rest[:n])
+			i += n
+			continue
+		}
+
+		c := code[i]
+		if strings.IndexByte(spec.quotes, c) >= 0 {
+			n := scanString(rest, c)
+			span("string", rest[:n])
+			i += n
+			continue
+		}
+		if c >= '0' && c <= '9' && (i == 0 || !isIdentByte(code[i-1])) {
+			n := 1
+			for n < len(rest) && (isIdentByte(rest[n]) || rest[n] == '.') {
+				n++
+			}
+			span("number", rest[:n])
+			i += n
+			continue
+		}
+		if isIdentByte(c) || (strings.IndexByte(spec.wordPrefixes, c) >= 0 && i+1 < len(code) && isIdentByte(code[i+1])) {
+			n := 1
+			for n < len(rest) && (isIdentByte(rest[n]) || rest[n] == '-' && spec.dashInWord) {
+				n++
+			}
+			word := rest[:n]
+			key := word
+			if spec.caseInsensitive {
+				key = strings.ToLower(word)
+			}
+			switch {
+			case spec.keywords[key]:
+				span("keyword", word)
+			case spec.literals[key]:
+				span("literal", word)
+			default:
+				b.WriteString(html.EscapeString(word))
+			}
+			i += n
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(rest)
+		b.WriteString(html.EscapeString(rest[:size]))
+		i += size
+	}
+	return b.String()
+}
+
+func matchPrefix(s string, prefixes []string) string {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return p
+		}
+	}
+	return ""
+}
+
+// scanString 返回从 s[0] 的引号开始到对应结束引号（含）的长度；反引号字符串可跨行，其余字符串在行尾结束
+func scanString(s string, quote byte) int {
+	for n := 1; n < len(s); n++ {
+		switch s[n] {
+		case '\\':
+			if quote != '`' {
+				n++
+			}
+		case quote:
+			return n + 1
+		case '\n':
+			if quote != '`' {
+				return n
+			}
+		}
+	}
+	return len(s)
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// renderCodeBlocks 高亮评论 HTML 中的代码块，并返回每个代码块的行数与折叠状态。
+// 行数超过 maxLines（>0）的代码块会标记为折叠，前端据此默认收起。
+func renderCodeBlocks(contentHTML string, maxLines int) (string, []*dto.CodeBlock) {
+	var blocks []*dto.CodeBlock
+	rendered := codeBlockRegex.ReplaceAllStringFunc(contentHTML, func(match string) string {
+		groups := codeBlockRegex.FindStringSubmatch(match)
+		lang := strings.TrimSpace(groups[1])
+		code := html.UnescapeString(groups[2])
+		lines := strings.Count(strings.TrimSuffix(code, "\n"), "\n") + 1
+		block := &dto.CodeBlock{
+			Index:    len(blocks),
+			Language: lang,
+			Lines:    lines,
+			Folded:   maxLines > 0 && lines > maxLines,
+		}
+		blocks = append(blocks, block)
+
+		var b strings.Builder
+		b.WriteString(`<pre class="comment-code" data-lines="`)
+		b.WriteString(strconv.Itoa(lines))
+		b.WriteString(`"`)
+		if block.Folded {
+			b.WriteString(` data-folded="true"`)
+		}
+		b.WriteString(`><code class="hljs`)
+		if lang != "" {
+			b.WriteString(" language-")
+			b.WriteString(html.EscapeString(lang))
+		}
+		b.WriteString(`">`)
+		b.WriteString(highlightCode(code, lookupCodeLang(lang)))
+		b.WriteString(`</code></pre>`)
+		return b.String()
+	})
+	return rendered, blocks
+}
+
+// checkCodeSize 校验评论中代码块的总字符数不超过设置的上限（0 表示不限制）
+func (s *Service) checkCodeSize(contentHTML string) error {
+	limit, _ := strconv.Atoi(s.settingSvc.Get(constant.KeyCommentCodeMaxLength.String()))
+	if limit <= 0 {
+		return nil
+	}
+	total := 0
+	for _, groups := range codeBlockRegex.FindAllStringSubmatch(contentHTML, -1) {
+		total += utf8.RuneCountInString(html.UnescapeString(groups[2]))
+	}
+	if total > limit {
+		return fmt.Errorf("评论中代码的总长度为 %d 个字符，超过了 %d 个字符的上限: %w", total, limit, constant.ErrCommentCodeTooLong)
+	}
+	return nil
+}
+
+// codeMaxLines 返回代码块折叠的行数阈值，与文章代码块共用同一设置
+func (s *Service) codeMaxLines() int {
+	maxLines, _ := strconv.Atoi(s.settingSvc.Get(constant.KeyPostCodeBlockCodeMaxLines.String()))
+	return maxLines
+}
@@ -0,0 +1,63 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+)
+
+func TestRenderCodeBlocks(t *testing.T) {
+	settings := &fakeProfileSettings{values: map[string]string{}}
+	parserSvc := parser.NewService(settings, event.NewEventBus())
+	content := "看看这段：\n\n```go\n// 注释\nfunc main() { s := \"<b>\" }\n```\n\n```\nline1\nline2\nline3\n```\n"
+	contentHTML, err := parserSvc.ToHTML(context.Background(), content)
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+
+	rendered, blocks := renderCodeBlocks(contentHTML, 2)
+	if len(blocks) != 2 {
+		t.Fatalf("应识别出两个代码块: %s", rendered)
+	}
+	if blocks[0].Language != "go" || blocks[0].Lines != 2 || blocks[0].Folded {
+		t.Errorf("第一个代码块信息错误: %+v", blocks[0])
+	}
+	if blocks[1].Language != "" || blocks[1].Lines != 3 || !blocks[1].Folded {
+		t.Errorf("超过最大行数的代码块应折叠: %+v", blocks[1])
+	}
+	for _, want := range []string{
+		`<span class="hljs-comment">// 注释</span>`,
+		`<span class="hljs-keyword">func</span>`,
+		`<span class="hljs-string">&#34;&lt;b&gt;&#34;</span>`,
+		`data-folded="true"`,
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("渲染结果缺少 %s:\n%s", want, rendered)
+		}
+	}
+
+	again, _ := renderCodeBlocks(rendered, 2)
+	if again != rendered {
+		t.Error("重复渲染不应改变已高亮的代码块")
+	}
+}
+
+func TestCheckCodeSize(t *testing.T) {
+	settings := &fakeProfileSettings{values: map[string]string{constant.KeyCommentCodeMaxLength.String(): "5"}}
+	svc := &Service{settingSvc: settings}
+	if err := svc.checkCodeSize("<pre><code>abc</code></pre><p>正文不计入长度</p>"); err != nil {
+		t.Errorf("未超限时不应报错: %v", err)
+	}
+	if err := svc.checkCodeSize("<pre><code>abc</code></pre><pre><code>&lt;&gt;&amp;</code></pre>"); !errors.Is(err, constant.ErrCommentCodeTooLong) {
+		t.Errorf("代码总长度超限应返回 ErrCommentCodeTooLong: %v", err)
+	}
+	settings.values[constant.KeyCommentCodeMaxLength.String()] = "0"
+	if err := svc.checkCodeSize("<pre><code>abcdefgh</code></pre>"); err != nil {
+		t.Errorf("上限为 0 时不应限制: %v", err)
+	}
+}
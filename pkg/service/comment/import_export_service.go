@@ -9,7 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -80,7 +80,7 @@ type ImportCommentResult struct {
 
 // ExportComments 导出评论为 JSON 格式
 func (s *Service) ExportComments(ctx context.Context, commentIDs []string) (*ExportCommentData, error) {
-	log.Printf("[导出评论] 开始导出 %d 条评论", len(commentIDs))
+	slog.InfoContext(ctx, "开始导出评论", slog.Int("count", len(commentIDs)))
 
 	exportData := &ExportCommentData{
 		Version:  "1.0",
@@ -99,7 +99,7 @@ func (s *Service) ExportComments(ctx context.Context, commentIDs []string) (*Exp
 	for _, publicID := range commentIDs {
 		dbID, entityType, err := idgen.DecodePublicID(publicID)
 		if err != nil || entityType != idgen.EntityTypeComment {
-			log.Printf("[导出评论] 跳过无效的评论ID: %s", publicID)
+			slog.WarnContext(ctx, "导出评论时跳过无效的评论ID", slog.String("id", publicID))
 			continue
 		}
 		dbIDs = append(dbIDs, dbID)
@@ -114,73 +114,15 @@ func (s *Service) ExportComments(ctx context.Context, commentIDs []string) (*Exp
 	for _, comment := range comments {
 		publicID := idMap[comment.ID]
 
-		// 构建父评论公共ID
-		var parentPublicID string
-		if comment.ParentID != nil {
-			parentPublicID, _ = idgen.GeneratePublicID(*comment.ParentID, idgen.EntityTypeComment)
-		}
-
-		// 构建回复目标评论公共ID
-		var replyToPublicID string
-		if comment.ReplyToID != nil {
-			replyToPublicID, _ = idgen.GeneratePublicID(*comment.ReplyToID, idgen.EntityTypeComment)
-		}
-
-		// 构建置顶时间字符串
-		var pinnedAtStr *string
-		if comment.PinnedAt != nil {
-			s := comment.PinnedAt.Format(time.RFC3339)
-			pinnedAtStr = &s
-		}
-
-		// 获取邮箱和网站
-		var email, website string
-		if comment.Author.Email != nil {
-			email = *comment.Author.Email
-		}
-		if comment.Author.Website != nil {
-			website = *comment.Author.Website
-		}
-
-		// 获取目标标题
-		var targetTitle string
-		if comment.TargetTitle != nil {
-			targetTitle = *comment.TargetTitle
-		}
-
-		exportItem := ExportCommentItem{
-			ID:             publicID,
-			CreatedAt:      comment.CreatedAt,
-			UpdatedAt:      comment.UpdatedAt,
-			PinnedAt:       pinnedAtStr,
-			Content:        comment.Content,
-			ContentHTML:    comment.ContentHTML,
-			TargetPath:     comment.TargetPath,
-			TargetTitle:    targetTitle,
-			Nickname:       comment.Author.Nickname,
-			Email:          email,
-			Website:        website,
-			IPAddress:      comment.Author.IP,
-			IPLocation:     comment.Author.Location,
-			UserAgent:      comment.Author.UserAgent,
-			ParentID:       parentPublicID,
-			ReplyToID:      replyToPublicID,
-			Status:         int(comment.Status),
-			IsAdminComment: comment.IsAdminAuthor,
-			IsAnonymous:    comment.IsAnonymous,
-			LikeCount:      comment.LikeCount,
-		}
-
-		exportData.Comments = append(exportData.Comments, exportItem)
+		exportData.Comments = append(exportData.Comments, newExportCommentItem(comment, publicID))
 	}
 
-	log.Printf("[导出评论] 成功导出 %d 条评论", len(exportData.Comments))
+	slog.InfoContext(ctx, "评论导出完成", slog.Int("count", len(exportData.Comments)))
 	return exportData, nil
 }
 
 // ExportAllComments 导出所有评论（用于全量备份）
 func (s *Service) ExportAllComments(ctx context.Context) (*ExportCommentData, error) {
-	log.Printf("[导出评论] 开始导出所有评论")
 
 	// 查询所有评论（不分页）
 	params := repository.AdminListParams{
@@ -193,7 +135,7 @@ func (s *Service) ExportAllComments(ctx context.Context) (*ExportCommentData, er
 		return nil, fmt.Errorf("获取评论列表失败: %w", err)
 	}
 
-	log.Printf("[导出评论] 共找到 %d 条评论", total)
+	slog.InfoContext(ctx, "开始导出所有评论", slog.Int64("total", total))
 
 	exportData := &ExportCommentData{
 		Version:  "1.0",
@@ -208,70 +150,73 @@ func (s *Service) ExportAllComments(ctx context.Context) (*ExportCommentData, er
 	for _, comment := range comments {
 		publicID, _ := idgen.GeneratePublicID(comment.ID, idgen.EntityTypeComment)
 
-		// 构建父评论公共ID
-		var parentPublicID string
-		if comment.ParentID != nil {
-			parentPublicID, _ = idgen.GeneratePublicID(*comment.ParentID, idgen.EntityTypeComment)
-		}
-
-		// 构建回复目标评论公共ID
-		var replyToPublicID string
-		if comment.ReplyToID != nil {
-			replyToPublicID, _ = idgen.GeneratePublicID(*comment.ReplyToID, idgen.EntityTypeComment)
-		}
-
-		// 构建置顶时间字符串
-		var pinnedAtStr *string
-		if comment.PinnedAt != nil {
-			s := comment.PinnedAt.Format(time.RFC3339)
-			pinnedAtStr = &s
-		}
-
-		// 获取邮箱和网站
-		var email, website string
-		if comment.Author.Email != nil {
-			email = *comment.Author.Email
-		}
-		if comment.Author.Website != nil {
-			website = *comment.Author.Website
-		}
-
-		// 获取目标标题
-		var targetTitle string
-		if comment.TargetTitle != nil {
-			targetTitle = *comment.TargetTitle
-		}
-
-		exportItem := ExportCommentItem{
-			ID:             publicID,
-			CreatedAt:      comment.CreatedAt,
-			UpdatedAt:      comment.UpdatedAt,
-			PinnedAt:       pinnedAtStr,
-			Content:        comment.Content,
-			ContentHTML:    comment.ContentHTML,
-			TargetPath:     comment.TargetPath,
-			TargetTitle:    targetTitle,
-			Nickname:       comment.Author.Nickname,
-			Email:          email,
-			Website:        website,
-			IPAddress:      comment.Author.IP,
-			IPLocation:     comment.Author.Location,
-			UserAgent:      comment.Author.UserAgent,
-			ParentID:       parentPublicID,
-			ReplyToID:      replyToPublicID,
-			Status:         int(comment.Status),
-			IsAdminComment: comment.IsAdminAuthor,
-			IsAnonymous:    comment.IsAnonymous,
-			LikeCount:      comment.LikeCount,
-		}
-
-		exportData.Comments = append(exportData.Comments, exportItem)
+		exportData.Comments = append(exportData.Comments, newExportCommentItem(comment, publicID))
 	}
 
-	log.Printf("[导出评论] 成功导出 %d 条评论", len(exportData.Comments))
+	slog.InfoContext(ctx, "评论导出完成", slog.Int("count", len(exportData.Comments)))
 	return exportData, nil
 }
 
+// newExportCommentItem 将评论转换为导出格式
+func newExportCommentItem(comment *model.Comment, publicID string) ExportCommentItem {
+	// 构建父评论公共ID
+	var parentPublicID string
+	if comment.ParentID != nil {
+		parentPublicID, _ = idgen.GeneratePublicID(*comment.ParentID, idgen.EntityTypeComment)
+	}
+
+	// 构建回复目标评论公共ID
+	var replyToPublicID string
+	if comment.ReplyToID != nil {
+		replyToPublicID, _ = idgen.GeneratePublicID(*comment.ReplyToID, idgen.EntityTypeComment)
+	}
+
+	// 构建置顶时间字符串
+	var pinnedAtStr *string
+	if comment.PinnedAt != nil {
+		s := comment.PinnedAt.Format(time.RFC3339)
+		pinnedAtStr = &s
+	}
+
+	// 获取邮箱和网站
+	var email, website string
+	if comment.Author.Email != nil {
+		email = *comment.Author.Email
+	}
+	if comment.Author.Website != nil {
+		website = *comment.Author.Website
+	}
+
+	// 获取目标标题
+	var targetTitle string
+	if comment.TargetTitle != nil {
+		targetTitle = *comment.TargetTitle
+	}
+
+	return ExportCommentItem{
+		ID:             publicID,
+		CreatedAt:      comment.CreatedAt,
+		UpdatedAt:      comment.UpdatedAt,
+		PinnedAt:       pinnedAtStr,
+		Content:        comment.Content,
+		ContentHTML:    comment.ContentHTML,
+		TargetPath:     comment.TargetPath,
+		TargetTitle:    targetTitle,
+		Nickname:       comment.Author.Nickname,
+		Email:          email,
+		Website:        website,
+		IPAddress:      comment.Author.IP,
+		IPLocation:     comment.Author.Location,
+		UserAgent:      comment.Author.UserAgent,
+		ParentID:       parentPublicID,
+		ReplyToID:      replyToPublicID,
+		Status:         int(comment.Status),
+		IsAdminComment: comment.IsAdminAuthor,
+		IsAnonymous:    comment.IsAnonymous,
+		LikeCount:      comment.LikeCount,
+	}
+}
+
 // ExportCommentsToZip 导出评论为 ZIP 压缩包
 func (s *Service) ExportCommentsToZip(ctx context.Context, commentIDs []string) ([]byte, error) {
 	var exportData *ExportCommentData
@@ -353,7 +298,7 @@ func (s *Service) ExportCommentsToZip(ctx context.Context, commentIDs []string)
 
 // ImportComments 从导出的数据导入评论
 func (s *Service) ImportComments(ctx context.Context, req *ImportCommentRequest) (*ImportCommentResult, error) {
-	log.Printf("[导入评论] 开始导入 %d 条评论", len(req.Data.Comments))
+	slog.InfoContext(ctx, "开始导入评论", slog.Int("count", len(req.Data.Comments)))
 
 	result := &ImportCommentResult{
 		TotalCount: len(req.Data.Comments),
@@ -375,27 +320,45 @@ func (s *Service) ImportComments(ctx context.Context, req *ImportCommentRequest)
 		}
 	}
 
-	log.Printf("[导入评论] 顶级评论: %d, 子评论: %d", len(topLevelComments), len(childComments))
+	slog.DebugContext(ctx, "导入评论分组完成", slog.Int("top_level", len(topLevelComments)), slog.Int("children", len(childComments)))
 
 	// 导入顶级评论
 	for _, commentData := range topLevelComments {
-		newID, isSkipped, err := s.importSingleComment(ctx, commentData, idMapping, req)
-		if err != nil {
-			result.FailedCount++
-			result.Errors = append(result.Errors, err.Error())
-			continue
-		}
-		// 无论是新导入还是跳过的已存在评论，都要加入映射以便子评论能找到父评论
-		idMapping[commentData.ID] = newID
-		if isSkipped {
-			result.SkippedCount++
-			log.Printf("[导入评论] 跳过已存在的评论: %s (使用已存在ID: %d)", commentData.Nickname, newID)
-		} else {
-			result.SuccessCount++
-		}
+		s.importAndRecord(ctx, commentData, idMapping, req, result)
+	}
+
+	// 导入子评论
+	s.importChildComments(ctx, childComments, idMapping, req, result)
+
+	slog.InfoContext(ctx, "评论导入完成",
+		slog.Int("total", result.TotalCount),
+		slog.Int("success", result.SuccessCount),
+		slog.Int("skipped", result.SkippedCount),
+		slog.Int("failed", result.FailedCount))
+
+	return result, nil
+}
+
+// importAndRecord 导入单条评论并将结果计入 result。
+// 无论是新导入还是跳过的已存在评论，都要加入映射以便子评论能找到父评论
+func (s *Service) importAndRecord(ctx context.Context, commentData ExportCommentItem, idMapping map[string]uint, req *ImportCommentRequest, result *ImportCommentResult) {
+	newID, isSkipped, err := s.importSingleComment(ctx, commentData, idMapping, req)
+	if err != nil {
+		result.FailedCount++
+		result.Errors = append(result.Errors, err.Error())
+		return
+	}
+	idMapping[commentData.ID] = newID
+	if isSkipped {
+		result.SkippedCount++
+		slog.DebugContext(ctx, "跳过已存在的评论", slog.String("nickname", commentData.Nickname), slog.Uint64("existing_id", uint64(newID)))
+	} else {
+		result.SuccessCount++
 	}
+}
 
-	// 导入子评论（可能需要多次迭代处理深层嵌套）
+// importChildComments 导入子评论（可能需要多次迭代处理深层嵌套），最终仍找不到父评论的记为失败
+func (s *Service) importChildComments(ctx context.Context, childComments []ExportCommentItem, idMapping map[string]uint, req *ImportCommentRequest, result *ImportCommentResult) {
 	maxIterations := 10
 	for iteration := 0; iteration < maxIterations && len(childComments) > 0; iteration++ {
 		remainingChildren := make([]ExportCommentItem, 0)
@@ -406,21 +369,7 @@ func (s *Service) ImportComments(ctx context.Context, req *ImportCommentRequest)
 				remainingChildren = append(remainingChildren, commentData)
 				continue
 			}
-
-			newID, isSkipped, err := s.importSingleComment(ctx, commentData, idMapping, req)
-			if err != nil {
-				result.FailedCount++
-				result.Errors = append(result.Errors, err.Error())
-				continue
-			}
-			// 无论是新导入还是跳过的已存在评论，都要加入映射以便更深层子评论能找到父评论
-			idMapping[commentData.ID] = newID
-			if isSkipped {
-				result.SkippedCount++
-				log.Printf("[导入评论] 跳过已存在的评论: %s (使用已存在ID: %d)", commentData.Nickname, newID)
-			} else {
-				result.SuccessCount++
-			}
+			s.importAndRecord(ctx, commentData, idMapping, req, result)
 		}
 
 		childComments = remainingChildren
@@ -431,11 +380,6 @@ func (s *Service) ImportComments(ctx context.Context, req *ImportCommentRequest)
 		result.FailedCount++
 		result.Errors = append(result.Errors, fmt.Sprintf("无法导入评论 '%s': 找不到父评论 '%s'", commentData.ID, commentData.ParentID))
 	}
-
-	log.Printf("[导入评论] 导入完成 - 总数: %d, 成功: %d, 跳过: %d, 失败: %d",
-		result.TotalCount, result.SuccessCount, result.SkippedCount, result.FailedCount)
-
-	return result, nil
 }
 
 // importSingleComment 导入单条评论
@@ -560,7 +504,7 @@ func (s *Service) importSingleComment(ctx context.Context, commentData ExportCom
 		}
 	}
 
-	log.Printf("[导入评论] 成功导入评论: %s (ID: %d)", commentData.Nickname, newComment.ID)
+	slog.DebugContext(ctx, "导入评论成功", slog.String("nickname", commentData.Nickname), slog.Uint64("comment_id", uint64(newComment.ID)))
 	return newComment.ID, false, nil
 }
 
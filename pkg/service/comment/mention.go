@@ -0,0 +1,268 @@
+// anheyu-app/pkg/service/comment/mention.go
+package comment
+
+import (
+	"context"
+	"html"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// maxMentionsPerComment 单条评论最多解析的 @ 提及数量，超出部分忽略，防止借提及批量发送通知
+const maxMentionsPerComment = 5
+
+var (
+	// mentionRegex 匹配 @昵称：@ 前不能是字母数字、@ 或 .（排除邮箱地址），昵称遇到空白与常见标点结束
+	mentionRegex = regexp.MustCompile(`(^|[^\w@.])@([^\s@<>()\[\]{}"'，。：；！？、,:;!?]{1,32})`)
+	// htmlTokenRegex 将 HTML 切分为标签与文本片段
+	htmlTokenRegex = regexp.MustCompile(`<[^>]*>|[^<]+`)
+	// mentionSkipTags 这些标签内的文本不替换提及（代码与已有链接）
+	mentionSkipTags = map[string]bool{"a": true, "code": true, "pre": true}
+)
+
+// mentionTarget 是解析出的被提及者
+type mentionTarget struct {
+	Nickname string
+	Email    string
+	Website  string
+	// UserID 非 nil 表示被提及者是注册用户，通知时遵循其通知偏好设置
+	UserID *uint
+}
+
+// parseMentions 从评论 Markdown 原文中提取去重后的 @ 昵称，保持出现顺序。
+// 代码块与行内代码中的 @ 不视为提及。
+func parseMentions(content string) []string {
+	content = stripMarkdownCode(content)
+	seen := make(map[string]bool)
+	var nicknames []string
+	for _, m := range mentionRegex.FindAllStringSubmatch(content, -1) {
+		nickname := m[2]
+		if seen[nickname] {
+			continue
+		}
+		seen[nickname] = true
+		nicknames = append(nicknames, nickname)
+		if len(nicknames) >= maxMentionsPerComment {
+			break
+		}
+	}
+	return nicknames
+}
+
+// stripMarkdownCode 去掉 Markdown 中的围栏代码块与行内代码
+func stripMarkdownCode(content string) string {
+	var b strings.Builder
+	inFence := false
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		parts := strings.Split(line, "`")
+		for i := 0; i < len(parts); i += 2 {
+			b.WriteString(parts[i])
+			b.WriteByte(' ')
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// resolveMentions 将昵称解析为被提及者：优先匹配注册用户，其次匹配同一页面下发表过评论的访客（取最近一条评论的信息）。
+// 匿名评论者不可被提及；无法解析的昵称忽略。
+func (s *Service) resolveMentions(ctx context.Context, targetPath string, nicknames []string) []*mentionTarget {
+	if len(nicknames) == 0 {
+		return nil
+	}
+	resolved := make(map[string]*mentionTarget, len(nicknames))
+
+	if s.userRepo != nil {
+		users, err := s.userRepo.FindByNicknames(ctx, nicknames)
+		if err != nil {
+			slog.WarnContext(ctx, "解析评论提及时查询用户失败", slog.Any("error", err))
+		}
+		for _, u := range users {
+			if u.Status != model.UserStatusActive {
+				continue
+			}
+			if _, ok := resolved[u.Nickname]; ok {
+				continue // 昵称重复的注册用户取第一个
+			}
+			uid := u.ID
+			resolved[u.Nickname] = &mentionTarget{Nickname: u.Nickname, Email: u.Email, Website: u.Website, UserID: &uid}
+		}
+	}
+
+	if len(resolved) < len(nicknames) {
+		comments, err := s.repo.FindAllPublishedByPath(ctx, targetPath)
+		if err != nil {
+			slog.WarnContext(ctx, "解析评论提及时查询页面评论失败", slog.Any("error", err))
+		}
+		wanted := make(map[string]bool, len(nicknames))
+		for _, n := range nicknames {
+			wanted[n] = true
+		}
+		var latest = make(map[string]*model.Comment)
+		for _, c := range comments {
+			nickname := c.Author.Nickname
+			if c.IsAnonymous || !wanted[nickname] || resolved[nickname] != nil {
+				continue
+			}
+			if prev, ok := latest[nickname]; !ok || c.CreatedAt.After(prev.CreatedAt) {
+				latest[nickname] = c
+			}
+		}
+		for nickname, c := range latest {
+			target := &mentionTarget{Nickname: nickname, UserID: c.UserID}
+			if c.Author.Email != nil {
+				target.Email = *c.Author.Email
+			}
+			if c.Author.Website != nil {
+				target.Website = *c.Author.Website
+			}
+			resolved[nickname] = target
+		}
+	}
+
+	targets := make([]*mentionTarget, 0, len(resolved))
+	for _, n := range nicknames {
+		if t, ok := resolved[n]; ok {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// linkMentions 将渲染后 HTML 文本中的 @昵称 替换为提及标记：被提及者填写了网站时链接到其网站，否则仅加样式。
+// 代码与已有链接内的文本保持不变。
+func linkMentions(htmlContent string, targets []*mentionTarget) string {
+	if len(targets) == 0 {
+		return htmlContent
+	}
+	// 较长的昵称优先替换，避免 @ab 抢先匹配 @abc
+	sorted := append([]*mentionTarget(nil), targets...)
+	sort.SliceStable(sorted, func(i, j int) bool { return len(sorted[i].Nickname) > len(sorted[j].Nickname) })
+	replacements := make([]string, 0, len(sorted)*2)
+	for _, t := range sorted {
+		escaped := html.EscapeString(t.Nickname)
+		var tag string
+		if website := safeMentionWebsite(t.Website); website != "" {
+			tag = `<a class="comment-mention" href="` + html.EscapeString(website) + `" target="_blank" rel="noopener external nofollow">@` + escaped + `</a>`
+		} else {
+			tag = `<span class="comment-mention">@` + escaped + `</span>`
+		}
+		replacements = append(replacements, "@"+escaped, tag)
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	var b strings.Builder
+	skipDepth := 0
+	for _, token := range htmlTokenRegex.FindAllString(htmlContent, -1) {
+		if strings.HasPrefix(token, "<") {
+			if name, closing := htmlTagName(token); mentionSkipTags[name] {
+				if closing {
+					if skipDepth > 0 {
+						skipDepth--
+					}
+				} else {
+					skipDepth++
+				}
+			}
+			b.WriteString(token)
+			continue
+		}
+		if skipDepth > 0 {
+			b.WriteString(token)
+			continue
+		}
+		b.WriteString(replacer.Replace(token))
+	}
+	return b.String()
+}
+
+// htmlTagName 返回标签名（小写）及是否为闭合标签
+func htmlTagName(token string) (string, bool) {
+	inner := strings.TrimSuffix(strings.TrimPrefix(token, "<"), ">")
+	closing := strings.HasPrefix(inner, "/")
+	inner = strings.TrimPrefix(inner, "/")
+	if i := strings.IndexAny(inner, " \t\n/"); i >= 0 {
+		inner = inner[:i]
+	}
+	return strings.ToLower(inner), closing
+}
+
+// safeMentionWebsite 只接受 http/https 网址
+func safeMentionWebsite(website string) string {
+	website = strings.TrimSpace(website)
+	if website == "" {
+		return ""
+	}
+	u, err := url.Parse(website)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return u.String()
+}
+
+// notifyMentions 异步通知被提及者。评论者本人、已通过回复通知覆盖的父评论作者不重复通知；
+// 注册用户按其通知偏好决定是否发送邮件，被提及者是博主时额外发送即时推送。
+func (s *Service) notifyMentions(ctx context.Context, newComment *model.Comment, parentComment *model.Comment, targets []*mentionTarget) {
+	if len(targets) == 0 || !s.settingSvc.GetBool(constant.KeyCommentNotifyMention.String()) {
+		return
+	}
+	skip := make(map[string]bool)
+	if newComment.Author.Email != nil {
+		skip[strings.ToLower(*newComment.Author.Email)] = true
+	}
+	if parentComment != nil && parentComment.Author.Email != nil && s.settingSvc.GetBool(constant.KeyCommentNotifyReply.String()) {
+		skip[strings.ToLower(*parentComment.Author.Email)] = true
+	}
+	adminEmail := strings.ToLower(s.settingSvc.Get(constant.KeyFrontDeskSiteOwnerEmail.String()))
+
+	workerpool.Go(workerpool.CategoryNotification, func() {
+		for _, t := range targets {
+			email := strings.ToLower(t.Email)
+			if email == "" || skip[email] {
+				continue
+			}
+			skip[email] = true
+
+			toEmail := t.Email
+			if t.UserID != nil && s.notificationSvc != nil {
+				if err := s.notificationSvc.EnsureUserDefaultConfigs(ctx, *t.UserID); err != nil {
+					slog.WarnContext(ctx, "初始化用户通知配置失败", slog.Uint64("user_id", uint64(*t.UserID)), slog.Any("error", err))
+				}
+				allowed, effectiveEmail, err := s.notificationSvc.ShouldNotifyUser(ctx, *t.UserID, model.NotificationTypeCommentMention, model.NotificationChannelEmail)
+				if err != nil {
+					slog.WarnContext(ctx, "获取用户的提及通知设置失败，跳过通知", slog.Uint64("user_id", uint64(*t.UserID)), slog.Any("error", err))
+					continue
+				}
+				if !allowed {
+					continue
+				}
+				toEmail = effectiveEmail
+			}
+
+			if s.emailSvc != nil {
+				if err := s.emailSvc.SendCommentMentionEmail(ctx, newComment, toEmail, t.Nickname); err != nil {
+					slog.ErrorContext(ctx, "发送评论提及邮件失败", slog.String("email", toEmail), slog.Any("error", err))
+				}
+			}
+			if s.pushooSvc != nil && adminEmail != "" && email == adminEmail {
+				if err := s.pushooSvc.SendCommentMentionNotification(ctx, newComment); err != nil {
+					slog.ErrorContext(ctx, "发送评论提及即时通知失败", slog.Any("error", err))
+				}
+			}
+		}
+	})
+}
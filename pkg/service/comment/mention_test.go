@@ -0,0 +1,87 @@
+package comment
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakeNicknameUserRepo struct {
+	repository.UserRepository
+	users []*model.User
+}
+
+func (f *fakeNicknameUserRepo) FindByNicknames(ctx context.Context, nicknames []string) ([]*model.User, error) {
+	return f.users, nil
+}
+
+func TestParseMentions(t *testing.T) {
+	content := "@小明 你好，@小红！联系 me@example.com，再次 @小明\n`@代码` 不算\n```\n@围栏\n```\n@Alice"
+	got := parseMentions(content)
+	want := []string{"小明", "小红", "Alice"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("解析提及错误: got %v, want %v", got, want)
+	}
+
+	many := parseMentions("@a @b @c @d @e @f @g")
+	if len(many) != maxMentionsPerComment {
+		t.Errorf("提及数量应限制为 %d: %v", maxMentionsPerComment, many)
+	}
+}
+
+func TestResolveMentions(t *testing.T) {
+	guestEmail := "old@example.com"
+	newEmail := "new@example.com"
+	site := "https://guest.example.com"
+	now := time.Now()
+	svc := &Service{
+		userRepo: &fakeNicknameUserRepo{users: []*model.User{
+			{ID: 9, Nickname: "站长", Email: "owner@example.com", Status: model.UserStatusActive},
+			{ID: 10, Nickname: "封禁", Email: "ban@example.com", Status: model.UserStatusBanned},
+		}},
+		repo: &fakePathCommentRepo{comments: []*model.Comment{
+			{ID: 1, Author: model.Author{Nickname: "访客", Email: &guestEmail}, CreatedAt: now.Add(-time.Hour)},
+			{ID: 2, Author: model.Author{Nickname: "访客", Email: &newEmail, Website: &site}, CreatedAt: now},
+			{ID: 3, Author: model.Author{Nickname: "匿名"}, IsAnonymous: true, CreatedAt: now},
+		}},
+	}
+
+	targets := svc.resolveMentions(context.Background(), "/posts/a", []string{"访客", "站长", "封禁", "匿名", "不存在"})
+	if len(targets) != 2 {
+		t.Fatalf("应解析出 2 个被提及者: %+v", targets)
+	}
+	if targets[0].Nickname != "访客" || targets[0].Email != newEmail || targets[0].Website != site || targets[0].UserID != nil {
+		t.Errorf("访客应取最近一条评论的信息: %+v", targets[0])
+	}
+	if targets[1].UserID == nil || *targets[1].UserID != 9 {
+		t.Errorf("注册用户应带上用户ID: %+v", targets[1])
+	}
+}
+
+func TestLinkMentions(t *testing.T) {
+	targets := []*mentionTarget{
+		{Nickname: "ab", Website: "https://ab.example.com"},
+		{Nickname: "abc"},
+		{Nickname: "坏人", Website: "javascript:alert(1)"},
+	}
+	htmlContent := `<p>@ab 和 @abc 还有 @坏人</p><pre><code>@ab</code></pre><p><a href="https://x.com">@ab</a></p>`
+	got := linkMentions(htmlContent, targets)
+
+	if !strings.Contains(got, `<a class="comment-mention" href="https://ab.example.com" target="_blank" rel="noopener external nofollow">@ab</a> 和`) {
+		t.Errorf("填写了网站的提及应链接到网站: %s", got)
+	}
+	if !strings.Contains(got, `<span class="comment-mention">@abc</span>`) {
+		t.Errorf("较长的昵称不应被较短的昵称抢先匹配: %s", got)
+	}
+	if strings.Contains(got, "javascript:") {
+		t.Errorf("非 http(s) 网址不应生成链接: %s", got)
+	}
+	if !strings.Contains(got, `<code>@ab</code>`) || !strings.Contains(got, `<a href="https://x.com">@ab</a>`) {
+		t.Errorf("代码与已有链接内的文本应保持不变: %s", got)
+	}
+}
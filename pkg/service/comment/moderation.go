@@ -0,0 +1,405 @@
+// anheyu-app/pkg/service/comment/moderation.go
+package comment
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	// maxClusterCandidates 相似度聚类最多参与比较的评论数（按创建时间取最新）
+	maxClusterCandidates = 500
+	// defaultClusterThreshold 默认的相似度阈值（Jaccard 系数）
+	defaultClusterThreshold = 0.7
+	// shingleSize 计算相似度时使用的字符片段长度
+	shingleSize = 3
+)
+
+// decodeCommentIDs 把评论公共ID列表解码为数据库ID，跳过无效ID
+func decodeCommentIDs(ids []string) []uint {
+	dbIDs := make([]uint, 0, len(ids))
+	for _, publicID := range ids {
+		dbID, entityType, err := idgen.DecodePublicID(publicID)
+		if err != nil || entityType != idgen.EntityTypeComment {
+			slog.Warn("跳过无效的评论ID", slog.String("id", publicID))
+			continue
+		}
+		dbIDs = append(dbIDs, dbID)
+	}
+	return dbIDs
+}
+
+// BatchApprove 批量通过评论审核，返回实际更新的数量。
+func (s *Service) BatchApprove(ctx context.Context, ids []string) (int, error) {
+	dbIDs := decodeCommentIDs(ids)
+	if len(dbIDs) == 0 {
+		return 0, errors.New("未提供任何有效的评论ID")
+	}
+	before := s.commentsBeforeChange(ctx, dbIDs)
+	pending := s.approvalNotifyCandidates(ctx, dbIDs)
+	count, err := s.repo.UpdateStatusByIDs(ctx, dbIDs, model.StatusPublished)
+	if err != nil {
+		return 0, fmt.Errorf("批量通过评论失败: %w", err)
+	}
+	s.notifyApproved(ctx, pending)
+	for _, c := range before {
+		if c.Status == model.StatusPublished {
+			continue
+		}
+		approved := *c
+		approved.Status = model.StatusPublished
+		s.auditComment(ctx, model.AuditActionCommentModerate, c, &approved)
+	}
+	if s.trustRepo != nil || s.streamHub != nil || s.subscriptionRepo != nil {
+		if approved, err := s.repo.FindManyByIDs(ctx, dbIDs); err != nil {
+			slog.WarnContext(ctx, "查询已通过的评论失败，跳过信任标记、实时推送与订阅通知", slog.Any("error", err))
+		} else {
+			s.trustCommenters(ctx, approved...)
+			s.publishPublished(ctx, approved...)
+			s.markSubscriptionsPending(ctx, approved...)
+		}
+	}
+	s.publishCountChanged()
+	return count, nil
+}
+
+// BatchReject 批量拒绝评论，被拒绝的评论会被删除（软删除），返回实际删除的数量。
+func (s *Service) BatchReject(ctx context.Context, ids []string) (int, error) {
+	return s.Delete(ctx, ids)
+}
+
+// ListClusters 将内容相近的评论聚成一组，便于一次性处理刷屏或垃圾评论。
+// 只在最新的 maxClusterCandidates 条评论中查找，只返回至少包含两条评论的分组，按组大小降序排列。
+func (s *Service) ListClusters(ctx context.Context, req *dto.ClusterListRequest) ([]*dto.ClusterResponse, error) {
+	status := req.Status
+	if status == nil {
+		pending := int(model.StatusPending)
+		status = &pending
+	}
+	threshold := req.Threshold
+	if threshold <= 0 {
+		threshold = defaultClusterThreshold
+	}
+
+	comments, _, err := s.repo.FindWithConditions(ctx, repository.AdminListParams{
+		Page:     1,
+		PageSize: maxClusterCandidates,
+		Status:   status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取评论列表失败: %w", err)
+	}
+
+	contents := make([]string, len(comments))
+	for i, c := range comments {
+		contents[i] = c.Content
+	}
+
+	clusters := make([]*dto.ClusterResponse, 0)
+	for _, group := range clusterSimilar(contents, threshold) {
+		cluster := &dto.ClusterResponse{
+			Size:      len(group),
+			Sample:    comments[group[0]].Content,
+			IDs:       make([]string, 0, len(group)),
+			Nicknames: make([]string, 0),
+			Comments:  make([]*dto.Response, 0, len(group)),
+		}
+		seenNick := make(map[string]bool)
+		for _, idx := range group {
+			resp := s.toResponseDTO(ctx, comments[idx], nil, nil, true)
+			cluster.IDs = append(cluster.IDs, resp.ID)
+			cluster.Comments = append(cluster.Comments, resp)
+			if nick := comments[idx].Author.Nickname; !seenNick[nick] {
+				seenNick[nick] = true
+				cluster.Nicknames = append(cluster.Nicknames, nick)
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// CommenterHistory 返回某位评论者（按邮箱哈希）的评论概况与分页评论列表。
+func (s *Service) CommenterHistory(ctx context.Context, emailMD5 string, page, pageSize int) (*dto.CommenterHistoryResponse, error) {
+	emailMD5 = strings.ToLower(strings.TrimSpace(emailMD5))
+	if emailMD5 == "" {
+		return nil, errors.New("邮箱哈希不能为空")
+	}
+
+	stats, err := s.repo.CommenterStats(ctx, emailMD5)
+	if err != nil {
+		return nil, fmt.Errorf("统计评论者信息失败: %w", err)
+	}
+	list, err := s.AdminList(ctx, &dto.AdminListRequest{
+		Page:     page,
+		PageSize: pageSize,
+		EmailMD5: &emailMD5,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.trustRepo != nil {
+		if stats.TrustStatus, err = s.trustRepo.GetStatus(ctx, emailMD5); err != nil {
+			return nil, fmt.Errorf("获取评论者信任状态失败: %w", err)
+		}
+	}
+
+	return &dto.CommenterHistoryResponse{
+		EmailMD5:       stats.EmailMD5,
+		TrustStatus:    stats.TrustStatus,
+		Nicknames:      stats.Nicknames,
+		IPAddresses:    stats.IPAddresses,
+		PublishedCount: stats.PublishedCount,
+		PendingCount:   stats.PendingCount,
+		FirstCommentAt: stats.FirstCommentAt,
+		LastCommentAt:  stats.LastCommentAt,
+		Comments:       list,
+	}, nil
+}
+
+// SetCommenterTrust 由管理员手动设置评论者的信任状态：信任后评论自动发布，撤销后评论一律进入审核。
+func (s *Service) SetCommenterTrust(ctx context.Context, emailMD5 string, trusted bool) error {
+	if s.trustRepo == nil {
+		return errors.New("评论者信任功能未启用")
+	}
+	emailMD5 = strings.ToLower(strings.TrimSpace(emailMD5))
+	if emailMD5 == "" {
+		return errors.New("邮箱哈希不能为空")
+	}
+	status := model.CommenterRevoked
+	if trusted {
+		status = model.CommenterTrusted
+	}
+	if err := s.trustRepo.SetStatus(ctx, emailMD5, status); err != nil {
+		return fmt.Errorf("设置评论者信任状态失败: %w", err)
+	}
+	slog.InfoContext(ctx, "评论者信任状态已更新", slog.String("email_md5", emailMD5), slog.String("status", string(status)))
+	return nil
+}
+
+// resolveTrustStatus 根据评论者信任状态与首评审核设置决定新评论的状态。
+// 被撤销信任的评论者一律待审核；开启首评审核时，访客只有被信任后评论才会直接发布。
+// 匿名评论共用同一个邮箱，不参与信任，开启首评审核时总是待审核。
+func (s *Service) resolveTrustStatus(ctx context.Context, emailMD5 string, isGuest, isAnonymous bool) model.Status {
+	if s.trustRepo == nil {
+		return model.StatusPublished
+	}
+
+	trust := ""
+	if emailMD5 != "" && !isAnonymous {
+		var err error
+		if trust, err = s.trustRepo.GetStatus(ctx, emailMD5); err != nil {
+			slog.WarnContext(ctx, "查询评论者信任状态失败", slog.Any("error", err))
+		}
+	}
+	if trust == model.CommenterRevoked {
+		return model.StatusPending
+	}
+	if !isGuest || trust == model.CommenterTrusted || !s.settingSvc.GetBool(constant.KeyCommentFirstCommentReview.String()) {
+		return model.StatusPublished
+	}
+	if emailMD5 == "" || isAnonymous {
+		return model.StatusPending
+	}
+
+	// 开启首评审核前已有已发布评论的老访客，直接视为已信任
+	published := int(model.StatusPublished)
+	_, total, err := s.repo.FindWithConditions(ctx, repository.AdminListParams{
+		Page:     1,
+		PageSize: 1,
+		EmailMD5: &emailMD5,
+		Status:   &published,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "查询评论者历史评论失败", slog.Any("error", err))
+		return model.StatusPending
+	}
+	if total > 0 {
+		if err := s.trustRepo.TrustIfAbsent(ctx, emailMD5); err != nil {
+			slog.WarnContext(ctx, "标记评论者为已信任失败", slog.Any("error", err))
+		}
+		return model.StatusPublished
+	}
+	return model.StatusPending
+}
+
+// approvalNotifyCandidates 在通过审核前查询尚未发布、需要通知评论者的评论；未开启审核通过通知时不查询。
+func (s *Service) approvalNotifyCandidates(ctx context.Context, dbIDs []uint) []*model.Comment {
+	if s.emailSvc == nil || len(dbIDs) == 0 || !s.settingSvc.GetBool(constant.KeyCommentNotifyApproved.String()) {
+		return nil
+	}
+	comments, err := s.repo.FindManyByIDs(ctx, dbIDs)
+	if err != nil {
+		slog.WarnContext(ctx, "查询待通过的评论失败，跳过审核通过通知", slog.Any("error", err))
+		return nil
+	}
+	candidates := make([]*model.Comment, 0, len(comments))
+	for _, c := range comments {
+		if c.IsPublished() || c.IsAnonymous || c.IsAdminAuthor || c.Author.Email == nil || *c.Author.Email == "" {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// notifyApproved 异步通知评论者其评论已通过审核，注册用户按其通知偏好决定是否发送及发送到哪个邮箱。
+func (s *Service) notifyApproved(ctx context.Context, comments []*model.Comment) {
+	if len(comments) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	workerpool.Go(workerpool.CategoryNotification, func() {
+		for _, c := range comments {
+			toEmail := *c.Author.Email
+			if c.UserID != nil && s.notificationSvc != nil {
+				if err := s.notificationSvc.EnsureUserDefaultConfigs(ctx, *c.UserID); err != nil {
+					slog.WarnContext(ctx, "初始化用户通知配置失败", slog.Uint64("user_id", uint64(*c.UserID)), slog.Any("error", err))
+				}
+				allowed, effectiveEmail, err := s.notificationSvc.ShouldNotifyUser(ctx, *c.UserID, model.NotificationTypeCommentApproved, model.NotificationChannelEmail)
+				if err != nil {
+					slog.WarnContext(ctx, "获取用户的审核通过通知设置失败，跳过通知", slog.Uint64("user_id", uint64(*c.UserID)), slog.Any("error", err))
+					continue
+				}
+				if !allowed {
+					continue
+				}
+				if effectiveEmail != "" {
+					toEmail = effectiveEmail
+				}
+			}
+			if err := s.emailSvc.SendCommentApprovedEmail(ctx, c, toEmail); err != nil {
+				slog.ErrorContext(ctx, "发送评论审核通过邮件失败", slog.Uint64("comment_id", uint64(c.ID)), slog.Any("error", err))
+			}
+		}
+	})
+}
+
+// trustCommenters 评论审核通过后将其作者标记为已信任（不会覆盖管理员的撤销操作）
+func (s *Service) trustCommenters(ctx context.Context, comments ...*model.Comment) {
+	if s.trustRepo == nil {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, c := range comments {
+		if c == nil || c.IsAnonymous || c.IsAdminAuthor || c.Author.Email == nil || *c.Author.Email == "" {
+			continue
+		}
+		emailMD5 := fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(*c.Author.Email))))
+		if seen[emailMD5] {
+			continue
+		}
+		seen[emailMD5] = true
+		if err := s.trustRepo.TrustIfAbsent(ctx, emailMD5); err != nil {
+			slog.WarnContext(ctx, "标记评论者为已信任失败", slog.String("email_md5", emailMD5), slog.Any("error", err))
+		}
+	}
+}
+
+// clusterSimilar 按内容相似度对文本分组，返回每组的下标（保持输入顺序），只保留至少两条的分组。
+// 相似度使用字符 shingle 集合的 Jaccard 系数，组内关系可传递（并查集）。
+func clusterSimilar(contents []string, threshold float64) [][]int {
+	shingles := make([]map[string]struct{}, len(contents))
+	for i, content := range contents {
+		shingles[i] = buildShingles(content)
+	}
+
+	parent := make([]int, len(contents))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(x int) int {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+
+	for i := 0; i < len(contents); i++ {
+		if len(shingles[i]) == 0 {
+			continue
+		}
+		for j := i + 1; j < len(contents); j++ {
+			if len(shingles[j]) == 0 || find(i) == find(j) {
+				continue
+			}
+			if jaccard(shingles[i], shingles[j]) >= threshold {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range contents {
+		root := find(i)
+		groups[root] = append(groups[root], i)
+	}
+	result := make([][]int, 0)
+	for _, group := range groups {
+		if len(group) >= 2 {
+			result = append(result, group)
+		}
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if len(result[a]) != len(result[b]) {
+			return len(result[a]) > len(result[b])
+		}
+		return result[a][0] < result[b][0]
+	})
+	return result
+}
+
+// buildShingles 把文本规范化（小写、去掉空白与标点）后切分为固定长度的字符片段集合
+func buildShingles(content string) map[string]struct{} {
+	runes := make([]rune, 0, len(content))
+	for _, r := range strings.ToLower(content) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			runes = append(runes, r)
+		}
+	}
+	set := make(map[string]struct{})
+	if len(runes) == 0 {
+		return set
+	}
+	if len(runes) <= shingleSize {
+		set[string(runes)] = struct{}{}
+		return set
+	}
+	for i := 0; i+shingleSize <= len(runes); i++ {
+		set[string(runes[i:i+shingleSize])] = struct{}{}
+	}
+	return set
+}
+
+// jaccard 计算两个集合的 Jaccard 系数
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	intersection := 0
+	for k := range a {
+		if _, ok := b[k]; ok {
+			intersection++
+		}
+	}
+	union := len(a) + len(b) - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
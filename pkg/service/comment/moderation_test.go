@@ -0,0 +1,147 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+func TestClusterSimilar(t *testing.T) {
+	contents := []string{
+		"Buy cheap watches at example dot com now!!!",
+		"今天的文章写得很好，学到了很多",
+		"buy cheap watches at example dot com now",
+		"Buy  cheap watches at example.com NOW",
+		"",
+		"",
+		"感谢分享",
+	}
+	got := clusterSimilar(contents, 0.7)
+	want := [][]int{{0, 2, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("clusterSimilar() = %v, want %v", got, want)
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	a := buildShingles("abcd")
+	b := buildShingles("abce")
+	// {abc, bcd} 与 {abc, bce} 交集 1，并集 3
+	if got := jaccard(a, b); got < 0.33 || got > 0.34 {
+		t.Errorf("jaccard() = %v, want 1/3", got)
+	}
+	if got := jaccard(buildShingles(""), buildShingles("")); got != 0 {
+		t.Errorf("jaccard(empty) = %v, want 0", got)
+	}
+}
+
+type fakeApprovalRepo struct {
+	repository.CommentRepository
+	comments map[uint]*model.Comment
+}
+
+func (f *fakeApprovalRepo) FindManyByIDs(ctx context.Context, ids []uint) ([]*model.Comment, error) {
+	var list []*model.Comment
+	for _, id := range ids {
+		if c, ok := f.comments[id]; ok {
+			list = append(list, c)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeApprovalRepo) UpdateStatusByIDs(ctx context.Context, ids []uint, status model.Status) (int, error) {
+	for _, id := range ids {
+		f.comments[id].Status = status
+	}
+	return len(ids), nil
+}
+
+type fakeApprovalNotification struct {
+	notification.Service
+	// prefs 记录注册用户是否开启审核通过通知及其通知邮箱
+	prefs map[uint]string
+}
+
+func (f *fakeApprovalNotification) EnsureUserDefaultConfigs(ctx context.Context, userID uint) error {
+	return nil
+}
+
+func (f *fakeApprovalNotification) ShouldNotifyUser(ctx context.Context, userID uint, typeCode string, channel string) (bool, string, error) {
+	email, ok := f.prefs[userID]
+	return ok, email, nil
+}
+
+type fakeApprovedEmail struct {
+	utility.EmailService
+	sent chan string
+}
+
+func (f *fakeApprovedEmail) SendCommentApprovedEmail(ctx context.Context, comment *model.Comment, toEmail string) error {
+	f.sent <- fmt.Sprintf("%d:%s", comment.ID, toEmail)
+	return nil
+}
+
+func TestBatchApproveNotifiesCommenters(t *testing.T) {
+	email := func(s string) *string { return &s }
+	userID := func(id uint) *uint { return &id }
+	repo := &fakeApprovalRepo{comments: map[uint]*model.Comment{
+		1: {ID: 1, Status: model.StatusPending, Author: model.Author{Email: email("guest@example.com")}},
+		2: {ID: 2, Status: model.StatusPublished, Author: model.Author{Email: email("old@example.com")}},
+		3: {ID: 3, Status: model.StatusPending, IsAnonymous: true, Author: model.Author{Email: email("anon@example.com")}},
+		4: {ID: 4, Status: model.StatusPending, IsAdminAuthor: true, Author: model.Author{Email: email("admin@example.com")}},
+		5: {ID: 5, Status: model.StatusPending},
+		6: {ID: 6, Status: model.StatusPending, UserID: userID(7), Author: model.Author{Email: email("user@example.com")}},
+		7: {ID: 7, Status: model.StatusPending, UserID: userID(8), Author: model.Author{Email: email("optout@example.com")}},
+	}}
+	emails := &fakeApprovedEmail{sent: make(chan string, 10)}
+	svc := &Service{
+		repo:            repo,
+		emailSvc:        emails,
+		notificationSvc: &fakeApprovalNotification{prefs: map[uint]string{7: "notify@example.com"}},
+		settingSvc:      &fakeProfileSettings{values: map[string]string{constant.KeyCommentNotifyApproved.String(): "true"}},
+	}
+
+	if err := idgen.InitSqidsEncoderWithSeed("comment-approved-test"); err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(repo.comments))
+	for id := range repo.comments {
+		publicID, err := idgen.GeneratePublicID(id, idgen.EntityTypeComment)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, publicID)
+	}
+	if _, err := svc.BatchApprove(context.Background(), ids); err != nil {
+		t.Fatal(err)
+	}
+
+	// 只有访客评论 1 和开启通知的注册用户评论 6 会收到邮件，注册用户使用其通知邮箱
+	want := map[string]bool{"1:guest@example.com": true, "6:notify@example.com": true}
+	for n := len(want); n > 0; n-- {
+		select {
+		case got := <-emails.sent:
+			if !want[got] {
+				t.Errorf("不应发送的审核通过通知: %s", got)
+			}
+			delete(want, got)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("未收到审核通过通知: %v", want)
+		}
+	}
+	select {
+	case got := <-emails.sent:
+		t.Errorf("不应发送的审核通过通知: %s", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,115 @@
+// anheyu-app/pkg/service/comment/profile.go
+package comment
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/strutil"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	// maxProfileRecentCount 资料卡片最多展示的最近评论数
+	maxProfileRecentCount = 20
+	// profileExcerptLength 最近评论摘要的最大字符数
+	profileExcerptLength = 80
+)
+
+var (
+	emailMD5Regex = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	htmlTagRegex  = regexp.MustCompile(`<[^>]*>`)
+)
+
+// CommenterProfile 返回评论者（按邮箱哈希）公开的评论足迹，供前台悬停头像时展示资料卡片。
+// 只统计已发布的非匿名评论，不返回邮箱、IP 等隐私信息；关闭资料卡片或评论者没有公开评论时返回 ErrNotFound。
+func (s *Service) CommenterProfile(ctx context.Context, emailMD5 string) (*dto.CommenterProfileResponse, error) {
+	if !s.settingSvc.GetBool(constant.KeyCommentProfileEnable.String()) {
+		return nil, fmt.Errorf("评论者资料卡片未开启: %w", constant.ErrNotFound)
+	}
+	emailMD5 = strings.ToLower(strings.TrimSpace(emailMD5))
+	if !emailMD5Regex.MatchString(emailMD5) {
+		return nil, fmt.Errorf("无效的邮箱哈希: %w", constant.ErrBadRequest)
+	}
+
+	recentCount, _ := strconv.Atoi(s.settingSvc.Get(constant.KeyCommentProfileRecentCount.String()))
+	if recentCount < 0 {
+		recentCount = 0
+	}
+	if recentCount > maxProfileRecentCount {
+		recentCount = maxProfileRecentCount
+	}
+
+	footprint, err := s.repo.CommenterFootprint(ctx, emailMD5, recentCount)
+	if err != nil {
+		return nil, fmt.Errorf("统计评论者足迹失败: %w", err)
+	}
+	if footprint.CommentCount == 0 || len(footprint.Recent) == 0 {
+		return nil, fmt.Errorf("评论者不存在: %w", constant.ErrNotFound)
+	}
+
+	latest := footprint.Recent[0]
+	profile := &dto.CommenterProfileResponse{
+		EmailMD5:       footprint.EmailMD5,
+		Nickname:       latest.Author.Nickname,
+		Website:        latest.Author.Website,
+		IsAdmin:        latest.IsAdminAuthor,
+		CommentCount:   footprint.CommentCount,
+		FirstCommentAt: footprint.FirstCommentAt,
+		LastCommentAt:  footprint.LastCommentAt,
+		Recent:         make([]*dto.CommenterProfileComment, 0, recentCount),
+	}
+	for i, c := range footprint.Recent {
+		if i >= recentCount {
+			break
+		}
+		profile.Recent = append(profile.Recent, toProfileComment(c))
+	}
+	return profile, nil
+}
+
+// UserCommentFootprint 返回注册用户已发布的非匿名评论数与最近 recentCount 条评论摘要，供公开资料页展示
+func (s *Service) UserCommentFootprint(ctx context.Context, userID uint, recentCount int) (int, []*dto.CommenterProfileComment, error) {
+	if recentCount > maxProfileRecentCount {
+		recentCount = maxProfileRecentCount
+	}
+	footprint, err := s.repo.UserFootprint(ctx, userID, recentCount)
+	if err != nil {
+		return 0, nil, fmt.Errorf("统计用户评论足迹失败: %w", err)
+	}
+	recent := make([]*dto.CommenterProfileComment, 0, len(footprint.Recent))
+	for i, c := range footprint.Recent {
+		if i >= recentCount {
+			break
+		}
+		recent = append(recent, toProfileComment(c))
+	}
+	return footprint.CommentCount, recent, nil
+}
+
+func toProfileComment(c *model.Comment) *dto.CommenterProfileComment {
+	publicID, _ := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+	return &dto.CommenterProfileComment{
+		ID:          publicID,
+		TargetPath:  c.TargetPath,
+		TargetTitle: c.TargetTitle,
+		Excerpt:     commentExcerpt(c.ContentHTML, c.Content),
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+// commentExcerpt 从评论 HTML 中提取纯文本摘要，HTML 为空时退回 Markdown 原文
+func commentExcerpt(contentHTML, content string) string {
+	text := content
+	if contentHTML != "" {
+		text = html.UnescapeString(htmlTagRegex.ReplaceAllString(contentHTML, " "))
+	}
+	return strutil.Truncate(strings.Join(strings.Fields(text), " "), profileExcerptLength)
+}
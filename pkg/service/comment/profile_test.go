@@ -0,0 +1,74 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeProfileSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeProfileSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeProfileSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+type fakeFootprintRepo struct {
+	repository.CommentRepository
+	footprint   *model.CommenterFootprint
+	recentLimit int
+}
+
+func (f *fakeFootprintRepo) CommenterFootprint(ctx context.Context, emailMD5 string, recentLimit int) (*model.CommenterFootprint, error) {
+	f.recentLimit = recentLimit
+	return f.footprint, nil
+}
+
+func TestCommenterProfile(t *testing.T) {
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeFootprintRepo{footprint: &model.CommenterFootprint{
+		EmailMD5:       "0123456789abcdef0123456789abcdef",
+		CommentCount:   3,
+		FirstCommentAt: &first,
+		LastCommentAt:  &last,
+		Recent: []*model.Comment{
+			{ID: 2, Author: model.Author{Nickname: "新昵称"}, ContentHTML: "<p>你好&amp;再见 <img src=\"x\"></p>", CreatedAt: last},
+			{ID: 1, Author: model.Author{Nickname: "旧昵称"}, Content: "早期评论", CreatedAt: first},
+		},
+	}}
+	settings := &fakeProfileSettings{values: map[string]string{
+		constant.KeyCommentProfileEnable.String():      "true",
+		constant.KeyCommentProfileRecentCount.String(): "1",
+	}}
+	svc := &Service{repo: repo, settingSvc: settings}
+	ctx := context.Background()
+
+	profile, err := svc.CommenterProfile(ctx, " 0123456789ABCDEF0123456789ABCDEF ")
+	if err != nil {
+		t.Fatalf("获取资料失败: %v", err)
+	}
+	if repo.recentLimit != 1 || profile.Nickname != "新昵称" || profile.CommentCount != 3 || len(profile.Recent) != 1 {
+		t.Fatalf("资料聚合错误: %+v", profile)
+	}
+	if profile.Recent[0].Excerpt != "你好&再见" {
+		t.Errorf("摘要应为纯文本: %q", profile.Recent[0].Excerpt)
+	}
+
+	if _, err := svc.CommenterProfile(ctx, "not-a-hash"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("无效的邮箱哈希应返回 ErrBadRequest: %v", err)
+	}
+
+	settings.values[constant.KeyCommentProfileEnable.String()] = "false"
+	if _, err := svc.CommenterProfile(ctx, repo.footprint.EmailMD5); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("关闭资料卡片后应返回 ErrNotFound: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+ * @Description: 评论表情回应：按访客记录 👍 ❤️ 😂 😮 😢 等回应，同一访客对同一评论的每种回应只计一次
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 03:00:00
+ * @LastEditTime: 2026-10-18 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package comment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+var (
+	// ErrInvalidReaction 不支持的回应类型
+	ErrInvalidReaction = errors.New("不支持的回应类型")
+	// ErrReactionVisitorMissing 无法识别访客
+	ErrReactionVisitorMissing = errors.New("无法识别访客身份")
+	// ErrReactionCommentNotFound 评论不存在或未发布
+	ErrReactionCommentNotFound = errors.New("评论不存在")
+)
+
+// ReactionResult 是添加或撤销回应后返回给前端的结果
+type ReactionResult struct {
+	LikeCount int            `json:"like_count"`
+	Reactions map[string]int `json:"reactions"`
+	Changed   bool           `json:"changed"` // 本次操作是否改变了回应（重复回应或撤销不存在的回应时为 false）
+}
+
+// IsValidReaction 判断是否为支持的回应类型
+func IsValidReaction(reaction string) bool {
+	return slices.Contains(model.CommentReactions, reaction)
+}
+
+// reactionVisitorHash 使用匿名评论的盐对访客标识做 HMAC，数据库中不保存原始的 Cookie 或 IP
+func (s *Service) reactionVisitorHash(visitorKey string) string {
+	salt := s.settingSvc.Get(constant.KeyCommentAnonymousSalt.String())
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte("reaction"))
+	mac.Write([]byte{0})
+	mac.Write([]byte(visitorKey))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignReactionVisitor 为访客标识生成带签名的 Cookie 值（标识.签名），盐为空时返回空字符串，不下发 Cookie
+func (s *Service) SignReactionVisitor(visitorKey string) string {
+	sig := s.reactionVisitorSignature(visitorKey)
+	if sig == "" {
+		return ""
+	}
+	return visitorKey + "." + sig
+}
+
+// VerifyReactionVisitor 校验访客 Cookie 的签名，签名有效时返回其中的访客标识
+func (s *Service) VerifyReactionVisitor(cookie string) (string, bool) {
+	visitorKey, sig, ok := strings.Cut(cookie, ".")
+	if !ok || visitorKey == "" {
+		return "", false
+	}
+	want := s.reactionVisitorSignature(visitorKey)
+	if want == "" || !hmac.Equal([]byte(sig), []byte(want)) {
+		return "", false
+	}
+	return visitorKey, true
+}
+
+// reactionVisitorSignature 计算访客 Cookie 的签名，与回应哈希使用不同的前缀，避免签名可被反推为库中的哈希
+func (s *Service) reactionVisitorSignature(visitorKey string) string {
+	salt := s.settingSvc.Get(constant.KeyCommentAnonymousSalt.String())
+	if salt == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte("reaction-cookie"))
+	mac.Write([]byte{0})
+	mac.Write([]byte(visitorKey))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// React 为评论添加一种表情回应，visitorKey 为访客的 Cookie 标识或 IP+UA 指纹
+func (s *Service) React(ctx context.Context, publicID, reaction, visitorKey string) (*ReactionResult, error) {
+	return s.changeReaction(ctx, publicID, reaction, visitorKey, true)
+}
+
+// Unreact 撤销访客对评论的某种表情回应
+func (s *Service) Unreact(ctx context.Context, publicID, reaction, visitorKey string) (*ReactionResult, error) {
+	return s.changeReaction(ctx, publicID, reaction, visitorKey, false)
+}
+
+func (s *Service) changeReaction(ctx context.Context, publicID, reaction, visitorKey string, add bool) (*ReactionResult, error) {
+	if !IsValidReaction(reaction) {
+		return nil, ErrInvalidReaction
+	}
+	if visitorKey == "" {
+		return nil, ErrReactionVisitorMissing
+	}
+	dbID, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeComment {
+		return nil, ErrReactionCommentNotFound
+	}
+
+	// 只能回应已发布的评论
+	target, err := s.repo.FindByID(ctx, dbID)
+	if err != nil || target.Status != model.StatusPublished {
+		return nil, ErrReactionCommentNotFound
+	}
+
+	hash := s.reactionVisitorHash(visitorKey)
+	var (
+		updated *model.Comment
+		changed bool
+	)
+	if add {
+		updated, changed, err = s.repo.AddReaction(ctx, dbID, reaction, hash)
+	} else {
+		updated, changed, err = s.repo.RemoveReaction(ctx, dbID, reaction, hash)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("更新评论回应失败: %w", err)
+	}
+	if changed {
+		s.publishReaction(updated)
+	}
+	return &ReactionResult{
+		LikeCount: updated.LikeCount,
+		Reactions: updated.ReactionCounts,
+		Changed:   changed,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+type fakeReactionRepo struct {
+	repository.CommentRepository
+	comment *model.Comment
+	rows    map[string]bool // reaction + "|" + visitorHash
+}
+
+func (f *fakeReactionRepo) FindByID(ctx context.Context, id uint) (*model.Comment, error) {
+	if f.comment == nil || f.comment.ID != id {
+		return nil, errors.New("not found")
+	}
+	return f.comment, nil
+}
+
+func (f *fakeReactionRepo) AddReaction(ctx context.Context, id uint, reaction, visitorHash string) (*model.Comment, bool, error) {
+	key := reaction + "|" + visitorHash
+	if f.rows[key] {
+		return f.comment, false, nil
+	}
+	f.rows[key] = true
+	f.comment.ReactionCounts[reaction]++
+	f.comment.LikeCount = f.comment.ReactionCounts[model.ReactionLike]
+	return f.comment, true, nil
+}
+
+func (f *fakeReactionRepo) RemoveReaction(ctx context.Context, id uint, reaction, visitorHash string) (*model.Comment, bool, error) {
+	key := reaction + "|" + visitorHash
+	if !f.rows[key] {
+		return f.comment, false, nil
+	}
+	delete(f.rows, key)
+	f.comment.ReactionCounts[reaction]--
+	f.comment.LikeCount = f.comment.ReactionCounts[model.ReactionLike]
+	return f.comment, true, nil
+}
+
+func TestReact(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("comment-reaction-test"); err != nil {
+		t.Fatal(err)
+	}
+	publicID, _ := idgen.GeneratePublicID(7, idgen.EntityTypeComment)
+	repo := &fakeReactionRepo{
+		comment: &model.Comment{ID: 7, Status: model.StatusPublished, ReactionCounts: map[string]int{}},
+		rows:    map[string]bool{},
+	}
+	svc := &Service{repo: repo, settingSvc: &fakeProfileSettings{values: map[string]string{
+		constant.KeyCommentAnonymousSalt.String(): "salt",
+	}}}
+	ctx := context.Background()
+
+	result, err := svc.React(ctx, publicID, model.ReactionLove, "visitor-a")
+	if err != nil || !result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("首次回应应计数: %+v, %v", result, err)
+	}
+	if result, _ := svc.React(ctx, publicID, model.ReactionLove, "visitor-a"); result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("同一访客重复回应不应重复计数: %+v", result)
+	}
+	if result, _ := svc.React(ctx, publicID, model.ReactionLike, "visitor-a"); !result.Changed || result.LikeCount != 1 {
+		t.Fatalf("同一访客的不同回应应分别计数: %+v", result)
+	}
+	if result, _ := svc.React(ctx, publicID, model.ReactionLove, "visitor-b"); result.Reactions[model.ReactionLove] != 2 {
+		t.Fatalf("不同访客的回应应分别计数: %+v", result)
+	}
+	for key := range repo.rows {
+		if strings.Contains(key, "visitor-") {
+			t.Fatalf("不应保存原始访客标识: %s", key)
+		}
+	}
+
+	if result, _ := svc.Unreact(ctx, publicID, model.ReactionLove, "visitor-a"); !result.Changed || result.Reactions[model.ReactionLove] != 1 {
+		t.Fatalf("撤销回应后应减少计数: %+v", result)
+	}
+	if result, _ := svc.Unreact(ctx, publicID, model.ReactionLove, "visitor-a"); result.Changed {
+		t.Fatalf("撤销不存在的回应不应改变计数: %+v", result)
+	}
+
+	if _, err := svc.React(ctx, publicID, "angry", "visitor-a"); !errors.Is(err, ErrInvalidReaction) {
+		t.Errorf("不支持的回应应返回 ErrInvalidReaction, 实际为 %v", err)
+	}
+	if _, err := svc.React(ctx, publicID, model.ReactionLike, ""); !errors.Is(err, ErrReactionVisitorMissing) {
+		t.Errorf("缺少访客标识应返回 ErrReactionVisitorMissing, 实际为 %v", err)
+	}
+	repo.comment.Status = model.StatusPending
+	if _, err := svc.React(ctx, publicID, model.ReactionLike, "visitor-c"); !errors.Is(err, ErrReactionCommentNotFound) {
+		t.Errorf("未发布的评论不能回应, 实际为 %v", err)
+	}
+}
+
+func TestReactionVisitorCookie(t *testing.T) {
+	svc := &Service{settingSvc: &fakeProfileSettings{values: map[string]string{
+		constant.KeyCommentAnonymousSalt.String(): "salt",
+	}}}
+	key := strings.Repeat("ab", 16)
+
+	signed := svc.SignReactionVisitor(key)
+	if got, ok := svc.VerifyReactionVisitor(signed); !ok || got != key {
+		t.Fatalf("签名的 Cookie 应校验通过: %q, %v", got, ok)
+	}
+	for _, forged := range []string{key, key + ".", key + ".deadbeef", strings.Repeat("cd", 16) + signed[len(key):]} {
+		if _, ok := svc.VerifyReactionVisitor(forged); ok {
+			t.Errorf("伪造的 Cookie 不应校验通过: %q", forged)
+		}
+	}
+
+	unsalted := &Service{settingSvc: &fakeProfileSettings{values: map[string]string{}}}
+	if unsalted.SignReactionVisitor(key) != "" {
+		t.Error("盐为空时不应签发 Cookie")
+	}
+	if _, ok := unsalted.VerifyReactionVisitor(key + "."); ok {
+		t.Error("盐为空时不应接受任何 Cookie")
+	}
+}
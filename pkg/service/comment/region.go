@@ -0,0 +1,130 @@
+/*
+ * @Description: 评论IP归属地展示粒度：按国家/省份/城市格式化归属地，并在粒度调低时改写已保存的归属地
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 02:00:00
+ * @LastEditTime: 2026-10-18 02:00:00
+ * @LastEditors: 安知鱼
+ */
+package comment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 评论IP归属地的展示粒度
+const (
+	RegionGranularityCountry  = "country"  // 仅显示国家
+	RegionGranularityProvince = "province" // 显示到省份
+	RegionGranularityCity     = "city"     // 显示到城市（默认，与旧版本一致）
+)
+
+// chinaCountryName 国内省级行政区在“仅国家”粒度下显示的国家名
+const chinaCountryName = "中国"
+
+// chinaProvinces 国内省级行政区名称（不含“省”“市”“自治区”等后缀），
+// 归属地查询保存的格式为“省份 城市”，不包含国家，需要据此判断是否为国内地址
+var chinaProvinces = []string{
+	"北京", "天津", "上海", "重庆",
+	"河北", "山西", "辽宁", "吉林", "黑龙江", "江苏", "浙江", "安徽", "福建", "江西", "山东",
+	"河南", "湖北", "湖南", "广东", "海南", "四川", "贵州", "云南", "陕西", "甘肃", "青海",
+	"内蒙古", "广西", "西藏", "宁夏", "新疆",
+}
+
+// normalizeRegionGranularity 规范化粒度配置，无法识别的值按城市粒度处理
+func normalizeRegionGranularity(granularity string) string {
+	switch strings.ToLower(strings.TrimSpace(granularity)) {
+	case RegionGranularityCountry:
+		return RegionGranularityCountry
+	case RegionGranularityProvince:
+		return RegionGranularityProvince
+	default:
+		return RegionGranularityCity
+	}
+}
+
+// ValidateRegionGranularity 校验待更新配置中的归属地展示粒度
+func ValidateRegionGranularity(values map[string]string) error {
+	raw, ok := values[constant.KeyCommentRegionGranularity.String()]
+	if !ok {
+		return nil
+	}
+	switch strings.TrimSpace(raw) {
+	case RegionGranularityCountry, RegionGranularityProvince, RegionGranularityCity:
+		return nil
+	default:
+		return fmt.Errorf("配置项 %s 无效: 仅支持 country、province、city", constant.KeyCommentRegionGranularity)
+	}
+}
+
+// FormatRegion 按粒度格式化保存的归属地。归属地格式为“省份 城市”“城市”“省份”或“国家”，
+// 空值、“未知”等无法拆分的值原样返回；粒度只能降低，已经是较粗粒度的归属地不会被还原。
+func FormatRegion(location, granularity string) string {
+	fields := strings.Fields(location)
+	if len(fields) == 0 {
+		return location
+	}
+	switch normalizeRegionGranularity(granularity) {
+	case RegionGranularityCountry:
+		for _, province := range chinaProvinces {
+			if strings.HasPrefix(fields[0], province) {
+				return chinaCountryName
+			}
+		}
+		return fields[0]
+	case RegionGranularityProvince:
+		return fields[0]
+	default:
+		return location
+	}
+}
+
+// regionGranularity 读取当前的归属地展示粒度
+func (s *Service) regionGranularity() string {
+	return normalizeRegionGranularity(s.settingSvc.Get(constant.KeyCommentRegionGranularity.String()))
+}
+
+// handleSettingUpdate 在归属地展示粒度变更后改写已保存的评论归属地（在事件总线的后台 worker 中执行）
+func (s *Service) handleSettingUpdate(eventData interface{}) {
+	evt, ok := eventData.(setting.SettingUpdatedEvent)
+	if !ok || evt.Key != constant.KeyCommentRegionGranularity.String() {
+		return
+	}
+	granularity := normalizeRegionGranularity(evt.Value)
+	if granularity == RegionGranularityCity {
+		return
+	}
+	updated, err := s.ReformatStoredRegions(context.Background(), granularity)
+	if err != nil {
+		log.Printf("[评论归属地] 按粒度 %s 改写已保存的归属地失败: %v", granularity, err)
+		return
+	}
+	log.Printf("[评论归属地] 已按粒度 %s 改写 %d 条评论的归属地", granularity, updated)
+}
+
+// ReformatStoredRegions 按粒度改写已保存的评论归属地，返回被改写的评论数量。
+// 按不重复的归属地逐个替换，改写是幂等的，重复执行不会产生额外变更。
+func (s *Service) ReformatStoredRegions(ctx context.Context, granularity string) (int, error) {
+	locations, err := s.repo.DistinctIPLocations(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("获取评论归属地失败: %w", err)
+	}
+	total := 0
+	for _, location := range locations {
+		formatted := FormatRegion(location, granularity)
+		if formatted == location {
+			continue
+		}
+		n, err := s.repo.ReplaceIPLocation(ctx, location, formatted)
+		if err != nil {
+			return total, fmt.Errorf("改写归属地 '%s' 失败: %w", location, err)
+		}
+		total += n
+	}
+	return total, nil
+}
@@ -0,0 +1,91 @@
+package comment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakeRegionRepo struct {
+	repository.CommentRepository
+	locations map[string]int // 归属地 -> 评论数
+}
+
+func (f *fakeRegionRepo) DistinctIPLocations(ctx context.Context) ([]string, error) {
+	locations := make([]string, 0, len(f.locations))
+	for location := range f.locations {
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
+
+func (f *fakeRegionRepo) ReplaceIPLocation(ctx context.Context, oldLocation, newLocation string) (int, error) {
+	n := f.locations[oldLocation]
+	delete(f.locations, oldLocation)
+	f.locations[newLocation] += n
+	return n, nil
+}
+
+func TestFormatRegion(t *testing.T) {
+	tests := []struct {
+		location, granularity, want string
+	}{
+		{"广东 深圳", RegionGranularityCity, "广东 深圳"},
+		{"广东 深圳", RegionGranularityProvince, "广东"},
+		{"广东 深圳", RegionGranularityCountry, "中国"},
+		{"广东省 深圳市", RegionGranularityCountry, "中国"},
+		{"内蒙古 呼和浩特", RegionGranularityCountry, "中国"},
+		{"北京", RegionGranularityCountry, "中国"},
+		{"美国", RegionGranularityProvince, "美国"},
+		{"日本", RegionGranularityCountry, "日本"},
+		{"未知", RegionGranularityCountry, "未知"},
+		{"", RegionGranularityProvince, ""},
+		{"广东 深圳", "unknown", "广东 深圳"},
+	}
+	for _, tt := range tests {
+		if got := FormatRegion(tt.location, tt.granularity); got != tt.want {
+			t.Errorf("FormatRegion(%q, %q) = %q, 期望 %q", tt.location, tt.granularity, got, tt.want)
+		}
+	}
+}
+
+func TestValidateRegionGranularity(t *testing.T) {
+	key := constant.KeyCommentRegionGranularity.String()
+	if err := ValidateRegionGranularity(map[string]string{"other": "x"}); err != nil {
+		t.Fatalf("未包含粒度配置时不应报错: %v", err)
+	}
+	if err := ValidateRegionGranularity(map[string]string{key: "province"}); err != nil {
+		t.Fatalf("province 应为有效粒度: %v", err)
+	}
+	if err := ValidateRegionGranularity(map[string]string{key: "street"}); err == nil {
+		t.Fatal("street 应为无效粒度")
+	}
+}
+
+func TestReformatStoredRegions(t *testing.T) {
+	repo := &fakeRegionRepo{locations: map[string]int{
+		"广东 深圳": 2,
+		"广东 广州": 1,
+		"浙江":    1,
+		"未知":    3,
+	}}
+	s := &Service{repo: repo}
+
+	updated, err := s.ReformatStoredRegions(context.Background(), RegionGranularityProvince)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 3 {
+		t.Fatalf("应改写 3 条评论，实际为 %d", updated)
+	}
+	if repo.locations["广东"] != 3 || repo.locations["浙江"] != 1 || repo.locations["未知"] != 3 {
+		t.Fatalf("改写结果不正确: %v", repo.locations)
+	}
+
+	// 重复执行不应产生额外变更
+	if updated, _ := s.ReformatStoredRegions(context.Background(), RegionGranularityProvince); updated != 0 {
+		t.Fatalf("重复改写应为空操作，实际改写 %d 条", updated)
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"path/filepath"
@@ -20,11 +20,15 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/disposable_email"
 	filesvc "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/image_style"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
@@ -38,6 +42,9 @@ import (
 // htmlInternalURIRegex 匹配HTML中的 src="anzhiyu://file/ID"
 var htmlInternalURIRegex = regexp.MustCompile(`src="anzhiyu://file/([a-zA-Z0-9_-]+)"`)
 
+// commentAvatarSize 评论列表使用的用户上传头像尺寸
+const commentAvatarSize = "128"
+
 // InAppNotificationCallback 站内通知回调接口
 // 用于PRO版本注入站内通知功能
 type InAppNotificationCallback func(ctx context.Context, data *InAppNotificationData)
@@ -81,8 +88,31 @@ type Service struct {
 	// styleSvc 可选；非 nil 且 comment_image 策略启用了 image_process.default_style 时，
 	// renderHTMLURLs 返回的评论内嵌图片 URL 会自动追加 "!styleName" 后缀（Plan B Phase 1 Task 1.13.2）。
 	styleSvc image_style.ImageStyleService
+	// trustRepo 可选；非 nil 时启用首评审核与评论者信任（按邮箱哈希）
+	trustRepo repository.CommenterTrustRepository
+	// targetGuards 创建评论前对目标路径的校验（如说说是否允许评论），任一返回错误即拒绝
+	targetGuards []TargetGuard
+	// wordFilters 缓存编译后的违禁词规则
+	wordFilters wordFilterCache
+	// streamHub 可选；非 nil 时向 WebSocket 订阅者实时推送评论事件
+	streamHub *StreamHub
+	// subscriptionRepo 可选；非 nil 时启用评论区订阅与摘要邮件
+	subscriptionRepo   repository.CommentSubscriptionRepository
+	subscriptionSigner SubscriptionSigner
+	emailSvc           utility.EmailService
+	// spamDetectors 反垃圾评论检测器，按注册顺序检测
+	spamDetectors []SpamDetector
+	// disposableEmail 可选；非 nil 时按配置拒绝或审核使用一次性邮箱的评论
+	disposableEmail *disposable_email.Service
+	// auditSvc 可选；记录后台对评论的审核、编辑与删除
+	auditSvc *audit.Service
+	// eventBus 可选；已发布评论的数量可能变化时发布事件，用于刷新站点统计
+	eventBus *event.EventBus
 }
 
+// TargetGuard 校验评论目标路径是否允许评论，不关心的路径应直接返回 nil
+type TargetGuard func(ctx context.Context, targetPath string) error
+
 // NewService 创建一个新的评论服务实例。
 func NewService(
 	repo repository.CommentRepository,
@@ -125,6 +155,35 @@ func (s *Service) SetImageStyleService(svc image_style.ImageStyleService) {
 	s.styleSvc = svc
 }
 
+// SetDisposableEmailService 注入一次性邮箱服务，启用后按配置处理使用一次性邮箱的评论
+func (s *Service) SetDisposableEmailService(svc *disposable_email.Service) {
+	s.disposableEmail = svc
+}
+
+// SetCommenterTrustRepo 注入评论者信任状态仓储，启用首评审核与信任评论者自动放行。
+func (s *Service) SetCommenterTrustRepo(repo repository.CommenterTrustRepository) {
+	s.trustRepo = repo
+}
+
+// SetEventBus 注入事件总线，评论发布、审核状态变更或删除时发布评论数量变化事件，
+// 同时订阅配置更新事件，在归属地展示粒度调低时改写已保存的归属地
+func (s *Service) SetEventBus(bus *event.EventBus) {
+	s.eventBus = bus
+	bus.Subscribe(event.Topic(setting.TopicSettingUpdated), s.handleSettingUpdate)
+}
+
+// publishCountChanged 发布评论数量变化事件，未注入事件总线时为空操作
+func (s *Service) publishCountChanged() {
+	if s.eventBus != nil {
+		s.eventBus.Publish(event.CommentCountChanged, nil)
+	}
+}
+
+// AddTargetGuard 注册评论目标路径校验，用于说说等非文章内容控制是否允许评论。
+func (s *Service) AddTargetGuard(guard TargetGuard) {
+	s.targetGuards = append(s.targetGuards, guard)
+}
+
 // UploadImage 负责处理评论图片的上传业务逻辑。
 func (s *Service) UploadImage(ctx context.Context, viewerID uint, originalFilename string, fileReader io.Reader) (*model.FileItem, error) {
 	newFileName := uuid.New().String() + filepath.Ext(originalFilename)
@@ -180,7 +239,7 @@ func (s *Service) ListLatest(ctx context.Context, page, pageSize int) (*dto.List
 
 		comments_batch, err := s.repo.FindManyByIDs(ctx, ids)
 		if err != nil {
-			log.Printf("警告：批量获取评论失败: %v", err)
+			slog.WarnContext(ctx, "批量获取评论失败", slog.Any("error", err))
 		} else {
 			for _, c := range comments_batch {
 				commentMap[c.ID] = c
@@ -223,7 +282,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 		redisKey := fmt.Sprintf("comment:rate_limit:%s:%s", ip, time.Now().Format("200601021504"))
 		count, err := s.cacheSvc.Increment(ctx, redisKey)
 		if err != nil {
-			log.Printf("警告：Redis速率限制检查失败: %v", err)
+			slog.WarnContext(ctx, "Redis 速率限制检查失败", slog.Any("error", err))
 		} else {
 			if count == 1 {
 				s.cacheSvc.Expire(ctx, redisKey, 70*time.Second)
@@ -234,6 +293,15 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 		}
 	}
 
+	for _, guard := range s.targetGuards {
+		if err := guard(ctx, req.TargetPath); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsAnonymous && s.anonymousDisabledFor(req.TargetPath) {
+		return nil, constant.ErrAnonymousCommentDisabled
+	}
+
 	var parentDBID *uint
 	var parentComment *model.Comment
 	if req.ParentID != nil && *req.ParentID != "" {
@@ -276,11 +344,28 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 		return nil, errors.New("匿名评论不允许被回复")
 	}
 
+	// 违禁词规则：reject 直接拒绝，pending 进入待审核，replace 将命中内容替换为 ***
+	status := model.StatusPublished
+	wordAction, filteredContent, _ := s.currentWordFilter().apply(req.Content)
+	switch wordAction {
+	case wordActionReject:
+		return nil, constant.ErrCommentRejectedByWordRule
+	case wordActionPending:
+		status = model.StatusPending
+	}
+	req.Content = filteredContent
+
 	// 从 Markdown 内容生成 HTML
 	safeHTML, err := s.parserSvc.ToHTML(ctx, req.Content)
 	if err != nil {
 		return nil, fmt.Errorf("markdown内容解析失败: %w", err)
 	}
+	if err := s.checkCodeSize(safeHTML); err != nil {
+		return nil, err
+	}
+	// 解析 @ 提及：被提及者填写了网站时在 HTML 中链接到其网站
+	mentions := s.resolveMentions(ctx, req.TargetPath, parseMentions(req.Content))
+	safeHTML = linkMentions(safeHTML, mentions)
 	var emailMD5 string
 	if req.Email != nil {
 		emailMD5 = fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(*req.Email))))
@@ -289,21 +374,9 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 	if ip != "" && s.geoService != nil {
 		location, err := s.geoService.Lookup(ip, referer)
 		if err == nil {
-			ipLocation = location
-		}
-	}
-	status := model.StatusPublished
-	forbiddenWords := s.settingSvc.Get(constant.KeyCommentForbiddenWords.String())
-	if forbiddenWords != "" {
-		for _, word := range strings.Split(forbiddenWords, ",") {
-			trimmedWord := strings.TrimSpace(word)
-			if trimmedWord != "" && strings.Contains(req.Content, trimmedWord) {
-				status = model.StatusPending
-				break
-			}
+			ipLocation = FormatRegion(location, s.regionGranularity())
 		}
 	}
-
 	// AI 违禁词检测
 	if status == model.StatusPublished {
 		aiDetectEnable := s.settingSvc.GetBool(constant.KeyCommentAIDetectEnable.String())
@@ -315,14 +388,14 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 			if aiDetectAPIURL != "" {
 				isViolation, riskLevel, err := s.checkAIForbiddenWords(req.Content, aiDetectAPIURL, referer)
 				if err != nil {
-					log.Printf("AI违禁词检测API调用失败: %v，跳过检测", err)
+					slog.WarnContext(ctx, "AI 违禁词检测接口调用失败，跳过检测", slog.Any("error", err))
 				} else if isViolation && shouldTakeAction(riskLevel, aiDetectRiskLevel) {
 					if aiDetectAction == "reject" {
 						return nil, fmt.Errorf("评论内容包含违规内容，请修改后重新提交")
 					}
 					// 默认为 pending
 					status = model.StatusPending
-					log.Printf("AI违禁词检测：评论内容包含违规内容，风险等级: %s，已设置为待审核", riskLevel)
+					slog.InfoContext(ctx, "AI 违禁词检测发现违规内容，评论已设置为待审核", slog.String("risk_level", riskLevel))
 				}
 			}
 		}
@@ -343,7 +416,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 		if req.Email != nil && *req.Email != "" {
 			admins, err := s.userRepo.FindByGroupID(ctx, 1)
 			if err != nil {
-				log.Printf("警告：查询管理员列表失败: %v", err)
+				slog.WarnContext(ctx, "查询管理员列表失败", slog.Any("error", err))
 			} else {
 				for _, admin := range admins {
 					if admin.Email == *req.Email {
@@ -357,18 +430,58 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 	// 使用前端传递的匿名标识，并在后端进行双重验证
 	isAnonymous := req.IsAnonymous
 
+	// 首评审核与评论者信任：被撤销信任或首次评论的访客进入待审核
+	if status == model.StatusPublished && !isAdmin {
+		status = s.resolveTrustStatus(ctx, emailMD5, userID == nil, isAnonymous)
+	}
+
 	// 如果前端标记为匿名评论，且配置了匿名邮箱，则验证邮箱是否匹配
 	if isAnonymous {
 		anonymousEmail := s.settingSvc.Get(constant.KeyCommentAnonymousEmail.String())
 		if anonymousEmail != "" {
 			// 如果配置了匿名邮箱，但用户邮箱不匹配，拒绝请求
 			if req.Email == nil || *req.Email != anonymousEmail {
-				log.Printf("警告：前端标记为匿名评论，但邮箱不匹配。前端邮箱: %v, 配置的匿名邮箱: %s", req.Email, anonymousEmail)
+				slog.WarnContext(ctx, "前端标记为匿名评论，但邮箱与配置的匿名邮箱不匹配", slog.Any("email", req.Email), slog.String("anonymous_email", anonymousEmail))
 				return nil, fmt.Errorf("匿名评论邮箱验证失败")
 			}
 		}
 	}
 
+	// 一次性邮箱：reject 直接拒绝，hold 进入待审核；匿名评论使用站点配置的匿名邮箱，不检测
+	if !isAdmin && !isAnonymous && req.Email != nil && s.disposableEmail != nil {
+		action := s.disposableEmail.Match(disposable_email.ScopeComment, *req.Email)
+		s.disposableEmail.Record(ctx, disposable_email.ScopeComment, action)
+		switch action {
+		case disposable_email.ActionReject:
+			return nil, constant.ErrDisposableEmail
+		case disposable_email.ActionHold:
+			if status == model.StatusPublished {
+				status = model.StatusPending
+			}
+		}
+	}
+
+	// 反垃圾检测：管理员的评论不检测，判定为垃圾的评论进入垃圾评论状态，不公开也不发送通知
+	if !isAdmin {
+		candidate := &SpamCandidate{
+			TargetPath: req.TargetPath,
+			Content:    req.Content,
+			Nickname:   req.Nickname,
+			IP:         ip,
+			UserAgent:  ua,
+			Referer:    referer,
+		}
+		if req.Email != nil {
+			candidate.Email = *req.Email
+		}
+		if req.Website != nil {
+			candidate.Website = *req.Website
+		}
+		if s.detectSpam(ctx, candidate, req.Homepage) {
+			status = model.StatusSpam
+		}
+	}
+
 	// 获取 replyToComment 的数据库ID
 	var replyToDBID *uint
 	if replyToComment != nil {
@@ -402,14 +515,14 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 	}
 
 	if newComment.IsPublished() {
-		log.Printf("[DEBUG] 评论已发布，开始处理通知逻辑，评论ID: %d", newComment.ID)
+		slog.DebugContext(ctx, "评论已发布，开始处理通知", slog.Uint64("comment_id", uint64(newComment.ID)))
 
 		// 发送邮件通知
 		if s.broker != nil {
-			log.Printf("[DEBUG] 邮件通知任务已分发，评论ID: %d", newComment.ID)
+			slog.DebugContext(ctx, "邮件通知任务已分发", slog.Uint64("comment_id", uint64(newComment.ID)))
 			go s.broker.DispatchCommentNotification(newComment.ID)
 		} else {
-			log.Printf("[DEBUG] broker 为 nil，跳过邮件通知")
+			slog.DebugContext(ctx, "broker 为 nil，跳过邮件通知")
 		}
 
 		// 发送站内通知（PRO版功能）
@@ -440,8 +553,8 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 					shouldNotifyAdmin = false
 				}
 				if shouldNotifyAdmin && adminEmail != "" && adminEmail != newCommenterEmail {
-					log.Printf("[DEBUG] 发送站内通知给管理员: %s", adminEmail)
-					go s.inAppNotificationCallback(ctx, &InAppNotificationData{
+					slog.DebugContext(ctx, "发送站内通知给管理员", slog.String("email", adminEmail))
+					data := &InAppNotificationData{
 						CommentID:          newComment.ID,
 						ArticleTitle:       articleTitle,
 						ArticlePath:        newComment.TargetPath,
@@ -453,7 +566,8 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 						IsAdminComment:     newComment.IsAdminAuthor,
 						NotifyAdmin:        true,
 						RecipientUserEmail: adminEmail,
-					})
+					}
+					workerpool.Go(workerpool.CategoryNotification, func() { s.inAppNotificationCallback(ctx, data) })
 				}
 			}
 
@@ -469,8 +583,8 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 
 				// 避免自己回复自己
 				if parentEmail != "" && parentEmail != newCommenterEmail {
-					log.Printf("[DEBUG] 发送站内通知给被回复者: %s (%s)", parentName, parentEmail)
-					go s.inAppNotificationCallback(ctx, &InAppNotificationData{
+					slog.DebugContext(ctx, "发送站内通知给被回复者", slog.String("name", parentName), slog.String("email", parentEmail))
+					data := &InAppNotificationData{
 						CommentID:          newComment.ID,
 						ArticleTitle:       articleTitle,
 						ArticlePath:        newComment.TargetPath,
@@ -486,35 +600,32 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 						IsAdminComment:     newComment.IsAdminAuthor,
 						RecipientUserID:    parentUserID,
 						RecipientUserEmail: parentEmail,
-					})
+					}
+					workerpool.Go(workerpool.CategoryNotification, func() { s.inAppNotificationCallback(ctx, data) })
 				}
 			}
 		}
 
 		// 发送即时通知
-		log.Printf("[DEBUG] 检查即时通知服务，pushooSvc 是否为 nil: %t", s.pushooSvc == nil)
 		if s.pushooSvc != nil {
-			go func() {
-				log.Printf("[DEBUG] 开始处理即时通知逻辑")
+			workerpool.Go(workerpool.CategoryNotification, func() {
 				pushChannel := s.settingSvc.Get(constant.KeyPushooChannel.String())
 				notifyAdmin := s.settingSvc.GetBool(constant.KeyCommentNotifyAdmin.String())
 				scMailNotify := s.settingSvc.GetBool(constant.KeyScMailNotify.String())
 				notifyReply := s.settingSvc.GetBool(constant.KeyCommentNotifyReply.String())
 				adminEmail := s.settingSvc.Get(constant.KeyFrontDeskSiteOwnerEmail.String())
 
-				log.Printf("[DEBUG] 即时通知配置检查:")
-				log.Printf("[DEBUG]   - pushChannel: '%s'", pushChannel)
-				log.Printf("[DEBUG]   - notifyAdmin: %t", notifyAdmin)
-				log.Printf("[DEBUG]   - scMailNotify: %t", scMailNotify)
-				log.Printf("[DEBUG]   - notifyReply: %t", notifyReply)
+				slog.DebugContext(ctx, "即时通知配置",
+					slog.String("push_channel", pushChannel),
+					slog.Bool("notify_admin", notifyAdmin),
+					slog.Bool("sc_mail_notify", scMailNotify),
+					slog.Bool("notify_reply", notifyReply))
 
 				if pushChannel == "" {
-					log.Printf("[DEBUG] pushChannel 为空，跳过即时通知")
+					slog.DebugContext(ctx, "pushChannel 为空，跳过即时通知")
 					return
 				}
 
-				log.Printf("[DEBUG] pushChannel 不为空，继续检查通知条件")
-
 				// 获取新评论者的邮箱
 				var newCommenterEmail string
 				if newComment.Author.Email != nil {
@@ -525,7 +636,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 				// 如果发送评论的人的邮箱与即时通知接收者的邮箱相同，则不应发送即时通知
 				// 这样可以避免用户收到自己操作的通知
 				if newCommenterEmail != "" && newCommenterEmail == adminEmail {
-					log.Printf("[DEBUG] 跳过即时通知：发送评论的人（%s）就是即时通知接收者本人，不发送通知", newCommenterEmail)
+					slog.DebugContext(ctx, "评论者就是即时通知接收者本人，跳过即时通知", slog.String("email", newCommenterEmail))
 					return
 				}
 
@@ -548,14 +659,14 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 				if (notifyAdmin || scMailNotify) && !isAdminComment {
 					// 如果有父评论且父评论作者是管理员，跳过博主通知（会在场景二中通知）
 					if !parentIsAdmin {
-						log.Printf("[DEBUG] 满足博主通知条件，开始发送即时通知")
+						slog.DebugContext(ctx, "满足博主通知条件，开始发送即时通知")
 						if err := s.pushooSvc.SendCommentNotification(ctx, newComment, nil); err != nil {
-							log.Printf("[ERROR] 发送博主即时通知失败: %v", err)
+							slog.ErrorContext(ctx, "发送博主即时通知失败", slog.Any("error", err))
 						} else {
-							log.Printf("[DEBUG] 博主即时通知发送成功")
+							slog.DebugContext(ctx, "博主即时通知发送成功")
 						}
 					} else {
-						log.Printf("[DEBUG] 被回复者是管理员，将在场景二统一通知，跳过场景一")
+						slog.DebugContext(ctx, "被回复者是管理员，将在场景二统一通知，跳过场景一")
 					}
 				}
 
@@ -569,40 +680,47 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 						if parentComment.UserID != nil {
 							userSettings, err := s.notificationSvc.GetUserNotificationSettings(ctx, *parentComment.UserID)
 							if err != nil {
-								log.Printf("[WARNING] 获取用户通知设置失败（用户ID: %d），使用默认值 true: %v", *parentComment.UserID, err)
+								slog.WarnContext(ctx, "获取用户通知设置失败，使用默认值 true", slog.Uint64("user_id", uint64(*parentComment.UserID)), slog.Any("error", err))
 							} else {
 								userAllowNotification = userSettings.AllowCommentReplyNotification
-								log.Printf("[DEBUG] 即时通知 - 用户 %d 的实时通知偏好设置: %t", *parentComment.UserID, userAllowNotification)
+								slog.DebugContext(ctx, "被回复用户的实时通知偏好", slog.Uint64("user_id", uint64(*parentComment.UserID)), slog.Bool("allow", userAllowNotification))
 							}
 						}
 
 						if userAllowNotification {
-							log.Printf("[DEBUG] 满足被回复者通知条件（用户回复管理员），开始发送即时通知")
+							slog.DebugContext(ctx, "满足被回复者通知条件（用户回复管理员），开始发送即时通知")
 							if err := s.pushooSvc.SendCommentNotification(ctx, newComment, parentComment); err != nil {
-								log.Printf("[ERROR] 发送被回复者即时通知失败: %v", err)
+								slog.ErrorContext(ctx, "发送被回复者即时通知失败", slog.Any("error", err))
 							} else {
-								log.Printf("[DEBUG] 被回复者即时通知发送成功")
+								slog.DebugContext(ctx, "被回复者即时通知发送成功")
 							}
 						} else {
-							log.Printf("[DEBUG] 用户关闭了评论回复即时通知，跳过通知")
+							slog.DebugContext(ctx, "用户关闭了评论回复即时通知，跳过通知")
 						}
 					} else {
-						log.Printf("[DEBUG] 自己回复自己，跳过被回复者通知")
+						slog.DebugContext(ctx, "自己回复自己，跳过被回复者通知")
 					}
 				} else {
 					if hasParentComment && !parentIsAdmin {
-						log.Printf("[DEBUG] 用户回复用户，跳过即时通知（被回复者不是管理员）")
+						slog.DebugContext(ctx, "用户回复用户，跳过即时通知（被回复者不是管理员）")
 					}
 				}
-			}()
+			})
 		} else {
-			log.Printf("[DEBUG] pushooSvc 为 nil，跳过即时通知")
+			slog.DebugContext(ctx, "pushooSvc 为 nil，跳过即时通知")
 		}
 	} else {
-		log.Printf("[DEBUG] 评论未发布，跳过所有通知逻辑")
+		slog.DebugContext(ctx, "评论未发布，跳过所有通知逻辑")
 	}
 
-	return s.toResponseDTO(ctx, newComment, parentComment, replyToComment, false), nil
+	resp := s.toResponseDTO(ctx, newComment, parentComment, replyToComment, false)
+	if newComment.IsPublished() {
+		s.markSubscriptionsPending(ctx, newComment)
+		s.notifyMentions(ctx, newComment, parentComment, mentions)
+		s.publishStream(&StreamEvent{Type: StreamEventCreated, Path: newComment.TargetPath, ID: resp.ID, Comment: resp})
+		s.publishCountChanged()
+	}
+	return resp, nil
 }
 
 // ListByPath 按路径获取评论列表（内存建树分页）。
@@ -954,20 +1072,21 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 	parsedHTML, err := s.parserSvc.ToHTML(ctx, c.Content)
 	var renderedContentHTML string
 	if err != nil {
-		log.Printf("【WARN】解析评论 %s 的表情包失败: %v", publicID, err)
+		slog.WarnContext(ctx, "解析评论表情包失败", slog.String("comment_id", publicID), slog.Any("error", err))
 		renderedContentHTML = c.ContentHTML
 	} else {
 		renderedContentHTML = parsedHTML
 	}
 
 	// 渲染图片URL
-	// log.Printf("【DEBUG】评论 %s 渲染前HTML: %s", publicID, renderedContentHTML)
 	renderedContentHTML, err = s.renderHTMLURLs(ctx, renderedContentHTML)
 	if err != nil {
-		log.Printf("【WARN】渲染评论 %s 的HTML链接失败: %v", publicID, err)
+		slog.WarnContext(ctx, "渲染评论 HTML 链接失败", slog.String("comment_id", publicID), slog.Any("error", err))
 		renderedContentHTML = c.ContentHTML
 	}
-	// log.Printf("【DEBUG】评论 %s 渲染后HTML: %s", publicID, renderedContentHTML)
+
+	// 高亮代码块，超过最大行数的代码块标记为折叠
+	renderedContentHTML, codeBlocks := renderCodeBlocks(renderedContentHTML, s.codeMaxLines())
 
 	var emailMD5 string
 	var qqNumber *string
@@ -991,7 +1110,11 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 	if replyTo != nil {
 		rID, _ := idgen.GeneratePublicID(replyTo.ID, idgen.EntityTypeComment)
 		replyToPublicID = &rID
-		replyToNick = &replyTo.Author.Nickname
+		nick := replyTo.Author.Nickname
+		if replyTo.IsAnonymous && !isAdminView {
+			nick, _ = s.anonymousIdentity(replyTo)
+		}
+		replyToNick = &nick
 	}
 
 	showUA := s.settingSvc.GetBool(constant.KeyCommentShowUA.String())
@@ -1001,6 +1124,10 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 	var avatarURL *string
 	if c.User != nil && c.User.Avatar != "" {
 		avatar := c.User.Avatar
+		// 上传的头像优先使用小尺寸版本，减少评论列表的加载体积
+		if small := c.User.AvatarVariants[commentAvatarSize]; small != "" {
+			avatar = small
+		}
 		// 处理头像URL：如果是相对路径则拼接gravatar URL，与 user handler 保持一致
 		if !strings.HasPrefix(avatar, "http://") && !strings.HasPrefix(avatar, "https://") {
 			gravatarBaseURL := strings.TrimSuffix(s.settingSvc.Get(constant.KeyGravatarURL.String()), "/")
@@ -1027,15 +1154,28 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 		ReplyToID:      replyToPublicID,
 		ReplyToNick:    replyToNick,
 		LikeCount:      c.LikeCount,
+		Reactions:      c.ReactionCounts,
+		CodeBlocks:     codeBlocks,
 		Children:       []*dto.Response{},
 	}
 
+	// 匿名评论在前台显示按页面稳定的化名与头像，避免所有匿名评论看起来完全相同
+	if c.IsAnonymous && !isAdminView {
+		resp.Nickname, resp.EmailMD5 = s.anonymousIdentity(c)
+		resp.QQNumber = nil
+		resp.AvatarURL = nil
+		resp.Website = nil
+	}
+
 	if showUA {
 		ua := c.Author.UserAgent
 		resp.UserAgent = &ua
 	}
 	if showRegion {
 		loc := c.Author.Location
+		if !isAdminView {
+			loc = FormatRegion(loc, s.regionGranularity())
+		}
 		resp.IPLocation = loc
 	}
 
@@ -1068,7 +1208,7 @@ func (s *Service) renderHTMLURLs(ctx context.Context, htmlContent string) (strin
 		if policy, perr := s.fileSvc.GetPolicyByFlag(ctx, constant.PolicyFlagCommentImage); perr == nil {
 			stylePolicy = policy
 		} else {
-			log.Printf("[comment.renderHTMLURLs] 获取 comment_image 策略失败（忽略，URL 不拼样式）: %v", perr)
+			slog.WarnContext(ctx, "获取 comment_image 存储策略失败，图片 URL 不拼接样式", slog.Any("error", perr))
 		}
 	}
 
@@ -1082,7 +1222,7 @@ func (s *Service) renderHTMLURLs(ctx context.Context, htmlContent string) (strin
 
 		fileModel, err := s.fileSvc.FindFileByPublicID(ctx, publicID)
 		if err != nil {
-			log.Printf("【ERROR】渲染图片失败：找不到文件, PublicID=%s, 错误: %v", publicID, err)
+			slog.ErrorContext(ctx, "渲染评论图片失败：找不到文件", slog.String("file_id", publicID), slog.Any("error", err))
 			return `src=""`
 		}
 
@@ -1093,7 +1233,7 @@ func (s *Service) renderHTMLURLs(ctx context.Context, htmlContent string) (strin
 			if firstError == nil {
 				firstError = err
 			}
-			log.Printf("【ERROR】渲染图片失败：为文件 %s 生成URL时出错: %v", publicID, err)
+			slog.ErrorContext(ctx, "渲染评论图片失败：生成 URL 出错", slog.String("file_id", publicID), slog.Any("error", err))
 			return `src=""`
 		}
 
@@ -1109,32 +1249,6 @@ func (s *Service) renderHTMLURLs(ctx context.Context, htmlContent string) (strin
 	return htmlInternalURIRegex.ReplaceAllStringFunc(htmlContent, replacer), firstError
 }
 
-// LikeComment 为评论增加点赞数。
-func (s *Service) LikeComment(ctx context.Context, publicID string) (int, error) {
-	dbID, _, err := idgen.DecodePublicID(publicID)
-	if err != nil {
-		return 0, errors.New("无效的评论ID")
-	}
-	updatedComment, err := s.repo.IncrementLikeCount(ctx, dbID)
-	if err != nil {
-		return 0, fmt.Errorf("点赞失败: %w", err)
-	}
-	return updatedComment.LikeCount, nil
-}
-
-// UnlikeComment 为评论减少点赞数。
-func (s *Service) UnlikeComment(ctx context.Context, publicID string) (int, error) {
-	dbID, _, err := idgen.DecodePublicID(publicID)
-	if err != nil {
-		return 0, errors.New("无效的评论ID")
-	}
-	updatedComment, err := s.repo.DecrementLikeCount(ctx, dbID)
-	if err != nil {
-		return 0, fmt.Errorf("取消点赞失败: %w", err)
-	}
-	return updatedComment.LikeCount, nil
-}
-
 // AdminList 管理员根据条件查询评论列表。
 func (s *Service) AdminList(ctx context.Context, req *dto.AdminListRequest) (*dto.ListResponse, error) {
 	if req.Page < 1 {
@@ -1153,6 +1267,9 @@ func (s *Service) AdminList(ctx context.Context, req *dto.AdminListRequest) (*dt
 		Content:    req.Content,
 		TargetPath: req.TargetPath,
 		Status:     req.Status,
+		EmailMD5:   req.EmailMD5,
+		HasLinks:   req.HasLinks,
+		FirstTime:  req.FirstTime,
 	}
 	comments, total, err := s.repo.FindWithConditions(ctx, params)
 	if err != nil {
@@ -1182,7 +1299,7 @@ func (s *Service) Delete(ctx context.Context, ids []string) (int, error) {
 	for _, publicID := range ids {
 		dbID, entityType, err := idgen.DecodePublicID(publicID)
 		if err != nil || entityType != idgen.EntityTypeComment {
-			log.Printf("警告：跳过无效的评论ID '%s' 进行删除", publicID)
+			slog.WarnContext(ctx, "删除时跳过无效的评论ID", slog.String("id", publicID))
 			continue
 		}
 		dbIDs = append(dbIDs, dbID)
@@ -1190,7 +1307,16 @@ func (s *Service) Delete(ctx context.Context, ids []string) (int, error) {
 	if len(dbIDs) == 0 {
 		return 0, errors.New("未提供任何有效的评论ID")
 	}
-	return s.repo.DeleteByIDs(ctx, dbIDs)
+	deleted := s.commentsBeforeChange(ctx, dbIDs)
+	count, err := s.repo.DeleteByIDs(ctx, dbIDs)
+	if err != nil {
+		return count, err
+	}
+	for _, c := range deleted {
+		s.auditComment(ctx, model.AuditActionCommentDelete, c, nil)
+	}
+	s.publishCountChanged()
+	return count, nil
 }
 
 // UpdateStatus 更新评论的状态。
@@ -1203,10 +1329,23 @@ func (s *Service) UpdateStatus(ctx context.Context, publicID string, status int)
 	if err != nil || entityType != idgen.EntityTypeComment {
 		return nil, errors.New("无效的评论ID")
 	}
+	before := s.commentBeforeChange(ctx, dbID)
+	var pending []*model.Comment
+	if s_ == model.StatusPublished {
+		pending = s.approvalNotifyCandidates(ctx, []uint{dbID})
+	}
 	updatedComment, err := s.repo.UpdateStatus(ctx, dbID, s_)
 	if err != nil {
 		return nil, fmt.Errorf("更新评论状态失败: %w", err)
 	}
+	s.auditComment(ctx, model.AuditActionCommentModerate, before, updatedComment)
+	if s_ == model.StatusPublished {
+		s.trustCommenters(ctx, updatedComment)
+		s.markSubscriptionsPending(ctx, updatedComment)
+		s.notifyApproved(ctx, pending)
+	}
+	s.publishStatus(ctx, updatedComment)
+	s.publishCountChanged()
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
 
@@ -1221,10 +1360,12 @@ func (s *Service) SetPin(ctx context.Context, publicID string, isPinned bool) (*
 		now := time.Now()
 		pinTime = &now
 	}
+	before := s.commentBeforeChange(ctx, dbID)
 	updatedComment, err := s.repo.SetPin(ctx, dbID, pinTime)
 	if err != nil {
 		return nil, fmt.Errorf("设置评论置顶状态失败: %w", err)
 	}
+	s.auditComment(ctx, model.AuditActionCommentModerate, before, updatedComment)
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
 
@@ -1247,10 +1388,12 @@ func (s *Service) UpdateContent(ctx context.Context, publicID string, newContent
 	}
 
 	// 更新评论内容
+	before := s.commentBeforeChange(ctx, dbID)
 	updatedComment, err := s.repo.UpdateContent(ctx, dbID, newContent, contentHTML)
 	if err != nil {
 		return nil, fmt.Errorf("更新评论内容失败: %w", err)
 	}
+	s.auditComment(ctx, model.AuditActionCommentModerate, before, updatedComment)
 
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
@@ -1313,10 +1456,12 @@ func (s *Service) UpdateCommentInfo(ctx context.Context, publicID string, req *d
 	}
 
 	// 执行更新
+	before := s.commentBeforeChange(ctx, dbID)
 	updatedComment, err := s.repo.UpdateCommentInfo(ctx, dbID, params)
 	if err != nil {
 		return nil, fmt.Errorf("更新评论信息失败: %w", err)
 	}
+	s.auditComment(ctx, model.AuditActionCommentModerate, before, updatedComment)
 
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
@@ -1327,7 +1472,16 @@ func (s *Service) UpdatePath(ctx context.Context, oldPath, newPath string) (int,
 	if oldPath == "" || newPath == "" || oldPath == newPath {
 		return 0, errors.New("无效的旧路径或新路径")
 	}
-	return s.repo.UpdatePath(ctx, oldPath, newPath)
+	count, err := s.repo.UpdatePath(ctx, oldPath, newPath)
+	if err != nil {
+		return count, err
+	}
+	if s.subscriptionRepo != nil {
+		if _, err := s.subscriptionRepo.UpdatePath(ctx, oldPath, newPath); err != nil {
+			slog.WarnContext(ctx, "迁移评论订阅路径失败", slog.String("old_path", oldPath), slog.String("new_path", newPath), slog.Any("error", err))
+		}
+	}
+	return count, nil
 }
 
 // QQInfoResponse QQ信息API的响应结构
@@ -1357,7 +1511,7 @@ func (s *Service) GetQQInfo(ctx context.Context, qqNumber string, referer string
 	// 调用第三方API
 	resp, err := httpGetQQInfo(apiURL, apiKey, qqNumber, referer)
 	if err != nil {
-		log.Printf("获取QQ信息失败: %v", err)
+		slog.WarnContext(ctx, "获取QQ信息失败", slog.Any("error", err))
 		return nil, fmt.Errorf("获取QQ信息失败: %w", err)
 	}
 
@@ -1382,7 +1536,6 @@ func httpGetQQInfo(apiURL, apiKey, qqNumber, referer string) (*QQInfoResponse, e
 	// 设置 Referer 请求头，用于 NSUUU API 的白名单验证
 	if referer != "" {
 		req.Header.Set("Referer", referer)
-		log.Printf("[QQ信息查询] 设置 Referer 请求头: %s", referer)
 	}
 
 	client := &http.Client{Timeout: 10 * time.Second}
@@ -1402,7 +1555,7 @@ func httpGetQQInfo(apiURL, apiKey, qqNumber, referer string) (*QQInfoResponse, e
 	}
 
 	// 添加调试日志，打印原始 API 响应
-	log.Printf("[DEBUG] QQ API 原始响应: %s", string(body))
+	slog.Debug("QQ 信息接口响应", slog.String("body", string(body)))
 
 	// 解析API响应 - 使用 json.RawMessage 处理 data 字段可能是字符串或对象的情况
 	// API成功返回格式: { code: 200, msg: "Success", data: { nick: "昵称", avatar: "..." }, ... }
@@ -1417,11 +1570,9 @@ func httpGetQQInfo(apiURL, apiKey, qqNumber, referer string) (*QQInfoResponse, e
 		return nil, fmt.Errorf("解析API响应失败: %w", err)
 	}
 
-	log.Printf("[DEBUG] QQ API 解析后 - Code: %d, Msg: %s, Data: %s", baseResp.Code, baseResp.Msg, string(baseResp.Data))
-
 	if baseResp.Code != 200 {
 		// 打印完整的 API 返回内容便于调试
-		log.Printf("[ERROR] QQ API 返回错误，完整响应: %s", string(body))
+		slog.Warn("QQ 信息接口返回错误", slog.Int("code", baseResp.Code), slog.String("body", string(body)))
 		// 尝试解析 data 作为错误信息字符串
 		var dataStr string
 		if json.Unmarshal(baseResp.Data, &dataStr) == nil && dataStr != "" {
@@ -1442,8 +1593,6 @@ func httpGetQQInfo(apiURL, apiKey, qqNumber, referer string) (*QQInfoResponse, e
 		return nil, fmt.Errorf("解析API数据失败: %w", err)
 	}
 
-	log.Printf("[DEBUG] QQ API 解析数据 - Nick: %s, Avatar: %s", dataObj.Nick, dataObj.Avatar)
-
 	// 构建QQ头像URL
 	avatarURL := fmt.Sprintf("https://q.qlogo.cn/headimg_dl?dst_uin=%s&spec=100", qqNumber)
 
@@ -1477,7 +1626,7 @@ func (s *Service) checkAIForbiddenWords(content string, apiURL string, referer s
 	checkContent := content
 	if len([]rune(content)) > maxContentLength {
 		checkContent = string([]rune(content)[:maxContentLength])
-		log.Printf("[AI违禁词检测] 评论内容过长(%d字符)，仅检测前%d字符", len([]rune(content)), maxContentLength)
+		slog.Debug("评论内容过长，仅检测开头部分", slog.Int("length", len([]rune(content))), slog.Int("checked", maxContentLength))
 	}
 
 	// 构建请求URL，对内容进行URL编码
@@ -1492,7 +1641,6 @@ func (s *Service) checkAIForbiddenWords(content string, apiURL string, referer s
 	// 设置 Referer 请求头，用于 NSUUU API 的白名单验证
 	if referer != "" {
 		req.Header.Set("Referer", referer)
-		log.Printf("[AI违禁词检测] 设置 Referer 请求头: %s", referer)
 	}
 
 	// 创建HTTP客户端，设置超时时间
@@ -1526,8 +1674,11 @@ func (s *Service) checkAIForbiddenWords(content string, apiURL string, referer s
 
 	// 记录检测日志
 	if aiResp.Data.IsViolation {
-		log.Printf("AI违禁词检测结果: 检测到违规内容, 风险等级=%s, 类型=%v, 关键词=%v, 说明=%s",
-			aiResp.Data.RiskLevel, aiResp.Data.Categories, aiResp.Data.Keywords, aiResp.Data.Explanation)
+		slog.Info("AI 违禁词检测发现违规内容",
+			slog.String("risk_level", aiResp.Data.RiskLevel),
+			slog.Any("categories", aiResp.Data.Categories),
+			slog.Any("keywords", aiResp.Data.Keywords),
+			slog.String("explanation", aiResp.Data.Explanation))
 	}
 
 	return aiResp.Data.IsViolation, aiResp.Data.RiskLevel, nil
@@ -1583,7 +1734,7 @@ func (s *Service) GetIPLocation(ctx context.Context, clientIP, referer string) (
 	// 调用 GeoIP 服务获取完整位置信息（包含经纬度）
 	result, err := s.geoService.LookupFull(clientIP, referer)
 	if err != nil {
-		log.Printf("[IP定位] 查询失败: IP=%s, 错误=%v", clientIP, err)
+		slog.WarnContext(ctx, "IP 定位查询失败", slog.String("ip", clientIP), slog.Any("error", err))
 		return nil, fmt.Errorf("IP定位查询失败: %w", err)
 	}
 
@@ -0,0 +1,141 @@
+// anheyu-app/pkg/service/comment/spam.go
+package comment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// SpamCandidate 是提交给反垃圾检测器的评论信息
+type SpamCandidate struct {
+	TargetPath string
+	Content    string // Markdown 原文
+	Nickname   string
+	Email      string
+	Website    string
+	IP         string
+	UserAgent  string
+	Referer    string
+}
+
+// SpamDetector 是可插拔的反垃圾评论检测器。
+// Check 返回 true 表示判定为垃圾评论，未启用的检测器应直接返回 false；
+// Feedback 在管理员标记垃圾/正常评论时调用，用于纠正或训练检测器。
+type SpamDetector interface {
+	Name() string
+	Check(ctx context.Context, c *SpamCandidate) (bool, error)
+	Feedback(ctx context.Context, c *SpamCandidate, spam bool) error
+}
+
+// AddSpamDetector 注册反垃圾评论检测器，按注册顺序检测，任一判定为垃圾即停止。
+func (s *Service) AddSpamDetector(detector SpamDetector) {
+	s.spamDetectors = append(s.spamDetectors, detector)
+}
+
+// detectSpam 判断新评论是否为垃圾评论：蜜罐字段被填写或任一检测器判定为垃圾。
+// 检测器出错时记录日志并放行，不影响正常评论。
+func (s *Service) detectSpam(ctx context.Context, c *SpamCandidate, honeypot string) bool {
+	if honeypot != "" && s.settingSvc.GetBool(constant.KeyCommentSpamHoneypotEnable.String()) {
+		slog.InfoContext(ctx, "蜜罐字段被填写，判定为垃圾评论", slog.String("ip", c.IP))
+		return true
+	}
+	for _, detector := range s.spamDetectors {
+		spam, err := detector.Check(ctx, c)
+		if err != nil {
+			slog.WarnContext(ctx, "反垃圾检测失败，跳过", slog.String("detector", detector.Name()), slog.Any("error", err))
+			continue
+		}
+		if spam {
+			slog.InfoContext(ctx, "判定为垃圾评论", slog.String("detector", detector.Name()), slog.String("ip", c.IP))
+			return true
+		}
+	}
+	return false
+}
+
+// MarkSpam 将评论标记为垃圾评论并反馈给各检测器，返回实际更新的数量。
+func (s *Service) MarkSpam(ctx context.Context, ids []string) (int, error) {
+	dbIDs := decodeCommentIDs(ids)
+	if len(dbIDs) == 0 {
+		return 0, fmt.Errorf("未提供任何有效的评论ID: %w", constant.ErrBadRequest)
+	}
+	comments, err := s.repo.FindManyByIDs(ctx, dbIDs)
+	if err != nil {
+		return 0, fmt.Errorf("查询评论失败: %w", err)
+	}
+	count, err := s.repo.UpdateStatusByIDs(ctx, dbIDs, model.StatusSpam)
+	if err != nil {
+		return 0, fmt.Errorf("标记垃圾评论失败: %w", err)
+	}
+	for _, c := range comments {
+		wasPublished := c.IsPublished()
+		c.Status = model.StatusSpam
+		if wasPublished {
+			s.publishStatus(ctx, c)
+		}
+	}
+	s.spamFeedback(ctx, comments, true)
+	s.publishCountChanged()
+	return count, nil
+}
+
+// MarkHam 将评论标记为正常评论并发布，反馈给各检测器，返回实际更新的数量。
+func (s *Service) MarkHam(ctx context.Context, ids []string) (int, error) {
+	dbIDs := decodeCommentIDs(ids)
+	if len(dbIDs) == 0 {
+		return 0, fmt.Errorf("未提供任何有效的评论ID: %w", constant.ErrBadRequest)
+	}
+	pending := s.approvalNotifyCandidates(ctx, dbIDs)
+	count, err := s.repo.UpdateStatusByIDs(ctx, dbIDs, model.StatusPublished)
+	if err != nil {
+		return 0, fmt.Errorf("标记正常评论失败: %w", err)
+	}
+	s.notifyApproved(ctx, pending)
+	s.publishCountChanged()
+	comments, err := s.repo.FindManyByIDs(ctx, dbIDs)
+	if err != nil {
+		slog.WarnContext(ctx, "查询已标记为正常的评论失败，跳过信任标记、实时推送与检测器反馈", slog.Any("error", err))
+		return count, nil
+	}
+	s.trustCommenters(ctx, comments...)
+	s.publishPublished(ctx, comments...)
+	s.markSubscriptionsPending(ctx, comments...)
+	s.spamFeedback(ctx, comments, false)
+	return count, nil
+}
+
+// spamFeedback 把管理员的判定反馈给所有检测器，管理员自己的评论不参与
+func (s *Service) spamFeedback(ctx context.Context, comments []*model.Comment, spam bool) {
+	for _, c := range comments {
+		if c.IsAdminAuthor {
+			continue
+		}
+		candidate := spamCandidateFromComment(c)
+		for _, detector := range s.spamDetectors {
+			if err := detector.Feedback(ctx, candidate, spam); err != nil {
+				slog.WarnContext(ctx, "向反垃圾检测器反馈评论失败", slog.String("detector", detector.Name()), slog.Uint64("comment_id", uint64(c.ID)), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+func spamCandidateFromComment(c *model.Comment) *SpamCandidate {
+	candidate := &SpamCandidate{
+		TargetPath: c.TargetPath,
+		Content:    c.Content,
+		Nickname:   c.Author.Nickname,
+		IP:         c.Author.IP,
+		UserAgent:  c.Author.UserAgent,
+	}
+	if c.Author.Email != nil {
+		candidate.Email = *c.Author.Email
+	}
+	if c.Author.Website != nil {
+		candidate.Website = *c.Author.Website
+	}
+	return candidate
+}
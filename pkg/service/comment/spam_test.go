@@ -0,0 +1,149 @@
+package comment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+type memorySpamTokenRepo struct {
+	counts map[string]model.SpamTokenCount
+}
+
+func (m *memorySpamTokenRepo) Counts(ctx context.Context, tokens []string) (map[string]model.SpamTokenCount, error) {
+	result := make(map[string]model.SpamTokenCount)
+	for _, token := range tokens {
+		if count, ok := m.counts[token]; ok {
+			result[token] = count
+		}
+	}
+	return result, nil
+}
+
+func (m *memorySpamTokenRepo) Train(ctx context.Context, tokens []string, spam bool) error {
+	for _, token := range tokens {
+		count := m.counts[token]
+		if spam {
+			count.Spam++
+		} else {
+			count.Ham++
+		}
+		m.counts[token] = count
+	}
+	return nil
+}
+
+func TestSpamTokens(t *testing.T) {
+	tokens := spamTokens(&SpamCandidate{
+		Content: "Buy CHEAP pills 便宜药品 https://Spam.example.com/x a",
+		Email:   "bot@Mail.example.com",
+		Website: "https://site.example.com",
+	})
+	want := map[string]bool{"host:spam.example.com": true, "site:site.example.com": true, "email:mail.example.com": true, "cheap": true, "便宜": true, "药品": true}
+	got := make(map[string]bool)
+	for _, token := range tokens {
+		got[token] = true
+	}
+	for token := range want {
+		if !got[token] {
+			t.Errorf("缺少词条 %q: %v", token, tokens)
+		}
+	}
+	if got["a"] {
+		t.Errorf("单字母不应作为词条: %v", tokens)
+	}
+}
+
+func TestBayesDetector(t *testing.T) {
+	repo := &memorySpamTokenRepo{counts: make(map[string]model.SpamTokenCount)}
+	settings := &fakeProfileSettings{values: map[string]string{
+		constant.KeyCommentSpamBayesEnable.String():     "true",
+		constant.KeyCommentSpamBayesMinSamples.String(): "3",
+	}}
+	detector := NewBayesDetector(repo, settings)
+	ctx := context.Background()
+
+	spam := &SpamCandidate{Content: "cheap pills casino bonus 点击领取 https://spam.example.com"}
+	if isSpam, _ := detector.Check(ctx, spam); isSpam {
+		t.Fatal("样本不足时不应判定")
+	}
+	for i := 0; i < 3; i++ {
+		_ = detector.Feedback(ctx, &SpamCandidate{Content: "casino bonus cheap pills 点击领取 https://spam.example.com/" + string(rune('a'+i))}, true)
+		_ = detector.Feedback(ctx, &SpamCandidate{Content: "写得很好，学到了 golang generics 的用法"}, false)
+	}
+
+	if isSpam, err := detector.Check(ctx, spam); err != nil || !isSpam {
+		t.Errorf("训练后应判定为垃圾评论: %v %v", isSpam, err)
+	}
+	if isSpam, _ := detector.Check(ctx, &SpamCandidate{Content: "golang generics 学到了"}); isSpam {
+		t.Error("正常评论不应被判定为垃圾")
+	}
+
+	settings.values[constant.KeyCommentSpamBayesEnable.String()] = "false"
+	if isSpam, _ := detector.Check(ctx, spam); isSpam {
+		t.Error("未开启时不应判定")
+	}
+}
+
+func TestAkismetDetector(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		paths = append(paths, r.URL.Path)
+		if r.Form.Get("api_key") != "key" || r.Form.Get("permalink") != "https://blog.example.com/posts/a" {
+			w.Header().Set("X-akismet-debug-help", "bad request")
+			_, _ = w.Write([]byte("invalid"))
+			return
+		}
+		if r.Form.Get("comment_author") == "viagra-test-123" {
+			_, _ = w.Write([]byte("true"))
+			return
+		}
+		_, _ = w.Write([]byte("false"))
+	}))
+	defer server.Close()
+
+	settings := &fakeProfileSettings{values: map[string]string{constant.KeySiteURL.String(): "https://blog.example.com/"}}
+	detector := NewAkismetDetector(settings)
+	detector.endpoint = server.URL
+	ctx := context.Background()
+
+	candidate := &SpamCandidate{TargetPath: "/posts/a", Nickname: "viagra-test-123", Content: "hi"}
+	if isSpam, err := detector.Check(ctx, candidate); isSpam || err != nil || len(paths) != 0 {
+		t.Fatalf("未配置 API Key 时不应请求 Akismet: %v %v", isSpam, err)
+	}
+
+	settings.values[constant.KeyCommentSpamAkismetKey.String()] = "key"
+	if isSpam, err := detector.Check(ctx, candidate); !isSpam || err != nil {
+		t.Errorf("应判定为垃圾评论: %v %v", isSpam, err)
+	}
+	if err := detector.Feedback(ctx, candidate, false); err != nil || paths[len(paths)-1] != "/submit-ham" {
+		t.Errorf("应提交 submit-ham: %v %v", err, paths)
+	}
+
+	settings.values[constant.KeyCommentSpamAkismetKey.String()] = "wrong"
+	if _, err := detector.Check(ctx, candidate); err == nil {
+		t.Error("无效的 API Key 应返回错误")
+	}
+}
+
+func TestDetectSpamHoneypot(t *testing.T) {
+	settings := &fakeProfileSettings{values: map[string]string{}}
+	svc := &Service{settingSvc: settings}
+	ctx := context.Background()
+
+	if svc.detectSpam(ctx, &SpamCandidate{}, "http://bot.example.com") {
+		t.Error("未开启蜜罐时不应判定")
+	}
+	settings.values[constant.KeyCommentSpamHoneypotEnable.String()] = "true"
+	if !svc.detectSpam(ctx, &SpamCandidate{}, "http://bot.example.com") {
+		t.Error("蜜罐字段被填写应判定为垃圾评论")
+	}
+	if svc.detectSpam(ctx, &SpamCandidate{}, "") {
+		t.Error("未填写蜜罐字段不应判定")
+	}
+}
@@ -0,0 +1,244 @@
+// anheyu-app/pkg/service/comment/stream.go
+package comment
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// 实时评论流的事件类型
+const (
+	StreamEventCreated = "comment.created" // 新评论发布（含审核通过）
+	StreamEventStatus  = "comment.status"  // 评论状态变更，如被改回待审核
+	StreamEventLike    = "comment.like"    // 点赞数与表情回应数变化
+)
+
+const (
+	// MaxStreamPaths 单个连接最多同时订阅的路径数
+	MaxStreamPaths = 20
+	// maxStreamPathLen 订阅路径的最大长度
+	maxStreamPathLen = 255
+	// streamBufferSize 每个订阅者的事件缓冲区大小，写满后丢弃新事件，避免慢连接拖慢评论接口
+	streamBufferSize = 32
+	// defaultMaxStreamSubscribers 默认的最大连接数
+	defaultMaxStreamSubscribers = 2000
+)
+
+var (
+	// ErrStreamFull 实时评论连接数已达上限
+	ErrStreamFull = errors.New("实时评论连接数已达上限，请稍后重试")
+	// ErrStreamDisabled 未启用实时评论流
+	ErrStreamDisabled = errors.New("实时评论推送未启用")
+	// ErrStreamPathInvalid 订阅路径无效
+	ErrStreamPathInvalid = errors.New("订阅路径无效")
+	// ErrStreamTooManyPaths 订阅的路径数超出上限
+	ErrStreamTooManyPaths = errors.New("订阅的路径数量超出上限")
+)
+
+// StreamEvent 推送给订阅者的评论事件，只包含公开可见的数据
+type StreamEvent struct {
+	Type      string         `json:"type"`
+	Path      string         `json:"path"`
+	ID        string         `json:"id"`
+	Status    int            `json:"status,omitempty"`
+	LikeCount *int           `json:"likeCount,omitempty"`
+	Reactions map[string]int `json:"reactions,omitempty"`
+	Comment   *dto.Response  `json:"comment,omitempty"`
+}
+
+// StreamHub 按评论目标路径分发实时事件。
+type StreamHub struct {
+	mu     sync.RWMutex
+	byPath map[string]map[*StreamSubscription]struct{}
+	total  int
+	max    int
+}
+
+// NewStreamHub 创建实时评论分发中心，maxSubscribers <= 0 时使用默认上限。
+func NewStreamHub(maxSubscribers int) *StreamHub {
+	if maxSubscribers <= 0 {
+		maxSubscribers = defaultMaxStreamSubscribers
+	}
+	return &StreamHub{
+		byPath: make(map[string]map[*StreamSubscription]struct{}),
+		max:    maxSubscribers,
+	}
+}
+
+// StreamSubscription 是一个连接的订阅，连接断开时必须调用 Close。
+type StreamSubscription struct {
+	hub    *StreamHub
+	events chan *StreamEvent
+	paths  map[string]struct{} // 由 hub.mu 保护
+	closed bool                // 由 hub.mu 保护
+}
+
+// Open 创建一个尚未订阅任何路径的订阅。
+func (h *StreamHub) Open() (*StreamSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.total >= h.max {
+		return nil, ErrStreamFull
+	}
+	h.total++
+	return &StreamSubscription{
+		hub:    h,
+		events: make(chan *StreamEvent, streamBufferSize),
+		paths:  make(map[string]struct{}),
+	}, nil
+}
+
+// Publish 把事件投递给订阅了对应路径的连接，缓冲区已满的连接会丢弃该事件。
+func (h *StreamHub) Publish(ev *StreamEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.byPath[ev.Path] {
+		select {
+		case sub.events <- ev:
+		default:
+		}
+	}
+}
+
+// Events 返回事件通道，订阅关闭后通道随之关闭。
+func (s *StreamSubscription) Events() <-chan *StreamEvent {
+	return s.events
+}
+
+// Subscribe 订阅指定目标路径的评论事件。
+func (s *StreamSubscription) Subscribe(path string) error {
+	path = strings.TrimSpace(path)
+	if path == "" || len(path) > maxStreamPathLen {
+		return ErrStreamPathInvalid
+	}
+	h := s.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	if _, ok := s.paths[path]; ok {
+		return nil
+	}
+	if len(s.paths) >= MaxStreamPaths {
+		return ErrStreamTooManyPaths
+	}
+	s.paths[path] = struct{}{}
+	subs := h.byPath[path]
+	if subs == nil {
+		subs = make(map[*StreamSubscription]struct{})
+		h.byPath[path] = subs
+	}
+	subs[s] = struct{}{}
+	return nil
+}
+
+// Unsubscribe 取消订阅指定目标路径。
+func (s *StreamSubscription) Unsubscribe(path string) {
+	path = strings.TrimSpace(path)
+	h := s.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := s.paths[path]; !ok {
+		return
+	}
+	delete(s.paths, path)
+	h.removeLocked(path, s)
+}
+
+// Close 取消全部订阅并关闭事件通道，可重复调用。
+func (s *StreamSubscription) Close() {
+	h := s.hub
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	for path := range s.paths {
+		h.removeLocked(path, s)
+	}
+	s.paths = nil
+	h.total--
+	close(s.events)
+}
+
+func (h *StreamHub) removeLocked(path string, s *StreamSubscription) {
+	subs := h.byPath[path]
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(h.byPath, path)
+	}
+}
+
+// SetStreamHub 注入实时评论分发中心（可选），注入后评论发布、状态变更与点赞会实时推送给订阅者。
+func (s *Service) SetStreamHub(hub *StreamHub) {
+	s.streamHub = hub
+}
+
+// OpenStream 为一个实时连接创建订阅。
+func (s *Service) OpenStream() (*StreamSubscription, error) {
+	if s.streamHub == nil {
+		return nil, ErrStreamDisabled
+	}
+	return s.streamHub.Open()
+}
+
+// publishStream 推送事件，未注入分发中心时为空操作
+func (s *Service) publishStream(ev *StreamEvent) {
+	if s.streamHub == nil || ev.Path == "" {
+		return
+	}
+	s.streamHub.Publish(ev)
+}
+
+// publishPublished 推送已发布评论的完整公开数据，供前端直接插入到列表中
+func (s *Service) publishPublished(ctx context.Context, comments ...*model.Comment) {
+	if s.streamHub == nil {
+		return
+	}
+	for _, c := range comments {
+		if c == nil || !c.IsPublished() {
+			continue
+		}
+		resp := s.toResponseDTO(ctx, c, nil, nil, false)
+		s.publishStream(&StreamEvent{Type: StreamEventCreated, Path: c.TargetPath, ID: resp.ID, Comment: resp})
+	}
+}
+
+// publishStatus 推送评论状态变更；评论变为已发布时附带完整公开数据
+func (s *Service) publishStatus(ctx context.Context, c *model.Comment) {
+	if s.streamHub == nil || c == nil {
+		return
+	}
+	if c.IsPublished() {
+		s.publishPublished(ctx, c)
+		return
+	}
+	publicID, err := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+	if err != nil {
+		slog.WarnContext(ctx, "生成评论公共ID失败", slog.Uint64("comment_id", uint64(c.ID)), slog.Any("error", err))
+		return
+	}
+	s.publishStream(&StreamEvent{Type: StreamEventStatus, Path: c.TargetPath, ID: publicID, Status: int(c.Status)})
+}
+
+// publishReaction 推送点赞数与表情回应数变化
+func (s *Service) publishReaction(c *model.Comment) {
+	if s.streamHub == nil || c == nil {
+		return
+	}
+	publicID, err := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+	if err != nil {
+		return
+	}
+	count := c.LikeCount
+	s.publishStream(&StreamEvent{Type: StreamEventLike, Path: c.TargetPath, ID: publicID, LikeCount: &count, Reactions: c.ReactionCounts})
+}
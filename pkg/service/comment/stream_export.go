@@ -0,0 +1,107 @@
+// anheyu-app/pkg/service/comment/stream_export.go
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// commentExportBatchSize 流式导出时每批从数据库读取的评论数
+const commentExportBatchSize = 500
+
+// CommentCSVHeader 评论以 CSV 格式导出时的表头，与 ExportCommentItem.CSVRecord 的字段顺序一致
+var CommentCSVHeader = []string{
+	"id", "created_at", "target_path", "target_title", "nickname", "email", "website",
+	"ip_address", "ip_location", "parent_id", "reply_to_id", "status",
+	"is_admin_comment", "is_anonymous", "like_count", "content",
+}
+
+// CSVRecord 返回评论的 CSV 行
+func (c ExportCommentItem) CSVRecord() []string {
+	return []string{
+		c.ID,
+		c.CreatedAt.Format(time.RFC3339),
+		c.TargetPath,
+		c.TargetTitle,
+		c.Nickname,
+		c.Email,
+		c.Website,
+		c.IPAddress,
+		c.IPLocation,
+		c.ParentID,
+		c.ReplyToID,
+		strconv.Itoa(c.Status),
+		strconv.FormatBool(c.IsAdminComment),
+		strconv.FormatBool(c.IsAnonymous),
+		strconv.Itoa(c.LikeCount),
+		c.Content,
+	}
+}
+
+// StreamComments 按ID升序分批读取所有未删除的评论并逐条交给 emit，内存占用只与批大小有关。
+// emit 返回错误（例如客户端断开）时立即停止读取。
+func (s *Service) StreamComments(ctx context.Context, emit func(item ExportCommentItem) error) error {
+	var afterID uint
+	for {
+		comments, err := s.repo.FindAfterID(ctx, afterID, commentExportBatchSize)
+		if err != nil {
+			return fmt.Errorf("获取评论失败: %w", err)
+		}
+		for _, comment := range comments {
+			publicID, _ := idgen.GeneratePublicID(comment.ID, idgen.EntityTypeComment)
+			if err := emit(newExportCommentItem(comment, publicID)); err != nil {
+				return err
+			}
+			afterID = comment.ID
+		}
+		if len(comments) < commentExportBatchSize {
+			return nil
+		}
+	}
+}
+
+// ImportCommentsFromNDJSON 逐行读取 NDJSON（每行一条 ExportCommentItem）并导入，不需要把整个文件读入内存。
+// 流式导出的评论按ID升序排列，父评论总是先于回复出现；少数父评论在后面的回复会暂存到读取结束后再导入。
+func (s *Service) ImportCommentsFromNDJSON(ctx context.Context, r io.Reader, req *ImportCommentRequest) (*ImportCommentResult, error) {
+	result := &ImportCommentResult{Errors: make([]string, 0)}
+	idMapping := make(map[string]uint)
+	var pending []ExportCommentItem
+
+	decoder := json.NewDecoder(r)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var item ExportCommentItem
+		if err := decoder.Decode(&item); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("解析第 %d 条评论失败: %w", result.TotalCount+1, err)
+		}
+		result.TotalCount++
+		if item.ParentID != "" {
+			if _, ok := idMapping[item.ParentID]; !ok {
+				pending = append(pending, item)
+				continue
+			}
+		}
+		s.importAndRecord(ctx, item, idMapping, req, result)
+	}
+	s.importChildComments(ctx, pending, idMapping, req, result)
+
+	slog.InfoContext(ctx, "NDJSON 评论导入完成",
+		slog.Int("total", result.TotalCount),
+		slog.Int("success", result.SuccessCount),
+		slog.Int("skipped", result.SkippedCount),
+		slog.Int("failed", result.FailedCount))
+	return result, nil
+}
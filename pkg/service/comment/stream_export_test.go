@@ -0,0 +1,84 @@
+package comment
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+type fakeExportRepo struct {
+	repository.CommentRepository
+	comments []*model.Comment
+	queries  int
+}
+
+func (f *fakeExportRepo) FindAfterID(ctx context.Context, afterID uint, limit int) ([]*model.Comment, error) {
+	f.queries++
+	var batch []*model.Comment
+	for _, c := range f.comments {
+		if c.ID > afterID && len(batch) < limit {
+			batch = append(batch, c)
+		}
+	}
+	return batch, nil
+}
+
+func (f *fakeExportRepo) Create(ctx context.Context, params *repository.CreateCommentParams) (*model.Comment, error) {
+	c := &model.Comment{ID: uint(len(f.comments) + 1), ParentID: params.ParentID, Content: params.Content}
+	f.comments = append(f.comments, c)
+	return c, nil
+}
+
+func TestStreamComments(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("comment-export-test"); err != nil {
+		t.Fatal(err)
+	}
+	repo := &fakeExportRepo{}
+	for i := 1; i <= commentExportBatchSize+1; i++ {
+		repo.comments = append(repo.comments, &model.Comment{ID: uint(i)})
+	}
+	s := &Service{repo: repo}
+
+	var count int
+	if err := s.StreamComments(context.Background(), func(item ExportCommentItem) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if count != commentExportBatchSize+1 || repo.queries != 2 {
+		t.Fatalf("应分两批导出全部评论，导出 %d 条，查询 %d 次", count, repo.queries)
+	}
+}
+
+func TestImportCommentsFromNDJSON(t *testing.T) {
+	// 回复出现在父评论之前时应暂存，读取结束后再导入并关联到父评论
+	input := strings.Join([]string{
+		`{"id":"reply","parent_id":"root","content":"reply","target_path":"/a","nickname":"b"}`,
+		`{"id":"root","content":"root","target_path":"/a","nickname":"a"}`,
+		`{"id":"child","parent_id":"root","content":"child","target_path":"/a","nickname":"c"}`,
+	}, "\n")
+	repo := &fakeExportRepo{}
+	s := &Service{repo: repo}
+
+	result, err := s.ImportCommentsFromNDJSON(context.Background(), strings.NewReader(input), &ImportCommentRequest{DefaultStatus: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalCount != 3 || result.SuccessCount != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for _, c := range repo.comments {
+		if c.Content != "root" && (c.ParentID == nil || *c.ParentID != repo.comments[0].ID) {
+			t.Errorf("评论 %q 应关联到父评论", c.Content)
+		}
+	}
+
+	if _, err := s.ImportCommentsFromNDJSON(context.Background(), strings.NewReader("{bad"), &ImportCommentRequest{}); err == nil {
+		t.Error("格式错误的行应返回错误")
+	}
+}
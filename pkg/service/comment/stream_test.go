@@ -0,0 +1,78 @@
+package comment
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStreamHub_DeliversByPath(t *testing.T) {
+	hub := NewStreamHub(2)
+	a, _ := hub.Open()
+	b, _ := hub.Open()
+	if _, err := hub.Open(); !errors.Is(err, ErrStreamFull) {
+		t.Fatalf("expected ErrStreamFull, got %v", err)
+	}
+	if err := a.Subscribe("/posts/a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Subscribe(" /posts/b "); err != nil {
+		t.Fatal(err)
+	}
+
+	hub.Publish(&StreamEvent{Type: StreamEventCreated, Path: "/posts/b", ID: "x"})
+	select {
+	case ev := <-b.Events():
+		if ev.ID != "x" {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+	default:
+		t.Fatal("expected subscriber of /posts/b to receive the event")
+	}
+	select {
+	case ev := <-a.Events():
+		t.Fatalf("subscriber of /posts/a should not receive %+v", ev)
+	default:
+	}
+
+	// 缓冲区写满后丢弃事件而不是阻塞
+	for i := 0; i < streamBufferSize+5; i++ {
+		hub.Publish(&StreamEvent{Type: StreamEventLike, Path: "/posts/a"})
+	}
+	if got := len(a.Events()); got != streamBufferSize {
+		t.Fatalf("expected buffer to hold %d events, got %d", streamBufferSize, got)
+	}
+
+	b.Unsubscribe("/posts/b")
+	hub.Publish(&StreamEvent{Type: StreamEventCreated, Path: "/posts/b"})
+	if len(b.Events()) != 0 {
+		t.Fatal("unsubscribed path should not deliver events")
+	}
+
+	b.Close()
+	b.Close()
+	if _, ok := <-b.Events(); ok {
+		t.Fatal("expected events channel to be closed")
+	}
+	if _, err := hub.Open(); err != nil {
+		t.Fatalf("expected slot to be released after Close, got %v", err)
+	}
+}
+
+func TestStreamSubscription_PathLimits(t *testing.T) {
+	sub, _ := NewStreamHub(0).Open()
+	defer sub.Close()
+	if err := sub.Subscribe("  "); !errors.Is(err, ErrStreamPathInvalid) {
+		t.Fatalf("expected ErrStreamPathInvalid, got %v", err)
+	}
+	for i := 0; i < MaxStreamPaths; i++ {
+		if err := sub.Subscribe("/posts/" + string(rune('a'+i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sub.Subscribe("/posts/a"); err != nil {
+		t.Fatalf("re-subscribing an existing path should succeed, got %v", err)
+	}
+	if err := sub.Subscribe("/posts/overflow"); !errors.Is(err, ErrStreamTooManyPaths) {
+		t.Fatalf("expected ErrStreamTooManyPaths, got %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+// anheyu-app/pkg/service/comment/subscription.go
+package comment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// digestBatchSize 每轮最多处理的到期订阅数，其余留到下一轮，避免一次性发出大量邮件
+	digestBatchSize = 100
+	// maxDigestComments 单封摘要邮件最多列出的评论数，只保留最新的部分
+	maxDigestComments = 20
+	// defaultDigestInterval 未配置或配置无效时两封摘要邮件的最短间隔
+	defaultDigestInterval = 60 * time.Minute
+	// unsubscribeLinkTTL 摘要邮件中退订链接的有效期，每封邮件都会签发新的链接
+	unsubscribeLinkTTL = 90 * 24 * time.Hour
+)
+
+// SubscriptionSigner 为退订链接签名与验签，由 auth.TokenService 实现
+type SubscriptionSigner interface {
+	GenerateSignedToken(identifier string, duration time.Duration) (string, error)
+	VerifySignedToken(identifier, sign string) error
+}
+
+// SetSubscriptionRepo 注入评论订阅仓库与退订链接签名器（可选）。
+// 未注入时订阅接口不可用，发布评论也不会记录待发送的摘要；摘要邮件通过 SetEmailService 注入的邮件服务发送。
+func (s *Service) SetSubscriptionRepo(repo repository.CommentSubscriptionRepository, signer SubscriptionSigner) {
+	s.subscriptionRepo = repo
+	s.subscriptionSigner = signer
+}
+
+// SetEmailService 注入邮件服务（可选），用于订阅摘要与 @ 提及通知邮件
+func (s *Service) SetEmailService(emailSvc utility.EmailService) {
+	s.emailSvc = emailSvc
+}
+
+// Subscribe 订阅评论区，之后发布的评论会按摘要邮件通知订阅者。重复订阅是幂等的。
+func (s *Service) Subscribe(ctx context.Context, targetPath, email string) error {
+	if s.subscriptionRepo == nil || !s.settingSvc.GetBool(constant.KeyCommentSubscribeEnable.String()) {
+		return fmt.Errorf("评论订阅未开启: %w", constant.ErrForbidden)
+	}
+	targetPath = strings.TrimSpace(targetPath)
+	if !strings.HasPrefix(targetPath, "/") || strings.ContainsAny(targetPath, "?#") {
+		return fmt.Errorf("无效的评论区路径: %w", constant.ErrBadRequest)
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return fmt.Errorf("无效的邮箱地址: %w", constant.ErrBadRequest)
+	}
+	for _, guard := range s.targetGuards {
+		if err := guard(ctx, targetPath); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.subscriptionRepo.Subscribe(ctx, targetPath, email, time.Now()); err != nil {
+		return fmt.Errorf("订阅评论失败: %w", err)
+	}
+	return nil
+}
+
+// Unsubscribe 通过摘要邮件中的签名链接退订
+func (s *Service) Unsubscribe(ctx context.Context, id uint, sign string) error {
+	if s.subscriptionRepo == nil || s.subscriptionSigner == nil {
+		return fmt.Errorf("评论订阅未开启: %w", constant.ErrForbidden)
+	}
+	if err := s.subscriptionSigner.VerifySignedToken(subscriptionIdentifier(id), sign); err != nil {
+		return fmt.Errorf("退订链接无效或已过期: %w", constant.ErrBadRequest)
+	}
+	if _, err := s.subscriptionRepo.FindByID(ctx, id); err != nil {
+		return fmt.Errorf("订阅不存在: %w", constant.ErrNotFound)
+	}
+	return s.subscriptionRepo.Deactivate(ctx, id)
+}
+
+// markSubscriptionsPending 有评论发布时标记对应评论区的订阅有待发送的摘要
+func (s *Service) markSubscriptionsPending(ctx context.Context, comments ...*model.Comment) {
+	if s.subscriptionRepo == nil {
+		return
+	}
+	now := time.Now()
+	marked := make(map[string]bool)
+	for _, c := range comments {
+		if c == nil || !c.IsPublished() || marked[c.TargetPath] {
+			continue
+		}
+		marked[c.TargetPath] = true
+		if _, err := s.subscriptionRepo.MarkPending(ctx, c.TargetPath, now); err != nil {
+			slog.WarnContext(ctx, "标记评论订阅失败", slog.String("path", c.TargetPath), slog.Any("error", err))
+		}
+	}
+}
+
+// SendSubscriptionDigests 为到期的订阅发送摘要邮件，返回发出的邮件数，由后台定时任务调用。
+// 同一订阅两封邮件之间至少间隔 comment.subscribe.digest_interval 分钟，间隔内的新评论合并到下一封；
+// 订阅者自己发表的评论不会出现在摘要中。
+func (s *Service) SendSubscriptionDigests(ctx context.Context) (int, error) {
+	if s.subscriptionRepo == nil || s.emailSvc == nil || !s.settingSvc.GetBool(constant.KeyCommentSubscribeEnable.String()) {
+		return 0, nil
+	}
+	interval := defaultDigestInterval
+	if minutes, err := strconv.Atoi(s.settingSvc.Get(constant.KeyCommentSubscribeDigestInterval.String())); err == nil && minutes > 0 {
+		interval = time.Duration(minutes) * time.Minute
+	}
+
+	now := time.Now()
+	due, err := s.subscriptionRepo.ListDue(ctx, now.Add(-interval), digestBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询待发送的评论订阅失败: %w", err)
+	}
+
+	byPath := make(map[string][]*model.Comment)
+	sent := 0
+	for _, sub := range due {
+		comments, ok := byPath[sub.TargetPath]
+		if !ok {
+			comments, err = s.repo.FindAllPublishedByPath(ctx, sub.TargetPath)
+			if err != nil {
+				slog.WarnContext(ctx, "查询订阅路径的评论失败", slog.String("path", sub.TargetPath), slog.Any("error", err))
+				continue
+			}
+			sort.Slice(comments, func(i, j int) bool { return comments[i].CreatedAt.Before(comments[j].CreatedAt) })
+			byPath[sub.TargetPath] = comments
+		}
+
+		digest, cursor := digestComments(comments, sub)
+		if len(digest) == 0 {
+			// 新评论都是订阅者自己发的，或已被删除
+			if err := s.subscriptionRepo.MarkSent(ctx, sub.ID, cursor, nil); err != nil {
+				slog.WarnContext(ctx, "更新评论订阅失败", slog.Uint64("subscription_id", uint64(sub.ID)), slog.Any("error", err))
+			}
+			continue
+		}
+
+		unsubscribeURL, err := s.unsubscribeURL(sub.ID)
+		if err != nil {
+			slog.WarnContext(ctx, "生成退订链接失败", slog.Uint64("subscription_id", uint64(sub.ID)), slog.Any("error", err))
+			continue
+		}
+		if err := s.emailSvc.SendCommentDigestEmail(ctx, sub.Email, unsubscribeURL, digest); err != nil {
+			slog.ErrorContext(ctx, "发送订阅摘要邮件失败", slog.Uint64("subscription_id", uint64(sub.ID)), slog.Any("error", err))
+			continue
+		}
+		if err := s.subscriptionRepo.MarkSent(ctx, sub.ID, cursor, &now); err != nil {
+			slog.WarnContext(ctx, "更新评论订阅失败", slog.Uint64("subscription_id", uint64(sub.ID)), slog.Any("error", err))
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// digestComments 返回订阅游标之后他人发表的评论（最多 maxDigestComments 条）以及新的游标
+func digestComments(comments []*model.Comment, sub *model.CommentSubscription) ([]*model.Comment, time.Time) {
+	cursor := sub.NotifiedUntil
+	var digest []*model.Comment
+	for _, c := range comments {
+		if !c.CreatedAt.After(sub.NotifiedUntil) {
+			continue
+		}
+		if c.CreatedAt.After(cursor) {
+			cursor = c.CreatedAt
+		}
+		if c.Author.Email != nil && strings.EqualFold(*c.Author.Email, sub.Email) {
+			continue
+		}
+		digest = append(digest, c)
+	}
+	if len(digest) > maxDigestComments {
+		digest = digest[len(digest)-maxDigestComments:]
+	}
+	return digest, cursor
+}
+
+// unsubscribeURL 生成带签名的退订链接
+func (s *Service) unsubscribeURL(id uint) (string, error) {
+	sign, err := s.subscriptionSigner.GenerateSignedToken(subscriptionIdentifier(id), unsubscribeLinkTTL)
+	if err != nil {
+		return "", err
+	}
+	siteURL := strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	return fmt.Sprintf("%s/api/public/comments/subscriptions/%d/unsubscribe?sign=%s", siteURL, id, url.QueryEscape(sign)), nil
+}
+
+func subscriptionIdentifier(id uint) string {
+	return fmt.Sprintf("comment_subscription:%d", id)
+}
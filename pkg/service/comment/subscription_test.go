@@ -0,0 +1,149 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeSubscriptionRepo struct {
+	repository.CommentSubscriptionRepository
+	subscribed []string
+	due        []*model.CommentSubscription
+	sent       map[uint]time.Time
+	sentMailAt map[uint]bool
+}
+
+func (f *fakeSubscriptionRepo) Subscribe(ctx context.Context, targetPath, email string, now time.Time) (*model.CommentSubscription, error) {
+	f.subscribed = append(f.subscribed, targetPath+"|"+email)
+	return &model.CommentSubscription{ID: 1, TargetPath: targetPath, Email: email, IsActive: true, NotifiedUntil: now}, nil
+}
+
+func (f *fakeSubscriptionRepo) ListDue(ctx context.Context, sentBefore time.Time, limit int) ([]*model.CommentSubscription, error) {
+	return f.due, nil
+}
+
+func (f *fakeSubscriptionRepo) MarkSent(ctx context.Context, id uint, notifiedUntil time.Time, sentAt *time.Time) error {
+	f.sent[id] = notifiedUntil
+	f.sentMailAt[id] = sentAt != nil
+	return nil
+}
+
+type fakePathCommentRepo struct {
+	repository.CommentRepository
+	comments []*model.Comment
+}
+
+func (f *fakePathCommentRepo) FindAllPublishedByPath(ctx context.Context, path string) ([]*model.Comment, error) {
+	return f.comments, nil
+}
+
+type fakeDigestEmail struct {
+	utility.EmailService
+	to       []string
+	links    []string
+	comments [][]*model.Comment
+}
+
+func (f *fakeDigestEmail) SendCommentDigestEmail(ctx context.Context, toEmail, unsubscribeURL string, comments []*model.Comment) error {
+	f.to = append(f.to, toEmail)
+	f.links = append(f.links, unsubscribeURL)
+	f.comments = append(f.comments, comments)
+	return nil
+}
+
+type fakeSigner struct{}
+
+func (fakeSigner) GenerateSignedToken(identifier string, duration time.Duration) (string, error) {
+	return "sig-" + identifier, nil
+}
+
+func (fakeSigner) VerifySignedToken(identifier, sign string) error {
+	if sign != "sig-"+identifier {
+		return errors.New("invalid sign")
+	}
+	return nil
+}
+
+func TestSubscribeValidation(t *testing.T) {
+	settings := &fakeProfileSettings{values: map[string]string{}}
+	repo := &fakeSubscriptionRepo{}
+	svc := &Service{settingSvc: settings}
+	svc.SetSubscriptionRepo(repo, fakeSigner{})
+	svc.SetEmailService(&fakeDigestEmail{})
+	ctx := context.Background()
+
+	if err := svc.Subscribe(ctx, "/posts/a", "a@example.com"); !errors.Is(err, constant.ErrForbidden) {
+		t.Fatalf("未开启时应返回 ErrForbidden: %v", err)
+	}
+	settings.values[constant.KeyCommentSubscribeEnable.String()] = "true"
+	if err := svc.Subscribe(ctx, "posts/a", "a@example.com"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("相对路径应返回 ErrBadRequest: %v", err)
+	}
+	if err := svc.Subscribe(ctx, "/posts/a", "Alice <a@example.com>"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("带显示名的地址应返回 ErrBadRequest: %v", err)
+	}
+	if err := svc.Subscribe(ctx, "/posts/a", " A@Example.com "); err != nil {
+		t.Fatalf("订阅失败: %v", err)
+	}
+	if len(repo.subscribed) != 1 || repo.subscribed[0] != "/posts/a|a@example.com" {
+		t.Errorf("邮箱应被规范化: %v", repo.subscribed)
+	}
+}
+
+func TestSendSubscriptionDigests(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	email := func(s string) *string { return &s }
+	comments := []*model.Comment{
+		{ID: 3, TargetPath: "/posts/a", Author: model.Author{Nickname: "Bob", Email: email("bob@example.com")}, CreatedAt: base.Add(3 * time.Minute)},
+		{ID: 1, TargetPath: "/posts/a", Author: model.Author{Nickname: "Old"}, CreatedAt: base.Add(-time.Minute)},
+		{ID: 2, TargetPath: "/posts/a", Author: model.Author{Nickname: "Alice", Email: email("Alice@example.com")}, CreatedAt: base.Add(2 * time.Minute)},
+	}
+	repo := &fakeSubscriptionRepo{
+		due: []*model.CommentSubscription{
+			{ID: 7, TargetPath: "/posts/a", Email: "alice@example.com", NotifiedUntil: base},
+			{ID: 8, TargetPath: "/posts/a", Email: "bob@example.com", NotifiedUntil: base.Add(2 * time.Minute)},
+		},
+		sent:       make(map[uint]time.Time),
+		sentMailAt: make(map[uint]bool),
+	}
+	mailer := &fakeDigestEmail{}
+	settings := &fakeProfileSettings{values: map[string]string{
+		constant.KeyCommentSubscribeEnable.String(): "true",
+		constant.KeySiteURL.String():                "https://blog.example.com/",
+	}}
+	svc := &Service{repo: &fakePathCommentRepo{comments: comments}, settingSvc: settings}
+	svc.SetSubscriptionRepo(repo, fakeSigner{})
+	svc.SetEmailService(mailer)
+
+	sent, err := svc.SendSubscriptionDigests(context.Background())
+	if err != nil {
+		t.Fatalf("发送摘要失败: %v", err)
+	}
+	if sent != 1 || len(mailer.to) != 1 || mailer.to[0] != "alice@example.com" {
+		t.Fatalf("只应给 alice 发送一封摘要: sent=%d to=%v", sent, mailer.to)
+	}
+	if got := mailer.comments[0]; len(got) != 1 || got[0].ID != 3 {
+		t.Errorf("摘要应排除订阅者自己和游标之前的评论: %+v", got)
+	}
+	if !strings.HasPrefix(mailer.links[0], "https://blog.example.com/api/public/comments/subscriptions/7/unsubscribe?sign=") {
+		t.Errorf("退订链接错误: %s", mailer.links[0])
+	}
+	if !repo.sent[7].Equal(base.Add(3*time.Minute)) || !repo.sentMailAt[7] {
+		t.Errorf("alice 的游标应推进到最新评论: %v", repo.sent[7])
+	}
+	if !repo.sent[8].Equal(base.Add(3*time.Minute)) || repo.sentMailAt[8] {
+		t.Errorf("只有自己评论的订阅应推进游标但不记录发送时间: %v %v", repo.sent[8], repo.sentMailAt[8])
+	}
+
+	if err := svc.Unsubscribe(context.Background(), 7, "forged"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("伪造的签名应被拒绝: %v", err)
+	}
+}
@@ -0,0 +1,281 @@
+// anheyu-app/pkg/service/comment/word_filter.go
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+)
+
+const (
+	wordRuleKeyword  = "keyword"
+	wordRuleWildcard = "wildcard"
+	wordRuleRegex    = "regex"
+
+	wordActionPass    = "pass"
+	wordActionPending = "pending"
+	wordActionReject  = "reject"
+	wordActionReplace = "replace"
+
+	// wordReplacement 是 replace 动作替换命中内容使用的字符串
+	wordReplacement = "***"
+	// maxWordRules 单次保存的规则数量上限
+	maxWordRules = 2000
+)
+
+// wordActionPriority 多条规则同时命中时，以优先级最高的动作作为最终结果
+var wordActionPriority = map[string]int{
+	wordActionPass:    0,
+	wordActionReplace: 1,
+	wordActionPending: 2,
+	wordActionReject:  3,
+}
+
+type compiledWordRule struct {
+	rule dto.WordRule
+	re   *regexp.Regexp
+}
+
+// wordFilter 是编译后的违禁词规则集合
+type wordFilter struct {
+	rules []compiledWordRule
+}
+
+// wordFilterCache 按配置原文缓存编译结果，配置变更后首次使用时重新编译
+type wordFilterCache struct {
+	mu     sync.Mutex
+	raw    string
+	filter *wordFilter
+}
+
+// get 返回配置原文对应的规则集合。配置无法解析时记录日志并退化为空规则，不影响评论提交
+func (c *wordFilterCache) get(raw string) *wordFilter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.filter != nil && c.raw == raw {
+		return c.filter
+	}
+	filter := &wordFilter{}
+	rules, err := parseWordRules(raw)
+	if err == nil {
+		filter, err = compileWordRules(rules)
+	}
+	if err != nil {
+		slog.Warn("评论违禁词规则无效，已忽略", slog.Any("error", err))
+		filter = &wordFilter{}
+	}
+	c.raw, c.filter = raw, filter
+	return filter
+}
+
+// parseWordRules 解析违禁词配置。JSON 数组为规则列表；
+// 兼容旧版逗号分隔的关键词列表，每个词视为动作为 pending 的关键词规则。
+func parseWordRules(raw string) ([]dto.WordRule, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(raw, "[") {
+		var rules []dto.WordRule
+		if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+			return nil, fmt.Errorf("违禁词规则格式错误: %w", err)
+		}
+		return rules, nil
+	}
+	var rules []dto.WordRule
+	for _, word := range strings.Split(raw, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			rules = append(rules, dto.WordRule{Pattern: word, Type: wordRuleKeyword, Action: wordActionPending})
+		}
+	}
+	return rules, nil
+}
+
+// compileWordRules 补全默认值并编译规则，任一规则无效时返回带下标的错误
+func compileWordRules(rules []dto.WordRule) (*wordFilter, error) {
+	if len(rules) > maxWordRules {
+		return nil, fmt.Errorf("违禁词规则不能超过 %d 条: %w", maxWordRules, constant.ErrBadRequest)
+	}
+	filter := &wordFilter{rules: make([]compiledWordRule, 0, len(rules))}
+	for i, rule := range rules {
+		rule = normalizeWordRule(rule)
+		if rule.Pattern == "" {
+			return nil, fmt.Errorf("第 %d 条规则的模式为空: %w", i+1, constant.ErrBadRequest)
+		}
+		var expr string
+		switch rule.Type {
+		case wordRuleKeyword:
+			expr = "(?i)" + regexp.QuoteMeta(rule.Pattern)
+		case wordRuleWildcard:
+			expr = "(?i)" + wildcardToRegexp(rule.Pattern)
+		case wordRuleRegex:
+			expr = rule.Pattern
+		default:
+			return nil, fmt.Errorf("第 %d 条规则的类型 '%s' 无效: %w", i+1, rule.Type, constant.ErrBadRequest)
+		}
+		if _, ok := wordActionPriority[rule.Action]; !ok || rule.Action == wordActionPass {
+			return nil, fmt.Errorf("第 %d 条规则的动作 '%s' 无效: %w", i+1, rule.Action, constant.ErrBadRequest)
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("第 %d 条规则的正则表达式无效: %v: %w", i+1, err, constant.ErrBadRequest)
+		}
+		if re.MatchString("") {
+			return nil, fmt.Errorf("第 %d 条规则会匹配空内容: %w", i+1, constant.ErrBadRequest)
+		}
+		filter.rules = append(filter.rules, compiledWordRule{rule: rule, re: re})
+	}
+	return filter, nil
+}
+
+// normalizeWordRule 去除首尾空白并补全默认的类型与动作
+func normalizeWordRule(rule dto.WordRule) dto.WordRule {
+	rule.Pattern = strings.TrimSpace(rule.Pattern)
+	rule.Remark = strings.TrimSpace(rule.Remark)
+	if rule.Type == "" {
+		rule.Type = wordRuleKeyword
+	}
+	if rule.Action == "" {
+		rule.Action = wordActionPending
+	}
+	return rule
+}
+
+// wildcardToRegexp 将通配符模式转换为正则：* 匹配任意字符，? 匹配单个字符
+func wildcardToRegexp(pattern string) string {
+	var b strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*?")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// apply 对内容执行全部规则，返回最终动作、应用 replace 规则后的内容以及命中明细
+func (f *wordFilter) apply(content string) (string, string, []*dto.WordRuleHit) {
+	action := wordActionPass
+	filtered := content
+	var hits []*dto.WordRuleHit
+	for i, compiled := range f.rules {
+		matches := compiled.re.FindAllString(content, -1)
+		if len(matches) == 0 {
+			continue
+		}
+		hits = append(hits, &dto.WordRuleHit{Index: i, Rule: compiled.rule, Matches: uniqueStrings(matches)})
+		if wordActionPriority[compiled.rule.Action] > wordActionPriority[action] {
+			action = compiled.rule.Action
+		}
+		if compiled.rule.Action == wordActionReplace {
+			filtered = compiled.re.ReplaceAllLiteralString(filtered, wordReplacement)
+		}
+	}
+	return action, filtered, hits
+}
+
+func uniqueStrings(items []string) []string {
+	seen := make(map[string]struct{}, len(items))
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		result = append(result, item)
+	}
+	return result
+}
+
+// currentWordFilter 返回当前配置的违禁词规则
+func (s *Service) currentWordFilter() *wordFilter {
+	return s.wordFilters.get(s.settingSvc.Get(constant.KeyCommentForbiddenWords.String()))
+}
+
+// ExportWordRules 导出当前的违禁词规则（旧版逗号分隔的配置会转换为规则列表）
+func (s *Service) ExportWordRules(ctx context.Context) ([]dto.WordRule, error) {
+	rules, err := parseWordRules(s.settingSvc.Get(constant.KeyCommentForbiddenWords.String()))
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		rules[i] = normalizeWordRule(rules[i])
+	}
+	if rules == nil {
+		rules = []dto.WordRule{}
+	}
+	return rules, nil
+}
+
+// ImportWordRules 导入违禁词规则并以 JSON 形式保存，返回保存后的规则总数。
+// 追加模式下，与现有规则类型和模式都相同的规则会覆盖原规则的动作与备注。
+func (s *Service) ImportWordRules(ctx context.Context, req *dto.WordRuleImportRequest) (int, error) {
+	var rules []dto.WordRule
+	if !req.Replace {
+		existing, err := s.ExportWordRules(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rules = existing
+	}
+	index := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		index[rule.Type+"\x00"+rule.Pattern] = i
+	}
+	for _, rule := range req.Rules {
+		rule = normalizeWordRule(rule)
+		key := rule.Type + "\x00" + rule.Pattern
+		if i, ok := index[key]; ok {
+			rules[i] = rule
+			continue
+		}
+		index[key] = len(rules)
+		rules = append(rules, rule)
+	}
+
+	if _, err := compileWordRules(rules); err != nil {
+		return 0, err
+	}
+	if rules == nil {
+		rules = []dto.WordRule{}
+	}
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return 0, fmt.Errorf("序列化违禁词规则失败: %w", err)
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{
+		constant.KeyCommentForbiddenWords.String(): string(data),
+	}); err != nil {
+		return 0, fmt.Errorf("保存违禁词规则失败: %w", err)
+	}
+	return len(rules), nil
+}
+
+// TestWordRules 预览一段评论内容会命中哪些规则以及最终的处理结果，不会保存任何数据。
+// 请求中携带规则时使用请求中的规则，便于在保存前验证。
+func (s *Service) TestWordRules(ctx context.Context, req *dto.WordRuleTestRequest) (*dto.WordRuleTestResponse, error) {
+	filter := s.currentWordFilter()
+	if len(req.Rules) > 0 {
+		compiled, err := compileWordRules(req.Rules)
+		if err != nil {
+			return nil, err
+		}
+		filter = compiled
+	}
+	action, content, hits := filter.apply(req.Content)
+	if hits == nil {
+		hits = []*dto.WordRuleHit{}
+	}
+	return &dto.WordRuleTestResponse{Action: action, Content: content, Hits: hits}, nil
+}
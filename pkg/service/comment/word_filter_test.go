@@ -0,0 +1,58 @@
+package comment
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+)
+
+func TestParseWordRules_LegacyCommaList(t *testing.T) {
+	rules, err := parseWordRules(" 空包, ,代发 ")
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if len(rules) != 2 || rules[0].Pattern != "空包" || rules[1].Action != wordActionPending || rules[1].Type != wordRuleKeyword {
+		t.Fatalf("旧版关键词应转换为 pending 关键词规则: %+v", rules)
+	}
+}
+
+func TestWordFilterApply(t *testing.T) {
+	filter, err := compileWordRules([]dto.WordRule{
+		{Pattern: "SPAM"},
+		{Pattern: "傻*瓜", Type: wordRuleWildcard, Action: wordActionReplace},
+		{Pattern: `1[3-9]\d{9}`, Type: wordRuleRegex, Action: wordActionReplace},
+	})
+	if err != nil {
+		t.Fatalf("编译失败: %v", err)
+	}
+
+	action, content, hits := filter.apply("你这个傻大瓜，联系 13800138000")
+	if action != wordActionReplace || content != "你这个***，联系 ***" || len(hits) != 2 {
+		t.Errorf("替换结果错误: %s %q %+v", action, content, hits)
+	}
+
+	action, _, hits = filter.apply("buy spam here, 傻瓜")
+	if action != wordActionPending || len(hits) != 2 || hits[0].Matches[0] != "spam" {
+		t.Errorf("多条规则命中时应取优先级最高的动作: %s %+v", action, hits)
+	}
+
+	if action, content, hits = filter.apply("正常评论"); action != wordActionPass || content != "正常评论" || hits != nil {
+		t.Errorf("未命中时应放行: %s %q %+v", action, content, hits)
+	}
+}
+
+func TestCompileWordRules_RejectsInvalidRules(t *testing.T) {
+	for _, rule := range []dto.WordRule{
+		{Pattern: "(", Type: wordRuleRegex},
+		{Pattern: "a*", Type: wordRuleRegex},
+		{Pattern: "*", Type: wordRuleWildcard},
+		{Pattern: "x", Action: "delete"},
+		{Pattern: "  "},
+	} {
+		if _, err := compileWordRules([]dto.WordRule{rule}); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("规则 %+v 应被拒绝: %v", rule, err)
+		}
+	}
+}
@@ -0,0 +1,387 @@
+/*
+ * @Description: 文章投稿服务：投稿者提交草稿进入审核队列，管理员可发表意见、要求修改、通过发布或拒绝，每一步都邮件通知对方
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 04:00:00
+ * @LastEditTime: 2026-10-18 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package contribution
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// maxNoteLength 审核意见与提交说明的最大长度（按字符计）
+const maxNoteLength = 2000
+
+// Service 封装了文章投稿与审核的业务逻辑。
+type Service struct {
+	articleSvc article_service.Service
+	reviewRepo repository.ArticleReviewRepository
+	userRepo   repository.UserRepository
+	emailSvc   utility.EmailService
+}
+
+// NewService 是投稿 Service 的构造函数。
+func NewService(
+	articleSvc article_service.Service,
+	reviewRepo repository.ArticleReviewRepository,
+	userRepo repository.UserRepository,
+	emailSvc utility.EmailService,
+) *Service {
+	return &Service{
+		articleSvc: articleSvc,
+		reviewRepo: reviewRepo,
+		userRepo:   userRepo,
+		emailSvc:   emailSvc,
+	}
+}
+
+// normalizeNote 去除首尾空白并校验长度
+func normalizeNote(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if len([]rune(content)) > maxNoteLength {
+		return "", fmt.Errorf("内容不能超过 %d 个字符: %w", maxNoteLength, constant.ErrBadRequest)
+	}
+	return content, nil
+}
+
+// decodeArticleID 将文章公共ID解码为数据库ID
+func decodeArticleID(publicID string) (uint, error) {
+	dbID, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeArticle {
+		return 0, fmt.Errorf("无效的文章ID '%s': %w", publicID, constant.ErrBadRequest)
+	}
+	return dbID, nil
+}
+
+// Submit 提交一篇新投稿。投稿始终保存为草稿并进入待审核状态，作者为当前用户，不会通知订阅者。
+func (s *Service) Submit(ctx context.Context, userID uint, req *model.SubmitContributionRequest, ip, referer string) (*model.ContributionDetail, error) {
+	note, err := normalizeNote(req.Note)
+	if err != nil {
+		return nil, err
+	}
+	showOnHome := false
+	created, err := s.articleSvc.Create(ctx, &model.CreateArticleRequest{
+		Title:                 strings.TrimSpace(req.Title),
+		ContentMd:             req.ContentMd,
+		ContentHTML:           req.ContentHTML,
+		CoverURL:              req.CoverURL,
+		Status:                "DRAFT",
+		PostTagIDs:            req.PostTagIDs,
+		PostCategoryIDs:       req.PostCategoryIDs,
+		Summaries:             req.Summaries,
+		Keywords:              req.Keywords,
+		ShowOnHome:            &showOnHome,
+		OwnerID:               userID,
+		ReviewStatus:          model.ReviewStatusPending,
+		SuppressNotifications: true,
+	}, ip, referer)
+	if err != nil {
+		return nil, err
+	}
+	articleID, err := decodeArticleID(created.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.reviewRepo.AddNote(ctx, &model.ArticleReviewNote{
+		ArticleID: articleID,
+		UserID:    userID,
+		Action:    model.ContributionActionSubmit,
+		Content:   note,
+	}); err != nil {
+		return nil, fmt.Errorf("记录投稿失败: %w", err)
+	}
+	s.notifyAdmin(ctx, userID, created.Title, note, false)
+	return s.detail(ctx, created.ID, articleID)
+}
+
+// Resubmit 修改投稿并重新提交审核，仅作者本人可在待审核或需修改状态下操作。
+func (s *Service) Resubmit(ctx context.Context, userID uint, publicID string, req *model.SubmitContributionRequest, ip, referer string) (*model.ContributionDetail, error) {
+	articleID, current, err := s.loadOwned(ctx, userID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != model.ReviewStatusPending && current.Status != model.ReviewStatusChangesRequested {
+		return nil, fmt.Errorf("投稿已审核完成，不能再修改: %w", constant.ErrConflict)
+	}
+	note, err := normalizeNote(req.Note)
+	if err != nil {
+		return nil, err
+	}
+	title := strings.TrimSpace(req.Title)
+	pending := model.ReviewStatusPending
+	updated, err := s.articleSvc.Update(ctx, publicID, &model.UpdateArticleRequest{
+		Title:           &title,
+		ContentMd:       &req.ContentMd,
+		ContentHTML:     &req.ContentHTML,
+		CoverURL:        &req.CoverURL,
+		PostTagIDs:      req.PostTagIDs,
+		PostCategoryIDs: req.PostCategoryIDs,
+		Summaries:       req.Summaries,
+		Keywords:        &req.Keywords,
+		ReviewStatus:    &pending,
+	}, ip, referer)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.reviewRepo.AddNote(ctx, &model.ArticleReviewNote{
+		ArticleID: articleID,
+		UserID:    userID,
+		Action:    model.ContributionActionSubmit,
+		Content:   note,
+	}); err != nil {
+		return nil, fmt.Errorf("记录投稿失败: %w", err)
+	}
+	s.notifyAdmin(ctx, userID, updated.Title, note, true)
+	return s.detail(ctx, publicID, articleID)
+}
+
+// ListMine 分页获取当前用户的投稿，status 为空时返回全部审核状态
+func (s *Service) ListMine(ctx context.Context, userID uint, page, pageSize int, status string) (*model.ArticleListResponse, error) {
+	return s.articleSvc.List(ctx, &model.ListArticlesOptions{
+		Page:         page,
+		PageSize:     pageSize,
+		AuthorID:     &userID,
+		ReviewStatus: status,
+		Reviewable:   true,
+	})
+}
+
+// GetMine 获取当前用户的一篇投稿及其审核记录，其他用户的投稿视为不存在
+func (s *Service) GetMine(ctx context.Context, userID uint, publicID string) (*model.ContributionDetail, error) {
+	articleID, _, err := s.loadOwned(ctx, userID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	return s.detail(ctx, publicID, articleID)
+}
+
+// ListQueue 分页获取审核队列，status 为空时返回全部投稿
+func (s *Service) ListQueue(ctx context.Context, page, pageSize int, status string) (*model.ArticleListResponse, error) {
+	return s.articleSvc.List(ctx, &model.ListArticlesOptions{
+		Page:         page,
+		PageSize:     pageSize,
+		ReviewStatus: status,
+		Reviewable:   true,
+	})
+}
+
+// Get 获取一篇投稿及其审核记录（管理员）
+func (s *Service) Get(ctx context.Context, publicID string) (*model.ContributionDetail, error) {
+	articleID, err := decodeArticleID(publicID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.reviewRepo.GetReviewResult(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status == model.ReviewStatusNone {
+		return nil, constant.ErrNotFound
+	}
+	return s.detail(ctx, publicID, articleID)
+}
+
+// Comment 对投稿发表审核意见，不改变审核状态
+func (s *Service) Comment(ctx context.Context, reviewerID uint, publicID, content string) (*model.ContributionDetail, error) {
+	return s.review(ctx, reviewerID, publicID, model.ContributionActionComment, content)
+}
+
+// RequestChanges 要求投稿者修改后重新提交
+func (s *Service) RequestChanges(ctx context.Context, reviewerID uint, publicID, content string) (*model.ContributionDetail, error) {
+	return s.review(ctx, reviewerID, publicID, model.ContributionActionRequestChanges, content)
+}
+
+// Approve 通过投稿并立即发布
+func (s *Service) Approve(ctx context.Context, reviewerID uint, publicID, content string) (*model.ContributionDetail, error) {
+	return s.review(ctx, reviewerID, publicID, model.ContributionActionApprove, content)
+}
+
+// Reject 拒绝投稿
+func (s *Service) Reject(ctx context.Context, reviewerID uint, publicID, content string) (*model.ContributionDetail, error) {
+	return s.review(ctx, reviewerID, publicID, model.ContributionActionReject, content)
+}
+
+// review 执行一次审核操作：校验状态、写入审核结果与记录，并通知投稿者
+func (s *Service) review(ctx context.Context, reviewerID uint, publicID, action, content string) (*model.ContributionDetail, error) {
+	articleID, err := decodeArticleID(publicID)
+	if err != nil {
+		return nil, err
+	}
+	content, err = normalizeNote(content)
+	if err != nil {
+		return nil, err
+	}
+	if content == "" && action != model.ContributionActionApprove {
+		return nil, fmt.Errorf("审核意见不能为空: %w", constant.ErrBadRequest)
+	}
+
+	current, err := s.reviewRepo.GetReviewResult(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	switch current.Status {
+	case model.ReviewStatusNone:
+		return nil, constant.ErrNotFound
+	case model.ReviewStatusPending:
+	case model.ReviewStatusChangesRequested:
+		// 等待投稿者修改期间只能继续发表意见或拒绝
+		if action != model.ContributionActionComment && action != model.ContributionActionReject {
+			return nil, fmt.Errorf("投稿正在等待作者修改: %w", constant.ErrConflict)
+		}
+	default:
+		return nil, fmt.Errorf("投稿已审核完成: %w", constant.ErrConflict)
+	}
+
+	article, err := s.articleSvc.Get(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case model.ContributionActionApprove:
+		// 审核状态与发布状态在同一次更新中写入，保证文章发布时已是审核通过状态
+		published, approved := "PUBLISHED", model.ReviewStatusApproved
+		if _, err := s.articleSvc.Update(ctx, publicID, &model.UpdateArticleRequest{
+			Status:       &published,
+			ReviewStatus: &approved,
+		}, "", ""); err != nil {
+			return nil, fmt.Errorf("发布投稿失败: %w", err)
+		}
+		err = s.setResult(ctx, articleID, model.ReviewStatusApproved, content, reviewerID)
+	case model.ContributionActionRequestChanges:
+		err = s.setResult(ctx, articleID, model.ReviewStatusChangesRequested, content, reviewerID)
+	case model.ContributionActionReject:
+		err = s.setResult(ctx, articleID, model.ReviewStatusRejected, content, reviewerID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("保存审核结果失败: %w", err)
+	}
+
+	if _, err := s.reviewRepo.AddNote(ctx, &model.ArticleReviewNote{
+		ArticleID: articleID,
+		UserID:    reviewerID,
+		Action:    action,
+		Content:   content,
+	}); err != nil {
+		return nil, fmt.Errorf("记录审核意见失败: %w", err)
+	}
+
+	s.notifyContributor(ctx, article, action, content)
+	return s.detail(ctx, publicID, articleID)
+}
+
+func (s *Service) setResult(ctx context.Context, articleID uint, status, comment string, reviewerID uint) error {
+	return s.reviewRepo.SetReviewResult(ctx, articleID, &model.ContributionReviewResult{
+		Status:     status,
+		Comment:    comment,
+		ReviewerID: reviewerID,
+		ReviewedAt: time.Now(),
+	})
+}
+
+// loadOwned 校验投稿属于当前用户，返回文章数据库ID与当前审核结果
+func (s *Service) loadOwned(ctx context.Context, userID uint, publicID string) (uint, *model.ContributionReviewResult, error) {
+	articleID, err := decodeArticleID(publicID)
+	if err != nil {
+		return 0, nil, err
+	}
+	current, err := s.reviewRepo.GetReviewResult(ctx, articleID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if current.Status == model.ReviewStatusNone {
+		return 0, nil, constant.ErrNotFound
+	}
+	ownerID, err := s.articleSvc.GetArticleOwnerID(ctx, publicID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if ownerID != userID {
+		return 0, nil, constant.ErrNotFound
+	}
+	return articleID, current, nil
+}
+
+// detail 组装投稿详情，审核记录中的操作人昵称按需查询
+func (s *Service) detail(ctx context.Context, publicID string, articleID uint) (*model.ContributionDetail, error) {
+	article, err := s.articleSvc.Get(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.reviewRepo.GetReviewResult(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := s.reviewRepo.ListNotes(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	nicknames := make(map[uint]string)
+	for _, note := range notes {
+		nickname, ok := nicknames[note.UserID]
+		if !ok {
+			if user, err := s.userRepo.FindByID(ctx, note.UserID); err == nil && user != nil {
+				nickname = user.Nickname
+			}
+			nicknames[note.UserID] = nickname
+		}
+		note.UserNickname = nickname
+	}
+	detail := &model.ContributionDetail{
+		Article:       article,
+		ReviewStatus:  current.Status,
+		ReviewComment: current.Comment,
+		Notes:         notes,
+	}
+	if !current.ReviewedAt.IsZero() {
+		reviewedAt := current.ReviewedAt
+		detail.ReviewedAt = &reviewedAt
+	}
+	return detail, nil
+}
+
+// notifyAdmin 通知站长有新的投稿，发送失败只记录日志
+func (s *Service) notifyAdmin(ctx context.Context, userID uint, title, note string, resubmitted bool) {
+	if s.emailSvc == nil {
+		return
+	}
+	nickname := "投稿者"
+	if user, err := s.userRepo.FindByID(ctx, userID); err == nil && user != nil && user.Nickname != "" {
+		nickname = user.Nickname
+	}
+	if err := s.emailSvc.SendContributionSubmittedEmail(ctx, nickname, title, note, resubmitted); err != nil {
+		log.Printf("[投稿] 发送投稿通知邮件失败: %v", err)
+	}
+}
+
+// notifyContributor 通知投稿者审核进展，发送失败只记录日志
+func (s *Service) notifyContributor(ctx context.Context, article *model.ArticleResponse, action, content string) {
+	if s.emailSvc == nil {
+		return
+	}
+	user, err := s.userRepo.FindByID(ctx, article.OwnerID)
+	if err != nil || user == nil || user.Email == "" {
+		log.Printf("[投稿] 投稿者 %d 没有可用的邮箱，跳过审核通知", article.OwnerID)
+		return
+	}
+	slug := article.ID
+	if article.Abbrlink != "" {
+		slug = article.Abbrlink
+	}
+	if err := s.emailSvc.SendContributionReviewEmail(ctx, user.Email, user.Nickname, article.Title, action, content, slug); err != nil {
+		log.Printf("[投稿] 发送审核通知邮件失败: %v", err)
+	}
+}
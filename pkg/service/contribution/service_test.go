@@ -0,0 +1,231 @@
+package contribution
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeArticleService struct {
+	article_service.Service
+	articles map[string]*model.ArticleResponse
+	nextID   uint
+	review   *fakeReviewRepo
+}
+
+func (f *fakeArticleService) Create(ctx context.Context, req *model.CreateArticleRequest, ip, referer string) (*model.ArticleResponse, error) {
+	f.nextID++
+	publicID, _ := idgen.GeneratePublicID(f.nextID, idgen.EntityTypeArticle)
+	a := &model.ArticleResponse{ID: publicID, Title: req.Title, Status: req.Status, OwnerID: req.OwnerID, ReviewStatus: req.ReviewStatus}
+	f.articles[publicID] = a
+	f.review.results[f.nextID] = &model.ContributionReviewResult{Status: req.ReviewStatus}
+	return a, nil
+}
+
+func (f *fakeArticleService) Update(ctx context.Context, publicID string, req *model.UpdateArticleRequest, ip, referer string) (*model.ArticleResponse, error) {
+	a := f.articles[publicID]
+	if req.Title != nil {
+		a.Title = *req.Title
+	}
+	if req.Status != nil {
+		a.Status = *req.Status
+	}
+	if req.ReviewStatus != nil {
+		a.ReviewStatus = *req.ReviewStatus
+		dbID, _, _ := idgen.DecodePublicID(publicID)
+		f.review.results[dbID].Status = *req.ReviewStatus
+	}
+	return a, nil
+}
+
+func (f *fakeArticleService) Get(ctx context.Context, publicID string) (*model.ArticleResponse, error) {
+	a, ok := f.articles[publicID]
+	if !ok {
+		return nil, constant.ErrNotFound
+	}
+	return a, nil
+}
+
+func (f *fakeArticleService) GetArticleOwnerID(ctx context.Context, publicID string) (uint, error) {
+	return f.articles[publicID].OwnerID, nil
+}
+
+type fakeReviewRepo struct {
+	repository.ArticleReviewRepository
+	results map[uint]*model.ContributionReviewResult
+	notes   []*model.ArticleReviewNote
+}
+
+func (f *fakeReviewRepo) AddNote(ctx context.Context, note *model.ArticleReviewNote) (*model.ArticleReviewNote, error) {
+	note.ID = uint(len(f.notes) + 1)
+	f.notes = append(f.notes, note)
+	return note, nil
+}
+
+func (f *fakeReviewRepo) ListNotes(ctx context.Context, articleID uint) ([]*model.ArticleReviewNote, error) {
+	var notes []*model.ArticleReviewNote
+	for _, note := range f.notes {
+		if note.ArticleID == articleID {
+			notes = append(notes, note)
+		}
+	}
+	return notes, nil
+}
+
+func (f *fakeReviewRepo) SetReviewResult(ctx context.Context, articleID uint, result *model.ContributionReviewResult) error {
+	f.results[articleID] = result
+	return nil
+}
+
+func (f *fakeReviewRepo) GetReviewResult(ctx context.Context, articleID uint) (*model.ContributionReviewResult, error) {
+	result, ok := f.results[articleID]
+	if !ok {
+		return nil, constant.ErrNotFound
+	}
+	copied := *result
+	return &copied, nil
+}
+
+type fakeUserRepo struct {
+	repository.UserRepository
+}
+
+func (fakeUserRepo) FindByID(ctx context.Context, id uint) (*model.User, error) {
+	switch id {
+	case 1:
+		return &model.User{ID: 1, Nickname: "站长", Email: "admin@example.com"}, nil
+	case 7:
+		return &model.User{ID: 7, Nickname: "投稿者", Email: "author@example.com"}, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+type sentMail struct {
+	to, action, slug string
+	resubmitted      bool
+}
+
+type fakeEmailService struct {
+	utility.EmailService
+	sent []sentMail
+}
+
+func (f *fakeEmailService) SendContributionSubmittedEmail(ctx context.Context, contributorNick, title, note string, resubmitted bool) error {
+	f.sent = append(f.sent, sentMail{to: "admin", action: model.ContributionActionSubmit, resubmitted: resubmitted})
+	return nil
+}
+
+func (f *fakeEmailService) SendContributionReviewEmail(ctx context.Context, toEmail, nickname, title, action, content, articleSlug string) error {
+	f.sent = append(f.sent, sentMail{to: toEmail, action: action, slug: articleSlug})
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *fakeArticleService, *fakeReviewRepo, *fakeEmailService) {
+	t.Helper()
+	if err := idgen.InitSqidsEncoderWithSeed("contribution-test"); err != nil {
+		t.Fatal(err)
+	}
+	reviewRepo := &fakeReviewRepo{results: map[uint]*model.ContributionReviewResult{}}
+	articleSvc := &fakeArticleService{articles: map[string]*model.ArticleResponse{}, review: reviewRepo}
+	emailSvc := &fakeEmailService{}
+	return NewService(articleSvc, reviewRepo, fakeUserRepo{}, emailSvc), articleSvc, reviewRepo, emailSvc
+}
+
+func TestContributionWorkflow(t *testing.T) {
+	svc, articles, reviews, mails := newTestService(t)
+	ctx := context.Background()
+	const author, admin = uint(7), uint(1)
+
+	detail, err := svc.Submit(ctx, author, &model.SubmitContributionRequest{Title: " 我的投稿 ", ContentMd: "正文", Note: "请审核"}, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := detail.Article.ID
+	if detail.Article.Status != "DRAFT" || detail.ReviewStatus != model.ReviewStatusPending || detail.Article.OwnerID != author {
+		t.Fatalf("投稿应保存为待审核草稿: %+v", detail.Article)
+	}
+	if len(detail.Notes) != 1 || detail.Notes[0].Action != model.ContributionActionSubmit || detail.Notes[0].UserNickname != "投稿者" {
+		t.Fatalf("应记录提交操作: %+v", detail.Notes)
+	}
+	if len(mails.sent) != 1 || mails.sent[0].to != "admin" || mails.sent[0].resubmitted {
+		t.Fatalf("提交后应通知站长: %+v", mails.sent)
+	}
+
+	if _, err := svc.GetMine(ctx, 8, id); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("其他用户不能查看投稿, 实际为 %v", err)
+	}
+
+	if _, err := svc.RequestChanges(ctx, admin, id, ""); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("要求修改时必须填写意见, 实际为 %v", err)
+	}
+	detail, err = svc.RequestChanges(ctx, admin, id, "请补充示例")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.ReviewStatus != model.ReviewStatusChangesRequested || detail.ReviewComment != "请补充示例" || detail.ReviewedAt == nil {
+		t.Fatalf("应进入需修改状态: %+v", detail)
+	}
+	if last := mails.sent[len(mails.sent)-1]; last.to != "author@example.com" || last.action != model.ContributionActionRequestChanges {
+		t.Fatalf("要求修改后应通知投稿者: %+v", last)
+	}
+	if _, err := svc.Approve(ctx, admin, id, ""); !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("等待作者修改期间不能直接通过, 实际为 %v", err)
+	}
+
+	detail, err = svc.Resubmit(ctx, author, id, &model.SubmitContributionRequest{Title: "修改后的投稿", ContentMd: "补充了示例"}, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.ReviewStatus != model.ReviewStatusPending || detail.Article.Title != "修改后的投稿" {
+		t.Fatalf("重新提交后应回到待审核状态: %+v", detail)
+	}
+	if last := mails.sent[len(mails.sent)-1]; last.to != "admin" || !last.resubmitted {
+		t.Fatalf("重新提交后应通知站长: %+v", last)
+	}
+
+	detail, err = svc.Approve(ctx, admin, id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if articles.articles[id].Status != "PUBLISHED" || detail.ReviewStatus != model.ReviewStatusApproved {
+		t.Fatalf("通过后应发布文章: %+v", detail)
+	}
+	if last := mails.sent[len(mails.sent)-1]; last.action != model.ContributionActionApprove || last.slug != id {
+		t.Fatalf("通过后应附带文章链接通知投稿者: %+v", last)
+	}
+	if len(detail.Notes) != 4 {
+		t.Fatalf("应保留完整的审核记录, 实际为 %d 条", len(detail.Notes))
+	}
+
+	if _, err := svc.Resubmit(ctx, author, id, &model.SubmitContributionRequest{Title: "再改"}, "", ""); !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("审核完成后不能再修改, 实际为 %v", err)
+	}
+	if _, err := svc.Reject(ctx, admin, id, "重复"); !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("审核完成后不能再拒绝, 实际为 %v", err)
+	}
+	if _, err := svc.Get(ctx, id); err != nil {
+		t.Fatalf("管理员应能查看已审核的投稿: %v", err)
+	}
+	if n := len(reviews.notes); n != 4 {
+		t.Fatalf("失败的操作不应写入审核记录, 实际为 %d 条", n)
+	}
+}
+
+func TestReviewNonContribution(t *testing.T) {
+	svc, _, reviews, _ := newTestService(t)
+	reviews.results[3] = &model.ContributionReviewResult{Status: model.ReviewStatusNone}
+	publicID, _ := idgen.GeneratePublicID(3, idgen.EntityTypeArticle)
+	if _, err := svc.Reject(context.Background(), 1, publicID, "不是投稿"); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("普通文章不属于审核队列, 实际为 %v", err)
+	}
+	if _, err := svc.Comment(context.Background(), 1, "invalid", "意见"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("无效的文章ID应返回 ErrBadRequest, 实际为 %v", err)
+	}
+}
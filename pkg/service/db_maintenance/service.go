@@ -0,0 +1,318 @@
+/*
+ * @Description: 数据库定期维护：清理过期的软删除数据并执行 ANALYZE / OPTIMIZE / VACUUM
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 19:00:00
+ * @LastEditTime: 2026-10-17 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package db_maintenance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent/migrate"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 维护的触发方式
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+)
+
+// maxRuns 保留的最近维护记录数
+const maxRuns = 20
+
+// Step 维护中的一个步骤
+type Step struct {
+	Name string `json:"name"`
+	// Rows 清理步骤永久删除的行数
+	Rows       int64  `json:"rows,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Report 一次数据库维护的结果
+type Report struct {
+	Trigger    string    `json:"trigger"`
+	DBType     string    `json:"db_type"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	DurationMs int64     `json:"duration_ms"`
+	// RetentionDays 软删除数据的保留天数，0 表示本次未清理
+	RetentionDays int    `json:"retention_days"`
+	Purged        int64  `json:"purged"`
+	Steps         []Step `json:"steps"`
+	Failed        int    `json:"failed"`
+}
+
+// Service 数据库维护服务
+type Service struct {
+	db         *sql.DB
+	dbType     string
+	settingSvc setting.SettingService
+
+	running atomic.Bool
+
+	mu   sync.Mutex
+	runs []*Report
+	// lastWindow 最近一次定时维护所在时段的开始时间，保证每个时段只执行一次
+	lastWindow time.Time
+}
+
+// NewService 创建数据库维护服务实例，dbType 为 mysql / postgres / sqlite
+func NewService(db *sql.DB, dbType string, settingSvc setting.SettingService) *Service {
+	return &Service{
+		db:         db,
+		dbType:     dbType,
+		settingSvc: settingSvc,
+	}
+}
+
+// Due 判断定时维护是否应当执行：已开启、当前处于维护时段内且本时段尚未执行过
+func (s *Service) Due(now time.Time) bool {
+	if s.settingSvc.Get(constant.KeyDBMaintenanceEnable.String()) != "true" {
+		return false
+	}
+	w, err := parseWindow(s.settingSvc.Get(constant.KeyDBMaintenanceWindow.String()))
+	if err != nil {
+		log.Printf("[数据库维护] 维护时段配置无效: %v", err)
+		return false
+	}
+	start, ok := w.current(now)
+	if !ok {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastWindow.Equal(start) {
+		return false
+	}
+	s.lastWindow = start
+	return true
+}
+
+// Running 返回是否有维护正在执行
+func (s *Service) Running() bool {
+	return s.running.Load()
+}
+
+// Runs 按时间倒序返回最近的维护记录
+func (s *Service) Runs() []*Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs := make([]*Report, 0, len(s.runs))
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		runs = append(runs, s.runs[i])
+	}
+	return runs
+}
+
+// Run 执行一次数据库维护：先永久删除超过保留期限的软删除数据，再整理数据库。
+// 单个步骤失败不影响其余步骤，全部完成后若有失败则返回错误，报告中记录了每一步的结果。
+func (s *Service) Run(ctx context.Context, trigger string) (*Report, error) {
+	if !s.running.CompareAndSwap(false, true) {
+		return nil, fmt.Errorf("数据库维护正在执行中: %w", constant.ErrConflict)
+	}
+	defer s.running.Store(false)
+
+	report := &Report{Trigger: trigger, DBType: s.dbType, StartedAt: time.Now(), Steps: []Step{}}
+	report.RetentionDays = s.retentionDays()
+	if report.RetentionDays > 0 {
+		cutoff := report.StartedAt.AddDate(0, 0, -report.RetentionDays)
+		for _, t := range purgeTargets {
+			step := s.step(t.table, func() (int64, error) { return s.purge(ctx, t, cutoff) })
+			report.Purged += step.Rows
+			report.Steps = append(report.Steps, step)
+		}
+	}
+	for _, op := range s.optimizeOps() {
+		report.Steps = append(report.Steps, s.step(op.name, func() (int64, error) { return 0, op.run(ctx) }))
+	}
+
+	report.FinishedAt = time.Now()
+	report.DurationMs = report.FinishedAt.Sub(report.StartedAt).Milliseconds()
+	for _, step := range report.Steps {
+		if step.Error != "" {
+			report.Failed++
+		}
+	}
+
+	s.mu.Lock()
+	s.runs = append(s.runs, report)
+	if len(s.runs) > maxRuns {
+		s.runs = s.runs[len(s.runs)-maxRuns:]
+	}
+	s.mu.Unlock()
+
+	if report.Failed > 0 {
+		return report, fmt.Errorf("数据库维护有 %d 个步骤失败", report.Failed)
+	}
+	return report, nil
+}
+
+func (s *Service) step(name string, fn func() (int64, error)) Step {
+	start := time.Now()
+	rows, err := fn()
+	step := Step{Name: name, Rows: rows, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	return step
+}
+
+func (s *Service) retentionDays() int {
+	days, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyDBMaintenanceSoftDeleteRetentionDays.String())))
+	if err != nil || days < 0 {
+		return 0
+	}
+	return days
+}
+
+// purgeTarget 需要清理软删除数据的表。
+// cleanup 在删除前处理引用这些行的数据，SQLite 不一定启用外键级联，因此显式处理而不依赖外键约束。
+type purgeTarget struct {
+	table   string
+	cleanup []string
+}
+
+// expiredIDs 过期软删除行 ID 的子查询，外层再包一层派生表以兼容 MySQL 不允许在子查询中引用被更新表的限制
+const expiredIDs = "SELECT id FROM (SELECT id FROM %s WHERE deleted_at IS NOT NULL AND deleted_at < ?) AS expired"
+
+var purgeTargets = []purgeTarget{
+	{table: "comments", cleanup: []string{
+		"UPDATE comments SET parent_id = NULL WHERE parent_id IN (" + expiredIDs + ")",
+		"DELETE FROM comment_reactions WHERE comment_id IN (" + expiredIDs + ")",
+	}},
+	{table: "articles", cleanup: []string{
+		"DELETE FROM article_histories WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM article_audios WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM article_review_notes WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM article_reactions WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM user_bookmarks WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM reading_progresses WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM article_post_tags WHERE article_id IN (" + expiredIDs + ")",
+		"DELETE FROM article_post_categories WHERE article_id IN (" + expiredIDs + ")",
+		"UPDATE comments SET article_id = NULL WHERE article_id IN (" + expiredIDs + ")",
+	}},
+	{table: "pages"},
+}
+
+// purge 在事务中永久删除 cutoff 之前软删除的行，返回删除的行数
+func (s *Service) purge(ctx context.Context, t purgeTarget, cutoff time.Time) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range t.cleanup {
+		if _, err := tx.ExecContext(ctx, s.rebind(fmt.Sprintf(stmt, t.table)), cutoff); err != nil {
+			return 0, fmt.Errorf("清理关联数据失败: %w", err)
+		}
+	}
+	res, err := tx.ExecContext(ctx, s.rebind(fmt.Sprintf("DELETE FROM %s WHERE deleted_at IS NOT NULL AND deleted_at < ?", t.table)), cutoff)
+	if err != nil {
+		return 0, err
+	}
+	rows, _ := res.RowsAffected()
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return rows, nil
+}
+
+// rebind 将 ? 占位符转换为 PostgreSQL 的 $n 形式
+func (s *Service) rebind(query string) string {
+	if s.dbType != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+type optimizeOp struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// optimizeOps 返回适用于当前数据库的整理操作
+func (s *Service) optimizeOps() []optimizeOp {
+	exec := func(query string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			_, err := s.db.ExecContext(ctx, query)
+			return err
+		}
+	}
+	switch s.dbType {
+	case "sqlite", "sqlite3":
+		return []optimizeOp{
+			{"ANALYZE", exec("ANALYZE")},
+			{"PRAGMA optimize", exec("PRAGMA optimize")},
+			// VACUUM 会重建整个数据库文件以回收空间，执行期间写入会被阻塞
+			{"VACUUM", exec("VACUUM")},
+		}
+	case "postgres":
+		return []optimizeOp{
+			{"VACUUM ANALYZE", exec("VACUUM (ANALYZE)")},
+		}
+	case "mysql":
+		tables := make([]string, 0, len(migrate.Tables))
+		for _, t := range migrate.Tables {
+			tables = append(tables, "`"+t.Name+"`")
+		}
+		list := strings.Join(tables, ", ")
+		return []optimizeOp{
+			{"ANALYZE TABLE", s.mysqlTableCommand("ANALYZE TABLE " + list)},
+			{"OPTIMIZE TABLE", s.mysqlTableCommand("OPTIMIZE TABLE " + list)},
+		}
+	}
+	return nil
+}
+
+// mysqlTableCommand 执行 ANALYZE / OPTIMIZE TABLE。这两个语句以结果集的形式逐表返回状态，
+// 需要读取结果集才能发现单个表的错误。
+func (s *Service) mysqlTableCommand(query string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		rows, err := s.db.QueryContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		var failed []string
+		for rows.Next() {
+			var table, op, msgType, msgText string
+			if err := rows.Scan(&table, &op, &msgType, &msgText); err != nil {
+				return err
+			}
+			if strings.EqualFold(msgType, "error") {
+				failed = append(failed, fmt.Sprintf("%s: %s", table, msgText))
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("%s", strings.Join(failed, "; "))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+package db_maintenance
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func TestWindowCurrent(t *testing.T) {
+	day := func(h, m int) time.Time { return time.Date(2026, 10, 17, h, m, 0, 0, time.Local) }
+
+	w, err := parseWindow("03:00-05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start, ok := w.current(day(4, 30)); !ok || !start.Equal(day(3, 0)) {
+		t.Errorf("04:30 应处于 03:00-05:00 时段内，实际 %v %v", start, ok)
+	}
+	if _, ok := w.current(day(5, 0)); ok {
+		t.Error("05:00 不应处于 03:00-05:00 时段内")
+	}
+
+	w, _ = parseWindow("23:30-01:00")
+	if start, ok := w.current(day(0, 30)); !ok || !start.Equal(day(23, 30).AddDate(0, 0, -1)) {
+		t.Errorf("跨越零点的时段开始时间应在前一天，实际 %v %v", start, ok)
+	}
+	if _, ok := w.current(day(12, 0)); ok {
+		t.Error("12:00 不应处于 23:30-01:00 时段内")
+	}
+
+	for _, s := range []string{"", "03:00", "3点-5点", "04:00-04:00", "25:00-26:00"} {
+		if _, err := parseWindow(s); err == nil {
+			t.Errorf("parseWindow(%q) 应返回错误", s)
+		}
+	}
+}
+
+func TestDueOncePerWindow(t *testing.T) {
+	svc := NewService(nil, "sqlite", &fakeSettings{values: map[string]string{
+		constant.KeyDBMaintenanceEnable.String(): "true",
+		constant.KeyDBMaintenanceWindow.String(): "03:00-05:00",
+	}})
+	now := time.Date(2026, 10, 17, 3, 5, 0, 0, time.Local)
+	if !svc.Due(now) {
+		t.Fatal("维护时段内首次检查应执行")
+	}
+	if svc.Due(now.Add(10 * time.Minute)) {
+		t.Error("同一时段内不应重复执行")
+	}
+	if !svc.Due(now.AddDate(0, 0, 1)) {
+		t.Error("第二天的时段应再次执行")
+	}
+}
+
+func TestRunPurgesExpiredSoftDeletedRows(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file::memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	now := time.Now().UTC()
+	expired, recent := now.AddDate(0, 0, -40), now.AddDate(0, 0, -5)
+	stmts := []string{
+		"CREATE TABLE articles (id INTEGER PRIMARY KEY, deleted_at DATETIME)",
+		"CREATE TABLE comments (id INTEGER PRIMARY KEY, deleted_at DATETIME, parent_id INTEGER, article_id INTEGER)",
+		"CREATE TABLE pages (id INTEGER PRIMARY KEY, deleted_at DATETIME)",
+		"CREATE TABLE article_histories (id INTEGER PRIMARY KEY, article_id INTEGER)",
+		"CREATE TABLE article_audios (id INTEGER PRIMARY KEY, article_id INTEGER)",
+		"CREATE TABLE article_post_tags (article_id INTEGER, post_tag_id INTEGER)",
+		"CREATE TABLE article_post_categories (article_id INTEGER, post_category_id INTEGER)",
+		"CREATE TABLE comment_reactions (id INTEGER PRIMARY KEY, comment_id INTEGER)",
+		"CREATE TABLE article_review_notes (id INTEGER PRIMARY KEY, article_id INTEGER)",
+		"CREATE TABLE article_reactions (id INTEGER PRIMARY KEY, article_id INTEGER)",
+		"CREATE TABLE user_bookmarks (id INTEGER PRIMARY KEY, article_id INTEGER)",
+		"CREATE TABLE reading_progresses (id INTEGER PRIMARY KEY, article_id INTEGER)",
+	}
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	inserts := []struct {
+		query string
+		args  []any
+	}{
+		{"INSERT INTO articles (id, deleted_at) VALUES (1, ?), (2, ?), (3, NULL)", []any{expired, recent}},
+		{"INSERT INTO comments (id, deleted_at, parent_id, article_id) VALUES (1, ?, NULL, 3), (2, NULL, 1, 1), (3, NULL, NULL, 3)", []any{expired}},
+		{"INSERT INTO pages (id, deleted_at) VALUES (1, ?)", []any{expired}},
+		{"INSERT INTO article_histories (id, article_id) VALUES (1, 1), (2, 2)", nil},
+		{"INSERT INTO article_post_tags (article_id, post_tag_id) VALUES (1, 1), (3, 1)", nil},
+		{"INSERT INTO comment_reactions (id, comment_id) VALUES (1, 1), (2, 3)", nil},
+		{"INSERT INTO article_review_notes (id, article_id) VALUES (1, 1), (2, 3)", nil},
+		{"INSERT INTO article_reactions (id, article_id) VALUES (1, 1), (2, 2)", nil},
+		{"INSERT INTO user_bookmarks (id, article_id) VALUES (1, 1), (2, 3)", nil},
+		{"INSERT INTO reading_progresses (id, article_id) VALUES (1, 1), (2, 2)", nil},
+	}
+	for _, in := range inserts {
+		if _, err := db.Exec(in.query, in.args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	svc := NewService(db, "sqlite", &fakeSettings{values: map[string]string{
+		constant.KeyDBMaintenanceSoftDeleteRetentionDays.String(): "30",
+	}})
+	report, err := svc.Run(context.Background(), TriggerManual)
+	if err != nil {
+		t.Fatalf("维护失败: %v, %+v", err, report)
+	}
+	if report.Purged != 3 {
+		t.Errorf("应永久删除 3 行，实际 %d", report.Purged)
+	}
+
+	count := func(query string) int {
+		var n int
+		if err := db.QueryRow(query).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	checks := map[string]int{
+		"SELECT COUNT(*) FROM articles":                                                           2,
+		"SELECT COUNT(*) FROM article_histories WHERE article_id = 1":                             0,
+		"SELECT COUNT(*) FROM article_histories":                                                  1,
+		"SELECT COUNT(*) FROM article_post_tags":                                                  1,
+		"SELECT COUNT(*) FROM comments":                                                           2,
+		"SELECT COUNT(*) FROM comments WHERE id = 2 AND parent_id IS NULL AND article_id IS NULL": 1,
+		"SELECT COUNT(*) FROM pages":                                                              0,
+		"SELECT COUNT(*) FROM comment_reactions WHERE comment_id = 3":                             1,
+		"SELECT COUNT(*) FROM comment_reactions":                                                  1,
+		"SELECT COUNT(*) FROM article_review_notes WHERE article_id = 3":                          1,
+		"SELECT COUNT(*) FROM article_review_notes":                                               1,
+		"SELECT COUNT(*) FROM article_reactions WHERE article_id = 2":                             1,
+		"SELECT COUNT(*) FROM article_reactions":                                                  1,
+		"SELECT COUNT(*) FROM user_bookmarks WHERE article_id = 3":                                1,
+		"SELECT COUNT(*) FROM user_bookmarks":                                                     1,
+		"SELECT COUNT(*) FROM reading_progresses WHERE article_id = 2":                            1,
+		"SELECT COUNT(*) FROM reading_progresses":                                                 1,
+	}
+	for query, want := range checks {
+		if got := count(query); got != want {
+			t.Errorf("%s = %d; 期望 %d", query, got, want)
+		}
+	}
+
+	runs := svc.Runs()
+	if len(runs) != 1 || runs[0] != report || report.Failed != 0 {
+		t.Errorf("维护记录不正确: %+v", runs)
+	}
+}
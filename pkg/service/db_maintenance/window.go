@@ -0,0 +1,67 @@
+/*
+ * @Description: 数据库维护时段
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 19:00:00
+ * @LastEditTime: 2026-10-17 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package db_maintenance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// window 每天的维护时段，以当天零点起的分钟数表示，end 小于 start 时表示跨越零点
+type window struct {
+	start, end int
+}
+
+// parseWindow 解析形如 "03:00-05:00" 的维护时段，允许跨越零点（如 "23:30-01:00"）
+func parseWindow(s string) (window, error) {
+	from, to, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		return window{}, fmt.Errorf("'%s' 不是 HH:MM-HH:MM 格式", s)
+	}
+	start, err := parseClock(from)
+	if err != nil {
+		return window{}, err
+	}
+	end, err := parseClock(to)
+	if err != nil {
+		return window{}, err
+	}
+	if start == end {
+		return window{}, fmt.Errorf("'%s' 的开始与结束时间相同", s)
+	}
+	return window{start: start, end: end}, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("'%s' 不是有效的时间", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// current 判断 now 是否处于维护时段内，是则返回本次时段的开始时间
+func (w window) current(now time.Time) (time.Time, bool) {
+	minute := now.Hour()*60 + now.Minute()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if w.start < w.end {
+		if minute < w.start || minute >= w.end {
+			return time.Time{}, false
+		}
+		return midnight.Add(time.Duration(w.start) * time.Minute), true
+	}
+	switch {
+	case minute >= w.start:
+		return midnight.Add(time.Duration(w.start) * time.Minute), true
+	case minute < w.end:
+		// 跨越零点的时段，开始时间在前一天
+		return midnight.AddDate(0, 0, -1).Add(time.Duration(w.start) * time.Minute), true
+	}
+	return time.Time{}, false
+}
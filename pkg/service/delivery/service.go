@@ -0,0 +1,309 @@
+/*
+ * @Description: 通知投递队列，即时推送与通知邮件先持久化再由后台任务发送，失败后按指数退避重试
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 22:00:00
+ * @LastEditTime: 2026-10-15 22:00:00
+ * @LastEditors: 安知鱼
+ */
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// batchSize 每轮最多处理的到期记录数
+	batchSize = 200
+	// sendTimeout 单次投递的超时
+	sendTimeout = time.Minute
+	// retryBaseDelay 首次重试的等待时间，之后每次翻倍
+	retryBaseDelay = 30 * time.Second
+	// retryMaxDelay 单次重试等待时间的上限
+	retryMaxDelay = time.Hour
+	// maxErrorLength 保存的失败原因最大长度
+	maxErrorLength = 1000
+
+	defaultMaxAttempts   = 5
+	defaultConcurrency   = 2
+	defaultRetentionDays = 14
+)
+
+// Sender 按渠道发送一条已渲染的投递内容
+type Sender func(ctx context.Context, payload []byte) error
+
+// Service 通知投递队列服务
+type Service struct {
+	repo       repository.NotificationDeliveryRepository
+	settingSvc setting.SettingService
+
+	mu       sync.Mutex
+	senders  map[string]Sender
+	slots    map[string]chan struct{} // 各渠道的并发槽位，所有处理轮次共享
+	dispatch func()
+}
+
+// NewService 创建通知投递队列服务
+func NewService(repo repository.NotificationDeliveryRepository, settingSvc setting.SettingService) *Service {
+	return &Service{
+		repo:       repo,
+		settingSvc: settingSvc,
+		senders:    make(map[string]Sender),
+		slots:      make(map[string]chan struct{}),
+	}
+}
+
+// RegisterSender 注册渠道的发送实现
+func (s *Service) RegisterSender(channel string, sender Sender) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.senders[channel] = sender
+}
+
+// SetDispatcher 设置入队后触发后台投递的回调（通常为任务调度器的派发方法），
+// 未设置时依赖定时任务轮询
+func (s *Service) SetDispatcher(dispatch func()) {
+	s.dispatch = dispatch
+}
+
+// Enqueue 持久化一条投递记录并触发后台投递，payload 会被序列化为 JSON
+func (s *Service) Enqueue(ctx context.Context, d *model.NotificationDelivery, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化投递内容失败: %w", err)
+	}
+	d.Payload = string(data)
+	d.MaxAttempts = s.maxAttempts()
+	if err := s.repo.Create(ctx, d); err != nil {
+		return fmt.Errorf("保存投递记录失败: %w", err)
+	}
+	if s.dispatch != nil {
+		s.dispatch()
+	}
+	return nil
+}
+
+// Recover 将上次进程退出时仍在发送中的记录恢复为待投递，应在启动时调用
+func (s *Service) Recover(ctx context.Context) {
+	n, err := s.repo.ResetSending(ctx)
+	if err != nil {
+		log.Printf("[通知投递] 恢复发送中的记录失败: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("[通知投递] 已恢复 %d 条上次未完成的投递", n)
+	}
+}
+
+// ProcessDue 投递所有已到期的记录。不同渠道并行处理，同一渠道的并发数受配置限制；
+// 每条记录先被原子领取再发送，多个处理轮次同时运行也不会重复发送。
+func (s *Service) ProcessDue(ctx context.Context) (int, error) {
+	due, err := s.repo.ListDue(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询待投递记录失败: %w", err)
+	}
+	if len(due) == 0 {
+		return 0, nil
+	}
+
+	byChannel := make(map[string][]*model.NotificationDelivery)
+	for _, d := range due {
+		byChannel[d.Channel] = append(byChannel[d.Channel], d)
+	}
+
+	var wg sync.WaitGroup
+	for channel, items := range byChannel {
+		wg.Add(1)
+		go func(slots chan struct{}, items []*model.NotificationDelivery) {
+			defer wg.Done()
+			var sends sync.WaitGroup
+			defer sends.Wait()
+			for _, d := range items {
+				select {
+				case slots <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				sends.Add(1)
+				go func(d *model.NotificationDelivery) {
+					defer sends.Done()
+					defer func() { <-slots }()
+					s.deliver(ctx, d)
+				}(d)
+			}
+		}(s.channelSlots(channel), items)
+	}
+	wg.Wait()
+	return len(due), ctx.Err()
+}
+
+// deliver 领取并发送一条记录，根据结果更新状态
+func (s *Service) deliver(ctx context.Context, d *model.NotificationDelivery) {
+	claimed, err := s.repo.Claim(ctx, d.ID)
+	if err != nil {
+		log.Printf("[通知投递] 领取记录 %d 失败: %v", d.ID, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+	attempts := d.Attempts + 1
+
+	s.mu.Lock()
+	sender := s.senders[d.Channel]
+	s.mu.Unlock()
+	if sender == nil {
+		s.markFailed(ctx, d.ID, fmt.Sprintf("不支持的投递渠道: %s", d.Channel))
+		return
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	err = sender(sendCtx, []byte(d.Payload))
+	cancel()
+	if err == nil {
+		if err := s.repo.MarkSent(ctx, d.ID, time.Now()); err != nil {
+			log.Printf("[通知投递] 更新记录 %d 状态失败: %v", d.ID, err)
+		}
+		return
+	}
+
+	msg := truncateError(err.Error())
+	if attempts >= d.MaxAttempts {
+		log.Printf("[通知投递] %s 通知 %d 已失败 %d 次，停止重试: %v", d.Channel, d.ID, attempts, err)
+		s.markFailed(ctx, d.ID, msg)
+		return
+	}
+	next := time.Now().Add(retryDelay(attempts))
+	log.Printf("[通知投递] %s 通知 %d 第 %d 次发送失败，将于 %s 重试: %v", d.Channel, d.ID, attempts, next.Format(time.DateTime), err)
+	if err := s.repo.MarkRetry(ctx, d.ID, next, msg); err != nil {
+		log.Printf("[通知投递] 更新记录 %d 状态失败: %v", d.ID, err)
+	}
+}
+
+func (s *Service) markFailed(ctx context.Context, id uint, msg string) {
+	if err := s.repo.MarkFailed(ctx, id, msg); err != nil {
+		log.Printf("[通知投递] 更新记录 %d 状态失败: %v", id, err)
+	}
+}
+
+// Retry 立即重新投递一条尚未成功的记录，并清零尝试次数
+func (s *Service) Retry(ctx context.Context, id uint) error {
+	d, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		return fmt.Errorf("投递记录不存在: %w", constant.ErrNotFound)
+	}
+	if d.Status == model.DeliverySent || d.Status == model.DeliverySending {
+		return fmt.Errorf("投递记录当前状态为 %s，无需重试: %w", d.Status, constant.ErrBadRequest)
+	}
+	if err := s.repo.Requeue(ctx, id); err != nil {
+		return err
+	}
+	if s.dispatch != nil {
+		s.dispatch()
+	}
+	return nil
+}
+
+// List 分页查询投递记录
+func (s *Service) List(ctx context.Context, query *model.NotificationDeliveryQuery) ([]*model.NotificationDelivery, int, error) {
+	switch query.Status {
+	case "", model.DeliveryPending, model.DeliverySending, model.DeliverySent, model.DeliveryFailed:
+	default:
+		return nil, 0, fmt.Errorf("无效的投递状态: %s: %w", query.Status, constant.ErrBadRequest)
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PageSize <= 0 || query.PageSize > 100 {
+		query.PageSize = 20
+	}
+	return s.repo.List(ctx, query)
+}
+
+// Stats 返回按渠道与状态分组的投递数量
+func (s *Service) Stats(ctx context.Context) ([]*model.NotificationDeliveryStat, error) {
+	return s.repo.CountByChannelAndStatus(ctx)
+}
+
+// Cleanup 删除超过保留天数的已结束记录
+func (s *Service) Cleanup(ctx context.Context) (int, error) {
+	days := defaultRetentionDays
+	if raw := strings.TrimSpace(s.settingSvc.Get(constant.KeyNotificationDeliveryRetentionDays.String())); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n >= 0 {
+			days = n
+		}
+	}
+	if days == 0 {
+		return 0, nil
+	}
+	return s.repo.DeleteFinishedBefore(ctx, time.Now().AddDate(0, 0, -days))
+}
+
+// channelSlots 返回渠道的并发槽位，配置变更后创建新的槽位（进行中的投递仍归还到旧槽位）
+func (s *Service) channelSlots(channel string) chan struct{} {
+	size := s.concurrency(channel)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if slots, ok := s.slots[channel]; ok && cap(slots) == size {
+		return slots
+	}
+	slots := make(chan struct{}, size)
+	s.slots[channel] = slots
+	return slots
+}
+
+func (s *Service) concurrency(channel string) int {
+	var limits map[string]int
+	raw := strings.TrimSpace(s.settingSvc.Get(constant.KeyNotificationDeliveryConcurrency.String()))
+	if raw != "" {
+		if err := json.Unmarshal([]byte(raw), &limits); err != nil {
+			log.Printf("[通知投递] 渠道并发配置无效，使用默认值: %v", err)
+		}
+	}
+	if n := limits[channel]; n > 0 {
+		return n
+	}
+	return defaultConcurrency
+}
+
+func (s *Service) maxAttempts() int {
+	n, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyNotificationDeliveryMaxAttempts.String())))
+	if err != nil || n <= 0 {
+		return defaultMaxAttempts
+	}
+	return n
+}
+
+// retryDelay 第 attempts 次失败后的等待时间：30s、1m、2m…… 最长 1 小时，并加入最多 20% 的随机抖动
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
+func truncateError(msg string) string {
+	if runes := []rune(msg); len(runes) > maxErrorLength {
+		return string(runes[:maxErrorLength])
+	}
+	return msg
+}
@@ -0,0 +1,124 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+type fakeRepo struct {
+	repository.NotificationDeliveryRepository
+	mu      sync.Mutex
+	records map[uint]*model.NotificationDelivery
+}
+
+func (r *fakeRepo) ListDue(_ context.Context, now time.Time, _ int) ([]*model.NotificationDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*model.NotificationDelivery
+	for _, d := range r.records {
+		if d.Status == model.DeliveryPending && !d.NextAttemptAt.After(now) {
+			copied := *d
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeRepo) Claim(_ context.Context, id uint) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d := r.records[id]
+	if d.Status != model.DeliveryPending {
+		return false, nil
+	}
+	d.Status = model.DeliverySending
+	d.Attempts++
+	return true, nil
+}
+
+func (r *fakeRepo) MarkSent(_ context.Context, id uint, _ time.Time) error {
+	return r.set(id, model.DeliverySent, "")
+}
+
+func (r *fakeRepo) MarkRetry(_ context.Context, id uint, next time.Time, msg string) error {
+	r.mu.Lock()
+	r.records[id].NextAttemptAt = next
+	r.mu.Unlock()
+	return r.set(id, model.DeliveryPending, msg)
+}
+
+func (r *fakeRepo) MarkFailed(_ context.Context, id uint, msg string) error {
+	return r.set(id, model.DeliveryFailed, msg)
+}
+
+func (r *fakeRepo) set(id uint, status, msg string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[id].Status = status
+	r.records[id].LastError = msg
+	return nil
+}
+
+func TestProcessDue_RetriesThenFails(t *testing.T) {
+	repo := &fakeRepo{records: map[uint]*model.NotificationDelivery{
+		1: {ID: 1, Channel: model.DeliveryChannelBark, Status: model.DeliveryPending, MaxAttempts: 2},
+		2: {ID: 2, Channel: model.DeliveryChannelEmail, Status: model.DeliveryPending, MaxAttempts: 2},
+		3: {ID: 3, Channel: model.DeliveryChannelWebhook, Status: model.DeliveryPending, MaxAttempts: 2},
+	}}
+	svc := NewService(repo, &fakeSettings{})
+	svc.RegisterSender(model.DeliveryChannelBark, func(context.Context, []byte) error { return errors.New("503") })
+	svc.RegisterSender(model.DeliveryChannelEmail, func(context.Context, []byte) error { return nil })
+
+	if _, err := svc.ProcessDue(context.Background()); err != nil {
+		t.Fatalf("ProcessDue() error = %v", err)
+	}
+	if got := repo.records[1]; got.Status != model.DeliveryPending || !got.NextAttemptAt.After(time.Now()) || got.LastError != "503" {
+		t.Fatalf("首次失败应等待重试, got %+v", got)
+	}
+	if repo.records[2].Status != model.DeliverySent {
+		t.Errorf("发送成功应标记为 SENT, got %s", repo.records[2].Status)
+	}
+	if repo.records[3].Status != model.DeliveryFailed {
+		t.Errorf("未注册的渠道应直接失败, got %s", repo.records[3].Status)
+	}
+
+	repo.records[1].NextAttemptAt = time.Time{}
+	if _, err := svc.ProcessDue(context.Background()); err != nil {
+		t.Fatalf("ProcessDue() error = %v", err)
+	}
+	if got := repo.records[1]; got.Status != model.DeliveryFailed || got.Attempts != 2 {
+		t.Errorf("达到最大尝试次数后应停止重试, got %+v", got)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := []struct {
+		attempts int
+		base     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{20, time.Hour},
+	}
+	for _, c := range cases {
+		got := retryDelay(c.attempts)
+		if got < c.base || got > c.base+c.base/5 {
+			t.Errorf("retryDelay(%d) = %s, want [%s, %s]", c.attempts, got, c.base, c.base+c.base/5)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+/*
+ * @Description: 一次性邮箱拦截：维护一次性邮箱域名列表，对评论与注册按配置拒绝或转为人工审核，并统计拦截次数
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 23:00:00
+ * @LastEditTime: 2026-10-18 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package disposable_email
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+//go:embed builtin_domains.txt
+var builtinDomains string
+
+// Action 是命中一次性邮箱时的处理方式
+type Action string
+
+const (
+	// ActionOff 不处理
+	ActionOff Action = "off"
+	// ActionReject 直接拒绝
+	ActionReject Action = "reject"
+	// ActionHold 放行但需要管理员审核：评论进入待审核，注册的账户需管理员启用
+	ActionHold Action = "hold"
+)
+
+// Scope 是一次性邮箱检查的业务场景
+type Scope string
+
+const (
+	ScopeComment  Scope = "comment"
+	ScopeRegister Scope = "register"
+)
+
+const (
+	// defaultListPath 下载的域名列表保存位置，重启后无需重新下载
+	defaultListPath = "data/disposable_email_domains.txt"
+	// pendingKeyPrefix 尚未写入数据库的拦截次数在缓存中的键前缀，完整的键为 前缀 场景:处理方式
+	pendingKeyPrefix = "anheyu:disposable_email:blocked:"
+	// maxListSize 远程列表的大小上限
+	maxListSize = 8 << 20
+	// minRemoteDomains 远程列表的最少域名数，避免错误的下载地址清空列表
+	minRemoteDomains = 100
+)
+
+// Counter 是某个场景与处理方式的拦截次数
+type Counter struct {
+	Scope  Scope  `json:"scope"`
+	Action Action `json:"action"`
+	Count  int64  `json:"count"`
+}
+
+// Stats 是一次性邮箱拦截的概况
+type Stats struct {
+	CommentAction  Action `json:"comment_action"`
+	RegisterAction Action `json:"register_action"`
+	// DomainCount 当前列表中的域名数（内置与远程合并后）
+	DomainCount int `json:"domain_count"`
+	// UpdatedAt 远程列表的最后更新时间，从未更新过时为 nil
+	UpdatedAt *time.Time `json:"updated_at"`
+	Counters  []Counter  `json:"counters"`
+}
+
+// Service 维护一次性邮箱域名列表并执行拦截
+type Service struct {
+	settingSvc setting.SettingService
+	httpClient *http.Client
+	cacheSvc   utility.CacheService
+	statRepo   repository.DisposableEmailStatRepository
+	listPath   string
+
+	mu        sync.RWMutex
+	domains   map[string]struct{}
+	updatedAt *time.Time
+}
+
+// NewService 创建一次性邮箱服务，加载内置列表以及此前下载并保存的远程列表。
+// 列表下载地址由管理员配置，下载经过出站白名单与 SSRF 防护；
+// 拦截次数先累加在缓存中，由定时任务调用 FlushCounters 写入数据库
+func NewService(settingSvc setting.SettingService, cacheSvc utility.CacheService, statRepo repository.DisposableEmailStatRepository) *Service {
+	guard := ssrf.NewGuard(func() string {
+		return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+	})
+	s := &Service{
+		settingSvc: settingSvc,
+		httpClient: httpclient.New("disposable_email", httpclient.DefaultPolicy(), httpclient.WithBaseTransport(guard.Transport())),
+		cacheSvc:   cacheSvc,
+		statRepo:   statRepo,
+		listPath:   defaultListPath,
+	}
+	s.loadSaved()
+	return s
+}
+
+// loadSaved 合并内置列表与本地保存的远程列表，本地列表不存在或读取失败时只使用内置列表
+func (s *Service) loadSaved() {
+	domains := parseDomains(strings.NewReader(builtinDomains))
+	var updatedAt *time.Time
+	if f, err := os.Open(s.listPath); err == nil {
+		for d := range parseDomains(f) {
+			domains[d] = struct{}{}
+		}
+		if info, err := f.Stat(); err == nil {
+			t := info.ModTime()
+			updatedAt = &t
+		}
+		f.Close()
+	} else if !os.IsNotExist(err) {
+		log.Printf("[WARNING] 读取一次性邮箱域名列表失败，仅使用内置列表: %v", err)
+	}
+
+	s.mu.Lock()
+	s.domains = domains
+	s.updatedAt = updatedAt
+	s.mu.Unlock()
+}
+
+// parseDomains 解析每行一个域名的列表，忽略空行与 # 开头的注释
+func parseDomains(r io.Reader) map[string]struct{} {
+	domains := make(map[string]struct{})
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if line == "" || strings.HasPrefix(line, "#") || !strings.Contains(line, ".") || strings.ContainsAny(line, " \t@/") {
+			continue
+		}
+		domains[line] = struct{}{}
+	}
+	return domains
+}
+
+// parseAllowlist 解析管理员配置的放行域名，支持逗号与换行分隔
+func parseAllowlist(raw string) []string {
+	fields := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == '，' || r == '\n' || r == '\r' || r == ' '
+	})
+	result := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f = strings.Trim(strings.ToLower(f), ".@"); f != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// emailDomain 返回邮箱的域名部分（小写），不是合法邮箱时返回空字符串
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(email[at+1:])), ".")
+}
+
+// domainMatches 判断 domain 是否为 target 本身或其子域名
+func domainMatches(domain, target string) bool {
+	return domain == target || strings.HasSuffix(domain, "."+target)
+}
+
+// IsDisposable 判断邮箱是否属于一次性邮箱，子域名同样视为命中，管理员放行的域名（及其子域名）除外
+func (s *Service) IsDisposable(email string) bool {
+	domain := emailDomain(email)
+	if domain == "" {
+		return false
+	}
+	for _, allowed := range parseAllowlist(s.settingSvc.Get(constant.KeyDisposableEmailAllowlist.String())) {
+		if domainMatches(domain, allowed) {
+			return false
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for d := domain; ; {
+		if _, ok := s.domains[d]; ok {
+			return true
+		}
+		dot := strings.Index(d, ".")
+		if dot < 0 {
+			return false
+		}
+		d = d[dot+1:]
+	}
+}
+
+// action 返回场景配置的处理方式，未知的值视为不处理
+func (s *Service) action(scope Scope) Action {
+	key := constant.KeyDisposableEmailCommentAction
+	if scope == ScopeRegister {
+		key = constant.KeyDisposableEmailRegisterAction
+	}
+	switch a := Action(strings.TrimSpace(s.settingSvc.Get(key.String()))); a {
+	case ActionReject, ActionHold:
+		return a
+	default:
+		return ActionOff
+	}
+}
+
+// Match 返回邮箱在指定场景下应采取的处理方式，未启用或不是一次性邮箱时返回 ActionOff。
+// 调用方按实际采取的处理方式调用 Record 计数。
+func (s *Service) Match(scope Scope, email string) Action {
+	action := s.action(scope)
+	if action == ActionOff || !s.IsDisposable(email) {
+		return ActionOff
+	}
+	return action
+}
+
+// Record 记录一次拦截，只在缓存中累加，不阻塞评论与注册请求
+func (s *Service) Record(ctx context.Context, scope Scope, action Action) {
+	if action == ActionOff {
+		return
+	}
+	if _, err := s.cacheSvc.Increment(ctx, pendingKeyPrefix+counterKey(scope, action)); err != nil {
+		log.Printf("[WARNING] 记录一次性邮箱拦截次数失败: %v", err)
+	}
+}
+
+func counterKey(scope Scope, action Action) string {
+	return fmt.Sprintf("%s:%s", scope, action)
+}
+
+// pendingCounts 返回缓存中尚未写入数据库的拦截次数，键为 场景:处理方式
+func (s *Service) pendingCounts(ctx context.Context) map[string]int64 {
+	counts := make(map[string]int64)
+	keys, err := s.cacheSvc.Scan(ctx, pendingKeyPrefix+"*")
+	if err != nil {
+		log.Printf("[WARNING] 读取一次性邮箱拦截次数失败: %v", err)
+		return counts
+	}
+	for _, key := range keys {
+		raw, err := s.cacheSvc.Get(ctx, key)
+		if err != nil || raw == "" {
+			continue
+		}
+		if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			counts[strings.TrimPrefix(key, pendingKeyPrefix)] += n
+		}
+	}
+	return counts
+}
+
+// FlushCounters 供定时任务调用：取出缓存中累加的拦截次数并写入数据库，返回写入的次数总和。
+// 写入失败的次数会重新累加回缓存，等待下次写入
+func (s *Service) FlushCounters(ctx context.Context) (int, error) {
+	keys, err := s.cacheSvc.Scan(ctx, pendingKeyPrefix+"*")
+	if err != nil {
+		return 0, fmt.Errorf("扫描一次性邮箱拦截次数失败: %w", err)
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	pending, err := s.cacheSvc.GetAndDeleteMany(ctx, keys)
+	if err != nil {
+		return 0, fmt.Errorf("读取一次性邮箱拦截次数失败: %w", err)
+	}
+
+	total := 0
+	var firstErr error
+	for key, delta := range pending {
+		if delta <= 0 {
+			continue
+		}
+		scope, action, ok := strings.Cut(strings.TrimPrefix(key, pendingKeyPrefix), ":")
+		if !ok {
+			continue
+		}
+		if err := s.statRepo.Add(ctx, scope, action, int64(delta)); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("保存一次性邮箱拦截次数失败: %w", err)
+			}
+			for i := 0; i < delta; i++ {
+				s.cacheSvc.Increment(ctx, key)
+			}
+			continue
+		}
+		total += delta
+	}
+	return total, firstErr
+}
+
+// Stats 返回当前配置、列表规模与各场景的拦截次数（数据库中的累计值加上缓存中尚未写入的部分）
+func (s *Service) Stats(ctx context.Context) *Stats {
+	s.mu.RLock()
+	stats := &Stats{
+		CommentAction:  s.action(ScopeComment),
+		RegisterAction: s.action(ScopeRegister),
+		DomainCount:    len(s.domains),
+		UpdatedAt:      s.updatedAt,
+	}
+	s.mu.RUnlock()
+
+	counts, err := s.statRepo.Counts(ctx)
+	if err != nil {
+		log.Printf("[WARNING] 读取一次性邮箱拦截次数失败: %v", err)
+		counts = make(map[string]int64)
+	}
+	for key, n := range s.pendingCounts(ctx) {
+		counts[key] += n
+	}
+	for _, scope := range []Scope{ScopeComment, ScopeRegister} {
+		for _, action := range []Action{ActionReject, ActionHold} {
+			stats.Counters = append(stats.Counters, Counter{Scope: scope, Action: action, Count: counts[counterKey(scope, action)]})
+		}
+	}
+	return stats
+}
+
+// Refresh 从配置的地址下载一次性邮箱域名列表，保存到本地并与内置列表合并，返回合并后的域名数
+func (s *Service) Refresh(ctx context.Context) (int, error) {
+	listURL := strings.TrimSpace(s.settingSvc.Get(constant.KeyDisposableEmailListURL.String()))
+	if listURL == "" {
+		return 0, fmt.Errorf("未配置一次性邮箱域名列表的下载地址: %w", constant.ErrBadRequest)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("无效的下载地址: %w", constant.ErrBadRequest)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("下载一次性邮箱域名列表失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("下载一次性邮箱域名列表失败，状态码: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxListSize+1))
+	if err != nil {
+		return 0, fmt.Errorf("读取一次性邮箱域名列表失败: %w", err)
+	}
+	if len(body) > maxListSize {
+		return 0, fmt.Errorf("一次性邮箱域名列表超过 %d MB", maxListSize>>20)
+	}
+	remote := parseDomains(strings.NewReader(string(body)))
+	if len(remote) < minRemoteDomains {
+		return 0, fmt.Errorf("下载的列表只包含 %d 个域名，可能不是有效的一次性邮箱域名列表", len(remote))
+	}
+
+	if err := s.save(remote); err != nil {
+		return 0, err
+	}
+	s.loadSaved()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.domains), nil
+}
+
+// save 将远程列表写入临时文件后重命名，避免写入中断留下不完整的列表
+func (s *Service) save(domains map[string]struct{}) error {
+	var b strings.Builder
+	for d := range domains {
+		b.WriteString(d)
+		b.WriteByte('\n')
+	}
+	if err := os.MkdirAll(filepath.Dir(s.listPath), 0755); err != nil {
+		return fmt.Errorf("创建目录失败: %w", err)
+	}
+	tmp := s.listPath + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("保存一次性邮箱域名列表失败: %w", err)
+	}
+	if err := os.Rename(tmp, s.listPath); err != nil {
+		return fmt.Errorf("保存一次性邮箱域名列表失败: %w", err)
+	}
+	return nil
+}
+
+// AutoRefresh 供定时任务调用：未开启自动更新或评论与注册均未启用拦截时跳过
+func (s *Service) AutoRefresh(ctx context.Context) (int, error) {
+	if !s.settingSvc.GetBool(constant.KeyDisposableEmailAutoUpdate.String()) {
+		return 0, nil
+	}
+	if s.action(ScopeComment) == ActionOff && s.action(ScopeRegister) == ActionOff {
+		return 0, nil
+	}
+	return s.Refresh(ctx)
+}
@@ -0,0 +1,215 @@
+package disposable_email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+// fakeStatRepo 在内存中保存累计的拦截次数，fail 为 true 时写入失败
+type fakeStatRepo struct {
+	counts map[string]int64
+	fail   bool
+}
+
+func (f *fakeStatRepo) Add(ctx context.Context, scope, action string, delta int64) error {
+	if f.fail {
+		return errors.New("db down")
+	}
+	f.counts[scope+":"+action] += delta
+	return nil
+}
+
+func (f *fakeStatRepo) Counts(ctx context.Context) (map[string]int64, error) {
+	counts := make(map[string]int64, len(f.counts))
+	for k, v := range f.counts {
+		counts[k] = v
+	}
+	return counts, nil
+}
+
+func newTestService(t *testing.T, values map[string]string) *Service {
+	t.Helper()
+	dir := t.TempDir()
+	s := &Service{
+		settingSvc: &fakeSettings{values: values},
+		httpClient: http.DefaultClient,
+		cacheSvc:   utility.NewMemoryCacheService(),
+		statRepo:   &fakeStatRepo{counts: map[string]int64{}},
+		listPath:   filepath.Join(dir, "domains.txt"),
+	}
+	s.loadSaved()
+	return s
+}
+
+func TestIsDisposable(t *testing.T) {
+	s := newTestService(t, map[string]string{
+		constant.KeyDisposableEmailAllowlist.String(): "yopmail.fr，@spam4.me",
+	})
+	cases := map[string]bool{
+		"a@mailinator.com":        true,
+		"a@MAILINATOR.com":        true,
+		"a@inbox.mailinator.com":  true,
+		"a@notmailinator.com":     false,
+		"a@example.com":           false,
+		"a@yopmail.fr":            false,
+		"a@sub.spam4.me":          false,
+		"not-an-email":            false,
+		"a@":                      false,
+		"a@guerrillamail.com.":    true,
+		"\"a@b\"@trashmail.com":   true,
+		"user@mail.yopmail.fr.cn": false,
+	}
+	for email, want := range cases {
+		if got := s.IsDisposable(email); got != want {
+			t.Errorf("IsDisposable(%q) = %v, want %v", email, got, want)
+		}
+	}
+}
+
+func TestMatchAndStats(t *testing.T) {
+	s := newTestService(t, map[string]string{
+		constant.KeyDisposableEmailCommentAction.String():  "hold",
+		constant.KeyDisposableEmailRegisterAction.String(): "unknown",
+	})
+	ctx := context.Background()
+
+	if got := s.Match(ScopeComment, "a@yopmail.com"); got != ActionHold {
+		t.Fatalf("评论应进入审核: %s", got)
+	}
+	if got := s.Match(ScopeComment, "a@example.com"); got != ActionOff {
+		t.Fatalf("普通邮箱不应拦截: %s", got)
+	}
+	if got := s.Match(ScopeRegister, "a@yopmail.com"); got != ActionOff {
+		t.Fatalf("未知的处理方式应视为不处理: %s", got)
+	}
+
+	s.Record(ctx, ScopeComment, ActionHold)
+	s.Record(ctx, ScopeComment, ActionHold)
+	s.Record(ctx, ScopeRegister, ActionReject)
+	s.Record(ctx, ScopeRegister, ActionOff)
+
+	stats := s.Stats(ctx)
+	if stats.CommentAction != ActionHold || stats.RegisterAction != ActionOff || stats.DomainCount == 0 || stats.UpdatedAt != nil {
+		t.Fatalf("统计信息错误: %+v", stats)
+	}
+	want := map[string]int64{"comment:hold": 2, "comment:reject": 0, "register:reject": 1, "register:hold": 0}
+	assertCounters(t, stats, want)
+
+	// 写入数据库后缓存清空，统计结果不变，之后的拦截继续在缓存中累加
+	if n, err := s.FlushCounters(ctx); err != nil || n != 3 {
+		t.Fatalf("FlushCounters = %d, %v, want 3", n, err)
+	}
+	if n, _ := s.FlushCounters(ctx); n != 0 {
+		t.Fatalf("重复写入了 %d 次拦截", n)
+	}
+	s.Record(ctx, ScopeComment, ActionHold)
+	want["comment:hold"] = 3
+	assertCounters(t, s.Stats(ctx), want)
+
+	// 数据库写入失败时次数留在缓存中，等待下次写入
+	repo := s.statRepo.(*fakeStatRepo)
+	repo.fail = true
+	if _, err := s.FlushCounters(ctx); err == nil {
+		t.Fatal("数据库写入失败时应返回错误")
+	}
+	assertCounters(t, s.Stats(ctx), want)
+	repo.fail = false
+	if n, err := s.FlushCounters(ctx); err != nil || n != 1 {
+		t.Fatalf("FlushCounters = %d, %v, want 1", n, err)
+	}
+	if repo.counts["comment:hold"] != 3 {
+		t.Fatalf("数据库中的计数 = %d, want 3", repo.counts["comment:hold"])
+	}
+}
+
+func assertCounters(t *testing.T, stats *Stats, want map[string]int64) {
+	t.Helper()
+	got := map[string]int64{}
+	for _, c := range stats.Counters {
+		got[string(c.Scope)+":"+string(c.Action)] = c.Count
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("计数 %s = %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	var body strings.Builder
+	body.WriteString("# comment\n\nFresh-Disposable.example\n")
+	for i := 0; i < minRemoteDomains; i++ {
+		fmt.Fprintf(&body, "d%d.example\n", i)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/short" {
+			_, _ = w.Write([]byte("only.example\n"))
+			return
+		}
+		_, _ = w.Write([]byte(body.String()))
+	}))
+	defer srv.Close()
+
+	values := map[string]string{
+		constant.KeyDisposableEmailCommentAction.String(): "reject",
+		constant.KeyDisposableEmailListURL.String():       srv.URL + "/short",
+	}
+	s := newTestService(t, values)
+	ctx := context.Background()
+
+	if _, err := s.Refresh(ctx); err == nil {
+		t.Fatal("域名过少的列表应被拒绝")
+	}
+	if s.IsDisposable("a@only.example") {
+		t.Fatal("更新失败时不应修改列表")
+	}
+
+	// 未开启自动更新时定时任务跳过
+	values[constant.KeyDisposableEmailListURL.String()] = srv.URL + "/list"
+	if n, err := s.AutoRefresh(ctx); err != nil || n != 0 {
+		t.Fatalf("未开启自动更新时应跳过: %d %v", n, err)
+	}
+
+	values[constant.KeyDisposableEmailAutoUpdate.String()] = "true"
+	n, err := s.AutoRefresh(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsDisposable("a@fresh-disposable.example") || !s.IsDisposable("a@mailinator.com") {
+		t.Fatal("远程列表应与内置列表合并")
+	}
+	if n != len(s.domains) || s.Stats(ctx).UpdatedAt == nil {
+		t.Fatalf("更新结果错误: %d", n)
+	}
+
+	// 重新创建服务时从本地文件加载
+	reloaded := &Service{settingSvc: s.settingSvc, listPath: s.listPath}
+	reloaded.loadSaved()
+	if !reloaded.IsDisposable("a@d7.example") {
+		t.Fatal("应加载已保存的远程列表")
+	}
+
+	values[constant.KeyDisposableEmailListURL.String()] = ""
+	if _, err := s.Refresh(ctx); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("未配置下载地址应返回参数错误: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package file
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// 支持的校验算法
+const (
+	checksumAlgoSHA256 = "sha256"
+	checksumAlgoCRC32C = "crc32c"
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksum 表示客户端提供的一个待校验的摘要值。
+type checksum struct {
+	algo     string
+	expected []byte
+}
+
+// parseChecksum 解析形如 "sha256=<hex>" 或 "crc32c:<hex>" 的校验值，空字符串返回 nil。
+func parseChecksum(value string) (*checksum, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	sep := strings.IndexAny(value, "=:")
+	if sep <= 0 {
+		return nil, fmt.Errorf("%w: 校验值格式应为 算法=十六进制摘要", constant.ErrBadRequest)
+	}
+	algo := strings.ToLower(strings.TrimSpace(value[:sep]))
+	digest, err := hex.DecodeString(strings.TrimSpace(value[sep+1:]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: 校验值不是合法的十六进制", constant.ErrBadRequest)
+	}
+
+	var size int
+	switch algo {
+	case checksumAlgoSHA256:
+		size = sha256.Size
+	case checksumAlgoCRC32C:
+		size = crc32.Size
+	default:
+		return nil, fmt.Errorf("%w: 不支持的校验算法 %s，仅支持 sha256 和 crc32c", constant.ErrBadRequest, algo)
+	}
+	if len(digest) != size {
+		return nil, fmt.Errorf("%w: %s 摘要长度应为 %d 字节", constant.ErrBadRequest, algo, size)
+	}
+	return &checksum{algo: algo, expected: digest}, nil
+}
+
+// newHash 返回与校验算法对应的哈希器。
+func (c *checksum) newHash() hash.Hash {
+	if c.algo == checksumAlgoCRC32C {
+		return crc32.New(crc32cTable)
+	}
+	return sha256.New()
+}
+
+// verify 比较哈希器的计算结果与期望值。
+func (c *checksum) verify(h hash.Hash) error {
+	actual := h.Sum(nil)
+	if !bytes.Equal(actual, c.expected) {
+		return fmt.Errorf("%w: %s 期望 %x，实际 %x", constant.ErrChecksumMismatch, c.algo, c.expected, actual)
+	}
+	return nil
+}
+
+// String 返回规范化后的 "算法=摘要" 表示，便于存入会话。
+func (c *checksum) String() string {
+	return c.algo + "=" + hex.EncodeToString(c.expected)
+}
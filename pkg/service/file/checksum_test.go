@@ -0,0 +1,49 @@
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+func TestParseChecksum(t *testing.T) {
+	data := []byte("hello anheyu")
+	sum := sha256.Sum256(data)
+	crc := crc32.Checksum(data, crc32cTable)
+	crcHex := hex.EncodeToString([]byte{byte(crc >> 24), byte(crc >> 16), byte(crc >> 8), byte(crc)})
+
+	for _, value := range []string{
+		"sha256=" + hex.EncodeToString(sum[:]),
+		"SHA256:" + hex.EncodeToString(sum[:]),
+		"crc32c=" + crcHex,
+	} {
+		c, err := parseChecksum(value)
+		if err != nil {
+			t.Fatalf("parseChecksum(%q): %v", value, err)
+		}
+		h := c.newHash()
+		h.Write(data)
+		if err := c.verify(h); err != nil {
+			t.Errorf("%q 校验应通过: %v", value, err)
+		}
+
+		h = c.newHash()
+		h.Write([]byte("corrupted"))
+		if err := c.verify(h); !errors.Is(err, constant.ErrChecksumMismatch) {
+			t.Errorf("%q 数据被篡改时应返回 ErrChecksumMismatch，实际 %v", value, err)
+		}
+	}
+
+	if c, err := parseChecksum(""); c != nil || err != nil {
+		t.Errorf("空校验值应返回 nil, nil")
+	}
+	for _, bad := range []string{"md5=d41d8cd98f00b204e9800998ecf8427e", "sha256=zz", "crc32c=0011", "deadbeef"} {
+		if _, err := parseChecksum(bad); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("parseChecksum(%q) 应返回 ErrBadRequest，实际 %v", bad, err)
+		}
+	}
+}
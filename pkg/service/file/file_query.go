@@ -136,23 +136,11 @@ func (s *serviceImpl) QueryByURI(ctx context.Context, ownerID, viewerID uint, pa
 
 	if token == nil { // 判断是否为第一页请求 (没有提供token)
 		uploadingFiles := []*model.File{}
-		uploadingEntities, err := s.entityRepo.FindUploadingByOwnerID(ctx, ownerID)
+		uploadingSessions, err := s.uploadSessionRepo.ListActiveByOwnerID(ctx, ownerID)
 		if err != nil {
 			fmt.Printf("警告: 查询上传中任务失败: %v\n", err)
 		} else {
-			for _, entity := range uploadingEntities {
-				const uploadSessionCachePrefix = "upload_session:"
-				sessionKey := uploadSessionCachePrefix + entity.UploadSessionID.String
-				sessionJSON, cacheErr := s.cacheSvc.Get(ctx, sessionKey)
-				if cacheErr != nil {
-					continue
-				}
-
-				var session model.UploadSession
-				if json.Unmarshal([]byte(sessionJSON), &session) != nil {
-					continue
-				}
-
+			for _, session := range uploadingSessions {
 				sessionURI, _ := uri.Parse(session.URI)
 				if sessionURI != nil && filepath.Dir(sessionURI.Path) == parsedURI.Path {
 					var pID int64
@@ -164,11 +152,11 @@ func (s *serviceImpl) QueryByURI(ctx context.Context, ownerID, viewerID uint, pa
 						ID: 0, OwnerID: ownerID,
 						ParentID: sql.NullInt64{Int64: pID, Valid: parentFolder != nil},
 						Name:     filepath.Base(sessionURI.Path),
-						Size:     entity.Size, Type: model.FileTypeFile,
-						UpdatedAt: entity.UpdatedAt, CreatedAt: entity.CreatedAt,
+						Size:     session.FileSize, Type: model.FileTypeFile,
+						UpdatedAt: session.LastActiveAt, CreatedAt: session.CreatedAt,
 						Metas: map[string]string{
 							model.MetaKeyThumbStatus: "uploading",
-							"sys:upload_session_id":  entity.UploadSessionID.String,
+							"sys:upload_session_id":  session.SessionID,
 						},
 					}
 					uploadingFiles = append(uploadingFiles, tempFile)
@@ -576,6 +576,42 @@ func GetPolicyInfo(policy *model.StoragePolicy) (*model.StoragePolicyInfo, error
 	return &model.StoragePolicyInfo{ID: publicID, Name: policy.Name, Type: string(policy.Type), MaxSize: policy.MaxSize}, nil
 }
 
+// checkMountWritable 检查虚拟路径所在的别名挂载点是否允许写入：
+// 只读挂载点下禁止一切写操作，别名挂载点目录本身也不允许通过文件操作移动、重命名或删除。
+func checkMountWritable(policy *model.StoragePolicy, virtualPath string) error {
+	if policy == nil || policy.MountID == 0 {
+		return nil
+	}
+	if policy.ReadOnly {
+		return fmt.Errorf("路径 '%s' 位于挂载点 '%s' 下: %w", virtualPath, policy.VirtualPath, constant.ErrMountReadOnly)
+	}
+	if strings.TrimSuffix(virtualPath, "/") == policy.VirtualPath {
+		return fmt.Errorf("挂载点目录 '%s' 只能通过挂载点管理修改: %w", virtualPath, constant.ErrForbidden)
+	}
+	return nil
+}
+
+// checkItemWritable 根据文件记录所在的挂载点检查是否允许对其执行写操作，记录不存在时交由后续逻辑处理；
+// 无法解析路径或所在策略时拒绝写入，避免绕过只读挂载点。
+func (s *serviceImpl) checkItemWritable(ctx context.Context, fileID uint, repo repository.FileRepository) error {
+	item, err := repo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("查询文件记录失败: %w", err)
+	}
+	fullVirtualPath, err := s.GetFullVirtualPathWithRepo(ctx, item, repo)
+	if err != nil {
+		return fmt.Errorf("获取 '%s' 的虚拟路径失败: %w", item.Name, err)
+	}
+	policy, err := s.vfsSvc.FindPolicyForPath(ctx, fullVirtualPath)
+	if err != nil {
+		return fmt.Errorf("找不到路径 '%s' 的存储策略: %w", fullVirtualPath, err)
+	}
+	return checkMountWritable(policy, fullVirtualPath)
+}
+
 // GetRelativePathsForMove 是一个用于移动和重命名操作的辅助函数。
 func (s *serviceImpl) GetRelativePathsForMove(policy *model.StoragePolicy, oldVirtualPath, newVirtualPath string) (string, string, error) {
 	if !strings.HasPrefix(oldVirtualPath, policy.VirtualPath) {
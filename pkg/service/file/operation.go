@@ -201,6 +201,10 @@ func (s *serviceImpl) CopyItems(ctx context.Context, ownerID uint, sourcePublicI
 	if destFolder.OwnerID != ownerID {
 		return fmt.Errorf("无权复制到目标文件夹: %w", constant.ErrForbidden)
 	}
+	destPath, err := s.GetFullVirtualPath(ctx, destFolder)
+	if err != nil {
+		return fmt.Errorf("无法获取目标文件夹的路径: %w", err)
+	}
 
 	// 2. 将所有复制操作包裹在单个事务中，以确保原子性
 	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
@@ -219,6 +223,15 @@ func (s *serviceImpl) CopyItems(ctx context.Context, ownerID uint, sourcePublicI
 			if srcItem.OwnerID != ownerID {
 				return fmt.Errorf("无权复制项目 '%s': %w", srcItem.Name, constant.ErrForbidden)
 			}
+			// 目标位于只读挂载点时拒绝复制
+			newVirtualPath := filepath.ToSlash(filepath.Join(destPath, srcItem.Name))
+			destPolicy, err := s.vfsSvc.FindPolicyForPath(ctx, newVirtualPath)
+			if err != nil {
+				return fmt.Errorf("找不到路径 '%s' 的存储策略: %w", newVirtualPath, err)
+			}
+			if err := checkMountWritable(destPolicy, newVirtualPath); err != nil {
+				return err
+			}
 
 			// 调用递归辅助函数来执行真正的复制，并传入所有需要的事务性 repo
 			err = s.CopyRecursively(ctx, ownerID, srcItem, destFolder, repos.File, repos.Entity, repos.Metadata)
@@ -317,8 +330,11 @@ func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicI
 				log.Printf("【MOVE WARN】找不到路径 '%s' 的存储策略，将跳过物理移动。", oldVirtualPath)
 			} else {
 				newPolicy, _ := s.vfsSvc.FindPolicyForPath(ctx, newVirtualPath)
-				if newPolicy == nil || policy.ID != newPolicy.ID {
-					return fmt.Errorf("不支持跨存储策略移动")
+				if newPolicy == nil || policy.ID != newPolicy.ID || policy.VirtualPath != newPolicy.VirtualPath {
+					return fmt.Errorf("不支持跨存储策略或跨挂载点移动")
+				}
+				if err := checkMountWritable(policy, oldVirtualPath); err != nil {
+					return err
 				}
 				provider, err := s.GetProviderForPolicy(policy)
 				if err != nil {
@@ -345,8 +361,34 @@ func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicI
 	})
 }
 
-// DeleteItems 是删除文件或文件夹的入口，执行永久删除。
+// DeleteItems 是删除文件或文件夹的入口。回收站启用时将项目移入回收站，否则执行永久删除。
 func (s *serviceImpl) DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error {
+	retention := s.recycleBinRetention()
+	if retention <= 0 {
+		return s.DeleteItemsPermanently(ctx, ownerID, publicIDs)
+	}
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		for _, publicID := range publicIDs {
+			dbID, entityType, err := idgen.DecodePublicID(publicID)
+			if err != nil || entityType != idgen.EntityTypeFile {
+				log.Printf("【DELETE WARN】无效的公共ID '%s' 或类型不匹配，跳过删除。", publicID)
+				continue
+			}
+
+			if err := s.checkItemWritable(ctx, dbID, repos.File); err != nil {
+				return err
+			}
+
+			if err := s.moveToRecycleBin(ctx, ownerID, dbID, retention, repos); err != nil {
+				return fmt.Errorf("删除项目 '%s' (ID: %d) 失败: %w", publicID, dbID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteItemsPermanently 永久删除文件或文件夹，不经过回收站。
+func (s *serviceImpl) DeleteItemsPermanently(ctx context.Context, ownerID uint, publicIDs []string) error {
 	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		for _, publicID := range publicIDs {
 			dbID, entityType, err := idgen.DecodePublicID(publicID)
@@ -355,6 +397,10 @@ func (s *serviceImpl) DeleteItems(ctx context.Context, ownerID uint, publicIDs [
 				continue
 			}
 
+			if err := s.checkItemWritable(ctx, dbID, repos.File); err != nil {
+				return err
+			}
+
 			// 调用新的 HardDeleteRecursively，并传入所有需要的 repo
 			err = s.HardDeleteRecursively(ctx, ownerID, dbID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink)
 			if err != nil {
@@ -418,6 +464,9 @@ func (s *serviceImpl) RenameItem(ctx context.Context, ownerID uint, req *model.R
 		if err != nil {
 			return fmt.Errorf("为路径 '%s' 定位存储策略失败: %w", oldVirtualPath, err)
 		}
+		if err := checkMountWritable(policy, oldVirtualPath); err != nil {
+			return err
+		}
 
 		provider, err := s.GetProviderForPolicy(policy)
 		if err != nil {
@@ -495,6 +544,9 @@ func (s *serviceImpl) CreateEmptyFile(ctx context.Context, ownerID uint, req *mo
 
 		// 获取存储策略和提供者
 		policy, _ := s.vfsSvc.FindPolicyForPath(ctx, parsedURI.Path)
+		if err := checkMountWritable(policy, parsedURI.Path); err != nil {
+			return err
+		}
 		if policy != nil {
 			provider, err := s.GetProviderForPolicy(policy)
 			if err != nil {
@@ -585,6 +637,9 @@ func (s *serviceImpl) UpdateFileContentByIDAndURI(ctx context.Context, viewerPub
 	if err != nil {
 		return nil, fmt.Errorf("找不到路径 %s 的存储策略: %w", currentVirtualPath, err)
 	}
+	if err := checkMountWritable(policy, currentVirtualPath); err != nil {
+		return nil, err
+	}
 
 	provider, err := s.GetProviderForPolicy(policy)
 	if err != nil {
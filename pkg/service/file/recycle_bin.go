@@ -0,0 +1,552 @@
+/*
+ * @Description: 文件回收站：移入、还原、列表与过期清理
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package file
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+const (
+	// recycleTrashNamePrefix 移入回收站的项目（数据库记录与物理文件）统一重命名为 ".trash-<文件ID>"，
+	// 既让出原名称，又因以点开头而被同步服务忽略
+	recycleTrashNamePrefix = ".trash-"
+	// recycleTombstoneNamePrefix 还原时若原名称被已永久删除的记录占用，该记录会被重命名为 ".deleted-<文件ID>"
+	recycleTombstoneNamePrefix = ".deleted-"
+	// recycleBinCleanupBatchSize 定时清理时每批读取的过期条目数
+	recycleBinCleanupBatchSize = 100
+	defaultRecycleBinPageSize  = 20
+	maxRecycleBinPageSize      = 100
+)
+
+// trashName 返回项目在回收站中的名称
+func trashName(fileID uint) string {
+	return recycleTrashNamePrefix + strconv.FormatUint(uint64(fileID), 10)
+}
+
+// recycleBinRetention 返回回收站的保留时长，未配置或为 0 时返回 0，表示不使用回收站
+func (s *serviceImpl) recycleBinRetention() time.Duration {
+	days, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyRecycleBinRetentionDays.String())))
+	if err != nil || days <= 0 {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// renameSourceSegment 在物理重命名后同步更新实体的存储路径。
+// relPath 是实体相对于被重命名项目的路径（项目本身是文件时为空），source 必须以 "oldName/relPath" 结尾且位于路径段边界，
+// 否则说明存储路径与虚拟路径不对应，返回 false。source 中的分隔符可以是 "/" 或 "\"。
+func renameSourceSegment(source, relPath, oldName, newName string) (string, bool) {
+	suffix := oldName
+	if relPath != "" {
+		suffix += "/" + relPath
+	}
+	normalized := strings.ReplaceAll(source, "\\", "/")
+	if !strings.HasSuffix(normalized, suffix) {
+		return source, false
+	}
+	start := len(source) - len(suffix)
+	if start > 0 && normalized[start-1] != '/' {
+		return source, false
+	}
+	return source[:start] + newName + source[start+len(oldName):], true
+}
+
+// sourceRewrite 是一条待更新的实体存储路径
+type sourceRewrite struct {
+	entity    *model.FileStorageEntity
+	newSource string
+}
+
+// recycleRename 描述项目移入或移出回收站时需要执行的物理重命名，以及需要同步更新的实体存储路径
+type recycleRename struct {
+	policy     *model.StoragePolicy
+	parentPath string
+	fromName   string
+	toName     string
+	rewrites   []sourceRewrite
+	// unsupported 非空时说明无法安全地通过重命名完成操作，内容为原因
+	unsupported string
+}
+
+// planRecycleRename 计算把 parentPath 下名为 fromName 的项目重命名为 toName 所需的操作。
+// 云存储没有真正的目录，重命名文件夹需要逐个复制对象，因此只有本地存储的文件夹支持移入回收站；
+// 包含其他存储策略或挂载点的文件夹、存储路径与虚拟路径不对应的文件同样无法安全重命名。
+func (s *serviceImpl) planRecycleRename(ctx context.Context, repos repository.Repositories, item *model.File, parentPath, fromName, toName string) (*recycleRename, error) {
+	virtualPath := filepath.ToSlash(filepath.Join(parentPath, fromName))
+	policy, err := s.vfsSvc.FindPolicyForPath(ctx, virtualPath)
+	if err != nil {
+		return nil, fmt.Errorf("为路径 '%s' 定位存储策略失败: %w", virtualPath, err)
+	}
+	plan := &recycleRename{policy: policy, parentPath: parentPath, fromName: fromName, toName: toName}
+
+	if item.Type == model.FileTypeFile {
+		if err := s.planSourceRewrite(ctx, repos, plan, item, ""); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	}
+
+	linkedPolicy, err := repos.StoragePolicy.FindByNodeID(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("检查文件夹 '%s' 的策略关联失败: %w", fromName, err)
+	}
+	if linkedPolicy != nil {
+		plan.unsupported = fmt.Sprintf("文件夹是存储策略 '%s' 的根目录", linkedPolicy.Name)
+		return plan, nil
+	}
+	if policy.Type != constant.PolicyTypeLocal {
+		plan.unsupported = fmt.Sprintf("文件夹位于 %s 存储中", policy.Type)
+		return plan, nil
+	}
+	if err := s.planFolderRewrites(ctx, repos, plan, item.ID, virtualPath, ""); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// planFolderRewrites 递归遍历文件夹（包括其中已移入回收站的子项），收集所有子文件需要更新的存储路径
+func (s *serviceImpl) planFolderRewrites(ctx context.Context, repos repository.Repositories, plan *recycleRename, folderID uint, folderPath, relPath string) error {
+	children, err := repos.File.ListByParentIDUnscoped(ctx, folderID)
+	if err != nil {
+		return fmt.Errorf("列出文件夹 %d 的子项失败: %w", folderID, err)
+	}
+	for _, child := range children {
+		childRel := child.File.Name
+		if relPath != "" {
+			childRel = relPath + "/" + child.File.Name
+		}
+		if child.File.Type == model.FileTypeFile {
+			if err := s.planSourceRewrite(ctx, repos, plan, child.File, childRel); err != nil {
+				return err
+			}
+			continue
+		}
+
+		childPath := folderPath + "/" + child.File.Name
+		childPolicy, err := s.vfsSvc.FindPolicyForPath(ctx, childPath)
+		if err != nil {
+			return fmt.Errorf("为路径 '%s' 定位存储策略失败: %w", childPath, err)
+		}
+		if childPolicy.ID != plan.policy.ID || childPolicy.MountID != plan.policy.MountID {
+			plan.unsupported = fmt.Sprintf("子目录 '%s' 位于其他存储策略或挂载点下", childPath)
+			return nil
+		}
+		if err := s.planFolderRewrites(ctx, repos, plan, child.File.ID, childPath, childRel); err != nil {
+			return err
+		}
+		if plan.unsupported != "" {
+			return nil
+		}
+	}
+	return nil
+}
+
+// planSourceRewrite 计算文件主实体在重命名后的存储路径，已永久删除的文件没有实体，直接跳过
+func (s *serviceImpl) planSourceRewrite(ctx context.Context, repos repository.Repositories, plan *recycleRename, file *model.File, relPath string) error {
+	if !file.PrimaryEntityID.Valid {
+		return nil
+	}
+	entity, err := repos.Entity.FindByID(ctx, uint(file.PrimaryEntityID.Uint64))
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("查找文件 %d 的实体失败: %w", file.ID, err)
+	}
+	if entity == nil || !entity.Source.Valid {
+		return nil
+	}
+	newSource, ok := renameSourceSegment(entity.Source.String, relPath, plan.fromName, plan.toName)
+	if !ok {
+		plan.unsupported = fmt.Sprintf("文件 %d 的存储路径与虚拟路径不对应", file.ID)
+		return nil
+	}
+	plan.rewrites = append(plan.rewrites, sourceRewrite{entity: entity, newSource: newSource})
+	return nil
+}
+
+// apply 更新实体的存储路径，再执行物理重命名。物理重命名放在最后，失败时整个事务回滚。
+// 物理文件已不存在时只记录日志，数据库记录仍然可以移入或移出回收站。
+func (p *recycleRename) apply(ctx context.Context, s *serviceImpl, repos repository.Repositories) error {
+	for _, rewrite := range p.rewrites {
+		rewrite.entity.Source = sql.NullString{String: rewrite.newSource, Valid: true}
+		if err := repos.Entity.Update(ctx, rewrite.entity); err != nil {
+			return fmt.Errorf("更新实体 %d 的存储路径失败: %w", rewrite.entity.ID, err)
+		}
+	}
+
+	provider, err := s.GetProviderForPolicy(p.policy)
+	if err != nil {
+		return err
+	}
+	oldVirtualPath := filepath.ToSlash(filepath.Join(p.parentPath, p.fromName))
+	newVirtualPath := filepath.ToSlash(filepath.Join(p.parentPath, p.toName))
+	oldRelativePath, newRelativePath, err := s.GetRelativePathsForMove(p.policy, oldVirtualPath, newVirtualPath)
+	if err != nil {
+		return err
+	}
+	if err := provider.Rename(ctx, p.policy, oldRelativePath, newRelativePath); err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			log.Printf("【RECYCLE WARN】物理文件 '%s' 不存在，仅移动数据库记录", oldVirtualPath)
+			return nil
+		}
+		return fmt.Errorf("物理重命名失败: %w", err)
+	}
+	return nil
+}
+
+// moveToRecycleBin 将项目移入回收站：项目记录与物理文件被重命名为回收站名称并软删除，原位置记录在回收站条目中。
+// 无法安全重命名的项目（见 planRecycleRename）返回 ErrInvalidOperation，由调用方决定是否永久删除。此函数必须在数据库事务中运行。
+func (s *serviceImpl) moveToRecycleBin(ctx context.Context, ownerID, fileID uint, retention time.Duration, repos repository.Repositories) error {
+	item, err := repos.File.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			log.Printf("【RECYCLE INFO】项目 ID %d 已不存在或已在回收站中，跳过删除。", fileID)
+			return nil
+		}
+		return fmt.Errorf("查找待删除项 %d 失败: %w", fileID, err)
+	}
+	if item.OwnerID != ownerID {
+		return fmt.Errorf("无权删除项目 '%s' (ID: %d): %w", item.Name, item.ID, constant.ErrForbidden)
+	}
+	if !item.ParentID.Valid {
+		return errors.New("无法删除根目录")
+	}
+
+	parentID := uint(item.ParentID.Int64)
+	parentPath, err := s.GetFolderPathWithRepo(ctx, parentID, repos.File)
+	if err != nil {
+		return fmt.Errorf("无法确定项目的父路径: %w", err)
+	}
+	plan, err := s.planRecycleRename(ctx, repos, item, parentPath, item.Name, trashName(item.ID))
+	if err != nil {
+		return err
+	}
+	if plan.unsupported != "" {
+		return fmt.Errorf("'%s' 无法移入回收站（%s），如需删除请选择永久删除: %w", item.Name, plan.unsupported, constant.ErrInvalidOperation)
+	}
+
+	recycleItem := &model.RecycleItem{
+		OwnerID:          ownerID,
+		FileID:           item.ID,
+		OriginalName:     item.Name,
+		OriginalParentID: parentID,
+		OriginalPath:     filepath.ToSlash(filepath.Join(parentPath, item.Name)),
+		FileType:         item.Type,
+		Size:             item.Size,
+		ExpireAt:         time.Now().Add(retention),
+	}
+	if item.Type == model.FileTypeDir {
+		recycleItem.Size = 0
+	}
+
+	item.Name = plan.toName
+	if err := repos.File.Update(ctx, item); err != nil {
+		return fmt.Errorf("重命名项目记录失败: %w", err)
+	}
+	if err := repos.File.SoftDelete(ctx, item.ID); err != nil {
+		return fmt.Errorf("软删除项目记录失败: %w", err)
+	}
+	if err := repos.RecycleItem.Create(ctx, recycleItem); err != nil {
+		return fmt.Errorf("创建回收站条目失败: %w", err)
+	}
+	return plan.apply(ctx, s, repos)
+}
+
+// ensureFolderAvailable 检查文件夹及其所有上级目录都未被删除
+func ensureFolderAvailable(ctx context.Context, repo repository.FileRepository, folderID uint) error {
+	currentID := folderID
+	for i := 0; i < 100; i++ {
+		folder, err := repo.FindByID(ctx, currentID)
+		if err != nil {
+			return err
+		}
+		if !folder.ParentID.Valid {
+			return nil
+		}
+		currentID = uint(folder.ParentID.Int64)
+	}
+	return nil
+}
+
+// restoreFromRecycleBin 将回收站条目还原到原位置。原位置存在同名项目时返回 constant.ErrConflict；
+// 存储路径无法通过重命名同步还原时返回 constant.ErrInvalidOperation，不做部分还原。
+func (s *serviceImpl) restoreFromRecycleBin(ctx context.Context, ownerID, fileID uint, repos repository.Repositories) error {
+	recycleItem, err := repos.RecycleItem.FindByFileID(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	if recycleItem.OwnerID != ownerID {
+		return fmt.Errorf("无权还原项目 '%s': %w", recycleItem.OriginalName, constant.ErrForbidden)
+	}
+	item, err := repos.File.FindByIDUnscoped(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			// 记录已不存在，回收站条目失去意义
+			_ = repos.RecycleItem.DeleteByFileID(ctx, fileID)
+		}
+		return err
+	}
+
+	parentID := recycleItem.OriginalParentID
+	if err := ensureFolderAvailable(ctx, repos.File, parentID); err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return fmt.Errorf("'%s' 的原位置已被删除，请先还原其所在的文件夹: %w", recycleItem.OriginalPath, constant.ErrConflict)
+		}
+		return err
+	}
+	if _, err := repos.File.FindByParentIDAndName(ctx, parentID, recycleItem.OriginalName); !errors.Is(err, constant.ErrNotFound) {
+		if err == nil {
+			return fmt.Errorf("原位置已存在同名项目 '%s': %w", recycleItem.OriginalName, constant.ErrConflict)
+		}
+		return fmt.Errorf("检查同名冲突时出错: %w", err)
+	}
+	if err := releaseTombstoneName(ctx, repos.File, parentID, recycleItem.OriginalName, item.ID); err != nil {
+		return err
+	}
+
+	parentPath, err := s.GetFolderPathWithRepo(ctx, parentID, repos.File)
+	if err != nil {
+		return fmt.Errorf("无法确定原位置的路径: %w", err)
+	}
+	plan, err := s.planRecycleRename(ctx, repos, item, parentPath, item.Name, recycleItem.OriginalName)
+	if err != nil {
+		return err
+	}
+	if plan.unsupported != "" {
+		return fmt.Errorf("'%s' 无法还原（%s）: %w", recycleItem.OriginalPath, plan.unsupported, constant.ErrInvalidOperation)
+	}
+
+	item.Name = recycleItem.OriginalName
+	if err := repos.File.Update(ctx, item); err != nil {
+		return fmt.Errorf("还原项目记录失败: %w", err)
+	}
+	if err := repos.File.Restore(ctx, item.ID); err != nil {
+		return fmt.Errorf("还原项目记录失败: %w", err)
+	}
+	if err := repos.RecycleItem.DeleteByFileID(ctx, item.ID); err != nil {
+		return fmt.Errorf("删除回收站条目失败: %w", err)
+	}
+	return plan.apply(ctx, s, repos)
+}
+
+// releaseTombstoneName 同一目录下已永久删除的记录仍占用着唯一索引中的名称，还原前将其改名让出原名称
+func releaseTombstoneName(ctx context.Context, repo repository.FileRepository, parentID uint, name string, restoringID uint) error {
+	siblings, err := repo.ListByParentIDUnscoped(ctx, parentID)
+	if err != nil {
+		return fmt.Errorf("列出原位置的子项失败: %w", err)
+	}
+	for _, sibling := range siblings {
+		if !sibling.IsDeleted || sibling.File.ID == restoringID || sibling.File.Name != name {
+			continue
+		}
+		sibling.File.Name = recycleTombstoneNamePrefix + strconv.FormatUint(uint64(sibling.File.ID), 10)
+		if err := repo.Update(ctx, sibling.File); err != nil {
+			return fmt.Errorf("释放已删除记录占用的名称失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// purgeRecycleItem 彻底删除回收站条目对应的项目及其物理文件。
+// 文件夹中先于它移入回收站的子项会随之删除，它们的回收站条目也一并移除。此函数必须在数据库事务中运行。
+func (s *serviceImpl) purgeRecycleItem(ctx context.Context, recycleItem *model.RecycleItem, repos repository.Repositories) error {
+	if recycleItem.FileType == model.FileTypeDir {
+		if err := forgetNestedRecycleItems(ctx, repos, recycleItem.FileID); err != nil {
+			return err
+		}
+	}
+	if err := repos.RecycleItem.DeleteByFileID(ctx, recycleItem.FileID); err != nil {
+		return fmt.Errorf("删除回收站条目失败: %w", err)
+	}
+	return s.HardDeleteRecursively(ctx, recycleItem.OwnerID, recycleItem.FileID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink)
+}
+
+// forgetNestedRecycleItems 移除文件夹中已移入回收站的子项对应的回收站条目
+func forgetNestedRecycleItems(ctx context.Context, repos repository.Repositories, folderID uint) error {
+	children, err := repos.File.ListByParentIDUnscoped(ctx, folderID)
+	if err != nil {
+		return fmt.Errorf("列出文件夹 %d 的子项失败: %w", folderID, err)
+	}
+	for _, child := range children {
+		if child.IsDeleted {
+			if err := repos.RecycleItem.DeleteByFileID(ctx, child.File.ID); err != nil {
+				return fmt.Errorf("删除回收站条目失败: %w", err)
+			}
+		}
+		if child.File.Type == model.FileTypeDir {
+			if err := forgetNestedRecycleItems(ctx, repos, child.File.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeRecycleFileIDs 将文件公共ID解码为数据库ID
+func decodeRecycleFileIDs(publicIDs []string) ([]uint, error) {
+	ids := make([]uint, 0, len(publicIDs))
+	for _, publicID := range publicIDs {
+		dbID, entityType, err := idgen.DecodePublicID(publicID)
+		if err != nil || entityType != idgen.EntityTypeFile {
+			return nil, fmt.Errorf("无效的文件ID '%s': %w", publicID, constant.ErrInvalidPublicID)
+		}
+		ids = append(ids, dbID)
+	}
+	return ids, nil
+}
+
+// ListRecycleBin 分页列出用户回收站中的条目，按移入回收站的时间倒序排列
+func (s *serviceImpl) ListRecycleBin(ctx context.Context, ownerID uint, page, pageSize int) (*model.RecycleItemListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultRecycleBinPageSize
+	}
+	if pageSize > maxRecycleBinPageSize {
+		pageSize = maxRecycleBinPageSize
+	}
+	items, total, err := s.recycleItemRepo.ListByOwnerID(ctx, ownerID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取回收站列表失败: %w", err)
+	}
+	list := make([]*model.RecycleItemDTO, 0, len(items))
+	for _, item := range items {
+		publicID, err := idgen.GeneratePublicID(item.FileID, idgen.EntityTypeFile)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, &model.RecycleItemDTO{
+			ID:           publicID,
+			Name:         item.OriginalName,
+			OriginalPath: item.OriginalPath,
+			Type:         int(item.FileType),
+			Size:         item.Size,
+			TrashedAt:    item.TrashedAt,
+			ExpireAt:     item.ExpireAt,
+		})
+	}
+	return &model.RecycleItemListResponse{List: list, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+// RestoreRecycleItems 将回收站中的条目还原到原位置，任何一个条目还原失败时全部回滚
+func (s *serviceImpl) RestoreRecycleItems(ctx context.Context, ownerID uint, publicIDs []string) error {
+	ids, err := decodeRecycleFileIDs(publicIDs)
+	if err != nil {
+		return err
+	}
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		for _, id := range ids {
+			if err := s.restoreFromRecycleBin(ctx, ownerID, id, repos); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PurgeRecycleItems 彻底删除回收站中的条目，all 为 true 时清空整个回收站（忽略 publicIDs）
+func (s *serviceImpl) PurgeRecycleItems(ctx context.Context, ownerID uint, publicIDs []string, all bool) error {
+	if !all && len(publicIDs) == 0 {
+		return fmt.Errorf("未指定要彻底删除的项目: %w", constant.ErrInvalidOperation)
+	}
+	var ids []uint
+	if !all {
+		var err error
+		if ids, err = decodeRecycleFileIDs(publicIDs); err != nil {
+			return err
+		}
+	}
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if all {
+			items, err := repos.RecycleItem.ListAllByOwnerID(ctx, ownerID)
+			if err != nil {
+				return fmt.Errorf("获取回收站列表失败: %w", err)
+			}
+			for _, item := range items {
+				ids = append(ids, item.FileID)
+			}
+		}
+		for _, id := range ids {
+			// 重新读取条目：清空回收站时，文件夹中的子项可能已随文件夹一起删除
+			item, err := repos.RecycleItem.FindByFileID(ctx, id)
+			if err != nil {
+				if errors.Is(err, constant.ErrNotFound) {
+					continue
+				}
+				return err
+			}
+			if item.OwnerID != ownerID {
+				return fmt.Errorf("无权删除项目 '%s': %w", item.OriginalName, constant.ErrForbidden)
+			}
+			if err := s.purgeRecycleItem(ctx, item, repos); err != nil {
+				return fmt.Errorf("彻底删除 '%s' 失败: %w", item.OriginalPath, err)
+			}
+		}
+		return nil
+	})
+}
+
+// CleanupExpiredRecycleItems 彻底删除所有用户回收站中已过期的条目。每个条目在单独的事务中删除，
+// 单个条目失败只记录日志，不影响其他条目。
+func (s *serviceImpl) CleanupExpiredRecycleItems(ctx context.Context) (int, error) {
+	now := time.Now()
+	purged := 0
+	failed := make(map[uint]bool)
+	for {
+		// 失败的条目仍会被查询到，扩大批大小以免它们占满整批
+		limit := recycleBinCleanupBatchSize + len(failed)
+		items, err := s.recycleItemRepo.FindExpired(ctx, now, limit)
+		if err != nil {
+			return purged, fmt.Errorf("查询过期的回收站条目失败: %w", err)
+		}
+		progressed := false
+		for _, item := range items {
+			if failed[item.FileID] {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return purged, err
+			}
+			err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
+				current, err := repos.RecycleItem.FindByFileID(ctx, item.FileID)
+				if err != nil {
+					if errors.Is(err, constant.ErrNotFound) {
+						return nil
+					}
+					return err
+				}
+				return s.purgeRecycleItem(ctx, current, repos)
+			})
+			if err != nil {
+				log.Printf("【RECYCLE ERROR】彻底删除过期的回收站条目 '%s' (文件ID: %d) 失败: %v", item.OriginalPath, item.FileID, err)
+				failed[item.FileID] = true
+				continue
+			}
+			purged++
+			progressed = true
+		}
+		if !progressed || len(items) < limit {
+			return purged, nil
+		}
+	}
+}
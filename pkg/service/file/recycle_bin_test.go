@@ -0,0 +1,295 @@
+package file
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+func TestRenameSourceSegment(t *testing.T) {
+	cases := []struct {
+		source, rel, from, to string
+		want                  string
+		ok                    bool
+	}{
+		{"/data/storage/docs/a.txt", "", "a.txt", ".trash-3", "/data/storage/docs/.trash-3", true},
+		{"/data/storage/docs/sub/a.txt", "sub/a.txt", "docs", ".trash-2", "/data/storage/.trash-2/sub/a.txt", true},
+		{`D:\storage\docs\a.txt`, "a.txt", "docs", ".trash-2", `D:\storage\.trash-2\a.txt`, true},
+		{"uploads/a.txt", "", "a.txt", ".trash-3", "uploads/.trash-3", true},
+		{"a.txt", "", "a.txt", ".trash-3", ".trash-3", true},
+		{"/data/storage/mydocs/a.txt", "a.txt", "docs", ".trash-2", "/data/storage/mydocs/a.txt", false},
+		{"/data/storage/3f2a9c", "", "a.txt", ".trash-3", "/data/storage/3f2a9c", false},
+	}
+	for _, tc := range cases {
+		got, ok := renameSourceSegment(tc.source, tc.rel, tc.from, tc.to)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("renameSourceSegment(%q, %q, %q, %q) = %q, %v; want %q, %v", tc.source, tc.rel, tc.from, tc.to, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+type fakeTrashFileRepo struct {
+	repository.FileRepository
+	files   map[uint]*model.File
+	deleted map[uint]bool
+}
+
+func (f *fakeTrashFileRepo) FindByIDUnscoped(ctx context.Context, id uint) (*model.File, error) {
+	file, ok := f.files[id]
+	if !ok {
+		return nil, constant.ErrNotFound
+	}
+	copied := *file
+	return &copied, nil
+}
+
+func (f *fakeTrashFileRepo) FindByID(ctx context.Context, id uint) (*model.File, error) {
+	if f.deleted[id] {
+		return nil, constant.ErrNotFound
+	}
+	return f.FindByIDUnscoped(ctx, id)
+}
+
+func (f *fakeTrashFileRepo) FindByParentIDAndName(ctx context.Context, parentID uint, name string) (*model.File, error) {
+	for _, file := range f.files {
+		if !f.deleted[file.ID] && file.ParentID.Valid && uint(file.ParentID.Int64) == parentID && file.Name == name {
+			copied := *file
+			return &copied, nil
+		}
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (f *fakeTrashFileRepo) ListByParentIDUnscoped(ctx context.Context, parentID uint) ([]repository.SyncItem, error) {
+	var items []repository.SyncItem
+	for _, file := range f.files {
+		if file.ParentID.Valid && uint(file.ParentID.Int64) == parentID {
+			copied := *file
+			items = append(items, repository.SyncItem{File: &copied, IsDeleted: f.deleted[file.ID]})
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeTrashFileRepo) Update(ctx context.Context, file *model.File) error {
+	copied := *file
+	f.files[file.ID] = &copied
+	return nil
+}
+
+func (f *fakeTrashFileRepo) SoftDelete(ctx context.Context, id uint) error {
+	f.deleted[id] = true
+	return nil
+}
+
+func (f *fakeTrashFileRepo) Restore(ctx context.Context, id uint) error {
+	delete(f.deleted, id)
+	return nil
+}
+
+func (f *fakeEntityRepo) Update(ctx context.Context, entity *model.FileStorageEntity) error {
+	f.entities[entity.ID] = entity
+	return nil
+}
+
+type fakeTrashPolicyRepo struct {
+	repository.StoragePolicyRepository
+}
+
+func (f *fakeTrashPolicyRepo) FindByNodeID(ctx context.Context, nodeID uint) (*model.StoragePolicy, error) {
+	return nil, nil
+}
+
+type fakeRecycleItemRepo struct {
+	repository.RecycleItemRepository
+	items map[uint]*model.RecycleItem
+}
+
+func (f *fakeRecycleItemRepo) Create(ctx context.Context, item *model.RecycleItem) error {
+	f.items[item.FileID] = item
+	return nil
+}
+
+func (f *fakeRecycleItemRepo) FindByFileID(ctx context.Context, fileID uint) (*model.RecycleItem, error) {
+	item, ok := f.items[fileID]
+	if !ok {
+		return nil, constant.ErrNotFound
+	}
+	return item, nil
+}
+
+func (f *fakeRecycleItemRepo) DeleteByFileID(ctx context.Context, fileID uint) error {
+	delete(f.items, fileID)
+	return nil
+}
+
+type fakeLocalVFS struct {
+	volume.IVFSService
+	policy *model.StoragePolicy
+}
+
+func (f *fakeLocalVFS) FindPolicyForPath(ctx context.Context, virtualPath string) (*model.StoragePolicy, error) {
+	return f.policy, nil
+}
+
+func TestMoveToRecycleBinAndRestore(t *testing.T) {
+	base := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(base, "docs", "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(base, "docs", "sub", "a.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	parent := func(id uint) sql.NullInt64 { return sql.NullInt64{Int64: int64(id), Valid: true} }
+	files := &fakeTrashFileRepo{
+		files: map[uint]*model.File{
+			1: {ID: 1, OwnerID: 1, Type: model.FileTypeDir},
+			2: {ID: 2, OwnerID: 1, ParentID: parent(1), Name: "docs", Type: model.FileTypeDir},
+			3: {ID: 3, OwnerID: 1, ParentID: parent(2), Name: "sub", Type: model.FileTypeDir},
+			4: {ID: 4, OwnerID: 1, ParentID: parent(3), Name: "a.txt", Type: model.FileTypeFile, Size: 5,
+				PrimaryEntityID: types.NullUint64{Uint64: 10, Valid: true}},
+			// 同名的已永久删除记录，还原时需要让出名称
+			5: {ID: 5, OwnerID: 1, ParentID: parent(1), Name: "docs", Type: model.FileTypeFile},
+		},
+		deleted: map[uint]bool{5: true},
+	}
+	entities := &fakeEntityRepo{entities: map[uint]*model.FileStorageEntity{
+		10: {ID: 10, Source: sql.NullString{String: filepath.Join(base, "docs", "sub", "a.txt"), Valid: true}},
+	}}
+	recycle := &fakeRecycleItemRepo{items: make(map[uint]*model.RecycleItem)}
+	policy := &model.StoragePolicy{ID: 1, Type: constant.PolicyTypeLocal, VirtualPath: "/", BasePath: base}
+	s := &serviceImpl{
+		vfsSvc:           &fakeLocalVFS{policy: policy},
+		storageProviders: map[constant.StoragePolicyType]storage.IStorageProvider{constant.PolicyTypeLocal: storage.NewLocalProvider("")},
+	}
+	repos := repository.Repositories{File: files, Entity: entities, StoragePolicy: &fakeTrashPolicyRepo{}, RecycleItem: recycle}
+	ctx := context.Background()
+
+	if err := s.moveToRecycleBin(ctx, 2, 2, time.Hour, repos); !errors.Is(err, constant.ErrForbidden) {
+		t.Fatalf("不能删除其他用户的文件: %v", err)
+	}
+	if err := s.moveToRecycleBin(ctx, 1, 2, time.Hour, repos); err != nil {
+		t.Fatal(err)
+	}
+	trashedSource := filepath.Join(base, ".trash-2", "sub", "a.txt")
+	if _, err := os.Stat(trashedSource); err != nil {
+		t.Fatalf("物理目录应被重命名为回收站名称: %v", err)
+	}
+	if got := entities.entities[10].Source.String; got != trashedSource {
+		t.Errorf("子文件实体的存储路径应同步更新: %s", got)
+	}
+	if !files.deleted[2] || files.files[2].Name != ".trash-2" {
+		t.Errorf("文件夹记录应被重命名并软删除: %+v", files.files[2])
+	}
+	item := recycle.items[2]
+	if item == nil || item.OriginalName != "docs" || item.OriginalPath != "/docs" || item.OriginalParentID != 1 {
+		t.Fatalf("应记录原始位置: %+v", item)
+	}
+
+	// 原位置出现同名项目时不能还原
+	files.files[6] = &model.File{ID: 6, OwnerID: 1, ParentID: parent(1), Name: "docs", Type: model.FileTypeDir}
+	if err := s.restoreFromRecycleBin(ctx, 1, 2, repos); !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("原位置存在同名项目时应返回冲突: %v", err)
+	}
+	delete(files.files, 6)
+
+	if err := s.restoreFromRecycleBin(ctx, 1, 2, repos); err != nil {
+		t.Fatal(err)
+	}
+	originalSource := filepath.Join(base, "docs", "sub", "a.txt")
+	if _, err := os.Stat(originalSource); err != nil {
+		t.Fatalf("物理目录应还原到原位置: %v", err)
+	}
+	if got := entities.entities[10].Source.String; got != originalSource {
+		t.Errorf("子文件实体的存储路径应还原: %s", got)
+	}
+	if files.deleted[2] || files.files[2].Name != "docs" {
+		t.Errorf("文件夹记录应被还原: %+v", files.files[2])
+	}
+	if files.files[5].Name != ".deleted-5" {
+		t.Errorf("已永久删除的同名记录应让出名称: %s", files.files[5].Name)
+	}
+	if _, ok := recycle.items[2]; ok {
+		t.Error("还原后应删除回收站条目")
+	}
+}
+
+func TestRestoreRequiresOriginalFolder(t *testing.T) {
+	files := &fakeTrashFileRepo{
+		files: map[uint]*model.File{
+			1: {ID: 1, OwnerID: 1, Type: model.FileTypeDir},
+			2: {ID: 2, OwnerID: 1, ParentID: sql.NullInt64{Int64: 1, Valid: true}, Name: ".trash-2", Type: model.FileTypeDir},
+			3: {ID: 3, OwnerID: 1, ParentID: sql.NullInt64{Int64: 2, Valid: true}, Name: ".trash-3", Type: model.FileTypeFile},
+		},
+		deleted: map[uint]bool{2: true, 3: true},
+	}
+	recycle := &fakeRecycleItemRepo{items: map[uint]*model.RecycleItem{
+		3: {OwnerID: 1, FileID: 3, OriginalName: "a.txt", OriginalParentID: 2, OriginalPath: "/docs/a.txt"},
+	}}
+	s := &serviceImpl{}
+	repos := repository.Repositories{File: files, RecycleItem: recycle}
+
+	err := s.restoreFromRecycleBin(context.Background(), 1, 3, repos)
+	if !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("所在文件夹仍在回收站中时应拒绝还原: %v", err)
+	}
+	if err := s.restoreFromRecycleBin(context.Background(), 1, 9, repos); !errors.Is(err, constant.ErrNotFound) {
+		t.Error("回收站中不存在的项目应返回未找到")
+	}
+}
+
+func TestMoveToRecycleBinRejectsUnsupportedItems(t *testing.T) {
+	files := &fakeTrashFileRepo{
+		files: map[uint]*model.File{
+			1: {ID: 1, OwnerID: 1, Type: model.FileTypeDir},
+			2: {ID: 2, OwnerID: 1, ParentID: sql.NullInt64{Int64: 1, Valid: true}, Name: "photos", Type: model.FileTypeDir},
+		},
+		deleted: map[uint]bool{},
+	}
+	recycle := &fakeRecycleItemRepo{items: make(map[uint]*model.RecycleItem)}
+	s := &serviceImpl{vfsSvc: &fakeLocalVFS{policy: &model.StoragePolicy{ID: 2, Type: constant.PolicyTypeTencentCOS, VirtualPath: "/"}}}
+	repos := repository.Repositories{File: files, StoragePolicy: &fakeTrashPolicyRepo{}, RecycleItem: recycle}
+
+	err := s.moveToRecycleBin(context.Background(), 1, 2, time.Hour, repos)
+	if !errors.Is(err, constant.ErrInvalidOperation) {
+		t.Fatalf("云存储中的文件夹无法移入回收站，应返回错误而不是永久删除: %v", err)
+	}
+	if files.deleted[2] || files.files[2].Name != "photos" || len(recycle.items) != 0 {
+		t.Errorf("拒绝时不应修改记录: %+v", files.files[2])
+	}
+}
+
+func TestRestoreRejectsUnsupportedItems(t *testing.T) {
+	files := &fakeTrashFileRepo{
+		files: map[uint]*model.File{
+			1: {ID: 1, OwnerID: 1, Type: model.FileTypeDir},
+			2: {ID: 2, OwnerID: 1, ParentID: sql.NullInt64{Int64: 1, Valid: true}, Name: ".trash-2", Type: model.FileTypeDir},
+		},
+		deleted: map[uint]bool{2: true},
+	}
+	recycle := &fakeRecycleItemRepo{items: map[uint]*model.RecycleItem{
+		2: {OwnerID: 1, FileID: 2, OriginalName: "photos", OriginalParentID: 1, OriginalPath: "/photos", FileType: model.FileTypeDir},
+	}}
+	s := &serviceImpl{vfsSvc: &fakeLocalVFS{policy: &model.StoragePolicy{ID: 2, Type: constant.PolicyTypeTencentCOS, VirtualPath: "/"}}}
+	repos := repository.Repositories{File: files, StoragePolicy: &fakeTrashPolicyRepo{}, RecycleItem: recycle}
+
+	err := s.restoreFromRecycleBin(context.Background(), 1, 2, repos)
+	if !errors.Is(err, constant.ErrInvalidOperation) {
+		t.Fatalf("存储路径无法同步还原时应返回错误而不是部分还原: %v", err)
+	}
+	if !files.deleted[2] || files.files[2].Name != ".trash-2" || recycle.items[2] == nil {
+		t.Errorf("拒绝时不应修改记录: %+v", files.files[2])
+	}
+}
@@ -33,8 +33,18 @@ type FileService interface {
 	// UpdateFileContent 更新一个已存在的文件的内容
 	UpdateFileContentByIDAndURI(ctx context.Context, viewerPublicID, filePublicID, uriStr string, contentReader io.Reader) (*model.UpdateResult, error)
 
-	// DeleteItems 根据一个或多个公共ID，批量永久删除文件或目录。
+	// DeleteItems 根据一个或多个公共ID，批量将文件或目录移入回收站；回收站未启用时永久删除。
 	DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error
+	// DeleteItemsPermanently 根据一个或多个公共ID，批量永久删除文件或目录，不经过回收站。用于系统内部的清理。
+	DeleteItemsPermanently(ctx context.Context, ownerID uint, publicIDs []string) error
+	// ListRecycleBin 分页列出用户回收站中的条目。
+	ListRecycleBin(ctx context.Context, ownerID uint, page, pageSize int) (*model.RecycleItemListResponse, error)
+	// RestoreRecycleItems 将回收站中的条目还原到原位置。
+	RestoreRecycleItems(ctx context.Context, ownerID uint, publicIDs []string) error
+	// PurgeRecycleItems 彻底删除回收站中的条目，all 为 true 时清空整个回收站。
+	PurgeRecycleItems(ctx context.Context, ownerID uint, publicIDs []string, all bool) error
+	// CleanupExpiredRecycleItems 彻底删除所有用户回收站中已过期的条目及其物理文件，返回删除的条目数。
+	CleanupExpiredRecycleItems(ctx context.Context) (int, error)
 	// RenameItem 重命名一个文件或目录。
 	RenameItem(ctx context.Context, ownerID uint, req *model.RenameItemRequest) (*model.FileInfoResponse, error)
 	// Download 提供一个流式下载文件的服务。
@@ -90,6 +100,8 @@ type serviceImpl struct {
 	txManager         repository.TransactionManager
 	entityRepo        repository.EntityRepository
 	fileEntityRepo    repository.FileEntityRepository
+	uploadSessionRepo repository.UploadSessionRepository
+	recycleItemRepo   repository.RecycleItemRepository
 	userGroupRepo     repository.UserGroupRepository
 	metadataService   *file_info.MetadataService
 	extractionSvc     *file_info.ExtractionService
@@ -110,6 +122,8 @@ func NewService(
 	txManager repository.TransactionManager,
 	entityRepo repository.EntityRepository,
 	fileEntityRepo repository.FileEntityRepository,
+	uploadSessionRepo repository.UploadSessionRepository,
+	recycleItemRepo repository.RecycleItemRepository,
 	userGroupRepo repository.UserGroupRepository,
 	metadataService *file_info.MetadataService,
 	extractionSvc *file_info.ExtractionService,
@@ -128,6 +142,8 @@ func NewService(
 		txManager:         txManager,
 		entityRepo:        entityRepo,
 		fileEntityRepo:    fileEntityRepo,
+		uploadSessionRepo: uploadSessionRepo,
+		recycleItemRepo:   recycleItemRepo,
 		userGroupRepo:     userGroupRepo,
 		metadataService:   metadataService,
 		extractionSvc:     extractionSvc,
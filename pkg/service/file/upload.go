@@ -3,11 +3,12 @@ package file
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -31,10 +32,14 @@ import (
 
 // 定义常量以提高代码可维护性
 const (
-	uploadSessionCachePrefix = "upload:session:"
-	defaultUploadChunkSize   = 5 * 1024 * 1024 // 5MB
-	uploadSessionExpiration  = 24 * time.Hour
-	defaultUploadTempDir     = "./data/temp/uploads"
+	defaultUploadChunkSize = 5 * 1024 * 1024 // 5MB
+	// uploadSessionExpiration 会话在最后一次活跃后的保留时长，每接收一个分片都会顺延
+	uploadSessionExpiration = 24 * time.Hour
+	defaultUploadTempDir    = "./data/temp/uploads"
+	// expiredSessionBatchSize 后台清理每批处理的过期会话数
+	expiredSessionBatchSize = 100
+	// throughputSmoothing 上传速度指数滑动平均的平滑系数，越大越偏向最近一个分片
+	throughputSmoothing = 0.3
 )
 
 // IUploadService 定义了所有与文件上传相关的业务逻辑接口。
@@ -42,15 +47,22 @@ type IUploadService interface {
 	// CreateUploadSession 创建一个新的文件上传会话。
 	CreateUploadSession(ctx context.Context, ownerID uint, req *model.CreateUploadRequest) (*model.UploadSessionData, error)
 	// UploadChunk 上传文件的一个分片，ownerID 用于验证会话所有权。
-	UploadChunk(ctx context.Context, ownerID uint, sessionID string, index int, chunkStream io.Reader) error
+	// chunkChecksum 为可选的分片摘要（"sha256=<hex>" 或 "crc32c=<hex>"），提供时会在确认前校验。
+	UploadChunk(ctx context.Context, ownerID uint, sessionID string, index int, chunkStream io.Reader, chunkChecksum string) error
 	// DeleteUploadSession 删除一个正在进行的上传会话。
 	DeleteUploadSession(ctx context.Context, ownerID uint, req *model.DeleteUploadRequest) error
 	// GetUploadSessionStatus 获取指定上传会话的状态。
 	GetUploadSessionStatus(ctx context.Context, ownerID uint, sessionID string) (*model.UploadSessionStatusResponse, error)
+	// ListUploadSessions 列出用户所有仍然有效的上传会话，供前端刷新页面后恢复上传。
+	ListUploadSessions(ctx context.Context, ownerID uint) ([]*model.UploadSessionStatusResponse, error)
 	// CleanupAbandonedUploads 清理所有被遗弃的、超时的上传任务。
 	CleanupAbandonedUploads(ctx context.Context) (int, error)
 	// FinalizeClientUpload 处理客户端直传完成后的回调，在数据库中创建文件记录。
 	FinalizeClientUpload(ctx context.Context, ownerID uint, req *model.FinalizeUploadRequest) (*model.File, error)
+	// DirectUploadLimit 返回不经过上传会话、直接写入 virtualPath 的上传（如 WebDAV）允许的最大文件大小，0 表示不限制。
+	DirectUploadLimit(ctx context.Context, ownerID uint, virtualPath string) (int64, error)
+	// CheckDirectUpload 按与上传会话相同的规则校验直接写入 virtualPath 的上传，通过时返回写入成功后用于计数的回调。
+	CheckDirectUpload(ctx context.Context, ownerID uint, virtualPath string, size int64) (func(), error)
 }
 
 // uploadService 是 IUploadService 接口的实现。
@@ -58,10 +70,13 @@ type uploadService struct {
 	txManager        repository.TransactionManager                           // 事务管理器，用于保证数据库操作的原子性
 	eventBus         *event.EventBus                                         // 事件总线，用于发布文件创建等事件
 	entityRepo       repository.EntityRepository                             // 物理实体仓库
+	sessionRepo      repository.UploadSessionRepository                      // 上传会话仓库，会话持久化在数据库中，服务重启后可继续上传
 	metadataSvc      *file_info.MetadataService                              // 元数据服务
-	cacheSvc         utility.CacheService                                    // 缓存服务，用于存储上传会话
+	cacheSvc         utility.CacheService                                    // 缓存服务，用于统计每日上传次数
 	policySvc        volume.IStoragePolicyService                            // 存储策略服务
+	vfsSvc           volume.IVFSService                                      // 虚拟文件系统服务，用于解析别名挂载点
 	settingSvc       setting.SettingService                                  // 系统设置服务
+	userRepo         repository.UserRepository                               // 用户仓库，用于读取用户组的上传限制
 	storageProviders map[constant.StoragePolicyType]storage.IStorageProvider // 存储驱动提供者集合
 	uploadTempDir    string                                                  // 临时上传目录
 }
@@ -71,10 +86,13 @@ func NewUploadService(
 	txManager repository.TransactionManager,
 	eventBus *event.EventBus,
 	entityRepo repository.EntityRepository,
+	sessionRepo repository.UploadSessionRepository,
 	metadataSvc *file_info.MetadataService,
 	cacheSvc utility.CacheService,
 	policySvc volume.IStoragePolicyService,
+	vfsSvc volume.IVFSService,
 	settingSvc setting.SettingService,
+	userRepo repository.UserRepository,
 	providers map[constant.StoragePolicyType]storage.IStorageProvider,
 ) IUploadService {
 
@@ -87,53 +105,141 @@ func NewUploadService(
 		txManager:        txManager,
 		eventBus:         eventBus,
 		entityRepo:       entityRepo,
+		sessionRepo:      sessionRepo,
 		metadataSvc:      metadataSvc,
 		cacheSvc:         cacheSvc,
 		policySvc:        policySvc,
+		vfsSvc:           vfsSvc,
 		settingSvc:       settingSvc,
+		userRepo:         userRepo,
 		storageProviders: providers,
 		uploadTempDir:    tempDir,
 	}
 }
 
 // CleanupAbandonedUploads 清理所有被遗弃的上传会话及其相关资源。
-// 这是一个后台垃圾回收任务，用于删除那些已开始但长时间未完成的上传所产生的临时数据。
+// 这是一个后台垃圾回收任务，依次清理：已过期的会话、没有会话的临时实体，以及没有会话的分片目录。
 func (s *uploadService) CleanupAbandonedUploads(ctx context.Context) (int, error) {
-	abandonedThreshold := time.Now().Add(-24 * time.Hour)
+	now := time.Now()
+	cleanedCount := 0
+
+	// 1. 过期的会话：删除分片、临时实体与会话记录
+	for {
+		expired, err := s.sessionRepo.FindExpired(ctx, now, expiredSessionBatchSize)
+		if err != nil {
+			return cleanedCount, fmt.Errorf("查找过期上传会话失败: %w", err)
+		}
+		for _, session := range expired {
+			log.Printf("[GC-JOB] 正在清理过期上传会话: %s", session.SessionID)
+			s.cleanupTempFiles(session.SessionID)
+			s.deleteTempEntity(ctx, session)
+			if err := s.sessionRepo.Delete(ctx, session.SessionID); err != nil {
+				return cleanedCount, fmt.Errorf("删除上传会话 %s 失败: %w", session.SessionID, err)
+			}
+			cleanedCount++
+		}
+		if len(expired) < expiredSessionBatchSize {
+			break
+		}
+	}
+
+	// 2. 会话已不存在的临时实体（例如会话写入失败或旧版本遗留的数据）
+	abandonedThreshold := now.Add(-uploadSessionExpiration)
 	orphanedEntities, err := s.entityRepo.FindOrphaned(ctx, abandonedThreshold)
 	if err != nil {
-		return 0, fmt.Errorf("查找孤儿实体时发生数据库错误: %w", err)
+		return cleanedCount, fmt.Errorf("查找孤儿实体时发生数据库错误: %w", err)
 	}
-
-	if len(orphanedEntities) == 0 {
-		return 0, nil
+	sessionIDs := make([]string, 0, len(orphanedEntities))
+	for _, entity := range orphanedEntities {
+		sessionIDs = append(sessionIDs, entity.UploadSessionID.String)
+	}
+	activeSessions, err := s.sessionRepo.ExistingSessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return cleanedCount, fmt.Errorf("查询上传会话失败: %w", err)
 	}
-
-	cleanedCount := 0
 	for _, entity := range orphanedEntities {
 		sessionID := entity.UploadSessionID.String
-		if sessionID == "" {
+		if sessionID == "" || activeSessions[sessionID] {
 			continue
 		}
 		log.Printf("[GC-JOB] 正在清理孤儿实体 ID: %d, Session ID: %s", entity.ID, sessionID)
-		// 删除磁盘上的临时分片文件
-		sessionTempDir := filepath.Join(s.uploadTempDir, sessionID)
-		if err := os.RemoveAll(sessionTempDir); err != nil {
-			log.Printf("[GC-WARN] 清理磁盘目录 %s 失败: %v", sessionTempDir, err)
-		}
-		// 删除数据库中的临时实体记录
+		s.cleanupTempFiles(sessionID)
 		if err := s.entityRepo.HardDelete(ctx, entity.ID); err != nil {
 			log.Printf("[GC-ERROR] 删除数据库中的孤儿实体记录 %d 失败: %v", entity.ID, err)
 			continue
 		}
-		// 删除缓存中的会话信息
-		sessionKey := uploadSessionCachePrefix + sessionID
-		_ = s.cacheSvc.Delete(ctx, sessionKey)
 		cleanedCount++
 		log.Printf("[GC-JOB] 成功清理孤儿实体 ID: %d", entity.ID)
 	}
 
-	return cleanedCount, nil
+	// 3. 没有对应会话的分片目录
+	removed, err := s.cleanupOrphanedChunkDirs(ctx, abandonedThreshold)
+	if err != nil {
+		return cleanedCount, err
+	}
+	return cleanedCount + removed, nil
+}
+
+// cleanupOrphanedChunkDirs 删除临时上传目录中修改时间早于 before、且数据库中已没有对应会话的分片目录
+func (s *uploadService) cleanupOrphanedChunkDirs(ctx context.Context, before time.Time) (int, error) {
+	entries, err := os.ReadDir(s.uploadTempDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("读取临时上传目录失败: %w", err)
+	}
+	var candidates []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(before) {
+			continue
+		}
+		candidates = append(candidates, entry.Name())
+	}
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+	existing, err := s.sessionRepo.ExistingSessionIDs(ctx, candidates)
+	if err != nil {
+		return 0, fmt.Errorf("查询上传会话失败: %w", err)
+	}
+	removed := 0
+	for _, sessionID := range candidates {
+		if existing[sessionID] {
+			continue
+		}
+		log.Printf("[GC-JOB] 正在清理孤儿分片目录: %s", sessionID)
+		dir := filepath.Join(s.uploadTempDir, sessionID)
+		if err := os.RemoveAll(dir); err != nil {
+			log.Printf("[GC-WARN] 清理磁盘目录 %s 失败: %v", dir, err)
+			continue
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// deleteTempEntity 删除会话关联的临时实体。实体已被定稿（不再关联该会话）时跳过，避免误删正式文件的数据
+func (s *uploadService) deleteTempEntity(ctx context.Context, session *model.UploadSession) {
+	if session.TempEntityID == 0 {
+		return
+	}
+	entity, err := s.entityRepo.FindByID(ctx, session.TempEntityID)
+	if err != nil || entity == nil || entity.UploadSessionID.String != session.SessionID {
+		return
+	}
+	if err := s.entityRepo.HardDelete(ctx, session.TempEntityID); err != nil {
+		log.Printf(
+			"严重警告: 删除临时实体记录 %d (会话ID: %s) 失败: %v。请手动检查数据库。",
+			session.TempEntityID,
+			session.SessionID,
+			err,
+		)
+	}
 }
 
 // CreateUploadSession 在上传流程开始时，负责进行前置校验、确保目标目录存在，并创建一个临时的物理实体记录。
@@ -146,24 +252,24 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 	fileName := filepath.Base(req.URI)
 	fileExt := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
 
-	// 步骤 2: 从全局设置服务获取允许的扩展名并校验
-	allowedExtStr := s.settingSvc.Get(constant.KeyUploadAllowedExtensions.String())
-	if allowedExtStr != "" {
-		allowedList := strings.Split(allowedExtStr, ",")
-		isAllowed := false
-		for _, allowed := range allowedList {
-			if strings.TrimSpace(allowed) == fileExt {
-				isAllowed = true
-				break
-			}
-		}
-		if !isAllowed {
-			return nil, fmt.Errorf("不支持的文件类型: .%s", fileExt)
-		}
+	// 步骤 2: 按全局设置与用户组上传限制校验后缀、大小与当日上传次数
+	groupPolicy, err := s.checkUploadPolicy(ctx, ownerID, fileExt, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	// 步骤 3: 校验文件摘要格式与路径解析
+	fileChecksum, err := parseChecksum(req.Checksum)
+	if err != nil {
+		return nil, err
+	}
+	parsedURI, err := uri.Parse(req.URI)
+	if err != nil {
+		return nil, fmt.Errorf("解析目标URI失败: %w", err)
 	}
 
-	// 步骤 3: 根据请求中的 PolicyID 获取策略并校验文件大小
-	policy, err := s.policySvc.GetPolicyByID(ctx, req.PolicyID)
+	// 步骤 4: 根据请求中的 PolicyID 获取策略（按目标路径解析挂载点）并校验文件大小
+	policy, err := s.resolvePolicy(ctx, req.PolicyID, parsedURI.Path)
 	if err != nil {
 		if errors.Is(err, constant.ErrNotFound) {
 			return nil, errors.New("指定的存储策略不存在")
@@ -171,13 +277,7 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 		return nil, fmt.Errorf("获取存储策略失败: %w", err)
 	}
 	if policy.MaxSize > 0 && req.Size > policy.MaxSize {
-		return nil, fmt.Errorf("文件大小超出策略限制")
-	}
-
-	// 步骤 4: 路径解析
-	parsedURI, err := uri.Parse(req.URI)
-	if err != nil {
-		return nil, fmt.Errorf("解析目标URI失败: %w", err)
+		return nil, fmt.Errorf("%w: 超出存储策略限制", constant.ErrUploadFileTooLarge)
 	}
 
 	// 步骤 5: 根据策略决定上传方式并执行相应逻辑
@@ -233,6 +333,7 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 			return nil, fmt.Errorf("创建客户端直传链接失败: %w", err)
 		}
 
+		s.recordDailyUpload(ctx, ownerID, groupPolicy)
 		return &model.UploadSessionData{
 			Expires:      presignedResult.ExpirationDateTime.Unix(),
 			UploadMethod: constant.UploadMethodClient,
@@ -290,7 +391,8 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 	if chunkSize <= 0 {
 		chunkSize = defaultUploadChunkSize
 	}
-	// 创建会话对象并存入缓存
+	// 创建会话对象并存入数据库
+	now := time.Now()
 	session := &model.UploadSession{
 		SessionID:      sessionID,
 		OwnerID:        ownerID,
@@ -300,20 +402,20 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 		FileSize:       req.Size,
 		TempEntityID:   tempEntityID,
 		UploadedChunks: make(map[int]bool),
-		ExpireAt:       time.Now().Add(uploadSessionExpiration),
+		ExpireAt:       now.Add(uploadSessionExpiration),
+		CreatedAt:      now,
+		LastActiveAt:   now,
 	}
-	sessionKey := uploadSessionCachePrefix + sessionID
-	sessionBytes, err := json.Marshal(session)
-	if err != nil {
-		// 如果序列化失败，清理掉之前创建的临时实体
-		_ = s.entityRepo.HardDelete(context.Background(), tempEntityID)
-		return nil, fmt.Errorf("无法序列化上传会话: %w", err)
+	if fileChecksum != nil {
+		session.Checksum = fileChecksum.String()
 	}
-	if err := s.cacheSvc.Set(ctx, sessionKey, string(sessionBytes), uploadSessionExpiration); err != nil {
-		s.entityRepo.HardDelete(context.Background(), tempEntityID)
-		return nil, fmt.Errorf("无法创建上传会话缓存: %w", err)
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		// 如果会话保存失败，清理掉之前创建的临时实体
+		_ = s.entityRepo.HardDelete(context.Background(), tempEntityID)
+		return nil, fmt.Errorf("无法保存上传会话: %w", err)
 	}
 
+	s.recordDailyUpload(ctx, ownerID, groupPolicy)
 	return &model.UploadSessionData{
 		Expires:      session.ExpireAt.Unix(),
 		UploadMethod: constant.UploadMethodServer,
@@ -328,6 +430,27 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 	}, nil
 }
 
+// resolvePolicy 获取上传使用的存储策略。
+// 当目标路径位于该策略的别名挂载点下时，返回以别名路径为挂载点的策略副本，
+// 以保证物理路径计算正确；目标位于只读挂载点（无论属于哪个策略）或无法解析所在策略时拒绝上传。
+func (s *uploadService) resolvePolicy(ctx context.Context, policyID, virtualPath string) (*model.StoragePolicy, error) {
+	policy, err := s.policySvc.GetPolicyByID(ctx, policyID)
+	if err != nil || s.vfsSvc == nil {
+		return policy, err
+	}
+	mounted, err := s.vfsSvc.FindPolicyForPath(ctx, virtualPath)
+	if err != nil {
+		return nil, fmt.Errorf("找不到路径 %s 的存储策略: %w", virtualPath, err)
+	}
+	if err := checkMountWritable(mounted, virtualPath); err != nil {
+		return nil, err
+	}
+	if mounted.ID != policy.ID {
+		return policy, nil
+	}
+	return mounted, nil
+}
+
 // getProviderForPolicy 是一个辅助函数，用于根据存储策略获取对应的存储驱动实例。
 func (s *uploadService) getProviderForPolicy(policy *model.StoragePolicy) (storage.IStorageProvider, error) {
 	if policy == nil {
@@ -374,16 +497,18 @@ func (s *uploadService) findOrCreatePath(ctx context.Context, ownerID uint, path
 }
 
 // UploadChunk 处理单个分片的上传，并在所有分片完成后触发最终的合并与定稿流程。
-func (s *uploadService) UploadChunk(ctx context.Context, ownerID uint, sessionID string, index int, chunkStream io.Reader) error {
-	// 从缓存中获取会话信息
-	sessionKey := uploadSessionCachePrefix + sessionID
-	sessionJSON, err := s.cacheSvc.Get(ctx, sessionKey)
+func (s *uploadService) UploadChunk(ctx context.Context, ownerID uint, sessionID string, index int, chunkStream io.Reader, chunkChecksum string) error {
+	expectedChunk, err := parseChecksum(chunkChecksum)
 	if err != nil {
-		return errors.New("上传会话不存在或已过期")
+		return err
 	}
-	var session model.UploadSession
-	if err := json.Unmarshal([]byte(sessionJSON), &session); err != nil {
-		return fmt.Errorf("解析上传会话失败: %w", err)
+
+	session, err := s.findActiveSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return errors.New("上传会话不存在或已过期")
+		}
+		return fmt.Errorf("读取上传会话失败: %w", err)
 	}
 
 	if session.OwnerID != ownerID {
@@ -408,19 +533,51 @@ func (s *uploadService) UploadChunk(ctx context.Context, ownerID uint, sessionID
 	}
 	defer chunkFile.Close()
 
-	if _, err = io.Copy(chunkFile, chunkStream); err != nil {
+	var dst io.Writer = chunkFile
+	var chunkHash hash.Hash
+	if expectedChunk != nil {
+		chunkHash = expectedChunk.newHash()
+		dst = io.MultiWriter(chunkFile, chunkHash)
+	}
+
+	copyStart := time.Now()
+	written, err := io.Copy(dst, chunkStream)
+	if err != nil {
 		return fmt.Errorf("写入分块数据失败: %w", err)
 	}
 
-	// 更新会话状态，标记此分片已上传
-	session.UploadedChunks[index] = true
-	sessionBytes, err := json.Marshal(session)
+	// 校验失败时删除已写入的分片，不标记为已上传，客户端可重新上传该分片
+	if expectedChunk != nil {
+		if err := expectedChunk.verify(chunkHash); err != nil {
+			_ = chunkFile.Close()
+			_ = os.Remove(chunkFilePath)
+			return fmt.Errorf("分块 %d %w", index, err)
+		}
+	}
+
+	// 更新会话状态，标记此分片已上传，并根据本次分片的接收耗时更新上传速度。
+	// 并发上传的分片各自写回时由仓库基于版本号重试，不会互相覆盖
+	now := time.Now()
+	var speed float64
+	if elapsed := now.Sub(copyStart).Seconds(); elapsed > 0 && written > 0 {
+		speed = float64(written) / elapsed
+	}
+	session, err = s.sessionRepo.Update(ctx, sessionID, func(latest *model.UploadSession) {
+		latest.UploadedChunks[index] = true
+		latest.LastActiveAt = now
+		latest.ExpireAt = now.Add(uploadSessionExpiration)
+		if speed <= 0 {
+			return
+		}
+		if latest.Throughput <= 0 {
+			latest.Throughput = speed
+		} else {
+			latest.Throughput = throughputSmoothing*speed + (1-throughputSmoothing)*latest.Throughput
+		}
+	})
 	if err != nil {
 		return fmt.Errorf("更新上传会话失败: %w", err)
 	}
-	if err := s.cacheSvc.Set(ctx, sessionKey, string(sessionBytes), time.Until(session.ExpireAt)); err != nil {
-		return fmt.Errorf("更新上传会话缓存失败: %w", err)
-	}
 
 	// 检查是否所有分片都已上传完毕
 	allChunksUploaded := true
@@ -433,14 +590,15 @@ func (s *uploadService) UploadChunk(ctx context.Context, ownerID uint, sessionID
 
 	// 如果所有分片都已上传，则触发文件定稿流程
 	if allChunksUploaded {
-		if err := s.completeFileUpload(ctx, &session); err != nil {
-			s.cleanupTempFiles(sessionID)
+		if err := s.completeFileUpload(ctx, session); err != nil {
+			if errors.Is(err, constant.ErrChecksumMismatch) {
+				// 合并后的文件已损坏，整个会话作废，需要客户端重新发起上传
+				_ = s.DeleteUploadSession(ctx, ownerID, &model.DeleteUploadRequest{ID: sessionID})
+			} else {
+				s.cleanupTempFiles(sessionID)
+			}
 			return fmt.Errorf("文件上传完成处理失败: %w", err)
 		}
-		// 定稿成功后，删除会话缓存
-		if err := s.cacheSvc.Delete(ctx, sessionKey); err != nil {
-			log.Printf("[UploadChunk] 警告: 删除上传会话缓存失败: %v\n", err)
-		}
 	}
 
 	return nil
@@ -455,6 +613,17 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 	if err != nil {
 		return fmt.Errorf("无法创建用于合并的临时文件: %w", err)
 	}
+	expectedFile, err := parseChecksum(session.Checksum)
+	if err != nil {
+		_ = mergedFile.Close()
+		return err
+	}
+	var mergeDst io.Writer = mergedFile
+	var fileHash hash.Hash
+	if expectedFile != nil {
+		fileHash = expectedFile.newHash()
+		mergeDst = io.MultiWriter(mergedFile, fileHash)
+	}
 	totalChunks := (int(session.FileSize) + session.ChunkSize - 1) / session.ChunkSize
 	for i := 0; i < totalChunks; i++ {
 		chunkPath := filepath.Join(sessionTempDir, strconv.Itoa(i))
@@ -463,7 +632,7 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 			_ = mergedFile.Close()
 			return fmt.Errorf("无法打开分块文件 %d: %w", i, err)
 		}
-		_, err = io.Copy(mergedFile, chunkFile)
+		_, err = io.Copy(mergeDst, chunkFile)
 		_ = chunkFile.Close()
 		if err != nil {
 			_ = mergedFile.Close()
@@ -473,8 +642,19 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 	_ = mergedFile.Close()
 	defer s.cleanupTempFiles(session.SessionID)
 
+	// 合并完成后校验整个文件的摘要，避免将静默损坏的数据写入最终存储
+	if expectedFile != nil {
+		if err := expectedFile.verify(fileHash); err != nil {
+			return fmt.Errorf("合并后的文件%w", err)
+		}
+	}
+
 	// 2. 上传到最终存储
-	policy, err := s.policySvc.GetPolicyByID(ctx, session.PolicyID)
+	parsedURI, err := uri.Parse(session.URI)
+	if err != nil {
+		return fmt.Errorf("解析目标URI失败: %w", err)
+	}
+	policy, err := s.resolvePolicy(ctx, session.PolicyID, parsedURI.Path)
 	if err != nil {
 		return fmt.Errorf("无法在完成阶段获取存储策略: %w", err)
 	}
@@ -489,7 +669,6 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 	}
 	defer fileToUpload.Close()
 
-	parsedURI, _ := uri.Parse(session.URI)
 	uploadResult, err := provider.Upload(ctx, fileToUpload, policy, parsedURI.Path)
 	if err != nil {
 		return fmt.Errorf("存储提供者上传失败: %w", err)
@@ -549,7 +728,8 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 			return fmt.Errorf("创建文件版本关联记录失败: %w", err)
 		}
 
-		return s.cacheSvc.Delete(ctx, uploadSessionCachePrefix+session.SessionID)
+		// 会话与实体、文件记录在同一事务中删除，避免会话残留导致后台清理误删已定稿的实体
+		return repos.UploadSession.Delete(ctx, session.SessionID)
 	})
 
 	if err != nil {
@@ -571,15 +751,12 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 
 // DeleteUploadSession 用于客户端主动取消一个正在进行的上传会话。
 func (s *uploadService) DeleteUploadSession(ctx context.Context, ownerID uint, req *model.DeleteUploadRequest) error {
-	sessionKey := uploadSessionCachePrefix + req.ID
-	sessionJSON, err := s.cacheSvc.Get(ctx, sessionKey)
+	session, err := s.sessionRepo.FindBySessionID(ctx, req.ID)
 	if err != nil {
-		return nil // 会话不存在或已过期，直接认为成功
-	}
-	var session model.UploadSession
-	if err := json.Unmarshal([]byte(sessionJSON), &session); err != nil {
-		_ = s.cacheSvc.Delete(ctx, sessionKey)
-		return fmt.Errorf("解析上传会话失败，但已尝试清理缓存: %w", err)
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil // 会话不存在或已被清理，直接认为成功
+		}
+		return fmt.Errorf("读取上传会话失败: %w", err)
 	}
 
 	if session.OwnerID != ownerID {
@@ -590,20 +767,12 @@ func (s *uploadService) DeleteUploadSession(ctx context.Context, ownerID uint, r
 	s.cleanupTempFiles(req.ID)
 
 	// 清理数据库中的临时实体
-	if session.TempEntityID > 0 {
-		if err := s.entityRepo.HardDelete(ctx, session.TempEntityID); err != nil {
-			fmt.Printf(
-				"严重警告: 删除临时实体记录 %d (会话ID: %s) 失败: %v。请手动检查数据库。\n",
-				session.TempEntityID,
-				req.ID,
-				err,
-			)
-		}
-	}
-
-	// 清理缓存中的会话
-	_ = s.cacheSvc.Delete(ctx, sessionKey)
+	s.deleteTempEntity(ctx, session)
 
+	// 清理会话记录
+	if err := s.sessionRepo.Delete(ctx, req.ID); err != nil {
+		return fmt.Errorf("删除上传会话失败: %w", err)
+	}
 	return nil
 }
 
@@ -635,40 +804,114 @@ func (s *uploadService) isThumbnailable(file *model.File) bool {
 }
 
 // GetUploadSessionStatus 实现了根据 sessionID 获取上传状态的逻辑。
+// 返回的已上传分片会与磁盘上的分片文件核对，客户端据此只需重新上传缺失的分片。
 func (s *uploadService) GetUploadSessionStatus(ctx context.Context, ownerID uint, sessionID string) (*model.UploadSessionStatusResponse, error) {
-	sessionKey := uploadSessionCachePrefix + sessionID
-	sessionJSON, err := s.cacheSvc.Get(ctx, sessionKey)
+	session, err := s.findActiveSession(ctx, sessionID)
 	if err != nil {
-		return nil, fmt.Errorf("从缓存服务获取会话失败: %w", err)
-	}
-
-	var session model.UploadSession
-	if err := json.Unmarshal([]byte(sessionJSON), &session); err != nil {
-		return nil, fmt.Errorf("解析上传会话JSON失败: %w", err)
+		return nil, err
 	}
 
 	if session.OwnerID != ownerID {
 		return nil, constant.ErrForbidden
 	}
 
-	uploadedChunksSlice := make([]int, 0, len(session.UploadedChunks))
-	for chunkIndex := range session.UploadedChunks {
-		uploadedChunksSlice = append(uploadedChunksSlice, chunkIndex)
+	s.dropMissingChunks(session)
+	return buildSessionStatus(session), nil
+}
+
+// ListUploadSessions 列出用户名下所有未过期的上传会话，结果按最近活跃时间倒序排列。
+func (s *uploadService) ListUploadSessions(ctx context.Context, ownerID uint) ([]*model.UploadSessionStatusResponse, error) {
+	activeSessions, err := s.sessionRepo.ListActiveByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("查询进行中的上传任务失败: %w", err)
+	}
+
+	sessions := make([]*model.UploadSessionStatusResponse, 0, len(activeSessions))
+	for _, session := range activeSessions {
+		s.dropMissingChunks(session)
+		sessions = append(sessions, buildSessionStatus(session))
 	}
+	return sessions, nil
+}
 
+// findActiveSession 读取会话，已过期但尚未被后台任务清理的会话同样视为不存在
+func (s *uploadService) findActiveSession(ctx context.Context, sessionID string) (*model.UploadSession, error) {
+	session, err := s.sessionRepo.FindBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(session.ExpireAt) {
+		return nil, constant.ErrNotFound
+	}
+	return session, nil
+}
+
+// dropMissingChunks 移除分片文件已不在磁盘上的分片标记（例如临时目录被手动清理），让客户端重新上传这些分片
+func (s *uploadService) dropMissingChunks(session *model.UploadSession) {
+	sessionTempDir := filepath.Join(s.uploadTempDir, session.SessionID)
+	for index := range session.UploadedChunks {
+		if _, err := os.Stat(filepath.Join(sessionTempDir, strconv.Itoa(index))); err != nil {
+			delete(session.UploadedChunks, index)
+		}
+	}
+}
+
+// buildSessionStatus 根据会话计算分片位图、已上传字节数、速度与预计剩余时间。
+func buildSessionStatus(session *model.UploadSession) *model.UploadSessionStatusResponse {
 	totalChunks := 0
 	if session.ChunkSize > 0 {
 		totalChunks = (int(session.FileSize) + session.ChunkSize - 1) / session.ChunkSize
 	}
 
+	uploadedChunksSlice := make([]int, 0, len(session.UploadedChunks))
+	bitmap := make([]byte, totalChunks)
+	var uploadedBytes int64
+	for i := 0; i < totalChunks; i++ {
+		if !session.UploadedChunks[i] {
+			bitmap[i] = '0'
+			continue
+		}
+		bitmap[i] = '1'
+		uploadedChunksSlice = append(uploadedChunksSlice, i)
+		chunkBytes := int64(session.ChunkSize)
+		if i == totalChunks-1 {
+			// 最后一个分片可能不满
+			chunkBytes = session.FileSize - int64(i)*int64(session.ChunkSize)
+		}
+		uploadedBytes += chunkBytes
+	}
+
+	progress := 100.0
+	if session.FileSize > 0 {
+		progress = math.Round(float64(uploadedBytes)/float64(session.FileSize)*10000) / 100
+	}
+
+	var eta int64 = -1
+	remaining := session.FileSize - uploadedBytes
+	if remaining <= 0 {
+		eta = 0
+	} else if session.Throughput > 0 {
+		eta = int64(math.Ceil(float64(remaining) / session.Throughput))
+	}
+
 	return &model.UploadSessionStatusResponse{
 		SessionID:      session.SessionID,
 		IsValid:        true,
+		URI:            session.URI,
+		PolicyID:       session.PolicyID,
+		FileSize:       session.FileSize,
 		ChunkSize:      int64(session.ChunkSize),
 		TotalChunks:    totalChunks,
 		UploadedChunks: uploadedChunksSlice,
+		ChunkBitmap:    string(bitmap),
+		UploadedBytes:  uploadedBytes,
+		Progress:       progress,
+		Throughput:     int64(session.Throughput),
+		ETASeconds:     eta,
+		CreatedAt:      session.CreatedAt,
+		LastActiveAt:   session.LastActiveAt,
 		ExpiresAt:      session.ExpireAt,
-	}, nil
+	}
 }
 
 // FinalizeClientUpload 处理客户端直传完成后的回调。
@@ -684,7 +927,7 @@ func (s *uploadService) FinalizeClientUpload(ctx context.Context, ownerID uint,
 	fileName := filepath.Base(parsedURI.Path)
 
 	// 步骤 2: 获取存储策略
-	policy, err := s.policySvc.GetPolicyByID(ctx, req.PolicyID)
+	policy, err := s.resolvePolicy(ctx, req.PolicyID, parsedURI.Path)
 	if err != nil {
 		if errors.Is(err, constant.ErrNotFound) {
 			return nil, errors.New("指定的存储策略不存在")
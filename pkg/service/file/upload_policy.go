@@ -0,0 +1,159 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	// uploadDailyCountPrefix 每日上传次数的缓存键前缀，完整键为 前缀用户ID:日期
+	uploadDailyCountPrefix = "upload:daily:"
+	// uploadDailyCountExpiration 计数键的过期时间，略长于一天以覆盖跨时区的边界
+	uploadDailyCountExpiration = 25 * time.Hour
+)
+
+// containsExtension 判断后缀名列表中是否包含 ext
+func containsExtension(list []string, ext string) bool {
+	for _, item := range list {
+		if item == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// groupUploadPolicy 返回用户所在用户组的上传限制，未设置时返回 nil
+func (s *uploadService) groupUploadPolicy(ctx context.Context, ownerID uint) (*model.GroupUploadPolicy, error) {
+	if s.userRepo == nil {
+		return nil, nil
+	}
+	user, err := s.userRepo.FindByID(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	if user == nil {
+		return nil, nil
+	}
+	return user.UserGroup.Settings.UploadPolicy, nil
+}
+
+// checkUploadPolicy 按全局上传设置与用户组上传限制校验文件后缀、大小与当日上传次数，
+// 返回生效的用户组限制，供会话创建成功后计数。
+// 全局黑名单仅在全局白名单未启用时生效；用户组的白名单与全局白名单同时满足，用户组黑名单始终生效。
+func (s *uploadService) checkUploadPolicy(ctx context.Context, ownerID uint, fileExt string, size int64) (*model.GroupUploadPolicy, error) {
+	globalAllowed := model.NormalizeExtensions(strings.Split(s.settingSvc.Get(constant.KeyUploadAllowedExtensions.String()), ","))
+	if len(globalAllowed) > 0 {
+		if !containsExtension(globalAllowed, fileExt) {
+			return nil, fmt.Errorf("%w: .%s", constant.ErrUploadExtensionNotAllowed, fileExt)
+		}
+	} else {
+		globalDenied := model.NormalizeExtensions(strings.Split(s.settingSvc.Get(constant.KeyUploadDeniedExtensions.String()), ","))
+		if containsExtension(globalDenied, fileExt) {
+			return nil, fmt.Errorf("%w: .%s", constant.ErrUploadExtensionNotAllowed, fileExt)
+		}
+	}
+
+	policy, err := s.groupUploadPolicy(ctx, ownerID)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+
+	if allowed := model.NormalizeExtensions(policy.AllowedExtensions); len(allowed) > 0 && !containsExtension(allowed, fileExt) {
+		return nil, fmt.Errorf("%w: 当前用户组不允许上传 .%s 文件", constant.ErrUploadExtensionNotAllowed, fileExt)
+	}
+	if containsExtension(model.NormalizeExtensions(policy.DeniedExtensions), fileExt) {
+		return nil, fmt.Errorf("%w: 当前用户组不允许上传 .%s 文件", constant.ErrUploadExtensionNotAllowed, fileExt)
+	}
+	if policy.MaxFileSize > 0 && size > policy.MaxFileSize {
+		return nil, fmt.Errorf("%w: 当前用户组单个文件最大 %d 字节", constant.ErrUploadFileTooLarge, policy.MaxFileSize)
+	}
+	if policy.MaxDailyUploads > 0 {
+		raw, err := s.cacheSvc.Get(ctx, uploadDailyCountKey(ownerID, time.Now()))
+		if err == nil && raw != "" {
+			if count, _ := strconv.Atoi(raw); count >= policy.MaxDailyUploads {
+				return nil, fmt.Errorf("%w: 当前用户组每天最多上传 %d 次", constant.ErrUploadDailyLimitExceeded, policy.MaxDailyUploads)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// recordDailyUpload 在上传会话创建成功后增加用户当日的上传次数，只有用户组设置了每日上限时才计数
+func (s *uploadService) recordDailyUpload(ctx context.Context, ownerID uint, policy *model.GroupUploadPolicy) {
+	if policy == nil || policy.MaxDailyUploads <= 0 {
+		return
+	}
+	key := uploadDailyCountKey(ownerID, time.Now())
+	count, err := s.cacheSvc.Increment(ctx, key)
+	if err != nil {
+		log.Printf("[WARNING] 记录用户 %d 的上传次数失败: %v", ownerID, err)
+		return
+	}
+	if count == 1 {
+		_ = s.cacheSvc.Expire(ctx, key, uploadDailyCountExpiration)
+	}
+}
+
+func uploadDailyCountKey(ownerID uint, now time.Time) string {
+	return fmt.Sprintf("%s%d:%s", uploadDailyCountPrefix, ownerID, now.Format("20060102"))
+}
+
+// directUploadPolicy 返回直接写入 virtualPath 时使用的存储策略，并校验挂载点是否可写
+func (s *uploadService) directUploadPolicy(ctx context.Context, virtualPath string) (*model.StoragePolicy, error) {
+	if s.vfsSvc == nil {
+		return nil, nil
+	}
+	policy, err := s.vfsSvc.FindPolicyForPath(ctx, virtualPath)
+	if err != nil {
+		return nil, fmt.Errorf("找不到路径 %s 的存储策略: %w", virtualPath, err)
+	}
+	if err := checkMountWritable(policy, virtualPath); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DirectUploadLimit 取存储策略与用户组单文件大小限制中较小的一个，均未设置时返回 0
+func (s *uploadService) DirectUploadLimit(ctx context.Context, ownerID uint, virtualPath string) (int64, error) {
+	var limit int64
+	policy, err := s.directUploadPolicy(ctx, virtualPath)
+	if err != nil {
+		return 0, err
+	}
+	if policy != nil {
+		limit = policy.MaxSize
+	}
+	group, err := s.groupUploadPolicy(ctx, ownerID)
+	if err != nil {
+		return 0, err
+	}
+	if group != nil && group.MaxFileSize > 0 && (limit == 0 || group.MaxFileSize < limit) {
+		limit = group.MaxFileSize
+	}
+	return limit, nil
+}
+
+// CheckDirectUpload 校验后缀、用户组限制与当日上传次数，以及存储策略的大小限制
+func (s *uploadService) CheckDirectUpload(ctx context.Context, ownerID uint, virtualPath string, size int64) (func(), error) {
+	fileExt := strings.ToLower(strings.TrimPrefix(filepath.Ext(virtualPath), "."))
+	groupPolicy, err := s.checkUploadPolicy(ctx, ownerID, fileExt, size)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.directUploadPolicy(ctx, virtualPath)
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil && policy.MaxSize > 0 && size > policy.MaxSize {
+		return nil, fmt.Errorf("%w: 超出存储策略限制", constant.ErrUploadFileTooLarge)
+	}
+	return func() { s.recordDailyUpload(context.WithoutCancel(ctx), ownerID, groupPolicy) }, nil
+}
@@ -0,0 +1,95 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users map[uint]*model.User
+}
+
+func (f *fakeUserRepo) FindByID(ctx context.Context, id uint) (*model.User, error) {
+	return f.users[id], nil
+}
+
+func TestCheckUploadPolicy(t *testing.T) {
+	values := map[string]string{
+		constant.KeyUploadDeniedExtensions.String(): "exe, .BAT",
+	}
+	s := &uploadService{
+		settingSvc: &fakeSettings{values: values},
+		cacheSvc:   utility.NewMemoryCacheService(),
+		userRepo: &fakeUserRepo{users: map[uint]*model.User{
+			1: {ID: 1},
+			2: {ID: 2, UserGroup: model.UserGroup{Settings: model.GroupSettings{UploadPolicy: &model.GroupUploadPolicy{
+				AllowedExtensions: []string{".JPG", "png", "zip"},
+				DeniedExtensions:  []string{"zip"},
+				MaxFileSize:       1024,
+				MaxDailyUploads:   2,
+			}}}},
+		}},
+	}
+	ctx := context.Background()
+
+	if _, err := s.checkUploadPolicy(ctx, 1, "bat", 10); !errors.Is(err, constant.ErrUploadExtensionNotAllowed) {
+		t.Fatalf("全局黑名单应生效: %v", err)
+	}
+	if policy, err := s.checkUploadPolicy(ctx, 1, "txt", 1<<30); err != nil || policy != nil {
+		t.Fatalf("未设置用户组限制时只受全局设置约束: %v", err)
+	}
+
+	cases := []struct {
+		ext  string
+		size int64
+		want error
+	}{
+		{"jpg", 100, nil},
+		{"txt", 100, constant.ErrUploadExtensionNotAllowed},
+		{"zip", 100, constant.ErrUploadExtensionNotAllowed},
+		{"png", 2048, constant.ErrUploadFileTooLarge},
+	}
+	for _, tc := range cases {
+		if _, err := s.checkUploadPolicy(ctx, 2, tc.ext, tc.size); !errors.Is(err, tc.want) {
+			t.Errorf(".%s %d 字节: got %v, want %v", tc.ext, tc.size, err, tc.want)
+		}
+	}
+
+	// 全局白名单启用后黑名单不再生效，但用户组白名单仍需同时满足
+	values[constant.KeyUploadAllowedExtensions.String()] = "png,bat"
+	if _, err := s.checkUploadPolicy(ctx, 1, "bat", 10); err != nil {
+		t.Fatalf("全局白名单启用时不检查全局黑名单: %v", err)
+	}
+	if _, err := s.checkUploadPolicy(ctx, 2, "jpg", 10); !errors.Is(err, constant.ErrUploadExtensionNotAllowed) {
+		t.Fatalf("用户组白名单不能放宽全局白名单: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		policy, err := s.checkUploadPolicy(ctx, 2, "png", 10)
+		if err != nil {
+			t.Fatalf("第 %d 次上传不应受限: %v", i+1, err)
+		}
+		s.recordDailyUpload(ctx, 2, policy)
+	}
+	if _, err := s.checkUploadPolicy(ctx, 2, "png", 10); !errors.Is(err, constant.ErrUploadDailyLimitExceeded) {
+		t.Fatalf("超过每日上传次数应被拒绝: %v", err)
+	}
+	if _, err := s.checkUploadPolicy(ctx, 1, "png", 10); err != nil {
+		t.Fatalf("每日上传次数按用户计算: %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package file
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakeSessionRepo struct {
+	repository.UploadSessionRepository
+	sessions map[string]*model.UploadSession
+}
+
+func (f *fakeSessionRepo) FindBySessionID(ctx context.Context, sessionID string) (*model.UploadSession, error) {
+	session, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, constant.ErrNotFound
+	}
+	copied := *session
+	copied.UploadedChunks = make(map[int]bool)
+	for k, v := range session.UploadedChunks {
+		copied.UploadedChunks[k] = v
+	}
+	return &copied, nil
+}
+
+func (f *fakeSessionRepo) Update(ctx context.Context, sessionID string, mutate func(*model.UploadSession)) (*model.UploadSession, error) {
+	session, err := f.FindBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	mutate(session)
+	f.sessions[sessionID] = session
+	return session, nil
+}
+
+func (f *fakeSessionRepo) FindExpired(ctx context.Context, before time.Time, limit int) ([]*model.UploadSession, error) {
+	var expired []*model.UploadSession
+	for _, session := range f.sessions {
+		if session.ExpireAt.Before(before) && len(expired) < limit {
+			expired = append(expired, session)
+		}
+	}
+	return expired, nil
+}
+
+func (f *fakeSessionRepo) ExistingSessionIDs(ctx context.Context, sessionIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for _, id := range sessionIDs {
+		if _, ok := f.sessions[id]; ok {
+			existing[id] = true
+		}
+	}
+	return existing, nil
+}
+
+func (f *fakeSessionRepo) Delete(ctx context.Context, sessionID string) error {
+	delete(f.sessions, sessionID)
+	return nil
+}
+
+type fakeEntityRepo struct {
+	repository.EntityRepository
+	entities map[uint]*model.FileStorageEntity
+}
+
+func (f *fakeEntityRepo) FindByID(ctx context.Context, id uint) (*model.FileStorageEntity, error) {
+	return f.entities[id], nil
+}
+
+func (f *fakeEntityRepo) HardDelete(ctx context.Context, id uint) error {
+	delete(f.entities, id)
+	return nil
+}
+
+func (f *fakeEntityRepo) FindOrphaned(ctx context.Context, before time.Time) ([]*model.FileStorageEntity, error) {
+	var orphaned []*model.FileStorageEntity
+	for _, e := range f.entities {
+		if e.UploadSessionID.Valid {
+			orphaned = append(orphaned, e)
+		}
+	}
+	return orphaned, nil
+}
+
+func writeChunk(t *testing.T, dir, sessionID, name string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, sessionID), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, sessionID, name), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadSessionStatusAndResume(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	repo := &fakeSessionRepo{sessions: map[string]*model.UploadSession{
+		"s1": {
+			SessionID: "s1", OwnerID: 1, URI: "anzhiyu://my/a.bin", ChunkSize: 4, FileSize: 10,
+			UploadedChunks: map[int]bool{0: true, 1: true},
+			CreatedAt:      now, LastActiveAt: now, ExpireAt: now.Add(time.Hour),
+		},
+		"expired": {SessionID: "expired", OwnerID: 1, ChunkSize: 4, FileSize: 4, ExpireAt: now.Add(-time.Minute)},
+	}}
+	s := &uploadService{sessionRepo: repo, uploadTempDir: dir}
+	ctx := context.Background()
+	writeChunk(t, dir, "s1", "0")
+
+	status, err := s.GetUploadSessionStatus(ctx, 1, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.ChunkBitmap != "100" || len(status.UploadedChunks) != 1 {
+		t.Fatalf("磁盘上缺失的分片不应算作已上传: %+v", status)
+	}
+	if _, err := s.GetUploadSessionStatus(ctx, 2, "s1"); !errors.Is(err, constant.ErrForbidden) {
+		t.Errorf("其他用户不能读取会话: %v", err)
+	}
+	if _, err := s.GetUploadSessionStatus(ctx, 1, "expired"); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("过期的会话应视为不存在: %v", err)
+	}
+
+	if err := s.UploadChunk(ctx, 1, "s1", 1, strings.NewReader("abcd"), ""); err != nil {
+		t.Fatal(err)
+	}
+	saved := repo.sessions["s1"]
+	if !saved.UploadedChunks[1] || !saved.ExpireAt.After(now.Add(time.Hour)) {
+		t.Fatalf("上传分片后应持久化分片状态并顺延过期时间: %+v", saved)
+	}
+	if err := s.UploadChunk(ctx, 1, "expired", 0, strings.NewReader("abcd"), ""); err == nil {
+		t.Error("过期的会话不应继续接收分片")
+	}
+}
+
+func TestCleanupAbandonedUploads(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-48 * time.Hour)
+	sessions := &fakeSessionRepo{sessions: map[string]*model.UploadSession{
+		"expired": {SessionID: "expired", TempEntityID: 1, ExpireAt: old},
+		"active":  {SessionID: "active", TempEntityID: 2, ExpireAt: time.Now().Add(time.Hour)},
+	}}
+	entities := &fakeEntityRepo{entities: map[uint]*model.FileStorageEntity{
+		1: {ID: 1, UploadSessionID: sql.NullString{String: "expired", Valid: true}},
+		2: {ID: 2, UploadSessionID: sql.NullString{String: "active", Valid: true}},
+		3: {ID: 3, UploadSessionID: sql.NullString{String: "lost", Valid: true}},
+	}}
+	s := &uploadService{sessionRepo: sessions, entityRepo: entities, uploadTempDir: dir}
+	for _, id := range []string{"expired", "active", "orphan", "fresh"} {
+		writeChunk(t, dir, id, "0")
+	}
+	for _, id := range []string{"active", "orphan"} {
+		if err := os.Chtimes(filepath.Join(dir, id), old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cleaned, err := s.CleanupAbandonedUploads(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cleaned != 3 {
+		t.Errorf("应清理过期会话、孤儿实体与孤儿分片目录各一项，实际 %d", cleaned)
+	}
+	if _, ok := sessions.sessions["expired"]; ok {
+		t.Error("过期会话应被删除")
+	}
+	if _, ok := entities.entities[2]; !ok {
+		t.Error("仍在进行的会话对应的实体不应被删除")
+	}
+	if len(entities.entities) != 1 {
+		t.Errorf("过期会话与丢失会话的临时实体应被删除: %v", entities.entities)
+	}
+	for id, want := range map[string]bool{"expired": false, "orphan": false, "active": true, "fresh": true} {
+		if _, err := os.Stat(filepath.Join(dir, id)); (err == nil) != want {
+			t.Errorf("分片目录 %s 存在 = %v, want %v", id, err == nil, want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * @Description: 图片配色服务：按需在后台提取图片的主色与强调色并缓存
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 21:00:00
+ * @LastEditTime: 2026-10-17 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package image_palette
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// 配色的提取状态
+const (
+	StatusReady   = "ready"
+	StatusPending = "pending"
+	StatusFailed  = "failed"
+)
+
+const (
+	// paletteSize 提取的颜色数量：1 个主色与 4 个强调色
+	paletteSize = 5
+
+	cacheKeyPrefix = "image_palette:"
+	// readyTTL 图片内容不变时配色不会变化，长期缓存
+	readyTTL = 30 * 24 * time.Hour
+	// failedTTL 提取失败的结果短暂缓存，避免反复读取无法解码的图片
+	failedTTL = 10 * time.Minute
+)
+
+// Result 图片配色的查询结果
+type Result struct {
+	Status  string           `json:"status"`
+	Palette *utility.Palette `json:"palette,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+// Dispatcher 将配色提取派发到后台执行，由 task.Broker 实现
+type Dispatcher interface {
+	DispatchImagePaletteExtraction(publicID string, size int, onDone func(palette *utility.Palette, err error)) bool
+}
+
+// Service 图片配色服务
+type Service struct {
+	cacheSvc   utility.CacheService
+	dispatcher Dispatcher
+
+	// inflight 正在提取配色的图片，同一图片只派发一次任务
+	inflight sync.Map
+}
+
+// NewService 创建图片配色服务实例
+func NewService(cacheSvc utility.CacheService, dispatcher Dispatcher) *Service {
+	return &Service{
+		cacheSvc:   cacheSvc,
+		dispatcher: dispatcher,
+	}
+}
+
+// Get 返回图片（文件或直链公共ID）的配色。缓存中没有时派发后台任务并返回 pending，
+// 调用方稍后重试即可拿到结果。
+func (s *Service) Get(ctx context.Context, publicID string) (*Result, error) {
+	_, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || (entityType != idgen.EntityTypeFile && entityType != idgen.EntityTypeDirectLink) {
+		return nil, fmt.Errorf("无效的图片ID: %w", constant.ErrBadRequest)
+	}
+
+	key := cacheKeyPrefix + publicID
+	if cached, err := s.cacheSvc.Get(ctx, key); err == nil && cached != "" {
+		var result Result
+		if json.Unmarshal([]byte(cached), &result) == nil {
+			return &result, nil
+		}
+	}
+
+	if _, loaded := s.inflight.LoadOrStore(publicID, struct{}{}); loaded {
+		return &Result{Status: StatusPending}, nil
+	}
+	dispatched := s.dispatcher.DispatchImagePaletteExtraction(publicID, paletteSize, func(palette *utility.Palette, err error) {
+		defer s.inflight.Delete(publicID)
+		s.store(key, palette, err)
+	})
+	if !dispatched {
+		s.inflight.Delete(publicID)
+		return nil, fmt.Errorf("配色提取服务未就绪")
+	}
+	return &Result{Status: StatusPending}, nil
+}
+
+// store 缓存提取结果，失败结果只保留较短时间
+func (s *Service) store(key string, palette *utility.Palette, err error) {
+	result, ttl := Result{Status: StatusReady, Palette: palette}, readyTTL
+	if err != nil {
+		result, ttl = Result{Status: StatusFailed, Error: err.Error()}, failedTTL
+	}
+	data, _ := json.Marshal(result)
+	if err := s.cacheSvc.Set(context.Background(), key, string(data), ttl); err != nil {
+		slog.Warn("缓存图片配色失败", slog.String("key", key), slog.Any("error", err))
+	}
+}
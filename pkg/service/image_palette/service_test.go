@@ -0,0 +1,95 @@
+package image_palette
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// fakeDispatcher 记录派发次数，由测试决定何时完成任务
+type fakeDispatcher struct {
+	calls   int
+	pending []func(*utility.Palette, error)
+}
+
+func (d *fakeDispatcher) DispatchImagePaletteExtraction(_ string, _ int, onDone func(*utility.Palette, error)) bool {
+	d.calls++
+	d.pending = append(d.pending, onDone)
+	return true
+}
+
+func TestGet_DispatchesOnceAndServesCachedResult(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("image-palette-test"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	dispatcher := &fakeDispatcher{}
+	svc := NewService(utility.NewMemoryCacheService(), dispatcher)
+	id, _ := idgen.GeneratePublicID(1, idgen.EntityTypeFile)
+
+	for range 2 {
+		result, err := svc.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if result.Status != StatusPending {
+			t.Fatalf("status = %q, want %q", result.Status, StatusPending)
+		}
+	}
+	if dispatcher.calls != 1 {
+		t.Fatalf("dispatched %d times, want 1", dispatcher.calls)
+	}
+
+	dispatcher.pending[0](&utility.Palette{Dominant: "#112233", Accents: []string{"#445566"}}, nil)
+	result, err := svc.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.Status != StatusReady || result.Palette == nil || result.Palette.Dominant != "#112233" {
+		t.Fatalf("result = %+v, want ready palette", result)
+	}
+	if dispatcher.calls != 1 {
+		t.Fatalf("cached palette should not dispatch again, got %d calls", dispatcher.calls)
+	}
+}
+
+func TestGet_CachesFailure(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("image-palette-test"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	dispatcher := &fakeDispatcher{}
+	svc := NewService(utility.NewMemoryCacheService(), dispatcher)
+	id, _ := idgen.GeneratePublicID(2, idgen.EntityTypeDirectLink)
+
+	if _, err := svc.Get(ctx, id); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	dispatcher.pending[0](nil, errors.New("解码图片失败"))
+
+	result, err := svc.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.Status != StatusFailed || result.Error == "" {
+		t.Fatalf("result = %+v, want failed", result)
+	}
+}
+
+func TestGet_RejectsNonImageIDs(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("image-palette-test"); err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(utility.NewMemoryCacheService(), &fakeDispatcher{})
+	userID, _ := idgen.GeneratePublicID(1, idgen.EntityTypeUser)
+
+	for _, id := range []string{"not-an-id", userID} {
+		if _, err := svc.Get(context.Background(), id); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("Get(%q) error = %v, want ErrBadRequest", id, err)
+		}
+	}
+}
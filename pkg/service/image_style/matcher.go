@@ -93,10 +93,10 @@ func Match(policy *model.StoragePolicy, filename, styleName string, query url.Va
 	}
 
 	// 2. 纯动态参数请求（URL 带 ?w=... 等，但没有命名样式）
-	if hasAnyDynamicOpt(query) {
+	if HasDynamicOpts(query) {
 		resolved := ResolvedStyle{
-			// Phase 1 默认 JPEG / 质量 80 / 自动旋转 true；Phase 3 可由 DefaultThumbParam 等覆盖
-			Format:     "jpg",
+			// 默认质量 80 / 自动旋转 true；未指定 fm 时 PNG 保持 PNG（保留透明通道），其余输出 JPEG
+			Format:     defaultDynamicFormat(ext),
 			Quality:    80,
 			AutoRotate: true,
 			Resize:     model.ImageResizeConfig{Mode: "cover"},
@@ -181,8 +181,17 @@ func styleToResolved(s model.ImageStyleConfig) ResolvedStyle {
 	}
 }
 
-// hasAnyDynamicOpt 判定 query 是否包含至少一个被识别的图片处理参数。
-func hasAnyDynamicOpt(query url.Values) bool {
+// defaultDynamicFormat 返回纯动态参数请求在未指定 fm 时的输出格式。
+func defaultDynamicFormat(ext string) string {
+	if ext == "png" {
+		return "png"
+	}
+	return "jpg"
+}
+
+// HasDynamicOpts 判定 query 是否包含至少一个被识别的图片处理参数。
+// 直链 handler 据此决定无样式后缀的请求是否需要走 ImageStyleService。
+func HasDynamicOpts(query url.Values) bool {
 	if len(query) == 0 {
 		return false
 	}
@@ -371,3 +371,33 @@ func TestMatch_NamedStyleWithInvalidQuery_ErrorsOut(t *testing.T) {
 		t.Errorf("命名样式 + 非法 query 应返回 ErrStyleProcessFailed，实际 %v", err)
 	}
 }
+
+func TestMatch_DynamicOnly_DefaultFormatFollowsSource(t *testing.T) {
+	policy := buildPolicyWithStyles(true, []string{"jpg", "png"}, "", sampleThumbnail())
+	q := url.Values{"w": []string{"800"}}
+
+	got, err := Match(policy, "a.png", "", q)
+	if err != nil {
+		t.Fatalf("未期望错误：%v", err)
+	}
+	if got.Format != "png" {
+		t.Errorf("未指定 fm 时 PNG 应保持 PNG，实际 %s", got.Format)
+	}
+
+	got, err = Match(policy, "a.jpg", "", q)
+	if err != nil {
+		t.Fatalf("未期望错误：%v", err)
+	}
+	if got.Format != "jpg" || got.Resize.Width != 800 {
+		t.Errorf("未指定 fm 时应默认输出 JPEG：%+v", got)
+	}
+}
+
+func TestHasDynamicOpts(t *testing.T) {
+	if HasDynamicOpts(nil) || HasDynamicOpts(url.Values{"token": []string{"x"}}) {
+		t.Error("不含处理参数的 query 不应被识别")
+	}
+	if !HasDynamicOpts(url.Values{"fm": []string{"webp"}}) {
+		t.Error("fm 应被识别为处理参数")
+	}
+}
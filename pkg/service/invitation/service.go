@@ -0,0 +1,106 @@
+/*
+ * @Description: 注册邀请码服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package invitation
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+const (
+	// codeAlphabet 邀请码字符集，去掉了 0/O/1/I 等易混淆字符
+	codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// codeLength 邀请码长度
+	codeLength = 10
+)
+
+// Service 封装了注册邀请码的管理逻辑；邀请码的核销在注册事务中完成。
+type Service struct {
+	repo repository.InvitationCodeRepository
+}
+
+// NewService 是邀请码 Service 的构造函数。
+func NewService(repo repository.InvitationCodeRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// Create 由管理员批量生成邀请码。
+func (s *Service) Create(ctx context.Context, creatorID uint, req *model.CreateInvitationCodeRequest) ([]*model.InvitationCode, error) {
+	count := req.Count
+	if count <= 0 {
+		count = 1
+	}
+	maxUses := req.MaxUses
+	if maxUses <= 0 {
+		maxUses = 1
+	}
+	var expiresAt *time.Time
+	if req.ExpiresInHours > 0 {
+		t := time.Now().Add(time.Duration(req.ExpiresInHours) * time.Hour)
+		expiresAt = &t
+	}
+
+	codes := make([]*model.InvitationCode, 0, count)
+	for i := 0; i < count; i++ {
+		raw, err := generateCode()
+		if err != nil {
+			return nil, fmt.Errorf("生成邀请码失败: %w", err)
+		}
+		code := &model.InvitationCode{
+			Code:      raw,
+			MaxUses:   maxUses,
+			ExpiresAt: expiresAt,
+			CreatedBy: creatorID,
+			Note:      strings.TrimSpace(req.Note),
+		}
+		if err := s.repo.Create(ctx, code); err != nil {
+			return nil, fmt.Errorf("保存邀请码失败: %w", err)
+		}
+		codes = append(codes, code)
+	}
+	log.Printf("[Invitation] 管理员 %d 生成了 %d 个邀请码（每个可用 %d 次）", creatorID, count, maxUses)
+	return codes, nil
+}
+
+// List 分页列出邀请码。
+func (s *Service) List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	return s.repo.List(ctx, page, pageSize)
+}
+
+// Delete 删除（作废）邀请码。
+func (s *Service) Delete(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// generateCode 生成随机邀请码（仅含大写字母与数字，注册时输入不区分大小写）
+func generateCode() (string, error) {
+	var sb strings.Builder
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := 0; i < codeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(codeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,307 @@
+package link
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// healthCheckConcurrency 最多同时检查的友链数量
+	healthCheckConcurrency = 10
+	// healthCheckTimeout 单个友链请求的超时时间
+	healthCheckTimeout = 10 * time.Second
+	// checkRecordRetention 健康检查记录的保留时间，过期记录在每次检查后清理
+	checkRecordRetention = 30 * 24 * time.Hour
+	// maxHealthHistoryLimit 单次查询健康检查记录的最大条数
+	maxHealthHistoryLimit = 500
+
+	defaultSickThreshold = 3
+)
+
+// linkCheckResult 是对单个友链发起一次请求的结果。
+type linkCheckResult struct {
+	checkedAt    time.Time
+	healthy      bool
+	statusCode   int
+	responseTime int // 毫秒
+	err          string
+	sslExpireAt  *time.Time
+}
+
+// newHealthCheckClient 创建健康检查使用的 HTTP 客户端，最多跟随 5 次重定向。
+// 友链地址由用户提交，连接经过 SSRF 防护，重定向到内网或云厂商元数据地址时同样在建立连接时被拦截。
+func newHealthCheckClient(settingSvc setting.SettingService) *http.Client {
+	guard := ssrf.NewGuard(func() string {
+		return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+	})
+	return &http.Client{
+		Timeout:   healthCheckTimeout,
+		Transport: guard.Transport(),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("重定向次数过多")
+			}
+			return nil
+		},
+	}
+}
+
+// CheckLinksHealth 立即检查所有参与健康检查的友链，忽略检查间隔，供后台手动触发。
+func (s *service) CheckLinksHealth(ctx context.Context) (*model.LinkHealthCheckResponse, error) {
+	return s.checkLinksHealth(ctx, true)
+}
+
+// RunScheduledHealthCheck 检查已到检查时间的友链，由定时任务每小时调用一次。
+// 每个友链按自身的检查间隔执行，未单独设置时使用全局间隔。
+func (s *service) RunScheduledHealthCheck(ctx context.Context) (*model.LinkHealthCheckResponse, error) {
+	return s.checkLinksHealth(ctx, false)
+}
+
+// GetLinkHealthHistory 按检查时间倒序返回友链最近的健康检查记录。
+func (s *service) GetLinkHealthHistory(ctx context.Context, id int, limit int) ([]*model.LinkCheckRecordDTO, error) {
+	if _, err := s.linkRepo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("获取友链失败: %w", err)
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > maxHealthHistoryLimit {
+		limit = maxHealthHistoryLimit
+	}
+	return s.linkCheckRecordRepo.ListByLinkID(ctx, id, limit)
+}
+
+// checkLinksHealth 检查友链并写回结果：记录每次检查，连续失败达到阈值的友链标记为 INVALID 并移入失联分类，
+// 恢复访问的友链重新标记为 APPROVED 并移回原分类。force 为 true 时忽略检查间隔。
+func (s *service) checkLinksHealth(ctx context.Context, force bool) (*model.LinkHealthCheckResponse, error) {
+	// 1. 获取已审核通过和失联的友链（跳过健康检查的友链已被排除）
+	approvedLinks, err := s.linkRepo.GetAllApprovedLinks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取已审核友链列表失败: %w", err)
+	}
+	invalidLinks, err := s.linkRepo.GetAllInvalidLinks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取失联友链列表失败: %w", err)
+	}
+
+	// 2. 筛选出到期需要检查的友链
+	now := time.Now()
+	globalInterval := s.settingInt(constant.KeyFriendLinkHealthCheckInterval)
+	links := make([]*model.LinkDTO, 0, len(approvedLinks)+len(invalidLinks))
+	for _, l := range append(approvedLinks, invalidLinks...) {
+		if force || isHealthCheckDue(l, globalInterval, now) {
+			links = append(links, l)
+		}
+	}
+
+	response := &model.LinkHealthCheckResponse{
+		Total:        len(links),
+		UnhealthyIDs: make([]int, 0),
+		MovedToSick:  make([]int, 0),
+		SSLExpiring:  make([]int, 0),
+	}
+	if len(links) == 0 {
+		return response, nil
+	}
+
+	// 3. 并发检查友链
+	results := make([]linkCheckResult, len(links))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, healthCheckConcurrency)
+	for i, l := range links {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			results[i] = s.probeLink(ctx, url)
+		}(i, l.URL)
+	}
+	wg.Wait()
+
+	// 4. 逐个写回检查结果
+	threshold := s.settingInt(constant.KeyFriendLinkSickThreshold)
+	if threshold <= 0 {
+		threshold = defaultSickThreshold
+	}
+	sslWarnBefore := now.AddDate(0, 0, s.settingInt(constant.KeyFriendLinkSSLWarnDays))
+	sickCategoryName := s.settingSvc.Get(constant.KeyFriendLinkSickCategoryName.String())
+
+	for i, l := range links {
+		result := results[i]
+		s.saveCheckRecord(ctx, l.ID, result)
+
+		update := &model.LinkHealthUpdate{
+			CheckedAt:    result.checkedAt,
+			ResponseTime: result.responseTime,
+			SSLExpireAt:  result.sslExpireAt,
+		}
+		movedToSick := false
+
+		if result.healthy {
+			response.Healthy++
+			if l.Status == "INVALID" {
+				update.Status = "APPROVED"
+				response.Recovered++
+			}
+			if l.OriginalCategoryID != nil {
+				// 仅当友链仍在失联分类中时移回，管理员期间手动调整过分类则保留其调整
+				if l.Category != nil && sickCategoryName != "" && l.Category.Name == sickCategoryName {
+					update.CategoryID = s.restoreCategoryID(ctx, *l.OriginalCategoryID)
+				}
+				update.ClearOriginalCategory = true
+			}
+		} else {
+			response.Unhealthy++
+			response.UnhealthyIDs = append(response.UnhealthyIDs, l.ID)
+			update.ConsecutiveFailures = l.ConsecutiveFailures + 1
+			if l.Status == "APPROVED" && update.ConsecutiveFailures >= threshold {
+				update.Status = "INVALID"
+				movedToSick = true
+				if sickID, err := s.ensureSickCategory(ctx, sickCategoryName); err != nil {
+					log.Printf("[友链健康检查] 获取失联分类失败: %v", err)
+				} else if sickID != 0 && l.Category != nil && l.Category.ID != sickID {
+					originalID := l.Category.ID
+					update.CategoryID = &sickID
+					update.OriginalCategoryID = &originalID
+				}
+			}
+		}
+
+		if result.sslExpireAt != nil && result.sslExpireAt.Before(sslWarnBefore) {
+			response.SSLExpiring = append(response.SSLExpiring, l.ID)
+		}
+
+		if err := s.linkRepo.UpdateHealthState(ctx, l.ID, update); err != nil {
+			log.Printf("[友链健康检查] 更新友链 %d 的检查结果失败: %v", l.ID, err)
+			continue
+		}
+
+		if movedToSick {
+			response.MovedToSick = append(response.MovedToSick, l.ID)
+			if err := s.emailSvc.SendLinkSickNotification(ctx, l, update.ConsecutiveFailures, result.err); err != nil {
+				log.Printf("[友链健康检查] 发送友链 %d 的失联通知失败: %v", l.ID, err)
+			}
+		}
+	}
+
+	// 5. 清理过期的检查记录
+	if _, err := s.linkCheckRecordRepo.DeleteBefore(ctx, now.Add(-checkRecordRetention)); err != nil {
+		log.Printf("[友链健康检查] 清理过期检查记录失败: %v", err)
+	}
+
+	return response, nil
+}
+
+// isHealthCheckDue 判断友链是否到了检查时间。友链单独设置的间隔优先于全局间隔，间隔为 0 表示不定时检查。
+func isHealthCheckDue(l *model.LinkDTO, globalInterval int, now time.Time) bool {
+	interval := l.CheckInterval
+	if interval <= 0 {
+		interval = globalInterval
+	}
+	if interval <= 0 {
+		return false
+	}
+	if l.LastCheckedAt == nil {
+		return true
+	}
+	// 预留一分钟余量，避免定时任务的执行抖动导致整点检查被推迟一个周期
+	return now.Sub(*l.LastCheckedAt) >= time.Duration(interval)*time.Hour-time.Minute
+}
+
+// probeLink 请求友链并记录状态码、响应耗时与证书过期时间，2xx 和 3xx 状态码视为健康。
+func (s *service) probeLink(ctx context.Context, url string) linkCheckResult {
+	result := linkCheckResult{checkedAt: time.Now()}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		result.err = err.Error()
+		return result
+	}
+	// 设置 User-Agent 避免被网站屏蔽
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; LinkHealthChecker/1.0)")
+
+	resp, err := s.healthClient.Do(req)
+	result.responseTime = int(time.Since(result.checkedAt).Milliseconds())
+	if err != nil {
+		result.err = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.statusCode = resp.StatusCode
+	result.healthy = resp.StatusCode >= 200 && resp.StatusCode < 400
+	if !result.healthy {
+		result.err = resp.Status
+	}
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		expireAt := resp.TLS.PeerCertificates[0].NotAfter
+		result.sslExpireAt = &expireAt
+	}
+	return result
+}
+
+func (s *service) saveCheckRecord(ctx context.Context, linkID int, result linkCheckResult) {
+	record := &model.LinkCheckRecordDTO{
+		LinkID:       linkID,
+		CheckedAt:    result.checkedAt,
+		Healthy:      result.healthy,
+		StatusCode:   result.statusCode,
+		ResponseTime: result.responseTime,
+		Error:        result.err,
+		SSLExpireAt:  result.sslExpireAt,
+	}
+	if err := s.linkCheckRecordRepo.Create(ctx, record); err != nil {
+		log.Printf("[友链健康检查] 保存友链 %d 的检查记录失败: %v", linkID, err)
+	}
+}
+
+// ensureSickCategory 返回失联分类的ID，分类不存在时自动创建；未配置分类名称时返回 0。
+func (s *service) ensureSickCategory(ctx context.Context, name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+	if category, err := s.linkCategoryRepo.GetByName(ctx, name); err == nil {
+		return category.ID, nil
+	}
+	category, err := s.linkCategoryRepo.Create(ctx, &model.CreateLinkCategoryRequest{
+		Name:        name,
+		Style:       "list",
+		Description: "连续多次无法访问的友链，恢复访问后会自动移回原分类",
+	})
+	if err != nil {
+		return 0, fmt.Errorf("创建失联分类失败: %w", err)
+	}
+	return category.ID, nil
+}
+
+// restoreCategoryID 返回友链恢复后应移回的分类：原分类已被清理时退回默认分类，都不可用时不移动。
+func (s *service) restoreCategoryID(ctx context.Context, originalID int) *int {
+	if _, err := s.linkCategoryRepo.GetByID(ctx, originalID); err == nil {
+		return &originalID
+	}
+	defaultID := s.settingInt(constant.KeyFriendLinkDefaultCategory)
+	if defaultID > 0 {
+		if _, err := s.linkCategoryRepo.GetByID(ctx, defaultID); err == nil {
+			return &defaultID
+		}
+	}
+	return nil
+}
+
+// settingInt 读取整数类型的配置，未配置或格式错误时返回 0。
+func (s *service) settingInt(key constant.SettingKey) int {
+	value, _ := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(key.String())))
+	return value
+}
@@ -0,0 +1,216 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+type fakeLinkRepo struct {
+	repository.LinkRepository
+	approved []*model.LinkDTO
+	invalid  []*model.LinkDTO
+	updates  map[int]*model.LinkHealthUpdate
+}
+
+func (f *fakeLinkRepo) GetAllApprovedLinks(ctx context.Context) ([]*model.LinkDTO, error) {
+	return f.approved, nil
+}
+
+func (f *fakeLinkRepo) GetAllInvalidLinks(ctx context.Context) ([]*model.LinkDTO, error) {
+	return f.invalid, nil
+}
+
+func (f *fakeLinkRepo) UpdateHealthState(ctx context.Context, id int, update *model.LinkHealthUpdate) error {
+	f.updates[id] = update
+	return nil
+}
+
+type fakeCategoryRepo struct {
+	repository.LinkCategoryRepository
+	categories map[int]*model.LinkCategoryDTO
+}
+
+func (f *fakeCategoryRepo) GetByID(ctx context.Context, id int) (*model.LinkCategoryDTO, error) {
+	if c, ok := f.categories[id]; ok {
+		return c, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (f *fakeCategoryRepo) GetByName(ctx context.Context, name string) (*model.LinkCategoryDTO, error) {
+	for _, c := range f.categories {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (f *fakeCategoryRepo) Create(ctx context.Context, req *model.CreateLinkCategoryRequest) (*model.LinkCategoryDTO, error) {
+	c := &model.LinkCategoryDTO{ID: len(f.categories) + 100, Name: req.Name, Style: req.Style}
+	f.categories[c.ID] = c
+	return c, nil
+}
+
+type fakeCheckRecordRepo struct {
+	repository.LinkCheckRecordRepository
+	records []*model.LinkCheckRecordDTO
+}
+
+func (f *fakeCheckRecordRepo) Create(ctx context.Context, record *model.LinkCheckRecordDTO) error {
+	f.records = append(f.records, record)
+	return nil
+}
+
+func (f *fakeCheckRecordRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+type fakeEmailSvc struct {
+	utility.EmailService
+	sick []int
+}
+
+func (f *fakeEmailSvc) SendLinkSickNotification(ctx context.Context, link *model.LinkDTO, failures int, lastError string) error {
+	f.sick = append(f.sick, link.ID)
+	return nil
+}
+
+func TestCheckLinksHealthMovesSickLinksAndRestoresRecovered(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	friends := &model.LinkCategoryDTO{ID: 1, Name: "朋友们"}
+	sick := &model.LinkCategoryDTO{ID: 2, Name: "失联友链"}
+	originalID := friends.ID
+	links := &fakeLinkRepo{
+		approved: []*model.LinkDTO{
+			{ID: 10, URL: srv.URL + "/down", Status: "APPROVED", Category: friends, ConsecutiveFailures: 2},
+			{ID: 11, URL: srv.URL + "/down", Status: "APPROVED", Category: friends},
+		},
+		invalid: []*model.LinkDTO{
+			{ID: 12, URL: srv.URL + "/up", Status: "INVALID", Category: sick, ConsecutiveFailures: 5, OriginalCategoryID: &originalID},
+		},
+		updates: make(map[int]*model.LinkHealthUpdate),
+	}
+	categories := &fakeCategoryRepo{categories: map[int]*model.LinkCategoryDTO{1: friends}}
+	records := &fakeCheckRecordRepo{}
+	emails := &fakeEmailSvc{}
+	s := &service{
+		linkRepo:            links,
+		linkCategoryRepo:    categories,
+		linkCheckRecordRepo: records,
+		healthClient:        srv.Client(),
+		emailSvc:            emails,
+		settingSvc: &fakeSettings{values: map[string]string{
+			constant.KeyFriendLinkSickThreshold.String():    "3",
+			constant.KeyFriendLinkSickCategoryName.String(): "失联友链",
+		}},
+	}
+
+	result, err := s.CheckLinksHealth(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 3 || result.Healthy != 1 || result.Unhealthy != 2 || result.Recovered != 1 {
+		t.Fatalf("统计不正确: %+v", result)
+	}
+	if len(records.records) != 3 {
+		t.Errorf("每次检查都应保存记录，实际 %d 条", len(records.records))
+	}
+
+	// 连续失败达到阈值：标记失联，移入自动创建的失联分类并通知站长
+	u := links.updates[10]
+	moved := result.MovedToSick
+	if u.Status != "INVALID" || u.ConsecutiveFailures != 3 || u.CategoryID == nil || u.OriginalCategoryID == nil || *u.OriginalCategoryID != 1 {
+		t.Errorf("达到阈值的友链应移入失联分类: %+v", u)
+	}
+	if u.CategoryID != nil && categories.categories[*u.CategoryID].Name != "失联友链" {
+		t.Errorf("应移入名为失联友链的分类，实际分类 %d", *u.CategoryID)
+	}
+	if len(moved) != 1 || moved[0] != 10 || len(emails.sick) != 1 || emails.sick[0] != 10 {
+		t.Errorf("只有达到阈值的友链应被移入并通知: moved=%v mailed=%v", moved, emails.sick)
+	}
+
+	// 未达阈值：只累加失败次数
+	if u := links.updates[11]; u.Status != "" || u.ConsecutiveFailures != 1 || u.CategoryID != nil {
+		t.Errorf("未达阈值的友链不应变更状态: %+v", u)
+	}
+
+	// 恢复访问：重新通过并移回原分类，同时记录证书过期时间
+	u = links.updates[12]
+	if u.Status != "APPROVED" || u.ConsecutiveFailures != 0 || u.CategoryID == nil || *u.CategoryID != 1 || !u.ClearOriginalCategory {
+		t.Errorf("恢复的友链应移回原分类: %+v", u)
+	}
+	if u.SSLExpireAt == nil {
+		t.Error("HTTPS 友链应记录证书过期时间")
+	}
+}
+
+func TestIsHealthCheckDue(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+
+	cases := []struct {
+		name   string
+		link   *model.LinkDTO
+		global int
+		want   bool
+	}{
+		{"从未检查过", &model.LinkDTO{}, 24, true},
+		{"未到全局间隔", &model.LinkDTO{LastCheckedAt: ago(2 * time.Hour)}, 24, false},
+		{"已到全局间隔", &model.LinkDTO{LastCheckedAt: ago(24 * time.Hour)}, 24, true},
+		{"单独设置的间隔优先", &model.LinkDTO{CheckInterval: 1, LastCheckedAt: ago(time.Hour)}, 24, true},
+		{"允许执行时间的轻微抖动", &model.LinkDTO{CheckInterval: 1, LastCheckedAt: ago(59*time.Minute + 30*time.Second)}, 24, true},
+		{"全局关闭时不检查", &model.LinkDTO{}, 0, false},
+		{"全局关闭时仍检查单独设置间隔的友链", &model.LinkDTO{CheckInterval: 6}, 0, true},
+	}
+	for _, tc := range cases {
+		if got := isHealthCheckDue(tc.link, tc.global, now); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestProbeLinkRefusesInternalAddresses(t *testing.T) {
+	var hits int
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer internal.Close()
+
+	s := &service{healthClient: newHealthCheckClient(&fakeSettings{values: map[string]string{}})}
+	for _, url := range []string{internal.URL, "http://169.254.169.254/latest/meta-data/", "http://metadata.google.internal/"} {
+		if result := s.probeLink(context.Background(), url); result.healthy || result.err == "" {
+			t.Errorf("%s: 内网与元数据地址应被拦截: %+v", url, result)
+		}
+	}
+	if hits != 0 {
+		t.Fatalf("被拦截的请求不应到达内网服务, 实际请求 %d 次", hits)
+	}
+}
@@ -7,10 +7,9 @@ import (
 	"log"
 	"net/http"
 	"strconv"
-	"sync"
-	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
@@ -50,6 +49,8 @@ type Service interface {
 	ImportLinks(ctx context.Context, req *model.ImportLinksRequest) (*model.ImportLinksResponse, error)
 	ExportLinks(ctx context.Context, req *model.ExportLinksRequest) (*model.ExportLinksResponse, error)
 	CheckLinksHealth(ctx context.Context) (*model.LinkHealthCheckResponse, error)
+	RunScheduledHealthCheck(ctx context.Context) (*model.LinkHealthCheckResponse, error)
+	GetLinkHealthHistory(ctx context.Context, id int, limit int) ([]*model.LinkCheckRecordDTO, error)
 	BatchUpdateLinkSort(ctx context.Context, req *model.BatchUpdateLinkSortRequest) error
 }
 
@@ -58,6 +59,10 @@ type service struct {
 	linkRepo         repository.LinkRepository
 	linkCategoryRepo repository.LinkCategoryRepository
 	linkTagRepo      repository.LinkTagRepository
+	// 友链健康检查记录
+	linkCheckRecordRepo repository.LinkCheckRecordRepository
+	// 健康检查使用的 HTTP 客户端
+	healthClient *http.Client
 	// 用于派发异步任务的 Broker
 	broker TaskBroker
 	// 保留事务管理器以备将来使用
@@ -83,6 +88,7 @@ func NewService(
 	linkRepo repository.LinkRepository,
 	linkCategoryRepo repository.LinkCategoryRepository,
 	linkTagRepo repository.LinkTagRepository,
+	linkCheckRecordRepo repository.LinkCheckRecordRepository,
 	txManager repository.TransactionManager,
 	broker TaskBroker,
 	settingSvc setting.SettingService,
@@ -91,15 +97,17 @@ func NewService(
 	eventBus *event.EventBus,
 ) Service {
 	return &service{
-		linkRepo:         linkRepo,
-		linkCategoryRepo: linkCategoryRepo,
-		linkTagRepo:      linkTagRepo,
-		txManager:        txManager,
-		broker:           broker,
-		settingSvc:       settingSvc,
-		pushooSvc:        pushooSvc,
-		emailSvc:         emailSvc,
-		eventBus:         eventBus,
+		linkRepo:            linkRepo,
+		linkCategoryRepo:    linkCategoryRepo,
+		linkTagRepo:         linkTagRepo,
+		linkCheckRecordRepo: linkCheckRecordRepo,
+		healthClient:        newHealthCheckClient(settingSvc),
+		txManager:           txManager,
+		broker:              broker,
+		settingSvc:          settingSvc,
+		pushooSvc:           pushooSvc,
+		emailSvc:            emailSvc,
+		eventBus:            eventBus,
 	}
 }
 
@@ -181,7 +189,7 @@ func (s *service) ApplyLink(ctx context.Context, req *model.ApplyLinkRequest) (*
 
 	// 发送即时通知
 	if s.pushooSvc != nil {
-		go func() {
+		workerpool.Go(workerpool.CategoryNotification, func() {
 			log.Printf("[DEBUG] 开始处理友链申请即时通知逻辑")
 			if pushChannel != "" && notifyAdmin {
 				log.Printf("[DEBUG] 满足通知条件，开始发送友链申请即时通知")
@@ -193,7 +201,7 @@ func (s *service) ApplyLink(ctx context.Context, req *model.ApplyLinkRequest) (*
 			} else {
 				log.Printf("[DEBUG] 不满足通知条件，跳过友链申请即时通知")
 			}
-		}()
+		})
 	} else {
 		log.Printf("[DEBUG] pushooSvc 为 nil，跳过友链申请即时通知")
 	}
@@ -297,6 +305,9 @@ func (s *service) AdminDeleteLink(ctx context.Context, id int) error {
 	if err != nil {
 		return err
 	}
+	if err := s.linkCheckRecordRepo.DeleteByLinkID(ctx, id); err != nil {
+		log.Printf("[友链删除] 清理友链 %d 的健康检查记录失败: %v", id, err)
+	}
 	// 操作成功后，派发清理任务
 	s.broker.DispatchLinkCleanup()
 	// 发布友链删除事件
@@ -347,7 +358,7 @@ func (s *service) ReviewLink(ctx context.Context, id int, req *model.ReviewLinkR
 			log.Printf("[WARNING] 获取更新后的友链信息失败，无法发送邮件通知: %v", err)
 		} else if s.emailSvc != nil {
 			// 异步发送邮件通知
-			go func() {
+			workerpool.Go(workerpool.CategoryNotification, func() {
 				isApproved := req.Status == "APPROVED"
 				rejectReason := ""
 				if req.RejectReason != nil {
@@ -356,7 +367,7 @@ func (s *service) ReviewLink(ctx context.Context, id int, req *model.ReviewLinkR
 				if err := s.emailSvc.SendLinkReviewNotification(context.Background(), updatedLink, isApproved, rejectReason); err != nil {
 					log.Printf("[ERROR] 发送友链审核邮件通知失败: %v", err)
 				}
-			}()
+			})
 		} else {
 			log.Printf("[DEBUG] 邮件服务未初始化，跳过友链审核邮件通知")
 		}
@@ -677,113 +688,6 @@ func (s *service) ExportLinks(ctx context.Context, req *model.ExportLinksRequest
 	}, nil
 }
 
-// CheckLinksHealth 检查所有友链的健康状态，将无法访问的友链标记为 INVALID，将恢复的友链标记为 APPROVED。
-func (s *service) CheckLinksHealth(ctx context.Context) (*model.LinkHealthCheckResponse, error) {
-	// 1. 获取所有已审核通过的友链
-	approvedLinks, err := s.linkRepo.GetAllApprovedLinks(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("获取已审核友链列表失败: %w", err)
-	}
-
-	// 2. 获取所有失联的友链（用于检查是否恢复）
-	invalidLinks, err := s.linkRepo.GetAllInvalidLinks(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("获取失联友链列表失败: %w", err)
-	}
-
-	response := &model.LinkHealthCheckResponse{
-		Total:        len(approvedLinks) + len(invalidLinks),
-		Healthy:      0,
-		Unhealthy:    0,
-		UnhealthyIDs: make([]int, 0),
-	}
-
-	if response.Total == 0 {
-		return response, nil
-	}
-
-	// 3. 创建 HTTP 客户端，设置超时时间
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			// 最多跟随 5 次重定向
-			if len(via) >= 5 {
-				return fmt.Errorf("重定向次数过多")
-			}
-			return nil
-		},
-	}
-
-	// 4. 使用 WaitGroup 和互斥锁来并发检查友链
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	toInvalidIDs := make([]int, 0)  // 需要标记为失联的友链ID
-	toApprovedIDs := make([]int, 0) // 需要恢复的友链ID
-
-	// 创建一个带缓冲的通道来限制并发数
-	semaphore := make(chan struct{}, 10) // 最多同时检查 10 个友链
-
-	// 5. 检查已审核通过的友链
-	for _, link := range approvedLinks {
-		wg.Add(1)
-		go func(l *model.LinkDTO) {
-			defer wg.Done()
-			semaphore <- struct{}{}        // 获取信号量
-			defer func() { <-semaphore }() // 释放信号量
-
-			isHealthy := checkLinkHealth(client, l.URL)
-			mu.Lock()
-			if isHealthy {
-				response.Healthy++
-			} else {
-				response.Unhealthy++
-				toInvalidIDs = append(toInvalidIDs, l.ID)
-			}
-			mu.Unlock()
-		}(link)
-	}
-
-	// 6. 检查失联的友链（检查是否恢复）
-	for _, link := range invalidLinks {
-		wg.Add(1)
-		go func(l *model.LinkDTO) {
-			defer wg.Done()
-			semaphore <- struct{}{}        // 获取信号量
-			defer func() { <-semaphore }() // 释放信号量
-
-			isHealthy := checkLinkHealth(client, l.URL)
-			mu.Lock()
-			if isHealthy {
-				response.Healthy++
-				toApprovedIDs = append(toApprovedIDs, l.ID)
-			} else {
-				response.Unhealthy++
-			}
-			mu.Unlock()
-		}(link)
-	}
-
-	wg.Wait()
-
-	// 7. 批量更新失联友链的状态为 INVALID
-	if len(toInvalidIDs) > 0 {
-		if err := s.linkRepo.BatchUpdateStatus(ctx, toInvalidIDs, "INVALID"); err != nil {
-			return nil, fmt.Errorf("更新失联友链状态失败: %w", err)
-		}
-		response.UnhealthyIDs = append(response.UnhealthyIDs, toInvalidIDs...)
-	}
-
-	// 8. 批量恢复健康友链的状态为 APPROVED
-	if len(toApprovedIDs) > 0 {
-		if err := s.linkRepo.BatchUpdateStatus(ctx, toApprovedIDs, "APPROVED"); err != nil {
-			return nil, fmt.Errorf("恢复友链状态失败: %w", err)
-		}
-		// 恢复的友链也算在健康的友链中，但不放入 UnhealthyIDs
-	}
-
-	return response, nil
-}
-
 // BatchUpdateLinkSort 批量更新友链排序
 func (s *service) BatchUpdateLinkSort(ctx context.Context, req *model.BatchUpdateLinkSortRequest) error {
 	return s.linkRepo.BatchUpdateSortOrder(ctx, req.Items)
@@ -800,23 +704,3 @@ func (s *service) CheckLinkExistsByURL(ctx context.Context, url string) (*model.
 		URL:    url,
 	}, nil
 }
-
-// checkLinkHealth 检查单个友链的健康状态。
-func checkLinkHealth(client *http.Client, url string) bool {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return false
-	}
-
-	// 设置 User-Agent 避免被网站屏蔽
-	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; LinkHealthChecker/1.0)")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return false
-	}
-	defer resp.Body.Close()
-
-	// 认为 2xx 和 3xx 状态码为健康
-	return resp.StatusCode >= 200 && resp.StatusCode < 400
-}
@@ -0,0 +1,267 @@
+/*
+ * @Description: 登录防暴力破解，按账号与 IP 统计失败次数，超过上限后暂时锁定登录
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 23:00:00
+ * @LastEditTime: 2026-10-17 23:00:00
+ * @LastEditors: 安知鱼
+ */
+package loginguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	cacheKeyPrefix = "login_guard:"
+
+	defaultMaxAttempts    = 5
+	defaultWindowMinutes  = 15
+	defaultLockoutMinutes = 15
+)
+
+// ErrLocked 账号或 IP 的登录失败次数过多，正处于锁定期
+var ErrLocked = errors.New("登录失败次数过多，请稍后再试")
+
+// LockedError 携带剩余锁定时间的 ErrLocked
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	minutes := int((e.RetryAfter + time.Minute - 1) / time.Minute)
+	return fmt.Sprintf("登录失败次数过多，请 %d 分钟后再试", minutes)
+}
+
+func (e *LockedError) Unwrap() error { return ErrLocked }
+
+// Attempt 一次登录尝试的来源信息
+type Attempt struct {
+	Email     string
+	IP        string
+	UserAgent string
+	Method    string
+	Path      string
+}
+
+func (a Attempt) normalized() Attempt {
+	a.Email = strings.ToLower(strings.TrimSpace(a.Email))
+	return a
+}
+
+// Config 当前生效的锁定策略
+type Config struct {
+	Enabled     bool
+	MaxAttempts int
+	Window      time.Duration
+	Lockout     time.Duration
+	NotifyEmail bool
+}
+
+// Service 登录防暴力破解服务
+type Service struct {
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	userRepo   repository.UserRepository
+	auditSvc   *audit.Service
+	emailSvc   utility.EmailService
+	now        func() time.Time
+}
+
+// NewService 创建登录防暴力破解服务，auditSvc 与 emailSvc 可为 nil
+func NewService(
+	settingSvc setting.SettingService,
+	cacheSvc utility.CacheService,
+	userRepo repository.UserRepository,
+	auditSvc *audit.Service,
+	emailSvc utility.EmailService,
+) *Service {
+	return &Service{
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		userRepo:   userRepo,
+		auditSvc:   auditSvc,
+		emailSvc:   emailSvc,
+		now:        time.Now,
+	}
+}
+
+// Config 读取当前的锁定策略，无效的数值使用默认值
+func (s *Service) Config() Config {
+	return Config{
+		Enabled:     s.settingSvc.GetBool(constant.KeyLoginGuardEnable.String()),
+		MaxAttempts: s.positiveInt(constant.KeyLoginGuardMaxAttempts, defaultMaxAttempts),
+		Window:      time.Duration(s.positiveInt(constant.KeyLoginGuardWindowMinutes, defaultWindowMinutes)) * time.Minute,
+		Lockout:     time.Duration(s.positiveInt(constant.KeyLoginGuardLockoutMinutes, defaultLockoutMinutes)) * time.Minute,
+		NotifyEmail: s.settingSvc.GetBool(constant.KeyLoginGuardNotifyEmail.String()),
+	}
+}
+
+func (s *Service) positiveInt(key constant.SettingKey, fallback int) int {
+	n, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(key.String())))
+	if err != nil || n < 1 {
+		return fallback
+	}
+	return n
+}
+
+// Check 在校验密码前调用，账号或 IP 处于锁定期时返回 *LockedError
+func (s *Service) Check(ctx context.Context, attempt Attempt) error {
+	if !s.Config().Enabled {
+		return nil
+	}
+	attempt = attempt.normalized()
+	var retryAfter time.Duration
+	for _, key := range s.lockKeys(attempt) {
+		raw, err := s.cacheSvc.Get(ctx, key)
+		if err != nil || raw == "" {
+			continue
+		}
+		until, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			continue
+		}
+		if remaining := time.Unix(until, 0).Sub(s.now()); remaining > retryAfter {
+			retryAfter = remaining
+		}
+	}
+	if retryAfter > 0 {
+		return &LockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RecordFailure 记录一次账号或密码错误的登录，达到上限时锁定对应的账号或 IP 并返回 *LockedError
+func (s *Service) RecordFailure(ctx context.Context, attempt Attempt) error {
+	cfg := s.Config()
+	if !cfg.Enabled {
+		return nil
+	}
+	// 登录失败的审计与通知不应被客户端断开连接打断
+	ctx = context.WithoutCancel(ctx)
+	attempt = attempt.normalized()
+
+	var user *model.User
+	if attempt.Email != "" && s.userRepo != nil {
+		user, _ = s.userRepo.FindByEmail(ctx, attempt.Email)
+	}
+
+	accountFailures := s.increment(ctx, s.failKey("account", attempt.Email), attempt.Email, cfg.Window)
+	ipFailures := s.increment(ctx, s.failKey("ip", attempt.IP), attempt.IP, cfg.Window)
+	s.audit(ctx, model.AuditActionLoginFailed, user, attempt,
+		fmt.Sprintf("邮箱 %s 登录失败，统计窗口内账号失败 %d 次、IP 失败 %d 次", attempt.Email, accountFailures, ipFailures))
+
+	lockedUntil := s.now().Add(cfg.Lockout)
+	var locked []string
+	if attempt.Email != "" && accountFailures >= int64(cfg.MaxAttempts) {
+		s.lock(ctx, "account", attempt.Email, lockedUntil, cfg.Lockout)
+		locked = append(locked, "账号")
+	}
+	if attempt.IP != "" && ipFailures >= int64(cfg.MaxAttempts) {
+		s.lock(ctx, "ip", attempt.IP, lockedUntil, cfg.Lockout)
+		locked = append(locked, "IP")
+	}
+	if len(locked) == 0 {
+		return nil
+	}
+
+	s.audit(ctx, model.AuditActionLoginLockout, user, attempt,
+		fmt.Sprintf("%s 登录失败次数达到 %d 次，锁定至 %s", strings.Join(locked, "与"), cfg.MaxAttempts, lockedUntil.Format(time.DateTime)))
+	slog.WarnContext(ctx, "登录失败次数过多，已锁定",
+		slog.String("email", attempt.Email), slog.String("ip", attempt.IP),
+		slog.Int64("account_failures", accountFailures), slog.Int64("ip_failures", ipFailures))
+
+	if cfg.NotifyEmail && user != nil && accountFailures >= int64(cfg.MaxAttempts) && s.emailSvc != nil {
+		if err := s.emailSvc.SendSuspiciousLoginEmail(ctx, user.Email, user.Nickname, attempt.IP, int(accountFailures), lockedUntil); err != nil {
+			slog.WarnContext(ctx, "发送异常登录提醒邮件失败", slog.String("email", user.Email), slog.Any("error", err))
+		}
+	}
+	return &LockedError{RetryAfter: cfg.Lockout}
+}
+
+// RecordSuccess 登录成功后清除该账号的失败计数；IP 的计数保留，避免用一个可登录的账号重置 IP 限制
+func (s *Service) RecordSuccess(ctx context.Context, attempt Attempt) {
+	attempt = attempt.normalized()
+	if attempt.Email == "" {
+		return
+	}
+	if err := s.cacheSvc.Delete(ctx, s.failKey("account", attempt.Email)); err != nil {
+		slog.WarnContext(ctx, "清除登录失败计数失败", slog.String("email", attempt.Email), slog.Any("error", err))
+	}
+}
+
+// increment 累加失败次数，首次失败时开始计算统计窗口
+func (s *Service) increment(ctx context.Context, key, subject string, window time.Duration) int64 {
+	if subject == "" {
+		return 0
+	}
+	count, err := s.cacheSvc.Increment(ctx, key)
+	if err != nil {
+		slog.WarnContext(ctx, "累加登录失败次数失败", slog.String("key", key), slog.Any("error", err))
+		return 0
+	}
+	if count == 1 {
+		if err := s.cacheSvc.Expire(ctx, key, window); err != nil {
+			slog.WarnContext(ctx, "设置登录失败计数过期时间失败", slog.String("key", key), slog.Any("error", err))
+		}
+	}
+	return count
+}
+
+// lock 写入锁定标记并清空失败计数，锁定结束后重新开始统计
+func (s *Service) lock(ctx context.Context, kind, subject string, until time.Time, lockout time.Duration) {
+	if err := s.cacheSvc.Set(ctx, s.lockKey(kind, subject), strconv.FormatInt(until.Unix(), 10), lockout); err != nil {
+		slog.WarnContext(ctx, "写入登录锁定标记失败", slog.String("kind", kind), slog.String("subject", subject), slog.Any("error", err))
+	}
+	_ = s.cacheSvc.Delete(ctx, s.failKey(kind, subject))
+}
+
+func (s *Service) audit(ctx context.Context, action string, user *model.User, attempt Attempt, detail string) {
+	if s.auditSvc == nil {
+		return
+	}
+	entry := &model.AuditLog{
+		Action:    action,
+		Method:    attempt.Method,
+		Path:      attempt.Path,
+		IP:        attempt.IP,
+		UserAgent: attempt.UserAgent,
+		Detail:    detail,
+	}
+	if user != nil {
+		entry.ActorID = user.ID
+		entry.SubjectUserID = user.ID
+	}
+	_ = s.auditSvc.Record(ctx, entry)
+}
+
+func (s *Service) lockKeys(attempt Attempt) []string {
+	var keys []string
+	if attempt.Email != "" {
+		keys = append(keys, s.lockKey("account", attempt.Email))
+	}
+	if attempt.IP != "" {
+		keys = append(keys, s.lockKey("ip", attempt.IP))
+	}
+	return keys
+}
+
+func (s *Service) failKey(kind, subject string) string {
+	return cacheKeyPrefix + "fail:" + kind + ":" + subject
+}
+
+func (s *Service) lockKey(kind, subject string) string {
+	return cacheKeyPrefix + "lock:" + kind + ":" + subject
+}
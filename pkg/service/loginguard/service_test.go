@@ -0,0 +1,163 @@
+package loginguard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string   { return f.values[key] }
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users map[string]*model.User
+}
+
+func (f *fakeUserRepo) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	return f.users[email], nil
+}
+
+type fakeAuditRepo struct {
+	repository.AuditLogRepository
+	entries []*model.AuditLog
+}
+
+func (f *fakeAuditRepo) Create(ctx context.Context, entry *model.AuditLog) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+type fakeEmail struct {
+	utility.EmailService
+	sent []string
+}
+
+func (f *fakeEmail) SendSuspiciousLoginEmail(ctx context.Context, toEmail, nickname, ip string, failures int, lockedUntil time.Time) error {
+	f.sent = append(f.sent, toEmail)
+	return nil
+}
+
+func newTestService(values map[string]string) (*Service, *fakeAuditRepo, *fakeEmail) {
+	settings := map[string]string{
+		constant.KeyLoginGuardEnable.String():         "true",
+		constant.KeyLoginGuardMaxAttempts.String():    "3",
+		constant.KeyLoginGuardWindowMinutes.String():  "15",
+		constant.KeyLoginGuardLockoutMinutes.String(): "10",
+		constant.KeyLoginGuardNotifyEmail.String():    "true",
+	}
+	for k, v := range values {
+		settings[k] = v
+	}
+	auditRepo := &fakeAuditRepo{}
+	email := &fakeEmail{}
+	users := &fakeUserRepo{users: map[string]*model.User{
+		"user@example.com": {ID: 9, Email: "user@example.com", Nickname: "user"},
+	}}
+	svc := NewService(&fakeSettings{values: settings}, utility.NewMemoryCacheService(), users, audit.NewService(auditRepo), email)
+	return svc, auditRepo, email
+}
+
+func TestLockoutAfterMaxAttempts(t *testing.T) {
+	svc, auditRepo, email := newTestService(nil)
+	ctx := context.Background()
+	attempt := Attempt{Email: " User@Example.com ", IP: "203.0.113.7"}
+
+	for i := 0; i < 2; i++ {
+		if err := svc.RecordFailure(ctx, attempt); err != nil {
+			t.Fatalf("第 %d 次失败不应锁定: %v", i+1, err)
+		}
+	}
+	if err := svc.Check(ctx, attempt); err != nil {
+		t.Fatalf("未达到上限时不应锁定: %v", err)
+	}
+
+	err := svc.RecordFailure(ctx, attempt)
+	var locked *LockedError
+	if !errors.As(err, &locked) || !errors.Is(err, ErrLocked) || locked.RetryAfter != 10*time.Minute {
+		t.Fatalf("达到上限应锁定 10 分钟，得到 %v", err)
+	}
+	if err := svc.Check(ctx, Attempt{Email: "user@example.com", IP: "198.51.100.1"}); !errors.Is(err, ErrLocked) {
+		t.Errorf("换 IP 后账号仍应处于锁定期，得到 %v", err)
+	}
+	if err := svc.Check(ctx, Attempt{Email: "other@example.com", IP: "203.0.113.7"}); !errors.Is(err, ErrLocked) {
+		t.Errorf("换账号后 IP 仍应处于锁定期，得到 %v", err)
+	}
+
+	svc.now = func() time.Time { return time.Now().Add(11 * time.Minute) }
+	if err := svc.Check(ctx, attempt); err != nil {
+		t.Errorf("锁定期结束后应允许登录，得到 %v", err)
+	}
+
+	var failed, lockouts int
+	for _, e := range auditRepo.entries {
+		switch e.Action {
+		case model.AuditActionLoginFailed:
+			failed++
+		case model.AuditActionLoginLockout:
+			lockouts++
+		}
+		if e.SubjectUserID != 9 || e.IP != "203.0.113.7" {
+			t.Errorf("审计日志应记录用户与 IP: %+v", e)
+		}
+	}
+	if failed != 3 || lockouts != 1 {
+		t.Errorf("审计日志数量不正确: failed=%d lockouts=%d", failed, lockouts)
+	}
+	if len(email.sent) != 1 || email.sent[0] != "user@example.com" {
+		t.Errorf("账号锁定时应邮件通知用户，得到 %v", email.sent)
+	}
+}
+
+func TestSuccessResetsAccountCounter(t *testing.T) {
+	svc, _, email := newTestService(map[string]string{constant.KeyLoginGuardNotifyEmail.String(): "false"})
+	ctx := context.Background()
+	attempt := Attempt{Email: "user@example.com"}
+
+	_ = svc.RecordFailure(ctx, attempt)
+	_ = svc.RecordFailure(ctx, attempt)
+	svc.RecordSuccess(ctx, attempt)
+	if err := svc.RecordFailure(ctx, attempt); err != nil {
+		t.Errorf("登录成功后应重新计数，得到 %v", err)
+	}
+	_ = svc.RecordFailure(ctx, attempt)
+	if err := svc.RecordFailure(ctx, attempt); !errors.Is(err, ErrLocked) {
+		t.Errorf("重新计数达到上限后应锁定，得到 %v", err)
+	}
+	if len(email.sent) != 0 {
+		t.Errorf("关闭通知时不应发送邮件，得到 %v", email.sent)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	svc, auditRepo, _ := newTestService(map[string]string{
+		constant.KeyLoginGuardEnable.String():      "false",
+		constant.KeyLoginGuardMaxAttempts.String(): "1",
+	})
+	ctx := context.Background()
+	attempt := Attempt{Email: "user@example.com", IP: "203.0.113.7"}
+	for i := 0; i < 3; i++ {
+		if err := svc.RecordFailure(ctx, attempt); err != nil {
+			t.Fatalf("关闭时不应锁定: %v", err)
+		}
+	}
+	if err := svc.Check(ctx, attempt); err != nil {
+		t.Errorf("关闭时不应锁定: %v", err)
+	}
+	if len(auditRepo.entries) != 0 {
+		t.Errorf("关闭时不应记录审计日志")
+	}
+}
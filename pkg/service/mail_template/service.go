@@ -0,0 +1,202 @@
+/*
+ * @Description: 评论通知邮件模板管理：校验模板变量、示例数据预览与历史版本回滚
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package mail_template
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// maxVersionsPerTemplate 每个模板保留的历史版本数
+	maxVersionsPerTemplate = 20
+	// maxTemplateLength 模板内容的最大长度
+	maxTemplateLength = 64 * 1024
+)
+
+// Service 邮件模板管理服务
+type Service struct {
+	repo       repository.MailTemplateVersionRepository
+	settingSvc setting.SettingService
+	defaults   map[string]string
+}
+
+// NewService 创建邮件模板管理服务
+func NewService(repo repository.MailTemplateVersionRepository, settingSvc setting.SettingService) *Service {
+	defaults := make(map[string]string, len(managedTemplates))
+	for _, def := range configdef.AllSettings {
+		if _, ok := findTemplate(def.Key.String()); ok {
+			defaults[def.Key.String()] = def.Value
+		}
+	}
+	return &Service{
+		repo:       repo,
+		settingSvc: settingSvc,
+		defaults:   defaults,
+	}
+}
+
+// List 返回所有可编辑的模板及其当前内容、默认内容、可用变量和历史版本数
+func (s *Service) List(ctx context.Context) ([]*model.MailTemplate, error) {
+	keys := make([]string, 0, len(managedTemplates))
+	for _, def := range managedTemplates {
+		keys = append(keys, def.key.String())
+	}
+	counts, err := s.repo.CountByKeys(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("统计模板历史版本失败: %w", err)
+	}
+
+	list := make([]*model.MailTemplate, 0, len(managedTemplates))
+	for _, def := range managedTemplates {
+		item := s.toModel(def)
+		item.VersionSize = counts[item.Key]
+		list = append(list, item)
+	}
+	return list, nil
+}
+
+// Validate 校验模板语法，并使用示例数据试渲染，引用未定义的变量视为错误
+func (s *Service) Validate(key, content string) error {
+	def, ok := findTemplate(key)
+	if !ok {
+		return fmt.Errorf("模板 %s 不存在: %w", key, constant.ErrNotFound)
+	}
+	_, err := render(def, content)
+	return err
+}
+
+// Preview 使用示例数据渲染模板；content 为空时渲染当前保存的模板
+func (s *Service) Preview(key, content string) (*model.MailTemplatePreview, error) {
+	def, ok := findTemplate(key)
+	if !ok {
+		return nil, fmt.Errorf("模板 %s 不存在: %w", key, constant.ErrNotFound)
+	}
+	if content == "" {
+		content = s.settingSvc.Get(key)
+	}
+	rendered, err := render(def, content)
+	if err != nil {
+		return nil, err
+	}
+	return &model.MailTemplatePreview{Rendered: rendered}, nil
+}
+
+// Update 校验通过后保存模板，修改前的内容存为历史版本以便回滚
+func (s *Service) Update(ctx context.Context, key, content string) (*model.MailTemplate, error) {
+	def, ok := findTemplate(key)
+	if !ok {
+		return nil, fmt.Errorf("模板 %s 不存在: %w", key, constant.ErrNotFound)
+	}
+	if _, err := render(def, content); err != nil {
+		return nil, err
+	}
+	if err := s.save(ctx, key, content); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, def)
+}
+
+// ListVersions 返回模板的历史版本，最新的在前
+func (s *Service) ListVersions(ctx context.Context, key string) ([]*model.MailTemplateVersion, error) {
+	if _, ok := findTemplate(key); !ok {
+		return nil, fmt.Errorf("模板 %s 不存在: %w", key, constant.ErrNotFound)
+	}
+	return s.repo.ListByKey(ctx, key)
+}
+
+// Rollback 将模板恢复为指定的历史版本，当前内容同样会存为历史版本。
+// 恢复的内容仍需通过校验，避免回滚到一个无法渲染的版本。
+func (s *Service) Rollback(ctx context.Context, key string, versionID uint) (*model.MailTemplate, error) {
+	def, ok := findTemplate(key)
+	if !ok {
+		return nil, fmt.Errorf("模板 %s 不存在: %w", key, constant.ErrNotFound)
+	}
+	version, err := s.repo.FindByID(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil || version.Key != key {
+		return nil, fmt.Errorf("历史版本不存在: %w", constant.ErrNotFound)
+	}
+	if _, err := render(def, version.Content); err != nil {
+		return nil, err
+	}
+	if err := s.save(ctx, key, version.Content); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, def)
+}
+
+// save 将当前内容存为历史版本后写入新内容；内容未变化时不产生版本
+func (s *Service) save(ctx context.Context, key, content string) error {
+	current := s.settingSvc.Get(key)
+	if current == content {
+		return nil
+	}
+	if current != "" {
+		if _, err := s.repo.Create(ctx, key, current, maxVersionsPerTemplate); err != nil {
+			return fmt.Errorf("保存模板历史版本失败: %w", err)
+		}
+	}
+	return s.settingSvc.UpdateSettings(ctx, map[string]string{key: content})
+}
+
+func (s *Service) get(ctx context.Context, def templateDef) (*model.MailTemplate, error) {
+	item := s.toModel(def)
+	counts, err := s.repo.CountByKeys(ctx, []string{item.Key})
+	if err != nil {
+		return nil, fmt.Errorf("统计模板历史版本失败: %w", err)
+	}
+	item.VersionSize = counts[item.Key]
+	return item, nil
+}
+
+func (s *Service) toModel(def templateDef) *model.MailTemplate {
+	key := def.key.String()
+	return &model.MailTemplate{
+		Key:       key,
+		Name:      def.name,
+		Kind:      def.kind,
+		Content:   s.settingSvc.Get(key),
+		Default:   s.defaults[key],
+		Variables: def.variables,
+	}
+}
+
+// render 按邮件服务相同的方式（html/template）渲染模板，缺失的变量会导致渲染失败
+func render(def templateDef, content string) (string, error) {
+	if strings.TrimSpace(content) == "" {
+		return "", fmt.Errorf("模板内容不能为空: %w", constant.ErrBadRequest)
+	}
+	if len(content) > maxTemplateLength {
+		return "", fmt.Errorf("模板内容不能超过 %d 字节: %w", maxTemplateLength, constant.ErrBadRequest)
+	}
+	tpl, err := template.New(def.key.String()).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("模板语法错误: %v: %w", err, constant.ErrBadRequest)
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, def.sample()); err != nil {
+		return "", fmt.Errorf("模板渲染失败（请检查变量名是否在可用变量列表中）: %v: %w", err, constant.ErrBadRequest)
+	}
+	rendered := buf.String()
+	if def.kind == kindSubject && strings.ContainsAny(rendered, "\r\n") {
+		return "", fmt.Errorf("邮件主题不能包含换行: %w", constant.ErrBadRequest)
+	}
+	return rendered, nil
+}
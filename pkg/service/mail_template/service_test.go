@@ -0,0 +1,166 @@
+package mail_template
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) UpdateSettings(ctx context.Context, values map[string]string) error {
+	for k, v := range values {
+		f.values[k] = v
+	}
+	return nil
+}
+
+type fakeVersionRepo struct {
+	repository.MailTemplateVersionRepository
+	versions []*model.MailTemplateVersion
+}
+
+func (f *fakeVersionRepo) Create(ctx context.Context, key, content string, keep int) (*model.MailTemplateVersion, error) {
+	v := &model.MailTemplateVersion{ID: uint(len(f.versions) + 1), Key: key, Content: content, CreatedAt: time.Now()}
+	f.versions = append(f.versions, v)
+	return v, nil
+}
+
+func (f *fakeVersionRepo) CountByKeys(ctx context.Context, keys []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, v := range f.versions {
+		counts[v.Key]++
+	}
+	return counts, nil
+}
+
+func (f *fakeVersionRepo) FindByID(ctx context.Context, id uint) (*model.MailTemplateVersion, error) {
+	for _, v := range f.versions {
+		if v.ID == id {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func newTestService() (*Service, *fakeSettings, *fakeVersionRepo) {
+	settings := &fakeSettings{values: make(map[string]string)}
+	for _, def := range configdef.AllSettings {
+		settings.values[def.Key.String()] = def.Value
+	}
+	repo := &fakeVersionRepo{}
+	return NewService(repo, settings), settings, repo
+}
+
+// 默认模板必须能够通过校验，否则后台无法在默认模板基础上编辑
+func TestDefaultTemplatesValid(t *testing.T) {
+	svc, _, _ := newTestService()
+	list, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != len(managedTemplates) {
+		t.Fatalf("模板数量错误: %d", len(list))
+	}
+	for _, tpl := range list {
+		if tpl.Default == "" {
+			t.Errorf("模板 %s 缺少默认值", tpl.Key)
+			continue
+		}
+		if err := svc.Validate(tpl.Key, tpl.Default); err != nil {
+			t.Errorf("默认模板 %s 校验失败: %v", tpl.Key, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	svc, _, _ := newTestService()
+	key := constant.KeyCommentMailTemplate.String()
+
+	cases := map[string]string{
+		"空内容":  "  ",
+		"语法错误": "{{.NICK",
+		"未知变量": "{{.UNKNOWN}}",
+	}
+	for name, content := range cases {
+		if err := svc.Validate(key, content); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("%s 应校验失败: %v", name, err)
+		}
+	}
+
+	digestKey := constant.KeyCommentSubscribeMailTemplate.String()
+	if err := svc.Validate(digestKey, "{{range .COMMENTS}}{{.NICK}}{{.EMAIL}}{{end}}"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("列表项中的未知变量应校验失败: %v", err)
+	}
+	if err := svc.Validate(constant.KeyCommentMailSubject.String(), "{{.NICK}}\n回复了你"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("包含换行的主题应校验失败: %v", err)
+	}
+	if err := svc.Validate("site.name", "{{.NICK}}"); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("非模板配置应返回不存在: %v", err)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	svc, _, _ := newTestService()
+	preview, err := svc.Preview(constant.KeyCommentMailTemplate.String(), "<p>{{.NICK}}: {{.COMMENT}}</p>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.Rendered != "<p>安知鱼: <p>写得真好，学到了！</p></p>" {
+		t.Errorf("预览结果错误: %s", preview.Rendered)
+	}
+}
+
+func TestUpdateAndRollback(t *testing.T) {
+	svc, settings, repo := newTestService()
+	ctx := context.Background()
+	key := constant.KeyCommentMailSubject.String()
+	original := settings.values[key]
+
+	if _, err := svc.Update(ctx, key, "{{.BROKEN"); err == nil {
+		t.Fatal("无效模板不应保存")
+	}
+	if settings.values[key] != original || len(repo.versions) != 0 {
+		t.Fatal("校验失败时不应修改模板或产生历史版本")
+	}
+
+	tpl, err := svc.Update(ctx, key, "{{.NICK}} 回复了你")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tpl.Content != "{{.NICK}} 回复了你" || tpl.VersionSize != 1 {
+		t.Errorf("保存结果错误: %+v", tpl)
+	}
+	if _, err := svc.Update(ctx, key, "{{.NICK}} 回复了你"); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.versions) != 1 {
+		t.Errorf("内容未变化时不应产生历史版本: %d", len(repo.versions))
+	}
+
+	if _, err := svc.Rollback(ctx, constant.KeyCommentMailTemplate.String(), 1); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("不能使用其他模板的历史版本回滚: %v", err)
+	}
+	tpl, err = svc.Rollback(ctx, key, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tpl.Content != original || tpl.VersionSize != 2 {
+		t.Errorf("回滚结果错误: %+v", tpl)
+	}
+	if repo.versions[1].Content != "{{.NICK}} 回复了你" {
+		t.Errorf("回滚前的内容应存为历史版本: %+v", repo.versions[1])
+	}
+}
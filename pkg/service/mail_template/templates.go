@@ -0,0 +1,156 @@
+/*
+ * @Description: 可在后台编辑的评论通知邮件模板定义与示例数据
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 21:00:00
+ * @LastEditTime: 2026-10-16 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package mail_template
+
+import (
+	"html/template"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	kindSubject = "subject"
+	kindBody    = "body"
+)
+
+// templateDef 描述一个受管理的模板及其可用变量
+type templateDef struct {
+	key       constant.SettingKey
+	name      string
+	kind      string
+	variables []model.MailTemplateVariable
+	sample    func() map[string]interface{}
+}
+
+var (
+	replyVariables = []model.MailTemplateVariable{
+		{Name: "SITE_NAME", Description: "站点名称"},
+		{Name: "SITE_URL", Description: "站点地址"},
+		{Name: "POST_URL", Description: "评论所在页面地址"},
+		{Name: "PARENT_NICK", Description: "被回复者昵称"},
+		{Name: "PARENT_COMMENT", Description: "被回复的评论内容（HTML）"},
+		{Name: "PARENT_IMG", Description: "被回复者头像地址"},
+		{Name: "NICK", Description: "回复者昵称"},
+		{Name: "COMMENT", Description: "回复内容（HTML）"},
+		{Name: "IMG", Description: "回复者头像地址"},
+	}
+	adminVariables = []model.MailTemplateVariable{
+		{Name: "SITE_NAME", Description: "站点名称"},
+		{Name: "SITE_URL", Description: "站点地址"},
+		{Name: "POST_URL", Description: "评论所在页面地址"},
+		{Name: "TARGET_TITLE", Description: "评论所在页面标题"},
+		{Name: "NICK", Description: "评论者昵称"},
+		{Name: "COMMENT", Description: "评论内容（HTML）"},
+		{Name: "MAIL", Description: "评论者邮箱"},
+		{Name: "IP", Description: "评论者 IP"},
+		{Name: "IMG", Description: "评论者头像地址"},
+	}
+	mentionVariables = []model.MailTemplateVariable{
+		{Name: "SITE_NAME", Description: "站点名称"},
+		{Name: "SITE_URL", Description: "站点地址"},
+		{Name: "POST_URL", Description: "评论所在页面地址"},
+		{Name: "TARGET_TITLE", Description: "评论所在页面标题"},
+		{Name: "MENTIONED_NICK", Description: "被提及者昵称"},
+		{Name: "NICK", Description: "评论者昵称"},
+		{Name: "IMG", Description: "评论者头像地址"},
+		{Name: "TIME", Description: "评论时间"},
+		{Name: "COMMENT", Description: "评论内容（HTML）"},
+	}
+	digestVariables = []model.MailTemplateVariable{
+		{Name: "SITE_NAME", Description: "站点名称"},
+		{Name: "SITE_URL", Description: "站点地址"},
+		{Name: "POST_URL", Description: "评论所在页面地址"},
+		{Name: "TARGET_TITLE", Description: "评论所在页面标题"},
+		{Name: "COUNT", Description: "新评论数量"},
+		{Name: "COMMENTS", Description: "新评论列表，使用 {{range .COMMENTS}} 遍历，每项包含 NICK、TIME、COMMENT"},
+		{Name: "UNSUBSCRIBE_URL", Description: "退订链接"},
+	}
+)
+
+// sampleComment 是预览与校验使用的示例评论内容
+const sampleComment = template.HTML("<p>写得真好，学到了！</p>")
+
+func replySample() map[string]interface{} {
+	return map[string]interface{}{
+		"SITE_NAME":      "安知鱼",
+		"SITE_URL":       "https://blog.example.com",
+		"POST_URL":       "https://blog.example.com/posts/hello-world",
+		"PARENT_NICK":    "小明",
+		"PARENT_COMMENT": template.HTML("<p>请问这个配置在哪里修改？</p>"),
+		"PARENT_IMG":     "https://cravatar.cn/avatar/00000000000000000000000000000000?d=mp",
+		"NICK":           "安知鱼",
+		"COMMENT":        sampleComment,
+		"IMG":            "https://cravatar.cn/avatar/00000000000000000000000000000000?d=mp",
+	}
+}
+
+func adminSample() map[string]interface{} {
+	return map[string]interface{}{
+		"SITE_NAME":    "安知鱼",
+		"SITE_URL":     "https://blog.example.com",
+		"POST_URL":     "https://blog.example.com/posts/hello-world",
+		"TARGET_TITLE": "Hello World",
+		"NICK":         "小明",
+		"COMMENT":      sampleComment,
+		"MAIL":         "xiaoming@example.com",
+		"IP":           "127.0.0.1",
+		"IMG":          "https://cravatar.cn/avatar/00000000000000000000000000000000?d=mp",
+	}
+}
+
+func mentionSample() map[string]interface{} {
+	return map[string]interface{}{
+		"SITE_NAME":      "安知鱼",
+		"SITE_URL":       "https://blog.example.com",
+		"POST_URL":       "https://blog.example.com/posts/hello-world",
+		"TARGET_TITLE":   "Hello World",
+		"MENTIONED_NICK": "小红",
+		"NICK":           "小明",
+		"IMG":            "https://cravatar.cn/avatar/00000000000000000000000000000000?d=mp",
+		"TIME":           "2026-10-16 21:00",
+		"COMMENT":        template.HTML(`<p><span class="comment-mention">@小红</span> 快来看看这篇文章</p>`),
+	}
+}
+
+func digestSample() map[string]interface{} {
+	return map[string]interface{}{
+		"SITE_NAME":    "安知鱼",
+		"SITE_URL":     "https://blog.example.com",
+		"POST_URL":     "https://blog.example.com/posts/hello-world",
+		"TARGET_TITLE": "Hello World",
+		"COUNT":        2,
+		"COMMENTS": []map[string]interface{}{
+			{"NICK": "小明", "TIME": "2026-10-16 20:30", "COMMENT": sampleComment},
+			{"NICK": "小红", "TIME": "2026-10-16 20:45", "COMMENT": template.HTML("<p>同问</p>")},
+		},
+		"UNSUBSCRIBE_URL": "https://blog.example.com/api/public/comments/subscriptions/1/unsubscribe?sign=example",
+	}
+}
+
+// managedTemplates 受管理的评论通知邮件模板，按后台展示顺序排列
+var managedTemplates = []templateDef{
+	{key: constant.KeyCommentMailSubject, name: "回复通知邮件主题", kind: kindSubject, variables: replyVariables, sample: replySample},
+	{key: constant.KeyCommentMailTemplate, name: "回复通知邮件正文", kind: kindBody, variables: replyVariables, sample: replySample},
+	{key: constant.KeyCommentMailSubjectAdmin, name: "博主新评论邮件主题", kind: kindSubject, variables: adminVariables, sample: adminSample},
+	{key: constant.KeyCommentMailTemplateAdmin, name: "博主新评论邮件正文", kind: kindBody, variables: adminVariables, sample: adminSample},
+	{key: constant.KeyCommentMailSubjectMention, name: "提及通知邮件主题", kind: kindSubject, variables: mentionVariables, sample: mentionSample},
+	{key: constant.KeyCommentMailTemplateMention, name: "提及通知邮件正文", kind: kindBody, variables: mentionVariables, sample: mentionSample},
+	{key: constant.KeyCommentSubscribeMailSubject, name: "评论订阅摘要邮件主题", kind: kindSubject, variables: digestVariables, sample: digestSample},
+	{key: constant.KeyCommentSubscribeMailTemplate, name: "评论订阅摘要邮件正文", kind: kindBody, variables: digestVariables, sample: digestSample},
+}
+
+// findTemplate 按配置键查找受管理的模板
+func findTemplate(key string) (templateDef, bool) {
+	for _, def := range managedTemplates {
+		if def.key.String() == key {
+			return def, true
+		}
+	}
+	return templateDef{}, false
+}
@@ -0,0 +1,477 @@
+/*
+ * @Description: 媒体库服务：汇总文章/评论存储策略下的文件及其引用关系，识别孤立图片
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 19:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package media
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/entity"
+	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/moment"
+	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	entsetting "github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
+	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/compression"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// orphanGracePeriod 新上传的文件在此时间内不会被判定为孤立，避免误删编辑器中尚未保存的图片
+	orphanGracePeriod = 24 * time.Hour
+	// scanBatchSize 扫描引用时每批读取的记录数
+	scanBatchSize = 500
+)
+
+// 引用类型
+const (
+	RefTypeArticle        = "article"
+	RefTypeArticleHistory = "article_history"
+	RefTypeComment        = "comment"
+	RefTypePage           = "page"
+	RefTypeMoment         = "moment"
+	RefTypeAvatar         = "avatar"
+	RefTypeSetting        = "setting"
+)
+
+var (
+	// directLinkRefRegex 匹配文章/页面中的直链地址，如 https://example.com/api/f/AbC123/1.png
+	directLinkRefRegex = regexp.MustCompile(`/api/f/([A-Za-z0-9]+)/`)
+	// fileURIRefRegex 匹配评论中的内部文件 URI，如 anzhiyu://file/AbC123
+	fileURIRefRegex = regexp.MustCompile(`anzhiyu://file/([A-Za-z0-9]+)`)
+)
+
+// MediaReference 文件的一处引用
+type MediaReference struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+}
+
+// MediaItem 媒体库中的一个文件
+type MediaItem struct {
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	Size       int64            `json:"size"`
+	MimeType   string           `json:"mime_type,omitempty"`
+	PolicyFlag string           `json:"policy_flag"`
+	OwnerID    string           `json:"owner_id"`
+	URL        string           `json:"url,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	References []MediaReference `json:"references"`
+	IsOrphan   bool             `json:"is_orphan"`
+
+	ownerDBID uint
+}
+
+// addReference 记录一处引用，同一对象（如同一文章的多个历史版本）只记录一次
+func (m *MediaItem) addReference(ref MediaReference) {
+	for _, existing := range m.References {
+		if existing.Type == ref.Type && existing.ID == ref.ID {
+			return
+		}
+	}
+	m.References = append(m.References, ref)
+}
+
+// ListOptions 媒体库查询参数
+type ListOptions struct {
+	Page       int
+	PageSize   int
+	PolicyFlag string // article_image / comment_image，为空时两者都包含
+	OrphanOnly bool
+}
+
+// ListResult 媒体库分页结果
+type ListResult struct {
+	List        []*MediaItem `json:"list"`
+	Total       int          `json:"total"`
+	OrphanCount int          `json:"orphan_count"`
+	Page        int          `json:"page"`
+	PageSize    int          `json:"pageSize"`
+}
+
+// Service 媒体库服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting.SettingService
+}
+
+// NewService 创建媒体库服务实例
+func NewService(db *ent.Client, settingSvc setting.SettingService) *Service {
+	return &Service{
+		db:         db,
+		settingSvc: settingSvc,
+	}
+}
+
+// List 分页列出文章/评论图片策略下的文件及其引用情况
+func (s *Service) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.PageSize < 1 || opts.PageSize > 200 {
+		opts.PageSize = 50
+	}
+
+	items, err := s.collect(ctx, opts.PolicyFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListResult{Page: opts.Page, PageSize: opts.PageSize}
+	filtered := items[:0]
+	for _, item := range items {
+		if item.IsOrphan {
+			result.OrphanCount++
+		}
+		if opts.OrphanOnly && !item.IsOrphan {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+	result.Total = len(filtered)
+
+	start := (opts.Page - 1) * opts.PageSize
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	end := start + opts.PageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	result.List = filtered[start:end]
+	return result, nil
+}
+
+// FindOrphanFiles 查找全部孤立图片，按所有者分组返回文件公共ID，供清理服务删除
+func (s *Service) FindOrphanFiles(ctx context.Context) (map[uint][]string, error) {
+	items, err := s.collect(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	orphans := make(map[uint][]string)
+	for _, item := range items {
+		if item.IsOrphan {
+			orphans[item.ownerDBID] = append(orphans[item.ownerDBID], item.ID)
+		}
+	}
+	return orphans, nil
+}
+
+// collect 读取目标策略下的全部文件，并扫描文章、文章历史、页面、评论、说说、用户头像与站点配置建立引用关系。
+// 返回的列表按上传时间倒序排列。
+func (s *Service) collect(ctx context.Context, policyFlag string) ([]*MediaItem, error) {
+	flags := []string{constant.PolicyFlagArticleImage, constant.PolicyFlagCommentImage}
+	if policyFlag != "" {
+		if policyFlag != constant.PolicyFlagArticleImage && policyFlag != constant.PolicyFlagCommentImage {
+			return nil, fmt.Errorf("%w: 不支持的策略类型 %s", constant.ErrBadRequest, policyFlag)
+		}
+		flags = []string{policyFlag}
+	}
+
+	policies, err := s.db.StoragePolicy.Query().Where(storagepolicy.FlagIn(flags...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询存储策略失败: %w", err)
+	}
+	if len(policies) == 0 {
+		return []*MediaItem{}, nil
+	}
+	policyFlags := make(map[uint]string, len(policies))
+	policyIDs := make([]uint, 0, len(policies))
+	for _, p := range policies {
+		policyFlags[p.ID] = p.Flag
+		policyIDs = append(policyIDs, p.ID)
+	}
+
+	files, err := s.db.File.Query().
+		Where(
+			file.TypeEQ(int(model.FileTypeFile)),
+			file.DeletedAtIsNil(),
+			file.HasPrimaryEntityWith(entity.PolicyIDIn(policyIDs...)),
+		).
+		WithPrimaryEntity().
+		WithDirectLink().
+		Order(ent.Desc(file.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询媒体文件失败: %w", err)
+	}
+
+	siteURL := strings.TrimSuffix(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	items := make([]*MediaItem, 0, len(files))
+	byFileID := make(map[string]*MediaItem, len(files))
+	byLinkID := make(map[string]*MediaItem, len(files))
+	for _, f := range files {
+		item := &MediaItem{
+			Name:       f.Name,
+			Size:       f.Size,
+			CreatedAt:  f.CreatedAt,
+			References: []MediaReference{},
+			ownerDBID:  f.OwnerID,
+		}
+		item.ID, _ = idgen.GeneratePublicID(f.ID, idgen.EntityTypeFile)
+		item.OwnerID, _ = idgen.GeneratePublicID(f.OwnerID, idgen.EntityTypeUser)
+		if f.Edges.PrimaryEntity != nil {
+			item.PolicyFlag = policyFlags[f.Edges.PrimaryEntity.PolicyID]
+			if f.Edges.PrimaryEntity.MimeType != nil {
+				item.MimeType = *f.Edges.PrimaryEntity.MimeType
+			}
+		}
+		if item.MimeType == "" {
+			item.MimeType = mime.TypeByExtension(strings.ToLower(path.Ext(f.Name)))
+		}
+		if link := f.Edges.DirectLink; link != nil {
+			if linkID, err := idgen.GeneratePublicID(link.ID, idgen.EntityTypeDirectLink); err == nil {
+				item.URL = fmt.Sprintf("%s/api/f/%s/%s", siteURL, linkID, url.PathEscape(f.Name))
+				byLinkID[linkID] = item
+			}
+		}
+		byFileID[item.ID] = item
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	if err := s.scanReferences(ctx, byFileID, byLinkID); err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-orphanGracePeriod)
+	for _, item := range items {
+		item.IsOrphan = len(item.References) == 0 &&
+			strings.HasPrefix(item.MimeType, "image/") &&
+			item.CreatedAt.Before(cutoff)
+		sort.SliceStable(item.References, func(i, j int) bool {
+			return item.References[i].Type < item.References[j].Type
+		})
+	}
+	return items, nil
+}
+
+// scanReferences 扫描所有可能引用图片的内容，把引用记录到对应文件上。
+// 已软删除的文章和评论同样计入引用，以免恢复后图片丢失。各类内容按主键分批读取，避免一次载入全部正文。
+func (s *Service) scanReferences(ctx context.Context, byFileID, byLinkID map[string]*MediaItem) error {
+	attach := func(ref MediaReference, texts ...string) {
+		for _, text := range texts {
+			if text == "" {
+				continue
+			}
+			for _, m := range directLinkRefRegex.FindAllStringSubmatch(text, -1) {
+				if item, ok := byLinkID[m[1]]; ok {
+					item.addReference(ref)
+				}
+			}
+			for _, m := range fileURIRefRegex.FindAllStringSubmatch(text, -1) {
+				if item, ok := byFileID[m[1]]; ok {
+					item.addReference(ref)
+				}
+			}
+		}
+	}
+
+	for _, scan := range []func(context.Context, func(MediaReference, ...string)) error{
+		s.scanArticles, s.scanArticleHistories, s.scanPages, s.scanComments,
+		s.scanMoments, s.scanUsers, s.scanSettings,
+	} {
+		if err := scan(ctx, attach); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) scanArticles(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := uint(0); ; {
+		list, err := s.db.Article.Query().
+			Where(article.IDGT(lastID)).
+			Order(ent.Asc(article.FieldID)).
+			Limit(scanBatchSize).
+			Select(article.FieldID, article.FieldTitle, article.FieldAbbrlink, article.FieldContentMd,
+				article.FieldContentHTML, article.FieldCoverURL, article.FieldTopImgURL, article.FieldDeletedAt).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描文章引用失败: %w", err)
+		}
+		for _, a := range list {
+			lastID = a.ID
+			ref := MediaReference{Type: RefTypeArticle, Title: a.Title, Deleted: a.DeletedAt != nil}
+			ref.ID, _ = idgen.GeneratePublicID(a.ID, idgen.EntityTypeArticle)
+			if a.Abbrlink != nil && *a.Abbrlink != "" {
+				ref.Path = "/posts/" + *a.Abbrlink
+			} else {
+				ref.Path = "/posts/" + ref.ID
+			}
+			attach(ref, compression.DecompressTextOrRaw(a.ContentMd), compression.DecompressTextOrRaw(a.ContentHTML), a.CoverURL, a.TopImgURL)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanArticleHistories(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := uint(0); ; {
+		list, err := s.db.ArticleHistory.Query().
+			Where(articlehistory.IDGT(lastID)).
+			Order(ent.Asc(articlehistory.FieldID)).
+			Limit(scanBatchSize).
+			Select(articlehistory.FieldID, articlehistory.FieldArticleID, articlehistory.FieldTitle,
+				articlehistory.FieldContentMd, articlehistory.FieldCoverURL, articlehistory.FieldTopImgURL).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描文章历史引用失败: %w", err)
+		}
+		for _, h := range list {
+			lastID = h.ID
+			ref := MediaReference{Type: RefTypeArticleHistory, Title: h.Title}
+			ref.ID, _ = idgen.GeneratePublicID(h.ArticleID, idgen.EntityTypeArticle)
+			attach(ref, h.ContentMd, h.CoverURL, h.TopImgURL)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanPages(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := uint(0); ; {
+		list, err := s.db.Page.Query().
+			Where(page.IDGT(lastID)).
+			Order(ent.Asc(page.FieldID)).
+			Limit(scanBatchSize).
+			Select(page.FieldID, page.FieldTitle, page.FieldPath, page.FieldContent, page.FieldMarkdownContent).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描页面引用失败: %w", err)
+		}
+		for _, p := range list {
+			lastID = p.ID
+			attach(MediaReference{Type: RefTypePage, ID: fmt.Sprint(p.ID), Title: p.Title, Path: p.Path}, p.Content, p.MarkdownContent)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanComments(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := uint(0); ; {
+		list, err := s.db.Comment.Query().
+			Where(comment.IDGT(lastID)).
+			Order(ent.Asc(comment.FieldID)).
+			Limit(scanBatchSize).
+			Select(comment.FieldID, comment.FieldTargetPath, comment.FieldTargetTitle,
+				comment.FieldContent, comment.FieldContentHTML, comment.FieldDeletedAt).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描评论引用失败: %w", err)
+		}
+		for _, c := range list {
+			lastID = c.ID
+			ref := MediaReference{Type: RefTypeComment, Path: c.TargetPath, Deleted: c.DeletedAt != nil}
+			ref.ID, _ = idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+			if c.TargetTitle != nil {
+				ref.Title = *c.TargetTitle
+			}
+			attach(ref, c.Content, c.ContentHTML)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanMoments(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := uint(0); ; {
+		list, err := s.db.Moment.Query().
+			Where(moment.IDGT(lastID)).
+			Order(ent.Asc(moment.FieldID)).
+			Limit(scanBatchSize).
+			Select(moment.FieldID, moment.FieldContent, moment.FieldContentHTML, moment.FieldImages).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描说说引用失败: %w", err)
+		}
+		for _, m := range list {
+			lastID = m.ID
+			ref := MediaReference{Type: RefTypeMoment}
+			ref.ID, _ = idgen.GeneratePublicID(m.ID, idgen.EntityTypeMoment)
+			attach(ref, append([]string{m.Content, m.ContentHTML}, m.Images...)...)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanUsers(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := uint(0); ; {
+		list, err := s.db.User.Query().
+			Where(user.IDGT(lastID)).
+			Order(ent.Asc(user.FieldID)).
+			Limit(scanBatchSize).
+			Select(user.FieldID, user.FieldNickname, user.FieldAvatar, user.FieldAvatarVariants).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描用户头像引用失败: %w", err)
+		}
+		for _, u := range list {
+			lastID = u.ID
+			ref := MediaReference{Type: RefTypeAvatar, Title: u.Nickname}
+			ref.ID, _ = idgen.GeneratePublicID(u.ID, idgen.EntityTypeUser)
+			texts := []string{u.Avatar}
+			for _, v := range u.AvatarVariants {
+				texts = append(texts, v)
+			}
+			attach(ref, texts...)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) scanSettings(ctx context.Context, attach func(MediaReference, ...string)) error {
+	for lastID := 0; ; {
+		list, err := s.db.Setting.Query().
+			Where(entsetting.IDGT(lastID)).
+			Order(ent.Asc(entsetting.FieldID)).
+			Limit(scanBatchSize).
+			Select(entsetting.FieldID, entsetting.FieldConfigKey, entsetting.FieldValue).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("扫描配置引用失败: %w", err)
+		}
+		for _, item := range list {
+			lastID = item.ID
+			attach(MediaReference{Type: RefTypeSetting, ID: item.ConfigKey}, item.Value)
+		}
+		if len(list) < scanBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	_ "github.com/anzhiyu-c/anheyu-app/ent/runtime"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+func TestScanReferencesCoversMomentsAvatarsAndSettings(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:"+t.TempDir()+"/test.db?_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	group := client.UserGroup.Create().SetName("用户").SetPermissions(model.Boolset{}).SaveX(ctx)
+	client.User.Create().
+		SetUsername("alice").SetPasswordHash("x").SetNickname("Alice").SetEmail("alice@example.com").
+		SetAvatar("/api/f/avatar/a.png").
+		SetAvatarVariants(map[string]string{"small": "/api/f/variant/a.png"}).
+		SetUserGroup(group).
+		SaveX(ctx)
+	client.Moment.Create().SetContent("说说").SetImages([]string{"/api/f/moment/m.png"}).SaveX(ctx)
+	client.Setting.Create().SetConfigKey("site.logo").SetValue("anzhiyu://file/logo").SaveX(ctx)
+	// 超过一批的说说，确认分批扫描不会遗漏后面的记录
+	for i := 0; i < scanBatchSize; i++ {
+		client.Moment.Create().SetContent(fmt.Sprintf("说说 %d", i)).SaveX(ctx)
+	}
+	client.Moment.Create().SetContent("![](/api/f/last/l.png)").SaveX(ctx)
+
+	byLinkID := map[string]*MediaItem{}
+	for _, id := range []string{"avatar", "variant", "moment", "last", "unused"} {
+		byLinkID[id] = &MediaItem{}
+	}
+	byFileID := map[string]*MediaItem{"logo": {}}
+
+	svc := NewService(client, nil)
+	if err := svc.scanReferences(ctx, byFileID, byLinkID); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[*MediaItem]string{
+		byLinkID["avatar"]:  RefTypeAvatar,
+		byLinkID["variant"]: RefTypeAvatar,
+		byLinkID["moment"]:  RefTypeMoment,
+		byLinkID["last"]:    RefTypeMoment,
+		byFileID["logo"]:    RefTypeSetting,
+	}
+	for item, refType := range want {
+		if len(item.References) != 1 || item.References[0].Type != refType {
+			t.Errorf("应被 %s 引用，实际 %+v", refType, item.References)
+		}
+	}
+	if len(byLinkID["unused"].References) != 0 {
+		t.Errorf("未被引用的文件不应有引用: %+v", byLinkID["unused"].References)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * @Description: 注册用户公开资料页服务，按用户的可见性设置返回昵称、头像、简介与最近评论
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 00:00:00
+ * @LastEditTime: 2026-10-16 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// recentCommentCount 资料页展示的最近评论数
+const recentCommentCount = 10
+
+// Profile 注册用户的公开资料，未公开的字段为空
+type Profile struct {
+	ID             string                         `json:"id"`
+	Nickname       string                         `json:"nickname"`
+	Avatar         string                         `json:"avatar,omitempty"`
+	AvatarVariants map[string]string              `json:"avatarVariants,omitempty"`
+	Bio            string                         `json:"bio,omitempty"`
+	Website        string                         `json:"website,omitempty"`
+	JoinedAt       time.Time                      `json:"joinedAt"`
+	CommentCount   *int                           `json:"commentCount,omitempty"`
+	RecentComments []*dto.CommenterProfileComment `json:"recentComments,omitempty"`
+}
+
+// Service 注册用户公开资料页服务
+type Service struct {
+	userRepo   repository.UserRepository
+	commentSvc *comment.Service
+	settingSvc setting.SettingService
+}
+
+// NewService 创建公开资料页服务
+func NewService(userRepo repository.UserRepository, commentSvc *comment.Service, settingSvc setting.SettingService) *Service {
+	return &Service{
+		userRepo:   userRepo,
+		commentSvc: commentSvc,
+		settingSvc: settingSvc,
+	}
+}
+
+// Profile 返回用户的公开资料。用户不存在、未激活或未公开资料页时统一返回 ErrNotFound，
+// 避免通过返回差异探测账户是否存在。
+func (s *Service) Profile(ctx context.Context, publicID string) (*Profile, error) {
+	userID, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return nil, fmt.Errorf("无效的用户ID: %w", constant.ErrBadRequest)
+	}
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil || user.Status != model.UserStatusActive || !user.ProfileVisibility.Public {
+		return nil, fmt.Errorf("用户资料不存在或未公开: %w", constant.ErrNotFound)
+	}
+
+	visibility := user.ProfileVisibility
+	profile := &Profile{
+		ID:       publicID,
+		Nickname: user.Nickname,
+		JoinedAt: user.CreatedAt,
+	}
+	if profile.Nickname == "" {
+		profile.Nickname = user.Username
+	}
+	if visibility.ShowAvatar {
+		profile.Avatar = s.avatarURL(user.Avatar)
+		profile.AvatarVariants = user.AvatarVariants
+	}
+	if visibility.ShowBio {
+		profile.Bio = user.Bio
+	}
+	if visibility.ShowWebsite {
+		profile.Website = user.Website
+	}
+	if visibility.ShowComments {
+		count, recent, err := s.commentSvc.UserCommentFootprint(ctx, user.ID, recentCommentCount)
+		if err != nil {
+			return nil, err
+		}
+		profile.CommentCount = &count
+		profile.RecentComments = recent
+	}
+	return profile, nil
+}
+
+// avatarURL 补全 Gravatar 相对路径，与用户信息接口保持一致
+func (s *Service) avatarURL(avatar string) string {
+	if avatar == "" || strings.HasPrefix(avatar, "http://") || strings.HasPrefix(avatar, "https://") {
+		return avatar
+	}
+	base := strings.TrimSuffix(s.settingSvc.Get(constant.KeyGravatarURL.String()), "/")
+	return base + "/" + strings.TrimPrefix(avatar, "/")
+}
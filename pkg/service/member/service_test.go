@@ -0,0 +1,60 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users map[uint]*model.User
+}
+
+func (r *fakeUserRepo) FindByID(_ context.Context, id uint) (*model.User, error) {
+	return r.users[id], nil
+}
+
+type fakeSettings struct {
+	setting.SettingService
+}
+
+func (fakeSettings) Get(string) string { return "https://gravatar.example/" }
+
+func TestProfile_RespectsVisibility(t *testing.T) {
+	if err := idgen.InitSqidsEncoderWithSeed("member-test"); err != nil {
+		t.Fatal(err)
+	}
+	repo := &fakeUserRepo{users: map[uint]*model.User{
+		1: {ID: 1, Nickname: "公开", Avatar: "avatar/abc", Bio: "简介", Website: "https://a.example", Status: model.UserStatusActive,
+			ProfileVisibility: model.ProfileVisibility{Public: true, ShowAvatar: true}},
+		2: {ID: 2, Nickname: "私密", Status: model.UserStatusActive},
+		3: {ID: 3, Nickname: "封禁", Status: model.UserStatusBanned, ProfileVisibility: model.ProfileVisibility{Public: true}},
+	}}
+	svc := NewService(repo, nil, fakeSettings{})
+
+	id1, _ := idgen.GeneratePublicID(1, idgen.EntityTypeUser)
+	profile, err := svc.Profile(context.Background(), id1)
+	if err != nil {
+		t.Fatalf("Profile() error = %v", err)
+	}
+	if profile.Avatar != "https://gravatar.example/avatar/abc" {
+		t.Errorf("公开头像应补全为完整地址, got %q", profile.Avatar)
+	}
+	if profile.Bio != "" || profile.Website != "" || profile.CommentCount != nil {
+		t.Errorf("未开启的字段不应返回: %+v", profile)
+	}
+
+	for _, id := range []uint{2, 3, 4} {
+		publicID, _ := idgen.GeneratePublicID(id, idgen.EntityTypeUser)
+		if _, err := svc.Profile(context.Background(), publicID); !errors.Is(err, constant.ErrNotFound) {
+			t.Errorf("用户 %d 应返回 ErrNotFound, got %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * @Description: Micropub 请求解析（表单编码与 JSON 两种格式）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package micropub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// Micropub 支持的操作
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Properties 是 microformats2 属性集合，每个属性都是值数组
+type Properties map[string][]interface{}
+
+// Request 统一表示一次 Micropub 写入请求
+type Request struct {
+	Action     string
+	URL        string
+	Properties Properties // create 使用
+	Replace    Properties // update 使用
+	Add        Properties // update 使用
+	Delete     []string   // update 时要整体删除的属性名
+	DeleteVals Properties // update 时要删除的属性值
+}
+
+// formReservedKeys 表单中不属于 h-entry 属性的保留字段
+var formReservedKeys = map[string]bool{
+	"h":            true,
+	"access_token": true,
+	"action":       true,
+	"url":          true,
+}
+
+// ParseForm 解析 application/x-www-form-urlencoded 或 multipart 表单格式的请求。
+// 数组属性可以写作 category[]=a&category[]=b，也可以重复 category=a&category=b。
+func ParseForm(values url.Values) (*Request, error) {
+	req := &Request{
+		Action:     strings.ToLower(values.Get("action")),
+		URL:        values.Get("url"),
+		Properties: Properties{},
+	}
+	if req.Action == "" {
+		req.Action = ActionCreate
+		if h := values.Get("h"); h != "" && h != "entry" {
+			return nil, fmt.Errorf("%w: 仅支持 h=entry", constant.ErrBadRequest)
+		}
+	}
+	for key, vals := range values {
+		if formReservedKeys[key] {
+			continue
+		}
+		name := strings.TrimSuffix(key, "[]")
+		for _, v := range vals {
+			req.Properties[name] = append(req.Properties[name], v)
+		}
+	}
+	return req, nil
+}
+
+// jsonRequest 是 JSON 请求体的结构
+type jsonRequest struct {
+	Type       []string        `json:"type"`
+	Properties Properties      `json:"properties"`
+	Action     string          `json:"action"`
+	URL        string          `json:"url"`
+	Replace    Properties      `json:"replace"`
+	Add        Properties      `json:"add"`
+	Delete     json.RawMessage `json:"delete"`
+}
+
+// ParseJSON 解析 application/json 格式的请求。
+func ParseJSON(body []byte) (*Request, error) {
+	var raw jsonRequest
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("%w: JSON 格式错误: %v", constant.ErrBadRequest, err)
+	}
+
+	req := &Request{
+		Action:     strings.ToLower(raw.Action),
+		URL:        raw.URL,
+		Properties: raw.Properties,
+		Replace:    raw.Replace,
+		Add:        raw.Add,
+	}
+	if req.Action == "" {
+		req.Action = ActionCreate
+		if len(raw.Type) > 0 && raw.Type[0] != "h-entry" {
+			return nil, fmt.Errorf("%w: 仅支持 h-entry", constant.ErrBadRequest)
+		}
+	}
+	if req.Properties == nil {
+		req.Properties = Properties{}
+	}
+
+	// delete 可以是属性名数组，也可以是 {属性: [值]} 对象
+	if len(raw.Delete) > 0 {
+		if err := json.Unmarshal(raw.Delete, &req.Delete); err != nil {
+			if err := json.Unmarshal(raw.Delete, &req.DeleteVals); err != nil {
+				return nil, fmt.Errorf("%w: delete 字段格式错误", constant.ErrBadRequest)
+			}
+		}
+	}
+	return req, nil
+}
+
+// first 返回属性的第一个字符串值
+func (p Properties) first(name string) string {
+	vals := p[name]
+	if len(vals) == 0 {
+		return ""
+	}
+	return valueString(vals[0])
+}
+
+// values 返回属性的全部字符串值，空值会被忽略
+func (p Properties) values(name string) []string {
+	result := make([]string, 0, len(p[name]))
+	for _, v := range p[name] {
+		if s := strings.TrimSpace(valueString(v)); s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// content 返回正文及其是否为 HTML。
+// content 可以是纯文本（按 Markdown 处理），也可以是 {"html": "..."} 对象。
+func (p Properties) content() (string, bool) {
+	vals := p["content"]
+	if len(vals) == 0 {
+		return "", false
+	}
+	if obj, ok := vals[0].(map[string]interface{}); ok {
+		if html, ok := obj["html"].(string); ok {
+			return html, true
+		}
+		if value, ok := obj["value"].(string); ok {
+			return value, false
+		}
+		return "", false
+	}
+	return valueString(vals[0]), false
+}
+
+// valueString 把属性值转换为字符串。photo 等属性可能是 {"value": url, "alt": ...} 对象。
+func valueString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case map[string]interface{}:
+		if s, ok := val["value"].(string); ok {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package micropub
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseForm(t *testing.T) {
+	values := url.Values{
+		"h":            {"entry"},
+		"access_token": {"ahy_secret"},
+		"name":         {"Hello"},
+		"content":      {"# Title"},
+		"category[]":   {"go", "blog"},
+		"post-status":  {"draft"},
+	}
+	req, err := ParseForm(values)
+	if err != nil {
+		t.Fatalf("ParseForm() error = %v", err)
+	}
+	if req.Action != ActionCreate {
+		t.Errorf("Action = %q, want %q", req.Action, ActionCreate)
+	}
+	if _, ok := req.Properties["access_token"]; ok {
+		t.Error("access_token should not be treated as a property")
+	}
+	if got := req.Properties.values("category"); !reflect.DeepEqual(got, []string{"go", "blog"}) {
+		t.Errorf("category = %v", got)
+	}
+	if got := postStatus(req.Properties.first("post-status")); got != "DRAFT" {
+		t.Errorf("post-status = %q, want DRAFT", got)
+	}
+
+	if _, err := ParseForm(url.Values{"h": {"event"}}); err == nil {
+		t.Error("expected error for h=event")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	req, err := ParseJSON([]byte(`{"type":["h-entry"],"properties":{"name":["Hi"],"content":[{"html":"<p>x</p>"}],"photo":[{"value":"https://a/b.png","alt":"b"}]}}`))
+	if err != nil {
+		t.Fatalf("ParseJSON() error = %v", err)
+	}
+	if content, isHTML := req.Properties.content(); content != "<p>x</p>" || !isHTML {
+		t.Errorf("content = %q, %v", content, isHTML)
+	}
+	if got := req.Properties.first("photo"); got != "https://a/b.png" {
+		t.Errorf("photo = %q", got)
+	}
+
+	update, err := ParseJSON([]byte(`{"action":"update","url":"https://blog.example/posts/hello","add":{"category":["new"]},"delete":["category"]}`))
+	if err != nil {
+		t.Fatalf("ParseJSON(update) error = %v", err)
+	}
+	if update.Action != ActionUpdate || !reflect.DeepEqual(update.Delete, []string{"category"}) {
+		t.Errorf("unexpected update request: %+v", update)
+	}
+
+	deleteVals, err := ParseJSON([]byte(`{"action":"update","url":"/posts/hello","delete":{"category":["old"]}}`))
+	if err != nil {
+		t.Fatalf("ParseJSON(delete values) error = %v", err)
+	}
+	if got := deleteVals.DeleteVals.values("category"); !reflect.DeepEqual(got, []string{"old"}) {
+		t.Errorf("delete values = %v", got)
+	}
+}
+
+func TestSlugFromURL(t *testing.T) {
+	tests := map[string]string{
+		"https://blog.example/posts/hello/": "hello",
+		"/posts/%E4%BD%A0%E5%A5%BD":         "你好",
+		"abc123":                            "abc123",
+		"":                                  "",
+	}
+	for in, want := range tests {
+		if got := slugFromURL(in); got != want {
+			t.Errorf("slugFromURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
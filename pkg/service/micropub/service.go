@@ -0,0 +1,395 @@
+/*
+ * @Description: Micropub 发布服务，供外部编辑器（iA Writer、Obsidian 插件、脚本等）通过个人访问令牌发布文章
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package micropub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// adminGroupID 管理员用户组的数据库ID
+const adminGroupID = 1
+
+// Service 封装了 Micropub 发布相关的业务逻辑。
+type Service struct {
+	articleSvc  article_service.Service
+	articleRepo repository.ArticleRepository
+	tagRepo     repository.PostTagRepository
+	parserSvc   *parser.Service
+	settingSvc  setting.SettingService
+}
+
+// NewService 是 Micropub Service 的构造函数。
+func NewService(
+	articleSvc article_service.Service,
+	articleRepo repository.ArticleRepository,
+	tagRepo repository.PostTagRepository,
+	parserSvc *parser.Service,
+	settingSvc setting.SettingService,
+) *Service {
+	return &Service{
+		articleSvc:  articleSvc,
+		articleRepo: articleRepo,
+		tagRepo:     tagRepo,
+		parserSvc:   parserSvc,
+		settingSvc:  settingSvc,
+	}
+}
+
+// Config 返回 q=config 查询的结果，告知客户端媒体端点地址。
+func (s *Service) Config() map[string]interface{} {
+	return map[string]interface{}{
+		"media-endpoint": s.siteURL() + "/api/micropub/media",
+		"syndicate-to":   []interface{}{},
+		"post-types": []map[string]string{
+			{"type": "article", "name": "文章"},
+			{"type": "bookmark", "name": "链接"},
+		},
+	}
+}
+
+// Create 根据 h-entry 属性创建文章，返回文章的永久链接。
+// post-status=draft 时创建草稿，否则直接发布；mp-slug 作为文章永久链接；bookmark-of 创建链接文章。
+func (s *Service) Create(ctx context.Context, user *model.User, req *Request, ip, referer string) (string, error) {
+	props := req.Properties
+	content, isHTML := props.content()
+	title := strings.TrimSpace(props.first("name"))
+	if title == "" {
+		if strings.TrimSpace(content) == "" {
+			return "", fmt.Errorf("%w: name 与 content 不能同时为空", constant.ErrBadRequest)
+		}
+		title = "未命名文章 " + time.Now().Format("2006-01-02 15:04")
+	}
+
+	contentMd, contentHTML, err := s.renderContent(ctx, content, isHTML)
+	if err != nil {
+		return "", err
+	}
+	tagIDs, err := s.resolveTagIDs(ctx, props.values("category"))
+	if err != nil {
+		return "", err
+	}
+
+	createReq := &model.CreateArticleRequest{
+		Title:       title,
+		ContentMd:   contentMd,
+		ContentHTML: contentHTML,
+		Status:      postStatus(props.first("post-status")),
+		CoverURL:    props.first("photo"),
+		Summaries:   props.values("summary"),
+		PostTagIDs:  tagIDs,
+		Abbrlink:    strings.TrimSpace(props.first("mp-slug")),
+		LinkURL:     strings.TrimSpace(props.first("bookmark-of")),
+		OwnerID:     user.ID,
+	}
+	if published := props.first("published"); published != "" {
+		createReq.CustomPublishedAt = &published
+	}
+
+	article, err := s.articleSvc.Create(ctx, createReq, ip, referer)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("[Micropub] 用户 %d 发布了文章 %s (%s)", user.ID, article.ID, article.Status)
+	return s.articleURL(article.Abbrlink, article.ID), nil
+}
+
+// Update 按 replace / add / delete 语义更新文章。
+// 非管理员只能更新自己的文章。
+func (s *Service) Update(ctx context.Context, user *model.User, req *Request) (string, error) {
+	article, err := s.resolveArticle(ctx, user, req.URL)
+	if err != nil {
+		return "", err
+	}
+
+	updateReq := &model.UpdateArticleRequest{}
+	changed := false
+
+	// replace：直接覆盖属性
+	if req.Replace != nil {
+		if _, ok := req.Replace["name"]; ok {
+			title := strings.TrimSpace(req.Replace.first("name"))
+			updateReq.Title = &title
+			changed = true
+		}
+		if _, ok := req.Replace["content"]; ok {
+			content, isHTML := req.Replace.content()
+			contentMd, contentHTML, err := s.renderContent(ctx, content, isHTML)
+			if err != nil {
+				return "", err
+			}
+			updateReq.ContentMd = &contentMd
+			updateReq.ContentHTML = &contentHTML
+			changed = true
+		}
+		if _, ok := req.Replace["category"]; ok {
+			tagIDs, err := s.resolveTagIDs(ctx, req.Replace.values("category"))
+			if err != nil {
+				return "", err
+			}
+			updateReq.PostTagIDs = tagIDs
+			changed = true
+		}
+		if _, ok := req.Replace["photo"]; ok {
+			cover := req.Replace.first("photo")
+			updateReq.CoverURL = &cover
+			changed = true
+		}
+		if _, ok := req.Replace["summary"]; ok {
+			updateReq.Summaries = req.Replace.values("summary")
+			changed = true
+		}
+		if _, ok := req.Replace["bookmark-of"]; ok {
+			linkURL := strings.TrimSpace(req.Replace.first("bookmark-of"))
+			updateReq.LinkURL = &linkURL
+			changed = true
+		}
+		if _, ok := req.Replace["post-status"]; ok {
+			status := postStatus(req.Replace.first("post-status"))
+			updateReq.Status = &status
+			changed = true
+		}
+	}
+
+	// add / delete：目前只有 category 是多值属性，需要在现有标签的基础上增删
+	addTags := req.Add.values("category")
+	deleteTags := req.DeleteVals.values("category")
+	deleteAllTags := false
+	for _, name := range req.Delete {
+		if name == "category" {
+			deleteAllTags = true
+		}
+	}
+	if updateReq.PostTagIDs == nil && (len(addTags) > 0 || len(deleteTags) > 0 || deleteAllTags) {
+		names := make([]string, 0, len(article.PostTags)+len(addTags))
+		if !deleteAllTags {
+			removed := make(map[string]bool, len(deleteTags))
+			for _, name := range deleteTags {
+				removed[name] = true
+			}
+			for _, tag := range article.PostTags {
+				if !removed[tag.Name] {
+					names = append(names, tag.Name)
+				}
+			}
+		}
+		names = append(names, addTags...)
+		tagIDs, err := s.resolveTagIDs(ctx, names)
+		if err != nil {
+			return "", err
+		}
+		updateReq.PostTagIDs = tagIDs
+		changed = true
+	}
+
+	if !changed {
+		return "", fmt.Errorf("%w: 没有可更新的属性", constant.ErrBadRequest)
+	}
+
+	updated, err := s.articleSvc.Update(ctx, article.ID, updateReq, "", "")
+	if err != nil {
+		return "", err
+	}
+	log.Printf("[Micropub] 用户 %d 更新了文章 %s", user.ID, updated.ID)
+	return s.articleURL(updated.Abbrlink, updated.ID), nil
+}
+
+// Delete 删除文章（移入回收站）。非管理员只能删除自己的文章。
+func (s *Service) Delete(ctx context.Context, user *model.User, req *Request) error {
+	article, err := s.resolveArticle(ctx, user, req.URL)
+	if err != nil {
+		return err
+	}
+	if err := s.articleSvc.Delete(ctx, article.ID); err != nil {
+		return err
+	}
+	log.Printf("[Micropub] 用户 %d 删除了文章 %s", user.ID, article.ID)
+	return nil
+}
+
+// Source 返回 q=source 查询的结果，即文章的 h-entry 属性。
+// props 为空时返回全部支持的属性。
+func (s *Service) Source(ctx context.Context, user *model.User, postURL string, props []string) (map[string]interface{}, error) {
+	article, err := s.resolveArticle(ctx, user, postURL)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]interface{}, 0, len(article.PostTags))
+	for _, tag := range article.PostTags {
+		categories = append(categories, tag.Name)
+	}
+	summaries := make([]interface{}, 0, len(article.Summaries))
+	for _, summary := range article.Summaries {
+		summaries = append(summaries, summary)
+	}
+	status := "published"
+	if article.Status == "DRAFT" {
+		status = "draft"
+	}
+	all := Properties{
+		"name":        {article.Title},
+		"content":     {article.ContentMd},
+		"category":    categories,
+		"summary":     summaries,
+		"post-status": {status},
+		"published":   {article.CreatedAt.Format(time.RFC3339)},
+	}
+	if article.CoverURL != "" {
+		all["photo"] = []interface{}{article.CoverURL}
+	}
+	if article.LinkURL != "" {
+		all["bookmark-of"] = []interface{}{article.LinkURL}
+	}
+
+	selected := all
+	if len(props) > 0 {
+		selected = Properties{}
+		for _, name := range props {
+			if vals, ok := all[name]; ok {
+				selected[name] = vals
+			}
+		}
+	}
+	return map[string]interface{}{
+		"type":       []string{"h-entry"},
+		"properties": selected,
+	}, nil
+}
+
+// UploadMedia 上传媒体文件（图片），返回文件的访问地址。
+func (s *Service) UploadMedia(ctx context.Context, user *model.User, reader io.Reader, filename string) (string, error) {
+	fileURL, _, err := s.articleSvc.UploadArticleImageWithGroup(ctx, user.ID, user.UserGroupID, reader, filename)
+	if err != nil {
+		return "", err
+	}
+	return fileURL, nil
+}
+
+// resolveArticle 根据文章永久链接找到文章，并校验当前用户是否有权操作
+func (s *Service) resolveArticle(ctx context.Context, user *model.User, postURL string) (*model.Article, error) {
+	slug := slugFromURL(postURL)
+	if slug == "" {
+		return nil, fmt.Errorf("%w: 缺少有效的 url 参数", constant.ErrBadRequest)
+	}
+	article, err := s.articleRepo.GetBySlugOrIDForPreview(ctx, slug)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) || ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: 文章不存在", constant.ErrNotFound)
+		}
+		return nil, err
+	}
+	if user.UserGroupID != adminGroupID && article.OwnerID != user.ID {
+		return nil, fmt.Errorf("%w: 只能操作自己的文章", constant.ErrForbidden)
+	}
+	return article, nil
+}
+
+// renderContent 把正文转换为编辑器使用的 Markdown 与 HTML。HTML 正文原样保存，Markdown 会原样渲染其中的 HTML。
+func (s *Service) renderContent(ctx context.Context, content string, isHTML bool) (string, string, error) {
+	if content == "" || isHTML {
+		return content, content, nil
+	}
+	html, err := s.parserSvc.ToHTML(ctx, content)
+	if err != nil {
+		return "", "", fmt.Errorf("渲染正文失败: %w", err)
+	}
+	return content, html, nil
+}
+
+// resolveTagIDs 把标签名转换为标签ID，不存在的标签会自动创建
+func (s *Service) resolveTagIDs(ctx context.Context, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return []string{}, nil
+	}
+	existing, err := s.tagRepo.List(ctx, &model.ListPostTagsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("获取标签列表失败: %w", err)
+	}
+	byName := make(map[string]string, len(existing))
+	for _, tag := range existing {
+		byName[strings.ToLower(tag.Name)] = tag.ID
+	}
+
+	ids := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if id, ok := byName[key]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		tag, err := s.tagRepo.Create(ctx, &model.CreatePostTagRequest{Name: name})
+		if err != nil {
+			return nil, fmt.Errorf("创建标签 '%s' 失败: %w", name, err)
+		}
+		byName[key] = tag.ID
+		ids = append(ids, tag.ID)
+	}
+	return ids, nil
+}
+
+// articleURL 生成文章的永久链接
+func (s *Service) articleURL(abbrlink, publicID string) string {
+	slug := abbrlink
+	if slug == "" {
+		slug = publicID
+	}
+	return s.siteURL() + "/posts/" + slug
+}
+
+// siteURL 返回去掉末尾斜杠的站点地址
+func (s *Service) siteURL() string {
+	return strings.TrimSuffix(strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String())), "/")
+}
+
+// postStatus 把 Micropub 的 post-status 映射为文章状态
+func postStatus(status string) string {
+	if strings.EqualFold(strings.TrimSpace(status), "draft") {
+		return "DRAFT"
+	}
+	return "PUBLISHED"
+}
+
+// slugFromURL 从文章链接（完整 URL 或 /posts/xxx 路径）中提取 slug 或公共ID
+func slugFromURL(postURL string) string {
+	postURL = strings.TrimSpace(postURL)
+	if postURL == "" {
+		return ""
+	}
+	path := postURL
+	if u, err := url.Parse(postURL); err == nil && u.Path != "" {
+		path = u.Path
+	}
+	path = strings.Trim(path, "/")
+	if idx := strings.LastIndex(path, "/"); idx >= 0 {
+		path = path[idx+1:]
+	}
+	if decoded, err := url.PathUnescape(path); err == nil {
+		path = decoded
+	}
+	return path
+}
@@ -0,0 +1,337 @@
+/*
+ * @Description: 迁移文章中引用的图片：本地资源从源码包读取，远程图片按需下载，统一上传到文章图片存储策略
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 15:00:00
+ * @LastEditTime: 2026-10-16 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package migration
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// maxImageSize 单张图片的大小上限
+	maxImageSize = 20 << 20
+)
+
+// imageExtensions 允许迁移的图片类型。SVG 可能携带脚本，不随迁移上传
+var imageExtensions = map[string]bool{
+	".png":  true,
+	".jpg":  true,
+	".jpeg": true,
+	".gif":  true,
+	".webp": true,
+	".avif": true,
+	".bmp":  true,
+	".ico":  true,
+}
+
+// unsafeContentTypes 可能在浏览器中执行脚本的类型，无论来源都不迁移
+var unsafeContentTypes = map[string]bool{
+	"image/svg+xml":          true,
+	"text/html":              true,
+	"application/xhtml+xml":  true,
+	"text/javascript":        true,
+	"application/javascript": true,
+}
+
+var (
+	// markdownImageRe 匹配 ![alt](url "title")
+	markdownImageRe = regexp.MustCompile(`!\[([^\]]*)\]\(\s*<?([^)\s>]+)>?((?:\s+(?:"[^"]*"|'[^']*'))?)\s*\)`)
+	// srcAttrRe 匹配 HTML 的 <img src> 以及 Hugo figure 短代码的 src 参数
+	srcAttrRe = regexp.MustCompile(`(<img\b[^>]*?\bsrc\s*=\s*|\{\{[<%]\s*figure\b[^}]*?\bsrc\s*=\s*)(["'])([^"']+)(["'])`)
+	// codeFenceRe 匹配围栏代码块的起止行
+	codeFenceRe = regexp.MustCompile("^\\s*(```|~~~)")
+	// assetImgRe 匹配 Hexo 的 {% asset_img name [title] %} 标签
+	assetImgRe = regexp.MustCompile(`\{%\s*asset_img\s+(\S+)(?:\s+(.*?))?\s*%\}`)
+	// hrefAttrRe 匹配 <a href>，仅用于迁移 WordPress 中链接到附件的地址
+	hrefAttrRe = regexp.MustCompile(`(<a\b[^>]*?\bhref\s*=\s*)(["'])([^"']+)(["'])`)
+)
+
+// imageMigrator 负责一次迁移中的图片上传，同一张图片只上传一次
+type imageMigrator struct {
+	ctx            context.Context
+	svc            *Service
+	layout         *siteLayout
+	files          map[string]*zip.File
+	ownerID        uint
+	downloadRemote bool
+	uploaded       map[string]string // 来源（压缩包路径或远程地址）→ 新地址
+	produced       map[string]bool   // 本次迁移生成的新地址，避免被当作远程图片再次下载
+	failed         map[string]string // 来源 → 失败原因
+	// attachments WordPress 导出中声明的附件，为 nil 时表示不是 WordPress 迁移
+	attachments *attachmentIndex
+}
+
+// postImages 单篇文章的图片迁移结果
+type postImages struct {
+	migrated int
+	warnings []string
+}
+
+// rewriteContent 替换正文中的图片地址，围栏代码块内的内容保持原样；无法迁移的图片保留原地址并记录警告
+func (m *imageMigrator) rewriteContent(postPath, content string, result *postImages) string {
+	var out, chunk strings.Builder
+	inFence := false
+	flush := func() {
+		out.WriteString(m.rewriteChunk(postPath, chunk.String(), result))
+		chunk.Reset()
+	}
+	for _, line := range strings.SplitAfter(content, "\n") {
+		if codeFenceRe.MatchString(line) {
+			if !inFence {
+				flush()
+			}
+			inFence = !inFence
+			out.WriteString(line)
+			continue
+		}
+		if inFence {
+			out.WriteString(line)
+		} else {
+			chunk.WriteString(line)
+		}
+	}
+	flush()
+	return out.String()
+}
+
+func (m *imageMigrator) rewriteChunk(postPath, content string, result *postImages) string {
+	if content == "" {
+		return content
+	}
+	content = assetImgRe.ReplaceAllStringFunc(content, func(match string) string {
+		sub := assetImgRe.FindStringSubmatch(match)
+		title := strings.Trim(strings.TrimSpace(sub[2]), `"'`)
+		if newURL, ok := m.migrate(postPath, sub[1], result); ok {
+			return fmt.Sprintf("![%s](%s)", title, newURL)
+		}
+		return match
+	})
+	content = markdownImageRe.ReplaceAllStringFunc(content, func(match string) string {
+		sub := markdownImageRe.FindStringSubmatch(match)
+		if newURL, ok := m.migrate(postPath, sub[2], result); ok {
+			return fmt.Sprintf("![%s](%s%s)", sub[1], newURL, sub[3])
+		}
+		return match
+	})
+	content = srcAttrRe.ReplaceAllStringFunc(content, func(match string) string {
+		sub := srcAttrRe.FindStringSubmatch(match)
+		if newURL, ok := m.migrate(postPath, sub[3], result); ok {
+			return sub[1] + sub[2] + newURL + sub[4]
+		}
+		return match
+	})
+	if m.attachments != nil {
+		content = hrefAttrRe.ReplaceAllStringFunc(content, func(match string) string {
+			sub := hrefAttrRe.FindStringSubmatch(match)
+			if _, ok := m.attachments.resolve(m.attachments.absolute(sub[3])); !ok {
+				return match
+			}
+			if newURL, ok := m.migrate(postPath, sub[3], result); ok {
+				return sub[1] + sub[2] + newURL + sub[4]
+			}
+			return match
+		})
+	}
+	return content
+}
+
+// rewriteURL 迁移 front-matter 中的单个图片地址（封面、顶部图）
+func (m *imageMigrator) rewriteURL(postPath, ref string, result *postImages) string {
+	if ref == "" {
+		return ref
+	}
+	if newURL, ok := m.migrate(postPath, ref, result); ok {
+		return newURL
+	}
+	return ref
+}
+
+// migrate 上传引用的图片并返回新地址；不需要迁移（如 data URI、已迁移过的地址）或迁移失败时返回 false
+func (m *imageMigrator) migrate(postPath, ref string, result *postImages) (string, bool) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.HasPrefix(ref, "data:") || strings.HasPrefix(ref, "#") || m.produced[ref] {
+		return "", false
+	}
+
+	if m.attachments != nil {
+		ref = m.attachments.absolute(ref)
+	}
+
+	var key string
+	var load func() (io.Reader, string, error)
+	if isRemote(ref) {
+		if !m.downloadRemote {
+			return "", false
+		}
+		remote := ref
+		if strings.HasPrefix(remote, "//") {
+			remote = "https:" + remote
+		}
+		// WordPress 正文中常引用缩略图尺寸（name-300x200.jpg），统一迁移附件原图
+		attachment := false
+		if m.attachments != nil {
+			remote, attachment = m.attachments.resolve(remote)
+		}
+		key = remote
+		load = func() (io.Reader, string, error) { return m.svc.download(m.ctx, remote, attachment) }
+	} else {
+		zipPath, ok := m.resolveLocal(postPath, ref)
+		if !ok {
+			result.warnings = append(result.warnings, fmt.Sprintf("未在源码包中找到图片: %s", ref))
+			return "", false
+		}
+		key = zipPath
+		load = func() (io.Reader, string, error) { return m.readLocal(zipPath) }
+	}
+
+	if newURL, ok := m.uploaded[key]; ok {
+		return newURL, true
+	}
+	if reason, ok := m.failed[key]; ok {
+		result.warnings = append(result.warnings, fmt.Sprintf("图片 %s 迁移失败: %s", ref, reason))
+		return "", false
+	}
+
+	newURL, err := m.upload(load)
+	if err != nil {
+		m.failed[key] = err.Error()
+		result.warnings = append(result.warnings, fmt.Sprintf("图片 %s 迁移失败: %v", ref, err))
+		return "", false
+	}
+	m.uploaded[key] = newURL
+	m.produced[newURL] = true
+	result.migrated++
+	return newURL, true
+}
+
+func (m *imageMigrator) upload(load func() (io.Reader, string, error)) (string, error) {
+	reader, filename, err := load()
+	if err != nil {
+		return "", err
+	}
+	newURL, _, err := m.svc.articles.UploadArticleImage(m.ctx, m.ownerID, reader, filename)
+	if err != nil {
+		return "", err
+	}
+	return newURL, nil
+}
+
+// resolveLocal 把站内图片引用解析为压缩包内的文件路径：
+// 以 / 开头的路径在 Hexo 的 source、Hugo 的 static / assets 下查找；
+// 相对路径依次在文章所在目录与同名资源目录（Hexo post_asset_folder）下查找。
+func (m *imageMigrator) resolveLocal(postPath, ref string) (string, bool) {
+	if i := strings.IndexAny(ref, "?#"); i >= 0 {
+		ref = ref[:i]
+	}
+	if unescaped, err := url.PathUnescape(ref); err == nil {
+		ref = unescaped
+	}
+	if !imageExtensions[strings.ToLower(path.Ext(ref))] {
+		return "", false
+	}
+
+	var candidates []string
+	if strings.HasPrefix(ref, "/") {
+		for _, dir := range m.layout.staticDirs {
+			candidates = append(candidates, path.Join(dir, ref))
+		}
+	} else {
+		dir := path.Dir(postPath)
+		candidates = append(candidates,
+			path.Join(dir, ref),
+			path.Join(strings.TrimSuffix(postPath, path.Ext(postPath)), ref),
+		)
+	}
+	for _, candidate := range candidates {
+		// path.Join 会消除 ..，确保结果仍在源站目录内
+		if !strings.HasPrefix(candidate, m.layout.root) {
+			continue
+		}
+		if _, ok := m.files[candidate]; ok {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+func (m *imageMigrator) readLocal(zipPath string) (io.Reader, string, error) {
+	f := m.files[zipPath]
+	if f.UncompressedSize64 > maxImageSize {
+		return nil, "", fmt.Errorf("图片超过 %d MB", maxImageSize>>20)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, maxImageSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxImageSize {
+		return nil, "", fmt.Errorf("图片超过 %d MB", maxImageSize>>20)
+	}
+	return bytes.NewReader(data), path.Base(zipPath), nil
+}
+
+// download 通过 SSRF 防护下载远程图片，只接受 image/* 响应。
+// attachment 为 true 时下载的是 WordPress 导出中声明的附件，允许文档、音视频等类型，但仍拒绝可执行脚本的类型
+func (s *Service) download(ctx context.Context, rawURL string, attachment bool) (io.Reader, string, error) {
+	if err := s.guard.CheckURL(rawURL); err != nil {
+		return nil, "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("User-Agent", "anheyu-app-migration")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("下载失败，状态码 %d", resp.StatusCode)
+	}
+	contentType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if unsafeContentTypes[contentType] || (!attachment && !strings.HasPrefix(contentType, "image/")) {
+		return nil, "", fmt.Errorf("不是支持的图片类型: %s", contentType)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxImageSize {
+		return nil, "", fmt.Errorf("图片超过 %d MB", maxImageSize>>20)
+	}
+
+	filename := "image"
+	if u, err := url.Parse(rawURL); err == nil && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+		filename = path.Base(u.Path)
+	}
+	if !imageExtensions[strings.ToLower(path.Ext(filename))] && !attachment {
+		if exts, _ := mime.ExtensionsByType(contentType); len(exts) > 0 {
+			filename += exts[0]
+		}
+	}
+	return bytes.NewReader(data), filename, nil
+}
+
+func isRemote(ref string) bool {
+	lower := strings.ToLower(ref)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "//")
+}
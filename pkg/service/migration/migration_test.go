@@ -0,0 +1,193 @@
+package migration
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+)
+
+type fakeImporter struct {
+	imported []article.ExportArticleItem
+	requests []*article.ImportArticleRequest
+	uploads  []string
+}
+
+func (f *fakeImporter) ImportArticles(_ context.Context, req *article.ImportArticleRequest) (*article.ImportResult, error) {
+	f.requests = append(f.requests, req)
+	f.imported = append(f.imported, req.Data.Articles...)
+	return &article.ImportResult{TotalCount: 1, SuccessCount: 1, CreatedIDs: []string{fmt.Sprintf("a%d", len(f.imported))}}, nil
+}
+
+func (f *fakeImporter) UploadArticleImage(_ context.Context, _ uint, r io.Reader, filename string) (string, string, error) {
+	if _, err := io.ReadAll(r); err != nil {
+		return "", "", err
+	}
+	f.uploads = append(f.uploads, filename)
+	return fmt.Sprintf("https://cdn.example.com/%d/%s", len(f.uploads), filename), "f", nil
+}
+
+type fakeArticleRepo struct {
+	repository.ArticleRepository
+	abbrlinks map[string]bool
+}
+
+func (r *fakeArticleRepo) ExistsByAbbrlink(_ context.Context, abbrlink string, _ uint) (bool, error) {
+	return r.abbrlinks[abbrlink], nil
+}
+
+func (r *fakeArticleRepo) ExistsByTitle(context.Context, string, uint) (bool, error) {
+	return false, nil
+}
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func findItem(t *testing.T, items []article.ExportArticleItem, title string) article.ExportArticleItem {
+	t.Helper()
+	for _, item := range items {
+		if item.Title == title {
+			return item
+		}
+	}
+	t.Fatalf("article %q not imported", title)
+	return article.ExportArticleItem{}
+}
+
+func TestMigrateHexo(t *testing.T) {
+	archive := buildZip(t, map[string]string{
+		"blog/_config.yml": "title: test\n",
+		"blog/source/_posts/hello.md": "---\ntitle: Hello\ndate: 2023-05-01 10:00:00\ntags: [go, hexo]\ncategories:\n  - [Tech, Backend]\ncover: /img/cover.png\n---\n" +
+			"{% asset_img a.png 示意图 %}\n\n![b](/img/cover.png)\n\n```md\n![code](a.png)\n```\n",
+		"blog/source/_posts/hello/a.png": "png-a",
+		"blog/source/img/cover.png":      "png-cover",
+		"blog/source/_drafts/wip.md":     "---\ntitle: WIP\n---\n![missing](nope.png)\n",
+		"blog/source/about/index.md":     "---\ntitle: About\n---\n",
+	})
+	importer := &fakeImporter{}
+	svc := &Service{articles: importer, repo: &fakeArticleRepo{}}
+
+	report, err := svc.Migrate(context.Background(), archive, Options{OwnerID: 1, SkipExisting: true})
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	if report.Source != SourceHexo || report.Total != 2 || report.Succeeded != 2 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if report.ImagesMigrated != 2 || len(importer.uploads) != 2 {
+		t.Fatalf("images should be uploaded once each, got %d uploads: %v", len(importer.uploads), importer.uploads)
+	}
+
+	hello := findItem(t, importer.imported, "Hello")
+	if !strings.Contains(hello.ContentMd, "![示意图](https://cdn.example.com/") {
+		t.Errorf("asset_img not rewritten: %q", hello.ContentMd)
+	}
+	if !strings.Contains(hello.ContentMd, "![code](a.png)") {
+		t.Errorf("fenced code should be left untouched: %q", hello.ContentMd)
+	}
+	if !strings.HasPrefix(hello.CoverURL, "https://cdn.example.com/") || !strings.Contains(hello.ContentMd, hello.CoverURL) {
+		t.Errorf("cover should reuse the uploaded image, cover=%q", hello.CoverURL)
+	}
+	if strings.Join(hello.Categories, ",") != "Tech,Backend" || strings.Join(hello.Tags, ",") != "go,hexo" {
+		t.Errorf("unexpected taxonomy: %v %v", hello.Categories, hello.Tags)
+	}
+
+	wip := findItem(t, importer.imported, "WIP")
+	if wip.Status != "DRAFT" {
+		t.Errorf("_drafts should import as draft, got %q", wip.Status)
+	}
+	for _, post := range report.Posts {
+		if post.Title == "WIP" && len(post.Warnings) != 1 {
+			t.Errorf("missing image should produce a warning, got %v", post.Warnings)
+		}
+	}
+	for _, req := range importer.requests {
+		if !req.SuppressNotifications {
+			t.Error("migrated articles must not notify subscribers")
+		}
+	}
+}
+
+func TestMigrateHugoTOMLBundle(t *testing.T) {
+	archive := buildZip(t, map[string]string{
+		"content/posts/my-post/index.md": "+++\ntitle = \"My Post\"\ndate = 2024-01-02T03:04:05Z\nlastmod = 2024-02-01T00:00:00Z\nslug = \"my-slug\"\ntags = [\"hugo\"]\nsummary = \"摘要\"\n[cover]\nimage = \"cover.jpg\"\n+++\n" +
+			"<img src=\"photo.webp\" alt=\"p\">\n",
+		"content/posts/my-post/cover.jpg":  "jpg",
+		"content/posts/my-post/photo.webp": "webp",
+		"content/posts/_index.md":          "+++\ntitle = \"Posts\"\n+++\n",
+		"content/about.md":                 "+++\ntitle = \"About\"\n+++\n",
+	})
+	importer := &fakeImporter{}
+	svc := &Service{articles: importer, repo: &fakeArticleRepo{}}
+
+	report, err := svc.Migrate(context.Background(), archive, Options{Source: SourceHugo, OwnerID: 1})
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	if report.Total != 1 || report.Succeeded != 1 || len(importer.imported) != 1 {
+		t.Fatalf("only the post section should be migrated: %+v", report)
+	}
+	item := importer.imported[0]
+	if item.Abbrlink != "my-slug" || report.Posts[0].Abbrlink != "my-slug" {
+		t.Errorf("abbrlink should come from slug, got %q", item.Abbrlink)
+	}
+	if item.UpdatedAt.Month() != 2 || item.CreatedAt.Year() != 2024 {
+		t.Errorf("unexpected dates: %v %v", item.CreatedAt, item.UpdatedAt)
+	}
+	if len(item.Summaries) != 1 || item.Summaries[0] != "摘要" {
+		t.Errorf("summary should map to description: %v", item.Summaries)
+	}
+	if path.Base(item.CoverURL) != "cover.jpg" || !strings.Contains(item.ContentMd, `src="https://cdn.example.com/`) {
+		t.Errorf("bundle images not migrated: cover=%q content=%q", item.CoverURL, item.ContentMd)
+	}
+}
+
+func TestMigrateSkipsExistingBeforeUploadingImages(t *testing.T) {
+	archive := buildZip(t, map[string]string{
+		"source/_posts/old.md":  "---\ntitle: Old\nabbrlink: old\n---\n![a](a.png)\n",
+		"source/_posts/a.png":   "png",
+		"source/_posts/new.md":  "---\ntitle: New\n---\n",
+		"source/_posts/skip.js": "ignored",
+	})
+	importer := &fakeImporter{}
+	svc := &Service{articles: importer, repo: &fakeArticleRepo{abbrlinks: map[string]bool{"old": true}}}
+
+	report, err := svc.Migrate(context.Background(), archive, Options{OwnerID: 1, SkipExisting: true})
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	if report.Skipped != 1 || report.Succeeded != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if len(importer.uploads) != 0 {
+		t.Errorf("skipped posts should not upload images, got %v", importer.uploads)
+	}
+}
+
+func TestDetectLayoutRejectsUnknownArchive(t *testing.T) {
+	files := map[string]*zip.File{"readme.txt": nil}
+	if _, err := detectLayout(files, ""); err == nil {
+		t.Error("expected error for archive without posts")
+	}
+}
@@ -0,0 +1,282 @@
+/*
+ * @Description: 从 Hexo / Hugo 源码包迁移文章与图片
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 15:00:00
+ * @LastEditTime: 2026-10-16 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package migration
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// maxPostFileSize 单篇文章文件的大小上限
+	maxPostFileSize = 10 << 20
+	// maxPostsPerMigration 单次迁移的文章数上限
+	maxPostsPerMigration = 5000
+)
+
+// 单篇文章的迁移状态
+const (
+	PostStatusSuccess = "success"
+	PostStatusSkipped = "skipped"
+	PostStatusFailed  = "failed"
+)
+
+// ArticleImporter 迁移依赖的文章服务能力
+type ArticleImporter interface {
+	ImportArticles(ctx context.Context, req *article.ImportArticleRequest) (*article.ImportResult, error)
+	UploadArticleImage(ctx context.Context, ownerID uint, fileReader io.Reader, originalFilename string) (string, string, error)
+}
+
+// Options 迁移选项
+type Options struct {
+	// Source 源站类型（hexo / hugo），为空时自动识别
+	Source string
+	// OwnerID 迁移文章与图片的所有者
+	OwnerID uint
+	// DownloadRemoteImages 是否把外链图片下载后转存到本站
+	DownloadRemoteImages bool
+	// SkipExisting 跳过永久链接或标题已存在的文章
+	SkipExisting bool
+}
+
+// PostReport 单篇文章的迁移结果
+type PostReport struct {
+	File      string   `json:"file"`
+	Title     string   `json:"title,omitempty"`
+	Status    string   `json:"status"`
+	ArticleID string   `json:"article_id,omitempty"`
+	Abbrlink  string   `json:"abbrlink,omitempty"`
+	Images    int      `json:"images"`
+	Warnings  []string `json:"warnings,omitempty"`
+	Error     string   `json:"error,omitempty"`
+	// Comments / CommentsFailed 仅 WordPress 导入时填写
+	Comments       int `json:"comments,omitempty"`
+	CommentsFailed int `json:"comments_failed,omitempty"`
+}
+
+// Report 迁移报告
+type Report struct {
+	Source         string       `json:"source"`
+	Total          int          `json:"total"`
+	Succeeded      int          `json:"succeeded"`
+	Skipped        int          `json:"skipped"`
+	Failed         int          `json:"failed"`
+	ImagesMigrated int          `json:"images_migrated"`
+	Posts          []PostReport `json:"posts"`
+}
+
+// Service 博客迁移服务
+type Service struct {
+	articles   ArticleImporter
+	repo       repository.ArticleRepository
+	guard      *ssrf.Guard
+	httpClient *http.Client
+
+	// WordPress 导入的可选依赖，见 SetWordPressImport
+	comments CommentImporter
+	renderer HTMLRenderer
+	dispatch func(taskID string)
+
+	mu         sync.Mutex
+	tasks      map[string]*wordpressTask
+	activeTask string
+}
+
+// NewService 创建迁移服务。远程图片下载经过出站白名单与 SSRF 防护
+func NewService(articles ArticleImporter, repo repository.ArticleRepository, settingSvc setting.SettingService) *Service {
+	guard := ssrf.NewGuard(func() string {
+		return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+	})
+	return &Service{
+		articles: articles,
+		repo:     repo,
+		guard:    guard,
+		tasks:    make(map[string]*wordpressTask),
+		httpClient: &http.Client{
+			Timeout:   20 * time.Second,
+			Transport: guard.Transport(),
+		},
+	}
+}
+
+// Migrate 迁移压缩包中的全部文章。单篇文章失败不影响其余文章，结果逐篇记录在报告中；
+// 迁移的文章不会触发订阅通知。
+func (s *Service) Migrate(ctx context.Context, archive []byte, opts Options) (*Report, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("无法读取压缩包: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zipReader.File))
+	for _, f := range zipReader.File {
+		name := strings.ReplaceAll(f.Name, "\\", "/")
+		if f.FileInfo().IsDir() || strings.HasPrefix(name, "__MACOSX/") {
+			continue
+		}
+		files[name] = f
+	}
+
+	layout, err := detectLayout(files, opts.Source)
+	if err != nil {
+		return nil, err
+	}
+	posts := layout.collectPosts(files)
+	if len(posts) == 0 {
+		return nil, fmt.Errorf("没有找到可迁移的文章")
+	}
+	if len(posts) > maxPostsPerMigration {
+		return nil, fmt.Errorf("文章数量超过上限 %d", maxPostsPerMigration)
+	}
+
+	images := &imageMigrator{
+		ctx:            ctx,
+		svc:            s,
+		layout:         layout,
+		files:          files,
+		ownerID:        opts.OwnerID,
+		downloadRemote: opts.DownloadRemoteImages,
+		uploaded:       make(map[string]string),
+		produced:       make(map[string]bool),
+		failed:         make(map[string]string),
+	}
+
+	report := &Report{Source: layout.kind, Total: len(posts), Posts: make([]PostReport, 0, len(posts))}
+	for _, post := range posts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		postReport := s.migratePost(ctx, images, post, opts)
+		switch postReport.Status {
+		case PostStatusSuccess:
+			report.Succeeded++
+		case PostStatusSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+		report.ImagesMigrated += postReport.Images
+		report.Posts = append(report.Posts, postReport)
+	}
+
+	log.Printf("[博客迁移] %s 迁移完成: 共 %d 篇, 成功 %d, 跳过 %d, 失败 %d, 迁移图片 %d 张",
+		report.Source, report.Total, report.Succeeded, report.Skipped, report.Failed, report.ImagesMigrated)
+	return report, nil
+}
+
+func (s *Service) migratePost(ctx context.Context, images *imageMigrator, post sourcePost, opts Options) PostReport {
+	postReport := PostReport{File: strings.TrimPrefix(post.path, images.layout.root), Status: PostStatusFailed}
+
+	data, err := readPostFile(post.file)
+	if err != nil {
+		postReport.Error = err.Error()
+		return postReport
+	}
+	normalized, err := normalizePost(data, post.draft)
+	if err != nil {
+		postReport.Error = err.Error()
+		return postReport
+	}
+	item, err := article.ParseMarkdownArticle(post.path, normalized.markdown)
+	if err != nil {
+		postReport.Error = err.Error()
+		return postReport
+	}
+	postReport.Title = item.Title
+	postReport.Abbrlink = item.Abbrlink
+	return s.importItem(ctx, images, post.path, item, opts, postReport)
+}
+
+// importItem 迁移文章引用的图片并导入文章，Hexo / Hugo 与 WordPress 迁移共用
+func (s *Service) importItem(ctx context.Context, images *imageMigrator, postPath string, item *article.ExportArticleItem, opts Options, postReport PostReport) PostReport {
+	// 先于图片迁移判断是否跳过，避免重复迁移时上传用不到的图片
+	if opts.SkipExisting {
+		exists, err := s.articleExists(ctx, item)
+		if err != nil {
+			postReport.Error = err.Error()
+			return postReport
+		}
+		if exists {
+			postReport.Status = PostStatusSkipped
+			return postReport
+		}
+	}
+
+	imageResult := &postImages{}
+	item.ContentMd = images.rewriteContent(postPath, item.ContentMd, imageResult)
+	item.CoverURL = images.rewriteURL(postPath, item.CoverURL, imageResult)
+	item.TopImgURL = images.rewriteURL(postPath, item.TopImgURL, imageResult)
+	postReport.Images = imageResult.migrated
+	postReport.Warnings = imageResult.warnings
+
+	result, err := s.articles.ImportArticles(ctx, &article.ImportArticleRequest{
+		Data:                  article.ExportArticleData{Version: "1.0", ExportAt: time.Now(), Articles: []article.ExportArticleItem{*item}},
+		CreateCategories:      true,
+		CreateTags:            true,
+		OwnerID:               opts.OwnerID,
+		DefaultStatus:         "PUBLISHED",
+		SkipExisting:          opts.SkipExisting,
+		SuppressNotifications: true,
+	})
+	switch {
+	case err != nil:
+		postReport.Error = err.Error()
+	case len(result.CreatedIDs) > 0:
+		postReport.Status = PostStatusSuccess
+		postReport.ArticleID = result.CreatedIDs[0]
+	case result.SkippedCount > 0:
+		postReport.Status = PostStatusSkipped
+	case len(result.Errors) > 0:
+		postReport.Error = result.Errors[0]
+	default:
+		postReport.Error = "导入失败"
+	}
+	return postReport
+}
+
+// articleExists 与导入时的跳过规则一致：永久链接或标题已被使用
+func (s *Service) articleExists(ctx context.Context, item *article.ExportArticleItem) (bool, error) {
+	if item.Abbrlink != "" {
+		exists, err := s.repo.ExistsByAbbrlink(ctx, item.Abbrlink, 0)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+	return s.repo.ExistsByTitle(ctx, item.Title, 0)
+}
+
+func readPostFile(f *zip.File) ([]byte, error) {
+	if f.UncompressedSize64 > maxPostFileSize {
+		return nil, fmt.Errorf("文件超过 %d MB", maxPostFileSize>>20)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, maxPostFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxPostFileSize {
+		return nil, fmt.Errorf("文件超过 %d MB", maxPostFileSize>>20)
+	}
+	return data, nil
+}
@@ -0,0 +1,359 @@
+/*
+ * @Description: Hexo / Hugo 源码包的目录识别与 front-matter 归一化
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 15:00:00
+ * @LastEditTime: 2026-10-16 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package migration
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// 支持的源站类型
+const (
+	SourceHexo = "hexo"
+	SourceHugo = "hugo"
+)
+
+// hugoPostSections Hugo 中常用于存放文章的目录，存在时只迁移这些目录，避免把 about 等独立页面当作文章
+var hugoPostSections = []string{"posts", "post", "blog", "articles"}
+
+// siteLayout 描述压缩包内源站的目录结构
+type siteLayout struct {
+	kind string
+	root string // 源站根目录在压缩包内的前缀，以 / 结尾或为空
+	// staticDirs 以 / 开头的站内路径对应的目录，按顺序查找
+	staticDirs []string
+}
+
+// sourcePost 待迁移的文章文件
+type sourcePost struct {
+	path  string
+	file  *zip.File
+	draft bool // 位于 Hexo 的 _drafts 目录
+}
+
+// detectLayout 根据目录特征识别 Hexo 或 Hugo 源码包，kind 非空时只按指定类型识别
+func detectLayout(files map[string]*zip.File, kind string) (*siteLayout, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if kind == "" || kind == SourceHexo {
+		for _, name := range names {
+			if i := strings.Index(name, "source/_posts/"); i >= 0 && (i == 0 || name[i-1] == '/') {
+				root := name[:i]
+				return &siteLayout{kind: SourceHexo, root: root, staticDirs: []string{root + "source"}}, nil
+			}
+		}
+	}
+	if kind == "" || kind == SourceHugo {
+		for _, name := range names {
+			if i := strings.Index(name, "content/"); i >= 0 && (i == 0 || name[i-1] == '/') && isMarkdown(name) {
+				root := name[:i]
+				return &siteLayout{kind: SourceHugo, root: root, staticDirs: []string{root + "static", root + "assets"}}, nil
+			}
+		}
+	}
+	if kind != "" {
+		return nil, fmt.Errorf("压缩包中没有找到 %s 的文章目录", kind)
+	}
+	return nil, fmt.Errorf("无法识别源码包类型，Hexo 需要包含 source/_posts，Hugo 需要包含 content 目录")
+}
+
+// collectPosts 列出需要迁移的文章文件，按路径排序保证报告顺序稳定
+func (l *siteLayout) collectPosts(files map[string]*zip.File) []sourcePost {
+	var posts []sourcePost
+	switch l.kind {
+	case SourceHexo:
+		for name, f := range files {
+			if !isMarkdown(name) || !strings.HasPrefix(name, l.root) {
+				continue
+			}
+			rel := strings.TrimPrefix(name, l.root)
+			switch {
+			case strings.HasPrefix(rel, "source/_posts/"):
+				posts = append(posts, sourcePost{path: name, file: f})
+			case strings.HasPrefix(rel, "source/_drafts/"):
+				posts = append(posts, sourcePost{path: name, file: f, draft: true})
+			}
+		}
+	case SourceHugo:
+		contentDir := l.root + "content/"
+		var sections []string
+		for _, section := range hugoPostSections {
+			prefix := contentDir + section + "/"
+			for name := range files {
+				if strings.HasPrefix(name, prefix) {
+					sections = append(sections, prefix)
+					break
+				}
+			}
+		}
+		if len(sections) == 0 {
+			sections = []string{contentDir}
+		}
+		for name, f := range files {
+			if !isMarkdown(name) || path.Base(name) == "_index.md" {
+				continue
+			}
+			for _, prefix := range sections {
+				if strings.HasPrefix(name, prefix) {
+					posts = append(posts, sourcePost{path: name, file: f})
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(posts, func(i, j int) bool { return posts[i].path < posts[j].path })
+	return posts
+}
+
+// normalizedPost 归一化后的文章：YAML front-matter 已统一为本站导入支持的字段
+type normalizedPost struct {
+	markdown []byte
+	abbrlink string
+}
+
+// normalizePost 把 Hexo / Hugo 的 front-matter 统一转换为 YAML，并映射常见的别名字段：
+// lastmod → updated，summary → description，Hugo 的封面写法 → cover，
+// permalink / slug / url 的最后一段 → abbrlink（原文未设置 abbrlink 时）。
+func normalizePost(data []byte, draft bool) (*normalizedPost, error) {
+	content := strings.ReplaceAll(string(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))), "\r\n", "\n")
+
+	var meta, body string
+	switch {
+	case strings.HasPrefix(content, "---\n"):
+		m, b, ok := cutFrontMatter(content, "---")
+		if !ok {
+			return nil, fmt.Errorf("front-matter 缺少结束标记 ---")
+		}
+		meta, body = m, b
+	case strings.HasPrefix(content, "+++\n"):
+		m, b, ok := cutFrontMatter(content, "+++")
+		if !ok {
+			return nil, fmt.Errorf("front-matter 缺少结束标记 +++")
+		}
+		converted, err := tomlToYAML(m)
+		if err != nil {
+			return nil, err
+		}
+		meta, body = converted, b
+	default:
+		body = content
+	}
+
+	var doc yaml.Node
+	if strings.TrimSpace(meta) != "" {
+		if err := yaml.Unmarshal([]byte(meta), &doc); err != nil {
+			return nil, fmt.Errorf("解析 front-matter 失败: %w", err)
+		}
+	}
+	var mapping *yaml.Node
+	if len(doc.Content) > 0 && doc.Content[0].Kind == yaml.MappingNode {
+		mapping = doc.Content[0]
+	} else {
+		mapping = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+
+	aliasKey(mapping, "updated", "lastmod")
+	aliasKey(mapping, "description", "summary")
+	normalizeCover(mapping)
+	if published := mappingValue(mapping, "published"); published != nil && published.Value == "false" {
+		draft = true
+	}
+	if draft {
+		setScalar(mapping, "draft", "true", "!!bool")
+	}
+
+	abbrlink := scalarValue(mapping, "abbrlink")
+	if abbrlink == "" {
+		for _, key := range []string{"permalink", "slug", "url"} {
+			if abbrlink = sanitizeAbbrlink(scalarValue(mapping, key)); abbrlink != "" {
+				setScalar(mapping, "abbrlink", abbrlink, "!!str")
+				break
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	if len(mapping.Content) > 0 {
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(mapping); err != nil {
+			return nil, fmt.Errorf("生成 front-matter 失败: %w", err)
+		}
+		encoder.Close()
+	}
+	buf.WriteString("---\n")
+	buf.WriteString(body)
+	return &normalizedPost{markdown: buf.Bytes(), abbrlink: abbrlink}, nil
+}
+
+// cutFrontMatter 按分隔行拆分 front-matter 与正文
+func cutFrontMatter(content, delimiter string) (string, string, bool) {
+	lines := strings.Split(content, "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimRight(lines[i], " \t") == delimiter {
+			return strings.Join(lines[1:i], "\n"), strings.Join(lines[i+1:], "\n"), true
+		}
+	}
+	return "", "", false
+}
+
+// tomlToYAML 把 Hugo 的 TOML front-matter 转为 YAML，时间统一输出为 RFC3339
+func tomlToYAML(meta string) (string, error) {
+	values := make(map[string]interface{})
+	if _, err := toml.Decode(meta, &values); err != nil {
+		return "", fmt.Errorf("解析 TOML front-matter 失败: %w", err)
+	}
+	out, err := yaml.Marshal(convertTOMLValue(values))
+	if err != nil {
+		return "", fmt.Errorf("转换 TOML front-matter 失败: %w", err)
+	}
+	return string(out), nil
+}
+
+func convertTOMLValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case time.Time:
+		return val.Format(time.RFC3339)
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = convertTOMLValue(item)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = convertTOMLValue(item)
+		}
+		return val
+	case []map[string]interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			result = append(result, convertTOMLValue(item))
+		}
+		return result
+	}
+	return v
+}
+
+// mappingValue 返回 YAML 映射中指定键的值节点
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalarValue(mapping *yaml.Node, key string) string {
+	if v := mappingValue(mapping, key); v != nil && v.Kind == yaml.ScalarNode {
+		return strings.TrimSpace(v.Value)
+	}
+	return ""
+}
+
+// setScalar 设置或覆盖映射中的标量值
+func setScalar(mapping *yaml.Node, key, value, tag string) {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+	setNode(mapping, key, node)
+}
+
+func setNode(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// aliasKey 目标键不存在时使用别名键的值
+func aliasKey(mapping *yaml.Node, target, alias string) {
+	if mappingValue(mapping, target) != nil {
+		return
+	}
+	if v := mappingValue(mapping, alias); v != nil {
+		setNode(mapping, target, v)
+	}
+}
+
+// normalizeCover 兼容 Hugo 主题常见的封面写法：cover.image、featured_image、image、images[0]、thumbnail
+func normalizeCover(mapping *yaml.Node) {
+	if cover := mappingValue(mapping, "cover"); cover != nil {
+		if cover.Kind == yaml.ScalarNode {
+			return
+		}
+		if cover.Kind == yaml.MappingNode {
+			if image := scalarValue(cover, "image"); image != "" {
+				setScalar(mapping, "cover", image, "!!str")
+				return
+			}
+		}
+	}
+	for _, key := range []string{"featured_image", "featuredImage", "image", "thumbnail"} {
+		if v := scalarValue(mapping, key); v != "" {
+			setScalar(mapping, "cover", v, "!!str")
+			return
+		}
+	}
+	if images := mappingValue(mapping, "images"); images != nil && images.Kind == yaml.SequenceNode && len(images.Content) > 0 {
+		if first := images.Content[0]; first.Kind == yaml.ScalarNode && first.Value != "" {
+			setScalar(mapping, "cover", first.Value, "!!str")
+			return
+		}
+	}
+	// 未能识别的封面写法直接移除，避免导入时解析失败
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == "cover" {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// sanitizeAbbrlink 取路径的最后一段作为永久链接，去掉 .html 后缀与不允许的字符
+func sanitizeAbbrlink(raw string) string {
+	raw = strings.Trim(strings.TrimSpace(raw), "/")
+	if i := strings.LastIndex(raw, "/"); i >= 0 {
+		raw = raw[i+1:]
+	}
+	raw = strings.TrimSuffix(raw, ".html")
+	var b strings.Builder
+	for _, r := range raw {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.':
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteRune('-')
+		}
+	}
+	result := strings.Trim(b.String(), ".-")
+	if len(result) > 200 {
+		return ""
+	}
+	return result
+}
+
+func isMarkdown(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".md" || ext == ".markdown"
+}
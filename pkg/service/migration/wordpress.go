@@ -0,0 +1,655 @@
+/*
+ * @Description: WordPress WXR 导出文件的异步导入：文章、分类标签、评论与附件
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 17:00:00
+ * @LastEditTime: 2026-10-16 17:00:00
+ * @LastEditors: 安知鱼
+ */
+package migration
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
+)
+
+// SourceWordPress WordPress WXR 导出文件
+const SourceWordPress = "wordpress"
+
+// WordPress 导入任务状态
+const (
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+)
+
+const (
+	// importTaskRetention 已结束的导入任务保留多久供前端查询
+	importTaskRetention = 24 * time.Hour
+	// wordpressZeroDate WordPress 中未设置的 GMT 时间（如草稿）
+	wordpressZeroDate = "0000-00-00 00:00:00"
+)
+
+// ErrImportTaskNotFound 查询不存在或已过期的导入任务时返回
+var ErrImportTaskNotFound = errors.New("导入任务不存在或已过期")
+
+// attachmentExtensions 除图片外允许迁移的附件类型
+var attachmentExtensions = map[string]bool{
+	".pdf": true, ".zip": true, ".rar": true, ".7z": true,
+	".mp3": true, ".wav": true, ".ogg": true, ".m4a": true,
+	".mp4": true, ".webm": true, ".mov": true,
+	".doc": true, ".docx": true, ".xls": true, ".xlsx": true, ".ppt": true, ".pptx": true,
+	".txt": true, ".csv": true,
+}
+
+var (
+	// blockCommentRe 匹配古腾堡编辑器的区块注释 <!-- wp:paragraph --> 以及 <!--more-->
+	blockCommentRe = regexp.MustCompile(`<!--\s*(?:/?wp:[^>]*?|more|noteaser)\s*-->\n?`)
+	// captionRe 匹配经典编辑器的 [caption] 短代码，只保留其中的图片与说明文字
+	captionRe = regexp.MustCompile(`\[/?caption[^\]]*\]`)
+	// sizeSuffixRe 匹配 WordPress 生成的缩略图尺寸后缀，如 photo-300x200.jpg
+	sizeSuffixRe = regexp.MustCompile(`-\d+x\d+(\.[A-Za-z0-9]+)$`)
+)
+
+// CommentImporter 导入 WordPress 评论所需的评论服务能力
+type CommentImporter interface {
+	ImportComments(ctx context.Context, req *comment.ImportCommentRequest) (*comment.ImportCommentResult, error)
+}
+
+// HTMLRenderer 把评论内容渲染为安全的 HTML
+type HTMLRenderer interface {
+	ToHTML(ctx context.Context, content string) (string, error)
+}
+
+// WordPressOptions WordPress 导入选项
+type WordPressOptions struct {
+	OwnerID uint
+	// DownloadAttachments 是否把正文引用的附件与特色图片转存到本站
+	DownloadAttachments bool
+	// SkipExisting 跳过永久链接或标题已存在的文章，被跳过文章的评论不会导入
+	SkipExisting bool
+	// ImportComments 是否导入评论（垃圾评论、回收站中的评论与 pingback/trackback 不导入）
+	ImportComments bool
+}
+
+// WordPressImportProgress WordPress 导入任务的进度
+type WordPressImportProgress struct {
+	TaskID           string       `json:"task_id"`
+	Status           string       `json:"status"`
+	Site             string       `json:"site"`
+	Total            int          `json:"total"`
+	Processed        int          `json:"processed"`
+	Succeeded        int          `json:"succeeded"`
+	Skipped          int          `json:"skipped"`
+	Failed           int          `json:"failed"`
+	CommentsImported int          `json:"comments_imported"`
+	CommentsFailed   int          `json:"comments_failed"`
+	FilesMigrated    int          `json:"files_migrated"`
+	Posts            []PostReport `json:"posts"`
+	Error            string       `json:"error,omitempty"`
+	StartedAt        time.Time    `json:"started_at"`
+	FinishedAt       *time.Time   `json:"finished_at,omitempty"`
+}
+
+func (p *WordPressImportProgress) clone() *WordPressImportProgress {
+	c := *p
+	c.Posts = append([]PostReport(nil), p.Posts...)
+	return &c
+}
+
+// wordpressTask 导入任务，doc 在任务开始执行后释放
+type wordpressTask struct {
+	progress *WordPressImportProgress
+	doc      *wxrDocument
+	opts     WordPressOptions
+}
+
+// --- WXR 文件结构，元素名不带命名空间，兼容 WXR 1.0 ~ 1.2 ---
+
+type wxrDocument struct {
+	Channel wxrChannel `xml:"channel"`
+}
+
+type wxrChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	BaseSiteURL string    `xml:"base_site_url"`
+	Items       []wxrItem `xml:"item"`
+}
+
+type wxrItem struct {
+	Title           string        `xml:"title"`
+	Encoded         []wxrEncoded  `xml:"encoded"`
+	PostID          string        `xml:"post_id"`
+	PostDate        string        `xml:"post_date"`
+	PostDateGMT     string        `xml:"post_date_gmt"`
+	PostModified    string        `xml:"post_modified"`
+	PostModifiedGMT string        `xml:"post_modified_gmt"`
+	PostName        string        `xml:"post_name"`
+	Status          string        `xml:"status"`
+	PostParent      string        `xml:"post_parent"`
+	PostType        string        `xml:"post_type"`
+	AttachmentURL   string        `xml:"attachment_url"`
+	Categories      []wxrCategory `xml:"category"`
+	Meta            []wxrMeta     `xml:"postmeta"`
+	Comments        []wxrComment  `xml:"comment"`
+}
+
+// wxrEncoded content:encoded 与 excerpt:encoded 同名，按命名空间区分
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type wxrCategory struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type wxrMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+type wxrComment struct {
+	ID          string `xml:"comment_id"`
+	Author      string `xml:"comment_author"`
+	AuthorEmail string `xml:"comment_author_email"`
+	AuthorURL   string `xml:"comment_author_url"`
+	AuthorIP    string `xml:"comment_author_IP"`
+	Date        string `xml:"comment_date"`
+	DateGMT     string `xml:"comment_date_gmt"`
+	Content     string `xml:"comment_content"`
+	Approved    string `xml:"comment_approved"`
+	Type        string `xml:"comment_type"`
+	Parent      string `xml:"comment_parent"`
+}
+
+func (item *wxrItem) content() string {
+	for _, e := range item.Encoded {
+		if strings.Contains(e.XMLName.Space, "/content/") {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+func (item *wxrItem) excerpt() string {
+	for _, e := range item.Encoded {
+		if strings.Contains(e.XMLName.Space, "excerpt") {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+func (item *wxrItem) meta(key string) string {
+	for _, m := range item.Meta {
+		if m.Key == key {
+			return strings.TrimSpace(m.Value)
+		}
+	}
+	return ""
+}
+
+// parseWXR 解析 WordPress 导出文件。WordPress 导出中常见 HTML 实体，因此使用非严格模式
+func parseWXR(data []byte) (*wxrDocument, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	var doc wxrDocument
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("解析 WordPress 导出文件失败: %w", err)
+	}
+	posts := 0
+	for _, item := range doc.Channel.Items {
+		if isImportablePost(&item) {
+			posts++
+		}
+	}
+	if posts == 0 {
+		return nil, fmt.Errorf("导出文件中没有可导入的文章，请确认是 WordPress 的 WXR 导出文件")
+	}
+	return &doc, nil
+}
+
+// isImportablePost 只导入文章类型，页面、导航菜单、附件以及回收站中的内容不导入
+func isImportablePost(item *wxrItem) bool {
+	if item.PostType != "post" {
+		return false
+	}
+	switch item.Status {
+	case "trash", "auto-draft", "inherit":
+		return false
+	}
+	return true
+}
+
+// attachmentIndex WordPress 附件索引，用于把正文中的缩略图地址还原为附件原图
+type attachmentIndex struct {
+	baseURL string
+	byID    map[string]string // 附件文章ID → 附件地址
+	byKey   map[string]string // 去掉协议的地址 → 附件地址
+}
+
+func newAttachmentIndex(doc *wxrDocument) *attachmentIndex {
+	idx := &attachmentIndex{
+		baseURL: strings.TrimRight(firstNonEmpty(doc.Channel.BaseSiteURL, doc.Channel.Link), "/"),
+		byID:    make(map[string]string),
+		byKey:   make(map[string]string),
+	}
+	for _, item := range doc.Channel.Items {
+		if item.PostType != "attachment" || item.AttachmentURL == "" {
+			continue
+		}
+		attachmentURL := strings.TrimSpace(item.AttachmentURL)
+		idx.byID[item.PostID] = attachmentURL
+		idx.byKey[attachmentKey(attachmentURL)] = attachmentURL
+	}
+	return idx
+}
+
+// absolute 把站内绝对路径（/wp-content/uploads/...）补全为原站地址
+func (idx *attachmentIndex) absolute(ref string) string {
+	if idx.baseURL != "" && strings.HasPrefix(ref, "/") && !strings.HasPrefix(ref, "//") {
+		return idx.baseURL + ref
+	}
+	return ref
+}
+
+// resolve 返回引用对应的附件原图地址；引用不是可迁移的附件时原样返回且第二个返回值为 false
+func (idx *attachmentIndex) resolve(ref string) (string, bool) {
+	key := attachmentKey(ref)
+	attachmentURL, ok := idx.byKey[key]
+	if !ok {
+		if loc := sizeSuffixRe.FindStringSubmatchIndex(key); loc != nil {
+			attachmentURL, ok = idx.byKey[key[:loc[0]]+key[loc[2]:loc[3]]]
+		}
+	}
+	if !ok {
+		return ref, false
+	}
+	ext := strings.ToLower(path.Ext(attachmentURL))
+	return attachmentURL, imageExtensions[ext] || attachmentExtensions[ext]
+}
+
+// attachmentKey 去掉协议、查询参数与片段，http 与 https 引用视为同一附件
+func attachmentKey(raw string) string {
+	if i := strings.IndexAny(raw, "?#"); i >= 0 {
+		raw = raw[:i]
+	}
+	if i := strings.Index(raw, "//"); i >= 0 {
+		raw = raw[i:]
+	}
+	return raw
+}
+
+// SetWordPressImport 注入 WordPress 导入所需的评论服务、评论渲染器与任务派发函数。
+// dispatch 为 nil 时导入在独立的 goroutine 中执行。
+func (s *Service) SetWordPressImport(comments CommentImporter, renderer HTMLRenderer, dispatch func(taskID string)) {
+	s.comments = comments
+	s.renderer = renderer
+	s.dispatch = dispatch
+}
+
+// StartWordPressImport 校验 WXR 文件并创建后台导入任务；已有导入任务执行中时返回该任务的进度
+func (s *Service) StartWordPressImport(data []byte, opts WordPressOptions) (*WordPressImportProgress, error) {
+	doc, err := parseWXR(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.reapLocked(time.Now())
+	if s.activeTask != "" {
+		progress := s.tasks[s.activeTask].progress.clone()
+		s.mu.Unlock()
+		return progress, nil
+	}
+	taskID, err := newImportTaskID()
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	progress := &WordPressImportProgress{
+		TaskID:    taskID,
+		Status:    ImportStatusPending,
+		Site:      firstNonEmpty(doc.Channel.Title, doc.Channel.Link),
+		Posts:     []PostReport{},
+		StartedAt: time.Now(),
+	}
+	s.tasks[taskID] = &wordpressTask{progress: progress, doc: doc, opts: opts}
+	s.activeTask = taskID
+	result := progress.clone()
+	s.mu.Unlock()
+
+	if s.dispatch != nil {
+		s.dispatch(taskID)
+	} else {
+		go s.RunWordPressImport(taskID)
+	}
+	return result, nil
+}
+
+// WordPressImportProgress 查询导入任务进度
+func (s *Service) WordPressImportProgress(taskID string) (*WordPressImportProgress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task, ok := s.tasks[taskID]
+	if !ok {
+		return nil, ErrImportTaskNotFound
+	}
+	return task.progress.clone(), nil
+}
+
+// RunWordPressImport 执行导入任务，由后台任务队列调用
+func (s *Service) RunWordPressImport(taskID string) {
+	s.mu.Lock()
+	task, ok := s.tasks[taskID]
+	if !ok || task.progress.Status != ImportStatusPending {
+		s.mu.Unlock()
+		return
+	}
+	doc, opts := task.doc, task.opts
+	task.doc = nil
+	task.progress.Status = ImportStatusRunning
+	s.mu.Unlock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[WordPress导入] 任务 %s 异常终止: %v", taskID, r)
+			s.finishTask(taskID, ImportStatusFailed, fmt.Sprintf("导入异常终止: %v", r))
+		}
+	}()
+
+	ctx := context.Background()
+	var posts []*wxrItem
+	for i := range doc.Channel.Items {
+		if isImportablePost(&doc.Channel.Items[i]) {
+			posts = append(posts, &doc.Channel.Items[i])
+		}
+	}
+	s.updateTask(taskID, func(p *WordPressImportProgress) { p.Total = len(posts) })
+	log.Printf("[WordPress导入] 任务 %s 开始导入站点 '%s' 的 %d 篇文章", taskID, doc.Channel.Title, len(posts))
+
+	attachments := newAttachmentIndex(doc)
+	images := &imageMigrator{
+		ctx:            ctx,
+		svc:            s,
+		layout:         &siteLayout{kind: SourceWordPress},
+		ownerID:        opts.OwnerID,
+		downloadRemote: opts.DownloadAttachments,
+		uploaded:       make(map[string]string),
+		produced:       make(map[string]bool),
+		failed:         make(map[string]string),
+		attachments:    attachments,
+	}
+	importOpts := Options{Source: SourceWordPress, OwnerID: opts.OwnerID, SkipExisting: opts.SkipExisting}
+
+	for _, post := range posts {
+		item := wordpressArticle(post, attachments)
+		report := PostReport{File: firstNonEmpty(post.PostName, post.PostID), Title: item.Title, Abbrlink: item.Abbrlink, Status: PostStatusFailed}
+		report = s.importItem(ctx, images, "", item, importOpts, report)
+		if report.Status == PostStatusSuccess && opts.ImportComments {
+			s.importWordPressComments(ctx, post, item, &report)
+		}
+
+		s.updateTask(taskID, func(p *WordPressImportProgress) {
+			p.Processed++
+			switch report.Status {
+			case PostStatusSuccess:
+				p.Succeeded++
+			case PostStatusSkipped:
+				p.Skipped++
+			default:
+				p.Failed++
+			}
+			p.FilesMigrated += report.Images
+			p.CommentsImported += report.Comments
+			p.CommentsFailed += report.CommentsFailed
+			p.Posts = append(p.Posts, report)
+		})
+	}
+
+	s.finishTask(taskID, ImportStatusCompleted, "")
+	if progress, err := s.WordPressImportProgress(taskID); err == nil {
+		log.Printf("[WordPress导入] 任务 %s 完成: 共 %d 篇, 成功 %d, 跳过 %d, 失败 %d, 评论 %d 条, 附件 %d 个",
+			taskID, progress.Total, progress.Succeeded, progress.Skipped, progress.Failed, progress.CommentsImported, progress.FilesMigrated)
+	}
+}
+
+// wordpressArticle 把 WXR 文章映射为导入数据
+func wordpressArticle(post *wxrItem, attachments *attachmentIndex) *article.ExportArticleItem {
+	item := &article.ExportArticleItem{
+		Title:     strings.TrimSpace(html.UnescapeString(post.Title)),
+		ContentMd: cleanWordPressContent(post.content()),
+	}
+	if item.Title == "" {
+		item.Title = "未命名文章 " + post.PostID
+	}
+	if slug, err := url.PathUnescape(post.PostName); err == nil {
+		item.Abbrlink = sanitizeAbbrlink(slug)
+	}
+	if excerpt := strings.TrimSpace(post.excerpt()); excerpt != "" {
+		item.Summaries = []string{excerpt}
+	}
+	if post.Status != "publish" {
+		// 私密、待审、定时发布的文章统一作为草稿导入，由管理员确认后发布
+		item.Status = "DRAFT"
+	}
+	item.CreatedAt = wordpressTime(post.PostDateGMT, post.PostDate)
+	item.UpdatedAt = wordpressTime(post.PostModifiedGMT, post.PostModified)
+	if thumbnailID := post.meta("_thumbnail_id"); thumbnailID != "" {
+		item.CoverURL = attachments.byID[thumbnailID]
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range post.Categories {
+		name := strings.TrimSpace(html.UnescapeString(c.Name))
+		if name == "" || seen[c.Domain+"\x00"+name] {
+			continue
+		}
+		seen[c.Domain+"\x00"+name] = true
+		switch c.Domain {
+		case "category":
+			item.Categories = append(item.Categories, name)
+		case "post_tag":
+			item.Tags = append(item.Tags, name)
+		}
+	}
+	return item
+}
+
+// cleanWordPressContent 去掉古腾堡区块注释与 [caption] 短代码。
+// 正文中的 HTML 原样保留，经典编辑器以空行分段的纯文本按 Markdown 段落渲染
+func cleanWordPressContent(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = blockCommentRe.ReplaceAllString(content, "")
+	content = captionRe.ReplaceAllString(content, "")
+	return strings.TrimSpace(content)
+}
+
+// wordpressTime 优先使用 GMT 时间，未设置时按服务器本地时区解析站点时间
+func wordpressTime(gmt, local string) time.Time {
+	if gmt = strings.TrimSpace(gmt); gmt != "" && gmt != wordpressZeroDate {
+		if t, err := time.Parse("2006-01-02 15:04:05", gmt); err == nil {
+			return t
+		}
+	}
+	if local = strings.TrimSpace(local); local != "" && local != wordpressZeroDate {
+		if t, err := time.ParseInLocation("2006-01-02 15:04:05", local, time.Local); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// importWordPressComments 导入新建文章的评论，保留回复关系与评论者邮箱
+func (s *Service) importWordPressComments(ctx context.Context, post *wxrItem, item *article.ExportArticleItem, report *PostReport) {
+	if s.comments == nil || len(post.Comments) == 0 {
+		return
+	}
+	targetPath := "/posts/" + report.ArticleID
+	if item.Abbrlink != "" {
+		targetPath = "/posts/" + item.Abbrlink
+	}
+
+	included := make(map[string]*wxrComment)
+	for i := range post.Comments {
+		c := &post.Comments[i]
+		if c.Type == "pingback" || c.Type == "trackback" || (c.Approved != "1" && c.Approved != "0") {
+			continue
+		}
+		included[c.ID] = c
+	}
+	// 与前台评论一致：ParentID 指向所在楼层的顶级评论，ReplyToID 指向直接回复的评论。
+	// 父评论未导入（如被标记为垃圾评论）时，回复挂到最近的已导入祖先评论下
+	byID := make(map[string]*wxrComment, len(post.Comments))
+	for i := range post.Comments {
+		byID[post.Comments[i].ID] = &post.Comments[i]
+	}
+	parentOf := func(c *wxrComment) string {
+		visited := make(map[string]bool)
+		for parent := c.Parent; parent != "" && parent != "0" && !visited[parent]; {
+			visited[parent] = true
+			if _, ok := included[parent]; ok {
+				return parent
+			}
+			p, ok := byID[parent]
+			if !ok {
+				return ""
+			}
+			parent = p.Parent
+		}
+		return ""
+	}
+
+	items := make([]comment.ExportCommentItem, 0, len(included))
+	for i := range post.Comments {
+		c := &post.Comments[i]
+		if _, ok := included[c.ID]; !ok {
+			continue
+		}
+		content := strings.TrimSpace(strings.ReplaceAll(c.Content, "\r\n", "\n"))
+		contentHTML := html.EscapeString(content)
+		if s.renderer != nil {
+			if rendered, err := s.renderer.ToHTML(ctx, content); err == nil {
+				contentHTML = rendered
+			}
+		}
+		status := int(model.StatusPublished)
+		if c.Approved == "0" {
+			status = int(model.StatusPending)
+		}
+		replyTo := parentOf(c)
+		root := replyTo
+		for depth := 0; root != "" && depth < len(included); depth++ {
+			next := parentOf(included[root])
+			if next == "" {
+				break
+			}
+			root = next
+		}
+		createdAt := wordpressTime(c.DateGMT, c.Date)
+		items = append(items, comment.ExportCommentItem{
+			ID:          c.ID,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+			Content:     content,
+			ContentHTML: contentHTML,
+			TargetPath:  targetPath,
+			TargetTitle: item.Title,
+			Nickname:    firstNonEmpty(strings.TrimSpace(html.UnescapeString(c.Author)), "匿名"),
+			Email:       strings.TrimSpace(c.AuthorEmail),
+			Website:     strings.TrimSpace(c.AuthorURL),
+			IPAddress:   strings.TrimSpace(c.AuthorIP),
+			ParentID:    root,
+			ReplyToID:   replyTo,
+			Status:      status,
+		})
+	}
+	if len(items) == 0 {
+		return
+	}
+	// 按时间先后导入，保证回复目标先于回复写入
+	sort.SliceStable(items, func(i, j int) bool { return items[i].CreatedAt.Before(items[j].CreatedAt) })
+
+	result, err := s.comments.ImportComments(ctx, &comment.ImportCommentRequest{
+		Data:           comment.ExportCommentData{Version: "1.0", ExportAt: time.Now(), Comments: items},
+		KeepCreateTime: true,
+	})
+	if err != nil {
+		report.CommentsFailed = len(items)
+		report.Warnings = append(report.Warnings, fmt.Sprintf("导入评论失败: %v", err))
+		return
+	}
+	report.Comments = result.SuccessCount
+	report.CommentsFailed = result.FailedCount
+	report.Warnings = append(report.Warnings, result.Errors...)
+}
+
+func (s *Service) updateTask(taskID string, fn func(p *WordPressImportProgress)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if task, ok := s.tasks[taskID]; ok {
+		fn(task.progress)
+	}
+}
+
+func (s *Service) finishTask(taskID, status, errMsg string) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if task, ok := s.tasks[taskID]; ok {
+		task.progress.Status = status
+		task.progress.Error = errMsg
+		task.progress.FinishedAt = &now
+	}
+	if s.activeTask == taskID {
+		s.activeTask = ""
+	}
+}
+
+// reapLocked 清理超过保留期的已结束任务，调用方需持有 s.mu
+func (s *Service) reapLocked(now time.Time) {
+	for id, task := range s.tasks {
+		if task.progress.FinishedAt != nil && now.Sub(*task.progress.FinishedAt) > importTaskRetention {
+			delete(s.tasks, id)
+		}
+	}
+}
+
+func newImportTaskID() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("生成导入任务ID失败: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+package migration
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
+)
+
+const sampleWXR = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"
+	xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>My WP Blog</title>
+	<link>https://blog.example.com</link>
+	<wp:base_site_url>https://blog.example.com</wp:base_site_url>
+	<item>
+		<title>photo.jpg</title>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_type>attachment</wp:post_type>
+		<wp:status>inherit</wp:status>
+		<wp:attachment_url>https://blog.example.com/wp-content/uploads/2023/05/photo.jpg</wp:attachment_url>
+	</item>
+	<item>
+		<title>Hello &amp; World</title>
+		<content:encoded><![CDATA[<!-- wp:paragraph -->
+<p>正文</p>
+<!-- /wp:paragraph -->
+[caption id="x" align="alignnone"]<img src="/wp-content/uploads/2023/05/photo-300x200.jpg" /> 说明[/caption]
+<!--more-->
+end]]></content:encoded>
+		<excerpt:encoded><![CDATA[摘要]]></excerpt:encoded>
+		<wp:post_id>1</wp:post_id>
+		<wp:post_date>2023-05-01 18:00:00</wp:post_date>
+		<wp:post_date_gmt>2023-05-01 10:00:00</wp:post_date_gmt>
+		<wp:post_name>hello-world</wp:post_name>
+		<wp:status>publish</wp:status>
+		<wp:post_type>post</wp:post_type>
+		<category domain="category" nicename="tech"><![CDATA[Tech]]></category>
+		<category domain="post_tag" nicename="go"><![CDATA[Go]]></category>
+		<wp:postmeta><wp:meta_key>_thumbnail_id</wp:meta_key><wp:meta_value>10</wp:meta_value></wp:postmeta>
+		<wp:comment>
+			<wp:comment_id>5</wp:comment_id>
+			<wp:comment_author>Alice</wp:comment_author>
+			<wp:comment_author_email>alice@example.com</wp:comment_author_email>
+			<wp:comment_date_gmt>2023-05-02 00:00:00</wp:comment_date_gmt>
+			<wp:comment_content>first</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>0</wp:comment_parent>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>6</wp:comment_id>
+			<wp:comment_author>Spammer</wp:comment_author>
+			<wp:comment_date_gmt>2023-05-02 01:00:00</wp:comment_date_gmt>
+			<wp:comment_content>spam</wp:comment_content>
+			<wp:comment_approved>spam</wp:comment_approved>
+			<wp:comment_parent>5</wp:comment_parent>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>7</wp:comment_id>
+			<wp:comment_author>Bob</wp:comment_author>
+			<wp:comment_author_email>bob@example.com</wp:comment_author_email>
+			<wp:comment_date_gmt>2023-05-02 02:00:00</wp:comment_date_gmt>
+			<wp:comment_content>reply</wp:comment_content>
+			<wp:comment_approved>0</wp:comment_approved>
+			<wp:comment_parent>6</wp:comment_parent>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>8</wp:comment_id>
+			<wp:comment_author>Carol</wp:comment_author>
+			<wp:comment_date_gmt>2023-05-02 03:00:00</wp:comment_date_gmt>
+			<wp:comment_content>nested</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>7</wp:comment_parent>
+		</wp:comment>
+	</item>
+	<item>
+		<title>Draft</title>
+		<wp:post_id>2</wp:post_id>
+		<wp:status>draft</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+	<item>
+		<title>About</title>
+		<wp:post_id>3</wp:post_id>
+		<wp:status>publish</wp:status>
+		<wp:post_type>page</wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+type fakeCommentImporter struct {
+	requests []*comment.ImportCommentRequest
+}
+
+func (f *fakeCommentImporter) ImportComments(_ context.Context, req *comment.ImportCommentRequest) (*comment.ImportCommentResult, error) {
+	f.requests = append(f.requests, req)
+	n := len(req.Data.Comments)
+	return &comment.ImportCommentResult{TotalCount: n, SuccessCount: n}, nil
+}
+
+func TestWordPressImport(t *testing.T) {
+	importer := &fakeImporter{}
+	comments := &fakeCommentImporter{}
+	var queued []string
+	svc := &Service{articles: importer, repo: &fakeArticleRepo{}, tasks: make(map[string]*wordpressTask)}
+	svc.SetWordPressImport(comments, nil, func(taskID string) { queued = append(queued, taskID) })
+
+	progress, err := svc.StartWordPressImport([]byte(sampleWXR), WordPressOptions{OwnerID: 1, SkipExisting: true, ImportComments: true})
+	if err != nil {
+		t.Fatalf("StartWordPressImport: %v", err)
+	}
+	if progress.Status != ImportStatusPending || progress.Site != "My WP Blog" || len(queued) != 1 {
+		t.Fatalf("task should be queued: %+v", progress)
+	}
+	svc.RunWordPressImport(queued[0])
+
+	progress, err = svc.WordPressImportProgress(progress.TaskID)
+	if err != nil {
+		t.Fatalf("WordPressImportProgress: %v", err)
+	}
+	if progress.Status != ImportStatusCompleted || progress.Total != 2 || progress.Succeeded != 2 || progress.FinishedAt == nil {
+		t.Fatalf("unexpected progress: %+v", progress)
+	}
+
+	hello := findItem(t, importer.imported, "Hello & World")
+	if hello.Abbrlink != "hello-world" || hello.CreatedAt.Hour() != 10 || hello.CreatedAt.Location().String() != "UTC" {
+		t.Errorf("unexpected abbrlink or date: %q %v", hello.Abbrlink, hello.CreatedAt)
+	}
+	if strings.Join(hello.Categories, ",") != "Tech" || strings.Join(hello.Tags, ",") != "Go" {
+		t.Errorf("unexpected taxonomy: %v %v", hello.Categories, hello.Tags)
+	}
+	if len(hello.Summaries) != 1 || hello.Summaries[0] != "摘要" {
+		t.Errorf("excerpt should map to summary: %v", hello.Summaries)
+	}
+	if strings.Contains(hello.ContentMd, "wp:") || strings.Contains(hello.ContentMd, "caption") || strings.Contains(hello.ContentMd, "more") {
+		t.Errorf("block markup not cleaned: %q", hello.ContentMd)
+	}
+	if findItem(t, importer.imported, "Draft").Status != "DRAFT" {
+		t.Error("unpublished posts should import as drafts")
+	}
+
+	if len(comments.requests) != 1 {
+		t.Fatalf("expected one comment import, got %d", len(comments.requests))
+	}
+	req := comments.requests[0]
+	if !req.KeepCreateTime || len(req.Data.Comments) != 3 || progress.CommentsImported != 3 {
+		t.Fatalf("spam comment should be dropped: %+v", req.Data.Comments)
+	}
+	byID := make(map[string]comment.ExportCommentItem)
+	for _, c := range req.Data.Comments {
+		if c.TargetPath != "/posts/hello-world" {
+			t.Errorf("unexpected target path %q", c.TargetPath)
+		}
+		byID[c.ID] = c
+	}
+	if bob := byID["7"]; bob.ParentID != "5" || bob.ReplyToID != "5" || bob.Status != 2 || bob.Email != "bob@example.com" {
+		t.Errorf("reply to a spam comment should attach to its nearest ancestor: %+v", bob)
+	}
+	if carol := byID["8"]; carol.ParentID != "5" || carol.ReplyToID != "7" {
+		t.Errorf("nested reply should keep its thread root and reply target: %+v", carol)
+	}
+}
+
+func TestAttachmentIndexResolvesSizeVariants(t *testing.T) {
+	doc, err := parseWXR([]byte(sampleWXR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := newAttachmentIndex(doc)
+
+	ref := idx.absolute("/wp-content/uploads/2023/05/photo-300x200.jpg")
+	got, ok := idx.resolve(ref)
+	if !ok || got != "https://blog.example.com/wp-content/uploads/2023/05/photo.jpg" {
+		t.Errorf("size variant should resolve to the original attachment, got %q %v", got, ok)
+	}
+	if _, ok := idx.resolve("http://blog.example.com/wp-content/uploads/2023/05/photo.jpg?ver=2"); !ok {
+		t.Error("scheme and query string should be ignored")
+	}
+	if _, ok := idx.resolve("https://other.example.com/a.jpg"); ok {
+		t.Error("unknown URLs are not attachments")
+	}
+}
+
+func TestStartWordPressImportRejectsInvalidFile(t *testing.T) {
+	svc := &Service{tasks: make(map[string]*wordpressTask)}
+	if _, err := svc.StartWordPressImport([]byte("<rss><channel></channel></rss>"), WordPressOptions{}); err == nil {
+		t.Error("expected error for export without posts")
+	}
+	if _, err := svc.WordPressImportProgress("missing"); err != ErrImportTaskNotFound {
+		t.Errorf("expected ErrImportTaskNotFound, got %v", err)
+	}
+}
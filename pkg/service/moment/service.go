@@ -0,0 +1,231 @@
+/*
+ * @Description: 说说（短动态）服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package moment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// maxImages 单条说说最多的配图数量
+	maxImages = 9
+	// maxContentLength 说说内容的最大字符数
+	maxContentLength = 2000
+	// rssCacheKey RSS feed 缓存键，与 rss 服务保持一致；说说变更后需要清除
+	rssCacheKey = "rss:feed:latest"
+)
+
+// ErrCommentDisabled 说说不存在、未公开或已关闭评论
+var ErrCommentDisabled = errors.New("该说说不允许评论")
+
+// Service 封装了说说相关的业务逻辑。
+type Service struct {
+	repo        repository.MomentRepository
+	commentRepo repository.CommentRepository
+	parserSvc   *parser.Service
+	cacheSvc    utility.CacheService
+}
+
+// NewService 是说说 Service 的构造函数。
+func NewService(
+	repo repository.MomentRepository,
+	commentRepo repository.CommentRepository,
+	parserSvc *parser.Service,
+	cacheSvc utility.CacheService,
+) *Service {
+	return &Service{
+		repo:        repo,
+		commentRepo: commentRepo,
+		parserSvc:   parserSvc,
+		cacheSvc:    cacheSvc,
+	}
+}
+
+// ListPublic 获取公开的说说时间线（分页）。
+func (s *Service) ListPublic(ctx context.Context, page, pageSize int) (*model.MomentListResponse, error) {
+	return s.list(ctx, page, pageSize, true)
+}
+
+// List 获取全部说说（含未公开的），供后台管理使用。
+func (s *Service) List(ctx context.Context, page, pageSize int) (*model.MomentListResponse, error) {
+	return s.list(ctx, page, pageSize, false)
+}
+
+func (s *Service) list(ctx context.Context, page, pageSize int, publicOnly bool) (*model.MomentListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 50 {
+		pageSize = 10
+	}
+
+	moments, total, err := s.repo.List(ctx, &model.ListMomentsOptions{
+		Page:       page,
+		PageSize:   pageSize,
+		PublicOnly: publicOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取说说列表失败: %w", err)
+	}
+
+	paths := make([]string, len(moments))
+	for i, m := range moments {
+		paths[i] = m.CommentPath()
+	}
+	counts, err := s.commentRepo.CountByTargetPaths(ctx, paths)
+	if err != nil {
+		log.Printf("[Moment] 警告：统计说说评论数失败: %v", err)
+		counts = map[string]int{}
+	}
+
+	list := make([]*model.MomentResponse, len(moments))
+	for i, m := range moments {
+		list[i] = &model.MomentResponse{
+			Moment:       *m,
+			CommentPath:  m.CommentPath(),
+			CommentCount: counts[m.CommentPath()],
+		}
+	}
+	return &model.MomentListResponse{
+		List:     list,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// GetPublic 获取单条公开说说，未公开的说说视为不存在。
+func (s *Service) GetPublic(ctx context.Context, id string) (*model.MomentResponse, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !m.IsPublic {
+		return nil, constant.ErrNotFound
+	}
+	resp := &model.MomentResponse{Moment: *m, CommentPath: m.CommentPath()}
+	if counts, err := s.commentRepo.CountByTargetPaths(ctx, []string{resp.CommentPath}); err == nil {
+		resp.CommentCount = counts[resp.CommentPath]
+	}
+	return resp, nil
+}
+
+// Create 发布说说。
+func (s *Service) Create(ctx context.Context, req *model.SaveMomentRequest) (*model.Moment, error) {
+	createdAt, err := s.prepare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.repo.Create(ctx, req, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateFeedCache(ctx)
+	return m, nil
+}
+
+// Update 编辑说说。
+func (s *Service) Update(ctx context.Context, id string, req *model.SaveMomentRequest) (*model.Moment, error) {
+	createdAt, err := s.prepare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.repo.Update(ctx, id, req, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateFeedCache(ctx)
+	return m, nil
+}
+
+// Delete 删除说说（其下的评论保留，可在评论管理中按路径处理）。
+func (s *Service) Delete(ctx context.Context, id string) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.invalidateFeedCache(ctx)
+	return nil
+}
+
+// CheckCommentTarget 校验评论目标路径：/moments/{id} 路径下的评论要求说说存在、公开且允许评论。
+// 其他路径直接放行，供评论服务在创建评论前调用。
+func (s *Service) CheckCommentTarget(ctx context.Context, targetPath string) error {
+	if !strings.HasPrefix(targetPath, model.MomentCommentPathPrefix) {
+		return nil
+	}
+	id := strings.TrimPrefix(targetPath, model.MomentCommentPathPrefix)
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return ErrCommentDisabled
+		}
+		return fmt.Errorf("获取说说失败: %w", err)
+	}
+	if !m.IsPublic || !m.CommentEnabled {
+		return ErrCommentDisabled
+	}
+	return nil
+}
+
+// prepare 校验并规范化请求，渲染内容 HTML，返回解析后的发布时间（未指定时为 nil）
+func (s *Service) prepare(ctx context.Context, req *model.SaveMomentRequest) (*time.Time, error) {
+	req.Content = strings.TrimSpace(req.Content)
+	req.Location = strings.TrimSpace(req.Location)
+	if req.Content == "" {
+		return nil, fmt.Errorf("%w: 说说内容不能为空", constant.ErrBadRequest)
+	}
+	if utf8.RuneCountInString(req.Content) > maxContentLength {
+		return nil, fmt.Errorf("%w: 说说内容不能超过 %d 个字符", constant.ErrBadRequest, maxContentLength)
+	}
+
+	images := make([]string, 0, len(req.Images))
+	for _, img := range req.Images {
+		if img = strings.TrimSpace(img); img != "" {
+			images = append(images, img)
+		}
+	}
+	if len(images) > maxImages {
+		return nil, fmt.Errorf("%w: 配图最多 %d 张", constant.ErrBadRequest, maxImages)
+	}
+	req.Images = images
+
+	var createdAt *time.Time
+	if req.CreatedAt != nil && *req.CreatedAt != "" {
+		t, err := time.Parse(time.RFC3339, *req.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 发布时间格式无效，应为 RFC3339", constant.ErrBadRequest)
+		}
+		createdAt = &t
+	}
+
+	html, err := s.parserSvc.ToHTML(ctx, req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("说说内容解析失败: %w", err)
+	}
+	req.ContentHTML = html
+	return createdAt, nil
+}
+
+// invalidateFeedCache 清除 RSS 缓存，使开启"RSS 包含说说"时订阅及时更新
+func (s *Service) invalidateFeedCache(ctx context.Context) {
+	if err := s.cacheSvc.Delete(ctx, rssCacheKey); err != nil {
+		log.Printf("[Moment] 警告：清除 RSS 缓存失败: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package moment
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// stubRepo 只实现 GetByID，其余方法不会被调用
+type stubRepo struct {
+	repository.MomentRepository
+	moments map[string]*model.Moment
+}
+
+func (r *stubRepo) GetByID(_ context.Context, id string) (*model.Moment, error) {
+	if m, ok := r.moments[id]; ok {
+		return m, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+func TestCheckCommentTarget(t *testing.T) {
+	svc := &Service{repo: &stubRepo{moments: map[string]*model.Moment{
+		"open":    {ID: "open", IsPublic: true, CommentEnabled: true},
+		"closed":  {ID: "closed", IsPublic: true, CommentEnabled: false},
+		"private": {ID: "private", IsPublic: false, CommentEnabled: true},
+	}}}
+	ctx := context.Background()
+
+	tests := map[string]error{
+		"/posts/hello":     nil,
+		"/moments/open":    nil,
+		"/moments/closed":  ErrCommentDisabled,
+		"/moments/private": ErrCommentDisabled,
+		"/moments/missing": ErrCommentDisabled,
+	}
+	for path, want := range tests {
+		if err := svc.CheckCommentTarget(ctx, path); !errors.Is(err, want) {
+			t.Errorf("CheckCommentTarget(%q) = %v, 期望 %v", path, err, want)
+		}
+	}
+}
+
+func TestPrepareValidation(t *testing.T) {
+	svc := &Service{}
+	ctx := context.Background()
+	badTime := "2026/01/01"
+
+	tests := []*model.SaveMomentRequest{
+		{Content: "   "},
+		{Content: strings.Repeat("字", maxContentLength+1)},
+		{Content: "ok", Images: make([]string, 0, maxImages+1)},
+		{Content: "ok", CreatedAt: &badTime},
+	}
+	for i := 0; i < maxImages+1; i++ {
+		tests[2].Images = append(tests[2].Images, "https://example.com/"+strconv.Itoa(i)+".png")
+	}
+	for i, req := range tests {
+		if _, err := svc.prepare(ctx, req); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("case %d: prepare() = %v, 期望 ErrBadRequest", i, err)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ * @Description: 音乐API配置解析与校验
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 14:00:00
+ * @LastEditTime: 2026-10-15 14:00:00
+ * @LastEditors: 安知鱼
+ */
+package music
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http/httpguts"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 音乐API默认配置
+const (
+	defaultAPIBaseURL       = "https://metings.qjqq.cn"
+	defaultPlaylistEndpoint = "/Playlist"
+	defaultSongEndpoint     = "/Song_V1"
+	defaultAPITimeout       = 15 * time.Second
+	defaultPicTimeout       = 3 * time.Second
+	defaultUserAgent        = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/141.0.0.0 Safari/537.36"
+
+	maxTimeoutSeconds = 120
+)
+
+// apiConfig 单次请求使用的音乐API配置快照
+type apiConfig struct {
+	playlistURL        string
+	songURL            string
+	highQualityEnabled bool
+	timeout            time.Duration
+	picTimeout         time.Duration
+	userAgent          string
+	headers            map[string]string
+}
+
+// loadAPIConfig 从配置中读取音乐API配置，非法值回退到默认值并记录警告
+// 每次请求时读取，后台修改配置后无需重启即可生效
+func loadAPIConfig(settingSvc setting.SettingService) apiConfig {
+	get := func(key constant.SettingKey) string {
+		return strings.TrimSpace(settingSvc.Get(key.String()))
+	}
+
+	cfg := apiConfig{
+		highQualityEnabled: true,
+		timeout:            defaultAPITimeout,
+		picTimeout:         defaultPicTimeout,
+		userAgent:          defaultUserAgent,
+	}
+
+	baseURL := get(constant.KeyMusicAPIBaseURL)
+	if baseURL == "" {
+		baseURL = defaultAPIBaseURL
+	} else if err := validateBaseURL(baseURL); err != nil {
+		slog.Warn("音乐接口地址配置无效，使用默认地址", slog.String("default", defaultAPIBaseURL), slog.Any("error", err))
+		baseURL = defaultAPIBaseURL
+	}
+
+	cfg.playlistURL = resolveEndpoint(baseURL, get(constant.KeyMusicAPIPlaylistEndpoint), defaultPlaylistEndpoint)
+	cfg.songURL = resolveEndpoint(baseURL, get(constant.KeyMusicAPISongEndpoint), defaultSongEndpoint)
+
+	if v := get(constant.KeyMusicAPIHighQualityEnable); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.highQualityEnabled = b
+		} else {
+			slog.Warn("高音质开关配置无效，默认启用", slog.String("value", v))
+		}
+	}
+
+	if v := get(constant.KeyMusicAPITimeout); v != "" {
+		if d, err := parseTimeoutSeconds(v); err == nil {
+			cfg.timeout = d
+		} else {
+			slog.Warn("音乐接口请求超时配置无效，使用默认值", slog.Duration("default", defaultAPITimeout), slog.Any("error", err))
+		}
+	}
+	if v := get(constant.KeyMusicAPIPicTimeout); v != "" {
+		if d, err := parseTimeoutSeconds(v); err == nil {
+			cfg.picTimeout = d
+		} else {
+			slog.Warn("封面解析超时配置无效，使用默认值", slog.Duration("default", defaultPicTimeout), slog.Any("error", err))
+		}
+	}
+
+	if v := get(constant.KeyMusicAPIUserAgent); v != "" {
+		if httpguts.ValidHeaderFieldValue(v) {
+			cfg.userAgent = v
+		} else {
+			slog.Warn("音乐接口 User-Agent 配置包含非法字符，使用默认值")
+		}
+	}
+
+	if v := get(constant.KeyMusicAPIHeaders); v != "" {
+		if headers, err := parseHeaders(v); err == nil {
+			cfg.headers = headers
+		} else {
+			slog.Warn("音乐接口自定义请求头配置无效，已忽略", slog.Any("error", err))
+		}
+	}
+
+	return cfg
+}
+
+// ValidateSettings 校验待更新配置中的音乐API相关项，其余配置项忽略
+func ValidateSettings(values map[string]string) error {
+	for key, raw := range values {
+		v := strings.TrimSpace(raw)
+		var err error
+		switch constant.SettingKey(key) {
+		case constant.KeyMusicAPIBaseURL:
+			if v != "" {
+				err = validateBaseURL(v)
+			}
+		case constant.KeyMusicAPIPlaylistEndpoint, constant.KeyMusicAPISongEndpoint:
+			if v != "" {
+				err = validateEndpoint(v)
+			}
+		case constant.KeyMusicAPIHighQualityEnable, constant.KeyMusicSourceNeteaseEnable, constant.KeyMusicSourceQQEnable,
+			constant.KeyMusicSourceCustomEnable, constant.KeyMusicSourceLocalEnable:
+			if _, perr := strconv.ParseBool(v); perr != nil {
+				err = fmt.Errorf("开关必须为 true 或 false")
+			}
+		case constant.KeyMusicSourceQQAPIURL:
+			if v != "" {
+				err = validateSourceTemplate(v)
+			}
+		case constant.KeyMusicSourceLocalFolder:
+			if v != "" && !strings.HasPrefix(v, "/") {
+				err = fmt.Errorf("本地音乐文件夹路径必须以 / 开头")
+			}
+		case constant.KeyMusicAPITimeout, constant.KeyMusicAPIPicTimeout:
+			if v != "" {
+				_, err = parseTimeoutSeconds(v)
+			}
+		case constant.KeyMusicAPIUserAgent:
+			if !httpguts.ValidHeaderFieldValue(v) {
+				err = fmt.Errorf("User-Agent 包含非法字符")
+			}
+		case constant.KeyMusicAPIHeaders:
+			if v != "" {
+				_, err = parseHeaders(v)
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("配置项 %s 无效: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// validateBaseURL 校验音乐API基础地址，必须是 http(s) 绝对地址
+func validateBaseURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("音乐API基础地址必须是 http(s) 开头的完整地址: %q", raw)
+	}
+	return nil
+}
+
+// validateEndpoint 校验接口地址，允许以 / 开头的路径或 http(s) 完整地址
+func validateEndpoint(raw string) error {
+	if strings.HasPrefix(raw, "/") {
+		if _, err := url.Parse(raw); err != nil {
+			return fmt.Errorf("接口路径格式错误: %q", raw)
+		}
+		return nil
+	}
+	if err := validateBaseURL(raw); err != nil {
+		return fmt.Errorf("接口地址必须以 / 开头或是 http(s) 完整地址: %q", raw)
+	}
+	return nil
+}
+
+// resolveEndpoint 将接口配置解析为完整地址，未配置或非法时使用默认路径
+func resolveEndpoint(baseURL, endpoint, fallback string) string {
+	if endpoint != "" {
+		if err := validateEndpoint(endpoint); err != nil {
+			slog.Warn("音乐接口路径配置无效，使用默认路径", slog.String("default", fallback), slog.Any("error", err))
+			endpoint = fallback
+		}
+	} else {
+		endpoint = fallback
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		return endpoint
+	}
+	return strings.TrimRight(baseURL, "/") + endpoint
+}
+
+// parseTimeoutSeconds 解析以秒为单位的超时配置
+func parseTimeoutSeconds(raw string) (time.Duration, error) {
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 || n > maxTimeoutSeconds {
+		return 0, fmt.Errorf("超时时间必须是 1-%d 之间的整数秒: %q", maxTimeoutSeconds, raw)
+	}
+	return time.Duration(n) * time.Second, nil
+}
+
+// parseHeaders 解析自定义请求头 JSON 对象，并校验名称和值的合法性
+func parseHeaders(raw string) (map[string]string, error) {
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(raw), &headers); err != nil {
+		return nil, fmt.Errorf("请求头必须是字符串键值对的 JSON 对象: %w", err)
+	}
+	for name, value := range headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return nil, fmt.Errorf("请求头名称非法: %q", name)
+		}
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return nil, fmt.Errorf("请求头 %s 的值包含非法字符", name)
+		}
+	}
+	return headers, nil
+}
+
+// originOf 返回地址的 scheme://host 部分，用于构造 Origin/Referer 请求头
+func originOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
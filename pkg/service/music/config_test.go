@@ -0,0 +1,71 @@
+package music
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+func TestLoadAPIConfig(t *testing.T) {
+	cfg := loadAPIConfig(&fakeSettings{values: map[string]string{
+		constant.KeyMusicAPIBaseURL.String():           "https://music.example.com/",
+		constant.KeyMusicAPISongEndpoint.String():      "https://hq.example.com/song",
+		constant.KeyMusicAPIHighQualityEnable.String(): "false",
+		constant.KeyMusicAPITimeout.String():           "30",
+		constant.KeyMusicAPIPicTimeout.String():        "999",
+		constant.KeyMusicAPIHeaders.String():           `{"X-Token":"abc"}`,
+	}})
+
+	if cfg.playlistURL != "https://music.example.com/Playlist" {
+		t.Errorf("歌单地址错误: %s", cfg.playlistURL)
+	}
+	if cfg.songURL != "https://hq.example.com/song" {
+		t.Errorf("完整地址应原样使用: %s", cfg.songURL)
+	}
+	if cfg.highQualityEnabled {
+		t.Error("高音质开关应关闭")
+	}
+	if cfg.timeout != 30*time.Second || cfg.picTimeout != defaultPicTimeout {
+		t.Errorf("超时配置错误: %v / %v", cfg.timeout, cfg.picTimeout)
+	}
+	if cfg.userAgent != defaultUserAgent || cfg.headers["X-Token"] != "abc" {
+		t.Errorf("请求头配置错误: %q %v", cfg.userAgent, cfg.headers)
+	}
+}
+
+func TestValidateSettings(t *testing.T) {
+	valid := map[string]string{
+		constant.KeyMusicAPIBaseURL.String():          "https://music.example.com",
+		constant.KeyMusicAPIPlaylistEndpoint.String(): "/Playlist",
+		constant.KeyMusicAPITimeout.String():          "15",
+		"unrelated.key":                               "anything",
+	}
+	if err := ValidateSettings(valid); err != nil {
+		t.Fatalf("合法配置不应报错: %v", err)
+	}
+
+	invalid := []map[string]string{
+		{constant.KeyMusicAPIBaseURL.String(): "ftp://music.example.com"},
+		{constant.KeyMusicAPISongEndpoint.String(): "Song_V1"},
+		{constant.KeyMusicAPIHighQualityEnable.String(): "maybe"},
+		{constant.KeyMusicAPITimeout.String(): "0"},
+		{constant.KeyMusicAPIUserAgent.String(): "bad\r\nua"},
+		{constant.KeyMusicAPIHeaders.String(): `{"Bad Name":"x"}`},
+	}
+	for _, values := range invalid {
+		if err := ValidateSettings(values); err == nil {
+			t.Errorf("非法配置应报错: %v", values)
+		}
+	}
+}
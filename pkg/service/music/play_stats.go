@@ -0,0 +1,182 @@
+/*
+ * @Description: 音乐播放统计服务 - 记录播放器上报的播放并提供排行与图表数据
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 15:00:00
+ * @LastEditTime: 2026-10-15 15:00:00
+ * @LastEditors: 安知鱼
+ */
+package music
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// playDedupWindow 同一会话重复上报同一首歌时，该时间窗口内只计一次
+	playDedupWindow = 30 * time.Minute
+	// maxPlayDuration 单次上报收听时长的上限（秒），超出部分截断以防异常数据
+	maxPlayDuration = 2 * 60 * 60
+	// topCacheTTL 前台排行榜缓存时间
+	topCacheTTL = 5 * time.Minute
+
+	playDedupKeyPrefix = "anheyu:music:play:dedup:"
+	topCacheKeyPrefix  = "anheyu:music:play:top:"
+
+	periodLayout = "2006-01"
+)
+
+// PlayStatService 音乐播放统计服务
+type PlayStatService struct {
+	repo     repository.MusicPlayStatRepository
+	cacheSvc utility.CacheService
+	now      func() time.Time
+}
+
+// NewPlayStatService 创建音乐播放统计服务
+func NewPlayStatService(repo repository.MusicPlayStatRepository, cacheSvc utility.CacheService) *PlayStatService {
+	return &PlayStatService{
+		repo:     repo,
+		cacheSvc: cacheSvc,
+		now:      utils.NowInChina,
+	}
+}
+
+// RecordPlay 记录一次播放，返回是否计入统计（同一会话窗口内的重复上报返回 false）
+// 会话由客户端 IP、User-Agent 和播放器上报的会话ID共同确定
+func (s *PlayStatService) RecordPlay(ctx context.Context, clientIP, userAgent string, report *model.MusicPlayReport) (bool, error) {
+	report.SongID = strings.TrimSpace(report.SongID)
+	if report.SongID == "" {
+		return false, fmt.Errorf("歌曲ID不能为空")
+	}
+	report.Name = strings.TrimSpace(report.Name)
+	report.Artist = strings.TrimSpace(report.Artist)
+	report.Duration = min(max(report.Duration, 0), maxPlayDuration)
+
+	sum := sha1.Sum([]byte(clientIP + "|" + userAgent + "|" + report.SessionID + "|" + report.SongID))
+	dedupKey := playDedupKeyPrefix + hex.EncodeToString(sum[:])
+	count, err := s.cacheSvc.Increment(ctx, dedupKey)
+	if err != nil {
+		// 去重失败时仍记录播放，统计允许少量误差
+		slog.WarnContext(ctx, "播放去重检查失败", slog.Any("error", err))
+	} else {
+		if count == 1 {
+			if err := s.cacheSvc.Expire(ctx, dedupKey, playDedupWindow); err != nil {
+				slog.WarnContext(ctx, "设置播放去重过期时间失败", slog.Any("error", err))
+			}
+		}
+		if count > 1 {
+			return false, nil
+		}
+	}
+
+	if err := s.repo.Record(ctx, s.now().Format(periodLayout), report); err != nil {
+		return false, fmt.Errorf("记录播放失败: %w", err)
+	}
+	return true, nil
+}
+
+// TopThisMonth 返回本月播放次数最多的歌曲（结果短暂缓存）
+func (s *PlayStatService) TopThisMonth(ctx context.Context, limit int) ([]*model.MusicPlayStat, error) {
+	period := s.now().Format(periodLayout)
+	cacheKey := fmt.Sprintf("%s%s:%d", topCacheKeyPrefix, period, limit)
+	if cached, err := s.cacheSvc.Get(ctx, cacheKey); err == nil && cached != "" {
+		var top []*model.MusicPlayStat
+		if json.Unmarshal([]byte(cached), &top) == nil {
+			return top, nil
+		}
+	}
+
+	rows, err := s.repo.ListByPeriods(ctx, period, period)
+	if err != nil {
+		return nil, fmt.Errorf("获取本月播放统计失败: %w", err)
+	}
+	top := rankSongs(rows, limit)
+
+	if data, err := json.Marshal(top); err == nil {
+		if err := s.cacheSvc.Set(ctx, cacheKey, string(data), topCacheTTL); err != nil {
+			slog.WarnContext(ctx, "缓存播放排行榜失败", slog.Any("error", err))
+		}
+	}
+	return top, nil
+}
+
+// Chart 返回最近 months 个月（含本月）的月度汇总以及区间内播放最多的 limit 首歌
+func (s *PlayStatService) Chart(ctx context.Context, months, limit int) (*model.MusicPlayChart, error) {
+	now := s.now()
+	first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -(months - 1), 0)
+	from, to := first.Format(periodLayout), now.Format(periodLayout)
+
+	rows, err := s.repo.ListByPeriods(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("获取播放统计失败: %w", err)
+	}
+
+	// 先按月份补齐空月，保证图表横轴连续
+	monthly := make(map[string]*model.MusicMonthlyStat, months)
+	chart := &model.MusicPlayChart{Months: make([]*model.MusicMonthlyStat, 0, months)}
+	for i := 0; i < months; i++ {
+		m := &model.MusicMonthlyStat{Period: first.AddDate(0, i, 0).Format(periodLayout)}
+		monthly[m.Period] = m
+		chart.Months = append(chart.Months, m)
+	}
+	for _, row := range rows {
+		if m, ok := monthly[row.Period]; ok {
+			m.PlayCount += row.PlayCount
+			m.TotalDuration += row.TotalDuration
+			m.SongCount++
+		}
+	}
+
+	chart.TopSongs = rankSongs(rows, limit)
+	return chart, nil
+}
+
+// rankSongs 按歌曲合并多个月份的汇总行，按播放次数（其次收听时长）降序取前 limit 首
+func rankSongs(rows []*model.MusicPlayStat, limit int) []*model.MusicPlayStat {
+	bySong := make(map[string]*model.MusicPlayStat)
+	latest := make(map[string]string) // 歌曲名称取最近月份的上报
+	for _, row := range rows {
+		song, ok := bySong[row.SongID]
+		if !ok {
+			song = &model.MusicPlayStat{SongID: row.SongID}
+			bySong[row.SongID] = song
+		}
+		song.PlayCount += row.PlayCount
+		song.TotalDuration += row.TotalDuration
+		if row.Period >= latest[row.SongID] && row.Name != "" {
+			latest[row.SongID] = row.Period
+			song.Name, song.Artist = row.Name, row.Artist
+		}
+	}
+
+	songs := make([]*model.MusicPlayStat, 0, len(bySong))
+	for _, song := range bySong {
+		songs = append(songs, song)
+	}
+	sort.Slice(songs, func(i, j int) bool {
+		if songs[i].PlayCount != songs[j].PlayCount {
+			return songs[i].PlayCount > songs[j].PlayCount
+		}
+		if songs[i].TotalDuration != songs[j].TotalDuration {
+			return songs[i].TotalDuration > songs[j].TotalDuration
+		}
+		return songs[i].SongID < songs[j].SongID
+	})
+	if len(songs) > limit {
+		songs = songs[:limit]
+	}
+	return songs
+}
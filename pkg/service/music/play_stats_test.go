@@ -0,0 +1,90 @@
+package music
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// memPlayStatRepo 内存实现的播放统计仓库
+type memPlayStatRepo struct {
+	rows map[string]*model.MusicPlayStat
+}
+
+func (m *memPlayStatRepo) Record(ctx context.Context, period string, report *model.MusicPlayReport) error {
+	key := period + "/" + report.SongID
+	row, ok := m.rows[key]
+	if !ok {
+		row = &model.MusicPlayStat{Period: period, SongID: report.SongID}
+		m.rows[key] = row
+	}
+	row.Name, row.Artist = report.Name, report.Artist
+	row.PlayCount++
+	row.TotalDuration += int64(report.Duration)
+	return nil
+}
+
+func (m *memPlayStatRepo) ListByPeriods(ctx context.Context, from, to string) ([]*model.MusicPlayStat, error) {
+	var out []*model.MusicPlayStat
+	for _, row := range m.rows {
+		if row.Period >= from && row.Period <= to {
+			copied := *row
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func newTestPlayStatService(now time.Time) (*PlayStatService, *memPlayStatRepo) {
+	repo := &memPlayStatRepo{rows: map[string]*model.MusicPlayStat{}}
+	svc := NewPlayStatService(repo, utility.NewMemoryCacheService())
+	svc.now = func() time.Time { return now }
+	return svc, repo
+}
+
+func TestRecordPlay_DeduplicatesPerSession(t *testing.T) {
+	svc, repo := newTestPlayStatService(time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	report := func(session string) *model.MusicPlayReport {
+		return &model.MusicPlayReport{SongID: "123456", Name: "晴天", Duration: 99999, SessionID: session}
+	}
+	if counted, err := svc.RecordPlay(ctx, "1.1.1.1", "ua", report("s1")); err != nil || !counted {
+		t.Fatalf("首次上报应计入: counted=%v err=%v", counted, err)
+	}
+	if counted, _ := svc.RecordPlay(ctx, "1.1.1.1", "ua", report("s1")); counted {
+		t.Error("同一会话重复上报不应计入")
+	}
+	if counted, _ := svc.RecordPlay(ctx, "1.1.1.1", "ua", report("s2")); !counted {
+		t.Error("不同会话应分别计入")
+	}
+
+	row := repo.rows["2026-10/123456"]
+	if row == nil || row.PlayCount != 2 || row.TotalDuration != 2*maxPlayDuration {
+		t.Errorf("汇总错误: %+v", row)
+	}
+}
+
+func TestChart_FillsEmptyMonthsAndRanksSongs(t *testing.T) {
+	svc, repo := newTestPlayStatService(time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	repo.rows = map[string]*model.MusicPlayStat{
+		"a": {Period: "2026-01", SongID: "1", Name: "旧名", PlayCount: 3, TotalDuration: 100},
+		"b": {Period: "2026-03", SongID: "1", Name: "新名", PlayCount: 1, TotalDuration: 50},
+		"c": {Period: "2026-03", SongID: "2", Name: "另一首", PlayCount: 2, TotalDuration: 10},
+		"d": {Period: "2025-12", SongID: "3", Name: "区间外", PlayCount: 100},
+	}
+
+	chart, err := svc.Chart(context.Background(), 3, 10)
+	if err != nil {
+		t.Fatalf("获取图表失败: %v", err)
+	}
+	if len(chart.Months) != 3 || chart.Months[0].Period != "2026-01" || chart.Months[1].PlayCount != 0 || chart.Months[2].SongCount != 2 {
+		t.Errorf("月度数据错误: %+v %+v %+v", chart.Months[0], chart.Months[1], chart.Months[2])
+	}
+	if len(chart.TopSongs) != 2 || chart.TopSongs[0].SongID != "1" || chart.TopSongs[0].PlayCount != 4 || chart.TopSongs[0].Name != "新名" {
+		t.Errorf("排行错误: %+v", chart.TopSongs)
+	}
+}
@@ -11,15 +11,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 )
 
@@ -32,6 +35,7 @@ type Song struct {
 	URL       string `json:"url"`
 	Pic       string `json:"pic"`
 	Lrc       string `json:"lrc"`
+	Source    string `json:"source,omitempty"` // 歌曲来源：netease / qq / custom / local
 }
 
 // PlaylistApiResponse 新的播放列表API响应结构
@@ -82,7 +86,7 @@ type SongResourceResponse struct {
 
 // MusicService 定义音乐服务接口
 type MusicService interface {
-	// 获取播放列表
+	// 获取播放列表（合并所有已启用的歌单来源）
 	FetchPlaylist(ctx context.Context) ([]Song, error)
 	// 获取歌曲资源（音频和歌词）
 	FetchSongResources(ctx context.Context, song Song) (SongResourceResponse, error)
@@ -90,25 +94,23 @@ type MusicService interface {
 
 // musicService 音乐服务实现
 type musicService struct {
-	settingSvc setting.SettingService
-	httpClient *http.Client
-	// API URLs
-	playlistAPI string
-	songAPI     string
+	settingSvc    setting.SettingService
+	fileRepo      repository.FileRepository
+	directLinkSvc direct_link.Service
+	transport     http.RoundTripper
+	// 按超时时间缓存的 HTTP 客户端，超时配置修改后按需创建新客户端
+	httpClients sync.Map // map[time.Duration]*http.Client
 	// 图片URL缓存，key: 原始URL, value: 优化后的URL
 	picUrlCache sync.Map
 	// 并发控制
 	concurrencyLimit int
+	logger           *slog.Logger
 }
 
 // NewMusicService 创建新的音乐服务
-func NewMusicService(settingSvc setting.SettingService) MusicService {
-	// 从配置获取音乐API基础地址
-	apiBaseURL := settingSvc.Get(constant.KeyMusicAPIBaseURL.String())
-	if apiBaseURL == "" {
-		apiBaseURL = "https://metings.qjqq.cn"
-	}
-
+// 接口地址、超时时间和请求头均在每次请求时从配置读取，见 loadAPIConfig
+// fileRepo 和 directLinkSvc 用于本地文件夹歌单来源
+func NewMusicService(settingSvc setting.SettingService, fileRepo repository.FileRepository, directLinkSvc direct_link.Service) MusicService {
 	// 创建自定义 Transport，跳过 SSL 证书验证
 	// 注意：这是为了兼容外部 API（metings.qjqq.cn）的临时解决方案
 	// 该 API 的证书由未知的证书颁发机构签名，导致验证失败
@@ -120,271 +122,166 @@ func NewMusicService(settingSvc setting.SettingService) MusicService {
 
 	return &musicService{
 		settingSvc:       settingSvc,
-		httpClient:       &http.Client{Timeout: 15 * time.Second, Transport: transport},
-		playlistAPI:      apiBaseURL + "/Playlist",
-		songAPI:          apiBaseURL + "/Song_V1",
+		fileRepo:         fileRepo,
+		directLinkSvc:    directLinkSvc,
+		transport:        transport,
 		picUrlCache:      sync.Map{},
 		concurrencyLimit: 20, // 限制并发数量为20
+		logger:           slog.Default().With("system", "music"),
 	}
 }
 
-// logRequest 记录请求日志
-func (s *musicService) logRequest(method, url string, requestBody []byte) {
-	log.Printf("[MUSIC_API] ==================== API 请求开始 ====================")
-	log.Printf("[MUSIC_API] 请求方法: %s", method)
-	log.Printf("[MUSIC_API] 请求URL: %s", url)
-	log.Printf("[MUSIC_API] 请求时间: %s", time.Now().Format("2006-01-02 15:04:05.000"))
-
-	// 解析URL获取更多信息
-	s.logURLInfo(url)
-
-	if len(requestBody) > 0 {
-		log.Printf("[MUSIC_API] 请求体长度: %d bytes", len(requestBody))
-		log.Printf("[MUSIC_API] 请求体内容: %s", string(requestBody))
+// httpClient 返回使用指定单次请求超时的 HTTP 客户端
+// 同名客户端共享熔断器，切换超时配置不会重置熔断状态
+func (s *musicService) httpClient(timeout time.Duration) *http.Client {
+	if c, ok := s.httpClients.Load(timeout); ok {
+		return c.(*http.Client)
+	}
+	policy := httpclient.DefaultPolicy()
+	policy.Timeout = timeout
+	c, _ := s.httpClients.LoadOrStore(timeout, httpclient.New("music_api", policy, httpclient.WithBaseTransport(s.transport)))
+	return c.(*http.Client)
+}
 
-		// 尝试解析请求体JSON
-		s.logRequestBodyInfo(requestBody)
-	} else {
-		log.Printf("[MUSIC_API] 请求体: 无")
+// applyHeaders 为上游请求设置 User-Agent 和站点自定义请求头（自定义请求头优先）
+func (s *musicService) applyHeaders(req *http.Request, cfg apiConfig) {
+	req.Header.Set("User-Agent", cfg.userAgent)
+	for name, value := range cfg.headers {
+		req.Header.Set(name, value)
 	}
 }
 
-// logResponse 记录响应日志
-func (s *musicService) logResponse(url string, statusCode int, responseBody []byte, duration time.Duration) {
-	log.Printf("[MUSIC_API] ==================== API 响应完成 ====================")
-	log.Printf("[MUSIC_API] 响应URL: %s", url)
-	log.Printf("[MUSIC_API] 响应状态码: %d", statusCode)
-	log.Printf("[MUSIC_API] 响应耗时: %v", duration)
-	log.Printf("[MUSIC_API] 响应时间: %s", time.Now().Format("2006-01-02 15:04:05.000"))
+// responseLogLimit 调试日志中完整记录响应体的最大字节数，超出时只记录开头部分与 JSON 结构摘要
+const responseLogLimit = 2048
 
-	// 评估响应性能
-	s.logPerformanceMetrics(duration, len(responseBody))
+// slowAPIThreshold 上游接口响应超过该耗时时记录警告
+const slowAPIThreshold = 2 * time.Second
+
+// logRequest 以 DEBUG 级别记录上游请求
+func (s *musicService) logRequest(ctx context.Context, method, url string, requestBody []byte) {
+	s.logger.DebugContext(ctx, "音乐接口请求",
+		slog.String("method", method),
+		slog.String("url", url),
+		slog.String("api_type", apiType(url)),
+		slog.String("body", string(requestBody)))
+}
 
-	if len(responseBody) == 0 {
-		log.Printf("[MUSIC_API] 响应体: 空")
+// logResponse 以 DEBUG 级别记录上游响应，响应缓慢时记录警告
+func (s *musicService) logResponse(ctx context.Context, url string, statusCode int, responseBody []byte, duration time.Duration) {
+	if duration > slowAPIThreshold {
+		s.logger.WarnContext(ctx, "音乐接口响应缓慢", slog.String("url", url), slog.Int64("duration_ms", duration.Milliseconds()))
+	}
+	if !s.logger.Enabled(ctx, slog.LevelDebug) {
 		return
 	}
-
-	responseStr := string(responseBody)
-	responseSize := len(responseBody)
-
-	// 记录响应体大小
-	log.Printf("[MUSIC_API] 响应体长度: %d bytes", responseSize)
-
-	// 根据响应大小决定记录策略
-	if responseSize <= 2048 {
-		// 小响应直接完整记录
-		log.Printf("[MUSIC_API] 完整响应体: %s", responseStr)
+	attrs := []any{
+		slog.String("url", url),
+		slog.Int("status", statusCode),
+		slog.Int64("duration_ms", duration.Milliseconds()),
+		slog.Int("bytes", len(responseBody)),
+	}
+	if len(responseBody) <= responseLogLimit {
+		attrs = append(attrs, slog.String("body", string(responseBody)))
 	} else {
-		// 大响应记录前500字符和后200字符
-		prefix := ""
-		suffix := ""
-
-		if responseSize > 500 {
-			prefix = responseStr[:500]
-		} else {
-			prefix = responseStr
-		}
-
-		if responseSize > 700 {
-			suffix = responseStr[responseSize-200:]
-		}
-
-		log.Printf("[MUSIC_API] 响应体摘要(前500字符): %s", prefix)
-		if suffix != "" {
-			log.Printf("[MUSIC_API] 响应体摘要(后200字符): %s", suffix)
-		}
-
-		// 尝试解析JSON结构并记录关键信息
-		s.logJSONStructure(responseStr)
+		attrs = append(attrs,
+			slog.String("body_prefix", string(responseBody[:500])),
+			slog.String("json_summary", jsonSummary(responseBody)))
 	}
-
-	log.Printf("[MUSIC_API] ==================== API 调用结束 ====================")
+	s.logger.DebugContext(ctx, "音乐接口响应", attrs...)
 }
 
-// logError 记录错误日志
-func (s *musicService) logError(operation, url string, err error) {
-	log.Printf("[MUSIC_API] ==================== API 错误 ====================")
-	log.Printf("[MUSIC_API] 错误时间: %s", time.Now().Format("2006-01-02 15:04:05.000"))
-	log.Printf("[MUSIC_API] 失败操作: %s", operation)
-	log.Printf("[MUSIC_API] 请求URL: %s", url)
-	log.Printf("[MUSIC_API] 错误类型: %T", err)
-	log.Printf("[MUSIC_API] 错误详情: %v", err)
-
-	// 识别错误类型
-	errorType := "unknown"
-	if strings.Contains(err.Error(), "timeout") {
-		errorType = "timeout"
-	} else if strings.Contains(err.Error(), "connection") {
-		errorType = "connection"
-	} else if strings.Contains(err.Error(), "json") {
-		errorType = "json-parse"
-	} else if strings.Contains(err.Error(), "unmarshal") {
-		errorType = "data-parse"
-	} else if strings.Contains(err.Error(), "context deadline exceeded") {
-		errorType = "context-timeout"
-	}
+// logError 记录上游请求失败
+func (s *musicService) logError(ctx context.Context, operation, url string, err error) {
+	s.logger.WarnContext(ctx, "音乐接口请求失败",
+		slog.String("operation", operation),
+		slog.String("url", url),
+		slog.String("error_type", classifyError(err)),
+		slog.Any("error", err))
+}
 
-	log.Printf("[MUSIC_API] 错误分类: %s", errorType)
-	log.Printf("[MUSIC_API] ==================== 错误记录结束 ====================")
+// classifyError 粗略识别上游请求的错误类型，便于按类型筛选日志
+func classifyError(err error) string {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "timeout"):
+		return "timeout"
+	case strings.Contains(msg, "connection"):
+		return "connection"
+	case strings.Contains(msg, "json"):
+		return "json-parse"
+	case strings.Contains(msg, "unmarshal"):
+		return "data-parse"
+	case strings.Contains(msg, "context deadline exceeded"):
+		return "context-timeout"
+	}
+	return "unknown"
 }
 
-// logJSONStructure 解析并记录JSON结构的关键信息
-func (s *musicService) logJSONStructure(jsonStr string) {
+// jsonSummary 返回 JSON 响应的结构摘要：常见状态字段、data 的类型与字段名以及所有顶级字段
+func jsonSummary(body []byte) string {
 	var jsonData map[string]interface{}
-	if err := json.Unmarshal([]byte(jsonStr), &jsonData); err != nil {
-		// 尝试解析为数组
+	if err := json.Unmarshal(body, &jsonData); err != nil {
 		var jsonArray []interface{}
-		if err2 := json.Unmarshal([]byte(jsonStr), &jsonArray); err2 == nil {
-			log.Printf("[MUSIC_API] JSON结构: 数组, 元素数量: %d", len(jsonArray))
-			if len(jsonArray) > 0 {
-				// 记录第一个元素的结构（如果是对象）
-				if firstElement, ok := jsonArray[0].(map[string]interface{}); ok {
-					keys := make([]string, 0, len(firstElement))
-					for key := range firstElement {
-						keys = append(keys, key)
-					}
-					log.Printf("[MUSIC_API] 数组元素字段: %v", keys)
-				}
+		if err := json.Unmarshal(body, &jsonArray); err != nil {
+			return "非 JSON 响应"
+		}
+		if len(jsonArray) > 0 {
+			if firstElement, ok := jsonArray[0].(map[string]interface{}); ok {
+				return fmt.Sprintf("数组(%d个元素, 字段: %v)", len(jsonArray), mapKeys(firstElement))
 			}
-		} else {
-			log.Printf("[MUSIC_API] JSON解析失败，可能不是有效的JSON格式")
 		}
-		return
+		return fmt.Sprintf("数组(%d个元素)", len(jsonArray))
 	}
 
-	// 记录JSON对象的关键字段
 	summary := make(map[string]interface{})
-
-	// 记录常见的API响应字段
-	if code, exists := jsonData["code"]; exists {
-		summary["code"] = code
-	}
-	if msg, exists := jsonData["msg"]; exists {
-		summary["msg"] = msg
-	}
-	if message, exists := jsonData["message"]; exists {
-		summary["message"] = message
-	}
-	if timestamp, exists := jsonData["timestamp"]; exists {
-		summary["timestamp"] = timestamp
+	for _, key := range []string{"code", "msg", "message", "timestamp"} {
+		if v, exists := jsonData[key]; exists {
+			summary[key] = v
+		}
 	}
-
-	// 分析data字段
 	if data, exists := jsonData["data"]; exists {
-		if dataArray, ok := data.([]interface{}); ok {
-			summary["data"] = fmt.Sprintf("数组(%d个元素)", len(dataArray))
-
-			// 记录第一个元素的字段（如果是对象）
-			if len(dataArray) > 0 {
-				if firstItem, ok := dataArray[0].(map[string]interface{}); ok {
-					keys := make([]string, 0, len(firstItem))
-					for key := range firstItem {
-						keys = append(keys, key)
-					}
-					summary["dataFields"] = keys
+		switch d := data.(type) {
+		case []interface{}:
+			summary["data"] = fmt.Sprintf("数组(%d个元素)", len(d))
+			if len(d) > 0 {
+				if firstItem, ok := d[0].(map[string]interface{}); ok {
+					summary["dataFields"] = mapKeys(firstItem)
 				}
 			}
-		} else if dataObj, ok := data.(map[string]interface{}); ok {
-			keys := make([]string, 0, len(dataObj))
-			for key := range dataObj {
-				keys = append(keys, key)
-			}
-			summary["data"] = fmt.Sprintf("对象(字段: %v)", keys)
-		} else if data == nil {
+		case map[string]interface{}:
+			summary["data"] = fmt.Sprintf("对象(字段: %v)", mapKeys(d))
+		case nil:
 			summary["data"] = "null"
-		} else {
+		default:
 			summary["data"] = fmt.Sprintf("%T", data)
 		}
 	}
+	summary["allFields"] = mapKeys(jsonData)
 
-	// 记录顶级字段
-	allFields := make([]string, 0, len(jsonData))
-	for key := range jsonData {
-		allFields = append(allFields, key)
-	}
-	summary["allFields"] = allFields
-
-	summaryJson, _ := json.Marshal(summary)
-	log.Printf("[MUSIC_API] JSON结构摘要: %s", string(summaryJson))
-}
-
-// logURLInfo 解析并记录URL信息
-func (s *musicService) logURLInfo(url string) {
-	// 识别API类型
-	apiType := "unknown"
-	if strings.Contains(url, "metings.qjqq.cn") {
-		apiType = "metings-api"
-		if strings.Contains(url, "/Playlist") {
-			apiType = "metings-playlist"
-		} else if strings.Contains(url, "/Song_V1") {
-			apiType = "metings-song"
-		}
-	}
-
-	log.Printf("[MUSIC_API] API类型: %s", apiType)
-
-	// 提取URL参数
-	if strings.Contains(url, "?") {
-		parts := strings.Split(url, "?")
-		if len(parts) > 1 {
-			log.Printf("[MUSIC_API] URL参数: %s", parts[1])
-		}
-	}
+	summaryJSON, _ := json.Marshal(summary)
+	return string(summaryJSON)
 }
 
-// logRequestBodyInfo 解析并记录请求体信息
-func (s *musicService) logRequestBodyInfo(requestBody []byte) {
-	var jsonData map[string]interface{}
-	if err := json.Unmarshal(requestBody, &jsonData); err != nil {
-		log.Printf("[MUSIC_API] 请求体不是有效的JSON格式")
-		return
-	}
-
-	// 记录请求参数摘要
-	summary := make(map[string]interface{})
-	for key, value := range jsonData {
-		// 对于敏感信息，只记录类型不记录值
-		if key == "id" || key == "neteaseId" {
-			summary[key] = value
-		} else {
-			summary[key] = fmt.Sprintf("%T", value)
-		}
+func mapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
-
-	summaryJson, _ := json.Marshal(summary)
-	log.Printf("[MUSIC_API] 请求参数摘要: %s", string(summaryJson))
+	return keys
 }
 
-// logPerformanceMetrics 记录性能指标
-func (s *musicService) logPerformanceMetrics(duration time.Duration, responseSize int) {
-	// 评估性能等级
-	performanceLevel := "excellent"
-	if duration > 2*time.Second {
-		performanceLevel = "slow"
-	} else if duration > 1*time.Second {
-		performanceLevel = "normal"
-	} else if duration > 500*time.Millisecond {
-		performanceLevel = "good"
-	}
-
-	log.Printf("[MUSIC_API] 性能评级: %s", performanceLevel)
-
-	// 计算平均速度
-	if responseSize > 0 && duration > 0 {
-		speed := float64(responseSize) / duration.Seconds() / 1024 // KB/s
-		log.Printf("[MUSIC_API] 传输速度: %.2f KB/s", speed)
+// apiType 识别上游接口类型
+func apiType(url string) string {
+	if !strings.Contains(url, "metings.qjqq.cn") {
+		return "unknown"
 	}
-
-	// 记录响应大小分类
-	sizeCategory := "small"
-	if responseSize > 100*1024 {
-		sizeCategory = "large"
-	} else if responseSize > 10*1024 {
-		sizeCategory = "medium"
+	switch {
+	case strings.Contains(url, "/Playlist"):
+		return "metings-playlist"
+	case strings.Contains(url, "/Song_V1"):
+		return "metings-song"
 	}
-
-	log.Printf("[MUSIC_API] 响应大小分类: %s (%d bytes)", sizeCategory, responseSize)
+	return "metings-api"
 }
 
 // getPlaylistID 获取播放列表ID
@@ -401,9 +298,9 @@ func (s *musicService) getPlaylistID() string {
 }
 
 // buildPlaylistAPI 构建播放列表API URL
-func (s *musicService) buildPlaylistAPI() string {
+func (s *musicService) buildPlaylistAPI(cfg apiConfig) string {
 	playlistID := s.getPlaylistID()
-	return fmt.Sprintf("%s?id=%s", s.playlistAPI, playlistID)
+	return fmt.Sprintf("%s?id=%s", cfg.playlistURL, url.QueryEscape(playlistID))
 }
 
 // isValidSong 验证歌曲数据是否有效
@@ -415,29 +312,26 @@ func (s *musicService) isValidSong(song map[string]interface{}) bool {
 	return nameOk && artistOk && urlOk && name != "" && artist != "" && url != ""
 }
 
-// FetchPlaylist 获取播放列表
-func (s *musicService) FetchPlaylist(ctx context.Context) ([]Song, error) {
-	playlistURL := s.buildPlaylistAPI()
-
-	// 记录开始日志
-	log.Printf("[MUSIC_API] 开始获取播放列表 - 播放列表ID: %s", s.getPlaylistID())
+// fetchNeteasePlaylist 获取网易云歌单
+func (s *musicService) fetchNeteasePlaylist(ctx context.Context, cfg apiConfig) ([]Song, error) {
+	playlistURL := s.buildPlaylistAPI(cfg)
 
-	// 记录请求日志
-	s.logRequest("GET", playlistURL, nil)
+	s.logRequest(ctx, "GET", playlistURL, nil)
 
 	startTime := time.Now()
 
 	// 创建请求
 	req, err := http.NewRequestWithContext(ctx, "GET", playlistURL, nil)
 	if err != nil {
-		s.logError("创建播放列表请求", playlistURL, err)
+		s.logError(ctx, "创建播放列表请求", playlistURL, err)
 		return nil, fmt.Errorf("创建播放列表请求失败: %w", err)
 	}
+	s.applyHeaders(req, cfg)
 
 	// 发送请求
-	resp, err := s.httpClient.Do(req)
+	resp, err := s.httpClient(cfg.timeout).Do(req)
 	if err != nil {
-		s.logError("获取播放列表", playlistURL, err)
+		s.logError(ctx, "获取播放列表", playlistURL, err)
 		return nil, fmt.Errorf("获取播放列表失败: %w", err)
 	}
 	defer resp.Body.Close()
@@ -445,30 +339,30 @@ func (s *musicService) FetchPlaylist(ctx context.Context) ([]Song, error) {
 	// 读取响应
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		s.logError("读取播放列表响应", playlistURL, err)
+		s.logError(ctx, "读取播放列表响应", playlistURL, err)
 		return nil, fmt.Errorf("读取播放列表响应失败: %w", err)
 	}
 
 	duration := time.Since(startTime)
-	s.logResponse(playlistURL, resp.StatusCode, responseBody, duration)
+	s.logResponse(ctx, playlistURL, resp.StatusCode, responseBody, duration)
 
 	// 检查状态码
 	if resp.StatusCode != http.StatusOK {
-		log.Printf("[MUSIC_API] 播放列表API返回错误状态码: %d", resp.StatusCode)
+		s.logger.WarnContext(ctx, "播放列表接口返回错误状态码", slog.String("url", playlistURL), slog.Int("status", resp.StatusCode))
 		return nil, fmt.Errorf("播放列表API返回错误状态码: %d", resp.StatusCode)
 	}
 
 	// 解析JSON - 新API返回的是嵌套结构
 	var apiResponse PlaylistApiResponse
 	if err := json.Unmarshal(responseBody, &apiResponse); err != nil {
-		s.logError("解析播放列表JSON", playlistURL, err)
+		s.logError(ctx, "解析播放列表JSON", playlistURL, err)
 		return nil, fmt.Errorf("解析播放列表JSON失败: %w", err)
 	}
 
 	// 验证数据
 	tracks := apiResponse.Data.Playlist.Tracks
 	if len(tracks) == 0 {
-		log.Printf("[MUSIC_API] 播放列表为空")
+		s.logger.InfoContext(ctx, "网易云歌单为空", slog.String("playlist_id", s.getPlaylistID()))
 		return []Song{}, nil
 	}
 
@@ -479,7 +373,7 @@ func (s *musicService) FetchPlaylist(ctx context.Context) ([]Song, error) {
 	for i, track := range tracks {
 		// 验证必要字段
 		if track.Name == "" || track.Artists == "" || track.ID == 0 {
-			log.Printf("[MUSIC_API] 跳过无效歌曲数据，索引: %d", i)
+			s.logger.DebugContext(ctx, "跳过无效歌曲数据", slog.Int("index", i))
 			continue
 		}
 
@@ -494,14 +388,17 @@ func (s *musicService) FetchPlaylist(ctx context.Context) ([]Song, error) {
 			URL:       "",           // 播放列表不提供URL，需要通过Song_V1 API获取
 			Pic:       track.PicURL, // 封面图
 			Lrc:       "",           // 播放列表不提供歌词，需要通过Song_V1 API获取
+			Source:    SourceNetease,
 		}
 
 		songs = append(songs, song)
 		validCount++
 	}
 
-	log.Printf("[MUSIC_API] 播放列表解析完成 - 播放列表: %s, 总数: %d, 有效: %d",
-		apiResponse.Data.Playlist.Name, len(tracks), validCount)
+	s.logger.DebugContext(ctx, "网易云歌单解析完成",
+		slog.String("playlist", apiResponse.Data.Playlist.Name),
+		slog.Int("total", len(tracks)),
+		slog.Int("valid", validCount))
 
 	return songs, nil
 }
@@ -529,7 +426,7 @@ func (s *musicService) extractNeteaseID(song map[string]interface{}) string {
 		}
 	}
 
-	log.Printf("[MUSIC_API] 未能从歌曲数据中提取有效的网易云音乐ID - 歌曲: %v", song["name"])
+	s.logger.Debug("未能从歌曲数据中提取有效的网易云音乐ID", slog.Any("song", song["name"]))
 	return ""
 }
 
@@ -545,8 +442,6 @@ func (s *musicService) extractIDFromURL(url string) string {
 
 // FetchSongResources 获取歌曲的高质量资源
 func (s *musicService) FetchSongResources(ctx context.Context, song Song) (SongResourceResponse, error) {
-	log.Printf("[MUSIC_API] 开始获取歌曲资源 - 网易云ID: %s", song.NeteaseID)
-
 	// 验证网易云ID
 	if song.NeteaseID == "" {
 		return SongResourceResponse{}, fmt.Errorf("网易云音乐ID不能为空")
@@ -556,35 +451,35 @@ func (s *musicService) FetchSongResources(ctx context.Context, song Song) (SongR
 		return SongResourceResponse{}, fmt.Errorf("网易云音乐ID格式无效: %s", song.NeteaseID)
 	}
 
+	cfg := loadAPIConfig(s.settingSvc)
+	if !cfg.highQualityEnabled {
+		// 高音质解析已关闭，返回空资源，由前端回退到歌单自带的播放地址
+		s.logger.DebugContext(ctx, "高音质解析接口已关闭，跳过", slog.String("netease_id", song.NeteaseID))
+		return SongResourceResponse{}, nil
+	}
+
 	// 先尝试获取 exhigh 音质
-	log.Printf("[MUSIC_API] 尝试获取 exhigh 音质 - 网易云ID: %s", song.NeteaseID)
-	response, err := s.fetchSongV1(ctx, song.NeteaseID, "exhigh")
+	response, err := s.fetchSongV1(ctx, cfg, song.NeteaseID, "exhigh")
 
 	// 如果 exhigh 失败或返回空，尝试 standard 音质
 	if err != nil || response.AudioURL == "" {
-		if err != nil {
-			log.Printf("[MUSIC_API] exhigh 音质获取失败，尝试 standard 音质 - 网易云ID: %s, 错误: %v", song.NeteaseID, err)
-		} else {
-			log.Printf("[MUSIC_API] exhigh 音质返回空，尝试 standard 音质 - 网易云ID: %s", song.NeteaseID)
-		}
+		s.logger.DebugContext(ctx, "exhigh 音质不可用，尝试 standard 音质", slog.String("netease_id", song.NeteaseID), slog.Any("error", err))
 
-		response, err = s.fetchSongV1(ctx, song.NeteaseID, "standard")
+		response, err = s.fetchSongV1(ctx, cfg, song.NeteaseID, "standard")
 		if err != nil {
-			log.Printf("[MUSIC_API] standard 音质获取失败 - 网易云ID: %s, 错误: %v", song.NeteaseID, err)
+			s.logger.WarnContext(ctx, "获取歌曲资源失败", slog.String("netease_id", song.NeteaseID), slog.Any("error", err))
 			return SongResourceResponse{}, fmt.Errorf("获取歌曲资源失败: %w", err)
 		}
 	}
 
 	if response.AudioURL == "" {
-		log.Printf("[MUSIC_API] 所有音质都返回空URL - 网易云ID: %s", song.NeteaseID)
+		s.logger.InfoContext(ctx, "所有音质都未返回播放地址", slog.String("netease_id", song.NeteaseID))
 		return SongResourceResponse{
 			AudioURL:   "",
 			LyricsText: "",
 		}, nil
 	}
 
-	log.Printf("[MUSIC_API] 成功获取歌曲资源 - 网易云ID: %s, 有歌词: %v", song.NeteaseID, response.LyricsText != "")
-
 	return response, nil
 }
 
@@ -611,21 +506,19 @@ func (s *musicService) isValidNeteaseID(neteaseID string) bool {
 }
 
 // fetchSongV1 使用 Song_V1 API 获取歌曲资源（音频和歌词）
-func (s *musicService) fetchSongV1(ctx context.Context, neteaseID string, level string) (SongResourceResponse, error) {
-	log.Printf("[MUSIC_API] 调用 Song_V1 API - 网易云ID: %s, 音质: %s", neteaseID, level)
-
+func (s *musicService) fetchSongV1(ctx context.Context, cfg apiConfig, neteaseID string, level string) (SongResourceResponse, error) {
 	// 构建请求参数（使用 form-urlencoded 格式）
 	formData := fmt.Sprintf("url=%s&level=%s&type=json", neteaseID, level)
+	songAPI := cfg.songURL
 
-	// 记录请求日志
-	s.logRequest("POST", s.songAPI, []byte(formData))
+	s.logRequest(ctx, "POST", songAPI, []byte(formData))
 
 	startTime := time.Now()
 
 	// 创建请求
-	req, err := http.NewRequestWithContext(ctx, "POST", s.songAPI, strings.NewReader(formData))
+	req, err := http.NewRequestWithContext(ctx, "POST", songAPI, strings.NewReader(formData))
 	if err != nil {
-		s.logError("创建 Song_V1 请求", s.songAPI, err)
+		s.logError(ctx, "创建 Song_V1 请求", songAPI, err)
 		return SongResourceResponse{}, fmt.Errorf("创建 Song_V1 请求失败: %w", err)
 	}
 
@@ -634,16 +527,18 @@ func (s *musicService) fetchSongV1(ctx context.Context, neteaseID string, level
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9")
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
-	req.Header.Set("Origin", "https://metings.qjqq.cn")
+	if origin := originOf(songAPI); origin != "" {
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Referer", origin+"/")
+	}
 	req.Header.Set("Pragma", "no-cache")
-	req.Header.Set("Referer", "https://metings.qjqq.cn/")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/141.0.0.0 Safari/537.36")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
+	s.applyHeaders(req, cfg)
 
 	// 发送请求
-	resp, err := s.httpClient.Do(req)
+	resp, err := s.httpClient(cfg.timeout).Do(req)
 	if err != nil {
-		s.logError("获取 Song_V1 数据", s.songAPI, err)
+		s.logError(ctx, "获取 Song_V1 数据", songAPI, err)
 		return SongResourceResponse{}, fmt.Errorf("Song_V1 请求失败: %w", err)
 	}
 	defer resp.Body.Close()
@@ -651,31 +546,33 @@ func (s *musicService) fetchSongV1(ctx context.Context, neteaseID string, level
 	// 读取响应
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		s.logError("读取 Song_V1 响应", s.songAPI, err)
+		s.logError(ctx, "读取 Song_V1 响应", songAPI, err)
 		return SongResourceResponse{}, fmt.Errorf("读取 Song_V1 响应失败: %w", err)
 	}
 
 	duration := time.Since(startTime)
-	s.logResponse(s.songAPI, resp.StatusCode, responseBody, duration)
+	s.logResponse(ctx, songAPI, resp.StatusCode, responseBody, duration)
 
 	// 检查状态码
 	if resp.StatusCode != http.StatusOK {
-		log.Printf("[MUSIC_API] Song_V1 API返回错误状态码: %d", resp.StatusCode)
+		s.logger.WarnContext(ctx, "Song_V1 接口返回错误状态码", slog.String("netease_id", neteaseID), slog.Int("status", resp.StatusCode))
 		return SongResourceResponse{}, fmt.Errorf("Song_V1 API返回错误状态码: %d", resp.StatusCode)
 	}
 
 	// 解析响应
 	var apiResponse SongV1ApiResponse
 	if err := json.Unmarshal(responseBody, &apiResponse); err != nil {
-		log.Printf("[MUSIC_API] JSON解析失败，响应内容: %s", string(responseBody))
-		s.logError("解析 Song_V1 JSON", s.songAPI, err)
+		s.logError(ctx, "解析 Song_V1 JSON", songAPI, err)
 		return SongResourceResponse{}, fmt.Errorf("解析 Song_V1 JSON失败: %w", err)
 	}
 
 	// 检查响应状态
 	if apiResponse.Status != 200 || !apiResponse.Success {
-		log.Printf("[MUSIC_API] Song_V1 API返回错误 - 状态码: %d, 成功: %v, 消息: %s",
-			apiResponse.Status, apiResponse.Success, apiResponse.Message)
+		s.logger.WarnContext(ctx, "Song_V1 接口返回错误",
+			slog.String("netease_id", neteaseID),
+			slog.Int("status", apiResponse.Status),
+			slog.Bool("success", apiResponse.Success),
+			slog.String("message", apiResponse.Message))
 		return SongResourceResponse{}, fmt.Errorf("Song_V1 API返回错误: %s", apiResponse.Message)
 	}
 
@@ -685,8 +582,12 @@ func (s *musicService) fetchSongV1(ctx context.Context, neteaseID string, level
 		LyricsText: apiResponse.Data.Lyric, // 注意字段名是 lyric 不是 lrc
 	}
 
-	log.Printf("[MUSIC_API] Song_V1 API调用成功 - 网易云ID: %s, 音质: %s (大小: %s), 有URL: %v, 有歌词: %v",
-		neteaseID, level, apiResponse.Data.Size, result.AudioURL != "", result.LyricsText != "")
+	s.logger.DebugContext(ctx, "Song_V1 接口调用成功",
+		slog.String("netease_id", neteaseID),
+		slog.String("level", level),
+		slog.String("size", apiResponse.Data.Size),
+		slog.Bool("has_url", result.AudioURL != ""),
+		slog.Bool("has_lyrics", result.LyricsText != ""))
 
 	return result, nil
 }
@@ -760,11 +661,11 @@ func (s *musicService) optimizePicUrlsWithTimeout(ctx context.Context, songs []S
 		// 所有任务完成
 	case <-timeoutCtx.Done():
 		// 超时，但goroutine会自行结束
-		log.Printf("[MUSIC_API] 图片URL优化达到时间限制: %v", timeout)
+		s.logger.DebugContext(ctx, "封面地址优化达到时间限制", slog.Duration("timeout", timeout))
 	}
 
 	totalDuration := time.Since(optimizeStartTime)
-	log.Printf("[MUSIC_API] 快速图片URL优化完成 - 耗时: %v", totalDuration)
+	s.logger.DebugContext(ctx, "封面地址优化完成", slog.Int64("duration_ms", totalDuration.Milliseconds()))
 
 	return int(optimizedCount)
 }
@@ -796,7 +697,7 @@ func (s *musicService) optimizePicUrl(ctx context.Context, originalPicUrl string
 	// 创建不跟随重定向的HTTP客户端
 	// 配置跳过 SSL 证书验证以兼容外部 API
 	client := &http.Client{
-		Timeout: 3 * time.Second, // 快速超时
+		Timeout: loadAPIConfig(s.settingSvc).picTimeout, // 快速超时
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: true, // 跳过证书验证
@@ -871,7 +772,6 @@ func (s *musicService) upgradePicSize(picURL string) string {
 	if paramPattern.MatchString(picURL) {
 		// 替换为150y150
 		optimizedURL := paramPattern.ReplaceAllString(picURL, "${1}param=150y150")
-		log.Printf("[MUSIC_API] 图片尺寸参数已优化: %s -> %s", picURL, optimizedURL)
 		return optimizedURL
 	}
 
@@ -905,34 +805,29 @@ func (s *musicService) constructHighQualityURL(originalURL string) string {
 func (s *musicService) validateJSONResponse(resp *http.Response, responseBody []byte, apiName string) error {
 	// 检查响应状态码
 	if resp.StatusCode != http.StatusOK {
-		log.Printf("[MUSIC_API] %s API返回错误状态码: %d", apiName, resp.StatusCode)
-		log.Printf("[MUSIC_API] 响应内容: %s", string(responseBody))
+		s.logger.Debug("接口返回错误状态码", slog.String("api", apiName), slog.Int("status", resp.StatusCode), slog.String("body", string(responseBody)))
 		return fmt.Errorf("%s API返回错误状态码: %d", apiName, resp.StatusCode)
 	}
 
 	// 检查Content-Type是否为JSON
 	contentType := resp.Header.Get("Content-Type")
 	if contentType != "" && !strings.Contains(strings.ToLower(contentType), "application/json") {
-		log.Printf("[MUSIC_API] %s 响应Content-Type不是JSON: %s", apiName, contentType)
-		log.Printf("[MUSIC_API] 响应内容预览: %.200s", string(responseBody))
+		s.logger.Debug("接口响应不是 JSON", slog.String("api", apiName), slog.String("content_type", contentType), slog.String("body", fmt.Sprintf("%.200s", responseBody)))
 		return fmt.Errorf("%s API返回非JSON响应，Content-Type: %s", apiName, contentType)
 	}
 
 	// 验证响应是否为有效的JSON格式
 	responseStr := strings.TrimSpace(string(responseBody))
 	if len(responseStr) == 0 {
-		log.Printf("[MUSIC_API] %s API返回空响应", apiName)
 		return fmt.Errorf("%s API返回空响应", apiName)
 	}
 
 	// 检查响应是否以JSON开始符号开头
 	if !strings.HasPrefix(responseStr, "{") && !strings.HasPrefix(responseStr, "[") {
-		log.Printf("[MUSIC_API] %s 响应不是有效的JSON格式，开始字符: %c", apiName, responseStr[0])
-		log.Printf("[MUSIC_API] 响应内容预览: %.300s", responseStr)
+		s.logger.Debug("接口响应不是有效的 JSON", slog.String("api", apiName), slog.String("body", fmt.Sprintf("%.300s", responseStr)))
 
 		// 检查是否是HTML错误页面
 		if strings.HasPrefix(responseStr, "<") {
-			log.Printf("[MUSIC_API] 疑似收到HTML错误页面而非JSON响应")
 			return fmt.Errorf("%s API返回HTML页面而非JSON数据，可能是服务器错误或API地址变更", apiName)
 		}
 
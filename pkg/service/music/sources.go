@@ -0,0 +1,325 @@
+/*
+ * @Description: 多来源歌单 - 合并网易云、QQ音乐、自定义JSON和本地文件夹中的歌曲
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 16:00:00
+ * @LastEditTime: 2026-10-15 16:00:00
+ * @LastEditors: 安知鱼
+ */
+package music
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// 歌单来源标识
+const (
+	SourceNetease = "netease"
+	SourceQQ      = "qq"
+	SourceCustom  = "custom"
+	SourceLocal   = "local"
+)
+
+// maxSourceBodySize 外部歌单响应体的大小上限
+const maxSourceBodySize = 10 << 20
+
+// localAudioExts 本地文件夹来源识别的音频扩展名
+var localAudioExts = map[string]bool{
+	".mp3": true, ".m4a": true, ".flac": true, ".ogg": true, ".wav": true, ".aac": true,
+}
+
+// playlistSource 一个歌单来源
+type playlistSource struct {
+	name    string
+	enabled bool
+	fetch   func(ctx context.Context, cfg apiConfig) ([]Song, error)
+}
+
+// FetchPlaylist 依次获取所有已启用来源的歌曲并合并去重
+// 单个来源失败只记录日志；所有已启用来源都失败时返回最后一个错误
+func (s *musicService) FetchPlaylist(ctx context.Context) ([]Song, error) {
+	cfg := loadAPIConfig(s.settingSvc)
+	sources := []playlistSource{
+		{SourceNetease, s.sourceEnabled(constant.KeyMusicSourceNeteaseEnable, true), s.fetchNeteasePlaylist},
+		{SourceQQ, s.sourceEnabled(constant.KeyMusicSourceQQEnable, false), s.fetchQQPlaylist},
+		{SourceCustom, s.sourceEnabled(constant.KeyMusicSourceCustomEnable, false), s.fetchCustomPlaylist},
+		{SourceLocal, s.sourceEnabled(constant.KeyMusicSourceLocalEnable, false), s.fetchLocalPlaylist},
+	}
+
+	var lists [][]Song
+	var lastErr error
+	enabled := 0
+	for _, src := range sources {
+		if !src.enabled {
+			continue
+		}
+		enabled++
+		songs, err := src.fetch(ctx, cfg)
+		if err != nil {
+			slog.WarnContext(ctx, "歌单来源获取失败", slog.String("source", src.name), slog.Any("error", err))
+			lastErr = err
+			continue
+		}
+		lists = append(lists, songs)
+	}
+
+	if enabled > 0 && len(lists) == 0 {
+		return nil, lastErr
+	}
+	return mergeSongs(lists...), nil
+}
+
+// sourceEnabled 读取来源开关，未配置时使用默认值
+func (s *musicService) sourceEnabled(key constant.SettingKey, def bool) bool {
+	if strings.TrimSpace(s.settingSvc.Get(key.String())) == "" {
+		return def
+	}
+	return s.settingSvc.GetBool(key.String())
+}
+
+// mergeSongs 按来源顺序合并歌曲，以「歌名+歌手」去重，先出现的来源优先
+func mergeSongs(lists ...[]Song) []Song {
+	seen := make(map[string]bool)
+	merged := []Song{}
+	for _, list := range lists {
+		for _, song := range list {
+			key := dedupKey(song)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, song)
+		}
+	}
+	return merged
+}
+
+// dedupKey 生成去重键：忽略大小写、首尾空白和连续空白（含全角空格）
+func dedupKey(song Song) string {
+	normalize := func(v string) string {
+		return strings.Join(strings.Fields(strings.ToLower(v)), " ")
+	}
+	return normalize(song.Name) + "\x00" + normalize(song.Artist)
+}
+
+// fetchQQPlaylist 通过 Meting API 获取QQ音乐歌单
+func (s *musicService) fetchQQPlaylist(ctx context.Context, cfg apiConfig) ([]Song, error) {
+	playlistID := strings.TrimSpace(s.settingSvc.Get(constant.KeyMusicSourceQQPlaylistID.String()))
+	if playlistID == "" {
+		return nil, fmt.Errorf("未配置QQ音乐歌单ID")
+	}
+	apiURL := strings.TrimSpace(s.settingSvc.Get(constant.KeyMusicSourceQQAPIURL.String()))
+	if err := validateSourceTemplate(apiURL); err != nil {
+		return nil, err
+	}
+
+	songs, err := s.fetchMetingList(ctx, cfg, strings.ReplaceAll(apiURL, "{id}", url.QueryEscape(playlistID)))
+	if err != nil {
+		return nil, fmt.Errorf("获取QQ音乐歌单失败: %w", err)
+	}
+	for i := range songs {
+		songs[i].Source = SourceQQ
+		songs[i].ID = SourceQQ + ":" + songs[i].ID
+	}
+	return songs, nil
+}
+
+// fetchCustomPlaylist 获取自定义歌单JSON（与 Meting/APlayer 格式兼容）
+func (s *musicService) fetchCustomPlaylist(ctx context.Context, cfg apiConfig) ([]Song, error) {
+	listURL := strings.TrimSpace(s.settingSvc.Get(constant.KeyMusicPlayerCustomPlaylist.String()))
+	if listURL == "" {
+		return nil, fmt.Errorf("未配置自定义歌单JSON链接")
+	}
+	if err := validateBaseURL(listURL); err != nil {
+		return nil, fmt.Errorf("自定义歌单JSON链接无效: %w", err)
+	}
+
+	songs, err := s.fetchMetingList(ctx, cfg, listURL)
+	if err != nil {
+		return nil, fmt.Errorf("获取自定义歌单失败: %w", err)
+	}
+	for i := range songs {
+		songs[i].Source = SourceCustom
+		songs[i].ID = SourceCustom + ":" + songs[i].ID
+	}
+	return songs, nil
+}
+
+// metingSong Meting API / APlayer 歌单条目，兼容 name/title、artist/author、pic/cover 两套字段名
+type metingSong struct {
+	ID     json.RawMessage `json:"id"`
+	Name   string          `json:"name"`
+	Title  string          `json:"title"`
+	Artist string          `json:"artist"`
+	Author string          `json:"author"`
+	URL    string          `json:"url"`
+	Pic    string          `json:"pic"`
+	Cover  string          `json:"cover"`
+	Lrc    string          `json:"lrc"`
+}
+
+// fetchMetingList 请求并解析 Meting 格式的歌单数组
+func (s *musicService) fetchMetingList(ctx context.Context, cfg apiConfig, listURL string) ([]Song, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	s.applyHeaders(req, cfg)
+
+	resp, err := s.httpClient(cfg.timeout).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("返回错误状态码: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceBodySize))
+	if err != nil {
+		return nil, err
+	}
+	var items []metingSong
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("解析歌单JSON失败: %w", err)
+	}
+	return parseMetingSongs(items), nil
+}
+
+// parseMetingSongs 将 Meting 条目转换为 Song，跳过缺少歌名或播放地址的条目
+func parseMetingSongs(items []metingSong) []Song {
+	songs := make([]Song, 0, len(items))
+	for i, item := range items {
+		name := firstNonEmpty(item.Name, item.Title)
+		if name == "" || item.URL == "" {
+			continue
+		}
+		// id 可能是数字或字符串，缺省时使用播放地址作为标识
+		id := strings.Trim(string(item.ID), `"`)
+		if id == "" || id == "null" {
+			id = fmt.Sprintf("%d", i)
+			if u, err := url.Parse(item.URL); err == nil && u.Query().Get("id") != "" {
+				id = u.Query().Get("id")
+			}
+		}
+		songs = append(songs, Song{
+			ID:     id,
+			Name:   name,
+			Artist: firstNonEmpty(item.Artist, item.Author),
+			URL:    item.URL,
+			Pic:    firstNonEmpty(item.Pic, item.Cover),
+			Lrc:    item.Lrc,
+		})
+	}
+	return songs
+}
+
+// fetchLocalPlaylist 列出站长网盘中指定文件夹内的音频文件，并生成直链
+// 文件名格式为「歌手 - 歌名」时自动拆分歌手；同名 .lrc 文件作为歌词
+func (s *musicService) fetchLocalPlaylist(ctx context.Context, cfg apiConfig) ([]Song, error) {
+	if s.fileRepo == nil || s.directLinkSvc == nil {
+		return nil, fmt.Errorf("本地歌单来源不可用")
+	}
+	folderPath := strings.TrimSpace(s.settingSvc.Get(constant.KeyMusicSourceLocalFolder.String()))
+	if folderPath == "" {
+		return nil, fmt.Errorf("未配置本地音乐文件夹")
+	}
+
+	// 与直链服务的匿名调用约定一致，仅使用站长（ID=1）的文件
+	folder, err := s.fileRepo.FindByPath(ctx, 1, folderPath)
+	if err != nil {
+		return nil, fmt.Errorf("查找本地音乐文件夹 %s 失败: %w", folderPath, err)
+	}
+	if folder.Type != model.FileTypeDir {
+		return nil, fmt.Errorf("%s 不是文件夹", folderPath)
+	}
+	children, err := s.fileRepo.ListByParentID(ctx, folder.ID)
+	if err != nil {
+		return nil, fmt.Errorf("列出本地音乐文件夹失败: %w", err)
+	}
+
+	var audios []*model.File
+	lyrics := make(map[string]*model.File)
+	fileIDs := make([]uint, 0, len(children))
+	for _, f := range children {
+		if f.Type != model.FileTypeFile {
+			continue
+		}
+		ext := strings.ToLower(path.Ext(f.Name))
+		switch {
+		case localAudioExts[ext]:
+			audios = append(audios, f)
+		case ext == ".lrc":
+			lyrics[strings.TrimSuffix(f.Name, path.Ext(f.Name))] = f
+		default:
+			continue
+		}
+		fileIDs = append(fileIDs, f.ID)
+	}
+	if len(audios) == 0 {
+		return []Song{}, nil
+	}
+
+	links, err := s.directLinkSvc.GetOrCreateDirectLinks(ctx, 0, fileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("生成本地音乐直链失败: %w", err)
+	}
+
+	songs := make([]Song, 0, len(audios))
+	for _, f := range audios {
+		link, ok := links[f.ID]
+		if !ok {
+			continue
+		}
+		base := strings.TrimSuffix(f.Name, path.Ext(f.Name))
+		artist, name := splitArtistTitle(base)
+		song := Song{
+			ID:     fmt.Sprintf("%s:%d", SourceLocal, f.ID),
+			Name:   name,
+			Artist: artist,
+			URL:    link.URL,
+			Source: SourceLocal,
+		}
+		if lrc, ok := lyrics[base]; ok {
+			song.Lrc = links[lrc.ID].URL
+		}
+		songs = append(songs, song)
+	}
+	return songs, nil
+}
+
+// splitArtistTitle 将「歌手 - 歌名」格式的文件名拆分为歌手和歌名
+func splitArtistTitle(base string) (artist, title string) {
+	if a, t, ok := strings.Cut(base, " - "); ok && strings.TrimSpace(a) != "" && strings.TrimSpace(t) != "" {
+		return strings.TrimSpace(a), strings.TrimSpace(t)
+	}
+	return "", strings.TrimSpace(base)
+}
+
+// validateSourceTemplate 校验歌单接口模板：必须是 http(s) 地址且包含 {id} 占位符
+func validateSourceTemplate(raw string) error {
+	if !strings.Contains(raw, "{id}") {
+		return fmt.Errorf("歌单接口地址必须包含 {id} 占位符: %q", raw)
+	}
+	return validateBaseURL(strings.ReplaceAll(raw, "{id}", "0"))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package music
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+func TestMergeSongs_DeduplicatesByTitleAndArtist(t *testing.T) {
+	netease := []Song{{ID: "1", Name: "晴天", Artist: "周杰伦", Source: SourceNetease}}
+	qq := []Song{
+		{ID: "qq:1", Name: " 晴天", Artist: "周杰伦　", Source: SourceQQ},
+		{ID: "qq:2", Name: "晴天", Artist: "其他歌手", Source: SourceQQ},
+	}
+
+	merged := mergeSongs(netease, qq)
+	if len(merged) != 2 || merged[0].Source != SourceNetease || merged[1].ID != "qq:2" {
+		t.Errorf("合并结果错误: %+v", merged)
+	}
+}
+
+func TestFetchPlaylist_MergesEnabledSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"title":"歌曲A","author":"歌手","url":"https://example.com/a.mp3","cover":"https://example.com/a.jpg"},
+			{"name":"歌曲A","artist":"歌手","url":"https://example.com/a2.mp3"},
+			{"name":"无地址"}
+		]`))
+	}))
+	defer server.Close()
+
+	svc := NewMusicService(&fakeSettings{values: map[string]string{
+		constant.KeyMusicSourceNeteaseEnable.String():  "false",
+		constant.KeyMusicSourceCustomEnable.String():   "true",
+		constant.KeyMusicPlayerCustomPlaylist.String(): server.URL,
+	}}, nil, nil)
+
+	songs, err := svc.FetchPlaylist(context.Background())
+	if err != nil {
+		t.Fatalf("获取歌单失败: %v", err)
+	}
+	if len(songs) != 1 || songs[0].Source != SourceCustom || songs[0].Pic != "https://example.com/a.jpg" {
+		t.Errorf("歌单结果错误: %+v", songs)
+	}
+}
+
+func TestFetchPlaylist_FailsWhenAllSourcesFail(t *testing.T) {
+	svc := NewMusicService(&fakeSettings{values: map[string]string{
+		constant.KeyMusicSourceNeteaseEnable.String(): "false",
+		constant.KeyMusicSourceLocalEnable.String():   "true",
+	}}, nil, nil)
+
+	if _, err := svc.FetchPlaylist(context.Background()); err == nil {
+		t.Error("所有已启用来源失败时应返回错误")
+	}
+}
+
+func TestSplitArtistTitle(t *testing.T) {
+	if a, n := splitArtistTitle("周杰伦 - 晴天"); a != "周杰伦" || n != "晴天" {
+		t.Errorf("拆分错误: %q %q", a, n)
+	}
+	if a, n := splitArtistTitle("晴天"); a != "" || n != "晴天" {
+		t.Errorf("无歌手时拆分错误: %q %q", a, n)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * @Description: 内置常见弱密码列表
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 01:00:00
+ * @LastEditTime: 2026-10-16 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package password
+
+// commonPasswords 内置的常见弱密码（小写），开启"禁止常见弱密码"后生效，
+// 管理员可以通过 password_policy.banned_list 追加站点相关的词。
+var commonPasswords = map[string]struct{}{
+	"123456":      {},
+	"123456789":   {},
+	"12345678":    {},
+	"password":    {},
+	"qwerty123":   {},
+	"qwerty":      {},
+	"1q2w3e4r":    {},
+	"12345":       {},
+	"111111":      {},
+	"1234567":     {},
+	"1234567890":  {},
+	"123123":      {},
+	"000000":      {},
+	"abc123":      {},
+	"password1":   {},
+	"iloveyou":    {},
+	"1qaz2wsx":    {},
+	"qwertyuiop":  {},
+	"123321":      {},
+	"654321":      {},
+	"666666":      {},
+	"888888":      {},
+	"112233":      {},
+	"121212":      {},
+	"123qwe":      {},
+	"qwe123":      {},
+	"a123456":     {},
+	"aa123456":    {},
+	"123456a":     {},
+	"123456abc":   {},
+	"abc12345":    {},
+	"abcd1234":    {},
+	"1234qwer":    {},
+	"qwer1234":    {},
+	"q1w2e3r4":    {},
+	"admin":       {},
+	"admin123":    {},
+	"admin888":    {},
+	"root123":     {},
+	"welcome":     {},
+	"welcome1":    {},
+	"letmein":     {},
+	"monkey":      {},
+	"dragon":      {},
+	"sunshine":    {},
+	"princess":    {},
+	"football":    {},
+	"baseball":    {},
+	"master":      {},
+	"superman":    {},
+	"batman":      {},
+	"trustno1":    {},
+	"passw0rd":    {},
+	"p@ssw0rd":    {},
+	"p@ssword":    {},
+	"password123": {},
+	"password12":  {},
+	"pass1234":    {},
+	"changeme":    {},
+	"secret":      {},
+	"11111111":    {},
+	"00000000":    {},
+	"88888888":    {},
+	"66666666":    {},
+	"12341234":    {},
+	"11223344":    {},
+	"87654321":    {},
+	"147258369":   {},
+	"159753":      {},
+	"159357":      {},
+	"147258":      {},
+	"5201314":     {},
+	"woaini1314":  {},
+	"woaini":      {},
+	"520520":      {},
+	"1314520":     {},
+	"a1b2c3d4":    {},
+	"a12345678":   {},
+	"zxcvbnm":     {},
+	"zxcvbnm123":  {},
+	"asdfghjkl":   {},
+	"asdf1234":    {},
+	"asdfgh":      {},
+	"1q2w3e":      {},
+	"1q2w3e4r5t":  {},
+	"qazwsx":      {},
+	"qazwsxedc":   {},
+	"zaq12wsx":    {},
+	"anheyu":      {},
+	"anheyu123":   {},
+	"anzhiyu":     {},
+}
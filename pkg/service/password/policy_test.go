@@ -0,0 +1,95 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string   { return f.values[key] }
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+func TestValidate_Requirements(t *testing.T) {
+	svc := NewService(&fakeSettings{values: map[string]string{
+		constant.KeyPasswordMinLength.String():     "10",
+		constant.KeyPasswordRequireUpper.String():  "true",
+		constant.KeyPasswordRequireDigit.String():  "true",
+		constant.KeyPasswordRequireSymbol.String(): "true",
+		constant.KeyPasswordBlockCommon.String():   "true",
+		constant.KeyPasswordBannedList.String():    "Anheyu-Blog-2026!",
+	}})
+
+	err := svc.Validate(context.Background(), "abcdef")
+	if !errors.Is(err, ErrWeakPassword) {
+		t.Fatalf("expected ErrWeakPassword, got %v", err)
+	}
+	for _, want := range []string{"10", "大写字母", "数字", "特殊字符"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to mention %q, got %q", want, err.Error())
+		}
+	}
+	if err := svc.Validate(context.Background(), "anheyu-blog-2026!"); !errors.Is(err, ErrWeakPassword) {
+		t.Errorf("expected banned password to be rejected, got %v", err)
+	}
+	if err := svc.Validate(context.Background(), "Correct-Horse-42"); err != nil {
+		t.Errorf("expected strong password to pass, got %v", err)
+	}
+}
+
+func TestValidate_MinLengthFloorAndCommonPasswords(t *testing.T) {
+	svc := NewService(&fakeSettings{values: map[string]string{
+		constant.KeyPasswordMinLength.String():   "2",
+		constant.KeyPasswordBlockCommon.String(): "true",
+	}})
+	if got := svc.Policy().MinLength; got != minLengthFloor {
+		t.Fatalf("expected min length to be clamped to %d, got %d", minLengthFloor, got)
+	}
+	if err := svc.Validate(context.Background(), "Password1"); !errors.Is(err, ErrWeakPassword) {
+		t.Errorf("expected common password to be rejected, got %v", err)
+	}
+}
+
+func TestValidate_BreachCheck(t *testing.T) {
+	// SHA-1("Correct-Horse-42") 的前 5 位只会出现在请求路径中
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		digest := sha1Hex("Correct-Horse-42")
+		fmt.Fprintf(w, "0000000000000000000000000000000000A:0\r\n%s:42\r\n", digest[5:])
+	}))
+	defer server.Close()
+
+	svc := NewService(&fakeSettings{values: map[string]string{
+		constant.KeyPasswordBreachCheck.String(): "true",
+	}})
+	svc.rangeURL = server.URL + "/range/"
+
+	err := svc.Validate(context.Background(), "Correct-Horse-42")
+	if !errors.Is(err, ErrWeakPassword) || !strings.Contains(err.Error(), "42") {
+		t.Fatalf("expected breached password to be rejected, got %v", err)
+	}
+	if want := "/range/" + sha1Hex("Correct-Horse-42")[:5]; gotPath != want {
+		t.Errorf("expected request path %q, got %q", want, gotPath)
+	}
+	if err := svc.Validate(context.Background(), "Another-Horse-43"); err != nil {
+		t.Errorf("expected password absent from range to pass, got %v", err)
+	}
+
+	// 接口不可用时不阻止设置密码
+	server.Close()
+	if err := svc.Validate(context.Background(), "Correct-Horse-42"); err != nil {
+		t.Errorf("expected breach check to fail open, got %v", err)
+	}
+}
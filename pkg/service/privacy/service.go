@@ -0,0 +1,266 @@
+/*
+ * @Description: 隐私数据服务：访客 IP 保留期限、评论者数据导出与被遗忘权
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 18:00:00
+ * @LastEditTime: 2026-10-15 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package privacy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
+	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// ErasedNickname 被擦除个人信息后的评论显示的昵称
+const ErasedNickname = "已注销用户"
+
+// CommentRecord 导出的单条评论数据
+type CommentRecord struct {
+	ID          string     `json:"id"`
+	ParentID    string     `json:"parent_id,omitempty"`
+	TargetPath  string     `json:"target_path"`
+	TargetTitle string     `json:"target_title,omitempty"`
+	Nickname    string     `json:"nickname"`
+	Email       string     `json:"email,omitempty"`
+	Website     string     `json:"website,omitempty"`
+	Content     string     `json:"content"`
+	Status      int        `json:"status"`
+	IsAnonymous bool       `json:"is_anonymous"`
+	LikeCount   int        `json:"like_count"`
+	IPAddress   string     `json:"ip_address,omitempty"`
+	IPLocation  string     `json:"ip_location,omitempty"`
+	UserAgent   string     `json:"user_agent,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// SubscriptionRecord 导出的邮件订阅数据
+type SubscriptionRecord struct {
+	Email     string    `json:"email"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CommenterExport 某个邮箱关联的全部个人数据
+type CommenterExport struct {
+	Email        string              `json:"email"`
+	ExportedAt   time.Time           `json:"exported_at"`
+	Comments     []CommentRecord     `json:"comments"`
+	Subscription *SubscriptionRecord `json:"subscription,omitempty"`
+}
+
+// EraseResult 擦除操作的结果
+type EraseResult struct {
+	CommentsScrubbed     int `json:"comments_scrubbed"`
+	SubscriptionsDeleted int `json:"subscriptions_deleted"`
+}
+
+// Service 隐私数据服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting.SettingService
+}
+
+// NewService 创建隐私数据服务实例
+func NewService(db *ent.Client, settingSvc setting.SettingService) *Service {
+	return &Service{
+		db:         db,
+		settingSvc: settingSvc,
+	}
+}
+
+// AnonymizeExpiredVisitorIPs 将超过保留天数的访问日志 IP 截断为网段（IPv4 保留 /24，IPv6 保留 /48）。
+// 保留天数 <= 0 表示不启用，返回被处理的日志条数。
+func (s *Service) AnonymizeExpiredVisitorIPs(ctx context.Context) (int, error) {
+	days, _ := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyPrivacyVisitorIPRetentionDays.String())))
+	if days <= 0 {
+		return 0, nil
+	}
+	// 访问日志以中国时区记录，截止时间保持一致
+	cutoff := utils.NowInChina().AddDate(0, 0, -days)
+
+	ips, err := s.db.VisitorLog.Query().
+		Where(visitorlog.CreatedAtLT(cutoff)).
+		Unique(true).
+		Select(visitorlog.FieldIPAddress).
+		Strings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询待匿名化的访客IP失败: %w", err)
+	}
+
+	total := 0
+	for _, ip := range ips {
+		masked := AnonymizeIP(ip)
+		if masked == ip {
+			continue // 已经匿名化过
+		}
+		n, err := s.db.VisitorLog.Update().
+			Where(visitorlog.CreatedAtLT(cutoff), visitorlog.IPAddressEQ(ip)).
+			SetIPAddress(masked).
+			Save(ctx)
+		if err != nil {
+			return total, fmt.Errorf("匿名化访客IP失败: %w", err)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// ExportCommenterData 导出与某个邮箱关联的全部数据（包括已删除的评论）
+func (s *Service) ExportCommenterData(ctx context.Context, email string) (*CommenterExport, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, fmt.Errorf("%w: 邮箱不能为空", constant.ErrBadRequest)
+	}
+
+	comments, err := s.db.Comment.Query().
+		Where(comment.EmailEqualFold(email)).
+		Order(ent.Asc(comment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询评论失败: %w", err)
+	}
+
+	export := &CommenterExport{
+		Email:      email,
+		ExportedAt: time.Now(),
+		Comments:   make([]CommentRecord, 0, len(comments)),
+	}
+	for _, c := range comments {
+		export.Comments = append(export.Comments, toCommentRecord(c))
+	}
+
+	sub, err := s.db.Subscriber.Query().Where(subscriber.EmailEqualFold(email)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("查询订阅信息失败: %w", err)
+	}
+	if sub != nil {
+		export.Subscription = &SubscriptionRecord{
+			Email:     sub.Email,
+			IsActive:  sub.IsActive,
+			CreatedAt: sub.CreatedAt,
+			UpdatedAt: sub.UpdatedAt,
+		}
+	}
+
+	return export, nil
+}
+
+// EraseCommenterData 擦除某个邮箱在评论和订阅中的个人信息。
+// 评论记录本身与父子关系保留，以维持评论楼层结构完整；仅清空昵称、邮箱、网站、IP、UA 等可识别信息。
+func (s *Service) EraseCommenterData(ctx context.Context, email string) (*EraseResult, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, fmt.Errorf("%w: 邮箱不能为空", constant.ErrBadRequest)
+	}
+
+	result := &EraseResult{}
+	err := withTx(ctx, s.db, func(tx *ent.Tx) error {
+		n, err := tx.Comment.Update().
+			Where(comment.EmailEqualFold(email)).
+			SetNickname(ErasedNickname).
+			ClearEmail().
+			SetEmailMd5("").
+			ClearWebsite().
+			SetIPAddress("").
+			ClearIPLocation().
+			ClearUserAgent().
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("擦除评论个人信息失败: %w", err)
+		}
+		result.CommentsScrubbed = n
+
+		n, err = tx.Subscriber.Delete().Where(subscriber.EmailEqualFold(email)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("删除订阅信息失败: %w", err)
+		}
+		result.SubscriptionsDeleted = n
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Privacy] 已擦除评论者个人信息: 评论 %d 条, 订阅 %d 条", result.CommentsScrubbed, result.SubscriptionsDeleted)
+	return result, nil
+}
+
+// AnonymizeIP 截断 IP 地址：IPv4 将最后一段置零，IPv6 仅保留前 48 位。无法解析的值返回空字符串。
+func AnonymizeIP(ip string) string {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String()
+}
+
+func toCommentRecord(c *ent.Comment) CommentRecord {
+	record := CommentRecord{
+		TargetPath:  c.TargetPath,
+		Nickname:    c.Nickname,
+		Content:     c.Content,
+		Status:      c.Status,
+		IsAnonymous: c.IsAnonymous,
+		LikeCount:   c.LikeCount,
+		IPAddress:   c.IPAddress,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+		DeletedAt:   c.DeletedAt,
+	}
+	record.ID, _ = idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+	if c.ParentID != nil {
+		record.ParentID, _ = idgen.GeneratePublicID(*c.ParentID, idgen.EntityTypeComment)
+	}
+	if c.TargetTitle != nil {
+		record.TargetTitle = *c.TargetTitle
+	}
+	if c.Email != nil {
+		record.Email = *c.Email
+	}
+	if c.Website != nil {
+		record.Website = *c.Website
+	}
+	if c.IPLocation != nil {
+		record.IPLocation = *c.IPLocation
+	}
+	if c.UserAgent != nil {
+		record.UserAgent = *c.UserAgent
+	}
+	return record
+}
+
+// withTx 在事务中执行 fn，出错时回滚
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: 回滚事务失败: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
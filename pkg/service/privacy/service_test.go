@@ -0,0 +1,19 @@
+package privacy
+
+import "testing"
+
+func TestAnonymizeIP(t *testing.T) {
+	cases := map[string]string{
+		"203.0.113.42":          "203.0.113.0",
+		"203.0.113.0":           "203.0.113.0",
+		"2001:db8:abcd:1234::1": "2001:db8:abcd::",
+		"::ffff:198.51.100.7":   "198.51.100.0",
+		"not-an-ip":             "",
+		"":                      "",
+	}
+	for in, want := range cases {
+		if got := AnonymizeIP(in); got != want {
+			t.Errorf("AnonymizeIP(%q) = %q, 期望 %q", in, got, want)
+		}
+	}
+}
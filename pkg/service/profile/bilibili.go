@@ -0,0 +1,62 @@
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// BilibiliProfile Bilibili 用户资料
+type BilibiliProfile struct {
+	UID          string `json:"uid"`
+	Name         string `json:"name"`
+	Face         string `json:"face"`
+	Sign         string `json:"sign"`
+	Follower     int    `json:"follower"`
+	Following    int    `json:"following"`
+	ArchiveCount int    `json:"archive_count"`
+	SpaceURL     string `json:"space_url"`
+}
+
+// fetchBilibili 通过用户名片接口获取昵称、头像与粉丝数
+func fetchBilibili(ctx context.Context, s *Service, uid string) (interface{}, error) {
+	body, err := s.get(ctx, "https://api.bilibili.com/x/web-interface/card?mid="+url.QueryEscape(uid), map[string]string{
+		"Referer": "https://space.bilibili.com/",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    struct {
+			Card struct {
+				Name      string `json:"name"`
+				Face      string `json:"face"`
+				Sign      string `json:"sign"`
+				Attention int    `json:"attention"`
+			} `json:"card"`
+			Follower     int `json:"follower"`
+			ArchiveCount int `json:"archive_count"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("解析 Bilibili 响应失败: %w", err)
+	}
+	if resp.Code != 0 {
+		return nil, fmt.Errorf("Bilibili 接口返回错误: %d %s", resp.Code, resp.Message)
+	}
+
+	return &BilibiliProfile{
+		UID:          uid,
+		Name:         resp.Data.Card.Name,
+		Face:         resp.Data.Card.Face,
+		Sign:         resp.Data.Card.Sign,
+		Follower:     resp.Data.Follower,
+		Following:    resp.Data.Card.Attention,
+		ArchiveCount: resp.Data.ArchiveCount,
+		SpaceURL:     "https://space.bilibili.com/" + uid,
+	}, nil
+}
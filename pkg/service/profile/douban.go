@@ -0,0 +1,161 @@
+package profile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// doubanMaxItems 返回的豆瓣条目数量上限
+const doubanMaxItems = 15
+
+// DoubanItem 豆瓣「看过」条目
+type DoubanItem struct {
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Cover  string `json:"cover"`
+	Rating int    `json:"rating"` // 用户评分（1-5 星），未评分为 0
+	Date   string `json:"date"`   // 标记日期
+}
+
+// DoubanCollection 豆瓣最近看过的影视
+type DoubanCollection struct {
+	UserID     string        `json:"user_id"`
+	Items      []*DoubanItem `json:"items"`
+	ProfileURL string        `json:"profile_url"`
+}
+
+// fetchDouban 豆瓣没有公开 API，解析用户「看过」页面获取最近标记的影视
+func fetchDouban(ctx context.Context, s *Service, userID string) (interface{}, error) {
+	body, err := s.get(ctx, "https://movie.douban.com/people/"+url.PathEscape(userID)+"/collect", nil)
+	if err != nil {
+		return nil, err
+	}
+	items, err := parseDoubanCollection(body)
+	if err != nil {
+		return nil, fmt.Errorf("解析豆瓣页面失败: %w", err)
+	}
+	return &DoubanCollection{
+		UserID:     userID,
+		Items:      items,
+		ProfileURL: "https://www.douban.com/people/" + userID + "/",
+	}, nil
+}
+
+// parseDoubanCollection 从「看过」页面中提取条目，每个条目对应一个 class 含 item 的节点
+func parseDoubanCollection(body []byte) ([]*DoubanItem, error) {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*DoubanItem, 0)
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if len(items) >= doubanMaxItems {
+			return
+		}
+		if n.Type == html.ElementNode && n.Data == "div" && hasClass(n, "item") {
+			if item := parseDoubanItem(n); item != nil {
+				items = append(items, item)
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return items, nil
+}
+
+// parseDoubanItem 解析单个条目，缺少标题或链接时返回 nil
+func parseDoubanItem(n *html.Node) *DoubanItem {
+	item := &DoubanItem{}
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch {
+			case n.Data == "img" && item.Cover == "":
+				item.Cover = attr(n, "src")
+			case n.Data == "li" && hasClass(n, "title"):
+				if a := findElement(n, "a"); a != nil {
+					item.URL = attr(a, "href")
+					item.Title = strings.TrimSpace(strings.SplitN(textContent(a), "/", 2)[0])
+				}
+				return
+			case n.Data == "span" && hasClass(n, "date"):
+				item.Date = strings.TrimSpace(textContent(n))
+			case n.Data == "span" && item.Rating == 0:
+				// 评分节点的 class 形如 rating4-t
+				for _, class := range strings.Fields(attr(n, "class")) {
+					var rating int
+					if _, err := fmt.Sscanf(class, "rating%d-t", &rating); err == nil {
+						item.Rating = rating
+					}
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	if item.Title == "" || item.URL == "" {
+		return nil
+	}
+	return item
+}
+
+// attr 获取节点属性值
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// hasClass 判断节点是否包含指定 class
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// findElement 深度优先查找第一个指定标签的子节点
+func findElement(n *html.Node, tag string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == tag {
+			return c
+		}
+		if found := findElement(c, tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// textContent 拼接节点下的全部文本
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
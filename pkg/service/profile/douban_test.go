@@ -0,0 +1,45 @@
+package profile
+
+import "testing"
+
+const doubanFixture = `<html><body><div class="grid-view">
+<div class="item comment-item">
+  <div class="pic"><a href="https://movie.douban.com/subject/1292052/"><img src="https://img.doubanio.com/view/photo/s_ratio_poster/public/p480747492.jpg"></a></div>
+  <div class="info"><ul>
+    <li class="title"><a href="https://movie.douban.com/subject/1292052/"><em>肖申克的救赎 / The Shawshank Redemption</em></a></li>
+    <li><span class="rating5-t"></span><span class="date">2026-09-30</span></li>
+  </ul></div>
+</div>
+<div class="item comment-item">
+  <div class="info"><ul>
+    <li class="title"><a href="https://movie.douban.com/subject/1291546/"><em>霸王别姬</em></a></li>
+    <li><span class="date">2026-09-01</span></li>
+  </ul></div>
+</div>
+<div class="item"><p>没有标题的条目</p></div>
+</div></body></html>`
+
+func TestParseDoubanCollection(t *testing.T) {
+	items, err := parseDoubanCollection([]byte(doubanFixture))
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("期望 2 个条目，实际 %d", len(items))
+	}
+
+	first := items[0]
+	if first.Title != "肖申克的救赎" {
+		t.Errorf("标题错误: %q", first.Title)
+	}
+	if first.URL != "https://movie.douban.com/subject/1292052/" || first.Cover == "" {
+		t.Errorf("链接或封面错误: %+v", first)
+	}
+	if first.Rating != 5 || first.Date != "2026-09-30" {
+		t.Errorf("评分或日期错误: %+v", first)
+	}
+
+	if items[1].Rating != 0 || items[1].Cover != "" {
+		t.Errorf("未评分条目解析错误: %+v", items[1])
+	}
+}
@@ -0,0 +1,175 @@
+/*
+ * @Description: 外部平台资料聚合服务，由服务端拉取并缓存 Bilibili、豆瓣、Steam 等平台的公开数据，
+ *               浏览器只请求本站接口，避免跨域、限流与密钥泄露问题
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 11:00:00
+ * @LastEditTime: 2026-10-16 11:00:00
+ * @LastEditors: 安知鱼
+ */
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	profileCacheKeyPrefix = "anheyu:profile:"
+	// staleCacheTTL 缓存条目的保存时长，远大于刷新间隔，第三方接口不可用时继续返回旧数据
+	staleCacheTTL = 7 * 24 * time.Hour
+	// defaultRefreshInterval 未配置刷新间隔时的默认值
+	defaultRefreshInterval = 60 * time.Minute
+	// maxResponseSize 第三方响应体的大小上限
+	maxResponseSize = 2 << 20
+	// userAgent 请求第三方接口时使用的 UA，部分平台会拒绝空 UA
+	userAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+)
+
+var (
+	// ErrUnknownProvider 不支持的平台
+	ErrUnknownProvider = errors.New("不支持的平台")
+	// ErrProviderDisabled 平台未配置账号，未启用
+	ErrProviderDisabled = errors.New("该平台未启用")
+)
+
+// provider 描述一个外部平台：从哪个配置读取账号，以及如何拉取数据
+type provider struct {
+	accountKey constant.SettingKey
+	fetch      func(ctx context.Context, s *Service, account string) (interface{}, error)
+}
+
+// providers 支持的平台
+var providers = map[string]provider{
+	"bilibili": {accountKey: constant.KeyProfileBilibiliUID, fetch: fetchBilibili},
+	"douban":   {accountKey: constant.KeyProfileDoubanUserID, fetch: fetchDouban},
+	"steam":    {accountKey: constant.KeyProfileSteamID, fetch: fetchSteam},
+}
+
+// Result 是某个平台的聚合数据
+type Result struct {
+	Provider  string          `json:"provider"`
+	Data      json.RawMessage `json:"data"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Stale     bool            `json:"stale"` // 本次刷新失败，返回的是上次成功拉取的数据
+}
+
+// Service 外部平台资料聚合服务
+type Service struct {
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	client     *http.Client
+	group      singleflight.Group
+}
+
+// NewService 创建外部平台资料聚合服务，client 应带有超时与出站防护
+func NewService(settingSvc setting.SettingService, cacheSvc utility.CacheService, client *http.Client) *Service {
+	return &Service{
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		client:     client,
+	}
+}
+
+// Get 获取指定平台的资料。缓存未过刷新间隔时直接返回；
+// 过期后重新拉取，拉取失败且有旧数据时返回旧数据并标记 stale。
+func (s *Service) Get(ctx context.Context, name string) (*Result, error) {
+	p, ok := providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	account := strings.TrimSpace(s.settingSvc.Get(p.accountKey.String()))
+	if account == "" {
+		return nil, ErrProviderDisabled
+	}
+
+	cacheKey := profileCacheKeyPrefix + name + ":" + account
+	cached := s.loadCache(ctx, cacheKey)
+	if cached != nil && time.Since(cached.FetchedAt) < s.refreshInterval() {
+		return cached, nil
+	}
+
+	// 同一平台的并发刷新只请求一次第三方接口
+	v, err, _ := s.group.Do(cacheKey, func() (interface{}, error) {
+		data, err := p.fetch(ctx, s, account)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		result := &Result{Provider: name, Data: raw, FetchedAt: time.Now()}
+		if encoded, err := json.Marshal(result); err == nil {
+			if err := s.cacheSvc.Set(ctx, cacheKey, string(encoded), staleCacheTTL); err != nil {
+				log.Printf("[Profile] 缓存 %s 资料失败: %v", name, err)
+			}
+		}
+		return result, nil
+	})
+	if err != nil {
+		if cached != nil {
+			log.Printf("[Profile] 刷新 %s 资料失败，返回旧数据: %v", name, err)
+			cached.Stale = true
+			return cached, nil
+		}
+		return nil, fmt.Errorf("获取 %s 资料失败: %w", name, err)
+	}
+	return v.(*Result), nil
+}
+
+// loadCache 读取缓存的资料，不存在或无法解析时返回 nil
+func (s *Service) loadCache(ctx context.Context, key string) *Result {
+	cached, err := s.cacheSvc.Get(ctx, key)
+	if err != nil || cached == "" {
+		return nil
+	}
+	var result Result
+	if err := json.Unmarshal([]byte(cached), &result); err != nil {
+		return nil
+	}
+	return &result
+}
+
+// refreshInterval 读取配置的刷新间隔
+func (s *Service) refreshInterval() time.Duration {
+	minutes, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyProfileRefreshMinutes.String())))
+	if err != nil || minutes <= 0 {
+		return defaultRefreshInterval
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// get 以 GET 请求第三方接口并返回响应体
+func (s *Service) get(ctx context.Context, rawURL string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("第三方接口返回状态码 %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
@@ -0,0 +1,90 @@
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// steamRecentGameCount 最近游玩列表返回的游戏数量
+const steamRecentGameCount = 10
+
+// SteamGame Steam 最近游玩的游戏
+type SteamGame struct {
+	AppID           int    `json:"appid"`
+	Name            string `json:"name"`
+	Playtime2Weeks  int    `json:"playtime_2weeks"`  // 近两周游玩时长（分钟）
+	PlaytimeForever int    `json:"playtime_forever"` // 总游玩时长（分钟）
+	IconURL         string `json:"icon_url"`
+	CoverURL        string `json:"cover_url"`
+	StoreURL        string `json:"store_url"`
+}
+
+// SteamRecent Steam 最近游玩
+type SteamRecent struct {
+	SteamID    string       `json:"steam_id"`
+	TotalCount int          `json:"total_count"`
+	Games      []*SteamGame `json:"games"`
+	ProfileURL string       `json:"profile_url"`
+}
+
+// fetchSteam 通过 Steam Web API 获取最近两周游玩的游戏，API Key 只在服务端使用
+func fetchSteam(ctx context.Context, s *Service, steamID string) (interface{}, error) {
+	apiKey := strings.TrimSpace(s.settingSvc.Get(constant.KeyProfileSteamAPIKey.String()))
+	if apiKey == "" {
+		return nil, errors.New("未配置 Steam Web API Key")
+	}
+
+	query := url.Values{}
+	query.Set("key", apiKey)
+	query.Set("steamid", steamID)
+	query.Set("count", fmt.Sprint(steamRecentGameCount))
+	body, err := s.get(ctx, "https://api.steampowered.com/IPlayerService/GetRecentlyPlayedGames/v1/?"+query.Encode(), nil)
+	if err != nil {
+		// 错误信息中可能带有包含 key 的 URL，避免写入日志
+		return nil, errors.New(strings.ReplaceAll(err.Error(), apiKey, "***"))
+	}
+
+	var resp struct {
+		Response struct {
+			TotalCount int `json:"total_count"`
+			Games      []struct {
+				AppID           int    `json:"appid"`
+				Name            string `json:"name"`
+				Playtime2Weeks  int    `json:"playtime_2weeks"`
+				PlaytimeForever int    `json:"playtime_forever"`
+				ImgIconURL      string `json:"img_icon_url"`
+			} `json:"games"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("解析 Steam 响应失败: %w", err)
+	}
+
+	recent := &SteamRecent{
+		SteamID:    steamID,
+		TotalCount: resp.Response.TotalCount,
+		Games:      make([]*SteamGame, 0, len(resp.Response.Games)),
+		ProfileURL: "https://steamcommunity.com/profiles/" + steamID,
+	}
+	for _, g := range resp.Response.Games {
+		game := &SteamGame{
+			AppID:           g.AppID,
+			Name:            g.Name,
+			Playtime2Weeks:  g.Playtime2Weeks,
+			PlaytimeForever: g.PlaytimeForever,
+			CoverURL:        fmt.Sprintf("https://cdn.cloudflare.steamstatic.com/steam/apps/%d/header.jpg", g.AppID),
+			StoreURL:        fmt.Sprintf("https://store.steampowered.com/app/%d", g.AppID),
+		}
+		if g.ImgIconURL != "" {
+			game.IconURL = fmt.Sprintf("https://media.steampowered.com/steamcommunity/public/images/apps/%d/%s.jpg", g.AppID, g.ImgIconURL)
+		}
+		recent.Games = append(recent.Games, game)
+	}
+	return recent, nil
+}
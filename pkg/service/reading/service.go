@@ -0,0 +1,281 @@
+/*
+ * @Description: 阅读同步服务：登录用户的文章书签与阅读进度，便于前端跨设备恢复阅读位置
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 06:00:00
+ * @LastEditTime: 2026-10-18 06:00:00
+ * @LastEditors: 安知鱼
+ */
+package reading
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+)
+
+const (
+	// defaultPageSize 默认每页数量
+	defaultPageSize = 10
+	// maxPageSize 每页最大数量
+	maxPageSize = 50
+	// maxAnchorLength 阅读位置锚点的最大长度
+	maxAnchorLength = 255
+)
+
+// Service 封装了书签与阅读进度的业务逻辑。
+type Service struct {
+	bookmarkRepo repository.UserBookmarkRepository
+	progressRepo repository.ReadingProgressRepository
+	articleRepo  repository.ArticleRepository
+	articleSvc   article_service.Service
+}
+
+// NewService 是阅读同步 Service 的构造函数。
+func NewService(
+	bookmarkRepo repository.UserBookmarkRepository,
+	progressRepo repository.ReadingProgressRepository,
+	articleRepo repository.ArticleRepository,
+	articleSvc article_service.Service,
+) *Service {
+	return &Service{
+		bookmarkRepo: bookmarkRepo,
+		progressRepo: progressRepo,
+		articleRepo:  articleRepo,
+		articleSvc:   articleSvc,
+	}
+}
+
+// AddBookmark 收藏一篇公开文章，重复收藏不会报错。
+func (s *Service) AddBookmark(ctx context.Context, userID uint, articlePublicID string) error {
+	articleID, err := s.publicArticleID(ctx, articlePublicID)
+	if err != nil {
+		return err
+	}
+	if err := s.bookmarkRepo.Add(ctx, userID, articleID); err != nil {
+		return fmt.Errorf("保存书签失败: %w", err)
+	}
+	return nil
+}
+
+// RemoveBookmark 取消收藏，文章下架或删除后仍允许取消。
+func (s *Service) RemoveBookmark(ctx context.Context, userID uint, articlePublicID string) error {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return err
+	}
+	if err := s.bookmarkRepo.Remove(ctx, userID, articleID); err != nil {
+		return fmt.Errorf("删除书签失败: %w", err)
+	}
+	return nil
+}
+
+// GetBookmarkStatus 查询文章是否已被用户收藏。
+func (s *Service) GetBookmarkStatus(ctx context.Context, userID uint, articlePublicID string) (*model.BookmarkStatus, error) {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := s.bookmarkRepo.Exists(ctx, userID, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("查询书签失败: %w", err)
+	}
+	return &model.BookmarkStatus{ArticleID: articlePublicID, Bookmarked: exists}, nil
+}
+
+// ListBookmarks 按收藏时间倒序获取用户的书签；已不可公开访问的文章不会出现在列表中，但仍计入总数。
+func (s *Service) ListBookmarks(ctx context.Context, userID uint, page, pageSize int) (*model.BookmarkListResponse, error) {
+	page, pageSize = normalizePagination(page, pageSize)
+	bookmarks, total, err := s.bookmarkRepo.List(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取书签列表失败: %w", err)
+	}
+
+	ids := make([]uint, len(bookmarks))
+	for i, b := range bookmarks {
+		ids[i] = b.ArticleID
+	}
+	articles, err := s.articleSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.BookmarkListResponse{
+		List:     make([]model.BookmarkItem, 0, len(bookmarks)),
+		Total:    int64(total),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, b := range bookmarks {
+		article, ok := articles[b.ArticleID]
+		if !ok {
+			continue
+		}
+		result.List = append(result.List, model.BookmarkItem{Article: *article, CreatedAt: b.CreatedAt})
+	}
+	return result, nil
+}
+
+// SaveProgress 保存用户在公开文章中的阅读进度，已有进度时覆盖。
+func (s *Service) SaveProgress(ctx context.Context, userID uint, articlePublicID string, req *model.SaveReadingProgressRequest) (*model.ReadingProgressResponse, error) {
+	if req.Progress < 0 || req.Progress > 1 {
+		return nil, fmt.Errorf("阅读进度必须在 0 到 1 之间: %w", constant.ErrBadRequest)
+	}
+	anchor := strings.TrimSpace(req.Anchor)
+	if utf8.RuneCountInString(anchor) > maxAnchorLength {
+		return nil, fmt.Errorf("阅读位置锚点不能超过 %d 个字符: %w", maxAnchorLength, constant.ErrBadRequest)
+	}
+	articleID, err := s.publicArticleID(ctx, articlePublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.progressRepo.Save(ctx, userID, &model.ReadingProgress{
+		ArticleID: articleID,
+		Progress:  req.Progress,
+		Anchor:    anchor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("保存阅读进度失败: %w", err)
+	}
+	return toProgressResponse(articlePublicID, saved), nil
+}
+
+// GetProgress 获取用户在文章中的阅读进度，没有记录时返回 nil。
+func (s *Service) GetProgress(ctx context.Context, userID uint, articlePublicID string) (*model.ReadingProgressResponse, error) {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return nil, err
+	}
+	progress, err := s.progressRepo.Get(ctx, userID, articleID)
+	if errors.Is(err, constant.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取阅读进度失败: %w", err)
+	}
+	return toProgressResponse(articlePublicID, progress), nil
+}
+
+// DeleteProgress 删除用户在文章中的阅读进度。
+func (s *Service) DeleteProgress(ctx context.Context, userID uint, articlePublicID string) error {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return err
+	}
+	if err := s.progressRepo.Delete(ctx, userID, articleID); err != nil {
+		return fmt.Errorf("删除阅读进度失败: %w", err)
+	}
+	return nil
+}
+
+// ListProgress 按最近阅读时间倒序获取用户的阅读进度，附带文章摘要，用于“继续阅读”列表。
+func (s *Service) ListProgress(ctx context.Context, userID uint, page, pageSize int) (*model.ReadingProgressListResponse, error) {
+	page, pageSize = normalizePagination(page, pageSize)
+	list, total, err := s.progressRepo.List(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取阅读进度列表失败: %w", err)
+	}
+
+	ids := make([]uint, len(list))
+	for i, p := range list {
+		ids[i] = p.ArticleID
+	}
+	articles, err := s.articleSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.ReadingProgressListResponse{
+		List:     make([]model.ReadingProgressResponse, 0, len(list)),
+		Total:    int64(total),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, p := range list {
+		article, ok := articles[p.ArticleID]
+		if !ok {
+			continue
+		}
+		item := toProgressResponse(article.ID, p)
+		item.Article = article
+		result.List = append(result.List, *item)
+	}
+	return result, nil
+}
+
+// publicArticleID 解析文章公共ID并确认文章可公开访问
+func (s *Service) publicArticleID(ctx context.Context, articlePublicID string) (uint, error) {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return 0, err
+	}
+	articles, err := s.articleRepo.ListPublishedByIDs(ctx, []uint{articleID})
+	if err != nil {
+		return 0, fmt.Errorf("获取文章失败: %w", err)
+	}
+	if len(articles) == 0 {
+		return 0, constant.ErrNotFound
+	}
+	return articleID, nil
+}
+
+// articleSummaries 批量获取可公开访问文章的摘要，返回 文章数据库ID -> 文章摘要
+func (s *Service) articleSummaries(ctx context.Context, ids []uint) (map[uint]*model.ArticleResponse, error) {
+	result := make(map[uint]*model.ArticleResponse, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	articles, err := s.articleRepo.ListPublishedByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("获取文章失败: %w", err)
+	}
+	for _, a := range articles {
+		id, _, err := idgen.DecodePublicID(a.ID)
+		if err != nil {
+			continue
+		}
+		a.ContentMd = ""
+		result[id] = s.articleSvc.ToAPIResponse(a, false, false)
+	}
+	return result, nil
+}
+
+// decodeArticleID 将文章公共ID解析为数据库ID
+func decodeArticleID(publicID string) (uint, error) {
+	id, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeArticle {
+		return 0, fmt.Errorf("无效的文章ID: %w", constant.ErrBadRequest)
+	}
+	return id, nil
+}
+
+func toProgressResponse(articlePublicID string, p *model.ReadingProgress) *model.ReadingProgressResponse {
+	return &model.ReadingProgressResponse{
+		ArticleID: articlePublicID,
+		Progress:  p.Progress,
+		Anchor:    p.Anchor,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// normalizePagination 规范化分页参数
+func normalizePagination(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return page, pageSize
+}
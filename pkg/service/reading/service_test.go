@@ -0,0 +1,203 @@
+package reading
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+)
+
+type fakeArticleRepo struct {
+	repository.ArticleRepository
+	published map[uint]*model.Article
+}
+
+func (f *fakeArticleRepo) ListPublishedByIDs(_ context.Context, ids []uint) ([]*model.Article, error) {
+	var result []*model.Article
+	for _, id := range ids {
+		if a, ok := f.published[id]; ok {
+			copied := *a
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+type fakeArticleService struct {
+	article_service.Service
+}
+
+func (fakeArticleService) ToAPIResponse(a *model.Article, _ bool, _ bool) *model.ArticleResponse {
+	return &model.ArticleResponse{ID: a.ID, Title: a.Title, ContentMd: a.ContentMd}
+}
+
+type fakeBookmarkRepo struct {
+	repository.UserBookmarkRepository
+	bookmarks map[uint][]uint
+}
+
+func (f *fakeBookmarkRepo) Add(_ context.Context, userID, articleID uint) error {
+	for _, id := range f.bookmarks[userID] {
+		if id == articleID {
+			return nil
+		}
+	}
+	f.bookmarks[userID] = append([]uint{articleID}, f.bookmarks[userID]...)
+	return nil
+}
+
+func (f *fakeBookmarkRepo) Remove(_ context.Context, userID, articleID uint) error {
+	ids := f.bookmarks[userID][:0]
+	for _, id := range f.bookmarks[userID] {
+		if id != articleID {
+			ids = append(ids, id)
+		}
+	}
+	f.bookmarks[userID] = ids
+	return nil
+}
+
+func (f *fakeBookmarkRepo) Exists(_ context.Context, userID, articleID uint) (bool, error) {
+	for _, id := range f.bookmarks[userID] {
+		if id == articleID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeBookmarkRepo) List(_ context.Context, userID uint, _, _ int) ([]*model.UserBookmark, int, error) {
+	var list []*model.UserBookmark
+	for _, id := range f.bookmarks[userID] {
+		list = append(list, &model.UserBookmark{ArticleID: id})
+	}
+	return list, len(list), nil
+}
+
+type fakeProgressRepo struct {
+	repository.ReadingProgressRepository
+	progress map[uint]*model.ReadingProgress
+}
+
+func (f *fakeProgressRepo) Save(_ context.Context, _ uint, p *model.ReadingProgress) (*model.ReadingProgress, error) {
+	f.progress[p.ArticleID] = p
+	return p, nil
+}
+
+func (f *fakeProgressRepo) Get(_ context.Context, _, articleID uint) (*model.ReadingProgress, error) {
+	if p, ok := f.progress[articleID]; ok {
+		return p, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (f *fakeProgressRepo) List(_ context.Context, _ uint, _, _ int) ([]*model.ReadingProgress, int, error) {
+	var list []*model.ReadingProgress
+	for _, p := range f.progress {
+		list = append(list, p)
+	}
+	return list, len(list), nil
+}
+
+func newTestService(t *testing.T) (*Service, []string) {
+	t.Helper()
+	if err := idgen.InitSqidsEncoderWithSeed("reading-test"); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	published := map[uint]*model.Article{}
+	for i := uint(1); i <= 2; i++ {
+		id, _ := idgen.GeneratePublicID(i, idgen.EntityTypeArticle)
+		ids = append(ids, id)
+		published[i] = &model.Article{ID: id, Title: id, ContentMd: "正文"}
+	}
+	// 第三篇文章未公开
+	hidden, _ := idgen.GeneratePublicID(3, idgen.EntityTypeArticle)
+	ids = append(ids, hidden)
+
+	svc := NewService(
+		&fakeBookmarkRepo{bookmarks: map[uint][]uint{}},
+		&fakeProgressRepo{progress: map[uint]*model.ReadingProgress{}},
+		&fakeArticleRepo{published: published},
+		fakeArticleService{},
+	)
+	return svc, ids
+}
+
+func TestBookmarks(t *testing.T) {
+	svc, ids := newTestService(t)
+	ctx := context.Background()
+
+	if err := svc.AddBookmark(ctx, 1, ids[0]); err != nil {
+		t.Fatalf("收藏公开文章失败: %v", err)
+	}
+	if err := svc.AddBookmark(ctx, 1, ids[0]); err != nil {
+		t.Fatalf("重复收藏不应报错: %v", err)
+	}
+	if err := svc.AddBookmark(ctx, 1, ids[2]); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("收藏未公开文章应返回 ErrNotFound, 实际为 %v", err)
+	}
+	if err := svc.AddBookmark(ctx, 1, "invalid"); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("无效的文章ID应返回 ErrBadRequest, 实际为 %v", err)
+	}
+
+	status, err := svc.GetBookmarkStatus(ctx, 1, ids[0])
+	if err != nil || !status.Bookmarked {
+		t.Fatalf("应已收藏: %+v, %v", status, err)
+	}
+	if status, _ := svc.GetBookmarkStatus(ctx, 2, ids[0]); status.Bookmarked {
+		t.Fatal("书签应按用户隔离")
+	}
+
+	list, err := svc.ListBookmarks(ctx, 1, 0, 0)
+	if err != nil || len(list.List) != 1 || list.List[0].Article.ID != ids[0] {
+		t.Fatalf("书签列表不正确: %+v, %v", list, err)
+	}
+	if list.Page != 1 || list.PageSize != defaultPageSize {
+		t.Errorf("分页参数未规范化: page=%d pageSize=%d", list.Page, list.PageSize)
+	}
+	if list.List[0].Article.ContentMd != "" {
+		t.Error("书签列表不应包含文章正文")
+	}
+
+	if err := svc.RemoveBookmark(ctx, 1, ids[0]); err != nil {
+		t.Fatalf("取消收藏失败: %v", err)
+	}
+	if status, _ := svc.GetBookmarkStatus(ctx, 1, ids[0]); status.Bookmarked {
+		t.Fatal("取消收藏后不应再显示为已收藏")
+	}
+}
+
+func TestReadingProgress(t *testing.T) {
+	svc, ids := newTestService(t)
+	ctx := context.Background()
+
+	if got, err := svc.GetProgress(ctx, 1, ids[0]); err != nil || got != nil {
+		t.Fatalf("没有阅读记录时应返回 nil: %+v, %v", got, err)
+	}
+
+	saved, err := svc.SaveProgress(ctx, 1, ids[0], &model.SaveReadingProgressRequest{Progress: 0.4, Anchor: "  #intro  "})
+	if err != nil || saved.Progress != 0.4 || saved.Anchor != "#intro" || saved.ArticleID != ids[0] {
+		t.Fatalf("保存阅读进度结果不正确: %+v, %v", saved, err)
+	}
+	if got, _ := svc.GetProgress(ctx, 1, ids[0]); got == nil || got.Progress != 0.4 {
+		t.Fatalf("应能读取已保存的阅读进度: %+v", got)
+	}
+
+	if _, err := svc.SaveProgress(ctx, 1, ids[0], &model.SaveReadingProgressRequest{Progress: 1.5}); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("超出范围的阅读进度应返回 ErrBadRequest, 实际为 %v", err)
+	}
+	if _, err := svc.SaveProgress(ctx, 1, ids[2], &model.SaveReadingProgressRequest{Progress: 0.1}); !errors.Is(err, constant.ErrNotFound) {
+		t.Errorf("未公开文章应返回 ErrNotFound, 实际为 %v", err)
+	}
+
+	list, err := svc.ListProgress(ctx, 1, 1, 10)
+	if err != nil || len(list.List) != 1 || list.List[0].Article == nil || list.List[0].Article.ID != ids[0] {
+		t.Fatalf("阅读进度列表应附带文章摘要: %+v, %v", list, err)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * @Description: 更新日志 RSS Feed
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 07:00:00
+ * @LastEditTime: 2026-10-18 07:00:00
+ * @LastEditors: 安知鱼
+ */
+package rss
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// changelogPagePath 前台更新页面的路径，订阅地址为该路径下的 rss.xml
+const changelogPagePath = "/update"
+
+// SetChangelogRepository 注入更新日志仓储（可选），未注入时更新日志订阅不可用
+func (s *service) SetChangelogRepository(repo repository.ChangelogRepository) {
+	s.changelogRepo = repo
+}
+
+// GenerateChangelogFeed 生成公开更新日志的 RSS feed，条目数与文章订阅共用 rss.item_count 配置；
+// 未注入更新日志仓储时返回 constant.ErrNotFound。
+func (s *service) GenerateChangelogFeed(ctx context.Context, opts *RSSOptions) (*RSSFeed, error) {
+	if s.changelogRepo == nil {
+		return nil, constant.ErrNotFound
+	}
+	if opts.ItemCount <= 0 {
+		opts.ItemCount = s.feedItemCount(constant.KeyRSSItemCount)
+	}
+	if opts.BuildTime.IsZero() {
+		opts.BuildTime = time.Now()
+	}
+
+	entries, _, err := s.changelogRepo.List(ctx, &model.ListChangelogOptions{
+		Page:          1,
+		PageSize:      opts.ItemCount,
+		PublishedOnly: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取更新日志失败: %w", err)
+	}
+
+	pageURL := opts.BaseURL + changelogPagePath
+	feed := &RSSFeed{
+		Title: fmt.Sprintf("%s 更新日志", s.settingSvc.Get(constant.KeyAppName.String())),
+		// GenerateXML 以 Link + "/rss.xml" 作为订阅自身地址，与 /update/rss.xml 路由一致
+		Link:          pageURL,
+		Description:   s.settingSvc.Get(constant.KeySiteDescription.String()),
+		Language:      "zh-CN",
+		PubDate:       opts.BuildTime.Format(time.RFC1123Z),
+		LastBuildDate: opts.BuildTime.Format(time.RFC1123Z),
+		Items:         make([]RSSItem, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		feed.Items = append(feed.Items, buildChangelogRSSItem(entry, pageURL))
+	}
+	return feed, nil
+}
+
+// buildChangelogRSSItem 构建单个版本的 RSS 条目，GUID 为更新页面中该版本的锚点
+func buildChangelogRSSItem(entry *model.ChangelogEntry, pageURL string) RSSItem {
+	anchor := pageURL + "#" + url.PathEscape(entry.Version)
+	link := entry.URL
+	if link == "" {
+		link = anchor
+	}
+	title := entry.Version
+	if entry.Title != "" && entry.Title != entry.Version {
+		title = fmt.Sprintf("%s %s", entry.Version, entry.Title)
+	}
+	return RSSItem{
+		Title:       title,
+		Link:        link,
+		Description: entry.ContentHTML,
+		PubDate:     entry.PublishedAt.Format(time.RFC1123Z),
+		GUID:        anchor,
+	}
+}
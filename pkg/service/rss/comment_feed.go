@@ -0,0 +1,154 @@
+/*
+ * @Description: 评论 Atom Feed（全站与单篇文章）
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:30:00
+ * @LastEditTime: 2026-10-15 23:30:00
+ * @LastEditors: 安知鱼
+ */
+package rss
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// GenerateCommentFeed 生成最新已发布评论的 Atom feed。
+// 只输出昵称与评论内容，不包含邮箱、IP 等隐私信息；评论功能关闭时返回 constant.ErrNotFound。
+func (s *service) GenerateCommentFeed(ctx context.Context, opts *CommentFeedOptions) (*AtomFeed, error) {
+	if !s.settingSvc.GetBool(constant.KeyCommentEnable.String()) {
+		return nil, constant.ErrNotFound
+	}
+	if opts.ItemCount <= 0 {
+		opts.ItemCount = s.feedItemCount(constant.KeyRSSCommentItemCount)
+	}
+
+	siteTitle := s.settingSvc.Get(constant.KeyAppName.String())
+	feed := &AtomFeed{
+		ID:       opts.BaseURL + "/comments.atom",
+		Title:    fmt.Sprintf("%s 的最新评论", siteTitle),
+		Subtitle: s.settingSvc.Get(constant.KeySiteDescription.String()),
+		Link:     opts.BaseURL,
+		SelfLink: opts.BaseURL + "/comments.atom",
+	}
+
+	var comments []*model.Comment
+	if opts.Slug == "" {
+		list, _, err := s.commentRepo.FindAllPublishedPaginated(ctx, 1, opts.ItemCount)
+		if err != nil {
+			return nil, fmt.Errorf("获取评论列表失败: %w", err)
+		}
+		comments = list
+	} else {
+		article, err := s.articleRepo.GetBySlugOrID(ctx, opts.Slug)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, constant.ErrNotFound
+			}
+			return nil, fmt.Errorf("获取文章失败: %w", err)
+		}
+		// 未公开与受限文章不提供评论订阅，避免借评论泄露文章存在
+		if article.IsEmbargoed(time.Now()) || (s.isRestricted != nil && s.isRestricted(ctx, article)) {
+			return nil, constant.ErrNotFound
+		}
+		// 评论按文章路径关联，有 abbrlink 时路径使用 abbrlink，否则使用公共 ID
+		path := "/posts/" + article.ID
+		if article.Abbrlink != "" {
+			path = "/posts/" + article.Abbrlink
+		}
+		list, err := s.commentRepo.FindAllPublishedByPath(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("获取评论列表失败: %w", err)
+		}
+		// 仓储按置顶优先排序，feed 只关心时间顺序
+		sort.SliceStable(list, func(i, j int) bool { return list[i].CreatedAt.After(list[j].CreatedAt) })
+		if len(list) > opts.ItemCount {
+			list = list[:opts.ItemCount]
+		}
+		comments = list
+
+		selfLink := fmt.Sprintf("%s%s/comments.atom", opts.BaseURL, path)
+		feed.ID = selfLink
+		feed.Title = fmt.Sprintf("《%s》的评论", article.Title)
+		feed.Subtitle = siteTitle
+		feed.Link = opts.BaseURL + path
+		feed.SelfLink = selfLink
+	}
+
+	feed.Entries = make([]AtomEntry, 0, len(comments))
+	updated := time.Time{}
+	for _, c := range comments {
+		feed.Entries = append(feed.Entries, buildCommentEntry(c, opts.BaseURL))
+		if c.UpdatedAt.After(updated) {
+			updated = c.UpdatedAt
+		}
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	feed.Updated = updated.Format(time.RFC3339)
+
+	return feed, nil
+}
+
+// buildCommentEntry 构建单条评论的 Atom 条目，链接指向评论所在页面的评论锚点
+func buildCommentEntry(c *model.Comment, baseURL string) AtomEntry {
+	publicID, _ := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+	link := fmt.Sprintf("%s%s#comment-%s", baseURL, c.TargetPath, publicID)
+
+	target := c.TargetPath
+	if c.TargetTitle != nil && *c.TargetTitle != "" {
+		target = *c.TargetTitle
+	}
+
+	return AtomEntry{
+		ID:        link,
+		Title:     fmt.Sprintf("%s 评论了《%s》", c.Author.Nickname, target),
+		Link:      link,
+		Author:    c.Author.Nickname,
+		Content:   c.ContentHTML,
+		Published: c.CreatedAt.Format(time.RFC3339),
+		Updated:   c.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// GenerateAtomXML 生成 Atom XML 字符串
+func (s *service) GenerateAtomXML(feed *AtomFeed) string {
+	var sb strings.Builder
+
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>`)
+	sb.WriteString("\n")
+	sb.WriteString(`<feed xmlns="http://www.w3.org/2005/Atom">`)
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("  <id>%s</id>\n", xmlEscape(feed.ID)))
+	sb.WriteString(fmt.Sprintf("  <title>%s</title>\n", xmlEscape(feed.Title)))
+	if feed.Subtitle != "" {
+		sb.WriteString(fmt.Sprintf("  <subtitle>%s</subtitle>\n", xmlEscape(feed.Subtitle)))
+	}
+	sb.WriteString(fmt.Sprintf("  <link href=\"%s\"/>\n", xmlEscape(feed.Link)))
+	sb.WriteString(fmt.Sprintf("  <link href=\"%s\" rel=\"self\" type=\"application/atom+xml\"/>\n", xmlEscape(feed.SelfLink)))
+	sb.WriteString(fmt.Sprintf("  <updated>%s</updated>\n", feed.Updated))
+
+	for _, entry := range feed.Entries {
+		sb.WriteString("  <entry>\n")
+		sb.WriteString(fmt.Sprintf("    <id>%s</id>\n", xmlEscape(entry.ID)))
+		sb.WriteString(fmt.Sprintf("    <title>%s</title>\n", xmlEscape(entry.Title)))
+		sb.WriteString(fmt.Sprintf("    <link href=\"%s\"/>\n", xmlEscape(entry.Link)))
+		sb.WriteString(fmt.Sprintf("    <author><name>%s</name></author>\n", xmlEscape(entry.Author)))
+		sb.WriteString(fmt.Sprintf("    <published>%s</published>\n", entry.Published))
+		sb.WriteString(fmt.Sprintf("    <updated>%s</updated>\n", entry.Updated))
+		sb.WriteString(fmt.Sprintf("    <content type=\"html\">%s</content>\n", xmlEscape(entry.Content)))
+		sb.WriteString("  </entry>\n")
+	}
+
+	sb.WriteString("</feed>")
+
+	return sb.String()
+}
@@ -11,6 +11,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"mime"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +24,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/strutil"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
@@ -31,28 +38,71 @@ type Service interface {
 	GenerateXML(feed *RSSFeed) string
 	// InvalidateCache 清除 RSS 缓存
 	InvalidateCache(ctx context.Context) error
+	// GenerateCommentFeed 生成最新已发布评论的 Atom feed（全站或单篇文章）
+	GenerateCommentFeed(ctx context.Context, opts *CommentFeedOptions) (*AtomFeed, error)
+	// GenerateAtomXML 生成 Atom XML 字符串
+	GenerateAtomXML(feed *AtomFeed) string
+	// SetRestrictionChecker 注入受限文章判断（可选），受限文章不会出现在订阅中
+	SetRestrictionChecker(checker model.ArticleRestrictionChecker)
+	// GenerateChangelogFeed 生成公开更新日志的 RSS feed
+	GenerateChangelogFeed(ctx context.Context, opts *RSSOptions) (*RSSFeed, error)
+	// SetChangelogRepository 注入更新日志仓储（可选），未注入时更新日志订阅不可用
+	SetChangelogRepository(repo repository.ChangelogRepository)
 }
 
 // service RSS 服务实现
 type service struct {
-	articleSvc article_service.Service
-	settingSvc setting.SettingService
-	cacheSvc   utility.CacheService
+	articleSvc  article_service.Service
+	articleRepo repository.ArticleRepository
+	commentRepo repository.CommentRepository
+	momentRepo  repository.MomentRepository
+	settingSvc  setting.SettingService
+	cacheSvc    utility.CacheService
+	// isRestricted 可选；PRO版注入，用于排除密码保护、会员专享等受限文章
+	isRestricted model.ArticleRestrictionChecker
+	// changelogRepo 可选；注入后提供更新日志订阅
+	changelogRepo repository.ChangelogRepository
 }
 
 // NewService 创建 RSS 服务
 func NewService(
 	articleSvc article_service.Service,
+	articleRepo repository.ArticleRepository,
+	commentRepo repository.CommentRepository,
+	momentRepo repository.MomentRepository,
 	settingSvc setting.SettingService,
 	cacheSvc utility.CacheService,
 ) Service {
 	return &service{
-		articleSvc: articleSvc,
-		settingSvc: settingSvc,
-		cacheSvc:   cacheSvc,
+		articleSvc:  articleSvc,
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		momentRepo:  momentRepo,
+		settingSvc:  settingSvc,
+		cacheSvc:    cacheSvc,
 	}
 }
 
+// SetRestrictionChecker 注入受限文章判断（可选），受限文章不会出现在订阅中
+func (s *service) SetRestrictionChecker(checker model.ArticleRestrictionChecker) {
+	s.isRestricted = checker
+}
+
+// maxFeedItemCount 订阅输出条目数上限
+const maxFeedItemCount = 100
+
+// feedItemCount 读取订阅条目数配置，未配置或非法时使用 20，并限制在 1-100 之间
+func (s *service) feedItemCount(key constant.SettingKey) int {
+	count, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(key.String())))
+	if err != nil || count <= 0 {
+		return 20
+	}
+	if count > maxFeedItemCount {
+		return maxFeedItemCount
+	}
+	return count
+}
+
 // rssCacheKey RSS feed 缓存键
 const rssCacheKey = "rss:feed:latest"
 
@@ -75,22 +125,32 @@ func (s *service) GenerateFeed(ctx context.Context, opts *RSSOptions) (*RSSFeed,
 
 	// 设置默认值
 	if opts.ItemCount <= 0 {
-		opts.ItemCount = 20
+		opts.ItemCount = s.feedItemCount(constant.KeyRSSItemCount)
 	}
 	if opts.BuildTime.IsZero() {
 		opts.BuildTime = time.Now()
 	}
-
-	// 获取最新的公开文章
-	options := &model.ListPublicArticlesOptions{
-		Page:     1,
-		PageSize: opts.ItemCount,
-	}
-
-	articlesResp, err := s.articleSvc.ListPublic(ctx, options)
+	fullText := s.settingSvc.GetBool(constant.KeyRSSFullText.String())
+
+	// 获取最新的公开文章；多取一些以便在排除定时发布与受限文章后仍能填满条目数
+	articles, _, err := s.articleRepo.ListPublic(ctx, &model.ListPublicArticlesOptions{
+		Page:        1,
+		PageSize:    opts.ItemCount * 2,
+		WithContent: fullText,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("获取文章列表失败: %w", err)
 	}
+	publicArticles := make([]model.ArticleResponse, 0, opts.ItemCount)
+	for _, a := range articles {
+		if len(publicArticles) >= opts.ItemCount {
+			break
+		}
+		if a.IsEmbargoed(opts.BuildTime) || (s.isRestricted != nil && s.isRestricted(ctx, a)) {
+			continue
+		}
+		publicArticles = append(publicArticles, *s.articleSvc.ToAPIResponse(a, true, fullText))
+	}
 
 	// 构建 RSS feed
 	feed := &RSSFeed{
@@ -100,15 +160,20 @@ func (s *service) GenerateFeed(ctx context.Context, opts *RSSOptions) (*RSSFeed,
 		Language:      "zh-CN",
 		PubDate:       opts.BuildTime.Format(time.RFC1123Z),
 		LastBuildDate: opts.BuildTime.Format(time.RFC1123Z),
-		Items:         make([]RSSItem, 0, len(articlesResp.List)),
+		Items:         make([]RSSItem, 0, len(publicArticles)),
 	}
 
 	// 添加文章到 feed
-	for _, article := range articlesResp.List {
+	for _, article := range publicArticles {
 		item := s.buildRSSItem(&article, opts.BaseURL)
 		feed.Items = append(feed.Items, item)
 	}
 
+	// 开启后将公开的说说与文章按发布时间混排，总数仍为 ItemCount
+	if s.settingSvc.GetBool(constant.KeyMomentsIncludeInRSS.String()) {
+		feed.Items = s.mergeMoments(ctx, publicArticles, opts)
+	}
+
 	// 缓存生成的 feed
 	if feedData, err := json.Marshal(feed); err == nil {
 		_ = s.cacheSvc.Set(ctx, rssCacheKey, string(feedData), rssCacheTTL*time.Second)
@@ -117,6 +182,49 @@ func (s *service) GenerateFeed(ctx context.Context, opts *RSSOptions) (*RSSFeed,
 	return feed, nil
 }
 
+// mergeMoments 将最新的公开说说与文章按发布时间倒序混排，取前 ItemCount 条
+func (s *service) mergeMoments(ctx context.Context, articles []model.ArticleResponse, opts *RSSOptions) []RSSItem {
+	type datedItem struct {
+		at   time.Time
+		item RSSItem
+	}
+	items := make([]datedItem, 0, len(articles)+opts.ItemCount)
+	for _, article := range articles {
+		items = append(items, datedItem{at: article.CreatedAt, item: s.buildRSSItem(&article, opts.BaseURL)})
+	}
+
+	moments, _, err := s.momentRepo.List(ctx, &model.ListMomentsOptions{Page: 1, PageSize: opts.ItemCount, PublicOnly: true})
+	if err != nil {
+		log.Printf("[RSS] 获取说说列表失败，RSS 中将不包含说说: %v", err)
+	}
+	for _, m := range moments {
+		items = append(items, datedItem{at: m.CreatedAt, item: buildMomentRSSItem(m, opts.BaseURL)})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].at.After(items[j].at) })
+	if len(items) > opts.ItemCount {
+		items = items[:opts.ItemCount]
+	}
+	result := make([]RSSItem, len(items))
+	for i, it := range items {
+		result[i] = it.item
+	}
+	return result
+}
+
+// buildMomentRSSItem 构建说说的 RSS 条目，标题取内容开头
+func buildMomentRSSItem(m *model.Moment, baseURL string) RSSItem {
+	link := baseURL + m.CommentPath()
+	plainText := strings.Join(strings.Fields(parser.StripHTML(m.ContentHTML)), " ")
+	return RSSItem{
+		Title:       "说说：" + strutil.Truncate(plainText, 30),
+		Link:        link,
+		Description: plainText,
+		PubDate:     m.CreatedAt.Format(time.RFC1123Z),
+		GUID:        link,
+	}
+}
+
 // InvalidateCache 清除 RSS 缓存
 func (s *service) InvalidateCache(ctx context.Context) error {
 	return s.cacheSvc.Delete(ctx, rssCacheKey)
@@ -139,15 +247,44 @@ func (s *service) buildRSSItem(article *model.ArticleResponse, baseURL string) R
 		categories = append(categories, tag.Name)
 	}
 
+	// 链接文章的条目链接指向外部 URL，GUID 仍使用文章永久链接（点评所在页）
+	itemLink := articleLink
+	if article.IsLinkPost {
+		itemLink = article.LinkURL
+	}
+
 	return RSSItem{
 		Title:       article.Title,
-		Link:        articleLink,
+		Link:        itemLink,
 		Description: description,
 		PubDate:     article.CreatedAt.Format(time.RFC1123Z),
 		GUID:        articleLink,
 		Author:      article.CopyrightAuthor,
 		Categories:  categories,
+		Content:     article.ContentHTML,
+		Enclosure:   buildCoverEnclosure(article.CoverURL, baseURL),
+	}
+}
+
+// buildCoverEnclosure 将封面图转换为 enclosure，相对地址补全为站点绝对地址，MIME 类型按扩展名推断
+func buildCoverEnclosure(coverURL, baseURL string) *RSSEnclosure {
+	coverURL = strings.TrimSpace(coverURL)
+	if coverURL == "" {
+		return nil
+	}
+	if strings.HasPrefix(coverURL, "//") {
+		coverURL = "https:" + coverURL
+	} else if strings.HasPrefix(coverURL, "/") {
+		coverURL = baseURL + coverURL
+	}
+
+	mimeType := "image/jpeg"
+	if u, err := url.Parse(coverURL); err == nil {
+		if t := mime.TypeByExtension(strings.ToLower(path.Ext(u.Path))); strings.HasPrefix(t, "image/") {
+			mimeType = t
+		}
 	}
+	return &RSSEnclosure{URL: coverURL, Type: mimeType}
 }
 
 // getArticleDescription 获取文章描述
@@ -215,6 +352,15 @@ func (s *service) GenerateXML(feed *RSSFeed) string {
 			sb.WriteString(fmt.Sprintf("      <category>%s</category>\n", xmlEscape(category)))
 		}
 
+		if item.Enclosure != nil {
+			// 封面大小未知，按 RSS 规范 length 填 0
+			sb.WriteString(fmt.Sprintf("      <enclosure url=\"%s\" length=\"0\" type=\"%s\"/>\n", xmlEscape(item.Enclosure.URL), item.Enclosure.Type))
+		}
+
+		if item.Content != "" {
+			sb.WriteString(fmt.Sprintf("      <content:encoded>%s</content:encoded>\n", cdata(item.Content)))
+		}
+
 		sb.WriteString("    </item>\n")
 	}
 
@@ -224,6 +370,11 @@ func (s *service) GenerateXML(feed *RSSFeed) string {
 	return sb.String()
 }
 
+// cdata 将内容包裹为 CDATA 段，内容中的 "]]>" 拆分到两个 CDATA 段中
+func cdata(s string) string {
+	return "<![CDATA[" + strings.ReplaceAll(s, "]]>", "]]]]><![CDATA[>") + "]]>"
+}
+
 // xmlEscape 转义 XML 特殊字符
 func xmlEscape(s string) string {
 	s = strings.ReplaceAll(s, "&", "&amp;")
@@ -18,6 +18,16 @@ type RSSItem struct {
 	GUID        string
 	Author      string
 	Categories  []string
+	// Content 全文 HTML，开启全文输出时通过 content:encoded 输出
+	Content string
+	// Enclosure 封面图，为空时不输出 enclosure 元素
+	Enclosure *RSSEnclosure
+}
+
+// RSSEnclosure RSS 附件（封面图）
+type RSSEnclosure struct {
+	URL  string
+	Type string
 }
 
 // RSSFeed RSS Feed 结构
@@ -33,10 +43,42 @@ type RSSFeed struct {
 
 // RSSOptions RSS 生成选项
 type RSSOptions struct {
-	// ItemCount 返回的文章数量
+	// ItemCount 返回的文章数量，为 0 时使用 rss.item_count 配置
 	ItemCount int
 	// BaseURL 站点基础 URL
 	BaseURL string
 	// BuildTime Feed 构建时间
 	BuildTime time.Time
 }
+
+// AtomEntry Atom 条目结构
+type AtomEntry struct {
+	ID        string
+	Title     string
+	Link      string
+	Author    string
+	Content   string // HTML 内容
+	Published string
+	Updated   string
+}
+
+// AtomFeed Atom Feed 结构
+type AtomFeed struct {
+	ID       string
+	Title    string
+	Subtitle string
+	Link     string // 对应的页面地址
+	SelfLink string // feed 自身地址
+	Updated  string
+	Entries  []AtomEntry
+}
+
+// CommentFeedOptions 评论 feed 生成选项
+type CommentFeedOptions struct {
+	// ItemCount 返回的评论数量，为 0 时使用 rss.comment_item_count 配置
+	ItemCount int
+	// BaseURL 站点基础 URL
+	BaseURL string
+	// Slug 文章的 abbrlink 或公共 ID，为空时生成全站评论 feed
+	Slug string
+}
@@ -0,0 +1,165 @@
+/*
+ * @Description: 启动时的搜索索引增量同步
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 10:00:00
+ * @LastEditTime: 2026-10-15 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// IndexSchemaVersion 索引结构版本
+// 索引字段或分词规则发生变化时递增，已有文档的版本戳随之失效，启动时会被全部重新索引
+const IndexSchemaVersion = 1
+
+// syncBatchSize 同步时分页读取文章的批大小
+const syncBatchSize = 200
+
+// PersistentSearcher 索引持久化在外部存储（Redis / MeiliSearch）中的搜索器
+// 实现该接口的搜索器在重启后保留已有索引，启动时只需同步发生变化的文档
+type PersistentSearcher interface {
+	model.Searcher
+	// IndexedStamps 返回所有已索引文档 ID 到其版本戳的映射
+	IndexedStamps(ctx context.Context) (map[string]string, error)
+}
+
+// ArticleSource 索引同步所需的文章数据来源，由文章仓储实现
+type ArticleSource interface {
+	List(ctx context.Context, options *model.ListArticlesOptions) ([]*model.Article, int, error)
+	GetByID(ctx context.Context, publicID string) (*model.Article, error)
+}
+
+// SyncResult 索引同步结果
+type SyncResult struct {
+	Incremental bool // 是否为增量同步（false 表示全量重建）
+	Total       int  // 文章总数
+	Indexed     int  // 成功（重新）索引的文章数
+	Skipped     int  // 版本戳未变化而跳过的文章数
+	Deleted     int  // 清理的已删除文章索引数
+	Failed      int  // 索引失败的文章数
+}
+
+// indexStamp 计算文章的索引版本戳，由索引结构版本和文章更新时间组成
+func indexStamp(article *model.Article) string {
+	return strconv.Itoa(IndexSchemaVersion) + ":" + strconv.FormatInt(article.UpdatedAt.UnixNano(), 10)
+}
+
+// SyncAllIndexes 同步所有文章的搜索索引
+// 搜索器支持持久化且已有索引时，只重新索引版本戳变化或缺失的文章并清理已删除文章；
+// 否则清空索引后全量重建
+func (s *SearchService) SyncAllIndexes(ctx context.Context, source ArticleSource) (*SyncResult, error) {
+	searcher := AppSearcher
+	if searcher == nil {
+		return nil, fmt.Errorf("搜索引擎未初始化")
+	}
+
+	var stamps map[string]string
+	if ps, ok := searcher.(PersistentSearcher); ok {
+		indexed, err := ps.IndexedStamps(ctx)
+		if err != nil {
+			log.Printf("[警告] 读取已有索引版本失败，将全量重建: %v", err)
+		} else if len(indexed) > 0 {
+			stamps = indexed
+		}
+	}
+
+	if stamps == nil {
+		if err := s.RebuildAllIndexes(ctx); err != nil {
+			return nil, err
+		}
+		return s.indexAll(ctx, searcher, source)
+	}
+	return s.syncChanged(ctx, searcher, source, stamps)
+}
+
+// indexAll 全量索引所有文章（分页携带内容读取）
+func (s *SearchService) indexAll(ctx context.Context, searcher model.Searcher, source ArticleSource) (*SyncResult, error) {
+	result := &SyncResult{}
+	for page := 1; ; page++ {
+		articles, _, err := source.List(ctx, &model.ListArticlesOptions{
+			WithContent: true,
+			Page:        page,
+			PageSize:    syncBatchSize,
+		})
+		if err != nil {
+			return result, fmt.Errorf("获取文章列表失败(page=%d): %w", page, err)
+		}
+		result.Total += len(articles)
+
+		for _, article := range articles {
+			if err := searcher.IndexArticle(ctx, article); err != nil {
+				log.Printf("为文章 %s 建立索引失败: %v", article.Title, err)
+				result.Failed++
+				continue
+			}
+			result.Indexed++
+		}
+
+		if len(articles) < syncBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// syncChanged 增量同步：列表阶段不读取内容，仅对需要更新的文章单独加载完整数据
+func (s *SearchService) syncChanged(ctx context.Context, searcher model.Searcher, source ArticleSource, stamps map[string]string) (*SyncResult, error) {
+	result := &SyncResult{Incremental: true}
+	seen := make(map[string]struct{}, len(stamps))
+
+	for page := 1; ; page++ {
+		articles, _, err := source.List(ctx, &model.ListArticlesOptions{
+			Page:     page,
+			PageSize: syncBatchSize,
+		})
+		if err != nil {
+			// 文章列表不完整时不能判断哪些索引已失效，跳过清理阶段
+			return result, fmt.Errorf("获取文章列表失败(page=%d): %w", page, err)
+		}
+		result.Total += len(articles)
+
+		for _, article := range articles {
+			seen[article.ID] = struct{}{}
+			if stamps[article.ID] == indexStamp(article) {
+				result.Skipped++
+				continue
+			}
+
+			full, err := source.GetByID(ctx, article.ID)
+			if err != nil {
+				log.Printf("加载文章 %s 失败，跳过索引: %v", article.ID, err)
+				result.Failed++
+				continue
+			}
+			if err := searcher.IndexArticle(ctx, full); err != nil {
+				log.Printf("为文章 %s 建立索引失败: %v", full.Title, err)
+				result.Failed++
+				continue
+			}
+			result.Indexed++
+		}
+
+		if len(articles) < syncBatchSize {
+			break
+		}
+	}
+
+	for id := range stamps {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		if err := searcher.DeleteArticle(ctx, id); err != nil {
+			log.Printf("清理文章 %s 的索引失败: %v", id, err)
+			continue
+		}
+		result.Deleted++
+	}
+	return result, nil
+}
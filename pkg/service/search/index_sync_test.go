@@ -0,0 +1,121 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// fakePersistentSearcher 记录索引调用的持久化搜索器
+type fakePersistentSearcher struct {
+	SimpleSearcher
+	stamps  map[string]string
+	indexed []string
+	deleted []string
+	cleared bool
+}
+
+func (f *fakePersistentSearcher) IndexArticle(ctx context.Context, article *model.Article) error {
+	f.indexed = append(f.indexed, article.ID)
+	f.stamps[article.ID] = indexStamp(article)
+	return nil
+}
+
+func (f *fakePersistentSearcher) DeleteArticle(ctx context.Context, articleID string) error {
+	f.deleted = append(f.deleted, articleID)
+	delete(f.stamps, articleID)
+	return nil
+}
+
+func (f *fakePersistentSearcher) ClearAllDocuments(ctx context.Context) error {
+	f.cleared = true
+	f.stamps = map[string]string{}
+	return nil
+}
+
+func (f *fakePersistentSearcher) IndexedStamps(ctx context.Context) (map[string]string, error) {
+	out := make(map[string]string, len(f.stamps))
+	for k, v := range f.stamps {
+		out[k] = v
+	}
+	return out, nil
+}
+
+type fakeArticleSource struct {
+	articles []*model.Article
+	gets     int
+}
+
+func (f *fakeArticleSource) List(ctx context.Context, options *model.ListArticlesOptions) ([]*model.Article, int, error) {
+	start := (options.Page - 1) * options.PageSize
+	if start >= len(f.articles) {
+		return nil, len(f.articles), nil
+	}
+	end := min(start+options.PageSize, len(f.articles))
+	return f.articles[start:end], len(f.articles), nil
+}
+
+func (f *fakeArticleSource) GetByID(ctx context.Context, publicID string) (*model.Article, error) {
+	f.gets++
+	for _, a := range f.articles {
+		if a.ID == publicID {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
+
+func withSearcher(t *testing.T, s model.Searcher) {
+	prev := AppSearcher
+	AppSearcher = s
+	t.Cleanup(func() { AppSearcher = prev })
+}
+
+func TestSyncAllIndexes_FullRebuildWhenIndexEmpty(t *testing.T) {
+	searcher := &fakePersistentSearcher{stamps: map[string]string{}}
+	withSearcher(t, searcher)
+	now := time.Now()
+	source := &fakeArticleSource{articles: []*model.Article{
+		{ID: "a", UpdatedAt: now},
+		{ID: "b", UpdatedAt: now},
+	}}
+
+	result, err := NewSearchService().SyncAllIndexes(context.Background(), source)
+	if err != nil {
+		t.Fatalf("同步失败: %v", err)
+	}
+	if result.Incremental || !searcher.cleared || result.Indexed != 2 || source.gets != 0 {
+		t.Errorf("空索引应全量重建: result=%+v cleared=%v gets=%d", result, searcher.cleared, source.gets)
+	}
+}
+
+func TestSyncAllIndexes_OnlyReindexesChangedDocuments(t *testing.T) {
+	now := time.Now()
+	unchanged := &model.Article{ID: "a", UpdatedAt: now}
+	changed := &model.Article{ID: "b", UpdatedAt: now}
+	added := &model.Article{ID: "c", UpdatedAt: now}
+
+	searcher := &fakePersistentSearcher{stamps: map[string]string{
+		"a":       indexStamp(unchanged),
+		"b":       indexStamp(&model.Article{UpdatedAt: now.Add(-time.Hour)}),
+		"removed": indexStamp(unchanged),
+	}}
+	withSearcher(t, searcher)
+	source := &fakeArticleSource{articles: []*model.Article{unchanged, changed, added}}
+
+	result, err := NewSearchService().SyncAllIndexes(context.Background(), source)
+	if err != nil {
+		t.Fatalf("同步失败: %v", err)
+	}
+	if !result.Incremental || searcher.cleared {
+		t.Fatalf("已有索引应增量同步: %+v", result)
+	}
+	if result.Skipped != 1 || result.Indexed != 2 || result.Deleted != 1 {
+		t.Errorf("同步统计错误: %+v", result)
+	}
+	if len(searcher.deleted) != 1 || searcher.deleted[0] != "removed" {
+		t.Errorf("应清理已删除文章的索引: %v", searcher.deleted)
+	}
+}
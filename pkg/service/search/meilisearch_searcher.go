@@ -41,6 +41,7 @@ type meiliDocument struct {
 	IsDoc       bool     `json:"is_doc"`
 	DocSeriesID string   `json:"doc_series_id"`
 	CreatedAt   int64    `json:"created_at"`
+	IndexStamp  string   `json:"index_stamp"`
 	// 搜索结果中的高亮字段（仅读取时存在）
 	Formatted *meiliFormattedFields `json:"_formatted,omitempty"`
 }
@@ -231,6 +232,7 @@ func (s *MeiliSearchSearcher) IndexArticle(ctx context.Context, article *model.A
 		IsDoc:       article.IsDoc,
 		DocSeriesID: docSeriesID,
 		CreatedAt:   article.CreatedAt.Unix(),
+		IndexStamp:  indexStamp(article),
 	}
 
 	pk := "id"
@@ -262,6 +264,35 @@ func (s *MeiliSearchSearcher) ClearAllDocuments(ctx context.Context) error {
 	return nil
 }
 
+// IndexedStamps 分页读取所有文档的 ID 和索引版本戳
+func (s *MeiliSearchSearcher) IndexedStamps(ctx context.Context) (map[string]string, error) {
+	const pageSize = 1000
+	stamps := make(map[string]string)
+	for offset := int64(0); ; offset += pageSize {
+		var resp meilisearch.DocumentsResult
+		err := s.index.GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{
+			Offset: offset,
+			Limit:  pageSize,
+			Fields: []string{"id", "index_stamp"},
+		}, &resp)
+		if err != nil {
+			return nil, fmt.Errorf("MeiliSearch 读取文档列表失败: %w", err)
+		}
+
+		var docs []meiliDocument
+		if err := resp.Results.DecodeInto(&docs); err != nil {
+			return nil, fmt.Errorf("MeiliSearch 解析文档列表失败: %w", err)
+		}
+		for _, doc := range docs {
+			stamps[doc.ID] = doc.IndexStamp
+		}
+
+		if len(docs) < pageSize || offset+pageSize >= resp.Total {
+			return stamps, nil
+		}
+	}
+}
+
 // HealthCheck MeiliSearch 健康检查
 func (s *MeiliSearchSearcher) HealthCheck(ctx context.Context) error {
 	health, err := s.client.Health()
@@ -394,6 +394,7 @@ func (rs *RedisSearcher) IndexArticle(ctx context.Context, article *model.Articl
 		"status":        article.Status,
 		"is_doc":        article.IsDoc,
 		"doc_series_id": docSeriesIDStr,
+		"index_stamp":   indexStamp(article),
 	}
 	if len(tags) > 0 {
 		articleData["tags"] = strings.Join(tags, ",")
@@ -530,6 +531,49 @@ func (rs *RedisSearcher) ClearAllDocuments(ctx context.Context) error {
 	return nil
 }
 
+// IndexedStamps 扫描所有已索引文章，返回文章 ID 到索引版本戳的映射
+func (rs *RedisSearcher) IndexedStamps(ctx context.Context) (map[string]string, error) {
+	stamps := make(map[string]string)
+	iter := rs.client.Scan(ctx, 0, KeyPrefixArticle+"*", 500).Iterator()
+	batch := make([]string, 0, 500)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		pipe := rs.client.Pipeline()
+		cmds := make([]*redis.StringCmd, len(batch))
+		for i, key := range batch {
+			cmds[i] = pipe.HGet(ctx, key, "index_stamp")
+		}
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return fmt.Errorf("读取索引版本戳失败: %w", err)
+		}
+		for i, key := range batch {
+			// 旧版本索引没有版本戳，记为空字符串以便重新索引
+			stamps[strings.TrimPrefix(key, KeyPrefixArticle)] = cmds[i].Val()
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == cap(batch) {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("扫描搜索索引键失败: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return stamps, nil
+}
+
 // HealthCheck 健康检查
 func (rs *RedisSearcher) HealthCheck(ctx context.Context) error {
 	return rs.client.Ping(ctx).Err()
@@ -0,0 +1,87 @@
+/*
+ * @Description: 配置安全模式 - 启动时校验关键配置，非法值回退到内置默认值
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 17:00:00
+ * @LastEditTime: 2026-10-15 17:00:00
+ * @LastEditors: 安知鱼
+ */
+package setting
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+)
+
+// InvalidSetting 描述一个在启动校验中被判定为非法、已回退到默认值的配置项
+type InvalidSetting struct {
+	Key    string `json:"key"`
+	Reason string `json:"reason"`
+}
+
+// validateSettingValue 按默认值推断配置类型并校验实际值
+// 默认值为 JSON 对象/数组的配置必须是同类型的合法 JSON（如菜单配置，非法时会导致 SSR 渲染失败）；
+// 默认值为布尔值的配置必须能解析为布尔值。空值视为合法。
+func validateSettingValue(def configdef.Definition, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	if kind := jsonKind(def.Value); kind != 0 {
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("不是合法的 JSON")
+		}
+		if jsonKind(value) != kind {
+			if kind == '{' {
+				return fmt.Errorf("应为 JSON 对象")
+			}
+			return fmt.Errorf("应为 JSON 数组")
+		}
+		return nil
+	}
+
+	if def.Value == "true" || def.Value == "false" {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("应为 true 或 false")
+		}
+	}
+	return nil
+}
+
+// jsonKind 返回合法 JSON 对象/数组的首字符（'{' 或 '['），其他情况返回 0
+func jsonKind(v string) byte {
+	v = strings.TrimSpace(v)
+	if v == "" || (v[0] != '{' && v[0] != '[') || !json.Valid([]byte(v)) {
+		return 0
+	}
+	return v[0]
+}
+
+// applySafeMode 校验缓存中的所有已定义配置，非法值替换为默认值（仅影响内存，不修改数据库，
+// 管理员在后台重新保存正确的值即可恢复），返回非法配置列表
+func applySafeMode(cache map[string]string) []InvalidSetting {
+	var invalid []InvalidSetting
+	for _, def := range configdef.AllSettings {
+		key := def.Key.String()
+		if err := validateSettingValue(def, cache[key]); err != nil {
+			invalid = append(invalid, InvalidSetting{Key: key, Reason: err.Error()})
+			cache[key] = def.Value
+		}
+	}
+
+	if len(invalid) > 0 {
+		log.Println("==================================================================")
+		log.Printf("⚠️  安全模式：检测到 %d 个非法配置，已临时回退为内置默认值：", len(invalid))
+		for _, item := range invalid {
+			log.Printf("⚠️    - %s: %s", item.Key, item.Reason)
+		}
+		log.Println("⚠️  请在后台重新保存上述配置，或通过诊断接口 /api/admin/diagnostics 查看详情")
+		log.Println("==================================================================")
+	}
+	return invalid
+}
@@ -0,0 +1,63 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+func defaultOf(key constant.SettingKey) string {
+	for _, def := range configdef.AllSettings {
+		if def.Key == key {
+			return def.Value
+		}
+	}
+	return ""
+}
+
+func TestApplySafeMode_FallsBackForInvalidValues(t *testing.T) {
+	menu := constant.KeyHeaderMenu.String()
+	musicEnable := constant.KeyMusicPlayerEnable.String()
+	siteName := constant.KeyAppName.String()
+
+	cache := map[string]string{
+		menu:        `[{"title":"文库",`,
+		musicEnable: "yes please",
+		siteName:    "{not json but plain text}",
+	}
+	invalid := applySafeMode(cache)
+
+	if len(invalid) != 2 {
+		t.Fatalf("应检测到 2 个非法配置，实际: %+v", invalid)
+	}
+	if cache[menu] != defaultOf(constant.KeyHeaderMenu) || cache[musicEnable] != defaultOf(constant.KeyMusicPlayerEnable) {
+		t.Error("非法配置应回退为默认值")
+	}
+	if cache[siteName] != "{not json but plain text}" {
+		t.Error("普通字符串配置不应被校验")
+	}
+}
+
+func TestValidateSettingValue_JSONKind(t *testing.T) {
+	def := configdef.Definition{Key: "x", Value: `[]`}
+	if err := validateSettingValue(def, `{"a":1}`); err == nil {
+		t.Error("数组类型配置填写对象时应报错")
+	}
+	if err := validateSettingValue(def, ` [1,2] `); err != nil {
+		t.Errorf("合法数组不应报错: %v", err)
+	}
+	if err := validateSettingValue(def, ""); err != nil {
+		t.Errorf("空值应视为合法: %v", err)
+	}
+}
+
+func TestApplySafeMode_DefaultsAreValid(t *testing.T) {
+	cache := make(map[string]string)
+	for _, def := range configdef.AllSettings {
+		cache[def.Key.String()] = def.Value
+	}
+	if invalid := applySafeMode(cache); len(invalid) != 0 {
+		t.Errorf("内置默认值不应被判定为非法: %+v", invalid)
+	}
+}
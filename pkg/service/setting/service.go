@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,7 +13,10 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/internal/configdef"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 )
 
 // TopicSettingUpdated 定义了配置更新事件的主题（Topic）
@@ -35,6 +39,9 @@ type SettingService interface {
 	UpdateSettings(ctx context.Context, settingsToUpdate map[string]string) error
 	RegisterPublicSettings(keys []string) // 动态注册公开配置
 	IsPublicSetting(key string) bool      // 检查配置是否为公开配置
+	IsAdminOnlySetting(key string) bool   // 检查配置是否只允许管理员修改
+	InvalidSettings() []InvalidSetting    // 启动校验时被回退为默认值的非法配置
+	SetAuditService(svc *audit.Service)   // 注入审计日志服务，记录后台修改配置的前后值
 }
 
 // settingService 是 SettingService 接口的实现
@@ -45,6 +52,11 @@ type settingService struct {
 	mu             sync.RWMutex
 	publicSetting  map[string]bool
 	eventBus       *event.EventBus
+	// siteConfigSnapshot 预先计算好的公开配置快照，公开配置变更时置空，下次读取时重建
+	siteConfigSnapshot map[string]interface{}
+	// invalidSettings 启动时校验失败、已回退为默认值的配置（安全模式）
+	invalidSettings []InvalidSetting
+	auditSvc        *audit.Service
 }
 
 // NewSettingService 是 settingService 的构造函数
@@ -80,6 +92,7 @@ func (s *settingService) LoadAllSettings(ctx context.Context) error {
 	if err != nil {
 		s.cache = newCache
 		s.configVersion = time.Now().UnixMilli()
+		s.siteConfigSnapshot = nil
 		log.Printf("⚠️ 警告: 从数据库加载配置失败: %v。服务将使用代码中定义的默认配置。", err)
 		return err
 	}
@@ -87,27 +100,44 @@ func (s *settingService) LoadAllSettings(ctx context.Context) error {
 	for _, dbSetting := range dbSettings {
 		newCache[dbSetting.ConfigKey] = dbSetting.Value
 	}
+	s.invalidSettings = applySafeMode(newCache)
 
 	s.cache = newCache
 	s.configVersion = time.Now().UnixMilli()
+	s.siteConfigSnapshot = nil
 
 	log.Printf("所有站点配置已成功加载到缓存，共 %d 项。", len(s.cache))
 	return nil
 }
 
+// SetAuditService 注入审计日志服务
+func (s *settingService) SetAuditService(svc *audit.Service) {
+	s.auditSvc = svc
+}
+
 // UpdateSettings 更新一个或多个配置项，并发布变更事件
 // 使用 Upsert 确保键即使在数据库中不存在也能正确写入，避免静默丢失
 func (s *settingService) UpdateSettings(ctx context.Context, settingsToUpdate map[string]string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	before, after := s.settingChanges(settingsToUpdate)
 	if err := s.repo.Upsert(ctx, settingsToUpdate); err != nil {
 		return err
 	}
+	if len(after) > 0 {
+		keys := make([]string, 0, len(after))
+		for key := range after {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		s.auditSvc.RecordChange(ctx, model.AuditActionSettingsUpdate, model.AuditEntitySetting, strings.Join(keys, ","), before, after)
+	}
 
 	siteConfigChanged := false
 	for key, value := range settingsToUpdate {
 		s.cache[key] = value
+		s.clearInvalidSetting(key, value)
 		if s.eventBus != nil {
 			s.eventBus.Publish(event.Topic(TopicSettingUpdated), SettingUpdatedEvent{
 				Key:   key,
@@ -120,6 +150,9 @@ func (s *settingService) UpdateSettings(ctx context.Context, settingsToUpdate ma
 	}
 
 	s.configVersion = time.Now().UnixMilli()
+	if siteConfigChanged {
+		s.siteConfigSnapshot = nil
+	}
 
 	if siteConfigChanged && s.eventBus != nil {
 		s.eventBus.Publish(event.SiteConfigUpdated, s.configVersion)
@@ -129,6 +162,97 @@ func (s *settingService) UpdateSettings(ctx context.Context, settingsToUpdate ma
 	return nil
 }
 
+// sensitiveSettingMarkers 键名包含这些片段的非公开配置视为密钥，审计日志中不记录原值
+var sensitiveSettingMarkers = []string{"secret", "smtp_pass", "api_key", "apikey", "akismet_key", "captcha_key", "token", "weather.key", "openweather.key"}
+
+// settingChanges 返回实际发生变化的配置的旧值与新值，密钥类配置以掩码代替（调用方需持有锁）
+func (s *settingService) settingChanges(settingsToUpdate map[string]string) (before, after map[string]string) {
+	before = make(map[string]string)
+	after = make(map[string]string)
+	for key, value := range settingsToUpdate {
+		old := s.cache[key]
+		if old == value {
+			continue
+		}
+		if s.isSensitiveSetting(key) {
+			old, value = maskSettingValue(old), maskSettingValue(value)
+		}
+		before[key] = old
+		after[key] = value
+	}
+	return before, after
+}
+
+func (s *settingService) isSensitiveSetting(key string) bool {
+	if s.isPublicSetting(key) {
+		return false
+	}
+	lower := strings.ToLower(key)
+	if lower == strings.ToLower(constant.KeySmtpPassword.String()) {
+		return true
+	}
+	for _, marker := range sensitiveSettingMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// adminOnlySettingPrefixes 以这些前缀开头的配置会影响登录凭据或邮件投递，只允许管理员修改
+var adminOnlySettingPrefixes = []string{"JWT_", "SMTP_", "comment.smtp_", "oauth."}
+
+// adminOnlySettings 只允许管理员修改的单个配置
+var adminOnlySettings = map[string]bool{
+	constant.KeyAdminIPAllowlist.String():       true,
+	constant.KeyOutboundAllowlist.String():      true,
+	constant.KeyLocalFileSigningSecret.String(): true,
+}
+
+// IsAdminOnlySetting 检查配置是否只允许管理员修改：签名密钥、管理接口白名单、OAuth 与 SMTP 配置以及其余密钥类配置。
+// 拥有 settings:write 权限的非管理员改动这些配置即可伪造管理员令牌或截获重置密码邮件。
+func (s *settingService) IsAdminOnlySetting(key string) bool {
+	if adminOnlySettings[key] || s.isSensitiveSetting(key) {
+		return true
+	}
+	for _, prefix := range adminOnlySettingPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskSettingValue 密钥只记录是否已设置
+func maskSettingValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "******"
+}
+
+// InvalidSettings 返回启动校验时被回退为默认值的非法配置列表
+func (s *settingService) InvalidSettings() []InvalidSetting {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]InvalidSetting(nil), s.invalidSettings...)
+}
+
+// clearInvalidSetting 管理员重新保存了合法值后，将该配置移出非法列表（调用方需持有写锁）
+func (s *settingService) clearInvalidSetting(key, value string) {
+	for i, item := range s.invalidSettings {
+		if item.Key != key {
+			continue
+		}
+		for _, def := range configdef.AllSettings {
+			if def.Key.String() == key && validateSettingValue(def, value) == nil {
+				s.invalidSettings = append(s.invalidSettings[:i:i], s.invalidSettings[i+1:]...)
+			}
+		}
+		return
+	}
+}
+
 // Get 根据键获取配置值
 func (s *settingService) Get(key string) string {
 	s.mu.RLock()
@@ -158,10 +282,21 @@ func (s *settingService) GetByKeys(keys []string) map[string]interface{} {
 	return unflatten(flatResult)
 }
 
-// GetSiteConfig 返回所有公开的站点配置（含 _config_version 供前端缓存校验）
+// GetSiteConfig 返回所有公开的站点配置（含 _config_version 供前端缓存校验）。
+// 结果是在内存中缓存的快照，公开配置变更后自动重建；调用方不应修改返回的 map。
 func (s *settingService) GetSiteConfig() map[string]interface{} {
 	s.mu.RLock()
-	defer s.mu.RUnlock()
+	snapshot := s.siteConfigSnapshot
+	s.mu.RUnlock()
+	if snapshot != nil {
+		return snapshot
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.siteConfigSnapshot != nil {
+		return s.siteConfigSnapshot
+	}
 	safeFlatConfig := make(map[string]string)
 	for key, value := range s.cache {
 		if s.isPublicSetting(key) {
@@ -170,6 +305,7 @@ func (s *settingService) GetSiteConfig() map[string]interface{} {
 	}
 	result := unflatten(safeFlatConfig)
 	result["_config_version"] = s.configVersion
+	s.siteConfigSnapshot = result
 	return result
 }
 
@@ -198,6 +334,9 @@ func (s *settingService) RegisterPublicSettings(keys []string) {
 	for _, key := range keys {
 		s.publicSetting[key] = true
 	}
+	// 公开配置的范围变了，快照与版本号都需要更新，避免客户端继续使用旧的 ETag
+	s.siteConfigSnapshot = nil
+	s.configVersion = time.Now().UnixMilli()
 	log.Printf("已注册 %d 个公开配置项", len(keys))
 }
 
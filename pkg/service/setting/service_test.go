@@ -0,0 +1,36 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+func TestSettingChanges_MasksSecretsAndSkipsUnchanged(t *testing.T) {
+	siteName := constant.KeyAppName.String()
+	smtpPass := constant.KeySmtpPassword.String()
+	jwtSecret := constant.KeyJWTSecret.String()
+	s := &settingService{
+		cache: map[string]string{
+			siteName:  "旧站点",
+			smtpPass:  "",
+			jwtSecret: "same",
+		},
+		publicSetting: map[string]bool{siteName: true},
+	}
+
+	before, after := s.settingChanges(map[string]string{
+		siteName:  "新站点",
+		smtpPass:  "p@ss",
+		jwtSecret: "same",
+	})
+	if before[siteName] != "旧站点" || after[siteName] != "新站点" {
+		t.Errorf("普通配置应记录原值: %v -> %v", before, after)
+	}
+	if before[smtpPass] != "" || after[smtpPass] != "******" {
+		t.Errorf("密钥类配置应以掩码记录: %q -> %q", before[smtpPass], after[smtpPass])
+	}
+	if _, ok := after[jwtSecret]; ok {
+		t.Error("未变化的配置不应记录")
+	}
+}
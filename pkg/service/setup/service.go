@@ -0,0 +1,312 @@
+/*
+ * @Description: 初始化向导服务 - 首次运行时引导创建管理员、配置站点地址、存储目录与邮件服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 18:00:00
+ * @LastEditTime: 2026-10-15 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package setup
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+// TokenEnv 可通过该环境变量预先指定初始化令牌，未设置时启动时随机生成并打印到日志
+const TokenEnv = "ANHEYU_SETUP_TOKEN"
+
+var (
+	// ErrSetupCompleted 初始化已完成，向导接口全部关闭
+	ErrSetupCompleted = errors.New("站点已完成初始化，初始化向导已关闭")
+	// ErrInvalidToken 初始化令牌缺失或错误
+	ErrInvalidToken = errors.New("初始化令牌无效，请查看服务启动日志获取令牌")
+	// ErrAdminRequired 完成初始化前必须先创建管理员账户
+	ErrAdminRequired = errors.New("请先创建管理员账户")
+)
+
+// State 初始化状态；完成初始化后只返回 Completed，避免向外暴露站点内部信息
+type State struct {
+	Completed      bool   `json:"completed"`
+	AdminCreated   bool   `json:"admin_created"`
+	SiteURL        string `json:"site_url,omitempty"`
+	SiteName       string `json:"site_name,omitempty"`
+	StorageRoot    string `json:"storage_root,omitempty"`
+	SMTPConfigured bool   `json:"smtp_configured"`
+}
+
+// AdminRequest 创建管理员账户的参数
+type AdminRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Nickname string `json:"nickname"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// SiteRequest 站点基础信息参数
+type SiteRequest struct {
+	SiteURL  string `json:"site_url" binding:"required"`
+	SiteName string `json:"site_name"`
+}
+
+// StorageRequest 存储目录参数
+type StorageRequest struct {
+	Root string `json:"root" binding:"required"`
+}
+
+// SMTPRequest 邮件服务配置参数，保存后向 ToEmail 发送一封测试邮件
+type SMTPRequest struct {
+	Host        string `json:"host" binding:"required"`
+	Port        int    `json:"port" binding:"required,min=1,max=65535"`
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	SenderName  string `json:"sender_name"`
+	SenderEmail string `json:"sender_email" binding:"required,email"`
+	ForceSSL    bool   `json:"force_ssl"`
+	ToEmail     string `json:"to_email" binding:"required,email"`
+}
+
+// Service 初始化向导服务
+type Service struct {
+	settingSvc       setting.SettingService
+	userRepo         repository.UserRepository
+	authSvc          auth.AuthService
+	storagePolicySvc volume.IStoragePolicyService
+	emailSvc         utility.EmailService
+
+	// mu 串行化所有向导步骤，避免并发请求同时创建管理员
+	mu    sync.Mutex
+	token string
+}
+
+// NewService 创建初始化向导服务
+func NewService(
+	settingSvc setting.SettingService,
+	userRepo repository.UserRepository,
+	authSvc auth.AuthService,
+	storagePolicySvc volume.IStoragePolicyService,
+	emailSvc utility.EmailService,
+) *Service {
+	return &Service{
+		settingSvc:       settingSvc,
+		userRepo:         userRepo,
+		authSvc:          authSvc,
+		storagePolicySvc: storagePolicySvc,
+		emailSvc:         emailSvc,
+	}
+}
+
+// Init 在启动时调用：已有用户的旧站点直接标记为已初始化；
+// 否则准备初始化令牌并打印到日志，只有持有令牌的人才能调用向导接口
+func (s *Service) Init(ctx context.Context) error {
+	if s.completed() {
+		return nil
+	}
+
+	count, err := s.userRepo.Count(ctx)
+	if err != nil {
+		return fmt.Errorf("获取用户总数失败: %w", err)
+	}
+	if count > 0 {
+		log.Println("检测到已有用户，自动标记站点为已完成初始化。")
+		return s.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeySetupCompleted.String(): "true"})
+	}
+
+	token := strings.TrimSpace(os.Getenv(TokenEnv))
+	if token == "" {
+		if token, err = utils.GenerateRandomString(32); err != nil {
+			return fmt.Errorf("生成初始化令牌失败: %w", err)
+		}
+	}
+	s.mu.Lock()
+	s.token = token
+	s.mu.Unlock()
+
+	log.Println("==================================================================")
+	log.Println("🚀 站点尚未初始化，请在浏览器中打开初始化向导完成配置。")
+	if os.Getenv(TokenEnv) != "" {
+		log.Printf("🔑 初始化令牌已通过环境变量 %s 指定。", TokenEnv)
+	} else {
+		log.Printf("🔑 初始化令牌: %s", token)
+	}
+	log.Println("==================================================================")
+	return nil
+}
+
+// State 返回当前初始化状态
+func (s *Service) State(ctx context.Context) (*State, error) {
+	if s.completed() {
+		return &State{Completed: true, AdminCreated: true}, nil
+	}
+
+	count, err := s.userRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户总数失败: %w", err)
+	}
+	state := &State{
+		AdminCreated:   count > 0,
+		SiteURL:        s.settingSvc.Get(constant.KeySiteURL.String()),
+		SiteName:       s.settingSvc.Get(constant.KeyAppName.String()),
+		SMTPConfigured: s.settingSvc.Get(constant.KeySmtpPassword.String()) != "",
+	}
+	if policies, err := s.storagePolicySvc.ListAll(ctx); err == nil {
+		for _, p := range policies {
+			if p.VirtualPath == "/" {
+				state.StorageRoot = p.BasePath
+				break
+			}
+		}
+	}
+	return state, nil
+}
+
+// Authorize 校验向导是否仍开放以及令牌是否正确
+func (s *Service) Authorize(token string) error {
+	if s.completed() {
+		return ErrSetupCompleted
+	}
+	s.mu.Lock()
+	expected := s.token
+	s.mu.Unlock()
+	if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// CreateAdmin 创建管理员账户（仅当系统中还没有任何用户时）
+func (s *Service) CreateAdmin(ctx context.Context, req *AdminRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completed() {
+		return ErrSetupCompleted
+	}
+	return s.authSvc.RegisterInitialAdmin(ctx, req.Email, req.Nickname, req.Password)
+}
+
+// ConfigureSite 保存站点地址与名称
+func (s *Service) ConfigureSite(ctx context.Context, req *SiteRequest) error {
+	siteURL := strings.TrimRight(strings.TrimSpace(req.SiteURL), "/")
+	u, err := url.Parse(siteURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: 站点地址必须是完整的 http(s) 地址", constant.ErrBadRequest)
+	}
+
+	values := map[string]string{constant.KeySiteURL.String(): siteURL}
+	if name := strings.TrimSpace(req.SiteName); name != "" {
+		values[constant.KeyAppName.String()] = name
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completed() {
+		return ErrSetupCompleted
+	}
+	return s.settingSvc.UpdateSettings(ctx, values)
+}
+
+// ConfigureStorage 设置本机存储根目录：创建目录并确认可写后更新根目录存储策略
+func (s *Service) ConfigureStorage(ctx context.Context, req *StorageRequest) (string, error) {
+	root := filepath.Clean(strings.TrimSpace(req.Root))
+	if !filepath.IsAbs(root) {
+		return "", fmt.Errorf("%w: 存储目录必须是绝对路径", constant.ErrBadRequest)
+	}
+	if err := ensureWritableDir(root); err != nil {
+		return "", fmt.Errorf("%w: %v", constant.ErrBadRequest, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completed() {
+		return "", ErrSetupCompleted
+	}
+	policy, err := s.storagePolicySvc.UpdateRootBasePath(ctx, root)
+	if err != nil {
+		return "", err
+	}
+	return policy.BasePath, nil
+}
+
+// TestSMTP 保存邮件服务配置并发送测试邮件
+func (s *Service) TestSMTP(ctx context.Context, req *SMTPRequest) error {
+	values := map[string]string{
+		constant.KeySmtpHost.String():        strings.TrimSpace(req.Host),
+		constant.KeySmtpPort.String():        strconv.Itoa(req.Port),
+		constant.KeySmtpUsername.String():    strings.TrimSpace(req.Username),
+		constant.KeySmtpPassword.String():    req.Password,
+		constant.KeySmtpSenderEmail.String(): strings.TrimSpace(req.SenderEmail),
+		constant.KeySmtpForceSSL.String():    strconv.FormatBool(req.ForceSSL),
+	}
+	if name := strings.TrimSpace(req.SenderName); name != "" {
+		values[constant.KeySmtpSenderName.String()] = name
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completed() {
+		return ErrSetupCompleted
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, values); err != nil {
+		return fmt.Errorf("保存邮件配置失败: %w", err)
+	}
+	if err := s.emailSvc.SendTestEmail(ctx, req.ToEmail); err != nil {
+		return fmt.Errorf("邮件配置已保存，但测试邮件发送失败: %w", err)
+	}
+	return nil
+}
+
+// Complete 结束初始化，之后所有向导接口关闭；要求管理员账户已创建
+func (s *Service) Complete(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completed() {
+		return ErrSetupCompleted
+	}
+
+	count, err := s.userRepo.Count(ctx)
+	if err != nil {
+		return fmt.Errorf("获取用户总数失败: %w", err)
+	}
+	if count == 0 {
+		return ErrAdminRequired
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, map[string]string{constant.KeySetupCompleted.String(): "true"}); err != nil {
+		return err
+	}
+	s.token = ""
+	log.Println("✅ 站点初始化已完成，初始化向导已关闭。")
+	return nil
+}
+
+func (s *Service) completed() bool {
+	return s.settingSvc.GetBool(constant.KeySetupCompleted.String())
+}
+
+// ensureWritableDir 创建目录并写入临时文件确认可写
+func ensureWritableDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("创建存储目录失败: %v", err)
+	}
+	f, err := os.CreateTemp(dir, ".anheyu-write-test-*")
+	if err != nil {
+		return fmt.Errorf("存储目录不可写: %v", err)
+	}
+	name := f.Name()
+	f.Close()
+	return os.Remove(name)
+}
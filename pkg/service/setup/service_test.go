@@ -0,0 +1,99 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func (f *fakeSettings) GetBool(key string) bool { return f.values[key] == "true" }
+
+func (f *fakeSettings) UpdateSettings(ctx context.Context, values map[string]string) error {
+	for k, v := range values {
+		f.values[k] = v
+	}
+	return nil
+}
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	count int64
+}
+
+func (f *fakeUserRepo) Count(ctx context.Context) (int64, error) { return f.count, nil }
+
+func newTestService(users int64) (*Service, *fakeSettings, *fakeUserRepo) {
+	settings := &fakeSettings{values: map[string]string{}}
+	userRepo := &fakeUserRepo{count: users}
+	return NewService(settings, userRepo, nil, nil, nil), settings, userRepo
+}
+
+func TestInit_MarksExistingSiteCompleted(t *testing.T) {
+	svc, settings, _ := newTestService(3)
+	if err := svc.Init(context.Background()); err != nil {
+		t.Fatalf("初始化失败: %v", err)
+	}
+	if settings.values[constant.KeySetupCompleted.String()] != "true" {
+		t.Error("已有用户的站点应自动标记为已初始化")
+	}
+	if err := svc.Authorize(""); !errors.Is(err, ErrSetupCompleted) {
+		t.Errorf("已初始化时应拒绝向导请求: %v", err)
+	}
+}
+
+func TestAuthorize_RequiresToken(t *testing.T) {
+	t.Setenv(TokenEnv, "secret-token")
+	svc, _, _ := newTestService(0)
+	if err := svc.Init(context.Background()); err != nil {
+		t.Fatalf("初始化失败: %v", err)
+	}
+
+	if err := svc.Authorize("wrong"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("错误令牌应被拒绝: %v", err)
+	}
+	if err := svc.Authorize("secret-token"); err != nil {
+		t.Errorf("正确令牌应通过: %v", err)
+	}
+}
+
+func TestConfigureSite_ValidatesURL(t *testing.T) {
+	svc, settings, _ := newTestService(0)
+	ctx := context.Background()
+
+	if err := svc.ConfigureSite(ctx, &SiteRequest{SiteURL: "example.com"}); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("缺少协议的地址应报错: %v", err)
+	}
+	if err := svc.ConfigureSite(ctx, &SiteRequest{SiteURL: "https://blog.example.com/", SiteName: "我的博客"}); err != nil {
+		t.Fatalf("保存站点信息失败: %v", err)
+	}
+	if settings.values[constant.KeySiteURL.String()] != "https://blog.example.com" || settings.values[constant.KeyAppName.String()] != "我的博客" {
+		t.Errorf("站点信息保存错误: %v", settings.values)
+	}
+}
+
+func TestComplete_RequiresAdminAndClosesWizard(t *testing.T) {
+	svc, _, userRepo := newTestService(0)
+	ctx := context.Background()
+
+	if err := svc.Complete(ctx); !errors.Is(err, ErrAdminRequired) {
+		t.Errorf("未创建管理员时应拒绝完成: %v", err)
+	}
+	userRepo.count = 1
+	if err := svc.Complete(ctx); err != nil {
+		t.Fatalf("完成初始化失败: %v", err)
+	}
+	if err := svc.ConfigureSite(ctx, &SiteRequest{SiteURL: "https://example.com"}); !errors.Is(err, ErrSetupCompleted) {
+		t.Errorf("完成后向导接口应关闭: %v", err)
+	}
+}
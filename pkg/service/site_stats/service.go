@@ -0,0 +1,154 @@
+/*
+ * @Description: 站点统计快照服务，为侧边栏提供文章数、字数与评论数
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 16:00:00
+ * @LastEditTime: 2026-10-17 16:00:00
+ * @LastEditors: 安知鱼
+ */
+package site_stats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	snapshotCacheKey = "anheyu:site_stats:snapshot"
+	// snapshotCacheTTL 快照由定时任务每 10 分钟刷新，缓存时间只需覆盖几个刷新周期
+	snapshotCacheTTL = time.Hour
+	// counterDisabledSetting 侧边栏计数配置为 -1 表示站长不希望公开该数据
+	counterDisabledSetting = "-1"
+)
+
+// Snapshot 站点统计快照
+type Snapshot struct {
+	TotalPosts    int       `json:"total_posts"`
+	TotalWords    int       `json:"total_words"`
+	TotalComments int       `json:"total_comments"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Counters 对外公开的站点统计，被站长禁用的项为 nil
+type Counters struct {
+	TotalPosts    *int      `json:"total_posts,omitempty"`
+	TotalWords    *int      `json:"total_words,omitempty"`
+	TotalComments *int      `json:"total_comments,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Service 站点统计快照服务。快照由定时任务定期刷新，文章或评论变化时也会立即在后台刷新，
+// 读取时只访问缓存，不会在每次请求中聚合数据库。
+type Service struct {
+	articleRepo repository.ArticleRepository
+	commentRepo repository.CommentRepository
+	cacheSvc    utility.CacheService
+	settingSvc  setting.SettingService
+
+	refreshPending atomic.Bool // 已有刷新任务在等待执行，期间的事件会被合并
+}
+
+// NewService 创建站点统计快照服务
+func NewService(articleRepo repository.ArticleRepository, commentRepo repository.CommentRepository, cacheSvc utility.CacheService, settingSvc setting.SettingService) *Service {
+	return &Service{
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		cacheSvc:    cacheSvc,
+		settingSvc:  settingSvc,
+	}
+}
+
+// Subscribe 订阅文章与评论事件，数据变化时立即在后台刷新快照
+func (s *Service) Subscribe(bus *event.EventBus) {
+	onChange := func(interface{}) { s.RefreshAsync() }
+	bus.Subscribe(event.ArticleCreated, onChange)
+	bus.Subscribe(event.ArticleUpdated, onChange)
+	bus.Subscribe(event.ArticleDeleted, onChange)
+	bus.Subscribe(event.CommentCountChanged, onChange)
+}
+
+// Refresh 重新聚合站点统计并写入缓存
+func (s *Service) Refresh(ctx context.Context) (*Snapshot, error) {
+	stats, err := s.articleRepo.GetSiteStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取文章统计失败: %w", err)
+	}
+	comments, err := s.commentRepo.CountPublished(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取评论统计失败: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		TotalPosts:    stats.TotalPosts,
+		TotalWords:    stats.TotalWords,
+		TotalComments: comments,
+		UpdatedAt:     time.Now(),
+	}
+	if data, err := json.Marshal(snapshot); err == nil {
+		if err := s.cacheSvc.Set(ctx, snapshotCacheKey, string(data), snapshotCacheTTL); err != nil {
+			log.Printf("[SiteStats] 缓存站点统计快照失败: %v", err)
+		}
+	}
+	return snapshot, nil
+}
+
+// RefreshAsync 在后台刷新快照，短时间内的多次调用只会触发一次刷新
+func (s *Service) RefreshAsync() {
+	if !s.refreshPending.CompareAndSwap(false, true) {
+		return
+	}
+	submitted := workerpool.Go(workerpool.CategoryCache, func() {
+		// 先清除标记再刷新，刷新期间到达的事件会再触发一次，保证快照不会遗漏变化
+		s.refreshPending.Store(false)
+		if _, err := s.Refresh(context.Background()); err != nil {
+			log.Printf("[SiteStats] 刷新站点统计快照失败: %v", err)
+		}
+	})
+	if !submitted {
+		s.refreshPending.Store(false)
+	}
+}
+
+// Get 获取站点统计快照，缓存中没有快照时立即聚合一次
+func (s *Service) Get(ctx context.Context) (*Snapshot, error) {
+	if cached, err := s.cacheSvc.Get(ctx, snapshotCacheKey); err == nil && cached != "" {
+		var snapshot Snapshot
+		if json.Unmarshal([]byte(cached), &snapshot) == nil {
+			return &snapshot, nil
+		}
+	}
+	return s.Refresh(ctx)
+}
+
+// GetCounters 获取对外公开的站点统计，侧边栏配置为 -1 的项不返回
+func (s *Service) GetCounters(ctx context.Context) (*Counters, error) {
+	snapshot, err := s.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counters := &Counters{UpdatedAt: snapshot.UpdatedAt}
+	if s.visible(constant.KeySidebarSiteInfoTotalPostCount) {
+		counters.TotalPosts = &snapshot.TotalPosts
+	}
+	if s.visible(constant.KeySidebarSiteInfoTotalWordCount) {
+		counters.TotalWords = &snapshot.TotalWords
+	}
+	if s.visible(constant.KeySidebarSiteInfoTotalCommentCount) {
+		counters.TotalComments = &snapshot.TotalComments
+	}
+	return counters, nil
+}
+
+func (s *Service) visible(key constant.SettingKey) bool {
+	return s.settingSvc.Get(key.String()) != counterDisabledSetting
+}
@@ -0,0 +1,88 @@
+package site_stats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+type fakeArticleRepo struct {
+	repository.ArticleRepository
+	stats model.SiteStats
+	calls int
+}
+
+func (f *fakeArticleRepo) GetSiteStats(ctx context.Context) (*model.SiteStats, error) {
+	f.calls++
+	stats := f.stats
+	return &stats, nil
+}
+
+type fakeCommentRepo struct {
+	repository.CommentRepository
+	count int
+}
+
+func (f *fakeCommentRepo) CountPublished(ctx context.Context) (int, error) { return f.count, nil }
+
+func TestGetUsesCachedSnapshot(t *testing.T) {
+	ctx := context.Background()
+	articles := &fakeArticleRepo{stats: model.SiteStats{TotalPosts: 3, TotalWords: 1200}}
+	comments := &fakeCommentRepo{count: 7}
+	s := NewService(articles, comments, utility.NewMemoryCacheService(), &fakeSettings{})
+
+	first, err := s.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.TotalPosts != 3 || first.TotalWords != 1200 || first.TotalComments != 7 || first.UpdatedAt.IsZero() {
+		t.Fatalf("快照内容不正确: %+v", first)
+	}
+
+	// 数据变化后，未刷新前仍返回缓存中的快照
+	articles.stats.TotalPosts = 4
+	if second, _ := s.Get(ctx); second.TotalPosts != 3 || articles.calls != 1 {
+		t.Errorf("应直接返回缓存的快照: %+v, 聚合 %d 次", second, articles.calls)
+	}
+
+	if _, err := s.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if third, _ := s.Get(ctx); third.TotalPosts != 4 {
+		t.Errorf("刷新后应返回新的快照: %+v", third)
+	}
+}
+
+func TestGetCountersHidesDisabledItems(t *testing.T) {
+	settings := &fakeSettings{values: map[string]string{
+		constant.KeySidebarSiteInfoTotalWordCount.String(): "-1",
+	}}
+	s := NewService(&fakeArticleRepo{stats: model.SiteStats{TotalPosts: 2, TotalWords: 500}},
+		&fakeCommentRepo{count: 9}, utility.NewMemoryCacheService(), settings)
+
+	counters, err := s.GetCounters(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counters.TotalPosts == nil || *counters.TotalPosts != 2 {
+		t.Errorf("文章数应公开: %+v", counters)
+	}
+	if counters.TotalWords != nil {
+		t.Errorf("配置为 -1 的字数不应公开: %d", *counters.TotalWords)
+	}
+	if counters.TotalComments == nil || *counters.TotalComments != 9 {
+		t.Errorf("评论数应公开: %+v", counters)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * @Description: 站点地图更新通知
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 10:00:00
+ * @LastEditTime: 2026-10-16 10:00:00
+ * @LastEditors: 安知鱼
+ */
+package sitemap
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+// pingTimeout 单个提交地址的请求超时
+const pingTimeout = 5 * time.Second
+
+// pingPlaceholder 提交地址中站点地图 URL 的占位符
+const pingPlaceholder = "{sitemap}"
+
+// Ping 依次请求 sitemap.ping_urls 中配置的地址，失败只记录日志
+func (s *service) Ping(ctx context.Context) {
+	endpoints := pingEndpoints(s.settingSvc.Get(constant.KeySitemapPingURLs.String()), s.getBaseURL()+"/sitemap.xml")
+	for _, endpoint := range endpoints {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			log.Printf("[sitemap] 提交地址无效 %s: %v", endpoint, err)
+			continue
+		}
+		resp, err := s.httpClient.Do(req)
+		if err != nil {
+			log.Printf("[sitemap] 通知 %s 失败: %v", endpoint, err)
+			continue
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			log.Printf("[sitemap] 通知 %s 返回状态码 %d", endpoint, resp.StatusCode)
+			continue
+		}
+		log.Printf("[sitemap] 已通知 %s", endpoint)
+	}
+}
+
+// pingEndpoints 解析逗号分隔的提交地址，并把占位符替换为编码后的站点地图 URL
+func pingEndpoints(raw, sitemapURL string) []string {
+	var endpoints []string
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if !strings.HasPrefix(item, "http://") && !strings.HasPrefix(item, "https://") {
+			continue
+		}
+		endpoints = append(endpoints, strings.ReplaceAll(item, pingPlaceholder, url.QueryEscape(sitemapURL)))
+	}
+	return endpoints
+}
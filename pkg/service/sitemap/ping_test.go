@@ -0,0 +1,36 @@
+package sitemap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func TestPingReplacesSitemapPlaceholder(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Query().Get("sitemap"))
+	}))
+	defer srv.Close()
+
+	svc := NewService(nil, nil, nil, &fakeSettings{values: map[string]string{
+		constant.KeySiteURL.String():         "https://example.com/",
+		constant.KeySitemapPingURLs.String(): srv.URL + "/ping?sitemap={sitemap}, not-a-url ,",
+	}})
+	svc.Ping(context.Background())
+
+	if len(got) != 1 || got[0] != "https://example.com/sitemap.xml" {
+		t.Fatalf("应只通知一个有效地址并带上站点地图 URL, got %v", got)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -26,6 +27,10 @@ type Service interface {
 	GenerateSitemap(ctx context.Context) (*URLSet, error)
 	// GenerateRobots 生成robots.txt
 	GenerateRobots(ctx context.Context) (string, error)
+	// Ping 通知配置的站点地图提交地址站点地图已更新
+	Ping(ctx context.Context)
+	// SetRestrictionChecker 注入受限文章判断（可选），受限文章不会出现在站点地图中
+	SetRestrictionChecker(checker model.ArticleRestrictionChecker)
 }
 
 // service 站点地图服务实现
@@ -34,6 +39,9 @@ type service struct {
 	pageRepo    repository.PageRepository
 	linkRepo    repository.LinkRepository
 	settingSvc  setting.SettingService
+	httpClient  *http.Client
+	// isRestricted 可选；PRO版注入，用于排除密码保护、会员专享等受限文章
+	isRestricted model.ArticleRestrictionChecker
 }
 
 // NewService 创建站点地图服务
@@ -48,9 +56,15 @@ func NewService(
 		pageRepo:    pageRepo,
 		linkRepo:    linkRepo,
 		settingSvc:  settingSvc,
+		httpClient:  &http.Client{Timeout: pingTimeout},
 	}
 }
 
+// SetRestrictionChecker 注入受限文章判断（可选），受限文章不会出现在站点地图中
+func (s *service) SetRestrictionChecker(checker model.ArticleRestrictionChecker) {
+	s.isRestricted = checker
+}
+
 // defaultBaseURL 当 SITE_URL 未配置时使用的 fallback，与 GenerateRobots 保持一致
 const defaultBaseURL = "https://blog.anheyu.com"
 
@@ -123,7 +137,12 @@ func (s *service) addArticles(ctx context.Context, baseURL string, items *[]Site
 
 	log.Printf("[DEBUG] 找到 %d 篇已发布文章用于生成站点地图", len(articles))
 
+	now := time.Now()
 	for _, article := range articles {
+		// 跳过定时发布未到、已下架、未通过审核及受限的文章
+		if article.IsEmbargoed(now) || (s.isRestricted != nil && s.isRestricted(ctx, article)) {
+			continue
+		}
 		log.Printf("[DEBUG] 处理文章: ID=%s, Title=%s, Abbrlink=%s", article.ID, article.Title, article.Abbrlink)
 
 		// 根据文章更新时间确定优先级和更新频率
@@ -0,0 +1,41 @@
+package sitemap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakeArticleRepo struct {
+	repository.ArticleRepository
+	articles []*model.Article
+}
+
+func (f *fakeArticleRepo) List(ctx context.Context, options *model.ListArticlesOptions) ([]*model.Article, int, error) {
+	return f.articles, len(f.articles), nil
+}
+
+func TestAddArticlesSkipsEmbargoedAndRestricted(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Hour)
+	repo := &fakeArticleRepo{articles: []*model.Article{
+		{ID: "a", Abbrlink: "public", Status: "PUBLISHED", CreatedAt: now.Add(-time.Hour), UpdatedAt: now},
+		{ID: "b", Status: "PUBLISHED", ScheduledAt: &future, UpdatedAt: now},
+		{ID: "c", Status: "PUBLISHED", IsTakedown: true, UpdatedAt: now},
+		{ID: "d", Status: "PUBLISHED", ReviewStatus: "PENDING", UpdatedAt: now},
+		{ID: "e", Abbrlink: "members", Status: "PUBLISHED", UpdatedAt: now},
+	}}
+	svc := NewService(repo, nil, nil, &fakeSettings{values: map[string]string{}})
+	svc.SetRestrictionChecker(func(ctx context.Context, a *model.Article) bool { return a.Abbrlink == "members" })
+
+	var items []SitemapItem
+	if err := svc.(*service).addArticles(context.Background(), "https://example.com", &items); err != nil {
+		t.Fatalf("生成失败: %v", err)
+	}
+	if len(items) != 1 || items[0].URL != "https://example.com/posts/public" {
+		t.Fatalf("站点地图只应包含公开文章: %+v", items)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+ * @Description: hCaptcha 与 Google reCAPTCHA 人机验证服务，两者使用相同的 siteverify 校验协议
+ * @Author: 安知鱼
+ * @Date: 2026-10-18 00:00:00
+ * @LastEditTime: 2026-10-18 00:00:00
+ * @LastEditors: 安知鱼
+ */
+package siteverify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// HCaptchaVerifyURL hCaptcha 验证 API 地址
+	HCaptchaVerifyURL = "https://api.hcaptcha.com/siteverify"
+	// RecaptchaVerifyURL Google reCAPTCHA 验证 API 地址
+	RecaptchaVerifyURL = "https://www.google.com/recaptcha/api/siteverify"
+
+	// defaultRecaptchaMinScore reCAPTCHA v3 最低得分的默认值
+	defaultRecaptchaMinScore = 0.5
+)
+
+// Verifier 校验前端人机验证组件返回的 token
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// verifyResponse siteverify 接口的响应，score 仅 reCAPTCHA v3 返回
+type verifyResponse struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score,omitempty"`
+	Hostname   string   `json:"hostname,omitempty"`
+	ErrorCodes []string `json:"error-codes,omitempty"`
+}
+
+// verifier 是 Verifier 的实现
+type verifier struct {
+	name       string
+	verifyURL  string
+	secretKey  constant.SettingKey
+	checkScore bool
+	settingSvc setting.SettingService
+	httpClient *http.Client
+}
+
+// NewHCaptchaVerifier 创建 hCaptcha 验证服务
+func NewHCaptchaVerifier(settingSvc setting.SettingService) Verifier {
+	return &verifier{
+		name:       "hCaptcha",
+		verifyURL:  HCaptchaVerifyURL,
+		secretKey:  constant.KeyHCaptchaSecretKey,
+		settingSvc: settingSvc,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// NewRecaptchaVerifier 创建 Google reCAPTCHA 验证服务，同时支持 v2 与 v3
+func NewRecaptchaVerifier(settingSvc setting.SettingService) Verifier {
+	return &verifier{
+		name:       "reCAPTCHA",
+		verifyURL:  RecaptchaVerifyURL,
+		secretKey:  constant.KeyRecaptchaSecretKey,
+		checkScore: true,
+		settingSvc: settingSvc,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Verify 向验证服务提交 token 并检查结果
+func (v *verifier) Verify(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return errors.New("请完成人机验证")
+	}
+	secret := v.settingSvc.Get(v.secretKey.String())
+	if secret == "" {
+		return fmt.Errorf("%s 配置错误：缺少 Secret Key", v.name)
+	}
+
+	form := url.Values{"secret": {secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.New("人机验证请求创建失败")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return errors.New("人机验证服务暂时不可用，请稍后重试")
+	}
+	defer resp.Body.Close()
+
+	var result verifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return errors.New("人机验证响应解析失败")
+	}
+	if !result.Success {
+		return v.errorFromCodes(result.ErrorCodes)
+	}
+	if v.checkScore && result.Score != nil && *result.Score < v.minScore() {
+		return errors.New("人机验证未通过，请稍后重试")
+	}
+	return nil
+}
+
+// minScore 读取 reCAPTCHA v3 的最低得分，无效时使用默认值
+func (v *verifier) minScore() float64 {
+	score, err := strconv.ParseFloat(strings.TrimSpace(v.settingSvc.Get(constant.KeyRecaptchaMinScore.String())), 64)
+	if err != nil || score < 0 || score > 1 {
+		return defaultRecaptchaMinScore
+	}
+	return score
+}
+
+// errorFromCodes 将 siteverify 的错误码转换为提示信息，两家服务的常见错误码一致
+func (v *verifier) errorFromCodes(codes []string) error {
+	if len(codes) == 0 {
+		return errors.New("人机验证失败，请重试")
+	}
+	switch codes[0] {
+	case "missing-input-secret":
+		return fmt.Errorf("%s 配置错误：缺少 Secret Key", v.name)
+	case "invalid-input-secret":
+		return fmt.Errorf("%s 配置错误：Secret Key 无效", v.name)
+	case "missing-input-response":
+		return errors.New("请完成人机验证")
+	case "invalid-input-response", "invalid-or-already-seen-response":
+		return errors.New("人机验证失败，请刷新页面后重试")
+	case "timeout-or-duplicate":
+		return errors.New("人机验证已过期，请刷新页面后重试")
+	case "bad-request":
+		return errors.New("人机验证请求格式错误")
+	default:
+		return errors.New("人机验证失败，请重试")
+	}
+}
@@ -0,0 +1,81 @@
+package siteverify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func newTestVerifier(t *testing.T, newFn func(setting.SettingService) Verifier, values map[string]string, body string) *verifier {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("secret") != "secret" || r.PostForm.Get("remoteip") != "203.0.113.7" {
+			t.Errorf("提交的表单不正确: %v", r.PostForm)
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	v := newFn(&fakeSettings{values: values}).(*verifier)
+	v.verifyURL = server.URL
+	return v
+}
+
+func TestHCaptchaVerify(t *testing.T) {
+	values := map[string]string{constant.KeyHCaptchaSecretKey.String(): "secret"}
+
+	v := newTestVerifier(t, NewHCaptchaVerifier, values, `{"success":true}`)
+	if err := v.Verify(context.Background(), "token", "203.0.113.7"); err != nil {
+		t.Errorf("验证通过时不应报错: %v", err)
+	}
+
+	v = newTestVerifier(t, NewHCaptchaVerifier, values, `{"success":false,"error-codes":["invalid-input-secret"]}`)
+	if err := v.Verify(context.Background(), "token", "203.0.113.7"); err == nil || !strings.Contains(err.Error(), "hCaptcha") {
+		t.Errorf("应返回 hCaptcha 配置错误，得到 %v", err)
+	}
+
+	if err := v.Verify(context.Background(), "", "203.0.113.7"); err == nil {
+		t.Error("缺少 token 时应报错")
+	}
+}
+
+func TestRecaptchaScore(t *testing.T) {
+	values := map[string]string{
+		constant.KeyRecaptchaSecretKey.String(): "secret",
+		constant.KeyRecaptchaMinScore.String():  "0.7",
+	}
+
+	v := newTestVerifier(t, NewRecaptchaVerifier, values, `{"success":true,"score":0.6}`)
+	if err := v.Verify(context.Background(), "token", "203.0.113.7"); err == nil {
+		t.Error("得分低于下限时应拒绝")
+	}
+
+	v = newTestVerifier(t, NewRecaptchaVerifier, values, `{"success":true,"score":0.9}`)
+	if err := v.Verify(context.Background(), "token", "203.0.113.7"); err != nil {
+		t.Errorf("得分达标时不应报错: %v", err)
+	}
+
+	v = newTestVerifier(t, NewRecaptchaVerifier, values, `{"success":true}`)
+	if err := v.Verify(context.Background(), "token", "203.0.113.7"); err != nil {
+		t.Errorf("v2 不返回得分时不应检查得分: %v", err)
+	}
+}
+
+func TestMissingSecret(t *testing.T) {
+	v := NewRecaptchaVerifier(&fakeSettings{values: map[string]string{}})
+	if err := v.Verify(context.Background(), "token", ""); err == nil || !strings.Contains(err.Error(), "Secret Key") {
+		t.Errorf("未配置 Secret Key 时应报错，得到 %v", err)
+	}
+}
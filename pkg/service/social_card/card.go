@@ -0,0 +1,78 @@
+/*
+ * @Description: 文章社交分享卡片（Open Graph / Twitter Card）
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 18:00:00
+ * @LastEditTime: 2026-10-17 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package social_card
+
+import (
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/parser"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/strutil"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// Twitter 卡片类型
+const (
+	TwitterCardSummary      = "summary"
+	TwitterCardSummaryLarge = "summary_large_image"
+)
+
+// descriptionMaxLength 从正文截取描述时的最大长度
+const descriptionMaxLength = 150
+
+// Card 社交平台抓取页面时看到的分享卡片
+type Card struct {
+	URL         string `json:"url,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Image       string `json:"image"`
+	TwitterCard string `json:"twitter_card"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+// ArticleDescription 返回文章页面的默认描述：优先使用第一条摘要，否则截取正文纯文本
+func ArticleDescription(summaries []string, contentHTML string) string {
+	if len(summaries) > 0 && summaries[0] != "" {
+		return summaries[0]
+	}
+	plainText := parser.StripHTML(contentHTML)
+	plainText = strings.Join(strings.Fields(plainText), " ")
+	return strutil.Truncate(plainText, descriptionMaxLength)
+}
+
+// ApplyOverrides 用文章的分享卡片覆盖配置替换默认的标题、描述与图片，
+// 未指定 Twitter 卡片类型时，有图片使用大图卡片，否则使用摘要卡片
+func ApplyOverrides(cfg *model.ArticleExtraConfig, card Card) Card {
+	if cfg != nil {
+		if v := trimmed(cfg.OGTitle); v != "" {
+			card.Title = v
+		}
+		if v := trimmed(cfg.OGDescription); v != "" {
+			card.Description = v
+		}
+		if v := trimmed(cfg.OGImage); v != "" {
+			card.Image = v
+		}
+		if v := trimmed(cfg.TwitterCard); v != "" {
+			card.TwitterCard = v
+		}
+	}
+	if card.TwitterCard == "" {
+		card.TwitterCard = TwitterCardSummary
+		if card.Image != "" {
+			card.TwitterCard = TwitterCardSummaryLarge
+		}
+	}
+	return card
+}
+
+func trimmed(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.TrimSpace(*s)
+}
@@ -0,0 +1,92 @@
+package social_card
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestApplyOverrides(t *testing.T) {
+	base := Card{Title: "文章 - 站点", Description: "摘要", Image: "https://img.example.com/cover.png"}
+
+	got := ApplyOverrides(nil, base)
+	if got.Title != base.Title || got.TwitterCard != TwitterCardSummaryLarge {
+		t.Errorf("无覆盖配置时应保留默认值并使用大图卡片，实际 %+v", got)
+	}
+
+	cfg := &model.ArticleExtraConfig{
+		OGTitle:       strPtr("  分享标题 "),
+		OGDescription: strPtr(""),
+		TwitterCard:   strPtr(TwitterCardSummary),
+	}
+	got = ApplyOverrides(cfg, base)
+	if got.Title != "分享标题" || got.Description != "摘要" || got.Image != base.Image || got.TwitterCard != TwitterCardSummary {
+		t.Errorf("覆盖结果不正确: %+v", got)
+	}
+
+	got = ApplyOverrides(&model.ArticleExtraConfig{}, Card{Title: "无图"})
+	if got.TwitterCard != TwitterCardSummary {
+		t.Errorf("没有图片时应使用摘要卡片，实际 %q", got.TwitterCard)
+	}
+}
+
+func TestArticleDescription(t *testing.T) {
+	if got := ArticleDescription([]string{"第一条摘要", "第二条"}, "<p>正文</p>"); got != "第一条摘要" {
+		t.Errorf("应优先使用第一条摘要，实际 %q", got)
+	}
+	if got := ArticleDescription(nil, "<p>正文\n\n  内容</p>"); got != "正文 内容" {
+		t.Errorf("应使用正文纯文本，实际 %q", got)
+	}
+}
+
+func TestParseMeta(t *testing.T) {
+	page := `<!doctype html><html><head>
+<title>页面标题</title>
+<meta name="description" content="页面描述">
+<meta property="og:title" content="OG 标题">
+<meta property="og:image" content="/static/cover.png">
+<meta name="twitter:image" content="https://example.com/twitter.png">
+<meta name="twitter:card" content="summary_large_image">
+</head><body><meta property="og:title" content="正文中的标签"></body></html>`
+	card, err := ParseMeta(strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Card{
+		Title:       "OG 标题",
+		Description: "页面描述",
+		Image:       "/static/cover.png",
+		TwitterCard: TwitterCardSummaryLarge,
+	}
+	if *card != want {
+		t.Errorf("ParseMeta = %+v; 期望 %+v", *card, want)
+	}
+
+	card, _ = ParseMeta(strings.NewReader(`<html><head><title> 仅标题 </title></head></html>`))
+	if card.Title != "仅标题" || card.Image != "" {
+		t.Errorf("缺少 og 标签时应回退到 title，实际 %+v", *card)
+	}
+}
+
+func TestImageWarnings(t *testing.T) {
+	cases := []struct {
+		check ImageCheck
+		card  string
+		want  int
+	}{
+		{ImageCheck{Error: "HTTP 404"}, TwitterCardSummaryLarge, 1},
+		{ImageCheck{ContentType: "image/png", Width: 1200, Height: 630}, TwitterCardSummaryLarge, 0},
+		{ImageCheck{ContentType: "image/png", Width: 250, Height: 250}, TwitterCardSummaryLarge, 1},
+		{ImageCheck{ContentType: "image/png", Width: 250, Height: 250}, TwitterCardSummary, 0},
+		{ImageCheck{ContentType: "image/png", Width: 100, Height: 100}, TwitterCardSummaryLarge, 2},
+		{ImageCheck{ContentType: "image/svg+xml"}, TwitterCardSummary, 1},
+	}
+	for _, c := range cases {
+		if got := imageWarnings(&c.check, c.card); len(got) != c.want {
+			t.Errorf("imageWarnings(%+v, %s) = %v; 期望 %d 条警告", c.check, c.card, got, c.want)
+		}
+	}
+}
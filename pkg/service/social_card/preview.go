@@ -0,0 +1,304 @@
+/*
+ * @Description: 文章分享卡片预览：抓取线上页面并检查分享图片
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 18:00:00
+ * @LastEditTime: 2026-10-17 18:00:00
+ * @LastEditors: 安知鱼
+ */
+package social_card
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	_ "golang.org/x/image/webp"
+	"golang.org/x/net/html"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// maxPageSize 读取页面时只解析前 2MB，meta 标签都在 head 中
+	maxPageSize = 2 << 20
+	// maxImageHeaderSize 读取图片尺寸时最多读取的字节数
+	maxImageHeaderSize = 1 << 20
+
+	// 各平台对分享图片的最小尺寸要求：Open Graph 为 200x200，Twitter 大图卡片为 300x157
+	minImageSide       = 200
+	minLargeCardWidth  = 300
+	minLargeCardHeight = 157
+)
+
+// ImageCheck 分享图片的检查结果
+type ImageCheck struct {
+	URL         string `json:"url"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Preview 分享卡片预览结果
+type Preview struct {
+	// Expected 按文章内容与覆盖配置计算出的卡片
+	Expected Card `json:"expected"`
+	// Page 从线上页面 meta 标签解析出的卡片，页面无法访问时为空
+	Page      *Card       `json:"page,omitempty"`
+	PageError string      `json:"page_error,omitempty"`
+	Image     *ImageCheck `json:"image,omitempty"`
+	Warnings  []string    `json:"warnings"`
+}
+
+// Service 分享卡片预览服务
+type Service struct {
+	articleRepo repository.ArticleRepository
+	settingSvc  setting.SettingService
+	httpClient  *http.Client
+}
+
+// NewService 创建分享卡片预览服务。抓取页面与图片经过出站白名单与 SSRF 防护
+func NewService(articleRepo repository.ArticleRepository, settingSvc setting.SettingService) *Service {
+	guard := ssrf.NewGuard(func() string {
+		return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+	})
+	return &Service{
+		articleRepo: articleRepo,
+		settingSvc:  settingSvc,
+		httpClient: &http.Client{
+			Timeout:   15 * time.Second,
+			Transport: guard.Transport(),
+		},
+	}
+}
+
+// Preview 抓取文章页面，解析社交平台会读取的 meta 标签并检查分享图片，
+// 页面无法访问（如未配置站点地址或仅 API 模式）时基于文章数据给出预期的卡片。
+func (s *Service) Preview(ctx context.Context, publicID string) (*Preview, error) {
+	if _, _, err := idgen.DecodePublicID(publicID); err != nil {
+		return nil, fmt.Errorf("无效的文章ID: %w", constant.ErrBadRequest)
+	}
+	a, err := s.articleRepo.GetByID(ctx, publicID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("文章不存在: %w", constant.ErrNotFound)
+		}
+		return nil, fmt.Errorf("获取文章失败: %w", err)
+	}
+
+	siteName := s.settingSvc.Get(constant.KeyAppName.String())
+	siteURL := strings.TrimRight(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	slug := a.ID
+	if a.Abbrlink != "" {
+		slug = a.Abbrlink
+	}
+	description := ArticleDescription(a.Summaries, a.ContentHTML)
+	if description == "" {
+		description = s.settingSvc.Get(constant.KeySiteDescription.String())
+	}
+	expected := ApplyOverrides(a.ExtraConfig, Card{
+		URL:         siteURL + "/posts/" + slug,
+		Title:       fmt.Sprintf("%s - %s", a.Title, siteName),
+		Description: description,
+		Image:       a.CoverURL,
+		SiteName:    siteName,
+	})
+
+	preview := &Preview{Expected: expected, Warnings: []string{}}
+	card := &expected
+	if siteURL == "" {
+		preview.PageError = "未配置站点地址，无法抓取线上页面"
+	} else if page, err := s.fetchPage(ctx, expected.URL); err != nil {
+		preview.PageError = err.Error()
+	} else {
+		preview.Page = page
+		card = page
+		if a.Status != "PUBLISHED" {
+			preview.Warnings = append(preview.Warnings, "文章尚未发布，线上页面可能不是该文章")
+		}
+	}
+
+	if card.Title == "" {
+		preview.Warnings = append(preview.Warnings, "缺少分享标题（og:title）")
+	}
+	if card.Description == "" {
+		preview.Warnings = append(preview.Warnings, "缺少分享描述（og:description）")
+	}
+	if card.Image == "" {
+		preview.Warnings = append(preview.Warnings, "缺少分享图片（og:image），社交平台将不显示图片")
+	} else {
+		imageURL := card.Image
+		if base, err := url.Parse(expected.URL); err == nil && siteURL != "" {
+			if ref, err := base.Parse(imageURL); err == nil {
+				imageURL = ref.String()
+			}
+		}
+		preview.Image = s.checkImage(ctx, imageURL)
+		preview.Warnings = append(preview.Warnings, imageWarnings(preview.Image, card.TwitterCard)...)
+	}
+	if preview.Page != nil && preview.Page.Image != expected.Image {
+		preview.Warnings = append(preview.Warnings, "线上页面的分享图片与文章设置不一致，页面缓存可能尚未更新")
+	}
+	return preview, nil
+}
+
+func (s *Service) fetchPage(ctx context.Context, pageURL string) (*Card, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("页面地址无效: %w", err)
+	}
+	// 模拟社交平台的抓取器，部分站点会对爬虫返回预渲染页面
+	req.Header.Set("User-Agent", "facebookexternalhit/1.1 (compatible; anheyu social preview)")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("抓取页面失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("抓取页面失败: HTTP %d", resp.StatusCode)
+	}
+	card, err := ParseMeta(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		return nil, fmt.Errorf("解析页面失败: %w", err)
+	}
+	if card.URL == "" {
+		card.URL = pageURL
+	}
+	return card, nil
+}
+
+// ParseMeta 解析页面中社交平台读取的 meta 标签。
+// Open Graph 标签优先，缺失时回退到 Twitter 标签以及页面的 title 与 description
+func ParseMeta(r io.Reader) (*Card, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	meta := make(map[string]string)
+	var title string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "title":
+				if title == "" && n.FirstChild != nil {
+					title = strings.TrimSpace(n.FirstChild.Data)
+				}
+			case "meta":
+				var key, content string
+				for _, attr := range n.Attr {
+					switch strings.ToLower(attr.Key) {
+					case "property", "name":
+						key = strings.ToLower(strings.TrimSpace(attr.Val))
+					case "content":
+						content = strings.TrimSpace(attr.Val)
+					}
+				}
+				if _, exists := meta[key]; key != "" && !exists {
+					meta[key] = content
+				}
+			case "body":
+				// meta 标签都在 head 中，无需遍历正文
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if v := meta[key]; v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	return &Card{
+		URL:         first("og:url"),
+		Title:       firstNonEmpty(first("og:title", "twitter:title"), title),
+		Description: first("og:description", "twitter:description", "description"),
+		Image:       first("og:image", "og:image:url", "twitter:image"),
+		TwitterCard: first("twitter:card"),
+		SiteName:    first("og:site_name"),
+	}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// checkImage 请求分享图片并读取其尺寸
+func (s *Service) checkImage(ctx context.Context, imageURL string) *ImageCheck {
+	check := &ImageCheck{URL: imageURL}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		check.Error = "图片地址无效"
+		return check
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	defer resp.Body.Close()
+	check.StatusCode = resp.StatusCode
+	check.ContentType = resp.Header.Get("Content-Type")
+	if resp.StatusCode != http.StatusOK {
+		check.Error = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		return check
+	}
+	if !strings.HasPrefix(check.ContentType, "image/") {
+		check.Error = "响应不是图片"
+		return check
+	}
+	cfg, _, err := image.DecodeConfig(io.LimitReader(resp.Body, maxImageHeaderSize))
+	if err != nil {
+		// SVG 等格式无法读取尺寸，只要可访问即可
+		return check
+	}
+	check.Width, check.Height = cfg.Width, cfg.Height
+	return check
+}
+
+// imageWarnings 根据图片检查结果与卡片类型给出警告
+func imageWarnings(check *ImageCheck, twitterCard string) []string {
+	if check.Error != "" {
+		return []string{fmt.Sprintf("分享图片无法使用: %s", check.Error)}
+	}
+	var warnings []string
+	if check.ContentType == "image/svg+xml" {
+		warnings = append(warnings, "多数社交平台不支持 SVG 分享图片，建议使用 PNG 或 JPEG")
+	}
+	if check.Width == 0 || check.Height == 0 {
+		return warnings
+	}
+	if check.Width < minImageSide || check.Height < minImageSide {
+		warnings = append(warnings, fmt.Sprintf("分享图片尺寸 %dx%d 过小，Open Graph 要求至少 %dx%d", check.Width, check.Height, minImageSide, minImageSide))
+	}
+	if twitterCard == TwitterCardSummaryLarge && (check.Width < minLargeCardWidth || check.Height < minLargeCardHeight) {
+		warnings = append(warnings, fmt.Sprintf("分享图片尺寸 %dx%d 小于 Twitter 大图卡片的最小尺寸 %dx%d", check.Width, check.Height, minLargeCardWidth, minLargeCardHeight))
+	}
+	return warnings
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -78,6 +79,9 @@ type VisitorStatService interface {
 
 	// 获取访客访问日志（时间范围）
 	GetVisitorLogs(ctx context.Context, startDate, endDate time.Time) ([]*ent.VisitorLog, error)
+
+	// 分批读取时间范围内的访问日志并逐条交给 emit，用于流式导出
+	StreamVisitorLogs(ctx context.Context, startDate, endDate time.Time, emit func(log *ent.VisitorLog) error) error
 }
 
 type visitorStatService struct {
@@ -151,6 +155,28 @@ func (s *visitorStatService) GetVisitorLogs(ctx context.Context, startDate, endD
 	return s.visitorLogRepo.GetByTimeRange(ctx, startDate, endDate)
 }
 
+// visitorLogExportBatchSize 流式导出访问日志时每批读取的条数
+const visitorLogExportBatchSize = 1000
+
+func (s *visitorStatService) StreamVisitorLogs(ctx context.Context, startDate, endDate time.Time, emit func(log *ent.VisitorLog) error) error {
+	var afterID uint
+	for {
+		logs, err := s.visitorLogRepo.GetByTimeRangeAfterID(ctx, startDate, endDate, afterID, visitorLogExportBatchSize)
+		if err != nil {
+			return fmt.Errorf("获取访问日志失败: %w", err)
+		}
+		for _, lg := range logs {
+			if err := emit(lg); err != nil {
+				return err
+			}
+			afterID = lg.ID
+		}
+		if len(logs) < visitorLogExportBatchSize {
+			return nil
+		}
+	}
+}
+
 // startWorkerPool 启动worker池处理访问任务
 func (s *visitorStatService) startWorkerPool() {
 	for task := range s.visitQueue {
@@ -1076,26 +1102,9 @@ func (s *visitorStatService) updateDatabaseStats(ctx context.Context, stats *mod
 	return nil
 }
 
-// 获取客户端真实IP
+// 获取客户端真实IP，与评论、限流等共用可信代理判定，避免直接信任可伪造的转发头
 func (s *visitorStatService) getClientIP(c *gin.Context) string {
-	// 检查代理头
-	if ip := c.GetHeader("X-Forwarded-For"); ip != "" {
-		// X-Forwarded-For 可能包含多个IP，取第一个
-		if ips := strings.Split(ip, ","); len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
-		}
-	}
-
-	if ip := c.GetHeader("X-Real-IP"); ip != "" {
-		return ip
-	}
-
-	if ip := c.GetHeader("X-Original-Forwarded-For"); ip != "" {
-		return ip
-	}
-
-	// 返回默认IP
-	return c.ClientIP()
+	return util.GetRealClientIP(c)
 }
 
 // 生成访客ID
@@ -13,6 +13,7 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 	"github.com/redis/go-redis/v9"
@@ -212,7 +213,7 @@ func (s *Service) NotifyArticlePublished(ctx context.Context, article *model.Art
 	log.Printf("[Subscriber.NotifyArticlePublished] 准备向 %d 位订阅者发送文章推送: %s", len(subscribers), article.Title)
 
 	// 异步逐个发送邮件
-	go func() {
+	workerpool.Go(workerpool.CategoryNotification, func() {
 		bgCtx := context.Background()
 		for _, sub := range subscribers {
 			// 如果没有 token，生成一个临时的（这里假设数据库已有 token，或者是空）
@@ -230,7 +231,7 @@ func (s *Service) NotifyArticlePublished(ctx context.Context, article *model.Art
 			// 稍微延时，避免瞬间并发过高触发 SMTP 限制
 			time.Sleep(100 * time.Millisecond)
 		}
-	}()
+	})
 
 	return nil
 }
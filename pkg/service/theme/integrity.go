@@ -0,0 +1,191 @@
+/*
+ * @Description: 主题静态资源的子资源完整性（SRI）哈希生成与校验
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 12:00:00
+ * @LastEditTime: 2026-10-16 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package theme
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// IntegrityFileName 安装时生成的 SRI 哈希清单，随主题目录一起复制到 static 目录
+const IntegrityFileName = "integrity.json"
+
+// integrityExtensions 需要生成 SRI 哈希的资源类型
+var integrityExtensions = map[string]bool{
+	".js":  true,
+	".mjs": true,
+	".css": true,
+}
+
+// ErrIntegrityMismatch 主题包声明的哈希与实际文件不一致
+var ErrIntegrityMismatch = errors.New("主题资源完整性校验失败")
+
+// integrityHashes 支持的 SRI 哈希算法
+var integrityHashes = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// validateIntegrityDeclarations 检查 theme.json 中 integrity 声明的格式
+func validateIntegrityDeclarations(declared map[string]string) []string {
+	var problems []string
+	for assetPath, value := range declared {
+		if _, _, err := parseIntegrityValue(value); err != nil {
+			problems = append(problems, fmt.Sprintf("integrity 中 %s 的哈希格式无效: %v", assetPath, err))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// parseIntegrityValue 解析 "sha384-<base64>" 形式的 SRI 值
+func parseIntegrityValue(value string) (string, []byte, error) {
+	algo, encoded, ok := strings.Cut(strings.TrimSpace(value), "-")
+	if !ok {
+		return "", nil, fmt.Errorf("缺少算法前缀")
+	}
+	newHash, supported := integrityHashes[algo]
+	if !supported {
+		return "", nil, fmt.Errorf("不支持的算法 %s", algo)
+	}
+	digest, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(digest) != newHash().Size() {
+		return "", nil, fmt.Errorf("摘要不是有效的 base64 编码")
+	}
+	return algo, digest, nil
+}
+
+// normalizeAssetPath 把资源路径统一为以 / 开头、不含查询参数的主题内相对路径
+func normalizeAssetPath(p string) string {
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		p = p[:i]
+	}
+	return path.Clean("/" + strings.TrimPrefix(filepath.ToSlash(p), "/"))
+}
+
+// hashFile 计算文件的 SRI 值
+func hashFile(filePath, algo string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := integrityHashes[algo]()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return algo + "-" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyDeclaredIntegrity 按 theme.json 声明的哈希校验解压后的文件，任意一项不一致即返回错误
+func verifyDeclaredIntegrity(themeDir string, declared map[string]string) error {
+	assetPaths := make([]string, 0, len(declared))
+	for assetPath := range declared {
+		assetPaths = append(assetPaths, assetPath)
+	}
+	sort.Strings(assetPaths)
+
+	for _, assetPath := range assetPaths {
+		algo, _, err := parseIntegrityValue(declared[assetPath])
+		if err != nil {
+			return fmt.Errorf("%w: %s 的哈希格式无效: %v", ErrIntegrityMismatch, assetPath, err)
+		}
+		filePath := filepath.Join(themeDir, filepath.FromSlash(strings.TrimPrefix(normalizeAssetPath(assetPath), "/")))
+		actual, err := hashFile(filePath, algo)
+		if err != nil {
+			return fmt.Errorf("%w: 无法读取 %s: %v", ErrIntegrityMismatch, assetPath, err)
+		}
+		if actual != strings.TrimSpace(declared[assetPath]) {
+			return fmt.Errorf("%w: %s 的哈希与声明不一致", ErrIntegrityMismatch, assetPath)
+		}
+	}
+	return nil
+}
+
+// computeIntegrity 为主题目录下的 JS/CSS 文件计算 sha384 哈希
+func computeIntegrity(themeDir string) (map[string]string, error) {
+	result := make(map[string]string)
+	err := filepath.WalkDir(themeDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !integrityExtensions[strings.ToLower(filepath.Ext(p))] {
+			return nil
+		}
+		rel, err := filepath.Rel(themeDir, p)
+		if err != nil {
+			return err
+		}
+		value, err := hashFile(p, "sha384")
+		if err != nil {
+			return err
+		}
+		result[normalizeAssetPath(rel)] = value
+		return nil
+	})
+	return result, err
+}
+
+// prepareThemeIntegrity 校验主题包声明的哈希并写入 integrity.json，供前台模板输出 integrity 属性。
+// 主题声明过的文件沿用声明值，其余 JS/CSS 使用安装时计算的 sha384。
+func prepareThemeIntegrity(themeDir string, declared map[string]string) error {
+	if err := verifyDeclaredIntegrity(themeDir, declared); err != nil {
+		return err
+	}
+	hashes, err := computeIntegrity(themeDir)
+	if err != nil {
+		return fmt.Errorf("计算主题资源哈希失败: %w", err)
+	}
+	for assetPath, value := range declared {
+		hashes[normalizeAssetPath(assetPath)] = strings.TrimSpace(value)
+	}
+	data, err := json.MarshalIndent(hashes, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(themeDir, IntegrityFileName), data, 0644)
+}
+
+// LoadIntegrity 读取目录下的 integrity.json，文件不存在时返回空清单
+func LoadIntegrity(dir string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, IntegrityFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]string)
+	if err := json.Unmarshal(data, &hashes); err != nil {
+		return nil, fmt.Errorf("解析 %s 失败: %w", IntegrityFileName, err)
+	}
+	return hashes, nil
+}
+
+// IntegrityLookup 返回模板函数使用的查询方法：传入 script/link 的资源地址，返回对应的 SRI 值，未知资源返回空字符串
+func IntegrityLookup(hashes map[string]string) func(src string) string {
+	return func(src string) string {
+		if strings.Contains(src, "://") || strings.HasPrefix(src, "//") {
+			return ""
+		}
+		return hashes[normalizeAssetPath(src)]
+	}
+}
@@ -0,0 +1,83 @@
+package theme
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeThemeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrepareThemeIntegrity(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "static/app.js", "console.log(1)")
+	writeThemeFile(t, dir, "static/app.css", "body{}")
+	writeThemeFile(t, dir, "index.html", "<html></html>")
+
+	declared, err := hashFile(filepath.Join(dir, "static", "app.js"), "sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := prepareThemeIntegrity(dir, map[string]string{"static/app.js": declared}); err != nil {
+		t.Fatalf("声明哈希一致时不应失败: %v", err)
+	}
+
+	hashes, err := LoadIntegrity(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashes["/static/app.js"] != declared {
+		t.Errorf("声明过的文件应沿用声明值: %s", hashes["/static/app.js"])
+	}
+	if _, ok := hashes["/index.html"]; ok {
+		t.Error("HTML 文件不应生成哈希")
+	}
+
+	lookup := IntegrityLookup(hashes)
+	if lookup("/static/app.css?v=2") == "" {
+		t.Error("带查询参数的本地资源应能查到哈希")
+	}
+	if lookup("https://cdn.example.com/static/app.css") != "" {
+		t.Error("外部资源不应返回哈希")
+	}
+}
+
+func TestPrepareThemeIntegrityMismatch(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "static/app.js", "console.log(1)")
+
+	other := t.TempDir()
+	writeThemeFile(t, other, "app.js", "console.log(2)")
+	wrong, err := hashFile(filepath.Join(other, "app.js"), "sha384")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = prepareThemeIntegrity(dir, map[string]string{"/static/app.js": wrong})
+	if !errors.Is(err, ErrIntegrityMismatch) {
+		t.Fatalf("期望 ErrIntegrityMismatch，实际 %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(dir, IntegrityFileName)); statErr == nil {
+		t.Error("校验失败时不应写入 integrity.json")
+	}
+}
+
+func TestValidateIntegrityDeclarations(t *testing.T) {
+	problems := validateIntegrityDeclarations(map[string]string{
+		"a.js": "md5-abc",
+		"b.js": "sha256-not-base64!",
+	})
+	if len(problems) != 2 {
+		t.Errorf("期望两条格式错误，实际 %v", problems)
+	}
+}
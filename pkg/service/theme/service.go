@@ -149,6 +149,8 @@ type ThemeMetadata struct {
 	Features    []string          `json:"features"`
 	// 主题配置定义（类似 Halo 的 settings.yaml）
 	Settings []ThemeSettingGroup `json:"settings,omitempty"`
+	// Integrity 资源路径到 SRI 哈希的声明（如 "/static/app.js": "sha384-..."），安装时逐项校验
+	Integrity map[string]string `json:"integrity,omitempty"`
 }
 
 // ThemeSettingGroup 主题配置分组
@@ -854,6 +856,16 @@ func (s *themeService) InstallTheme(ctx context.Context, userID uint, req *Theme
 		return fmt.Errorf("主题文件验证失败: %w", err)
 	}
 
+	// 校验 theme.json 声明的资源哈希并生成 integrity.json
+	var declared map[string]string
+	if metadata, err := s.loadThemeMetadataFromDisk(req.ThemeName); err == nil {
+		declared = metadata.Integrity
+	}
+	if err := prepareThemeIntegrity(themeDir, declared); err != nil {
+		os.RemoveAll(themeDir)
+		return err
+	}
+
 	// 4. 在数据库中记录主题信息（只存储必要的本地信息）
 	createBuilder := s.db.UserInstalledTheme.
 		Create().
@@ -1506,6 +1518,12 @@ func (s *themeService) UploadTheme(ctx context.Context, userID uint, file *multi
 		return nil, fmt.Errorf("解压后验证失败: %w", err)
 	}
 
+	// 校验 theme.json 声明的资源哈希并生成 integrity.json
+	if err := prepareThemeIntegrity(themeDir, metadata.Integrity); err != nil {
+		os.RemoveAll(themeDir)
+		return nil, err
+	}
+
 	// 6. 在数据库中记录主题信息
 	if isUpdate {
 		// 更新现有记录
@@ -1850,6 +1868,8 @@ func (s *themeService) validateThemeMetadata(metadata *ThemeMetadata) []string {
 		errors = append(errors, "author字段不能为空")
 	}
 
+	errors = append(errors, validateIntegrityDeclarations(metadata.Integrity)...)
+
 	// 验证分类
 	if metadata.Category != "" {
 		validCategories := []string{
@@ -57,6 +57,8 @@ type ThumbnailService struct {
 	storageProviders map[constant.StoragePolicyType]storage.IStorageProvider
 	generators       []Generator
 	cachePath        string
+	// vipsPath 非空时用于生成 AVIF/WebP 缩略图变体
+	vipsPath string
 }
 
 // NewThumbnailService 是 ThumbnailService 的构造函数。
@@ -78,6 +80,7 @@ func NewThumbnailService(
 	provider := &settingAdapter{settingSvc: settingSvc}
 	var generators []Generator
 	var loadedGeneratorNames []string // 用于记录已加载的生成器名称
+	var variantVipsPath string
 
 	log.Println("--- 开始加载缩略图生成器 (Thumbnail Generators) ---")
 
@@ -107,7 +110,11 @@ func NewThumbnailService(
 		exts := parseCommaSeparatedString(extsStr)
 		maxSizeStr := provider.Get(constant.KeyVipsMaxFileSize.String())
 		maxSize := parseSizeString(maxSizeStr, "VIPS生成器")
-		generators = append(generators, NewVipsCliGenerator(cachePath, vipsPath, exts, maxSize))
+		vipsGenerator := NewVipsCliGenerator(cachePath, vipsPath, exts, maxSize)
+		if g, ok := vipsGenerator.(*VipsCliGenerator); ok && g.isAvailable {
+			variantVipsPath = g.vipsPath
+		}
+		generators = append(generators, vipsGenerator)
 		log.Printf("  -> 已加载 [3]: VIPS (高性能图片)")
 	}
 
@@ -159,6 +166,7 @@ func NewThumbnailService(
 		storageProviders: storageProviders,
 		generators:       generators,
 		cachePath:        cachePath,
+		vipsPath:         variantVipsPath,
 	}
 }
 
@@ -240,7 +248,8 @@ func (s *ThumbnailService) Generate(ctx context.Context, fileID uint) {
 				s.updateMetaOnFailure(fileID, "保存原生缩略图失败")
 				return
 			}
-			s.updateMetaOnSuccess(fileID, "native:"+string(policy.Type), false, nativeFormat)
+			variants := s.generateVariants(ctx, ownerPublicID, filePublicID, parentPath, nativeFormat)
+			s.updateMetaOnSuccess(fileID, "native:"+string(policy.Type), false, nativeFormat, variants)
 			return
 		} else if !errors.Is(err, storage.ErrFeatureNotSupported) {
 			log.Printf("[ThumbnailService] 错误: 尝试获取原生缩略图时发生错误: %v", err)
@@ -272,7 +281,11 @@ func (s *ThumbnailService) Generate(ctx context.Context, fileID uint) {
 			}
 			log.Printf("[ThumbnailService] 成功: 文件ID %d 预览已生成", fileID)
 
-			s.updateMetaOnSuccess(fileID, "generated:"+result.GeneratorName, result.IsDirectServe, result.Format)
+			var variants []string
+			if !result.IsDirectServe {
+				variants = s.generateVariants(ctx, ownerPublicID, filePublicID, parentPath, result.Format)
+			}
+			s.updateMetaOnSuccess(fileID, "generated:"+result.GeneratorName, result.IsDirectServe, result.Format, variants)
 			return
 		}
 	}
@@ -363,7 +376,8 @@ func (s *ThumbnailService) getOriginalFile(ctx context.Context, file *model.File
 	}
 }
 
-func (s *ThumbnailService) updateMetaOnSuccess(fileID uint, source string, isDirectServe bool, format string) {
+// updateMetaOnSuccess 记录生成结果；variants 为包含主格式在内的全部可用格式，少于两个时不记录变体。
+func (s *ThumbnailService) updateMetaOnSuccess(fileID uint, source string, isDirectServe bool, format string, variants []string) {
 	ctx := context.Background()
 	status := model.MetaValueStatusReady
 	if isDirectServe {
@@ -372,6 +386,11 @@ func (s *ThumbnailService) updateMetaOnSuccess(fileID uint, source string, isDir
 	s.metaService.Set(ctx, fileID, model.MetaKeyThumbStatus, status)
 	s.metaService.Set(ctx, fileID, model.MetaKeyThumbSource, source)
 	s.metaService.Set(ctx, fileID, model.MetaKeyThumbFormat, format)
+	if len(variants) > 1 {
+		s.metaService.Set(ctx, fileID, model.MetaKeyThumbVariants, strings.Join(variants, ","))
+	} else {
+		s.metaService.Delete(ctx, fileID, model.MetaKeyThumbVariants)
+	}
 	s.metaService.Delete(ctx, fileID, model.MetaKeyThumbRetryCount)
 	s.metaService.Delete(ctx, fileID, model.MetaKeyThumbError)
 }
@@ -412,6 +431,7 @@ func (s *ThumbnailService) ResetThumbnailMetadata(ctx context.Context, publicFil
 	go s.metaService.Delete(bgCtx, internalFileID, model.MetaKeyThumbError)
 	go s.metaService.Delete(bgCtx, internalFileID, model.MetaKeyThumbRetryCount)
 	go s.metaService.Delete(bgCtx, internalFileID, model.MetaKeyThumbFormat)
+	go s.metaService.Delete(bgCtx, internalFileID, model.MetaKeyThumbVariants)
 	// 将状态设置回空，让 GetThumbnailSign 逻辑来触发重新生成
 	return s.metaService.Set(bgCtx, internalFileID, model.MetaKeyThumbStatus, "")
 }
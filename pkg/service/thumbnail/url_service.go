@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -39,6 +40,7 @@ func (s *ThumbnailService) GenerateSignedURL(ctx context.Context, file *model.Fi
 
 	var tokenType string
 	var format string
+	var variants string
 
 	switch status {
 	case model.MetaValueStatusReadyDirect:
@@ -55,6 +57,8 @@ func (s *ThumbnailService) GenerateSignedURL(ctx context.Context, file *model.Fi
 			return "", time.Time{}, fmt.Errorf("找不到文件ID %d 的缩略图格式元数据: %w", file.ID, err)
 		}
 		format = thumbFormat
+		// 多格式变体为可选项，旧缩略图没有该元数据时只提供主格式
+		variants, _ = s.metaService.Get(ctx, file.ID, model.MetaKeyThumbVariants)
 	default:
 		log.Printf("[GenerateSignedURL-ERROR] 文件ID %d 状态为 '%s'，不满足生成签名的条件 (需要 Ready 或 ReadyDirect)", file.ID, status)
 
@@ -85,6 +89,9 @@ func (s *ThumbnailService) GenerateSignedURL(ctx context.Context, file *model.Fi
 		"tf": format,    // tf: target_format (e.g., "jpeg" or "svg")
 		"e":  expiresAt.Unix(),
 	}
+	if variants != "" {
+		payload["tv"] = variants // tv: thumb_variants，内容服务时按 Accept 头协商
+	}
 	payloadBytes, _ := json.Marshal(payload)
 
 	// 4. 计算签名
@@ -192,13 +199,25 @@ func (s *ThumbnailService) ServeThumbnailContent(c context.Context, token string
 		log.Printf("[ServeThumbnailContent-DEBUG] 文件 %s 的虚拟父路径: %s", filePublicID, parentPath)
 		log.Printf("[ServeThumbnailContent-DEBUG] 缩略图格式: %s", format)
 
-		cacheFileName := GenerateCacheName(ownerPublicID, filePublicID, format)
-		thumbnailPath, err := GetCachePath(s.cachePath, parentPath, cacheFileName)
+		thumbnailPath, err := GetCachePath(s.cachePath, parentPath, GenerateCacheName(ownerPublicID, filePublicID, format))
 		if err != nil {
 			log.Printf("[GetCachePath-ERROR] 获取缩略图缓存路径失败: %v", err)
 			return err
 		}
 
+		// 有多格式变体时按 Accept 头选择 AVIF/WebP/JPEG，变体文件缺失则回退主格式
+		if variants, _ := payload["tv"].(string); variants != "" {
+			w.Header().Set("Vary", "Accept")
+			if negotiated := negotiateThumbFormat(r.Header.Get("Accept"), format, strings.Split(variants, ",")); negotiated != format {
+				variantPath, err := GetCachePath(s.cachePath, parentPath, GenerateCacheName(ownerPublicID, filePublicID, negotiated))
+				if err == nil {
+					if _, statErr := os.Stat(variantPath); statErr == nil {
+						thumbnailPath = variantPath
+					}
+				}
+			}
+		}
+
 		log.Printf("[ServeThumbnailContent-DEBUG] 缩略图缓存路径: %s", thumbnailPath)
 
 		http.ServeFile(w, r, thumbnailPath)
@@ -0,0 +1,174 @@
+/*
+ * @Description: 缩略图多格式变体的生成与按 Accept 头的格式协商
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 13:00:00
+ * @LastEditTime: 2026-10-17 13:00:00
+ * @LastEditors: 安知鱼
+ */
+package thumbnail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+)
+
+// variantFormats 是为支持现代格式的浏览器额外生成的缩略图格式，按协商优先级排列。
+var variantFormats = []string{"avif", "webp"}
+
+// fallbackVariantFormat 是主缩略图格式浏览器无法通用显示时生成的兜底格式。
+const fallbackVariantFormat = "jpeg"
+
+// variantSaveOptions 是 vips 保存各格式变体时附加的参数。
+var variantSaveOptions = map[string]string{
+	"avif": "[Q=50,strip]",
+	"webp": "[Q=75,strip]",
+	"jpeg": "[Q=80,strip]",
+}
+
+// variantTimeout 限制单个变体的编码耗时，避免 AVIF 编码卡住整个缩略图任务。
+const variantTimeout = 30 * time.Second
+
+var errVariantEncoderUnavailable = errors.New("没有可用于该格式的编码器")
+
+// normalizeThumbFormat 将缩略图格式统一为不带点的小写名称，jpg 归一为 jpeg。
+func normalizeThumbFormat(format string) string {
+	f := strings.ToLower(strings.TrimPrefix(format, "."))
+	if f == "jpg" {
+		return "jpeg"
+	}
+	return f
+}
+
+// isBrowserSafeFormat 判断格式是否能被所有浏览器直接显示。
+func isBrowserSafeFormat(format string) bool {
+	switch normalizeThumbFormat(format) {
+	case "jpeg", "png", "gif":
+		return true
+	}
+	return false
+}
+
+// generateVariants 基于已生成的主缩略图派生 AVIF/WebP 变体，主格式非通用格式时再补一份 JPEG。
+// 返回所有可用格式（含主格式）在缓存文件名中使用的格式串；单个变体失败只记录日志，不影响主缩略图。
+func (s *ThumbnailService) generateVariants(ctx context.Context, ownerPublicID, filePublicID, parentPath, primaryFormat string) []string {
+	formats := []string{primaryFormat}
+	primaryPath, err := GetCachePath(s.cachePath, parentPath, GenerateCacheName(ownerPublicID, filePublicID, primaryFormat))
+	if err != nil {
+		return formats
+	}
+	if _, err := os.Stat(primaryPath); err != nil {
+		return formats
+	}
+
+	targets := append([]string{}, variantFormats...)
+	if !isBrowserSafeFormat(primaryFormat) {
+		targets = append(targets, fallbackVariantFormat)
+	}
+
+	primary := normalizeThumbFormat(primaryFormat)
+	for _, format := range targets {
+		if format == primary {
+			continue
+		}
+		outPath, err := GetCachePath(s.cachePath, parentPath, GenerateCacheName(ownerPublicID, filePublicID, format))
+		if err != nil {
+			continue
+		}
+		if err := s.encodeVariant(ctx, primaryPath, outPath, format); err != nil {
+			if !errors.Is(err, errVariantEncoderUnavailable) {
+				log.Printf("[ThumbnailService] 警告: 生成 %s 缩略图变体失败 (文件: %s): %v", format, filePublicID, err)
+			}
+			_ = os.Remove(outPath)
+			continue
+		}
+		formats = append(formats, format)
+	}
+	return formats
+}
+
+// encodeVariant 将主缩略图转码为指定格式。优先使用 vips；JPEG 兜底在 vips 不可用时使用纯 Go 编码。
+func (s *ThumbnailService) encodeVariant(ctx context.Context, srcPath, dstPath, format string) error {
+	if s.vipsPath != "" {
+		ctx, cancel := context.WithTimeout(ctx, variantTimeout)
+		defer cancel()
+
+		var errBuf bytes.Buffer
+		cmd := exec.CommandContext(ctx, s.vipsPath, "copy", srcPath, dstPath+variantSaveOptions[format])
+		cmd.Stderr = &errBuf
+		err := cmd.Run()
+		if err == nil {
+			return nil
+		}
+		if format != fallbackVariantFormat {
+			return fmt.Errorf("调用 vips 失败: %w, 错误输出: %s", err, errBuf.String())
+		}
+	}
+
+	if format != fallbackVariantFormat {
+		return errVariantEncoderUnavailable
+	}
+	img, err := imaging.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("解码主缩略图失败: %w", err)
+	}
+	return imaging.Save(img, dstPath, imaging.JPEGQuality(80))
+}
+
+// acceptedImageFormats 解析 Accept 头中显式列出且未被 q=0 排除的图片格式。
+// 通配符（*/*、image/*）不计入，以免向仅声明通配的旧浏览器返回其无法解码的格式。
+func acceptedImageFormats(accept string) map[string]bool {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(fields[0]))
+		if !strings.HasPrefix(mediaType, "image/") || mediaType == "image/*" {
+			continue
+		}
+		excluded := false
+		for _, param := range fields[1:] {
+			param = strings.ReplaceAll(strings.TrimSpace(param), " ", "")
+			if q, ok := strings.CutPrefix(param, "q="); ok {
+				if v, err := strconv.ParseFloat(q, 64); err == nil && v <= 0 {
+					excluded = true
+				}
+			}
+		}
+		if !excluded {
+			accepted[normalizeThumbFormat(strings.TrimPrefix(mediaType, "image/"))] = true
+		}
+	}
+	return accepted
+}
+
+// negotiateThumbFormat 根据请求的 Accept 头从可用变体中选出要返回的格式：
+// 显式接受 AVIF 时优先 AVIF，其次 WebP；否则返回通用的主格式或 JPEG 兜底变体。
+func negotiateThumbFormat(accept, primary string, available []string) string {
+	byFormat := make(map[string]string, len(available))
+	for _, format := range available {
+		byFormat[normalizeThumbFormat(format)] = format
+	}
+
+	accepted := acceptedImageFormats(accept)
+	for _, format := range variantFormats {
+		if raw, ok := byFormat[format]; ok && accepted[format] {
+			return raw
+		}
+	}
+	if isBrowserSafeFormat(primary) {
+		return primary
+	}
+	if raw, ok := byFormat[fallbackVariantFormat]; ok {
+		return raw
+	}
+	return primary
+}
@@ -0,0 +1,77 @@
+package thumbnail
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"golang.org/x/image/bmp"
+)
+
+func TestNegotiateThumbFormat(t *testing.T) {
+	const (
+		chrome   = "image/avif,image/webp,image/apng,image/svg+xml,image/*,*/*;q=0.8"
+		safari   = "image/webp,image/png,image/svg+xml,image/*;q=0.8,video/*;q=0.8,*/*;q=0.5"
+		legacy   = "*/*"
+		noAvif   = "image/avif;q=0,image/webp"
+		excluded = "image/webp;q=0.0, image/avif ; q = 0"
+	)
+	all := []string{"jpeg", "avif", "webp"}
+
+	cases := []struct {
+		name      string
+		accept    string
+		primary   string
+		available []string
+		want      string
+	}{
+		{"支持 AVIF 时优先 AVIF", chrome, "jpeg", all, "avif"},
+		{"仅支持 WebP", safari, "jpeg", all, "webp"},
+		{"仅声明通配符时返回通用主格式", legacy, "jpeg", all, "jpeg"},
+		{"q=0 排除 AVIF", noAvif, "jpeg", all, "webp"},
+		{"全部被排除", excluded, "jpeg", all, "jpeg"},
+		{"无变体时返回主格式", chrome, "jpeg", nil, "jpeg"},
+		{"主格式为 WebP 且客户端支持", safari, ".webp", []string{".webp", "jpeg"}, ".webp"},
+		{"主格式为 WebP 但客户端不支持时回退 JPEG", legacy, ".webp", []string{".webp", "jpeg"}, "jpeg"},
+		{"主格式非通用且无兜底变体", legacy, ".webp", []string{".webp"}, ".webp"},
+	}
+	for _, tc := range cases {
+		if got := negotiateThumbFormat(tc.accept, tc.primary, tc.available); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestGenerateVariantsJPEGFallbackWithoutVips(t *testing.T) {
+	s := &ThumbnailService{cachePath: t.TempDir()}
+	primaryPath, err := GetCachePath(s.cachePath, "/photos", GenerateCacheName("u1", "f1", "bmp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	out, err := os.Create(primaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bmp.Encode(out, img); err != nil {
+		t.Fatal(err)
+	}
+	out.Close()
+
+	got := s.generateVariants(context.Background(), "u1", "f1", "/photos", "bmp")
+	if want := []string{"bmp", "jpeg"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("没有 vips 时只应生成 JPEG 兜底变体: got %v, want %v", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(s.cachePath, "photos", GenerateCacheName("u1", "f1", "jpeg"))); err != nil {
+		t.Errorf("JPEG 变体文件应写入缓存目录: %v", err)
+	}
+
+	if got := s.generateVariants(context.Background(), "u1", "missing", "/photos", "jpeg"); !reflect.DeepEqual(got, []string{"jpeg"}) {
+		t.Errorf("主缩略图不存在时只返回主格式: %v", got)
+	}
+}
@@ -0,0 +1,65 @@
+package tts
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ProviderAzure Azure AI 语音服务
+const ProviderAzure = "azure"
+
+// defaultNeuralVoice Azure 与 Edge 共用的默认中文发音人
+const defaultNeuralVoice = "zh-CN-XiaoxiaoNeural"
+
+// Azure 调用 Azure 语音服务的 REST 合成接口
+type Azure struct {
+	client *http.Client
+	region string // 区域，如 eastasia；也可以直接填写完整的接口地址
+	key    string
+	voice  string
+}
+
+// Name 返回服务商标识
+func (a *Azure) Name() string { return ProviderAzure }
+
+// Voice 返回发音人
+func (a *Azure) Voice() string { return a.voice }
+
+// Synthesize 以 SSML 调用 /cognitiveservices/v1 合成 MP3 音频
+func (a *Azure) Synthesize(ctx context.Context, text string) ([]byte, error) {
+	endpoint := a.region
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = fmt.Sprintf("https://%s.tts.speech.microsoft.com/cognitiveservices/v1", endpoint)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(a.ssml(text)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/ssml+xml")
+	req.Header.Set("X-Microsoft-OutputFormat", "audio-24khz-48kbitrate-mono-mp3")
+	req.Header.Set("Ocp-Apim-Subscription-Key", a.key)
+	req.Header.Set("User-Agent", "anheyu-app")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, redactKey(err, a.key)
+	}
+	return readAudio(resp, a.key)
+}
+
+// ssml 生成合成请求体，语言取自发音人名称的前缀（如 zh-CN）
+func (a *Azure) ssml(text string) string {
+	lang := "zh-CN"
+	if parts := strings.SplitN(a.voice, "-", 3); len(parts) == 3 {
+		lang = parts[0] + "-" + parts[1]
+	}
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(text))
+	var voice strings.Builder
+	_ = xml.EscapeText(&voice, []byte(a.voice))
+	return fmt.Sprintf(`<speak version="1.0" xmlns="http://www.w3.org/2001/10/synthesis" xml:lang="%s"><voice name="%s">%s</voice></speak>`,
+		lang, voice.String(), escaped.String())
+}
@@ -0,0 +1,71 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ProviderOpenAI OpenAI 语音合成接口
+	ProviderOpenAI = "openai"
+	// ProviderEdge 通过 OpenAI 兼容网关（如 openai-edge-tts）调用的 Microsoft Edge 在线语音
+	ProviderEdge = "edge"
+
+	defaultOpenAIBaseURL = "https://api.openai.com"
+	defaultOpenAIModel   = "tts-1"
+	defaultOpenAIVoice   = "alloy"
+)
+
+// OpenAI 调用 OpenAI 兼容的 /v1/audio/speech 接口，Edge-TTS 网关复用同一实现
+type OpenAI struct {
+	client  *http.Client
+	name    string
+	baseURL string
+	key     string
+	model   string
+	voice   string
+}
+
+// Name 返回服务商标识
+func (o *OpenAI) Name() string { return o.name }
+
+// Voice 返回发音人
+func (o *OpenAI) Voice() string { return o.voice }
+
+// Synthesize 调用 /v1/audio/speech 合成 MP3 音频
+func (o *OpenAI) Synthesize(ctx context.Context, text string) ([]byte, error) {
+	modelName := o.model
+	if modelName == "" {
+		modelName = defaultOpenAIModel
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"model":           modelName,
+		"input":           text,
+		"voice":           o.voice,
+		"response_format": "mp3",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := strings.TrimRight(o.baseURL, "/")
+	if !strings.HasSuffix(endpoint, "/v1") {
+		endpoint += "/v1"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/audio/speech", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.key != "" {
+		req.Header.Set("Authorization", "Bearer "+o.key)
+	}
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, redactKey(err, o.key)
+	}
+	return readAudio(resp, o.key)
+}
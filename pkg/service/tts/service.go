@@ -0,0 +1,369 @@
+/*
+ * @Description: 文章语音朗读服务，文章发布后调用 TTS 服务商生成音频并保存到文件系统
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 21:00:00
+ * @LastEditTime: 2026-10-15 21:00:00
+ * @LastEditors: 安知鱼
+ */
+package tts
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// defaultMaxChars 未配置朗读字数上限时的默认值
+	defaultMaxChars = 20000
+	// chunkSize 单次请求服务商的最大字符数，各服务商对单次输入都有长度限制
+	chunkSize = 3000
+	// maxAudioSize 单段音频响应体的大小上限
+	maxAudioSize = 32 << 20
+	// generateTimeout 单篇文章生成音频的总超时
+	generateTimeout = 10 * time.Minute
+	// systemOwnerID 音频文件与其他系统文件一样归属于管理员
+	systemOwnerID uint = 1
+)
+
+// ErrNotConfigured 未选择语音合成服务商或缺少必要配置
+var ErrNotConfigured = errors.New("语音合成服务未配置")
+
+// Provider 语音合成服务商
+type Provider interface {
+	// Name 返回服务商标识，与 tts.provider 配置值一致
+	Name() string
+	// Voice 返回实际使用的发音人
+	Voice() string
+	// Synthesize 将一段文本合成为 MP3 音频
+	Synthesize(ctx context.Context, text string) ([]byte, error)
+}
+
+// Service 文章语音朗读服务
+type Service struct {
+	settingSvc    setting.SettingService
+	articleRepo   repository.ArticleRepository
+	audioRepo     repository.ArticleAudioRepository
+	fileSvc       file.FileService
+	directLinkSvc direct_link.Service
+	bus           *event.EventBus
+	client        *http.Client
+	group         singleflight.Group
+}
+
+// NewService 创建文章语音朗读服务，client 应带有超时与出站防护
+func NewService(
+	settingSvc setting.SettingService,
+	articleRepo repository.ArticleRepository,
+	audioRepo repository.ArticleAudioRepository,
+	fileSvc file.FileService,
+	directLinkSvc direct_link.Service,
+	bus *event.EventBus,
+	client *http.Client,
+) *Service {
+	return &Service{
+		settingSvc:    settingSvc,
+		articleRepo:   articleRepo,
+		audioRepo:     audioRepo,
+		fileSvc:       fileSvc,
+		directLinkSvc: directLinkSvc,
+		bus:           bus,
+		client:        client,
+	}
+}
+
+// Subscribe 订阅文章事件：文章处于发布状态且内容变化时在后台生成音频，文章删除时清理音频
+func (s *Service) Subscribe() {
+	s.bus.Subscribe(event.ArticleCreated, s.onArticleChange)
+	s.bus.Subscribe(event.ArticleUpdated, s.onArticleChange)
+	s.bus.Subscribe(event.ArticleDeleted, s.onArticleDeleted)
+}
+
+func (s *Service) onArticleChange(payload interface{}) {
+	p, ok := payload.(*event.ArticlePayload)
+	if !ok || p.PublicID == "" {
+		return
+	}
+	if strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSProvider.String())) == "" {
+		return
+	}
+	publicID := p.PublicID
+	workerpool.Go(workerpool.CategoryIndexing, func() {
+		// 未配置或文章未发布（草稿保存也会触发更新事件）时静默跳过
+		if _, err := s.Generate(context.Background(), publicID, false); err != nil && !errors.Is(err, ErrNotConfigured) && !errors.Is(err, constant.ErrBadRequest) {
+			log.Printf("[TTS] 生成文章 %s 的语音失败: %v", publicID, err)
+		}
+	})
+}
+
+func (s *Service) onArticleDeleted(payload interface{}) {
+	p, ok := payload.(*event.ArticlePayload)
+	if !ok || p.PublicID == "" {
+		return
+	}
+	articleID, err := decodeArticleID(p.PublicID)
+	if err != nil {
+		return
+	}
+	ctx := context.Background()
+	audio, err := s.audioRepo.FindByArticleID(ctx, articleID)
+	if err != nil || audio == nil {
+		return
+	}
+	if audio.FilePublicID != "" {
+		if err := s.fileSvc.DeleteItemsPermanently(ctx, systemOwnerID, []string{audio.FilePublicID}); err != nil {
+			log.Printf("[TTS] 删除文章 %d 的音频文件失败: %v", articleID, err)
+		}
+	}
+	if err := s.audioRepo.DeleteByArticleID(ctx, articleID); err != nil {
+		log.Printf("[TTS] 删除文章 %d 的语音记录失败: %v", articleID, err)
+	}
+}
+
+// configuredProvider 根据设置创建服务商实例，每次调用读取最新配置
+func (s *Service) configuredProvider() (Provider, error) {
+	apiURL := strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSAPIURL.String()))
+	apiKey := strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSAPIKey.String()))
+	voice := strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSVoice.String()))
+	modelName := strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSModel.String()))
+
+	switch strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSProvider.String())) {
+	case ProviderOpenAI:
+		if apiKey == "" {
+			return nil, ErrNotConfigured
+		}
+		if apiURL == "" {
+			apiURL = defaultOpenAIBaseURL
+		}
+		if voice == "" {
+			voice = defaultOpenAIVoice
+		}
+		return &OpenAI{client: s.client, name: ProviderOpenAI, baseURL: apiURL, key: apiKey, model: modelName, voice: voice}, nil
+	case ProviderEdge:
+		// Edge-TTS 没有官方 HTTP 接口，通过自建的 OpenAI 兼容网关调用，Key 可为空
+		if apiURL == "" {
+			return nil, ErrNotConfigured
+		}
+		if voice == "" {
+			voice = defaultNeuralVoice
+		}
+		return &OpenAI{client: s.client, name: ProviderEdge, baseURL: apiURL, key: apiKey, model: modelName, voice: voice}, nil
+	case ProviderAzure:
+		if apiKey == "" || apiURL == "" {
+			return nil, ErrNotConfigured
+		}
+		if voice == "" {
+			voice = defaultNeuralVoice
+		}
+		return &Azure{client: s.client, region: apiURL, key: apiKey, voice: voice}, nil
+	default:
+		return nil, ErrNotConfigured
+	}
+}
+
+// Get 返回文章当前的语音记录，尚未生成时返回 nil
+func (s *Service) Get(ctx context.Context, articlePublicID string) (*model.ArticleAudio, error) {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return nil, err
+	}
+	return s.audioRepo.FindByArticleID(ctx, articleID)
+}
+
+// Generate 为已发布的文章生成语音。内容与发音人未变化且已有可用音频时直接返回现有记录，
+// force 为 true 时强制重新生成。同一篇文章的并发请求会合并为一次生成。
+func (s *Service) Generate(ctx context.Context, articlePublicID string, force bool) (*model.ArticleAudio, error) {
+	articleID, err := decodeArticleID(articlePublicID)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.configuredProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	v, err, _ := s.group.Do(strconv.FormatUint(uint64(articleID), 10), func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), generateTimeout)
+		defer cancel()
+		return s.generate(ctx, articleID, articlePublicID, provider, force)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*model.ArticleAudio), nil
+}
+
+func (s *Service) generate(ctx context.Context, articleID uint, articlePublicID string, provider Provider, force bool) (*model.ArticleAudio, error) {
+	article, err := s.articleRepo.GetByID(ctx, articlePublicID)
+	if err != nil {
+		return nil, fmt.Errorf("获取文章失败: %w", err)
+	}
+	if article.Status != "PUBLISHED" {
+		return nil, fmt.Errorf("只能为已发布的文章生成语音: %w", constant.ErrBadRequest)
+	}
+
+	text := speechText(article.Title, article.ContentHTML, s.maxChars())
+	if text == "" {
+		return nil, fmt.Errorf("文章没有可朗读的文本: %w", constant.ErrBadRequest)
+	}
+	hash := contentHash(provider, text)
+
+	existing, err := s.audioRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("查询文章语音失败: %w", err)
+	}
+	if existing != nil && existing.ContentHash == hash && existing.Status == model.ArticleAudioReady && !force {
+		return existing, nil
+	}
+
+	audio := &model.ArticleAudio{
+		ArticleID:   articleID,
+		Status:      model.ArticleAudioPending,
+		Provider:    provider.Name(),
+		Voice:       provider.Voice(),
+		ContentHash: hash,
+		CharCount:   len([]rune(text)),
+	}
+	var oldFileID string
+	if existing != nil {
+		oldFileID = existing.FilePublicID
+		audio.FilePublicID, audio.URL = existing.FilePublicID, existing.URL
+	}
+	if err := s.audioRepo.Save(ctx, audio); err != nil {
+		return nil, fmt.Errorf("保存文章语音状态失败: %w", err)
+	}
+
+	fileID, url, err := s.synthesizeAndStore(ctx, provider, text, articleID, hash)
+	if err != nil {
+		audio.Status = model.ArticleAudioFailed
+		audio.ErrorMessage = err.Error()
+		if saveErr := s.audioRepo.Save(ctx, audio); saveErr != nil {
+			log.Printf("[TTS] 保存文章 %d 的失败状态时出错: %v", articleID, saveErr)
+		}
+		return nil, err
+	}
+
+	audio.Status = model.ArticleAudioReady
+	audio.FilePublicID, audio.URL, audio.ErrorMessage = fileID, url, ""
+	if err := s.audioRepo.Save(ctx, audio); err != nil {
+		return nil, fmt.Errorf("保存文章语音失败: %w", err)
+	}
+	if oldFileID != "" && oldFileID != fileID {
+		if err := s.fileSvc.DeleteItemsPermanently(ctx, systemOwnerID, []string{oldFileID}); err != nil {
+			log.Printf("[TTS] 删除文章 %d 的旧音频文件 %s 失败: %v", articleID, oldFileID, err)
+		}
+	}
+	// 通知前端刷新文章缓存；内容哈希未变时不会再次生成，不会形成循环
+	if s.bus != nil {
+		s.bus.Publish(event.ArticleUpdated, &event.ArticlePayload{Slug: article.Abbrlink, PublicID: articlePublicID})
+	}
+	log.Printf("[TTS] 文章 %s 的语音已生成（%s，%d 字）", articlePublicID, provider.Name(), audio.CharCount)
+	return s.audioRepo.FindByArticleID(ctx, articleID)
+}
+
+// synthesizeAndStore 分段合成音频并拼接为一个 MP3 文件，上传到文章存储策略后返回文件公共ID与直链
+func (s *Service) synthesizeAndStore(ctx context.Context, provider Provider, text string, articleID uint, hash string) (string, string, error) {
+	var buf bytes.Buffer
+	for i, chunk := range splitText(text, chunkSize) {
+		data, err := provider.Synthesize(ctx, chunk)
+		if err != nil {
+			return "", "", fmt.Errorf("合成第 %d 段语音失败: %w", i+1, err)
+		}
+		buf.Write(data)
+	}
+
+	filename := fmt.Sprintf("tts-%d-%s.mp3", articleID, hash[:8])
+	fileItem, err := s.fileSvc.UploadFileByPolicyFlagWithGroup(ctx, systemOwnerID, 0, &buf, constant.PolicyFlagArticleImage, filename)
+	if err != nil {
+		return "", "", fmt.Errorf("上传音频文件失败: %w", err)
+	}
+	dbFileID, _, err := idgen.DecodePublicID(fileItem.ID)
+	if err != nil {
+		return "", "", fmt.Errorf("无效的文件ID: %w", err)
+	}
+	links, err := s.directLinkSvc.GetOrCreateDirectLinks(ctx, systemOwnerID, []uint{dbFileID})
+	if err != nil {
+		return "", "", fmt.Errorf("创建音频直链失败: %w", err)
+	}
+	link, ok := links[dbFileID]
+	if !ok || link.URL == "" {
+		return "", "", fmt.Errorf("获取音频直链失败")
+	}
+	return fileItem.ID, link.URL, nil
+}
+
+// maxChars 读取单篇文章参与朗读的字符上限
+func (s *Service) maxChars() int {
+	n, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyTTSMaxChars.String())))
+	if err != nil || n <= 0 {
+		return defaultMaxChars
+	}
+	return n
+}
+
+// contentHash 由服务商、发音人与朗读文本共同决定，任一变化都需要重新生成
+func contentHash(provider Provider, text string) string {
+	sum := sha256.Sum256([]byte(provider.Name() + "\x00" + provider.Voice() + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+func decodeArticleID(publicID string) (uint, error) {
+	id, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeArticle {
+		return 0, fmt.Errorf("无效的文章ID: %w", constant.ErrBadRequest)
+	}
+	return id, nil
+}
+
+// readAudio 读取服务商返回的音频，非 2xx 状态码时返回截断后的错误信息，错误中的 API Key 会被隐藏
+func readAudio(resp *http.Response, apiKey string) ([]byte, error) {
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		msg := strings.TrimSpace(string(body))
+		if apiKey != "" {
+			msg = strings.ReplaceAll(msg, apiKey, "***")
+		}
+		return nil, fmt.Errorf("语音合成服务返回状态码 %d: %s", resp.StatusCode, msg)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxAudioSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxAudioSize {
+		return nil, fmt.Errorf("语音合成服务返回的音频超过 %d 字节", maxAudioSize)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("语音合成服务返回了空音频")
+	}
+	return data, nil
+}
+
+// redactKey 隐藏请求错误中的 API Key
+func redactKey(err error, apiKey string) error {
+	if apiKey == "" {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), apiKey, "***"))
+}
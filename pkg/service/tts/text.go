@@ -0,0 +1,87 @@
+package tts
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// unspokenBlockRegex 匹配不适合朗读的整块内容：代码、脚本、样式、公式与图表
+	unspokenBlockRegex = regexp.MustCompile(`(?is)<(pre|script|style|svg|math|figure)\b[^>]*>.*?</(pre|script|style|svg|math|figure)>`)
+	// blockBreakRegex 匹配块级元素边界，替换为换行以保留段落停顿
+	blockBreakRegex = regexp.MustCompile(`(?i)</?(p|div|h[1-6]|li|br|tr|blockquote|section)\b[^>]*>`)
+	htmlTagRegex    = regexp.MustCompile(`<[^>]*>`)
+	spaceRegex      = regexp.MustCompile(`[ \t\r\f\v]+`)
+	blankLineRegex  = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// speechText 从文章标题与 HTML 正文中提取朗读文本，去掉代码块等无法朗读的内容，
+// 超过 maxChars 个字符时在句子边界处截断
+func speechText(title, contentHTML string, maxChars int) string {
+	body := unspokenBlockRegex.ReplaceAllString(contentHTML, "\n")
+	body = blockBreakRegex.ReplaceAllString(body, "\n")
+	body = html.UnescapeString(htmlTagRegex.ReplaceAllString(body, ""))
+	body = spaceRegex.ReplaceAllString(body, " ")
+	body = blankLineRegex.ReplaceAllString(body, "\n")
+
+	var lines []string
+	if title = strings.TrimSpace(title); title != "" {
+		lines = append(lines, title+"。")
+	}
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	text := strings.Join(lines, "\n")
+	if maxChars > 0 && utf8.RuneCountInString(text) > maxChars {
+		text = splitText(text, maxChars)[0]
+	}
+	return text
+}
+
+// splitText 按句子边界把文本切分为不超过 size 个字符的片段，单个句子过长时强制切分
+func splitText(text string, size int) []string {
+	var chunks []string
+	var current []rune
+	flush := func() {
+		if s := strings.TrimSpace(string(current)); s != "" {
+			chunks = append(chunks, s)
+		}
+		current = current[:0]
+	}
+	for _, sentence := range splitSentences(text) {
+		runes := []rune(sentence)
+		if len(current)+len(runes) > size {
+			flush()
+		}
+		for len(runes) > size {
+			current = append(current, runes[:size]...)
+			flush()
+			runes = runes[size:]
+		}
+		current = append(current, runes...)
+	}
+	flush()
+	return chunks
+}
+
+// splitSentences 在中英文句末标点与换行之后切分，标点保留在句子末尾
+func splitSentences(text string) []string {
+	var sentences []string
+	start := 0
+	for i, r := range text {
+		switch r {
+		case '。', '！', '？', '；', '!', '?', ';', '.', '\n':
+			end := i + utf8.RuneLen(r)
+			sentences = append(sentences, text[start:end])
+			start = end
+		}
+	}
+	if start < len(text) {
+		sentences = append(sentences, text[start:])
+	}
+	return sentences
+}
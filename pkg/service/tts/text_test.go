@@ -0,0 +1,39 @@
+package tts
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSpeechText_SkipsCodeAndKeepsParagraphs(t *testing.T) {
+	html := `<h2>简介</h2><p>第一段&amp;说明。</p><pre><code>fmt.Println("hi")</code></pre><p>第二段<strong>重点</strong></p>`
+	got := speechText("标题", html, 0)
+	want := "标题。\n简介\n第一段&说明。\n第二段重点"
+	if got != want {
+		t.Errorf("speechText() = %q, want %q", got, want)
+	}
+}
+
+func TestSpeechText_TruncatesAtSentenceBoundary(t *testing.T) {
+	got := speechText("", "<p>一二三。四五六。七八九。</p>", 7)
+	if got != "一二三。" {
+		t.Errorf("超出上限时应在句子边界截断, got %q", got)
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	text := strings.Repeat("句子。", 5) + strings.Repeat("长", 12)
+	chunks := splitText(text, 10)
+	if strings.Join(chunks, "") != text {
+		t.Fatalf("切分后拼接应还原原文: %q", chunks)
+	}
+	for _, c := range chunks {
+		if utf8.RuneCountInString(c) > 10 {
+			t.Errorf("片段 %q 超过上限", c)
+		}
+	}
+	if chunks[0] != "句子。句子。句子。" {
+		t.Errorf("应优先在句子边界切分, got %q", chunks[0])
+	}
+}
@@ -0,0 +1,185 @@
+/*
+ * @Description: 绝对 URL 前缀替换
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 17:00:00
+ * @LastEditTime: 2026-10-17 17:00:00
+ * @LastEditors: 安知鱼
+ */
+package url_migration
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+const (
+	// snippetContext 预览片段中匹配位置前后保留的字节数
+	snippetContext = 40
+	// maxSnippetsPerField 每个字段最多返回的预览片段数
+	maxSnippetsPerField = 3
+)
+
+// Snippet 一处替换的前后对比片段
+type Snippet struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// rewriter 将内容中以旧地址开头的绝对 URL 替换为新地址。
+// 只替换完整的地址前缀：https://old.com 不会匹配 https://old.com.cn 或 https://old.community。
+type rewriter struct {
+	from []string
+	to   string
+}
+
+type match struct {
+	start, end int
+}
+
+// newRewriter 校验并规范化新旧地址。两者都必须是 http(s) 绝对地址，末尾的斜杠会被去除；
+// bothSchemes 为 true 时同时替换旧地址的 http 与 https 两种写法。
+func newRewriter(from, to string, bothSchemes bool) (*rewriter, error) {
+	from, err := normalizeOrigin(from)
+	if err != nil {
+		return nil, fmt.Errorf("旧地址无效: %w", err)
+	}
+	to, err = normalizeOrigin(to)
+	if err != nil {
+		return nil, fmt.Errorf("新地址无效: %w", err)
+	}
+	if from == to {
+		return nil, fmt.Errorf("新旧地址相同: %w", constant.ErrBadRequest)
+	}
+
+	r := &rewriter{from: []string{from}, to: to}
+	if bothSchemes {
+		if rest, ok := strings.CutPrefix(from, "https://"); ok {
+			r.from = append(r.from, "http://"+rest)
+		} else if rest, ok := strings.CutPrefix(from, "http://"); ok {
+			r.from = append(r.from, "https://"+rest)
+		}
+	}
+	return r, nil
+}
+
+func normalizeOrigin(raw string) (string, error) {
+	raw = strings.TrimRight(strings.TrimSpace(raw), "/")
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("'%s' 不是 http(s) 绝对地址: %w", raw, constant.ErrBadRequest)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("'%s' 不能包含查询参数、锚点或用户信息: %w", raw, constant.ErrBadRequest)
+	}
+	return raw, nil
+}
+
+// find 返回内容中所有需要替换的旧地址位置
+func (r *rewriter) find(s string) []match {
+	var matches []match
+	for i := 0; i < len(s); {
+		best := match{start: -1}
+		for _, prefix := range r.from {
+			idx := strings.Index(s[i:], prefix)
+			for idx >= 0 && !isBoundary(s, i+idx+len(prefix)) {
+				next := strings.Index(s[i+idx+1:], prefix)
+				if next < 0 {
+					idx = -1
+					break
+				}
+				idx += next + 1
+			}
+			if idx >= 0 && (best.start < 0 || i+idx < best.start) {
+				best = match{start: i + idx, end: i + idx + len(prefix)}
+			}
+		}
+		if best.start < 0 {
+			break
+		}
+		matches = append(matches, best)
+		i = best.end
+	}
+	return matches
+}
+
+// Rewrite 替换内容中的旧地址，返回新内容与替换次数
+func (r *rewriter) Rewrite(s string) (string, int) {
+	matches := r.find(s)
+	if len(matches) == 0 {
+		return s, 0
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	last := 0
+	for _, m := range matches {
+		b.WriteString(s[last:m.start])
+		b.WriteString(r.to)
+		last = m.end
+	}
+	b.WriteString(s[last:])
+	return b.String(), len(matches)
+}
+
+// Snippets 返回前几处替换的前后对比片段
+func (r *rewriter) Snippets(s string) []Snippet {
+	matches := r.find(s)
+	if len(matches) > maxSnippetsPerField {
+		matches = matches[:maxSnippetsPerField]
+	}
+	snippets := make([]Snippet, 0, len(matches))
+	for _, m := range matches {
+		start := runeStart(s, m.start-snippetContext)
+		end := runeStart(s, m.end+snippetContext)
+		prefix, suffix := s[start:m.start], s[m.end:end]
+		if start > 0 {
+			prefix = "…" + prefix
+		}
+		if end < len(s) {
+			suffix += "…"
+		}
+		snippets = append(snippets, Snippet{
+			Before: prefix + s[m.start:m.end] + suffix,
+			After:  prefix + r.to + suffix,
+		})
+	}
+	return snippets
+}
+
+// isBoundary 判断旧地址之后的字符是否结束了地址的主机或路径段
+func isBoundary(s string, i int) bool {
+	if i >= len(s) {
+		return true
+	}
+	switch c := s[i]; c {
+	case '/', '?', '#':
+		return true
+	case '.':
+		// 句末的点号不属于地址
+		return i+1 >= len(s) || !isURLChar(s[i+1])
+	default:
+		return !isURLChar(c)
+	}
+}
+
+func isURLChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.IndexByte("-._~%:@+", c) >= 0
+}
+
+// runeStart 将字节位置限制在 [0, len(s)] 内，并回退到最近的字符起始位置
+func runeStart(s string, i int) int {
+	if i <= 0 {
+		return 0
+	}
+	if i >= len(s) {
+		return len(s)
+	}
+	for i > 0 && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
@@ -0,0 +1,79 @@
+package url_migration
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+func TestRewrite(t *testing.T) {
+	r, err := newRewriter("https://old.com/", "https://new.com", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		in, want string
+		count    int
+	}{
+		{"![图](https://old.com/img/a.png)", "![图](https://new.com/img/a.png)", 1},
+		{`<a href="https://old.com">首页</a>`, `<a href="https://new.com">首页</a>`, 1},
+		{"访问 https://old.com。或 https://old.com?q=1#top", "访问 https://new.com。或 https://new.com?q=1#top", 2},
+		{"见 https://old.com.", "见 https://new.com.", 1},
+		{"https://old.com.cn/a https://old.community https://old.com:8080", "https://old.com.cn/a https://old.community https://old.com:8080", 0},
+		{"http://old.com/a", "http://old.com/a", 0},
+	}
+	for _, c := range cases {
+		got, count := r.Rewrite(c.in)
+		if got != c.want || count != c.count {
+			t.Errorf("Rewrite(%q) = %q, %d; 期望 %q, %d", c.in, got, count, c.want, c.count)
+		}
+	}
+}
+
+func TestRewritePathPrefixAndBothSchemes(t *testing.T) {
+	r, err := newRewriter("https://example.com/blog", "https://blog.example.com", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := "http://example.com/blog/p/1 https://example.com/blogger https://example.com/blog"
+	want := "https://blog.example.com/p/1 https://example.com/blogger https://blog.example.com"
+	if got, count := r.Rewrite(in); got != want || count != 2 {
+		t.Errorf("Rewrite = %q, %d; 期望 %q, 2", got, count, want)
+	}
+}
+
+func TestSnippets(t *testing.T) {
+	r, _ := newRewriter("https://old.com", "https://new.com", false)
+	content := strings.Repeat("文", 30) + "![](https://old.com/a.png)" + strings.Repeat("字", 30)
+	snippets := r.Snippets(content)
+	if len(snippets) != 1 {
+		t.Fatalf("应返回一个片段，实际 %d", len(snippets))
+	}
+	s := snippets[0]
+	if !strings.HasPrefix(s.Before, "…") || !strings.HasSuffix(s.Before, "…") ||
+		!strings.Contains(s.Before, "https://old.com/a.png") || !strings.Contains(s.After, "https://new.com/a.png") {
+		t.Errorf("片段不正确: %+v", s)
+	}
+	if strings.ContainsRune(s.Before, '�') {
+		t.Errorf("片段不应截断多字节字符: %q", s.Before)
+	}
+}
+
+func TestNewRewriterValidation(t *testing.T) {
+	cases := [][2]string{
+		{"old.com", "https://new.com"},
+		{"https://old.com", "ftp://new.com"},
+		{"https://old.com?a=1", "https://new.com"},
+		{"https://old.com/", "https://old.com"},
+	}
+	for _, c := range cases {
+		if _, err := newRewriter(c[0], c[1], false); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("newRewriter(%q, %q) 应返回 ErrBadRequest，实际 %v", c[0], c[1], err)
+		}
+	}
+	if _, err := parseScopes([]string{"article", "page"}); !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("未知的替换范围应返回 ErrBadRequest，实际 %v", err)
+	}
+}
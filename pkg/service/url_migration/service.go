@@ -0,0 +1,346 @@
+/*
+ * @Description: 站点地址迁移：批量替换文章、评论与配置中的绝对 URL
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 17:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package url_migration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/article"
+	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/compression"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	setting_service "github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// 替换范围
+const (
+	ScopeArticle = "article"
+	ScopeComment = "comment"
+	ScopeSetting = "setting"
+)
+
+const (
+	// batchSize 分批读取文章与评论的数量
+	batchSize = 200
+	// maxReportChanges 报告中最多列出的变更字段数，超出时只统计数量
+	maxReportChanges = 500
+)
+
+// Options 地址迁移选项
+type Options struct {
+	// From 旧地址，如 https://old.example.com 或 https://example.com/blog
+	From string `json:"from" binding:"required"`
+	// To 新地址
+	To string `json:"to" binding:"required"`
+	// MatchBothSchemes 同时替换旧地址的 http 与 https 写法
+	MatchBothSchemes bool `json:"match_both_schemes"`
+	// Scopes 替换范围（article / comment / setting），为空时全部替换
+	Scopes []string `json:"scopes"`
+}
+
+// Change 单个字段的替换结果
+type Change struct {
+	Scope    string    `json:"scope"`
+	ID       string    `json:"id"`
+	Title    string    `json:"title,omitempty"`
+	Field    string    `json:"field"`
+	Count    int       `json:"count"`
+	Snippets []Snippet `json:"snippets"`
+}
+
+// Report 地址迁移报告
+type Report struct {
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	DryRun       bool     `json:"dry_run"`
+	Articles     int      `json:"articles"`
+	Comments     int      `json:"comments"`
+	Settings     int      `json:"settings"`
+	Replacements int      `json:"replacements"`
+	Changes      []Change `json:"changes"`
+	// Truncated 变更数超过上限，Changes 只包含前 maxReportChanges 条
+	Truncated bool `json:"truncated"`
+}
+
+// ArticleRefresher 文章内容改写后的缓存清理与索引更新，由文章服务实现
+type ArticleRefresher interface {
+	HandleContentRewritten(ctx context.Context, publicIDs []string)
+}
+
+// Service 站点地址迁移服务
+type Service struct {
+	db         *ent.Client
+	settingSvc setting_service.SettingService
+	articles   ArticleRefresher
+}
+
+// NewService 创建站点地址迁移服务实例
+func NewService(db *ent.Client, settingSvc setting_service.SettingService, articles ArticleRefresher) *Service {
+	return &Service{
+		db:         db,
+		settingSvc: settingSvc,
+		articles:   articles,
+	}
+}
+
+// field 待替换的字段：value 为当前值，set 在更新语句中写入新值
+type field[U any] struct {
+	name  string
+	value string
+	set   func(u U, v string) U
+}
+
+// Preview 预览替换结果，不修改任何数据
+func (s *Service) Preview(ctx context.Context, opts Options) (*Report, error) {
+	return s.run(ctx, opts, true)
+}
+
+// Apply 执行替换。文章与评论在同一事务中更新，配置经由配置服务更新以刷新内存缓存；
+// 完成后清除受影响文章的缓存并重新索引。
+func (s *Service) Apply(ctx context.Context, opts Options) (*Report, error) {
+	report, err := s.run(ctx, opts, false)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[地址迁移] %s -> %s：文章 %d 篇，评论 %d 条，配置 %d 项，共替换 %d 处",
+		report.From, report.To, report.Articles, report.Comments, report.Settings, report.Replacements)
+	return report, nil
+}
+
+func (s *Service) run(ctx context.Context, opts Options, dryRun bool) (*Report, error) {
+	r, err := newRewriter(opts.From, opts.To, opts.MatchBothSchemes)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := parseScopes(opts.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{From: r.from[0], To: r.to, DryRun: dryRun, Changes: []Change{}}
+	var rewrittenArticles []string
+	err = withTx(ctx, s.db, dryRun, func(tx *ent.Tx) error {
+		if scopes[ScopeArticle] {
+			ids, err := rewriteArticles(ctx, tx, r, report, dryRun)
+			if err != nil {
+				return err
+			}
+			rewrittenArticles = ids
+		}
+		if scopes[ScopeComment] {
+			if err := rewriteComments(ctx, tx, r, report, dryRun); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if scopes[ScopeSetting] {
+		if err := s.rewriteSettings(ctx, r, report, dryRun); err != nil {
+			return nil, err
+		}
+	}
+
+	if !dryRun && len(rewrittenArticles) > 0 && s.articles != nil {
+		s.articles.HandleContentRewritten(ctx, rewrittenArticles)
+	}
+	return report, nil
+}
+
+func rewriteArticles(ctx context.Context, tx *ent.Tx, r *rewriter, report *Report, dryRun bool) ([]string, error) {
+	var rewritten []string
+	for lastID := uint(0); ; {
+		list, err := tx.Article.Query().
+			Where(article.DeletedAtIsNil(), article.IDGT(lastID)).
+			Order(ent.Asc(article.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询文章失败: %w", err)
+		}
+		for _, a := range list {
+			lastID = a.ID
+			publicID, _ := idgen.GeneratePublicID(a.ID, idgen.EntityTypeArticle)
+			// 正文可能以压缩格式存储，解压后替换，写回时按当前设置重新压缩
+			contentMd, err := compression.DecompressText(a.ContentMd)
+			if err != nil {
+				return nil, fmt.Errorf("解压文章 %s 的 Markdown 正文失败: %w", publicID, err)
+			}
+			contentHTML, err := compression.DecompressText(a.ContentHTML)
+			if err != nil {
+				return nil, fmt.Errorf("解压文章 %s 的 HTML 正文失败: %w", publicID, err)
+			}
+			fields := []field[*ent.ArticleUpdateOne]{
+				{"content_md", contentMd, func(u *ent.ArticleUpdateOne, v string) *ent.ArticleUpdateOne {
+					return u.SetContentMd(compression.MaybeCompressText(v))
+				}},
+				{"content_html", contentHTML, func(u *ent.ArticleUpdateOne, v string) *ent.ArticleUpdateOne {
+					return u.SetContentHTML(compression.MaybeCompressText(v))
+				}},
+				{"cover_url", a.CoverURL, (*ent.ArticleUpdateOne).SetCoverURL},
+				{"top_img_url", a.TopImgURL, (*ent.ArticleUpdateOne).SetTopImgURL},
+				{"copyright_url", a.CopyrightURL, (*ent.ArticleUpdateOne).SetCopyrightURL},
+				{"copyright_author_href", a.CopyrightAuthorHref, (*ent.ArticleUpdateOne).SetCopyrightAuthorHref},
+				{"link_url", a.LinkURL, (*ent.ArticleUpdateOne).SetLinkURL},
+			}
+			// 与编辑保存一致刷新更新时间，订阅与缓存按更新时间判断内容是否变化
+			update := tx.Article.UpdateOneID(a.ID).SetUpdatedAt(time.Now())
+			update, changed := rewriteFields(r, report, ScopeArticle, publicID, a.Title, fields, update)
+			if !changed {
+				continue
+			}
+			report.Articles++
+			rewritten = append(rewritten, publicID)
+			if !dryRun {
+				if err := update.Exec(ctx); err != nil {
+					return nil, fmt.Errorf("更新文章 %s 失败: %w", publicID, err)
+				}
+			}
+		}
+		if len(list) < batchSize {
+			return rewritten, nil
+		}
+	}
+}
+
+func rewriteComments(ctx context.Context, tx *ent.Tx, r *rewriter, report *Report, dryRun bool) error {
+	for lastID := uint(0); ; {
+		list, err := tx.Comment.Query().
+			Where(comment.DeletedAtIsNil(), comment.IDGT(lastID)).
+			Order(ent.Asc(comment.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("查询评论失败: %w", err)
+		}
+		for _, c := range list {
+			lastID = c.ID
+			publicID, _ := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+			fields := []field[*ent.CommentUpdateOne]{
+				{"content", c.Content, (*ent.CommentUpdateOne).SetContent},
+				{"content_html", c.ContentHTML, (*ent.CommentUpdateOne).SetContentHTML},
+			}
+			title := c.TargetPath
+			if c.TargetTitle != nil && *c.TargetTitle != "" {
+				title = *c.TargetTitle
+			}
+			update := tx.Comment.UpdateOneID(c.ID).SetUpdatedAt(c.UpdatedAt)
+			update, changed := rewriteFields(r, report, ScopeComment, publicID, title, fields, update)
+			if !changed {
+				continue
+			}
+			report.Comments++
+			if !dryRun {
+				if err := update.Exec(ctx); err != nil {
+					return fmt.Errorf("更新评论 %s 失败: %w", publicID, err)
+				}
+			}
+		}
+		if len(list) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) rewriteSettings(ctx context.Context, r *rewriter, report *Report, dryRun bool) error {
+	list, err := s.db.Setting.Query().Order(ent.Asc(setting.FieldConfigKey)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询配置失败: %w", err)
+	}
+	updates := make(map[string]string)
+	for _, item := range list {
+		fields := []field[map[string]string]{{
+			name:  "value",
+			value: item.Value,
+			set: func(u map[string]string, v string) map[string]string {
+				u[item.ConfigKey] = v
+				return u
+			},
+		}}
+		if _, changed := rewriteFields(r, report, ScopeSetting, item.ConfigKey, "", fields, updates); changed {
+			report.Settings++
+		}
+	}
+	if dryRun || len(updates) == 0 {
+		return nil
+	}
+	if err := s.settingSvc.UpdateSettings(ctx, updates); err != nil {
+		return fmt.Errorf("更新配置失败: %w", err)
+	}
+	return nil
+}
+
+// rewriteFields 替换各字段中的旧地址并写入更新，同时把变更记录到报告中
+func rewriteFields[U any](r *rewriter, report *Report, scope, id, title string, fields []field[U], update U) (U, bool) {
+	changed := false
+	for _, f := range fields {
+		rewritten, count := r.Rewrite(f.value)
+		if count == 0 {
+			continue
+		}
+		changed = true
+		update = f.set(update, rewritten)
+		report.Replacements += count
+		if len(report.Changes) >= maxReportChanges {
+			report.Truncated = true
+			continue
+		}
+		report.Changes = append(report.Changes, Change{
+			Scope:    scope,
+			ID:       id,
+			Title:    title,
+			Field:    f.name,
+			Count:    count,
+			Snippets: r.Snippets(f.value),
+		})
+	}
+	return update, changed
+}
+
+func parseScopes(scopes []string) (map[string]bool, error) {
+	if len(scopes) == 0 {
+		return map[string]bool{ScopeArticle: true, ScopeComment: true, ScopeSetting: true}, nil
+	}
+	result := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		switch scope {
+		case ScopeArticle, ScopeComment, ScopeSetting:
+			result[scope] = true
+		default:
+			return nil, fmt.Errorf("未知的替换范围 '%s': %w", scope, constant.ErrBadRequest)
+		}
+	}
+	return result, nil
+}
+
+// withTx 在事务中执行 fn；预览时同样读取事务内的数据，但最终回滚
+func withTx(ctx context.Context, client *ent.Client, rollback bool, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: 回滚事务失败: %v", err, rerr)
+		}
+		return err
+	}
+	if rollback {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,85 @@
+package url_migration
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	_ "github.com/anzhiyu-c/anheyu-app/ent/runtime"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/compression"
+)
+
+type fakeRefresher struct{ ids []string }
+
+func (f *fakeRefresher) HandleContentRewritten(ctx context.Context, publicIDs []string) {
+	f.ids = append(f.ids, publicIDs...)
+}
+
+func TestApplyRewritesCompressedArticleContent(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:"+t.TempDir()+"/test.db?_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	body := strings.Repeat("![图](https://old.example.com/a.png) 正文内容 ", 100)
+	stored := compression.CompressText(body)
+	if !compression.IsCompressed(stored) {
+		t.Fatal("测试正文应以压缩格式存储")
+	}
+	oldTime := time.Now().Add(-24 * time.Hour)
+	a, err := client.Article.Create().
+		SetOwnerID(1).
+		SetTitle("测试").
+		SetContentMd(stored).
+		SetContentHTML(stored).
+		SetUpdatedAt(oldTime).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refresher := &fakeRefresher{}
+	svc := NewService(client, nil, refresher)
+	report, err := svc.Apply(ctx, Options{From: "https://old.example.com", To: "https://new.example.com", Scopes: []string{ScopeArticle}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Articles != 1 || report.Replacements != 200 {
+		t.Fatalf("报告错误: 文章 %d 篇，替换 %d 处", report.Articles, report.Replacements)
+	}
+
+	got, err := client.Article.Get(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, err := compression.DecompressText(got.ContentMd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(md, "old.example.com") || !strings.Contains(md, "https://new.example.com/a.png") {
+		t.Fatal("压缩存储的正文应被解压替换")
+	}
+	if !compression.IsCompressed(got.ContentMd) {
+		t.Fatal("写回时应重新压缩")
+	}
+	if !got.UpdatedAt.After(oldTime) {
+		t.Fatal("替换后应刷新更新时间")
+	}
+	if len(refresher.ids) != 1 {
+		t.Fatalf("应通知文章服务刷新缓存与索引: %v", refresher.ids)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * @Description: 用户与用户组管理操作的审计日志快照
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 03:00:00
+ * @LastEditTime: 2026-10-17 03:00:00
+ * @LastEditors: 安知鱼
+ */
+package user
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+)
+
+// userAuditSnapshot 审计日志中记录的用户字段，密码只记录是否被重置
+type userAuditSnapshot struct {
+	Username        string `json:"username"`
+	Email           string `json:"email"`
+	Nickname        string `json:"nickname"`
+	UserGroupID     uint   `json:"user_group_id"`
+	Status          int    `json:"status"`
+	PasswordChanged bool   `json:"password_changed,omitempty"`
+}
+
+func newUserAuditSnapshot(u *model.User) *userAuditSnapshot {
+	return &userAuditSnapshot{
+		Username:    u.Username,
+		Email:       u.Email,
+		Nickname:    u.Nickname,
+		UserGroupID: u.UserGroupID,
+		Status:      u.Status,
+	}
+}
+
+// userGroupAuditSnapshot 审计日志中记录的用户组字段
+type userGroupAuditSnapshot struct {
+	Name         string                   `json:"name"`
+	Permissions  []string                 `json:"permissions"`
+	UploadPolicy *model.GroupUploadPolicy `json:"upload_policy,omitempty"`
+}
+
+func newUserGroupAuditSnapshot(g *model.UserGroup) *userGroupAuditSnapshot {
+	snapshot := &userGroupAuditSnapshot{
+		Name:        g.Name,
+		Permissions: g.Permissions.Names(),
+	}
+	if g.Settings.UploadPolicy != nil {
+		policy := *g.Settings.UploadPolicy
+		snapshot.UploadPolicy = &policy
+	}
+	return snapshot
+}
+
+// SetAuditService 注入审计日志服务，记录管理员对用户与用户组的修改
+func (s *userService) SetAuditService(svc *audit.Service) {
+	s.auditSvc = svc
+}
+
+// auditUser 记录用户的变更，before 为 nil 表示新建，after 为 nil 表示删除
+func (s *userService) auditUser(ctx context.Context, action string, userID uint, before, after *userAuditSnapshot) {
+	publicID, _ := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
+	s.auditSvc.RecordChange(ctx, action, model.AuditEntityUser, publicID, before, after)
+}
+
+// auditUserGroup 记录用户组的变更
+func (s *userService) auditUserGroup(ctx context.Context, groupID uint, before, after *userGroupAuditSnapshot) {
+	publicID, _ := idgen.GeneratePublicID(groupID, idgen.EntityTypeUserGroup)
+	s.auditSvc.RecordChange(ctx, model.AuditActionUserGroupUpdate, model.AuditEntityUserGroup, publicID, before, after)
+}
@@ -0,0 +1,90 @@
+/*
+ * @Description: 用户头像处理，上传时居中裁剪为正方形并生成多个尺寸版本
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 23:30:00
+ * @LastEditTime: 2026-10-15 23:30:00
+ * @LastEditors: 安知鱼
+ */
+package user
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/image_style/engine"
+)
+
+const (
+	// AvatarMaxBytes 头像源文件的最大体积
+	AvatarMaxBytes = 10 << 20
+	// avatarMainSize 主头像的最大边长，原图较小时不放大
+	avatarMainSize = 512
+	// avatarMaxPixels 允许解码的最大像素数，防止解压炸弹
+	avatarMaxPixels   = 50_000_000
+	avatarJPEGQuality = 85
+)
+
+// AvatarVariantSizes 额外生成的头像尺寸（边长像素），原图较小时跳过更大的尺寸
+var AvatarVariantSizes = []int{256, 128, 64}
+
+// AvatarImage 处理后的一张正方形头像
+type AvatarImage struct {
+	Size int    // 边长像素
+	Ext  string // 文件扩展名，含 "."
+	Data []byte
+}
+
+// ProcessAvatar 将上传的图片按 EXIF 方向矫正后居中裁剪为正方形，
+// 返回主头像（第一个元素）及各尺寸版本。带透明通道的格式输出 PNG，其余输出 JPEG。
+func ProcessAvatar(ctx context.Context, r io.Reader) ([]AvatarImage, error) {
+	data, err := io.ReadAll(io.LimitReader(r, AvatarMaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("读取头像文件失败: %w", err)
+	}
+	if len(data) > AvatarMaxBytes {
+		return nil, fmt.Errorf("头像文件不能超过 %d MB: %w", AvatarMaxBytes>>20, constant.ErrBadRequest)
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("无法识别的图片格式，请上传 JPG、PNG、GIF 或 WebP 图片: %w", constant.ErrBadRequest)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > avatarMaxPixels {
+		return nil, fmt.Errorf("图片尺寸无效或过大: %w", constant.ErrBadRequest)
+	}
+
+	outFormat, ext := "jpg", ".jpg"
+	if format != "jpeg" {
+		outFormat, ext = "png", ".png"
+	}
+
+	side := min(cfg.Width, cfg.Height, avatarMainSize)
+	sizes := []int{side}
+	for _, size := range AvatarVariantSizes {
+		if size < side {
+			sizes = append(sizes, size)
+		}
+	}
+
+	native := engine.NewNativeGoEngine()
+	images := make([]AvatarImage, 0, len(sizes))
+	for _, size := range sizes {
+		var buf bytes.Buffer
+		_, err := native.Process(ctx, bytes.NewReader(data), model.ImageStyleConfig{
+			Format:     outFormat,
+			Quality:    avatarJPEGQuality,
+			AutoRotate: true,
+			Resize:     model.ImageResizeConfig{Mode: "cover", Width: size, Height: size},
+		}, &buf)
+		if err != nil {
+			return nil, fmt.Errorf("处理头像失败: %w", err)
+		}
+		images = append(images, AvatarImage{Size: size, Ext: ext, Data: buf.Bytes()})
+	}
+	return images, nil
+}
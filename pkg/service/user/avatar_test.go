@@ -0,0 +1,55 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+)
+
+func TestProcessAvatar_CropsToSquareVariants(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 300, 200))
+	for x := 0; x < 300; x++ {
+		for y := 0; y < 200; y++ {
+			src.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+
+	images, err := ProcessAvatar(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("ProcessAvatar() error = %v", err)
+	}
+	wantSizes := []int{200, 128, 64}
+	if len(images) != len(wantSizes) {
+		t.Fatalf("应生成 %d 个尺寸, got %d", len(wantSizes), len(images))
+	}
+	for i, img := range images {
+		if img.Size != wantSizes[i] || img.Ext != ".png" {
+			t.Errorf("第 %d 个头像 = %d%s, want %d.png", i, img.Size, img.Ext, wantSizes[i])
+		}
+		cfg, err := png.DecodeConfig(bytes.NewReader(img.Data))
+		if err != nil {
+			t.Fatalf("解码输出失败: %v", err)
+		}
+		if cfg.Width != img.Size || cfg.Height != img.Size {
+			t.Errorf("头像应裁剪为 %dx%d, got %dx%d", img.Size, img.Size, cfg.Width, cfg.Height)
+		}
+	}
+}
+
+func TestProcessAvatar_RejectsNonImage(t *testing.T) {
+	_, err := ProcessAvatar(context.Background(), strings.NewReader("not an image"))
+	if !errors.Is(err, constant.ErrBadRequest) {
+		t.Errorf("非图片文件应返回 ErrBadRequest, got %v", err)
+	}
+}
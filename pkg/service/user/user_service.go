@@ -15,8 +15,11 @@ import (
 	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/security"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/password"
 )
 
 // UserService 定义了用户相关的业务逻辑接口
@@ -26,8 +29,8 @@ type UserService interface {
 	UpdateUserPassword(ctx context.Context, username, oldPassword, newPassword string) error
 	UpdateUserPasswordByID(ctx context.Context, userID uint, oldPassword, newPassword string) error
 	UpdateUserProfile(ctx context.Context, username string, nickname, website *string) error
-	UpdateUserProfileByID(ctx context.Context, userID uint, nickname, website *string) error
-	UpdateUserAvatar(ctx context.Context, userID uint, avatarURL string) error
+	UpdateUserProfileByID(ctx context.Context, userID uint, nickname, website, bio *string, visibility *model.ProfileVisibility) error
+	UpdateUserAvatar(ctx context.Context, userID uint, avatarURL string, variants map[string]string) error
 
 	// 管理员用户管理方法
 	AdminListUsers(ctx context.Context, page, pageSize int, keyword string, groupID *uint, status *int) ([]*model.User, int64, error)
@@ -39,20 +42,36 @@ type UserService interface {
 
 	// 用户组管理方法
 	ListUserGroups(ctx context.Context) ([]*model.UserGroup, error)
+	UpdateUserGroupUploadPolicy(ctx context.Context, groupID uint, policy *model.GroupUploadPolicy) (*model.UserGroup, error)
+	UpdateUserGroupPermissions(ctx context.Context, groupID uint, permissions []string) (*model.UserGroup, error)
+
+	// SetAuditService 注入审计日志服务（可选）
+	SetAuditService(svc *audit.Service)
 }
 
 // userService 是 UserService 接口的实现
 type userService struct {
-	userRepo      repository.UserRepository
-	userGroupRepo repository.UserGroupRepository
+	userRepo       repository.UserRepository
+	userGroupRepo  repository.UserGroupRepository
+	passwordPolicy *password.Service // 为 nil 时不做额外的密码强度校验
+	auditSvc       *audit.Service    // 为 nil 时不记录审计日志
 }
 
 // NewUserService 是 userService 的构造函数
-func NewUserService(userRepo repository.UserRepository, userGroupRepo repository.UserGroupRepository) UserService {
+func NewUserService(userRepo repository.UserRepository, userGroupRepo repository.UserGroupRepository, passwordPolicy *password.Service) UserService {
 	return &userService{
-		userRepo:      userRepo,
-		userGroupRepo: userGroupRepo,
+		userRepo:       userRepo,
+		userGroupRepo:  userGroupRepo,
+		passwordPolicy: passwordPolicy,
+	}
+}
+
+// validatePassword 按站点密码策略校验新密码
+func (s *userService) validatePassword(ctx context.Context, pw string) error {
+	if s.passwordPolicy == nil {
+		return nil
 	}
+	return s.passwordPolicy.Validate(ctx, pw)
 }
 
 // GetUserInfoByUsername 实现了获取用户信息的业务逻辑
@@ -95,7 +114,10 @@ func (s *userService) UpdateUserPassword(ctx context.Context, username, oldPassw
 		return fmt.Errorf("旧密码不正确")
 	}
 
-	// 3. 哈希新密码
+	// 3. 校验密码策略并哈希新密码
+	if err := s.validatePassword(ctx, newPassword); err != nil {
+		return err
+	}
 	newHashedPassword, err := security.HashPassword(newPassword)
 	if err != nil {
 		return fmt.Errorf("生成新密码失败: %w", err)
@@ -126,7 +148,10 @@ func (s *userService) UpdateUserPasswordByID(ctx context.Context, userID uint, o
 		return fmt.Errorf("旧密码不正确")
 	}
 
-	// 3. 哈希新密码
+	// 3. 校验密码策略并哈希新密码
+	if err := s.validatePassword(ctx, newPassword); err != nil {
+		return err
+	}
 	newHashedPassword, err := security.HashPassword(newPassword)
 	if err != nil {
 		return fmt.Errorf("生成新密码失败: %w", err)
@@ -168,8 +193,8 @@ func (s *userService) UpdateUserProfile(ctx context.Context, username string, ni
 	return nil
 }
 
-// UpdateUserProfileByID 实现了根据用户ID更新基本信息的业务逻辑
-func (s *userService) UpdateUserProfileByID(ctx context.Context, userID uint, nickname, website *string) error {
+// UpdateUserProfileByID 实现了根据用户ID更新基本信息的业务逻辑，nil 参数表示不修改
+func (s *userService) UpdateUserProfileByID(ctx context.Context, userID uint, nickname, website, bio *string, visibility *model.ProfileVisibility) error {
 	// 1. 获取用户信息
 	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -186,6 +211,12 @@ func (s *userService) UpdateUserProfileByID(ctx context.Context, userID uint, ni
 	if website != nil {
 		user.Website = *website
 	}
+	if bio != nil {
+		user.Bio = strings.TrimSpace(*bio)
+	}
+	if visibility != nil {
+		user.ProfileVisibility = *visibility
+	}
 
 	// 3. 保存更新
 	if err := s.userRepo.Update(ctx, user); err != nil {
@@ -195,8 +226,8 @@ func (s *userService) UpdateUserProfileByID(ctx context.Context, userID uint, ni
 	return nil
 }
 
-// UpdateUserAvatar 更新用户头像
-func (s *userService) UpdateUserAvatar(ctx context.Context, userID uint, avatarURL string) error {
+// UpdateUserAvatar 更新用户头像，variants 为各尺寸版本的URL（键为边长像素），可为空
+func (s *userService) UpdateUserAvatar(ctx context.Context, userID uint, avatarURL string, variants map[string]string) error {
 	// 1. 获取用户信息
 	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -208,6 +239,7 @@ func (s *userService) UpdateUserAvatar(ctx context.Context, userID uint, avatarU
 
 	// 2. 更新头像字段
 	user.Avatar = avatarURL
+	user.AvatarVariants = variants
 
 	// 3. 保存更新
 	if err := s.userRepo.Update(ctx, user); err != nil {
@@ -265,7 +297,10 @@ func (s *userService) AdminCreateUser(ctx context.Context, username, password, e
 		}
 	}
 
-	// 4. 密码加密
+	// 4. 校验密码策略并加密
+	if err := s.validatePassword(ctx, password); err != nil {
+		return nil, err
+	}
 	hashedPassword, err := security.HashPassword(password)
 	if err != nil {
 		return nil, fmt.Errorf("密码加密失败: %w", err)
@@ -296,6 +331,7 @@ func (s *userService) AdminCreateUser(ctx context.Context, username, password, e
 	if err != nil {
 		return nil, fmt.Errorf("查询创建的用户失败: %w", err)
 	}
+	s.auditUser(ctx, model.AuditActionUserCreate, user.ID, nil, newUserAuditSnapshot(createdUser))
 
 	return createdUser, nil
 }
@@ -310,6 +346,7 @@ func (s *userService) AdminUpdateUser(ctx context.Context, userID uint, username
 	if user == nil {
 		return fmt.Errorf("用户不存在")
 	}
+	before := newUserAuditSnapshot(user)
 
 	// 2. 更新用户名（如果提供）
 	if username != nil && *username != user.Username {
@@ -339,6 +376,7 @@ func (s *userService) AdminUpdateUser(ctx context.Context, userID uint, username
 		hasher := md5.New()
 		hasher.Write([]byte(strings.ToLower(strings.TrimSpace(*email))))
 		user.Avatar = "avatar/" + hex.EncodeToString(hasher.Sum(nil)) + "?d=identicon"
+		user.AvatarVariants = nil
 	}
 
 	// 4. 更新昵称（如果提供）
@@ -360,6 +398,7 @@ func (s *userService) AdminUpdateUser(ctx context.Context, userID uint, username
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return fmt.Errorf("更新用户失败: %w", err)
 	}
+	s.auditUser(ctx, model.AuditActionUserUpdate, userID, before, newUserAuditSnapshot(user))
 
 	return nil
 }
@@ -379,6 +418,7 @@ func (s *userService) AdminDeleteUser(ctx context.Context, userID uint) error {
 	if err := s.userRepo.Delete(ctx, userID); err != nil {
 		return fmt.Errorf("删除用户失败: %w", err)
 	}
+	s.auditUser(ctx, model.AuditActionUserDelete, userID, newUserAuditSnapshot(user), nil)
 
 	return nil
 }
@@ -392,6 +432,9 @@ func (s *userService) AdminResetPassword(ctx context.Context, userID uint, newPa
 	if len(newPassword) < 6 {
 		return fmt.Errorf("新密码长度至少为6位")
 	}
+	if err := s.validatePassword(ctx, newPassword); err != nil {
+		return err
+	}
 
 	// 2. 查询用户
 	user, err := s.userRepo.FindByID(ctx, userID)
@@ -413,6 +456,9 @@ func (s *userService) AdminResetPassword(ctx context.Context, userID uint, newPa
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return fmt.Errorf("重置密码失败: %w", err)
 	}
+	after := newUserAuditSnapshot(user)
+	after.PasswordChanged = true
+	s.auditUser(ctx, model.AuditActionUserUpdate, userID, newUserAuditSnapshot(user), after)
 
 	return nil
 }
@@ -434,10 +480,12 @@ func (s *userService) AdminUpdateUserStatus(ctx context.Context, userID uint, st
 	}
 
 	// 3. 更新状态
+	before := newUserAuditSnapshot(user)
 	user.Status = status
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return fmt.Errorf("更新用户状态失败: %w", err)
 	}
+	s.auditUser(ctx, model.AuditActionUserUpdate, userID, before, newUserAuditSnapshot(user))
 
 	return nil
 }
@@ -450,3 +498,56 @@ func (s *userService) ListUserGroups(ctx context.Context) ([]*model.UserGroup, e
 	}
 	return groups, nil
 }
+
+// UpdateUserGroupUploadPolicy 更新用户组的上传限制，policy 为 nil 时清除限制
+func (s *userService) UpdateUserGroupUploadPolicy(ctx context.Context, groupID uint, policy *model.GroupUploadPolicy) (*model.UserGroup, error) {
+	group, err := s.userGroupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	if group == nil {
+		return nil, constant.ErrNotFound
+	}
+
+	if policy != nil {
+		if policy.MaxFileSize < 0 || policy.MaxDailyUploads < 0 {
+			return nil, fmt.Errorf("%w: 大小上限与每日上传次数不能为负数", constant.ErrBadRequest)
+		}
+		policy.AllowedExtensions = model.NormalizeExtensions(policy.AllowedExtensions)
+		policy.DeniedExtensions = model.NormalizeExtensions(policy.DeniedExtensions)
+	}
+	before := newUserGroupAuditSnapshot(group)
+	group.Settings.UploadPolicy = policy
+	if err := s.userGroupRepo.Save(ctx, group); err != nil {
+		return nil, fmt.Errorf("保存用户组失败: %w", err)
+	}
+	s.auditUserGroup(ctx, groupID, before, newUserGroupAuditSnapshot(group))
+	return group, nil
+}
+
+// UpdateUserGroupPermissions 按权限名称（如 article:write）替换用户组的权限。
+// 管理员组必须保留 admin 权限，避免所有人失去管理权限。
+func (s *userService) UpdateUserGroupPermissions(ctx context.Context, groupID uint, permissions []string) (*model.UserGroup, error) {
+	bs, err := model.ParsePermissions(permissions)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", constant.ErrBadRequest, err)
+	}
+	if groupID == 1 && !bs.Enabled(model.PermissionAdmin) {
+		return nil, fmt.Errorf("%w: 管理员组不能移除 admin 权限", constant.ErrBadRequest)
+	}
+
+	group, err := s.userGroupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	if group == nil {
+		return nil, constant.ErrNotFound
+	}
+	before := newUserGroupAuditSnapshot(group)
+	group.Permissions = bs
+	if err := s.userGroupRepo.Save(ctx, group); err != nil {
+		return nil, fmt.Errorf("保存用户组失败: %w", err)
+	}
+	s.auditUserGroup(ctx, groupID, before, newUserGroupAuditSnapshot(group))
+	return group, nil
+}
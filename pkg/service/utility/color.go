@@ -40,9 +40,66 @@ func checkVipsAvailability() bool {
 }
 
 func (s *ColorService) GetPrimaryColor(reader io.Reader) (string, error) {
+	img, err := s.decode(reader)
+	if err != nil {
+		return "", err
+	}
+
+	colors, err := prominentcolor.KmeansWithArgs(
+		1,
+		img,
+	)
+	if err != nil {
+		return "", fmt.Errorf("使用 prominentcolor (K-Means) 提取主色调失败: %w", err)
+	}
+
+	if len(colors) == 0 {
+		return "", fmt.Errorf("prominentcolor (K-Means) 未能找到任何主色调")
+	}
+
+	return hexColor(colors[0].Color), nil
+}
+
+// Palette 图片的配色方案
+type Palette struct {
+	// Dominant 占比最高的颜色
+	Dominant string `json:"dominant"`
+	// Accents 其余的代表色，按占比从高到低排列
+	Accents []string `json:"accents"`
+}
+
+// GetPalette 使用 K-Means 将图片颜色聚为 size 类，返回主色与强调色。
+// 与 GetPrimaryColor 不同，这里不裁剪接近白色的背景，保证浅色图片的配色也能反映整体观感。
+func (s *ColorService) GetPalette(reader io.Reader, size int) (*Palette, error) {
+	img, err := s.decode(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	colors, err := prominentcolor.KmeansWithAll(size, img, prominentcolor.ArgumentNoCropping, prominentcolor.DefaultSize, prominentcolor.GetDefaultMasks())
+	if err != nil {
+		return nil, fmt.Errorf("使用 prominentcolor (K-Means) 提取配色失败: %w", err)
+	}
+	if len(colors) == 0 {
+		return nil, fmt.Errorf("prominentcolor (K-Means) 未能找到任何颜色")
+	}
+
+	palette := &Palette{Dominant: hexColor(colors[0].Color), Accents: make([]string, 0, len(colors)-1)}
+	for _, c := range colors[1:] {
+		palette.Accents = append(palette.Accents, hexColor(c.Color))
+	}
+	return palette, nil
+}
+
+func hexColor(c prominentcolor.ColorRGB) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// decode 读取并解码图片，标准库无法解码且 VIPS 可用时使用 VIPS 转换后再解码
+func (s *ColorService) decode(reader io.Reader) (image.Image, error) {
 	imgData, err := io.ReadAll(reader)
 	if err != nil {
-		return "", fmt.Errorf("读取图片数据失败: %w", err)
+		return nil, fmt.Errorf("读取图片数据失败: %w", err)
 	}
 
 	// 尝试使用标准库解码
@@ -53,30 +110,15 @@ func (s *ColorService) GetPrimaryColor(reader io.Reader) (string, error) {
 		log.Printf("[ColorService] 标准库解码失败 (%v)，尝试使用VIPS解码", err)
 		img, err = s.decodeWithVips(imgData)
 		if err != nil {
-			return "", fmt.Errorf("VIPS解码也失败: %w", err)
+			return nil, fmt.Errorf("VIPS解码也失败: %w", err)
 		}
 		log.Printf("[ColorService] VIPS解码成功")
 	} else if err != nil {
-		return "", fmt.Errorf("解码图片失败: %w", err)
+		return nil, fmt.Errorf("解码图片失败: %w", err)
 	} else {
 		log.Printf("[ColorService] 标准库解码成功，格式: %s", format)
 	}
-
-	colors, err := prominentcolor.KmeansWithArgs(
-		1,
-		img,
-	)
-	if err != nil {
-		return "", fmt.Errorf("使用 prominentcolor (K-Means) 提取主色调失败: %w", err)
-	}
-
-	if len(colors) == 0 {
-		return "", fmt.Errorf("prominentcolor (K-Means) 未能找到任何主色调")
-	}
-
-	dominantColor := colors[0].Color
-
-	return fmt.Sprintf("#%02x%02x%02x", dominantColor.R, dominantColor.G, dominantColor.B), nil
+	return img, nil
 }
 
 // decodeWithVips 使用VIPS将图像转换为标准格式然后解码
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/md5"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"log"
@@ -15,8 +16,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/workerpool"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
 	parser_service "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
@@ -33,10 +36,28 @@ type EmailService interface {
 	SendLinkApplicationNotification(ctx context.Context, link *model.LinkDTO) error
 	// SendLinkReviewNotification 发送友链审核通知
 	SendLinkReviewNotification(ctx context.Context, link *model.LinkDTO, isApproved bool, rejectReason string) error
+	// SendLinkSickNotification 通知友链站长其网站连续多次无法访问，已被标记为失联
+	SendLinkSickNotification(ctx context.Context, link *model.LinkDTO, failures int, lastError string) error
 	// SendVerificationEmail 发送验证码邮件
 	SendVerificationEmail(ctx context.Context, toEmail, code string) error
 	// SendArticlePushEmail 发送文章更新推送邮件
 	SendArticlePushEmail(ctx context.Context, toEmail, unsubscribeToken string, article *model.Article) error
+	// SendCommentDigestEmail 发送评论订阅摘要邮件，comments 为同一页面的新评论，按发布时间排序
+	SendCommentDigestEmail(ctx context.Context, toEmail, unsubscribeURL string, comments []*model.Comment) error
+	// SendCommentMentionEmail 发送评论 @ 提及通知邮件
+	SendCommentMentionEmail(ctx context.Context, comment *model.Comment, toEmail, toNickname string) error
+	// SendCommentApprovedEmail 通知评论者其待审核的评论已通过审核
+	SendCommentApprovedEmail(ctx context.Context, comment *model.Comment, toEmail string) error
+	// SendSuspiciousLoginEmail 提醒用户其账号因多次登录失败已被暂时锁定
+	SendSuspiciousLoginEmail(ctx context.Context, toEmail, nickname, ip string, failures int, lockedUntil time.Time) error
+	// SendContributionSubmittedEmail 通知站长有新的投稿或投稿已修改后重新提交
+	SendContributionSubmittedEmail(ctx context.Context, contributorNick, title, note string, resubmitted bool) error
+	// SendContributionReviewEmail 通知投稿者其投稿收到审核意见、被要求修改、通过或被拒绝，通过时邮件附带文章链接
+	SendContributionReviewEmail(ctx context.Context, toEmail, nickname, title, action, content, articleSlug string) error
+	// SetQueue 设置通知投递队列（可选注入），设置后评论、友链与文章推送等通知邮件会持久化排队并在失败时重试
+	SetQueue(queue NotificationQueue)
+	// DeliverQueued 发送一封已入队的邮件，供投递队列调用
+	DeliverQueued(ctx context.Context, payload []byte) error
 }
 
 // emailService 是 EmailService 接口的实现
@@ -44,6 +65,7 @@ type emailService struct {
 	settingSvc      setting.SettingService
 	notificationSvc notification.Service
 	parserSvc       *parser_service.Service
+	queue           NotificationQueue
 }
 
 // NewEmailService 是 emailService 的构造函数
@@ -55,6 +77,43 @@ func NewEmailService(settingSvc setting.SettingService, notificationSvc notifica
 	}
 }
 
+// SetQueue 设置通知投递队列
+func (s *emailService) SetQueue(queue NotificationQueue) {
+	s.queue = queue
+}
+
+// DeliverQueued 发送一封已入队的邮件
+func (s *emailService) DeliverQueued(ctx context.Context, payload []byte) error {
+	var p EmailPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("解析邮件投递内容失败: %w", err)
+	}
+	return s.send(p.To, p.Subject, p.Body)
+}
+
+// sendNotification 异步发送通知邮件：注入了投递队列时入队，由队列负责重试；否则直接在后台发送
+func (s *emailService) sendNotification(kind, to, subject, body string) {
+	if s.queue != nil {
+		err := s.queue.Enqueue(context.Background(), &model.NotificationDelivery{
+			Channel:   model.DeliveryChannelEmail,
+			Kind:      kind,
+			Recipient: to,
+			Subject:   subject,
+		}, EmailPayload{To: to, Subject: subject, Body: body})
+		if err == nil {
+			return
+		}
+		log.Printf("[WARNING] %s 通知邮件入队失败，改为直接发送: %v", kind, err)
+	}
+	workerpool.Go(workerpool.CategoryNotification, func() {
+		if err := s.send(to, subject, body); err != nil {
+			log.Printf("[ERROR] 发送 %s 通知邮件失败 (Email: %s): %v", kind, to, err)
+		} else {
+			log.Printf("[INFO] %s 通知邮件已发送到: %s", kind, to)
+		}
+	})
+}
+
 // SendTestEmail 负责发送一封测试邮件
 func (s *emailService) SendTestEmail(ctx context.Context, toEmail string) error {
 	appName := s.settingSvc.Get(constant.KeyAppName.String())
@@ -158,13 +217,7 @@ func (s *emailService) SendLinkApplicationNotification(ctx context.Context, link
 		return fmt.Errorf("渲染友链申请邮件正文失败: %w", err)
 	}
 
-	go func() {
-		if err := s.send(adminEmail, subject, body); err != nil {
-			log.Printf("[ERROR] 发送友链申请通知邮件失败: %v", err)
-		} else {
-			log.Printf("[INFO] 友链申请通知邮件已发送到: %s", adminEmail)
-		}
-	}()
+	s.sendNotification(NotificationKindLinkApplication, adminEmail, subject, body)
 
 	return nil
 }
@@ -280,7 +333,7 @@ func (s *emailService) SendCommentNotification(newComment *model.Comment, parent
 
 		subject, _ := renderTemplate(adminSubjectTpl, data)
 		body, _ := renderTemplate(adminBodyTpl, data)
-		go func() { _ = s.send(primaryAdminEmail, subject, body) }()
+		s.sendNotification(NotificationKindComment, primaryAdminEmail, subject, body)
 		log.Printf("[DEBUG] 博主通知邮件已分发")
 	} else {
 		log.Printf("[DEBUG] 跳过博主通知: primaryAdminEmail=%s, shouldSendEmail=%t, isAdminComment=%t",
@@ -364,7 +417,7 @@ func (s *emailService) SendCommentNotification(newComment *model.Comment, parent
 
 		subject, _ := renderTemplate(replySubjectTpl, data)
 		body, _ := renderTemplate(replyBodyTpl, data)
-		go func() { _ = s.send(parentEmail, subject, body) }()
+		s.sendNotification(NotificationKindCommentReply, parentEmail, subject, body)
 		log.Printf("[DEBUG] 回复通知邮件已分发到: %s", parentEmail)
 	}
 }
@@ -399,7 +452,7 @@ func (s *emailService) SendActivationEmail(ctx context.Context, toEmail, nicknam
 		return fmt.Errorf("渲染激活邮件正文失败: %w", err)
 	}
 
-	go func() { _ = s.send(toEmail, subject, body) }()
+	workerpool.Go(workerpool.CategoryNotification, func() { _ = s.send(toEmail, subject, body) })
 	return nil
 }
 
@@ -433,7 +486,7 @@ func (s *emailService) SendForgotPasswordEmail(ctx context.Context, toEmail, nic
 		return fmt.Errorf("渲染重置密码邮件正文失败: %w", err)
 	}
 
-	go func() { _ = s.send(toEmail, subject, body) }()
+	workerpool.Go(workerpool.CategoryNotification, func() { _ = s.send(toEmail, subject, body) })
 	return nil
 }
 
@@ -558,17 +611,81 @@ func (s *emailService) SendLinkReviewNotification(ctx context.Context, link *mod
 	}
 
 	// 异步发送邮件
-	go func() {
-		if err := s.send(link.Email, subject, body); err != nil {
-			log.Printf("[ERROR] 发送友链审核邮件失败: %v", err)
-		} else {
-			log.Printf("[INFO] 友链审核邮件已发送到: %s", link.Email)
-		}
-	}()
+	s.sendNotification(NotificationKindLinkReview, link.Email, subject, body)
 
 	return nil
 }
 
+// SendLinkSickNotification 负责发送友链失联通知邮件
+func (s *emailService) SendLinkSickNotification(ctx context.Context, link *model.LinkDTO, failures int, lastError string) error {
+	if !s.settingSvc.GetBool(constant.KeyFriendLinkSickMailEnable.String()) {
+		return nil
+	}
+	if link.Email == "" {
+		log.Printf("[DEBUG] 友链 %s 没有填写邮箱，跳过失联通知", link.Name)
+		return nil
+	}
+
+	appName := s.settingSvc.Get(constant.KeyAppName.String())
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+
+	subjectTplStr := s.settingSvc.Get(constant.KeyFriendLinkSickMailSubject.String())
+	bodyTplStr := s.settingSvc.Get(constant.KeyFriendLinkSickMailTemplate.String())
+	if subjectTplStr == "" {
+		subjectTplStr = "【{{.SITE_NAME}}】您的友链暂时无法访问"
+	}
+	if bodyTplStr == "" {
+		bodyTplStr = `<div style="background-color:#f4f5f7;padding:30px 0;">
+	<div style="max-width:600px;margin:0 auto;background:#fff;border-radius:8px;overflow:hidden;box-shadow:0 2px 8px rgba(0,0,0,0.1);">
+		<div style="background:linear-gradient(135deg,#f6d365 0%,#fda085 100%);padding:30px;text-align:center;">
+			<h1 style="color:#fff;margin:0;font-size:24px;">友链访问异常通知</h1>
+		</div>
+		<div style="padding:30px;">
+			<p style="font-size:16px;line-height:1.8;color:#333;">亲爱的 <strong>{{.LINK_NAME}}</strong> 站长，您好！</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;"><a href="{{.SITE_URL}}" style="color:#fda085;text-decoration:none;">{{.SITE_NAME}}</a> 在最近 {{.FAILURES}} 次检查中均无法访问您的网站，友链已被暂时移入失联列表。</p>
+			<div style="background:#fff8f3;padding:20px;border-radius:6px;margin:20px 0;border-left:4px solid #fda085;">
+				<p style="margin:8px 0;color:#666;"><strong>网站名称：</strong>{{.LINK_NAME}}</p>
+				<p style="margin:8px 0;color:#666;"><strong>网站地址：</strong><a href="{{.LINK_URL}}" style="color:#fda085;">{{.LINK_URL}}</a></p>
+				{{if .LAST_ERROR}}<p style="margin:8px 0;color:#666;"><strong>最近一次错误：</strong>{{.LAST_ERROR}}</p>{{end}}
+			</div>
+			<p style="font-size:14px;line-height:1.8;color:#666;">网站恢复访问后，友链会在下一次检查时自动恢复展示，无需重新申请。如果网站地址已变更，欢迎通过友链页面提交修改申请。</p>
+		</div>
+		<div style="background:#f8f9fa;padding:20px;text-align:center;color:#999;font-size:12px;">
+			<p style="margin:5px 0;">本邮件由系统自动发送，请勿直接回复</p>
+			<p style="margin:5px 0;">© {{.SITE_NAME}}</p>
+		</div>
+	</div>
+</div>`
+	}
+
+	data := map[string]interface{}{
+		"SITE_NAME":  appName,
+		"SITE_URL":   siteURL,
+		"LINK_NAME":  link.Name,
+		"LINK_URL":   link.URL,
+		"LINK_LOGO":  link.Logo,
+		"FAILURES":   failures,
+		"LAST_ERROR": lastError,
+	}
+
+	subject, err := renderTemplate(subjectTplStr, data)
+	if err != nil {
+		return fmt.Errorf("渲染友链失联邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(bodyTplStr, data)
+	if err != nil {
+		return fmt.Errorf("渲染友链失联邮件正文失败: %w", err)
+	}
+
+	s.sendNotification(NotificationKindLinkSick, link.Email, subject, body)
+	return nil
+}
+
 // SendVerificationEmail 发送验证码邮件
 func (s *emailService) SendVerificationEmail(ctx context.Context, toEmail, code string) error {
 	appName := s.settingSvc.Get(constant.KeyAppName.String())
@@ -610,9 +727,9 @@ func (s *emailService) SendVerificationEmail(ctx context.Context, toEmail, code
 
 	// 在独立goroutine中发送邮件，使用channel接收结果
 	errChan := make(chan error, 1)
-	go func() {
+	workerpool.Go(workerpool.CategoryNotification, func() {
 		errChan <- s.send(toEmail, subject, body)
-	}()
+	})
 
 	// 等待发送完成或超时
 	select {
@@ -731,14 +848,306 @@ func (s *emailService) SendArticlePushEmail(ctx context.Context, toEmail, unsubs
 	}
 
 	// 异步发送
-	go func() {
-		if err := s.send(toEmail, subject, body); err != nil {
-			log.Printf("[ERROR] 发送文章推送邮件失败 (Email: %s): %v", toEmail, err)
-		} else {
-			log.Printf("[INFO] 文章推送邮件已发送到: %s", toEmail)
-		}
-	}()
+	s.sendNotification(NotificationKindArticlePush, toEmail, subject, body)
+
+	return nil
+}
+
+// SendCommentDigestEmail 发送评论订阅摘要邮件
+func (s *emailService) SendCommentDigestEmail(ctx context.Context, toEmail, unsubscribeURL string, comments []*model.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+
+	targetTitle := "一个页面"
+	if comments[0].TargetTitle != nil && *comments[0].TargetTitle != "" {
+		targetTitle = *comments[0].TargetTitle
+	}
+
+	items := make([]map[string]interface{}, 0, len(comments))
+	for _, c := range comments {
+		items = append(items, map[string]interface{}{
+			"NICK":    c.Author.Nickname,
+			"TIME":    c.CreatedAt.Format("2006-01-02 15:04"),
+			"COMMENT": template.HTML(c.ContentHTML),
+		})
+	}
+	data := map[string]interface{}{
+		"SITE_NAME":       s.settingSvc.Get(constant.KeyAppName.String()),
+		"SITE_URL":        siteURL,
+		"POST_URL":        siteURL + comments[0].TargetPath,
+		"TARGET_TITLE":    targetTitle,
+		"COUNT":           len(comments),
+		"COMMENTS":        items,
+		"UNSUBSCRIBE_URL": unsubscribeURL,
+	}
+
+	subject, err := renderTemplate(s.settingSvc.Get(constant.KeyCommentSubscribeMailSubject.String()), data)
+	if err != nil {
+		return fmt.Errorf("渲染评论摘要邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(s.settingSvc.Get(constant.KeyCommentSubscribeMailTemplate.String()), data)
+	if err != nil {
+		return fmt.Errorf("渲染评论摘要邮件正文失败: %w", err)
+	}
+	s.sendNotification(NotificationKindCommentDigest, toEmail, subject, body)
+	return nil
+}
+
+// SendCommentMentionEmail 发送评论 @ 提及通知邮件
+func (s *emailService) SendCommentMentionEmail(ctx context.Context, comment *model.Comment, toEmail, toNickname string) error {
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
 
+	targetTitle := "一个页面"
+	if comment.TargetTitle != nil && *comment.TargetTitle != "" {
+		targetTitle = *comment.TargetTitle
+	}
+
+	gravatarURL := strings.TrimRight(s.settingSvc.Get(constant.KeyGravatarURL.String()), "/") + "/avatar/"
+	defaultGravatar := s.settingSvc.Get(constant.KeyDefaultGravatarType.String())
+	var emailMD5 string
+	if comment.Author.Email != nil {
+		emailMD5 = fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(*comment.Author.Email))))
+	}
+
+	data := map[string]interface{}{
+		"SITE_NAME":      s.settingSvc.Get(constant.KeyAppName.String()),
+		"SITE_URL":       siteURL,
+		"POST_URL":       siteURL + comment.TargetPath,
+		"TARGET_TITLE":   targetTitle,
+		"MENTIONED_NICK": toNickname,
+		"NICK":           comment.Author.Nickname,
+		"IMG":            fmt.Sprintf("%s%s?d=%s", gravatarURL, emailMD5, defaultGravatar),
+		"TIME":           comment.CreatedAt.Format("2006-01-02 15:04"),
+		"COMMENT":        template.HTML(comment.ContentHTML),
+	}
+
+	subject, err := renderTemplate(s.settingSvc.Get(constant.KeyCommentMailSubjectMention.String()), data)
+	if err != nil {
+		return fmt.Errorf("渲染评论提及邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(s.settingSvc.Get(constant.KeyCommentMailTemplateMention.String()), data)
+	if err != nil {
+		return fmt.Errorf("渲染评论提及邮件正文失败: %w", err)
+	}
+	s.sendNotification(NotificationKindCommentMention, toEmail, subject, body)
+	return nil
+}
+
+// SendCommentApprovedEmail 发送评论审核通过通知邮件
+func (s *emailService) SendCommentApprovedEmail(ctx context.Context, comment *model.Comment, toEmail string) error {
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+
+	targetTitle := "一个页面"
+	if comment.TargetTitle != nil && *comment.TargetTitle != "" {
+		targetTitle = *comment.TargetTitle
+	}
+
+	postURL := siteURL + comment.TargetPath
+	commentURL := postURL
+	if publicID, err := idgen.GeneratePublicID(comment.ID, idgen.EntityTypeComment); err == nil {
+		commentURL = fmt.Sprintf("%s#comment-%s", postURL, publicID)
+	}
+
+	gravatarURL := strings.TrimRight(s.settingSvc.Get(constant.KeyGravatarURL.String()), "/") + "/avatar/"
+	defaultGravatar := s.settingSvc.Get(constant.KeyDefaultGravatarType.String())
+	emailMD5 := fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(toEmail))))
+
+	data := map[string]interface{}{
+		"SITE_NAME":    s.settingSvc.Get(constant.KeyAppName.String()),
+		"SITE_URL":     siteURL,
+		"POST_URL":     postURL,
+		"COMMENT_URL":  commentURL,
+		"TARGET_TITLE": targetTitle,
+		"NICK":         comment.Author.Nickname,
+		"IMG":          fmt.Sprintf("%s%s?d=%s", gravatarURL, emailMD5, defaultGravatar),
+		"TIME":         comment.CreatedAt.Format("2006-01-02 15:04"),
+		"COMMENT":      template.HTML(comment.ContentHTML),
+	}
+
+	subject, err := renderTemplate(s.settingSvc.Get(constant.KeyCommentMailSubjectApproved.String()), data)
+	if err != nil {
+		return fmt.Errorf("渲染评论审核通过邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(s.settingSvc.Get(constant.KeyCommentMailTemplateApproved.String()), data)
+	if err != nil {
+		return fmt.Errorf("渲染评论审核通过邮件正文失败: %w", err)
+	}
+	s.sendNotification(NotificationKindCommentApproved, toEmail, subject, body)
+	return nil
+}
+
+// SendSuspiciousLoginEmail 提醒用户其账号因多次登录失败已被暂时锁定
+func (s *emailService) SendSuspiciousLoginEmail(ctx context.Context, toEmail, nickname, ip string, failures int, lockedUntil time.Time) error {
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+
+	data := map[string]interface{}{
+		"SITE_NAME":    s.settingSvc.Get(constant.KeyAppName.String()),
+		"SITE_URL":     siteURL,
+		"NICK":         nickname,
+		"IP":           ip,
+		"FAILURES":     failures,
+		"LOCKED_UNTIL": lockedUntil.Format("2006-01-02 15:04"),
+	}
+
+	subject, err := renderTemplate("【{{.SITE_NAME}}】您的账号存在异常登录尝试", data)
+	if err != nil {
+		return fmt.Errorf("渲染异常登录提醒邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(`<div style="background-color:#f4f5f7;padding:30px 0;">
+	<div style="max-width:600px;margin:0 auto;background:#fff;border-radius:8px;overflow:hidden;box-shadow:0 2px 8px rgba(0,0,0,0.1);">
+		<div style="background:linear-gradient(135deg,#ff758c 0%,#ff7eb3 100%);padding:30px;text-align:center;">
+			<h1 style="color:#fff;margin:0;font-size:24px;">异常登录提醒</h1>
+		</div>
+		<div style="padding:30px;">
+			<p style="font-size:16px;line-height:1.8;color:#333;">亲爱的 <strong>{{.NICK}}</strong>，您好！</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">您在 <a href="{{.SITE_URL}}" style="color:#ff758c;text-decoration:none;">{{.SITE_NAME}}</a> 的账号最近连续 {{.FAILURES}} 次登录失败，为保护账号安全，登录已被暂时锁定。</p>
+			<div style="background:#fff5f7;padding:20px;border-radius:6px;margin:20px 0;border-left:4px solid #ff758c;">
+				<p style="margin:8px 0;color:#666;"><strong>最近一次尝试的 IP：</strong>{{.IP}}</p>
+				<p style="margin:8px 0;color:#666;"><strong>锁定至：</strong>{{.LOCKED_UNTIL}}</p>
+			</div>
+			<p style="font-size:14px;line-height:1.8;color:#666;">如果这些尝试不是您本人操作，建议尽快修改密码；如果是您忘记了密码，可以在锁定结束后通过“忘记密码”重置。</p>
+		</div>
+		<div style="background:#f8f9fa;padding:20px;text-align:center;color:#999;font-size:12px;">
+			<p style="margin:5px 0;">本邮件由系统自动发送，请勿直接回复</p>
+			<p style="margin:5px 0;">© {{.SITE_NAME}}</p>
+		</div>
+	</div>
+</div>`, data)
+	if err != nil {
+		return fmt.Errorf("渲染异常登录提醒邮件正文失败: %w", err)
+	}
+	s.sendNotification(NotificationKindSuspiciousLogin, toEmail, subject, body)
+	return nil
+}
+
+// contributionMailLayout 是投稿通知邮件的通用版式，正文由 {{.MESSAGE}} 与可选的 {{.CONTENT}}、{{.LINK}} 组成
+const contributionMailLayout = `<div style="background-color:#f4f5f7;padding:30px 0;">
+	<div style="max-width:600px;margin:0 auto;background:#fff;border-radius:8px;overflow:hidden;box-shadow:0 2px 8px rgba(0,0,0,0.1);">
+		<div style="background:linear-gradient(135deg,#667eea 0%,#764ba2 100%);padding:30px;text-align:center;">
+			<h1 style="color:#fff;margin:0;font-size:24px;">{{.HEADING}}</h1>
+		</div>
+		<div style="padding:30px;">
+			<p style="font-size:16px;line-height:1.8;color:#333;">{{.GREETING}}</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">{{.MESSAGE}}</p>
+			{{if .CONTENT}}<div style="background:#f7f7fd;padding:20px;border-radius:6px;margin:20px 0;border-left:4px solid #667eea;white-space:pre-wrap;color:#666;">{{.CONTENT}}</div>{{end}}
+			{{if .LINK}}<p style="text-align:center;margin:30px 0;"><a href="{{.LINK}}" style="display:inline-block;padding:12px 30px;background:#667eea;color:#fff;text-decoration:none;border-radius:5px;">{{.LINK_TEXT}}</a></p>{{end}}
+		</div>
+		<div style="background:#f8f9fa;padding:20px;text-align:center;color:#999;font-size:12px;">
+			<p style="margin:5px 0;">本邮件由系统自动发送，请勿直接回复</p>
+			<p style="margin:5px 0;">© <a href="{{.SITE_URL}}" style="color:#999;text-decoration:none;">{{.SITE_NAME}}</a></p>
+		</div>
+	</div>
+</div>`
+
+// SendContributionSubmittedEmail 通知站长有新的投稿或投稿已修改后重新提交
+func (s *emailService) SendContributionSubmittedEmail(ctx context.Context, contributorNick, title, note string, resubmitted bool) error {
+	adminEmail := strings.TrimSpace(s.settingSvc.Get(constant.KeyFrontDeskSiteOwnerEmail.String()))
+	if adminEmail == "" {
+		log.Printf("[WARNING] 站长邮箱未配置（frontDesk.siteOwner.email 为空），无法发送投稿通知邮件")
+		return nil
+	}
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+
+	heading, verb := "新的投稿", "提交了一篇新投稿"
+	if resubmitted {
+		heading, verb = "投稿已重新提交", "修改并重新提交了投稿"
+	}
+	data := map[string]interface{}{
+		"SITE_NAME": s.settingSvc.Get(constant.KeyAppName.String()),
+		"SITE_URL":  siteURL,
+		"HEADING":   heading,
+		"GREETING":  "站长，您好！",
+		"MESSAGE":   fmt.Sprintf("%s %s《%s》，请前往后台审核。", contributorNick, verb, title),
+		"CONTENT":   note,
+		"LINK":      siteURL + "/admin",
+		"LINK_TEXT": "前往后台审核",
+		"TITLE":     title,
+	}
+
+	subject, err := renderTemplate("【{{.SITE_NAME}}】{{.HEADING}}：{{.TITLE}}", data)
+	if err != nil {
+		return fmt.Errorf("渲染投稿通知邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(contributionMailLayout, data)
+	if err != nil {
+		return fmt.Errorf("渲染投稿通知邮件正文失败: %w", err)
+	}
+	s.sendNotification(NotificationKindContribution, adminEmail, subject, body)
+	return nil
+}
+
+// SendContributionReviewEmail 通知投稿者其投稿的审核进展
+func (s *emailService) SendContributionReviewEmail(ctx context.Context, toEmail, nickname, title, action, content, articleSlug string) error {
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+
+	var heading, message, articleURL string
+	switch action {
+	case model.ContributionActionComment:
+		heading, message = "投稿收到新的审核意见", fmt.Sprintf("管理员对您的投稿《%s》发表了审核意见：", title)
+	case model.ContributionActionRequestChanges:
+		heading, message = "投稿需要修改", fmt.Sprintf("您的投稿《%s》需要修改后重新提交，修改意见如下：", title)
+	case model.ContributionActionApprove:
+		heading, message = "投稿已通过审核", fmt.Sprintf("恭喜！您的投稿《%s》已通过审核并发布。", title)
+		articleURL = fmt.Sprintf("%s/post/%s.html", siteURL, articleSlug)
+	case model.ContributionActionReject:
+		heading, message = "投稿未通过审核", fmt.Sprintf("很遗憾，您的投稿《%s》未通过审核。", title)
+	default:
+		return fmt.Errorf("未知的投稿审核操作: %s", action)
+	}
+
+	data := map[string]interface{}{
+		"SITE_NAME": s.settingSvc.Get(constant.KeyAppName.String()),
+		"SITE_URL":  siteURL,
+		"HEADING":   heading,
+		"GREETING":  fmt.Sprintf("亲爱的 %s，您好！", nickname),
+		"MESSAGE":   message,
+		"CONTENT":   content,
+		"LINK":      articleURL,
+		"LINK_TEXT": "查看文章",
+		"TITLE":     title,
+	}
+
+	subject, err := renderTemplate("【{{.SITE_NAME}}】{{.HEADING}}：{{.TITLE}}", data)
+	if err != nil {
+		return fmt.Errorf("渲染投稿审核邮件主题失败: %w", err)
+	}
+	body, err := renderTemplate(contributionMailLayout, data)
+	if err != nil {
+		return fmt.Errorf("渲染投稿审核邮件正文失败: %w", err)
+	}
+	s.sendNotification(NotificationKindContributionReview, toEmail, subject, body)
 	return nil
 }
 
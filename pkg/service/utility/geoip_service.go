@@ -16,6 +16,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 )
@@ -102,13 +104,18 @@ type smartGeoIPService struct {
 }
 
 // NewGeoIPService 是构造函数，注入了配置服务。
-// 它不再需要数据库路径参数。
+// 它不再需要数据库路径参数。API 地址由管理员配置，请求经过出站白名单与 SSRF 防护。
 func NewGeoIPService(settingSvc setting.SettingService) (GeoIPService, error) {
+	policy := httpclient.DefaultPolicy()
+	policy.Timeout = 5 * time.Second // 为 API 请求设置5秒超时
+	policy.MaxRetries = 1
+	guard := ssrf.NewGuard(func() string {
+		return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+	})
+
 	return &smartGeoIPService{
 		settingSvc: settingSvc,
-		httpClient: &http.Client{
-			Timeout: 5 * time.Second, // 为 API 请求设置5秒超时
-		},
+		httpClient: httpclient.New("geoip_api", policy, httpclient.WithBaseTransport(guard.Transport())),
 	}, nil
 }
 
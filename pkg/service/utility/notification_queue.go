@@ -0,0 +1,45 @@
+// pkg/service/utility/notification_queue.go
+package utility
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// NotificationQueue 持久化的通知投递队列。注入后，即时推送与通知邮件会先入队，
+// 再由后台任务发送，失败时按退避策略重试。
+type NotificationQueue interface {
+	Enqueue(ctx context.Context, delivery *model.NotificationDelivery, payload interface{}) error
+}
+
+// 通知类型，记录在投递队列中便于后台筛查
+const (
+	NotificationKindComment            = "comment"
+	NotificationKindCommentReply       = "comment_reply"
+	NotificationKindLinkApplication    = "link_application"
+	NotificationKindLinkReview         = "link_review"
+	NotificationKindLinkSick           = "link_sick"
+	NotificationKindArticlePush        = "article_push"
+	NotificationKindCommentDigest      = "comment_digest"
+	NotificationKindCommentMention     = "comment_mention"
+	NotificationKindCommentApproved    = "comment_approved"
+	NotificationKindSuspiciousLogin    = "suspicious_login"
+	NotificationKindContribution       = "contribution"
+	NotificationKindContributionReview = "contribution_review"
+)
+
+// EmailPayload 是入队邮件的投递内容
+type EmailPayload struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// PushPayload 是入队即时推送的投递内容，保存渲染完成的 HTTP 请求，重试时原样发送
+type PushPayload struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
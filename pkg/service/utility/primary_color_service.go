@@ -181,79 +181,119 @@ func (s *PrimaryColorService) isMiyousheImage(imageURL string) bool {
 
 // getColorFromSystemFile 从系统内的文件获取主色调
 func (s *PrimaryColorService) getColorFromSystemFile(ctx context.Context, filePublicID string) string {
-	// 解码公共ID
-	entityID, entityType, err := idgen.DecodePublicID(filePublicID)
+	file, policy, err := s.findSystemFile(ctx, filePublicID)
 	if err != nil {
-		log.Printf("[主色调服务] 解码ID失败: %v，返回空字符串", err)
+		log.Printf("[主色调服务] %v，返回空字符串", err)
 		return ""
 	}
 
-	var file *model.File
+	log.Printf("[主色调服务] 文件所属存储策略类型: %s", policy.Type)
+
+	// 根据存储策略类型选择不同的处理方式
+	switch policy.Type {
+	case constant.PolicyTypeLocal:
+		return s.getColorFromLocalFile(ctx, file, policy)
+	case constant.PolicyTypeOneDrive:
+		return s.getColorFromOneDriveFile(ctx, file, policy)
+	case constant.PolicyTypeTencentCOS:
+		return s.getColorFromTencentCOS(ctx, file, policy)
+	case constant.PolicyTypeAliOSS:
+		return s.getColorFromAliOSS(ctx, file, policy)
+	case constant.PolicyTypeQiniu:
+		return s.getColorFromQiniu(ctx, file, policy)
+	default:
+		log.Printf("[主色调服务] 不支持的存储策略类型: %s，返回空字符串", policy.Type)
+		return ""
+	}
+}
+
+// findSystemFile 根据文件或直链的公共ID查找文件及其存储策略
+func (s *PrimaryColorService) findSystemFile(ctx context.Context, filePublicID string) (*model.File, *model.StoragePolicy, error) {
+	entityID, entityType, err := idgen.DecodePublicID(filePublicID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("解码ID失败: %w", constant.ErrBadRequest)
+	}
 
-	// 根据实体类型获取文件信息
+	var file *model.File
 	switch entityType {
 	case idgen.EntityTypeFile:
-		// 直接是文件类型
-		log.Printf("[主色调服务] ID类型为文件，FileID: %d", entityID)
 		file, err = s.fileRepo.FindByID(ctx, entityID)
 		if err != nil {
-			log.Printf("[主色调服务] 查找文件失败: %v，返回空字符串", err)
-			return ""
+			return nil, nil, fmt.Errorf("查找文件失败: %w", err)
 		}
 	case idgen.EntityTypeDirectLink:
 		// 直链类型，需要先获取直链关联的文件
-		log.Printf("[主色调服务] ID类型为直链，DirectLinkID: %d，正在查找关联文件...", entityID)
 		directLink, err := s.directLinkRepo.FindByPublicID(ctx, filePublicID)
 		if err != nil {
-			log.Printf("[主色调服务] 查找直链失败: %v，返回空字符串", err)
-			return ""
+			return nil, nil, fmt.Errorf("查找直链失败: %w", err)
 		}
 		if directLink == nil || directLink.File == nil {
-			log.Printf("[主色调服务] 直链或关联文件不存在，返回空字符串")
-			return ""
+			return nil, nil, fmt.Errorf("直链或关联文件不存在: %w", constant.ErrNotFound)
 		}
 		file = directLink.File
-		log.Printf("[主色调服务] 通过直链找到关联文件，FileID: %d", file.ID)
 	default:
-		log.Printf("[主色调服务] 不支持的ID类型: %v，返回空字符串", entityType)
-		return ""
+		return nil, nil, fmt.Errorf("不支持的ID类型 %v: %w", entityType, constant.ErrBadRequest)
 	}
 
 	if file == nil || file.PrimaryEntity == nil {
-		log.Printf("[主色调服务] 文件或实体信息不完整，返回空字符串")
-		return ""
+		return nil, nil, fmt.Errorf("文件或实体信息不完整: %w", constant.ErrNotFound)
 	}
 
-	// 获取存储策略
 	policy, err := s.storagePolicyRepo.FindByID(ctx, file.PrimaryEntity.PolicyID)
 	if err != nil {
-		log.Printf("[主色调服务] 查找存储策略失败: %v，返回空字符串", err)
-		return ""
+		return nil, nil, fmt.Errorf("查找存储策略失败: %w", err)
 	}
-
 	if policy == nil {
-		log.Printf("[主色调服务] 存储策略不存在，返回空字符串")
-		return ""
+		return nil, nil, fmt.Errorf("存储策略不存在: %w", constant.ErrNotFound)
 	}
+	return file, policy, nil
+}
 
-	log.Printf("[主色调服务] 文件所属存储策略类型: %s", policy.Type)
+// paletteExtensions 可以提取配色的图片格式，SVG 等矢量图无法解码
+var paletteExtensions = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true,
+	".webp": true, ".bmp": true, ".avif": true, ".heic": true,
+}
 
-	// 根据存储策略类型选择不同的处理方式
-	switch policy.Type {
-	case constant.PolicyTypeLocal:
-		return s.getColorFromLocalFile(ctx, file, policy)
-	case constant.PolicyTypeOneDrive:
-		return s.getColorFromOneDriveFile(ctx, file, policy)
-	case constant.PolicyTypeTencentCOS:
-		return s.getColorFromTencentCOS(ctx, file, policy)
-	case constant.PolicyTypeAliOSS:
-		return s.getColorFromAliOSS(ctx, file, policy)
-	case constant.PolicyTypeQiniu:
-		return s.getColorFromQiniu(ctx, file, policy)
-	default:
-		log.Printf("[主色调服务] 不支持的存储策略类型: %s，返回空字符串", policy.Type)
-		return ""
+// GetFilePalette 读取系统内的图片文件（文件或直链公共ID）并提取 size 种代表色。
+// 与 GetPrimaryColorFromURL 不同，云存储的图片处理接口只能给出平均色，因此统一下载原图在本地聚类。
+func (s *PrimaryColorService) GetFilePalette(ctx context.Context, filePublicID string, size int) (*Palette, error) {
+	file, policy, err := s.findSystemFile(ctx, filePublicID)
+	if err != nil {
+		return nil, err
+	}
+	if !paletteExtensions[strings.ToLower(filepath.Ext(file.Name))] {
+		return nil, fmt.Errorf("文件 %s 不是支持的图片格式: %w", file.Name, constant.ErrBadRequest)
+	}
+	if !file.PrimaryEntity.Source.Valid || file.PrimaryEntity.Source.String == "" {
+		return nil, fmt.Errorf("文件存储路径为空: %w", constant.ErrNotFound)
+	}
+
+	if policy.Type == constant.PolicyTypeLocal {
+		var lastErr error
+		for _, fullPath := range localPrimaryColorPathCandidates(policy.BasePath, strings.TrimSpace(file.PrimaryEntity.Source.String)) {
+			f, err := os.Open(fullPath)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			palette, err := s.colorSvc.GetPalette(f, size)
+			_ = f.Close()
+			return palette, err
+		}
+		return nil, fmt.Errorf("打开本地文件失败: %w", lastErr)
+	}
+
+	provider, exists := s.storageProviders[policy.Type]
+	if !exists {
+		return nil, fmt.Errorf("存储策略类型 %s 没有可用的存储提供者", policy.Type)
+	}
+	reader, err := provider.Get(ctx, policy, file.PrimaryEntity.Source.String)
+	if err != nil {
+		return nil, fmt.Errorf("读取文件失败: %w", err)
 	}
+	defer reader.Close()
+	return s.colorSvc.GetPalette(reader, size)
 }
 
 // getColorFromLocalFile 从本地存储的文件获取主色调
@@ -21,6 +21,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/httpclient"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/ssrf"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
@@ -30,22 +32,34 @@ import (
 // PushooService 定义了即时消息推送的接口
 type PushooService interface {
 	SendCommentNotification(ctx context.Context, newComment *model.Comment, parentComment *model.Comment) error
+	// SendCommentMentionNotification 推送博主在评论中被 @ 提及的通知
+	SendCommentMentionNotification(ctx context.Context, comment *model.Comment) error
 	SendLinkApplicationNotification(ctx context.Context, link *model.LinkDTO) error
+	// SetQueue 设置通知投递队列（可选注入），设置后推送会持久化排队并在失败时重试
+	SetQueue(queue NotificationQueue)
+	// DeliverQueued 发送一条已入队的推送，供投递队列调用
+	DeliverQueued(ctx context.Context, payload []byte) error
 }
 
 // pushooService 是 PushooService 接口的实现
 type pushooService struct {
 	settingSvc setting.SettingService
 	httpClient *http.Client
+	queue      NotificationQueue
 }
 
-// NewPushooService 是 pushooService 的构造函数
+// NewPushooService 是 pushooService 的构造函数。
+// 推送地址由管理员配置，请求经过出站白名单与 SSRF 防护，推送到内网服务时需将其加入出站白名单
 func NewPushooService(settingSvc setting.SettingService) PushooService {
+	policy := httpclient.DefaultPolicy()
+	policy.Timeout = 30 * time.Second // 增加超时时间到30秒
+	guard := ssrf.NewGuard(func() string {
+		return settingSvc.Get(constant.KeyOutboundAllowlist.String())
+	})
+
 	return &pushooService{
 		settingSvc: settingSvc,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second, // 增加超时时间到30秒
-		},
+		httpClient: httpclient.New("pushoo", policy, httpclient.WithBaseTransport(guard.Transport())),
 	}
 }
 
@@ -77,19 +91,48 @@ func (s *pushooService) SendCommentNotification(ctx context.Context, newComment
 
 	// 2. 根据不同通道发送推送
 	log.Printf("[DEBUG] 开始根据渠道发送推送，渠道: %s", channel)
+	kind := NotificationKindComment
+	if parentComment != nil {
+		kind = NotificationKindCommentReply
+	}
 	switch strings.ToLower(channel) {
 	case "bark":
 		log.Printf("[DEBUG] 使用 Bark 渠道发送推送")
-		return s.sendBarkPush(ctx, pushURL, data)
+		return s.sendBarkPush(ctx, kind, pushURL, data)
 	case "webhook":
 		log.Printf("[DEBUG] 使用 Webhook 渠道发送推送")
-		return s.sendWebhookPush(ctx, pushURL, data)
+		return s.sendWebhookPush(ctx, kind, pushURL, data)
 	default:
 		log.Printf("[ERROR] 不支持的推送渠道: %s", channel)
 		return fmt.Errorf("不支持的推送通道: %s", channel)
 	}
 }
 
+// SendCommentMentionNotification 推送博主在评论中被 @ 提及的通知
+func (s *pushooService) SendCommentMentionNotification(ctx context.Context, comment *model.Comment) error {
+	channel := strings.TrimSpace(s.settingSvc.Get(constant.KeyPushooChannel.String()))
+	pushURL := strings.TrimSpace(s.settingSvc.Get(constant.KeyPushooURL.String()))
+	if channel == "" || pushURL == "" {
+		return nil // 未配置，静默返回
+	}
+
+	data, err := s.prepareTemplateData(comment, nil)
+	if err != nil {
+		return fmt.Errorf("准备推送模板数据失败: %w", err)
+	}
+	data["TITLE"] = fmt.Sprintf("您在「%s」的评论中被提及", data["SITE_NAME"])
+	data["BODY"] = fmt.Sprintf("%s 在评论中提到了您：「%s」", comment.Author.Nickname, comment.Content)
+
+	switch strings.ToLower(channel) {
+	case "bark":
+		return s.sendBarkPush(ctx, NotificationKindCommentMention, pushURL, data)
+	case "webhook":
+		return s.sendWebhookPush(ctx, NotificationKindCommentMention, pushURL, data)
+	default:
+		return fmt.Errorf("不支持的推送通道: %s", channel)
+	}
+}
+
 // prepareTemplateData 准备推送所需的模板数据
 func (s *pushooService) prepareTemplateData(newComment *model.Comment, parentComment *model.Comment) (map[string]interface{}, error) {
 	siteName := s.settingSvc.Get(constant.KeyAppName.String())
@@ -146,7 +189,7 @@ func (s *pushooService) prepareTemplateData(newComment *model.Comment, parentCom
 }
 
 // sendBarkPush 使用模板处理URL后发送Bark推送
-func (s *pushooService) sendBarkPush(ctx context.Context, pushURLTpl string, data map[string]interface{}) error {
+func (s *pushooService) sendBarkPush(ctx context.Context, kind, pushURLTpl string, data map[string]interface{}) error {
 	log.Printf("[DEBUG] sendBarkPush 开始执行，URL模板: %s", pushURLTpl)
 
 	// 为Bark创建专门的数据副本，对URL路径部分进行编码
@@ -187,45 +230,11 @@ func (s *pushooService) sendBarkPush(ctx context.Context, pushURLTpl string, dat
 	finalEncodedURL := finalURL
 	log.Printf("[DEBUG] 最终Bark请求URL: %s", finalEncodedURL)
 
-	// 创建一个独立的context，避免继承已经超时的context
-	reqCtx, cancel := context.WithTimeout(context.Background(), 25*time.Second)
-	defer cancel()
-
-	req, err := http.NewRequestWithContext(reqCtx, "GET", finalEncodedURL, nil)
-	if err != nil {
-		log.Printf("[ERROR] 创建Bark请求失败: %v", err)
-		return fmt.Errorf("创建bark请求失败: %w", err)
-	}
-
-	log.Printf("[DEBUG] 开始发送Bark HTTP请求")
-
-	// 添加网络诊断信息
-	log.Printf("[DEBUG] 请求目标: %s", req.URL.Host)
-	log.Printf("[DEBUG] 请求方法: %s", req.Method)
-	log.Printf("[DEBUG] 超时设置: %v", s.httpClient.Timeout)
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		log.Printf("[ERROR] 发送Bark推送失败: %v", err)
-		log.Printf("[DEBUG] 错误类型: %T", err)
-		// 尝试手动测试连接
-		log.Printf("[DEBUG] 建议手动测试: curl -I https://api.day.app")
-		return fmt.Errorf("发送bark推送失败: %w", err)
-	}
-	defer resp.Body.Close()
-
-	log.Printf("[DEBUG] Bark推送响应状态码: %d", resp.StatusCode)
-	if resp.StatusCode != http.StatusOK {
-		log.Printf("[ERROR] Bark推送返回错误状态码: %d", resp.StatusCode)
-		return fmt.Errorf("bark推送返回错误状态码: %d", resp.StatusCode)
-	}
-
-	log.Printf("[INFO] Bark推送发送成功: %s", data["TITLE"])
-	return nil
+	return s.dispatchPush(ctx, model.DeliveryChannelBark, kind, data, PushPayload{Method: http.MethodGet, URL: finalEncodedURL})
 }
 
 // sendWebhookPush 发送灵活配置的Webhook推送
-func (s *pushooService) sendWebhookPush(ctx context.Context, webhookURL string, data map[string]interface{}) error {
+func (s *pushooService) sendWebhookPush(ctx context.Context, kind, webhookURL string, data map[string]interface{}) error {
 	log.Printf("[DEBUG] sendWebhookPush 开始执行，URL: %s", webhookURL)
 
 	// 获取自定义配置
@@ -267,51 +276,87 @@ func (s *pushooService) sendWebhookPush(ctx context.Context, webhookURL string,
 
 	log.Printf("[DEBUG] Webhook请求配置: 方法=%s, URL=%s, Content-Type=%s", method, finalURL, contentType)
 
-	// 创建独立的context
-	reqCtx, cancel := context.WithTimeout(context.Background(), 25*time.Second)
-	defer cancel()
+	payload := PushPayload{Method: method, URL: finalURL, Body: requestBody, Headers: map[string]string{}}
+	// 设置自定义请求头
+	if customHeaders != "" {
+		for key, value := range s.extractWebhookHeaders(customHeaders) {
+			payload.Headers[key] = value
+		}
+	}
+	// 设置Content-Type
+	if contentType != "" {
+		payload.Headers["Content-Type"] = contentType
+	}
+	return s.dispatchPush(ctx, model.DeliveryChannelWebhook, kind, data, payload)
+}
 
-	// 创建请求
-	var reqBody io.Reader
-	if requestBody != "" {
-		reqBody = strings.NewReader(requestBody)
-		log.Printf("[DEBUG] Webhook请求体长度: %d bytes", len(requestBody))
+// SetQueue 设置通知投递队列
+func (s *pushooService) SetQueue(queue NotificationQueue) {
+	s.queue = queue
+}
+
+// DeliverQueued 发送一条已入队的推送
+func (s *pushooService) DeliverQueued(ctx context.Context, payload []byte) error {
+	var p PushPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("解析推送投递内容失败: %w", err)
 	}
+	return s.doPush(ctx, p)
+}
 
-	req, err := http.NewRequestWithContext(reqCtx, method, finalURL, reqBody)
+// dispatchPush 注入了投递队列时将推送入队，否则立即发送
+func (s *pushooService) dispatchPush(ctx context.Context, channel, kind string, data map[string]interface{}, p PushPayload) error {
+	if s.queue == nil {
+		// 创建一个独立的context，避免继承已经超时的context
+		return s.doPush(context.Background(), p)
+	}
+	var recipient string
+	if u, err := url.Parse(p.URL); err == nil {
+		recipient = u.Host // 推送地址中可能包含密钥，列表中只展示主机名
+	}
+	// 调用方的 context 可能随请求结束而取消，入队不应受其影响
+	err := s.queue.Enqueue(context.WithoutCancel(ctx), &model.NotificationDelivery{
+		Channel:   channel,
+		Kind:      kind,
+		Recipient: recipient,
+		Subject:   getString(data["TITLE"]),
+	}, p)
 	if err != nil {
-		log.Printf("[ERROR] 创建Webhook请求失败: %v", err)
-		return fmt.Errorf("创建webhook请求失败: %w", err)
+		return fmt.Errorf("推送入队失败: %w", err)
 	}
+	log.Printf("[DEBUG] %s 推送已加入投递队列", channel)
+	return nil
+}
 
-	// 设置自定义请求头
-	if customHeaders != "" {
-		headers := s.extractWebhookHeaders(customHeaders)
-		for key, value := range headers {
-			req.Header.Set(key, value)
-		}
-	}
+// doPush 发送渲染完成的推送请求
+func (s *pushooService) doPush(ctx context.Context, p PushPayload) error {
+	reqCtx, cancel := context.WithTimeout(ctx, 25*time.Second)
+	defer cancel()
 
-	// 设置Content-Type
-	if contentType != "" {
-		req.Header.Set("Content-Type", contentType)
+	var reqBody io.Reader
+	if p.Body != "" {
+		reqBody = strings.NewReader(p.Body)
+	}
+	req, err := http.NewRequestWithContext(reqCtx, p.Method, p.URL, reqBody)
+	if err != nil {
+		return fmt.Errorf("创建推送请求失败: %w", err)
+	}
+	for key, value := range p.Headers {
+		req.Header.Set(key, value)
 	}
 
-	log.Printf("[DEBUG] 开始发送Webhook HTTP请求")
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		log.Printf("[ERROR] 发送Webhook推送失败: %v", err)
-		return fmt.Errorf("发送webhook推送失败: %w", err)
+		log.Printf("[ERROR] 发送推送失败: %v", err)
+		return fmt.Errorf("发送推送失败: %w", err)
 	}
 	defer resp.Body.Close()
 
-	log.Printf("[DEBUG] Webhook推送响应状态码: %d", resp.StatusCode)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		log.Printf("[ERROR] Webhook推送返回错误状态码: %d", resp.StatusCode)
-		return fmt.Errorf("webhook推送返回错误状态码: %d", resp.StatusCode)
+		log.Printf("[ERROR] 推送返回错误状态码: %d", resp.StatusCode)
+		return fmt.Errorf("推送返回错误状态码: %d", resp.StatusCode)
 	}
-
-	log.Printf("[INFO] Webhook推送发送成功")
+	log.Printf("[INFO] 推送发送成功: %s", req.URL.Host)
 	return nil
 }
 
@@ -431,7 +476,7 @@ func (s *pushooService) SendLinkApplicationNotification(ctx context.Context, lin
 	switch strings.ToLower(channel) {
 	case "bark":
 		log.Printf("[DEBUG] 使用 Bark 渠道发送推送")
-		return s.sendBarkPush(ctx, pushURL, data)
+		return s.sendBarkPush(ctx, NotificationKindLinkApplication, pushURL, data)
 	case "webhook":
 		log.Printf("[DEBUG] 使用 Webhook 渠道发送推送")
 		return s.sendLinkWebhookPush(ctx, pushURL, data)
@@ -517,52 +562,18 @@ func (s *pushooService) sendLinkWebhookPush(ctx context.Context, webhookURL stri
 
 	log.Printf("[DEBUG] 友链Webhook请求配置: 方法=%s, URL=%s, Content-Type=%s", method, finalURL, contentType)
 
-	// 创建独立的context
-	reqCtx, cancel := context.WithTimeout(context.Background(), 25*time.Second)
-	defer cancel()
-
-	// 创建请求
-	var reqBody io.Reader
-	if requestBody != "" {
-		reqBody = strings.NewReader(requestBody)
-		log.Printf("[DEBUG] 友链Webhook请求体长度: %d bytes", len(requestBody))
-	}
-
-	req, err := http.NewRequestWithContext(reqCtx, method, finalURL, reqBody)
-	if err != nil {
-		log.Printf("[ERROR] 创建友链Webhook请求失败: %v", err)
-		return fmt.Errorf("创建友链webhook请求失败: %w", err)
-	}
-
+	payload := PushPayload{Method: method, URL: finalURL, Body: requestBody, Headers: map[string]string{}}
 	// 设置自定义请求头
 	if customHeaders != "" {
-		headers := s.extractWebhookHeaders(customHeaders)
-		for key, value := range headers {
-			req.Header.Set(key, value)
+		for key, value := range s.extractWebhookHeaders(customHeaders) {
+			payload.Headers[key] = value
 		}
 	}
-
 	// 设置Content-Type
 	if contentType != "" {
-		req.Header.Set("Content-Type", contentType)
+		payload.Headers["Content-Type"] = contentType
 	}
-
-	log.Printf("[DEBUG] 开始发送友链Webhook HTTP请求")
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		log.Printf("[ERROR] 发送友链Webhook推送失败: %v", err)
-		return fmt.Errorf("发送友链webhook推送失败: %w", err)
-	}
-	defer resp.Body.Close()
-
-	log.Printf("[DEBUG] 友链Webhook推送响应状态码: %d", resp.StatusCode)
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		log.Printf("[ERROR] 友链Webhook推送返回错误状态码: %d", resp.StatusCode)
-		return fmt.Errorf("友链webhook推送返回错误状态码: %d", resp.StatusCode)
-	}
-
-	log.Printf("[INFO] 友链Webhook推送发送成功")
-	return nil
+	return s.dispatchPush(ctx, model.DeliveryChannelWebhook, NotificationKindLinkApplication, data, payload)
 }
 
 // replaceLinkWebhookParameters 替换友链webhook参数，使用#{parameter}格式
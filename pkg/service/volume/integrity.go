@@ -0,0 +1,282 @@
+/*
+ * @Description: 数据一致性检查 - 检测文件、物理实体与存储策略之间的不一致，可选自动修复并保留最近一次报告供下载
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 04:00:00
+ * @LastEditTime: 2026-10-17 04:00:00
+ * @LastEditors: 安知鱼
+ */
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// ErrIntegrityCheckRunning 已有一致性检查在执行时返回
+var ErrIntegrityCheckRunning = errors.New("已有数据一致性检查正在执行")
+
+const (
+	// orphanedEntityGrace 实体创建后多久仍未被引用才视为孤立，避免误删刚上传、尚未关联文件的实体
+	orphanedEntityGrace = 24 * time.Hour
+	// integrityMaxItems 报告中每类问题最多保留的明细条数
+	integrityMaxItems = 1000
+)
+
+// IntegrityIssue 一条不一致记录，ID 为对应表中的数据库ID
+type IntegrityIssue struct {
+	ID     uint   `json:"id"`
+	Detail string `json:"detail"`
+}
+
+// IntegrityCategory 单类问题的检查与修复结果
+type IntegrityCategory struct {
+	Count    int              `json:"count"`
+	Repaired int              `json:"repaired"`
+	Items    []IntegrityIssue `json:"items"`
+	// Truncated 为 true 表示问题数超过 integrityMaxItems，明细只保留前面部分
+	Truncated bool `json:"truncated"`
+}
+
+func (c *IntegrityCategory) add(id uint, format string, args ...any) {
+	c.Count++
+	if len(c.Items) >= integrityMaxItems {
+		c.Truncated = true
+		return
+	}
+	c.Items = append(c.Items, IntegrityIssue{ID: id, Detail: fmt.Sprintf(format, args...)})
+}
+
+// IntegrityReport 一次一致性检查的完整报告
+type IntegrityReport struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Repair     bool      `json:"repair"`
+	// OrphanedEntities 没有被任何文件引用的实体，修复时删除远程对象及实体记录
+	OrphanedEntities IntegrityCategory `json:"orphaned_entities"`
+	// FilesMissingEntity 主实体已不存在的文件，修复时改为指向最新的有效版本，没有可用版本时移入回收站
+	FilesMissingEntity IntegrityCategory `json:"files_missing_entity"`
+	// DanglingVersions 指向不存在实体的文件版本关联，修复时删除
+	DanglingVersions IntegrityCategory `json:"dangling_versions"`
+	// EntitiesWithDeletedPolicy 所属存储策略已删除的实体，无法安全地自动处理，只报告
+	EntitiesWithDeletedPolicy IntegrityCategory `json:"entities_with_deleted_policy"`
+	// ChildrenCount 子项计数为负数或与实际不符的目录，修复时更新为实际值
+	ChildrenCount IntegrityCategory `json:"children_count"`
+	Errors        []string          `json:"errors"`
+}
+
+// IntegrityService 检查并修复文件、实体与存储策略之间的数据一致性
+type IntegrityService struct {
+	repo       repository.IntegrityRepository
+	entityRepo repository.EntityRepository
+	policySvc  IStoragePolicyService
+	settingSvc setting.SettingService
+	providers  map[constant.StoragePolicyType]storage.IStorageProvider
+
+	mu      sync.Mutex
+	running bool
+	last    *IntegrityReport
+}
+
+// NewIntegrityService 创建数据一致性检查服务
+func NewIntegrityService(
+	repo repository.IntegrityRepository,
+	entityRepo repository.EntityRepository,
+	policySvc IStoragePolicyService,
+	settingSvc setting.SettingService,
+	providers map[constant.StoragePolicyType]storage.IStorageProvider,
+) *IntegrityService {
+	return &IntegrityService{
+		repo:       repo,
+		entityRepo: entityRepo,
+		policySvc:  policySvc,
+		settingSvc: settingSvc,
+		providers:  providers,
+	}
+}
+
+// AutoRepairEnabled 返回定时检查是否自动修复
+func (s *IntegrityService) AutoRepairEnabled() bool {
+	enabled, _ := strconv.ParseBool(strings.TrimSpace(s.settingSvc.Get(constant.KeyIntegrityAutoRepair.String())))
+	return enabled
+}
+
+// LastReport 返回最近一次检查的报告，尚未执行过检查时返回 nil
+func (s *IntegrityService) LastReport() *IntegrityReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+// AutoCheck 供定时任务调用，按系统设置决定是否自动修复
+func (s *IntegrityService) AutoCheck(ctx context.Context) (*IntegrityReport, error) {
+	return s.Check(ctx, s.AutoRepairEnabled())
+}
+
+// Check 执行一次一致性检查，repair 为 true 时修复可自动处理的问题。
+// 各类问题依次检查，先修复文件与版本关联，再清理孤立实体，最后校正目录计数，
+// 使移入回收站的文件能反映到计数中。单类检查失败记录在报告中，不影响其他类别。
+func (s *IntegrityService) Check(ctx context.Context, repair bool) (*IntegrityReport, error) {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil, ErrIntegrityCheckRunning
+	}
+	s.running = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+	}()
+
+	report := &IntegrityReport{StartedAt: time.Now(), Repair: repair, Errors: []string{}}
+	steps := []struct {
+		name string
+		run  func(ctx context.Context, report *IntegrityReport, repair bool) error
+	}{
+		{"文件主实体", s.checkFilesMissingEntity},
+		{"文件版本关联", s.checkDanglingVersions},
+		{"实体存储策略", s.checkEntitiesWithDeletedPolicy},
+		{"孤立实体", s.checkOrphanedEntities},
+		{"目录子项计数", s.checkChildrenCount},
+	}
+	for _, step := range steps {
+		if err := step.run(ctx, report, repair); err != nil {
+			log.Printf("[一致性检查] 检查%s失败: %v", step.name, err)
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", step.name, err))
+		}
+	}
+	report.FinishedAt = time.Now()
+
+	s.mu.Lock()
+	s.last = report
+	s.mu.Unlock()
+	return report, nil
+}
+
+func (s *IntegrityService) checkFilesMissingEntity(ctx context.Context, report *IntegrityReport, repair bool) error {
+	files, err := s.repo.FindFilesWithMissingEntity(ctx)
+	if err != nil {
+		return err
+	}
+	category := &report.FilesMissingEntity
+	for _, f := range files {
+		category.add(f.ID, "文件 '%s' (所有者 %d) 的主实体 %d 不存在", f.Name, f.OwnerID, f.PrimaryEntityID.Uint64)
+		if !repair {
+			continue
+		}
+		if _, err := s.repo.RelinkOrTrashFile(ctx, f.ID); err != nil {
+			report.Errors = append(report.Errors, err.Error())
+			continue
+		}
+		category.Repaired++
+	}
+	return nil
+}
+
+func (s *IntegrityService) checkDanglingVersions(ctx context.Context, report *IntegrityReport, repair bool) error {
+	ids, err := s.repo.FindDanglingFileVersions(ctx)
+	if err != nil {
+		return err
+	}
+	category := &report.DanglingVersions
+	for _, id := range ids {
+		category.add(id, "文件版本关联指向的实体不存在")
+	}
+	if repair && len(ids) > 0 {
+		n, err := s.repo.DeleteFileVersions(ctx, ids)
+		if err != nil {
+			return err
+		}
+		category.Repaired = n
+	}
+	return nil
+}
+
+func (s *IntegrityService) checkEntitiesWithDeletedPolicy(ctx context.Context, report *IntegrityReport, _ bool) error {
+	entities, err := s.repo.FindEntitiesWithDeletedPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	for _, e := range entities {
+		report.EntitiesWithDeletedPolicy.add(e.ID, "实体 '%s' 所属的存储策略 %d 已删除", e.Source.String, e.PolicyID)
+	}
+	return nil
+}
+
+// checkOrphanedEntities 检查孤立实体。修复时先删除远程对象，成功后再删除实体记录；
+// 存储策略已删除的实体无法访问远程对象，只报告不删除，避免丢失仅存的对象路径。
+func (s *IntegrityService) checkOrphanedEntities(ctx context.Context, report *IntegrityReport, repair bool) error {
+	entities, err := s.repo.FindOrphanedEntities(ctx, time.Now().Add(-orphanedEntityGrace))
+	if err != nil {
+		return err
+	}
+	category := &report.OrphanedEntities
+	byPolicy := make(map[uint][]*model.FileStorageEntity)
+	for _, e := range entities {
+		category.add(e.ID, "实体 '%s' (策略 %d, %d 字节) 未被任何文件引用", e.Source.String, e.PolicyID, e.Size)
+		byPolicy[e.PolicyID] = append(byPolicy[e.PolicyID], e)
+	}
+	if !repair {
+		return nil
+	}
+
+	for policyID, group := range byPolicy {
+		policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, policyID)
+		if err != nil || policy.DeletedAt != nil {
+			continue
+		}
+		provider, ok := s.providers[policy.Type]
+		if !ok {
+			report.Errors = append(report.Errors, fmt.Sprintf("策略 %d 的存储类型 %s 不受支持", policyID, policy.Type))
+			continue
+		}
+		var deletedIDs []uint
+		for _, e := range group {
+			if e.Source.Valid && e.Source.String != "" {
+				if err := provider.Delete(ctx, policy, []string{e.Source.String}); err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("删除远程对象 %s 失败: %v", e.Source.String, err))
+					continue
+				}
+			}
+			deletedIDs = append(deletedIDs, e.ID)
+		}
+		if err := s.entityRepo.HardDeleteBatch(ctx, deletedIDs); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("删除策略 %d 下的孤立实体记录失败: %v", policyID, err))
+			continue
+		}
+		category.Repaired += len(deletedIDs)
+	}
+	return nil
+}
+
+func (s *IntegrityService) checkChildrenCount(ctx context.Context, report *IntegrityReport, repair bool) error {
+	mismatches, err := s.repo.FindChildrenCountMismatches(ctx)
+	if err != nil {
+		return err
+	}
+	category := &report.ChildrenCount
+	for _, m := range mismatches {
+		category.add(m.FileID, "目录 '%s' (所有者 %d) 记录的子项数 %d，实际为 %d", m.Name, m.OwnerID, m.Recorded, m.Actual)
+	}
+	if repair && len(mismatches) > 0 {
+		n, err := s.repo.SetChildrenCounts(ctx, mismatches)
+		category.Repaired = n
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package volume
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakeIntegrityRepo struct {
+	repository.IntegrityRepository
+	orphans        []*model.FileStorageEntity
+	missing        []*model.File
+	dangling       []uint
+	deletedPolicy  []*model.FileStorageEntity
+	mismatches     []repository.ChildrenCountMismatch
+	relinked       []uint
+	deletedVersion []uint
+	counts         []repository.ChildrenCountMismatch
+}
+
+func (f *fakeIntegrityRepo) FindOrphanedEntities(ctx context.Context, before time.Time) ([]*model.FileStorageEntity, error) {
+	return f.orphans, nil
+}
+
+func (f *fakeIntegrityRepo) FindFilesWithMissingEntity(ctx context.Context) ([]*model.File, error) {
+	return f.missing, nil
+}
+
+func (f *fakeIntegrityRepo) FindDanglingFileVersions(ctx context.Context) ([]uint, error) {
+	return f.dangling, nil
+}
+
+func (f *fakeIntegrityRepo) FindEntitiesWithDeletedPolicy(ctx context.Context) ([]*model.FileStorageEntity, error) {
+	return f.deletedPolicy, nil
+}
+
+func (f *fakeIntegrityRepo) FindChildrenCountMismatches(ctx context.Context) ([]repository.ChildrenCountMismatch, error) {
+	return f.mismatches, nil
+}
+
+func (f *fakeIntegrityRepo) RelinkOrTrashFile(ctx context.Context, fileID uint) (bool, error) {
+	f.relinked = append(f.relinked, fileID)
+	return true, nil
+}
+
+func (f *fakeIntegrityRepo) DeleteFileVersions(ctx context.Context, ids []uint) (int, error) {
+	f.deletedVersion = append(f.deletedVersion, ids...)
+	return len(ids), nil
+}
+
+func (f *fakeIntegrityRepo) SetChildrenCounts(ctx context.Context, items []repository.ChildrenCountMismatch) (int, error) {
+	f.counts = append(f.counts, items...)
+	return len(items), nil
+}
+
+type fakeIntegrityPolicySvc struct {
+	IStoragePolicyService
+	policies map[uint]*model.StoragePolicy
+}
+
+func (f *fakeIntegrityPolicySvc) GetPolicyByDatabaseID(ctx context.Context, id uint) (*model.StoragePolicy, error) {
+	if p, ok := f.policies[id]; ok {
+		return p, nil
+	}
+	return nil, constant.ErrPolicyNotFound
+}
+
+func newIntegrityEntity(id, policyID uint, source string) *model.FileStorageEntity {
+	return &model.FileStorageEntity{ID: id, PolicyID: policyID, Size: 10, Source: sql.NullString{String: source, Valid: true}}
+}
+
+func TestIntegrityCheck(t *testing.T) {
+	deletedAt := time.Now()
+	repo := &fakeIntegrityRepo{
+		orphans: []*model.FileStorageEntity{
+			newIntegrityEntity(1, 1, "a.jpg"),
+			newIntegrityEntity(2, 1, "fail.jpg"),
+			newIntegrityEntity(3, 2, "c.jpg"),
+		},
+		missing:       []*model.File{{ID: 10, Name: "lost.txt"}},
+		dangling:      []uint{20, 21},
+		deletedPolicy: []*model.FileStorageEntity{newIntegrityEntity(3, 2, "c.jpg")},
+		mismatches:    []repository.ChildrenCountMismatch{{FileID: 30, Name: "docs", Recorded: -1, Actual: 2}},
+	}
+	entityRepo := &fakePurgeEntityRepo{entities: map[uint]*model.FileStorageEntity{}}
+	for _, e := range repo.orphans {
+		entityRepo.entities[e.ID] = e
+	}
+	settings := &fakeStaleSettings{values: map[string]string{}}
+	svc := NewIntegrityService(repo, entityRepo,
+		&fakeIntegrityPolicySvc{policies: map[uint]*model.StoragePolicy{
+			1: {ID: 1, Type: constant.PolicyTypeLocal},
+			2: {ID: 2, Type: constant.PolicyTypeLocal, DeletedAt: &deletedAt},
+		}},
+		settings,
+		map[constant.StoragePolicyType]storage.IStorageProvider{constant.PolicyTypeLocal: &fakePurgeProvider{failing: "fail.jpg"}},
+	)
+	ctx := context.Background()
+
+	if svc.LastReport() != nil {
+		t.Fatal("未检查前不应有报告")
+	}
+	report, err := svc.AutoCheck(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Repair || len(repo.relinked) > 0 || len(repo.deletedVersion) > 0 || len(repo.counts) > 0 || len(entityRepo.entities) != 3 {
+		t.Fatalf("未开启自动修复时不应修改数据: %+v", report)
+	}
+	if report.OrphanedEntities.Count != 3 || report.FilesMissingEntity.Count != 1 || report.DanglingVersions.Count != 2 ||
+		report.EntitiesWithDeletedPolicy.Count != 1 || report.ChildrenCount.Count != 1 {
+		t.Fatalf("检查结果错误: %+v", report)
+	}
+	if svc.LastReport() != report {
+		t.Error("应保留最近一次报告供下载")
+	}
+
+	settings.values[constant.KeyIntegrityAutoRepair.String()] = "true"
+	report, err = svc.AutoCheck(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Repair || report.FilesMissingEntity.Repaired != 1 || report.DanglingVersions.Repaired != 2 || report.ChildrenCount.Repaired != 1 {
+		t.Fatalf("修复结果错误: %+v", report)
+	}
+	// 远程对象删除失败的实体和策略已删除的实体都应保留记录
+	if report.OrphanedEntities.Repaired != 1 || len(entityRepo.entities) != 2 || entityRepo.entities[2] == nil || entityRepo.entities[3] == nil {
+		t.Fatalf("孤立实体修复错误: repaired=%d remaining=%v", report.OrphanedEntities.Repaired, entityRepo.entities)
+	}
+	if len(report.Errors) != 1 {
+		t.Errorf("远程对象删除失败应记录在报告中: %v", report.Errors)
+	}
+}
+
+func TestIntegrityCheckRejectsConcurrentRun(t *testing.T) {
+	svc := NewIntegrityService(&fakeIntegrityRepo{}, nil, nil, nil, nil)
+	svc.running = true
+	if _, err := svc.Check(context.Background(), false); !errors.Is(err, ErrIntegrityCheckRunning) {
+		t.Fatalf("检查进行中时应拒绝新的检查: %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+/*
+ * @Description: 存储策略别名挂载点管理服务
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 20:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// mountsCacheKey 是全部别名挂载点列表的缓存键，VFS 路由与挂载点管理共用
+const mountsCacheKey = "storage_policy_mounts_all"
+
+// MountRequest 定义了新增或修改挂载点时的参数
+type MountRequest struct {
+	VirtualPath string `json:"virtual_path" binding:"required"`
+	ReadOnly    bool   `json:"read_only"`
+}
+
+// MountService 管理存储策略的别名挂载点。
+// 策略记录上的 VirtualPath 仍是主挂载路径，别名挂载点单独存储，由 VFS 统一参与最长前缀匹配。
+type MountService struct {
+	policySvc IStoragePolicyService
+	mountRepo repository.StoragePolicyMountRepository
+	txManager repository.TransactionManager
+	cacheSvc  utility.CacheService
+}
+
+// NewMountService 是 MountService 的构造函数
+func NewMountService(
+	policySvc IStoragePolicyService,
+	mountRepo repository.StoragePolicyMountRepository,
+	txManager repository.TransactionManager,
+	cacheSvc utility.CacheService,
+) *MountService {
+	return &MountService{
+		policySvc: policySvc,
+		mountRepo: mountRepo,
+		txManager: txManager,
+		cacheSvc:  cacheSvc,
+	}
+}
+
+// List 返回策略的全部别名挂载点
+func (s *MountService) List(ctx context.Context, publicPolicyID string) ([]*model.StoragePolicyMount, error) {
+	policy, err := s.policySvc.GetPolicyByID(ctx, publicPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	return s.mountRepo.ListByPolicyID(ctx, policy.ID)
+}
+
+// Add 为策略新增一个别名挂载点，并在操作者的根目录下创建对应的挂载目录
+func (s *MountService) Add(ctx context.Context, ownerID uint, publicPolicyID string, req *MountRequest) (*model.StoragePolicyMount, error) {
+	policy, err := s.policySvc.GetPolicyByID(ctx, publicPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.VirtualPath == "/" {
+		return nil, fmt.Errorf("根存储策略不支持别名挂载: %w", constant.ErrBadRequest)
+	}
+
+	mountPath, err := normalizeMountPath(req.VirtualPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPathAvailable(ctx, mountPath, 0); err != nil {
+		return nil, err
+	}
+
+	mount := &model.StoragePolicyMount{
+		PolicyID:    policy.ID,
+		VirtualPath: mountPath,
+		ReadOnly:    req.ReadOnly,
+	}
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		rootDir, err := repos.File.FindOrCreateRootDirectory(ctx, ownerID)
+		if err != nil {
+			return fmt.Errorf("无法找到或创建用户(ID: %d)的根目录: %w", ownerID, err)
+		}
+		dir, err := repos.File.FindOrCreateDirectory(ctx, rootDir.ID, strings.TrimPrefix(mountPath, "/"), ownerID)
+		if err != nil {
+			return fmt.Errorf("创建挂载点目录失败: %w", err)
+		}
+		mount.NodeID = &dir.ID
+		return repos.StoragePolicyMount.Create(ctx, mount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+	log.Printf("[挂载点] 策略 '%s' 新增别名挂载点 %s (只读: %t)", policy.Name, mount.VirtualPath, mount.ReadOnly)
+	return mount, nil
+}
+
+// Update 修改别名挂载点的路径或只读属性；修改路径时会同步重命名挂载目录，目录必须为空
+func (s *MountService) Update(ctx context.Context, publicPolicyID string, mountID uint, req *MountRequest) (*model.StoragePolicyMount, error) {
+	mount, err := s.findOwnedMount(ctx, publicPolicyID, mountID)
+	if err != nil {
+		return nil, err
+	}
+
+	mountPath, err := normalizeMountPath(req.VirtualPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if mountPath != mount.VirtualPath {
+			if err := s.checkPathAvailable(ctx, mountPath, mount.ID); err != nil {
+				return err
+			}
+			if mount.NodeID != nil {
+				dir, err := repos.File.FindByID(ctx, *mount.NodeID)
+				if err != nil {
+					return fmt.Errorf("找不到挂载点目录 (FileID: %d): %w", *mount.NodeID, err)
+				}
+				if dir.ChildrenCount > 0 {
+					return fmt.Errorf("无法修改挂载路径：挂载点目录 '%s' 不为空: %w", dir.Name, constant.ErrConflict)
+				}
+				dir.Name = strings.TrimPrefix(mountPath, "/")
+				if err := repos.File.Update(ctx, dir); err != nil {
+					return fmt.Errorf("重命名挂载点目录失败: %w", err)
+				}
+			}
+			mount.VirtualPath = mountPath
+		}
+		mount.ReadOnly = req.ReadOnly
+		return repos.StoragePolicyMount.Update(ctx, mount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+	return mount, nil
+}
+
+// Remove 删除别名挂载点，挂载目录保留为普通目录。删除后挂载目录将按根路径所在的策略解析，
+// 其中已有文件的物理位置会对不上，因此挂载目录必须为空
+func (s *MountService) Remove(ctx context.Context, publicPolicyID string, mountID uint) error {
+	mount, err := s.findOwnedMount(ctx, publicPolicyID, mountID)
+	if err != nil {
+		return err
+	}
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if mount.NodeID != nil {
+			dir, err := repos.File.FindByID(ctx, *mount.NodeID)
+			if err != nil && !errors.Is(err, constant.ErrNotFound) {
+				return fmt.Errorf("查询挂载点目录失败 (FileID: %d): %w", *mount.NodeID, err)
+			}
+			if dir != nil && dir.ChildrenCount > 0 {
+				return fmt.Errorf("无法删除挂载点：挂载点目录 '%s' 不为空，请先移出或删除其中的文件: %w", dir.Name, constant.ErrConflict)
+			}
+		}
+		if err := repos.StoragePolicyMount.Delete(ctx, mount.ID); err != nil {
+			return fmt.Errorf("删除挂载点失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.invalidate(ctx)
+	log.Printf("[挂载点] 已删除别名挂载点 %s (策略ID: %d)", mount.VirtualPath, mount.PolicyID)
+	return nil
+}
+
+// findOwnedMount 查找挂载点并确认其属于指定策略
+func (s *MountService) findOwnedMount(ctx context.Context, publicPolicyID string, mountID uint) (*model.StoragePolicyMount, error) {
+	policy, err := s.policySvc.GetPolicyByID(ctx, publicPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	mount, err := s.mountRepo.FindByID(ctx, mountID)
+	if err != nil {
+		return nil, err
+	}
+	if mount == nil || mount.PolicyID != policy.ID {
+		return nil, constant.ErrNotFound
+	}
+	return mount, nil
+}
+
+// checkPathAvailable 检查挂载路径是否已被任何策略的主路径或其他挂载点占用
+func (s *MountService) checkPathAvailable(ctx context.Context, mountPath string, selfID uint) error {
+	policies, err := s.policySvc.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("检查虚拟路径冲突失败: %w", err)
+	}
+	for _, policy := range policies {
+		if policy.VirtualPath == mountPath {
+			return fmt.Errorf("虚拟路径 '%s' 已被策略 '%s' 占用: %w", mountPath, policy.Name, constant.ErrConflict)
+		}
+	}
+	existing, err := s.mountRepo.FindByVirtualPath(ctx, mountPath)
+	if err != nil {
+		return fmt.Errorf("检查虚拟路径冲突失败: %w", err)
+	}
+	if existing != nil && existing.ID != selfID {
+		return fmt.Errorf("虚拟路径 '%s' 已被其他挂载点占用: %w", mountPath, constant.ErrConflict)
+	}
+	return nil
+}
+
+// invalidate 清除挂载点列表缓存，使 VFS 立即按新的挂载配置路由
+func (s *MountService) invalidate(ctx context.Context) {
+	s.cacheSvc.Delete(ctx, mountsCacheKey)
+}
+
+// normalizeMountPath 校验并规范化挂载路径，规则与策略主路径一致：以 '/' 开头的一级目录，不能是根目录
+func normalizeMountPath(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "/") {
+		return "", fmt.Errorf("挂载路径必须以'/'开头: %w", constant.ErrBadRequest)
+	}
+	path = "/" + strings.Trim(path, "/")
+	if path == "/" {
+		return "", fmt.Errorf("不能挂载到根目录 '/': %w", constant.ErrBadRequest)
+	}
+	name := strings.TrimPrefix(path, "/")
+	if strings.Contains(name, "/") {
+		return "", fmt.Errorf("挂载路径只能是一级目录（如 /photos）: %w", constant.ErrBadRequest)
+	}
+	if name == "." || name == ".." {
+		return "", fmt.Errorf("挂载路径无效: %w", constant.ErrBadRequest)
+	}
+	return path, nil
+}
@@ -0,0 +1,157 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+type fakeMountPolicySvc struct {
+	IStoragePolicyService
+	policies []*model.StoragePolicy
+}
+
+func (f *fakeMountPolicySvc) ListAll(ctx context.Context) ([]*model.StoragePolicy, error) {
+	return f.policies, nil
+}
+
+func (f *fakeMountPolicySvc) GetPolicyByID(ctx context.Context, id string) (*model.StoragePolicy, error) {
+	return f.policies[0], nil
+}
+
+type fakeMountRepo struct {
+	repository.StoragePolicyMountRepository
+	mounts []*model.StoragePolicyMount
+}
+
+func (f *fakeMountRepo) ListAll(ctx context.Context) ([]*model.StoragePolicyMount, error) {
+	return f.mounts, nil
+}
+
+func (f *fakeMountRepo) FindByID(ctx context.Context, id uint) (*model.StoragePolicyMount, error) {
+	for _, m := range f.mounts {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (f *fakeMountRepo) Delete(ctx context.Context, id uint) error {
+	for i, m := range f.mounts {
+		if m.ID == id {
+			f.mounts = append(f.mounts[:i], f.mounts[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+type fakeMountFileRepo struct {
+	repository.FileRepository
+	dirs map[uint]*model.File
+}
+
+func (f *fakeMountFileRepo) FindByID(ctx context.Context, id uint) (*model.File, error) {
+	if dir, ok := f.dirs[id]; ok {
+		return dir, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+type fakeMountTx struct {
+	repos repository.Repositories
+}
+
+func (f *fakeMountTx) Do(ctx context.Context, fn func(repos repository.Repositories) error) error {
+	return fn(f.repos)
+}
+
+type noopCache struct {
+	utility.CacheService
+}
+
+func (noopCache) Get(ctx context.Context, key string) (string, error) { return "", errors.New("miss") }
+
+func (noopCache) Delete(ctx context.Context, key ...string) error { return nil }
+
+func (noopCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	return nil
+}
+
+func TestFindPolicyForPath_ResolvesMountsByLongestPrefix(t *testing.T) {
+	policySvc := &fakeMountPolicySvc{policies: []*model.StoragePolicy{
+		{ID: 1, Name: "本机存储", VirtualPath: "/"},
+		{ID: 2, Name: "OSS", VirtualPath: "/oss"},
+	}}
+	mountRepo := &fakeMountRepo{mounts: []*model.StoragePolicyMount{
+		{ID: 7, PolicyID: 2, VirtualPath: "/photos", ReadOnly: true},
+		{ID: 8, PolicyID: 99, VirtualPath: "/orphan"},
+	}}
+	vfs := NewVFSService(policySvc, mountRepo, noopCache{}, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	policy, err := vfs.FindPolicyForPath(ctx, "/photos/2026/a.jpg")
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if policy.ID != 2 || policy.VirtualPath != "/photos" || policy.MountID != 7 || !policy.ReadOnly {
+		t.Errorf("别名挂载点解析错误: %+v", policy)
+	}
+
+	policy, _ = vfs.FindPolicyForPath(ctx, "/oss/a.jpg")
+	if policy.ID != 2 || policy.VirtualPath != "/oss" || policy.ReadOnly {
+		t.Errorf("主路径不应受别名影响: %+v", policy)
+	}
+	if policySvc.policies[1].VirtualPath != "/oss" {
+		t.Error("别名解析不应修改原策略")
+	}
+
+	policy, _ = vfs.FindPolicyForPath(ctx, "/orphan/a.jpg")
+	if policy.ID != 1 {
+		t.Errorf("所属策略不存在的挂载点应被忽略: %+v", policy)
+	}
+}
+
+func TestNormalizeMountPath(t *testing.T) {
+	if path, err := normalizeMountPath(" /photos/ "); err != nil || path != "/photos" {
+		t.Errorf("规范化结果错误: %q %v", path, err)
+	}
+	for _, bad := range []string{"photos", "/", "/a/b", "/.."} {
+		if _, err := normalizeMountPath(bad); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("路径 %q 应被拒绝: %v", bad, err)
+		}
+	}
+}
+
+func TestMountRemoveRequiresEmptyDirectory(t *testing.T) {
+	full, empty := uint(10), uint(11)
+	policySvc := &fakeMountPolicySvc{policies: []*model.StoragePolicy{{ID: 2, Name: "OSS", VirtualPath: "/oss"}}}
+	mountRepo := &fakeMountRepo{mounts: []*model.StoragePolicyMount{
+		{ID: 7, PolicyID: 2, VirtualPath: "/photos", NodeID: &full},
+		{ID: 8, PolicyID: 2, VirtualPath: "/music", NodeID: &empty},
+	}}
+	fileRepo := &fakeMountFileRepo{dirs: map[uint]*model.File{
+		full:  {ID: full, Name: "photos", ChildrenCount: 3},
+		empty: {ID: empty, Name: "music"},
+	}}
+	tx := &fakeMountTx{repos: repository.Repositories{File: fileRepo, StoragePolicyMount: mountRepo}}
+	svc := NewMountService(policySvc, mountRepo, tx, noopCache{})
+	ctx := context.Background()
+
+	if err := svc.Remove(ctx, "p", 7); !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("挂载目录不为空时应拒绝删除，实际 %v", err)
+	}
+	if err := svc.Remove(ctx, "p", 8); err != nil {
+		t.Fatalf("删除空挂载点失败: %v", err)
+	}
+	if len(mountRepo.mounts) != 1 || mountRepo.mounts[0].ID != 7 {
+		t.Errorf("只应删除空挂载点: %+v", mountRepo.mounts)
+	}
+}
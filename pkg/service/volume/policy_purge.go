@@ -0,0 +1,385 @@
+/*
+ * @Description: 存储策略远程对象清理 - 删除策略时可选择保留远程数据（分离）或异步清除远程对象
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 19:00:00
+ * @LastEditTime: 2026-10-15 19:00:00
+ * @LastEditors: 安知鱼
+ */
+package volume
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// 策略删除模式
+const (
+	// DeleteModeDetach 仅软删除策略，保留远程对象和文件记录（默认）
+	DeleteModeDetach = "detach"
+	// DeleteModePurge 异步删除策略下的全部远程对象及实体记录，完成后再删除策略
+	DeleteModePurge = "purge"
+)
+
+// 清理任务状态
+const (
+	PurgeStatusRunning   = "running"
+	PurgeStatusCompleted = "completed"
+	// PurgeStatusPartial 部分远程对象删除失败，策略被保留，可重新发起清理
+	PurgeStatusPartial = "partial"
+	PurgeStatusFailed  = "failed"
+)
+
+// ErrPurgeTaskNotFound 查询不存在或已过期的清理任务时返回
+var ErrPurgeTaskNotFound = errors.New("清理任务不存在或已过期")
+
+const (
+	purgeSampleSize    = 20
+	purgeMaxErrors     = 20
+	purgeTaskRetention = 24 * time.Hour
+)
+
+// purgeRate 单个存储类型的删除速率：每批删除的对象数及批次间隔
+type purgeRate struct {
+	batchSize int
+	interval  time.Duration
+}
+
+// purgeRates 各存储类型的删除速率，未列出的类型使用 defaultPurgeRate。
+// OneDrive 的 Graph API 限流最严格，对象存储的批量删除接口相对宽松。
+var purgeRates = map[constant.StoragePolicyType]purgeRate{
+	constant.PolicyTypeLocal:    {batchSize: 200},
+	constant.PolicyTypeOneDrive: {batchSize: 20, interval: time.Second},
+}
+
+var defaultPurgeRate = purgeRate{batchSize: 100, interval: 500 * time.Millisecond}
+
+func purgeRateFor(t constant.StoragePolicyType) purgeRate {
+	if rate, ok := purgeRates[t]; ok {
+		return rate
+	}
+	return defaultPurgeRate
+}
+
+// PurgeReport 清理预览（dry-run）结果，不会修改任何数据
+type PurgeReport struct {
+	PolicyID         string                     `json:"policy_id"`
+	PolicyName       string                     `json:"policy_name"`
+	PolicyType       constant.StoragePolicyType `json:"policy_type"`
+	VirtualPath      string                     `json:"virtual_path"`
+	EntityCount      int64                      `json:"entity_count"`
+	TotalSize        int64                      `json:"total_size"`
+	SampleSources    []string                   `json:"sample_sources"`
+	BatchSize        int                        `json:"batch_size"`
+	EstimatedSeconds int64                      `json:"estimated_seconds"`
+}
+
+// PurgeProgress 清理任务的进度快照
+type PurgeProgress struct {
+	TaskID     string     `json:"task_id"`
+	PolicyID   string     `json:"policy_id"`
+	PolicyName string     `json:"policy_name"`
+	Status     string     `json:"status"`
+	Total      int64      `json:"total"`
+	Processed  int64      `json:"processed"`
+	Deleted    int64      `json:"deleted"`
+	Failed     int64      `json:"failed"`
+	BytesFreed int64      `json:"bytes_freed"`
+	Errors     []string   `json:"errors"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+func (p *PurgeProgress) clone() *PurgeProgress {
+	c := *p
+	c.Errors = append([]string(nil), p.Errors...)
+	return &c
+}
+
+// PolicyPurgeService 负责存储策略远程对象的预览与异步清理
+type PolicyPurgeService struct {
+	policySvc  IStoragePolicyService
+	entityRepo repository.EntityRepository
+	fileRepo   repository.FileRepository
+	providers  map[constant.StoragePolicyType]storage.IStorageProvider
+
+	mu     sync.Mutex
+	tasks  map[string]*PurgeProgress
+	active map[uint]string // 策略数据库 ID => 运行中的任务 ID
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// NewPolicyPurgeService 创建存储策略清理服务
+func NewPolicyPurgeService(
+	policySvc IStoragePolicyService,
+	entityRepo repository.EntityRepository,
+	fileRepo repository.FileRepository,
+	providers map[constant.StoragePolicyType]storage.IStorageProvider,
+) *PolicyPurgeService {
+	return &PolicyPurgeService{
+		policySvc:  policySvc,
+		entityRepo: entityRepo,
+		fileRepo:   fileRepo,
+		providers:  providers,
+		tasks:      make(map[string]*PurgeProgress),
+		active:     make(map[uint]string),
+		sleep:      sleepContext,
+	}
+}
+
+// Preview 统计清理将影响的实体数量、总大小和部分对象路径（dry-run）
+func (s *PolicyPurgeService) Preview(ctx context.Context, publicID string) (*PurgeReport, error) {
+	policy, err := s.loadPolicy(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, totalSize, err := s.entityRepo.CountEntityByStoragePolicyID(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+	sample, err := s.entityRepo.ListByStoragePolicyIDAfter(ctx, policy.ID, 0, purgeSampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	rate := purgeRateFor(policy.Type)
+	batches := (count + int64(rate.batchSize) - 1) / int64(rate.batchSize)
+	report := &PurgeReport{
+		PolicyID:         publicID,
+		PolicyName:       policy.Name,
+		PolicyType:       policy.Type,
+		VirtualPath:      policy.VirtualPath,
+		EntityCount:      count,
+		TotalSize:        totalSize,
+		SampleSources:    make([]string, 0, len(sample)),
+		BatchSize:        rate.batchSize,
+		EstimatedSeconds: int64(time.Duration(batches) * rate.interval / time.Second),
+	}
+	for _, e := range sample {
+		if e.Source.Valid {
+			report.SampleSources = append(report.SampleSources, e.Source.String)
+		}
+	}
+	return report, nil
+}
+
+// Start 启动异步清理任务；同一策略已有运行中的任务时直接返回该任务的进度
+func (s *PolicyPurgeService) Start(ctx context.Context, publicID string) (*PurgeProgress, error) {
+	policy, err := s.loadPolicy(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := s.providers[policy.Type]
+	if !ok {
+		return nil, fmt.Errorf("不支持的存储类型: %s", policy.Type)
+	}
+	total, _, err := s.entityRepo.CountEntityByStoragePolicyID(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reapLocked(time.Now())
+	if taskID, ok := s.active[policy.ID]; ok {
+		return s.tasks[taskID].clone(), nil
+	}
+
+	taskID, err := newPurgeTaskID()
+	if err != nil {
+		return nil, err
+	}
+	progress := &PurgeProgress{
+		TaskID:     taskID,
+		PolicyID:   publicID,
+		PolicyName: policy.Name,
+		Status:     PurgeStatusRunning,
+		Total:      total,
+		Errors:     []string{},
+		StartedAt:  time.Now(),
+	}
+	s.tasks[taskID] = progress
+	s.active[policy.ID] = taskID
+
+	go s.run(policy, publicID, provider, taskID)
+	return progress.clone(), nil
+}
+
+// Progress 查询清理任务进度
+func (s *PolicyPurgeService) Progress(taskID string) (*PurgeProgress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	progress, ok := s.tasks[taskID]
+	if !ok {
+		return nil, ErrPurgeTaskNotFound
+	}
+	return progress.clone(), nil
+}
+
+// run 按批次删除远程对象及实体记录。删除失败的对象保留实体记录并跳过，
+// 全部成功时才删除策略本身及其挂载目录，否则策略保留以便重新发起清理。
+func (s *PolicyPurgeService) run(policy *model.StoragePolicy, publicID string, provider storage.IStorageProvider, taskID string) {
+	ctx := context.Background()
+	rate := purgeRateFor(policy.Type)
+	log.Printf("[策略清理] 开始清理策略 ID=%d 名称='%s' 的远程对象，任务ID=%s", policy.ID, policy.Name, taskID)
+
+	var afterID uint
+	for {
+		batch, err := s.entityRepo.ListByStoragePolicyIDAfter(ctx, policy.ID, afterID, rate.batchSize)
+		if err != nil {
+			s.finish(policy.ID, taskID, PurgeStatusFailed, fmt.Sprintf("查询实体失败: %v", err))
+			return
+		}
+		if len(batch) == 0 {
+			break
+		}
+		afterID = batch[len(batch)-1].ID
+
+		deletedIDs, freed, errs := s.deleteBatch(ctx, policy, provider, batch)
+		if err := s.entityRepo.HardDeleteBatch(ctx, deletedIDs); err != nil {
+			s.finish(policy.ID, taskID, PurgeStatusFailed, fmt.Sprintf("删除实体记录失败: %v", err))
+			return
+		}
+		s.update(taskID, func(p *PurgeProgress) {
+			p.Processed += int64(len(batch))
+			p.Deleted += int64(len(deletedIDs))
+			p.Failed += int64(len(batch) - len(deletedIDs))
+			p.BytesFreed += freed
+			for _, e := range errs {
+				if len(p.Errors) < purgeMaxErrors {
+					p.Errors = append(p.Errors, e)
+				}
+			}
+		})
+
+		if len(batch) < rate.batchSize {
+			break
+		}
+		if err := s.sleep(ctx, rate.interval); err != nil {
+			s.finish(policy.ID, taskID, PurgeStatusFailed, err.Error())
+			return
+		}
+	}
+
+	if progress, _ := s.Progress(taskID); progress != nil && progress.Failed > 0 {
+		s.finish(policy.ID, taskID, PurgeStatusPartial, "")
+		return
+	}
+	if err := s.policySvc.DeletePolicy(ctx, publicID); err != nil {
+		s.finish(policy.ID, taskID, PurgeStatusFailed, fmt.Sprintf("远程对象已清理，但删除策略失败: %v", err))
+		return
+	}
+	if policy.NodeID != nil && s.fileRepo != nil {
+		if err := s.fileRepo.SoftDelete(ctx, *policy.NodeID); err != nil {
+			log.Printf("[策略清理] 删除策略挂载目录 ID=%d 失败: %v", *policy.NodeID, err)
+		}
+	}
+	s.finish(policy.ID, taskID, PurgeStatusCompleted, "")
+}
+
+// deleteBatch 删除一批实体对应的远程对象。批量删除失败时逐个重试，以定位具体失败的对象。
+func (s *PolicyPurgeService) deleteBatch(ctx context.Context, policy *model.StoragePolicy, provider storage.IStorageProvider, batch []*model.FileStorageEntity) (deletedIDs []uint, freed int64, errs []string) {
+	sources := make([]string, 0, len(batch))
+	for _, e := range batch {
+		if e.Source.Valid && e.Source.String != "" {
+			sources = append(sources, e.Source.String)
+		}
+	}
+
+	if len(sources) == 0 || provider.Delete(ctx, policy, sources) == nil {
+		for _, e := range batch {
+			deletedIDs = append(deletedIDs, e.ID)
+			freed += e.Size
+		}
+		return deletedIDs, freed, nil
+	}
+
+	for _, e := range batch {
+		if e.Source.Valid && e.Source.String != "" {
+			if err := provider.Delete(ctx, policy, []string{e.Source.String}); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", e.Source.String, err))
+				continue
+			}
+		}
+		deletedIDs = append(deletedIDs, e.ID)
+		freed += e.Size
+	}
+	return deletedIDs, freed, errs
+}
+
+func (s *PolicyPurgeService) update(taskID string, fn func(p *PurgeProgress)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p, ok := s.tasks[taskID]; ok {
+		fn(p)
+	}
+}
+
+func (s *PolicyPurgeService) finish(policyID uint, taskID, status, errMsg string) {
+	now := time.Now()
+	s.update(taskID, func(p *PurgeProgress) {
+		p.Status = status
+		p.FinishedAt = &now
+		if errMsg != "" && len(p.Errors) < purgeMaxErrors {
+			p.Errors = append(p.Errors, errMsg)
+		}
+	})
+	s.mu.Lock()
+	delete(s.active, policyID)
+	s.mu.Unlock()
+	log.Printf("[策略清理] 任务 %s 结束，状态: %s %s", taskID, status, errMsg)
+}
+
+// reapLocked 回收结束超过保留期的任务，调用方需持有 s.mu
+func (s *PolicyPurgeService) reapLocked(now time.Time) {
+	for id, p := range s.tasks {
+		if p.FinishedAt != nil && now.Sub(*p.FinishedAt) > purgeTaskRetention {
+			delete(s.tasks, id)
+		}
+	}
+}
+
+// loadPolicy 查找策略并检查是否允许删除
+func (s *PolicyPurgeService) loadPolicy(ctx context.Context, publicID string) (*model.StoragePolicy, error) {
+	policy, err := s.policySvc.GetPolicyByID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPolicyDeletable(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func newPurgeTaskID() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("生成清理任务ID失败: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package volume
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type fakePurgePolicySvc struct {
+	IStoragePolicyService
+	policy  *model.StoragePolicy
+	deleted bool
+}
+
+func (f *fakePurgePolicySvc) GetPolicyByID(ctx context.Context, id string) (*model.StoragePolicy, error) {
+	return f.policy, nil
+}
+
+func (f *fakePurgePolicySvc) DeletePolicy(ctx context.Context, id string) error {
+	f.deleted = true
+	return nil
+}
+
+type fakePurgeEntityRepo struct {
+	repository.EntityRepository
+	mu       sync.Mutex
+	entities map[uint]*model.FileStorageEntity
+}
+
+func (f *fakePurgeEntityRepo) CountEntityByStoragePolicyID(ctx context.Context, policyID uint) (int64, int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var size int64
+	for _, e := range f.entities {
+		size += e.Size
+	}
+	return int64(len(f.entities)), size, nil
+}
+
+func (f *fakePurgeEntityRepo) ListByStoragePolicyIDAfter(ctx context.Context, policyID, afterID uint, limit int) ([]*model.FileStorageEntity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*model.FileStorageEntity
+	for id, e := range f.entities {
+		if id > afterID {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (f *fakePurgeEntityRepo) HardDeleteBatch(ctx context.Context, ids []uint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, id := range ids {
+		delete(f.entities, id)
+	}
+	return nil
+}
+
+type fakePurgeProvider struct {
+	storage.IStorageProvider
+	failing string
+}
+
+func (f *fakePurgeProvider) Delete(ctx context.Context, policy *model.StoragePolicy, sources []string) error {
+	for _, src := range sources {
+		if src == f.failing {
+			return errors.New("限流")
+		}
+	}
+	return nil
+}
+
+func newTestPurgeService(failing string) (*PolicyPurgeService, *fakePurgePolicySvc, *fakePurgeEntityRepo) {
+	policySvc := &fakePurgePolicySvc{policy: &model.StoragePolicy{ID: 5, Name: "S3", Type: constant.PolicyTypeLocal, VirtualPath: "/s3"}}
+	entityRepo := &fakePurgeEntityRepo{entities: map[uint]*model.FileStorageEntity{}}
+	for i := uint(1); i <= 3; i++ {
+		entityRepo.entities[i] = &model.FileStorageEntity{ID: i, Size: 10, Source: sql.NullString{String: string(rune('a'+i-1)) + ".jpg", Valid: true}}
+	}
+	providers := map[constant.StoragePolicyType]storage.IStorageProvider{constant.PolicyTypeLocal: &fakePurgeProvider{failing: failing}}
+	return NewPolicyPurgeService(policySvc, entityRepo, nil, providers), policySvc, entityRepo
+}
+
+func waitPurge(t *testing.T, svc *PolicyPurgeService, taskID string) *PurgeProgress {
+	t.Helper()
+	for i := 0; i < 200; i++ {
+		progress, err := svc.Progress(taskID)
+		if err != nil {
+			t.Fatalf("查询进度失败: %v", err)
+		}
+		if progress.Status != PurgeStatusRunning {
+			return progress
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("清理任务未在预期时间内结束")
+	return nil
+}
+
+func TestPolicyPurge_DeletesObjectsThenPolicy(t *testing.T) {
+	svc, policySvc, entityRepo := newTestPurgeService("")
+
+	report, err := svc.Preview(context.Background(), "pub")
+	if err != nil || report.EntityCount != 3 || report.TotalSize != 30 || len(report.SampleSources) != 3 {
+		t.Fatalf("预览结果错误: %+v %v", report, err)
+	}
+	if len(entityRepo.entities) != 3 {
+		t.Fatal("预览不应修改数据")
+	}
+
+	started, err := svc.Start(context.Background(), "pub")
+	if err != nil {
+		t.Fatalf("启动清理失败: %v", err)
+	}
+	progress := waitPurge(t, svc, started.TaskID)
+	if progress.Status != PurgeStatusCompleted || progress.Deleted != 3 || progress.BytesFreed != 30 {
+		t.Errorf("清理结果错误: %+v", progress)
+	}
+	if !policySvc.deleted || len(entityRepo.entities) != 0 {
+		t.Error("清理完成后应删除策略和全部实体")
+	}
+}
+
+func TestPolicyPurge_KeepsPolicyWhenObjectsFail(t *testing.T) {
+	svc, policySvc, entityRepo := newTestPurgeService("b.jpg")
+
+	started, err := svc.Start(context.Background(), "pub")
+	if err != nil {
+		t.Fatalf("启动清理失败: %v", err)
+	}
+	progress := waitPurge(t, svc, started.TaskID)
+	if progress.Status != PurgeStatusPartial || progress.Deleted != 2 || progress.Failed != 1 || len(progress.Errors) != 1 {
+		t.Errorf("部分失败结果错误: %+v", progress)
+	}
+	if policySvc.deleted || len(entityRepo.entities) != 1 || entityRepo.entities[2] == nil {
+		t.Error("存在失败对象时应保留策略和失败的实体记录")
+	}
+}
+
+func TestPolicyPurge_RejectsProtectedPolicy(t *testing.T) {
+	svc, policySvc, _ := newTestPurgeService("")
+	policySvc.policy.VirtualPath = "/"
+	if _, err := svc.Start(context.Background(), "pub"); err == nil {
+		t.Error("根存储策略不应允许清理")
+	}
+}
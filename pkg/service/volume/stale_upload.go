@@ -0,0 +1,124 @@
+/*
+ * @Description: 远程未完成上传清理 - 按策略中止超时的分片上传或上传会话，并统计释放的空间
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 01:00:00
+ * @LastEditTime: 2026-10-17 01:00:00
+ * @LastEditors: 安知鱼
+ */
+package volume
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// minStaleUploadAge 允许的最短清理时长，避免中止仍在进行的大文件上传
+const minStaleUploadAge = time.Hour
+
+// StaleUploadPolicyReport 单个存储策略的清理结果
+type StaleUploadPolicyReport struct {
+	PolicyID       string                     `json:"policy_id"`
+	PolicyName     string                     `json:"policy_name"`
+	PolicyType     constant.StoragePolicyType `json:"policy_type"`
+	Aborted        int                        `json:"aborted"`
+	ReclaimedBytes int64                      `json:"reclaimed_bytes"`
+	Error          string                     `json:"error,omitempty"`
+}
+
+// StaleUploadReport 一次清理的汇总结果，只包含支持清理的存储策略
+type StaleUploadReport struct {
+	MaxAgeHours    int                       `json:"max_age_hours"`
+	Aborted        int                       `json:"aborted"`
+	ReclaimedBytes int64                     `json:"reclaimed_bytes"`
+	Policies       []StaleUploadPolicyReport `json:"policies"`
+}
+
+// StaleUploadService 清理各存储策略中被遗弃的未完成上传
+type StaleUploadService struct {
+	policySvc  IStoragePolicyService
+	settingSvc setting.SettingService
+	providers  map[constant.StoragePolicyType]storage.IStorageProvider
+}
+
+// NewStaleUploadService 创建远程未完成上传清理服务
+func NewStaleUploadService(
+	policySvc IStoragePolicyService,
+	settingSvc setting.SettingService,
+	providers map[constant.StoragePolicyType]storage.IStorageProvider,
+) *StaleUploadService {
+	return &StaleUploadService{
+		policySvc:  policySvc,
+		settingSvc: settingSvc,
+		providers:  providers,
+	}
+}
+
+// MaxAge 返回配置的清理时长，未配置或为 0 时返回 0
+func (s *StaleUploadService) MaxAge() time.Duration {
+	hours, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyUploadStaleMaxAgeHours.String())))
+	if err != nil || hours <= 0 {
+		return 0
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// Cleanup 中止所有策略中发起时间早于 maxAge 之前的未完成上传。
+// 单个策略失败不影响其他策略，错误记录在该策略的结果中。
+func (s *StaleUploadService) Cleanup(ctx context.Context, maxAge time.Duration) (*StaleUploadReport, error) {
+	if maxAge < minStaleUploadAge {
+		return nil, fmt.Errorf("%w: 清理时长不能少于 1 小时", constant.ErrBadRequest)
+	}
+	policies, err := s.policySvc.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取存储策略失败: %w", err)
+	}
+
+	before := time.Now().Add(-maxAge)
+	report := &StaleUploadReport{
+		MaxAgeHours: int(maxAge / time.Hour),
+		Policies:    []StaleUploadPolicyReport{},
+	}
+	for _, policy := range policies {
+		cleaner, ok := s.providers[policy.Type].(storage.IStaleUploadCleaner)
+		if !ok {
+			continue
+		}
+		publicID, _ := idgen.GeneratePublicID(policy.ID, idgen.EntityTypeStoragePolicy)
+		item := StaleUploadPolicyReport{
+			PolicyID:   publicID,
+			PolicyName: policy.Name,
+			PolicyType: policy.Type,
+		}
+		result, err := cleaner.AbortStaleUploads(ctx, policy, before)
+		if result != nil {
+			item.Aborted = result.Aborted
+			item.ReclaimedBytes = result.ReclaimedBytes
+		}
+		if err != nil {
+			item.Error = err.Error()
+			log.Printf("[未完成上传清理] 策略 ID=%d 名称='%s' 清理失败: %v", policy.ID, policy.Name, err)
+		}
+		report.Aborted += item.Aborted
+		report.ReclaimedBytes += item.ReclaimedBytes
+		report.Policies = append(report.Policies, item)
+	}
+	return report, nil
+}
+
+// AutoCleanup 供定时任务调用，按配置的时长清理；未开启自动清理时返回 nil
+func (s *StaleUploadService) AutoCleanup(ctx context.Context) (*StaleUploadReport, error) {
+	maxAge := s.MaxAge()
+	if maxAge == 0 {
+		return nil, nil
+	}
+	return s.Cleanup(ctx, maxAge)
+}
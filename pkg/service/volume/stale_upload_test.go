@@ -0,0 +1,89 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+type fakeStaleSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeStaleSettings) Get(key string) string { return f.values[key] }
+
+type fakeStalePolicySvc struct {
+	IStoragePolicyService
+	policies []*model.StoragePolicy
+}
+
+func (f *fakeStalePolicySvc) ListAll(ctx context.Context) ([]*model.StoragePolicy, error) {
+	return f.policies, nil
+}
+
+// fakeStaleCleaner 记录收到的截止时间，按策略返回预设结果
+type fakeStaleCleaner struct {
+	storage.IStorageProvider
+	before  time.Time
+	results map[uint]*storage.StaleUploadResult
+	errs    map[uint]error
+}
+
+func (f *fakeStaleCleaner) AbortStaleUploads(ctx context.Context, policy *model.StoragePolicy, before time.Time) (*storage.StaleUploadResult, error) {
+	f.before = before
+	return f.results[policy.ID], f.errs[policy.ID]
+}
+
+func TestStaleUploadCleanup(t *testing.T) {
+	cleaner := &fakeStaleCleaner{
+		results: map[uint]*storage.StaleUploadResult{
+			1: {Aborted: 2, ReclaimedBytes: 300},
+			2: {Aborted: 1, ReclaimedBytes: 50},
+		},
+		errs: map[uint]error{2: errors.New("列出失败")},
+	}
+	settings := &fakeStaleSettings{values: map[string]string{}}
+	svc := NewStaleUploadService(
+		&fakeStalePolicySvc{policies: []*model.StoragePolicy{
+			{ID: 1, Name: "S3", Type: constant.PolicyTypeS3},
+			{ID: 2, Name: "OneDrive", Type: constant.PolicyTypeOneDrive},
+			{ID: 3, Name: "本地", Type: constant.PolicyTypeLocal},
+		}},
+		settings,
+		map[constant.StoragePolicyType]storage.IStorageProvider{
+			constant.PolicyTypeS3:       cleaner,
+			constant.PolicyTypeOneDrive: cleaner,
+			constant.PolicyTypeLocal:    &fakePurgeProvider{},
+		},
+	)
+	ctx := context.Background()
+
+	if report, err := svc.AutoCleanup(ctx); err != nil || report != nil {
+		t.Fatalf("未配置清理时长时定时任务应跳过: %+v %v", report, err)
+	}
+	if _, err := svc.Cleanup(ctx, 10*time.Minute); !errors.Is(err, constant.ErrBadRequest) {
+		t.Fatalf("过短的清理时长应被拒绝: %v", err)
+	}
+
+	settings.values[constant.KeyUploadStaleMaxAgeHours.String()] = "48"
+	report, err := svc.AutoCleanup(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if age := time.Since(cleaner.before); age < 48*time.Hour || age > 49*time.Hour {
+		t.Errorf("截止时间应为 48 小时前: %v", age)
+	}
+	if report.MaxAgeHours != 48 || report.Aborted != 3 || report.ReclaimedBytes != 350 || len(report.Policies) != 2 {
+		t.Fatalf("清理结果错误: %+v", report)
+	}
+	if report.Policies[0].Error != "" || report.Policies[1].Error == "" || report.Policies[1].Aborted != 1 {
+		t.Errorf("单个策略失败应记录错误并保留已完成部分的统计: %+v", report.Policies)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume/strategy"
@@ -51,6 +52,10 @@ type IStoragePolicyService interface {
 	GetPolicyByDatabaseID(ctx context.Context, dbID uint) (*model.StoragePolicy, error)
 	GenerateAuthURL(ctx context.Context, publicPolicyID string) (string, error)
 	FinalizeAuth(ctx context.Context, code string, state string) error
+	// UpdateRootBasePath 修改挂载在根目录的本机存储策略的物理存储目录（仅供初始化向导使用）
+	UpdateRootBasePath(ctx context.Context, basePath string) (*model.StoragePolicy, error)
+	// SetAuditService 注入审计日志服务，记录后台对存储策略的修改
+	SetAuditService(svc *audit.Service)
 }
 
 type storagePolicyService struct {
@@ -61,6 +66,7 @@ type storagePolicyService struct {
 	settingSvc       setting.SettingService
 	cacheSvc         utility.CacheService
 	storageProviders map[constant.StoragePolicyType]storage.IStorageProvider
+	auditSvc         *audit.Service
 }
 
 func NewStoragePolicyService(
@@ -83,6 +89,35 @@ func NewStoragePolicyService(
 	}
 }
 
+// SetAuditService 注入审计日志服务
+func (s *storagePolicyService) SetAuditService(svc *audit.Service) {
+	s.auditSvc = svc
+}
+
+// policyAuditSnapshot 返回写入审计日志的策略副本，凭证（含 OneDrive 的 refresh token）只记录是否已设置
+func policyAuditSnapshot(policy *model.StoragePolicy) *model.StoragePolicy {
+	if policy == nil {
+		return nil
+	}
+	snapshot := *policy
+	for _, credential := range []*string{&snapshot.AccessKey, &snapshot.SecretKey} {
+		if *credential != "" {
+			*credential = "******"
+		}
+	}
+	return &snapshot
+}
+
+// auditPolicy 记录存储策略的变更，before 为 nil 表示新建，after 为 nil 表示删除
+func (s *storagePolicyService) auditPolicy(ctx context.Context, action string, before, after *model.StoragePolicy) {
+	target := after
+	if target == nil {
+		target = before
+	}
+	publicID, _ := idgen.GeneratePublicID(target.ID, idgen.EntityTypeStoragePolicy)
+	s.auditSvc.RecordChange(ctx, action, model.AuditEntityStoragePolicy, publicID, policyAuditSnapshot(before), policyAuditSnapshot(after))
+}
+
 // CreatePolicy 方法集成了正确的验证逻辑，并确保为每个策略创建根目录
 func (s *storagePolicyService) CreatePolicy(ctx context.Context, ownerID uint, policy *model.StoragePolicy) error {
 
@@ -216,6 +251,12 @@ func (s *storagePolicyService) CreatePolicy(ctx context.Context, ownerID uint, p
 			}
 		}
 
+		if mount, err := repos.StoragePolicyMount.FindByVirtualPath(ctx, policy.VirtualPath); err != nil {
+			return fmt.Errorf("检查虚拟路径冲突失败: %w", err)
+		} else if mount != nil {
+			return fmt.Errorf("虚拟路径 '%s' 已被挂载点占用", policy.VirtualPath)
+		}
+
 		// 2a: 创建存储策略记录
 		if err := policyRepo.Create(ctx, policy); err != nil {
 			return fmt.Errorf("创建存储策略记录失败: %w", err)
@@ -279,6 +320,7 @@ func (s *storagePolicyService) CreatePolicy(ctx context.Context, ownerID uint, p
 	// --- 清除策略列表缓存，确保新策略立即生效 ---
 	s.cacheSvc.Delete(ctx, "storage_policies_all")
 	log.Printf("[缓存清理] 策略创建后已清除策略列表缓存，新策略将立即生效")
+	s.auditPolicy(ctx, model.AuditActionPolicyCreate, nil, policy)
 
 	// --- 第四步：为云存储策略自动配置CORS ---
 	if policy.Type == constant.PolicyTypeTencentCOS || policy.Type == constant.PolicyTypeAliOSS {
@@ -496,6 +538,11 @@ func (s *storagePolicyService) UpdatePolicy(ctx context.Context, policy *model.S
 			if existingPolicy != nil && existingPolicy.ID != policy.ID {
 				return fmt.Errorf("无法更新策略：虚拟路径 '%s' 已被策略 '%s' 占用", policy.VirtualPath, existingPolicy.Name)
 			}
+			if mount, err := repos.StoragePolicyMount.FindByVirtualPath(ctx, policy.VirtualPath); err != nil {
+				return fmt.Errorf("检查虚拟路径冲突时出错: %w", err)
+			} else if mount != nil {
+				return fmt.Errorf("无法更新策略：虚拟路径 '%s' 已被挂载点占用", policy.VirtualPath)
+			}
 
 			// 处理挂载点目录的移动/重命名
 			if target.NodeID != nil && *target.NodeID > 0 {
@@ -564,7 +611,9 @@ func (s *storagePolicyService) UpdatePolicy(ctx context.Context, policy *model.S
 
 	// --- 5. 强制重新加载策略到缓存 ---
 	// 确保下次访问时能立即获取到最新的策略数据
+	auditAfter := policy
 	if updatedPolicy, err := s.repo.FindByID(ctx, policy.ID); err == nil && updatedPolicy != nil {
+		auditAfter = updatedPolicy
 		if policyBytes, jsonErr := json.Marshal(updatedPolicy); jsonErr == nil {
 			s.cacheSvc.Set(ctx, policyCacheKey(policy.ID), policyBytes, policyCacheTTL)
 			if publicID != "" {
@@ -577,6 +626,7 @@ func (s *storagePolicyService) UpdatePolicy(ctx context.Context, policy *model.S
 	// --- 清除策略列表缓存，确保策略更新立即生效 ---
 	s.cacheSvc.Delete(ctx, "storage_policies_all")
 	log.Printf("[缓存清理] 策略更新后已清除策略列表缓存，更新将立即生效")
+	s.auditPolicy(ctx, model.AuditActionPolicyUpdate, target, auditAfter)
 
 	return nil
 }
@@ -592,19 +642,9 @@ func (s *storagePolicyService) DeletePolicy(ctx context.Context, publicID string
 		return err
 	}
 
-	// 2. 【保护逻辑】禁止删除默认策略
-	if policy.ID == 1 {
-		return errors.New("禁止删除默认策略")
-	}
-
-	// 保护逻辑：禁止删除内置的系统策略
-	if policy.Flag != "" {
-		return errors.New("无法删除内置的系统策略")
-	}
-
-	// 检查是否为默认的根存储策略
-	if policy.VirtualPath == "/" {
-		return errors.New("无法删除默认的根存储策略")
+	// 2. 【保护逻辑】禁止删除默认策略、内置系统策略和根存储策略
+	if err := checkPolicyDeletable(policy); err != nil {
+		return err
 	}
 
 	// 3. 在事务中执行删除操作
@@ -620,7 +660,12 @@ func (s *storagePolicyService) DeletePolicy(ctx context.Context, publicID string
 			return fmt.Errorf("执行策略删除前置任务失败: %w", err)
 		}
 
-		// 3b. 软删除策略记录（使用ent的软删除功能，只设置deleted_at字段）
+		// 3b. 删除策略的别名挂载点，释放其占用的虚拟路径
+		if _, err := repos.StoragePolicyMount.DeleteByPolicyID(ctx, policy.ID); err != nil {
+			return fmt.Errorf("删除策略挂载点失败: %w", err)
+		}
+
+		// 3c. 软删除策略记录（使用ent的软删除功能，只设置deleted_at字段）
 		log.Printf("[软删除] 删除策略记录: ID=%d, 名称=%s", policy.ID, policy.Name)
 		if err := policyRepo.Delete(ctx, policy.ID); err != nil {
 			return fmt.Errorf("删除策略记录失败: %w", err)
@@ -641,12 +686,27 @@ func (s *storagePolicyService) DeletePolicy(ctx context.Context, publicID string
 		s.cleanupOneDriveCredentials(ctx, policy)
 	}
 
-	// 6. 清除策略列表缓存，确保策略删除立即生效
-	s.cacheSvc.Delete(ctx, "storage_policies_all")
+	// 6. 清除策略列表及挂载点缓存，确保策略删除立即生效
+	s.cacheSvc.Delete(ctx, "storage_policies_all", mountsCacheKey)
 	log.Printf("[缓存清理] 策略删除后已清除策略列表缓存，删除将立即生效")
 
 	log.Printf("[删除完成] 存储策略 ID=%d 名称='%s' 已软删除成功，文件和实体记录已保留",
 		policy.ID, policy.Name)
+	s.auditPolicy(ctx, model.AuditActionPolicyDelete, policy, nil)
+	return nil
+}
+
+// checkPolicyDeletable 检查策略是否允许删除：默认策略、内置系统策略和根存储策略均不可删除
+func checkPolicyDeletable(policy *model.StoragePolicy) error {
+	if policy.ID == 1 {
+		return errors.New("禁止删除默认策略")
+	}
+	if policy.Flag != "" {
+		return errors.New("无法删除内置的系统策略")
+	}
+	if policy.VirtualPath == "/" {
+		return errors.New("无法删除默认的根存储策略")
+	}
 	return nil
 }
 
@@ -740,3 +800,27 @@ func (s *storagePolicyService) GenerateAuthURL(ctx context.Context, publicPolicy
 	}
 	return authHandler.GenerateAuthURL(ctx, policy, siteURL)
 }
+
+// UpdateRootBasePath 修改挂载在根目录 "/" 的本机存储策略的物理存储目录。
+// 根目录策略不能通过 UpdatePolicy 修改，因此单独提供此方法，调用方负责确认目录可写。
+func (s *storagePolicyService) UpdateRootBasePath(ctx context.Context, basePath string) (*model.StoragePolicy, error) {
+	policy, err := s.repo.FindByVirtualPath(ctx, "/")
+	if err != nil {
+		return nil, fmt.Errorf("查找根目录存储策略失败: %w", err)
+	}
+	if policy == nil {
+		return nil, constant.ErrPolicyNotFound
+	}
+	if policy.Type != constant.PolicyTypeLocal {
+		return nil, fmt.Errorf("根目录存储策略不是本机存储，无法修改存储目录")
+	}
+
+	policy.BasePath = basePath
+	if err := s.repo.Update(ctx, policy); err != nil {
+		return nil, fmt.Errorf("更新根目录存储策略失败: %w", err)
+	}
+
+	publicID, _ := idgen.GeneratePublicID(policy.ID, idgen.EntityTypeStoragePolicy)
+	s.cacheSvc.Delete(ctx, policyCacheKey(policy.ID), policyPublicCacheKey(publicID), "storage_policies_all")
+	return policy, nil
+}
@@ -37,6 +37,7 @@ type IVFSService interface {
 // vfsService 是 IVFSService 的实现
 type vfsService struct {
 	policySvc        IStoragePolicyService
+	mountRepo        repository.StoragePolicyMountRepository
 	cacheSvc         utility.CacheService
 	fileRepo         repository.FileRepository
 	entityRepo       repository.EntityRepository
@@ -47,6 +48,7 @@ type vfsService struct {
 // NewVFSService 是 vfsService 的构造函数
 func NewVFSService(
 	policySvc IStoragePolicyService,
+	mountRepo repository.StoragePolicyMountRepository,
 	cacheSvc utility.CacheService,
 	fileRepo repository.FileRepository,
 	entityRepo repository.EntityRepository,
@@ -55,6 +57,7 @@ func NewVFSService(
 ) IVFSService {
 	return &vfsService{
 		policySvc:        policySvc,
+		mountRepo:        mountRepo,
 		cacheSvc:         cacheSvc,
 		fileRepo:         fileRepo,
 		entityRepo:       entityRepo,
@@ -97,7 +100,10 @@ func (s *vfsService) FindPolicyForPath(ctx context.Context, virtualPath string)
 		return nil, errors.New("系统中未配置任何存储策略")
 	}
 
-	// 3. 执行最长前缀匹配
+	// 3. 将别名挂载点展开为以挂载路径为 VirtualPath 的策略副本，与策略主路径一同参与匹配
+	allPolicies = append(allPolicies, s.mountedPolicies(ctx, allPolicies)...)
+
+	// 4. 执行最长前缀匹配
 	var bestMatch *model.StoragePolicy
 	longestPrefix := -1
 	normalizedVirtualPath := "/" + strings.Trim(virtualPath, "/")
@@ -128,6 +134,53 @@ func (s *vfsService) FindPolicyForPath(ctx context.Context, virtualPath string)
 	return bestMatch, nil
 }
 
+// mountedPolicies 加载全部别名挂载点（带缓存），为每个挂载点生成一个策略副本。
+// 挂载点所属策略已不存在时忽略该挂载点；加载失败只记录日志，不影响主路径匹配。
+func (s *vfsService) mountedPolicies(ctx context.Context, policies []*model.StoragePolicy) []*model.StoragePolicy {
+	if s.mountRepo == nil {
+		return nil
+	}
+
+	var mounts []*model.StoragePolicyMount
+	if cached, err := s.cacheSvc.Get(ctx, mountsCacheKey); err == nil && cached != "" {
+		if err := json.Unmarshal([]byte(cached), &mounts); err != nil {
+			log.Printf("警告: 从缓存反序列化挂载点失败: %v", err)
+			mounts = nil
+		}
+	}
+	if mounts == nil {
+		dbMounts, err := s.mountRepo.ListAll(ctx)
+		if err != nil {
+			log.Printf("警告: 获取存储策略挂载点失败: %v", err)
+			return nil
+		}
+		mounts = dbMounts
+		if mountsJSON, err := json.Marshal(mounts); err == nil {
+			s.cacheSvc.Set(ctx, mountsCacheKey, string(mountsJSON), 5*time.Minute)
+		}
+	}
+
+	byID := make(map[uint]*model.StoragePolicy, len(policies))
+	for _, policy := range policies {
+		byID[policy.ID] = policy
+	}
+
+	result := make([]*model.StoragePolicy, 0, len(mounts))
+	for _, mount := range mounts {
+		policy, ok := byID[mount.PolicyID]
+		if !ok {
+			continue
+		}
+		aliased := *policy
+		aliased.VirtualPath = mount.VirtualPath
+		aliased.NodeID = mount.NodeID
+		aliased.MountID = mount.ID
+		aliased.ReadOnly = mount.ReadOnly
+		result = append(result, &aliased)
+	}
+	return result
+}
+
 // GetFileReader 为给定的文件模型提供一个可读的流。
 // 它会处理存储策略的解析，并从正确的物理位置获取文件。
 func (s *vfsService) GetFileReader(ctx context.Context, file *model.File) (io.ReadCloser, error) {
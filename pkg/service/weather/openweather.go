@@ -0,0 +1,82 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ProviderOpenWeather OpenWeather
+const ProviderOpenWeather = "openweather"
+
+// defaultOpenWeatherBaseURL OpenWeather 当前天气接口地址
+const defaultOpenWeatherBaseURL = "https://api.openweathermap.org"
+
+// OpenWeather OpenWeather 当前天气接口
+type OpenWeather struct {
+	client *http.Client
+	key    string
+}
+
+// Name 返回服务商标识
+func (o *OpenWeather) Name() string { return ProviderOpenWeather }
+
+// Now 调用 /data/2.5/weather 查询当前天气
+func (o *OpenWeather) Now(ctx context.Context, lat, lon float64) (*Weather, error) {
+	query := url.Values{}
+	query.Set("lat", fmt.Sprintf("%.2f", lat))
+	query.Set("lon", fmt.Sprintf("%.2f", lon))
+	query.Set("appid", o.key)
+	query.Set("units", "metric")
+	query.Set("lang", "zh_cn")
+
+	var resp struct {
+		Name    string `json:"name"`
+		Dt      int64  `json:"dt"`
+		Weather []struct {
+			Description string `json:"description"`
+			Icon        string `json:"icon"`
+		} `json:"weather"`
+		Main struct {
+			Temp      float64 `json:"temp"`
+			FeelsLike float64 `json:"feels_like"`
+			Humidity  int     `json:"humidity"`
+		} `json:"main"`
+		Wind struct {
+			Speed float64 `json:"speed"`
+			Deg   float64 `json:"deg"`
+		} `json:"wind"`
+	}
+	if err := getJSON(ctx, o.client, defaultOpenWeatherBaseURL+"/data/2.5/weather?"+query.Encode(), o.key, &resp); err != nil {
+		return nil, err
+	}
+
+	w := &Weather{
+		Location:  resp.Name,
+		Temp:      resp.Main.Temp,
+		FeelsLike: resp.Main.FeelsLike,
+		Humidity:  resp.Main.Humidity,
+		WindDir:   windDirection(resp.Wind.Deg),
+		WindSpeed: resp.Wind.Speed * 3.6, // m/s 转为 km/h，与和风天气一致
+	}
+	if len(resp.Weather) > 0 {
+		w.Text = resp.Weather[0].Description
+		w.Icon = resp.Weather[0].Icon
+	}
+	if resp.Dt > 0 {
+		w.ObservedAt = time.Unix(resp.Dt, 0)
+	}
+	return w, nil
+}
+
+// windDirection 将风向角度转换为八方位中文描述
+func windDirection(deg float64) string {
+	dirs := []string{"北风", "东北风", "东风", "东南风", "南风", "西南风", "西风", "西北风"}
+	idx := int((deg+22.5)/45) % 8
+	if idx < 0 {
+		idx += 8
+	}
+	return dirs[idx]
+}
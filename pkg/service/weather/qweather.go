@@ -0,0 +1,78 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProviderQWeather 和风天气
+const ProviderQWeather = "qweather"
+
+// defaultQWeatherHost 和风天气默认 API Host，新账号请在控制台查看专属 Host
+const defaultQWeatherHost = "devapi.qweather.com"
+
+// QWeather 和风天气实时天气接口
+type QWeather struct {
+	client *http.Client
+	host   string
+	key    string
+}
+
+// Name 返回服务商标识
+func (q *QWeather) Name() string { return ProviderQWeather }
+
+// Now 调用 /v7/weather/now 查询实时天气
+func (q *QWeather) Now(ctx context.Context, lat, lon float64) (*Weather, error) {
+	host := strings.TrimSpace(q.host)
+	if host == "" {
+		host = defaultQWeatherHost
+	}
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	query := url.Values{}
+	query.Set("location", fmt.Sprintf("%.2f,%.2f", lon, lat))
+	query.Set("key", q.key)
+	query.Set("lang", "zh")
+
+	var resp struct {
+		Code string `json:"code"`
+		Now  struct {
+			ObsTime   string `json:"obsTime"`
+			Temp      string `json:"temp"`
+			FeelsLike string `json:"feelsLike"`
+			Icon      string `json:"icon"`
+			Text      string `json:"text"`
+			WindDir   string `json:"windDir"`
+			WindSpeed string `json:"windSpeed"`
+			Humidity  string `json:"humidity"`
+		} `json:"now"`
+	}
+	if err := getJSON(ctx, q.client, strings.TrimRight(host, "/")+"/v7/weather/now?"+query.Encode(), q.key, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Code != "200" {
+		return nil, fmt.Errorf("和风天气返回错误码 %s", resp.Code)
+	}
+
+	w := &Weather{
+		Text:    resp.Now.Text,
+		Icon:    resp.Now.Icon,
+		WindDir: resp.Now.WindDir,
+	}
+	w.Temp, _ = strconv.ParseFloat(resp.Now.Temp, 64)
+	w.FeelsLike, _ = strconv.ParseFloat(resp.Now.FeelsLike, 64)
+	w.WindSpeed, _ = strconv.ParseFloat(resp.Now.WindSpeed, 64)
+	w.Humidity, _ = strconv.Atoi(resp.Now.Humidity)
+	// obsTime 形如 2026-10-16T12:00+08:00
+	if t, err := time.Parse("2006-01-02T15:04Z07:00", resp.Now.ObsTime); err == nil {
+		w.ObservedAt = t
+	}
+	return w, nil
+}
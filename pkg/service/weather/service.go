@@ -0,0 +1,195 @@
+/*
+ * @Description: 天气代理服务，API Key 只保存在服务端，按位置缓存天气数据，支持和风天气与 OpenWeather
+ * @Author: 安知鱼
+ * @Date: 2026-10-16 12:00:00
+ * @LastEditTime: 2026-10-16 12:00:00
+ * @LastEditors: 安知鱼
+ */
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	weatherCacheKeyPrefix = "anheyu:weather:"
+	// defaultCacheMinutes 未配置缓存时长时的默认值
+	defaultCacheMinutes = 30
+	// maxResponseSize 第三方响应体的大小上限
+	maxResponseSize = 1 << 20
+)
+
+// ErrNotConfigured 未选择天气服务商或未配置 API Key
+var ErrNotConfigured = errors.New("天气服务未配置")
+
+// Weather 是统一后的实时天气，不同服务商的字段在此对齐
+type Weather struct {
+	Provider   string    `json:"provider"`
+	Location   string    `json:"location,omitempty"` // 位置名称，部分服务商不返回
+	Temp       float64   `json:"temp"`               // 温度（摄氏度）
+	FeelsLike  float64   `json:"feels_like"`         // 体感温度（摄氏度）
+	Text       string    `json:"text"`               // 天气状况描述
+	Icon       string    `json:"icon"`               // 服务商的天气图标代码
+	Humidity   int       `json:"humidity"`           // 相对湿度（%）
+	WindDir    string    `json:"wind_dir,omitempty"`
+	WindSpeed  float64   `json:"wind_speed"` // 风速（km/h）
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+// Response 是天气接口的返回数据，附带服务器时间供时钟组件校准
+type Response struct {
+	*Weather
+	ServerTime time.Time `json:"server_time"`
+	Cached     bool      `json:"cached"`
+}
+
+// Provider 天气服务商
+type Provider interface {
+	// Name 返回服务商标识，与 weather.provider 配置值一致
+	Name() string
+	// Now 查询指定经纬度的实时天气
+	Now(ctx context.Context, lat, lon float64) (*Weather, error)
+}
+
+// Service 天气代理服务
+type Service struct {
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	client     *http.Client
+	group      singleflight.Group
+}
+
+// NewService 创建天气代理服务，client 应带有超时与出站防护
+func NewService(settingSvc setting.SettingService, cacheSvc utility.CacheService, client *http.Client) *Service {
+	return &Service{
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		client:     client,
+	}
+}
+
+// configuredProvider 根据设置创建服务商实例，每次请求读取最新配置
+func (s *Service) configuredProvider(name string) (Provider, error) {
+	switch name {
+	case ProviderQWeather:
+		key := strings.TrimSpace(s.settingSvc.Get(constant.KeyWeatherQWeatherKey.String()))
+		if key == "" {
+			return nil, ErrNotConfigured
+		}
+		return &QWeather{
+			client: s.client,
+			host:   s.settingSvc.Get(constant.KeyWeatherQWeatherHost.String()),
+			key:    key,
+		}, nil
+	case ProviderOpenWeather:
+		key := strings.TrimSpace(s.settingSvc.Get(constant.KeyWeatherOpenWeatherKey.String()))
+		if key == "" {
+			return nil, ErrNotConfigured
+		}
+		return &OpenWeather{client: s.client, key: key}, nil
+	default:
+		return nil, ErrNotConfigured
+	}
+}
+
+// Now 查询实时天气。location 为「经度,纬度」（与和风天气一致），
+// 坐标保留两位小数（约 1 公里）作为缓存键，相近位置共享缓存。
+func (s *Service) Now(ctx context.Context, location string) (*Response, error) {
+	lat, lon, err := ParseLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	providerName := strings.TrimSpace(s.settingSvc.Get(constant.KeyWeatherProvider.String()))
+	provider, err := s.configuredProvider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("%s%s:%.2f,%.2f", weatherCacheKeyPrefix, provider.Name(), lon, lat)
+	if cached, err := s.cacheSvc.Get(ctx, cacheKey); err == nil && cached != "" {
+		var w Weather
+		if json.Unmarshal([]byte(cached), &w) == nil {
+			return &Response{Weather: &w, ServerTime: time.Now(), Cached: true}, nil
+		}
+	}
+
+	v, err, _ := s.group.Do(cacheKey, func() (interface{}, error) {
+		w, err := provider.Now(ctx, lat, lon)
+		if err != nil {
+			return nil, err
+		}
+		w.Provider = provider.Name()
+		if encoded, err := json.Marshal(w); err == nil {
+			if err := s.cacheSvc.Set(ctx, cacheKey, string(encoded), s.cacheTTL()); err != nil {
+				log.Printf("[Weather] 缓存天气数据失败: %v", err)
+			}
+		}
+		return w, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询天气失败: %w", err)
+	}
+	return &Response{Weather: v.(*Weather), ServerTime: time.Now()}, nil
+}
+
+// cacheTTL 读取配置的缓存时长
+func (s *Service) cacheTTL() time.Duration {
+	minutes, err := strconv.Atoi(strings.TrimSpace(s.settingSvc.Get(constant.KeyWeatherCacheMinutes.String())))
+	if err != nil || minutes <= 0 {
+		minutes = defaultCacheMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// ParseLocation 解析「经度,纬度」格式的位置
+func ParseLocation(location string) (lat, lon float64, err error) {
+	parts := strings.Split(strings.TrimSpace(location), ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%w: 位置格式应为「经度,纬度」", constant.ErrBadRequest)
+	}
+	lon, errLon := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lat, errLat := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if errLon != nil || errLat != nil || lon < -180 || lon > 180 || lat < -90 || lat > 90 {
+		return 0, 0, fmt.Errorf("%w: 无效的经纬度", constant.ErrBadRequest)
+	}
+	return lat, lon, nil
+}
+
+// getJSON 以 GET 请求第三方接口并解析 JSON 响应，错误信息中的 API Key 会被隐藏
+func getJSON(ctx context.Context, client *http.Client, rawURL, apiKey string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.New(strings.ReplaceAll(err.Error(), apiKey, "***"))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("天气服务返回状态码 %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("解析天气服务响应失败: %w", err)
+	}
+	return nil
+}
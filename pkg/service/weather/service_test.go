@@ -0,0 +1,78 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// fakeSettings 只实现测试用到的 Get
+type fakeSettings struct {
+	setting.SettingService
+	values map[string]string
+}
+
+func (f *fakeSettings) Get(key string) string { return f.values[key] }
+
+func TestParseLocation(t *testing.T) {
+	lat, lon, err := ParseLocation(" 116.41 , 39.92 ")
+	if err != nil || lat != 39.92 || lon != 116.41 {
+		t.Fatalf("解析结果错误: lat=%v lon=%v err=%v", lat, lon, err)
+	}
+	for _, bad := range []string{"", "116.41", "abc,39.92", "200,39.92", "116.41,-91"} {
+		if _, _, err := ParseLocation(bad); !errors.Is(err, constant.ErrBadRequest) {
+			t.Errorf("ParseLocation(%q) 应返回 ErrBadRequest，实际 %v", bad, err)
+		}
+	}
+}
+
+func TestNowQWeatherCachesByLocation(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.URL.Path != "/v7/weather/now" || r.URL.Query().Get("key") != "secret" {
+			t.Errorf("非预期的请求: %s", r.URL.String())
+		}
+		w.Write([]byte(`{"code":"200","now":{"obsTime":"2026-10-16T12:00+08:00","temp":"24","feelsLike":"26","icon":"101","text":"多云","windDir":"东南风","windSpeed":"12","humidity":"72"}}`))
+	}))
+	defer server.Close()
+
+	svc := NewService(&fakeSettings{values: map[string]string{
+		constant.KeyWeatherProvider.String():     ProviderQWeather,
+		constant.KeyWeatherQWeatherKey.String():  "secret",
+		constant.KeyWeatherQWeatherHost.String(): server.URL,
+	}}, utility.NewMemoryCacheService(), server.Client())
+
+	first, err := svc.Now(context.Background(), "116.411,39.921")
+	if err != nil {
+		t.Fatalf("查询天气失败: %v", err)
+	}
+	if first.Provider != ProviderQWeather || first.Temp != 24 || first.Humidity != 72 || first.Text != "多云" || first.Cached {
+		t.Errorf("天气数据错误: %+v", first.Weather)
+	}
+
+	// 约 1 公里内的位置命中同一缓存
+	second, err := svc.Now(context.Background(), "116.409,39.918")
+	if err != nil {
+		t.Fatalf("查询天气失败: %v", err)
+	}
+	if !second.Cached || atomic.LoadInt32(&hits) != 1 {
+		t.Errorf("期望命中缓存，上游请求次数 %d", hits)
+	}
+}
+
+func TestNowNotConfigured(t *testing.T) {
+	svc := NewService(&fakeSettings{values: map[string]string{
+		constant.KeyWeatherProvider.String(): ProviderOpenWeather,
+	}}, utility.NewMemoryCacheService(), http.DefaultClient)
+	if _, err := svc.Now(context.Background(), "116.41,39.92"); !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("未配置 Key 时应返回 ErrNotConfigured，实际 %v", err)
+	}
+}
@@ -0,0 +1,606 @@
+/*
+ * @Description: WebDAV 文件系统 - 将用户的虚拟文件系统适配为 golang.org/x/net/webdav 的 FileSystem
+ * @Author: 安知鱼
+ * @Date: 2026-10-17 06:00:00
+ * @LastEditTime: 2026-10-18 08:00:00
+ * @LastEditors: 安知鱼
+ */
+package webdav
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	xwebdav "golang.org/x/net/webdav"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+)
+
+// tempDir 上传与下载时暂存文件内容的目录。WebDAV 的 GET 需要可随机读取的内容以支持 Range 请求，
+// PUT 则需要在写入完成后一次性提交给文件服务
+const tempDir = "./data/temp/webdav"
+
+// defaultMaxWriteSize 存储策略与用户组均未限制文件大小时，单次写入暂存的内容上限
+const defaultMaxWriteSize int64 = 4 << 30
+
+// ErrCopyUnsupported 服务端复制只支持目标与源同名、复制到其他目录的情况，其余情况由调用方逐个文件复制
+var ErrCopyUnsupported = errors.New("不支持在服务端直接复制")
+
+// errFileTooLarge 写入的内容超过允许的文件大小
+var errFileTooLarge = errors.New("文件大小超出限制")
+
+// UploadChecker 按与网页上传相同的规则校验写入：后缀名单、用户组限制、每日上传次数与存储策略大小限制
+type UploadChecker interface {
+	DirectUploadLimit(ctx context.Context, ownerID uint, virtualPath string) (int64, error)
+	CheckDirectUpload(ctx context.Context, ownerID uint, virtualPath string, size int64) (func(), error)
+}
+
+// Service 创建按用户隔离的 WebDAV 文件系统
+type Service struct {
+	fileSvc   file_service.FileService
+	uploadSvc UploadChecker
+	fileRepo  repository.FileRepository
+}
+
+// NewService 是 Service 的构造函数
+func NewService(fileSvc file_service.FileService, uploadSvc UploadChecker, fileRepo repository.FileRepository) *Service {
+	if err := os.MkdirAll(tempDir, os.ModePerm); err != nil {
+		log.Printf("警告: 无法创建 WebDAV 临时目录 %s: %v", tempDir, err)
+	}
+	return &Service{fileSvc: fileSvc, uploadSvc: uploadSvc, fileRepo: fileRepo}
+}
+
+// ForUser 返回以指定用户根目录为根的文件系统
+func (s *Service) ForUser(ownerID uint) *FileSystem {
+	return &FileSystem{fileSvc: s.fileSvc, uploadSvc: s.uploadSvc, fileRepo: s.fileRepo, ownerID: ownerID}
+}
+
+// FileSystem 实现 webdav.FileSystem，所有操作都通过文件服务完成，与网页端的文件管理行为一致
+type FileSystem struct {
+	fileSvc   file_service.FileService
+	uploadSvc UploadChecker
+	fileRepo  repository.FileRepository
+	ownerID   uint
+}
+
+var _ xwebdav.FileSystem = (*FileSystem)(nil)
+
+// cleanPath 将 WebDAV 传入的名称规范化为以 / 开头的虚拟路径
+func cleanPath(name string) string {
+	return path.Clean("/" + name)
+}
+
+// fileURI 构造文件服务使用的 anzhiyu://my 路径，路径中的特殊字符会被转义
+func fileURI(p string) string {
+	return (&url.URL{Scheme: "anzhiyu", Host: "my", Path: p}).String()
+}
+
+// toFSError 将业务错误转换为 webdav 包识别的文件系统错误
+func toFSError(op, name string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, constant.ErrNotFound):
+		return &os.PathError{Op: op, Path: name, Err: os.ErrNotExist}
+	case errors.Is(err, constant.ErrConflict):
+		return &os.PathError{Op: op, Path: name, Err: os.ErrExist}
+	case errors.Is(err, constant.ErrForbidden), errors.Is(err, constant.ErrInvalidOperation),
+		errors.Is(err, constant.ErrUploadExtensionNotAllowed), errors.Is(err, constant.ErrUploadFileTooLarge),
+		errors.Is(err, constant.ErrUploadDailyLimitExceeded):
+		return &os.PathError{Op: op, Path: name, Err: os.ErrPermission}
+	}
+	return err
+}
+
+func filePublicID(f *model.File) (string, error) {
+	return idgen.GeneratePublicID(f.ID, idgen.EntityTypeFile)
+}
+
+// find 按虚拟路径查找当前用户的文件或目录
+func (fs *FileSystem) find(ctx context.Context, op, name string) (*model.File, error) {
+	f, err := fs.fileRepo.FindByPath(ctx, fs.ownerID, name)
+	if err != nil {
+		return nil, toFSError(op, name, err)
+	}
+	return f, nil
+}
+
+// findDir 查找目录，目标不是目录时按不存在处理
+func (fs *FileSystem) findDir(ctx context.Context, op, name string) (*model.File, error) {
+	dir, err := fs.find(ctx, op, name)
+	if err != nil {
+		return nil, err
+	}
+	if dir.Type != model.FileTypeDir {
+		return nil, &os.PathError{Op: op, Path: name, Err: os.ErrNotExist}
+	}
+	return dir, nil
+}
+
+// Mkdir 创建目录，父目录不存在时返回 os.ErrNotExist，同名项目已存在时返回 os.ErrExist
+func (fs *FileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	name = cleanPath(name)
+	if name == "/" {
+		return &os.PathError{Op: "mkdir", Path: name, Err: os.ErrExist}
+	}
+	if _, err := fs.findDir(ctx, "mkdir", path.Dir(name)); err != nil {
+		return err
+	}
+	_, err := fs.fileSvc.CreateEmptyFile(ctx, fs.ownerID, &model.CreateFileRequest{
+		URI:  fileURI(name),
+		Type: int(model.FileTypeDir),
+	})
+	return toFSError("mkdir", name, err)
+}
+
+// OpenFile 打开文件或目录。以写方式打开时返回的文件在 Close 时才提交内容
+func (fs *FileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (xwebdav.File, error) {
+	name = cleanPath(name)
+	existing, err := fs.find(ctx, "open", name)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		if existing == nil {
+			return nil, err
+		}
+		if existing.Type == model.FileTypeDir {
+			return &dirFile{ctx: ctx, fs: fs, dir: existing}, nil
+		}
+		return &readFile{ctx: ctx, fs: fs, file: existing}, nil
+	}
+
+	if existing == nil {
+		if flag&os.O_CREATE == 0 {
+			return nil, err
+		}
+		if _, err := fs.findDir(ctx, "open", path.Dir(name)); err != nil {
+			return nil, err
+		}
+	} else {
+		if flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL {
+			return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrExist}
+		}
+		if existing.Type == model.FileTypeDir {
+			return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrInvalid}
+		}
+	}
+	limit, err := fs.uploadSvc.DirectUploadLimit(ctx, fs.ownerID, name)
+	if err != nil {
+		return nil, toFSError("open", name, err)
+	}
+	if limit <= 0 {
+		limit = defaultMaxWriteSize
+	}
+	tmp, err := os.CreateTemp(tempDir, "upload-*")
+	if err != nil {
+		return nil, err
+	}
+	return &writeFile{ctx: ctx, fs: fs, name: name, tmp: tmp, limit: limit}, nil
+}
+
+// RemoveAll 删除文件或目录（含子项），目标不存在时视为成功
+func (fs *FileSystem) RemoveAll(ctx context.Context, name string) error {
+	name = cleanPath(name)
+	if name == "/" {
+		return &os.PathError{Op: "remove", Path: name, Err: os.ErrPermission}
+	}
+	target, err := fs.find(ctx, "remove", name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	publicID, err := filePublicID(target)
+	if err != nil {
+		return err
+	}
+	return toFSError("remove", name, fs.fileSvc.DeleteItems(ctx, fs.ownerID, []string{publicID}))
+}
+
+// Rename 移动或重命名文件与目录。目标位于其他目录且名称也不同时，先移动再重命名；
+// 若目标目录中已有与源同名的项目，则先在原目录重命名再移动，尽量避免中间步骤的同名冲突
+func (fs *FileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	oldName, newName = cleanPath(oldName), cleanPath(newName)
+	if oldName == "/" || newName == "/" {
+		return &os.PathError{Op: "rename", Path: oldName, Err: os.ErrPermission}
+	}
+	src, err := fs.find(ctx, "rename", oldName)
+	if err != nil {
+		return err
+	}
+	publicID, err := filePublicID(src)
+	if err != nil {
+		return err
+	}
+	oldBase, newBase := path.Base(oldName), path.Base(newName)
+	rename := func() error {
+		if oldBase == newBase {
+			return nil
+		}
+		_, err := fs.fileSvc.RenameItem(ctx, fs.ownerID, &model.RenameItemRequest{ID: publicID, NewName: newBase})
+		return toFSError("rename", newName, err)
+	}
+	if path.Dir(oldName) == path.Dir(newName) {
+		return rename()
+	}
+
+	destDir, err := fs.findDir(ctx, "rename", path.Dir(newName))
+	if err != nil {
+		return err
+	}
+	destPublicID, err := filePublicID(destDir)
+	if err != nil {
+		return err
+	}
+	move := func() error {
+		return toFSError("rename", newName, fs.fileSvc.MoveItems(ctx, fs.ownerID, []string{publicID}, destPublicID))
+	}
+	if _, err := fs.fileRepo.FindByParentIDAndName(ctx, destDir.ID, oldBase); err == nil && oldBase != newBase {
+		if err := rename(); err != nil {
+			return err
+		}
+		return move()
+	}
+	if err := move(); err != nil {
+		return err
+	}
+	return rename()
+}
+
+// Stat 返回文件或目录的信息
+func (fs *FileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	name = cleanPath(name)
+	f, err := fs.find(ctx, "stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return newFileInfo(f), nil
+}
+
+// Copy 使用文件服务在服务端复制文件或目录（含子项），物理实体直接复用而不重新上传。
+// 只支持目标与源同名且位于不同目录的情况，否则返回 ErrCopyUnsupported。
+// 返回值与 webdav 包的 copyFiles 一致：新建时为 201，覆盖已有目标时为 204
+func (fs *FileSystem) Copy(ctx context.Context, src, dst string, overwrite bool) (int, error) {
+	src, dst = cleanPath(src), cleanPath(dst)
+	if src == "/" || path.Base(src) != path.Base(dst) || path.Dir(src) == path.Dir(dst) {
+		return 0, ErrCopyUnsupported
+	}
+	if strings.HasPrefix(dst, src+"/") {
+		return http.StatusForbidden, &os.PathError{Op: "copy", Path: dst, Err: os.ErrPermission}
+	}
+	srcFile, err := fs.find(ctx, "copy", src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	destDir, err := fs.findDir(ctx, "copy", path.Dir(dst))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return http.StatusConflict, err
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	status := http.StatusCreated
+	if _, err := fs.Stat(ctx, dst); err == nil {
+		if !overwrite {
+			return http.StatusPreconditionFailed, os.ErrExist
+		}
+		if err := fs.RemoveAll(ctx, dst); err != nil {
+			return http.StatusForbidden, err
+		}
+		status = http.StatusNoContent
+	} else if !os.IsNotExist(err) {
+		return http.StatusForbidden, err
+	}
+
+	srcID, err := filePublicID(srcFile)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	destID, err := filePublicID(destDir)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err := fs.fileSvc.CopyItems(ctx, fs.ownerID, []string{srcID}, destID); err != nil {
+		if err = toFSError("copy", dst, err); os.IsPermission(err) {
+			return http.StatusForbidden, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	return status, nil
+}
+
+// upload 将内容写入指定路径的文件，文件不存在时先创建。新建的文件写入失败时会被删除，避免留下空文件
+func (fs *FileSystem) upload(ctx context.Context, name string, content io.Reader, size int64) error {
+	recordUpload, err := fs.uploadSvc.CheckDirectUpload(ctx, fs.ownerID, name, size)
+	if err != nil {
+		return toFSError("write", name, err)
+	}
+	uriStr := fileURI(name)
+	target, err := fs.find(ctx, "write", name)
+	created := false
+	var publicID string
+	switch {
+	case err == nil:
+		if target.Type != model.FileTypeFile {
+			return &os.PathError{Op: "write", Path: name, Err: os.ErrInvalid}
+		}
+		if publicID, err = filePublicID(target); err != nil {
+			return err
+		}
+	case os.IsNotExist(err):
+		item, err := fs.fileSvc.CreateEmptyFile(ctx, fs.ownerID, &model.CreateFileRequest{
+			URI:  uriStr,
+			Type: int(model.FileTypeFile),
+		})
+		if err != nil {
+			return toFSError("write", name, err)
+		}
+		if size == 0 {
+			recordUpload()
+			return nil
+		}
+		publicID, created = item.ID, true
+	default:
+		return err
+	}
+
+	viewerID, err := idgen.GeneratePublicID(fs.ownerID, idgen.EntityTypeUser)
+	if err != nil {
+		return err
+	}
+	if _, err := fs.fileSvc.UpdateFileContentByIDAndURI(ctx, viewerID, publicID, uriStr, content); err != nil {
+		if created {
+			if delErr := fs.fileSvc.DeleteItemsPermanently(context.WithoutCancel(ctx), fs.ownerID, []string{publicID}); delErr != nil {
+				log.Printf("[WebDAV] 清理写入失败的文件 %s 时出错: %v", name, delErr)
+			}
+		}
+		return toFSError("write", name, err)
+	}
+	recordUpload()
+	return nil
+}
+
+// fileInfo 实现 os.FileInfo，并实现 webdav.ContentTyper 以免在列目录时为推断类型读取文件内容
+type fileInfo struct {
+	name    string
+	size    int64
+	dir     bool
+	modTime time.Time
+}
+
+var _ xwebdav.ContentTyper = (*fileInfo)(nil)
+
+func newFileInfo(f *model.File) *fileInfo {
+	name := f.Name
+	if name == "" {
+		name = "/"
+	}
+	return &fileInfo{name: name, size: f.Size, dir: f.Type == model.FileTypeDir, modTime: f.UpdatedAt}
+}
+
+func (fi *fileInfo) Name() string       { return fi.name }
+func (fi *fileInfo) Size() int64        { return fi.size }
+func (fi *fileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *fileInfo) IsDir() bool        { return fi.dir }
+func (fi *fileInfo) Sys() any           { return nil }
+
+func (fi *fileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+
+// ContentType 根据扩展名推断内容类型，无法推断时返回 application/octet-stream
+func (fi *fileInfo) ContentType(ctx context.Context) (string, error) {
+	if t := mime.TypeByExtension(path.Ext(fi.name)); t != "" {
+		return t, nil
+	}
+	return "application/octet-stream", nil
+}
+
+// dirFile 是以只读方式打开的目录
+type dirFile struct {
+	ctx      context.Context
+	fs       *FileSystem
+	dir      *model.File
+	children []os.FileInfo
+	loaded   bool
+	pos      int
+}
+
+func (d *dirFile) Close() error { return nil }
+
+func (d *dirFile) Read(p []byte) (int, error) {
+	return 0, &os.PathError{Op: "read", Path: d.dir.Name, Err: os.ErrInvalid}
+}
+
+func (d *dirFile) Write(p []byte) (int, error) {
+	return 0, &os.PathError{Op: "write", Path: d.dir.Name, Err: os.ErrInvalid}
+}
+
+func (d *dirFile) Seek(offset int64, whence int) (int64, error) {
+	return 0, &os.PathError{Op: "seek", Path: d.dir.Name, Err: os.ErrInvalid}
+}
+
+func (d *dirFile) Stat() (os.FileInfo, error) { return newFileInfo(d.dir), nil }
+
+// Readdir 与 os.File.Readdir 语义一致：count <= 0 时返回剩余全部子项
+func (d *dirFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !d.loaded {
+		children, err := d.fs.fileRepo.ListByParentID(d.ctx, d.dir.ID)
+		if err != nil {
+			return nil, err
+		}
+		d.children = make([]os.FileInfo, len(children))
+		for i, c := range children {
+			d.children[i] = newFileInfo(c)
+		}
+		d.loaded = true
+	}
+	rest := d.children[d.pos:]
+	if count <= 0 {
+		d.pos = len(d.children)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	d.pos += count
+	return rest[:count], nil
+}
+
+// readFile 是以只读方式打开的文件，首次读取时才把内容下载到临时文件，
+// 只查询属性（PROPFIND）时不会访问存储
+type readFile struct {
+	ctx  context.Context
+	fs   *FileSystem
+	file *model.File
+	tmp  *os.File
+}
+
+func (f *readFile) load() error {
+	if f.tmp != nil {
+		return nil
+	}
+	tmp, err := os.CreateTemp(tempDir, "download-*")
+	if err != nil {
+		return err
+	}
+	// 空文件没有关联的物理实体，无需下载
+	if f.file.PrimaryEntityID.Valid {
+		publicID, err := filePublicID(f.file)
+		if err == nil {
+			_, err = f.fs.fileSvc.Download(f.ctx, f.fs.ownerID, publicID, tmp)
+		}
+		if err == nil {
+			_, err = tmp.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return toFSError("read", f.file.Name, err)
+		}
+	}
+	f.tmp = tmp
+	return nil
+}
+
+func (f *readFile) Read(p []byte) (int, error) {
+	if err := f.load(); err != nil {
+		return 0, err
+	}
+	return f.tmp.Read(p)
+}
+
+func (f *readFile) Seek(offset int64, whence int) (int64, error) {
+	// http.ServeContent 通过 Seek 到末尾获取长度，此时无需下载内容
+	if f.tmp == nil && offset == 0 && whence != io.SeekCurrent {
+		if whence == io.SeekEnd {
+			return f.file.Size, nil
+		}
+		return 0, nil
+	}
+	if err := f.load(); err != nil {
+		return 0, err
+	}
+	return f.tmp.Seek(offset, whence)
+}
+
+func (f *readFile) Write(p []byte) (int, error) {
+	return 0, &os.PathError{Op: "write", Path: f.file.Name, Err: os.ErrPermission}
+}
+
+func (f *readFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, &os.PathError{Op: "readdir", Path: f.file.Name, Err: os.ErrInvalid}
+}
+
+func (f *readFile) Stat() (os.FileInfo, error) { return newFileInfo(f.file), nil }
+
+func (f *readFile) Close() error {
+	if f.tmp == nil {
+		return nil
+	}
+	f.tmp.Close()
+	return os.Remove(f.tmp.Name())
+}
+
+// writeFile 是以写方式打开的文件，内容先写入临时文件，Close 时再提交给文件服务。
+// 写入超过 limit 后拒绝继续写入，Close 时也不再提交不完整的内容
+type writeFile struct {
+	ctx     context.Context
+	fs      *FileSystem
+	name    string
+	tmp     *os.File
+	limit   int64
+	tooLong bool
+}
+
+func (f *writeFile) Read(p []byte) (int, error) { return f.tmp.Read(p) }
+
+func (f *writeFile) Write(p []byte) (int, error) {
+	pos, err := f.tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if pos+int64(len(p)) > f.limit {
+		f.tooLong = true
+		return 0, &os.PathError{Op: "write", Path: f.name, Err: errFileTooLarge}
+	}
+	return f.tmp.Write(p)
+}
+
+func (f *writeFile) Seek(offset int64, whence int) (int64, error) {
+	return f.tmp.Seek(offset, whence)
+}
+
+func (f *writeFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, &os.PathError{Op: "readdir", Path: f.name, Err: os.ErrInvalid}
+}
+
+func (f *writeFile) Stat() (os.FileInfo, error) {
+	st, err := f.tmp.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo{name: path.Base(f.name), size: st.Size(), modTime: st.ModTime()}, nil
+}
+
+func (f *writeFile) Close() error {
+	defer os.Remove(f.tmp.Name())
+	defer f.tmp.Close()
+	if f.tooLong {
+		return &os.PathError{Op: "write", Path: f.name, Err: errFileTooLarge}
+	}
+	st, err := f.tmp.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := f.tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return f.fs.upload(f.ctx, f.name, f.tmp, st.Size())
+}
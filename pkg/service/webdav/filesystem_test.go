@@ -0,0 +1,356 @@
+package webdav
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	xwebdav "golang.org/x/net/webdav"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/uri"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+)
+
+// fakeTree 是内存中的文件树，同时充当文件仓库与文件服务
+type fakeTree struct {
+	repository.FileRepository
+	file_service.FileService
+	files   map[uint]*model.File
+	content map[uint]string
+	nextID  uint
+	copies  int
+	// uploadLimit 为 0 时不限制大小；uploads 统计通过校验并写入成功的次数
+	uploadLimit int64
+	uploads     int
+}
+
+func (t *fakeTree) DirectUploadLimit(ctx context.Context, ownerID uint, virtualPath string) (int64, error) {
+	return t.uploadLimit, nil
+}
+
+func (t *fakeTree) CheckDirectUpload(ctx context.Context, ownerID uint, virtualPath string, size int64) (func(), error) {
+	if strings.HasSuffix(virtualPath, ".exe") {
+		return nil, constant.ErrUploadExtensionNotAllowed
+	}
+	if t.uploadLimit > 0 && size > t.uploadLimit {
+		return nil, constant.ErrUploadFileTooLarge
+	}
+	return func() { t.uploads++ }, nil
+}
+
+func newFakeTree() *fakeTree {
+	t := &fakeTree{files: map[uint]*model.File{}, content: map[uint]string{}, nextID: 1}
+	t.add(0, "", model.FileTypeDir)
+	return t
+}
+
+func (t *fakeTree) add(parentID uint, name string, typ model.FileType) *model.File {
+	f := &model.File{ID: t.nextID, OwnerID: 1, Name: name, Type: typ, UpdatedAt: time.Now()}
+	if parentID != 0 {
+		f.ParentID = sql.NullInt64{Int64: int64(parentID), Valid: true}
+	}
+	t.files[f.ID] = f
+	t.nextID++
+	return f
+}
+
+func (t *fakeTree) child(parentID uint, name string) *model.File {
+	for _, f := range t.files {
+		if f.ParentID.Valid && uint(f.ParentID.Int64) == parentID && f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (t *fakeTree) FindByPath(ctx context.Context, ownerID uint, p string) (*model.File, error) {
+	current := t.files[1]
+	for _, seg := range strings.Split(strings.Trim(p, "/"), "/") {
+		if seg == "" {
+			continue
+		}
+		if current = t.child(current.ID, seg); current == nil {
+			return nil, constant.ErrNotFound
+		}
+	}
+	return current, nil
+}
+
+func (t *fakeTree) FindByParentIDAndName(ctx context.Context, parentID uint, name string) (*model.File, error) {
+	if f := t.child(parentID, name); f != nil {
+		return f, nil
+	}
+	return nil, constant.ErrNotFound
+}
+
+func (t *fakeTree) ListByParentID(ctx context.Context, parentID uint) ([]*model.File, error) {
+	var result []*model.File
+	for _, f := range t.files {
+		if f.ParentID.Valid && uint(f.ParentID.Int64) == parentID {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+func (t *fakeTree) byPublicID(publicID string) *model.File {
+	id, _, err := idgen.DecodePublicID(publicID)
+	if err != nil {
+		return nil
+	}
+	return t.files[id]
+}
+
+func (t *fakeTree) CreateEmptyFile(ctx context.Context, ownerID uint, req *model.CreateFileRequest) (*model.FileItem, error) {
+	parsed, err := uri.Parse(req.URI)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := t.FindByPath(ctx, ownerID, path.Dir(parsed.Path))
+	if err != nil {
+		return nil, err
+	}
+	if t.child(parent.ID, path.Base(parsed.Path)) != nil {
+		return nil, constant.ErrConflict
+	}
+	f := t.add(parent.ID, path.Base(parsed.Path), model.FileType(req.Type))
+	publicID, _ := filePublicID(f)
+	return &model.FileItem{ID: publicID, Name: f.Name}, nil
+}
+
+func (t *fakeTree) UpdateFileContentByIDAndURI(ctx context.Context, viewerPublicID, filePublicID, uriStr string, r io.Reader) (*model.UpdateResult, error) {
+	f := t.byPublicID(filePublicID)
+	parsed, _ := uri.Parse(uriStr)
+	if f == nil || parsed == nil || parsed.Path != t.pathOf(f) {
+		return nil, constant.ErrConflict
+	}
+	data, _ := io.ReadAll(r)
+	t.content[f.ID] = string(data)
+	f.Size = int64(len(data))
+	f.PrimaryEntityID = types.NullUint64{Uint64: uint64(f.ID), Valid: true}
+	return &model.UpdateResult{}, nil
+}
+
+func (t *fakeTree) pathOf(f *model.File) string {
+	if !f.ParentID.Valid {
+		return "/"
+	}
+	return path.Join(t.pathOf(t.files[uint(f.ParentID.Int64)]), f.Name)
+}
+
+func (t *fakeTree) Download(ctx context.Context, viewerID uint, publicFileID string, w io.Writer) (*file_service.DownloadResult, error) {
+	f := t.byPublicID(publicFileID)
+	if f == nil {
+		return nil, constant.ErrNotFound
+	}
+	io.WriteString(w, t.content[f.ID])
+	return &file_service.DownloadResult{Name: f.Name, Size: f.Size}, nil
+}
+
+func (t *fakeTree) remove(id uint) {
+	for _, c := range t.files {
+		if c.ParentID.Valid && uint(c.ParentID.Int64) == id {
+			t.remove(c.ID)
+		}
+	}
+	delete(t.files, id)
+}
+
+func (t *fakeTree) DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error {
+	for _, id := range publicIDs {
+		if f := t.byPublicID(id); f != nil {
+			t.remove(f.ID)
+		}
+	}
+	return nil
+}
+
+func (t *fakeTree) DeleteItemsPermanently(ctx context.Context, ownerID uint, publicIDs []string) error {
+	return t.DeleteItems(ctx, ownerID, publicIDs)
+}
+
+func (t *fakeTree) RenameItem(ctx context.Context, ownerID uint, req *model.RenameItemRequest) (*model.FileInfoResponse, error) {
+	f := t.byPublicID(req.ID)
+	if t.child(uint(f.ParentID.Int64), req.NewName) != nil {
+		return nil, constant.ErrConflict
+	}
+	f.Name = req.NewName
+	return &model.FileInfoResponse{}, nil
+}
+
+func (t *fakeTree) MoveItems(ctx context.Context, ownerID uint, srcIDs []string, destID string) error {
+	dest := t.byPublicID(destID)
+	for _, id := range srcIDs {
+		f := t.byPublicID(id)
+		if t.child(dest.ID, f.Name) != nil {
+			return fmt.Errorf("目标文件夹中已存在同名项目: %w", constant.ErrConflict)
+		}
+		f.ParentID = sql.NullInt64{Int64: int64(dest.ID), Valid: true}
+	}
+	return nil
+}
+
+func (t *fakeTree) CopyItems(ctx context.Context, ownerID uint, srcIDs []string, destID string) error {
+	dest := t.byPublicID(destID)
+	for _, id := range srcIDs {
+		src := t.byPublicID(id)
+		c := t.add(dest.ID, src.Name, src.Type)
+		c.Size, c.PrimaryEntityID = src.Size, src.PrimaryEntityID
+		t.content[c.ID] = t.content[src.ID]
+		t.copies++
+	}
+	return nil
+}
+
+func newTestHandler(t *testing.T) (*fakeTree, *FileSystem, http.Handler) {
+	t.Helper()
+	if err := idgen.InitSqidsEncoderWithSeed("webdav-test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(tempDir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll("./data") })
+	tree := newFakeTree()
+	fs := NewService(tree, tree, tree).ForUser(1)
+	return tree, fs, &xwebdav.Handler{Prefix: "/dav", FileSystem: fs, LockSystem: xwebdav.NewMemLS()}
+}
+
+func do(h http.Handler, method, target, body string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWebDAVBasicOperations(t *testing.T) {
+	tree, _, h := newTestHandler(t)
+
+	if rec := do(h, "MKCOL", "/dav/docs", ""); rec.Code != http.StatusCreated {
+		t.Fatalf("MKCOL = %d", rec.Code)
+	}
+	if rec := do(h, "MKCOL", "/dav/missing/sub", ""); rec.Code != http.StatusConflict {
+		t.Fatalf("父目录不存在时 MKCOL 应返回 409，得到 %d", rec.Code)
+	}
+	if rec := do(h, http.MethodPut, "/dav/docs/a%20b.txt", "hello"); rec.Code != http.StatusCreated {
+		t.Fatalf("PUT = %d %s", rec.Code, rec.Body)
+	}
+	if rec := do(h, http.MethodPut, "/dav/docs/a%20b.txt", "hello world"); rec.Code != http.StatusCreated {
+		t.Fatalf("覆盖写入 PUT = %d %s", rec.Code, rec.Body)
+	}
+	if rec := do(h, http.MethodGet, "/dav/docs/a%20b.txt", ""); rec.Code != http.StatusOK || rec.Body.String() != "hello world" {
+		t.Fatalf("GET = %d %q", rec.Code, rec.Body)
+	}
+	if rec := do(h, http.MethodGet, "/dav/docs/a%20b.txt", "", "Range", "bytes=6-"); rec.Code != http.StatusPartialContent || rec.Body.String() != "world" {
+		t.Fatalf("Range GET = %d %q", rec.Code, rec.Body)
+	}
+
+	rec := do(h, "PROPFIND", "/dav/docs", "", "Depth", "1")
+	if rec.Code != http.StatusMultiStatus || !strings.Contains(rec.Body.String(), "/dav/docs/a%20b.txt") ||
+		!strings.Contains(rec.Body.String(), "<D:getcontentlength>11</D:getcontentlength>") {
+		t.Fatalf("PROPFIND = %d %s", rec.Code, rec.Body)
+	}
+
+	if rec := do(h, "MOVE", "/dav/docs/a%20b.txt", "", "Destination", "/dav/renamed.txt"); rec.Code != http.StatusCreated {
+		t.Fatalf("MOVE = %d %s", rec.Code, rec.Body)
+	}
+	if f, _ := tree.FindByPath(context.Background(), 1, "/renamed.txt"); f == nil || tree.content[f.ID] != "hello world" {
+		t.Fatal("MOVE 应移动并重命名文件")
+	}
+
+	if rec := do(h, http.MethodDelete, "/dav/docs", ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("DELETE = %d", rec.Code)
+	}
+	if rec := do(h, http.MethodGet, "/dav/docs", ""); rec.Code != http.StatusNotFound {
+		t.Fatalf("删除后应返回 404，得到 %d", rec.Code)
+	}
+}
+
+func TestRenameAvoidsIntermediateConflict(t *testing.T) {
+	tree, fs, _ := newTestHandler(t)
+	ctx := context.Background()
+	src := tree.add(1, "src", model.FileTypeDir)
+	dst := tree.add(1, "dst", model.FileTypeDir)
+	f := tree.add(src.ID, "a.txt", model.FileTypeFile)
+	tree.add(dst.ID, "a.txt", model.FileTypeFile)
+
+	if err := fs.Rename(ctx, "/src/a.txt", "/dst/b.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if uint(f.ParentID.Int64) != dst.ID || f.Name != "b.txt" {
+		t.Fatalf("目标目录已有同名文件时应先重命名再移动: %+v", f)
+	}
+	if err := fs.Rename(ctx, "/dst/b.txt", "/dst/a.txt"); !os.IsExist(err) {
+		t.Fatalf("重命名冲突应返回 os.ErrExist: %v", err)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	tree, fs, _ := newTestHandler(t)
+	ctx := context.Background()
+	src := tree.add(1, "src", model.FileTypeDir)
+	dst := tree.add(1, "dst", model.FileTypeDir)
+	tree.add(src.ID, "a.txt", model.FileTypeFile)
+
+	if _, err := fs.Copy(ctx, "/src/a.txt", "/dst/b.txt", true); err != ErrCopyUnsupported {
+		t.Fatalf("名称不同时应交给逐个文件复制: %v", err)
+	}
+	if status, err := fs.Copy(ctx, "/src/a.txt", "/dst/a.txt", true); err != nil || status != http.StatusCreated {
+		t.Fatalf("Copy = %d %v", status, err)
+	}
+	if status, _ := fs.Copy(ctx, "/src/a.txt", "/dst/a.txt", false); status != http.StatusPreconditionFailed {
+		t.Fatalf("目标已存在且不允许覆盖时应返回 412，得到 %d", status)
+	}
+	if status, err := fs.Copy(ctx, "/src/a.txt", "/dst/a.txt", true); err != nil || status != http.StatusNoContent {
+		t.Fatalf("覆盖复制 = %d %v", status, err)
+	}
+	if status, _ := fs.Copy(ctx, "/src/a.txt", "/none/a.txt", true); status != http.StatusConflict {
+		t.Fatalf("目标目录不存在时应返回 409，得到 %d", status)
+	}
+	if status, _ := fs.Copy(ctx, "/src", "/src/src", true); status != http.StatusForbidden {
+		t.Fatalf("复制到自身子目录应返回 403，得到 %d", status)
+	}
+	if tree.copies != 2 || tree.child(dst.ID, "a.txt") == nil {
+		t.Fatalf("应使用文件服务复制: copies=%d", tree.copies)
+	}
+}
+
+func TestPutEnforcesUploadRules(t *testing.T) {
+	tree, _, h := newTestHandler(t)
+	tree.uploadLimit = 5
+
+	if rec := do(h, http.MethodPut, "/dav/ok.txt", "hello"); rec.Code != http.StatusCreated {
+		t.Fatalf("PUT = %d %s", rec.Code, rec.Body)
+	}
+	if rec := do(h, http.MethodPut, "/dav/big.txt", "hello world"); rec.Code < 400 {
+		t.Fatalf("超过大小限制的 PUT 应失败，得到 %d", rec.Code)
+	}
+	if f, _ := tree.FindByPath(context.Background(), 1, "/big.txt"); f != nil && tree.content[f.ID] != "" {
+		t.Fatal("超过大小限制时不应提交不完整的内容")
+	}
+	if rec := do(h, http.MethodPut, "/dav/a.exe", "x"); rec.Code < 400 {
+		t.Fatalf("不允许的后缀应拒绝写入，得到 %d", rec.Code)
+	}
+	if f, _ := tree.FindByPath(context.Background(), 1, "/a.exe"); f != nil {
+		t.Fatal("不允许的后缀不应创建文件")
+	}
+	if tree.uploads != 1 {
+		t.Fatalf("只有成功的写入才计入上传次数: %d", tree.uploads)
+	}
+}
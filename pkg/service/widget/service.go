@@ -0,0 +1,134 @@
+/*
+ * @Description: 公开统计挂件服务，向外部页面和 README 徽章提供安全的站点计数
+ * @Author: 安知鱼
+ * @Date: 2026-10-15 16:00:00
+ * @LastEditTime: 2026-10-15 16:00:00
+ * @LastEditors: 安知鱼
+ */
+package widget
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/site_stats"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const counterDisabledSetting = "-1" // 侧边栏计数配置为 -1 表示站长不希望公开该数据
+
+// launchTimeLayouts 兼容后台可能保存的几种上线时间格式
+var launchTimeLayouts = []string{
+	"01/02/2006 15:04:05",
+	"2006-01-02 15:04:05",
+	"2006/01/02 15:04:05",
+	"2006-01-02",
+	time.RFC3339,
+}
+
+// SiteCounters 站点公开计数，被站长禁用的项为 nil
+type SiteCounters struct {
+	TotalPosts  *int       `json:"total_posts,omitempty"`
+	TotalWords  *int       `json:"total_words,omitempty"`
+	RunningDays *int       `json:"running_days,omitempty"`
+	LaunchTime  *time.Time `json:"launch_time,omitempty"`
+}
+
+// ArticleViews 单篇文章的浏览量
+type ArticleViews struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Views int    `json:"views"`
+}
+
+// Service 公开统计挂件服务接口
+type Service interface {
+	// GetSiteCounters 获取站点公开计数（文章数、总字数、运行天数）
+	GetSiteCounters(ctx context.Context) (*SiteCounters, error)
+	// GetArticleViews 获取已发布文章的浏览量，不会增加浏览次数
+	GetArticleViews(ctx context.Context, slugOrID string) (*ArticleViews, error)
+}
+
+type serviceImpl struct {
+	articleRepo  repository.ArticleRepository
+	cacheSvc     utility.CacheService
+	settingSvc   setting.SettingService
+	siteStatsSvc *site_stats.Service
+}
+
+// NewService 创建公开统计挂件服务
+func NewService(articleRepo repository.ArticleRepository, cacheSvc utility.CacheService, settingSvc setting.SettingService, siteStatsSvc *site_stats.Service) Service {
+	return &serviceImpl{
+		articleRepo:  articleRepo,
+		cacheSvc:     cacheSvc,
+		settingSvc:   settingSvc,
+		siteStatsSvc: siteStatsSvc,
+	}
+}
+
+// GetSiteCounters 获取站点公开计数，文章数与字数取自站点统计快照
+func (s *serviceImpl) GetSiteCounters(ctx context.Context) (*SiteCounters, error) {
+	snapshot, err := s.siteStatsSvc.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counters := &SiteCounters{}
+	if s.settingSvc.Get(constant.KeySidebarSiteInfoTotalPostCount.String()) != counterDisabledSetting {
+		counters.TotalPosts = &snapshot.TotalPosts
+	}
+	if s.settingSvc.Get(constant.KeySidebarSiteInfoTotalWordCount.String()) != counterDisabledSetting {
+		counters.TotalWords = &snapshot.TotalWords
+	}
+	if launch, ok := parseLaunchTime(s.settingSvc.Get(constant.KeyFooterRuntimeLaunchTime.String())); ok {
+		days := int(time.Since(launch).Hours() / 24)
+		if days < 0 {
+			days = 0
+		}
+		counters.RunningDays = &days
+		counters.LaunchTime = &launch
+	}
+	return counters, nil
+}
+
+// GetArticleViews 浏览量 = 数据库中的值 + Redis 中尚未同步的增量
+func (s *serviceImpl) GetArticleViews(ctx context.Context, slugOrID string) (*ArticleViews, error) {
+	article, err := s.articleRepo.GetBySlugOrID(ctx, slugOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	views := article.ViewCount
+	incr, err := s.cacheSvc.Get(ctx, article_service.ArticleViewCountKeyPrefix+article.ID)
+	if err != nil {
+		log.Printf("[Widget] 获取文章 %s 的增量浏览量失败: %v", article.ID, err)
+	} else if n, convErr := strconv.Atoi(incr); convErr == nil {
+		views += n
+	}
+
+	return &ArticleViews{
+		ID:    article.ID,
+		Title: article.Title,
+		Views: views,
+	}, nil
+}
+
+func parseLaunchTime(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range launchTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -3,14 +3,16 @@ package util
 
 import (
 	"fmt"
+	"log"
 	"net"
 	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
 )
 
-// TrustedProxyCIDRs 是可信代理的 CIDR 列表，供 Gin 和本包共同使用。
-var TrustedProxyCIDRs = []string{
+// DefaultTrustedProxyCIDRs 是未显式配置可信代理时使用的默认列表（本机与私有网段）。
+var DefaultTrustedProxyCIDRs = []string{
 	"127.0.0.0/8",
 	"::1/128",
 	"10.0.0.0/8",
@@ -18,16 +20,22 @@ var TrustedProxyCIDRs = []string{
 	"192.168.0.0/16",
 }
 
-var trustedProxyNets []*net.IPNet
+// TrustedProxyCIDRs 是当前生效的可信代理 CIDR 列表，供 Gin 和本包共同使用。
+// 启动时可通过 SetTrustedProxies 按配置文件覆盖。
+var TrustedProxyCIDRs = DefaultTrustedProxyCIDRs
+
+var (
+	trustedProxyMu   sync.RWMutex
+	trustedProxyNets []*net.IPNet
+
+	untrustedForwardWarnOnce sync.Once
+)
+
 var privateOrReservedNets []*net.IPNet
 
 func init() {
-	for _, cidr := range TrustedProxyCIDRs {
-		_, ipNet, err := net.ParseCIDR(cidr)
-		if err != nil {
-			panic(fmt.Sprintf("invalid CIDR in TrustedProxyCIDRs: %s", cidr))
-		}
-		trustedProxyNets = append(trustedProxyNets, ipNet)
+	if err := SetTrustedProxies(DefaultTrustedProxyCIDRs); err != nil {
+		panic(err)
 	}
 
 	reservedCIDRs := []string{
@@ -49,16 +57,72 @@ func init() {
 	}
 }
 
+// ParseTrustedProxies 解析逗号分隔的可信代理配置，单个 IP 会被转换为 /32 或 /128 网段。
+// 空字符串返回默认列表；"none" 表示不信任任何代理（直接暴露在公网时使用）。
+func ParseTrustedProxies(raw string) ([]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return DefaultTrustedProxyCIDRs, nil
+	}
+	if strings.EqualFold(raw, "none") {
+		return []string{}, nil
+	}
+
+	var cidrs []string
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("无效的可信代理地址: %s", entry)
+			}
+			if ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		if _, _, err := net.ParseCIDR(entry); err != nil {
+			return nil, fmt.Errorf("无效的可信代理网段: %s", entry)
+		}
+		cidrs = append(cidrs, entry)
+	}
+	return cidrs, nil
+}
+
+// SetTrustedProxies 替换当前生效的可信代理列表，调用方需同步调用 gin.Engine.SetTrustedProxies。
+func SetTrustedProxies(cidrs []string) error {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR in TrustedProxyCIDRs: %s", cidr)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	trustedProxyMu.Lock()
+	defer trustedProxyMu.Unlock()
+	TrustedProxyCIDRs = cidrs
+	trustedProxyNets = nets
+	return nil
+}
+
 func isTrustedProxy(remoteAddr string) bool {
 	host, _, err := net.SplitHostPort(remoteAddr)
 	if err != nil {
 		host = remoteAddr
 	}
-	ip := net.ParseIP(host)
+	ip := net.ParseIP(strings.TrimSpace(host))
 	if ip == nil {
 		return false
 	}
 
+	trustedProxyMu.RLock()
+	defer trustedProxyMu.RUnlock()
 	for _, ipNet := range trustedProxyNets {
 		if ipNet.Contains(ip) {
 			return true
@@ -79,29 +143,81 @@ func extractIPFromHeader(value string) string {
 	return ""
 }
 
-// GetRealClientIP 获取客户端真实IP地址
+// extractIPFromForwardedFor 从右向左遍历 X-Forwarded-For，跳过可信代理追加的地址，
+// 返回第一个非可信地址；客户端自行伪造的左侧条目因此不会被采用。
+func extractIPFromForwardedFor(value string) string {
+	if value == "" {
+		return ""
+	}
+	parts := strings.Split(value, ",")
+	leftmost := ""
+	for i := len(parts) - 1; i >= 0; i-- {
+		candidate := strings.TrimSpace(parts[i])
+		if net.ParseIP(candidate) == nil {
+			return ""
+		}
+		if !isTrustedProxy(candidate) {
+			return candidate
+		}
+		leftmost = candidate
+	}
+	return leftmost
+}
+
+// forwardingHeaders 为可信代理可能携带的真实IP头部，按优先级排列
+var forwardingHeaders = []string{
+	"X-Forwarded-For",
+	"X-Real-IP",
+	"CF-Connecting-IP",
+	"EO-Connecting-IP",
+	"Ali-CDN-Real-IP",
+	"True-Client-IP",
+}
+
+// GetRealClientIP 获取客户端真实IP地址，评论、统计、限流、地理位置等处应统一使用此函数。
 // 仅当直连来源是可信代理时，才检查转发头部，防止客户端直接伪造代理头部。
 func GetRealClientIP(c *gin.Context) string {
 	if !isTrustedProxy(c.Request.RemoteAddr) {
-		return c.ClientIP()
+		warnUntrustedForwarding(c)
+		return c.RemoteIP()
 	}
 
-	headers := []string{
-		"X-Forwarded-For",
-		"X-Real-IP",
-		"CF-Connecting-IP",
-		"EO-Connecting-IP",
-		"Ali-CDN-Real-IP",
-		"True-Client-IP",
+	if ip := extractIPFromForwardedFor(c.GetHeader("X-Forwarded-For")); ip != "" {
+		return ip
 	}
-
-	for _, header := range headers {
+	for _, header := range forwardingHeaders[1:] {
 		if ip := extractIPFromHeader(c.GetHeader(header)); ip != "" {
 			return ip
 		}
 	}
 
-	return c.ClientIP()
+	return c.RemoteIP()
+}
+
+// HasForwardingHeaders 判断请求是否携带任一代理转发头部
+func HasForwardingHeaders(c *gin.Context) bool {
+	for _, header := range forwardingHeaders {
+		if c.GetHeader(header) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// warnUntrustedForwarding 在首次收到来自非可信来源的转发头部时打印一次警告，
+// 提示部署在反向代理或 CDN 之后却未配置可信代理的情况。
+func warnUntrustedForwarding(c *gin.Context) {
+	for _, header := range forwardingHeaders {
+		if c.GetHeader(header) != "" {
+			untrustedForwardWarnOnce.Do(func() {
+				log.Printf("⚠️  警告: 收到来自非可信来源 %s 的 %s 头部，已忽略并使用连接地址作为客户端IP。"+
+					"如果服务部署在反向代理或 CDN 之后，请在 data/conf.ini 的 [System] 中配置 TrustedProxies "+
+					"(或环境变量 ANHEYU_SYSTEM_TRUSTEDPROXIES)，否则评论、统计、限流记录的都将是代理的IP。",
+					c.RemoteIP(), header)
+			})
+			return
+		}
+	}
 }
 
 // IsValidIP 验证IP地址是否有效
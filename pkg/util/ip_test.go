@@ -0,0 +1,68 @@
+package util
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	got, err := ParseTrustedProxies(" 203.0.113.7, 2001:db8::1 ,198.51.100.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"203.0.113.7/32", "2001:db8::1/128", "198.51.100.0/24"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTrustedProxies = %v, want %v", got, want)
+	}
+
+	if got, _ := ParseTrustedProxies(""); !reflect.DeepEqual(got, DefaultTrustedProxyCIDRs) {
+		t.Errorf("未配置时应使用默认列表，实际 %v", got)
+	}
+	if got, _ := ParseTrustedProxies("none"); len(got) != 0 {
+		t.Errorf("none 应表示不信任任何代理，实际 %v", got)
+	}
+	if _, err := ParseTrustedProxies("10.0.0.0/8, not-an-ip"); err == nil {
+		t.Error("无效地址应返回错误")
+	}
+}
+
+func TestGetRealClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Cleanup(func() { _ = SetTrustedProxies(DefaultTrustedProxyCIDRs) })
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "203.0.113.7/32"}); err != nil {
+		t.Fatal(err)
+	}
+
+	resolve := func(remoteAddr string, headers map[string]string) string {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/", nil)
+		c.Request.RemoteAddr = remoteAddr
+		for k, v := range headers {
+			c.Request.Header.Set(k, v)
+		}
+		return GetRealClientIP(c)
+	}
+
+	cases := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"直连无转发头", "198.51.100.9:1234", nil, "198.51.100.9"},
+		{"非可信来源伪造转发头", "198.51.100.9:1234", map[string]string{"X-Forwarded-For": "1.2.3.4"}, "198.51.100.9"},
+		{"可信代理转发", "10.0.0.2:1234", map[string]string{"X-Forwarded-For": "198.51.100.9"}, "198.51.100.9"},
+		{"多级可信代理从右向左跳过", "10.0.0.2:1234", map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.9, 203.0.113.7"}, "198.51.100.9"},
+		{"全部为可信地址时取最左侧", "10.0.0.2:1234", map[string]string{"X-Forwarded-For": "10.1.1.1, 10.0.0.3"}, "10.1.1.1"},
+		{"回退到 X-Real-IP", "10.0.0.2:1234", map[string]string{"X-Real-IP": "198.51.100.9"}, "198.51.100.9"},
+		{"转发头非法时使用连接地址", "10.0.0.2:1234", map[string]string{"X-Forwarded-For": "garbage"}, "10.0.0.2"},
+	}
+	for _, tc := range cases {
+		if got := resolve(tc.remote, tc.headers); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}